@@ -77,7 +77,8 @@ type WorkflowService interface {  //WorkflowService API is exposed to provide su
   // StartWorkflowExecution starts a new long running workflow instance.  It will create the instance with
   // 'WorkflowExecutionStarted' event in history and also schedule the first DecisionTask for the worker to make the
   // first decision for this instance.  It will return 'WorkflowExecutionAlreadyStartedError', if an instance already
-  // exists with same workflowId.
+  // exists with same workflowId.  When requestEagerExecution is set the first DecisionTask is started right away for
+  // the caller and returned in the response, instead of being dispatched to the pollers of the task list.
   // 
   // 
   // Parameters:
@@ -109,7 +110,7 @@ type WorkflowService interface {  //WorkflowService API is exposed to provide su
   // 
   // Parameters:
   //  - CompleteRequest
-  RespondDecisionTaskCompleted(completeRequest *shared.RespondDecisionTaskCompletedRequest) (err error)
+  RespondDecisionTaskCompleted(completeRequest *shared.RespondDecisionTaskCompletedRequest) (r *shared.RespondDecisionTaskCompletedResponse, err error)
   // PollForActivityTask is called by application worker to process ActivityTask from a specific taskList.  ActivityTask
   // is dispatched to callers whenever a ScheduleTask decision is made for a workflow execution.
   // Application is expected to call 'RespondActivityTaskCompleted' or 'RespondActivityTaskFailed' once it is done
@@ -197,6 +198,103 @@ type WorkflowService interface {  //WorkflowService API is exposed to provide su
   // Parameters:
   //  - ListRequest
   ListClosedWorkflowExecutions(listRequest *shared.ListClosedWorkflowExecutionsRequest) (r *shared.ListClosedWorkflowExecutionsResponse, err error)
+  // ScanWorkflowExecutions is a visibility API to scan all the executions in a specific domain, open executions
+  // first.  Unlike the list APIs the results are not filtered or sorted by time, which makes it cheaper for bulk
+  // exports and batch jobs that sweep the whole domain.
+  // 
+  // 
+  // Parameters:
+  //  - ListRequest
+  ScanWorkflowExecutions(listRequest *shared.ScanWorkflowExecutionsRequest) (r *shared.ScanWorkflowExecutionsResponse, err error)
+  // GetDomainReplicationMessages returns the changes made to domains (registration, update and deprecation) after the
+  // given notification version, in the order they were made.  External systems can poll it with the last notification
+  // version they have seen to keep a copy of the domains in sync.
+  // 
+  // 
+  // Parameters:
+  //  - GetRequest
+  GetDomainReplicationMessages(getRequest *shared.GetDomainReplicationMessagesRequest) (r *shared.GetDomainReplicationMessagesResponse, err error)
+  // GetWorkflowResult waits until the run of a workflow execution closes and returns its close event, which holds
+  // the result or the failure of the run.  The call returns without a close event and with a continuation token
+  // before the deadline of the request expires, the token is passed with the next call to continue waiting.
+  // 
+  // 
+  // Parameters:
+  //  - GetRequest
+  GetWorkflowResult(getRequest *shared.GetWorkflowResultRequest) (r *shared.GetWorkflowResultResponse, err error)
+  // CountOpenWorkflowExecutions is a visibility API to count the open executions in a specific domain which match the
+  // filters of the request, without listing them.
+  // 
+  // Parameters:
+  //  - ListRequest
+  CountOpenWorkflowExecutions(listRequest *shared.CountOpenWorkflowExecutionsRequest) (r *shared.CountOpenWorkflowExecutionsResponse, err error)
+  // CountClosedWorkflowExecutions is a visibility API to count the closed executions in a specific domain which match
+  // the filters of the request, without listing them.
+  // 
+  // Parameters:
+  //  - ListRequest
+  CountClosedWorkflowExecutions(listRequest *shared.CountClosedWorkflowExecutionsRequest) (r *shared.CountClosedWorkflowExecutionsResponse, err error)
+  // GetClusterInfo returns the capabilities of the cluster, so that clients can adapt their behavior to the features
+  // supported by the server instead of comparing server versions.
+  // 
+  // Parameters:
+  //  - GetRequest
+  GetClusterInfo(getRequest *shared.GetClusterInfoRequest) (r *shared.GetClusterInfoResponse, err error)
+  // ListWorkflowExecutionsWithQuery is a visibility API to list the open and closed executions in a specific domain
+  // which match a SQL-like query, e.g. "WorkflowType = 'X' AND CloseTime > '2018-01-01T00:00:00Z'".  It is only
+  // supported by the Elasticsearch visibility store.
+  // 
+  // Parameters:
+  //  - ListRequest
+  ListWorkflowExecutionsWithQuery(listRequest *shared.ListWorkflowExecutionsWithQueryRequest) (r *shared.ListWorkflowExecutionsWithQueryResponse, err error)
+  // SignalWithStartWorkflowExecution is used to ensure sending a signal to a workflow.  If the workflow is running, this
+  // results in WorkflowExecutionSignaled event recorded in the history and a decision task being created for the
+  // execution.  If the workflow is not running or not found, this results in WorkflowExecutionStarted and
+  // WorkflowExecutionSignaled events recorded in the history of a new run, and a decision task being created for it.
+  // 
+  // Parameters:
+  //  - SignalWithStartRequest
+  SignalWithStartWorkflowExecution(signalWithStartRequest *shared.SignalWithStartWorkflowExecutionRequest) (r *shared.StartWorkflowExecutionResponse, err error)
+  // ResetWorkflowExecution resets a workflow execution to the end of a decision task of one of its runs.  A new run is
+  // created with the history of the run up to the started event of the decision task, which is then recorded as failed
+  // and followed by a new decision task.  The signals received by the run after that point are recorded again in the new
+  // run.  The run is terminated if it is still running.
+  // 
+  // Parameters:
+  //  - ResetRequest
+  ResetWorkflowExecution(resetRequest *shared.ResetWorkflowExecutionRequest) (r *shared.ResetWorkflowExecutionResponse, err error)
+  // QueryWorkflow returns the result of a query of a workflow execution.  The query is dispatched to a worker polling
+  // the decision task list of the execution, which replays its history and answers with 'RespondQueryTaskCompleted'.
+  // It fails with 'QueryFailedError' if the worker fails to answer the query.
+  // 
+  // Parameters:
+  //  - QueryRequest
+  QueryWorkflow(queryRequest *shared.QueryWorkflowRequest) (r *shared.QueryWorkflowResponse, err error)
+  // RespondQueryTaskCompleted is called by application worker to complete a query task handed as a result of
+  // 'PollForDecisionTask' API call, a decision task which carries a query.  It delivers the result of the query, or
+  // the reason it failed, to the caller of 'QueryWorkflow'.
+  // 
+  // Parameters:
+  //  - CompleteRequest
+  RespondQueryTaskCompleted(completeRequest *shared.RespondQueryTaskCompletedRequest) (err error)
+  // StartBatchOperation starts signaling, canceling or terminating all the open executions of a domain which match a
+  // visibility query, at a limited rate.  It returns the ID of the batch to follow its progress with
+  // DescribeBatchOperation.  It is only supported by the Elasticsearch visibility store.
+  // 
+  // Parameters:
+  //  - StartRequest
+  StartBatchOperation(startRequest *shared.StartBatchOperationRequest) (r *shared.StartBatchOperationResponse, err error)
+  // DescribeBatchOperation returns the state and the progress of a batch operation.
+  // The progress of a batch running on another frontend host is the progress that host last recorded.
+  // 
+  // Parameters:
+  //  - DescribeRequest
+  DescribeBatchOperation(describeRequest *shared.DescribeBatchOperationRequest) (r *shared.DescribeBatchOperationResponse, err error)
+  // StopBatchOperation stops a running batch operation, the executions already processed are left as they are.
+  // 
+  // Parameters:
+  //  - StopRequest
+  StopBatchOperation(stopRequest *shared.StopBatchOperationRequest) (err error)
 }
 
 //WorkflowService API is exposed to provide support for long running applications.  Application is expected to call
@@ -594,7 +692,8 @@ func (p *WorkflowServiceClient) recvDeprecateDomain() (err error) {
 // StartWorkflowExecution starts a new long running workflow instance.  It will create the instance with
 // 'WorkflowExecutionStarted' event in history and also schedule the first DecisionTask for the worker to make the
 // first decision for this instance.  It will return 'WorkflowExecutionAlreadyStartedError', if an instance already
-// exists with same workflowId.
+// exists with same workflowId.  When requestEagerExecution is set the first DecisionTask is started right away for
+// the caller and returned in the response, instead of being dispatched to the pollers of the task list.
 // 
 // 
 // Parameters:
@@ -872,7 +971,7 @@ func (p *WorkflowServiceClient) recvPollForDecisionTask() (value *shared.PollFor
 // 
 // Parameters:
 //  - CompleteRequest
-func (p *WorkflowServiceClient) RespondDecisionTaskCompleted(completeRequest *shared.RespondDecisionTaskCompletedRequest) (err error) {
+func (p *WorkflowServiceClient) RespondDecisionTaskCompleted(completeRequest *shared.RespondDecisionTaskCompletedRequest) (r *shared.RespondDecisionTaskCompletedResponse, err error) {
   if err = p.sendRespondDecisionTaskCompleted(completeRequest); err != nil { return }
   return p.recvRespondDecisionTaskCompleted()
 }
@@ -900,7 +999,7 @@ func (p *WorkflowServiceClient) sendRespondDecisionTaskCompleted(completeRequest
 }
 
 
-func (p *WorkflowServiceClient) recvRespondDecisionTaskCompleted() (err error) {
+func (p *WorkflowServiceClient) recvRespondDecisionTaskCompleted() (value *shared.RespondDecisionTaskCompletedResponse, err error) {
   iprot := p.InputProtocol
   if iprot == nil {
     iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -919,16 +1018,16 @@ func (p *WorkflowServiceClient) recvRespondDecisionTaskCompleted() (err error) {
     return
   }
   if mTypeId == thrift.EXCEPTION {
-    error14 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-    var error15 error
-    error15, err = error14.Read(iprot)
+    error18 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error19 error
+    error19, err = error18.Read(iprot)
     if err != nil {
       return
     }
     if err = iprot.ReadMessageEnd(); err != nil {
       return
     }
-    err = error15
+    err = error19
     return
   }
   if mTypeId != thrift.REPLY {
@@ -952,6 +1051,7 @@ func (p *WorkflowServiceClient) recvRespondDecisionTaskCompleted() (err error) {
     err = result.EntityNotExistError
     return 
   }
+  value = result.GetSuccess()
   return
 }
 
@@ -1863,1086 +1963,7087 @@ func (p *WorkflowServiceClient) recvListClosedWorkflowExecutions() (value *share
   return
 }
 
-
-type WorkflowServiceProcessor struct {
-  processorMap map[string]thrift.TProcessorFunction
-  handler WorkflowService
-}
-
-func (p *WorkflowServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-  p.processorMap[key] = processor
-}
-
-func (p *WorkflowServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-  processor, ok = p.processorMap[key]
-  return processor, ok
-}
-
-func (p *WorkflowServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-  return p.processorMap
-}
-
-func NewWorkflowServiceProcessor(handler WorkflowService) *WorkflowServiceProcessor {
-
-  self36 := &WorkflowServiceProcessor{handler:handler, processorMap:make(map[string]thrift.TProcessorFunction)}
-  self36.processorMap["RegisterDomain"] = &workflowServiceProcessorRegisterDomain{handler:handler}
-  self36.processorMap["DescribeDomain"] = &workflowServiceProcessorDescribeDomain{handler:handler}
-  self36.processorMap["UpdateDomain"] = &workflowServiceProcessorUpdateDomain{handler:handler}
-  self36.processorMap["DeprecateDomain"] = &workflowServiceProcessorDeprecateDomain{handler:handler}
-  self36.processorMap["StartWorkflowExecution"] = &workflowServiceProcessorStartWorkflowExecution{handler:handler}
-  self36.processorMap["GetWorkflowExecutionHistory"] = &workflowServiceProcessorGetWorkflowExecutionHistory{handler:handler}
-  self36.processorMap["PollForDecisionTask"] = &workflowServiceProcessorPollForDecisionTask{handler:handler}
-  self36.processorMap["RespondDecisionTaskCompleted"] = &workflowServiceProcessorRespondDecisionTaskCompleted{handler:handler}
-  self36.processorMap["PollForActivityTask"] = &workflowServiceProcessorPollForActivityTask{handler:handler}
-  self36.processorMap["RecordActivityTaskHeartbeat"] = &workflowServiceProcessorRecordActivityTaskHeartbeat{handler:handler}
-  self36.processorMap["RespondActivityTaskCompleted"] = &workflowServiceProcessorRespondActivityTaskCompleted{handler:handler}
-  self36.processorMap["RespondActivityTaskFailed"] = &workflowServiceProcessorRespondActivityTaskFailed{handler:handler}
-  self36.processorMap["RespondActivityTaskCanceled"] = &workflowServiceProcessorRespondActivityTaskCanceled{handler:handler}
-  self36.processorMap["RequestCancelWorkflowExecution"] = &workflowServiceProcessorRequestCancelWorkflowExecution{handler:handler}
-  self36.processorMap["SignalWorkflowExecution"] = &workflowServiceProcessorSignalWorkflowExecution{handler:handler}
-  self36.processorMap["TerminateWorkflowExecution"] = &workflowServiceProcessorTerminateWorkflowExecution{handler:handler}
-  self36.processorMap["ListOpenWorkflowExecutions"] = &workflowServiceProcessorListOpenWorkflowExecutions{handler:handler}
-  self36.processorMap["ListClosedWorkflowExecutions"] = &workflowServiceProcessorListClosedWorkflowExecutions{handler:handler}
-return self36
+// ScanWorkflowExecutions is a visibility API to scan all the executions in a specific domain, open executions
+// first.  Unlike the list APIs the results are not filtered or sorted by time, which makes it cheaper for bulk
+// exports and batch jobs that sweep the whole domain.
+// 
+// 
+// Parameters:
+//  - ListRequest
+func (p *WorkflowServiceClient) ScanWorkflowExecutions(listRequest *shared.ScanWorkflowExecutionsRequest) (r *shared.ScanWorkflowExecutionsResponse, err error) {
+  if err = p.sendScanWorkflowExecutions(listRequest); err != nil { return }
+  return p.recvScanWorkflowExecutions()
 }
 
-func (p *WorkflowServiceProcessor) Process(iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  name, _, seqId, err := iprot.ReadMessageBegin()
-  if err != nil { return false, err }
-  if processor, ok := p.GetProcessorFunction(name); ok {
-    return processor.Process(seqId, iprot, oprot)
+func (p *WorkflowServiceClient) sendScanWorkflowExecutions(listRequest *shared.ScanWorkflowExecutionsRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
   }
-  iprot.Skip(thrift.STRUCT)
-  iprot.ReadMessageEnd()
-  x37 := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function " + name)
-  oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-  x37.Write(oprot)
-  oprot.WriteMessageEnd()
-  oprot.Flush()
-  return false, x37
-
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("ScanWorkflowExecutions", thrift.CALL, p.SeqId); err != nil {
+      return
+  }
+  args := WorkflowServiceScanWorkflowExecutionsArgs{
+  ListRequest : listRequest,
+  }
+  if err = args.Write(oprot); err != nil {
+      return
+  }
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
+  }
+  return oprot.Flush()
 }
 
-type workflowServiceProcessorRegisterDomain struct {
-  handler WorkflowService
-}
 
-func (p *workflowServiceProcessorRegisterDomain) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceRegisterDomainArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("RegisterDomain", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
+func (p *WorkflowServiceClient) recvScanWorkflowExecutions() (value *shared.ScanWorkflowExecutionsResponse, err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
   }
-
-  iprot.ReadMessageEnd()
-  result := WorkflowServiceRegisterDomainResult{}
-  var err2 error
-  if err2 = p.handler.RegisterDomain(args.RegisterRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.DomainAlreadyExistsError:
-  result.DomainExistsError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RegisterDomain: " + err2.Error())
-    oprot.WriteMessageBegin("RegisterDomain", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
   }
+  if method != "ScanWorkflowExecutions" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "ScanWorkflowExecutions failed: wrong method name")
+    return
   }
-  if err2 = oprot.WriteMessageBegin("RegisterDomain", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ScanWorkflowExecutions failed: out of sequence response")
+    return
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  if mTypeId == thrift.EXCEPTION {
+    error34 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error35 error
+    error35, err = error34.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error35
+    return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "ScanWorkflowExecutions failed: invalid message type")
+    return
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  result := WorkflowServiceScanWorkflowExecutionsResult{}
+  if err = result.Read(iprot); err != nil {
+    return
   }
-  if err != nil {
+  if err = iprot.ReadMessageEnd(); err != nil {
     return
   }
-  return true, err
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  }
+  value = result.GetSuccess()
+  return
 }
 
-type workflowServiceProcessorDescribeDomain struct {
-  handler WorkflowService
+// GetDomainReplicationMessages returns the changes made to domains (registration, update and deprecation) after the
+// given notification version, in the order they were made.  External systems can poll it with the last notification
+// version they have seen to keep a copy of the domains in sync.
+// 
+// 
+// Parameters:
+//  - GetRequest
+func (p *WorkflowServiceClient) GetDomainReplicationMessages(getRequest *shared.GetDomainReplicationMessagesRequest) (r *shared.GetDomainReplicationMessagesResponse, err error) {
+  if err = p.sendGetDomainReplicationMessages(getRequest); err != nil { return }
+  return p.recvGetDomainReplicationMessages()
 }
 
-func (p *workflowServiceProcessorDescribeDomain) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceDescribeDomainArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("DescribeDomain", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
+func (p *WorkflowServiceClient) sendGetDomainReplicationMessages(getRequest *shared.GetDomainReplicationMessagesRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
   }
-
-  iprot.ReadMessageEnd()
-  result := WorkflowServiceDescribeDomainResult{}
-var retval *shared.DescribeDomainResponse
-  var err2 error
-  if retval, err2 = p.handler.DescribeDomain(args.DescribeRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DescribeDomain: " + err2.Error())
-    oprot.WriteMessageBegin("DescribeDomain", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("GetDomainReplicationMessages", thrift.CALL, p.SeqId); err != nil {
+      return
   }
-  } else {
-    result.Success = retval
-}
-  if err2 = oprot.WriteMessageBegin("DescribeDomain", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+  args := WorkflowServiceGetDomainReplicationMessagesArgs{
+  GetRequest : getRequest,
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
-  }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
-  }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  if err = args.Write(oprot); err != nil {
+      return
   }
-  if err != nil {
-    return
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
   }
-  return true, err
+  return oprot.Flush()
 }
 
-type workflowServiceProcessorUpdateDomain struct {
-  handler WorkflowService
-}
 
-func (p *workflowServiceProcessorUpdateDomain) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceUpdateDomainArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("UpdateDomain", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
+func (p *WorkflowServiceClient) recvGetDomainReplicationMessages() (value *shared.GetDomainReplicationMessagesResponse, err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
   }
-
-  iprot.ReadMessageEnd()
-  result := WorkflowServiceUpdateDomainResult{}
-var retval *shared.UpdateDomainResponse
-  var err2 error
-  if retval, err2 = p.handler.UpdateDomain(args.UpdateRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateDomain: " + err2.Error())
-    oprot.WriteMessageBegin("UpdateDomain", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
   }
-  } else {
-    result.Success = retval
-}
-  if err2 = oprot.WriteMessageBegin("UpdateDomain", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+  if method != "GetDomainReplicationMessages" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "GetDomainReplicationMessages failed: wrong method name")
+    return
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "GetDomainReplicationMessages failed: out of sequence response")
+    return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  if mTypeId == thrift.EXCEPTION {
+    error34 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error35 error
+    error35, err = error34.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error35
+    return
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "GetDomainReplicationMessages failed: invalid message type")
+    return
   }
-  if err != nil {
+  result := WorkflowServiceGetDomainReplicationMessagesResult{}
+  if err = result.Read(iprot); err != nil {
     return
   }
-  return true, err
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  }
+  value = result.GetSuccess()
+  return
 }
 
-type workflowServiceProcessorDeprecateDomain struct {
-  handler WorkflowService
+// GetWorkflowResult waits until the run of a workflow execution closes and returns its close event, which holds
+// the result or the failure of the run.  The call returns without a close event and with a continuation token
+// before the deadline of the request expires, the token is passed with the next call to continue waiting.
+// 
+// 
+// Parameters:
+//  - GetRequest
+func (p *WorkflowServiceClient) GetWorkflowResult(getRequest *shared.GetWorkflowResultRequest) (r *shared.GetWorkflowResultResponse, err error) {
+  if err = p.sendGetWorkflowResult(getRequest); err != nil { return }
+  return p.recvGetWorkflowResult()
 }
 
-func (p *workflowServiceProcessorDeprecateDomain) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceDeprecateDomainArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("DeprecateDomain", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
-  }
-
-  iprot.ReadMessageEnd()
-  result := WorkflowServiceDeprecateDomainResult{}
-  var err2 error
-  if err2 = p.handler.DeprecateDomain(args.DeprecateRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeprecateDomain: " + err2.Error())
-    oprot.WriteMessageBegin("DeprecateDomain", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
-  }
-  }
-  if err2 = oprot.WriteMessageBegin("DeprecateDomain", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+func (p *WorkflowServiceClient) sendGetWorkflowResult(getRequest *shared.GetWorkflowResultRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("GetWorkflowResult", thrift.CALL, p.SeqId); err != nil {
+      return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  args := WorkflowServiceGetWorkflowResultArgs{
+  GetRequest : getRequest,
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  if err = args.Write(oprot); err != nil {
+      return
   }
-  if err != nil {
-    return
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
   }
-  return true, err
+  return oprot.Flush()
 }
 
-type workflowServiceProcessorStartWorkflowExecution struct {
-  handler WorkflowService
-}
 
-func (p *workflowServiceProcessorStartWorkflowExecution) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceStartWorkflowExecutionArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("StartWorkflowExecution", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
+func (p *WorkflowServiceClient) recvGetWorkflowResult() (value *shared.GetWorkflowResultResponse, err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
   }
-
-  iprot.ReadMessageEnd()
-  result := WorkflowServiceStartWorkflowExecutionResult{}
-var retval *shared.StartWorkflowExecutionResponse
-  var err2 error
-  if retval, err2 = p.handler.StartWorkflowExecution(args.StartRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.WorkflowExecutionAlreadyStartedError:
-  result.SessionAlreadyExistError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing StartWorkflowExecution: " + err2.Error())
-    oprot.WriteMessageBegin("StartWorkflowExecution", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
   }
-  } else {
-    result.Success = retval
-}
-  if err2 = oprot.WriteMessageBegin("StartWorkflowExecution", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+  if method != "GetWorkflowResult" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "GetWorkflowResult failed: wrong method name")
+    return
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "GetWorkflowResult failed: out of sequence response")
+    return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  if mTypeId == thrift.EXCEPTION {
+    error34 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error35 error
+    error35, err = error34.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error35
+    return
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "GetWorkflowResult failed: invalid message type")
+    return
   }
-  if err != nil {
+  result := WorkflowServiceGetWorkflowResultResult{}
+  if err = result.Read(iprot); err != nil {
     return
   }
-  return true, err
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  }
+  value = result.GetSuccess()
+  return
 }
 
-type workflowServiceProcessorGetWorkflowExecutionHistory struct {
-  handler WorkflowService
+
+// CountOpenWorkflowExecutions is a visibility API to count the open executions in a specific domain which match the
+// filters of the request, without listing them.
+// 
+// Parameters:
+//  - ListRequest
+func (p *WorkflowServiceClient) CountOpenWorkflowExecutions(listRequest *shared.CountOpenWorkflowExecutionsRequest) (r *shared.CountOpenWorkflowExecutionsResponse, err error) {
+  if err = p.sendCountOpenWorkflowExecutions(listRequest); err != nil { return }
+  return p.recvCountOpenWorkflowExecutions()
 }
 
-func (p *workflowServiceProcessorGetWorkflowExecutionHistory) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceGetWorkflowExecutionHistoryArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("GetWorkflowExecutionHistory", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
-  }
-
-  iprot.ReadMessageEnd()
-  result := WorkflowServiceGetWorkflowExecutionHistoryResult{}
-var retval *shared.GetWorkflowExecutionHistoryResponse
-  var err2 error
-  if retval, err2 = p.handler.GetWorkflowExecutionHistory(args.GetRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetWorkflowExecutionHistory: " + err2.Error())
-    oprot.WriteMessageBegin("GetWorkflowExecutionHistory", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
-  }
-  } else {
-    result.Success = retval
-}
-  if err2 = oprot.WriteMessageBegin("GetWorkflowExecutionHistory", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+func (p *WorkflowServiceClient) sendCountOpenWorkflowExecutions(listRequest *shared.CountOpenWorkflowExecutionsRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("CountOpenWorkflowExecutions", thrift.CALL, p.SeqId); err != nil {
+      return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  args := WorkflowServiceCountOpenWorkflowExecutionsArgs{
+  ListRequest : listRequest,
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  if err = args.Write(oprot); err != nil {
+      return
   }
-  if err != nil {
-    return
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
   }
-  return true, err
+  return oprot.Flush()
 }
 
-type workflowServiceProcessorPollForDecisionTask struct {
-  handler WorkflowService
-}
 
-func (p *workflowServiceProcessorPollForDecisionTask) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServicePollForDecisionTaskArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("PollForDecisionTask", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
+func (p *WorkflowServiceClient) recvCountOpenWorkflowExecutions() (value *shared.CountOpenWorkflowExecutionsResponse, err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
   }
-
-  iprot.ReadMessageEnd()
-  result := WorkflowServicePollForDecisionTaskResult{}
-var retval *shared.PollForDecisionTaskResponse
-  var err2 error
-  if retval, err2 = p.handler.PollForDecisionTask(args.PollRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PollForDecisionTask: " + err2.Error())
-    oprot.WriteMessageBegin("PollForDecisionTask", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
   }
-  } else {
-    result.Success = retval
-}
-  if err2 = oprot.WriteMessageBegin("PollForDecisionTask", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+  if method != "CountOpenWorkflowExecutions" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "CountOpenWorkflowExecutions failed: wrong method name")
+    return
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "CountOpenWorkflowExecutions failed: out of sequence response")
+    return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  if mTypeId == thrift.EXCEPTION {
+    error32 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error33 error
+    error33, err = error32.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error33
+    return
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "CountOpenWorkflowExecutions failed: invalid message type")
+    return
   }
-  if err != nil {
+  result := WorkflowServiceCountOpenWorkflowExecutionsResult{}
+  if err = result.Read(iprot); err != nil {
     return
   }
-  return true, err
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  }
+  value = result.GetSuccess()
+  return
 }
 
-type workflowServiceProcessorRespondDecisionTaskCompleted struct {
-  handler WorkflowService
+// CountClosedWorkflowExecutions is a visibility API to count the closed executions in a specific domain which match
+// the filters of the request, without listing them.
+// 
+// Parameters:
+//  - ListRequest
+func (p *WorkflowServiceClient) CountClosedWorkflowExecutions(listRequest *shared.CountClosedWorkflowExecutionsRequest) (r *shared.CountClosedWorkflowExecutionsResponse, err error) {
+  if err = p.sendCountClosedWorkflowExecutions(listRequest); err != nil { return }
+  return p.recvCountClosedWorkflowExecutions()
 }
 
-func (p *workflowServiceProcessorRespondDecisionTaskCompleted) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceRespondDecisionTaskCompletedArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("RespondDecisionTaskCompleted", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
+func (p *WorkflowServiceClient) sendCountClosedWorkflowExecutions(listRequest *shared.CountClosedWorkflowExecutionsRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
+  }
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("CountClosedWorkflowExecutions", thrift.CALL, p.SeqId); err != nil {
+      return
+  }
+  args := WorkflowServiceCountClosedWorkflowExecutionsArgs{
+  ListRequest : listRequest,
+  }
+  if err = args.Write(oprot); err != nil {
+      return
+  }
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
   }
+  return oprot.Flush()
+}
 
-  iprot.ReadMessageEnd()
-  result := WorkflowServiceRespondDecisionTaskCompletedResult{}
-  var err2 error
-  if err2 = p.handler.RespondDecisionTaskCompleted(args.CompleteRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondDecisionTaskCompleted: " + err2.Error())
-    oprot.WriteMessageBegin("RespondDecisionTaskCompleted", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
+
+func (p *WorkflowServiceClient) recvCountClosedWorkflowExecutions() (value *shared.CountClosedWorkflowExecutionsResponse, err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
   }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
   }
-  if err2 = oprot.WriteMessageBegin("RespondDecisionTaskCompleted", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+  if method != "CountClosedWorkflowExecutions" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "CountClosedWorkflowExecutions failed: wrong method name")
+    return
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "CountClosedWorkflowExecutions failed: out of sequence response")
+    return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  if mTypeId == thrift.EXCEPTION {
+    error34 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error35 error
+    error35, err = error34.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error35
+    return
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "CountClosedWorkflowExecutions failed: invalid message type")
+    return
   }
-  if err != nil {
+  result := WorkflowServiceCountClosedWorkflowExecutionsResult{}
+  if err = result.Read(iprot); err != nil {
     return
   }
-  return true, err
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  }
+  value = result.GetSuccess()
+  return
 }
 
-type workflowServiceProcessorPollForActivityTask struct {
-  handler WorkflowService
+// GetClusterInfo returns the capabilities of the cluster, so that clients can adapt their behavior to the features
+// supported by the server instead of comparing server versions.
+// 
+// Parameters:
+//  - GetRequest
+func (p *WorkflowServiceClient) GetClusterInfo(getRequest *shared.GetClusterInfoRequest) (r *shared.GetClusterInfoResponse, err error) {
+  if err = p.sendGetClusterInfo(getRequest); err != nil { return }
+  return p.recvGetClusterInfo()
 }
 
-func (p *workflowServiceProcessorPollForActivityTask) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServicePollForActivityTaskArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("PollForActivityTask", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
-  }
-
-  iprot.ReadMessageEnd()
-  result := WorkflowServicePollForActivityTaskResult{}
-var retval *shared.PollForActivityTaskResponse
-  var err2 error
-  if retval, err2 = p.handler.PollForActivityTask(args.PollRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PollForActivityTask: " + err2.Error())
-    oprot.WriteMessageBegin("PollForActivityTask", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
-  }
-  } else {
-    result.Success = retval
-}
-  if err2 = oprot.WriteMessageBegin("PollForActivityTask", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+func (p *WorkflowServiceClient) sendGetClusterInfo(getRequest *shared.GetClusterInfoRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("GetClusterInfo", thrift.CALL, p.SeqId); err != nil {
+      return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  args := WorkflowServiceGetClusterInfoArgs{
+  GetRequest : getRequest,
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  if err = args.Write(oprot); err != nil {
+      return
   }
-  if err != nil {
-    return
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
   }
-  return true, err
+  return oprot.Flush()
 }
 
-type workflowServiceProcessorRecordActivityTaskHeartbeat struct {
-  handler WorkflowService
-}
 
-func (p *workflowServiceProcessorRecordActivityTaskHeartbeat) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceRecordActivityTaskHeartbeatArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("RecordActivityTaskHeartbeat", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
+func (p *WorkflowServiceClient) recvGetClusterInfo() (value *shared.GetClusterInfoResponse, err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
   }
-
-  iprot.ReadMessageEnd()
-  result := WorkflowServiceRecordActivityTaskHeartbeatResult{}
-var retval *shared.RecordActivityTaskHeartbeatResponse
-  var err2 error
-  if retval, err2 = p.handler.RecordActivityTaskHeartbeat(args.HeartbeatRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RecordActivityTaskHeartbeat: " + err2.Error())
-    oprot.WriteMessageBegin("RecordActivityTaskHeartbeat", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
   }
-  } else {
-    result.Success = retval
-}
-  if err2 = oprot.WriteMessageBegin("RecordActivityTaskHeartbeat", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+  if method != "GetClusterInfo" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "GetClusterInfo failed: wrong method name")
+    return
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "GetClusterInfo failed: out of sequence response")
+    return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  if mTypeId == thrift.EXCEPTION {
+    error34 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error35 error
+    error35, err = error34.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error35
+    return
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "GetClusterInfo failed: invalid message type")
+    return
   }
-  if err != nil {
+  result := WorkflowServiceGetClusterInfoResult{}
+  if err = result.Read(iprot); err != nil {
     return
   }
-  return true, err
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  }
+  value = result.GetSuccess()
+  return
 }
 
-type workflowServiceProcessorRespondActivityTaskCompleted struct {
-  handler WorkflowService
+// ListWorkflowExecutionsWithQuery is a visibility API to list the open and closed executions in a specific domain
+// which match a SQL-like query, e.g. "WorkflowType = 'X' AND CloseTime > '2018-01-01T00:00:00Z'".  It is only
+// supported by the Elasticsearch visibility store.
+// 
+// Parameters:
+//  - ListRequest
+func (p *WorkflowServiceClient) ListWorkflowExecutionsWithQuery(listRequest *shared.ListWorkflowExecutionsWithQueryRequest) (r *shared.ListWorkflowExecutionsWithQueryResponse, err error) {
+  if err = p.sendListWorkflowExecutionsWithQuery(listRequest); err != nil { return }
+  return p.recvListWorkflowExecutionsWithQuery()
 }
 
-func (p *workflowServiceProcessorRespondActivityTaskCompleted) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceRespondActivityTaskCompletedArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("RespondActivityTaskCompleted", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
-  }
-
-  iprot.ReadMessageEnd()
-  result := WorkflowServiceRespondActivityTaskCompletedResult{}
-  var err2 error
-  if err2 = p.handler.RespondActivityTaskCompleted(args.CompleteRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondActivityTaskCompleted: " + err2.Error())
-    oprot.WriteMessageBegin("RespondActivityTaskCompleted", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
-  }
-  }
-  if err2 = oprot.WriteMessageBegin("RespondActivityTaskCompleted", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+func (p *WorkflowServiceClient) sendListWorkflowExecutionsWithQuery(listRequest *shared.ListWorkflowExecutionsWithQueryRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("ListWorkflowExecutionsWithQuery", thrift.CALL, p.SeqId); err != nil {
+      return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  args := WorkflowServiceListWorkflowExecutionsWithQueryArgs{
+  ListRequest : listRequest,
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  if err = args.Write(oprot); err != nil {
+      return
   }
-  if err != nil {
-    return
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
   }
-  return true, err
-}
-
-type workflowServiceProcessorRespondActivityTaskFailed struct {
-  handler WorkflowService
+  return oprot.Flush()
 }
 
-func (p *workflowServiceProcessorRespondActivityTaskFailed) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceRespondActivityTaskFailedArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("RespondActivityTaskFailed", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
-  }
 
-  iprot.ReadMessageEnd()
-  result := WorkflowServiceRespondActivityTaskFailedResult{}
-  var err2 error
-  if err2 = p.handler.RespondActivityTaskFailed(args.FailRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondActivityTaskFailed: " + err2.Error())
-    oprot.WriteMessageBegin("RespondActivityTaskFailed", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
-  }
+func (p *WorkflowServiceClient) recvListWorkflowExecutionsWithQuery() (value *shared.ListWorkflowExecutionsWithQueryResponse, err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
   }
-  if err2 = oprot.WriteMessageBegin("RespondActivityTaskFailed", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  if method != "ListWorkflowExecutionsWithQuery" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "ListWorkflowExecutionsWithQuery failed: wrong method name")
+    return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ListWorkflowExecutionsWithQuery failed: out of sequence response")
+    return
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  if mTypeId == thrift.EXCEPTION {
+    error34 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error35 error
+    error35, err = error34.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error35
+    return
   }
-  if err != nil {
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "ListWorkflowExecutionsWithQuery failed: invalid message type")
     return
   }
-  return true, err
+  result := WorkflowServiceListWorkflowExecutionsWithQueryResult{}
+  if err = result.Read(iprot); err != nil {
+    return
+  }
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  }
+  value = result.GetSuccess()
+  return
 }
 
-type workflowServiceProcessorRespondActivityTaskCanceled struct {
-  handler WorkflowService
+// SignalWithStartWorkflowExecution is used to ensure sending a signal to a workflow.  If the workflow is running, this
+// results in WorkflowExecutionSignaled event recorded in the history and a decision task being created for the
+// execution.  If the workflow is not running or not found, this results in WorkflowExecutionStarted and
+// WorkflowExecutionSignaled events recorded in the history of a new run, and a decision task being created for it.
+// 
+// Parameters:
+//  - SignalWithStartRequest
+func (p *WorkflowServiceClient) SignalWithStartWorkflowExecution(signalWithStartRequest *shared.SignalWithStartWorkflowExecutionRequest) (r *shared.StartWorkflowExecutionResponse, err error) {
+  if err = p.sendSignalWithStartWorkflowExecution(signalWithStartRequest); err != nil { return }
+  return p.recvSignalWithStartWorkflowExecution()
 }
 
-func (p *workflowServiceProcessorRespondActivityTaskCanceled) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceRespondActivityTaskCanceledArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("RespondActivityTaskCanceled", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
-  }
-
-  iprot.ReadMessageEnd()
-  result := WorkflowServiceRespondActivityTaskCanceledResult{}
-  var err2 error
-  if err2 = p.handler.RespondActivityTaskCanceled(args.CanceledRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondActivityTaskCanceled: " + err2.Error())
-    oprot.WriteMessageBegin("RespondActivityTaskCanceled", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
-  }
-  }
-  if err2 = oprot.WriteMessageBegin("RespondActivityTaskCanceled", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+func (p *WorkflowServiceClient) sendSignalWithStartWorkflowExecution(signalWithStartRequest *shared.SignalWithStartWorkflowExecutionRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("SignalWithStartWorkflowExecution", thrift.CALL, p.SeqId); err != nil {
+      return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  args := WorkflowServiceSignalWithStartWorkflowExecutionArgs{
+  SignalWithStartRequest : signalWithStartRequest,
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  if err = args.Write(oprot); err != nil {
+      return
   }
-  if err != nil {
-    return
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
   }
-  return true, err
+  return oprot.Flush()
 }
 
-type workflowServiceProcessorRequestCancelWorkflowExecution struct {
-  handler WorkflowService
-}
 
-func (p *workflowServiceProcessorRequestCancelWorkflowExecution) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceRequestCancelWorkflowExecutionArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("RequestCancelWorkflowExecution", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
+func (p *WorkflowServiceClient) recvSignalWithStartWorkflowExecution() (value *shared.StartWorkflowExecutionResponse, err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
   }
-
-  iprot.ReadMessageEnd()
-  result := WorkflowServiceRequestCancelWorkflowExecutionResult{}
-  var err2 error
-  if err2 = p.handler.RequestCancelWorkflowExecution(args.CancelRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RequestCancelWorkflowExecution: " + err2.Error())
-    oprot.WriteMessageBegin("RequestCancelWorkflowExecution", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
   }
+  if method != "SignalWithStartWorkflowExecution" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "SignalWithStartWorkflowExecution failed: wrong method name")
+    return
   }
-  if err2 = oprot.WriteMessageBegin("RequestCancelWorkflowExecution", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "SignalWithStartWorkflowExecution failed: out of sequence response")
+    return
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  if mTypeId == thrift.EXCEPTION {
+    error8 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error9 error
+    error9, err = error8.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error9
+    return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "SignalWithStartWorkflowExecution failed: invalid message type")
+    return
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  result := WorkflowServiceSignalWithStartWorkflowExecutionResult{}
+  if err = result.Read(iprot); err != nil {
+    return
   }
-  if err != nil {
+  if err = iprot.ReadMessageEnd(); err != nil {
     return
   }
-  return true, err
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.SessionAlreadyExistError != nil {
+    err = result.SessionAlreadyExistError
+    return 
+  }
+  value = result.GetSuccess()
+  return
 }
 
-type workflowServiceProcessorSignalWorkflowExecution struct {
-  handler WorkflowService
+// ResetWorkflowExecution resets a workflow execution to the end of a decision task of one of its runs.  A new run is
+// created with the history of the run up to the started event of the decision task, which is then recorded as failed
+// and followed by a new decision task.  The signals received by the run after that point are recorded again in the new
+// run.  The run is terminated if it is still running.
+// 
+// Parameters:
+//  - ResetRequest
+func (p *WorkflowServiceClient) ResetWorkflowExecution(resetRequest *shared.ResetWorkflowExecutionRequest) (r *shared.ResetWorkflowExecutionResponse, err error) {
+  if err = p.sendResetWorkflowExecution(resetRequest); err != nil { return }
+  return p.recvResetWorkflowExecution()
 }
 
-func (p *workflowServiceProcessorSignalWorkflowExecution) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceSignalWorkflowExecutionArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("SignalWorkflowExecution", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
-  }
-
-  iprot.ReadMessageEnd()
-  result := WorkflowServiceSignalWorkflowExecutionResult{}
-  var err2 error
-  if err2 = p.handler.SignalWorkflowExecution(args.SignalRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SignalWorkflowExecution: " + err2.Error())
-    oprot.WriteMessageBegin("SignalWorkflowExecution", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
-  }
-  }
-  if err2 = oprot.WriteMessageBegin("SignalWorkflowExecution", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+func (p *WorkflowServiceClient) sendResetWorkflowExecution(resetRequest *shared.ResetWorkflowExecutionRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("ResetWorkflowExecution", thrift.CALL, p.SeqId); err != nil {
+      return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  args := WorkflowServiceResetWorkflowExecutionArgs{
+  ResetRequest : resetRequest,
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  if err = args.Write(oprot); err != nil {
+      return
   }
-  if err != nil {
-    return
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
   }
-  return true, err
+  return oprot.Flush()
 }
 
-type workflowServiceProcessorTerminateWorkflowExecution struct {
-  handler WorkflowService
-}
 
-func (p *workflowServiceProcessorTerminateWorkflowExecution) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceTerminateWorkflowExecutionArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("TerminateWorkflowExecution", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
+func (p *WorkflowServiceClient) recvResetWorkflowExecution() (value *shared.ResetWorkflowExecutionResponse, err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
   }
-
-  iprot.ReadMessageEnd()
-  result := WorkflowServiceTerminateWorkflowExecutionResult{}
-  var err2 error
-  if err2 = p.handler.TerminateWorkflowExecution(args.TerminateRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TerminateWorkflowExecution: " + err2.Error())
-    oprot.WriteMessageBegin("TerminateWorkflowExecution", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
   }
+  if method != "ResetWorkflowExecution" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "ResetWorkflowExecution failed: wrong method name")
+    return
   }
-  if err2 = oprot.WriteMessageBegin("TerminateWorkflowExecution", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ResetWorkflowExecution failed: out of sequence response")
+    return
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  if mTypeId == thrift.EXCEPTION {
+    error18 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error19 error
+    error19, err = error18.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error19
+    return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "ResetWorkflowExecution failed: invalid message type")
+    return
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  result := WorkflowServiceResetWorkflowExecutionResult{}
+  if err = result.Read(iprot); err != nil {
+    return
   }
-  if err != nil {
+  if err = iprot.ReadMessageEnd(); err != nil {
     return
   }
-  return true, err
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  }
+  value = result.GetSuccess()
+  return
 }
 
-type workflowServiceProcessorListOpenWorkflowExecutions struct {
-  handler WorkflowService
+// QueryWorkflow returns the result of a query of a workflow execution.  The query is dispatched to a worker polling
+// the decision task list of the execution, which replays its history and answers with 'RespondQueryTaskCompleted'.
+// It fails with 'QueryFailedError' if the worker fails to answer the query.
+// 
+// Parameters:
+//  - QueryRequest
+func (p *WorkflowServiceClient) QueryWorkflow(queryRequest *shared.QueryWorkflowRequest) (r *shared.QueryWorkflowResponse, err error) {
+  if err = p.sendQueryWorkflow(queryRequest); err != nil { return }
+  return p.recvQueryWorkflow()
 }
 
-func (p *workflowServiceProcessorListOpenWorkflowExecutions) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceListOpenWorkflowExecutionsArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("ListOpenWorkflowExecutions", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
+func (p *WorkflowServiceClient) sendQueryWorkflow(queryRequest *shared.QueryWorkflowRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
   }
-
-  iprot.ReadMessageEnd()
-  result := WorkflowServiceListOpenWorkflowExecutionsResult{}
-var retval *shared.ListOpenWorkflowExecutionsResponse
-  var err2 error
-  if retval, err2 = p.handler.ListOpenWorkflowExecutions(args.ListRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListOpenWorkflowExecutions: " + err2.Error())
-    oprot.WriteMessageBegin("ListOpenWorkflowExecutions", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("QueryWorkflow", thrift.CALL, p.SeqId); err != nil {
+      return
   }
-  } else {
-    result.Success = retval
-}
-  if err2 = oprot.WriteMessageBegin("ListOpenWorkflowExecutions", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+  args := WorkflowServiceQueryWorkflowArgs{
+  QueryRequest : queryRequest,
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  if err = args.Write(oprot); err != nil {
+      return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  return oprot.Flush()
+}
+
+
+func (p *WorkflowServiceClient) recvQueryWorkflow() (value *shared.QueryWorkflowResponse, err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
   }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
   if err != nil {
     return
   }
-  return true, err
+  if method != "QueryWorkflow" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "QueryWorkflow failed: wrong method name")
+    return
+  }
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "QueryWorkflow failed: out of sequence response")
+    return
+  }
+  if mTypeId == thrift.EXCEPTION {
+    error10 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error11 error
+    error11, err = error10.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error11
+    return
+  }
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "QueryWorkflow failed: invalid message type")
+    return
+  }
+  result := WorkflowServiceQueryWorkflowResult{}
+  if err = result.Read(iprot); err != nil {
+    return
+  }
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  } else   if result.QueryFailedError != nil {
+    err = result.QueryFailedError
+    return 
+  }
+  value = result.GetSuccess()
+  return
 }
 
-type workflowServiceProcessorListClosedWorkflowExecutions struct {
-  handler WorkflowService
+// RespondQueryTaskCompleted is called by application worker to complete a query task handed as a result of
+// 'PollForDecisionTask' API call, a decision task which carries a query.  It delivers the result of the query, or
+// the reason it failed, to the caller of 'QueryWorkflow'.
+// 
+// Parameters:
+//  - CompleteRequest
+func (p *WorkflowServiceClient) RespondQueryTaskCompleted(completeRequest *shared.RespondQueryTaskCompletedRequest) (err error) {
+  if err = p.sendRespondQueryTaskCompleted(completeRequest); err != nil { return }
+  return p.recvRespondQueryTaskCompleted()
 }
 
-func (p *workflowServiceProcessorListClosedWorkflowExecutions) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceListClosedWorkflowExecutionsArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("ListClosedWorkflowExecutions", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
+func (p *WorkflowServiceClient) sendRespondQueryTaskCompleted(completeRequest *shared.RespondQueryTaskCompletedRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
   }
-
-  iprot.ReadMessageEnd()
-  result := WorkflowServiceListClosedWorkflowExecutionsResult{}
-var retval *shared.ListClosedWorkflowExecutionsResponse
-  var err2 error
-  if retval, err2 = p.handler.ListClosedWorkflowExecutions(args.ListRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListClosedWorkflowExecutions: " + err2.Error())
-    oprot.WriteMessageBegin("ListClosedWorkflowExecutions", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("RespondQueryTaskCompleted", thrift.CALL, p.SeqId); err != nil {
+      return
   }
-  } else {
-    result.Success = retval
-}
-  if err2 = oprot.WriteMessageBegin("ListClosedWorkflowExecutions", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+  args := WorkflowServiceRespondQueryTaskCompletedArgs{
+  CompleteRequest : completeRequest,
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  if err = args.Write(oprot); err != nil {
+      return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  return oprot.Flush()
+}
+
+
+func (p *WorkflowServiceClient) recvRespondQueryTaskCompleted() (err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
   }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
   if err != nil {
     return
   }
-  return true, err
+  if method != "RespondQueryTaskCompleted" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RespondQueryTaskCompleted failed: wrong method name")
+    return
+  }
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RespondQueryTaskCompleted failed: out of sequence response")
+    return
+  }
+  if mTypeId == thrift.EXCEPTION {
+    error14 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error15 error
+    error15, err = error14.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error15
+    return
+  }
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RespondQueryTaskCompleted failed: invalid message type")
+    return
+  }
+  result := WorkflowServiceRespondQueryTaskCompletedResult{}
+  if err = result.Read(iprot); err != nil {
+    return
+  }
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  }
+  return
+}
+
+// StartBatchOperation starts signaling, canceling or terminating all the open executions of a domain which match a
+// visibility query, at a limited rate.  It returns the ID of the batch to follow its progress with
+// DescribeBatchOperation.  It is only supported by the Elasticsearch visibility store.
+// 
+// Parameters:
+//  - StartRequest
+func (p *WorkflowServiceClient) StartBatchOperation(startRequest *shared.StartBatchOperationRequest) (r *shared.StartBatchOperationResponse, err error) {
+  if err = p.sendStartBatchOperation(startRequest); err != nil { return }
+  return p.recvStartBatchOperation()
+}
+
+func (p *WorkflowServiceClient) sendStartBatchOperation(startRequest *shared.StartBatchOperationRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
+  }
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("StartBatchOperation", thrift.CALL, p.SeqId); err != nil {
+      return
+  }
+  args := WorkflowServiceStartBatchOperationArgs{
+  StartRequest : startRequest,
+  }
+  if err = args.Write(oprot); err != nil {
+      return
+  }
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
+  }
+  return oprot.Flush()
+}
+
+
+func (p *WorkflowServiceClient) recvStartBatchOperation() (value *shared.StartBatchOperationResponse, err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
+  }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
+  }
+  if method != "StartBatchOperation" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "StartBatchOperation failed: wrong method name")
+    return
+  }
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "StartBatchOperation failed: out of sequence response")
+    return
+  }
+  if mTypeId == thrift.EXCEPTION {
+    error32 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error33 error
+    error33, err = error32.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error33
+    return
+  }
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "StartBatchOperation failed: invalid message type")
+    return
+  }
+  result := WorkflowServiceStartBatchOperationResult{}
+  if err = result.Read(iprot); err != nil {
+    return
+  }
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  }
+  value = result.GetSuccess()
+  return
+}
+
+// DescribeBatchOperation returns the state and the progress of a batch operation.
+// The progress of a batch running on another frontend host is the progress that host last recorded.
+// 
+// Parameters:
+//  - DescribeRequest
+func (p *WorkflowServiceClient) DescribeBatchOperation(describeRequest *shared.DescribeBatchOperationRequest) (r *shared.DescribeBatchOperationResponse, err error) {
+  if err = p.sendDescribeBatchOperation(describeRequest); err != nil { return }
+  return p.recvDescribeBatchOperation()
+}
+
+func (p *WorkflowServiceClient) sendDescribeBatchOperation(describeRequest *shared.DescribeBatchOperationRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
+  }
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("DescribeBatchOperation", thrift.CALL, p.SeqId); err != nil {
+      return
+  }
+  args := WorkflowServiceDescribeBatchOperationArgs{
+  DescribeRequest : describeRequest,
+  }
+  if err = args.Write(oprot); err != nil {
+      return
+  }
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
+  }
+  return oprot.Flush()
+}
+
+
+func (p *WorkflowServiceClient) recvDescribeBatchOperation() (value *shared.DescribeBatchOperationResponse, err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
+  }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
+  }
+  if method != "DescribeBatchOperation" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "DescribeBatchOperation failed: wrong method name")
+    return
+  }
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "DescribeBatchOperation failed: out of sequence response")
+    return
+  }
+  if mTypeId == thrift.EXCEPTION {
+    error34 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error35 error
+    error35, err = error34.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error35
+    return
+  }
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "DescribeBatchOperation failed: invalid message type")
+    return
+  }
+  result := WorkflowServiceDescribeBatchOperationResult{}
+  if err = result.Read(iprot); err != nil {
+    return
+  }
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  }
+  value = result.GetSuccess()
+  return
+}
+
+// StopBatchOperation stops a running batch operation, the executions already processed are left as they are.
+// 
+// Parameters:
+//  - StopRequest
+func (p *WorkflowServiceClient) StopBatchOperation(stopRequest *shared.StopBatchOperationRequest) (err error) {
+  if err = p.sendStopBatchOperation(stopRequest); err != nil { return }
+  return p.recvStopBatchOperation()
+}
+
+func (p *WorkflowServiceClient) sendStopBatchOperation(stopRequest *shared.StopBatchOperationRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
+  }
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("StopBatchOperation", thrift.CALL, p.SeqId); err != nil {
+      return
+  }
+  args := WorkflowServiceStopBatchOperationArgs{
+  StopRequest : stopRequest,
+  }
+  if err = args.Write(oprot); err != nil {
+      return
+  }
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
+  }
+  return oprot.Flush()
+}
+
+
+func (p *WorkflowServiceClient) recvStopBatchOperation() (err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
+  }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
+  }
+  if method != "StopBatchOperation" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "StopBatchOperation failed: wrong method name")
+    return
+  }
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "StopBatchOperation failed: out of sequence response")
+    return
+  }
+  if mTypeId == thrift.EXCEPTION {
+    error30 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error31 error
+    error31, err = error30.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error31
+    return
+  }
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "StopBatchOperation failed: invalid message type")
+    return
+  }
+  result := WorkflowServiceStopBatchOperationResult{}
+  if err = result.Read(iprot); err != nil {
+    return
+  }
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  }
+  return
+}
+
+type WorkflowServiceProcessor struct {
+  processorMap map[string]thrift.TProcessorFunction
+  handler WorkflowService
+}
+
+func (p *WorkflowServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+  p.processorMap[key] = processor
+}
+
+func (p *WorkflowServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+  processor, ok = p.processorMap[key]
+  return processor, ok
+}
+
+func (p *WorkflowServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+  return p.processorMap
+}
+
+func NewWorkflowServiceProcessor(handler WorkflowService) *WorkflowServiceProcessor {
+
+  self36 := &WorkflowServiceProcessor{handler:handler, processorMap:make(map[string]thrift.TProcessorFunction)}
+  self36.processorMap["RegisterDomain"] = &workflowServiceProcessorRegisterDomain{handler:handler}
+  self36.processorMap["DescribeDomain"] = &workflowServiceProcessorDescribeDomain{handler:handler}
+  self36.processorMap["UpdateDomain"] = &workflowServiceProcessorUpdateDomain{handler:handler}
+  self36.processorMap["DeprecateDomain"] = &workflowServiceProcessorDeprecateDomain{handler:handler}
+  self36.processorMap["StartWorkflowExecution"] = &workflowServiceProcessorStartWorkflowExecution{handler:handler}
+  self36.processorMap["GetWorkflowExecutionHistory"] = &workflowServiceProcessorGetWorkflowExecutionHistory{handler:handler}
+  self36.processorMap["PollForDecisionTask"] = &workflowServiceProcessorPollForDecisionTask{handler:handler}
+  self36.processorMap["RespondDecisionTaskCompleted"] = &workflowServiceProcessorRespondDecisionTaskCompleted{handler:handler}
+  self36.processorMap["PollForActivityTask"] = &workflowServiceProcessorPollForActivityTask{handler:handler}
+  self36.processorMap["RecordActivityTaskHeartbeat"] = &workflowServiceProcessorRecordActivityTaskHeartbeat{handler:handler}
+  self36.processorMap["RespondActivityTaskCompleted"] = &workflowServiceProcessorRespondActivityTaskCompleted{handler:handler}
+  self36.processorMap["RespondActivityTaskFailed"] = &workflowServiceProcessorRespondActivityTaskFailed{handler:handler}
+  self36.processorMap["RespondActivityTaskCanceled"] = &workflowServiceProcessorRespondActivityTaskCanceled{handler:handler}
+  self36.processorMap["RequestCancelWorkflowExecution"] = &workflowServiceProcessorRequestCancelWorkflowExecution{handler:handler}
+  self36.processorMap["SignalWorkflowExecution"] = &workflowServiceProcessorSignalWorkflowExecution{handler:handler}
+  self36.processorMap["TerminateWorkflowExecution"] = &workflowServiceProcessorTerminateWorkflowExecution{handler:handler}
+  self36.processorMap["ListOpenWorkflowExecutions"] = &workflowServiceProcessorListOpenWorkflowExecutions{handler:handler}
+  self36.processorMap["ListClosedWorkflowExecutions"] = &workflowServiceProcessorListClosedWorkflowExecutions{handler:handler}
+  self36.processorMap["ScanWorkflowExecutions"] = &workflowServiceProcessorScanWorkflowExecutions{handler:handler}
+  self36.processorMap["GetDomainReplicationMessages"] = &workflowServiceProcessorGetDomainReplicationMessages{handler:handler}
+  self36.processorMap["GetWorkflowResult"] = &workflowServiceProcessorGetWorkflowResult{handler:handler}
+  self36.processorMap["CountOpenWorkflowExecutions"] = &workflowServiceProcessorCountOpenWorkflowExecutions{handler:handler}
+  self36.processorMap["CountClosedWorkflowExecutions"] = &workflowServiceProcessorCountClosedWorkflowExecutions{handler:handler}
+  self36.processorMap["GetClusterInfo"] = &workflowServiceProcessorGetClusterInfo{handler:handler}
+  self36.processorMap["ListWorkflowExecutionsWithQuery"] = &workflowServiceProcessorListWorkflowExecutionsWithQuery{handler:handler}
+  self36.processorMap["SignalWithStartWorkflowExecution"] = &workflowServiceProcessorSignalWithStartWorkflowExecution{handler:handler}
+  self36.processorMap["ResetWorkflowExecution"] = &workflowServiceProcessorResetWorkflowExecution{handler:handler}
+  self36.processorMap["QueryWorkflow"] = &workflowServiceProcessorQueryWorkflow{handler:handler}
+  self36.processorMap["RespondQueryTaskCompleted"] = &workflowServiceProcessorRespondQueryTaskCompleted{handler:handler}
+  self36.processorMap["StartBatchOperation"] = &workflowServiceProcessorStartBatchOperation{handler:handler}
+  self36.processorMap["DescribeBatchOperation"] = &workflowServiceProcessorDescribeBatchOperation{handler:handler}
+  self36.processorMap["StopBatchOperation"] = &workflowServiceProcessorStopBatchOperation{handler:handler}
+return self36
+}
+
+func (p *WorkflowServiceProcessor) Process(iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  name, _, seqId, err := iprot.ReadMessageBegin()
+  if err != nil { return false, err }
+  if processor, ok := p.GetProcessorFunction(name); ok {
+    return processor.Process(seqId, iprot, oprot)
+  }
+  iprot.Skip(thrift.STRUCT)
+  iprot.ReadMessageEnd()
+  x37 := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function " + name)
+  oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+  x37.Write(oprot)
+  oprot.WriteMessageEnd()
+  oprot.Flush()
+  return false, x37
+
+}
+
+type workflowServiceProcessorRegisterDomain struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorRegisterDomain) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceRegisterDomainArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("RegisterDomain", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceRegisterDomainResult{}
+  var err2 error
+  if err2 = p.handler.RegisterDomain(args.RegisterRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.DomainAlreadyExistsError:
+  result.DomainExistsError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RegisterDomain: " + err2.Error())
+    oprot.WriteMessageBegin("RegisterDomain", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("RegisterDomain", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorDescribeDomain struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorDescribeDomain) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceDescribeDomainArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("DescribeDomain", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceDescribeDomainResult{}
+var retval *shared.DescribeDomainResponse
+  var err2 error
+  if retval, err2 = p.handler.DescribeDomain(args.DescribeRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DescribeDomain: " + err2.Error())
+    oprot.WriteMessageBegin("DescribeDomain", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("DescribeDomain", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorUpdateDomain struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorUpdateDomain) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceUpdateDomainArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("UpdateDomain", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceUpdateDomainResult{}
+var retval *shared.UpdateDomainResponse
+  var err2 error
+  if retval, err2 = p.handler.UpdateDomain(args.UpdateRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateDomain: " + err2.Error())
+    oprot.WriteMessageBegin("UpdateDomain", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("UpdateDomain", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorDeprecateDomain struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorDeprecateDomain) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceDeprecateDomainArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("DeprecateDomain", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceDeprecateDomainResult{}
+  var err2 error
+  if err2 = p.handler.DeprecateDomain(args.DeprecateRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeprecateDomain: " + err2.Error())
+    oprot.WriteMessageBegin("DeprecateDomain", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("DeprecateDomain", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorStartWorkflowExecution struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorStartWorkflowExecution) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceStartWorkflowExecutionArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("StartWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceStartWorkflowExecutionResult{}
+var retval *shared.StartWorkflowExecutionResponse
+  var err2 error
+  if retval, err2 = p.handler.StartWorkflowExecution(args.StartRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.WorkflowExecutionAlreadyStartedError:
+  result.SessionAlreadyExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing StartWorkflowExecution: " + err2.Error())
+    oprot.WriteMessageBegin("StartWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("StartWorkflowExecution", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorGetWorkflowExecutionHistory struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorGetWorkflowExecutionHistory) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceGetWorkflowExecutionHistoryArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("GetWorkflowExecutionHistory", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceGetWorkflowExecutionHistoryResult{}
+var retval *shared.GetWorkflowExecutionHistoryResponse
+  var err2 error
+  if retval, err2 = p.handler.GetWorkflowExecutionHistory(args.GetRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetWorkflowExecutionHistory: " + err2.Error())
+    oprot.WriteMessageBegin("GetWorkflowExecutionHistory", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("GetWorkflowExecutionHistory", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorPollForDecisionTask struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorPollForDecisionTask) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServicePollForDecisionTaskArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("PollForDecisionTask", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServicePollForDecisionTaskResult{}
+var retval *shared.PollForDecisionTaskResponse
+  var err2 error
+  if retval, err2 = p.handler.PollForDecisionTask(args.PollRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PollForDecisionTask: " + err2.Error())
+    oprot.WriteMessageBegin("PollForDecisionTask", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("PollForDecisionTask", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorRespondDecisionTaskCompleted struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorRespondDecisionTaskCompleted) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceRespondDecisionTaskCompletedArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("RespondDecisionTaskCompleted", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceRespondDecisionTaskCompletedResult{}
+var retval *shared.RespondDecisionTaskCompletedResponse
+  var err2 error
+  if retval, err2 = p.handler.RespondDecisionTaskCompleted(args.CompleteRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondDecisionTaskCompleted: " + err2.Error())
+    oprot.WriteMessageBegin("RespondDecisionTaskCompleted", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("RespondDecisionTaskCompleted", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorPollForActivityTask struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorPollForActivityTask) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServicePollForActivityTaskArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("PollForActivityTask", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServicePollForActivityTaskResult{}
+var retval *shared.PollForActivityTaskResponse
+  var err2 error
+  if retval, err2 = p.handler.PollForActivityTask(args.PollRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PollForActivityTask: " + err2.Error())
+    oprot.WriteMessageBegin("PollForActivityTask", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("PollForActivityTask", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorRecordActivityTaskHeartbeat struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorRecordActivityTaskHeartbeat) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceRecordActivityTaskHeartbeatArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("RecordActivityTaskHeartbeat", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceRecordActivityTaskHeartbeatResult{}
+var retval *shared.RecordActivityTaskHeartbeatResponse
+  var err2 error
+  if retval, err2 = p.handler.RecordActivityTaskHeartbeat(args.HeartbeatRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RecordActivityTaskHeartbeat: " + err2.Error())
+    oprot.WriteMessageBegin("RecordActivityTaskHeartbeat", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("RecordActivityTaskHeartbeat", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorRespondActivityTaskCompleted struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorRespondActivityTaskCompleted) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceRespondActivityTaskCompletedArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("RespondActivityTaskCompleted", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceRespondActivityTaskCompletedResult{}
+  var err2 error
+  if err2 = p.handler.RespondActivityTaskCompleted(args.CompleteRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondActivityTaskCompleted: " + err2.Error())
+    oprot.WriteMessageBegin("RespondActivityTaskCompleted", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("RespondActivityTaskCompleted", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorRespondActivityTaskFailed struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorRespondActivityTaskFailed) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceRespondActivityTaskFailedArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("RespondActivityTaskFailed", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceRespondActivityTaskFailedResult{}
+  var err2 error
+  if err2 = p.handler.RespondActivityTaskFailed(args.FailRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondActivityTaskFailed: " + err2.Error())
+    oprot.WriteMessageBegin("RespondActivityTaskFailed", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("RespondActivityTaskFailed", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorRespondActivityTaskCanceled struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorRespondActivityTaskCanceled) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceRespondActivityTaskCanceledArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("RespondActivityTaskCanceled", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceRespondActivityTaskCanceledResult{}
+  var err2 error
+  if err2 = p.handler.RespondActivityTaskCanceled(args.CanceledRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondActivityTaskCanceled: " + err2.Error())
+    oprot.WriteMessageBegin("RespondActivityTaskCanceled", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("RespondActivityTaskCanceled", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorRequestCancelWorkflowExecution struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorRequestCancelWorkflowExecution) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceRequestCancelWorkflowExecutionArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("RequestCancelWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceRequestCancelWorkflowExecutionResult{}
+  var err2 error
+  if err2 = p.handler.RequestCancelWorkflowExecution(args.CancelRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RequestCancelWorkflowExecution: " + err2.Error())
+    oprot.WriteMessageBegin("RequestCancelWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("RequestCancelWorkflowExecution", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorSignalWorkflowExecution struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorSignalWorkflowExecution) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceSignalWorkflowExecutionArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("SignalWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceSignalWorkflowExecutionResult{}
+  var err2 error
+  if err2 = p.handler.SignalWorkflowExecution(args.SignalRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SignalWorkflowExecution: " + err2.Error())
+    oprot.WriteMessageBegin("SignalWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("SignalWorkflowExecution", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorTerminateWorkflowExecution struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorTerminateWorkflowExecution) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceTerminateWorkflowExecutionArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("TerminateWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceTerminateWorkflowExecutionResult{}
+  var err2 error
+  if err2 = p.handler.TerminateWorkflowExecution(args.TerminateRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TerminateWorkflowExecution: " + err2.Error())
+    oprot.WriteMessageBegin("TerminateWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("TerminateWorkflowExecution", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorListOpenWorkflowExecutions struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorListOpenWorkflowExecutions) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceListOpenWorkflowExecutionsArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("ListOpenWorkflowExecutions", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceListOpenWorkflowExecutionsResult{}
+var retval *shared.ListOpenWorkflowExecutionsResponse
+  var err2 error
+  if retval, err2 = p.handler.ListOpenWorkflowExecutions(args.ListRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListOpenWorkflowExecutions: " + err2.Error())
+    oprot.WriteMessageBegin("ListOpenWorkflowExecutions", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("ListOpenWorkflowExecutions", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorListClosedWorkflowExecutions struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorListClosedWorkflowExecutions) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceListClosedWorkflowExecutionsArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("ListClosedWorkflowExecutions", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceListClosedWorkflowExecutionsResult{}
+var retval *shared.ListClosedWorkflowExecutionsResponse
+  var err2 error
+  if retval, err2 = p.handler.ListClosedWorkflowExecutions(args.ListRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListClosedWorkflowExecutions: " + err2.Error())
+    oprot.WriteMessageBegin("ListClosedWorkflowExecutions", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("ListClosedWorkflowExecutions", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorScanWorkflowExecutions struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorScanWorkflowExecutions) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceScanWorkflowExecutionsArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("ScanWorkflowExecutions", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceScanWorkflowExecutionsResult{}
+var retval *shared.ScanWorkflowExecutionsResponse
+  var err2 error
+  if retval, err2 = p.handler.ScanWorkflowExecutions(args.ListRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ScanWorkflowExecutions: " + err2.Error())
+    oprot.WriteMessageBegin("ScanWorkflowExecutions", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("ScanWorkflowExecutions", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorGetDomainReplicationMessages struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorGetDomainReplicationMessages) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceGetDomainReplicationMessagesArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("GetDomainReplicationMessages", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceGetDomainReplicationMessagesResult{}
+var retval *shared.GetDomainReplicationMessagesResponse
+  var err2 error
+  if retval, err2 = p.handler.GetDomainReplicationMessages(args.GetRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetDomainReplicationMessages: " + err2.Error())
+    oprot.WriteMessageBegin("GetDomainReplicationMessages", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("GetDomainReplicationMessages", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorGetWorkflowResult struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorGetWorkflowResult) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceGetWorkflowResultArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("GetWorkflowResult", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceGetWorkflowResultResult{}
+var retval *shared.GetWorkflowResultResponse
+  var err2 error
+  if retval, err2 = p.handler.GetWorkflowResult(args.GetRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetWorkflowResult: " + err2.Error())
+    oprot.WriteMessageBegin("GetWorkflowResult", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("GetWorkflowResult", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorCountOpenWorkflowExecutions struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorCountOpenWorkflowExecutions) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceCountOpenWorkflowExecutionsArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("CountOpenWorkflowExecutions", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceCountOpenWorkflowExecutionsResult{}
+var retval *shared.CountOpenWorkflowExecutionsResponse
+  var err2 error
+  if retval, err2 = p.handler.CountOpenWorkflowExecutions(args.ListRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CountOpenWorkflowExecutions: " + err2.Error())
+    oprot.WriteMessageBegin("CountOpenWorkflowExecutions", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("CountOpenWorkflowExecutions", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorCountClosedWorkflowExecutions struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorCountClosedWorkflowExecutions) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceCountClosedWorkflowExecutionsArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("CountClosedWorkflowExecutions", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceCountClosedWorkflowExecutionsResult{}
+var retval *shared.CountClosedWorkflowExecutionsResponse
+  var err2 error
+  if retval, err2 = p.handler.CountClosedWorkflowExecutions(args.ListRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CountClosedWorkflowExecutions: " + err2.Error())
+    oprot.WriteMessageBegin("CountClosedWorkflowExecutions", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("CountClosedWorkflowExecutions", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorGetClusterInfo struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorGetClusterInfo) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceGetClusterInfoArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("GetClusterInfo", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceGetClusterInfoResult{}
+var retval *shared.GetClusterInfoResponse
+  var err2 error
+  if retval, err2 = p.handler.GetClusterInfo(args.GetRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetClusterInfo: " + err2.Error())
+    oprot.WriteMessageBegin("GetClusterInfo", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("GetClusterInfo", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorListWorkflowExecutionsWithQuery struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorListWorkflowExecutionsWithQuery) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceListWorkflowExecutionsWithQueryArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("ListWorkflowExecutionsWithQuery", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceListWorkflowExecutionsWithQueryResult{}
+var retval *shared.ListWorkflowExecutionsWithQueryResponse
+  var err2 error
+  if retval, err2 = p.handler.ListWorkflowExecutionsWithQuery(args.ListRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListWorkflowExecutionsWithQuery: " + err2.Error())
+    oprot.WriteMessageBegin("ListWorkflowExecutionsWithQuery", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("ListWorkflowExecutionsWithQuery", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorSignalWithStartWorkflowExecution struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorSignalWithStartWorkflowExecution) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceSignalWithStartWorkflowExecutionArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("SignalWithStartWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceSignalWithStartWorkflowExecutionResult{}
+var retval *shared.StartWorkflowExecutionResponse
+  var err2 error
+  if retval, err2 = p.handler.SignalWithStartWorkflowExecution(args.SignalWithStartRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.WorkflowExecutionAlreadyStartedError:
+  result.SessionAlreadyExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SignalWithStartWorkflowExecution: " + err2.Error())
+    oprot.WriteMessageBegin("SignalWithStartWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("SignalWithStartWorkflowExecution", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorResetWorkflowExecution struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorResetWorkflowExecution) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceResetWorkflowExecutionArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("ResetWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceResetWorkflowExecutionResult{}
+var retval *shared.ResetWorkflowExecutionResponse
+  var err2 error
+  if retval, err2 = p.handler.ResetWorkflowExecution(args.ResetRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ResetWorkflowExecution: " + err2.Error())
+    oprot.WriteMessageBegin("ResetWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("ResetWorkflowExecution", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorQueryWorkflow struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorQueryWorkflow) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceQueryWorkflowArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("QueryWorkflow", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceQueryWorkflowResult{}
+var retval *shared.QueryWorkflowResponse
+  var err2 error
+  if retval, err2 = p.handler.QueryWorkflow(args.QueryRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    case *shared.QueryFailedError:
+  result.QueryFailedError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing QueryWorkflow: " + err2.Error())
+    oprot.WriteMessageBegin("QueryWorkflow", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("QueryWorkflow", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorRespondQueryTaskCompleted struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorRespondQueryTaskCompleted) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceRespondQueryTaskCompletedArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("RespondQueryTaskCompleted", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceRespondQueryTaskCompletedResult{}
+  var err2 error
+  if err2 = p.handler.RespondQueryTaskCompleted(args.CompleteRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondQueryTaskCompleted: " + err2.Error())
+    oprot.WriteMessageBegin("RespondQueryTaskCompleted", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("RespondQueryTaskCompleted", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorStartBatchOperation struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorStartBatchOperation) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceStartBatchOperationArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("StartBatchOperation", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceStartBatchOperationResult{}
+var retval *shared.StartBatchOperationResponse
+  var err2 error
+  if retval, err2 = p.handler.StartBatchOperation(args.StartRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing StartBatchOperation: " + err2.Error())
+    oprot.WriteMessageBegin("StartBatchOperation", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("StartBatchOperation", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorDescribeBatchOperation struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorDescribeBatchOperation) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceDescribeBatchOperationArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("DescribeBatchOperation", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceDescribeBatchOperationResult{}
+var retval *shared.DescribeBatchOperationResponse
+  var err2 error
+  if retval, err2 = p.handler.DescribeBatchOperation(args.DescribeRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DescribeBatchOperation: " + err2.Error())
+    oprot.WriteMessageBegin("DescribeBatchOperation", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("DescribeBatchOperation", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorStopBatchOperation struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorStopBatchOperation) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceStopBatchOperationArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("StopBatchOperation", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceStopBatchOperationResult{}
+  var err2 error
+  if err2 = p.handler.StopBatchOperation(args.StopRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing StopBatchOperation: " + err2.Error())
+    oprot.WriteMessageBegin("StopBatchOperation", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("StopBatchOperation", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+
+// HELPER FUNCTIONS AND STRUCTURES
+
+// Attributes:
+//  - RegisterRequest
+type WorkflowServiceRegisterDomainArgs struct {
+  RegisterRequest *shared.RegisterDomainRequest `thrift:"registerRequest,1" db:"registerRequest" json:"registerRequest"`
+}
+
+func NewWorkflowServiceRegisterDomainArgs() *WorkflowServiceRegisterDomainArgs {
+  return &WorkflowServiceRegisterDomainArgs{}
+}
+
+var WorkflowServiceRegisterDomainArgs_RegisterRequest_DEFAULT *shared.RegisterDomainRequest
+func (p *WorkflowServiceRegisterDomainArgs) GetRegisterRequest() *shared.RegisterDomainRequest {
+  if !p.IsSetRegisterRequest() {
+    return WorkflowServiceRegisterDomainArgs_RegisterRequest_DEFAULT
+  }
+return p.RegisterRequest
+}
+func (p *WorkflowServiceRegisterDomainArgs) IsSetRegisterRequest() bool {
+  return p.RegisterRequest != nil
+}
+
+func (p *WorkflowServiceRegisterDomainArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRegisterDomainArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.RegisterRequest = &shared.RegisterDomainRequest{}
+  if err := p.RegisterRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.RegisterRequest), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRegisterDomainArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RegisterDomain_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceRegisterDomainArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("registerRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:registerRequest: ", p), err) }
+  if err := p.RegisterRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.RegisterRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:registerRequest: ", p), err) }
+  return err
+}
+
+func (p *WorkflowServiceRegisterDomainArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceRegisterDomainArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - BadRequestError
+//  - InternalServiceError
+//  - DomainExistsError
+type WorkflowServiceRegisterDomainResult struct {
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  DomainExistsError *shared.DomainAlreadyExistsError `thrift:"domainExistsError,3" db:"domainExistsError" json:"domainExistsError,omitempty"`
+}
+
+func NewWorkflowServiceRegisterDomainResult() *WorkflowServiceRegisterDomainResult {
+  return &WorkflowServiceRegisterDomainResult{}
+}
+
+var WorkflowServiceRegisterDomainResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceRegisterDomainResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return WorkflowServiceRegisterDomainResult_BadRequestError_DEFAULT
+  }
+return p.BadRequestError
+}
+var WorkflowServiceRegisterDomainResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceRegisterDomainResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return WorkflowServiceRegisterDomainResult_InternalServiceError_DEFAULT
+  }
+return p.InternalServiceError
+}
+var WorkflowServiceRegisterDomainResult_DomainExistsError_DEFAULT *shared.DomainAlreadyExistsError
+func (p *WorkflowServiceRegisterDomainResult) GetDomainExistsError() *shared.DomainAlreadyExistsError {
+  if !p.IsSetDomainExistsError() {
+    return WorkflowServiceRegisterDomainResult_DomainExistsError_DEFAULT
+  }
+return p.DomainExistsError
+}
+func (p *WorkflowServiceRegisterDomainResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
+
+func (p *WorkflowServiceRegisterDomainResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
+}
+
+func (p *WorkflowServiceRegisterDomainResult) IsSetDomainExistsError() bool {
+  return p.DomainExistsError != nil
+}
+
+func (p *WorkflowServiceRegisterDomainResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRegisterDomainResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRegisterDomainResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRegisterDomainResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.DomainExistsError = &shared.DomainAlreadyExistsError{}
+  if err := p.DomainExistsError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DomainExistsError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRegisterDomainResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RegisterDomain_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceRegisterDomainResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRegisterDomainResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRegisterDomainResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomainExistsError() {
+    if err := oprot.WriteFieldBegin("domainExistsError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:domainExistsError: ", p), err) }
+    if err := p.DomainExistsError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DomainExistsError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:domainExistsError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRegisterDomainResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceRegisterDomainResult(%+v)", *p)
+}
+
+// Attributes:
+//  - DescribeRequest
+type WorkflowServiceDescribeDomainArgs struct {
+  DescribeRequest *shared.DescribeDomainRequest `thrift:"describeRequest,1" db:"describeRequest" json:"describeRequest"`
+}
+
+func NewWorkflowServiceDescribeDomainArgs() *WorkflowServiceDescribeDomainArgs {
+  return &WorkflowServiceDescribeDomainArgs{}
+}
+
+var WorkflowServiceDescribeDomainArgs_DescribeRequest_DEFAULT *shared.DescribeDomainRequest
+func (p *WorkflowServiceDescribeDomainArgs) GetDescribeRequest() *shared.DescribeDomainRequest {
+  if !p.IsSetDescribeRequest() {
+    return WorkflowServiceDescribeDomainArgs_DescribeRequest_DEFAULT
+  }
+return p.DescribeRequest
+}
+func (p *WorkflowServiceDescribeDomainArgs) IsSetDescribeRequest() bool {
+  return p.DescribeRequest != nil
+}
+
+func (p *WorkflowServiceDescribeDomainArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceDescribeDomainArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.DescribeRequest = &shared.DescribeDomainRequest{}
+  if err := p.DescribeRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DescribeRequest), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceDescribeDomainArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("DescribeDomain_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceDescribeDomainArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("describeRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:describeRequest: ", p), err) }
+  if err := p.DescribeRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DescribeRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:describeRequest: ", p), err) }
+  return err
+}
+
+func (p *WorkflowServiceDescribeDomainArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceDescribeDomainArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - BadRequestError
+//  - InternalServiceError
+//  - EntityNotExistError
+type WorkflowServiceDescribeDomainResult struct {
+  Success *shared.DescribeDomainResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+}
+
+func NewWorkflowServiceDescribeDomainResult() *WorkflowServiceDescribeDomainResult {
+  return &WorkflowServiceDescribeDomainResult{}
+}
+
+var WorkflowServiceDescribeDomainResult_Success_DEFAULT *shared.DescribeDomainResponse
+func (p *WorkflowServiceDescribeDomainResult) GetSuccess() *shared.DescribeDomainResponse {
+  if !p.IsSetSuccess() {
+    return WorkflowServiceDescribeDomainResult_Success_DEFAULT
+  }
+return p.Success
+}
+var WorkflowServiceDescribeDomainResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceDescribeDomainResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return WorkflowServiceDescribeDomainResult_BadRequestError_DEFAULT
+  }
+return p.BadRequestError
+}
+var WorkflowServiceDescribeDomainResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceDescribeDomainResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return WorkflowServiceDescribeDomainResult_InternalServiceError_DEFAULT
+  }
+return p.InternalServiceError
+}
+var WorkflowServiceDescribeDomainResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceDescribeDomainResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return WorkflowServiceDescribeDomainResult_EntityNotExistError_DEFAULT
+  }
+return p.EntityNotExistError
+}
+func (p *WorkflowServiceDescribeDomainResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *WorkflowServiceDescribeDomainResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
+
+func (p *WorkflowServiceDescribeDomainResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
+}
+
+func (p *WorkflowServiceDescribeDomainResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
+}
+
+func (p *WorkflowServiceDescribeDomainResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceDescribeDomainResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.DescribeDomainResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceDescribeDomainResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceDescribeDomainResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceDescribeDomainResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceDescribeDomainResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("DescribeDomain_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceDescribeDomainResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceDescribeDomainResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceDescribeDomainResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceDescribeDomainResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceDescribeDomainResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceDescribeDomainResult(%+v)", *p)
+}
+
+// Attributes:
+//  - UpdateRequest
+type WorkflowServiceUpdateDomainArgs struct {
+  UpdateRequest *shared.UpdateDomainRequest `thrift:"updateRequest,1" db:"updateRequest" json:"updateRequest"`
+}
+
+func NewWorkflowServiceUpdateDomainArgs() *WorkflowServiceUpdateDomainArgs {
+  return &WorkflowServiceUpdateDomainArgs{}
+}
+
+var WorkflowServiceUpdateDomainArgs_UpdateRequest_DEFAULT *shared.UpdateDomainRequest
+func (p *WorkflowServiceUpdateDomainArgs) GetUpdateRequest() *shared.UpdateDomainRequest {
+  if !p.IsSetUpdateRequest() {
+    return WorkflowServiceUpdateDomainArgs_UpdateRequest_DEFAULT
+  }
+return p.UpdateRequest
+}
+func (p *WorkflowServiceUpdateDomainArgs) IsSetUpdateRequest() bool {
+  return p.UpdateRequest != nil
+}
+
+func (p *WorkflowServiceUpdateDomainArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceUpdateDomainArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.UpdateRequest = &shared.UpdateDomainRequest{}
+  if err := p.UpdateRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.UpdateRequest), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceUpdateDomainArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("UpdateDomain_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceUpdateDomainArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("updateRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:updateRequest: ", p), err) }
+  if err := p.UpdateRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.UpdateRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:updateRequest: ", p), err) }
+  return err
+}
+
+func (p *WorkflowServiceUpdateDomainArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceUpdateDomainArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - BadRequestError
+//  - InternalServiceError
+//  - EntityNotExistError
+type WorkflowServiceUpdateDomainResult struct {
+  Success *shared.UpdateDomainResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+}
+
+func NewWorkflowServiceUpdateDomainResult() *WorkflowServiceUpdateDomainResult {
+  return &WorkflowServiceUpdateDomainResult{}
+}
+
+var WorkflowServiceUpdateDomainResult_Success_DEFAULT *shared.UpdateDomainResponse
+func (p *WorkflowServiceUpdateDomainResult) GetSuccess() *shared.UpdateDomainResponse {
+  if !p.IsSetSuccess() {
+    return WorkflowServiceUpdateDomainResult_Success_DEFAULT
+  }
+return p.Success
+}
+var WorkflowServiceUpdateDomainResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceUpdateDomainResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return WorkflowServiceUpdateDomainResult_BadRequestError_DEFAULT
+  }
+return p.BadRequestError
+}
+var WorkflowServiceUpdateDomainResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceUpdateDomainResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return WorkflowServiceUpdateDomainResult_InternalServiceError_DEFAULT
+  }
+return p.InternalServiceError
+}
+var WorkflowServiceUpdateDomainResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceUpdateDomainResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return WorkflowServiceUpdateDomainResult_EntityNotExistError_DEFAULT
+  }
+return p.EntityNotExistError
+}
+func (p *WorkflowServiceUpdateDomainResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *WorkflowServiceUpdateDomainResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
+
+func (p *WorkflowServiceUpdateDomainResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
+}
+
+func (p *WorkflowServiceUpdateDomainResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
+}
+
+func (p *WorkflowServiceUpdateDomainResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceUpdateDomainResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.UpdateDomainResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceUpdateDomainResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceUpdateDomainResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceUpdateDomainResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceUpdateDomainResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("UpdateDomain_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceUpdateDomainResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceUpdateDomainResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceUpdateDomainResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceUpdateDomainResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceUpdateDomainResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceUpdateDomainResult(%+v)", *p)
+}
+
+// Attributes:
+//  - DeprecateRequest
+type WorkflowServiceDeprecateDomainArgs struct {
+  DeprecateRequest *shared.DeprecateDomainRequest `thrift:"deprecateRequest,1" db:"deprecateRequest" json:"deprecateRequest"`
+}
+
+func NewWorkflowServiceDeprecateDomainArgs() *WorkflowServiceDeprecateDomainArgs {
+  return &WorkflowServiceDeprecateDomainArgs{}
+}
+
+var WorkflowServiceDeprecateDomainArgs_DeprecateRequest_DEFAULT *shared.DeprecateDomainRequest
+func (p *WorkflowServiceDeprecateDomainArgs) GetDeprecateRequest() *shared.DeprecateDomainRequest {
+  if !p.IsSetDeprecateRequest() {
+    return WorkflowServiceDeprecateDomainArgs_DeprecateRequest_DEFAULT
+  }
+return p.DeprecateRequest
+}
+func (p *WorkflowServiceDeprecateDomainArgs) IsSetDeprecateRequest() bool {
+  return p.DeprecateRequest != nil
+}
+
+func (p *WorkflowServiceDeprecateDomainArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceDeprecateDomainArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.DeprecateRequest = &shared.DeprecateDomainRequest{}
+  if err := p.DeprecateRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DeprecateRequest), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceDeprecateDomainArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("DeprecateDomain_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceDeprecateDomainArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("deprecateRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:deprecateRequest: ", p), err) }
+  if err := p.DeprecateRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DeprecateRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:deprecateRequest: ", p), err) }
+  return err
+}
+
+func (p *WorkflowServiceDeprecateDomainArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceDeprecateDomainArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - BadRequestError
+//  - InternalServiceError
+//  - EntityNotExistError
+type WorkflowServiceDeprecateDomainResult struct {
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+}
+
+func NewWorkflowServiceDeprecateDomainResult() *WorkflowServiceDeprecateDomainResult {
+  return &WorkflowServiceDeprecateDomainResult{}
+}
+
+var WorkflowServiceDeprecateDomainResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceDeprecateDomainResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return WorkflowServiceDeprecateDomainResult_BadRequestError_DEFAULT
+  }
+return p.BadRequestError
+}
+var WorkflowServiceDeprecateDomainResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceDeprecateDomainResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return WorkflowServiceDeprecateDomainResult_InternalServiceError_DEFAULT
+  }
+return p.InternalServiceError
+}
+var WorkflowServiceDeprecateDomainResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceDeprecateDomainResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return WorkflowServiceDeprecateDomainResult_EntityNotExistError_DEFAULT
+  }
+return p.EntityNotExistError
+}
+func (p *WorkflowServiceDeprecateDomainResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
+
+func (p *WorkflowServiceDeprecateDomainResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
+}
+
+func (p *WorkflowServiceDeprecateDomainResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
+}
+
+func (p *WorkflowServiceDeprecateDomainResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceDeprecateDomainResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceDeprecateDomainResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceDeprecateDomainResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceDeprecateDomainResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("DeprecateDomain_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceDeprecateDomainResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceDeprecateDomainResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceDeprecateDomainResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceDeprecateDomainResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceDeprecateDomainResult(%+v)", *p)
+}
+
+// Attributes:
+//  - StartRequest
+type WorkflowServiceStartWorkflowExecutionArgs struct {
+  StartRequest *shared.StartWorkflowExecutionRequest `thrift:"startRequest,1" db:"startRequest" json:"startRequest"`
+}
+
+func NewWorkflowServiceStartWorkflowExecutionArgs() *WorkflowServiceStartWorkflowExecutionArgs {
+  return &WorkflowServiceStartWorkflowExecutionArgs{}
+}
+
+var WorkflowServiceStartWorkflowExecutionArgs_StartRequest_DEFAULT *shared.StartWorkflowExecutionRequest
+func (p *WorkflowServiceStartWorkflowExecutionArgs) GetStartRequest() *shared.StartWorkflowExecutionRequest {
+  if !p.IsSetStartRequest() {
+    return WorkflowServiceStartWorkflowExecutionArgs_StartRequest_DEFAULT
+  }
+return p.StartRequest
+}
+func (p *WorkflowServiceStartWorkflowExecutionArgs) IsSetStartRequest() bool {
+  return p.StartRequest != nil
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.StartRequest = &shared.StartWorkflowExecutionRequest{}
+  if err := p.StartRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.StartRequest), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("StartWorkflowExecution_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("startRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:startRequest: ", p), err) }
+  if err := p.StartRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.StartRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:startRequest: ", p), err) }
+  return err
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceStartWorkflowExecutionArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - BadRequestError
+//  - InternalServiceError
+//  - SessionAlreadyExistError
+type WorkflowServiceStartWorkflowExecutionResult struct {
+  Success *shared.StartWorkflowExecutionResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  SessionAlreadyExistError *shared.WorkflowExecutionAlreadyStartedError `thrift:"sessionAlreadyExistError,3" db:"sessionAlreadyExistError" json:"sessionAlreadyExistError,omitempty"`
+}
+
+func NewWorkflowServiceStartWorkflowExecutionResult() *WorkflowServiceStartWorkflowExecutionResult {
+  return &WorkflowServiceStartWorkflowExecutionResult{}
+}
+
+var WorkflowServiceStartWorkflowExecutionResult_Success_DEFAULT *shared.StartWorkflowExecutionResponse
+func (p *WorkflowServiceStartWorkflowExecutionResult) GetSuccess() *shared.StartWorkflowExecutionResponse {
+  if !p.IsSetSuccess() {
+    return WorkflowServiceStartWorkflowExecutionResult_Success_DEFAULT
+  }
+return p.Success
+}
+var WorkflowServiceStartWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceStartWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return WorkflowServiceStartWorkflowExecutionResult_BadRequestError_DEFAULT
+  }
+return p.BadRequestError
+}
+var WorkflowServiceStartWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceStartWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return WorkflowServiceStartWorkflowExecutionResult_InternalServiceError_DEFAULT
+  }
+return p.InternalServiceError
+}
+var WorkflowServiceStartWorkflowExecutionResult_SessionAlreadyExistError_DEFAULT *shared.WorkflowExecutionAlreadyStartedError
+func (p *WorkflowServiceStartWorkflowExecutionResult) GetSessionAlreadyExistError() *shared.WorkflowExecutionAlreadyStartedError {
+  if !p.IsSetSessionAlreadyExistError() {
+    return WorkflowServiceStartWorkflowExecutionResult_SessionAlreadyExistError_DEFAULT
+  }
+return p.SessionAlreadyExistError
+}
+func (p *WorkflowServiceStartWorkflowExecutionResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionResult) IsSetSessionAlreadyExistError() bool {
+  return p.SessionAlreadyExistError != nil
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.StartWorkflowExecutionResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.SessionAlreadyExistError = &shared.WorkflowExecutionAlreadyStartedError{}
+  if err := p.SessionAlreadyExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.SessionAlreadyExistError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("StartWorkflowExecution_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSessionAlreadyExistError() {
+    if err := oprot.WriteFieldBegin("sessionAlreadyExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:sessionAlreadyExistError: ", p), err) }
+    if err := p.SessionAlreadyExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.SessionAlreadyExistError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:sessionAlreadyExistError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceStartWorkflowExecutionResult(%+v)", *p)
+}
+
+// Attributes:
+//  - GetRequest
+type WorkflowServiceGetWorkflowExecutionHistoryArgs struct {
+  GetRequest *shared.GetWorkflowExecutionHistoryRequest `thrift:"getRequest,1" db:"getRequest" json:"getRequest"`
+}
+
+func NewWorkflowServiceGetWorkflowExecutionHistoryArgs() *WorkflowServiceGetWorkflowExecutionHistoryArgs {
+  return &WorkflowServiceGetWorkflowExecutionHistoryArgs{}
+}
+
+var WorkflowServiceGetWorkflowExecutionHistoryArgs_GetRequest_DEFAULT *shared.GetWorkflowExecutionHistoryRequest
+func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) GetGetRequest() *shared.GetWorkflowExecutionHistoryRequest {
+  if !p.IsSetGetRequest() {
+    return WorkflowServiceGetWorkflowExecutionHistoryArgs_GetRequest_DEFAULT
+  }
+return p.GetRequest
+}
+func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) IsSetGetRequest() bool {
+  return p.GetRequest != nil
+}
+
+func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.GetRequest = &shared.GetWorkflowExecutionHistoryRequest{}
+  if err := p.GetRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.GetRequest), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetWorkflowExecutionHistory_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("getRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:getRequest: ", p), err) }
+  if err := p.GetRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.GetRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:getRequest: ", p), err) }
+  return err
+}
+
+func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceGetWorkflowExecutionHistoryArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - BadRequestError
+//  - InternalServiceError
+//  - EntityNotExistError
+type WorkflowServiceGetWorkflowExecutionHistoryResult struct {
+  Success *shared.GetWorkflowExecutionHistoryResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+}
+
+func NewWorkflowServiceGetWorkflowExecutionHistoryResult() *WorkflowServiceGetWorkflowExecutionHistoryResult {
+  return &WorkflowServiceGetWorkflowExecutionHistoryResult{}
+}
+
+var WorkflowServiceGetWorkflowExecutionHistoryResult_Success_DEFAULT *shared.GetWorkflowExecutionHistoryResponse
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) GetSuccess() *shared.GetWorkflowExecutionHistoryResponse {
+  if !p.IsSetSuccess() {
+    return WorkflowServiceGetWorkflowExecutionHistoryResult_Success_DEFAULT
+  }
+return p.Success
+}
+var WorkflowServiceGetWorkflowExecutionHistoryResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return WorkflowServiceGetWorkflowExecutionHistoryResult_BadRequestError_DEFAULT
+  }
+return p.BadRequestError
+}
+var WorkflowServiceGetWorkflowExecutionHistoryResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return WorkflowServiceGetWorkflowExecutionHistoryResult_InternalServiceError_DEFAULT
+  }
+return p.InternalServiceError
+}
+var WorkflowServiceGetWorkflowExecutionHistoryResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return WorkflowServiceGetWorkflowExecutionHistoryResult_EntityNotExistError_DEFAULT
+  }
+return p.EntityNotExistError
+}
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
+
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
+}
+
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
+}
+
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.GetWorkflowExecutionHistoryResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetWorkflowExecutionHistory_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceGetWorkflowExecutionHistoryResult(%+v)", *p)
+}
+
+// Attributes:
+//  - PollRequest
+type WorkflowServicePollForDecisionTaskArgs struct {
+  PollRequest *shared.PollForDecisionTaskRequest `thrift:"pollRequest,1" db:"pollRequest" json:"pollRequest"`
+}
+
+func NewWorkflowServicePollForDecisionTaskArgs() *WorkflowServicePollForDecisionTaskArgs {
+  return &WorkflowServicePollForDecisionTaskArgs{}
+}
+
+var WorkflowServicePollForDecisionTaskArgs_PollRequest_DEFAULT *shared.PollForDecisionTaskRequest
+func (p *WorkflowServicePollForDecisionTaskArgs) GetPollRequest() *shared.PollForDecisionTaskRequest {
+  if !p.IsSetPollRequest() {
+    return WorkflowServicePollForDecisionTaskArgs_PollRequest_DEFAULT
+  }
+return p.PollRequest
+}
+func (p *WorkflowServicePollForDecisionTaskArgs) IsSetPollRequest() bool {
+  return p.PollRequest != nil
+}
+
+func (p *WorkflowServicePollForDecisionTaskArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServicePollForDecisionTaskArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.PollRequest = &shared.PollForDecisionTaskRequest{}
+  if err := p.PollRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.PollRequest), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServicePollForDecisionTaskArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("PollForDecisionTask_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServicePollForDecisionTaskArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("pollRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:pollRequest: ", p), err) }
+  if err := p.PollRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.PollRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:pollRequest: ", p), err) }
+  return err
+}
+
+func (p *WorkflowServicePollForDecisionTaskArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServicePollForDecisionTaskArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - BadRequestError
+//  - InternalServiceError
+type WorkflowServicePollForDecisionTaskResult struct {
+  Success *shared.PollForDecisionTaskResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+}
+
+func NewWorkflowServicePollForDecisionTaskResult() *WorkflowServicePollForDecisionTaskResult {
+  return &WorkflowServicePollForDecisionTaskResult{}
+}
+
+var WorkflowServicePollForDecisionTaskResult_Success_DEFAULT *shared.PollForDecisionTaskResponse
+func (p *WorkflowServicePollForDecisionTaskResult) GetSuccess() *shared.PollForDecisionTaskResponse {
+  if !p.IsSetSuccess() {
+    return WorkflowServicePollForDecisionTaskResult_Success_DEFAULT
+  }
+return p.Success
+}
+var WorkflowServicePollForDecisionTaskResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServicePollForDecisionTaskResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return WorkflowServicePollForDecisionTaskResult_BadRequestError_DEFAULT
+  }
+return p.BadRequestError
+}
+var WorkflowServicePollForDecisionTaskResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServicePollForDecisionTaskResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return WorkflowServicePollForDecisionTaskResult_InternalServiceError_DEFAULT
+  }
+return p.InternalServiceError
+}
+func (p *WorkflowServicePollForDecisionTaskResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *WorkflowServicePollForDecisionTaskResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
+
+func (p *WorkflowServicePollForDecisionTaskResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
+}
+
+func (p *WorkflowServicePollForDecisionTaskResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServicePollForDecisionTaskResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.PollForDecisionTaskResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServicePollForDecisionTaskResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServicePollForDecisionTaskResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServicePollForDecisionTaskResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("PollForDecisionTask_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServicePollForDecisionTaskResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServicePollForDecisionTaskResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServicePollForDecisionTaskResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServicePollForDecisionTaskResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServicePollForDecisionTaskResult(%+v)", *p)
+}
+
+// Attributes:
+//  - CompleteRequest
+type WorkflowServiceRespondDecisionTaskCompletedArgs struct {
+  CompleteRequest *shared.RespondDecisionTaskCompletedRequest `thrift:"completeRequest,1" db:"completeRequest" json:"completeRequest"`
+}
+
+func NewWorkflowServiceRespondDecisionTaskCompletedArgs() *WorkflowServiceRespondDecisionTaskCompletedArgs {
+  return &WorkflowServiceRespondDecisionTaskCompletedArgs{}
+}
+
+var WorkflowServiceRespondDecisionTaskCompletedArgs_CompleteRequest_DEFAULT *shared.RespondDecisionTaskCompletedRequest
+func (p *WorkflowServiceRespondDecisionTaskCompletedArgs) GetCompleteRequest() *shared.RespondDecisionTaskCompletedRequest {
+  if !p.IsSetCompleteRequest() {
+    return WorkflowServiceRespondDecisionTaskCompletedArgs_CompleteRequest_DEFAULT
+  }
+return p.CompleteRequest
+}
+func (p *WorkflowServiceRespondDecisionTaskCompletedArgs) IsSetCompleteRequest() bool {
+  return p.CompleteRequest != nil
+}
+
+func (p *WorkflowServiceRespondDecisionTaskCompletedArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRespondDecisionTaskCompletedArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.CompleteRequest = &shared.RespondDecisionTaskCompletedRequest{}
+  if err := p.CompleteRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CompleteRequest), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRespondDecisionTaskCompletedArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondDecisionTaskCompleted_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceRespondDecisionTaskCompletedArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("completeRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:completeRequest: ", p), err) }
+  if err := p.CompleteRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CompleteRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:completeRequest: ", p), err) }
+  return err
+}
+
+func (p *WorkflowServiceRespondDecisionTaskCompletedArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceRespondDecisionTaskCompletedArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - BadRequestError
+//  - InternalServiceError
+//  - EntityNotExistError
+type WorkflowServiceRespondDecisionTaskCompletedResult struct {
+  Success *shared.RespondDecisionTaskCompletedResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+}
+
+func NewWorkflowServiceRespondDecisionTaskCompletedResult() *WorkflowServiceRespondDecisionTaskCompletedResult {
+  return &WorkflowServiceRespondDecisionTaskCompletedResult{}
+}
+
+var WorkflowServiceRespondDecisionTaskCompletedResult_Success_DEFAULT *shared.RespondDecisionTaskCompletedResponse
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult) GetSuccess() *shared.RespondDecisionTaskCompletedResponse {
+  if !p.IsSetSuccess() {
+    return WorkflowServiceRespondDecisionTaskCompletedResult_Success_DEFAULT
+  }
+return p.Success
+}
+var WorkflowServiceRespondDecisionTaskCompletedResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return WorkflowServiceRespondDecisionTaskCompletedResult_BadRequestError_DEFAULT
+  }
+return p.BadRequestError
+}
+var WorkflowServiceRespondDecisionTaskCompletedResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return WorkflowServiceRespondDecisionTaskCompletedResult_InternalServiceError_DEFAULT
+  }
+return p.InternalServiceError
+}
+var WorkflowServiceRespondDecisionTaskCompletedResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return WorkflowServiceRespondDecisionTaskCompletedResult_EntityNotExistError_DEFAULT
+  }
+return p.EntityNotExistError
+}
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
+
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
+}
+
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
+}
+
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.RespondDecisionTaskCompletedResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondDecisionTaskCompleted_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceRespondDecisionTaskCompletedResult(%+v)", *p)
+}
+
+// Attributes:
+//  - PollRequest
+type WorkflowServicePollForActivityTaskArgs struct {
+  PollRequest *shared.PollForActivityTaskRequest `thrift:"pollRequest,1" db:"pollRequest" json:"pollRequest"`
+}
+
+func NewWorkflowServicePollForActivityTaskArgs() *WorkflowServicePollForActivityTaskArgs {
+  return &WorkflowServicePollForActivityTaskArgs{}
+}
+
+var WorkflowServicePollForActivityTaskArgs_PollRequest_DEFAULT *shared.PollForActivityTaskRequest
+func (p *WorkflowServicePollForActivityTaskArgs) GetPollRequest() *shared.PollForActivityTaskRequest {
+  if !p.IsSetPollRequest() {
+    return WorkflowServicePollForActivityTaskArgs_PollRequest_DEFAULT
+  }
+return p.PollRequest
+}
+func (p *WorkflowServicePollForActivityTaskArgs) IsSetPollRequest() bool {
+  return p.PollRequest != nil
+}
+
+func (p *WorkflowServicePollForActivityTaskArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServicePollForActivityTaskArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.PollRequest = &shared.PollForActivityTaskRequest{}
+  if err := p.PollRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.PollRequest), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServicePollForActivityTaskArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("PollForActivityTask_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServicePollForActivityTaskArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("pollRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:pollRequest: ", p), err) }
+  if err := p.PollRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.PollRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:pollRequest: ", p), err) }
+  return err
+}
+
+func (p *WorkflowServicePollForActivityTaskArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServicePollForActivityTaskArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - BadRequestError
+//  - InternalServiceError
+type WorkflowServicePollForActivityTaskResult struct {
+  Success *shared.PollForActivityTaskResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+}
+
+func NewWorkflowServicePollForActivityTaskResult() *WorkflowServicePollForActivityTaskResult {
+  return &WorkflowServicePollForActivityTaskResult{}
+}
+
+var WorkflowServicePollForActivityTaskResult_Success_DEFAULT *shared.PollForActivityTaskResponse
+func (p *WorkflowServicePollForActivityTaskResult) GetSuccess() *shared.PollForActivityTaskResponse {
+  if !p.IsSetSuccess() {
+    return WorkflowServicePollForActivityTaskResult_Success_DEFAULT
+  }
+return p.Success
+}
+var WorkflowServicePollForActivityTaskResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServicePollForActivityTaskResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return WorkflowServicePollForActivityTaskResult_BadRequestError_DEFAULT
+  }
+return p.BadRequestError
+}
+var WorkflowServicePollForActivityTaskResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServicePollForActivityTaskResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return WorkflowServicePollForActivityTaskResult_InternalServiceError_DEFAULT
+  }
+return p.InternalServiceError
+}
+func (p *WorkflowServicePollForActivityTaskResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *WorkflowServicePollForActivityTaskResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
+
+func (p *WorkflowServicePollForActivityTaskResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
+}
+
+func (p *WorkflowServicePollForActivityTaskResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServicePollForActivityTaskResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.PollForActivityTaskResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServicePollForActivityTaskResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServicePollForActivityTaskResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServicePollForActivityTaskResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("PollForActivityTask_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServicePollForActivityTaskResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServicePollForActivityTaskResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServicePollForActivityTaskResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServicePollForActivityTaskResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServicePollForActivityTaskResult(%+v)", *p)
+}
+
+// Attributes:
+//  - HeartbeatRequest
+type WorkflowServiceRecordActivityTaskHeartbeatArgs struct {
+  HeartbeatRequest *shared.RecordActivityTaskHeartbeatRequest `thrift:"heartbeatRequest,1" db:"heartbeatRequest" json:"heartbeatRequest"`
+}
+
+func NewWorkflowServiceRecordActivityTaskHeartbeatArgs() *WorkflowServiceRecordActivityTaskHeartbeatArgs {
+  return &WorkflowServiceRecordActivityTaskHeartbeatArgs{}
+}
+
+var WorkflowServiceRecordActivityTaskHeartbeatArgs_HeartbeatRequest_DEFAULT *shared.RecordActivityTaskHeartbeatRequest
+func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs) GetHeartbeatRequest() *shared.RecordActivityTaskHeartbeatRequest {
+  if !p.IsSetHeartbeatRequest() {
+    return WorkflowServiceRecordActivityTaskHeartbeatArgs_HeartbeatRequest_DEFAULT
+  }
+return p.HeartbeatRequest
+}
+func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs) IsSetHeartbeatRequest() bool {
+  return p.HeartbeatRequest != nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.HeartbeatRequest = &shared.RecordActivityTaskHeartbeatRequest{}
+  if err := p.HeartbeatRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.HeartbeatRequest), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RecordActivityTaskHeartbeat_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("heartbeatRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:heartbeatRequest: ", p), err) }
+  if err := p.HeartbeatRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.HeartbeatRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:heartbeatRequest: ", p), err) }
+  return err
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceRecordActivityTaskHeartbeatArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - BadRequestError
+//  - InternalServiceError
+//  - EntityNotExistError
+type WorkflowServiceRecordActivityTaskHeartbeatResult struct {
+  Success *shared.RecordActivityTaskHeartbeatResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+}
+
+func NewWorkflowServiceRecordActivityTaskHeartbeatResult() *WorkflowServiceRecordActivityTaskHeartbeatResult {
+  return &WorkflowServiceRecordActivityTaskHeartbeatResult{}
+}
+
+var WorkflowServiceRecordActivityTaskHeartbeatResult_Success_DEFAULT *shared.RecordActivityTaskHeartbeatResponse
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) GetSuccess() *shared.RecordActivityTaskHeartbeatResponse {
+  if !p.IsSetSuccess() {
+    return WorkflowServiceRecordActivityTaskHeartbeatResult_Success_DEFAULT
+  }
+return p.Success
+}
+var WorkflowServiceRecordActivityTaskHeartbeatResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return WorkflowServiceRecordActivityTaskHeartbeatResult_BadRequestError_DEFAULT
+  }
+return p.BadRequestError
+}
+var WorkflowServiceRecordActivityTaskHeartbeatResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return WorkflowServiceRecordActivityTaskHeartbeatResult_InternalServiceError_DEFAULT
+  }
+return p.InternalServiceError
+}
+var WorkflowServiceRecordActivityTaskHeartbeatResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return WorkflowServiceRecordActivityTaskHeartbeatResult_EntityNotExistError_DEFAULT
+  }
+return p.EntityNotExistError
+}
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.RecordActivityTaskHeartbeatResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RecordActivityTaskHeartbeat_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceRecordActivityTaskHeartbeatResult(%+v)", *p)
+}
+
+// Attributes:
+//  - CompleteRequest
+type WorkflowServiceRespondActivityTaskCompletedArgs struct {
+  CompleteRequest *shared.RespondActivityTaskCompletedRequest `thrift:"completeRequest,1" db:"completeRequest" json:"completeRequest"`
+}
+
+func NewWorkflowServiceRespondActivityTaskCompletedArgs() *WorkflowServiceRespondActivityTaskCompletedArgs {
+  return &WorkflowServiceRespondActivityTaskCompletedArgs{}
+}
+
+var WorkflowServiceRespondActivityTaskCompletedArgs_CompleteRequest_DEFAULT *shared.RespondActivityTaskCompletedRequest
+func (p *WorkflowServiceRespondActivityTaskCompletedArgs) GetCompleteRequest() *shared.RespondActivityTaskCompletedRequest {
+  if !p.IsSetCompleteRequest() {
+    return WorkflowServiceRespondActivityTaskCompletedArgs_CompleteRequest_DEFAULT
+  }
+return p.CompleteRequest
+}
+func (p *WorkflowServiceRespondActivityTaskCompletedArgs) IsSetCompleteRequest() bool {
+  return p.CompleteRequest != nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskCompletedArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskCompletedArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.CompleteRequest = &shared.RespondActivityTaskCompletedRequest{}
+  if err := p.CompleteRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CompleteRequest), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskCompletedArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskCompleted_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskCompletedArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("completeRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:completeRequest: ", p), err) }
+  if err := p.CompleteRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CompleteRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:completeRequest: ", p), err) }
+  return err
+}
+
+func (p *WorkflowServiceRespondActivityTaskCompletedArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceRespondActivityTaskCompletedArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - BadRequestError
+//  - InternalServiceError
+//  - EntityNotExistError
+type WorkflowServiceRespondActivityTaskCompletedResult struct {
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+}
+
+func NewWorkflowServiceRespondActivityTaskCompletedResult() *WorkflowServiceRespondActivityTaskCompletedResult {
+  return &WorkflowServiceRespondActivityTaskCompletedResult{}
+}
+
+var WorkflowServiceRespondActivityTaskCompletedResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceRespondActivityTaskCompletedResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return WorkflowServiceRespondActivityTaskCompletedResult_BadRequestError_DEFAULT
+  }
+return p.BadRequestError
+}
+var WorkflowServiceRespondActivityTaskCompletedResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceRespondActivityTaskCompletedResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return WorkflowServiceRespondActivityTaskCompletedResult_InternalServiceError_DEFAULT
+  }
+return p.InternalServiceError
+}
+var WorkflowServiceRespondActivityTaskCompletedResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceRespondActivityTaskCompletedResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return WorkflowServiceRespondActivityTaskCompletedResult_EntityNotExistError_DEFAULT
+  }
+return p.EntityNotExistError
+}
+func (p *WorkflowServiceRespondActivityTaskCompletedResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskCompletedResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskCompletedResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskCompletedResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskCompletedResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskCompletedResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskCompletedResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskCompletedResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskCompleted_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskCompletedResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRespondActivityTaskCompletedResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRespondActivityTaskCompletedResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRespondActivityTaskCompletedResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceRespondActivityTaskCompletedResult(%+v)", *p)
+}
+
+// Attributes:
+//  - FailRequest
+type WorkflowServiceRespondActivityTaskFailedArgs struct {
+  FailRequest *shared.RespondActivityTaskFailedRequest `thrift:"failRequest,1" db:"failRequest" json:"failRequest"`
+}
+
+func NewWorkflowServiceRespondActivityTaskFailedArgs() *WorkflowServiceRespondActivityTaskFailedArgs {
+  return &WorkflowServiceRespondActivityTaskFailedArgs{}
+}
+
+var WorkflowServiceRespondActivityTaskFailedArgs_FailRequest_DEFAULT *shared.RespondActivityTaskFailedRequest
+func (p *WorkflowServiceRespondActivityTaskFailedArgs) GetFailRequest() *shared.RespondActivityTaskFailedRequest {
+  if !p.IsSetFailRequest() {
+    return WorkflowServiceRespondActivityTaskFailedArgs_FailRequest_DEFAULT
+  }
+return p.FailRequest
+}
+func (p *WorkflowServiceRespondActivityTaskFailedArgs) IsSetFailRequest() bool {
+  return p.FailRequest != nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskFailedArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskFailedArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.FailRequest = &shared.RespondActivityTaskFailedRequest{}
+  if err := p.FailRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.FailRequest), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskFailedArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskFailed_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskFailedArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("failRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:failRequest: ", p), err) }
+  if err := p.FailRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.FailRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:failRequest: ", p), err) }
+  return err
+}
+
+func (p *WorkflowServiceRespondActivityTaskFailedArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceRespondActivityTaskFailedArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - BadRequestError
+//  - InternalServiceError
+//  - EntityNotExistError
+type WorkflowServiceRespondActivityTaskFailedResult struct {
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+}
+
+func NewWorkflowServiceRespondActivityTaskFailedResult() *WorkflowServiceRespondActivityTaskFailedResult {
+  return &WorkflowServiceRespondActivityTaskFailedResult{}
+}
+
+var WorkflowServiceRespondActivityTaskFailedResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceRespondActivityTaskFailedResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return WorkflowServiceRespondActivityTaskFailedResult_BadRequestError_DEFAULT
+  }
+return p.BadRequestError
+}
+var WorkflowServiceRespondActivityTaskFailedResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceRespondActivityTaskFailedResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return WorkflowServiceRespondActivityTaskFailedResult_InternalServiceError_DEFAULT
+  }
+return p.InternalServiceError
+}
+var WorkflowServiceRespondActivityTaskFailedResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceRespondActivityTaskFailedResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return WorkflowServiceRespondActivityTaskFailedResult_EntityNotExistError_DEFAULT
+  }
+return p.EntityNotExistError
+}
+func (p *WorkflowServiceRespondActivityTaskFailedResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskFailedResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskFailedResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskFailedResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskFailedResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskFailedResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskFailedResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskFailedResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskFailed_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskFailedResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRespondActivityTaskFailedResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRespondActivityTaskFailedResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRespondActivityTaskFailedResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceRespondActivityTaskFailedResult(%+v)", *p)
+}
+
+// Attributes:
+//  - CanceledRequest
+type WorkflowServiceRespondActivityTaskCanceledArgs struct {
+  CanceledRequest *shared.RespondActivityTaskCanceledRequest `thrift:"canceledRequest,1" db:"canceledRequest" json:"canceledRequest"`
+}
+
+func NewWorkflowServiceRespondActivityTaskCanceledArgs() *WorkflowServiceRespondActivityTaskCanceledArgs {
+  return &WorkflowServiceRespondActivityTaskCanceledArgs{}
+}
+
+var WorkflowServiceRespondActivityTaskCanceledArgs_CanceledRequest_DEFAULT *shared.RespondActivityTaskCanceledRequest
+func (p *WorkflowServiceRespondActivityTaskCanceledArgs) GetCanceledRequest() *shared.RespondActivityTaskCanceledRequest {
+  if !p.IsSetCanceledRequest() {
+    return WorkflowServiceRespondActivityTaskCanceledArgs_CanceledRequest_DEFAULT
+  }
+return p.CanceledRequest
+}
+func (p *WorkflowServiceRespondActivityTaskCanceledArgs) IsSetCanceledRequest() bool {
+  return p.CanceledRequest != nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskCanceledArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskCanceledArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.CanceledRequest = &shared.RespondActivityTaskCanceledRequest{}
+  if err := p.CanceledRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CanceledRequest), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskCanceledArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskCanceled_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskCanceledArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("canceledRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:canceledRequest: ", p), err) }
+  if err := p.CanceledRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CanceledRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:canceledRequest: ", p), err) }
+  return err
+}
+
+func (p *WorkflowServiceRespondActivityTaskCanceledArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceRespondActivityTaskCanceledArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - BadRequestError
+//  - InternalServiceError
+//  - EntityNotExistError
+type WorkflowServiceRespondActivityTaskCanceledResult struct {
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+}
+
+func NewWorkflowServiceRespondActivityTaskCanceledResult() *WorkflowServiceRespondActivityTaskCanceledResult {
+  return &WorkflowServiceRespondActivityTaskCanceledResult{}
+}
+
+var WorkflowServiceRespondActivityTaskCanceledResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceRespondActivityTaskCanceledResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return WorkflowServiceRespondActivityTaskCanceledResult_BadRequestError_DEFAULT
+  }
+return p.BadRequestError
+}
+var WorkflowServiceRespondActivityTaskCanceledResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceRespondActivityTaskCanceledResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return WorkflowServiceRespondActivityTaskCanceledResult_InternalServiceError_DEFAULT
+  }
+return p.InternalServiceError
+}
+var WorkflowServiceRespondActivityTaskCanceledResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceRespondActivityTaskCanceledResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return WorkflowServiceRespondActivityTaskCanceledResult_EntityNotExistError_DEFAULT
+  }
+return p.EntityNotExistError
+}
+func (p *WorkflowServiceRespondActivityTaskCanceledResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskCanceledResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskCanceledResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskCanceledResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskCanceledResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskCanceledResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskCanceledResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskCanceledResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskCanceled_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskCanceledResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRespondActivityTaskCanceledResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRespondActivityTaskCanceledResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRespondActivityTaskCanceledResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceRespondActivityTaskCanceledResult(%+v)", *p)
+}
+
+// Attributes:
+//  - CancelRequest
+type WorkflowServiceRequestCancelWorkflowExecutionArgs struct {
+  CancelRequest *shared.RequestCancelWorkflowExecutionRequest `thrift:"cancelRequest,1" db:"cancelRequest" json:"cancelRequest"`
+}
+
+func NewWorkflowServiceRequestCancelWorkflowExecutionArgs() *WorkflowServiceRequestCancelWorkflowExecutionArgs {
+  return &WorkflowServiceRequestCancelWorkflowExecutionArgs{}
+}
+
+var WorkflowServiceRequestCancelWorkflowExecutionArgs_CancelRequest_DEFAULT *shared.RequestCancelWorkflowExecutionRequest
+func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs) GetCancelRequest() *shared.RequestCancelWorkflowExecutionRequest {
+  if !p.IsSetCancelRequest() {
+    return WorkflowServiceRequestCancelWorkflowExecutionArgs_CancelRequest_DEFAULT
+  }
+return p.CancelRequest
+}
+func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs) IsSetCancelRequest() bool {
+  return p.CancelRequest != nil
+}
+
+func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.CancelRequest = &shared.RequestCancelWorkflowExecutionRequest{}
+  if err := p.CancelRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CancelRequest), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RequestCancelWorkflowExecution_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("cancelRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:cancelRequest: ", p), err) }
+  if err := p.CancelRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CancelRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:cancelRequest: ", p), err) }
+  return err
+}
+
+func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceRequestCancelWorkflowExecutionArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - BadRequestError
+//  - InternalServiceError
+//  - EntityNotExistError
+type WorkflowServiceRequestCancelWorkflowExecutionResult struct {
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+}
+
+func NewWorkflowServiceRequestCancelWorkflowExecutionResult() *WorkflowServiceRequestCancelWorkflowExecutionResult {
+  return &WorkflowServiceRequestCancelWorkflowExecutionResult{}
+}
+
+var WorkflowServiceRequestCancelWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return WorkflowServiceRequestCancelWorkflowExecutionResult_BadRequestError_DEFAULT
+  }
+return p.BadRequestError
+}
+var WorkflowServiceRequestCancelWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return WorkflowServiceRequestCancelWorkflowExecutionResult_InternalServiceError_DEFAULT
+  }
+return p.InternalServiceError
+}
+var WorkflowServiceRequestCancelWorkflowExecutionResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return WorkflowServiceRequestCancelWorkflowExecutionResult_EntityNotExistError_DEFAULT
+  }
+return p.EntityNotExistError
+}
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
+
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
+}
+
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
+}
+
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RequestCancelWorkflowExecution_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceRequestCancelWorkflowExecutionResult(%+v)", *p)
 }
 
-
-// HELPER FUNCTIONS AND STRUCTURES
-
 // Attributes:
-//  - RegisterRequest
-type WorkflowServiceRegisterDomainArgs struct {
-  RegisterRequest *shared.RegisterDomainRequest `thrift:"registerRequest,1" db:"registerRequest" json:"registerRequest"`
+//  - SignalRequest
+type WorkflowServiceSignalWorkflowExecutionArgs struct {
+  SignalRequest *shared.SignalWorkflowExecutionRequest `thrift:"signalRequest,1" db:"signalRequest" json:"signalRequest"`
 }
 
-func NewWorkflowServiceRegisterDomainArgs() *WorkflowServiceRegisterDomainArgs {
-  return &WorkflowServiceRegisterDomainArgs{}
+func NewWorkflowServiceSignalWorkflowExecutionArgs() *WorkflowServiceSignalWorkflowExecutionArgs {
+  return &WorkflowServiceSignalWorkflowExecutionArgs{}
 }
 
-var WorkflowServiceRegisterDomainArgs_RegisterRequest_DEFAULT *shared.RegisterDomainRequest
-func (p *WorkflowServiceRegisterDomainArgs) GetRegisterRequest() *shared.RegisterDomainRequest {
-  if !p.IsSetRegisterRequest() {
-    return WorkflowServiceRegisterDomainArgs_RegisterRequest_DEFAULT
+var WorkflowServiceSignalWorkflowExecutionArgs_SignalRequest_DEFAULT *shared.SignalWorkflowExecutionRequest
+func (p *WorkflowServiceSignalWorkflowExecutionArgs) GetSignalRequest() *shared.SignalWorkflowExecutionRequest {
+  if !p.IsSetSignalRequest() {
+    return WorkflowServiceSignalWorkflowExecutionArgs_SignalRequest_DEFAULT
   }
-return p.RegisterRequest
+return p.SignalRequest
 }
-func (p *WorkflowServiceRegisterDomainArgs) IsSetRegisterRequest() bool {
-  return p.RegisterRequest != nil
+func (p *WorkflowServiceSignalWorkflowExecutionArgs) IsSetSignalRequest() bool {
+  return p.SignalRequest != nil
 }
 
-func (p *WorkflowServiceRegisterDomainArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceSignalWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -2974,16 +9075,16 @@ func (p *WorkflowServiceRegisterDomainArgs) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *WorkflowServiceRegisterDomainArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.RegisterRequest = &shared.RegisterDomainRequest{}
-  if err := p.RegisterRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.RegisterRequest), err)
+func (p *WorkflowServiceSignalWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.SignalRequest = &shared.SignalWorkflowExecutionRequest{}
+  if err := p.SignalRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.SignalRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceRegisterDomainArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RegisterDomain_args"); err != nil {
+func (p *WorkflowServiceSignalWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("SignalWorkflowExecution_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -2995,72 +9096,72 @@ func (p *WorkflowServiceRegisterDomainArgs) Write(oprot thrift.TProtocol) error
   return nil
 }
 
-func (p *WorkflowServiceRegisterDomainArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("registerRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:registerRequest: ", p), err) }
-  if err := p.RegisterRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.RegisterRequest), err)
+func (p *WorkflowServiceSignalWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("signalRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:signalRequest: ", p), err) }
+  if err := p.SignalRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.SignalRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:registerRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:signalRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServiceRegisterDomainArgs) String() string {
+func (p *WorkflowServiceSignalWorkflowExecutionArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceRegisterDomainArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceSignalWorkflowExecutionArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - BadRequestError
 //  - InternalServiceError
-//  - DomainExistsError
-type WorkflowServiceRegisterDomainResult struct {
+//  - EntityNotExistError
+type WorkflowServiceSignalWorkflowExecutionResult struct {
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
-  DomainExistsError *shared.DomainAlreadyExistsError `thrift:"domainExistsError,3" db:"domainExistsError" json:"domainExistsError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
 }
 
-func NewWorkflowServiceRegisterDomainResult() *WorkflowServiceRegisterDomainResult {
-  return &WorkflowServiceRegisterDomainResult{}
+func NewWorkflowServiceSignalWorkflowExecutionResult() *WorkflowServiceSignalWorkflowExecutionResult {
+  return &WorkflowServiceSignalWorkflowExecutionResult{}
 }
 
-var WorkflowServiceRegisterDomainResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceRegisterDomainResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceSignalWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceSignalWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceRegisterDomainResult_BadRequestError_DEFAULT
+    return WorkflowServiceSignalWorkflowExecutionResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceRegisterDomainResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceRegisterDomainResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceSignalWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceSignalWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceRegisterDomainResult_InternalServiceError_DEFAULT
+    return WorkflowServiceSignalWorkflowExecutionResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceRegisterDomainResult_DomainExistsError_DEFAULT *shared.DomainAlreadyExistsError
-func (p *WorkflowServiceRegisterDomainResult) GetDomainExistsError() *shared.DomainAlreadyExistsError {
-  if !p.IsSetDomainExistsError() {
-    return WorkflowServiceRegisterDomainResult_DomainExistsError_DEFAULT
+var WorkflowServiceSignalWorkflowExecutionResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceSignalWorkflowExecutionResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return WorkflowServiceSignalWorkflowExecutionResult_EntityNotExistError_DEFAULT
   }
-return p.DomainExistsError
+return p.EntityNotExistError
 }
-func (p *WorkflowServiceRegisterDomainResult) IsSetBadRequestError() bool {
+func (p *WorkflowServiceSignalWorkflowExecutionResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceRegisterDomainResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceSignalWorkflowExecutionResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServiceRegisterDomainResult) IsSetDomainExistsError() bool {
-  return p.DomainExistsError != nil
+func (p *WorkflowServiceSignalWorkflowExecutionResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
 }
 
-func (p *WorkflowServiceRegisterDomainResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceSignalWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -3100,7 +9201,7 @@ func (p *WorkflowServiceRegisterDomainResult) Read(iprot thrift.TProtocol) error
   return nil
 }
 
-func (p *WorkflowServiceRegisterDomainResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceSignalWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -3108,7 +9209,7 @@ func (p *WorkflowServiceRegisterDomainResult)  ReadField1(iprot thrift.TProtocol
   return nil
 }
 
-func (p *WorkflowServiceRegisterDomainResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceSignalWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -3116,16 +9217,16 @@ func (p *WorkflowServiceRegisterDomainResult)  ReadField2(iprot thrift.TProtocol
   return nil
 }
 
-func (p *WorkflowServiceRegisterDomainResult)  ReadField3(iprot thrift.TProtocol) error {
-  p.DomainExistsError = &shared.DomainAlreadyExistsError{}
-  if err := p.DomainExistsError.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DomainExistsError), err)
+func (p *WorkflowServiceSignalWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceRegisterDomainResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RegisterDomain_result"); err != nil {
+func (p *WorkflowServiceSignalWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("SignalWorkflowExecution_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -3139,7 +9240,7 @@ func (p *WorkflowServiceRegisterDomainResult) Write(oprot thrift.TProtocol) erro
   return nil
 }
 
-func (p *WorkflowServiceRegisterDomainResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceSignalWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -3152,7 +9253,7 @@ func (p *WorkflowServiceRegisterDomainResult) writeField1(oprot thrift.TProtocol
   return err
 }
 
-func (p *WorkflowServiceRegisterDomainResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceSignalWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -3165,48 +9266,48 @@ func (p *WorkflowServiceRegisterDomainResult) writeField2(oprot thrift.TProtocol
   return err
 }
 
-func (p *WorkflowServiceRegisterDomainResult) writeField3(oprot thrift.TProtocol) (err error) {
-  if p.IsSetDomainExistsError() {
-    if err := oprot.WriteFieldBegin("domainExistsError", thrift.STRUCT, 3); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:domainExistsError: ", p), err) }
-    if err := p.DomainExistsError.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DomainExistsError), err)
+func (p *WorkflowServiceSignalWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
     }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:domainExistsError: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
   }
   return err
 }
 
-func (p *WorkflowServiceRegisterDomainResult) String() string {
+func (p *WorkflowServiceSignalWorkflowExecutionResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceRegisterDomainResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceSignalWorkflowExecutionResult(%+v)", *p)
 }
 
 // Attributes:
-//  - DescribeRequest
-type WorkflowServiceDescribeDomainArgs struct {
-  DescribeRequest *shared.DescribeDomainRequest `thrift:"describeRequest,1" db:"describeRequest" json:"describeRequest"`
+//  - TerminateRequest
+type WorkflowServiceTerminateWorkflowExecutionArgs struct {
+  TerminateRequest *shared.TerminateWorkflowExecutionRequest `thrift:"terminateRequest,1" db:"terminateRequest" json:"terminateRequest"`
 }
 
-func NewWorkflowServiceDescribeDomainArgs() *WorkflowServiceDescribeDomainArgs {
-  return &WorkflowServiceDescribeDomainArgs{}
+func NewWorkflowServiceTerminateWorkflowExecutionArgs() *WorkflowServiceTerminateWorkflowExecutionArgs {
+  return &WorkflowServiceTerminateWorkflowExecutionArgs{}
 }
 
-var WorkflowServiceDescribeDomainArgs_DescribeRequest_DEFAULT *shared.DescribeDomainRequest
-func (p *WorkflowServiceDescribeDomainArgs) GetDescribeRequest() *shared.DescribeDomainRequest {
-  if !p.IsSetDescribeRequest() {
-    return WorkflowServiceDescribeDomainArgs_DescribeRequest_DEFAULT
+var WorkflowServiceTerminateWorkflowExecutionArgs_TerminateRequest_DEFAULT *shared.TerminateWorkflowExecutionRequest
+func (p *WorkflowServiceTerminateWorkflowExecutionArgs) GetTerminateRequest() *shared.TerminateWorkflowExecutionRequest {
+  if !p.IsSetTerminateRequest() {
+    return WorkflowServiceTerminateWorkflowExecutionArgs_TerminateRequest_DEFAULT
   }
-return p.DescribeRequest
+return p.TerminateRequest
 }
-func (p *WorkflowServiceDescribeDomainArgs) IsSetDescribeRequest() bool {
-  return p.DescribeRequest != nil
+func (p *WorkflowServiceTerminateWorkflowExecutionArgs) IsSetTerminateRequest() bool {
+  return p.TerminateRequest != nil
 }
 
-func (p *WorkflowServiceDescribeDomainArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceTerminateWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -3238,16 +9339,16 @@ func (p *WorkflowServiceDescribeDomainArgs) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *WorkflowServiceDescribeDomainArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.DescribeRequest = &shared.DescribeDomainRequest{}
-  if err := p.DescribeRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DescribeRequest), err)
+func (p *WorkflowServiceTerminateWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.TerminateRequest = &shared.TerminateWorkflowExecutionRequest{}
+  if err := p.TerminateRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TerminateRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceDescribeDomainArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("DescribeDomain_args"); err != nil {
+func (p *WorkflowServiceTerminateWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("TerminateWorkflowExecution_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -3259,85 +9360,72 @@ func (p *WorkflowServiceDescribeDomainArgs) Write(oprot thrift.TProtocol) error
   return nil
 }
 
-func (p *WorkflowServiceDescribeDomainArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("describeRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:describeRequest: ", p), err) }
-  if err := p.DescribeRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DescribeRequest), err)
+func (p *WorkflowServiceTerminateWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("terminateRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:terminateRequest: ", p), err) }
+  if err := p.TerminateRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TerminateRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:describeRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:terminateRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServiceDescribeDomainArgs) String() string {
+func (p *WorkflowServiceTerminateWorkflowExecutionArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceDescribeDomainArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceTerminateWorkflowExecutionArgs(%+v)", *p)
 }
 
 // Attributes:
-//  - Success
 //  - BadRequestError
 //  - InternalServiceError
 //  - EntityNotExistError
-type WorkflowServiceDescribeDomainResult struct {
-  Success *shared.DescribeDomainResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+type WorkflowServiceTerminateWorkflowExecutionResult struct {
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
 }
 
-func NewWorkflowServiceDescribeDomainResult() *WorkflowServiceDescribeDomainResult {
-  return &WorkflowServiceDescribeDomainResult{}
+func NewWorkflowServiceTerminateWorkflowExecutionResult() *WorkflowServiceTerminateWorkflowExecutionResult {
+  return &WorkflowServiceTerminateWorkflowExecutionResult{}
 }
 
-var WorkflowServiceDescribeDomainResult_Success_DEFAULT *shared.DescribeDomainResponse
-func (p *WorkflowServiceDescribeDomainResult) GetSuccess() *shared.DescribeDomainResponse {
-  if !p.IsSetSuccess() {
-    return WorkflowServiceDescribeDomainResult_Success_DEFAULT
-  }
-return p.Success
-}
-var WorkflowServiceDescribeDomainResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceDescribeDomainResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceTerminateWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceTerminateWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceDescribeDomainResult_BadRequestError_DEFAULT
+    return WorkflowServiceTerminateWorkflowExecutionResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceDescribeDomainResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceDescribeDomainResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceTerminateWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceTerminateWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceDescribeDomainResult_InternalServiceError_DEFAULT
+    return WorkflowServiceTerminateWorkflowExecutionResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceDescribeDomainResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *WorkflowServiceDescribeDomainResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var WorkflowServiceTerminateWorkflowExecutionResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceTerminateWorkflowExecutionResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return WorkflowServiceDescribeDomainResult_EntityNotExistError_DEFAULT
+    return WorkflowServiceTerminateWorkflowExecutionResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-func (p *WorkflowServiceDescribeDomainResult) IsSetSuccess() bool {
-  return p.Success != nil
-}
-
-func (p *WorkflowServiceDescribeDomainResult) IsSetBadRequestError() bool {
+func (p *WorkflowServiceTerminateWorkflowExecutionResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceDescribeDomainResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceTerminateWorkflowExecutionResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServiceDescribeDomainResult) IsSetEntityNotExistError() bool {
+func (p *WorkflowServiceTerminateWorkflowExecutionResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *WorkflowServiceDescribeDomainResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceTerminateWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -3350,10 +9438,6 @@ func (p *WorkflowServiceDescribeDomainResult) Read(iprot thrift.TProtocol) error
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
-    case 0:
-      if err := p.ReadField0(iprot); err != nil {
-        return err
-      }
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -3381,15 +9465,7 @@ func (p *WorkflowServiceDescribeDomainResult) Read(iprot thrift.TProtocol) error
   return nil
 }
 
-func (p *WorkflowServiceDescribeDomainResult)  ReadField0(iprot thrift.TProtocol) error {
-  p.Success = &shared.DescribeDomainResponse{}
-  if err := p.Success.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
-  }
-  return nil
-}
-
-func (p *WorkflowServiceDescribeDomainResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceTerminateWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -3397,7 +9473,7 @@ func (p *WorkflowServiceDescribeDomainResult)  ReadField1(iprot thrift.TProtocol
   return nil
 }
 
-func (p *WorkflowServiceDescribeDomainResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceTerminateWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -3405,7 +9481,7 @@ func (p *WorkflowServiceDescribeDomainResult)  ReadField2(iprot thrift.TProtocol
   return nil
 }
 
-func (p *WorkflowServiceDescribeDomainResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceTerminateWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -3413,11 +9489,10 @@ func (p *WorkflowServiceDescribeDomainResult)  ReadField3(iprot thrift.TProtocol
   return nil
 }
 
-func (p *WorkflowServiceDescribeDomainResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("DescribeDomain_result"); err != nil {
+func (p *WorkflowServiceTerminateWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("TerminateWorkflowExecution_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
-    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
@@ -3429,20 +9504,7 @@ func (p *WorkflowServiceDescribeDomainResult) Write(oprot thrift.TProtocol) erro
   return nil
 }
 
-func (p *WorkflowServiceDescribeDomainResult) writeField0(oprot thrift.TProtocol) (err error) {
-  if p.IsSetSuccess() {
-    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
-    if err := p.Success.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
-  }
-  return err
-}
-
-func (p *WorkflowServiceDescribeDomainResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceTerminateWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -3455,7 +9517,7 @@ func (p *WorkflowServiceDescribeDomainResult) writeField1(oprot thrift.TProtocol
   return err
 }
 
-func (p *WorkflowServiceDescribeDomainResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceTerminateWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -3468,7 +9530,7 @@ func (p *WorkflowServiceDescribeDomainResult) writeField2(oprot thrift.TProtocol
   return err
 }
 
-func (p *WorkflowServiceDescribeDomainResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceTerminateWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -3481,35 +9543,35 @@ func (p *WorkflowServiceDescribeDomainResult) writeField3(oprot thrift.TProtocol
   return err
 }
 
-func (p *WorkflowServiceDescribeDomainResult) String() string {
+func (p *WorkflowServiceTerminateWorkflowExecutionResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceDescribeDomainResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceTerminateWorkflowExecutionResult(%+v)", *p)
 }
 
 // Attributes:
-//  - UpdateRequest
-type WorkflowServiceUpdateDomainArgs struct {
-  UpdateRequest *shared.UpdateDomainRequest `thrift:"updateRequest,1" db:"updateRequest" json:"updateRequest"`
+//  - ListRequest
+type WorkflowServiceListOpenWorkflowExecutionsArgs struct {
+  ListRequest *shared.ListOpenWorkflowExecutionsRequest `thrift:"listRequest,1" db:"listRequest" json:"listRequest"`
 }
 
-func NewWorkflowServiceUpdateDomainArgs() *WorkflowServiceUpdateDomainArgs {
-  return &WorkflowServiceUpdateDomainArgs{}
+func NewWorkflowServiceListOpenWorkflowExecutionsArgs() *WorkflowServiceListOpenWorkflowExecutionsArgs {
+  return &WorkflowServiceListOpenWorkflowExecutionsArgs{}
 }
 
-var WorkflowServiceUpdateDomainArgs_UpdateRequest_DEFAULT *shared.UpdateDomainRequest
-func (p *WorkflowServiceUpdateDomainArgs) GetUpdateRequest() *shared.UpdateDomainRequest {
-  if !p.IsSetUpdateRequest() {
-    return WorkflowServiceUpdateDomainArgs_UpdateRequest_DEFAULT
+var WorkflowServiceListOpenWorkflowExecutionsArgs_ListRequest_DEFAULT *shared.ListOpenWorkflowExecutionsRequest
+func (p *WorkflowServiceListOpenWorkflowExecutionsArgs) GetListRequest() *shared.ListOpenWorkflowExecutionsRequest {
+  if !p.IsSetListRequest() {
+    return WorkflowServiceListOpenWorkflowExecutionsArgs_ListRequest_DEFAULT
   }
-return p.UpdateRequest
+return p.ListRequest
 }
-func (p *WorkflowServiceUpdateDomainArgs) IsSetUpdateRequest() bool {
-  return p.UpdateRequest != nil
+func (p *WorkflowServiceListOpenWorkflowExecutionsArgs) IsSetListRequest() bool {
+  return p.ListRequest != nil
 }
 
-func (p *WorkflowServiceUpdateDomainArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceListOpenWorkflowExecutionsArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -3541,16 +9603,16 @@ func (p *WorkflowServiceUpdateDomainArgs) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *WorkflowServiceUpdateDomainArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.UpdateRequest = &shared.UpdateDomainRequest{}
-  if err := p.UpdateRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.UpdateRequest), err)
+func (p *WorkflowServiceListOpenWorkflowExecutionsArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.ListRequest = &shared.ListOpenWorkflowExecutionsRequest{}
+  if err := p.ListRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ListRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceUpdateDomainArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("UpdateDomain_args"); err != nil {
+func (p *WorkflowServiceListOpenWorkflowExecutionsArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ListOpenWorkflowExecutions_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -3562,22 +9624,22 @@ func (p *WorkflowServiceUpdateDomainArgs) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *WorkflowServiceUpdateDomainArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("updateRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:updateRequest: ", p), err) }
-  if err := p.UpdateRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.UpdateRequest), err)
+func (p *WorkflowServiceListOpenWorkflowExecutionsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("listRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:listRequest: ", p), err) }
+  if err := p.ListRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ListRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:updateRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:listRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServiceUpdateDomainArgs) String() string {
+func (p *WorkflowServiceListOpenWorkflowExecutionsArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceUpdateDomainArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceListOpenWorkflowExecutionsArgs(%+v)", *p)
 }
 
 // Attributes:
@@ -3585,62 +9647,62 @@ func (p *WorkflowServiceUpdateDomainArgs) String() string {
 //  - BadRequestError
 //  - InternalServiceError
 //  - EntityNotExistError
-type WorkflowServiceUpdateDomainResult struct {
-  Success *shared.UpdateDomainResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+type WorkflowServiceListOpenWorkflowExecutionsResult struct {
+  Success *shared.ListOpenWorkflowExecutionsResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
 }
 
-func NewWorkflowServiceUpdateDomainResult() *WorkflowServiceUpdateDomainResult {
-  return &WorkflowServiceUpdateDomainResult{}
+func NewWorkflowServiceListOpenWorkflowExecutionsResult() *WorkflowServiceListOpenWorkflowExecutionsResult {
+  return &WorkflowServiceListOpenWorkflowExecutionsResult{}
 }
 
-var WorkflowServiceUpdateDomainResult_Success_DEFAULT *shared.UpdateDomainResponse
-func (p *WorkflowServiceUpdateDomainResult) GetSuccess() *shared.UpdateDomainResponse {
+var WorkflowServiceListOpenWorkflowExecutionsResult_Success_DEFAULT *shared.ListOpenWorkflowExecutionsResponse
+func (p *WorkflowServiceListOpenWorkflowExecutionsResult) GetSuccess() *shared.ListOpenWorkflowExecutionsResponse {
   if !p.IsSetSuccess() {
-    return WorkflowServiceUpdateDomainResult_Success_DEFAULT
+    return WorkflowServiceListOpenWorkflowExecutionsResult_Success_DEFAULT
   }
 return p.Success
 }
-var WorkflowServiceUpdateDomainResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceUpdateDomainResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceListOpenWorkflowExecutionsResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceListOpenWorkflowExecutionsResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceUpdateDomainResult_BadRequestError_DEFAULT
+    return WorkflowServiceListOpenWorkflowExecutionsResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceUpdateDomainResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceUpdateDomainResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceListOpenWorkflowExecutionsResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceListOpenWorkflowExecutionsResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceUpdateDomainResult_InternalServiceError_DEFAULT
+    return WorkflowServiceListOpenWorkflowExecutionsResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceUpdateDomainResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *WorkflowServiceUpdateDomainResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var WorkflowServiceListOpenWorkflowExecutionsResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceListOpenWorkflowExecutionsResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return WorkflowServiceUpdateDomainResult_EntityNotExistError_DEFAULT
+    return WorkflowServiceListOpenWorkflowExecutionsResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-func (p *WorkflowServiceUpdateDomainResult) IsSetSuccess() bool {
+func (p *WorkflowServiceListOpenWorkflowExecutionsResult) IsSetSuccess() bool {
   return p.Success != nil
 }
 
-func (p *WorkflowServiceUpdateDomainResult) IsSetBadRequestError() bool {
+func (p *WorkflowServiceListOpenWorkflowExecutionsResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceUpdateDomainResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceListOpenWorkflowExecutionsResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServiceUpdateDomainResult) IsSetEntityNotExistError() bool {
+func (p *WorkflowServiceListOpenWorkflowExecutionsResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *WorkflowServiceUpdateDomainResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceListOpenWorkflowExecutionsResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -3684,15 +9746,15 @@ func (p *WorkflowServiceUpdateDomainResult) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *WorkflowServiceUpdateDomainResult)  ReadField0(iprot thrift.TProtocol) error {
-  p.Success = &shared.UpdateDomainResponse{}
+func (p *WorkflowServiceListOpenWorkflowExecutionsResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.ListOpenWorkflowExecutionsResponse{}
   if err := p.Success.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceUpdateDomainResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceListOpenWorkflowExecutionsResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -3700,7 +9762,7 @@ func (p *WorkflowServiceUpdateDomainResult)  ReadField1(iprot thrift.TProtocol)
   return nil
 }
 
-func (p *WorkflowServiceUpdateDomainResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceListOpenWorkflowExecutionsResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -3708,7 +9770,7 @@ func (p *WorkflowServiceUpdateDomainResult)  ReadField2(iprot thrift.TProtocol)
   return nil
 }
 
-func (p *WorkflowServiceUpdateDomainResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceListOpenWorkflowExecutionsResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -3716,8 +9778,8 @@ func (p *WorkflowServiceUpdateDomainResult)  ReadField3(iprot thrift.TProtocol)
   return nil
 }
 
-func (p *WorkflowServiceUpdateDomainResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("UpdateDomain_result"); err != nil {
+func (p *WorkflowServiceListOpenWorkflowExecutionsResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ListOpenWorkflowExecutions_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField0(oprot); err != nil { return err }
@@ -3732,7 +9794,7 @@ func (p *WorkflowServiceUpdateDomainResult) Write(oprot thrift.TProtocol) error
   return nil
 }
 
-func (p *WorkflowServiceUpdateDomainResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceListOpenWorkflowExecutionsResult) writeField0(oprot thrift.TProtocol) (err error) {
   if p.IsSetSuccess() {
     if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
@@ -3745,7 +9807,7 @@ func (p *WorkflowServiceUpdateDomainResult) writeField0(oprot thrift.TProtocol)
   return err
 }
 
-func (p *WorkflowServiceUpdateDomainResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceListOpenWorkflowExecutionsResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -3758,7 +9820,7 @@ func (p *WorkflowServiceUpdateDomainResult) writeField1(oprot thrift.TProtocol)
   return err
 }
 
-func (p *WorkflowServiceUpdateDomainResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceListOpenWorkflowExecutionsResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -3771,7 +9833,7 @@ func (p *WorkflowServiceUpdateDomainResult) writeField2(oprot thrift.TProtocol)
   return err
 }
 
-func (p *WorkflowServiceUpdateDomainResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceListOpenWorkflowExecutionsResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -3784,35 +9846,35 @@ func (p *WorkflowServiceUpdateDomainResult) writeField3(oprot thrift.TProtocol)
   return err
 }
 
-func (p *WorkflowServiceUpdateDomainResult) String() string {
+func (p *WorkflowServiceListOpenWorkflowExecutionsResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceUpdateDomainResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceListOpenWorkflowExecutionsResult(%+v)", *p)
 }
 
 // Attributes:
-//  - DeprecateRequest
-type WorkflowServiceDeprecateDomainArgs struct {
-  DeprecateRequest *shared.DeprecateDomainRequest `thrift:"deprecateRequest,1" db:"deprecateRequest" json:"deprecateRequest"`
+//  - ListRequest
+type WorkflowServiceListClosedWorkflowExecutionsArgs struct {
+  ListRequest *shared.ListClosedWorkflowExecutionsRequest `thrift:"listRequest,1" db:"listRequest" json:"listRequest"`
 }
 
-func NewWorkflowServiceDeprecateDomainArgs() *WorkflowServiceDeprecateDomainArgs {
-  return &WorkflowServiceDeprecateDomainArgs{}
+func NewWorkflowServiceListClosedWorkflowExecutionsArgs() *WorkflowServiceListClosedWorkflowExecutionsArgs {
+  return &WorkflowServiceListClosedWorkflowExecutionsArgs{}
 }
 
-var WorkflowServiceDeprecateDomainArgs_DeprecateRequest_DEFAULT *shared.DeprecateDomainRequest
-func (p *WorkflowServiceDeprecateDomainArgs) GetDeprecateRequest() *shared.DeprecateDomainRequest {
-  if !p.IsSetDeprecateRequest() {
-    return WorkflowServiceDeprecateDomainArgs_DeprecateRequest_DEFAULT
+var WorkflowServiceListClosedWorkflowExecutionsArgs_ListRequest_DEFAULT *shared.ListClosedWorkflowExecutionsRequest
+func (p *WorkflowServiceListClosedWorkflowExecutionsArgs) GetListRequest() *shared.ListClosedWorkflowExecutionsRequest {
+  if !p.IsSetListRequest() {
+    return WorkflowServiceListClosedWorkflowExecutionsArgs_ListRequest_DEFAULT
   }
-return p.DeprecateRequest
+return p.ListRequest
 }
-func (p *WorkflowServiceDeprecateDomainArgs) IsSetDeprecateRequest() bool {
-  return p.DeprecateRequest != nil
+func (p *WorkflowServiceListClosedWorkflowExecutionsArgs) IsSetListRequest() bool {
+  return p.ListRequest != nil
 }
 
-func (p *WorkflowServiceDeprecateDomainArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceListClosedWorkflowExecutionsArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -3844,16 +9906,16 @@ func (p *WorkflowServiceDeprecateDomainArgs) Read(iprot thrift.TProtocol) error
   return nil
 }
 
-func (p *WorkflowServiceDeprecateDomainArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.DeprecateRequest = &shared.DeprecateDomainRequest{}
-  if err := p.DeprecateRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DeprecateRequest), err)
+func (p *WorkflowServiceListClosedWorkflowExecutionsArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.ListRequest = &shared.ListClosedWorkflowExecutionsRequest{}
+  if err := p.ListRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ListRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceDeprecateDomainArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("DeprecateDomain_args"); err != nil {
+func (p *WorkflowServiceListClosedWorkflowExecutionsArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ListClosedWorkflowExecutions_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -3865,72 +9927,85 @@ func (p *WorkflowServiceDeprecateDomainArgs) Write(oprot thrift.TProtocol) error
   return nil
 }
 
-func (p *WorkflowServiceDeprecateDomainArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("deprecateRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:deprecateRequest: ", p), err) }
-  if err := p.DeprecateRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DeprecateRequest), err)
+func (p *WorkflowServiceListClosedWorkflowExecutionsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("listRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:listRequest: ", p), err) }
+  if err := p.ListRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ListRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:deprecateRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:listRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServiceDeprecateDomainArgs) String() string {
+func (p *WorkflowServiceListClosedWorkflowExecutionsArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceDeprecateDomainArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceListClosedWorkflowExecutionsArgs(%+v)", *p)
 }
 
 // Attributes:
+//  - Success
 //  - BadRequestError
 //  - InternalServiceError
 //  - EntityNotExistError
-type WorkflowServiceDeprecateDomainResult struct {
+type WorkflowServiceListClosedWorkflowExecutionsResult struct {
+  Success *shared.ListClosedWorkflowExecutionsResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
 }
 
-func NewWorkflowServiceDeprecateDomainResult() *WorkflowServiceDeprecateDomainResult {
-  return &WorkflowServiceDeprecateDomainResult{}
+func NewWorkflowServiceListClosedWorkflowExecutionsResult() *WorkflowServiceListClosedWorkflowExecutionsResult {
+  return &WorkflowServiceListClosedWorkflowExecutionsResult{}
 }
 
-var WorkflowServiceDeprecateDomainResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceDeprecateDomainResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceListClosedWorkflowExecutionsResult_Success_DEFAULT *shared.ListClosedWorkflowExecutionsResponse
+func (p *WorkflowServiceListClosedWorkflowExecutionsResult) GetSuccess() *shared.ListClosedWorkflowExecutionsResponse {
+  if !p.IsSetSuccess() {
+    return WorkflowServiceListClosedWorkflowExecutionsResult_Success_DEFAULT
+  }
+return p.Success
+}
+var WorkflowServiceListClosedWorkflowExecutionsResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceListClosedWorkflowExecutionsResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceDeprecateDomainResult_BadRequestError_DEFAULT
+    return WorkflowServiceListClosedWorkflowExecutionsResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceDeprecateDomainResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceDeprecateDomainResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceListClosedWorkflowExecutionsResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceListClosedWorkflowExecutionsResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceDeprecateDomainResult_InternalServiceError_DEFAULT
+    return WorkflowServiceListClosedWorkflowExecutionsResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceDeprecateDomainResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *WorkflowServiceDeprecateDomainResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var WorkflowServiceListClosedWorkflowExecutionsResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceListClosedWorkflowExecutionsResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return WorkflowServiceDeprecateDomainResult_EntityNotExistError_DEFAULT
+    return WorkflowServiceListClosedWorkflowExecutionsResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-func (p *WorkflowServiceDeprecateDomainResult) IsSetBadRequestError() bool {
+func (p *WorkflowServiceListClosedWorkflowExecutionsResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *WorkflowServiceListClosedWorkflowExecutionsResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceDeprecateDomainResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceListClosedWorkflowExecutionsResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServiceDeprecateDomainResult) IsSetEntityNotExistError() bool {
+func (p *WorkflowServiceListClosedWorkflowExecutionsResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *WorkflowServiceDeprecateDomainResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceListClosedWorkflowExecutionsResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -3943,6 +10018,10 @@ func (p *WorkflowServiceDeprecateDomainResult) Read(iprot thrift.TProtocol) erro
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -3970,7 +10049,15 @@ func (p *WorkflowServiceDeprecateDomainResult) Read(iprot thrift.TProtocol) erro
   return nil
 }
 
-func (p *WorkflowServiceDeprecateDomainResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceListClosedWorkflowExecutionsResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.ListClosedWorkflowExecutionsResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceListClosedWorkflowExecutionsResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -3978,7 +10065,7 @@ func (p *WorkflowServiceDeprecateDomainResult)  ReadField1(iprot thrift.TProtoco
   return nil
 }
 
-func (p *WorkflowServiceDeprecateDomainResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceListClosedWorkflowExecutionsResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -3986,7 +10073,7 @@ func (p *WorkflowServiceDeprecateDomainResult)  ReadField2(iprot thrift.TProtoco
   return nil
 }
 
-func (p *WorkflowServiceDeprecateDomainResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceListClosedWorkflowExecutionsResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -3994,10 +10081,11 @@ func (p *WorkflowServiceDeprecateDomainResult)  ReadField3(iprot thrift.TProtoco
   return nil
 }
 
-func (p *WorkflowServiceDeprecateDomainResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("DeprecateDomain_result"); err != nil {
+func (p *WorkflowServiceListClosedWorkflowExecutionsResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ListClosedWorkflowExecutions_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
@@ -4009,7 +10097,20 @@ func (p *WorkflowServiceDeprecateDomainResult) Write(oprot thrift.TProtocol) err
   return nil
 }
 
-func (p *WorkflowServiceDeprecateDomainResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceListClosedWorkflowExecutionsResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceListClosedWorkflowExecutionsResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -4022,7 +10123,7 @@ func (p *WorkflowServiceDeprecateDomainResult) writeField1(oprot thrift.TProtoco
   return err
 }
 
-func (p *WorkflowServiceDeprecateDomainResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceListClosedWorkflowExecutionsResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -4035,7 +10136,7 @@ func (p *WorkflowServiceDeprecateDomainResult) writeField2(oprot thrift.TProtoco
   return err
 }
 
-func (p *WorkflowServiceDeprecateDomainResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceListClosedWorkflowExecutionsResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -4048,35 +10149,37 @@ func (p *WorkflowServiceDeprecateDomainResult) writeField3(oprot thrift.TProtoco
   return err
 }
 
-func (p *WorkflowServiceDeprecateDomainResult) String() string {
+func (p *WorkflowServiceListClosedWorkflowExecutionsResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceDeprecateDomainResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceListClosedWorkflowExecutionsResult(%+v)", *p)
 }
 
+
+
 // Attributes:
-//  - StartRequest
-type WorkflowServiceStartWorkflowExecutionArgs struct {
-  StartRequest *shared.StartWorkflowExecutionRequest `thrift:"startRequest,1" db:"startRequest" json:"startRequest"`
+//  - ListRequest
+type WorkflowServiceScanWorkflowExecutionsArgs struct {
+  ListRequest *shared.ScanWorkflowExecutionsRequest `thrift:"listRequest,1" db:"listRequest" json:"listRequest"`
 }
 
-func NewWorkflowServiceStartWorkflowExecutionArgs() *WorkflowServiceStartWorkflowExecutionArgs {
-  return &WorkflowServiceStartWorkflowExecutionArgs{}
+func NewWorkflowServiceScanWorkflowExecutionsArgs() *WorkflowServiceScanWorkflowExecutionsArgs {
+  return &WorkflowServiceScanWorkflowExecutionsArgs{}
 }
 
-var WorkflowServiceStartWorkflowExecutionArgs_StartRequest_DEFAULT *shared.StartWorkflowExecutionRequest
-func (p *WorkflowServiceStartWorkflowExecutionArgs) GetStartRequest() *shared.StartWorkflowExecutionRequest {
-  if !p.IsSetStartRequest() {
-    return WorkflowServiceStartWorkflowExecutionArgs_StartRequest_DEFAULT
+var WorkflowServiceScanWorkflowExecutionsArgs_ListRequest_DEFAULT *shared.ScanWorkflowExecutionsRequest
+func (p *WorkflowServiceScanWorkflowExecutionsArgs) GetListRequest() *shared.ScanWorkflowExecutionsRequest {
+  if !p.IsSetListRequest() {
+    return WorkflowServiceScanWorkflowExecutionsArgs_ListRequest_DEFAULT
   }
-return p.StartRequest
+return p.ListRequest
 }
-func (p *WorkflowServiceStartWorkflowExecutionArgs) IsSetStartRequest() bool {
-  return p.StartRequest != nil
+func (p *WorkflowServiceScanWorkflowExecutionsArgs) IsSetListRequest() bool {
+  return p.ListRequest != nil
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceScanWorkflowExecutionsArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -4108,16 +10211,16 @@ func (p *WorkflowServiceStartWorkflowExecutionArgs) Read(iprot thrift.TProtocol)
   return nil
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.StartRequest = &shared.StartWorkflowExecutionRequest{}
-  if err := p.StartRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.StartRequest), err)
+func (p *WorkflowServiceScanWorkflowExecutionsArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.ListRequest = &shared.ScanWorkflowExecutionsRequest{}
+  if err := p.ListRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ListRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("StartWorkflowExecution_args"); err != nil {
+func (p *WorkflowServiceScanWorkflowExecutionsArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ScanWorkflowExecutions_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -4129,85 +10232,85 @@ func (p *WorkflowServiceStartWorkflowExecutionArgs) Write(oprot thrift.TProtocol
   return nil
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("startRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:startRequest: ", p), err) }
-  if err := p.StartRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.StartRequest), err)
+func (p *WorkflowServiceScanWorkflowExecutionsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("listRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:listRequest: ", p), err) }
+  if err := p.ListRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ListRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:startRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:listRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionArgs) String() string {
+func (p *WorkflowServiceScanWorkflowExecutionsArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceStartWorkflowExecutionArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceScanWorkflowExecutionsArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Success
 //  - BadRequestError
 //  - InternalServiceError
-//  - SessionAlreadyExistError
-type WorkflowServiceStartWorkflowExecutionResult struct {
-  Success *shared.StartWorkflowExecutionResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+//  - EntityNotExistError
+type WorkflowServiceScanWorkflowExecutionsResult struct {
+  Success *shared.ScanWorkflowExecutionsResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
-  SessionAlreadyExistError *shared.WorkflowExecutionAlreadyStartedError `thrift:"sessionAlreadyExistError,3" db:"sessionAlreadyExistError" json:"sessionAlreadyExistError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
 }
 
-func NewWorkflowServiceStartWorkflowExecutionResult() *WorkflowServiceStartWorkflowExecutionResult {
-  return &WorkflowServiceStartWorkflowExecutionResult{}
+func NewWorkflowServiceScanWorkflowExecutionsResult() *WorkflowServiceScanWorkflowExecutionsResult {
+  return &WorkflowServiceScanWorkflowExecutionsResult{}
 }
 
-var WorkflowServiceStartWorkflowExecutionResult_Success_DEFAULT *shared.StartWorkflowExecutionResponse
-func (p *WorkflowServiceStartWorkflowExecutionResult) GetSuccess() *shared.StartWorkflowExecutionResponse {
+var WorkflowServiceScanWorkflowExecutionsResult_Success_DEFAULT *shared.ScanWorkflowExecutionsResponse
+func (p *WorkflowServiceScanWorkflowExecutionsResult) GetSuccess() *shared.ScanWorkflowExecutionsResponse {
   if !p.IsSetSuccess() {
-    return WorkflowServiceStartWorkflowExecutionResult_Success_DEFAULT
+    return WorkflowServiceScanWorkflowExecutionsResult_Success_DEFAULT
   }
 return p.Success
 }
-var WorkflowServiceStartWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceStartWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceScanWorkflowExecutionsResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceScanWorkflowExecutionsResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceStartWorkflowExecutionResult_BadRequestError_DEFAULT
+    return WorkflowServiceScanWorkflowExecutionsResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceStartWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceStartWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceScanWorkflowExecutionsResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceScanWorkflowExecutionsResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceStartWorkflowExecutionResult_InternalServiceError_DEFAULT
+    return WorkflowServiceScanWorkflowExecutionsResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceStartWorkflowExecutionResult_SessionAlreadyExistError_DEFAULT *shared.WorkflowExecutionAlreadyStartedError
-func (p *WorkflowServiceStartWorkflowExecutionResult) GetSessionAlreadyExistError() *shared.WorkflowExecutionAlreadyStartedError {
-  if !p.IsSetSessionAlreadyExistError() {
-    return WorkflowServiceStartWorkflowExecutionResult_SessionAlreadyExistError_DEFAULT
+var WorkflowServiceScanWorkflowExecutionsResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceScanWorkflowExecutionsResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return WorkflowServiceScanWorkflowExecutionsResult_EntityNotExistError_DEFAULT
   }
-return p.SessionAlreadyExistError
+return p.EntityNotExistError
 }
-func (p *WorkflowServiceStartWorkflowExecutionResult) IsSetSuccess() bool {
+func (p *WorkflowServiceScanWorkflowExecutionsResult) IsSetSuccess() bool {
   return p.Success != nil
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionResult) IsSetBadRequestError() bool {
+func (p *WorkflowServiceScanWorkflowExecutionsResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceScanWorkflowExecutionsResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionResult) IsSetSessionAlreadyExistError() bool {
-  return p.SessionAlreadyExistError != nil
+func (p *WorkflowServiceScanWorkflowExecutionsResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceScanWorkflowExecutionsResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -4251,15 +10354,15 @@ func (p *WorkflowServiceStartWorkflowExecutionResult) Read(iprot thrift.TProtoco
   return nil
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionResult)  ReadField0(iprot thrift.TProtocol) error {
-  p.Success = &shared.StartWorkflowExecutionResponse{}
+func (p *WorkflowServiceScanWorkflowExecutionsResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.ScanWorkflowExecutionsResponse{}
   if err := p.Success.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceScanWorkflowExecutionsResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -4267,7 +10370,7 @@ func (p *WorkflowServiceStartWorkflowExecutionResult)  ReadField1(iprot thrift.T
   return nil
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceScanWorkflowExecutionsResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -4275,16 +10378,16 @@ func (p *WorkflowServiceStartWorkflowExecutionResult)  ReadField2(iprot thrift.T
   return nil
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
-  p.SessionAlreadyExistError = &shared.WorkflowExecutionAlreadyStartedError{}
-  if err := p.SessionAlreadyExistError.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.SessionAlreadyExistError), err)
+func (p *WorkflowServiceScanWorkflowExecutionsResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("StartWorkflowExecution_result"); err != nil {
+func (p *WorkflowServiceScanWorkflowExecutionsResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ScanWorkflowExecutions_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField0(oprot); err != nil { return err }
@@ -4299,7 +10402,7 @@ func (p *WorkflowServiceStartWorkflowExecutionResult) Write(oprot thrift.TProtoc
   return nil
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceScanWorkflowExecutionsResult) writeField0(oprot thrift.TProtocol) (err error) {
   if p.IsSetSuccess() {
     if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
@@ -4312,7 +10415,7 @@ func (p *WorkflowServiceStartWorkflowExecutionResult) writeField0(oprot thrift.T
   return err
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceScanWorkflowExecutionsResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -4325,7 +10428,7 @@ func (p *WorkflowServiceStartWorkflowExecutionResult) writeField1(oprot thrift.T
   return err
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceScanWorkflowExecutionsResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -4338,48 +10441,50 @@ func (p *WorkflowServiceStartWorkflowExecutionResult) writeField2(oprot thrift.T
   return err
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
-  if p.IsSetSessionAlreadyExistError() {
-    if err := oprot.WriteFieldBegin("sessionAlreadyExistError", thrift.STRUCT, 3); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:sessionAlreadyExistError: ", p), err) }
-    if err := p.SessionAlreadyExistError.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.SessionAlreadyExistError), err)
+func (p *WorkflowServiceScanWorkflowExecutionsResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
     }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:sessionAlreadyExistError: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
   }
   return err
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionResult) String() string {
+func (p *WorkflowServiceScanWorkflowExecutionsResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceStartWorkflowExecutionResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceScanWorkflowExecutionsResult(%+v)", *p)
 }
 
+
+
 // Attributes:
 //  - GetRequest
-type WorkflowServiceGetWorkflowExecutionHistoryArgs struct {
-  GetRequest *shared.GetWorkflowExecutionHistoryRequest `thrift:"getRequest,1" db:"getRequest" json:"getRequest"`
+type WorkflowServiceGetDomainReplicationMessagesArgs struct {
+  GetRequest *shared.GetDomainReplicationMessagesRequest `thrift:"getRequest,1" db:"getRequest" json:"getRequest"`
 }
 
-func NewWorkflowServiceGetWorkflowExecutionHistoryArgs() *WorkflowServiceGetWorkflowExecutionHistoryArgs {
-  return &WorkflowServiceGetWorkflowExecutionHistoryArgs{}
+func NewWorkflowServiceGetDomainReplicationMessagesArgs() *WorkflowServiceGetDomainReplicationMessagesArgs {
+  return &WorkflowServiceGetDomainReplicationMessagesArgs{}
 }
 
-var WorkflowServiceGetWorkflowExecutionHistoryArgs_GetRequest_DEFAULT *shared.GetWorkflowExecutionHistoryRequest
-func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) GetGetRequest() *shared.GetWorkflowExecutionHistoryRequest {
+var WorkflowServiceGetDomainReplicationMessagesArgs_GetRequest_DEFAULT *shared.GetDomainReplicationMessagesRequest
+func (p *WorkflowServiceGetDomainReplicationMessagesArgs) GetGetRequest() *shared.GetDomainReplicationMessagesRequest {
   if !p.IsSetGetRequest() {
-    return WorkflowServiceGetWorkflowExecutionHistoryArgs_GetRequest_DEFAULT
+    return WorkflowServiceGetDomainReplicationMessagesArgs_GetRequest_DEFAULT
   }
 return p.GetRequest
 }
-func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) IsSetGetRequest() bool {
+func (p *WorkflowServiceGetDomainReplicationMessagesArgs) IsSetGetRequest() bool {
   return p.GetRequest != nil
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceGetDomainReplicationMessagesArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -4411,16 +10516,16 @@ func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) Read(iprot thrift.TProt
   return nil
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.GetRequest = &shared.GetWorkflowExecutionHistoryRequest{}
+func (p *WorkflowServiceGetDomainReplicationMessagesArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.GetRequest = &shared.GetDomainReplicationMessagesRequest{}
   if err := p.GetRequest.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.GetRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("GetWorkflowExecutionHistory_args"); err != nil {
+func (p *WorkflowServiceGetDomainReplicationMessagesArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetDomainReplicationMessages_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -4432,7 +10537,7 @@ func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) Write(oprot thrift.TPro
   return nil
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceGetDomainReplicationMessagesArgs) writeField1(oprot thrift.TProtocol) (err error) {
   if err := oprot.WriteFieldBegin("getRequest", thrift.STRUCT, 1); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:getRequest: ", p), err) }
   if err := p.GetRequest.Write(oprot); err != nil {
@@ -4443,11 +10548,11 @@ func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) writeField1(oprot thrif
   return err
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) String() string {
+func (p *WorkflowServiceGetDomainReplicationMessagesArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceGetWorkflowExecutionHistoryArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceGetDomainReplicationMessagesArgs(%+v)", *p)
 }
 
 // Attributes:
@@ -4455,62 +10560,62 @@ func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) String() string {
 //  - BadRequestError
 //  - InternalServiceError
 //  - EntityNotExistError
-type WorkflowServiceGetWorkflowExecutionHistoryResult struct {
-  Success *shared.GetWorkflowExecutionHistoryResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+type WorkflowServiceGetDomainReplicationMessagesResult struct {
+  Success *shared.GetDomainReplicationMessagesResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
 }
 
-func NewWorkflowServiceGetWorkflowExecutionHistoryResult() *WorkflowServiceGetWorkflowExecutionHistoryResult {
-  return &WorkflowServiceGetWorkflowExecutionHistoryResult{}
+func NewWorkflowServiceGetDomainReplicationMessagesResult() *WorkflowServiceGetDomainReplicationMessagesResult {
+  return &WorkflowServiceGetDomainReplicationMessagesResult{}
 }
 
-var WorkflowServiceGetWorkflowExecutionHistoryResult_Success_DEFAULT *shared.GetWorkflowExecutionHistoryResponse
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) GetSuccess() *shared.GetWorkflowExecutionHistoryResponse {
+var WorkflowServiceGetDomainReplicationMessagesResult_Success_DEFAULT *shared.GetDomainReplicationMessagesResponse
+func (p *WorkflowServiceGetDomainReplicationMessagesResult) GetSuccess() *shared.GetDomainReplicationMessagesResponse {
   if !p.IsSetSuccess() {
-    return WorkflowServiceGetWorkflowExecutionHistoryResult_Success_DEFAULT
+    return WorkflowServiceGetDomainReplicationMessagesResult_Success_DEFAULT
   }
 return p.Success
 }
-var WorkflowServiceGetWorkflowExecutionHistoryResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceGetDomainReplicationMessagesResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceGetDomainReplicationMessagesResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceGetWorkflowExecutionHistoryResult_BadRequestError_DEFAULT
+    return WorkflowServiceGetDomainReplicationMessagesResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceGetWorkflowExecutionHistoryResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceGetDomainReplicationMessagesResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceGetDomainReplicationMessagesResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceGetWorkflowExecutionHistoryResult_InternalServiceError_DEFAULT
+    return WorkflowServiceGetDomainReplicationMessagesResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceGetWorkflowExecutionHistoryResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var WorkflowServiceGetDomainReplicationMessagesResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceGetDomainReplicationMessagesResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return WorkflowServiceGetWorkflowExecutionHistoryResult_EntityNotExistError_DEFAULT
+    return WorkflowServiceGetDomainReplicationMessagesResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) IsSetSuccess() bool {
+func (p *WorkflowServiceGetDomainReplicationMessagesResult) IsSetSuccess() bool {
   return p.Success != nil
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) IsSetBadRequestError() bool {
+func (p *WorkflowServiceGetDomainReplicationMessagesResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceGetDomainReplicationMessagesResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) IsSetEntityNotExistError() bool {
+func (p *WorkflowServiceGetDomainReplicationMessagesResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceGetDomainReplicationMessagesResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -4554,15 +10659,15 @@ func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) Read(iprot thrift.TPr
   return nil
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult)  ReadField0(iprot thrift.TProtocol) error {
-  p.Success = &shared.GetWorkflowExecutionHistoryResponse{}
+func (p *WorkflowServiceGetDomainReplicationMessagesResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.GetDomainReplicationMessagesResponse{}
   if err := p.Success.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceGetDomainReplicationMessagesResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -4570,7 +10675,7 @@ func (p *WorkflowServiceGetWorkflowExecutionHistoryResult)  ReadField1(iprot thr
   return nil
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceGetDomainReplicationMessagesResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -4578,7 +10683,7 @@ func (p *WorkflowServiceGetWorkflowExecutionHistoryResult)  ReadField2(iprot thr
   return nil
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceGetDomainReplicationMessagesResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -4586,8 +10691,8 @@ func (p *WorkflowServiceGetWorkflowExecutionHistoryResult)  ReadField3(iprot thr
   return nil
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("GetWorkflowExecutionHistory_result"); err != nil {
+func (p *WorkflowServiceGetDomainReplicationMessagesResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetDomainReplicationMessages_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField0(oprot); err != nil { return err }
@@ -4602,7 +10707,7 @@ func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) Write(oprot thrift.TP
   return nil
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceGetDomainReplicationMessagesResult) writeField0(oprot thrift.TProtocol) (err error) {
   if p.IsSetSuccess() {
     if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
@@ -4615,7 +10720,7 @@ func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) writeField0(oprot thr
   return err
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceGetDomainReplicationMessagesResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -4628,7 +10733,7 @@ func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) writeField1(oprot thr
   return err
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceGetDomainReplicationMessagesResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -4641,7 +10746,7 @@ func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) writeField2(oprot thr
   return err
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceGetDomainReplicationMessagesResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -4654,35 +10759,37 @@ func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) writeField3(oprot thr
   return err
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) String() string {
+func (p *WorkflowServiceGetDomainReplicationMessagesResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceGetWorkflowExecutionHistoryResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceGetDomainReplicationMessagesResult(%+v)", *p)
 }
 
+
+
 // Attributes:
-//  - PollRequest
-type WorkflowServicePollForDecisionTaskArgs struct {
-  PollRequest *shared.PollForDecisionTaskRequest `thrift:"pollRequest,1" db:"pollRequest" json:"pollRequest"`
+//  - GetRequest
+type WorkflowServiceGetWorkflowResultArgs struct {
+  GetRequest *shared.GetWorkflowResultRequest `thrift:"getRequest,1" db:"getRequest" json:"getRequest"`
 }
 
-func NewWorkflowServicePollForDecisionTaskArgs() *WorkflowServicePollForDecisionTaskArgs {
-  return &WorkflowServicePollForDecisionTaskArgs{}
+func NewWorkflowServiceGetWorkflowResultArgs() *WorkflowServiceGetWorkflowResultArgs {
+  return &WorkflowServiceGetWorkflowResultArgs{}
 }
 
-var WorkflowServicePollForDecisionTaskArgs_PollRequest_DEFAULT *shared.PollForDecisionTaskRequest
-func (p *WorkflowServicePollForDecisionTaskArgs) GetPollRequest() *shared.PollForDecisionTaskRequest {
-  if !p.IsSetPollRequest() {
-    return WorkflowServicePollForDecisionTaskArgs_PollRequest_DEFAULT
+var WorkflowServiceGetWorkflowResultArgs_GetRequest_DEFAULT *shared.GetWorkflowResultRequest
+func (p *WorkflowServiceGetWorkflowResultArgs) GetGetRequest() *shared.GetWorkflowResultRequest {
+  if !p.IsSetGetRequest() {
+    return WorkflowServiceGetWorkflowResultArgs_GetRequest_DEFAULT
   }
-return p.PollRequest
+return p.GetRequest
 }
-func (p *WorkflowServicePollForDecisionTaskArgs) IsSetPollRequest() bool {
-  return p.PollRequest != nil
+func (p *WorkflowServiceGetWorkflowResultArgs) IsSetGetRequest() bool {
+  return p.GetRequest != nil
 }
 
-func (p *WorkflowServicePollForDecisionTaskArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceGetWorkflowResultArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -4714,16 +10821,16 @@ func (p *WorkflowServicePollForDecisionTaskArgs) Read(iprot thrift.TProtocol) er
   return nil
 }
 
-func (p *WorkflowServicePollForDecisionTaskArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.PollRequest = &shared.PollForDecisionTaskRequest{}
-  if err := p.PollRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.PollRequest), err)
+func (p *WorkflowServiceGetWorkflowResultArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.GetRequest = &shared.GetWorkflowResultRequest{}
+  if err := p.GetRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.GetRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServicePollForDecisionTaskArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("PollForDecisionTask_args"); err != nil {
+func (p *WorkflowServiceGetWorkflowResultArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetWorkflowResult_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -4735,72 +10842,85 @@ func (p *WorkflowServicePollForDecisionTaskArgs) Write(oprot thrift.TProtocol) e
   return nil
 }
 
-func (p *WorkflowServicePollForDecisionTaskArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("pollRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:pollRequest: ", p), err) }
-  if err := p.PollRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.PollRequest), err)
+func (p *WorkflowServiceGetWorkflowResultArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("getRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:getRequest: ", p), err) }
+  if err := p.GetRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.GetRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:pollRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:getRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServicePollForDecisionTaskArgs) String() string {
+func (p *WorkflowServiceGetWorkflowResultArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServicePollForDecisionTaskArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceGetWorkflowResultArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Success
 //  - BadRequestError
 //  - InternalServiceError
-type WorkflowServicePollForDecisionTaskResult struct {
-  Success *shared.PollForDecisionTaskResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+//  - EntityNotExistError
+type WorkflowServiceGetWorkflowResultResult struct {
+  Success *shared.GetWorkflowResultResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
 }
 
-func NewWorkflowServicePollForDecisionTaskResult() *WorkflowServicePollForDecisionTaskResult {
-  return &WorkflowServicePollForDecisionTaskResult{}
+func NewWorkflowServiceGetWorkflowResultResult() *WorkflowServiceGetWorkflowResultResult {
+  return &WorkflowServiceGetWorkflowResultResult{}
 }
 
-var WorkflowServicePollForDecisionTaskResult_Success_DEFAULT *shared.PollForDecisionTaskResponse
-func (p *WorkflowServicePollForDecisionTaskResult) GetSuccess() *shared.PollForDecisionTaskResponse {
+var WorkflowServiceGetWorkflowResultResult_Success_DEFAULT *shared.GetWorkflowResultResponse
+func (p *WorkflowServiceGetWorkflowResultResult) GetSuccess() *shared.GetWorkflowResultResponse {
   if !p.IsSetSuccess() {
-    return WorkflowServicePollForDecisionTaskResult_Success_DEFAULT
+    return WorkflowServiceGetWorkflowResultResult_Success_DEFAULT
   }
 return p.Success
 }
-var WorkflowServicePollForDecisionTaskResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServicePollForDecisionTaskResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceGetWorkflowResultResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceGetWorkflowResultResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServicePollForDecisionTaskResult_BadRequestError_DEFAULT
+    return WorkflowServiceGetWorkflowResultResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServicePollForDecisionTaskResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServicePollForDecisionTaskResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceGetWorkflowResultResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceGetWorkflowResultResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServicePollForDecisionTaskResult_InternalServiceError_DEFAULT
+    return WorkflowServiceGetWorkflowResultResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-func (p *WorkflowServicePollForDecisionTaskResult) IsSetSuccess() bool {
+var WorkflowServiceGetWorkflowResultResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceGetWorkflowResultResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return WorkflowServiceGetWorkflowResultResult_EntityNotExistError_DEFAULT
+  }
+return p.EntityNotExistError
+}
+func (p *WorkflowServiceGetWorkflowResultResult) IsSetSuccess() bool {
   return p.Success != nil
 }
 
-func (p *WorkflowServicePollForDecisionTaskResult) IsSetBadRequestError() bool {
+func (p *WorkflowServiceGetWorkflowResultResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServicePollForDecisionTaskResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceGetWorkflowResultResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServicePollForDecisionTaskResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceGetWorkflowResultResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
+}
+
+func (p *WorkflowServiceGetWorkflowResultResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -4825,6 +10945,10 @@ func (p *WorkflowServicePollForDecisionTaskResult) Read(iprot thrift.TProtocol)
       if err := p.ReadField2(iprot); err != nil {
         return err
       }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -4840,15 +10964,15 @@ func (p *WorkflowServicePollForDecisionTaskResult) Read(iprot thrift.TProtocol)
   return nil
 }
 
-func (p *WorkflowServicePollForDecisionTaskResult)  ReadField0(iprot thrift.TProtocol) error {
-  p.Success = &shared.PollForDecisionTaskResponse{}
+func (p *WorkflowServiceGetWorkflowResultResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.GetWorkflowResultResponse{}
   if err := p.Success.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
   }
   return nil
 }
 
-func (p *WorkflowServicePollForDecisionTaskResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceGetWorkflowResultResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -4856,7 +10980,7 @@ func (p *WorkflowServicePollForDecisionTaskResult)  ReadField1(iprot thrift.TPro
   return nil
 }
 
-func (p *WorkflowServicePollForDecisionTaskResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceGetWorkflowResultResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -4864,13 +10988,22 @@ func (p *WorkflowServicePollForDecisionTaskResult)  ReadField2(iprot thrift.TPro
   return nil
 }
 
-func (p *WorkflowServicePollForDecisionTaskResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("PollForDecisionTask_result"); err != nil {
+func (p *WorkflowServiceGetWorkflowResultResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceGetWorkflowResultResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetWorkflowResult_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -4879,7 +11012,7 @@ func (p *WorkflowServicePollForDecisionTaskResult) Write(oprot thrift.TProtocol)
   return nil
 }
 
-func (p *WorkflowServicePollForDecisionTaskResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceGetWorkflowResultResult) writeField0(oprot thrift.TProtocol) (err error) {
   if p.IsSetSuccess() {
     if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
@@ -4892,7 +11025,7 @@ func (p *WorkflowServicePollForDecisionTaskResult) writeField0(oprot thrift.TPro
   return err
 }
 
-func (p *WorkflowServicePollForDecisionTaskResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceGetWorkflowResultResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -4905,7 +11038,7 @@ func (p *WorkflowServicePollForDecisionTaskResult) writeField1(oprot thrift.TPro
   return err
 }
 
-func (p *WorkflowServicePollForDecisionTaskResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceGetWorkflowResultResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -4918,35 +11051,48 @@ func (p *WorkflowServicePollForDecisionTaskResult) writeField2(oprot thrift.TPro
   return err
 }
 
-func (p *WorkflowServicePollForDecisionTaskResult) String() string {
+func (p *WorkflowServiceGetWorkflowResultResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceGetWorkflowResultResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServicePollForDecisionTaskResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceGetWorkflowResultResult(%+v)", *p)
 }
 
 // Attributes:
-//  - CompleteRequest
-type WorkflowServiceRespondDecisionTaskCompletedArgs struct {
-  CompleteRequest *shared.RespondDecisionTaskCompletedRequest `thrift:"completeRequest,1" db:"completeRequest" json:"completeRequest"`
+//  - ListRequest
+type WorkflowServiceCountOpenWorkflowExecutionsArgs struct {
+  ListRequest *shared.CountOpenWorkflowExecutionsRequest `thrift:"listRequest,1" db:"listRequest" json:"listRequest"`
 }
 
-func NewWorkflowServiceRespondDecisionTaskCompletedArgs() *WorkflowServiceRespondDecisionTaskCompletedArgs {
-  return &WorkflowServiceRespondDecisionTaskCompletedArgs{}
+func NewWorkflowServiceCountOpenWorkflowExecutionsArgs() *WorkflowServiceCountOpenWorkflowExecutionsArgs {
+  return &WorkflowServiceCountOpenWorkflowExecutionsArgs{}
 }
 
-var WorkflowServiceRespondDecisionTaskCompletedArgs_CompleteRequest_DEFAULT *shared.RespondDecisionTaskCompletedRequest
-func (p *WorkflowServiceRespondDecisionTaskCompletedArgs) GetCompleteRequest() *shared.RespondDecisionTaskCompletedRequest {
-  if !p.IsSetCompleteRequest() {
-    return WorkflowServiceRespondDecisionTaskCompletedArgs_CompleteRequest_DEFAULT
+var WorkflowServiceCountOpenWorkflowExecutionsArgs_ListRequest_DEFAULT *shared.CountOpenWorkflowExecutionsRequest
+func (p *WorkflowServiceCountOpenWorkflowExecutionsArgs) GetListRequest() *shared.CountOpenWorkflowExecutionsRequest {
+  if !p.IsSetListRequest() {
+    return WorkflowServiceCountOpenWorkflowExecutionsArgs_ListRequest_DEFAULT
   }
-return p.CompleteRequest
+return p.ListRequest
 }
-func (p *WorkflowServiceRespondDecisionTaskCompletedArgs) IsSetCompleteRequest() bool {
-  return p.CompleteRequest != nil
+func (p *WorkflowServiceCountOpenWorkflowExecutionsArgs) IsSetListRequest() bool {
+  return p.ListRequest != nil
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceCountOpenWorkflowExecutionsArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -4978,16 +11124,16 @@ func (p *WorkflowServiceRespondDecisionTaskCompletedArgs) Read(iprot thrift.TPro
   return nil
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.CompleteRequest = &shared.RespondDecisionTaskCompletedRequest{}
-  if err := p.CompleteRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CompleteRequest), err)
+func (p *WorkflowServiceCountOpenWorkflowExecutionsArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.ListRequest = &shared.CountOpenWorkflowExecutionsRequest{}
+  if err := p.ListRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ListRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondDecisionTaskCompleted_args"); err != nil {
+func (p *WorkflowServiceCountOpenWorkflowExecutionsArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("CountOpenWorkflowExecutions_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -4999,72 +11145,85 @@ func (p *WorkflowServiceRespondDecisionTaskCompletedArgs) Write(oprot thrift.TPr
   return nil
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("completeRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:completeRequest: ", p), err) }
-  if err := p.CompleteRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CompleteRequest), err)
+func (p *WorkflowServiceCountOpenWorkflowExecutionsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("listRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:listRequest: ", p), err) }
+  if err := p.ListRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ListRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:completeRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:listRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedArgs) String() string {
+func (p *WorkflowServiceCountOpenWorkflowExecutionsArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceRespondDecisionTaskCompletedArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceCountOpenWorkflowExecutionsArgs(%+v)", *p)
 }
 
 // Attributes:
+//  - Success
 //  - BadRequestError
 //  - InternalServiceError
 //  - EntityNotExistError
-type WorkflowServiceRespondDecisionTaskCompletedResult struct {
+type WorkflowServiceCountOpenWorkflowExecutionsResult struct {
+  Success *shared.CountOpenWorkflowExecutionsResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
 }
 
-func NewWorkflowServiceRespondDecisionTaskCompletedResult() *WorkflowServiceRespondDecisionTaskCompletedResult {
-  return &WorkflowServiceRespondDecisionTaskCompletedResult{}
+func NewWorkflowServiceCountOpenWorkflowExecutionsResult() *WorkflowServiceCountOpenWorkflowExecutionsResult {
+  return &WorkflowServiceCountOpenWorkflowExecutionsResult{}
 }
 
-var WorkflowServiceRespondDecisionTaskCompletedResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceCountOpenWorkflowExecutionsResult_Success_DEFAULT *shared.CountOpenWorkflowExecutionsResponse
+func (p *WorkflowServiceCountOpenWorkflowExecutionsResult) GetSuccess() *shared.CountOpenWorkflowExecutionsResponse {
+  if !p.IsSetSuccess() {
+    return WorkflowServiceCountOpenWorkflowExecutionsResult_Success_DEFAULT
+  }
+return p.Success
+}
+var WorkflowServiceCountOpenWorkflowExecutionsResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceCountOpenWorkflowExecutionsResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceRespondDecisionTaskCompletedResult_BadRequestError_DEFAULT
+    return WorkflowServiceCountOpenWorkflowExecutionsResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceRespondDecisionTaskCompletedResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceCountOpenWorkflowExecutionsResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceCountOpenWorkflowExecutionsResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceRespondDecisionTaskCompletedResult_InternalServiceError_DEFAULT
+    return WorkflowServiceCountOpenWorkflowExecutionsResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceRespondDecisionTaskCompletedResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var WorkflowServiceCountOpenWorkflowExecutionsResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceCountOpenWorkflowExecutionsResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return WorkflowServiceRespondDecisionTaskCompletedResult_EntityNotExistError_DEFAULT
+    return WorkflowServiceCountOpenWorkflowExecutionsResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult) IsSetBadRequestError() bool {
+func (p *WorkflowServiceCountOpenWorkflowExecutionsResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *WorkflowServiceCountOpenWorkflowExecutionsResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceCountOpenWorkflowExecutionsResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult) IsSetEntityNotExistError() bool {
+func (p *WorkflowServiceCountOpenWorkflowExecutionsResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceCountOpenWorkflowExecutionsResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -5077,6 +11236,10 @@ func (p *WorkflowServiceRespondDecisionTaskCompletedResult) Read(iprot thrift.TP
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -5104,7 +11267,15 @@ func (p *WorkflowServiceRespondDecisionTaskCompletedResult) Read(iprot thrift.TP
   return nil
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceCountOpenWorkflowExecutionsResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.CountOpenWorkflowExecutionsResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceCountOpenWorkflowExecutionsResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -5112,7 +11283,7 @@ func (p *WorkflowServiceRespondDecisionTaskCompletedResult)  ReadField1(iprot th
   return nil
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceCountOpenWorkflowExecutionsResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -5120,7 +11291,7 @@ func (p *WorkflowServiceRespondDecisionTaskCompletedResult)  ReadField2(iprot th
   return nil
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceCountOpenWorkflowExecutionsResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -5128,10 +11299,11 @@ func (p *WorkflowServiceRespondDecisionTaskCompletedResult)  ReadField3(iprot th
   return nil
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondDecisionTaskCompleted_result"); err != nil {
+func (p *WorkflowServiceCountOpenWorkflowExecutionsResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("CountOpenWorkflowExecutions_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
@@ -5143,7 +11315,20 @@ func (p *WorkflowServiceRespondDecisionTaskCompletedResult) Write(oprot thrift.T
   return nil
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceCountOpenWorkflowExecutionsResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceCountOpenWorkflowExecutionsResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -5156,7 +11341,7 @@ func (p *WorkflowServiceRespondDecisionTaskCompletedResult) writeField1(oprot th
   return err
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceCountOpenWorkflowExecutionsResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -5169,7 +11354,7 @@ func (p *WorkflowServiceRespondDecisionTaskCompletedResult) writeField2(oprot th
   return err
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceCountOpenWorkflowExecutionsResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -5182,35 +11367,35 @@ func (p *WorkflowServiceRespondDecisionTaskCompletedResult) writeField3(oprot th
   return err
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult) String() string {
+func (p *WorkflowServiceCountOpenWorkflowExecutionsResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceRespondDecisionTaskCompletedResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceCountOpenWorkflowExecutionsResult(%+v)", *p)
 }
 
 // Attributes:
-//  - PollRequest
-type WorkflowServicePollForActivityTaskArgs struct {
-  PollRequest *shared.PollForActivityTaskRequest `thrift:"pollRequest,1" db:"pollRequest" json:"pollRequest"`
+//  - ListRequest
+type WorkflowServiceCountClosedWorkflowExecutionsArgs struct {
+  ListRequest *shared.CountClosedWorkflowExecutionsRequest `thrift:"listRequest,1" db:"listRequest" json:"listRequest"`
 }
 
-func NewWorkflowServicePollForActivityTaskArgs() *WorkflowServicePollForActivityTaskArgs {
-  return &WorkflowServicePollForActivityTaskArgs{}
+func NewWorkflowServiceCountClosedWorkflowExecutionsArgs() *WorkflowServiceCountClosedWorkflowExecutionsArgs {
+  return &WorkflowServiceCountClosedWorkflowExecutionsArgs{}
 }
 
-var WorkflowServicePollForActivityTaskArgs_PollRequest_DEFAULT *shared.PollForActivityTaskRequest
-func (p *WorkflowServicePollForActivityTaskArgs) GetPollRequest() *shared.PollForActivityTaskRequest {
-  if !p.IsSetPollRequest() {
-    return WorkflowServicePollForActivityTaskArgs_PollRequest_DEFAULT
+var WorkflowServiceCountClosedWorkflowExecutionsArgs_ListRequest_DEFAULT *shared.CountClosedWorkflowExecutionsRequest
+func (p *WorkflowServiceCountClosedWorkflowExecutionsArgs) GetListRequest() *shared.CountClosedWorkflowExecutionsRequest {
+  if !p.IsSetListRequest() {
+    return WorkflowServiceCountClosedWorkflowExecutionsArgs_ListRequest_DEFAULT
   }
-return p.PollRequest
+return p.ListRequest
 }
-func (p *WorkflowServicePollForActivityTaskArgs) IsSetPollRequest() bool {
-  return p.PollRequest != nil
+func (p *WorkflowServiceCountClosedWorkflowExecutionsArgs) IsSetListRequest() bool {
+  return p.ListRequest != nil
 }
 
-func (p *WorkflowServicePollForActivityTaskArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceCountClosedWorkflowExecutionsArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -5242,16 +11427,16 @@ func (p *WorkflowServicePollForActivityTaskArgs) Read(iprot thrift.TProtocol) er
   return nil
 }
 
-func (p *WorkflowServicePollForActivityTaskArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.PollRequest = &shared.PollForActivityTaskRequest{}
-  if err := p.PollRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.PollRequest), err)
+func (p *WorkflowServiceCountClosedWorkflowExecutionsArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.ListRequest = &shared.CountClosedWorkflowExecutionsRequest{}
+  if err := p.ListRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ListRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServicePollForActivityTaskArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("PollForActivityTask_args"); err != nil {
+func (p *WorkflowServiceCountClosedWorkflowExecutionsArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("CountClosedWorkflowExecutions_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -5263,72 +11448,85 @@ func (p *WorkflowServicePollForActivityTaskArgs) Write(oprot thrift.TProtocol) e
   return nil
 }
 
-func (p *WorkflowServicePollForActivityTaskArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("pollRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:pollRequest: ", p), err) }
-  if err := p.PollRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.PollRequest), err)
+func (p *WorkflowServiceCountClosedWorkflowExecutionsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("listRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:listRequest: ", p), err) }
+  if err := p.ListRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ListRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:pollRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:listRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServicePollForActivityTaskArgs) String() string {
+func (p *WorkflowServiceCountClosedWorkflowExecutionsArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServicePollForActivityTaskArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceCountClosedWorkflowExecutionsArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Success
 //  - BadRequestError
 //  - InternalServiceError
-type WorkflowServicePollForActivityTaskResult struct {
-  Success *shared.PollForActivityTaskResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+//  - EntityNotExistError
+type WorkflowServiceCountClosedWorkflowExecutionsResult struct {
+  Success *shared.CountClosedWorkflowExecutionsResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
 }
 
-func NewWorkflowServicePollForActivityTaskResult() *WorkflowServicePollForActivityTaskResult {
-  return &WorkflowServicePollForActivityTaskResult{}
+func NewWorkflowServiceCountClosedWorkflowExecutionsResult() *WorkflowServiceCountClosedWorkflowExecutionsResult {
+  return &WorkflowServiceCountClosedWorkflowExecutionsResult{}
 }
 
-var WorkflowServicePollForActivityTaskResult_Success_DEFAULT *shared.PollForActivityTaskResponse
-func (p *WorkflowServicePollForActivityTaskResult) GetSuccess() *shared.PollForActivityTaskResponse {
+var WorkflowServiceCountClosedWorkflowExecutionsResult_Success_DEFAULT *shared.CountClosedWorkflowExecutionsResponse
+func (p *WorkflowServiceCountClosedWorkflowExecutionsResult) GetSuccess() *shared.CountClosedWorkflowExecutionsResponse {
   if !p.IsSetSuccess() {
-    return WorkflowServicePollForActivityTaskResult_Success_DEFAULT
+    return WorkflowServiceCountClosedWorkflowExecutionsResult_Success_DEFAULT
   }
 return p.Success
 }
-var WorkflowServicePollForActivityTaskResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServicePollForActivityTaskResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceCountClosedWorkflowExecutionsResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceCountClosedWorkflowExecutionsResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServicePollForActivityTaskResult_BadRequestError_DEFAULT
+    return WorkflowServiceCountClosedWorkflowExecutionsResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServicePollForActivityTaskResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServicePollForActivityTaskResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceCountClosedWorkflowExecutionsResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceCountClosedWorkflowExecutionsResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServicePollForActivityTaskResult_InternalServiceError_DEFAULT
+    return WorkflowServiceCountClosedWorkflowExecutionsResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-func (p *WorkflowServicePollForActivityTaskResult) IsSetSuccess() bool {
+var WorkflowServiceCountClosedWorkflowExecutionsResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceCountClosedWorkflowExecutionsResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return WorkflowServiceCountClosedWorkflowExecutionsResult_EntityNotExistError_DEFAULT
+  }
+return p.EntityNotExistError
+}
+func (p *WorkflowServiceCountClosedWorkflowExecutionsResult) IsSetSuccess() bool {
   return p.Success != nil
 }
 
-func (p *WorkflowServicePollForActivityTaskResult) IsSetBadRequestError() bool {
+func (p *WorkflowServiceCountClosedWorkflowExecutionsResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServicePollForActivityTaskResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceCountClosedWorkflowExecutionsResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServicePollForActivityTaskResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceCountClosedWorkflowExecutionsResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
+}
+
+func (p *WorkflowServiceCountClosedWorkflowExecutionsResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -5353,6 +11551,10 @@ func (p *WorkflowServicePollForActivityTaskResult) Read(iprot thrift.TProtocol)
       if err := p.ReadField2(iprot); err != nil {
         return err
       }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -5368,15 +11570,15 @@ func (p *WorkflowServicePollForActivityTaskResult) Read(iprot thrift.TProtocol)
   return nil
 }
 
-func (p *WorkflowServicePollForActivityTaskResult)  ReadField0(iprot thrift.TProtocol) error {
-  p.Success = &shared.PollForActivityTaskResponse{}
+func (p *WorkflowServiceCountClosedWorkflowExecutionsResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.CountClosedWorkflowExecutionsResponse{}
   if err := p.Success.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
   }
   return nil
 }
 
-func (p *WorkflowServicePollForActivityTaskResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceCountClosedWorkflowExecutionsResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -5384,7 +11586,7 @@ func (p *WorkflowServicePollForActivityTaskResult)  ReadField1(iprot thrift.TPro
   return nil
 }
 
-func (p *WorkflowServicePollForActivityTaskResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceCountClosedWorkflowExecutionsResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -5392,13 +11594,22 @@ func (p *WorkflowServicePollForActivityTaskResult)  ReadField2(iprot thrift.TPro
   return nil
 }
 
-func (p *WorkflowServicePollForActivityTaskResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("PollForActivityTask_result"); err != nil {
+func (p *WorkflowServiceCountClosedWorkflowExecutionsResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceCountClosedWorkflowExecutionsResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("CountClosedWorkflowExecutions_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -5407,7 +11618,7 @@ func (p *WorkflowServicePollForActivityTaskResult) Write(oprot thrift.TProtocol)
   return nil
 }
 
-func (p *WorkflowServicePollForActivityTaskResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceCountClosedWorkflowExecutionsResult) writeField0(oprot thrift.TProtocol) (err error) {
   if p.IsSetSuccess() {
     if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
@@ -5420,7 +11631,7 @@ func (p *WorkflowServicePollForActivityTaskResult) writeField0(oprot thrift.TPro
   return err
 }
 
-func (p *WorkflowServicePollForActivityTaskResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceCountClosedWorkflowExecutionsResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -5433,7 +11644,7 @@ func (p *WorkflowServicePollForActivityTaskResult) writeField1(oprot thrift.TPro
   return err
 }
 
-func (p *WorkflowServicePollForActivityTaskResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceCountClosedWorkflowExecutionsResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -5446,35 +11657,48 @@ func (p *WorkflowServicePollForActivityTaskResult) writeField2(oprot thrift.TPro
   return err
 }
 
-func (p *WorkflowServicePollForActivityTaskResult) String() string {
+func (p *WorkflowServiceCountClosedWorkflowExecutionsResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceCountClosedWorkflowExecutionsResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServicePollForActivityTaskResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceCountClosedWorkflowExecutionsResult(%+v)", *p)
 }
 
 // Attributes:
-//  - HeartbeatRequest
-type WorkflowServiceRecordActivityTaskHeartbeatArgs struct {
-  HeartbeatRequest *shared.RecordActivityTaskHeartbeatRequest `thrift:"heartbeatRequest,1" db:"heartbeatRequest" json:"heartbeatRequest"`
+//  - GetRequest
+type WorkflowServiceGetClusterInfoArgs struct {
+  GetRequest *shared.GetClusterInfoRequest `thrift:"getRequest,1" db:"getRequest" json:"getRequest"`
 }
 
-func NewWorkflowServiceRecordActivityTaskHeartbeatArgs() *WorkflowServiceRecordActivityTaskHeartbeatArgs {
-  return &WorkflowServiceRecordActivityTaskHeartbeatArgs{}
+func NewWorkflowServiceGetClusterInfoArgs() *WorkflowServiceGetClusterInfoArgs {
+  return &WorkflowServiceGetClusterInfoArgs{}
 }
 
-var WorkflowServiceRecordActivityTaskHeartbeatArgs_HeartbeatRequest_DEFAULT *shared.RecordActivityTaskHeartbeatRequest
-func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs) GetHeartbeatRequest() *shared.RecordActivityTaskHeartbeatRequest {
-  if !p.IsSetHeartbeatRequest() {
-    return WorkflowServiceRecordActivityTaskHeartbeatArgs_HeartbeatRequest_DEFAULT
+var WorkflowServiceGetClusterInfoArgs_GetRequest_DEFAULT *shared.GetClusterInfoRequest
+func (p *WorkflowServiceGetClusterInfoArgs) GetGetRequest() *shared.GetClusterInfoRequest {
+  if !p.IsSetGetRequest() {
+    return WorkflowServiceGetClusterInfoArgs_GetRequest_DEFAULT
   }
-return p.HeartbeatRequest
+return p.GetRequest
 }
-func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs) IsSetHeartbeatRequest() bool {
-  return p.HeartbeatRequest != nil
+func (p *WorkflowServiceGetClusterInfoArgs) IsSetGetRequest() bool {
+  return p.GetRequest != nil
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceGetClusterInfoArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -5506,16 +11730,16 @@ func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs) Read(iprot thrift.TProt
   return nil
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.HeartbeatRequest = &shared.RecordActivityTaskHeartbeatRequest{}
-  if err := p.HeartbeatRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.HeartbeatRequest), err)
+func (p *WorkflowServiceGetClusterInfoArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.GetRequest = &shared.GetClusterInfoRequest{}
+  if err := p.GetRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.GetRequest), err)
   }
   return nil
 }
-
-func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RecordActivityTaskHeartbeat_args"); err != nil {
+
+func (p *WorkflowServiceGetClusterInfoArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetClusterInfo_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -5527,22 +11751,22 @@ func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs) Write(oprot thrift.TPro
   return nil
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("heartbeatRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:heartbeatRequest: ", p), err) }
-  if err := p.HeartbeatRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.HeartbeatRequest), err)
+func (p *WorkflowServiceGetClusterInfoArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("getRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:getRequest: ", p), err) }
+  if err := p.GetRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.GetRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:heartbeatRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:getRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs) String() string {
+func (p *WorkflowServiceGetClusterInfoArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceRecordActivityTaskHeartbeatArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceGetClusterInfoArgs(%+v)", *p)
 }
 
 // Attributes:
@@ -5550,62 +11774,62 @@ func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs) String() string {
 //  - BadRequestError
 //  - InternalServiceError
 //  - EntityNotExistError
-type WorkflowServiceRecordActivityTaskHeartbeatResult struct {
-  Success *shared.RecordActivityTaskHeartbeatResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+type WorkflowServiceGetClusterInfoResult struct {
+  Success *shared.GetClusterInfoResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
 }
 
-func NewWorkflowServiceRecordActivityTaskHeartbeatResult() *WorkflowServiceRecordActivityTaskHeartbeatResult {
-  return &WorkflowServiceRecordActivityTaskHeartbeatResult{}
+func NewWorkflowServiceGetClusterInfoResult() *WorkflowServiceGetClusterInfoResult {
+  return &WorkflowServiceGetClusterInfoResult{}
 }
 
-var WorkflowServiceRecordActivityTaskHeartbeatResult_Success_DEFAULT *shared.RecordActivityTaskHeartbeatResponse
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) GetSuccess() *shared.RecordActivityTaskHeartbeatResponse {
+var WorkflowServiceGetClusterInfoResult_Success_DEFAULT *shared.GetClusterInfoResponse
+func (p *WorkflowServiceGetClusterInfoResult) GetSuccess() *shared.GetClusterInfoResponse {
   if !p.IsSetSuccess() {
-    return WorkflowServiceRecordActivityTaskHeartbeatResult_Success_DEFAULT
+    return WorkflowServiceGetClusterInfoResult_Success_DEFAULT
   }
 return p.Success
 }
-var WorkflowServiceRecordActivityTaskHeartbeatResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceGetClusterInfoResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceGetClusterInfoResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceRecordActivityTaskHeartbeatResult_BadRequestError_DEFAULT
+    return WorkflowServiceGetClusterInfoResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceRecordActivityTaskHeartbeatResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceGetClusterInfoResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceGetClusterInfoResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceRecordActivityTaskHeartbeatResult_InternalServiceError_DEFAULT
+    return WorkflowServiceGetClusterInfoResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceRecordActivityTaskHeartbeatResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var WorkflowServiceGetClusterInfoResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceGetClusterInfoResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return WorkflowServiceRecordActivityTaskHeartbeatResult_EntityNotExistError_DEFAULT
+    return WorkflowServiceGetClusterInfoResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) IsSetSuccess() bool {
+func (p *WorkflowServiceGetClusterInfoResult) IsSetSuccess() bool {
   return p.Success != nil
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) IsSetBadRequestError() bool {
+func (p *WorkflowServiceGetClusterInfoResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceGetClusterInfoResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) IsSetEntityNotExistError() bool {
+func (p *WorkflowServiceGetClusterInfoResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceGetClusterInfoResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -5649,15 +11873,15 @@ func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) Read(iprot thrift.TPr
   return nil
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult)  ReadField0(iprot thrift.TProtocol) error {
-  p.Success = &shared.RecordActivityTaskHeartbeatResponse{}
+func (p *WorkflowServiceGetClusterInfoResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.GetClusterInfoResponse{}
   if err := p.Success.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceGetClusterInfoResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -5665,7 +11889,7 @@ func (p *WorkflowServiceRecordActivityTaskHeartbeatResult)  ReadField1(iprot thr
   return nil
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceGetClusterInfoResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -5673,7 +11897,7 @@ func (p *WorkflowServiceRecordActivityTaskHeartbeatResult)  ReadField2(iprot thr
   return nil
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceGetClusterInfoResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -5681,8 +11905,8 @@ func (p *WorkflowServiceRecordActivityTaskHeartbeatResult)  ReadField3(iprot thr
   return nil
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RecordActivityTaskHeartbeat_result"); err != nil {
+func (p *WorkflowServiceGetClusterInfoResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetClusterInfo_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField0(oprot); err != nil { return err }
@@ -5697,7 +11921,7 @@ func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) Write(oprot thrift.TP
   return nil
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceGetClusterInfoResult) writeField0(oprot thrift.TProtocol) (err error) {
   if p.IsSetSuccess() {
     if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
@@ -5710,7 +11934,7 @@ func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) writeField0(oprot thr
   return err
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceGetClusterInfoResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -5723,7 +11947,7 @@ func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) writeField1(oprot thr
   return err
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceGetClusterInfoResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -5736,7 +11960,7 @@ func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) writeField2(oprot thr
   return err
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceGetClusterInfoResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -5749,35 +11973,35 @@ func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) writeField3(oprot thr
   return err
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) String() string {
+func (p *WorkflowServiceGetClusterInfoResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceRecordActivityTaskHeartbeatResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceGetClusterInfoResult(%+v)", *p)
 }
 
 // Attributes:
-//  - CompleteRequest
-type WorkflowServiceRespondActivityTaskCompletedArgs struct {
-  CompleteRequest *shared.RespondActivityTaskCompletedRequest `thrift:"completeRequest,1" db:"completeRequest" json:"completeRequest"`
+//  - ListRequest
+type WorkflowServiceListWorkflowExecutionsWithQueryArgs struct {
+  ListRequest *shared.ListWorkflowExecutionsWithQueryRequest `thrift:"listRequest,1" db:"listRequest" json:"listRequest"`
 }
 
-func NewWorkflowServiceRespondActivityTaskCompletedArgs() *WorkflowServiceRespondActivityTaskCompletedArgs {
-  return &WorkflowServiceRespondActivityTaskCompletedArgs{}
+func NewWorkflowServiceListWorkflowExecutionsWithQueryArgs() *WorkflowServiceListWorkflowExecutionsWithQueryArgs {
+  return &WorkflowServiceListWorkflowExecutionsWithQueryArgs{}
 }
 
-var WorkflowServiceRespondActivityTaskCompletedArgs_CompleteRequest_DEFAULT *shared.RespondActivityTaskCompletedRequest
-func (p *WorkflowServiceRespondActivityTaskCompletedArgs) GetCompleteRequest() *shared.RespondActivityTaskCompletedRequest {
-  if !p.IsSetCompleteRequest() {
-    return WorkflowServiceRespondActivityTaskCompletedArgs_CompleteRequest_DEFAULT
+var WorkflowServiceListWorkflowExecutionsWithQueryArgs_ListRequest_DEFAULT *shared.ListWorkflowExecutionsWithQueryRequest
+func (p *WorkflowServiceListWorkflowExecutionsWithQueryArgs) GetListRequest() *shared.ListWorkflowExecutionsWithQueryRequest {
+  if !p.IsSetListRequest() {
+    return WorkflowServiceListWorkflowExecutionsWithQueryArgs_ListRequest_DEFAULT
   }
-return p.CompleteRequest
+return p.ListRequest
 }
-func (p *WorkflowServiceRespondActivityTaskCompletedArgs) IsSetCompleteRequest() bool {
-  return p.CompleteRequest != nil
+func (p *WorkflowServiceListWorkflowExecutionsWithQueryArgs) IsSetListRequest() bool {
+  return p.ListRequest != nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceListWorkflowExecutionsWithQueryArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -5809,16 +12033,16 @@ func (p *WorkflowServiceRespondActivityTaskCompletedArgs) Read(iprot thrift.TPro
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.CompleteRequest = &shared.RespondActivityTaskCompletedRequest{}
-  if err := p.CompleteRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CompleteRequest), err)
+func (p *WorkflowServiceListWorkflowExecutionsWithQueryArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.ListRequest = &shared.ListWorkflowExecutionsWithQueryRequest{}
+  if err := p.ListRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ListRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondActivityTaskCompleted_args"); err != nil {
+func (p *WorkflowServiceListWorkflowExecutionsWithQueryArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ListWorkflowExecutionsWithQuery_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -5830,72 +12054,85 @@ func (p *WorkflowServiceRespondActivityTaskCompletedArgs) Write(oprot thrift.TPr
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("completeRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:completeRequest: ", p), err) }
-  if err := p.CompleteRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CompleteRequest), err)
+func (p *WorkflowServiceListWorkflowExecutionsWithQueryArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("listRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:listRequest: ", p), err) }
+  if err := p.ListRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ListRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:completeRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:listRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedArgs) String() string {
+func (p *WorkflowServiceListWorkflowExecutionsWithQueryArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceRespondActivityTaskCompletedArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceListWorkflowExecutionsWithQueryArgs(%+v)", *p)
 }
 
 // Attributes:
+//  - Success
 //  - BadRequestError
 //  - InternalServiceError
 //  - EntityNotExistError
-type WorkflowServiceRespondActivityTaskCompletedResult struct {
+type WorkflowServiceListWorkflowExecutionsWithQueryResult struct {
+  Success *shared.ListWorkflowExecutionsWithQueryResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
 }
 
-func NewWorkflowServiceRespondActivityTaskCompletedResult() *WorkflowServiceRespondActivityTaskCompletedResult {
-  return &WorkflowServiceRespondActivityTaskCompletedResult{}
+func NewWorkflowServiceListWorkflowExecutionsWithQueryResult() *WorkflowServiceListWorkflowExecutionsWithQueryResult {
+  return &WorkflowServiceListWorkflowExecutionsWithQueryResult{}
 }
 
-var WorkflowServiceRespondActivityTaskCompletedResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceRespondActivityTaskCompletedResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceListWorkflowExecutionsWithQueryResult_Success_DEFAULT *shared.ListWorkflowExecutionsWithQueryResponse
+func (p *WorkflowServiceListWorkflowExecutionsWithQueryResult) GetSuccess() *shared.ListWorkflowExecutionsWithQueryResponse {
+  if !p.IsSetSuccess() {
+    return WorkflowServiceListWorkflowExecutionsWithQueryResult_Success_DEFAULT
+  }
+return p.Success
+}
+var WorkflowServiceListWorkflowExecutionsWithQueryResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceListWorkflowExecutionsWithQueryResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceRespondActivityTaskCompletedResult_BadRequestError_DEFAULT
+    return WorkflowServiceListWorkflowExecutionsWithQueryResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceRespondActivityTaskCompletedResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceRespondActivityTaskCompletedResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceListWorkflowExecutionsWithQueryResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceListWorkflowExecutionsWithQueryResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceRespondActivityTaskCompletedResult_InternalServiceError_DEFAULT
+    return WorkflowServiceListWorkflowExecutionsWithQueryResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceRespondActivityTaskCompletedResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *WorkflowServiceRespondActivityTaskCompletedResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var WorkflowServiceListWorkflowExecutionsWithQueryResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceListWorkflowExecutionsWithQueryResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return WorkflowServiceRespondActivityTaskCompletedResult_EntityNotExistError_DEFAULT
+    return WorkflowServiceListWorkflowExecutionsWithQueryResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-func (p *WorkflowServiceRespondActivityTaskCompletedResult) IsSetBadRequestError() bool {
+func (p *WorkflowServiceListWorkflowExecutionsWithQueryResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *WorkflowServiceListWorkflowExecutionsWithQueryResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceListWorkflowExecutionsWithQueryResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedResult) IsSetEntityNotExistError() bool {
+func (p *WorkflowServiceListWorkflowExecutionsWithQueryResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceListWorkflowExecutionsWithQueryResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -5908,6 +12145,10 @@ func (p *WorkflowServiceRespondActivityTaskCompletedResult) Read(iprot thrift.TP
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -5935,7 +12176,15 @@ func (p *WorkflowServiceRespondActivityTaskCompletedResult) Read(iprot thrift.TP
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceListWorkflowExecutionsWithQueryResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.ListWorkflowExecutionsWithQueryResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceListWorkflowExecutionsWithQueryResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -5943,7 +12192,7 @@ func (p *WorkflowServiceRespondActivityTaskCompletedResult)  ReadField1(iprot th
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceListWorkflowExecutionsWithQueryResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -5951,7 +12200,7 @@ func (p *WorkflowServiceRespondActivityTaskCompletedResult)  ReadField2(iprot th
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceListWorkflowExecutionsWithQueryResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -5959,10 +12208,11 @@ func (p *WorkflowServiceRespondActivityTaskCompletedResult)  ReadField3(iprot th
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondActivityTaskCompleted_result"); err != nil {
+func (p *WorkflowServiceListWorkflowExecutionsWithQueryResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ListWorkflowExecutionsWithQuery_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
@@ -5974,7 +12224,20 @@ func (p *WorkflowServiceRespondActivityTaskCompletedResult) Write(oprot thrift.T
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceListWorkflowExecutionsWithQueryResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceListWorkflowExecutionsWithQueryResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -5987,7 +12250,7 @@ func (p *WorkflowServiceRespondActivityTaskCompletedResult) writeField1(oprot th
   return err
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceListWorkflowExecutionsWithQueryResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -6000,7 +12263,7 @@ func (p *WorkflowServiceRespondActivityTaskCompletedResult) writeField2(oprot th
   return err
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceListWorkflowExecutionsWithQueryResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -6013,35 +12276,35 @@ func (p *WorkflowServiceRespondActivityTaskCompletedResult) writeField3(oprot th
   return err
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedResult) String() string {
+func (p *WorkflowServiceListWorkflowExecutionsWithQueryResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceRespondActivityTaskCompletedResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceListWorkflowExecutionsWithQueryResult(%+v)", *p)
 }
 
 // Attributes:
-//  - FailRequest
-type WorkflowServiceRespondActivityTaskFailedArgs struct {
-  FailRequest *shared.RespondActivityTaskFailedRequest `thrift:"failRequest,1" db:"failRequest" json:"failRequest"`
+//  - SignalWithStartRequest
+type WorkflowServiceSignalWithStartWorkflowExecutionArgs struct {
+  SignalWithStartRequest *shared.SignalWithStartWorkflowExecutionRequest `thrift:"signalWithStartRequest,1" db:"signalWithStartRequest" json:"signalWithStartRequest"`
 }
 
-func NewWorkflowServiceRespondActivityTaskFailedArgs() *WorkflowServiceRespondActivityTaskFailedArgs {
-  return &WorkflowServiceRespondActivityTaskFailedArgs{}
+func NewWorkflowServiceSignalWithStartWorkflowExecutionArgs() *WorkflowServiceSignalWithStartWorkflowExecutionArgs {
+  return &WorkflowServiceSignalWithStartWorkflowExecutionArgs{}
 }
 
-var WorkflowServiceRespondActivityTaskFailedArgs_FailRequest_DEFAULT *shared.RespondActivityTaskFailedRequest
-func (p *WorkflowServiceRespondActivityTaskFailedArgs) GetFailRequest() *shared.RespondActivityTaskFailedRequest {
-  if !p.IsSetFailRequest() {
-    return WorkflowServiceRespondActivityTaskFailedArgs_FailRequest_DEFAULT
+var WorkflowServiceSignalWithStartWorkflowExecutionArgs_SignalWithStartRequest_DEFAULT *shared.SignalWithStartWorkflowExecutionRequest
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionArgs) GetSignalWithStartRequest() *shared.SignalWithStartWorkflowExecutionRequest {
+  if !p.IsSetSignalWithStartRequest() {
+    return WorkflowServiceSignalWithStartWorkflowExecutionArgs_SignalWithStartRequest_DEFAULT
   }
-return p.FailRequest
+return p.SignalWithStartRequest
 }
-func (p *WorkflowServiceRespondActivityTaskFailedArgs) IsSetFailRequest() bool {
-  return p.FailRequest != nil
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionArgs) IsSetSignalWithStartRequest() bool {
+  return p.SignalWithStartRequest != nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -6073,16 +12336,16 @@ func (p *WorkflowServiceRespondActivityTaskFailedArgs) Read(iprot thrift.TProtoc
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.FailRequest = &shared.RespondActivityTaskFailedRequest{}
-  if err := p.FailRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.FailRequest), err)
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.SignalWithStartRequest = &shared.SignalWithStartWorkflowExecutionRequest{}
+  if err := p.SignalWithStartRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.SignalWithStartRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondActivityTaskFailed_args"); err != nil {
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("SignalWithStartWorkflowExecution_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -6094,72 +12357,85 @@ func (p *WorkflowServiceRespondActivityTaskFailedArgs) Write(oprot thrift.TProto
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("failRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:failRequest: ", p), err) }
-  if err := p.FailRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.FailRequest), err)
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("signalWithStartRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:signalWithStartRequest: ", p), err) }
+  if err := p.SignalWithStartRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.SignalWithStartRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:failRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:signalWithStartRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedArgs) String() string {
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceRespondActivityTaskFailedArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceSignalWithStartWorkflowExecutionArgs(%+v)", *p)
 }
 
 // Attributes:
+//  - Success
 //  - BadRequestError
 //  - InternalServiceError
-//  - EntityNotExistError
-type WorkflowServiceRespondActivityTaskFailedResult struct {
+//  - SessionAlreadyExistError
+type WorkflowServiceSignalWithStartWorkflowExecutionResult struct {
+  Success *shared.StartWorkflowExecutionResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
-  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+  SessionAlreadyExistError *shared.WorkflowExecutionAlreadyStartedError `thrift:"sessionAlreadyExistError,3" db:"sessionAlreadyExistError" json:"sessionAlreadyExistError,omitempty"`
 }
 
-func NewWorkflowServiceRespondActivityTaskFailedResult() *WorkflowServiceRespondActivityTaskFailedResult {
-  return &WorkflowServiceRespondActivityTaskFailedResult{}
+func NewWorkflowServiceSignalWithStartWorkflowExecutionResult() *WorkflowServiceSignalWithStartWorkflowExecutionResult {
+  return &WorkflowServiceSignalWithStartWorkflowExecutionResult{}
 }
 
-var WorkflowServiceRespondActivityTaskFailedResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceRespondActivityTaskFailedResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceSignalWithStartWorkflowExecutionResult_Success_DEFAULT *shared.StartWorkflowExecutionResponse
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) GetSuccess() *shared.StartWorkflowExecutionResponse {
+  if !p.IsSetSuccess() {
+    return WorkflowServiceSignalWithStartWorkflowExecutionResult_Success_DEFAULT
+  }
+return p.Success
+}
+var WorkflowServiceSignalWithStartWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceRespondActivityTaskFailedResult_BadRequestError_DEFAULT
+    return WorkflowServiceSignalWithStartWorkflowExecutionResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceRespondActivityTaskFailedResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceRespondActivityTaskFailedResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceSignalWithStartWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceRespondActivityTaskFailedResult_InternalServiceError_DEFAULT
+    return WorkflowServiceSignalWithStartWorkflowExecutionResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceRespondActivityTaskFailedResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *WorkflowServiceRespondActivityTaskFailedResult) GetEntityNotExistError() *shared.EntityNotExistsError {
-  if !p.IsSetEntityNotExistError() {
-    return WorkflowServiceRespondActivityTaskFailedResult_EntityNotExistError_DEFAULT
+var WorkflowServiceSignalWithStartWorkflowExecutionResult_SessionAlreadyExistError_DEFAULT *shared.WorkflowExecutionAlreadyStartedError
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) GetSessionAlreadyExistError() *shared.WorkflowExecutionAlreadyStartedError {
+  if !p.IsSetSessionAlreadyExistError() {
+    return WorkflowServiceSignalWithStartWorkflowExecutionResult_SessionAlreadyExistError_DEFAULT
   }
-return p.EntityNotExistError
+return p.SessionAlreadyExistError
 }
-func (p *WorkflowServiceRespondActivityTaskFailedResult) IsSetBadRequestError() bool {
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedResult) IsSetEntityNotExistError() bool {
-  return p.EntityNotExistError != nil
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) IsSetSessionAlreadyExistError() bool {
+  return p.SessionAlreadyExistError != nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -6172,6 +12448,10 @@ func (p *WorkflowServiceRespondActivityTaskFailedResult) Read(iprot thrift.TProt
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -6199,7 +12479,15 @@ func (p *WorkflowServiceRespondActivityTaskFailedResult) Read(iprot thrift.TProt
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.StartWorkflowExecutionResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -6207,7 +12495,7 @@ func (p *WorkflowServiceRespondActivityTaskFailedResult)  ReadField1(iprot thrif
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -6215,18 +12503,19 @@ func (p *WorkflowServiceRespondActivityTaskFailedResult)  ReadField2(iprot thrif
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedResult)  ReadField3(iprot thrift.TProtocol) error {
-  p.EntityNotExistError = &shared.EntityNotExistsError{}
-  if err := p.EntityNotExistError.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.SessionAlreadyExistError = &shared.WorkflowExecutionAlreadyStartedError{}
+  if err := p.SessionAlreadyExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.SessionAlreadyExistError), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondActivityTaskFailed_result"); err != nil {
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("SignalWithStartWorkflowExecution_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
@@ -6238,7 +12527,20 @@ func (p *WorkflowServiceRespondActivityTaskFailedResult) Write(oprot thrift.TPro
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -6251,7 +12553,7 @@ func (p *WorkflowServiceRespondActivityTaskFailedResult) writeField1(oprot thrif
   return err
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -6264,48 +12566,48 @@ func (p *WorkflowServiceRespondActivityTaskFailedResult) writeField2(oprot thrif
   return err
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedResult) writeField3(oprot thrift.TProtocol) (err error) {
-  if p.IsSetEntityNotExistError() {
-    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
-    if err := p.EntityNotExistError.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSessionAlreadyExistError() {
+    if err := oprot.WriteFieldBegin("sessionAlreadyExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:sessionAlreadyExistError: ", p), err) }
+    if err := p.SessionAlreadyExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.SessionAlreadyExistError), err)
     }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:sessionAlreadyExistError: ", p), err) }
   }
   return err
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedResult) String() string {
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceRespondActivityTaskFailedResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceSignalWithStartWorkflowExecutionResult(%+v)", *p)
 }
 
 // Attributes:
-//  - CanceledRequest
-type WorkflowServiceRespondActivityTaskCanceledArgs struct {
-  CanceledRequest *shared.RespondActivityTaskCanceledRequest `thrift:"canceledRequest,1" db:"canceledRequest" json:"canceledRequest"`
+//  - ResetRequest
+type WorkflowServiceResetWorkflowExecutionArgs struct {
+  ResetRequest *shared.ResetWorkflowExecutionRequest `thrift:"resetRequest,1" db:"resetRequest" json:"resetRequest"`
 }
 
-func NewWorkflowServiceRespondActivityTaskCanceledArgs() *WorkflowServiceRespondActivityTaskCanceledArgs {
-  return &WorkflowServiceRespondActivityTaskCanceledArgs{}
+func NewWorkflowServiceResetWorkflowExecutionArgs() *WorkflowServiceResetWorkflowExecutionArgs {
+  return &WorkflowServiceResetWorkflowExecutionArgs{}
 }
 
-var WorkflowServiceRespondActivityTaskCanceledArgs_CanceledRequest_DEFAULT *shared.RespondActivityTaskCanceledRequest
-func (p *WorkflowServiceRespondActivityTaskCanceledArgs) GetCanceledRequest() *shared.RespondActivityTaskCanceledRequest {
-  if !p.IsSetCanceledRequest() {
-    return WorkflowServiceRespondActivityTaskCanceledArgs_CanceledRequest_DEFAULT
+var WorkflowServiceResetWorkflowExecutionArgs_ResetRequest_DEFAULT *shared.ResetWorkflowExecutionRequest
+func (p *WorkflowServiceResetWorkflowExecutionArgs) GetResetRequest() *shared.ResetWorkflowExecutionRequest {
+  if !p.IsSetResetRequest() {
+    return WorkflowServiceResetWorkflowExecutionArgs_ResetRequest_DEFAULT
   }
-return p.CanceledRequest
+return p.ResetRequest
 }
-func (p *WorkflowServiceRespondActivityTaskCanceledArgs) IsSetCanceledRequest() bool {
-  return p.CanceledRequest != nil
+func (p *WorkflowServiceResetWorkflowExecutionArgs) IsSetResetRequest() bool {
+  return p.ResetRequest != nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceResetWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -6337,16 +12639,16 @@ func (p *WorkflowServiceRespondActivityTaskCanceledArgs) Read(iprot thrift.TProt
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.CanceledRequest = &shared.RespondActivityTaskCanceledRequest{}
-  if err := p.CanceledRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CanceledRequest), err)
+func (p *WorkflowServiceResetWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.ResetRequest = &shared.ResetWorkflowExecutionRequest{}
+  if err := p.ResetRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ResetRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondActivityTaskCanceled_args"); err != nil {
+func (p *WorkflowServiceResetWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ResetWorkflowExecution_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -6358,72 +12660,85 @@ func (p *WorkflowServiceRespondActivityTaskCanceledArgs) Write(oprot thrift.TPro
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("canceledRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:canceledRequest: ", p), err) }
-  if err := p.CanceledRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CanceledRequest), err)
+func (p *WorkflowServiceResetWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("resetRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:resetRequest: ", p), err) }
+  if err := p.ResetRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ResetRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:canceledRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:resetRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledArgs) String() string {
+func (p *WorkflowServiceResetWorkflowExecutionArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceRespondActivityTaskCanceledArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceResetWorkflowExecutionArgs(%+v)", *p)
 }
 
 // Attributes:
+//  - Success
 //  - BadRequestError
 //  - InternalServiceError
 //  - EntityNotExistError
-type WorkflowServiceRespondActivityTaskCanceledResult struct {
+type WorkflowServiceResetWorkflowExecutionResult struct {
+  Success *shared.ResetWorkflowExecutionResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
 }
 
-func NewWorkflowServiceRespondActivityTaskCanceledResult() *WorkflowServiceRespondActivityTaskCanceledResult {
-  return &WorkflowServiceRespondActivityTaskCanceledResult{}
+func NewWorkflowServiceResetWorkflowExecutionResult() *WorkflowServiceResetWorkflowExecutionResult {
+  return &WorkflowServiceResetWorkflowExecutionResult{}
 }
 
-var WorkflowServiceRespondActivityTaskCanceledResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceRespondActivityTaskCanceledResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceResetWorkflowExecutionResult_Success_DEFAULT *shared.ResetWorkflowExecutionResponse
+func (p *WorkflowServiceResetWorkflowExecutionResult) GetSuccess() *shared.ResetWorkflowExecutionResponse {
+  if !p.IsSetSuccess() {
+    return WorkflowServiceResetWorkflowExecutionResult_Success_DEFAULT
+  }
+return p.Success
+}
+var WorkflowServiceResetWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceResetWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceRespondActivityTaskCanceledResult_BadRequestError_DEFAULT
+    return WorkflowServiceResetWorkflowExecutionResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceRespondActivityTaskCanceledResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceRespondActivityTaskCanceledResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceResetWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceResetWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceRespondActivityTaskCanceledResult_InternalServiceError_DEFAULT
+    return WorkflowServiceResetWorkflowExecutionResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceRespondActivityTaskCanceledResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *WorkflowServiceRespondActivityTaskCanceledResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var WorkflowServiceResetWorkflowExecutionResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceResetWorkflowExecutionResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return WorkflowServiceRespondActivityTaskCanceledResult_EntityNotExistError_DEFAULT
+    return WorkflowServiceResetWorkflowExecutionResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-func (p *WorkflowServiceRespondActivityTaskCanceledResult) IsSetBadRequestError() bool {
+func (p *WorkflowServiceResetWorkflowExecutionResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *WorkflowServiceResetWorkflowExecutionResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceResetWorkflowExecutionResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledResult) IsSetEntityNotExistError() bool {
+func (p *WorkflowServiceResetWorkflowExecutionResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceResetWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -6436,6 +12751,10 @@ func (p *WorkflowServiceRespondActivityTaskCanceledResult) Read(iprot thrift.TPr
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -6463,7 +12782,15 @@ func (p *WorkflowServiceRespondActivityTaskCanceledResult) Read(iprot thrift.TPr
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceResetWorkflowExecutionResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.ResetWorkflowExecutionResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceResetWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -6471,7 +12798,7 @@ func (p *WorkflowServiceRespondActivityTaskCanceledResult)  ReadField1(iprot thr
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceResetWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -6479,7 +12806,7 @@ func (p *WorkflowServiceRespondActivityTaskCanceledResult)  ReadField2(iprot thr
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceResetWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -6487,10 +12814,11 @@ func (p *WorkflowServiceRespondActivityTaskCanceledResult)  ReadField3(iprot thr
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondActivityTaskCanceled_result"); err != nil {
+func (p *WorkflowServiceResetWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ResetWorkflowExecution_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
@@ -6502,7 +12830,20 @@ func (p *WorkflowServiceRespondActivityTaskCanceledResult) Write(oprot thrift.TP
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceResetWorkflowExecutionResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceResetWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -6515,7 +12856,7 @@ func (p *WorkflowServiceRespondActivityTaskCanceledResult) writeField1(oprot thr
   return err
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceResetWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -6528,7 +12869,7 @@ func (p *WorkflowServiceRespondActivityTaskCanceledResult) writeField2(oprot thr
   return err
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceResetWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -6541,35 +12882,35 @@ func (p *WorkflowServiceRespondActivityTaskCanceledResult) writeField3(oprot thr
   return err
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledResult) String() string {
+func (p *WorkflowServiceResetWorkflowExecutionResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceRespondActivityTaskCanceledResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceResetWorkflowExecutionResult(%+v)", *p)
 }
 
 // Attributes:
-//  - CancelRequest
-type WorkflowServiceRequestCancelWorkflowExecutionArgs struct {
-  CancelRequest *shared.RequestCancelWorkflowExecutionRequest `thrift:"cancelRequest,1" db:"cancelRequest" json:"cancelRequest"`
+//  - QueryRequest
+type WorkflowServiceQueryWorkflowArgs struct {
+  QueryRequest *shared.QueryWorkflowRequest `thrift:"queryRequest,1" db:"queryRequest" json:"queryRequest"`
 }
 
-func NewWorkflowServiceRequestCancelWorkflowExecutionArgs() *WorkflowServiceRequestCancelWorkflowExecutionArgs {
-  return &WorkflowServiceRequestCancelWorkflowExecutionArgs{}
+func NewWorkflowServiceQueryWorkflowArgs() *WorkflowServiceQueryWorkflowArgs {
+  return &WorkflowServiceQueryWorkflowArgs{}
 }
 
-var WorkflowServiceRequestCancelWorkflowExecutionArgs_CancelRequest_DEFAULT *shared.RequestCancelWorkflowExecutionRequest
-func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs) GetCancelRequest() *shared.RequestCancelWorkflowExecutionRequest {
-  if !p.IsSetCancelRequest() {
-    return WorkflowServiceRequestCancelWorkflowExecutionArgs_CancelRequest_DEFAULT
+var WorkflowServiceQueryWorkflowArgs_QueryRequest_DEFAULT *shared.QueryWorkflowRequest
+func (p *WorkflowServiceQueryWorkflowArgs) GetQueryRequest() *shared.QueryWorkflowRequest {
+  if !p.IsSetQueryRequest() {
+    return WorkflowServiceQueryWorkflowArgs_QueryRequest_DEFAULT
   }
-return p.CancelRequest
+return p.QueryRequest
 }
-func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs) IsSetCancelRequest() bool {
-  return p.CancelRequest != nil
+func (p *WorkflowServiceQueryWorkflowArgs) IsSetQueryRequest() bool {
+  return p.QueryRequest != nil
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceQueryWorkflowArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -6601,16 +12942,16 @@ func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs) Read(iprot thrift.TP
   return nil
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.CancelRequest = &shared.RequestCancelWorkflowExecutionRequest{}
-  if err := p.CancelRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CancelRequest), err)
+func (p *WorkflowServiceQueryWorkflowArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.QueryRequest = &shared.QueryWorkflowRequest{}
+  if err := p.QueryRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.QueryRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RequestCancelWorkflowExecution_args"); err != nil {
+func (p *WorkflowServiceQueryWorkflowArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("QueryWorkflow_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -6622,72 +12963,98 @@ func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs) Write(oprot thrift.T
   return nil
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("cancelRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:cancelRequest: ", p), err) }
-  if err := p.CancelRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CancelRequest), err)
+func (p *WorkflowServiceQueryWorkflowArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("queryRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:queryRequest: ", p), err) }
+  if err := p.QueryRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.QueryRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:cancelRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:queryRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs) String() string {
+func (p *WorkflowServiceQueryWorkflowArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceRequestCancelWorkflowExecutionArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceQueryWorkflowArgs(%+v)", *p)
 }
 
 // Attributes:
+//  - Success
 //  - BadRequestError
 //  - InternalServiceError
 //  - EntityNotExistError
-type WorkflowServiceRequestCancelWorkflowExecutionResult struct {
+//  - QueryFailedError
+type WorkflowServiceQueryWorkflowResult struct {
+  Success *shared.QueryWorkflowResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+  QueryFailedError *shared.QueryFailedError `thrift:"queryFailedError,4" db:"queryFailedError" json:"queryFailedError,omitempty"`
 }
 
-func NewWorkflowServiceRequestCancelWorkflowExecutionResult() *WorkflowServiceRequestCancelWorkflowExecutionResult {
-  return &WorkflowServiceRequestCancelWorkflowExecutionResult{}
+func NewWorkflowServiceQueryWorkflowResult() *WorkflowServiceQueryWorkflowResult {
+  return &WorkflowServiceQueryWorkflowResult{}
 }
 
-var WorkflowServiceRequestCancelWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceQueryWorkflowResult_Success_DEFAULT *shared.QueryWorkflowResponse
+func (p *WorkflowServiceQueryWorkflowResult) GetSuccess() *shared.QueryWorkflowResponse {
+  if !p.IsSetSuccess() {
+    return WorkflowServiceQueryWorkflowResult_Success_DEFAULT
+  }
+return p.Success
+}
+var WorkflowServiceQueryWorkflowResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceQueryWorkflowResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceRequestCancelWorkflowExecutionResult_BadRequestError_DEFAULT
+    return WorkflowServiceQueryWorkflowResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceRequestCancelWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceQueryWorkflowResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceQueryWorkflowResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceRequestCancelWorkflowExecutionResult_InternalServiceError_DEFAULT
+    return WorkflowServiceQueryWorkflowResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceRequestCancelWorkflowExecutionResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var WorkflowServiceQueryWorkflowResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceQueryWorkflowResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return WorkflowServiceRequestCancelWorkflowExecutionResult_EntityNotExistError_DEFAULT
+    return WorkflowServiceQueryWorkflowResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) IsSetBadRequestError() bool {
+var WorkflowServiceQueryWorkflowResult_QueryFailedError_DEFAULT *shared.QueryFailedError
+func (p *WorkflowServiceQueryWorkflowResult) GetQueryFailedError() *shared.QueryFailedError {
+  if !p.IsSetQueryFailedError() {
+    return WorkflowServiceQueryWorkflowResult_QueryFailedError_DEFAULT
+  }
+return p.QueryFailedError
+}
+func (p *WorkflowServiceQueryWorkflowResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *WorkflowServiceQueryWorkflowResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceQueryWorkflowResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) IsSetEntityNotExistError() bool {
+func (p *WorkflowServiceQueryWorkflowResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceQueryWorkflowResult) IsSetQueryFailedError() bool {
+  return p.QueryFailedError != nil
+}
+
+func (p *WorkflowServiceQueryWorkflowResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -6700,6 +13067,10 @@ func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) Read(iprot thrift.
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -6712,6 +13083,10 @@ func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) Read(iprot thrift.
       if err := p.ReadField3(iprot); err != nil {
         return err
       }
+    case 4:
+      if err := p.ReadField4(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -6727,7 +13102,15 @@ func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) Read(iprot thrift.
   return nil
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceQueryWorkflowResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.QueryWorkflowResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceQueryWorkflowResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -6735,7 +13118,7 @@ func (p *WorkflowServiceRequestCancelWorkflowExecutionResult)  ReadField1(iprot
   return nil
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceQueryWorkflowResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -6743,7 +13126,7 @@ func (p *WorkflowServiceRequestCancelWorkflowExecutionResult)  ReadField2(iprot
   return nil
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceQueryWorkflowResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -6751,13 +13134,23 @@ func (p *WorkflowServiceRequestCancelWorkflowExecutionResult)  ReadField3(iprot
   return nil
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RequestCancelWorkflowExecution_result"); err != nil {
+func (p *WorkflowServiceQueryWorkflowResult)  ReadField4(iprot thrift.TProtocol) error {
+  p.QueryFailedError = &shared.QueryFailedError{}
+  if err := p.QueryFailedError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.QueryFailedError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceQueryWorkflowResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("QueryWorkflow_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
+    if err := p.writeField4(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -6766,7 +13159,20 @@ func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) Write(oprot thrift
   return nil
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceQueryWorkflowResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceQueryWorkflowResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -6779,7 +13185,7 @@ func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) writeField1(oprot
   return err
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceQueryWorkflowResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -6792,7 +13198,7 @@ func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) writeField2(oprot
   return err
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceQueryWorkflowResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -6805,35 +13211,48 @@ func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) writeField3(oprot
   return err
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) String() string {
+func (p *WorkflowServiceQueryWorkflowResult) writeField4(oprot thrift.TProtocol) (err error) {
+  if p.IsSetQueryFailedError() {
+    if err := oprot.WriteFieldBegin("queryFailedError", thrift.STRUCT, 4); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:queryFailedError: ", p), err) }
+    if err := p.QueryFailedError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.QueryFailedError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 4:queryFailedError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceQueryWorkflowResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceRequestCancelWorkflowExecutionResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceQueryWorkflowResult(%+v)", *p)
 }
 
 // Attributes:
-//  - SignalRequest
-type WorkflowServiceSignalWorkflowExecutionArgs struct {
-  SignalRequest *shared.SignalWorkflowExecutionRequest `thrift:"signalRequest,1" db:"signalRequest" json:"signalRequest"`
+//  - CompleteRequest
+type WorkflowServiceRespondQueryTaskCompletedArgs struct {
+  CompleteRequest *shared.RespondQueryTaskCompletedRequest `thrift:"completeRequest,1" db:"completeRequest" json:"completeRequest"`
 }
 
-func NewWorkflowServiceSignalWorkflowExecutionArgs() *WorkflowServiceSignalWorkflowExecutionArgs {
-  return &WorkflowServiceSignalWorkflowExecutionArgs{}
+func NewWorkflowServiceRespondQueryTaskCompletedArgs() *WorkflowServiceRespondQueryTaskCompletedArgs {
+  return &WorkflowServiceRespondQueryTaskCompletedArgs{}
 }
 
-var WorkflowServiceSignalWorkflowExecutionArgs_SignalRequest_DEFAULT *shared.SignalWorkflowExecutionRequest
-func (p *WorkflowServiceSignalWorkflowExecutionArgs) GetSignalRequest() *shared.SignalWorkflowExecutionRequest {
-  if !p.IsSetSignalRequest() {
-    return WorkflowServiceSignalWorkflowExecutionArgs_SignalRequest_DEFAULT
+var WorkflowServiceRespondQueryTaskCompletedArgs_CompleteRequest_DEFAULT *shared.RespondQueryTaskCompletedRequest
+func (p *WorkflowServiceRespondQueryTaskCompletedArgs) GetCompleteRequest() *shared.RespondQueryTaskCompletedRequest {
+  if !p.IsSetCompleteRequest() {
+    return WorkflowServiceRespondQueryTaskCompletedArgs_CompleteRequest_DEFAULT
   }
-return p.SignalRequest
+return p.CompleteRequest
 }
-func (p *WorkflowServiceSignalWorkflowExecutionArgs) IsSetSignalRequest() bool {
-  return p.SignalRequest != nil
+func (p *WorkflowServiceRespondQueryTaskCompletedArgs) IsSetCompleteRequest() bool {
+  return p.CompleteRequest != nil
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondQueryTaskCompletedArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -6865,16 +13284,16 @@ func (p *WorkflowServiceSignalWorkflowExecutionArgs) Read(iprot thrift.TProtocol
   return nil
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.SignalRequest = &shared.SignalWorkflowExecutionRequest{}
-  if err := p.SignalRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.SignalRequest), err)
+func (p *WorkflowServiceRespondQueryTaskCompletedArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.CompleteRequest = &shared.RespondQueryTaskCompletedRequest{}
+  if err := p.CompleteRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CompleteRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("SignalWorkflowExecution_args"); err != nil {
+func (p *WorkflowServiceRespondQueryTaskCompletedArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondQueryTaskCompleted_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -6886,72 +13305,72 @@ func (p *WorkflowServiceSignalWorkflowExecutionArgs) Write(oprot thrift.TProtoco
   return nil
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("signalRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:signalRequest: ", p), err) }
-  if err := p.SignalRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.SignalRequest), err)
+func (p *WorkflowServiceRespondQueryTaskCompletedArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("completeRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:completeRequest: ", p), err) }
+  if err := p.CompleteRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CompleteRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:signalRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:completeRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionArgs) String() string {
+func (p *WorkflowServiceRespondQueryTaskCompletedArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceSignalWorkflowExecutionArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceRespondQueryTaskCompletedArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - BadRequestError
 //  - InternalServiceError
 //  - EntityNotExistError
-type WorkflowServiceSignalWorkflowExecutionResult struct {
+type WorkflowServiceRespondQueryTaskCompletedResult struct {
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
 }
 
-func NewWorkflowServiceSignalWorkflowExecutionResult() *WorkflowServiceSignalWorkflowExecutionResult {
-  return &WorkflowServiceSignalWorkflowExecutionResult{}
+func NewWorkflowServiceRespondQueryTaskCompletedResult() *WorkflowServiceRespondQueryTaskCompletedResult {
+  return &WorkflowServiceRespondQueryTaskCompletedResult{}
 }
 
-var WorkflowServiceSignalWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceSignalWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceRespondQueryTaskCompletedResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceRespondQueryTaskCompletedResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceSignalWorkflowExecutionResult_BadRequestError_DEFAULT
+    return WorkflowServiceRespondQueryTaskCompletedResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceSignalWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceSignalWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceRespondQueryTaskCompletedResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceRespondQueryTaskCompletedResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceSignalWorkflowExecutionResult_InternalServiceError_DEFAULT
+    return WorkflowServiceRespondQueryTaskCompletedResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceSignalWorkflowExecutionResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *WorkflowServiceSignalWorkflowExecutionResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var WorkflowServiceRespondQueryTaskCompletedResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceRespondQueryTaskCompletedResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return WorkflowServiceSignalWorkflowExecutionResult_EntityNotExistError_DEFAULT
+    return WorkflowServiceRespondQueryTaskCompletedResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-func (p *WorkflowServiceSignalWorkflowExecutionResult) IsSetBadRequestError() bool {
+func (p *WorkflowServiceRespondQueryTaskCompletedResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceRespondQueryTaskCompletedResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionResult) IsSetEntityNotExistError() bool {
+func (p *WorkflowServiceRespondQueryTaskCompletedResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondQueryTaskCompletedResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -6991,7 +13410,7 @@ func (p *WorkflowServiceSignalWorkflowExecutionResult) Read(iprot thrift.TProtoc
   return nil
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondQueryTaskCompletedResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -6999,7 +13418,7 @@ func (p *WorkflowServiceSignalWorkflowExecutionResult)  ReadField1(iprot thrift.
   return nil
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondQueryTaskCompletedResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -7007,7 +13426,7 @@ func (p *WorkflowServiceSignalWorkflowExecutionResult)  ReadField2(iprot thrift.
   return nil
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondQueryTaskCompletedResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -7015,8 +13434,8 @@ func (p *WorkflowServiceSignalWorkflowExecutionResult)  ReadField3(iprot thrift.
   return nil
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("SignalWorkflowExecution_result"); err != nil {
+func (p *WorkflowServiceRespondQueryTaskCompletedResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondQueryTaskCompleted_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -7030,7 +13449,7 @@ func (p *WorkflowServiceSignalWorkflowExecutionResult) Write(oprot thrift.TProto
   return nil
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRespondQueryTaskCompletedResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -7043,7 +13462,7 @@ func (p *WorkflowServiceSignalWorkflowExecutionResult) writeField1(oprot thrift.
   return err
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRespondQueryTaskCompletedResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -7056,7 +13475,7 @@ func (p *WorkflowServiceSignalWorkflowExecutionResult) writeField2(oprot thrift.
   return err
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRespondQueryTaskCompletedResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -7069,35 +13488,35 @@ func (p *WorkflowServiceSignalWorkflowExecutionResult) writeField3(oprot thrift.
   return err
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionResult) String() string {
+func (p *WorkflowServiceRespondQueryTaskCompletedResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceSignalWorkflowExecutionResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceRespondQueryTaskCompletedResult(%+v)", *p)
 }
 
 // Attributes:
-//  - TerminateRequest
-type WorkflowServiceTerminateWorkflowExecutionArgs struct {
-  TerminateRequest *shared.TerminateWorkflowExecutionRequest `thrift:"terminateRequest,1" db:"terminateRequest" json:"terminateRequest"`
+//  - StartRequest
+type WorkflowServiceStartBatchOperationArgs struct {
+  StartRequest *shared.StartBatchOperationRequest `thrift:"startRequest,1" db:"startRequest" json:"startRequest"`
 }
-
-func NewWorkflowServiceTerminateWorkflowExecutionArgs() *WorkflowServiceTerminateWorkflowExecutionArgs {
-  return &WorkflowServiceTerminateWorkflowExecutionArgs{}
+
+func NewWorkflowServiceStartBatchOperationArgs() *WorkflowServiceStartBatchOperationArgs {
+  return &WorkflowServiceStartBatchOperationArgs{}
 }
 
-var WorkflowServiceTerminateWorkflowExecutionArgs_TerminateRequest_DEFAULT *shared.TerminateWorkflowExecutionRequest
-func (p *WorkflowServiceTerminateWorkflowExecutionArgs) GetTerminateRequest() *shared.TerminateWorkflowExecutionRequest {
-  if !p.IsSetTerminateRequest() {
-    return WorkflowServiceTerminateWorkflowExecutionArgs_TerminateRequest_DEFAULT
+var WorkflowServiceStartBatchOperationArgs_StartRequest_DEFAULT *shared.StartBatchOperationRequest
+func (p *WorkflowServiceStartBatchOperationArgs) GetStartRequest() *shared.StartBatchOperationRequest {
+  if !p.IsSetStartRequest() {
+    return WorkflowServiceStartBatchOperationArgs_StartRequest_DEFAULT
   }
-return p.TerminateRequest
+return p.StartRequest
 }
-func (p *WorkflowServiceTerminateWorkflowExecutionArgs) IsSetTerminateRequest() bool {
-  return p.TerminateRequest != nil
+func (p *WorkflowServiceStartBatchOperationArgs) IsSetStartRequest() bool {
+  return p.StartRequest != nil
 }
 
-func (p *WorkflowServiceTerminateWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceStartBatchOperationArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -7129,16 +13548,16 @@ func (p *WorkflowServiceTerminateWorkflowExecutionArgs) Read(iprot thrift.TProto
   return nil
 }
 
-func (p *WorkflowServiceTerminateWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.TerminateRequest = &shared.TerminateWorkflowExecutionRequest{}
-  if err := p.TerminateRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TerminateRequest), err)
+func (p *WorkflowServiceStartBatchOperationArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.StartRequest = &shared.StartBatchOperationRequest{}
+  if err := p.StartRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.StartRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceTerminateWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("TerminateWorkflowExecution_args"); err != nil {
+func (p *WorkflowServiceStartBatchOperationArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("StartBatchOperation_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -7150,72 +13569,85 @@ func (p *WorkflowServiceTerminateWorkflowExecutionArgs) Write(oprot thrift.TProt
   return nil
 }
 
-func (p *WorkflowServiceTerminateWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("terminateRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:terminateRequest: ", p), err) }
-  if err := p.TerminateRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TerminateRequest), err)
+func (p *WorkflowServiceStartBatchOperationArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("startRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:startRequest: ", p), err) }
+  if err := p.StartRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.StartRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:terminateRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:startRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServiceTerminateWorkflowExecutionArgs) String() string {
+func (p *WorkflowServiceStartBatchOperationArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceTerminateWorkflowExecutionArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceStartBatchOperationArgs(%+v)", *p)
 }
 
 // Attributes:
+//  - Success
 //  - BadRequestError
 //  - InternalServiceError
 //  - EntityNotExistError
-type WorkflowServiceTerminateWorkflowExecutionResult struct {
+type WorkflowServiceStartBatchOperationResult struct {
+  Success *shared.StartBatchOperationResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
 }
 
-func NewWorkflowServiceTerminateWorkflowExecutionResult() *WorkflowServiceTerminateWorkflowExecutionResult {
-  return &WorkflowServiceTerminateWorkflowExecutionResult{}
+func NewWorkflowServiceStartBatchOperationResult() *WorkflowServiceStartBatchOperationResult {
+  return &WorkflowServiceStartBatchOperationResult{}
 }
 
-var WorkflowServiceTerminateWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceTerminateWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceStartBatchOperationResult_Success_DEFAULT *shared.StartBatchOperationResponse
+func (p *WorkflowServiceStartBatchOperationResult) GetSuccess() *shared.StartBatchOperationResponse {
+  if !p.IsSetSuccess() {
+    return WorkflowServiceStartBatchOperationResult_Success_DEFAULT
+  }
+return p.Success
+}
+var WorkflowServiceStartBatchOperationResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceStartBatchOperationResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceTerminateWorkflowExecutionResult_BadRequestError_DEFAULT
+    return WorkflowServiceStartBatchOperationResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceTerminateWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceTerminateWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceStartBatchOperationResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceStartBatchOperationResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceTerminateWorkflowExecutionResult_InternalServiceError_DEFAULT
+    return WorkflowServiceStartBatchOperationResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceTerminateWorkflowExecutionResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *WorkflowServiceTerminateWorkflowExecutionResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var WorkflowServiceStartBatchOperationResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceStartBatchOperationResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return WorkflowServiceTerminateWorkflowExecutionResult_EntityNotExistError_DEFAULT
+    return WorkflowServiceStartBatchOperationResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-func (p *WorkflowServiceTerminateWorkflowExecutionResult) IsSetBadRequestError() bool {
+func (p *WorkflowServiceStartBatchOperationResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *WorkflowServiceStartBatchOperationResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceTerminateWorkflowExecutionResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceStartBatchOperationResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServiceTerminateWorkflowExecutionResult) IsSetEntityNotExistError() bool {
+func (p *WorkflowServiceStartBatchOperationResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *WorkflowServiceTerminateWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceStartBatchOperationResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -7228,6 +13660,10 @@ func (p *WorkflowServiceTerminateWorkflowExecutionResult) Read(iprot thrift.TPro
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -7255,7 +13691,15 @@ func (p *WorkflowServiceTerminateWorkflowExecutionResult) Read(iprot thrift.TPro
   return nil
 }
 
-func (p *WorkflowServiceTerminateWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceStartBatchOperationResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.StartBatchOperationResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceStartBatchOperationResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -7263,7 +13707,7 @@ func (p *WorkflowServiceTerminateWorkflowExecutionResult)  ReadField1(iprot thri
   return nil
 }
 
-func (p *WorkflowServiceTerminateWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceStartBatchOperationResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -7271,7 +13715,7 @@ func (p *WorkflowServiceTerminateWorkflowExecutionResult)  ReadField2(iprot thri
   return nil
 }
 
-func (p *WorkflowServiceTerminateWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceStartBatchOperationResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -7279,10 +13723,11 @@ func (p *WorkflowServiceTerminateWorkflowExecutionResult)  ReadField3(iprot thri
   return nil
 }
 
-func (p *WorkflowServiceTerminateWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("TerminateWorkflowExecution_result"); err != nil {
+func (p *WorkflowServiceStartBatchOperationResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("StartBatchOperation_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
@@ -7294,7 +13739,20 @@ func (p *WorkflowServiceTerminateWorkflowExecutionResult) Write(oprot thrift.TPr
   return nil
 }
 
-func (p *WorkflowServiceTerminateWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceStartBatchOperationResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceStartBatchOperationResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -7307,7 +13765,7 @@ func (p *WorkflowServiceTerminateWorkflowExecutionResult) writeField1(oprot thri
   return err
 }
 
-func (p *WorkflowServiceTerminateWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceStartBatchOperationResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -7320,7 +13778,7 @@ func (p *WorkflowServiceTerminateWorkflowExecutionResult) writeField2(oprot thri
   return err
 }
 
-func (p *WorkflowServiceTerminateWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceStartBatchOperationResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -7333,35 +13791,35 @@ func (p *WorkflowServiceTerminateWorkflowExecutionResult) writeField3(oprot thri
   return err
 }
 
-func (p *WorkflowServiceTerminateWorkflowExecutionResult) String() string {
+func (p *WorkflowServiceStartBatchOperationResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceTerminateWorkflowExecutionResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceStartBatchOperationResult(%+v)", *p)
 }
 
 // Attributes:
-//  - ListRequest
-type WorkflowServiceListOpenWorkflowExecutionsArgs struct {
-  ListRequest *shared.ListOpenWorkflowExecutionsRequest `thrift:"listRequest,1" db:"listRequest" json:"listRequest"`
+//  - DescribeRequest
+type WorkflowServiceDescribeBatchOperationArgs struct {
+  DescribeRequest *shared.DescribeBatchOperationRequest `thrift:"describeRequest,1" db:"describeRequest" json:"describeRequest"`
 }
 
-func NewWorkflowServiceListOpenWorkflowExecutionsArgs() *WorkflowServiceListOpenWorkflowExecutionsArgs {
-  return &WorkflowServiceListOpenWorkflowExecutionsArgs{}
+func NewWorkflowServiceDescribeBatchOperationArgs() *WorkflowServiceDescribeBatchOperationArgs {
+  return &WorkflowServiceDescribeBatchOperationArgs{}
 }
 
-var WorkflowServiceListOpenWorkflowExecutionsArgs_ListRequest_DEFAULT *shared.ListOpenWorkflowExecutionsRequest
-func (p *WorkflowServiceListOpenWorkflowExecutionsArgs) GetListRequest() *shared.ListOpenWorkflowExecutionsRequest {
-  if !p.IsSetListRequest() {
-    return WorkflowServiceListOpenWorkflowExecutionsArgs_ListRequest_DEFAULT
+var WorkflowServiceDescribeBatchOperationArgs_DescribeRequest_DEFAULT *shared.DescribeBatchOperationRequest
+func (p *WorkflowServiceDescribeBatchOperationArgs) GetDescribeRequest() *shared.DescribeBatchOperationRequest {
+  if !p.IsSetDescribeRequest() {
+    return WorkflowServiceDescribeBatchOperationArgs_DescribeRequest_DEFAULT
   }
-return p.ListRequest
+return p.DescribeRequest
 }
-func (p *WorkflowServiceListOpenWorkflowExecutionsArgs) IsSetListRequest() bool {
-  return p.ListRequest != nil
+func (p *WorkflowServiceDescribeBatchOperationArgs) IsSetDescribeRequest() bool {
+  return p.DescribeRequest != nil
 }
 
-func (p *WorkflowServiceListOpenWorkflowExecutionsArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceDescribeBatchOperationArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -7393,16 +13851,16 @@ func (p *WorkflowServiceListOpenWorkflowExecutionsArgs) Read(iprot thrift.TProto
   return nil
 }
 
-func (p *WorkflowServiceListOpenWorkflowExecutionsArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.ListRequest = &shared.ListOpenWorkflowExecutionsRequest{}
-  if err := p.ListRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ListRequest), err)
+func (p *WorkflowServiceDescribeBatchOperationArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.DescribeRequest = &shared.DescribeBatchOperationRequest{}
+  if err := p.DescribeRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DescribeRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceListOpenWorkflowExecutionsArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("ListOpenWorkflowExecutions_args"); err != nil {
+func (p *WorkflowServiceDescribeBatchOperationArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("DescribeBatchOperation_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -7414,22 +13872,22 @@ func (p *WorkflowServiceListOpenWorkflowExecutionsArgs) Write(oprot thrift.TProt
   return nil
 }
 
-func (p *WorkflowServiceListOpenWorkflowExecutionsArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("listRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:listRequest: ", p), err) }
-  if err := p.ListRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ListRequest), err)
+func (p *WorkflowServiceDescribeBatchOperationArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("describeRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:describeRequest: ", p), err) }
+  if err := p.DescribeRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DescribeRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:listRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:describeRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServiceListOpenWorkflowExecutionsArgs) String() string {
+func (p *WorkflowServiceDescribeBatchOperationArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceListOpenWorkflowExecutionsArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceDescribeBatchOperationArgs(%+v)", *p)
 }
 
 // Attributes:
@@ -7437,62 +13895,62 @@ func (p *WorkflowServiceListOpenWorkflowExecutionsArgs) String() string {
 //  - BadRequestError
 //  - InternalServiceError
 //  - EntityNotExistError
-type WorkflowServiceListOpenWorkflowExecutionsResult struct {
-  Success *shared.ListOpenWorkflowExecutionsResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+type WorkflowServiceDescribeBatchOperationResult struct {
+  Success *shared.DescribeBatchOperationResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
 }
 
-func NewWorkflowServiceListOpenWorkflowExecutionsResult() *WorkflowServiceListOpenWorkflowExecutionsResult {
-  return &WorkflowServiceListOpenWorkflowExecutionsResult{}
+func NewWorkflowServiceDescribeBatchOperationResult() *WorkflowServiceDescribeBatchOperationResult {
+  return &WorkflowServiceDescribeBatchOperationResult{}
 }
 
-var WorkflowServiceListOpenWorkflowExecutionsResult_Success_DEFAULT *shared.ListOpenWorkflowExecutionsResponse
-func (p *WorkflowServiceListOpenWorkflowExecutionsResult) GetSuccess() *shared.ListOpenWorkflowExecutionsResponse {
+var WorkflowServiceDescribeBatchOperationResult_Success_DEFAULT *shared.DescribeBatchOperationResponse
+func (p *WorkflowServiceDescribeBatchOperationResult) GetSuccess() *shared.DescribeBatchOperationResponse {
   if !p.IsSetSuccess() {
-    return WorkflowServiceListOpenWorkflowExecutionsResult_Success_DEFAULT
+    return WorkflowServiceDescribeBatchOperationResult_Success_DEFAULT
   }
 return p.Success
 }
-var WorkflowServiceListOpenWorkflowExecutionsResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceListOpenWorkflowExecutionsResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceDescribeBatchOperationResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceDescribeBatchOperationResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceListOpenWorkflowExecutionsResult_BadRequestError_DEFAULT
+    return WorkflowServiceDescribeBatchOperationResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceListOpenWorkflowExecutionsResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceListOpenWorkflowExecutionsResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceDescribeBatchOperationResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceDescribeBatchOperationResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceListOpenWorkflowExecutionsResult_InternalServiceError_DEFAULT
+    return WorkflowServiceDescribeBatchOperationResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceListOpenWorkflowExecutionsResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *WorkflowServiceListOpenWorkflowExecutionsResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var WorkflowServiceDescribeBatchOperationResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceDescribeBatchOperationResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return WorkflowServiceListOpenWorkflowExecutionsResult_EntityNotExistError_DEFAULT
+    return WorkflowServiceDescribeBatchOperationResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-func (p *WorkflowServiceListOpenWorkflowExecutionsResult) IsSetSuccess() bool {
+func (p *WorkflowServiceDescribeBatchOperationResult) IsSetSuccess() bool {
   return p.Success != nil
 }
 
-func (p *WorkflowServiceListOpenWorkflowExecutionsResult) IsSetBadRequestError() bool {
+func (p *WorkflowServiceDescribeBatchOperationResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceListOpenWorkflowExecutionsResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceDescribeBatchOperationResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServiceListOpenWorkflowExecutionsResult) IsSetEntityNotExistError() bool {
+func (p *WorkflowServiceDescribeBatchOperationResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *WorkflowServiceListOpenWorkflowExecutionsResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceDescribeBatchOperationResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -7536,15 +13994,15 @@ func (p *WorkflowServiceListOpenWorkflowExecutionsResult) Read(iprot thrift.TPro
   return nil
 }
 
-func (p *WorkflowServiceListOpenWorkflowExecutionsResult)  ReadField0(iprot thrift.TProtocol) error {
-  p.Success = &shared.ListOpenWorkflowExecutionsResponse{}
+func (p *WorkflowServiceDescribeBatchOperationResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.DescribeBatchOperationResponse{}
   if err := p.Success.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceListOpenWorkflowExecutionsResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceDescribeBatchOperationResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -7552,7 +14010,7 @@ func (p *WorkflowServiceListOpenWorkflowExecutionsResult)  ReadField1(iprot thri
   return nil
 }
 
-func (p *WorkflowServiceListOpenWorkflowExecutionsResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceDescribeBatchOperationResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -7560,7 +14018,7 @@ func (p *WorkflowServiceListOpenWorkflowExecutionsResult)  ReadField2(iprot thri
   return nil
 }
 
-func (p *WorkflowServiceListOpenWorkflowExecutionsResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceDescribeBatchOperationResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -7568,8 +14026,8 @@ func (p *WorkflowServiceListOpenWorkflowExecutionsResult)  ReadField3(iprot thri
   return nil
 }
 
-func (p *WorkflowServiceListOpenWorkflowExecutionsResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("ListOpenWorkflowExecutions_result"); err != nil {
+func (p *WorkflowServiceDescribeBatchOperationResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("DescribeBatchOperation_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField0(oprot); err != nil { return err }
@@ -7584,7 +14042,7 @@ func (p *WorkflowServiceListOpenWorkflowExecutionsResult) Write(oprot thrift.TPr
   return nil
 }
 
-func (p *WorkflowServiceListOpenWorkflowExecutionsResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceDescribeBatchOperationResult) writeField0(oprot thrift.TProtocol) (err error) {
   if p.IsSetSuccess() {
     if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
@@ -7597,7 +14055,7 @@ func (p *WorkflowServiceListOpenWorkflowExecutionsResult) writeField0(oprot thri
   return err
 }
 
-func (p *WorkflowServiceListOpenWorkflowExecutionsResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceDescribeBatchOperationResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -7610,7 +14068,7 @@ func (p *WorkflowServiceListOpenWorkflowExecutionsResult) writeField1(oprot thri
   return err
 }
 
-func (p *WorkflowServiceListOpenWorkflowExecutionsResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceDescribeBatchOperationResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -7623,7 +14081,7 @@ func (p *WorkflowServiceListOpenWorkflowExecutionsResult) writeField2(oprot thri
   return err
 }
 
-func (p *WorkflowServiceListOpenWorkflowExecutionsResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceDescribeBatchOperationResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -7636,35 +14094,35 @@ func (p *WorkflowServiceListOpenWorkflowExecutionsResult) writeField3(oprot thri
   return err
 }
 
-func (p *WorkflowServiceListOpenWorkflowExecutionsResult) String() string {
+func (p *WorkflowServiceDescribeBatchOperationResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceListOpenWorkflowExecutionsResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceDescribeBatchOperationResult(%+v)", *p)
 }
 
 // Attributes:
-//  - ListRequest
-type WorkflowServiceListClosedWorkflowExecutionsArgs struct {
-  ListRequest *shared.ListClosedWorkflowExecutionsRequest `thrift:"listRequest,1" db:"listRequest" json:"listRequest"`
+//  - StopRequest
+type WorkflowServiceStopBatchOperationArgs struct {
+  StopRequest *shared.StopBatchOperationRequest `thrift:"stopRequest,1" db:"stopRequest" json:"stopRequest"`
 }
 
-func NewWorkflowServiceListClosedWorkflowExecutionsArgs() *WorkflowServiceListClosedWorkflowExecutionsArgs {
-  return &WorkflowServiceListClosedWorkflowExecutionsArgs{}
+func NewWorkflowServiceStopBatchOperationArgs() *WorkflowServiceStopBatchOperationArgs {
+  return &WorkflowServiceStopBatchOperationArgs{}
 }
 
-var WorkflowServiceListClosedWorkflowExecutionsArgs_ListRequest_DEFAULT *shared.ListClosedWorkflowExecutionsRequest
-func (p *WorkflowServiceListClosedWorkflowExecutionsArgs) GetListRequest() *shared.ListClosedWorkflowExecutionsRequest {
-  if !p.IsSetListRequest() {
-    return WorkflowServiceListClosedWorkflowExecutionsArgs_ListRequest_DEFAULT
+var WorkflowServiceStopBatchOperationArgs_StopRequest_DEFAULT *shared.StopBatchOperationRequest
+func (p *WorkflowServiceStopBatchOperationArgs) GetStopRequest() *shared.StopBatchOperationRequest {
+  if !p.IsSetStopRequest() {
+    return WorkflowServiceStopBatchOperationArgs_StopRequest_DEFAULT
   }
-return p.ListRequest
+return p.StopRequest
 }
-func (p *WorkflowServiceListClosedWorkflowExecutionsArgs) IsSetListRequest() bool {
-  return p.ListRequest != nil
+func (p *WorkflowServiceStopBatchOperationArgs) IsSetStopRequest() bool {
+  return p.StopRequest != nil
 }
 
-func (p *WorkflowServiceListClosedWorkflowExecutionsArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceStopBatchOperationArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -7696,16 +14154,16 @@ func (p *WorkflowServiceListClosedWorkflowExecutionsArgs) Read(iprot thrift.TPro
   return nil
 }
 
-func (p *WorkflowServiceListClosedWorkflowExecutionsArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.ListRequest = &shared.ListClosedWorkflowExecutionsRequest{}
-  if err := p.ListRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ListRequest), err)
+func (p *WorkflowServiceStopBatchOperationArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.StopRequest = &shared.StopBatchOperationRequest{}
+  if err := p.StopRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.StopRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceListClosedWorkflowExecutionsArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("ListClosedWorkflowExecutions_args"); err != nil {
+func (p *WorkflowServiceStopBatchOperationArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("StopBatchOperation_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -7717,85 +14175,72 @@ func (p *WorkflowServiceListClosedWorkflowExecutionsArgs) Write(oprot thrift.TPr
   return nil
 }
 
-func (p *WorkflowServiceListClosedWorkflowExecutionsArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("listRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:listRequest: ", p), err) }
-  if err := p.ListRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ListRequest), err)
+func (p *WorkflowServiceStopBatchOperationArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("stopRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:stopRequest: ", p), err) }
+  if err := p.StopRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.StopRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:listRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:stopRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServiceListClosedWorkflowExecutionsArgs) String() string {
+func (p *WorkflowServiceStopBatchOperationArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceListClosedWorkflowExecutionsArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceStopBatchOperationArgs(%+v)", *p)
 }
 
 // Attributes:
-//  - Success
 //  - BadRequestError
 //  - InternalServiceError
 //  - EntityNotExistError
-type WorkflowServiceListClosedWorkflowExecutionsResult struct {
-  Success *shared.ListClosedWorkflowExecutionsResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+type WorkflowServiceStopBatchOperationResult struct {
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
 }
 
-func NewWorkflowServiceListClosedWorkflowExecutionsResult() *WorkflowServiceListClosedWorkflowExecutionsResult {
-  return &WorkflowServiceListClosedWorkflowExecutionsResult{}
+func NewWorkflowServiceStopBatchOperationResult() *WorkflowServiceStopBatchOperationResult {
+  return &WorkflowServiceStopBatchOperationResult{}
 }
 
-var WorkflowServiceListClosedWorkflowExecutionsResult_Success_DEFAULT *shared.ListClosedWorkflowExecutionsResponse
-func (p *WorkflowServiceListClosedWorkflowExecutionsResult) GetSuccess() *shared.ListClosedWorkflowExecutionsResponse {
-  if !p.IsSetSuccess() {
-    return WorkflowServiceListClosedWorkflowExecutionsResult_Success_DEFAULT
-  }
-return p.Success
-}
-var WorkflowServiceListClosedWorkflowExecutionsResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceListClosedWorkflowExecutionsResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceStopBatchOperationResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceStopBatchOperationResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceListClosedWorkflowExecutionsResult_BadRequestError_DEFAULT
+    return WorkflowServiceStopBatchOperationResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceListClosedWorkflowExecutionsResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceListClosedWorkflowExecutionsResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceStopBatchOperationResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceStopBatchOperationResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceListClosedWorkflowExecutionsResult_InternalServiceError_DEFAULT
+    return WorkflowServiceStopBatchOperationResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceListClosedWorkflowExecutionsResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *WorkflowServiceListClosedWorkflowExecutionsResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var WorkflowServiceStopBatchOperationResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceStopBatchOperationResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return WorkflowServiceListClosedWorkflowExecutionsResult_EntityNotExistError_DEFAULT
+    return WorkflowServiceStopBatchOperationResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-func (p *WorkflowServiceListClosedWorkflowExecutionsResult) IsSetSuccess() bool {
-  return p.Success != nil
-}
-
-func (p *WorkflowServiceListClosedWorkflowExecutionsResult) IsSetBadRequestError() bool {
+func (p *WorkflowServiceStopBatchOperationResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceListClosedWorkflowExecutionsResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceStopBatchOperationResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServiceListClosedWorkflowExecutionsResult) IsSetEntityNotExistError() bool {
+func (p *WorkflowServiceStopBatchOperationResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *WorkflowServiceListClosedWorkflowExecutionsResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceStopBatchOperationResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -7808,10 +14253,6 @@ func (p *WorkflowServiceListClosedWorkflowExecutionsResult) Read(iprot thrift.TP
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
-    case 0:
-      if err := p.ReadField0(iprot); err != nil {
-        return err
-      }
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -7839,15 +14280,7 @@ func (p *WorkflowServiceListClosedWorkflowExecutionsResult) Read(iprot thrift.TP
   return nil
 }
 
-func (p *WorkflowServiceListClosedWorkflowExecutionsResult)  ReadField0(iprot thrift.TProtocol) error {
-  p.Success = &shared.ListClosedWorkflowExecutionsResponse{}
-  if err := p.Success.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
-  }
-  return nil
-}
-
-func (p *WorkflowServiceListClosedWorkflowExecutionsResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceStopBatchOperationResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -7855,7 +14288,7 @@ func (p *WorkflowServiceListClosedWorkflowExecutionsResult)  ReadField1(iprot th
   return nil
 }
 
-func (p *WorkflowServiceListClosedWorkflowExecutionsResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceStopBatchOperationResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -7863,7 +14296,7 @@ func (p *WorkflowServiceListClosedWorkflowExecutionsResult)  ReadField2(iprot th
   return nil
 }
 
-func (p *WorkflowServiceListClosedWorkflowExecutionsResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceStopBatchOperationResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -7871,11 +14304,10 @@ func (p *WorkflowServiceListClosedWorkflowExecutionsResult)  ReadField3(iprot th
   return nil
 }
 
-func (p *WorkflowServiceListClosedWorkflowExecutionsResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("ListClosedWorkflowExecutions_result"); err != nil {
+func (p *WorkflowServiceStopBatchOperationResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("StopBatchOperation_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
-    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
@@ -7887,20 +14319,7 @@ func (p *WorkflowServiceListClosedWorkflowExecutionsResult) Write(oprot thrift.T
   return nil
 }
 
-func (p *WorkflowServiceListClosedWorkflowExecutionsResult) writeField0(oprot thrift.TProtocol) (err error) {
-  if p.IsSetSuccess() {
-    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
-    if err := p.Success.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
-  }
-  return err
-}
-
-func (p *WorkflowServiceListClosedWorkflowExecutionsResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceStopBatchOperationResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -7913,7 +14332,7 @@ func (p *WorkflowServiceListClosedWorkflowExecutionsResult) writeField1(oprot th
   return err
 }
 
-func (p *WorkflowServiceListClosedWorkflowExecutionsResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceStopBatchOperationResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -7926,7 +14345,7 @@ func (p *WorkflowServiceListClosedWorkflowExecutionsResult) writeField2(oprot th
   return err
 }
 
-func (p *WorkflowServiceListClosedWorkflowExecutionsResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceStopBatchOperationResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -7939,11 +14358,9 @@ func (p *WorkflowServiceListClosedWorkflowExecutionsResult) writeField3(oprot th
   return err
 }
 
-func (p *WorkflowServiceListClosedWorkflowExecutionsResult) String() string {
+func (p *WorkflowServiceStopBatchOperationResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceListClosedWorkflowExecutionsResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceStopBatchOperationResult(%+v)", *p)
 }
-
-
@@ -38,22 +38,36 @@ var _ = shared.GoUnusedProtection__
 
 // TChanWorkflowService is the interface that defines the server handler and client interface.
 type TChanWorkflowService interface {
+	CountClosedWorkflowExecutions(ctx thrift.Context, listRequest *shared.CountClosedWorkflowExecutionsRequest) (*shared.CountClosedWorkflowExecutionsResponse, error)
+	CountOpenWorkflowExecutions(ctx thrift.Context, listRequest *shared.CountOpenWorkflowExecutionsRequest) (*shared.CountOpenWorkflowExecutionsResponse, error)
 	DeprecateDomain(ctx thrift.Context, deprecateRequest *shared.DeprecateDomainRequest) error
+	DescribeBatchOperation(ctx thrift.Context, describeRequest *shared.DescribeBatchOperationRequest) (*shared.DescribeBatchOperationResponse, error)
 	DescribeDomain(ctx thrift.Context, describeRequest *shared.DescribeDomainRequest) (*shared.DescribeDomainResponse, error)
+	GetClusterInfo(ctx thrift.Context, getRequest *shared.GetClusterInfoRequest) (*shared.GetClusterInfoResponse, error)
+	GetDomainReplicationMessages(ctx thrift.Context, getRequest *shared.GetDomainReplicationMessagesRequest) (*shared.GetDomainReplicationMessagesResponse, error)
 	GetWorkflowExecutionHistory(ctx thrift.Context, getRequest *shared.GetWorkflowExecutionHistoryRequest) (*shared.GetWorkflowExecutionHistoryResponse, error)
+	GetWorkflowResult(ctx thrift.Context, getRequest *shared.GetWorkflowResultRequest) (*shared.GetWorkflowResultResponse, error)
 	ListClosedWorkflowExecutions(ctx thrift.Context, listRequest *shared.ListClosedWorkflowExecutionsRequest) (*shared.ListClosedWorkflowExecutionsResponse, error)
 	ListOpenWorkflowExecutions(ctx thrift.Context, listRequest *shared.ListOpenWorkflowExecutionsRequest) (*shared.ListOpenWorkflowExecutionsResponse, error)
+	ListWorkflowExecutionsWithQuery(ctx thrift.Context, listRequest *shared.ListWorkflowExecutionsWithQueryRequest) (*shared.ListWorkflowExecutionsWithQueryResponse, error)
 	PollForActivityTask(ctx thrift.Context, pollRequest *shared.PollForActivityTaskRequest) (*shared.PollForActivityTaskResponse, error)
 	PollForDecisionTask(ctx thrift.Context, pollRequest *shared.PollForDecisionTaskRequest) (*shared.PollForDecisionTaskResponse, error)
+	QueryWorkflow(ctx thrift.Context, queryRequest *shared.QueryWorkflowRequest) (*shared.QueryWorkflowResponse, error)
 	RecordActivityTaskHeartbeat(ctx thrift.Context, heartbeatRequest *shared.RecordActivityTaskHeartbeatRequest) (*shared.RecordActivityTaskHeartbeatResponse, error)
 	RegisterDomain(ctx thrift.Context, registerRequest *shared.RegisterDomainRequest) error
 	RequestCancelWorkflowExecution(ctx thrift.Context, cancelRequest *shared.RequestCancelWorkflowExecutionRequest) error
+	ResetWorkflowExecution(ctx thrift.Context, resetRequest *shared.ResetWorkflowExecutionRequest) (*shared.ResetWorkflowExecutionResponse, error)
 	RespondActivityTaskCanceled(ctx thrift.Context, canceledRequest *shared.RespondActivityTaskCanceledRequest) error
 	RespondActivityTaskCompleted(ctx thrift.Context, completeRequest *shared.RespondActivityTaskCompletedRequest) error
 	RespondActivityTaskFailed(ctx thrift.Context, failRequest *shared.RespondActivityTaskFailedRequest) error
-	RespondDecisionTaskCompleted(ctx thrift.Context, completeRequest *shared.RespondDecisionTaskCompletedRequest) error
+	RespondDecisionTaskCompleted(ctx thrift.Context, completeRequest *shared.RespondDecisionTaskCompletedRequest) (*shared.RespondDecisionTaskCompletedResponse, error)
+	RespondQueryTaskCompleted(ctx thrift.Context, completeRequest *shared.RespondQueryTaskCompletedRequest) error
+	ScanWorkflowExecutions(ctx thrift.Context, listRequest *shared.ScanWorkflowExecutionsRequest) (*shared.ScanWorkflowExecutionsResponse, error)
+	SignalWithStartWorkflowExecution(ctx thrift.Context, signalWithStartRequest *shared.SignalWithStartWorkflowExecutionRequest) (*shared.StartWorkflowExecutionResponse, error)
 	SignalWorkflowExecution(ctx thrift.Context, signalRequest *shared.SignalWorkflowExecutionRequest) error
+	StartBatchOperation(ctx thrift.Context, startRequest *shared.StartBatchOperationRequest) (*shared.StartBatchOperationResponse, error)
 	StartWorkflowExecution(ctx thrift.Context, startRequest *shared.StartWorkflowExecutionRequest) (*shared.StartWorkflowExecutionResponse, error)
+	StopBatchOperation(ctx thrift.Context, stopRequest *shared.StopBatchOperationRequest) error
 	TerminateWorkflowExecution(ctx thrift.Context, terminateRequest *shared.TerminateWorkflowExecutionRequest) error
 	UpdateDomain(ctx thrift.Context, updateRequest *shared.UpdateDomainRequest) (*shared.UpdateDomainResponse, error)
 }
@@ -77,6 +91,50 @@ func NewTChanWorkflowServiceClient(client thrift.TChanClient) TChanWorkflowServi
 	return NewTChanWorkflowServiceInheritedClient("WorkflowService", client)
 }
 
+func (c *tchanWorkflowServiceClient) CountClosedWorkflowExecutions(ctx thrift.Context, listRequest *shared.CountClosedWorkflowExecutionsRequest) (*shared.CountClosedWorkflowExecutionsResponse, error) {
+	var resp WorkflowServiceCountClosedWorkflowExecutionsResult
+	args := WorkflowServiceCountClosedWorkflowExecutionsArgs{
+		ListRequest: listRequest,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "CountClosedWorkflowExecutions", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.EntityNotExistError != nil:
+			err = resp.EntityNotExistError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for CountClosedWorkflowExecutions")
+		}
+	}
+
+	return resp.GetSuccess(), err
+}
+
+func (c *tchanWorkflowServiceClient) CountOpenWorkflowExecutions(ctx thrift.Context, listRequest *shared.CountOpenWorkflowExecutionsRequest) (*shared.CountOpenWorkflowExecutionsResponse, error) {
+	var resp WorkflowServiceCountOpenWorkflowExecutionsResult
+	args := WorkflowServiceCountOpenWorkflowExecutionsArgs{
+		ListRequest: listRequest,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "CountOpenWorkflowExecutions", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.EntityNotExistError != nil:
+			err = resp.EntityNotExistError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for CountOpenWorkflowExecutions")
+		}
+	}
+
+	return resp.GetSuccess(), err
+}
+
 func (c *tchanWorkflowServiceClient) DeprecateDomain(ctx thrift.Context, deprecateRequest *shared.DeprecateDomainRequest) error {
 	var resp WorkflowServiceDeprecateDomainResult
 	args := WorkflowServiceDeprecateDomainArgs{
@@ -99,6 +157,28 @@ func (c *tchanWorkflowServiceClient) DeprecateDomain(ctx thrift.Context, depreca
 	return err
 }
 
+func (c *tchanWorkflowServiceClient) DescribeBatchOperation(ctx thrift.Context, describeRequest *shared.DescribeBatchOperationRequest) (*shared.DescribeBatchOperationResponse, error) {
+	var resp WorkflowServiceDescribeBatchOperationResult
+	args := WorkflowServiceDescribeBatchOperationArgs{
+		DescribeRequest: describeRequest,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "DescribeBatchOperation", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.EntityNotExistError != nil:
+			err = resp.EntityNotExistError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for DescribeBatchOperation")
+		}
+	}
+
+	return resp.GetSuccess(), err
+}
+
 func (c *tchanWorkflowServiceClient) DescribeDomain(ctx thrift.Context, describeRequest *shared.DescribeDomainRequest) (*shared.DescribeDomainResponse, error) {
 	var resp WorkflowServiceDescribeDomainResult
 	args := WorkflowServiceDescribeDomainArgs{
@@ -121,6 +201,50 @@ func (c *tchanWorkflowServiceClient) DescribeDomain(ctx thrift.Context, describe
 	return resp.GetSuccess(), err
 }
 
+func (c *tchanWorkflowServiceClient) GetClusterInfo(ctx thrift.Context, getRequest *shared.GetClusterInfoRequest) (*shared.GetClusterInfoResponse, error) {
+	var resp WorkflowServiceGetClusterInfoResult
+	args := WorkflowServiceGetClusterInfoArgs{
+		GetRequest: getRequest,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "GetClusterInfo", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.EntityNotExistError != nil:
+			err = resp.EntityNotExistError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for GetClusterInfo")
+		}
+	}
+
+	return resp.GetSuccess(), err
+}
+
+func (c *tchanWorkflowServiceClient) GetDomainReplicationMessages(ctx thrift.Context, getRequest *shared.GetDomainReplicationMessagesRequest) (*shared.GetDomainReplicationMessagesResponse, error) {
+	var resp WorkflowServiceGetDomainReplicationMessagesResult
+	args := WorkflowServiceGetDomainReplicationMessagesArgs{
+		GetRequest: getRequest,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "GetDomainReplicationMessages", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.EntityNotExistError != nil:
+			err = resp.EntityNotExistError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for GetDomainReplicationMessages")
+		}
+	}
+
+	return resp.GetSuccess(), err
+}
+
 func (c *tchanWorkflowServiceClient) GetWorkflowExecutionHistory(ctx thrift.Context, getRequest *shared.GetWorkflowExecutionHistoryRequest) (*shared.GetWorkflowExecutionHistoryResponse, error) {
 	var resp WorkflowServiceGetWorkflowExecutionHistoryResult
 	args := WorkflowServiceGetWorkflowExecutionHistoryArgs{
@@ -143,6 +267,28 @@ func (c *tchanWorkflowServiceClient) GetWorkflowExecutionHistory(ctx thrift.Cont
 	return resp.GetSuccess(), err
 }
 
+func (c *tchanWorkflowServiceClient) GetWorkflowResult(ctx thrift.Context, getRequest *shared.GetWorkflowResultRequest) (*shared.GetWorkflowResultResponse, error) {
+	var resp WorkflowServiceGetWorkflowResultResult
+	args := WorkflowServiceGetWorkflowResultArgs{
+		GetRequest: getRequest,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "GetWorkflowResult", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.EntityNotExistError != nil:
+			err = resp.EntityNotExistError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for GetWorkflowResult")
+		}
+	}
+
+	return resp.GetSuccess(), err
+}
+
 func (c *tchanWorkflowServiceClient) ListClosedWorkflowExecutions(ctx thrift.Context, listRequest *shared.ListClosedWorkflowExecutionsRequest) (*shared.ListClosedWorkflowExecutionsResponse, error) {
 	var resp WorkflowServiceListClosedWorkflowExecutionsResult
 	args := WorkflowServiceListClosedWorkflowExecutionsArgs{
@@ -187,6 +333,28 @@ func (c *tchanWorkflowServiceClient) ListOpenWorkflowExecutions(ctx thrift.Conte
 	return resp.GetSuccess(), err
 }
 
+func (c *tchanWorkflowServiceClient) ListWorkflowExecutionsWithQuery(ctx thrift.Context, listRequest *shared.ListWorkflowExecutionsWithQueryRequest) (*shared.ListWorkflowExecutionsWithQueryResponse, error) {
+	var resp WorkflowServiceListWorkflowExecutionsWithQueryResult
+	args := WorkflowServiceListWorkflowExecutionsWithQueryArgs{
+		ListRequest: listRequest,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "ListWorkflowExecutionsWithQuery", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.EntityNotExistError != nil:
+			err = resp.EntityNotExistError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for ListWorkflowExecutionsWithQuery")
+		}
+	}
+
+	return resp.GetSuccess(), err
+}
+
 func (c *tchanWorkflowServiceClient) PollForActivityTask(ctx thrift.Context, pollRequest *shared.PollForActivityTaskRequest) (*shared.PollForActivityTaskResponse, error) {
 	var resp WorkflowServicePollForActivityTaskResult
 	args := WorkflowServicePollForActivityTaskArgs{
@@ -227,6 +395,30 @@ func (c *tchanWorkflowServiceClient) PollForDecisionTask(ctx thrift.Context, pol
 	return resp.GetSuccess(), err
 }
 
+func (c *tchanWorkflowServiceClient) QueryWorkflow(ctx thrift.Context, queryRequest *shared.QueryWorkflowRequest) (*shared.QueryWorkflowResponse, error) {
+	var resp WorkflowServiceQueryWorkflowResult
+	args := WorkflowServiceQueryWorkflowArgs{
+		QueryRequest: queryRequest,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "QueryWorkflow", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.EntityNotExistError != nil:
+			err = resp.EntityNotExistError
+		case resp.QueryFailedError != nil:
+			err = resp.QueryFailedError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for QueryWorkflow")
+		}
+	}
+
+	return resp.GetSuccess(), err
+}
+
 func (c *tchanWorkflowServiceClient) RecordActivityTaskHeartbeat(ctx thrift.Context, heartbeatRequest *shared.RecordActivityTaskHeartbeatRequest) (*shared.RecordActivityTaskHeartbeatResponse, error) {
 	var resp WorkflowServiceRecordActivityTaskHeartbeatResult
 	args := WorkflowServiceRecordActivityTaskHeartbeatArgs{
@@ -293,6 +485,28 @@ func (c *tchanWorkflowServiceClient) RequestCancelWorkflowExecution(ctx thrift.C
 	return err
 }
 
+func (c *tchanWorkflowServiceClient) ResetWorkflowExecution(ctx thrift.Context, resetRequest *shared.ResetWorkflowExecutionRequest) (*shared.ResetWorkflowExecutionResponse, error) {
+	var resp WorkflowServiceResetWorkflowExecutionResult
+	args := WorkflowServiceResetWorkflowExecutionArgs{
+		ResetRequest: resetRequest,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "ResetWorkflowExecution", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.EntityNotExistError != nil:
+			err = resp.EntityNotExistError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for ResetWorkflowExecution")
+		}
+	}
+
+	return resp.GetSuccess(), err
+}
+
 func (c *tchanWorkflowServiceClient) RespondActivityTaskCanceled(ctx thrift.Context, canceledRequest *shared.RespondActivityTaskCanceledRequest) error {
 	var resp WorkflowServiceRespondActivityTaskCanceledResult
 	args := WorkflowServiceRespondActivityTaskCanceledArgs{
@@ -359,7 +573,7 @@ func (c *tchanWorkflowServiceClient) RespondActivityTaskFailed(ctx thrift.Contex
 	return err
 }
 
-func (c *tchanWorkflowServiceClient) RespondDecisionTaskCompleted(ctx thrift.Context, completeRequest *shared.RespondDecisionTaskCompletedRequest) error {
+func (c *tchanWorkflowServiceClient) RespondDecisionTaskCompleted(ctx thrift.Context, completeRequest *shared.RespondDecisionTaskCompletedRequest) (*shared.RespondDecisionTaskCompletedResponse, error) {
 	var resp WorkflowServiceRespondDecisionTaskCompletedResult
 	args := WorkflowServiceRespondDecisionTaskCompletedArgs{
 		CompleteRequest: completeRequest,
@@ -378,9 +592,75 @@ func (c *tchanWorkflowServiceClient) RespondDecisionTaskCompleted(ctx thrift.Con
 		}
 	}
 
+	return resp.GetSuccess(), err
+}
+
+func (c *tchanWorkflowServiceClient) RespondQueryTaskCompleted(ctx thrift.Context, completeRequest *shared.RespondQueryTaskCompletedRequest) error {
+	var resp WorkflowServiceRespondQueryTaskCompletedResult
+	args := WorkflowServiceRespondQueryTaskCompletedArgs{
+		CompleteRequest: completeRequest,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "RespondQueryTaskCompleted", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.EntityNotExistError != nil:
+			err = resp.EntityNotExistError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for RespondQueryTaskCompleted")
+		}
+	}
+
 	return err
 }
 
+func (c *tchanWorkflowServiceClient) ScanWorkflowExecutions(ctx thrift.Context, listRequest *shared.ScanWorkflowExecutionsRequest) (*shared.ScanWorkflowExecutionsResponse, error) {
+	var resp WorkflowServiceScanWorkflowExecutionsResult
+	args := WorkflowServiceScanWorkflowExecutionsArgs{
+		ListRequest: listRequest,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "ScanWorkflowExecutions", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.EntityNotExistError != nil:
+			err = resp.EntityNotExistError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for ScanWorkflowExecutions")
+		}
+	}
+
+	return resp.GetSuccess(), err
+}
+
+func (c *tchanWorkflowServiceClient) SignalWithStartWorkflowExecution(ctx thrift.Context, signalWithStartRequest *shared.SignalWithStartWorkflowExecutionRequest) (*shared.StartWorkflowExecutionResponse, error) {
+	var resp WorkflowServiceSignalWithStartWorkflowExecutionResult
+	args := WorkflowServiceSignalWithStartWorkflowExecutionArgs{
+		SignalWithStartRequest: signalWithStartRequest,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "SignalWithStartWorkflowExecution", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.SessionAlreadyExistError != nil:
+			err = resp.SessionAlreadyExistError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for SignalWithStartWorkflowExecution")
+		}
+	}
+
+	return resp.GetSuccess(), err
+}
+
 func (c *tchanWorkflowServiceClient) SignalWorkflowExecution(ctx thrift.Context, signalRequest *shared.SignalWorkflowExecutionRequest) error {
 	var resp WorkflowServiceSignalWorkflowExecutionResult
 	args := WorkflowServiceSignalWorkflowExecutionArgs{
@@ -403,6 +683,28 @@ func (c *tchanWorkflowServiceClient) SignalWorkflowExecution(ctx thrift.Context,
 	return err
 }
 
+func (c *tchanWorkflowServiceClient) StartBatchOperation(ctx thrift.Context, startRequest *shared.StartBatchOperationRequest) (*shared.StartBatchOperationResponse, error) {
+	var resp WorkflowServiceStartBatchOperationResult
+	args := WorkflowServiceStartBatchOperationArgs{
+		StartRequest: startRequest,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "StartBatchOperation", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.EntityNotExistError != nil:
+			err = resp.EntityNotExistError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for StartBatchOperation")
+		}
+	}
+
+	return resp.GetSuccess(), err
+}
+
 func (c *tchanWorkflowServiceClient) StartWorkflowExecution(ctx thrift.Context, startRequest *shared.StartWorkflowExecutionRequest) (*shared.StartWorkflowExecutionResponse, error) {
 	var resp WorkflowServiceStartWorkflowExecutionResult
 	args := WorkflowServiceStartWorkflowExecutionArgs{
@@ -425,6 +727,28 @@ func (c *tchanWorkflowServiceClient) StartWorkflowExecution(ctx thrift.Context,
 	return resp.GetSuccess(), err
 }
 
+func (c *tchanWorkflowServiceClient) StopBatchOperation(ctx thrift.Context, stopRequest *shared.StopBatchOperationRequest) error {
+	var resp WorkflowServiceStopBatchOperationResult
+	args := WorkflowServiceStopBatchOperationArgs{
+		StopRequest: stopRequest,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "StopBatchOperation", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.EntityNotExistError != nil:
+			err = resp.EntityNotExistError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for StopBatchOperation")
+		}
+	}
+
+	return err
+}
+
 func (c *tchanWorkflowServiceClient) TerminateWorkflowExecution(ctx thrift.Context, terminateRequest *shared.TerminateWorkflowExecutionRequest) error {
 	var resp WorkflowServiceTerminateWorkflowExecutionResult
 	args := WorkflowServiceTerminateWorkflowExecutionArgs{
@@ -487,22 +811,36 @@ func (s *tchanWorkflowServiceServer) Service() string {
 
 func (s *tchanWorkflowServiceServer) Methods() []string {
 	return []string{
+		"CountClosedWorkflowExecutions",
+		"CountOpenWorkflowExecutions",
 		"DeprecateDomain",
+		"DescribeBatchOperation",
 		"DescribeDomain",
+		"GetClusterInfo",
+		"GetDomainReplicationMessages",
 		"GetWorkflowExecutionHistory",
+		"GetWorkflowResult",
 		"ListClosedWorkflowExecutions",
 		"ListOpenWorkflowExecutions",
+		"ListWorkflowExecutionsWithQuery",
 		"PollForActivityTask",
 		"PollForDecisionTask",
+		"QueryWorkflow",
 		"RecordActivityTaskHeartbeat",
 		"RegisterDomain",
 		"RequestCancelWorkflowExecution",
+		"ResetWorkflowExecution",
 		"RespondActivityTaskCanceled",
 		"RespondActivityTaskCompleted",
 		"RespondActivityTaskFailed",
 		"RespondDecisionTaskCompleted",
+		"RespondQueryTaskCompleted",
+		"ScanWorkflowExecutions",
+		"SignalWithStartWorkflowExecution",
 		"SignalWorkflowExecution",
+		"StartBatchOperation",
 		"StartWorkflowExecution",
+		"StopBatchOperation",
 		"TerminateWorkflowExecution",
 		"UpdateDomain",
 	}
@@ -510,26 +848,44 @@ func (s *tchanWorkflowServiceServer) Methods() []string {
 
 func (s *tchanWorkflowServiceServer) Handle(ctx thrift.Context, methodName string, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
 	switch methodName {
+	case "CountClosedWorkflowExecutions":
+		return s.handleCountClosedWorkflowExecutions(ctx, protocol)
+	case "CountOpenWorkflowExecutions":
+		return s.handleCountOpenWorkflowExecutions(ctx, protocol)
 	case "DeprecateDomain":
 		return s.handleDeprecateDomain(ctx, protocol)
+	case "DescribeBatchOperation":
+		return s.handleDescribeBatchOperation(ctx, protocol)
 	case "DescribeDomain":
 		return s.handleDescribeDomain(ctx, protocol)
+	case "GetClusterInfo":
+		return s.handleGetClusterInfo(ctx, protocol)
+	case "GetDomainReplicationMessages":
+		return s.handleGetDomainReplicationMessages(ctx, protocol)
 	case "GetWorkflowExecutionHistory":
 		return s.handleGetWorkflowExecutionHistory(ctx, protocol)
+	case "GetWorkflowResult":
+		return s.handleGetWorkflowResult(ctx, protocol)
 	case "ListClosedWorkflowExecutions":
 		return s.handleListClosedWorkflowExecutions(ctx, protocol)
 	case "ListOpenWorkflowExecutions":
 		return s.handleListOpenWorkflowExecutions(ctx, protocol)
+	case "ListWorkflowExecutionsWithQuery":
+		return s.handleListWorkflowExecutionsWithQuery(ctx, protocol)
 	case "PollForActivityTask":
 		return s.handlePollForActivityTask(ctx, protocol)
 	case "PollForDecisionTask":
 		return s.handlePollForDecisionTask(ctx, protocol)
+	case "QueryWorkflow":
+		return s.handleQueryWorkflow(ctx, protocol)
 	case "RecordActivityTaskHeartbeat":
 		return s.handleRecordActivityTaskHeartbeat(ctx, protocol)
 	case "RegisterDomain":
 		return s.handleRegisterDomain(ctx, protocol)
 	case "RequestCancelWorkflowExecution":
 		return s.handleRequestCancelWorkflowExecution(ctx, protocol)
+	case "ResetWorkflowExecution":
+		return s.handleResetWorkflowExecution(ctx, protocol)
 	case "RespondActivityTaskCanceled":
 		return s.handleRespondActivityTaskCanceled(ctx, protocol)
 	case "RespondActivityTaskCompleted":
@@ -538,10 +894,20 @@ func (s *tchanWorkflowServiceServer) Handle(ctx thrift.Context, methodName strin
 		return s.handleRespondActivityTaskFailed(ctx, protocol)
 	case "RespondDecisionTaskCompleted":
 		return s.handleRespondDecisionTaskCompleted(ctx, protocol)
+	case "RespondQueryTaskCompleted":
+		return s.handleRespondQueryTaskCompleted(ctx, protocol)
+	case "ScanWorkflowExecutions":
+		return s.handleScanWorkflowExecutions(ctx, protocol)
+	case "SignalWithStartWorkflowExecution":
+		return s.handleSignalWithStartWorkflowExecution(ctx, protocol)
 	case "SignalWorkflowExecution":
 		return s.handleSignalWorkflowExecution(ctx, protocol)
+	case "StartBatchOperation":
+		return s.handleStartBatchOperation(ctx, protocol)
 	case "StartWorkflowExecution":
 		return s.handleStartWorkflowExecution(ctx, protocol)
+	case "StopBatchOperation":
+		return s.handleStopBatchOperation(ctx, protocol)
 	case "TerminateWorkflowExecution":
 		return s.handleTerminateWorkflowExecution(ctx, protocol)
 	case "UpdateDomain":
@@ -552,16 +918,16 @@ func (s *tchanWorkflowServiceServer) Handle(ctx thrift.Context, methodName strin
 	}
 }
 
-func (s *tchanWorkflowServiceServer) handleDeprecateDomain(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
-	var req WorkflowServiceDeprecateDomainArgs
-	var res WorkflowServiceDeprecateDomainResult
+func (s *tchanWorkflowServiceServer) handleCountClosedWorkflowExecutions(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req WorkflowServiceCountClosedWorkflowExecutionsArgs
+	var res WorkflowServiceCountClosedWorkflowExecutionsResult
 
 	if err := req.Read(protocol); err != nil {
 		return false, nil, err
 	}
 
-	err :=
-		s.handler.DeprecateDomain(ctx, req.DeprecateRequest)
+	r, err :=
+		s.handler.CountClosedWorkflowExecutions(ctx, req.ListRequest)
 
 	if err != nil {
 		switch v := err.(type) {
@@ -584,21 +950,22 @@ func (s *tchanWorkflowServiceServer) handleDeprecateDomain(ctx thrift.Context, p
 			return false, nil, err
 		}
 	} else {
+		res.Success = r
 	}
 
 	return err == nil, &res, nil
 }
 
-func (s *tchanWorkflowServiceServer) handleDescribeDomain(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
-	var req WorkflowServiceDescribeDomainArgs
-	var res WorkflowServiceDescribeDomainResult
+func (s *tchanWorkflowServiceServer) handleCountOpenWorkflowExecutions(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req WorkflowServiceCountOpenWorkflowExecutionsArgs
+	var res WorkflowServiceCountOpenWorkflowExecutionsResult
 
 	if err := req.Read(protocol); err != nil {
 		return false, nil, err
 	}
 
 	r, err :=
-		s.handler.DescribeDomain(ctx, req.DescribeRequest)
+		s.handler.CountOpenWorkflowExecutions(ctx, req.ListRequest)
 
 	if err != nil {
 		switch v := err.(type) {
@@ -627,16 +994,16 @@ func (s *tchanWorkflowServiceServer) handleDescribeDomain(ctx thrift.Context, pr
 	return err == nil, &res, nil
 }
 
-func (s *tchanWorkflowServiceServer) handleGetWorkflowExecutionHistory(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
-	var req WorkflowServiceGetWorkflowExecutionHistoryArgs
-	var res WorkflowServiceGetWorkflowExecutionHistoryResult
+func (s *tchanWorkflowServiceServer) handleDeprecateDomain(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req WorkflowServiceDeprecateDomainArgs
+	var res WorkflowServiceDeprecateDomainResult
 
 	if err := req.Read(protocol); err != nil {
 		return false, nil, err
 	}
 
-	r, err :=
-		s.handler.GetWorkflowExecutionHistory(ctx, req.GetRequest)
+	err :=
+		s.handler.DeprecateDomain(ctx, req.DeprecateRequest)
 
 	if err != nil {
 		switch v := err.(type) {
@@ -659,7 +1026,234 @@ func (s *tchanWorkflowServiceServer) handleGetWorkflowExecutionHistory(ctx thrif
 			return false, nil, err
 		}
 	} else {
-		res.Success = r
+	}
+
+	return err == nil, &res, nil
+}
+
+func (s *tchanWorkflowServiceServer) handleDescribeBatchOperation(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req WorkflowServiceDescribeBatchOperationArgs
+	var res WorkflowServiceDescribeBatchOperationResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	r, err :=
+		s.handler.DescribeBatchOperation(ctx, req.DescribeRequest)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.EntityNotExistsError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for entityNotExistError returned non-nil error type *shared.EntityNotExistsError but nil value")
+			}
+			res.EntityNotExistError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+		res.Success = r
+	}
+
+	return err == nil, &res, nil
+}
+
+func (s *tchanWorkflowServiceServer) handleDescribeDomain(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req WorkflowServiceDescribeDomainArgs
+	var res WorkflowServiceDescribeDomainResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	r, err :=
+		s.handler.DescribeDomain(ctx, req.DescribeRequest)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.EntityNotExistsError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for entityNotExistError returned non-nil error type *shared.EntityNotExistsError but nil value")
+			}
+			res.EntityNotExistError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+		res.Success = r
+	}
+
+	return err == nil, &res, nil
+}
+
+func (s *tchanWorkflowServiceServer) handleGetClusterInfo(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req WorkflowServiceGetClusterInfoArgs
+	var res WorkflowServiceGetClusterInfoResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	r, err :=
+		s.handler.GetClusterInfo(ctx, req.GetRequest)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.EntityNotExistsError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for entityNotExistError returned non-nil error type *shared.EntityNotExistsError but nil value")
+			}
+			res.EntityNotExistError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+		res.Success = r
+	}
+
+	return err == nil, &res, nil
+}
+
+func (s *tchanWorkflowServiceServer) handleGetDomainReplicationMessages(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req WorkflowServiceGetDomainReplicationMessagesArgs
+	var res WorkflowServiceGetDomainReplicationMessagesResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	r, err :=
+		s.handler.GetDomainReplicationMessages(ctx, req.GetRequest)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.EntityNotExistsError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for entityNotExistError returned non-nil error type *shared.EntityNotExistsError but nil value")
+			}
+			res.EntityNotExistError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+		res.Success = r
+	}
+
+	return err == nil, &res, nil
+}
+
+func (s *tchanWorkflowServiceServer) handleGetWorkflowExecutionHistory(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req WorkflowServiceGetWorkflowExecutionHistoryArgs
+	var res WorkflowServiceGetWorkflowExecutionHistoryResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	r, err :=
+		s.handler.GetWorkflowExecutionHistory(ctx, req.GetRequest)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.EntityNotExistsError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for entityNotExistError returned non-nil error type *shared.EntityNotExistsError but nil value")
+			}
+			res.EntityNotExistError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+		res.Success = r
+	}
+
+	return err == nil, &res, nil
+}
+
+func (s *tchanWorkflowServiceServer) handleGetWorkflowResult(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req WorkflowServiceGetWorkflowResultArgs
+	var res WorkflowServiceGetWorkflowResultResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	r, err :=
+		s.handler.GetWorkflowResult(ctx, req.GetRequest)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.EntityNotExistsError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for entityNotExistError returned non-nil error type *shared.EntityNotExistsError but nil value")
+			}
+			res.EntityNotExistError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+		res.Success = r
 	}
 
 	return err == nil, &res, nil
@@ -741,6 +1335,44 @@ func (s *tchanWorkflowServiceServer) handleListOpenWorkflowExecutions(ctx thrift
 	return err == nil, &res, nil
 }
 
+func (s *tchanWorkflowServiceServer) handleListWorkflowExecutionsWithQuery(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req WorkflowServiceListWorkflowExecutionsWithQueryArgs
+	var res WorkflowServiceListWorkflowExecutionsWithQueryResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	r, err :=
+		s.handler.ListWorkflowExecutionsWithQuery(ctx, req.ListRequest)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.EntityNotExistsError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for entityNotExistError returned non-nil error type *shared.EntityNotExistsError but nil value")
+			}
+			res.EntityNotExistError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+		res.Success = r
+	}
+
+	return err == nil, &res, nil
+}
+
 func (s *tchanWorkflowServiceServer) handlePollForActivityTask(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
 	var req WorkflowServicePollForActivityTaskArgs
 	var res WorkflowServicePollForActivityTaskResult
@@ -807,6 +1439,49 @@ func (s *tchanWorkflowServiceServer) handlePollForDecisionTask(ctx thrift.Contex
 	return err == nil, &res, nil
 }
 
+func (s *tchanWorkflowServiceServer) handleQueryWorkflow(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req WorkflowServiceQueryWorkflowArgs
+	var res WorkflowServiceQueryWorkflowResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	r, err :=
+		s.handler.QueryWorkflow(ctx, req.QueryRequest)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.EntityNotExistsError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for entityNotExistError returned non-nil error type *shared.EntityNotExistsError but nil value")
+			}
+			res.EntityNotExistError = v
+		case *shared.QueryFailedError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for queryFailedError returned non-nil error type *shared.QueryFailedError but nil value")
+			}
+			res.QueryFailedError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+		res.Success = r
+	}
+
+	return err == nil, &res, nil
+}
+
 func (s *tchanWorkflowServiceServer) handleRecordActivityTaskHeartbeat(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
 	var req WorkflowServiceRecordActivityTaskHeartbeatArgs
 	var res WorkflowServiceRecordActivityTaskHeartbeatResult
@@ -919,6 +1594,44 @@ func (s *tchanWorkflowServiceServer) handleRequestCancelWorkflowExecution(ctx th
 	return err == nil, &res, nil
 }
 
+func (s *tchanWorkflowServiceServer) handleResetWorkflowExecution(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req WorkflowServiceResetWorkflowExecutionArgs
+	var res WorkflowServiceResetWorkflowExecutionResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	r, err :=
+		s.handler.ResetWorkflowExecution(ctx, req.ResetRequest)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.EntityNotExistsError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for entityNotExistError returned non-nil error type *shared.EntityNotExistsError but nil value")
+			}
+			res.EntityNotExistError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+		res.Success = r
+	}
+
+	return err == nil, &res, nil
+}
+
 func (s *tchanWorkflowServiceServer) handleRespondActivityTaskCanceled(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
 	var req WorkflowServiceRespondActivityTaskCanceledArgs
 	var res WorkflowServiceRespondActivityTaskCanceledResult
@@ -1038,7 +1751,7 @@ func (s *tchanWorkflowServiceServer) handleRespondDecisionTaskCompleted(ctx thri
 		return false, nil, err
 	}
 
-	err :=
+	r, err :=
 		s.handler.RespondDecisionTaskCompleted(ctx, req.CompleteRequest)
 
 	if err != nil {
@@ -1062,6 +1775,120 @@ func (s *tchanWorkflowServiceServer) handleRespondDecisionTaskCompleted(ctx thri
 			return false, nil, err
 		}
 	} else {
+		res.Success = r
+	}
+
+	return err == nil, &res, nil
+}
+
+func (s *tchanWorkflowServiceServer) handleRespondQueryTaskCompleted(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req WorkflowServiceRespondQueryTaskCompletedArgs
+	var res WorkflowServiceRespondQueryTaskCompletedResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	err :=
+		s.handler.RespondQueryTaskCompleted(ctx, req.CompleteRequest)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.EntityNotExistsError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for entityNotExistError returned non-nil error type *shared.EntityNotExistsError but nil value")
+			}
+			res.EntityNotExistError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+	}
+
+	return err == nil, &res, nil
+}
+
+func (s *tchanWorkflowServiceServer) handleScanWorkflowExecutions(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req WorkflowServiceScanWorkflowExecutionsArgs
+	var res WorkflowServiceScanWorkflowExecutionsResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	r, err :=
+		s.handler.ScanWorkflowExecutions(ctx, req.ListRequest)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.EntityNotExistsError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for entityNotExistError returned non-nil error type *shared.EntityNotExistsError but nil value")
+			}
+			res.EntityNotExistError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+		res.Success = r
+	}
+
+	return err == nil, &res, nil
+}
+
+func (s *tchanWorkflowServiceServer) handleSignalWithStartWorkflowExecution(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req WorkflowServiceSignalWithStartWorkflowExecutionArgs
+	var res WorkflowServiceSignalWithStartWorkflowExecutionResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	r, err :=
+		s.handler.SignalWithStartWorkflowExecution(ctx, req.SignalWithStartRequest)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.WorkflowExecutionAlreadyStartedError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for sessionAlreadyExistError returned non-nil error type *shared.WorkflowExecutionAlreadyStartedError but nil value")
+			}
+			res.SessionAlreadyExistError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+		res.Success = r
 	}
 
 	return err == nil, &res, nil
@@ -1104,6 +1931,44 @@ func (s *tchanWorkflowServiceServer) handleSignalWorkflowExecution(ctx thrift.Co
 	return err == nil, &res, nil
 }
 
+func (s *tchanWorkflowServiceServer) handleStartBatchOperation(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req WorkflowServiceStartBatchOperationArgs
+	var res WorkflowServiceStartBatchOperationResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	r, err :=
+		s.handler.StartBatchOperation(ctx, req.StartRequest)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.EntityNotExistsError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for entityNotExistError returned non-nil error type *shared.EntityNotExistsError but nil value")
+			}
+			res.EntityNotExistError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+		res.Success = r
+	}
+
+	return err == nil, &res, nil
+}
+
 func (s *tchanWorkflowServiceServer) handleStartWorkflowExecution(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
 	var req WorkflowServiceStartWorkflowExecutionArgs
 	var res WorkflowServiceStartWorkflowExecutionResult
@@ -1142,6 +2007,43 @@ func (s *tchanWorkflowServiceServer) handleStartWorkflowExecution(ctx thrift.Con
 	return err == nil, &res, nil
 }
 
+func (s *tchanWorkflowServiceServer) handleStopBatchOperation(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req WorkflowServiceStopBatchOperationArgs
+	var res WorkflowServiceStopBatchOperationResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	err :=
+		s.handler.StopBatchOperation(ctx, req.StopRequest)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.EntityNotExistsError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for entityNotExistError returned non-nil error type *shared.EntityNotExistsError but nil value")
+			}
+			res.EntityNotExistError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+	}
+
+	return err == nil, &res, nil
+}
+
 func (s *tchanWorkflowServiceServer) handleTerminateWorkflowExecution(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
 	var req WorkflowServiceTerminateWorkflowExecutionArgs
 	var res WorkflowServiceTerminateWorkflowExecutionResult
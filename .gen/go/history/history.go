@@ -758,11 +758,20 @@ func (p *GetWorkflowExecutionNextEventIDRequest) String() string {
 // Attributes:
 //  - EventId
 //  - RunId
+//  - Tasklist
+//  - WorkflowType
+//  - PreviousStartedEventId
 type GetWorkflowExecutionNextEventIDResponse struct {
   // unused fields # 1 to 9
   EventId *int64 `thrift:"eventId,10" db:"eventId" json:"eventId,omitempty"`
   // unused fields # 11 to 19
   RunId *string `thrift:"runId,20" db:"runId" json:"runId,omitempty"`
+  // unused fields # 21 to 29
+  Tasklist *shared.TaskList `thrift:"tasklist,30" db:"tasklist" json:"tasklist,omitempty"`
+  // unused fields # 31 to 39
+  WorkflowType *shared.WorkflowType `thrift:"workflowType,40" db:"workflowType" json:"workflowType,omitempty"`
+  // unused fields # 41 to 49
+  PreviousStartedEventId *int64 `thrift:"previousStartedEventId,50" db:"previousStartedEventId" json:"previousStartedEventId,omitempty"`
 }
 
 func NewGetWorkflowExecutionNextEventIDResponse() *GetWorkflowExecutionNextEventIDResponse {
@@ -783,6 +792,27 @@ func (p *GetWorkflowExecutionNextEventIDResponse) GetRunId() string {
   }
 return *p.RunId
 }
+var GetWorkflowExecutionNextEventIDResponse_Tasklist_DEFAULT *shared.TaskList
+func (p *GetWorkflowExecutionNextEventIDResponse) GetTasklist() *shared.TaskList {
+  if !p.IsSetTasklist() {
+    return GetWorkflowExecutionNextEventIDResponse_Tasklist_DEFAULT
+  }
+return p.Tasklist
+}
+var GetWorkflowExecutionNextEventIDResponse_WorkflowType_DEFAULT *shared.WorkflowType
+func (p *GetWorkflowExecutionNextEventIDResponse) GetWorkflowType() *shared.WorkflowType {
+  if !p.IsSetWorkflowType() {
+    return GetWorkflowExecutionNextEventIDResponse_WorkflowType_DEFAULT
+  }
+return p.WorkflowType
+}
+var GetWorkflowExecutionNextEventIDResponse_PreviousStartedEventId_DEFAULT int64
+func (p *GetWorkflowExecutionNextEventIDResponse) GetPreviousStartedEventId() int64 {
+  if !p.IsSetPreviousStartedEventId() {
+    return GetWorkflowExecutionNextEventIDResponse_PreviousStartedEventId_DEFAULT
+  }
+return *p.PreviousStartedEventId
+}
 func (p *GetWorkflowExecutionNextEventIDResponse) IsSetEventId() bool {
   return p.EventId != nil
 }
@@ -791,6 +821,18 @@ func (p *GetWorkflowExecutionNextEventIDResponse) IsSetRunId() bool {
   return p.RunId != nil
 }
 
+func (p *GetWorkflowExecutionNextEventIDResponse) IsSetTasklist() bool {
+  return p.Tasklist != nil
+}
+
+func (p *GetWorkflowExecutionNextEventIDResponse) IsSetWorkflowType() bool {
+  return p.WorkflowType != nil
+}
+
+func (p *GetWorkflowExecutionNextEventIDResponse) IsSetPreviousStartedEventId() bool {
+  return p.PreviousStartedEventId != nil
+}
+
 func (p *GetWorkflowExecutionNextEventIDResponse) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
@@ -812,6 +854,18 @@ func (p *GetWorkflowExecutionNextEventIDResponse) Read(iprot thrift.TProtocol) e
       if err := p.ReadField20(iprot); err != nil {
         return err
       }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
+    case 50:
+      if err := p.ReadField50(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -845,12 +899,40 @@ func (p *GetWorkflowExecutionNextEventIDResponse)  ReadField20(iprot thrift.TPro
   return nil
 }
 
+func (p *GetWorkflowExecutionNextEventIDResponse)  ReadField30(iprot thrift.TProtocol) error {
+  p.Tasklist = &shared.TaskList{}
+  if err := p.Tasklist.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Tasklist), err)
+  }
+  return nil
+}
+
+func (p *GetWorkflowExecutionNextEventIDResponse)  ReadField40(iprot thrift.TProtocol) error {
+  p.WorkflowType = &shared.WorkflowType{}
+  if err := p.WorkflowType.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowType), err)
+  }
+  return nil
+}
+
+func (p *GetWorkflowExecutionNextEventIDResponse)  ReadField50(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 50: ", err)
+} else {
+  p.PreviousStartedEventId = &v
+}
+  return nil
+}
+
 func (p *GetWorkflowExecutionNextEventIDResponse) Write(oprot thrift.TProtocol) error {
   if err := oprot.WriteStructBegin("GetWorkflowExecutionNextEventIDResponse"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
     if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
+    if err := p.writeField40(oprot); err != nil { return err }
+    if err := p.writeField50(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -883,6 +965,44 @@ func (p *GetWorkflowExecutionNextEventIDResponse) writeField20(oprot thrift.TPro
   return err
 }
 
+func (p *GetWorkflowExecutionNextEventIDResponse) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTasklist() {
+    if err := oprot.WriteFieldBegin("tasklist", thrift.STRUCT, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:tasklist: ", p), err) }
+    if err := p.Tasklist.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Tasklist), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:tasklist: ", p), err) }
+  }
+  return err
+}
+
+func (p *GetWorkflowExecutionNextEventIDResponse) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowType() {
+    if err := oprot.WriteFieldBegin("workflowType", thrift.STRUCT, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:workflowType: ", p), err) }
+    if err := p.WorkflowType.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowType), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:workflowType: ", p), err) }
+  }
+  return err
+}
+
+func (p *GetWorkflowExecutionNextEventIDResponse) writeField50(oprot thrift.TProtocol) (err error) {
+  if p.IsSetPreviousStartedEventId() {
+    if err := oprot.WriteFieldBegin("previousStartedEventId", thrift.I64, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:previousStartedEventId: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.PreviousStartedEventId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.previousStartedEventId (50) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:previousStartedEventId: ", p), err) }
+  }
+  return err
+}
+
 func (p *GetWorkflowExecutionNextEventIDResponse) String() string {
   if p == nil {
     return "<nil>"
@@ -2600,6 +2720,141 @@ func (p *SignalWorkflowExecutionRequest) String() string {
   return fmt.Sprintf("SignalWorkflowExecutionRequest(%+v)", *p)
 }
 
+// Attributes:
+//  - DomainUUID
+//  - SignalWithStartRequest
+type SignalWithStartWorkflowExecutionRequest struct {
+  // unused fields # 1 to 9
+  DomainUUID *string `thrift:"domainUUID,10" db:"domainUUID" json:"domainUUID,omitempty"`
+  // unused fields # 11 to 19
+  SignalWithStartRequest *shared.SignalWithStartWorkflowExecutionRequest `thrift:"signalWithStartRequest,20" db:"signalWithStartRequest" json:"signalWithStartRequest,omitempty"`
+}
+
+func NewSignalWithStartWorkflowExecutionRequest() *SignalWithStartWorkflowExecutionRequest {
+  return &SignalWithStartWorkflowExecutionRequest{}
+}
+
+var SignalWithStartWorkflowExecutionRequest_DomainUUID_DEFAULT string
+func (p *SignalWithStartWorkflowExecutionRequest) GetDomainUUID() string {
+  if !p.IsSetDomainUUID() {
+    return SignalWithStartWorkflowExecutionRequest_DomainUUID_DEFAULT
+  }
+return *p.DomainUUID
+}
+var SignalWithStartWorkflowExecutionRequest_SignalWithStartRequest_DEFAULT *shared.SignalWithStartWorkflowExecutionRequest
+func (p *SignalWithStartWorkflowExecutionRequest) GetSignalWithStartRequest() *shared.SignalWithStartWorkflowExecutionRequest {
+  if !p.IsSetSignalWithStartRequest() {
+    return SignalWithStartWorkflowExecutionRequest_SignalWithStartRequest_DEFAULT
+  }
+return p.SignalWithStartRequest
+}
+func (p *SignalWithStartWorkflowExecutionRequest) IsSetDomainUUID() bool {
+  return p.DomainUUID != nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) IsSetSignalWithStartRequest() bool {
+  return p.SignalWithStartRequest != nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.DomainUUID = &v
+}
+  return nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest)  ReadField20(iprot thrift.TProtocol) error {
+  p.SignalWithStartRequest = &shared.SignalWithStartWorkflowExecutionRequest{}
+  if err := p.SignalWithStartRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.SignalWithStartRequest), err)
+  }
+  return nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("SignalWithStartWorkflowExecutionRequest"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomainUUID() {
+    if err := oprot.WriteFieldBegin("domainUUID", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domainUUID: ", p), err) }
+    if err := oprot.WriteString(string(*p.DomainUUID)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domainUUID (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domainUUID: ", p), err) }
+  }
+  return err
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSignalWithStartRequest() {
+    if err := oprot.WriteFieldBegin("signalWithStartRequest", thrift.STRUCT, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:signalWithStartRequest: ", p), err) }
+    if err := p.SignalWithStartRequest.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.SignalWithStartRequest), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:signalWithStartRequest: ", p), err) }
+  }
+  return err
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("SignalWithStartWorkflowExecutionRequest(%+v)", *p)
+}
+
 // Attributes:
 //  - DomainUUID
 //  - TerminateRequest
@@ -2737,69 +2992,41 @@ func (p *TerminateWorkflowExecutionRequest) String() string {
 
 // Attributes:
 //  - DomainUUID
-//  - CancelRequest
-//  - ExternalInitiatedEventId
-//  - ExternalWorkflowExecution
-type RequestCancelWorkflowExecutionRequest struct {
+//  - ResetRequest
+type ResetWorkflowExecutionRequest struct {
   // unused fields # 1 to 9
   DomainUUID *string `thrift:"domainUUID,10" db:"domainUUID" json:"domainUUID,omitempty"`
   // unused fields # 11 to 19
-  CancelRequest *shared.RequestCancelWorkflowExecutionRequest `thrift:"cancelRequest,20" db:"cancelRequest" json:"cancelRequest,omitempty"`
-  // unused fields # 21 to 29
-  ExternalInitiatedEventId *int64 `thrift:"externalInitiatedEventId,30" db:"externalInitiatedEventId" json:"externalInitiatedEventId,omitempty"`
-  // unused fields # 31 to 39
-  ExternalWorkflowExecution *shared.WorkflowExecution `thrift:"externalWorkflowExecution,40" db:"externalWorkflowExecution" json:"externalWorkflowExecution,omitempty"`
+  ResetRequest *shared.ResetWorkflowExecutionRequest `thrift:"resetRequest,20" db:"resetRequest" json:"resetRequest,omitempty"`
 }
 
-func NewRequestCancelWorkflowExecutionRequest() *RequestCancelWorkflowExecutionRequest {
-  return &RequestCancelWorkflowExecutionRequest{}
+func NewResetWorkflowExecutionRequest() *ResetWorkflowExecutionRequest {
+  return &ResetWorkflowExecutionRequest{}
 }
 
-var RequestCancelWorkflowExecutionRequest_DomainUUID_DEFAULT string
-func (p *RequestCancelWorkflowExecutionRequest) GetDomainUUID() string {
+var ResetWorkflowExecutionRequest_DomainUUID_DEFAULT string
+func (p *ResetWorkflowExecutionRequest) GetDomainUUID() string {
   if !p.IsSetDomainUUID() {
-    return RequestCancelWorkflowExecutionRequest_DomainUUID_DEFAULT
+    return ResetWorkflowExecutionRequest_DomainUUID_DEFAULT
   }
 return *p.DomainUUID
 }
-var RequestCancelWorkflowExecutionRequest_CancelRequest_DEFAULT *shared.RequestCancelWorkflowExecutionRequest
-func (p *RequestCancelWorkflowExecutionRequest) GetCancelRequest() *shared.RequestCancelWorkflowExecutionRequest {
-  if !p.IsSetCancelRequest() {
-    return RequestCancelWorkflowExecutionRequest_CancelRequest_DEFAULT
-  }
-return p.CancelRequest
-}
-var RequestCancelWorkflowExecutionRequest_ExternalInitiatedEventId_DEFAULT int64
-func (p *RequestCancelWorkflowExecutionRequest) GetExternalInitiatedEventId() int64 {
-  if !p.IsSetExternalInitiatedEventId() {
-    return RequestCancelWorkflowExecutionRequest_ExternalInitiatedEventId_DEFAULT
-  }
-return *p.ExternalInitiatedEventId
-}
-var RequestCancelWorkflowExecutionRequest_ExternalWorkflowExecution_DEFAULT *shared.WorkflowExecution
-func (p *RequestCancelWorkflowExecutionRequest) GetExternalWorkflowExecution() *shared.WorkflowExecution {
-  if !p.IsSetExternalWorkflowExecution() {
-    return RequestCancelWorkflowExecutionRequest_ExternalWorkflowExecution_DEFAULT
+var ResetWorkflowExecutionRequest_ResetRequest_DEFAULT *shared.ResetWorkflowExecutionRequest
+func (p *ResetWorkflowExecutionRequest) GetResetRequest() *shared.ResetWorkflowExecutionRequest {
+  if !p.IsSetResetRequest() {
+    return ResetWorkflowExecutionRequest_ResetRequest_DEFAULT
   }
-return p.ExternalWorkflowExecution
+return p.ResetRequest
 }
-func (p *RequestCancelWorkflowExecutionRequest) IsSetDomainUUID() bool {
+func (p *ResetWorkflowExecutionRequest) IsSetDomainUUID() bool {
   return p.DomainUUID != nil
 }
 
-func (p *RequestCancelWorkflowExecutionRequest) IsSetCancelRequest() bool {
-  return p.CancelRequest != nil
-}
-
-func (p *RequestCancelWorkflowExecutionRequest) IsSetExternalInitiatedEventId() bool {
-  return p.ExternalInitiatedEventId != nil
-}
-
-func (p *RequestCancelWorkflowExecutionRequest) IsSetExternalWorkflowExecution() bool {
-  return p.ExternalWorkflowExecution != nil
+func (p *ResetWorkflowExecutionRequest) IsSetResetRequest() bool {
+  return p.ResetRequest != nil
 }
 
-func (p *RequestCancelWorkflowExecutionRequest) Read(iprot thrift.TProtocol) error {
+func (p *ResetWorkflowExecutionRequest) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -2820,14 +3047,6 @@ func (p *RequestCancelWorkflowExecutionRequest) Read(iprot thrift.TProtocol) err
       if err := p.ReadField20(iprot); err != nil {
         return err
       }
-    case 30:
-      if err := p.ReadField30(iprot); err != nil {
-        return err
-      }
-    case 40:
-      if err := p.ReadField40(iprot); err != nil {
-        return err
-      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -2843,7 +3062,7 @@ func (p *RequestCancelWorkflowExecutionRequest) Read(iprot thrift.TProtocol) err
   return nil
 }
 
-func (p *RequestCancelWorkflowExecutionRequest)  ReadField10(iprot thrift.TProtocol) error {
+func (p *ResetWorkflowExecutionRequest)  ReadField10(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 10: ", err)
 } else {
@@ -2852,33 +3071,204 @@ func (p *RequestCancelWorkflowExecutionRequest)  ReadField10(iprot thrift.TProto
   return nil
 }
 
-func (p *RequestCancelWorkflowExecutionRequest)  ReadField20(iprot thrift.TProtocol) error {
-  p.CancelRequest = &shared.RequestCancelWorkflowExecutionRequest{}
-  if err := p.CancelRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CancelRequest), err)
-  }
-  return nil
-}
-
-func (p *RequestCancelWorkflowExecutionRequest)  ReadField30(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI64(); err != nil {
-  return thrift.PrependError("error reading field 30: ", err)
-} else {
-  p.ExternalInitiatedEventId = &v
-}
-  return nil
-}
-
-func (p *RequestCancelWorkflowExecutionRequest)  ReadField40(iprot thrift.TProtocol) error {
-  p.ExternalWorkflowExecution = &shared.WorkflowExecution{}
-  if err := p.ExternalWorkflowExecution.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ExternalWorkflowExecution), err)
+func (p *ResetWorkflowExecutionRequest)  ReadField20(iprot thrift.TProtocol) error {
+  p.ResetRequest = &shared.ResetWorkflowExecutionRequest{}
+  if err := p.ResetRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ResetRequest), err)
   }
   return nil
 }
 
-func (p *RequestCancelWorkflowExecutionRequest) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RequestCancelWorkflowExecutionRequest"); err != nil {
+func (p *ResetWorkflowExecutionRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ResetWorkflowExecutionRequest"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ResetWorkflowExecutionRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomainUUID() {
+    if err := oprot.WriteFieldBegin("domainUUID", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domainUUID: ", p), err) }
+    if err := oprot.WriteString(string(*p.DomainUUID)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domainUUID (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domainUUID: ", p), err) }
+  }
+  return err
+}
+
+func (p *ResetWorkflowExecutionRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetResetRequest() {
+    if err := oprot.WriteFieldBegin("resetRequest", thrift.STRUCT, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:resetRequest: ", p), err) }
+    if err := p.ResetRequest.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ResetRequest), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:resetRequest: ", p), err) }
+  }
+  return err
+}
+
+func (p *ResetWorkflowExecutionRequest) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ResetWorkflowExecutionRequest(%+v)", *p)
+}
+
+// Attributes:
+//  - DomainUUID
+//  - CancelRequest
+//  - ExternalInitiatedEventId
+//  - ExternalWorkflowExecution
+type RequestCancelWorkflowExecutionRequest struct {
+  // unused fields # 1 to 9
+  DomainUUID *string `thrift:"domainUUID,10" db:"domainUUID" json:"domainUUID,omitempty"`
+  // unused fields # 11 to 19
+  CancelRequest *shared.RequestCancelWorkflowExecutionRequest `thrift:"cancelRequest,20" db:"cancelRequest" json:"cancelRequest,omitempty"`
+  // unused fields # 21 to 29
+  ExternalInitiatedEventId *int64 `thrift:"externalInitiatedEventId,30" db:"externalInitiatedEventId" json:"externalInitiatedEventId,omitempty"`
+  // unused fields # 31 to 39
+  ExternalWorkflowExecution *shared.WorkflowExecution `thrift:"externalWorkflowExecution,40" db:"externalWorkflowExecution" json:"externalWorkflowExecution,omitempty"`
+}
+
+func NewRequestCancelWorkflowExecutionRequest() *RequestCancelWorkflowExecutionRequest {
+  return &RequestCancelWorkflowExecutionRequest{}
+}
+
+var RequestCancelWorkflowExecutionRequest_DomainUUID_DEFAULT string
+func (p *RequestCancelWorkflowExecutionRequest) GetDomainUUID() string {
+  if !p.IsSetDomainUUID() {
+    return RequestCancelWorkflowExecutionRequest_DomainUUID_DEFAULT
+  }
+return *p.DomainUUID
+}
+var RequestCancelWorkflowExecutionRequest_CancelRequest_DEFAULT *shared.RequestCancelWorkflowExecutionRequest
+func (p *RequestCancelWorkflowExecutionRequest) GetCancelRequest() *shared.RequestCancelWorkflowExecutionRequest {
+  if !p.IsSetCancelRequest() {
+    return RequestCancelWorkflowExecutionRequest_CancelRequest_DEFAULT
+  }
+return p.CancelRequest
+}
+var RequestCancelWorkflowExecutionRequest_ExternalInitiatedEventId_DEFAULT int64
+func (p *RequestCancelWorkflowExecutionRequest) GetExternalInitiatedEventId() int64 {
+  if !p.IsSetExternalInitiatedEventId() {
+    return RequestCancelWorkflowExecutionRequest_ExternalInitiatedEventId_DEFAULT
+  }
+return *p.ExternalInitiatedEventId
+}
+var RequestCancelWorkflowExecutionRequest_ExternalWorkflowExecution_DEFAULT *shared.WorkflowExecution
+func (p *RequestCancelWorkflowExecutionRequest) GetExternalWorkflowExecution() *shared.WorkflowExecution {
+  if !p.IsSetExternalWorkflowExecution() {
+    return RequestCancelWorkflowExecutionRequest_ExternalWorkflowExecution_DEFAULT
+  }
+return p.ExternalWorkflowExecution
+}
+func (p *RequestCancelWorkflowExecutionRequest) IsSetDomainUUID() bool {
+  return p.DomainUUID != nil
+}
+
+func (p *RequestCancelWorkflowExecutionRequest) IsSetCancelRequest() bool {
+  return p.CancelRequest != nil
+}
+
+func (p *RequestCancelWorkflowExecutionRequest) IsSetExternalInitiatedEventId() bool {
+  return p.ExternalInitiatedEventId != nil
+}
+
+func (p *RequestCancelWorkflowExecutionRequest) IsSetExternalWorkflowExecution() bool {
+  return p.ExternalWorkflowExecution != nil
+}
+
+func (p *RequestCancelWorkflowExecutionRequest) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *RequestCancelWorkflowExecutionRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.DomainUUID = &v
+}
+  return nil
+}
+
+func (p *RequestCancelWorkflowExecutionRequest)  ReadField20(iprot thrift.TProtocol) error {
+  p.CancelRequest = &shared.RequestCancelWorkflowExecutionRequest{}
+  if err := p.CancelRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CancelRequest), err)
+  }
+  return nil
+}
+
+func (p *RequestCancelWorkflowExecutionRequest)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
+} else {
+  p.ExternalInitiatedEventId = &v
+}
+  return nil
+}
+
+func (p *RequestCancelWorkflowExecutionRequest)  ReadField40(iprot thrift.TProtocol) error {
+  p.ExternalWorkflowExecution = &shared.WorkflowExecution{}
+  if err := p.ExternalWorkflowExecution.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ExternalWorkflowExecution), err)
+  }
+  return nil
+}
+
+func (p *RequestCancelWorkflowExecutionRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RequestCancelWorkflowExecutionRequest"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
@@ -3347,1325 +3737,1788 @@ func (p *RecordChildExecutionCompletedRequest) String() string {
   return fmt.Sprintf("RecordChildExecutionCompletedRequest(%+v)", *p)
 }
 
-type HistoryService interface {  //HistoryService provides API to start a new long running workflow instance, as well as query and update the history
-  //of workflow instances already created.
-  //
+// Attributes:
+//  - ShardId
+type DescribeShardRequest struct {
+  // unused fields # 1 to 9
+  ShardId *int32 `thrift:"shardId,10" db:"shardId" json:"shardId,omitempty"`
+}
 
-  // StartWorkflowExecution starts a new long running workflow instance.  It will create the instance with
-  // 'WorkflowExecutionStarted' event in history and also schedule the first DecisionTask for the worker to make the
-  // first decision for this instance.  It will return 'WorkflowExecutionAlreadyStartedError', if an instance already
-  // exists with same workflowId.
-  // 
-  // 
-  // Parameters:
-  //  - StartRequest
-  StartWorkflowExecution(startRequest *StartWorkflowExecutionRequest) (r *shared.StartWorkflowExecutionResponse, err error)
-  // Returns the nextEventID of the history of workflow execution. Only events in the history with Ids below the returned Id are
-  // guaranteed to be valid, so the first step of reading an execution's history is to retrieve this event Id.
-  // It fails with 'EntityNotExistError' if specified workflow execution in unknown to the service.
-  // 
-  // 
-  // Parameters:
-  //  - GetRequest
-  GetWorkflowExecutionNextEventID(getRequest *GetWorkflowExecutionNextEventIDRequest) (r *GetWorkflowExecutionNextEventIDResponse, err error)
-  // RecordDecisionTaskStarted is called by the Matchingservice before it hands a decision task to the application worker in response to
-  // a PollForDecisionTask call. It records in the history the event that the decision task has started. It will return 'EventAlreadyStartedError',
-  // if the workflow's execution history already includes a record of the event starting.
-  // 
-  // 
-  // Parameters:
-  //  - AddRequest
-  RecordDecisionTaskStarted(addRequest *RecordDecisionTaskStartedRequest) (r *RecordDecisionTaskStartedResponse, err error)
-  // RecordActivityTaskStarted is called by the Matchingservice before it hands a decision task to the application worker in response to
-  // a PollForActivityTask call. It records in the history the event that the decision task has started. It will return 'EventAlreadyStartedError',
-  // if the workflow's execution history already includes a record of the event starting.
-  // 
-  // 
-  // Parameters:
-  //  - AddRequest
-  RecordActivityTaskStarted(addRequest *RecordActivityTaskStartedRequest) (r *RecordActivityTaskStartedResponse, err error)
-  // RespondDecisionTaskCompleted is called by application worker to complete a DecisionTask handed as a result of
-  // 'PollForDecisionTask' API call.  Completing a DecisionTask will result in new events for the workflow execution and
-  // potentially new ActivityTask being created for corresponding decisions.  It will also create a DecisionTaskCompleted
-  // event in the history for that session.  Use the 'taskToken' provided as response of PollForDecisionTask API call
-  // for completing the DecisionTask.
-  // 
-  // 
-  // Parameters:
-  //  - CompleteRequest
-  RespondDecisionTaskCompleted(completeRequest *RespondDecisionTaskCompletedRequest) (err error)
-  // RecordActivityTaskHeartbeat is called by application worker while it is processing an ActivityTask.  If worker fails
-  // to heartbeat within 'heartbeatTimeoutSeconds' interval for the ActivityTask, then it will be marked as timedout and
-  // 'ActivityTaskTimedOut' event will be written to the workflow history.  Calling 'RecordActivityTaskHeartbeat' will
-  // fail with 'EntityNotExistsError' in such situations.  Use the 'taskToken' provided as response of
-  // PollForActivityTask API call for heartbeating.
-  // 
-  // 
-  // Parameters:
-  //  - HeartbeatRequest
-  RecordActivityTaskHeartbeat(heartbeatRequest *RecordActivityTaskHeartbeatRequest) (r *shared.RecordActivityTaskHeartbeatResponse, err error)
-  // RespondActivityTaskCompleted is called by application worker when it is done processing an ActivityTask.  It will
-  // result in a new 'ActivityTaskCompleted' event being written to the workflow history and a new DecisionTask
-  // created for the workflow so new decisions could be made.  Use the 'taskToken' provided as response of
-  // PollForActivityTask API call for completion. It fails with 'EntityNotExistsError' if the taskToken is not valid
-  // anymore due to activity timeout.
-  // 
-  // 
-  // Parameters:
-  //  - CompleteRequest
-  RespondActivityTaskCompleted(completeRequest *RespondActivityTaskCompletedRequest) (err error)
-  // RespondActivityTaskFailed is called by application worker when it is done processing an ActivityTask.  It will
-  // result in a new 'ActivityTaskFailed' event being written to the workflow history and a new DecisionTask
-  // created for the workflow instance so new decisions could be made.  Use the 'taskToken' provided as response of
-  // PollForActivityTask API call for completion. It fails with 'EntityNotExistsError' if the taskToken is not valid
-  // anymore due to activity timeout.
-  // 
-  // 
-  // Parameters:
-  //  - FailRequest
-  RespondActivityTaskFailed(failRequest *RespondActivityTaskFailedRequest) (err error)
-  // RespondActivityTaskCanceled is called by application worker when it is successfully canceled an ActivityTask.  It will
-  // result in a new 'ActivityTaskCanceled' event being written to the workflow history and a new DecisionTask
-  // created for the workflow instance so new decisions could be made.  Use the 'taskToken' provided as response of
-  // PollForActivityTask API call for completion. It fails with 'EntityNotExistsError' if the taskToken is not valid
-  // anymore due to activity timeout.
-  // 
-  // 
-  // Parameters:
-  //  - CanceledRequest
-  RespondActivityTaskCanceled(canceledRequest *RespondActivityTaskCanceledRequest) (err error)
-  // SignalWorkflowExecution is used to send a signal event to running workflow execution.  This results in
-  // WorkflowExecutionSignaled event recorded in the history and a decision task being created for the execution.
-  // 
-  // 
-  // Parameters:
-  //  - SignalRequest
-  SignalWorkflowExecution(signalRequest *SignalWorkflowExecutionRequest) (err error)
-  // TerminateWorkflowExecution terminates an existing workflow execution by recording WorkflowExecutionTerminated event
-  // in the history and immediately terminating the execution instance.
-  // 
-  // 
-  // Parameters:
-  //  - TerminateRequest
-  TerminateWorkflowExecution(terminateRequest *TerminateWorkflowExecutionRequest) (err error)
-  // RequestCancelWorkflowExecution is called by application worker when it wants to request cancellation of a workflow instance.
-  // It will result in a new 'WorkflowExecutionCancelRequested' event being written to the workflow history and a new DecisionTask
-  // created for the workflow instance so new decisions could be made. It fails with 'EntityNotExistsError' if the workflow is not valid
-  // anymore due to completion or doesn't exist.
-  // 
-  // 
-  // Parameters:
-  //  - CancelRequest
-  RequestCancelWorkflowExecution(cancelRequest *RequestCancelWorkflowExecutionRequest) (err error)
-  // ScheduleDecisionTask is used for creating a decision task for already started workflow execution.  This is mainly
-  // used by transfer queue processor during the processing of StartChildWorkflowExecution task, where it first starts
-  // child execution without creating the decision task and then calls this API after updating the mutable state of
-  // parent execution.
-  // 
-  // 
-  // Parameters:
-  //  - ScheduleRequest
-  ScheduleDecisionTask(scheduleRequest *ScheduleDecisionTaskRequest) (err error)
-  // RecordChildExecutionCompleted is used for reporting the completion of child workflow execution to parent.
-  // This is mainly called by transfer queue processor during the processing of DeleteExecution task.
-  // 
-  // 
-  // Parameters:
-  //  - CompletionRequest
-  RecordChildExecutionCompleted(completionRequest *RecordChildExecutionCompletedRequest) (err error)
+func NewDescribeShardRequest() *DescribeShardRequest {
+  return &DescribeShardRequest{}
 }
 
-//HistoryService provides API to start a new long running workflow instance, as well as query and update the history
-//of workflow instances already created.
-//
-type HistoryServiceClient struct {
-  Transport thrift.TTransport
-  ProtocolFactory thrift.TProtocolFactory
-  InputProtocol thrift.TProtocol
-  OutputProtocol thrift.TProtocol
-  SeqId int32
+var DescribeShardRequest_ShardId_DEFAULT int32
+func (p *DescribeShardRequest) GetShardId() int32 {
+  if !p.IsSetShardId() {
+    return DescribeShardRequest_ShardId_DEFAULT
+  }
+return *p.ShardId
+}
+func (p *DescribeShardRequest) IsSetShardId() bool {
+  return p.ShardId != nil
 }
 
-func NewHistoryServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *HistoryServiceClient {
-  return &HistoryServiceClient{Transport: t,
-    ProtocolFactory: f,
-    InputProtocol: f.GetProtocol(t),
-    OutputProtocol: f.GetProtocol(t),
-    SeqId: 0,
+func (p *DescribeShardRequest) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
-}
 
-func NewHistoryServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *HistoryServiceClient {
-  return &HistoryServiceClient{Transport: t,
-    ProtocolFactory: nil,
-    InputProtocol: iprot,
-    OutputProtocol: oprot,
-    SeqId: 0,
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
   }
+  return nil
 }
 
-// StartWorkflowExecution starts a new long running workflow instance.  It will create the instance with
-// 'WorkflowExecutionStarted' event in history and also schedule the first DecisionTask for the worker to make the
-// first decision for this instance.  It will return 'WorkflowExecutionAlreadyStartedError', if an instance already
-// exists with same workflowId.
-// 
-// 
-// Parameters:
-//  - StartRequest
-func (p *HistoryServiceClient) StartWorkflowExecution(startRequest *StartWorkflowExecutionRequest) (r *shared.StartWorkflowExecutionResponse, err error) {
-  if err = p.sendStartWorkflowExecution(startRequest); err != nil { return }
-  return p.recvStartWorkflowExecution()
+func (p *DescribeShardRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.ShardId = &v
+}
+  return nil
 }
 
-func (p *HistoryServiceClient) sendStartWorkflowExecution(startRequest *StartWorkflowExecutionRequest)(err error) {
-  oprot := p.OutputProtocol
-  if oprot == nil {
-    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
-    p.OutputProtocol = oprot
-  }
-  p.SeqId++
-  if err = oprot.WriteMessageBegin("StartWorkflowExecution", thrift.CALL, p.SeqId); err != nil {
-      return
-  }
-  args := HistoryServiceStartWorkflowExecutionArgs{
-  StartRequest : startRequest,
+func (p *DescribeShardRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("DescribeShardRequest"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
   }
-  if err = args.Write(oprot); err != nil {
-      return
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *DescribeShardRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetShardId() {
+    if err := oprot.WriteFieldBegin("shardId", thrift.I32, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:shardId: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.ShardId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.shardId (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:shardId: ", p), err) }
   }
-  if err = oprot.WriteMessageEnd(); err != nil {
-      return
+  return err
+}
+
+func (p *DescribeShardRequest) String() string {
+  if p == nil {
+    return "<nil>"
   }
-  return oprot.Flush()
+  return fmt.Sprintf("DescribeShardRequest(%+v)", *p)
 }
 
+// Attributes:
+//  - ShardId
+//  - Owner
+//  - RangeId
+//  - TransferAckLevel
+//  - TransferMaxReadLevel
+//  - MaxTaskId
+//  - TimerAckLevel
+type DescribeShardResponse struct {
+  // unused fields # 1 to 9
+  ShardId *int32 `thrift:"shardId,10" db:"shardId" json:"shardId,omitempty"`
+  // unused fields # 11 to 19
+  Owner *string `thrift:"owner,20" db:"owner" json:"owner,omitempty"`
+  // unused fields # 21 to 29
+  RangeId *int64 `thrift:"rangeId,30" db:"rangeId" json:"rangeId,omitempty"`
+  // unused fields # 31 to 39
+  TransferAckLevel *int64 `thrift:"transferAckLevel,40" db:"transferAckLevel" json:"transferAckLevel,omitempty"`
+  // unused fields # 41 to 49
+  TransferMaxReadLevel *int64 `thrift:"transferMaxReadLevel,50" db:"transferMaxReadLevel" json:"transferMaxReadLevel,omitempty"`
+  // unused fields # 51 to 59
+  MaxTaskId *int64 `thrift:"maxTaskId,60" db:"maxTaskId" json:"maxTaskId,omitempty"`
+  // unused fields # 61 to 69
+  TimerAckLevel *int64 `thrift:"timerAckLevel,70" db:"timerAckLevel" json:"timerAckLevel,omitempty"`
+}
 
-func (p *HistoryServiceClient) recvStartWorkflowExecution() (value *shared.StartWorkflowExecutionResponse, err error) {
-  iprot := p.InputProtocol
-  if iprot == nil {
-    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
-    p.InputProtocol = iprot
-  }
-  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
-  if err != nil {
-    return
-  }
-  if method != "StartWorkflowExecution" {
-    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "StartWorkflowExecution failed: wrong method name")
-    return
+func NewDescribeShardResponse() *DescribeShardResponse {
+  return &DescribeShardResponse{}
+}
+
+var DescribeShardResponse_ShardId_DEFAULT int32
+func (p *DescribeShardResponse) GetShardId() int32 {
+  if !p.IsSetShardId() {
+    return DescribeShardResponse_ShardId_DEFAULT
   }
-  if p.SeqId != seqId {
-    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "StartWorkflowExecution failed: out of sequence response")
-    return
+return *p.ShardId
+}
+var DescribeShardResponse_Owner_DEFAULT string
+func (p *DescribeShardResponse) GetOwner() string {
+  if !p.IsSetOwner() {
+    return DescribeShardResponse_Owner_DEFAULT
   }
-  if mTypeId == thrift.EXCEPTION {
-    error0 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-    var error1 error
-    error1, err = error0.Read(iprot)
-    if err != nil {
-      return
-    }
-    if err = iprot.ReadMessageEnd(); err != nil {
-      return
-    }
-    err = error1
-    return
+return *p.Owner
+}
+var DescribeShardResponse_RangeId_DEFAULT int64
+func (p *DescribeShardResponse) GetRangeId() int64 {
+  if !p.IsSetRangeId() {
+    return DescribeShardResponse_RangeId_DEFAULT
   }
-  if mTypeId != thrift.REPLY {
-    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "StartWorkflowExecution failed: invalid message type")
-    return
+return *p.RangeId
+}
+var DescribeShardResponse_TransferAckLevel_DEFAULT int64
+func (p *DescribeShardResponse) GetTransferAckLevel() int64 {
+  if !p.IsSetTransferAckLevel() {
+    return DescribeShardResponse_TransferAckLevel_DEFAULT
   }
-  result := HistoryServiceStartWorkflowExecutionResult{}
-  if err = result.Read(iprot); err != nil {
-    return
+return *p.TransferAckLevel
+}
+var DescribeShardResponse_TransferMaxReadLevel_DEFAULT int64
+func (p *DescribeShardResponse) GetTransferMaxReadLevel() int64 {
+  if !p.IsSetTransferMaxReadLevel() {
+    return DescribeShardResponse_TransferMaxReadLevel_DEFAULT
   }
-  if err = iprot.ReadMessageEnd(); err != nil {
-    return
+return *p.TransferMaxReadLevel
+}
+var DescribeShardResponse_MaxTaskId_DEFAULT int64
+func (p *DescribeShardResponse) GetMaxTaskId() int64 {
+  if !p.IsSetMaxTaskId() {
+    return DescribeShardResponse_MaxTaskId_DEFAULT
   }
-  if result.BadRequestError != nil {
-    err = result.BadRequestError
-    return 
-  } else   if result.InternalServiceError != nil {
-    err = result.InternalServiceError
-    return 
-  } else   if result.SessionAlreadyExistError != nil {
-    err = result.SessionAlreadyExistError
-    return 
-  } else   if result.ShardOwnershipLostError != nil {
-    err = result.ShardOwnershipLostError
-    return 
+return *p.MaxTaskId
+}
+var DescribeShardResponse_TimerAckLevel_DEFAULT int64
+func (p *DescribeShardResponse) GetTimerAckLevel() int64 {
+  if !p.IsSetTimerAckLevel() {
+    return DescribeShardResponse_TimerAckLevel_DEFAULT
   }
-  value = result.GetSuccess()
-  return
+return *p.TimerAckLevel
+}
+func (p *DescribeShardResponse) IsSetShardId() bool {
+  return p.ShardId != nil
 }
 
-// Returns the nextEventID of the history of workflow execution. Only events in the history with Ids below the returned Id are
-// guaranteed to be valid, so the first step of reading an execution's history is to retrieve this event Id.
-// It fails with 'EntityNotExistError' if specified workflow execution in unknown to the service.
-// 
-// 
-// Parameters:
-//  - GetRequest
-func (p *HistoryServiceClient) GetWorkflowExecutionNextEventID(getRequest *GetWorkflowExecutionNextEventIDRequest) (r *GetWorkflowExecutionNextEventIDResponse, err error) {
-  if err = p.sendGetWorkflowExecutionNextEventID(getRequest); err != nil { return }
-  return p.recvGetWorkflowExecutionNextEventID()
+func (p *DescribeShardResponse) IsSetOwner() bool {
+  return p.Owner != nil
 }
 
-func (p *HistoryServiceClient) sendGetWorkflowExecutionNextEventID(getRequest *GetWorkflowExecutionNextEventIDRequest)(err error) {
-  oprot := p.OutputProtocol
-  if oprot == nil {
-    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
-    p.OutputProtocol = oprot
-  }
-  p.SeqId++
-  if err = oprot.WriteMessageBegin("GetWorkflowExecutionNextEventID", thrift.CALL, p.SeqId); err != nil {
-      return
-  }
-  args := HistoryServiceGetWorkflowExecutionNextEventIDArgs{
-  GetRequest : getRequest,
-  }
-  if err = args.Write(oprot); err != nil {
-      return
-  }
-  if err = oprot.WriteMessageEnd(); err != nil {
-      return
-  }
-  return oprot.Flush()
+func (p *DescribeShardResponse) IsSetRangeId() bool {
+  return p.RangeId != nil
 }
 
+func (p *DescribeShardResponse) IsSetTransferAckLevel() bool {
+  return p.TransferAckLevel != nil
+}
 
-func (p *HistoryServiceClient) recvGetWorkflowExecutionNextEventID() (value *GetWorkflowExecutionNextEventIDResponse, err error) {
-  iprot := p.InputProtocol
-  if iprot == nil {
-    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
-    p.InputProtocol = iprot
-  }
-  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
-  if err != nil {
-    return
-  }
-  if method != "GetWorkflowExecutionNextEventID" {
-    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "GetWorkflowExecutionNextEventID failed: wrong method name")
-    return
-  }
-  if p.SeqId != seqId {
-    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "GetWorkflowExecutionNextEventID failed: out of sequence response")
-    return
+func (p *DescribeShardResponse) IsSetTransferMaxReadLevel() bool {
+  return p.TransferMaxReadLevel != nil
+}
+
+func (p *DescribeShardResponse) IsSetMaxTaskId() bool {
+  return p.MaxTaskId != nil
+}
+
+func (p *DescribeShardResponse) IsSetTimerAckLevel() bool {
+  return p.TimerAckLevel != nil
+}
+
+func (p *DescribeShardResponse) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
-  if mTypeId == thrift.EXCEPTION {
-    error2 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-    var error3 error
-    error3, err = error2.Read(iprot)
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
     if err != nil {
-      return
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
     }
-    if err = iprot.ReadMessageEnd(); err != nil {
-      return
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
+    case 50:
+      if err := p.ReadField50(iprot); err != nil {
+        return err
+      }
+    case 60:
+      if err := p.ReadField60(iprot); err != nil {
+        return err
+      }
+    case 70:
+      if err := p.ReadField70(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
     }
-    err = error3
-    return
   }
-  if mTypeId != thrift.REPLY {
-    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "GetWorkflowExecutionNextEventID failed: invalid message type")
-    return
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
   }
-  result := HistoryServiceGetWorkflowExecutionNextEventIDResult{}
-  if err = result.Read(iprot); err != nil {
-    return
+  return nil
+}
+
+func (p *DescribeShardResponse)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.ShardId = &v
+}
+  return nil
+}
+
+func (p *DescribeShardResponse)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 20: ", err)
+} else {
+  p.Owner = &v
+}
+  return nil
+}
+
+func (p *DescribeShardResponse)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
+} else {
+  p.RangeId = &v
+}
+  return nil
+}
+
+func (p *DescribeShardResponse)  ReadField40(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 40: ", err)
+} else {
+  p.TransferAckLevel = &v
+}
+  return nil
+}
+
+func (p *DescribeShardResponse)  ReadField50(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 50: ", err)
+} else {
+  p.TransferMaxReadLevel = &v
+}
+  return nil
+}
+
+func (p *DescribeShardResponse)  ReadField60(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 60: ", err)
+} else {
+  p.MaxTaskId = &v
+}
+  return nil
+}
+
+func (p *DescribeShardResponse)  ReadField70(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 70: ", err)
+} else {
+  p.TimerAckLevel = &v
+}
+  return nil
+}
+
+func (p *DescribeShardResponse) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("DescribeShardResponse"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
+    if err := p.writeField40(oprot); err != nil { return err }
+    if err := p.writeField50(oprot); err != nil { return err }
+    if err := p.writeField60(oprot); err != nil { return err }
+    if err := p.writeField70(oprot); err != nil { return err }
   }
-  if err = iprot.ReadMessageEnd(); err != nil {
-    return
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *DescribeShardResponse) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetShardId() {
+    if err := oprot.WriteFieldBegin("shardId", thrift.I32, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:shardId: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.ShardId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.shardId (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:shardId: ", p), err) }
   }
-  if result.BadRequestError != nil {
-    err = result.BadRequestError
-    return 
-  } else   if result.InternalServiceError != nil {
-    err = result.InternalServiceError
-    return 
-  } else   if result.EntityNotExistError != nil {
-    err = result.EntityNotExistError
-    return 
-  } else   if result.ShardOwnershipLostError != nil {
-    err = result.ShardOwnershipLostError
-    return 
+  return err
+}
+
+func (p *DescribeShardResponse) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetOwner() {
+    if err := oprot.WriteFieldBegin("owner", thrift.STRING, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:owner: ", p), err) }
+    if err := oprot.WriteString(string(*p.Owner)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.owner (20) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:owner: ", p), err) }
   }
-  value = result.GetSuccess()
-  return
+  return err
 }
 
-// RecordDecisionTaskStarted is called by the Matchingservice before it hands a decision task to the application worker in response to
-// a PollForDecisionTask call. It records in the history the event that the decision task has started. It will return 'EventAlreadyStartedError',
-// if the workflow's execution history already includes a record of the event starting.
-// 
-// 
-// Parameters:
-//  - AddRequest
-func (p *HistoryServiceClient) RecordDecisionTaskStarted(addRequest *RecordDecisionTaskStartedRequest) (r *RecordDecisionTaskStartedResponse, err error) {
-  if err = p.sendRecordDecisionTaskStarted(addRequest); err != nil { return }
-  return p.recvRecordDecisionTaskStarted()
+func (p *DescribeShardResponse) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetRangeId() {
+    if err := oprot.WriteFieldBegin("rangeId", thrift.I64, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:rangeId: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.RangeId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.rangeId (30) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:rangeId: ", p), err) }
+  }
+  return err
 }
 
-func (p *HistoryServiceClient) sendRecordDecisionTaskStarted(addRequest *RecordDecisionTaskStartedRequest)(err error) {
-  oprot := p.OutputProtocol
-  if oprot == nil {
-    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
-    p.OutputProtocol = oprot
+func (p *DescribeShardResponse) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTransferAckLevel() {
+    if err := oprot.WriteFieldBegin("transferAckLevel", thrift.I64, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:transferAckLevel: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.TransferAckLevel)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.transferAckLevel (40) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:transferAckLevel: ", p), err) }
   }
-  p.SeqId++
-  if err = oprot.WriteMessageBegin("RecordDecisionTaskStarted", thrift.CALL, p.SeqId); err != nil {
-      return
+  return err
+}
+
+func (p *DescribeShardResponse) writeField50(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTransferMaxReadLevel() {
+    if err := oprot.WriteFieldBegin("transferMaxReadLevel", thrift.I64, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:transferMaxReadLevel: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.TransferMaxReadLevel)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.transferMaxReadLevel (50) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:transferMaxReadLevel: ", p), err) }
   }
-  args := HistoryServiceRecordDecisionTaskStartedArgs{
-  AddRequest : addRequest,
+  return err
+}
+
+func (p *DescribeShardResponse) writeField60(oprot thrift.TProtocol) (err error) {
+  if p.IsSetMaxTaskId() {
+    if err := oprot.WriteFieldBegin("maxTaskId", thrift.I64, 60); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:maxTaskId: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.MaxTaskId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.maxTaskId (60) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:maxTaskId: ", p), err) }
   }
-  if err = args.Write(oprot); err != nil {
-      return
+  return err
+}
+
+func (p *DescribeShardResponse) writeField70(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTimerAckLevel() {
+    if err := oprot.WriteFieldBegin("timerAckLevel", thrift.I64, 70); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 70:timerAckLevel: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.TimerAckLevel)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.timerAckLevel (70) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 70:timerAckLevel: ", p), err) }
   }
-  if err = oprot.WriteMessageEnd(); err != nil {
-      return
+  return err
+}
+
+func (p *DescribeShardResponse) String() string {
+  if p == nil {
+    return "<nil>"
   }
-  return oprot.Flush()
+  return fmt.Sprintf("DescribeShardResponse(%+v)", *p)
+}
+
+// Attributes:
+//  - ShardId
+//  - DomainUUID
+//  - Execution
+type InvalidateMutableStateRequest struct {
+  // unused fields # 1 to 9
+  ShardId *int32 `thrift:"shardId,10" db:"shardId" json:"shardId,omitempty"`
+  // unused fields # 11 to 19
+  DomainUUID *string `thrift:"domainUUID,20" db:"domainUUID" json:"domainUUID,omitempty"`
+  // unused fields # 21 to 29
+  Execution *shared.WorkflowExecution `thrift:"execution,30" db:"execution" json:"execution,omitempty"`
 }
 
+func NewInvalidateMutableStateRequest() *InvalidateMutableStateRequest {
+  return &InvalidateMutableStateRequest{}
+}
 
-func (p *HistoryServiceClient) recvRecordDecisionTaskStarted() (value *RecordDecisionTaskStartedResponse, err error) {
-  iprot := p.InputProtocol
-  if iprot == nil {
-    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
-    p.InputProtocol = iprot
+var InvalidateMutableStateRequest_ShardId_DEFAULT int32
+func (p *InvalidateMutableStateRequest) GetShardId() int32 {
+  if !p.IsSetShardId() {
+    return InvalidateMutableStateRequest_ShardId_DEFAULT
   }
-  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
-  if err != nil {
-    return
+return *p.ShardId
+}
+var InvalidateMutableStateRequest_DomainUUID_DEFAULT string
+func (p *InvalidateMutableStateRequest) GetDomainUUID() string {
+  if !p.IsSetDomainUUID() {
+    return InvalidateMutableStateRequest_DomainUUID_DEFAULT
   }
-  if method != "RecordDecisionTaskStarted" {
-    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RecordDecisionTaskStarted failed: wrong method name")
-    return
+return *p.DomainUUID
+}
+var InvalidateMutableStateRequest_Execution_DEFAULT *shared.WorkflowExecution
+func (p *InvalidateMutableStateRequest) GetExecution() *shared.WorkflowExecution {
+  if !p.IsSetExecution() {
+    return InvalidateMutableStateRequest_Execution_DEFAULT
   }
-  if p.SeqId != seqId {
-    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RecordDecisionTaskStarted failed: out of sequence response")
-    return
+return p.Execution
+}
+func (p *InvalidateMutableStateRequest) IsSetShardId() bool {
+  return p.ShardId != nil
+}
+
+func (p *InvalidateMutableStateRequest) IsSetDomainUUID() bool {
+  return p.DomainUUID != nil
+}
+
+func (p *InvalidateMutableStateRequest) IsSetExecution() bool {
+  return p.Execution != nil
+}
+
+func (p *InvalidateMutableStateRequest) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
-  if mTypeId == thrift.EXCEPTION {
-    error4 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-    var error5 error
-    error5, err = error4.Read(iprot)
-    if err != nil {
-      return
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
     }
-    if err = iprot.ReadMessageEnd(); err != nil {
-      return
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
     }
-    err = error5
-    return
-  }
-  if mTypeId != thrift.REPLY {
-    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RecordDecisionTaskStarted failed: invalid message type")
-    return
-  }
-  result := HistoryServiceRecordDecisionTaskStartedResult{}
-  if err = result.Read(iprot); err != nil {
-    return
-  }
-  if err = iprot.ReadMessageEnd(); err != nil {
-    return
   }
-  if result.BadRequestError != nil {
-    err = result.BadRequestError
-    return 
-  } else   if result.InternalServiceError != nil {
-    err = result.InternalServiceError
-    return 
-  } else   if result.EventAlreadyStartedError != nil {
-    err = result.EventAlreadyStartedError
-    return 
-  } else   if result.EntityNotExistError != nil {
-    err = result.EntityNotExistError
-    return 
-  } else   if result.ShardOwnershipLostError != nil {
-    err = result.ShardOwnershipLostError
-    return 
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
   }
-  value = result.GetSuccess()
-  return
+  return nil
 }
 
-// RecordActivityTaskStarted is called by the Matchingservice before it hands a decision task to the application worker in response to
-// a PollForActivityTask call. It records in the history the event that the decision task has started. It will return 'EventAlreadyStartedError',
-// if the workflow's execution history already includes a record of the event starting.
-// 
-// 
-// Parameters:
-//  - AddRequest
-func (p *HistoryServiceClient) RecordActivityTaskStarted(addRequest *RecordActivityTaskStartedRequest) (r *RecordActivityTaskStartedResponse, err error) {
-  if err = p.sendRecordActivityTaskStarted(addRequest); err != nil { return }
-  return p.recvRecordActivityTaskStarted()
+func (p *InvalidateMutableStateRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.ShardId = &v
 }
-
-func (p *HistoryServiceClient) sendRecordActivityTaskStarted(addRequest *RecordActivityTaskStartedRequest)(err error) {
-  oprot := p.OutputProtocol
-  if oprot == nil {
-    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
-    p.OutputProtocol = oprot
-  }
-  p.SeqId++
-  if err = oprot.WriteMessageBegin("RecordActivityTaskStarted", thrift.CALL, p.SeqId); err != nil {
-      return
-  }
-  args := HistoryServiceRecordActivityTaskStartedArgs{
-  AddRequest : addRequest,
-  }
-  if err = args.Write(oprot); err != nil {
-      return
-  }
-  if err = oprot.WriteMessageEnd(); err != nil {
-      return
-  }
-  return oprot.Flush()
+  return nil
 }
 
+func (p *InvalidateMutableStateRequest)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 20: ", err)
+} else {
+  p.DomainUUID = &v
+}
+  return nil
+}
 
-func (p *HistoryServiceClient) recvRecordActivityTaskStarted() (value *RecordActivityTaskStartedResponse, err error) {
-  iprot := p.InputProtocol
-  if iprot == nil {
-    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
-    p.InputProtocol = iprot
+func (p *InvalidateMutableStateRequest)  ReadField30(iprot thrift.TProtocol) error {
+  p.Execution = &shared.WorkflowExecution{}
+  if err := p.Execution.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Execution), err)
   }
-  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
-  if err != nil {
-    return
+  return nil
+}
+
+func (p *InvalidateMutableStateRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("InvalidateMutableStateRequest"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
   }
-  if method != "RecordActivityTaskStarted" {
-    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RecordActivityTaskStarted failed: wrong method name")
-    return
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *InvalidateMutableStateRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetShardId() {
+    if err := oprot.WriteFieldBegin("shardId", thrift.I32, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:shardId: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.ShardId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.shardId (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:shardId: ", p), err) }
   }
-  if p.SeqId != seqId {
-    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RecordActivityTaskStarted failed: out of sequence response")
-    return
+  return err
+}
+
+func (p *InvalidateMutableStateRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomainUUID() {
+    if err := oprot.WriteFieldBegin("domainUUID", thrift.STRING, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:domainUUID: ", p), err) }
+    if err := oprot.WriteString(string(*p.DomainUUID)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domainUUID (20) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:domainUUID: ", p), err) }
   }
-  if mTypeId == thrift.EXCEPTION {
-    error6 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-    var error7 error
-    error7, err = error6.Read(iprot)
-    if err != nil {
-      return
-    }
-    if err = iprot.ReadMessageEnd(); err != nil {
-      return
+  return err
+}
+
+func (p *InvalidateMutableStateRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetExecution() {
+    if err := oprot.WriteFieldBegin("execution", thrift.STRUCT, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:execution: ", p), err) }
+    if err := p.Execution.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Execution), err)
     }
-    err = error7
-    return
-  }
-  if mTypeId != thrift.REPLY {
-    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RecordActivityTaskStarted failed: invalid message type")
-    return
-  }
-  result := HistoryServiceRecordActivityTaskStartedResult{}
-  if err = result.Read(iprot); err != nil {
-    return
-  }
-  if err = iprot.ReadMessageEnd(); err != nil {
-    return
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:execution: ", p), err) }
   }
-  if result.BadRequestError != nil {
-    err = result.BadRequestError
-    return 
-  } else   if result.InternalServiceError != nil {
-    err = result.InternalServiceError
-    return 
-  } else   if result.EventAlreadyStartedError != nil {
-    err = result.EventAlreadyStartedError
-    return 
-  } else   if result.EntityNotExistError != nil {
-    err = result.EntityNotExistError
-    return 
-  } else   if result.ShardOwnershipLostError != nil {
-    err = result.ShardOwnershipLostError
-    return 
+  return err
+}
+
+func (p *InvalidateMutableStateRequest) String() string {
+  if p == nil {
+    return "<nil>"
   }
-  value = result.GetSuccess()
-  return
+  return fmt.Sprintf("InvalidateMutableStateRequest(%+v)", *p)
 }
 
-// RespondDecisionTaskCompleted is called by application worker to complete a DecisionTask handed as a result of
-// 'PollForDecisionTask' API call.  Completing a DecisionTask will result in new events for the workflow execution and
-// potentially new ActivityTask being created for corresponding decisions.  It will also create a DecisionTaskCompleted
-// event in the history for that session.  Use the 'taskToken' provided as response of PollForDecisionTask API call
-// for completing the DecisionTask.
-// 
-// 
-// Parameters:
-//  - CompleteRequest
-func (p *HistoryServiceClient) RespondDecisionTaskCompleted(completeRequest *RespondDecisionTaskCompletedRequest) (err error) {
-  if err = p.sendRespondDecisionTaskCompleted(completeRequest); err != nil { return }
-  return p.recvRespondDecisionTaskCompleted()
+// Attributes:
+//  - ShardId
+//  - DomainUUID
+//  - TransferQueue
+//  - TimerQueue
+//  - Paused
+type SetTaskProcessingPausedRequest struct {
+  // unused fields # 1 to 9
+  ShardId *int32 `thrift:"shardId,10" db:"shardId" json:"shardId,omitempty"`
+  // unused fields # 11 to 19
+  DomainUUID *string `thrift:"domainUUID,20" db:"domainUUID" json:"domainUUID,omitempty"`
+  // unused fields # 21 to 29
+  TransferQueue *bool `thrift:"transferQueue,30" db:"transferQueue" json:"transferQueue,omitempty"`
+  // unused fields # 31 to 39
+  TimerQueue *bool `thrift:"timerQueue,40" db:"timerQueue" json:"timerQueue,omitempty"`
+  // unused fields # 41 to 49
+  Paused *bool `thrift:"paused,50" db:"paused" json:"paused,omitempty"`
 }
 
-func (p *HistoryServiceClient) sendRespondDecisionTaskCompleted(completeRequest *RespondDecisionTaskCompletedRequest)(err error) {
-  oprot := p.OutputProtocol
-  if oprot == nil {
-    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
-    p.OutputProtocol = oprot
+func NewSetTaskProcessingPausedRequest() *SetTaskProcessingPausedRequest {
+  return &SetTaskProcessingPausedRequest{}
+}
+
+var SetTaskProcessingPausedRequest_ShardId_DEFAULT int32
+func (p *SetTaskProcessingPausedRequest) GetShardId() int32 {
+  if !p.IsSetShardId() {
+    return SetTaskProcessingPausedRequest_ShardId_DEFAULT
   }
-  p.SeqId++
-  if err = oprot.WriteMessageBegin("RespondDecisionTaskCompleted", thrift.CALL, p.SeqId); err != nil {
-      return
+return *p.ShardId
+}
+var SetTaskProcessingPausedRequest_DomainUUID_DEFAULT string
+func (p *SetTaskProcessingPausedRequest) GetDomainUUID() string {
+  if !p.IsSetDomainUUID() {
+    return SetTaskProcessingPausedRequest_DomainUUID_DEFAULT
   }
-  args := HistoryServiceRespondDecisionTaskCompletedArgs{
-  CompleteRequest : completeRequest,
+return *p.DomainUUID
+}
+var SetTaskProcessingPausedRequest_TransferQueue_DEFAULT bool
+func (p *SetTaskProcessingPausedRequest) GetTransferQueue() bool {
+  if !p.IsSetTransferQueue() {
+    return SetTaskProcessingPausedRequest_TransferQueue_DEFAULT
   }
-  if err = args.Write(oprot); err != nil {
-      return
+return *p.TransferQueue
+}
+var SetTaskProcessingPausedRequest_TimerQueue_DEFAULT bool
+func (p *SetTaskProcessingPausedRequest) GetTimerQueue() bool {
+  if !p.IsSetTimerQueue() {
+    return SetTaskProcessingPausedRequest_TimerQueue_DEFAULT
   }
-  if err = oprot.WriteMessageEnd(); err != nil {
-      return
+return *p.TimerQueue
+}
+var SetTaskProcessingPausedRequest_Paused_DEFAULT bool
+func (p *SetTaskProcessingPausedRequest) GetPaused() bool {
+  if !p.IsSetPaused() {
+    return SetTaskProcessingPausedRequest_Paused_DEFAULT
   }
-  return oprot.Flush()
+return *p.Paused
+}
+func (p *SetTaskProcessingPausedRequest) IsSetShardId() bool {
+  return p.ShardId != nil
 }
 
+func (p *SetTaskProcessingPausedRequest) IsSetDomainUUID() bool {
+  return p.DomainUUID != nil
+}
 
-func (p *HistoryServiceClient) recvRespondDecisionTaskCompleted() (err error) {
-  iprot := p.InputProtocol
-  if iprot == nil {
-    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
-    p.InputProtocol = iprot
-  }
-  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
-  if err != nil {
-    return
+func (p *SetTaskProcessingPausedRequest) IsSetTransferQueue() bool {
+  return p.TransferQueue != nil
+}
+
+func (p *SetTaskProcessingPausedRequest) IsSetTimerQueue() bool {
+  return p.TimerQueue != nil
+}
+
+func (p *SetTaskProcessingPausedRequest) IsSetPaused() bool {
+  return p.Paused != nil
+}
+
+func (p *SetTaskProcessingPausedRequest) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
-  if method != "RespondDecisionTaskCompleted" {
-    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RespondDecisionTaskCompleted failed: wrong method name")
-    return
-  }
-  if p.SeqId != seqId {
-    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RespondDecisionTaskCompleted failed: out of sequence response")
-    return
-  }
-  if mTypeId == thrift.EXCEPTION {
-    error8 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-    var error9 error
-    error9, err = error8.Read(iprot)
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
     if err != nil {
-      return
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
     }
-    if err = iprot.ReadMessageEnd(); err != nil {
-      return
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
+    case 50:
+      if err := p.ReadField50(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
     }
-    err = error9
-    return
-  }
-  if mTypeId != thrift.REPLY {
-    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RespondDecisionTaskCompleted failed: invalid message type")
-    return
-  }
-  result := HistoryServiceRespondDecisionTaskCompletedResult{}
-  if err = result.Read(iprot); err != nil {
-    return
-  }
-  if err = iprot.ReadMessageEnd(); err != nil {
-    return
   }
-  if result.BadRequestError != nil {
-    err = result.BadRequestError
-    return 
-  } else   if result.InternalServiceError != nil {
-    err = result.InternalServiceError
-    return 
-  } else   if result.EntityNotExistError != nil {
-    err = result.EntityNotExistError
-    return 
-  } else   if result.ShardOwnershipLostError != nil {
-    err = result.ShardOwnershipLostError
-    return 
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
   }
-  return
+  return nil
 }
 
-// RecordActivityTaskHeartbeat is called by application worker while it is processing an ActivityTask.  If worker fails
-// to heartbeat within 'heartbeatTimeoutSeconds' interval for the ActivityTask, then it will be marked as timedout and
-// 'ActivityTaskTimedOut' event will be written to the workflow history.  Calling 'RecordActivityTaskHeartbeat' will
-// fail with 'EntityNotExistsError' in such situations.  Use the 'taskToken' provided as response of
-// PollForActivityTask API call for heartbeating.
-// 
-// 
-// Parameters:
-//  - HeartbeatRequest
-func (p *HistoryServiceClient) RecordActivityTaskHeartbeat(heartbeatRequest *RecordActivityTaskHeartbeatRequest) (r *shared.RecordActivityTaskHeartbeatResponse, err error) {
-  if err = p.sendRecordActivityTaskHeartbeat(heartbeatRequest); err != nil { return }
-  return p.recvRecordActivityTaskHeartbeat()
+func (p *SetTaskProcessingPausedRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.ShardId = &v
+}
+  return nil
 }
 
-func (p *HistoryServiceClient) sendRecordActivityTaskHeartbeat(heartbeatRequest *RecordActivityTaskHeartbeatRequest)(err error) {
-  oprot := p.OutputProtocol
-  if oprot == nil {
-    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
-    p.OutputProtocol = oprot
-  }
-  p.SeqId++
-  if err = oprot.WriteMessageBegin("RecordActivityTaskHeartbeat", thrift.CALL, p.SeqId); err != nil {
-      return
-  }
-  args := HistoryServiceRecordActivityTaskHeartbeatArgs{
-  HeartbeatRequest : heartbeatRequest,
-  }
-  if err = args.Write(oprot); err != nil {
-      return
-  }
-  if err = oprot.WriteMessageEnd(); err != nil {
-      return
-  }
-  return oprot.Flush()
+func (p *SetTaskProcessingPausedRequest)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 20: ", err)
+} else {
+  p.DomainUUID = &v
+}
+  return nil
 }
 
+func (p *SetTaskProcessingPausedRequest)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
+} else {
+  p.TransferQueue = &v
+}
+  return nil
+}
 
-func (p *HistoryServiceClient) recvRecordActivityTaskHeartbeat() (value *shared.RecordActivityTaskHeartbeatResponse, err error) {
-  iprot := p.InputProtocol
-  if iprot == nil {
-    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
-    p.InputProtocol = iprot
-  }
-  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
-  if err != nil {
-    return
-  }
-  if method != "RecordActivityTaskHeartbeat" {
-    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RecordActivityTaskHeartbeat failed: wrong method name")
-    return
+func (p *SetTaskProcessingPausedRequest)  ReadField40(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(); err != nil {
+  return thrift.PrependError("error reading field 40: ", err)
+} else {
+  p.TimerQueue = &v
+}
+  return nil
+}
+
+func (p *SetTaskProcessingPausedRequest)  ReadField50(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(); err != nil {
+  return thrift.PrependError("error reading field 50: ", err)
+} else {
+  p.Paused = &v
+}
+  return nil
+}
+
+func (p *SetTaskProcessingPausedRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("SetTaskProcessingPausedRequest"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
+    if err := p.writeField40(oprot); err != nil { return err }
+    if err := p.writeField50(oprot); err != nil { return err }
   }
-  if p.SeqId != seqId {
-    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RecordActivityTaskHeartbeat failed: out of sequence response")
-    return
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *SetTaskProcessingPausedRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetShardId() {
+    if err := oprot.WriteFieldBegin("shardId", thrift.I32, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:shardId: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.ShardId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.shardId (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:shardId: ", p), err) }
   }
-  if mTypeId == thrift.EXCEPTION {
-    error10 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-    var error11 error
-    error11, err = error10.Read(iprot)
-    if err != nil {
-      return
-    }
-    if err = iprot.ReadMessageEnd(); err != nil {
-      return
-    }
-    err = error11
-    return
+  return err
+}
+
+func (p *SetTaskProcessingPausedRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomainUUID() {
+    if err := oprot.WriteFieldBegin("domainUUID", thrift.STRING, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:domainUUID: ", p), err) }
+    if err := oprot.WriteString(string(*p.DomainUUID)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domainUUID (20) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:domainUUID: ", p), err) }
   }
-  if mTypeId != thrift.REPLY {
-    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RecordActivityTaskHeartbeat failed: invalid message type")
-    return
+  return err
+}
+
+func (p *SetTaskProcessingPausedRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTransferQueue() {
+    if err := oprot.WriteFieldBegin("transferQueue", thrift.BOOL, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:transferQueue: ", p), err) }
+    if err := oprot.WriteBool(bool(*p.TransferQueue)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.transferQueue (30) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:transferQueue: ", p), err) }
   }
-  result := HistoryServiceRecordActivityTaskHeartbeatResult{}
-  if err = result.Read(iprot); err != nil {
-    return
+  return err
+}
+
+func (p *SetTaskProcessingPausedRequest) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTimerQueue() {
+    if err := oprot.WriteFieldBegin("timerQueue", thrift.BOOL, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:timerQueue: ", p), err) }
+    if err := oprot.WriteBool(bool(*p.TimerQueue)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.timerQueue (40) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:timerQueue: ", p), err) }
   }
-  if err = iprot.ReadMessageEnd(); err != nil {
-    return
+  return err
+}
+
+func (p *SetTaskProcessingPausedRequest) writeField50(oprot thrift.TProtocol) (err error) {
+  if p.IsSetPaused() {
+    if err := oprot.WriteFieldBegin("paused", thrift.BOOL, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:paused: ", p), err) }
+    if err := oprot.WriteBool(bool(*p.Paused)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.paused (50) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:paused: ", p), err) }
   }
-  if result.BadRequestError != nil {
-    err = result.BadRequestError
-    return 
-  } else   if result.InternalServiceError != nil {
-    err = result.InternalServiceError
-    return 
-  } else   if result.EntityNotExistError != nil {
-    err = result.EntityNotExistError
-    return 
-  } else   if result.ShardOwnershipLostError != nil {
-    err = result.ShardOwnershipLostError
-    return 
+  return err
+}
+
+func (p *SetTaskProcessingPausedRequest) String() string {
+  if p == nil {
+    return "<nil>"
   }
-  value = result.GetSuccess()
-  return
+  return fmt.Sprintf("SetTaskProcessingPausedRequest(%+v)", *p)
 }
 
-// RespondActivityTaskCompleted is called by application worker when it is done processing an ActivityTask.  It will
-// result in a new 'ActivityTaskCompleted' event being written to the workflow history and a new DecisionTask
-// created for the workflow so new decisions could be made.  Use the 'taskToken' provided as response of
-// PollForActivityTask API call for completion. It fails with 'EntityNotExistsError' if the taskToken is not valid
-// anymore due to activity timeout.
-// 
-// 
-// Parameters:
-//  - CompleteRequest
-func (p *HistoryServiceClient) RespondActivityTaskCompleted(completeRequest *RespondActivityTaskCompletedRequest) (err error) {
-  if err = p.sendRespondActivityTaskCompleted(completeRequest); err != nil { return }
-  return p.recvRespondActivityTaskCompleted()
+// Attributes:
+//  - ShardId
+//  - PageSize
+//  - NextPageToken
+type ListConcreteExecutionsRequest struct {
+  // unused fields # 1 to 9
+  ShardId *int32 `thrift:"shardId,10" db:"shardId" json:"shardId,omitempty"`
+  // unused fields # 11 to 19
+  PageSize *int32 `thrift:"pageSize,20" db:"pageSize" json:"pageSize,omitempty"`
+  // unused fields # 21 to 29
+  NextPageToken []byte `thrift:"nextPageToken,30" db:"nextPageToken" json:"nextPageToken,omitempty"`
 }
 
-func (p *HistoryServiceClient) sendRespondActivityTaskCompleted(completeRequest *RespondActivityTaskCompletedRequest)(err error) {
-  oprot := p.OutputProtocol
-  if oprot == nil {
-    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
-    p.OutputProtocol = oprot
-  }
-  p.SeqId++
-  if err = oprot.WriteMessageBegin("RespondActivityTaskCompleted", thrift.CALL, p.SeqId); err != nil {
-      return
-  }
-  args := HistoryServiceRespondActivityTaskCompletedArgs{
-  CompleteRequest : completeRequest,
-  }
-  if err = args.Write(oprot); err != nil {
-      return
+func NewListConcreteExecutionsRequest() *ListConcreteExecutionsRequest {
+  return &ListConcreteExecutionsRequest{}
+}
+
+var ListConcreteExecutionsRequest_ShardId_DEFAULT int32
+func (p *ListConcreteExecutionsRequest) GetShardId() int32 {
+  if !p.IsSetShardId() {
+    return ListConcreteExecutionsRequest_ShardId_DEFAULT
   }
-  if err = oprot.WriteMessageEnd(); err != nil {
-      return
+return *p.ShardId
+}
+var ListConcreteExecutionsRequest_PageSize_DEFAULT int32
+func (p *ListConcreteExecutionsRequest) GetPageSize() int32 {
+  if !p.IsSetPageSize() {
+    return ListConcreteExecutionsRequest_PageSize_DEFAULT
   }
-  return oprot.Flush()
+return *p.PageSize
 }
+var ListConcreteExecutionsRequest_NextPageToken_DEFAULT []byte
 
+func (p *ListConcreteExecutionsRequest) GetNextPageToken() []byte {
+  return p.NextPageToken
+}
+func (p *ListConcreteExecutionsRequest) IsSetShardId() bool {
+  return p.ShardId != nil
+}
 
-func (p *HistoryServiceClient) recvRespondActivityTaskCompleted() (err error) {
-  iprot := p.InputProtocol
-  if iprot == nil {
-    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
-    p.InputProtocol = iprot
-  }
-  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
-  if err != nil {
-    return
-  }
-  if method != "RespondActivityTaskCompleted" {
-    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RespondActivityTaskCompleted failed: wrong method name")
-    return
-  }
-  if p.SeqId != seqId {
-    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RespondActivityTaskCompleted failed: out of sequence response")
-    return
+func (p *ListConcreteExecutionsRequest) IsSetPageSize() bool {
+  return p.PageSize != nil
+}
+
+func (p *ListConcreteExecutionsRequest) IsSetNextPageToken() bool {
+  return p.NextPageToken != nil
+}
+
+func (p *ListConcreteExecutionsRequest) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
-  if mTypeId == thrift.EXCEPTION {
-    error12 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-    var error13 error
-    error13, err = error12.Read(iprot)
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
     if err != nil {
-      return
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
     }
-    if err = iprot.ReadMessageEnd(); err != nil {
-      return
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
     }
-    err = error13
-    return
-  }
-  if mTypeId != thrift.REPLY {
-    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RespondActivityTaskCompleted failed: invalid message type")
-    return
-  }
-  result := HistoryServiceRespondActivityTaskCompletedResult{}
-  if err = result.Read(iprot); err != nil {
-    return
-  }
-  if err = iprot.ReadMessageEnd(); err != nil {
-    return
   }
-  if result.BadRequestError != nil {
-    err = result.BadRequestError
-    return 
-  } else   if result.InternalServiceError != nil {
-    err = result.InternalServiceError
-    return 
-  } else   if result.EntityNotExistError != nil {
-    err = result.EntityNotExistError
-    return 
-  } else   if result.ShardOwnershipLostError != nil {
-    err = result.ShardOwnershipLostError
-    return 
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
   }
-  return
+  return nil
 }
 
-// RespondActivityTaskFailed is called by application worker when it is done processing an ActivityTask.  It will
-// result in a new 'ActivityTaskFailed' event being written to the workflow history and a new DecisionTask
-// created for the workflow instance so new decisions could be made.  Use the 'taskToken' provided as response of
-// PollForActivityTask API call for completion. It fails with 'EntityNotExistsError' if the taskToken is not valid
-// anymore due to activity timeout.
-// 
-// 
-// Parameters:
-//  - FailRequest
-func (p *HistoryServiceClient) RespondActivityTaskFailed(failRequest *RespondActivityTaskFailedRequest) (err error) {
-  if err = p.sendRespondActivityTaskFailed(failRequest); err != nil { return }
-  return p.recvRespondActivityTaskFailed()
+func (p *ListConcreteExecutionsRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.ShardId = &v
+}
+  return nil
 }
 
-func (p *HistoryServiceClient) sendRespondActivityTaskFailed(failRequest *RespondActivityTaskFailedRequest)(err error) {
-  oprot := p.OutputProtocol
-  if oprot == nil {
-    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
-    p.OutputProtocol = oprot
+func (p *ListConcreteExecutionsRequest)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 20: ", err)
+} else {
+  p.PageSize = &v
+}
+  return nil
+}
+
+func (p *ListConcreteExecutionsRequest)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
+} else {
+  p.NextPageToken = v
+}
+  return nil
+}
+
+func (p *ListConcreteExecutionsRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ListConcreteExecutionsRequest"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
   }
-  p.SeqId++
-  if err = oprot.WriteMessageBegin("RespondActivityTaskFailed", thrift.CALL, p.SeqId); err != nil {
-      return
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ListConcreteExecutionsRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetShardId() {
+    if err := oprot.WriteFieldBegin("shardId", thrift.I32, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:shardId: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.ShardId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.shardId (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:shardId: ", p), err) }
   }
-  args := HistoryServiceRespondActivityTaskFailedArgs{
-  FailRequest : failRequest,
+  return err
+}
+
+func (p *ListConcreteExecutionsRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetPageSize() {
+    if err := oprot.WriteFieldBegin("pageSize", thrift.I32, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:pageSize: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.PageSize)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.pageSize (20) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:pageSize: ", p), err) }
   }
-  if err = args.Write(oprot); err != nil {
-      return
+  return err
+}
+
+func (p *ListConcreteExecutionsRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetNextPageToken() {
+    if err := oprot.WriteFieldBegin("nextPageToken", thrift.STRING, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:nextPageToken: ", p), err) }
+    if err := oprot.WriteBinary(p.NextPageToken); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.nextPageToken (30) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:nextPageToken: ", p), err) }
   }
-  if err = oprot.WriteMessageEnd(); err != nil {
-      return
+  return err
+}
+
+func (p *ListConcreteExecutionsRequest) String() string {
+  if p == nil {
+    return "<nil>"
   }
-  return oprot.Flush()
+  return fmt.Sprintf("ListConcreteExecutionsRequest(%+v)", *p)
+}
+
+// Attributes:
+//  - DomainUUID
+//  - Execution
+//  - WorkflowTypeName
+//  - State
+//  - CloseStatus
+//  - NextEventId
+//  - StartTimestamp
+//  - LastUpdatedTimestamp
+type ConcreteExecution struct {
+  // unused fields # 1 to 9
+  DomainUUID *string `thrift:"domainUUID,10" db:"domainUUID" json:"domainUUID,omitempty"`
+  // unused fields # 11 to 19
+  Execution *shared.WorkflowExecution `thrift:"execution,20" db:"execution" json:"execution,omitempty"`
+  // unused fields # 21 to 29
+  WorkflowTypeName *string `thrift:"workflowTypeName,30" db:"workflowTypeName" json:"workflowTypeName,omitempty"`
+  // unused fields # 31 to 39
+  State *int32 `thrift:"state,40" db:"state" json:"state,omitempty"`
+  // unused fields # 41 to 49
+  CloseStatus *int32 `thrift:"closeStatus,50" db:"closeStatus" json:"closeStatus,omitempty"`
+  // unused fields # 51 to 59
+  NextEventId *int64 `thrift:"nextEventId,60" db:"nextEventId" json:"nextEventId,omitempty"`
+  // unused fields # 61 to 69
+  StartTimestamp *int64 `thrift:"startTimestamp,70" db:"startTimestamp" json:"startTimestamp,omitempty"`
+  // unused fields # 71 to 79
+  LastUpdatedTimestamp *int64 `thrift:"lastUpdatedTimestamp,80" db:"lastUpdatedTimestamp" json:"lastUpdatedTimestamp,omitempty"`
 }
 
+func NewConcreteExecution() *ConcreteExecution {
+  return &ConcreteExecution{}
+}
 
-func (p *HistoryServiceClient) recvRespondActivityTaskFailed() (err error) {
-  iprot := p.InputProtocol
-  if iprot == nil {
-    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
-    p.InputProtocol = iprot
-  }
-  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
-  if err != nil {
-    return
+var ConcreteExecution_DomainUUID_DEFAULT string
+func (p *ConcreteExecution) GetDomainUUID() string {
+  if !p.IsSetDomainUUID() {
+    return ConcreteExecution_DomainUUID_DEFAULT
   }
-  if method != "RespondActivityTaskFailed" {
-    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RespondActivityTaskFailed failed: wrong method name")
-    return
+return *p.DomainUUID
+}
+var ConcreteExecution_Execution_DEFAULT *shared.WorkflowExecution
+func (p *ConcreteExecution) GetExecution() *shared.WorkflowExecution {
+  if !p.IsSetExecution() {
+    return ConcreteExecution_Execution_DEFAULT
   }
-  if p.SeqId != seqId {
-    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RespondActivityTaskFailed failed: out of sequence response")
-    return
+return p.Execution
+}
+var ConcreteExecution_WorkflowTypeName_DEFAULT string
+func (p *ConcreteExecution) GetWorkflowTypeName() string {
+  if !p.IsSetWorkflowTypeName() {
+    return ConcreteExecution_WorkflowTypeName_DEFAULT
   }
-  if mTypeId == thrift.EXCEPTION {
-    error14 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-    var error15 error
-    error15, err = error14.Read(iprot)
-    if err != nil {
-      return
-    }
-    if err = iprot.ReadMessageEnd(); err != nil {
-      return
-    }
-    err = error15
-    return
+return *p.WorkflowTypeName
+}
+var ConcreteExecution_State_DEFAULT int32
+func (p *ConcreteExecution) GetState() int32 {
+  if !p.IsSetState() {
+    return ConcreteExecution_State_DEFAULT
   }
-  if mTypeId != thrift.REPLY {
-    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RespondActivityTaskFailed failed: invalid message type")
-    return
+return *p.State
+}
+var ConcreteExecution_CloseStatus_DEFAULT int32
+func (p *ConcreteExecution) GetCloseStatus() int32 {
+  if !p.IsSetCloseStatus() {
+    return ConcreteExecution_CloseStatus_DEFAULT
   }
-  result := HistoryServiceRespondActivityTaskFailedResult{}
-  if err = result.Read(iprot); err != nil {
-    return
+return *p.CloseStatus
+}
+var ConcreteExecution_NextEventId_DEFAULT int64
+func (p *ConcreteExecution) GetNextEventId() int64 {
+  if !p.IsSetNextEventId() {
+    return ConcreteExecution_NextEventId_DEFAULT
   }
-  if err = iprot.ReadMessageEnd(); err != nil {
-    return
+return *p.NextEventId
+}
+var ConcreteExecution_StartTimestamp_DEFAULT int64
+func (p *ConcreteExecution) GetStartTimestamp() int64 {
+  if !p.IsSetStartTimestamp() {
+    return ConcreteExecution_StartTimestamp_DEFAULT
   }
-  if result.BadRequestError != nil {
-    err = result.BadRequestError
-    return 
-  } else   if result.InternalServiceError != nil {
-    err = result.InternalServiceError
-    return 
-  } else   if result.EntityNotExistError != nil {
-    err = result.EntityNotExistError
-    return 
-  } else   if result.ShardOwnershipLostError != nil {
-    err = result.ShardOwnershipLostError
-    return 
+return *p.StartTimestamp
+}
+var ConcreteExecution_LastUpdatedTimestamp_DEFAULT int64
+func (p *ConcreteExecution) GetLastUpdatedTimestamp() int64 {
+  if !p.IsSetLastUpdatedTimestamp() {
+    return ConcreteExecution_LastUpdatedTimestamp_DEFAULT
   }
-  return
+return *p.LastUpdatedTimestamp
+}
+func (p *ConcreteExecution) IsSetDomainUUID() bool {
+  return p.DomainUUID != nil
 }
 
-// RespondActivityTaskCanceled is called by application worker when it is successfully canceled an ActivityTask.  It will
-// result in a new 'ActivityTaskCanceled' event being written to the workflow history and a new DecisionTask
-// created for the workflow instance so new decisions could be made.  Use the 'taskToken' provided as response of
-// PollForActivityTask API call for completion. It fails with 'EntityNotExistsError' if the taskToken is not valid
-// anymore due to activity timeout.
-// 
-// 
-// Parameters:
-//  - CanceledRequest
-func (p *HistoryServiceClient) RespondActivityTaskCanceled(canceledRequest *RespondActivityTaskCanceledRequest) (err error) {
-  if err = p.sendRespondActivityTaskCanceled(canceledRequest); err != nil { return }
-  return p.recvRespondActivityTaskCanceled()
+func (p *ConcreteExecution) IsSetExecution() bool {
+  return p.Execution != nil
 }
 
-func (p *HistoryServiceClient) sendRespondActivityTaskCanceled(canceledRequest *RespondActivityTaskCanceledRequest)(err error) {
-  oprot := p.OutputProtocol
-  if oprot == nil {
-    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
-    p.OutputProtocol = oprot
-  }
-  p.SeqId++
-  if err = oprot.WriteMessageBegin("RespondActivityTaskCanceled", thrift.CALL, p.SeqId); err != nil {
-      return
-  }
-  args := HistoryServiceRespondActivityTaskCanceledArgs{
-  CanceledRequest : canceledRequest,
-  }
-  if err = args.Write(oprot); err != nil {
-      return
-  }
-  if err = oprot.WriteMessageEnd(); err != nil {
-      return
-  }
-  return oprot.Flush()
+func (p *ConcreteExecution) IsSetWorkflowTypeName() bool {
+  return p.WorkflowTypeName != nil
 }
 
+func (p *ConcreteExecution) IsSetState() bool {
+  return p.State != nil
+}
 
-func (p *HistoryServiceClient) recvRespondActivityTaskCanceled() (err error) {
-  iprot := p.InputProtocol
-  if iprot == nil {
-    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
-    p.InputProtocol = iprot
-  }
-  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
-  if err != nil {
-    return
-  }
-  if method != "RespondActivityTaskCanceled" {
-    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RespondActivityTaskCanceled failed: wrong method name")
-    return
-  }
-  if p.SeqId != seqId {
-    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RespondActivityTaskCanceled failed: out of sequence response")
-    return
+func (p *ConcreteExecution) IsSetCloseStatus() bool {
+  return p.CloseStatus != nil
+}
+
+func (p *ConcreteExecution) IsSetNextEventId() bool {
+  return p.NextEventId != nil
+}
+
+func (p *ConcreteExecution) IsSetStartTimestamp() bool {
+  return p.StartTimestamp != nil
+}
+
+func (p *ConcreteExecution) IsSetLastUpdatedTimestamp() bool {
+  return p.LastUpdatedTimestamp != nil
+}
+
+func (p *ConcreteExecution) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
-  if mTypeId == thrift.EXCEPTION {
-    error16 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-    var error17 error
-    error17, err = error16.Read(iprot)
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
     if err != nil {
-      return
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
     }
-    if err = iprot.ReadMessageEnd(); err != nil {
-      return
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
+    case 50:
+      if err := p.ReadField50(iprot); err != nil {
+        return err
+      }
+    case 60:
+      if err := p.ReadField60(iprot); err != nil {
+        return err
+      }
+    case 70:
+      if err := p.ReadField70(iprot); err != nil {
+        return err
+      }
+    case 80:
+      if err := p.ReadField80(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
     }
-    err = error17
-    return
-  }
-  if mTypeId != thrift.REPLY {
-    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RespondActivityTaskCanceled failed: invalid message type")
-    return
-  }
-  result := HistoryServiceRespondActivityTaskCanceledResult{}
-  if err = result.Read(iprot); err != nil {
-    return
   }
-  if err = iprot.ReadMessageEnd(); err != nil {
-    return
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
   }
-  if result.BadRequestError != nil {
-    err = result.BadRequestError
-    return 
-  } else   if result.InternalServiceError != nil {
-    err = result.InternalServiceError
-    return 
-  } else   if result.EntityNotExistError != nil {
-    err = result.EntityNotExistError
-    return 
-  } else   if result.ShardOwnershipLostError != nil {
-    err = result.ShardOwnershipLostError
-    return 
+  return nil
+}
+
+func (p *ConcreteExecution)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.DomainUUID = &v
+}
+  return nil
+}
+
+func (p *ConcreteExecution)  ReadField20(iprot thrift.TProtocol) error {
+  p.Execution = &shared.WorkflowExecution{}
+  if err := p.Execution.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Execution), err)
   }
-  return
+  return nil
 }
 
-// SignalWorkflowExecution is used to send a signal event to running workflow execution.  This results in
-// WorkflowExecutionSignaled event recorded in the history and a decision task being created for the execution.
-// 
-// 
-// Parameters:
-//  - SignalRequest
-func (p *HistoryServiceClient) SignalWorkflowExecution(signalRequest *SignalWorkflowExecutionRequest) (err error) {
-  if err = p.sendSignalWorkflowExecution(signalRequest); err != nil { return }
-  return p.recvSignalWorkflowExecution()
+func (p *ConcreteExecution)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
+} else {
+  p.WorkflowTypeName = &v
+}
+  return nil
 }
 
-func (p *HistoryServiceClient) sendSignalWorkflowExecution(signalRequest *SignalWorkflowExecutionRequest)(err error) {
-  oprot := p.OutputProtocol
-  if oprot == nil {
-    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
-    p.OutputProtocol = oprot
+func (p *ConcreteExecution)  ReadField40(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 40: ", err)
+} else {
+  p.State = &v
+}
+  return nil
+}
+
+func (p *ConcreteExecution)  ReadField50(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 50: ", err)
+} else {
+  p.CloseStatus = &v
+}
+  return nil
+}
+
+func (p *ConcreteExecution)  ReadField60(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 60: ", err)
+} else {
+  p.NextEventId = &v
+}
+  return nil
+}
+
+func (p *ConcreteExecution)  ReadField70(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 70: ", err)
+} else {
+  p.StartTimestamp = &v
+}
+  return nil
+}
+
+func (p *ConcreteExecution)  ReadField80(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 80: ", err)
+} else {
+  p.LastUpdatedTimestamp = &v
+}
+  return nil
+}
+
+func (p *ConcreteExecution) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ConcreteExecution"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
+    if err := p.writeField40(oprot); err != nil { return err }
+    if err := p.writeField50(oprot); err != nil { return err }
+    if err := p.writeField60(oprot); err != nil { return err }
+    if err := p.writeField70(oprot); err != nil { return err }
+    if err := p.writeField80(oprot); err != nil { return err }
   }
-  p.SeqId++
-  if err = oprot.WriteMessageBegin("SignalWorkflowExecution", thrift.CALL, p.SeqId); err != nil {
-      return
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ConcreteExecution) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomainUUID() {
+    if err := oprot.WriteFieldBegin("domainUUID", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domainUUID: ", p), err) }
+    if err := oprot.WriteString(string(*p.DomainUUID)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domainUUID (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domainUUID: ", p), err) }
   }
-  args := HistoryServiceSignalWorkflowExecutionArgs{
-  SignalRequest : signalRequest,
+  return err
+}
+
+func (p *ConcreteExecution) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetExecution() {
+    if err := oprot.WriteFieldBegin("execution", thrift.STRUCT, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:execution: ", p), err) }
+    if err := p.Execution.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Execution), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:execution: ", p), err) }
   }
-  if err = args.Write(oprot); err != nil {
-      return
+  return err
+}
+
+func (p *ConcreteExecution) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowTypeName() {
+    if err := oprot.WriteFieldBegin("workflowTypeName", thrift.STRING, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:workflowTypeName: ", p), err) }
+    if err := oprot.WriteString(string(*p.WorkflowTypeName)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.workflowTypeName (30) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:workflowTypeName: ", p), err) }
   }
-  if err = oprot.WriteMessageEnd(); err != nil {
-      return
+  return err
+}
+
+func (p *ConcreteExecution) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetState() {
+    if err := oprot.WriteFieldBegin("state", thrift.I32, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:state: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.State)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.state (40) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:state: ", p), err) }
   }
-  return oprot.Flush()
+  return err
 }
 
+func (p *ConcreteExecution) writeField50(oprot thrift.TProtocol) (err error) {
+  if p.IsSetCloseStatus() {
+    if err := oprot.WriteFieldBegin("closeStatus", thrift.I32, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:closeStatus: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.CloseStatus)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.closeStatus (50) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:closeStatus: ", p), err) }
+  }
+  return err
+}
 
-func (p *HistoryServiceClient) recvSignalWorkflowExecution() (err error) {
-  iprot := p.InputProtocol
-  if iprot == nil {
-    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
-    p.InputProtocol = iprot
+func (p *ConcreteExecution) writeField60(oprot thrift.TProtocol) (err error) {
+  if p.IsSetNextEventId() {
+    if err := oprot.WriteFieldBegin("nextEventId", thrift.I64, 60); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:nextEventId: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.NextEventId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.nextEventId (60) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:nextEventId: ", p), err) }
   }
-  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
-  if err != nil {
-    return
+  return err
+}
+
+func (p *ConcreteExecution) writeField70(oprot thrift.TProtocol) (err error) {
+  if p.IsSetStartTimestamp() {
+    if err := oprot.WriteFieldBegin("startTimestamp", thrift.I64, 70); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 70:startTimestamp: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.StartTimestamp)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.startTimestamp (70) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 70:startTimestamp: ", p), err) }
   }
-  if method != "SignalWorkflowExecution" {
-    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "SignalWorkflowExecution failed: wrong method name")
-    return
+  return err
+}
+
+func (p *ConcreteExecution) writeField80(oprot thrift.TProtocol) (err error) {
+  if p.IsSetLastUpdatedTimestamp() {
+    if err := oprot.WriteFieldBegin("lastUpdatedTimestamp", thrift.I64, 80); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 80:lastUpdatedTimestamp: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.LastUpdatedTimestamp)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.lastUpdatedTimestamp (80) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 80:lastUpdatedTimestamp: ", p), err) }
   }
-  if p.SeqId != seqId {
-    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "SignalWorkflowExecution failed: out of sequence response")
-    return
+  return err
+}
+
+func (p *ConcreteExecution) String() string {
+  if p == nil {
+    return "<nil>"
   }
-  if mTypeId == thrift.EXCEPTION {
-    error18 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-    var error19 error
-    error19, err = error18.Read(iprot)
-    if err != nil {
-      return
-    }
-    if err = iprot.ReadMessageEnd(); err != nil {
-      return
-    }
-    err = error19
-    return
-  }
-  if mTypeId != thrift.REPLY {
-    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "SignalWorkflowExecution failed: invalid message type")
-    return
-  }
-  result := HistoryServiceSignalWorkflowExecutionResult{}
-  if err = result.Read(iprot); err != nil {
-    return
-  }
-  if err = iprot.ReadMessageEnd(); err != nil {
-    return
-  }
-  if result.BadRequestError != nil {
-    err = result.BadRequestError
-    return 
-  } else   if result.InternalServiceError != nil {
-    err = result.InternalServiceError
-    return 
-  } else   if result.EntityNotExistError != nil {
-    err = result.EntityNotExistError
-    return 
-  } else   if result.ShardOwnershipLostError != nil {
-    err = result.ShardOwnershipLostError
-    return 
-  }
-  return
+  return fmt.Sprintf("ConcreteExecution(%+v)", *p)
 }
 
-// TerminateWorkflowExecution terminates an existing workflow execution by recording WorkflowExecutionTerminated event
-// in the history and immediately terminating the execution instance.
-// 
-// 
-// Parameters:
-//  - TerminateRequest
-func (p *HistoryServiceClient) TerminateWorkflowExecution(terminateRequest *TerminateWorkflowExecutionRequest) (err error) {
-  if err = p.sendTerminateWorkflowExecution(terminateRequest); err != nil { return }
-  return p.recvTerminateWorkflowExecution()
+// Attributes:
+//  - Executions
+//  - NextPageToken
+type ListConcreteExecutionsResponse struct {
+  // unused fields # 1 to 9
+  Executions []*ConcreteExecution `thrift:"executions,10" db:"executions" json:"executions,omitempty"`
+  // unused fields # 11 to 19
+  NextPageToken []byte `thrift:"nextPageToken,20" db:"nextPageToken" json:"nextPageToken,omitempty"`
 }
 
-func (p *HistoryServiceClient) sendTerminateWorkflowExecution(terminateRequest *TerminateWorkflowExecutionRequest)(err error) {
-  oprot := p.OutputProtocol
-  if oprot == nil {
-    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
-    p.OutputProtocol = oprot
-  }
-  p.SeqId++
-  if err = oprot.WriteMessageBegin("TerminateWorkflowExecution", thrift.CALL, p.SeqId); err != nil {
-      return
-  }
-  args := HistoryServiceTerminateWorkflowExecutionArgs{
-  TerminateRequest : terminateRequest,
-  }
-  if err = args.Write(oprot); err != nil {
-      return
-  }
-  if err = oprot.WriteMessageEnd(); err != nil {
-      return
-  }
-  return oprot.Flush()
+func NewListConcreteExecutionsResponse() *ListConcreteExecutionsResponse {
+  return &ListConcreteExecutionsResponse{}
 }
 
+var ListConcreteExecutionsResponse_Executions_DEFAULT []*ConcreteExecution
 
-func (p *HistoryServiceClient) recvTerminateWorkflowExecution() (err error) {
-  iprot := p.InputProtocol
-  if iprot == nil {
-    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
-    p.InputProtocol = iprot
-  }
-  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
-  if err != nil {
-    return
-  }
-  if method != "TerminateWorkflowExecution" {
-    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "TerminateWorkflowExecution failed: wrong method name")
-    return
-  }
-  if p.SeqId != seqId {
-    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "TerminateWorkflowExecution failed: out of sequence response")
-    return
+func (p *ListConcreteExecutionsResponse) GetExecutions() []*ConcreteExecution {
+  return p.Executions
+}
+var ListConcreteExecutionsResponse_NextPageToken_DEFAULT []byte
+
+func (p *ListConcreteExecutionsResponse) GetNextPageToken() []byte {
+  return p.NextPageToken
+}
+func (p *ListConcreteExecutionsResponse) IsSetExecutions() bool {
+  return p.Executions != nil
+}
+
+func (p *ListConcreteExecutionsResponse) IsSetNextPageToken() bool {
+  return p.NextPageToken != nil
+}
+
+func (p *ListConcreteExecutionsResponse) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
-  if mTypeId == thrift.EXCEPTION {
-    error20 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-    var error21 error
-    error21, err = error20.Read(iprot)
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
     if err != nil {
-      return
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
     }
-    if err = iprot.ReadMessageEnd(); err != nil {
-      return
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
     }
-    err = error21
-    return
   }
-  if mTypeId != thrift.REPLY {
-    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "TerminateWorkflowExecution failed: invalid message type")
-    return
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
   }
-  result := HistoryServiceTerminateWorkflowExecutionResult{}
-  if err = result.Read(iprot); err != nil {
-    return
+  return nil
+}
+
+func (p *ListConcreteExecutionsResponse)  ReadField10(iprot thrift.TProtocol) error {
+  _, size, err := iprot.ReadListBegin()
+  if err != nil {
+    return thrift.PrependError("error reading list begin: ", err)
   }
-  if err = iprot.ReadMessageEnd(); err != nil {
-    return
+  tSlice := make([]*ConcreteExecution, 0, size)
+  p.Executions =  tSlice
+  for i := 0; i < size; i ++ {
+    _elem := &ConcreteExecution{}
+    if err := _elem.Read(iprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem), err)
+    }
+    p.Executions = append(p.Executions, _elem)
   }
-  if result.BadRequestError != nil {
-    err = result.BadRequestError
-    return 
-  } else   if result.InternalServiceError != nil {
-    err = result.InternalServiceError
-    return 
-  } else   if result.EntityNotExistError != nil {
-    err = result.EntityNotExistError
-    return 
-  } else   if result.ShardOwnershipLostError != nil {
-    err = result.ShardOwnershipLostError
-    return 
+  if err := iprot.ReadListEnd(); err != nil {
+    return thrift.PrependError("error reading list end: ", err)
   }
-  return
+  return nil
 }
 
-// RequestCancelWorkflowExecution is called by application worker when it wants to request cancellation of a workflow instance.
-// It will result in a new 'WorkflowExecutionCancelRequested' event being written to the workflow history and a new DecisionTask
-// created for the workflow instance so new decisions could be made. It fails with 'EntityNotExistsError' if the workflow is not valid
-// anymore due to completion or doesn't exist.
-// 
-// 
-// Parameters:
-//  - CancelRequest
-func (p *HistoryServiceClient) RequestCancelWorkflowExecution(cancelRequest *RequestCancelWorkflowExecutionRequest) (err error) {
-  if err = p.sendRequestCancelWorkflowExecution(cancelRequest); err != nil { return }
-  return p.recvRequestCancelWorkflowExecution()
+func (p *ListConcreteExecutionsResponse)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 20: ", err)
+} else {
+  p.NextPageToken = v
+}
+  return nil
 }
 
-func (p *HistoryServiceClient) sendRequestCancelWorkflowExecution(cancelRequest *RequestCancelWorkflowExecutionRequest)(err error) {
-  oprot := p.OutputProtocol
-  if oprot == nil {
-    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
-    p.OutputProtocol = oprot
-  }
-  p.SeqId++
-  if err = oprot.WriteMessageBegin("RequestCancelWorkflowExecution", thrift.CALL, p.SeqId); err != nil {
-      return
-  }
-  args := HistoryServiceRequestCancelWorkflowExecutionArgs{
-  CancelRequest : cancelRequest,
-  }
-  if err = args.Write(oprot); err != nil {
-      return
-  }
-  if err = oprot.WriteMessageEnd(); err != nil {
-      return
+func (p *ListConcreteExecutionsResponse) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ListConcreteExecutionsResponse"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
   }
-  return oprot.Flush()
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
 }
 
-
-func (p *HistoryServiceClient) recvRequestCancelWorkflowExecution() (err error) {
-  iprot := p.InputProtocol
-  if iprot == nil {
-    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
-    p.InputProtocol = iprot
-  }
-  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
-  if err != nil {
-    return
-  }
-  if method != "RequestCancelWorkflowExecution" {
-    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RequestCancelWorkflowExecution failed: wrong method name")
-    return
-  }
-  if p.SeqId != seqId {
-    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RequestCancelWorkflowExecution failed: out of sequence response")
-    return
-  }
-  if mTypeId == thrift.EXCEPTION {
-    error22 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-    var error23 error
-    error23, err = error22.Read(iprot)
-    if err != nil {
-      return
+func (p *ListConcreteExecutionsResponse) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetExecutions() {
+    if err := oprot.WriteFieldBegin("executions", thrift.LIST, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:executions: ", p), err) }
+    if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Executions)); err != nil {
+      return thrift.PrependError("error writing list begin: ", err)
     }
-    if err = iprot.ReadMessageEnd(); err != nil {
-      return
+    for _, v := range p.Executions {
+      if err := v.Write(oprot); err != nil {
+        return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+      }
     }
-    err = error23
-    return
-  }
-  if mTypeId != thrift.REPLY {
-    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RequestCancelWorkflowExecution failed: invalid message type")
-    return
-  }
-  result := HistoryServiceRequestCancelWorkflowExecutionResult{}
-  if err = result.Read(iprot); err != nil {
-    return
-  }
-  if err = iprot.ReadMessageEnd(); err != nil {
-    return
-  }
-  if result.BadRequestError != nil {
-    err = result.BadRequestError
-    return 
-  } else   if result.InternalServiceError != nil {
-    err = result.InternalServiceError
-    return 
-  } else   if result.EntityNotExistError != nil {
-    err = result.EntityNotExistError
-    return 
-  } else   if result.ShardOwnershipLostError != nil {
-    err = result.ShardOwnershipLostError
-    return 
+    if err := oprot.WriteListEnd(); err != nil {
+      return thrift.PrependError("error writing list end: ", err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:executions: ", p), err) }
   }
-  return
+  return err
 }
 
-// ScheduleDecisionTask is used for creating a decision task for already started workflow execution.  This is mainly
-// used by transfer queue processor during the processing of StartChildWorkflowExecution task, where it first starts
-// child execution without creating the decision task and then calls this API after updating the mutable state of
-// parent execution.
+func (p *ListConcreteExecutionsResponse) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetNextPageToken() {
+    if err := oprot.WriteFieldBegin("nextPageToken", thrift.STRING, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:nextPageToken: ", p), err) }
+    if err := oprot.WriteBinary(p.NextPageToken); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.nextPageToken (20) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:nextPageToken: ", p), err) }
+  }
+  return err
+}
+
+func (p *ListConcreteExecutionsResponse) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ListConcreteExecutionsResponse(%+v)", *p)
+}
+
+type HistoryService interface {  //HistoryService provides API to start a new long running workflow instance, as well as query and update the history
+  //of workflow instances already created.
+  //
+
+  // StartWorkflowExecution starts a new long running workflow instance.  It will create the instance with
+  // 'WorkflowExecutionStarted' event in history and also schedule the first DecisionTask for the worker to make the
+  // first decision for this instance.  It will return 'WorkflowExecutionAlreadyStartedError', if an instance already
+  // exists with same workflowId.
+  // 
+  // 
+  // Parameters:
+  //  - StartRequest
+  StartWorkflowExecution(startRequest *StartWorkflowExecutionRequest) (r *shared.StartWorkflowExecutionResponse, err error)
+  // Returns the nextEventID of the history of workflow execution. Only events in the history with Ids below the returned Id are
+  // guaranteed to be valid, so the first step of reading an execution's history is to retrieve this event Id.
+  // It fails with 'EntityNotExistError' if specified workflow execution in unknown to the service.
+  // 
+  // 
+  // Parameters:
+  //  - GetRequest
+  GetWorkflowExecutionNextEventID(getRequest *GetWorkflowExecutionNextEventIDRequest) (r *GetWorkflowExecutionNextEventIDResponse, err error)
+  // RecordDecisionTaskStarted is called by the Matchingservice before it hands a decision task to the application worker in response to
+  // a PollForDecisionTask call. It records in the history the event that the decision task has started. It will return 'EventAlreadyStartedError',
+  // if the workflow's execution history already includes a record of the event starting.
+  // 
+  // 
+  // Parameters:
+  //  - AddRequest
+  RecordDecisionTaskStarted(addRequest *RecordDecisionTaskStartedRequest) (r *RecordDecisionTaskStartedResponse, err error)
+  // RecordActivityTaskStarted is called by the Matchingservice before it hands a decision task to the application worker in response to
+  // a PollForActivityTask call. It records in the history the event that the decision task has started. It will return 'EventAlreadyStartedError',
+  // if the workflow's execution history already includes a record of the event starting.
+  // 
+  // 
+  // Parameters:
+  //  - AddRequest
+  RecordActivityTaskStarted(addRequest *RecordActivityTaskStartedRequest) (r *RecordActivityTaskStartedResponse, err error)
+  // RespondDecisionTaskCompleted is called by application worker to complete a DecisionTask handed as a result of
+  // 'PollForDecisionTask' API call.  Completing a DecisionTask will result in new events for the workflow execution and
+  // potentially new ActivityTask being created for corresponding decisions.  It will also create a DecisionTaskCompleted
+  // event in the history for that session.  Use the 'taskToken' provided as response of PollForDecisionTask API call
+  // for completing the DecisionTask.
+  // 
+  // 
+  // Parameters:
+  //  - CompleteRequest
+  RespondDecisionTaskCompleted(completeRequest *RespondDecisionTaskCompletedRequest) (r *shared.RespondDecisionTaskCompletedResponse, err error)
+  // RecordActivityTaskHeartbeat is called by application worker while it is processing an ActivityTask.  If worker fails
+  // to heartbeat within 'heartbeatTimeoutSeconds' interval for the ActivityTask, then it will be marked as timedout and
+  // 'ActivityTaskTimedOut' event will be written to the workflow history.  Calling 'RecordActivityTaskHeartbeat' will
+  // fail with 'EntityNotExistsError' in such situations.  Use the 'taskToken' provided as response of
+  // PollForActivityTask API call for heartbeating.
+  // 
+  // 
+  // Parameters:
+  //  - HeartbeatRequest
+  RecordActivityTaskHeartbeat(heartbeatRequest *RecordActivityTaskHeartbeatRequest) (r *shared.RecordActivityTaskHeartbeatResponse, err error)
+  // RespondActivityTaskCompleted is called by application worker when it is done processing an ActivityTask.  It will
+  // result in a new 'ActivityTaskCompleted' event being written to the workflow history and a new DecisionTask
+  // created for the workflow so new decisions could be made.  Use the 'taskToken' provided as response of
+  // PollForActivityTask API call for completion. It fails with 'EntityNotExistsError' if the taskToken is not valid
+  // anymore due to activity timeout.
+  // 
+  // 
+  // Parameters:
+  //  - CompleteRequest
+  RespondActivityTaskCompleted(completeRequest *RespondActivityTaskCompletedRequest) (err error)
+  // RespondActivityTaskFailed is called by application worker when it is done processing an ActivityTask.  It will
+  // result in a new 'ActivityTaskFailed' event being written to the workflow history and a new DecisionTask
+  // created for the workflow instance so new decisions could be made.  Use the 'taskToken' provided as response of
+  // PollForActivityTask API call for completion. It fails with 'EntityNotExistsError' if the taskToken is not valid
+  // anymore due to activity timeout.
+  // 
+  // 
+  // Parameters:
+  //  - FailRequest
+  RespondActivityTaskFailed(failRequest *RespondActivityTaskFailedRequest) (err error)
+  // RespondActivityTaskCanceled is called by application worker when it is successfully canceled an ActivityTask.  It will
+  // result in a new 'ActivityTaskCanceled' event being written to the workflow history and a new DecisionTask
+  // created for the workflow instance so new decisions could be made.  Use the 'taskToken' provided as response of
+  // PollForActivityTask API call for completion. It fails with 'EntityNotExistsError' if the taskToken is not valid
+  // anymore due to activity timeout.
+  // 
+  // 
+  // Parameters:
+  //  - CanceledRequest
+  RespondActivityTaskCanceled(canceledRequest *RespondActivityTaskCanceledRequest) (err error)
+  // SignalWorkflowExecution is used to send a signal event to running workflow execution.  This results in
+  // WorkflowExecutionSignaled event recorded in the history and a decision task being created for the execution.
+  // 
+  // 
+  // Parameters:
+  //  - SignalRequest
+  SignalWorkflowExecution(signalRequest *SignalWorkflowExecutionRequest) (err error)
+  // TerminateWorkflowExecution terminates an existing workflow execution by recording WorkflowExecutionTerminated event
+  // in the history and immediately terminating the execution instance.
+  // 
+  // 
+  // Parameters:
+  //  - TerminateRequest
+  TerminateWorkflowExecution(terminateRequest *TerminateWorkflowExecutionRequest) (err error)
+  // RequestCancelWorkflowExecution is called by application worker when it wants to request cancellation of a workflow instance.
+  // It will result in a new 'WorkflowExecutionCancelRequested' event being written to the workflow history and a new DecisionTask
+  // created for the workflow instance so new decisions could be made. It fails with 'EntityNotExistsError' if the workflow is not valid
+  // anymore due to completion or doesn't exist.
+  // 
+  // 
+  // Parameters:
+  //  - CancelRequest
+  RequestCancelWorkflowExecution(cancelRequest *RequestCancelWorkflowExecutionRequest) (err error)
+  // ScheduleDecisionTask is used for creating a decision task for already started workflow execution.  This is mainly
+  // used by transfer queue processor during the processing of StartChildWorkflowExecution task, where it first starts
+  // child execution without creating the decision task and then calls this API after updating the mutable state of
+  // parent execution.
+  // 
+  // 
+  // Parameters:
+  //  - ScheduleRequest
+  ScheduleDecisionTask(scheduleRequest *ScheduleDecisionTaskRequest) (err error)
+  // RecordChildExecutionCompleted is used for reporting the completion of child workflow execution to parent.
+  // This is mainly called by transfer queue processor during the processing of DeleteExecution task.
+  // 
+  // 
+  // Parameters:
+  //  - CompletionRequest
+  RecordChildExecutionCompleted(completionRequest *RecordChildExecutionCompletedRequest) (err error)
+  // DescribeShard returns the task ID watermarks and ack levels of a shard owned by this host.  It is used by
+  // consumers of the transfer and timer queues to verify that no task ID range has been skipped.
+  // 
+  // Parameters:
+  //  - Request
+  DescribeShard(request *DescribeShardRequest) (r *DescribeShardResponse, err error)
+  // InvalidateMutableState drops the cached mutable state of a workflow execution owned by this host, or unloads a
+  // whole shard when no execution is given, so that the state is reloaded from persistence on next use.
+  // 
+  // Parameters:
+  //  - Request
+  InvalidateMutableState(request *InvalidateMutableStateRequest) (err error)
+  // SetTaskProcessingPaused pauses or resumes the processing of the transfer and/or timer tasks of a shard, or of a
+  // domain on every shard owned by this host.  Paused tasks stay in the queues until processing is resumed.
+  // 
+  // Parameters:
+  //  - Request
+  SetTaskProcessingPaused(request *SetTaskProcessingPausedRequest) (err error)
+  // ListConcreteExecutions returns a page of the workflow executions stored by a shard owned by this host, including
+  // closed executions which have not been deleted yet.  It is used by scanners which check the integrity of the
+  // executions of a shard.
+  // 
+  // Parameters:
+  //  - Request
+  ListConcreteExecutions(request *ListConcreteExecutionsRequest) (r *ListConcreteExecutionsResponse, err error)
+  // SignalWithStartWorkflowExecution is used to ensure sending a signal to a workflow.  If the workflow is running, this
+  // results in WorkflowExecutionSignaled event recorded in the history and a decision task being created for the
+  // execution.  If the workflow is not running or not found, this results in WorkflowExecutionStarted and
+  // WorkflowExecutionSignaled events recorded in the history of a new run, and a decision task being created for it.
+  // 
+  // Parameters:
+  //  - SignalWithStartRequest
+  SignalWithStartWorkflowExecution(signalWithStartRequest *SignalWithStartWorkflowExecutionRequest) (r *shared.StartWorkflowExecutionResponse, err error)
+  // ResetWorkflowExecution resets a workflow execution to the end of a decision task of one of its runs.  A new run is
+  // created with the history of the run up to the started event of the decision task, which is then recorded as failed
+  // and followed by a new decision task.  The signals received by the run after that point are recorded again in the new
+  // run.  The run is terminated if it is still running.
+  // 
+  // Parameters:
+  //  - ResetRequest
+  ResetWorkflowExecution(resetRequest *ResetWorkflowExecutionRequest) (r *shared.ResetWorkflowExecutionResponse, err error)
+}
+
+//HistoryService provides API to start a new long running workflow instance, as well as query and update the history
+//of workflow instances already created.
+//
+type HistoryServiceClient struct {
+  Transport thrift.TTransport
+  ProtocolFactory thrift.TProtocolFactory
+  InputProtocol thrift.TProtocol
+  OutputProtocol thrift.TProtocol
+  SeqId int32
+}
+
+func NewHistoryServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *HistoryServiceClient {
+  return &HistoryServiceClient{Transport: t,
+    ProtocolFactory: f,
+    InputProtocol: f.GetProtocol(t),
+    OutputProtocol: f.GetProtocol(t),
+    SeqId: 0,
+  }
+}
+
+func NewHistoryServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *HistoryServiceClient {
+  return &HistoryServiceClient{Transport: t,
+    ProtocolFactory: nil,
+    InputProtocol: iprot,
+    OutputProtocol: oprot,
+    SeqId: 0,
+  }
+}
+
+// StartWorkflowExecution starts a new long running workflow instance.  It will create the instance with
+// 'WorkflowExecutionStarted' event in history and also schedule the first DecisionTask for the worker to make the
+// first decision for this instance.  It will return 'WorkflowExecutionAlreadyStartedError', if an instance already
+// exists with same workflowId.
 // 
 // 
 // Parameters:
-//  - ScheduleRequest
-func (p *HistoryServiceClient) ScheduleDecisionTask(scheduleRequest *ScheduleDecisionTaskRequest) (err error) {
-  if err = p.sendScheduleDecisionTask(scheduleRequest); err != nil { return }
-  return p.recvScheduleDecisionTask()
+//  - StartRequest
+func (p *HistoryServiceClient) StartWorkflowExecution(startRequest *StartWorkflowExecutionRequest) (r *shared.StartWorkflowExecutionResponse, err error) {
+  if err = p.sendStartWorkflowExecution(startRequest); err != nil { return }
+  return p.recvStartWorkflowExecution()
 }
 
-func (p *HistoryServiceClient) sendScheduleDecisionTask(scheduleRequest *ScheduleDecisionTaskRequest)(err error) {
+func (p *HistoryServiceClient) sendStartWorkflowExecution(startRequest *StartWorkflowExecutionRequest)(err error) {
   oprot := p.OutputProtocol
   if oprot == nil {
     oprot = p.ProtocolFactory.GetProtocol(p.Transport)
     p.OutputProtocol = oprot
   }
   p.SeqId++
-  if err = oprot.WriteMessageBegin("ScheduleDecisionTask", thrift.CALL, p.SeqId); err != nil {
+  if err = oprot.WriteMessageBegin("StartWorkflowExecution", thrift.CALL, p.SeqId); err != nil {
       return
   }
-  args := HistoryServiceScheduleDecisionTaskArgs{
-  ScheduleRequest : scheduleRequest,
+  args := HistoryServiceStartWorkflowExecutionArgs{
+  StartRequest : startRequest,
   }
   if err = args.Write(oprot); err != nil {
       return
@@ -4677,7 +5530,7 @@ func (p *HistoryServiceClient) sendScheduleDecisionTask(scheduleRequest *Schedul
 }
 
 
-func (p *HistoryServiceClient) recvScheduleDecisionTask() (err error) {
+func (p *HistoryServiceClient) recvStartWorkflowExecution() (value *shared.StartWorkflowExecutionResponse, err error) {
   iprot := p.InputProtocol
   if iprot == nil {
     iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -4687,32 +5540,32 @@ func (p *HistoryServiceClient) recvScheduleDecisionTask() (err error) {
   if err != nil {
     return
   }
-  if method != "ScheduleDecisionTask" {
-    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "ScheduleDecisionTask failed: wrong method name")
+  if method != "StartWorkflowExecution" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "StartWorkflowExecution failed: wrong method name")
     return
   }
   if p.SeqId != seqId {
-    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ScheduleDecisionTask failed: out of sequence response")
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "StartWorkflowExecution failed: out of sequence response")
     return
   }
   if mTypeId == thrift.EXCEPTION {
-    error24 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-    var error25 error
-    error25, err = error24.Read(iprot)
+    error0 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error1 error
+    error1, err = error0.Read(iprot)
     if err != nil {
       return
     }
     if err = iprot.ReadMessageEnd(); err != nil {
       return
     }
-    err = error25
+    err = error1
     return
   }
   if mTypeId != thrift.REPLY {
-    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "ScheduleDecisionTask failed: invalid message type")
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "StartWorkflowExecution failed: invalid message type")
     return
   }
-  result := HistoryServiceScheduleDecisionTaskResult{}
+  result := HistoryServiceStartWorkflowExecutionResult{}
   if err = result.Read(iprot); err != nil {
     return
   }
@@ -4725,39 +5578,41 @@ func (p *HistoryServiceClient) recvScheduleDecisionTask() (err error) {
   } else   if result.InternalServiceError != nil {
     err = result.InternalServiceError
     return 
-  } else   if result.EntityNotExistError != nil {
-    err = result.EntityNotExistError
+  } else   if result.SessionAlreadyExistError != nil {
+    err = result.SessionAlreadyExistError
     return 
   } else   if result.ShardOwnershipLostError != nil {
     err = result.ShardOwnershipLostError
     return 
   }
+  value = result.GetSuccess()
   return
 }
 
-// RecordChildExecutionCompleted is used for reporting the completion of child workflow execution to parent.
-// This is mainly called by transfer queue processor during the processing of DeleteExecution task.
+// Returns the nextEventID of the history of workflow execution. Only events in the history with Ids below the returned Id are
+// guaranteed to be valid, so the first step of reading an execution's history is to retrieve this event Id.
+// It fails with 'EntityNotExistError' if specified workflow execution in unknown to the service.
 // 
 // 
 // Parameters:
-//  - CompletionRequest
-func (p *HistoryServiceClient) RecordChildExecutionCompleted(completionRequest *RecordChildExecutionCompletedRequest) (err error) {
-  if err = p.sendRecordChildExecutionCompleted(completionRequest); err != nil { return }
-  return p.recvRecordChildExecutionCompleted()
+//  - GetRequest
+func (p *HistoryServiceClient) GetWorkflowExecutionNextEventID(getRequest *GetWorkflowExecutionNextEventIDRequest) (r *GetWorkflowExecutionNextEventIDResponse, err error) {
+  if err = p.sendGetWorkflowExecutionNextEventID(getRequest); err != nil { return }
+  return p.recvGetWorkflowExecutionNextEventID()
 }
 
-func (p *HistoryServiceClient) sendRecordChildExecutionCompleted(completionRequest *RecordChildExecutionCompletedRequest)(err error) {
+func (p *HistoryServiceClient) sendGetWorkflowExecutionNextEventID(getRequest *GetWorkflowExecutionNextEventIDRequest)(err error) {
   oprot := p.OutputProtocol
   if oprot == nil {
     oprot = p.ProtocolFactory.GetProtocol(p.Transport)
     p.OutputProtocol = oprot
   }
   p.SeqId++
-  if err = oprot.WriteMessageBegin("RecordChildExecutionCompleted", thrift.CALL, p.SeqId); err != nil {
+  if err = oprot.WriteMessageBegin("GetWorkflowExecutionNextEventID", thrift.CALL, p.SeqId); err != nil {
       return
   }
-  args := HistoryServiceRecordChildExecutionCompletedArgs{
-  CompletionRequest : completionRequest,
+  args := HistoryServiceGetWorkflowExecutionNextEventIDArgs{
+  GetRequest : getRequest,
   }
   if err = args.Write(oprot); err != nil {
       return
@@ -4769,7 +5624,7 @@ func (p *HistoryServiceClient) sendRecordChildExecutionCompleted(completionReque
 }
 
 
-func (p *HistoryServiceClient) recvRecordChildExecutionCompleted() (err error) {
+func (p *HistoryServiceClient) recvGetWorkflowExecutionNextEventID() (value *GetWorkflowExecutionNextEventIDResponse, err error) {
   iprot := p.InputProtocol
   if iprot == nil {
     iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -4779,32 +5634,32 @@ func (p *HistoryServiceClient) recvRecordChildExecutionCompleted() (err error) {
   if err != nil {
     return
   }
-  if method != "RecordChildExecutionCompleted" {
-    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RecordChildExecutionCompleted failed: wrong method name")
+  if method != "GetWorkflowExecutionNextEventID" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "GetWorkflowExecutionNextEventID failed: wrong method name")
     return
   }
   if p.SeqId != seqId {
-    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RecordChildExecutionCompleted failed: out of sequence response")
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "GetWorkflowExecutionNextEventID failed: out of sequence response")
     return
   }
   if mTypeId == thrift.EXCEPTION {
-    error26 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-    var error27 error
-    error27, err = error26.Read(iprot)
+    error2 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error3 error
+    error3, err = error2.Read(iprot)
     if err != nil {
       return
     }
     if err = iprot.ReadMessageEnd(); err != nil {
       return
     }
-    err = error27
+    err = error3
     return
   }
   if mTypeId != thrift.REPLY {
-    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RecordChildExecutionCompleted failed: invalid message type")
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "GetWorkflowExecutionNextEventID failed: invalid message type")
     return
   }
-  result := HistoryServiceRecordChildExecutionCompletedResult{}
+  result := HistoryServiceGetWorkflowExecutionNextEventIDResult{}
   if err = result.Read(iprot); err != nil {
     return
   }
@@ -4824,719 +5679,2419 @@ func (p *HistoryServiceClient) recvRecordChildExecutionCompleted() (err error) {
     err = result.ShardOwnershipLostError
     return 
   }
+  value = result.GetSuccess()
   return
 }
 
-
-type HistoryServiceProcessor struct {
-  processorMap map[string]thrift.TProcessorFunction
-  handler HistoryService
-}
-
-func (p *HistoryServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-  p.processorMap[key] = processor
-}
-
-func (p *HistoryServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-  processor, ok = p.processorMap[key]
-  return processor, ok
-}
-
-func (p *HistoryServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-  return p.processorMap
-}
-
-func NewHistoryServiceProcessor(handler HistoryService) *HistoryServiceProcessor {
-
-  self28 := &HistoryServiceProcessor{handler:handler, processorMap:make(map[string]thrift.TProcessorFunction)}
-  self28.processorMap["StartWorkflowExecution"] = &historyServiceProcessorStartWorkflowExecution{handler:handler}
-  self28.processorMap["GetWorkflowExecutionNextEventID"] = &historyServiceProcessorGetWorkflowExecutionNextEventID{handler:handler}
-  self28.processorMap["RecordDecisionTaskStarted"] = &historyServiceProcessorRecordDecisionTaskStarted{handler:handler}
-  self28.processorMap["RecordActivityTaskStarted"] = &historyServiceProcessorRecordActivityTaskStarted{handler:handler}
-  self28.processorMap["RespondDecisionTaskCompleted"] = &historyServiceProcessorRespondDecisionTaskCompleted{handler:handler}
-  self28.processorMap["RecordActivityTaskHeartbeat"] = &historyServiceProcessorRecordActivityTaskHeartbeat{handler:handler}
-  self28.processorMap["RespondActivityTaskCompleted"] = &historyServiceProcessorRespondActivityTaskCompleted{handler:handler}
-  self28.processorMap["RespondActivityTaskFailed"] = &historyServiceProcessorRespondActivityTaskFailed{handler:handler}
-  self28.processorMap["RespondActivityTaskCanceled"] = &historyServiceProcessorRespondActivityTaskCanceled{handler:handler}
-  self28.processorMap["SignalWorkflowExecution"] = &historyServiceProcessorSignalWorkflowExecution{handler:handler}
-  self28.processorMap["TerminateWorkflowExecution"] = &historyServiceProcessorTerminateWorkflowExecution{handler:handler}
-  self28.processorMap["RequestCancelWorkflowExecution"] = &historyServiceProcessorRequestCancelWorkflowExecution{handler:handler}
-  self28.processorMap["ScheduleDecisionTask"] = &historyServiceProcessorScheduleDecisionTask{handler:handler}
-  self28.processorMap["RecordChildExecutionCompleted"] = &historyServiceProcessorRecordChildExecutionCompleted{handler:handler}
-return self28
-}
-
-func (p *HistoryServiceProcessor) Process(iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  name, _, seqId, err := iprot.ReadMessageBegin()
-  if err != nil { return false, err }
-  if processor, ok := p.GetProcessorFunction(name); ok {
-    return processor.Process(seqId, iprot, oprot)
-  }
-  iprot.Skip(thrift.STRUCT)
-  iprot.ReadMessageEnd()
-  x29 := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function " + name)
-  oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-  x29.Write(oprot)
-  oprot.WriteMessageEnd()
-  oprot.Flush()
-  return false, x29
-
-}
-
-type historyServiceProcessorStartWorkflowExecution struct {
-  handler HistoryService
+// RecordDecisionTaskStarted is called by the Matchingservice before it hands a decision task to the application worker in response to
+// a PollForDecisionTask call. It records in the history the event that the decision task has started. It will return 'EventAlreadyStartedError',
+// if the workflow's execution history already includes a record of the event starting.
+// 
+// 
+// Parameters:
+//  - AddRequest
+func (p *HistoryServiceClient) RecordDecisionTaskStarted(addRequest *RecordDecisionTaskStartedRequest) (r *RecordDecisionTaskStartedResponse, err error) {
+  if err = p.sendRecordDecisionTaskStarted(addRequest); err != nil { return }
+  return p.recvRecordDecisionTaskStarted()
 }
 
-func (p *historyServiceProcessorStartWorkflowExecution) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := HistoryServiceStartWorkflowExecutionArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("StartWorkflowExecution", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
-  }
-
-  iprot.ReadMessageEnd()
-  result := HistoryServiceStartWorkflowExecutionResult{}
-var retval *shared.StartWorkflowExecutionResponse
-  var err2 error
-  if retval, err2 = p.handler.StartWorkflowExecution(args.StartRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.WorkflowExecutionAlreadyStartedError:
-  result.SessionAlreadyExistError = v
-    case *ShardOwnershipLostError:
-  result.ShardOwnershipLostError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing StartWorkflowExecution: " + err2.Error())
-    oprot.WriteMessageBegin("StartWorkflowExecution", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
-  }
-  } else {
-    result.Success = retval
-}
-  if err2 = oprot.WriteMessageBegin("StartWorkflowExecution", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+func (p *HistoryServiceClient) sendRecordDecisionTaskStarted(addRequest *RecordDecisionTaskStartedRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("RecordDecisionTaskStarted", thrift.CALL, p.SeqId); err != nil {
+      return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  args := HistoryServiceRecordDecisionTaskStartedArgs{
+  AddRequest : addRequest,
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  if err = args.Write(oprot); err != nil {
+      return
   }
-  if err != nil {
-    return
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
   }
-  return true, err
+  return oprot.Flush()
 }
 
-type historyServiceProcessorGetWorkflowExecutionNextEventID struct {
-  handler HistoryService
-}
 
-func (p *historyServiceProcessorGetWorkflowExecutionNextEventID) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := HistoryServiceGetWorkflowExecutionNextEventIDArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("GetWorkflowExecutionNextEventID", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
+func (p *HistoryServiceClient) recvRecordDecisionTaskStarted() (value *RecordDecisionTaskStartedResponse, err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
   }
-
-  iprot.ReadMessageEnd()
-  result := HistoryServiceGetWorkflowExecutionNextEventIDResult{}
-var retval *GetWorkflowExecutionNextEventIDResponse
-  var err2 error
-  if retval, err2 = p.handler.GetWorkflowExecutionNextEventID(args.GetRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    case *ShardOwnershipLostError:
-  result.ShardOwnershipLostError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetWorkflowExecutionNextEventID: " + err2.Error())
-    oprot.WriteMessageBegin("GetWorkflowExecutionNextEventID", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
   }
-  } else {
-    result.Success = retval
-}
-  if err2 = oprot.WriteMessageBegin("GetWorkflowExecutionNextEventID", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+  if method != "RecordDecisionTaskStarted" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RecordDecisionTaskStarted failed: wrong method name")
+    return
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RecordDecisionTaskStarted failed: out of sequence response")
+    return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  if mTypeId == thrift.EXCEPTION {
+    error4 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error5 error
+    error5, err = error4.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error5
+    return
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RecordDecisionTaskStarted failed: invalid message type")
+    return
   }
-  if err != nil {
+  result := HistoryServiceRecordDecisionTaskStartedResult{}
+  if err = result.Read(iprot); err != nil {
     return
   }
-  return true, err
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EventAlreadyStartedError != nil {
+    err = result.EventAlreadyStartedError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  } else   if result.ShardOwnershipLostError != nil {
+    err = result.ShardOwnershipLostError
+    return 
+  }
+  value = result.GetSuccess()
+  return
 }
 
-type historyServiceProcessorRecordDecisionTaskStarted struct {
-  handler HistoryService
+// RecordActivityTaskStarted is called by the Matchingservice before it hands a decision task to the application worker in response to
+// a PollForActivityTask call. It records in the history the event that the decision task has started. It will return 'EventAlreadyStartedError',
+// if the workflow's execution history already includes a record of the event starting.
+// 
+// 
+// Parameters:
+//  - AddRequest
+func (p *HistoryServiceClient) RecordActivityTaskStarted(addRequest *RecordActivityTaskStartedRequest) (r *RecordActivityTaskStartedResponse, err error) {
+  if err = p.sendRecordActivityTaskStarted(addRequest); err != nil { return }
+  return p.recvRecordActivityTaskStarted()
 }
 
-func (p *historyServiceProcessorRecordDecisionTaskStarted) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := HistoryServiceRecordDecisionTaskStartedArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("RecordDecisionTaskStarted", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
-  }
-
-  iprot.ReadMessageEnd()
-  result := HistoryServiceRecordDecisionTaskStartedResult{}
-var retval *RecordDecisionTaskStartedResponse
-  var err2 error
-  if retval, err2 = p.handler.RecordDecisionTaskStarted(args.AddRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *EventAlreadyStartedError:
-  result.EventAlreadyStartedError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    case *ShardOwnershipLostError:
-  result.ShardOwnershipLostError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RecordDecisionTaskStarted: " + err2.Error())
-    oprot.WriteMessageBegin("RecordDecisionTaskStarted", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
-  }
-  } else {
-    result.Success = retval
-}
-  if err2 = oprot.WriteMessageBegin("RecordDecisionTaskStarted", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+func (p *HistoryServiceClient) sendRecordActivityTaskStarted(addRequest *RecordActivityTaskStartedRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("RecordActivityTaskStarted", thrift.CALL, p.SeqId); err != nil {
+      return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  args := HistoryServiceRecordActivityTaskStartedArgs{
+  AddRequest : addRequest,
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  if err = args.Write(oprot); err != nil {
+      return
   }
-  if err != nil {
-    return
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
   }
-  return true, err
+  return oprot.Flush()
 }
 
-type historyServiceProcessorRecordActivityTaskStarted struct {
-  handler HistoryService
-}
 
-func (p *historyServiceProcessorRecordActivityTaskStarted) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := HistoryServiceRecordActivityTaskStartedArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("RecordActivityTaskStarted", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
+func (p *HistoryServiceClient) recvRecordActivityTaskStarted() (value *RecordActivityTaskStartedResponse, err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
   }
-
-  iprot.ReadMessageEnd()
-  result := HistoryServiceRecordActivityTaskStartedResult{}
-var retval *RecordActivityTaskStartedResponse
-  var err2 error
-  if retval, err2 = p.handler.RecordActivityTaskStarted(args.AddRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *EventAlreadyStartedError:
-  result.EventAlreadyStartedError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    case *ShardOwnershipLostError:
-  result.ShardOwnershipLostError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RecordActivityTaskStarted: " + err2.Error())
-    oprot.WriteMessageBegin("RecordActivityTaskStarted", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
   }
-  } else {
-    result.Success = retval
-}
-  if err2 = oprot.WriteMessageBegin("RecordActivityTaskStarted", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+  if method != "RecordActivityTaskStarted" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RecordActivityTaskStarted failed: wrong method name")
+    return
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RecordActivityTaskStarted failed: out of sequence response")
+    return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  if mTypeId == thrift.EXCEPTION {
+    error6 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error7 error
+    error7, err = error6.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error7
+    return
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RecordActivityTaskStarted failed: invalid message type")
+    return
   }
-  if err != nil {
+  result := HistoryServiceRecordActivityTaskStartedResult{}
+  if err = result.Read(iprot); err != nil {
     return
   }
-  return true, err
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EventAlreadyStartedError != nil {
+    err = result.EventAlreadyStartedError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  } else   if result.ShardOwnershipLostError != nil {
+    err = result.ShardOwnershipLostError
+    return 
+  }
+  value = result.GetSuccess()
+  return
 }
 
-type historyServiceProcessorRespondDecisionTaskCompleted struct {
-  handler HistoryService
+// RespondDecisionTaskCompleted is called by application worker to complete a DecisionTask handed as a result of
+// 'PollForDecisionTask' API call.  Completing a DecisionTask will result in new events for the workflow execution and
+// potentially new ActivityTask being created for corresponding decisions.  It will also create a DecisionTaskCompleted
+// event in the history for that session.  Use the 'taskToken' provided as response of PollForDecisionTask API call
+// for completing the DecisionTask.
+// 
+// 
+// Parameters:
+//  - CompleteRequest
+func (p *HistoryServiceClient) RespondDecisionTaskCompleted(completeRequest *RespondDecisionTaskCompletedRequest) (r *shared.RespondDecisionTaskCompletedResponse, err error) {
+  if err = p.sendRespondDecisionTaskCompleted(completeRequest); err != nil { return }
+  return p.recvRespondDecisionTaskCompleted()
 }
 
-func (p *historyServiceProcessorRespondDecisionTaskCompleted) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := HistoryServiceRespondDecisionTaskCompletedArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("RespondDecisionTaskCompleted", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
-  }
-
-  iprot.ReadMessageEnd()
-  result := HistoryServiceRespondDecisionTaskCompletedResult{}
-  var err2 error
-  if err2 = p.handler.RespondDecisionTaskCompleted(args.CompleteRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    case *ShardOwnershipLostError:
-  result.ShardOwnershipLostError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondDecisionTaskCompleted: " + err2.Error())
-    oprot.WriteMessageBegin("RespondDecisionTaskCompleted", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
-  }
-  }
-  if err2 = oprot.WriteMessageBegin("RespondDecisionTaskCompleted", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+func (p *HistoryServiceClient) sendRespondDecisionTaskCompleted(completeRequest *RespondDecisionTaskCompletedRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("RespondDecisionTaskCompleted", thrift.CALL, p.SeqId); err != nil {
+      return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  args := HistoryServiceRespondDecisionTaskCompletedArgs{
+  CompleteRequest : completeRequest,
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  if err = args.Write(oprot); err != nil {
+      return
   }
-  if err != nil {
-    return
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
   }
-  return true, err
+  return oprot.Flush()
 }
 
-type historyServiceProcessorRecordActivityTaskHeartbeat struct {
-  handler HistoryService
-}
 
-func (p *historyServiceProcessorRecordActivityTaskHeartbeat) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := HistoryServiceRecordActivityTaskHeartbeatArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("RecordActivityTaskHeartbeat", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
+func (p *HistoryServiceClient) recvRespondDecisionTaskCompleted() (value *shared.RespondDecisionTaskCompletedResponse, err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
   }
-
-  iprot.ReadMessageEnd()
-  result := HistoryServiceRecordActivityTaskHeartbeatResult{}
-var retval *shared.RecordActivityTaskHeartbeatResponse
-  var err2 error
-  if retval, err2 = p.handler.RecordActivityTaskHeartbeat(args.HeartbeatRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    case *ShardOwnershipLostError:
-  result.ShardOwnershipLostError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RecordActivityTaskHeartbeat: " + err2.Error())
-    oprot.WriteMessageBegin("RecordActivityTaskHeartbeat", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
   }
-  } else {
-    result.Success = retval
-}
-  if err2 = oprot.WriteMessageBegin("RecordActivityTaskHeartbeat", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+  if method != "RespondDecisionTaskCompleted" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RespondDecisionTaskCompleted failed: wrong method name")
+    return
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RespondDecisionTaskCompleted failed: out of sequence response")
+    return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  if mTypeId == thrift.EXCEPTION {
+    error10 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error11 error
+    error11, err = error10.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error11
+    return
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RespondDecisionTaskCompleted failed: invalid message type")
+    return
   }
-  if err != nil {
+  result := HistoryServiceRespondDecisionTaskCompletedResult{}
+  if err = result.Read(iprot); err != nil {
     return
   }
-  return true, err
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  } else   if result.ShardOwnershipLostError != nil {
+    err = result.ShardOwnershipLostError
+    return 
+  }
+  value = result.GetSuccess()
+  return
 }
 
-type historyServiceProcessorRespondActivityTaskCompleted struct {
-  handler HistoryService
+// RecordActivityTaskHeartbeat is called by application worker while it is processing an ActivityTask.  If worker fails
+// to heartbeat within 'heartbeatTimeoutSeconds' interval for the ActivityTask, then it will be marked as timedout and
+// 'ActivityTaskTimedOut' event will be written to the workflow history.  Calling 'RecordActivityTaskHeartbeat' will
+// fail with 'EntityNotExistsError' in such situations.  Use the 'taskToken' provided as response of
+// PollForActivityTask API call for heartbeating.
+// 
+// 
+// Parameters:
+//  - HeartbeatRequest
+func (p *HistoryServiceClient) RecordActivityTaskHeartbeat(heartbeatRequest *RecordActivityTaskHeartbeatRequest) (r *shared.RecordActivityTaskHeartbeatResponse, err error) {
+  if err = p.sendRecordActivityTaskHeartbeat(heartbeatRequest); err != nil { return }
+  return p.recvRecordActivityTaskHeartbeat()
 }
 
-func (p *historyServiceProcessorRespondActivityTaskCompleted) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := HistoryServiceRespondActivityTaskCompletedArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("RespondActivityTaskCompleted", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
-  }
-
-  iprot.ReadMessageEnd()
-  result := HistoryServiceRespondActivityTaskCompletedResult{}
-  var err2 error
-  if err2 = p.handler.RespondActivityTaskCompleted(args.CompleteRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    case *ShardOwnershipLostError:
-  result.ShardOwnershipLostError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondActivityTaskCompleted: " + err2.Error())
-    oprot.WriteMessageBegin("RespondActivityTaskCompleted", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
-  }
-  }
-  if err2 = oprot.WriteMessageBegin("RespondActivityTaskCompleted", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+func (p *HistoryServiceClient) sendRecordActivityTaskHeartbeat(heartbeatRequest *RecordActivityTaskHeartbeatRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("RecordActivityTaskHeartbeat", thrift.CALL, p.SeqId); err != nil {
+      return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  args := HistoryServiceRecordActivityTaskHeartbeatArgs{
+  HeartbeatRequest : heartbeatRequest,
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  if err = args.Write(oprot); err != nil {
+      return
   }
-  if err != nil {
-    return
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
   }
-  return true, err
+  return oprot.Flush()
 }
 
-type historyServiceProcessorRespondActivityTaskFailed struct {
-  handler HistoryService
-}
 
-func (p *historyServiceProcessorRespondActivityTaskFailed) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := HistoryServiceRespondActivityTaskFailedArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("RespondActivityTaskFailed", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
+func (p *HistoryServiceClient) recvRecordActivityTaskHeartbeat() (value *shared.RecordActivityTaskHeartbeatResponse, err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
   }
-
-  iprot.ReadMessageEnd()
-  result := HistoryServiceRespondActivityTaskFailedResult{}
-  var err2 error
-  if err2 = p.handler.RespondActivityTaskFailed(args.FailRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    case *ShardOwnershipLostError:
-  result.ShardOwnershipLostError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondActivityTaskFailed: " + err2.Error())
-    oprot.WriteMessageBegin("RespondActivityTaskFailed", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
   }
+  if method != "RecordActivityTaskHeartbeat" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RecordActivityTaskHeartbeat failed: wrong method name")
+    return
   }
-  if err2 = oprot.WriteMessageBegin("RespondActivityTaskFailed", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RecordActivityTaskHeartbeat failed: out of sequence response")
+    return
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  if mTypeId == thrift.EXCEPTION {
+    error10 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error11 error
+    error11, err = error10.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error11
+    return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RecordActivityTaskHeartbeat failed: invalid message type")
+    return
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  result := HistoryServiceRecordActivityTaskHeartbeatResult{}
+  if err = result.Read(iprot); err != nil {
+    return
   }
-  if err != nil {
+  if err = iprot.ReadMessageEnd(); err != nil {
     return
   }
-  return true, err
-}
-
-type historyServiceProcessorRespondActivityTaskCanceled struct {
-  handler HistoryService
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  } else   if result.ShardOwnershipLostError != nil {
+    err = result.ShardOwnershipLostError
+    return 
+  }
+  value = result.GetSuccess()
+  return
 }
 
-func (p *historyServiceProcessorRespondActivityTaskCanceled) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := HistoryServiceRespondActivityTaskCanceledArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("RespondActivityTaskCanceled", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
-  }
+// RespondActivityTaskCompleted is called by application worker when it is done processing an ActivityTask.  It will
+// result in a new 'ActivityTaskCompleted' event being written to the workflow history and a new DecisionTask
+// created for the workflow so new decisions could be made.  Use the 'taskToken' provided as response of
+// PollForActivityTask API call for completion. It fails with 'EntityNotExistsError' if the taskToken is not valid
+// anymore due to activity timeout.
+// 
+// 
+// Parameters:
+//  - CompleteRequest
+func (p *HistoryServiceClient) RespondActivityTaskCompleted(completeRequest *RespondActivityTaskCompletedRequest) (err error) {
+  if err = p.sendRespondActivityTaskCompleted(completeRequest); err != nil { return }
+  return p.recvRespondActivityTaskCompleted()
+}
 
-  iprot.ReadMessageEnd()
-  result := HistoryServiceRespondActivityTaskCanceledResult{}
-  var err2 error
-  if err2 = p.handler.RespondActivityTaskCanceled(args.CanceledRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    case *ShardOwnershipLostError:
-  result.ShardOwnershipLostError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondActivityTaskCanceled: " + err2.Error())
-    oprot.WriteMessageBegin("RespondActivityTaskCanceled", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
-  }
-  }
-  if err2 = oprot.WriteMessageBegin("RespondActivityTaskCanceled", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+func (p *HistoryServiceClient) sendRespondActivityTaskCompleted(completeRequest *RespondActivityTaskCompletedRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("RespondActivityTaskCompleted", thrift.CALL, p.SeqId); err != nil {
+      return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  args := HistoryServiceRespondActivityTaskCompletedArgs{
+  CompleteRequest : completeRequest,
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  if err = args.Write(oprot); err != nil {
+      return
   }
-  if err != nil {
-    return
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
   }
-  return true, err
+  return oprot.Flush()
 }
 
-type historyServiceProcessorSignalWorkflowExecution struct {
-  handler HistoryService
-}
 
-func (p *historyServiceProcessorSignalWorkflowExecution) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := HistoryServiceSignalWorkflowExecutionArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("SignalWorkflowExecution", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
+func (p *HistoryServiceClient) recvRespondActivityTaskCompleted() (err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
   }
-
-  iprot.ReadMessageEnd()
-  result := HistoryServiceSignalWorkflowExecutionResult{}
-  var err2 error
-  if err2 = p.handler.SignalWorkflowExecution(args.SignalRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    case *ShardOwnershipLostError:
-  result.ShardOwnershipLostError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SignalWorkflowExecution: " + err2.Error())
-    oprot.WriteMessageBegin("SignalWorkflowExecution", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
   }
+  if method != "RespondActivityTaskCompleted" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RespondActivityTaskCompleted failed: wrong method name")
+    return
   }
-  if err2 = oprot.WriteMessageBegin("SignalWorkflowExecution", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RespondActivityTaskCompleted failed: out of sequence response")
+    return
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  if mTypeId == thrift.EXCEPTION {
+    error12 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error13 error
+    error13, err = error12.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error13
+    return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RespondActivityTaskCompleted failed: invalid message type")
+    return
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  result := HistoryServiceRespondActivityTaskCompletedResult{}
+  if err = result.Read(iprot); err != nil {
+    return
   }
-  if err != nil {
+  if err = iprot.ReadMessageEnd(); err != nil {
     return
   }
-  return true, err
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  } else   if result.ShardOwnershipLostError != nil {
+    err = result.ShardOwnershipLostError
+    return 
+  }
+  return
 }
 
-type historyServiceProcessorTerminateWorkflowExecution struct {
-  handler HistoryService
+// RespondActivityTaskFailed is called by application worker when it is done processing an ActivityTask.  It will
+// result in a new 'ActivityTaskFailed' event being written to the workflow history and a new DecisionTask
+// created for the workflow instance so new decisions could be made.  Use the 'taskToken' provided as response of
+// PollForActivityTask API call for completion. It fails with 'EntityNotExistsError' if the taskToken is not valid
+// anymore due to activity timeout.
+// 
+// 
+// Parameters:
+//  - FailRequest
+func (p *HistoryServiceClient) RespondActivityTaskFailed(failRequest *RespondActivityTaskFailedRequest) (err error) {
+  if err = p.sendRespondActivityTaskFailed(failRequest); err != nil { return }
+  return p.recvRespondActivityTaskFailed()
 }
 
-func (p *historyServiceProcessorTerminateWorkflowExecution) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := HistoryServiceTerminateWorkflowExecutionArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("TerminateWorkflowExecution", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
-  }
-
-  iprot.ReadMessageEnd()
-  result := HistoryServiceTerminateWorkflowExecutionResult{}
-  var err2 error
-  if err2 = p.handler.TerminateWorkflowExecution(args.TerminateRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    case *ShardOwnershipLostError:
-  result.ShardOwnershipLostError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TerminateWorkflowExecution: " + err2.Error())
-    oprot.WriteMessageBegin("TerminateWorkflowExecution", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
+func (p *HistoryServiceClient) sendRespondActivityTaskFailed(failRequest *RespondActivityTaskFailedRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
   }
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("RespondActivityTaskFailed", thrift.CALL, p.SeqId); err != nil {
+      return
   }
-  if err2 = oprot.WriteMessageBegin("TerminateWorkflowExecution", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+  args := HistoryServiceRespondActivityTaskFailedArgs{
+  FailRequest : failRequest,
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  if err = args.Write(oprot); err != nil {
+      return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  return oprot.Flush()
+}
+
+
+func (p *HistoryServiceClient) recvRespondActivityTaskFailed() (err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
   }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
   if err != nil {
     return
   }
-  return true, err
-}
-
-type historyServiceProcessorRequestCancelWorkflowExecution struct {
-  handler HistoryService
-}
-
-func (p *historyServiceProcessorRequestCancelWorkflowExecution) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := HistoryServiceRequestCancelWorkflowExecutionArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("RequestCancelWorkflowExecution", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
+  if method != "RespondActivityTaskFailed" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RespondActivityTaskFailed failed: wrong method name")
+    return
   }
-
-  iprot.ReadMessageEnd()
-  result := HistoryServiceRequestCancelWorkflowExecutionResult{}
-  var err2 error
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RespondActivityTaskFailed failed: out of sequence response")
+    return
+  }
+  if mTypeId == thrift.EXCEPTION {
+    error14 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error15 error
+    error15, err = error14.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error15
+    return
+  }
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RespondActivityTaskFailed failed: invalid message type")
+    return
+  }
+  result := HistoryServiceRespondActivityTaskFailedResult{}
+  if err = result.Read(iprot); err != nil {
+    return
+  }
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  } else   if result.ShardOwnershipLostError != nil {
+    err = result.ShardOwnershipLostError
+    return 
+  }
+  return
+}
+
+// RespondActivityTaskCanceled is called by application worker when it is successfully canceled an ActivityTask.  It will
+// result in a new 'ActivityTaskCanceled' event being written to the workflow history and a new DecisionTask
+// created for the workflow instance so new decisions could be made.  Use the 'taskToken' provided as response of
+// PollForActivityTask API call for completion. It fails with 'EntityNotExistsError' if the taskToken is not valid
+// anymore due to activity timeout.
+// 
+// 
+// Parameters:
+//  - CanceledRequest
+func (p *HistoryServiceClient) RespondActivityTaskCanceled(canceledRequest *RespondActivityTaskCanceledRequest) (err error) {
+  if err = p.sendRespondActivityTaskCanceled(canceledRequest); err != nil { return }
+  return p.recvRespondActivityTaskCanceled()
+}
+
+func (p *HistoryServiceClient) sendRespondActivityTaskCanceled(canceledRequest *RespondActivityTaskCanceledRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
+  }
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("RespondActivityTaskCanceled", thrift.CALL, p.SeqId); err != nil {
+      return
+  }
+  args := HistoryServiceRespondActivityTaskCanceledArgs{
+  CanceledRequest : canceledRequest,
+  }
+  if err = args.Write(oprot); err != nil {
+      return
+  }
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
+  }
+  return oprot.Flush()
+}
+
+
+func (p *HistoryServiceClient) recvRespondActivityTaskCanceled() (err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
+  }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
+  }
+  if method != "RespondActivityTaskCanceled" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RespondActivityTaskCanceled failed: wrong method name")
+    return
+  }
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RespondActivityTaskCanceled failed: out of sequence response")
+    return
+  }
+  if mTypeId == thrift.EXCEPTION {
+    error16 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error17 error
+    error17, err = error16.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error17
+    return
+  }
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RespondActivityTaskCanceled failed: invalid message type")
+    return
+  }
+  result := HistoryServiceRespondActivityTaskCanceledResult{}
+  if err = result.Read(iprot); err != nil {
+    return
+  }
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  } else   if result.ShardOwnershipLostError != nil {
+    err = result.ShardOwnershipLostError
+    return 
+  }
+  return
+}
+
+// SignalWorkflowExecution is used to send a signal event to running workflow execution.  This results in
+// WorkflowExecutionSignaled event recorded in the history and a decision task being created for the execution.
+// 
+// 
+// Parameters:
+//  - SignalRequest
+func (p *HistoryServiceClient) SignalWorkflowExecution(signalRequest *SignalWorkflowExecutionRequest) (err error) {
+  if err = p.sendSignalWorkflowExecution(signalRequest); err != nil { return }
+  return p.recvSignalWorkflowExecution()
+}
+
+func (p *HistoryServiceClient) sendSignalWorkflowExecution(signalRequest *SignalWorkflowExecutionRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
+  }
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("SignalWorkflowExecution", thrift.CALL, p.SeqId); err != nil {
+      return
+  }
+  args := HistoryServiceSignalWorkflowExecutionArgs{
+  SignalRequest : signalRequest,
+  }
+  if err = args.Write(oprot); err != nil {
+      return
+  }
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
+  }
+  return oprot.Flush()
+}
+
+
+func (p *HistoryServiceClient) recvSignalWorkflowExecution() (err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
+  }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
+  }
+  if method != "SignalWorkflowExecution" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "SignalWorkflowExecution failed: wrong method name")
+    return
+  }
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "SignalWorkflowExecution failed: out of sequence response")
+    return
+  }
+  if mTypeId == thrift.EXCEPTION {
+    error18 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error19 error
+    error19, err = error18.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error19
+    return
+  }
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "SignalWorkflowExecution failed: invalid message type")
+    return
+  }
+  result := HistoryServiceSignalWorkflowExecutionResult{}
+  if err = result.Read(iprot); err != nil {
+    return
+  }
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  } else   if result.ShardOwnershipLostError != nil {
+    err = result.ShardOwnershipLostError
+    return 
+  }
+  return
+}
+
+// TerminateWorkflowExecution terminates an existing workflow execution by recording WorkflowExecutionTerminated event
+// in the history and immediately terminating the execution instance.
+// 
+// 
+// Parameters:
+//  - TerminateRequest
+func (p *HistoryServiceClient) TerminateWorkflowExecution(terminateRequest *TerminateWorkflowExecutionRequest) (err error) {
+  if err = p.sendTerminateWorkflowExecution(terminateRequest); err != nil { return }
+  return p.recvTerminateWorkflowExecution()
+}
+
+func (p *HistoryServiceClient) sendTerminateWorkflowExecution(terminateRequest *TerminateWorkflowExecutionRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
+  }
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("TerminateWorkflowExecution", thrift.CALL, p.SeqId); err != nil {
+      return
+  }
+  args := HistoryServiceTerminateWorkflowExecutionArgs{
+  TerminateRequest : terminateRequest,
+  }
+  if err = args.Write(oprot); err != nil {
+      return
+  }
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
+  }
+  return oprot.Flush()
+}
+
+
+func (p *HistoryServiceClient) recvTerminateWorkflowExecution() (err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
+  }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
+  }
+  if method != "TerminateWorkflowExecution" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "TerminateWorkflowExecution failed: wrong method name")
+    return
+  }
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "TerminateWorkflowExecution failed: out of sequence response")
+    return
+  }
+  if mTypeId == thrift.EXCEPTION {
+    error20 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error21 error
+    error21, err = error20.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error21
+    return
+  }
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "TerminateWorkflowExecution failed: invalid message type")
+    return
+  }
+  result := HistoryServiceTerminateWorkflowExecutionResult{}
+  if err = result.Read(iprot); err != nil {
+    return
+  }
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  } else   if result.ShardOwnershipLostError != nil {
+    err = result.ShardOwnershipLostError
+    return 
+  }
+  return
+}
+
+// RequestCancelWorkflowExecution is called by application worker when it wants to request cancellation of a workflow instance.
+// It will result in a new 'WorkflowExecutionCancelRequested' event being written to the workflow history and a new DecisionTask
+// created for the workflow instance so new decisions could be made. It fails with 'EntityNotExistsError' if the workflow is not valid
+// anymore due to completion or doesn't exist.
+// 
+// 
+// Parameters:
+//  - CancelRequest
+func (p *HistoryServiceClient) RequestCancelWorkflowExecution(cancelRequest *RequestCancelWorkflowExecutionRequest) (err error) {
+  if err = p.sendRequestCancelWorkflowExecution(cancelRequest); err != nil { return }
+  return p.recvRequestCancelWorkflowExecution()
+}
+
+func (p *HistoryServiceClient) sendRequestCancelWorkflowExecution(cancelRequest *RequestCancelWorkflowExecutionRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
+  }
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("RequestCancelWorkflowExecution", thrift.CALL, p.SeqId); err != nil {
+      return
+  }
+  args := HistoryServiceRequestCancelWorkflowExecutionArgs{
+  CancelRequest : cancelRequest,
+  }
+  if err = args.Write(oprot); err != nil {
+      return
+  }
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
+  }
+  return oprot.Flush()
+}
+
+
+func (p *HistoryServiceClient) recvRequestCancelWorkflowExecution() (err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
+  }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
+  }
+  if method != "RequestCancelWorkflowExecution" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RequestCancelWorkflowExecution failed: wrong method name")
+    return
+  }
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RequestCancelWorkflowExecution failed: out of sequence response")
+    return
+  }
+  if mTypeId == thrift.EXCEPTION {
+    error22 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error23 error
+    error23, err = error22.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error23
+    return
+  }
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RequestCancelWorkflowExecution failed: invalid message type")
+    return
+  }
+  result := HistoryServiceRequestCancelWorkflowExecutionResult{}
+  if err = result.Read(iprot); err != nil {
+    return
+  }
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  } else   if result.ShardOwnershipLostError != nil {
+    err = result.ShardOwnershipLostError
+    return 
+  }
+  return
+}
+
+// ScheduleDecisionTask is used for creating a decision task for already started workflow execution.  This is mainly
+// used by transfer queue processor during the processing of StartChildWorkflowExecution task, where it first starts
+// child execution without creating the decision task and then calls this API after updating the mutable state of
+// parent execution.
+// 
+// 
+// Parameters:
+//  - ScheduleRequest
+func (p *HistoryServiceClient) ScheduleDecisionTask(scheduleRequest *ScheduleDecisionTaskRequest) (err error) {
+  if err = p.sendScheduleDecisionTask(scheduleRequest); err != nil { return }
+  return p.recvScheduleDecisionTask()
+}
+
+func (p *HistoryServiceClient) sendScheduleDecisionTask(scheduleRequest *ScheduleDecisionTaskRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
+  }
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("ScheduleDecisionTask", thrift.CALL, p.SeqId); err != nil {
+      return
+  }
+  args := HistoryServiceScheduleDecisionTaskArgs{
+  ScheduleRequest : scheduleRequest,
+  }
+  if err = args.Write(oprot); err != nil {
+      return
+  }
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
+  }
+  return oprot.Flush()
+}
+
+
+func (p *HistoryServiceClient) recvScheduleDecisionTask() (err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
+  }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
+  }
+  if method != "ScheduleDecisionTask" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "ScheduleDecisionTask failed: wrong method name")
+    return
+  }
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ScheduleDecisionTask failed: out of sequence response")
+    return
+  }
+  if mTypeId == thrift.EXCEPTION {
+    error24 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error25 error
+    error25, err = error24.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error25
+    return
+  }
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "ScheduleDecisionTask failed: invalid message type")
+    return
+  }
+  result := HistoryServiceScheduleDecisionTaskResult{}
+  if err = result.Read(iprot); err != nil {
+    return
+  }
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  } else   if result.ShardOwnershipLostError != nil {
+    err = result.ShardOwnershipLostError
+    return 
+  }
+  return
+}
+
+// RecordChildExecutionCompleted is used for reporting the completion of child workflow execution to parent.
+// This is mainly called by transfer queue processor during the processing of DeleteExecution task.
+// 
+// 
+// Parameters:
+//  - CompletionRequest
+func (p *HistoryServiceClient) RecordChildExecutionCompleted(completionRequest *RecordChildExecutionCompletedRequest) (err error) {
+  if err = p.sendRecordChildExecutionCompleted(completionRequest); err != nil { return }
+  return p.recvRecordChildExecutionCompleted()
+}
+
+func (p *HistoryServiceClient) sendRecordChildExecutionCompleted(completionRequest *RecordChildExecutionCompletedRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
+  }
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("RecordChildExecutionCompleted", thrift.CALL, p.SeqId); err != nil {
+      return
+  }
+  args := HistoryServiceRecordChildExecutionCompletedArgs{
+  CompletionRequest : completionRequest,
+  }
+  if err = args.Write(oprot); err != nil {
+      return
+  }
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
+  }
+  return oprot.Flush()
+}
+
+
+func (p *HistoryServiceClient) recvRecordChildExecutionCompleted() (err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
+  }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
+  }
+  if method != "RecordChildExecutionCompleted" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RecordChildExecutionCompleted failed: wrong method name")
+    return
+  }
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RecordChildExecutionCompleted failed: out of sequence response")
+    return
+  }
+  if mTypeId == thrift.EXCEPTION {
+    error26 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error27 error
+    error27, err = error26.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error27
+    return
+  }
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RecordChildExecutionCompleted failed: invalid message type")
+    return
+  }
+  result := HistoryServiceRecordChildExecutionCompletedResult{}
+  if err = result.Read(iprot); err != nil {
+    return
+  }
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  } else   if result.ShardOwnershipLostError != nil {
+    err = result.ShardOwnershipLostError
+    return 
+  }
+  return
+}
+
+// DescribeShard returns the task ID watermarks and ack levels of a shard owned by this host.  It is used by
+// consumers of the transfer and timer queues to verify that no task ID range has been skipped.
+// 
+// Parameters:
+//  - Request
+func (p *HistoryServiceClient) DescribeShard(request *DescribeShardRequest) (r *DescribeShardResponse, err error) {
+  if err = p.sendDescribeShard(request); err != nil { return }
+  return p.recvDescribeShard()
+}
+
+func (p *HistoryServiceClient) sendDescribeShard(request *DescribeShardRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
+  }
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("DescribeShard", thrift.CALL, p.SeqId); err != nil {
+      return
+  }
+  args := HistoryServiceDescribeShardArgs{
+  Request : request,
+  }
+  if err = args.Write(oprot); err != nil {
+      return
+  }
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
+  }
+  return oprot.Flush()
+}
+
+
+func (p *HistoryServiceClient) recvDescribeShard() (value *DescribeShardResponse, err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
+  }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
+  }
+  if method != "DescribeShard" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "DescribeShard failed: wrong method name")
+    return
+  }
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "DescribeShard failed: out of sequence response")
+    return
+  }
+  if mTypeId == thrift.EXCEPTION {
+    error2 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error3 error
+    error3, err = error2.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error3
+    return
+  }
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "DescribeShard failed: invalid message type")
+    return
+  }
+  result := HistoryServiceDescribeShardResult{}
+  if err = result.Read(iprot); err != nil {
+    return
+  }
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  } else   if result.ShardOwnershipLostError != nil {
+    err = result.ShardOwnershipLostError
+    return 
+  }
+  value = result.GetSuccess()
+  return
+}
+
+// InvalidateMutableState drops the cached mutable state of a workflow execution owned by this host, or unloads a
+// whole shard when no execution is given, so that the state is reloaded from persistence on next use.
+// 
+// Parameters:
+//  - Request
+func (p *HistoryServiceClient) InvalidateMutableState(request *InvalidateMutableStateRequest) (err error) {
+  if err = p.sendInvalidateMutableState(request); err != nil { return }
+  return p.recvInvalidateMutableState()
+}
+
+func (p *HistoryServiceClient) sendInvalidateMutableState(request *InvalidateMutableStateRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
+  }
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("InvalidateMutableState", thrift.CALL, p.SeqId); err != nil {
+      return
+  }
+  args := HistoryServiceInvalidateMutableStateArgs{
+  Request : request,
+  }
+  if err = args.Write(oprot); err != nil {
+      return
+  }
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
+  }
+  return oprot.Flush()
+}
+
+
+func (p *HistoryServiceClient) recvInvalidateMutableState() (err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
+  }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
+  }
+  if method != "InvalidateMutableState" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "InvalidateMutableState failed: wrong method name")
+    return
+  }
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "InvalidateMutableState failed: out of sequence response")
+    return
+  }
+  if mTypeId == thrift.EXCEPTION {
+    error26 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error27 error
+    error27, err = error26.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error27
+    return
+  }
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "InvalidateMutableState failed: invalid message type")
+    return
+  }
+  result := HistoryServiceInvalidateMutableStateResult{}
+  if err = result.Read(iprot); err != nil {
+    return
+  }
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  } else   if result.ShardOwnershipLostError != nil {
+    err = result.ShardOwnershipLostError
+    return 
+  }
+  return
+}
+
+// SetTaskProcessingPaused pauses or resumes the processing of the transfer and/or timer tasks of a shard, or of a
+// domain on every shard owned by this host.  Paused tasks stay in the queues until processing is resumed.
+// 
+// Parameters:
+//  - Request
+func (p *HistoryServiceClient) SetTaskProcessingPaused(request *SetTaskProcessingPausedRequest) (err error) {
+  if err = p.sendSetTaskProcessingPaused(request); err != nil { return }
+  return p.recvSetTaskProcessingPaused()
+}
+
+func (p *HistoryServiceClient) sendSetTaskProcessingPaused(request *SetTaskProcessingPausedRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
+  }
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("SetTaskProcessingPaused", thrift.CALL, p.SeqId); err != nil {
+      return
+  }
+  args := HistoryServiceSetTaskProcessingPausedArgs{
+  Request : request,
+  }
+  if err = args.Write(oprot); err != nil {
+      return
+  }
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
+  }
+  return oprot.Flush()
+}
+
+
+func (p *HistoryServiceClient) recvSetTaskProcessingPaused() (err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
+  }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
+  }
+  if method != "SetTaskProcessingPaused" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "SetTaskProcessingPaused failed: wrong method name")
+    return
+  }
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "SetTaskProcessingPaused failed: out of sequence response")
+    return
+  }
+  if mTypeId == thrift.EXCEPTION {
+    error26 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error27 error
+    error27, err = error26.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error27
+    return
+  }
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "SetTaskProcessingPaused failed: invalid message type")
+    return
+  }
+  result := HistoryServiceSetTaskProcessingPausedResult{}
+  if err = result.Read(iprot); err != nil {
+    return
+  }
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  } else   if result.ShardOwnershipLostError != nil {
+    err = result.ShardOwnershipLostError
+    return 
+  }
+  return
+}
+
+// ListConcreteExecutions returns a page of the workflow executions stored by a shard owned by this host, including
+// closed executions which have not been deleted yet.  It is used by scanners which check the integrity of the
+// executions of a shard.
+// 
+// Parameters:
+//  - Request
+func (p *HistoryServiceClient) ListConcreteExecutions(request *ListConcreteExecutionsRequest) (r *ListConcreteExecutionsResponse, err error) {
+  if err = p.sendListConcreteExecutions(request); err != nil { return }
+  return p.recvListConcreteExecutions()
+}
+
+func (p *HistoryServiceClient) sendListConcreteExecutions(request *ListConcreteExecutionsRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
+  }
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("ListConcreteExecutions", thrift.CALL, p.SeqId); err != nil {
+      return
+  }
+  args := HistoryServiceListConcreteExecutionsArgs{
+  Request : request,
+  }
+  if err = args.Write(oprot); err != nil {
+      return
+  }
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
+  }
+  return oprot.Flush()
+}
+
+
+func (p *HistoryServiceClient) recvListConcreteExecutions() (value *ListConcreteExecutionsResponse, err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
+  }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
+  }
+  if method != "ListConcreteExecutions" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "ListConcreteExecutions failed: wrong method name")
+    return
+  }
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ListConcreteExecutions failed: out of sequence response")
+    return
+  }
+  if mTypeId == thrift.EXCEPTION {
+    error2 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error3 error
+    error3, err = error2.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error3
+    return
+  }
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "ListConcreteExecutions failed: invalid message type")
+    return
+  }
+  result := HistoryServiceListConcreteExecutionsResult{}
+  if err = result.Read(iprot); err != nil {
+    return
+  }
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  } else   if result.ShardOwnershipLostError != nil {
+    err = result.ShardOwnershipLostError
+    return 
+  }
+  value = result.GetSuccess()
+  return
+}
+
+
+// SignalWithStartWorkflowExecution is used to ensure sending a signal to a workflow.  If the workflow is running, this
+// results in WorkflowExecutionSignaled event recorded in the history and a decision task being created for the
+// execution.  If the workflow is not running or not found, this results in WorkflowExecutionStarted and
+// WorkflowExecutionSignaled events recorded in the history of a new run, and a decision task being created for it.
+// 
+// Parameters:
+//  - SignalWithStartRequest
+func (p *HistoryServiceClient) SignalWithStartWorkflowExecution(signalWithStartRequest *SignalWithStartWorkflowExecutionRequest) (r *shared.StartWorkflowExecutionResponse, err error) {
+  if err = p.sendSignalWithStartWorkflowExecution(signalWithStartRequest); err != nil { return }
+  return p.recvSignalWithStartWorkflowExecution()
+}
+
+func (p *HistoryServiceClient) sendSignalWithStartWorkflowExecution(signalWithStartRequest *SignalWithStartWorkflowExecutionRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
+  }
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("SignalWithStartWorkflowExecution", thrift.CALL, p.SeqId); err != nil {
+      return
+  }
+  args := HistoryServiceSignalWithStartWorkflowExecutionArgs{
+  SignalWithStartRequest : signalWithStartRequest,
+  }
+  if err = args.Write(oprot); err != nil {
+      return
+  }
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
+  }
+  return oprot.Flush()
+}
+
+
+func (p *HistoryServiceClient) recvSignalWithStartWorkflowExecution() (value *shared.StartWorkflowExecutionResponse, err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
+  }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
+  }
+  if method != "SignalWithStartWorkflowExecution" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "SignalWithStartWorkflowExecution failed: wrong method name")
+    return
+  }
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "SignalWithStartWorkflowExecution failed: out of sequence response")
+    return
+  }
+  if mTypeId == thrift.EXCEPTION {
+    error0 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error1 error
+    error1, err = error0.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error1
+    return
+  }
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "SignalWithStartWorkflowExecution failed: invalid message type")
+    return
+  }
+  result := HistoryServiceSignalWithStartWorkflowExecutionResult{}
+  if err = result.Read(iprot); err != nil {
+    return
+  }
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.SessionAlreadyExistError != nil {
+    err = result.SessionAlreadyExistError
+    return 
+  } else   if result.ShardOwnershipLostError != nil {
+    err = result.ShardOwnershipLostError
+    return 
+  }
+  value = result.GetSuccess()
+  return
+}
+
+// ResetWorkflowExecution resets a workflow execution to the end of a decision task of one of its runs.  A new run is
+// created with the history of the run up to the started event of the decision task, which is then recorded as failed
+// and followed by a new decision task.  The signals received by the run after that point are recorded again in the new
+// run.  The run is terminated if it is still running.
+// 
+// Parameters:
+//  - ResetRequest
+func (p *HistoryServiceClient) ResetWorkflowExecution(resetRequest *ResetWorkflowExecutionRequest) (r *shared.ResetWorkflowExecutionResponse, err error) {
+  if err = p.sendResetWorkflowExecution(resetRequest); err != nil { return }
+  return p.recvResetWorkflowExecution()
+}
+
+func (p *HistoryServiceClient) sendResetWorkflowExecution(resetRequest *ResetWorkflowExecutionRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
+  }
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("ResetWorkflowExecution", thrift.CALL, p.SeqId); err != nil {
+      return
+  }
+  args := HistoryServiceResetWorkflowExecutionArgs{
+  ResetRequest : resetRequest,
+  }
+  if err = args.Write(oprot); err != nil {
+      return
+  }
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
+  }
+  return oprot.Flush()
+}
+
+
+func (p *HistoryServiceClient) recvResetWorkflowExecution() (value *shared.ResetWorkflowExecutionResponse, err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
+  }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
+  }
+  if method != "ResetWorkflowExecution" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "ResetWorkflowExecution failed: wrong method name")
+    return
+  }
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ResetWorkflowExecution failed: out of sequence response")
+    return
+  }
+  if mTypeId == thrift.EXCEPTION {
+    error10 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error11 error
+    error11, err = error10.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error11
+    return
+  }
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "ResetWorkflowExecution failed: invalid message type")
+    return
+  }
+  result := HistoryServiceResetWorkflowExecutionResult{}
+  if err = result.Read(iprot); err != nil {
+    return
+  }
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  } else   if result.ShardOwnershipLostError != nil {
+    err = result.ShardOwnershipLostError
+    return 
+  }
+  value = result.GetSuccess()
+  return
+}
+
+type HistoryServiceProcessor struct {
+  processorMap map[string]thrift.TProcessorFunction
+  handler HistoryService
+}
+
+func (p *HistoryServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+  p.processorMap[key] = processor
+}
+
+func (p *HistoryServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+  processor, ok = p.processorMap[key]
+  return processor, ok
+}
+
+func (p *HistoryServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+  return p.processorMap
+}
+
+func NewHistoryServiceProcessor(handler HistoryService) *HistoryServiceProcessor {
+
+  self28 := &HistoryServiceProcessor{handler:handler, processorMap:make(map[string]thrift.TProcessorFunction)}
+  self28.processorMap["StartWorkflowExecution"] = &historyServiceProcessorStartWorkflowExecution{handler:handler}
+  self28.processorMap["GetWorkflowExecutionNextEventID"] = &historyServiceProcessorGetWorkflowExecutionNextEventID{handler:handler}
+  self28.processorMap["RecordDecisionTaskStarted"] = &historyServiceProcessorRecordDecisionTaskStarted{handler:handler}
+  self28.processorMap["RecordActivityTaskStarted"] = &historyServiceProcessorRecordActivityTaskStarted{handler:handler}
+  self28.processorMap["RespondDecisionTaskCompleted"] = &historyServiceProcessorRespondDecisionTaskCompleted{handler:handler}
+  self28.processorMap["RecordActivityTaskHeartbeat"] = &historyServiceProcessorRecordActivityTaskHeartbeat{handler:handler}
+  self28.processorMap["RespondActivityTaskCompleted"] = &historyServiceProcessorRespondActivityTaskCompleted{handler:handler}
+  self28.processorMap["RespondActivityTaskFailed"] = &historyServiceProcessorRespondActivityTaskFailed{handler:handler}
+  self28.processorMap["RespondActivityTaskCanceled"] = &historyServiceProcessorRespondActivityTaskCanceled{handler:handler}
+  self28.processorMap["SignalWorkflowExecution"] = &historyServiceProcessorSignalWorkflowExecution{handler:handler}
+  self28.processorMap["TerminateWorkflowExecution"] = &historyServiceProcessorTerminateWorkflowExecution{handler:handler}
+  self28.processorMap["RequestCancelWorkflowExecution"] = &historyServiceProcessorRequestCancelWorkflowExecution{handler:handler}
+  self28.processorMap["ScheduleDecisionTask"] = &historyServiceProcessorScheduleDecisionTask{handler:handler}
+  self28.processorMap["RecordChildExecutionCompleted"] = &historyServiceProcessorRecordChildExecutionCompleted{handler:handler}
+  self28.processorMap["DescribeShard"] = &historyServiceProcessorDescribeShard{handler:handler}
+  self28.processorMap["InvalidateMutableState"] = &historyServiceProcessorInvalidateMutableState{handler:handler}
+  self28.processorMap["SetTaskProcessingPaused"] = &historyServiceProcessorSetTaskProcessingPaused{handler:handler}
+  self28.processorMap["ListConcreteExecutions"] = &historyServiceProcessorListConcreteExecutions{handler:handler}
+  self28.processorMap["SignalWithStartWorkflowExecution"] = &historyServiceProcessorSignalWithStartWorkflowExecution{handler:handler}
+  self28.processorMap["ResetWorkflowExecution"] = &historyServiceProcessorResetWorkflowExecution{handler:handler}
+return self28
+}
+
+func (p *HistoryServiceProcessor) Process(iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  name, _, seqId, err := iprot.ReadMessageBegin()
+  if err != nil { return false, err }
+  if processor, ok := p.GetProcessorFunction(name); ok {
+    return processor.Process(seqId, iprot, oprot)
+  }
+  iprot.Skip(thrift.STRUCT)
+  iprot.ReadMessageEnd()
+  x29 := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function " + name)
+  oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+  x29.Write(oprot)
+  oprot.WriteMessageEnd()
+  oprot.Flush()
+  return false, x29
+
+}
+
+type historyServiceProcessorStartWorkflowExecution struct {
+  handler HistoryService
+}
+
+func (p *historyServiceProcessorStartWorkflowExecution) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := HistoryServiceStartWorkflowExecutionArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("StartWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := HistoryServiceStartWorkflowExecutionResult{}
+var retval *shared.StartWorkflowExecutionResponse
+  var err2 error
+  if retval, err2 = p.handler.StartWorkflowExecution(args.StartRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.WorkflowExecutionAlreadyStartedError:
+  result.SessionAlreadyExistError = v
+    case *ShardOwnershipLostError:
+  result.ShardOwnershipLostError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing StartWorkflowExecution: " + err2.Error())
+    oprot.WriteMessageBegin("StartWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("StartWorkflowExecution", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type historyServiceProcessorGetWorkflowExecutionNextEventID struct {
+  handler HistoryService
+}
+
+func (p *historyServiceProcessorGetWorkflowExecutionNextEventID) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := HistoryServiceGetWorkflowExecutionNextEventIDArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("GetWorkflowExecutionNextEventID", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := HistoryServiceGetWorkflowExecutionNextEventIDResult{}
+var retval *GetWorkflowExecutionNextEventIDResponse
+  var err2 error
+  if retval, err2 = p.handler.GetWorkflowExecutionNextEventID(args.GetRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    case *ShardOwnershipLostError:
+  result.ShardOwnershipLostError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetWorkflowExecutionNextEventID: " + err2.Error())
+    oprot.WriteMessageBegin("GetWorkflowExecutionNextEventID", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("GetWorkflowExecutionNextEventID", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type historyServiceProcessorRecordDecisionTaskStarted struct {
+  handler HistoryService
+}
+
+func (p *historyServiceProcessorRecordDecisionTaskStarted) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := HistoryServiceRecordDecisionTaskStartedArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("RecordDecisionTaskStarted", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := HistoryServiceRecordDecisionTaskStartedResult{}
+var retval *RecordDecisionTaskStartedResponse
+  var err2 error
+  if retval, err2 = p.handler.RecordDecisionTaskStarted(args.AddRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *EventAlreadyStartedError:
+  result.EventAlreadyStartedError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    case *ShardOwnershipLostError:
+  result.ShardOwnershipLostError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RecordDecisionTaskStarted: " + err2.Error())
+    oprot.WriteMessageBegin("RecordDecisionTaskStarted", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("RecordDecisionTaskStarted", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type historyServiceProcessorRecordActivityTaskStarted struct {
+  handler HistoryService
+}
+
+func (p *historyServiceProcessorRecordActivityTaskStarted) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := HistoryServiceRecordActivityTaskStartedArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("RecordActivityTaskStarted", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := HistoryServiceRecordActivityTaskStartedResult{}
+var retval *RecordActivityTaskStartedResponse
+  var err2 error
+  if retval, err2 = p.handler.RecordActivityTaskStarted(args.AddRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *EventAlreadyStartedError:
+  result.EventAlreadyStartedError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    case *ShardOwnershipLostError:
+  result.ShardOwnershipLostError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RecordActivityTaskStarted: " + err2.Error())
+    oprot.WriteMessageBegin("RecordActivityTaskStarted", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("RecordActivityTaskStarted", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type historyServiceProcessorRespondDecisionTaskCompleted struct {
+  handler HistoryService
+}
+
+func (p *historyServiceProcessorRespondDecisionTaskCompleted) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := HistoryServiceRespondDecisionTaskCompletedArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("RespondDecisionTaskCompleted", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := HistoryServiceRespondDecisionTaskCompletedResult{}
+var retval *shared.RespondDecisionTaskCompletedResponse
+  var err2 error
+  if retval, err2 = p.handler.RespondDecisionTaskCompleted(args.CompleteRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    case *ShardOwnershipLostError:
+  result.ShardOwnershipLostError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondDecisionTaskCompleted: " + err2.Error())
+    oprot.WriteMessageBegin("RespondDecisionTaskCompleted", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("RespondDecisionTaskCompleted", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type historyServiceProcessorRecordActivityTaskHeartbeat struct {
+  handler HistoryService
+}
+
+func (p *historyServiceProcessorRecordActivityTaskHeartbeat) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := HistoryServiceRecordActivityTaskHeartbeatArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("RecordActivityTaskHeartbeat", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := HistoryServiceRecordActivityTaskHeartbeatResult{}
+var retval *shared.RecordActivityTaskHeartbeatResponse
+  var err2 error
+  if retval, err2 = p.handler.RecordActivityTaskHeartbeat(args.HeartbeatRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    case *ShardOwnershipLostError:
+  result.ShardOwnershipLostError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RecordActivityTaskHeartbeat: " + err2.Error())
+    oprot.WriteMessageBegin("RecordActivityTaskHeartbeat", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("RecordActivityTaskHeartbeat", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type historyServiceProcessorRespondActivityTaskCompleted struct {
+  handler HistoryService
+}
+
+func (p *historyServiceProcessorRespondActivityTaskCompleted) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := HistoryServiceRespondActivityTaskCompletedArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("RespondActivityTaskCompleted", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := HistoryServiceRespondActivityTaskCompletedResult{}
+  var err2 error
+  if err2 = p.handler.RespondActivityTaskCompleted(args.CompleteRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    case *ShardOwnershipLostError:
+  result.ShardOwnershipLostError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondActivityTaskCompleted: " + err2.Error())
+    oprot.WriteMessageBegin("RespondActivityTaskCompleted", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("RespondActivityTaskCompleted", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type historyServiceProcessorRespondActivityTaskFailed struct {
+  handler HistoryService
+}
+
+func (p *historyServiceProcessorRespondActivityTaskFailed) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := HistoryServiceRespondActivityTaskFailedArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("RespondActivityTaskFailed", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := HistoryServiceRespondActivityTaskFailedResult{}
+  var err2 error
+  if err2 = p.handler.RespondActivityTaskFailed(args.FailRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    case *ShardOwnershipLostError:
+  result.ShardOwnershipLostError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondActivityTaskFailed: " + err2.Error())
+    oprot.WriteMessageBegin("RespondActivityTaskFailed", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("RespondActivityTaskFailed", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type historyServiceProcessorRespondActivityTaskCanceled struct {
+  handler HistoryService
+}
+
+func (p *historyServiceProcessorRespondActivityTaskCanceled) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := HistoryServiceRespondActivityTaskCanceledArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("RespondActivityTaskCanceled", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := HistoryServiceRespondActivityTaskCanceledResult{}
+  var err2 error
+  if err2 = p.handler.RespondActivityTaskCanceled(args.CanceledRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    case *ShardOwnershipLostError:
+  result.ShardOwnershipLostError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondActivityTaskCanceled: " + err2.Error())
+    oprot.WriteMessageBegin("RespondActivityTaskCanceled", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("RespondActivityTaskCanceled", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type historyServiceProcessorSignalWorkflowExecution struct {
+  handler HistoryService
+}
+
+func (p *historyServiceProcessorSignalWorkflowExecution) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := HistoryServiceSignalWorkflowExecutionArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("SignalWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := HistoryServiceSignalWorkflowExecutionResult{}
+  var err2 error
+  if err2 = p.handler.SignalWorkflowExecution(args.SignalRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    case *ShardOwnershipLostError:
+  result.ShardOwnershipLostError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SignalWorkflowExecution: " + err2.Error())
+    oprot.WriteMessageBegin("SignalWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("SignalWorkflowExecution", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type historyServiceProcessorTerminateWorkflowExecution struct {
+  handler HistoryService
+}
+
+func (p *historyServiceProcessorTerminateWorkflowExecution) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := HistoryServiceTerminateWorkflowExecutionArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("TerminateWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := HistoryServiceTerminateWorkflowExecutionResult{}
+  var err2 error
+  if err2 = p.handler.TerminateWorkflowExecution(args.TerminateRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    case *ShardOwnershipLostError:
+  result.ShardOwnershipLostError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TerminateWorkflowExecution: " + err2.Error())
+    oprot.WriteMessageBegin("TerminateWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("TerminateWorkflowExecution", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type historyServiceProcessorRequestCancelWorkflowExecution struct {
+  handler HistoryService
+}
+
+func (p *historyServiceProcessorRequestCancelWorkflowExecution) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := HistoryServiceRequestCancelWorkflowExecutionArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("RequestCancelWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := HistoryServiceRequestCancelWorkflowExecutionResult{}
+  var err2 error
   if err2 = p.handler.RequestCancelWorkflowExecution(args.CancelRequest); err2 != nil {
   switch v := err2.(type) {
     case *shared.BadRequestError:
@@ -5548,169 +8103,2647 @@ func (p *historyServiceProcessorRequestCancelWorkflowExecution) Process(seqId in
     case *ShardOwnershipLostError:
   result.ShardOwnershipLostError = v
     default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RequestCancelWorkflowExecution: " + err2.Error())
-    oprot.WriteMessageBegin("RequestCancelWorkflowExecution", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RequestCancelWorkflowExecution: " + err2.Error())
+    oprot.WriteMessageBegin("RequestCancelWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("RequestCancelWorkflowExecution", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type historyServiceProcessorScheduleDecisionTask struct {
+  handler HistoryService
+}
+
+func (p *historyServiceProcessorScheduleDecisionTask) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := HistoryServiceScheduleDecisionTaskArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("ScheduleDecisionTask", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := HistoryServiceScheduleDecisionTaskResult{}
+  var err2 error
+  if err2 = p.handler.ScheduleDecisionTask(args.ScheduleRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    case *ShardOwnershipLostError:
+  result.ShardOwnershipLostError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ScheduleDecisionTask: " + err2.Error())
+    oprot.WriteMessageBegin("ScheduleDecisionTask", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("ScheduleDecisionTask", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type historyServiceProcessorRecordChildExecutionCompleted struct {
+  handler HistoryService
+}
+
+func (p *historyServiceProcessorRecordChildExecutionCompleted) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := HistoryServiceRecordChildExecutionCompletedArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("RecordChildExecutionCompleted", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := HistoryServiceRecordChildExecutionCompletedResult{}
+  var err2 error
+  if err2 = p.handler.RecordChildExecutionCompleted(args.CompletionRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    case *ShardOwnershipLostError:
+  result.ShardOwnershipLostError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RecordChildExecutionCompleted: " + err2.Error())
+    oprot.WriteMessageBegin("RecordChildExecutionCompleted", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("RecordChildExecutionCompleted", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type historyServiceProcessorDescribeShard struct {
+  handler HistoryService
+}
+
+func (p *historyServiceProcessorDescribeShard) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := HistoryServiceDescribeShardArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("DescribeShard", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := HistoryServiceDescribeShardResult{}
+var retval *DescribeShardResponse
+  var err2 error
+  if retval, err2 = p.handler.DescribeShard(args.Request); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    case *ShardOwnershipLostError:
+  result.ShardOwnershipLostError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DescribeShard: " + err2.Error())
+    oprot.WriteMessageBegin("DescribeShard", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("DescribeShard", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type historyServiceProcessorInvalidateMutableState struct {
+  handler HistoryService
+}
+
+func (p *historyServiceProcessorInvalidateMutableState) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := HistoryServiceInvalidateMutableStateArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("InvalidateMutableState", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := HistoryServiceInvalidateMutableStateResult{}
+  var err2 error
+  if err2 = p.handler.InvalidateMutableState(args.Request); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    case *ShardOwnershipLostError:
+  result.ShardOwnershipLostError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing InvalidateMutableState: " + err2.Error())
+    oprot.WriteMessageBegin("InvalidateMutableState", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("InvalidateMutableState", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type historyServiceProcessorSetTaskProcessingPaused struct {
+  handler HistoryService
+}
+
+func (p *historyServiceProcessorSetTaskProcessingPaused) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := HistoryServiceSetTaskProcessingPausedArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("SetTaskProcessingPaused", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := HistoryServiceSetTaskProcessingPausedResult{}
+  var err2 error
+  if err2 = p.handler.SetTaskProcessingPaused(args.Request); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    case *ShardOwnershipLostError:
+  result.ShardOwnershipLostError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SetTaskProcessingPaused: " + err2.Error())
+    oprot.WriteMessageBegin("SetTaskProcessingPaused", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("SetTaskProcessingPaused", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type historyServiceProcessorListConcreteExecutions struct {
+  handler HistoryService
+}
+
+func (p *historyServiceProcessorListConcreteExecutions) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := HistoryServiceListConcreteExecutionsArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("ListConcreteExecutions", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := HistoryServiceListConcreteExecutionsResult{}
+var retval *ListConcreteExecutionsResponse
+  var err2 error
+  if retval, err2 = p.handler.ListConcreteExecutions(args.Request); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    case *ShardOwnershipLostError:
+  result.ShardOwnershipLostError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListConcreteExecutions: " + err2.Error())
+    oprot.WriteMessageBegin("ListConcreteExecutions", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("ListConcreteExecutions", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type historyServiceProcessorSignalWithStartWorkflowExecution struct {
+  handler HistoryService
+}
+
+func (p *historyServiceProcessorSignalWithStartWorkflowExecution) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := HistoryServiceSignalWithStartWorkflowExecutionArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("SignalWithStartWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := HistoryServiceSignalWithStartWorkflowExecutionResult{}
+var retval *shared.StartWorkflowExecutionResponse
+  var err2 error
+  if retval, err2 = p.handler.SignalWithStartWorkflowExecution(args.SignalWithStartRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.WorkflowExecutionAlreadyStartedError:
+  result.SessionAlreadyExistError = v
+    case *ShardOwnershipLostError:
+  result.ShardOwnershipLostError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SignalWithStartWorkflowExecution: " + err2.Error())
+    oprot.WriteMessageBegin("SignalWithStartWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("SignalWithStartWorkflowExecution", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type historyServiceProcessorResetWorkflowExecution struct {
+  handler HistoryService
+}
+
+func (p *historyServiceProcessorResetWorkflowExecution) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := HistoryServiceResetWorkflowExecutionArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("ResetWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := HistoryServiceResetWorkflowExecutionResult{}
+var retval *shared.ResetWorkflowExecutionResponse
+  var err2 error
+  if retval, err2 = p.handler.ResetWorkflowExecution(args.ResetRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    case *ShardOwnershipLostError:
+  result.ShardOwnershipLostError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ResetWorkflowExecution: " + err2.Error())
+    oprot.WriteMessageBegin("ResetWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("ResetWorkflowExecution", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+
+// HELPER FUNCTIONS AND STRUCTURES
+
+// Attributes:
+//  - StartRequest
+type HistoryServiceStartWorkflowExecutionArgs struct {
+  StartRequest *StartWorkflowExecutionRequest `thrift:"startRequest,1" db:"startRequest" json:"startRequest"`
+}
+
+func NewHistoryServiceStartWorkflowExecutionArgs() *HistoryServiceStartWorkflowExecutionArgs {
+  return &HistoryServiceStartWorkflowExecutionArgs{}
+}
+
+var HistoryServiceStartWorkflowExecutionArgs_StartRequest_DEFAULT *StartWorkflowExecutionRequest
+func (p *HistoryServiceStartWorkflowExecutionArgs) GetStartRequest() *StartWorkflowExecutionRequest {
+  if !p.IsSetStartRequest() {
+    return HistoryServiceStartWorkflowExecutionArgs_StartRequest_DEFAULT
+  }
+return p.StartRequest
+}
+func (p *HistoryServiceStartWorkflowExecutionArgs) IsSetStartRequest() bool {
+  return p.StartRequest != nil
+}
+
+func (p *HistoryServiceStartWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceStartWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.StartRequest = &StartWorkflowExecutionRequest{}
+  if err := p.StartRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.StartRequest), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceStartWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("StartWorkflowExecution_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *HistoryServiceStartWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("startRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:startRequest: ", p), err) }
+  if err := p.StartRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.StartRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:startRequest: ", p), err) }
+  return err
+}
+
+func (p *HistoryServiceStartWorkflowExecutionArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("HistoryServiceStartWorkflowExecutionArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - BadRequestError
+//  - InternalServiceError
+//  - SessionAlreadyExistError
+//  - ShardOwnershipLostError
+type HistoryServiceStartWorkflowExecutionResult struct {
+  Success *shared.StartWorkflowExecutionResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  SessionAlreadyExistError *shared.WorkflowExecutionAlreadyStartedError `thrift:"sessionAlreadyExistError,3" db:"sessionAlreadyExistError" json:"sessionAlreadyExistError,omitempty"`
+  ShardOwnershipLostError *ShardOwnershipLostError `thrift:"shardOwnershipLostError,4" db:"shardOwnershipLostError" json:"shardOwnershipLostError,omitempty"`
+}
+
+func NewHistoryServiceStartWorkflowExecutionResult() *HistoryServiceStartWorkflowExecutionResult {
+  return &HistoryServiceStartWorkflowExecutionResult{}
+}
+
+var HistoryServiceStartWorkflowExecutionResult_Success_DEFAULT *shared.StartWorkflowExecutionResponse
+func (p *HistoryServiceStartWorkflowExecutionResult) GetSuccess() *shared.StartWorkflowExecutionResponse {
+  if !p.IsSetSuccess() {
+    return HistoryServiceStartWorkflowExecutionResult_Success_DEFAULT
+  }
+return p.Success
+}
+var HistoryServiceStartWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *HistoryServiceStartWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return HistoryServiceStartWorkflowExecutionResult_BadRequestError_DEFAULT
+  }
+return p.BadRequestError
+}
+var HistoryServiceStartWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *HistoryServiceStartWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return HistoryServiceStartWorkflowExecutionResult_InternalServiceError_DEFAULT
+  }
+return p.InternalServiceError
+}
+var HistoryServiceStartWorkflowExecutionResult_SessionAlreadyExistError_DEFAULT *shared.WorkflowExecutionAlreadyStartedError
+func (p *HistoryServiceStartWorkflowExecutionResult) GetSessionAlreadyExistError() *shared.WorkflowExecutionAlreadyStartedError {
+  if !p.IsSetSessionAlreadyExistError() {
+    return HistoryServiceStartWorkflowExecutionResult_SessionAlreadyExistError_DEFAULT
+  }
+return p.SessionAlreadyExistError
+}
+var HistoryServiceStartWorkflowExecutionResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
+func (p *HistoryServiceStartWorkflowExecutionResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
+  if !p.IsSetShardOwnershipLostError() {
+    return HistoryServiceStartWorkflowExecutionResult_ShardOwnershipLostError_DEFAULT
+  }
+return p.ShardOwnershipLostError
+}
+func (p *HistoryServiceStartWorkflowExecutionResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *HistoryServiceStartWorkflowExecutionResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
+
+func (p *HistoryServiceStartWorkflowExecutionResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
+}
+
+func (p *HistoryServiceStartWorkflowExecutionResult) IsSetSessionAlreadyExistError() bool {
+  return p.SessionAlreadyExistError != nil
+}
+
+func (p *HistoryServiceStartWorkflowExecutionResult) IsSetShardOwnershipLostError() bool {
+  return p.ShardOwnershipLostError != nil
+}
+
+func (p *HistoryServiceStartWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
+    case 4:
+      if err := p.ReadField4(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceStartWorkflowExecutionResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.StartWorkflowExecutionResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceStartWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceStartWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceStartWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.SessionAlreadyExistError = &shared.WorkflowExecutionAlreadyStartedError{}
+  if err := p.SessionAlreadyExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.SessionAlreadyExistError), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceStartWorkflowExecutionResult)  ReadField4(iprot thrift.TProtocol) error {
+  p.ShardOwnershipLostError = &ShardOwnershipLostError{}
+  if err := p.ShardOwnershipLostError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ShardOwnershipLostError), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceStartWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("StartWorkflowExecution_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+    if err := p.writeField4(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *HistoryServiceStartWorkflowExecutionResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceStartWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceStartWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceStartWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSessionAlreadyExistError() {
+    if err := oprot.WriteFieldBegin("sessionAlreadyExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:sessionAlreadyExistError: ", p), err) }
+    if err := p.SessionAlreadyExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.SessionAlreadyExistError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:sessionAlreadyExistError: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceStartWorkflowExecutionResult) writeField4(oprot thrift.TProtocol) (err error) {
+  if p.IsSetShardOwnershipLostError() {
+    if err := oprot.WriteFieldBegin("shardOwnershipLostError", thrift.STRUCT, 4); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:shardOwnershipLostError: ", p), err) }
+    if err := p.ShardOwnershipLostError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ShardOwnershipLostError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 4:shardOwnershipLostError: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceStartWorkflowExecutionResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("HistoryServiceStartWorkflowExecutionResult(%+v)", *p)
+}
+
+// Attributes:
+//  - GetRequest
+type HistoryServiceGetWorkflowExecutionNextEventIDArgs struct {
+  GetRequest *GetWorkflowExecutionNextEventIDRequest `thrift:"getRequest,1" db:"getRequest" json:"getRequest"`
+}
+
+func NewHistoryServiceGetWorkflowExecutionNextEventIDArgs() *HistoryServiceGetWorkflowExecutionNextEventIDArgs {
+  return &HistoryServiceGetWorkflowExecutionNextEventIDArgs{}
+}
+
+var HistoryServiceGetWorkflowExecutionNextEventIDArgs_GetRequest_DEFAULT *GetWorkflowExecutionNextEventIDRequest
+func (p *HistoryServiceGetWorkflowExecutionNextEventIDArgs) GetGetRequest() *GetWorkflowExecutionNextEventIDRequest {
+  if !p.IsSetGetRequest() {
+    return HistoryServiceGetWorkflowExecutionNextEventIDArgs_GetRequest_DEFAULT
+  }
+return p.GetRequest
+}
+func (p *HistoryServiceGetWorkflowExecutionNextEventIDArgs) IsSetGetRequest() bool {
+  return p.GetRequest != nil
+}
+
+func (p *HistoryServiceGetWorkflowExecutionNextEventIDArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceGetWorkflowExecutionNextEventIDArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.GetRequest = &GetWorkflowExecutionNextEventIDRequest{}
+  if err := p.GetRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.GetRequest), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceGetWorkflowExecutionNextEventIDArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetWorkflowExecutionNextEventID_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *HistoryServiceGetWorkflowExecutionNextEventIDArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("getRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:getRequest: ", p), err) }
+  if err := p.GetRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.GetRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:getRequest: ", p), err) }
+  return err
+}
+
+func (p *HistoryServiceGetWorkflowExecutionNextEventIDArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("HistoryServiceGetWorkflowExecutionNextEventIDArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - BadRequestError
+//  - InternalServiceError
+//  - EntityNotExistError
+//  - ShardOwnershipLostError
+type HistoryServiceGetWorkflowExecutionNextEventIDResult struct {
+  Success *GetWorkflowExecutionNextEventIDResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+  ShardOwnershipLostError *ShardOwnershipLostError `thrift:"shardOwnershipLostError,4" db:"shardOwnershipLostError" json:"shardOwnershipLostError,omitempty"`
+}
+
+func NewHistoryServiceGetWorkflowExecutionNextEventIDResult() *HistoryServiceGetWorkflowExecutionNextEventIDResult {
+  return &HistoryServiceGetWorkflowExecutionNextEventIDResult{}
+}
+
+var HistoryServiceGetWorkflowExecutionNextEventIDResult_Success_DEFAULT *GetWorkflowExecutionNextEventIDResponse
+func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult) GetSuccess() *GetWorkflowExecutionNextEventIDResponse {
+  if !p.IsSetSuccess() {
+    return HistoryServiceGetWorkflowExecutionNextEventIDResult_Success_DEFAULT
+  }
+return p.Success
+}
+var HistoryServiceGetWorkflowExecutionNextEventIDResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return HistoryServiceGetWorkflowExecutionNextEventIDResult_BadRequestError_DEFAULT
+  }
+return p.BadRequestError
+}
+var HistoryServiceGetWorkflowExecutionNextEventIDResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return HistoryServiceGetWorkflowExecutionNextEventIDResult_InternalServiceError_DEFAULT
+  }
+return p.InternalServiceError
+}
+var HistoryServiceGetWorkflowExecutionNextEventIDResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return HistoryServiceGetWorkflowExecutionNextEventIDResult_EntityNotExistError_DEFAULT
+  }
+return p.EntityNotExistError
+}
+var HistoryServiceGetWorkflowExecutionNextEventIDResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
+func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
+  if !p.IsSetShardOwnershipLostError() {
+    return HistoryServiceGetWorkflowExecutionNextEventIDResult_ShardOwnershipLostError_DEFAULT
+  }
+return p.ShardOwnershipLostError
+}
+func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
+
+func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
+}
+
+func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
+}
+
+func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult) IsSetShardOwnershipLostError() bool {
+  return p.ShardOwnershipLostError != nil
+}
+
+func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
+    case 4:
+      if err := p.ReadField4(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &GetWorkflowExecutionNextEventIDResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult)  ReadField4(iprot thrift.TProtocol) error {
+  p.ShardOwnershipLostError = &ShardOwnershipLostError{}
+  if err := p.ShardOwnershipLostError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ShardOwnershipLostError), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetWorkflowExecutionNextEventID_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+    if err := p.writeField4(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult) writeField4(oprot thrift.TProtocol) (err error) {
+  if p.IsSetShardOwnershipLostError() {
+    if err := oprot.WriteFieldBegin("shardOwnershipLostError", thrift.STRUCT, 4); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:shardOwnershipLostError: ", p), err) }
+    if err := p.ShardOwnershipLostError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ShardOwnershipLostError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 4:shardOwnershipLostError: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("HistoryServiceGetWorkflowExecutionNextEventIDResult(%+v)", *p)
+}
+
+// Attributes:
+//  - AddRequest
+type HistoryServiceRecordDecisionTaskStartedArgs struct {
+  AddRequest *RecordDecisionTaskStartedRequest `thrift:"addRequest,1" db:"addRequest" json:"addRequest"`
+}
+
+func NewHistoryServiceRecordDecisionTaskStartedArgs() *HistoryServiceRecordDecisionTaskStartedArgs {
+  return &HistoryServiceRecordDecisionTaskStartedArgs{}
+}
+
+var HistoryServiceRecordDecisionTaskStartedArgs_AddRequest_DEFAULT *RecordDecisionTaskStartedRequest
+func (p *HistoryServiceRecordDecisionTaskStartedArgs) GetAddRequest() *RecordDecisionTaskStartedRequest {
+  if !p.IsSetAddRequest() {
+    return HistoryServiceRecordDecisionTaskStartedArgs_AddRequest_DEFAULT
+  }
+return p.AddRequest
+}
+func (p *HistoryServiceRecordDecisionTaskStartedArgs) IsSetAddRequest() bool {
+  return p.AddRequest != nil
+}
+
+func (p *HistoryServiceRecordDecisionTaskStartedArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceRecordDecisionTaskStartedArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.AddRequest = &RecordDecisionTaskStartedRequest{}
+  if err := p.AddRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.AddRequest), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceRecordDecisionTaskStartedArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RecordDecisionTaskStarted_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *HistoryServiceRecordDecisionTaskStartedArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("addRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:addRequest: ", p), err) }
+  if err := p.AddRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.AddRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:addRequest: ", p), err) }
+  return err
+}
+
+func (p *HistoryServiceRecordDecisionTaskStartedArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("HistoryServiceRecordDecisionTaskStartedArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - BadRequestError
+//  - InternalServiceError
+//  - EventAlreadyStartedError
+//  - EntityNotExistError
+//  - ShardOwnershipLostError
+type HistoryServiceRecordDecisionTaskStartedResult struct {
+  Success *RecordDecisionTaskStartedResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  EventAlreadyStartedError *EventAlreadyStartedError `thrift:"eventAlreadyStartedError,3" db:"eventAlreadyStartedError" json:"eventAlreadyStartedError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,4" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+  ShardOwnershipLostError *ShardOwnershipLostError `thrift:"shardOwnershipLostError,5" db:"shardOwnershipLostError" json:"shardOwnershipLostError,omitempty"`
+}
+
+func NewHistoryServiceRecordDecisionTaskStartedResult() *HistoryServiceRecordDecisionTaskStartedResult {
+  return &HistoryServiceRecordDecisionTaskStartedResult{}
+}
+
+var HistoryServiceRecordDecisionTaskStartedResult_Success_DEFAULT *RecordDecisionTaskStartedResponse
+func (p *HistoryServiceRecordDecisionTaskStartedResult) GetSuccess() *RecordDecisionTaskStartedResponse {
+  if !p.IsSetSuccess() {
+    return HistoryServiceRecordDecisionTaskStartedResult_Success_DEFAULT
+  }
+return p.Success
+}
+var HistoryServiceRecordDecisionTaskStartedResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *HistoryServiceRecordDecisionTaskStartedResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return HistoryServiceRecordDecisionTaskStartedResult_BadRequestError_DEFAULT
+  }
+return p.BadRequestError
+}
+var HistoryServiceRecordDecisionTaskStartedResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *HistoryServiceRecordDecisionTaskStartedResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return HistoryServiceRecordDecisionTaskStartedResult_InternalServiceError_DEFAULT
+  }
+return p.InternalServiceError
+}
+var HistoryServiceRecordDecisionTaskStartedResult_EventAlreadyStartedError_DEFAULT *EventAlreadyStartedError
+func (p *HistoryServiceRecordDecisionTaskStartedResult) GetEventAlreadyStartedError() *EventAlreadyStartedError {
+  if !p.IsSetEventAlreadyStartedError() {
+    return HistoryServiceRecordDecisionTaskStartedResult_EventAlreadyStartedError_DEFAULT
+  }
+return p.EventAlreadyStartedError
+}
+var HistoryServiceRecordDecisionTaskStartedResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *HistoryServiceRecordDecisionTaskStartedResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return HistoryServiceRecordDecisionTaskStartedResult_EntityNotExistError_DEFAULT
+  }
+return p.EntityNotExistError
+}
+var HistoryServiceRecordDecisionTaskStartedResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
+func (p *HistoryServiceRecordDecisionTaskStartedResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
+  if !p.IsSetShardOwnershipLostError() {
+    return HistoryServiceRecordDecisionTaskStartedResult_ShardOwnershipLostError_DEFAULT
+  }
+return p.ShardOwnershipLostError
+}
+func (p *HistoryServiceRecordDecisionTaskStartedResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *HistoryServiceRecordDecisionTaskStartedResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
+
+func (p *HistoryServiceRecordDecisionTaskStartedResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
+}
+
+func (p *HistoryServiceRecordDecisionTaskStartedResult) IsSetEventAlreadyStartedError() bool {
+  return p.EventAlreadyStartedError != nil
+}
+
+func (p *HistoryServiceRecordDecisionTaskStartedResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
+}
+
+func (p *HistoryServiceRecordDecisionTaskStartedResult) IsSetShardOwnershipLostError() bool {
+  return p.ShardOwnershipLostError != nil
+}
+
+func (p *HistoryServiceRecordDecisionTaskStartedResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
+    case 4:
+      if err := p.ReadField4(iprot); err != nil {
+        return err
+      }
+    case 5:
+      if err := p.ReadField5(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceRecordDecisionTaskStartedResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &RecordDecisionTaskStartedResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceRecordDecisionTaskStartedResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceRecordDecisionTaskStartedResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceRecordDecisionTaskStartedResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EventAlreadyStartedError = &EventAlreadyStartedError{}
+  if err := p.EventAlreadyStartedError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EventAlreadyStartedError), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceRecordDecisionTaskStartedResult)  ReadField4(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceRecordDecisionTaskStartedResult)  ReadField5(iprot thrift.TProtocol) error {
+  p.ShardOwnershipLostError = &ShardOwnershipLostError{}
+  if err := p.ShardOwnershipLostError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ShardOwnershipLostError), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceRecordDecisionTaskStartedResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RecordDecisionTaskStarted_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+    if err := p.writeField4(oprot); err != nil { return err }
+    if err := p.writeField5(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *HistoryServiceRecordDecisionTaskStartedResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceRecordDecisionTaskStartedResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceRecordDecisionTaskStartedResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceRecordDecisionTaskStartedResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEventAlreadyStartedError() {
+    if err := oprot.WriteFieldBegin("eventAlreadyStartedError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:eventAlreadyStartedError: ", p), err) }
+    if err := p.EventAlreadyStartedError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EventAlreadyStartedError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:eventAlreadyStartedError: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceRecordDecisionTaskStartedResult) writeField4(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 4); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 4:entityNotExistError: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceRecordDecisionTaskStartedResult) writeField5(oprot thrift.TProtocol) (err error) {
+  if p.IsSetShardOwnershipLostError() {
+    if err := oprot.WriteFieldBegin("shardOwnershipLostError", thrift.STRUCT, 5); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:shardOwnershipLostError: ", p), err) }
+    if err := p.ShardOwnershipLostError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ShardOwnershipLostError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 5:shardOwnershipLostError: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceRecordDecisionTaskStartedResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("HistoryServiceRecordDecisionTaskStartedResult(%+v)", *p)
+}
+
+// Attributes:
+//  - AddRequest
+type HistoryServiceRecordActivityTaskStartedArgs struct {
+  AddRequest *RecordActivityTaskStartedRequest `thrift:"addRequest,1" db:"addRequest" json:"addRequest"`
+}
+
+func NewHistoryServiceRecordActivityTaskStartedArgs() *HistoryServiceRecordActivityTaskStartedArgs {
+  return &HistoryServiceRecordActivityTaskStartedArgs{}
+}
+
+var HistoryServiceRecordActivityTaskStartedArgs_AddRequest_DEFAULT *RecordActivityTaskStartedRequest
+func (p *HistoryServiceRecordActivityTaskStartedArgs) GetAddRequest() *RecordActivityTaskStartedRequest {
+  if !p.IsSetAddRequest() {
+    return HistoryServiceRecordActivityTaskStartedArgs_AddRequest_DEFAULT
+  }
+return p.AddRequest
+}
+func (p *HistoryServiceRecordActivityTaskStartedArgs) IsSetAddRequest() bool {
+  return p.AddRequest != nil
+}
+
+func (p *HistoryServiceRecordActivityTaskStartedArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceRecordActivityTaskStartedArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.AddRequest = &RecordActivityTaskStartedRequest{}
+  if err := p.AddRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.AddRequest), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceRecordActivityTaskStartedArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RecordActivityTaskStarted_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *HistoryServiceRecordActivityTaskStartedArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("addRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:addRequest: ", p), err) }
+  if err := p.AddRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.AddRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:addRequest: ", p), err) }
+  return err
+}
+
+func (p *HistoryServiceRecordActivityTaskStartedArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("HistoryServiceRecordActivityTaskStartedArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - BadRequestError
+//  - InternalServiceError
+//  - EventAlreadyStartedError
+//  - EntityNotExistError
+//  - ShardOwnershipLostError
+type HistoryServiceRecordActivityTaskStartedResult struct {
+  Success *RecordActivityTaskStartedResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  EventAlreadyStartedError *EventAlreadyStartedError `thrift:"eventAlreadyStartedError,3" db:"eventAlreadyStartedError" json:"eventAlreadyStartedError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,4" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+  ShardOwnershipLostError *ShardOwnershipLostError `thrift:"shardOwnershipLostError,5" db:"shardOwnershipLostError" json:"shardOwnershipLostError,omitempty"`
+}
+
+func NewHistoryServiceRecordActivityTaskStartedResult() *HistoryServiceRecordActivityTaskStartedResult {
+  return &HistoryServiceRecordActivityTaskStartedResult{}
+}
+
+var HistoryServiceRecordActivityTaskStartedResult_Success_DEFAULT *RecordActivityTaskStartedResponse
+func (p *HistoryServiceRecordActivityTaskStartedResult) GetSuccess() *RecordActivityTaskStartedResponse {
+  if !p.IsSetSuccess() {
+    return HistoryServiceRecordActivityTaskStartedResult_Success_DEFAULT
+  }
+return p.Success
+}
+var HistoryServiceRecordActivityTaskStartedResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *HistoryServiceRecordActivityTaskStartedResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return HistoryServiceRecordActivityTaskStartedResult_BadRequestError_DEFAULT
+  }
+return p.BadRequestError
+}
+var HistoryServiceRecordActivityTaskStartedResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *HistoryServiceRecordActivityTaskStartedResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return HistoryServiceRecordActivityTaskStartedResult_InternalServiceError_DEFAULT
+  }
+return p.InternalServiceError
+}
+var HistoryServiceRecordActivityTaskStartedResult_EventAlreadyStartedError_DEFAULT *EventAlreadyStartedError
+func (p *HistoryServiceRecordActivityTaskStartedResult) GetEventAlreadyStartedError() *EventAlreadyStartedError {
+  if !p.IsSetEventAlreadyStartedError() {
+    return HistoryServiceRecordActivityTaskStartedResult_EventAlreadyStartedError_DEFAULT
+  }
+return p.EventAlreadyStartedError
+}
+var HistoryServiceRecordActivityTaskStartedResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *HistoryServiceRecordActivityTaskStartedResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return HistoryServiceRecordActivityTaskStartedResult_EntityNotExistError_DEFAULT
+  }
+return p.EntityNotExistError
+}
+var HistoryServiceRecordActivityTaskStartedResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
+func (p *HistoryServiceRecordActivityTaskStartedResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
+  if !p.IsSetShardOwnershipLostError() {
+    return HistoryServiceRecordActivityTaskStartedResult_ShardOwnershipLostError_DEFAULT
+  }
+return p.ShardOwnershipLostError
+}
+func (p *HistoryServiceRecordActivityTaskStartedResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *HistoryServiceRecordActivityTaskStartedResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
+
+func (p *HistoryServiceRecordActivityTaskStartedResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
+}
+
+func (p *HistoryServiceRecordActivityTaskStartedResult) IsSetEventAlreadyStartedError() bool {
+  return p.EventAlreadyStartedError != nil
+}
+
+func (p *HistoryServiceRecordActivityTaskStartedResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
+}
+
+func (p *HistoryServiceRecordActivityTaskStartedResult) IsSetShardOwnershipLostError() bool {
+  return p.ShardOwnershipLostError != nil
+}
+
+func (p *HistoryServiceRecordActivityTaskStartedResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
+    case 4:
+      if err := p.ReadField4(iprot); err != nil {
+        return err
+      }
+    case 5:
+      if err := p.ReadField5(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceRecordActivityTaskStartedResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &RecordActivityTaskStartedResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceRecordActivityTaskStartedResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceRecordActivityTaskStartedResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceRecordActivityTaskStartedResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EventAlreadyStartedError = &EventAlreadyStartedError{}
+  if err := p.EventAlreadyStartedError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EventAlreadyStartedError), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceRecordActivityTaskStartedResult)  ReadField4(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceRecordActivityTaskStartedResult)  ReadField5(iprot thrift.TProtocol) error {
+  p.ShardOwnershipLostError = &ShardOwnershipLostError{}
+  if err := p.ShardOwnershipLostError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ShardOwnershipLostError), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceRecordActivityTaskStartedResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RecordActivityTaskStarted_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+    if err := p.writeField4(oprot); err != nil { return err }
+    if err := p.writeField5(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *HistoryServiceRecordActivityTaskStartedResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceRecordActivityTaskStartedResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceRecordActivityTaskStartedResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceRecordActivityTaskStartedResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEventAlreadyStartedError() {
+    if err := oprot.WriteFieldBegin("eventAlreadyStartedError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:eventAlreadyStartedError: ", p), err) }
+    if err := p.EventAlreadyStartedError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EventAlreadyStartedError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:eventAlreadyStartedError: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceRecordActivityTaskStartedResult) writeField4(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 4); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 4:entityNotExistError: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceRecordActivityTaskStartedResult) writeField5(oprot thrift.TProtocol) (err error) {
+  if p.IsSetShardOwnershipLostError() {
+    if err := oprot.WriteFieldBegin("shardOwnershipLostError", thrift.STRUCT, 5); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:shardOwnershipLostError: ", p), err) }
+    if err := p.ShardOwnershipLostError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ShardOwnershipLostError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 5:shardOwnershipLostError: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceRecordActivityTaskStartedResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("HistoryServiceRecordActivityTaskStartedResult(%+v)", *p)
+}
+
+// Attributes:
+//  - CompleteRequest
+type HistoryServiceRespondDecisionTaskCompletedArgs struct {
+  CompleteRequest *RespondDecisionTaskCompletedRequest `thrift:"completeRequest,1" db:"completeRequest" json:"completeRequest"`
+}
+
+func NewHistoryServiceRespondDecisionTaskCompletedArgs() *HistoryServiceRespondDecisionTaskCompletedArgs {
+  return &HistoryServiceRespondDecisionTaskCompletedArgs{}
+}
+
+var HistoryServiceRespondDecisionTaskCompletedArgs_CompleteRequest_DEFAULT *RespondDecisionTaskCompletedRequest
+func (p *HistoryServiceRespondDecisionTaskCompletedArgs) GetCompleteRequest() *RespondDecisionTaskCompletedRequest {
+  if !p.IsSetCompleteRequest() {
+    return HistoryServiceRespondDecisionTaskCompletedArgs_CompleteRequest_DEFAULT
+  }
+return p.CompleteRequest
+}
+func (p *HistoryServiceRespondDecisionTaskCompletedArgs) IsSetCompleteRequest() bool {
+  return p.CompleteRequest != nil
+}
+
+func (p *HistoryServiceRespondDecisionTaskCompletedArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceRespondDecisionTaskCompletedArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.CompleteRequest = &RespondDecisionTaskCompletedRequest{}
+  if err := p.CompleteRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CompleteRequest), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceRespondDecisionTaskCompletedArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondDecisionTaskCompleted_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *HistoryServiceRespondDecisionTaskCompletedArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("completeRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:completeRequest: ", p), err) }
+  if err := p.CompleteRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CompleteRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:completeRequest: ", p), err) }
+  return err
+}
+
+func (p *HistoryServiceRespondDecisionTaskCompletedArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("HistoryServiceRespondDecisionTaskCompletedArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - BadRequestError
+//  - InternalServiceError
+//  - EntityNotExistError
+//  - ShardOwnershipLostError
+type HistoryServiceRespondDecisionTaskCompletedResult struct {
+  Success *shared.RespondDecisionTaskCompletedResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+  ShardOwnershipLostError *ShardOwnershipLostError `thrift:"shardOwnershipLostError,4" db:"shardOwnershipLostError" json:"shardOwnershipLostError,omitempty"`
+}
+
+func NewHistoryServiceRespondDecisionTaskCompletedResult() *HistoryServiceRespondDecisionTaskCompletedResult {
+  return &HistoryServiceRespondDecisionTaskCompletedResult{}
+}
+
+var HistoryServiceRespondDecisionTaskCompletedResult_Success_DEFAULT *shared.RespondDecisionTaskCompletedResponse
+func (p *HistoryServiceRespondDecisionTaskCompletedResult) GetSuccess() *shared.RespondDecisionTaskCompletedResponse {
+  if !p.IsSetSuccess() {
+    return HistoryServiceRespondDecisionTaskCompletedResult_Success_DEFAULT
+  }
+return p.Success
+}
+var HistoryServiceRespondDecisionTaskCompletedResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *HistoryServiceRespondDecisionTaskCompletedResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return HistoryServiceRespondDecisionTaskCompletedResult_BadRequestError_DEFAULT
+  }
+return p.BadRequestError
+}
+var HistoryServiceRespondDecisionTaskCompletedResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *HistoryServiceRespondDecisionTaskCompletedResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return HistoryServiceRespondDecisionTaskCompletedResult_InternalServiceError_DEFAULT
+  }
+return p.InternalServiceError
+}
+var HistoryServiceRespondDecisionTaskCompletedResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *HistoryServiceRespondDecisionTaskCompletedResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return HistoryServiceRespondDecisionTaskCompletedResult_EntityNotExistError_DEFAULT
+  }
+return p.EntityNotExistError
+}
+var HistoryServiceRespondDecisionTaskCompletedResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
+func (p *HistoryServiceRespondDecisionTaskCompletedResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
+  if !p.IsSetShardOwnershipLostError() {
+    return HistoryServiceRespondDecisionTaskCompletedResult_ShardOwnershipLostError_DEFAULT
+  }
+return p.ShardOwnershipLostError
+}
+func (p *HistoryServiceRespondDecisionTaskCompletedResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *HistoryServiceRespondDecisionTaskCompletedResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
+
+func (p *HistoryServiceRespondDecisionTaskCompletedResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
+}
+
+func (p *HistoryServiceRespondDecisionTaskCompletedResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
+}
+
+func (p *HistoryServiceRespondDecisionTaskCompletedResult) IsSetShardOwnershipLostError() bool {
+  return p.ShardOwnershipLostError != nil
+}
+
+func (p *HistoryServiceRespondDecisionTaskCompletedResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
+    case 4:
+      if err := p.ReadField4(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceRespondDecisionTaskCompletedResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.RespondDecisionTaskCompletedResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceRespondDecisionTaskCompletedResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceRespondDecisionTaskCompletedResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceRespondDecisionTaskCompletedResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceRespondDecisionTaskCompletedResult)  ReadField4(iprot thrift.TProtocol) error {
+  p.ShardOwnershipLostError = &ShardOwnershipLostError{}
+  if err := p.ShardOwnershipLostError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ShardOwnershipLostError), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceRespondDecisionTaskCompletedResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondDecisionTaskCompleted_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+    if err := p.writeField4(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *HistoryServiceRespondDecisionTaskCompletedResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceRespondDecisionTaskCompletedResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceRespondDecisionTaskCompletedResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceRespondDecisionTaskCompletedResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceRespondDecisionTaskCompletedResult) writeField4(oprot thrift.TProtocol) (err error) {
+  if p.IsSetShardOwnershipLostError() {
+    if err := oprot.WriteFieldBegin("shardOwnershipLostError", thrift.STRUCT, 4); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:shardOwnershipLostError: ", p), err) }
+    if err := p.ShardOwnershipLostError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ShardOwnershipLostError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 4:shardOwnershipLostError: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceRespondDecisionTaskCompletedResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("HistoryServiceRespondDecisionTaskCompletedResult(%+v)", *p)
+}
+
+// Attributes:
+//  - HeartbeatRequest
+type HistoryServiceRecordActivityTaskHeartbeatArgs struct {
+  HeartbeatRequest *RecordActivityTaskHeartbeatRequest `thrift:"heartbeatRequest,1" db:"heartbeatRequest" json:"heartbeatRequest"`
+}
+
+func NewHistoryServiceRecordActivityTaskHeartbeatArgs() *HistoryServiceRecordActivityTaskHeartbeatArgs {
+  return &HistoryServiceRecordActivityTaskHeartbeatArgs{}
+}
+
+var HistoryServiceRecordActivityTaskHeartbeatArgs_HeartbeatRequest_DEFAULT *RecordActivityTaskHeartbeatRequest
+func (p *HistoryServiceRecordActivityTaskHeartbeatArgs) GetHeartbeatRequest() *RecordActivityTaskHeartbeatRequest {
+  if !p.IsSetHeartbeatRequest() {
+    return HistoryServiceRecordActivityTaskHeartbeatArgs_HeartbeatRequest_DEFAULT
+  }
+return p.HeartbeatRequest
+}
+func (p *HistoryServiceRecordActivityTaskHeartbeatArgs) IsSetHeartbeatRequest() bool {
+  return p.HeartbeatRequest != nil
+}
+
+func (p *HistoryServiceRecordActivityTaskHeartbeatArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
   }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
   }
-  if err2 = oprot.WriteMessageBegin("RequestCancelWorkflowExecution", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+  return nil
+}
+
+func (p *HistoryServiceRecordActivityTaskHeartbeatArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.HeartbeatRequest = &RecordActivityTaskHeartbeatRequest{}
+  if err := p.HeartbeatRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.HeartbeatRequest), err)
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  return nil
+}
+
+func (p *HistoryServiceRecordActivityTaskHeartbeatArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RecordActivityTaskHeartbeat_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *HistoryServiceRecordActivityTaskHeartbeatArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("heartbeatRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:heartbeatRequest: ", p), err) }
+  if err := p.HeartbeatRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.HeartbeatRequest), err)
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:heartbeatRequest: ", p), err) }
+  return err
+}
+
+func (p *HistoryServiceRecordActivityTaskHeartbeatArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("HistoryServiceRecordActivityTaskHeartbeatArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - BadRequestError
+//  - InternalServiceError
+//  - EntityNotExistError
+//  - ShardOwnershipLostError
+type HistoryServiceRecordActivityTaskHeartbeatResult struct {
+  Success *shared.RecordActivityTaskHeartbeatResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+  ShardOwnershipLostError *ShardOwnershipLostError `thrift:"shardOwnershipLostError,4" db:"shardOwnershipLostError" json:"shardOwnershipLostError,omitempty"`
+}
+
+func NewHistoryServiceRecordActivityTaskHeartbeatResult() *HistoryServiceRecordActivityTaskHeartbeatResult {
+  return &HistoryServiceRecordActivityTaskHeartbeatResult{}
+}
+
+var HistoryServiceRecordActivityTaskHeartbeatResult_Success_DEFAULT *shared.RecordActivityTaskHeartbeatResponse
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult) GetSuccess() *shared.RecordActivityTaskHeartbeatResponse {
+  if !p.IsSetSuccess() {
+    return HistoryServiceRecordActivityTaskHeartbeatResult_Success_DEFAULT
+  }
+return p.Success
+}
+var HistoryServiceRecordActivityTaskHeartbeatResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return HistoryServiceRecordActivityTaskHeartbeatResult_BadRequestError_DEFAULT
+  }
+return p.BadRequestError
+}
+var HistoryServiceRecordActivityTaskHeartbeatResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return HistoryServiceRecordActivityTaskHeartbeatResult_InternalServiceError_DEFAULT
+  }
+return p.InternalServiceError
+}
+var HistoryServiceRecordActivityTaskHeartbeatResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return HistoryServiceRecordActivityTaskHeartbeatResult_EntityNotExistError_DEFAULT
+  }
+return p.EntityNotExistError
+}
+var HistoryServiceRecordActivityTaskHeartbeatResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
+  if !p.IsSetShardOwnershipLostError() {
+    return HistoryServiceRecordActivityTaskHeartbeatResult_ShardOwnershipLostError_DEFAULT
+  }
+return p.ShardOwnershipLostError
+}
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
+
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
+}
+
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
+}
+
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult) IsSetShardOwnershipLostError() bool {
+  return p.ShardOwnershipLostError != nil
+}
+
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
+    case 4:
+      if err := p.ReadField4(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
   }
-  if err != nil {
-    return
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
   }
-  return true, err
+  return nil
 }
 
-type historyServiceProcessorScheduleDecisionTask struct {
-  handler HistoryService
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.RecordActivityTaskHeartbeatResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
 }
 
-func (p *historyServiceProcessorScheduleDecisionTask) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := HistoryServiceScheduleDecisionTaskArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("ScheduleDecisionTask", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
   }
+  return nil
+}
 
-  iprot.ReadMessageEnd()
-  result := HistoryServiceScheduleDecisionTaskResult{}
-  var err2 error
-  if err2 = p.handler.ScheduleDecisionTask(args.ScheduleRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    case *ShardOwnershipLostError:
-  result.ShardOwnershipLostError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ScheduleDecisionTask: " + err2.Error())
-    oprot.WriteMessageBegin("ScheduleDecisionTask", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
-  }
-  }
-  if err2 = oprot.WriteMessageBegin("ScheduleDecisionTask", thrift.REPLY, seqId); err2 != nil {
-    err = err2
-  }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
-  }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
-  }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
-  }
-  if err != nil {
-    return
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
   }
-  return true, err
+  return nil
 }
 
-type historyServiceProcessorRecordChildExecutionCompleted struct {
-  handler HistoryService
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
+  }
+  return nil
 }
 
-func (p *historyServiceProcessorRecordChildExecutionCompleted) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := HistoryServiceRecordChildExecutionCompletedArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("RecordChildExecutionCompleted", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult)  ReadField4(iprot thrift.TProtocol) error {
+  p.ShardOwnershipLostError = &ShardOwnershipLostError{}
+  if err := p.ShardOwnershipLostError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ShardOwnershipLostError), err)
   }
+  return nil
+}
 
-  iprot.ReadMessageEnd()
-  result := HistoryServiceRecordChildExecutionCompletedResult{}
-  var err2 error
-  if err2 = p.handler.RecordChildExecutionCompleted(args.CompletionRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    case *ShardOwnershipLostError:
-  result.ShardOwnershipLostError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RecordChildExecutionCompleted: " + err2.Error())
-    oprot.WriteMessageBegin("RecordChildExecutionCompleted", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
-  }
-  }
-  if err2 = oprot.WriteMessageBegin("RecordChildExecutionCompleted", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RecordActivityTaskHeartbeat_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+    if err := p.writeField4(oprot); err != nil { return err }
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  return err
+}
+
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  return err
+}
+
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
   }
-  if err != nil {
-    return
+  return err
+}
+
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
   }
-  return true, err
+  return err
 }
 
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult) writeField4(oprot thrift.TProtocol) (err error) {
+  if p.IsSetShardOwnershipLostError() {
+    if err := oprot.WriteFieldBegin("shardOwnershipLostError", thrift.STRUCT, 4); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:shardOwnershipLostError: ", p), err) }
+    if err := p.ShardOwnershipLostError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ShardOwnershipLostError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 4:shardOwnershipLostError: ", p), err) }
+  }
+  return err
+}
 
-// HELPER FUNCTIONS AND STRUCTURES
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("HistoryServiceRecordActivityTaskHeartbeatResult(%+v)", *p)
+}
 
 // Attributes:
-//  - StartRequest
-type HistoryServiceStartWorkflowExecutionArgs struct {
-  StartRequest *StartWorkflowExecutionRequest `thrift:"startRequest,1" db:"startRequest" json:"startRequest"`
+//  - CompleteRequest
+type HistoryServiceRespondActivityTaskCompletedArgs struct {
+  CompleteRequest *RespondActivityTaskCompletedRequest `thrift:"completeRequest,1" db:"completeRequest" json:"completeRequest"`
 }
 
-func NewHistoryServiceStartWorkflowExecutionArgs() *HistoryServiceStartWorkflowExecutionArgs {
-  return &HistoryServiceStartWorkflowExecutionArgs{}
+func NewHistoryServiceRespondActivityTaskCompletedArgs() *HistoryServiceRespondActivityTaskCompletedArgs {
+  return &HistoryServiceRespondActivityTaskCompletedArgs{}
 }
 
-var HistoryServiceStartWorkflowExecutionArgs_StartRequest_DEFAULT *StartWorkflowExecutionRequest
-func (p *HistoryServiceStartWorkflowExecutionArgs) GetStartRequest() *StartWorkflowExecutionRequest {
-  if !p.IsSetStartRequest() {
-    return HistoryServiceStartWorkflowExecutionArgs_StartRequest_DEFAULT
+var HistoryServiceRespondActivityTaskCompletedArgs_CompleteRequest_DEFAULT *RespondActivityTaskCompletedRequest
+func (p *HistoryServiceRespondActivityTaskCompletedArgs) GetCompleteRequest() *RespondActivityTaskCompletedRequest {
+  if !p.IsSetCompleteRequest() {
+    return HistoryServiceRespondActivityTaskCompletedArgs_CompleteRequest_DEFAULT
   }
-return p.StartRequest
+return p.CompleteRequest
 }
-func (p *HistoryServiceStartWorkflowExecutionArgs) IsSetStartRequest() bool {
-  return p.StartRequest != nil
+func (p *HistoryServiceRespondActivityTaskCompletedArgs) IsSetCompleteRequest() bool {
+  return p.CompleteRequest != nil
 }
 
-func (p *HistoryServiceStartWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRespondActivityTaskCompletedArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -5739,19 +10772,19 @@ func (p *HistoryServiceStartWorkflowExecutionArgs) Read(iprot thrift.TProtocol)
   if err := iprot.ReadStructEnd(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
   }
-  return nil
-}
-
-func (p *HistoryServiceStartWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.StartRequest = &StartWorkflowExecutionRequest{}
-  if err := p.StartRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.StartRequest), err)
+  return nil
+}
+
+func (p *HistoryServiceRespondActivityTaskCompletedArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.CompleteRequest = &RespondActivityTaskCompletedRequest{}
+  if err := p.CompleteRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CompleteRequest), err)
   }
   return nil
 }
 
-func (p *HistoryServiceStartWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("StartWorkflowExecution_args"); err != nil {
+func (p *HistoryServiceRespondActivityTaskCompletedArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskCompleted_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -5763,98 +10796,85 @@ func (p *HistoryServiceStartWorkflowExecutionArgs) Write(oprot thrift.TProtocol)
   return nil
 }
 
-func (p *HistoryServiceStartWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("startRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:startRequest: ", p), err) }
-  if err := p.StartRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.StartRequest), err)
+func (p *HistoryServiceRespondActivityTaskCompletedArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("completeRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:completeRequest: ", p), err) }
+  if err := p.CompleteRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CompleteRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:startRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:completeRequest: ", p), err) }
   return err
 }
 
-func (p *HistoryServiceStartWorkflowExecutionArgs) String() string {
+func (p *HistoryServiceRespondActivityTaskCompletedArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceStartWorkflowExecutionArgs(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceRespondActivityTaskCompletedArgs(%+v)", *p)
 }
 
 // Attributes:
-//  - Success
 //  - BadRequestError
 //  - InternalServiceError
-//  - SessionAlreadyExistError
+//  - EntityNotExistError
 //  - ShardOwnershipLostError
-type HistoryServiceStartWorkflowExecutionResult struct {
-  Success *shared.StartWorkflowExecutionResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+type HistoryServiceRespondActivityTaskCompletedResult struct {
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
-  SessionAlreadyExistError *shared.WorkflowExecutionAlreadyStartedError `thrift:"sessionAlreadyExistError,3" db:"sessionAlreadyExistError" json:"sessionAlreadyExistError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
   ShardOwnershipLostError *ShardOwnershipLostError `thrift:"shardOwnershipLostError,4" db:"shardOwnershipLostError" json:"shardOwnershipLostError,omitempty"`
 }
 
-func NewHistoryServiceStartWorkflowExecutionResult() *HistoryServiceStartWorkflowExecutionResult {
-  return &HistoryServiceStartWorkflowExecutionResult{}
+func NewHistoryServiceRespondActivityTaskCompletedResult() *HistoryServiceRespondActivityTaskCompletedResult {
+  return &HistoryServiceRespondActivityTaskCompletedResult{}
 }
 
-var HistoryServiceStartWorkflowExecutionResult_Success_DEFAULT *shared.StartWorkflowExecutionResponse
-func (p *HistoryServiceStartWorkflowExecutionResult) GetSuccess() *shared.StartWorkflowExecutionResponse {
-  if !p.IsSetSuccess() {
-    return HistoryServiceStartWorkflowExecutionResult_Success_DEFAULT
-  }
-return p.Success
-}
-var HistoryServiceStartWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *HistoryServiceStartWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
+var HistoryServiceRespondActivityTaskCompletedResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *HistoryServiceRespondActivityTaskCompletedResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return HistoryServiceStartWorkflowExecutionResult_BadRequestError_DEFAULT
+    return HistoryServiceRespondActivityTaskCompletedResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var HistoryServiceStartWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *HistoryServiceStartWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
+var HistoryServiceRespondActivityTaskCompletedResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *HistoryServiceRespondActivityTaskCompletedResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return HistoryServiceStartWorkflowExecutionResult_InternalServiceError_DEFAULT
+    return HistoryServiceRespondActivityTaskCompletedResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var HistoryServiceStartWorkflowExecutionResult_SessionAlreadyExistError_DEFAULT *shared.WorkflowExecutionAlreadyStartedError
-func (p *HistoryServiceStartWorkflowExecutionResult) GetSessionAlreadyExistError() *shared.WorkflowExecutionAlreadyStartedError {
-  if !p.IsSetSessionAlreadyExistError() {
-    return HistoryServiceStartWorkflowExecutionResult_SessionAlreadyExistError_DEFAULT
+var HistoryServiceRespondActivityTaskCompletedResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *HistoryServiceRespondActivityTaskCompletedResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return HistoryServiceRespondActivityTaskCompletedResult_EntityNotExistError_DEFAULT
   }
-return p.SessionAlreadyExistError
+return p.EntityNotExistError
 }
-var HistoryServiceStartWorkflowExecutionResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
-func (p *HistoryServiceStartWorkflowExecutionResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
+var HistoryServiceRespondActivityTaskCompletedResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
+func (p *HistoryServiceRespondActivityTaskCompletedResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
   if !p.IsSetShardOwnershipLostError() {
-    return HistoryServiceStartWorkflowExecutionResult_ShardOwnershipLostError_DEFAULT
+    return HistoryServiceRespondActivityTaskCompletedResult_ShardOwnershipLostError_DEFAULT
   }
 return p.ShardOwnershipLostError
 }
-func (p *HistoryServiceStartWorkflowExecutionResult) IsSetSuccess() bool {
-  return p.Success != nil
-}
-
-func (p *HistoryServiceStartWorkflowExecutionResult) IsSetBadRequestError() bool {
+func (p *HistoryServiceRespondActivityTaskCompletedResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *HistoryServiceStartWorkflowExecutionResult) IsSetInternalServiceError() bool {
+func (p *HistoryServiceRespondActivityTaskCompletedResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *HistoryServiceStartWorkflowExecutionResult) IsSetSessionAlreadyExistError() bool {
-  return p.SessionAlreadyExistError != nil
+func (p *HistoryServiceRespondActivityTaskCompletedResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
 }
 
-func (p *HistoryServiceStartWorkflowExecutionResult) IsSetShardOwnershipLostError() bool {
+func (p *HistoryServiceRespondActivityTaskCompletedResult) IsSetShardOwnershipLostError() bool {
   return p.ShardOwnershipLostError != nil
 }
 
-func (p *HistoryServiceStartWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRespondActivityTaskCompletedResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -5867,10 +10887,6 @@ func (p *HistoryServiceStartWorkflowExecutionResult) Read(iprot thrift.TProtocol
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
-    case 0:
-      if err := p.ReadField0(iprot); err != nil {
-        return err
-      }
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -5902,15 +10918,7 @@ func (p *HistoryServiceStartWorkflowExecutionResult) Read(iprot thrift.TProtocol
   return nil
 }
 
-func (p *HistoryServiceStartWorkflowExecutionResult)  ReadField0(iprot thrift.TProtocol) error {
-  p.Success = &shared.StartWorkflowExecutionResponse{}
-  if err := p.Success.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
-  }
-  return nil
-}
-
-func (p *HistoryServiceStartWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRespondActivityTaskCompletedResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -5918,7 +10926,7 @@ func (p *HistoryServiceStartWorkflowExecutionResult)  ReadField1(iprot thrift.TP
   return nil
 }
 
-func (p *HistoryServiceStartWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRespondActivityTaskCompletedResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -5926,15 +10934,15 @@ func (p *HistoryServiceStartWorkflowExecutionResult)  ReadField2(iprot thrift.TP
   return nil
 }
 
-func (p *HistoryServiceStartWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
-  p.SessionAlreadyExistError = &shared.WorkflowExecutionAlreadyStartedError{}
-  if err := p.SessionAlreadyExistError.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.SessionAlreadyExistError), err)
+func (p *HistoryServiceRespondActivityTaskCompletedResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
   }
   return nil
 }
 
-func (p *HistoryServiceStartWorkflowExecutionResult)  ReadField4(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRespondActivityTaskCompletedResult)  ReadField4(iprot thrift.TProtocol) error {
   p.ShardOwnershipLostError = &ShardOwnershipLostError{}
   if err := p.ShardOwnershipLostError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ShardOwnershipLostError), err)
@@ -5942,11 +10950,10 @@ func (p *HistoryServiceStartWorkflowExecutionResult)  ReadField4(iprot thrift.TP
   return nil
 }
 
-func (p *HistoryServiceStartWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("StartWorkflowExecution_result"); err != nil {
+func (p *HistoryServiceRespondActivityTaskCompletedResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskCompleted_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
-    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
@@ -5959,20 +10966,7 @@ func (p *HistoryServiceStartWorkflowExecutionResult) Write(oprot thrift.TProtoco
   return nil
 }
 
-func (p *HistoryServiceStartWorkflowExecutionResult) writeField0(oprot thrift.TProtocol) (err error) {
-  if p.IsSetSuccess() {
-    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
-    if err := p.Success.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
-  }
-  return err
-}
-
-func (p *HistoryServiceStartWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceRespondActivityTaskCompletedResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -5985,7 +10979,7 @@ func (p *HistoryServiceStartWorkflowExecutionResult) writeField1(oprot thrift.TP
   return err
 }
 
-func (p *HistoryServiceStartWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceRespondActivityTaskCompletedResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -5998,20 +10992,20 @@ func (p *HistoryServiceStartWorkflowExecutionResult) writeField2(oprot thrift.TP
   return err
 }
 
-func (p *HistoryServiceStartWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
-  if p.IsSetSessionAlreadyExistError() {
-    if err := oprot.WriteFieldBegin("sessionAlreadyExistError", thrift.STRUCT, 3); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:sessionAlreadyExistError: ", p), err) }
-    if err := p.SessionAlreadyExistError.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.SessionAlreadyExistError), err)
+func (p *HistoryServiceRespondActivityTaskCompletedResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
     }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:sessionAlreadyExistError: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
   }
   return err
 }
 
-func (p *HistoryServiceStartWorkflowExecutionResult) writeField4(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceRespondActivityTaskCompletedResult) writeField4(oprot thrift.TProtocol) (err error) {
   if p.IsSetShardOwnershipLostError() {
     if err := oprot.WriteFieldBegin("shardOwnershipLostError", thrift.STRUCT, 4); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:shardOwnershipLostError: ", p), err) }
@@ -6024,35 +11018,35 @@ func (p *HistoryServiceStartWorkflowExecutionResult) writeField4(oprot thrift.TP
   return err
 }
 
-func (p *HistoryServiceStartWorkflowExecutionResult) String() string {
+func (p *HistoryServiceRespondActivityTaskCompletedResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceStartWorkflowExecutionResult(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceRespondActivityTaskCompletedResult(%+v)", *p)
 }
 
 // Attributes:
-//  - GetRequest
-type HistoryServiceGetWorkflowExecutionNextEventIDArgs struct {
-  GetRequest *GetWorkflowExecutionNextEventIDRequest `thrift:"getRequest,1" db:"getRequest" json:"getRequest"`
+//  - FailRequest
+type HistoryServiceRespondActivityTaskFailedArgs struct {
+  FailRequest *RespondActivityTaskFailedRequest `thrift:"failRequest,1" db:"failRequest" json:"failRequest"`
 }
 
-func NewHistoryServiceGetWorkflowExecutionNextEventIDArgs() *HistoryServiceGetWorkflowExecutionNextEventIDArgs {
-  return &HistoryServiceGetWorkflowExecutionNextEventIDArgs{}
+func NewHistoryServiceRespondActivityTaskFailedArgs() *HistoryServiceRespondActivityTaskFailedArgs {
+  return &HistoryServiceRespondActivityTaskFailedArgs{}
 }
 
-var HistoryServiceGetWorkflowExecutionNextEventIDArgs_GetRequest_DEFAULT *GetWorkflowExecutionNextEventIDRequest
-func (p *HistoryServiceGetWorkflowExecutionNextEventIDArgs) GetGetRequest() *GetWorkflowExecutionNextEventIDRequest {
-  if !p.IsSetGetRequest() {
-    return HistoryServiceGetWorkflowExecutionNextEventIDArgs_GetRequest_DEFAULT
+var HistoryServiceRespondActivityTaskFailedArgs_FailRequest_DEFAULT *RespondActivityTaskFailedRequest
+func (p *HistoryServiceRespondActivityTaskFailedArgs) GetFailRequest() *RespondActivityTaskFailedRequest {
+  if !p.IsSetFailRequest() {
+    return HistoryServiceRespondActivityTaskFailedArgs_FailRequest_DEFAULT
   }
-return p.GetRequest
+return p.FailRequest
 }
-func (p *HistoryServiceGetWorkflowExecutionNextEventIDArgs) IsSetGetRequest() bool {
-  return p.GetRequest != nil
+func (p *HistoryServiceRespondActivityTaskFailedArgs) IsSetFailRequest() bool {
+  return p.FailRequest != nil
 }
 
-func (p *HistoryServiceGetWorkflowExecutionNextEventIDArgs) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRespondActivityTaskFailedArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -6084,16 +11078,16 @@ func (p *HistoryServiceGetWorkflowExecutionNextEventIDArgs) Read(iprot thrift.TP
   return nil
 }
 
-func (p *HistoryServiceGetWorkflowExecutionNextEventIDArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.GetRequest = &GetWorkflowExecutionNextEventIDRequest{}
-  if err := p.GetRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.GetRequest), err)
+func (p *HistoryServiceRespondActivityTaskFailedArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.FailRequest = &RespondActivityTaskFailedRequest{}
+  if err := p.FailRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.FailRequest), err)
   }
   return nil
 }
 
-func (p *HistoryServiceGetWorkflowExecutionNextEventIDArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("GetWorkflowExecutionNextEventID_args"); err != nil {
+func (p *HistoryServiceRespondActivityTaskFailedArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskFailed_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -6105,98 +11099,85 @@ func (p *HistoryServiceGetWorkflowExecutionNextEventIDArgs) Write(oprot thrift.T
   return nil
 }
 
-func (p *HistoryServiceGetWorkflowExecutionNextEventIDArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("getRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:getRequest: ", p), err) }
-  if err := p.GetRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.GetRequest), err)
+func (p *HistoryServiceRespondActivityTaskFailedArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("failRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:failRequest: ", p), err) }
+  if err := p.FailRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.FailRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:getRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:failRequest: ", p), err) }
   return err
 }
 
-func (p *HistoryServiceGetWorkflowExecutionNextEventIDArgs) String() string {
+func (p *HistoryServiceRespondActivityTaskFailedArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceGetWorkflowExecutionNextEventIDArgs(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceRespondActivityTaskFailedArgs(%+v)", *p)
 }
 
 // Attributes:
-//  - Success
 //  - BadRequestError
 //  - InternalServiceError
 //  - EntityNotExistError
 //  - ShardOwnershipLostError
-type HistoryServiceGetWorkflowExecutionNextEventIDResult struct {
-  Success *GetWorkflowExecutionNextEventIDResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+type HistoryServiceRespondActivityTaskFailedResult struct {
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
   ShardOwnershipLostError *ShardOwnershipLostError `thrift:"shardOwnershipLostError,4" db:"shardOwnershipLostError" json:"shardOwnershipLostError,omitempty"`
 }
 
-func NewHistoryServiceGetWorkflowExecutionNextEventIDResult() *HistoryServiceGetWorkflowExecutionNextEventIDResult {
-  return &HistoryServiceGetWorkflowExecutionNextEventIDResult{}
+func NewHistoryServiceRespondActivityTaskFailedResult() *HistoryServiceRespondActivityTaskFailedResult {
+  return &HistoryServiceRespondActivityTaskFailedResult{}
 }
 
-var HistoryServiceGetWorkflowExecutionNextEventIDResult_Success_DEFAULT *GetWorkflowExecutionNextEventIDResponse
-func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult) GetSuccess() *GetWorkflowExecutionNextEventIDResponse {
-  if !p.IsSetSuccess() {
-    return HistoryServiceGetWorkflowExecutionNextEventIDResult_Success_DEFAULT
-  }
-return p.Success
-}
-var HistoryServiceGetWorkflowExecutionNextEventIDResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult) GetBadRequestError() *shared.BadRequestError {
+var HistoryServiceRespondActivityTaskFailedResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *HistoryServiceRespondActivityTaskFailedResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return HistoryServiceGetWorkflowExecutionNextEventIDResult_BadRequestError_DEFAULT
+    return HistoryServiceRespondActivityTaskFailedResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var HistoryServiceGetWorkflowExecutionNextEventIDResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult) GetInternalServiceError() *shared.InternalServiceError {
+var HistoryServiceRespondActivityTaskFailedResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *HistoryServiceRespondActivityTaskFailedResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return HistoryServiceGetWorkflowExecutionNextEventIDResult_InternalServiceError_DEFAULT
+    return HistoryServiceRespondActivityTaskFailedResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var HistoryServiceGetWorkflowExecutionNextEventIDResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var HistoryServiceRespondActivityTaskFailedResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *HistoryServiceRespondActivityTaskFailedResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return HistoryServiceGetWorkflowExecutionNextEventIDResult_EntityNotExistError_DEFAULT
+    return HistoryServiceRespondActivityTaskFailedResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-var HistoryServiceGetWorkflowExecutionNextEventIDResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
-func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
+var HistoryServiceRespondActivityTaskFailedResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
+func (p *HistoryServiceRespondActivityTaskFailedResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
   if !p.IsSetShardOwnershipLostError() {
-    return HistoryServiceGetWorkflowExecutionNextEventIDResult_ShardOwnershipLostError_DEFAULT
+    return HistoryServiceRespondActivityTaskFailedResult_ShardOwnershipLostError_DEFAULT
   }
 return p.ShardOwnershipLostError
 }
-func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult) IsSetSuccess() bool {
-  return p.Success != nil
-}
-
-func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult) IsSetBadRequestError() bool {
+func (p *HistoryServiceRespondActivityTaskFailedResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult) IsSetInternalServiceError() bool {
+func (p *HistoryServiceRespondActivityTaskFailedResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult) IsSetEntityNotExistError() bool {
+func (p *HistoryServiceRespondActivityTaskFailedResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult) IsSetShardOwnershipLostError() bool {
+func (p *HistoryServiceRespondActivityTaskFailedResult) IsSetShardOwnershipLostError() bool {
   return p.ShardOwnershipLostError != nil
 }
 
-func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRespondActivityTaskFailedResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -6209,10 +11190,6 @@ func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult) Read(iprot thrift.
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
-    case 0:
-      if err := p.ReadField0(iprot); err != nil {
-        return err
-      }
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -6244,15 +11221,7 @@ func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult) Read(iprot thrift.
   return nil
 }
 
-func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult)  ReadField0(iprot thrift.TProtocol) error {
-  p.Success = &GetWorkflowExecutionNextEventIDResponse{}
-  if err := p.Success.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
-  }
-  return nil
-}
-
-func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRespondActivityTaskFailedResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -6260,7 +11229,7 @@ func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult)  ReadField1(iprot
   return nil
 }
 
-func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRespondActivityTaskFailedResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -6268,7 +11237,7 @@ func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult)  ReadField2(iprot
   return nil
 }
 
-func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRespondActivityTaskFailedResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -6276,7 +11245,7 @@ func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult)  ReadField3(iprot
   return nil
 }
 
-func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult)  ReadField4(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRespondActivityTaskFailedResult)  ReadField4(iprot thrift.TProtocol) error {
   p.ShardOwnershipLostError = &ShardOwnershipLostError{}
   if err := p.ShardOwnershipLostError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ShardOwnershipLostError), err)
@@ -6284,11 +11253,10 @@ func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult)  ReadField4(iprot
   return nil
 }
 
-func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("GetWorkflowExecutionNextEventID_result"); err != nil {
+func (p *HistoryServiceRespondActivityTaskFailedResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskFailed_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
-    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
@@ -6301,20 +11269,7 @@ func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult) Write(oprot thrift
   return nil
 }
 
-func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult) writeField0(oprot thrift.TProtocol) (err error) {
-  if p.IsSetSuccess() {
-    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
-    if err := p.Success.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
-  }
-  return err
-}
-
-func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceRespondActivityTaskFailedResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -6327,7 +11282,7 @@ func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult) writeField1(oprot
   return err
 }
 
-func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceRespondActivityTaskFailedResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -6340,7 +11295,7 @@ func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult) writeField2(oprot
   return err
 }
 
-func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceRespondActivityTaskFailedResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -6353,7 +11308,7 @@ func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult) writeField3(oprot
   return err
 }
 
-func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult) writeField4(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceRespondActivityTaskFailedResult) writeField4(oprot thrift.TProtocol) (err error) {
   if p.IsSetShardOwnershipLostError() {
     if err := oprot.WriteFieldBegin("shardOwnershipLostError", thrift.STRUCT, 4); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:shardOwnershipLostError: ", p), err) }
@@ -6366,35 +11321,35 @@ func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult) writeField4(oprot
   return err
 }
 
-func (p *HistoryServiceGetWorkflowExecutionNextEventIDResult) String() string {
+func (p *HistoryServiceRespondActivityTaskFailedResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceGetWorkflowExecutionNextEventIDResult(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceRespondActivityTaskFailedResult(%+v)", *p)
 }
 
 // Attributes:
-//  - AddRequest
-type HistoryServiceRecordDecisionTaskStartedArgs struct {
-  AddRequest *RecordDecisionTaskStartedRequest `thrift:"addRequest,1" db:"addRequest" json:"addRequest"`
+//  - CanceledRequest
+type HistoryServiceRespondActivityTaskCanceledArgs struct {
+  CanceledRequest *RespondActivityTaskCanceledRequest `thrift:"canceledRequest,1" db:"canceledRequest" json:"canceledRequest"`
 }
 
-func NewHistoryServiceRecordDecisionTaskStartedArgs() *HistoryServiceRecordDecisionTaskStartedArgs {
-  return &HistoryServiceRecordDecisionTaskStartedArgs{}
+func NewHistoryServiceRespondActivityTaskCanceledArgs() *HistoryServiceRespondActivityTaskCanceledArgs {
+  return &HistoryServiceRespondActivityTaskCanceledArgs{}
 }
 
-var HistoryServiceRecordDecisionTaskStartedArgs_AddRequest_DEFAULT *RecordDecisionTaskStartedRequest
-func (p *HistoryServiceRecordDecisionTaskStartedArgs) GetAddRequest() *RecordDecisionTaskStartedRequest {
-  if !p.IsSetAddRequest() {
-    return HistoryServiceRecordDecisionTaskStartedArgs_AddRequest_DEFAULT
+var HistoryServiceRespondActivityTaskCanceledArgs_CanceledRequest_DEFAULT *RespondActivityTaskCanceledRequest
+func (p *HistoryServiceRespondActivityTaskCanceledArgs) GetCanceledRequest() *RespondActivityTaskCanceledRequest {
+  if !p.IsSetCanceledRequest() {
+    return HistoryServiceRespondActivityTaskCanceledArgs_CanceledRequest_DEFAULT
   }
-return p.AddRequest
+return p.CanceledRequest
 }
-func (p *HistoryServiceRecordDecisionTaskStartedArgs) IsSetAddRequest() bool {
-  return p.AddRequest != nil
+func (p *HistoryServiceRespondActivityTaskCanceledArgs) IsSetCanceledRequest() bool {
+  return p.CanceledRequest != nil
 }
 
-func (p *HistoryServiceRecordDecisionTaskStartedArgs) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRespondActivityTaskCanceledArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -6426,16 +11381,16 @@ func (p *HistoryServiceRecordDecisionTaskStartedArgs) Read(iprot thrift.TProtoco
   return nil
 }
 
-func (p *HistoryServiceRecordDecisionTaskStartedArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.AddRequest = &RecordDecisionTaskStartedRequest{}
-  if err := p.AddRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.AddRequest), err)
+func (p *HistoryServiceRespondActivityTaskCanceledArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.CanceledRequest = &RespondActivityTaskCanceledRequest{}
+  if err := p.CanceledRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CanceledRequest), err)
   }
   return nil
 }
 
-func (p *HistoryServiceRecordDecisionTaskStartedArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RecordDecisionTaskStarted_args"); err != nil {
+func (p *HistoryServiceRespondActivityTaskCanceledArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskCanceled_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -6447,111 +11402,85 @@ func (p *HistoryServiceRecordDecisionTaskStartedArgs) Write(oprot thrift.TProtoc
   return nil
 }
 
-func (p *HistoryServiceRecordDecisionTaskStartedArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("addRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:addRequest: ", p), err) }
-  if err := p.AddRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.AddRequest), err)
+func (p *HistoryServiceRespondActivityTaskCanceledArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("canceledRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:canceledRequest: ", p), err) }
+  if err := p.CanceledRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CanceledRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:addRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:canceledRequest: ", p), err) }
   return err
 }
 
-func (p *HistoryServiceRecordDecisionTaskStartedArgs) String() string {
+func (p *HistoryServiceRespondActivityTaskCanceledArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceRecordDecisionTaskStartedArgs(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceRespondActivityTaskCanceledArgs(%+v)", *p)
 }
 
 // Attributes:
-//  - Success
 //  - BadRequestError
 //  - InternalServiceError
-//  - EventAlreadyStartedError
 //  - EntityNotExistError
 //  - ShardOwnershipLostError
-type HistoryServiceRecordDecisionTaskStartedResult struct {
-  Success *RecordDecisionTaskStartedResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+type HistoryServiceRespondActivityTaskCanceledResult struct {
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
-  EventAlreadyStartedError *EventAlreadyStartedError `thrift:"eventAlreadyStartedError,3" db:"eventAlreadyStartedError" json:"eventAlreadyStartedError,omitempty"`
-  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,4" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
-  ShardOwnershipLostError *ShardOwnershipLostError `thrift:"shardOwnershipLostError,5" db:"shardOwnershipLostError" json:"shardOwnershipLostError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+  ShardOwnershipLostError *ShardOwnershipLostError `thrift:"shardOwnershipLostError,4" db:"shardOwnershipLostError" json:"shardOwnershipLostError,omitempty"`
 }
 
-func NewHistoryServiceRecordDecisionTaskStartedResult() *HistoryServiceRecordDecisionTaskStartedResult {
-  return &HistoryServiceRecordDecisionTaskStartedResult{}
+func NewHistoryServiceRespondActivityTaskCanceledResult() *HistoryServiceRespondActivityTaskCanceledResult {
+  return &HistoryServiceRespondActivityTaskCanceledResult{}
 }
 
-var HistoryServiceRecordDecisionTaskStartedResult_Success_DEFAULT *RecordDecisionTaskStartedResponse
-func (p *HistoryServiceRecordDecisionTaskStartedResult) GetSuccess() *RecordDecisionTaskStartedResponse {
-  if !p.IsSetSuccess() {
-    return HistoryServiceRecordDecisionTaskStartedResult_Success_DEFAULT
-  }
-return p.Success
-}
-var HistoryServiceRecordDecisionTaskStartedResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *HistoryServiceRecordDecisionTaskStartedResult) GetBadRequestError() *shared.BadRequestError {
+var HistoryServiceRespondActivityTaskCanceledResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *HistoryServiceRespondActivityTaskCanceledResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return HistoryServiceRecordDecisionTaskStartedResult_BadRequestError_DEFAULT
+    return HistoryServiceRespondActivityTaskCanceledResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var HistoryServiceRecordDecisionTaskStartedResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *HistoryServiceRecordDecisionTaskStartedResult) GetInternalServiceError() *shared.InternalServiceError {
+var HistoryServiceRespondActivityTaskCanceledResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *HistoryServiceRespondActivityTaskCanceledResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return HistoryServiceRecordDecisionTaskStartedResult_InternalServiceError_DEFAULT
+    return HistoryServiceRespondActivityTaskCanceledResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var HistoryServiceRecordDecisionTaskStartedResult_EventAlreadyStartedError_DEFAULT *EventAlreadyStartedError
-func (p *HistoryServiceRecordDecisionTaskStartedResult) GetEventAlreadyStartedError() *EventAlreadyStartedError {
-  if !p.IsSetEventAlreadyStartedError() {
-    return HistoryServiceRecordDecisionTaskStartedResult_EventAlreadyStartedError_DEFAULT
-  }
-return p.EventAlreadyStartedError
-}
-var HistoryServiceRecordDecisionTaskStartedResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *HistoryServiceRecordDecisionTaskStartedResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var HistoryServiceRespondActivityTaskCanceledResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *HistoryServiceRespondActivityTaskCanceledResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return HistoryServiceRecordDecisionTaskStartedResult_EntityNotExistError_DEFAULT
+    return HistoryServiceRespondActivityTaskCanceledResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-var HistoryServiceRecordDecisionTaskStartedResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
-func (p *HistoryServiceRecordDecisionTaskStartedResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
+var HistoryServiceRespondActivityTaskCanceledResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
+func (p *HistoryServiceRespondActivityTaskCanceledResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
   if !p.IsSetShardOwnershipLostError() {
-    return HistoryServiceRecordDecisionTaskStartedResult_ShardOwnershipLostError_DEFAULT
+    return HistoryServiceRespondActivityTaskCanceledResult_ShardOwnershipLostError_DEFAULT
   }
 return p.ShardOwnershipLostError
 }
-func (p *HistoryServiceRecordDecisionTaskStartedResult) IsSetSuccess() bool {
-  return p.Success != nil
-}
-
-func (p *HistoryServiceRecordDecisionTaskStartedResult) IsSetBadRequestError() bool {
+func (p *HistoryServiceRespondActivityTaskCanceledResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *HistoryServiceRecordDecisionTaskStartedResult) IsSetInternalServiceError() bool {
+func (p *HistoryServiceRespondActivityTaskCanceledResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *HistoryServiceRecordDecisionTaskStartedResult) IsSetEventAlreadyStartedError() bool {
-  return p.EventAlreadyStartedError != nil
-}
-
-func (p *HistoryServiceRecordDecisionTaskStartedResult) IsSetEntityNotExistError() bool {
+func (p *HistoryServiceRespondActivityTaskCanceledResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *HistoryServiceRecordDecisionTaskStartedResult) IsSetShardOwnershipLostError() bool {
+func (p *HistoryServiceRespondActivityTaskCanceledResult) IsSetShardOwnershipLostError() bool {
   return p.ShardOwnershipLostError != nil
 }
 
-func (p *HistoryServiceRecordDecisionTaskStartedResult) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRespondActivityTaskCanceledResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -6564,10 +11493,6 @@ func (p *HistoryServiceRecordDecisionTaskStartedResult) Read(iprot thrift.TProto
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
-    case 0:
-      if err := p.ReadField0(iprot); err != nil {
-        return err
-      }
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -6584,10 +11509,6 @@ func (p *HistoryServiceRecordDecisionTaskStartedResult) Read(iprot thrift.TProto
       if err := p.ReadField4(iprot); err != nil {
         return err
       }
-    case 5:
-      if err := p.ReadField5(iprot); err != nil {
-        return err
-      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -6603,15 +11524,7 @@ func (p *HistoryServiceRecordDecisionTaskStartedResult) Read(iprot thrift.TProto
   return nil
 }
 
-func (p *HistoryServiceRecordDecisionTaskStartedResult)  ReadField0(iprot thrift.TProtocol) error {
-  p.Success = &RecordDecisionTaskStartedResponse{}
-  if err := p.Success.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
-  }
-  return nil
-}
-
-func (p *HistoryServiceRecordDecisionTaskStartedResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRespondActivityTaskCanceledResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -6619,7 +11532,7 @@ func (p *HistoryServiceRecordDecisionTaskStartedResult)  ReadField1(iprot thrift
   return nil
 }
 
-func (p *HistoryServiceRecordDecisionTaskStartedResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRespondActivityTaskCanceledResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -6627,15 +11540,7 @@ func (p *HistoryServiceRecordDecisionTaskStartedResult)  ReadField2(iprot thrift
   return nil
 }
 
-func (p *HistoryServiceRecordDecisionTaskStartedResult)  ReadField3(iprot thrift.TProtocol) error {
-  p.EventAlreadyStartedError = &EventAlreadyStartedError{}
-  if err := p.EventAlreadyStartedError.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EventAlreadyStartedError), err)
-  }
-  return nil
-}
-
-func (p *HistoryServiceRecordDecisionTaskStartedResult)  ReadField4(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRespondActivityTaskCanceledResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -6643,7 +11548,7 @@ func (p *HistoryServiceRecordDecisionTaskStartedResult)  ReadField4(iprot thrift
   return nil
 }
 
-func (p *HistoryServiceRecordDecisionTaskStartedResult)  ReadField5(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRespondActivityTaskCanceledResult)  ReadField4(iprot thrift.TProtocol) error {
   p.ShardOwnershipLostError = &ShardOwnershipLostError{}
   if err := p.ShardOwnershipLostError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ShardOwnershipLostError), err)
@@ -6651,16 +11556,14 @@ func (p *HistoryServiceRecordDecisionTaskStartedResult)  ReadField5(iprot thrift
   return nil
 }
 
-func (p *HistoryServiceRecordDecisionTaskStartedResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RecordDecisionTaskStarted_result"); err != nil {
+func (p *HistoryServiceRespondActivityTaskCanceledResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskCanceled_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
-    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
     if err := p.writeField4(oprot); err != nil { return err }
-    if err := p.writeField5(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -6669,20 +11572,7 @@ func (p *HistoryServiceRecordDecisionTaskStartedResult) Write(oprot thrift.TProt
   return nil
 }
 
-func (p *HistoryServiceRecordDecisionTaskStartedResult) writeField0(oprot thrift.TProtocol) (err error) {
-  if p.IsSetSuccess() {
-    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
-    if err := p.Success.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
-  }
-  return err
-}
-
-func (p *HistoryServiceRecordDecisionTaskStartedResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceRespondActivityTaskCanceledResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -6694,88 +11584,75 @@ func (p *HistoryServiceRecordDecisionTaskStartedResult) writeField1(oprot thrift
   }
   return err
 }
-
-func (p *HistoryServiceRecordDecisionTaskStartedResult) writeField2(oprot thrift.TProtocol) (err error) {
-  if p.IsSetInternalServiceError() {
-    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
-    if err := p.InternalServiceError.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
-  }
-  return err
-}
-
-func (p *HistoryServiceRecordDecisionTaskStartedResult) writeField3(oprot thrift.TProtocol) (err error) {
-  if p.IsSetEventAlreadyStartedError() {
-    if err := oprot.WriteFieldBegin("eventAlreadyStartedError", thrift.STRUCT, 3); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:eventAlreadyStartedError: ", p), err) }
-    if err := p.EventAlreadyStartedError.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EventAlreadyStartedError), err)
+
+func (p *HistoryServiceRespondActivityTaskCanceledResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
     }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:eventAlreadyStartedError: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
   }
   return err
 }
 
-func (p *HistoryServiceRecordDecisionTaskStartedResult) writeField4(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceRespondActivityTaskCanceledResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
-    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 4); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:entityNotExistError: ", p), err) }
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
     if err := p.EntityNotExistError.Write(oprot); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
     }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 4:entityNotExistError: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
   }
   return err
 }
 
-func (p *HistoryServiceRecordDecisionTaskStartedResult) writeField5(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceRespondActivityTaskCanceledResult) writeField4(oprot thrift.TProtocol) (err error) {
   if p.IsSetShardOwnershipLostError() {
-    if err := oprot.WriteFieldBegin("shardOwnershipLostError", thrift.STRUCT, 5); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:shardOwnershipLostError: ", p), err) }
+    if err := oprot.WriteFieldBegin("shardOwnershipLostError", thrift.STRUCT, 4); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:shardOwnershipLostError: ", p), err) }
     if err := p.ShardOwnershipLostError.Write(oprot); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ShardOwnershipLostError), err)
     }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 5:shardOwnershipLostError: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 4:shardOwnershipLostError: ", p), err) }
   }
   return err
 }
 
-func (p *HistoryServiceRecordDecisionTaskStartedResult) String() string {
+func (p *HistoryServiceRespondActivityTaskCanceledResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceRecordDecisionTaskStartedResult(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceRespondActivityTaskCanceledResult(%+v)", *p)
 }
 
 // Attributes:
-//  - AddRequest
-type HistoryServiceRecordActivityTaskStartedArgs struct {
-  AddRequest *RecordActivityTaskStartedRequest `thrift:"addRequest,1" db:"addRequest" json:"addRequest"`
+//  - SignalRequest
+type HistoryServiceSignalWorkflowExecutionArgs struct {
+  SignalRequest *SignalWorkflowExecutionRequest `thrift:"signalRequest,1" db:"signalRequest" json:"signalRequest"`
 }
 
-func NewHistoryServiceRecordActivityTaskStartedArgs() *HistoryServiceRecordActivityTaskStartedArgs {
-  return &HistoryServiceRecordActivityTaskStartedArgs{}
+func NewHistoryServiceSignalWorkflowExecutionArgs() *HistoryServiceSignalWorkflowExecutionArgs {
+  return &HistoryServiceSignalWorkflowExecutionArgs{}
 }
 
-var HistoryServiceRecordActivityTaskStartedArgs_AddRequest_DEFAULT *RecordActivityTaskStartedRequest
-func (p *HistoryServiceRecordActivityTaskStartedArgs) GetAddRequest() *RecordActivityTaskStartedRequest {
-  if !p.IsSetAddRequest() {
-    return HistoryServiceRecordActivityTaskStartedArgs_AddRequest_DEFAULT
+var HistoryServiceSignalWorkflowExecutionArgs_SignalRequest_DEFAULT *SignalWorkflowExecutionRequest
+func (p *HistoryServiceSignalWorkflowExecutionArgs) GetSignalRequest() *SignalWorkflowExecutionRequest {
+  if !p.IsSetSignalRequest() {
+    return HistoryServiceSignalWorkflowExecutionArgs_SignalRequest_DEFAULT
   }
-return p.AddRequest
+return p.SignalRequest
 }
-func (p *HistoryServiceRecordActivityTaskStartedArgs) IsSetAddRequest() bool {
-  return p.AddRequest != nil
+func (p *HistoryServiceSignalWorkflowExecutionArgs) IsSetSignalRequest() bool {
+  return p.SignalRequest != nil
 }
 
-func (p *HistoryServiceRecordActivityTaskStartedArgs) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceSignalWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -6807,16 +11684,16 @@ func (p *HistoryServiceRecordActivityTaskStartedArgs) Read(iprot thrift.TProtoco
   return nil
 }
 
-func (p *HistoryServiceRecordActivityTaskStartedArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.AddRequest = &RecordActivityTaskStartedRequest{}
-  if err := p.AddRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.AddRequest), err)
+func (p *HistoryServiceSignalWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.SignalRequest = &SignalWorkflowExecutionRequest{}
+  if err := p.SignalRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.SignalRequest), err)
   }
   return nil
 }
 
-func (p *HistoryServiceRecordActivityTaskStartedArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RecordActivityTaskStarted_args"); err != nil {
+func (p *HistoryServiceSignalWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("SignalWorkflowExecution_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -6828,111 +11705,85 @@ func (p *HistoryServiceRecordActivityTaskStartedArgs) Write(oprot thrift.TProtoc
   return nil
 }
 
-func (p *HistoryServiceRecordActivityTaskStartedArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("addRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:addRequest: ", p), err) }
-  if err := p.AddRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.AddRequest), err)
+func (p *HistoryServiceSignalWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("signalRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:signalRequest: ", p), err) }
+  if err := p.SignalRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.SignalRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:addRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:signalRequest: ", p), err) }
   return err
 }
 
-func (p *HistoryServiceRecordActivityTaskStartedArgs) String() string {
+func (p *HistoryServiceSignalWorkflowExecutionArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceRecordActivityTaskStartedArgs(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceSignalWorkflowExecutionArgs(%+v)", *p)
 }
 
 // Attributes:
-//  - Success
 //  - BadRequestError
 //  - InternalServiceError
-//  - EventAlreadyStartedError
 //  - EntityNotExistError
 //  - ShardOwnershipLostError
-type HistoryServiceRecordActivityTaskStartedResult struct {
-  Success *RecordActivityTaskStartedResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+type HistoryServiceSignalWorkflowExecutionResult struct {
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
-  EventAlreadyStartedError *EventAlreadyStartedError `thrift:"eventAlreadyStartedError,3" db:"eventAlreadyStartedError" json:"eventAlreadyStartedError,omitempty"`
-  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,4" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
-  ShardOwnershipLostError *ShardOwnershipLostError `thrift:"shardOwnershipLostError,5" db:"shardOwnershipLostError" json:"shardOwnershipLostError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+  ShardOwnershipLostError *ShardOwnershipLostError `thrift:"shardOwnershipLostError,4" db:"shardOwnershipLostError" json:"shardOwnershipLostError,omitempty"`
 }
 
-func NewHistoryServiceRecordActivityTaskStartedResult() *HistoryServiceRecordActivityTaskStartedResult {
-  return &HistoryServiceRecordActivityTaskStartedResult{}
+func NewHistoryServiceSignalWorkflowExecutionResult() *HistoryServiceSignalWorkflowExecutionResult {
+  return &HistoryServiceSignalWorkflowExecutionResult{}
 }
 
-var HistoryServiceRecordActivityTaskStartedResult_Success_DEFAULT *RecordActivityTaskStartedResponse
-func (p *HistoryServiceRecordActivityTaskStartedResult) GetSuccess() *RecordActivityTaskStartedResponse {
-  if !p.IsSetSuccess() {
-    return HistoryServiceRecordActivityTaskStartedResult_Success_DEFAULT
-  }
-return p.Success
-}
-var HistoryServiceRecordActivityTaskStartedResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *HistoryServiceRecordActivityTaskStartedResult) GetBadRequestError() *shared.BadRequestError {
+var HistoryServiceSignalWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *HistoryServiceSignalWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return HistoryServiceRecordActivityTaskStartedResult_BadRequestError_DEFAULT
+    return HistoryServiceSignalWorkflowExecutionResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var HistoryServiceRecordActivityTaskStartedResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *HistoryServiceRecordActivityTaskStartedResult) GetInternalServiceError() *shared.InternalServiceError {
+var HistoryServiceSignalWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *HistoryServiceSignalWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return HistoryServiceRecordActivityTaskStartedResult_InternalServiceError_DEFAULT
+    return HistoryServiceSignalWorkflowExecutionResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var HistoryServiceRecordActivityTaskStartedResult_EventAlreadyStartedError_DEFAULT *EventAlreadyStartedError
-func (p *HistoryServiceRecordActivityTaskStartedResult) GetEventAlreadyStartedError() *EventAlreadyStartedError {
-  if !p.IsSetEventAlreadyStartedError() {
-    return HistoryServiceRecordActivityTaskStartedResult_EventAlreadyStartedError_DEFAULT
-  }
-return p.EventAlreadyStartedError
-}
-var HistoryServiceRecordActivityTaskStartedResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *HistoryServiceRecordActivityTaskStartedResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var HistoryServiceSignalWorkflowExecutionResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *HistoryServiceSignalWorkflowExecutionResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return HistoryServiceRecordActivityTaskStartedResult_EntityNotExistError_DEFAULT
+    return HistoryServiceSignalWorkflowExecutionResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-var HistoryServiceRecordActivityTaskStartedResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
-func (p *HistoryServiceRecordActivityTaskStartedResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
+var HistoryServiceSignalWorkflowExecutionResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
+func (p *HistoryServiceSignalWorkflowExecutionResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
   if !p.IsSetShardOwnershipLostError() {
-    return HistoryServiceRecordActivityTaskStartedResult_ShardOwnershipLostError_DEFAULT
+    return HistoryServiceSignalWorkflowExecutionResult_ShardOwnershipLostError_DEFAULT
   }
 return p.ShardOwnershipLostError
 }
-func (p *HistoryServiceRecordActivityTaskStartedResult) IsSetSuccess() bool {
-  return p.Success != nil
-}
-
-func (p *HistoryServiceRecordActivityTaskStartedResult) IsSetBadRequestError() bool {
+func (p *HistoryServiceSignalWorkflowExecutionResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *HistoryServiceRecordActivityTaskStartedResult) IsSetInternalServiceError() bool {
+func (p *HistoryServiceSignalWorkflowExecutionResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *HistoryServiceRecordActivityTaskStartedResult) IsSetEventAlreadyStartedError() bool {
-  return p.EventAlreadyStartedError != nil
-}
-
-func (p *HistoryServiceRecordActivityTaskStartedResult) IsSetEntityNotExistError() bool {
+func (p *HistoryServiceSignalWorkflowExecutionResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *HistoryServiceRecordActivityTaskStartedResult) IsSetShardOwnershipLostError() bool {
+func (p *HistoryServiceSignalWorkflowExecutionResult) IsSetShardOwnershipLostError() bool {
   return p.ShardOwnershipLostError != nil
 }
 
-func (p *HistoryServiceRecordActivityTaskStartedResult) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceSignalWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -6945,10 +11796,6 @@ func (p *HistoryServiceRecordActivityTaskStartedResult) Read(iprot thrift.TProto
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
-    case 0:
-      if err := p.ReadField0(iprot); err != nil {
-        return err
-      }
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -6965,10 +11812,6 @@ func (p *HistoryServiceRecordActivityTaskStartedResult) Read(iprot thrift.TProto
       if err := p.ReadField4(iprot); err != nil {
         return err
       }
-    case 5:
-      if err := p.ReadField5(iprot); err != nil {
-        return err
-      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -6984,15 +11827,7 @@ func (p *HistoryServiceRecordActivityTaskStartedResult) Read(iprot thrift.TProto
   return nil
 }
 
-func (p *HistoryServiceRecordActivityTaskStartedResult)  ReadField0(iprot thrift.TProtocol) error {
-  p.Success = &RecordActivityTaskStartedResponse{}
-  if err := p.Success.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
-  }
-  return nil
-}
-
-func (p *HistoryServiceRecordActivityTaskStartedResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *HistoryServiceSignalWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -7000,7 +11835,7 @@ func (p *HistoryServiceRecordActivityTaskStartedResult)  ReadField1(iprot thrift
   return nil
 }
 
-func (p *HistoryServiceRecordActivityTaskStartedResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *HistoryServiceSignalWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -7008,15 +11843,7 @@ func (p *HistoryServiceRecordActivityTaskStartedResult)  ReadField2(iprot thrift
   return nil
 }
 
-func (p *HistoryServiceRecordActivityTaskStartedResult)  ReadField3(iprot thrift.TProtocol) error {
-  p.EventAlreadyStartedError = &EventAlreadyStartedError{}
-  if err := p.EventAlreadyStartedError.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EventAlreadyStartedError), err)
-  }
-  return nil
-}
-
-func (p *HistoryServiceRecordActivityTaskStartedResult)  ReadField4(iprot thrift.TProtocol) error {
+func (p *HistoryServiceSignalWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -7024,7 +11851,7 @@ func (p *HistoryServiceRecordActivityTaskStartedResult)  ReadField4(iprot thrift
   return nil
 }
 
-func (p *HistoryServiceRecordActivityTaskStartedResult)  ReadField5(iprot thrift.TProtocol) error {
+func (p *HistoryServiceSignalWorkflowExecutionResult)  ReadField4(iprot thrift.TProtocol) error {
   p.ShardOwnershipLostError = &ShardOwnershipLostError{}
   if err := p.ShardOwnershipLostError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ShardOwnershipLostError), err)
@@ -7032,16 +11859,14 @@ func (p *HistoryServiceRecordActivityTaskStartedResult)  ReadField5(iprot thrift
   return nil
 }
 
-func (p *HistoryServiceRecordActivityTaskStartedResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RecordActivityTaskStarted_result"); err != nil {
+func (p *HistoryServiceSignalWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("SignalWorkflowExecution_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
-    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
     if err := p.writeField4(oprot); err != nil { return err }
-    if err := p.writeField5(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -7050,20 +11875,7 @@ func (p *HistoryServiceRecordActivityTaskStartedResult) Write(oprot thrift.TProt
   return nil
 }
 
-func (p *HistoryServiceRecordActivityTaskStartedResult) writeField0(oprot thrift.TProtocol) (err error) {
-  if p.IsSetSuccess() {
-    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
-    if err := p.Success.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
-  }
-  return err
-}
-
-func (p *HistoryServiceRecordActivityTaskStartedResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceSignalWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -7076,7 +11888,7 @@ func (p *HistoryServiceRecordActivityTaskStartedResult) writeField1(oprot thrift
   return err
 }
 
-func (p *HistoryServiceRecordActivityTaskStartedResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceSignalWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -7089,74 +11901,61 @@ func (p *HistoryServiceRecordActivityTaskStartedResult) writeField2(oprot thrift
   return err
 }
 
-func (p *HistoryServiceRecordActivityTaskStartedResult) writeField3(oprot thrift.TProtocol) (err error) {
-  if p.IsSetEventAlreadyStartedError() {
-    if err := oprot.WriteFieldBegin("eventAlreadyStartedError", thrift.STRUCT, 3); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:eventAlreadyStartedError: ", p), err) }
-    if err := p.EventAlreadyStartedError.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EventAlreadyStartedError), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:eventAlreadyStartedError: ", p), err) }
-  }
-  return err
-}
-
-func (p *HistoryServiceRecordActivityTaskStartedResult) writeField4(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceSignalWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
-    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 4); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:entityNotExistError: ", p), err) }
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
     if err := p.EntityNotExistError.Write(oprot); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
     }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 4:entityNotExistError: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
   }
   return err
 }
 
-func (p *HistoryServiceRecordActivityTaskStartedResult) writeField5(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceSignalWorkflowExecutionResult) writeField4(oprot thrift.TProtocol) (err error) {
   if p.IsSetShardOwnershipLostError() {
-    if err := oprot.WriteFieldBegin("shardOwnershipLostError", thrift.STRUCT, 5); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:shardOwnershipLostError: ", p), err) }
+    if err := oprot.WriteFieldBegin("shardOwnershipLostError", thrift.STRUCT, 4); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:shardOwnershipLostError: ", p), err) }
     if err := p.ShardOwnershipLostError.Write(oprot); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ShardOwnershipLostError), err)
     }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 5:shardOwnershipLostError: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 4:shardOwnershipLostError: ", p), err) }
   }
   return err
 }
 
-func (p *HistoryServiceRecordActivityTaskStartedResult) String() string {
+func (p *HistoryServiceSignalWorkflowExecutionResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceRecordActivityTaskStartedResult(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceSignalWorkflowExecutionResult(%+v)", *p)
 }
 
 // Attributes:
-//  - CompleteRequest
-type HistoryServiceRespondDecisionTaskCompletedArgs struct {
-  CompleteRequest *RespondDecisionTaskCompletedRequest `thrift:"completeRequest,1" db:"completeRequest" json:"completeRequest"`
+//  - TerminateRequest
+type HistoryServiceTerminateWorkflowExecutionArgs struct {
+  TerminateRequest *TerminateWorkflowExecutionRequest `thrift:"terminateRequest,1" db:"terminateRequest" json:"terminateRequest"`
 }
 
-func NewHistoryServiceRespondDecisionTaskCompletedArgs() *HistoryServiceRespondDecisionTaskCompletedArgs {
-  return &HistoryServiceRespondDecisionTaskCompletedArgs{}
+func NewHistoryServiceTerminateWorkflowExecutionArgs() *HistoryServiceTerminateWorkflowExecutionArgs {
+  return &HistoryServiceTerminateWorkflowExecutionArgs{}
 }
-
-var HistoryServiceRespondDecisionTaskCompletedArgs_CompleteRequest_DEFAULT *RespondDecisionTaskCompletedRequest
-func (p *HistoryServiceRespondDecisionTaskCompletedArgs) GetCompleteRequest() *RespondDecisionTaskCompletedRequest {
-  if !p.IsSetCompleteRequest() {
-    return HistoryServiceRespondDecisionTaskCompletedArgs_CompleteRequest_DEFAULT
+
+var HistoryServiceTerminateWorkflowExecutionArgs_TerminateRequest_DEFAULT *TerminateWorkflowExecutionRequest
+func (p *HistoryServiceTerminateWorkflowExecutionArgs) GetTerminateRequest() *TerminateWorkflowExecutionRequest {
+  if !p.IsSetTerminateRequest() {
+    return HistoryServiceTerminateWorkflowExecutionArgs_TerminateRequest_DEFAULT
   }
-return p.CompleteRequest
+return p.TerminateRequest
 }
-func (p *HistoryServiceRespondDecisionTaskCompletedArgs) IsSetCompleteRequest() bool {
-  return p.CompleteRequest != nil
+func (p *HistoryServiceTerminateWorkflowExecutionArgs) IsSetTerminateRequest() bool {
+  return p.TerminateRequest != nil
 }
 
-func (p *HistoryServiceRespondDecisionTaskCompletedArgs) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceTerminateWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -7188,16 +11987,16 @@ func (p *HistoryServiceRespondDecisionTaskCompletedArgs) Read(iprot thrift.TProt
   return nil
 }
 
-func (p *HistoryServiceRespondDecisionTaskCompletedArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.CompleteRequest = &RespondDecisionTaskCompletedRequest{}
-  if err := p.CompleteRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CompleteRequest), err)
+func (p *HistoryServiceTerminateWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.TerminateRequest = &TerminateWorkflowExecutionRequest{}
+  if err := p.TerminateRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TerminateRequest), err)
   }
   return nil
 }
 
-func (p *HistoryServiceRespondDecisionTaskCompletedArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondDecisionTaskCompleted_args"); err != nil {
+func (p *HistoryServiceTerminateWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("TerminateWorkflowExecution_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -7209,22 +12008,22 @@ func (p *HistoryServiceRespondDecisionTaskCompletedArgs) Write(oprot thrift.TPro
   return nil
 }
 
-func (p *HistoryServiceRespondDecisionTaskCompletedArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("completeRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:completeRequest: ", p), err) }
-  if err := p.CompleteRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CompleteRequest), err)
+func (p *HistoryServiceTerminateWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("terminateRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:terminateRequest: ", p), err) }
+  if err := p.TerminateRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TerminateRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:completeRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:terminateRequest: ", p), err) }
   return err
 }
 
-func (p *HistoryServiceRespondDecisionTaskCompletedArgs) String() string {
+func (p *HistoryServiceTerminateWorkflowExecutionArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceRespondDecisionTaskCompletedArgs(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceTerminateWorkflowExecutionArgs(%+v)", *p)
 }
 
 // Attributes:
@@ -7232,62 +12031,62 @@ func (p *HistoryServiceRespondDecisionTaskCompletedArgs) String() string {
 //  - InternalServiceError
 //  - EntityNotExistError
 //  - ShardOwnershipLostError
-type HistoryServiceRespondDecisionTaskCompletedResult struct {
+type HistoryServiceTerminateWorkflowExecutionResult struct {
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
   ShardOwnershipLostError *ShardOwnershipLostError `thrift:"shardOwnershipLostError,4" db:"shardOwnershipLostError" json:"shardOwnershipLostError,omitempty"`
 }
 
-func NewHistoryServiceRespondDecisionTaskCompletedResult() *HistoryServiceRespondDecisionTaskCompletedResult {
-  return &HistoryServiceRespondDecisionTaskCompletedResult{}
+func NewHistoryServiceTerminateWorkflowExecutionResult() *HistoryServiceTerminateWorkflowExecutionResult {
+  return &HistoryServiceTerminateWorkflowExecutionResult{}
 }
 
-var HistoryServiceRespondDecisionTaskCompletedResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *HistoryServiceRespondDecisionTaskCompletedResult) GetBadRequestError() *shared.BadRequestError {
+var HistoryServiceTerminateWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *HistoryServiceTerminateWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return HistoryServiceRespondDecisionTaskCompletedResult_BadRequestError_DEFAULT
+    return HistoryServiceTerminateWorkflowExecutionResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var HistoryServiceRespondDecisionTaskCompletedResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *HistoryServiceRespondDecisionTaskCompletedResult) GetInternalServiceError() *shared.InternalServiceError {
+var HistoryServiceTerminateWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *HistoryServiceTerminateWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return HistoryServiceRespondDecisionTaskCompletedResult_InternalServiceError_DEFAULT
+    return HistoryServiceTerminateWorkflowExecutionResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var HistoryServiceRespondDecisionTaskCompletedResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *HistoryServiceRespondDecisionTaskCompletedResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var HistoryServiceTerminateWorkflowExecutionResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *HistoryServiceTerminateWorkflowExecutionResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return HistoryServiceRespondDecisionTaskCompletedResult_EntityNotExistError_DEFAULT
+    return HistoryServiceTerminateWorkflowExecutionResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-var HistoryServiceRespondDecisionTaskCompletedResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
-func (p *HistoryServiceRespondDecisionTaskCompletedResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
+var HistoryServiceTerminateWorkflowExecutionResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
+func (p *HistoryServiceTerminateWorkflowExecutionResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
   if !p.IsSetShardOwnershipLostError() {
-    return HistoryServiceRespondDecisionTaskCompletedResult_ShardOwnershipLostError_DEFAULT
+    return HistoryServiceTerminateWorkflowExecutionResult_ShardOwnershipLostError_DEFAULT
   }
 return p.ShardOwnershipLostError
 }
-func (p *HistoryServiceRespondDecisionTaskCompletedResult) IsSetBadRequestError() bool {
+func (p *HistoryServiceTerminateWorkflowExecutionResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *HistoryServiceRespondDecisionTaskCompletedResult) IsSetInternalServiceError() bool {
+func (p *HistoryServiceTerminateWorkflowExecutionResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *HistoryServiceRespondDecisionTaskCompletedResult) IsSetEntityNotExistError() bool {
+func (p *HistoryServiceTerminateWorkflowExecutionResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *HistoryServiceRespondDecisionTaskCompletedResult) IsSetShardOwnershipLostError() bool {
+func (p *HistoryServiceTerminateWorkflowExecutionResult) IsSetShardOwnershipLostError() bool {
   return p.ShardOwnershipLostError != nil
 }
 
-func (p *HistoryServiceRespondDecisionTaskCompletedResult) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceTerminateWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -7331,7 +12130,7 @@ func (p *HistoryServiceRespondDecisionTaskCompletedResult) Read(iprot thrift.TPr
   return nil
 }
 
-func (p *HistoryServiceRespondDecisionTaskCompletedResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *HistoryServiceTerminateWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -7339,7 +12138,7 @@ func (p *HistoryServiceRespondDecisionTaskCompletedResult)  ReadField1(iprot thr
   return nil
 }
 
-func (p *HistoryServiceRespondDecisionTaskCompletedResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *HistoryServiceTerminateWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -7347,7 +12146,7 @@ func (p *HistoryServiceRespondDecisionTaskCompletedResult)  ReadField2(iprot thr
   return nil
 }
 
-func (p *HistoryServiceRespondDecisionTaskCompletedResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *HistoryServiceTerminateWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -7355,7 +12154,7 @@ func (p *HistoryServiceRespondDecisionTaskCompletedResult)  ReadField3(iprot thr
   return nil
 }
 
-func (p *HistoryServiceRespondDecisionTaskCompletedResult)  ReadField4(iprot thrift.TProtocol) error {
+func (p *HistoryServiceTerminateWorkflowExecutionResult)  ReadField4(iprot thrift.TProtocol) error {
   p.ShardOwnershipLostError = &ShardOwnershipLostError{}
   if err := p.ShardOwnershipLostError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ShardOwnershipLostError), err)
@@ -7363,8 +12162,8 @@ func (p *HistoryServiceRespondDecisionTaskCompletedResult)  ReadField4(iprot thr
   return nil
 }
 
-func (p *HistoryServiceRespondDecisionTaskCompletedResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondDecisionTaskCompleted_result"); err != nil {
+func (p *HistoryServiceTerminateWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("TerminateWorkflowExecution_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -7379,7 +12178,7 @@ func (p *HistoryServiceRespondDecisionTaskCompletedResult) Write(oprot thrift.TP
   return nil
 }
 
-func (p *HistoryServiceRespondDecisionTaskCompletedResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceTerminateWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -7392,7 +12191,7 @@ func (p *HistoryServiceRespondDecisionTaskCompletedResult) writeField1(oprot thr
   return err
 }
 
-func (p *HistoryServiceRespondDecisionTaskCompletedResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceTerminateWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -7405,7 +12204,7 @@ func (p *HistoryServiceRespondDecisionTaskCompletedResult) writeField2(oprot thr
   return err
 }
 
-func (p *HistoryServiceRespondDecisionTaskCompletedResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceTerminateWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -7418,7 +12217,7 @@ func (p *HistoryServiceRespondDecisionTaskCompletedResult) writeField3(oprot thr
   return err
 }
 
-func (p *HistoryServiceRespondDecisionTaskCompletedResult) writeField4(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceTerminateWorkflowExecutionResult) writeField4(oprot thrift.TProtocol) (err error) {
   if p.IsSetShardOwnershipLostError() {
     if err := oprot.WriteFieldBegin("shardOwnershipLostError", thrift.STRUCT, 4); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:shardOwnershipLostError: ", p), err) }
@@ -7431,35 +12230,35 @@ func (p *HistoryServiceRespondDecisionTaskCompletedResult) writeField4(oprot thr
   return err
 }
 
-func (p *HistoryServiceRespondDecisionTaskCompletedResult) String() string {
+func (p *HistoryServiceTerminateWorkflowExecutionResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceRespondDecisionTaskCompletedResult(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceTerminateWorkflowExecutionResult(%+v)", *p)
 }
 
 // Attributes:
-//  - HeartbeatRequest
-type HistoryServiceRecordActivityTaskHeartbeatArgs struct {
-  HeartbeatRequest *RecordActivityTaskHeartbeatRequest `thrift:"heartbeatRequest,1" db:"heartbeatRequest" json:"heartbeatRequest"`
+//  - CancelRequest
+type HistoryServiceRequestCancelWorkflowExecutionArgs struct {
+  CancelRequest *RequestCancelWorkflowExecutionRequest `thrift:"cancelRequest,1" db:"cancelRequest" json:"cancelRequest"`
 }
 
-func NewHistoryServiceRecordActivityTaskHeartbeatArgs() *HistoryServiceRecordActivityTaskHeartbeatArgs {
-  return &HistoryServiceRecordActivityTaskHeartbeatArgs{}
+func NewHistoryServiceRequestCancelWorkflowExecutionArgs() *HistoryServiceRequestCancelWorkflowExecutionArgs {
+  return &HistoryServiceRequestCancelWorkflowExecutionArgs{}
 }
 
-var HistoryServiceRecordActivityTaskHeartbeatArgs_HeartbeatRequest_DEFAULT *RecordActivityTaskHeartbeatRequest
-func (p *HistoryServiceRecordActivityTaskHeartbeatArgs) GetHeartbeatRequest() *RecordActivityTaskHeartbeatRequest {
-  if !p.IsSetHeartbeatRequest() {
-    return HistoryServiceRecordActivityTaskHeartbeatArgs_HeartbeatRequest_DEFAULT
+var HistoryServiceRequestCancelWorkflowExecutionArgs_CancelRequest_DEFAULT *RequestCancelWorkflowExecutionRequest
+func (p *HistoryServiceRequestCancelWorkflowExecutionArgs) GetCancelRequest() *RequestCancelWorkflowExecutionRequest {
+  if !p.IsSetCancelRequest() {
+    return HistoryServiceRequestCancelWorkflowExecutionArgs_CancelRequest_DEFAULT
   }
-return p.HeartbeatRequest
+return p.CancelRequest
 }
-func (p *HistoryServiceRecordActivityTaskHeartbeatArgs) IsSetHeartbeatRequest() bool {
-  return p.HeartbeatRequest != nil
+func (p *HistoryServiceRequestCancelWorkflowExecutionArgs) IsSetCancelRequest() bool {
+  return p.CancelRequest != nil
 }
 
-func (p *HistoryServiceRecordActivityTaskHeartbeatArgs) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRequestCancelWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -7491,16 +12290,16 @@ func (p *HistoryServiceRecordActivityTaskHeartbeatArgs) Read(iprot thrift.TProto
   return nil
 }
 
-func (p *HistoryServiceRecordActivityTaskHeartbeatArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.HeartbeatRequest = &RecordActivityTaskHeartbeatRequest{}
-  if err := p.HeartbeatRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.HeartbeatRequest), err)
+func (p *HistoryServiceRequestCancelWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.CancelRequest = &RequestCancelWorkflowExecutionRequest{}
+  if err := p.CancelRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CancelRequest), err)
   }
   return nil
 }
 
-func (p *HistoryServiceRecordActivityTaskHeartbeatArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RecordActivityTaskHeartbeat_args"); err != nil {
+func (p *HistoryServiceRequestCancelWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RequestCancelWorkflowExecution_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -7512,98 +12311,85 @@ func (p *HistoryServiceRecordActivityTaskHeartbeatArgs) Write(oprot thrift.TProt
   return nil
 }
 
-func (p *HistoryServiceRecordActivityTaskHeartbeatArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("heartbeatRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:heartbeatRequest: ", p), err) }
-  if err := p.HeartbeatRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.HeartbeatRequest), err)
+func (p *HistoryServiceRequestCancelWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("cancelRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:cancelRequest: ", p), err) }
+  if err := p.CancelRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CancelRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:heartbeatRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:cancelRequest: ", p), err) }
   return err
 }
 
-func (p *HistoryServiceRecordActivityTaskHeartbeatArgs) String() string {
+func (p *HistoryServiceRequestCancelWorkflowExecutionArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceRecordActivityTaskHeartbeatArgs(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceRequestCancelWorkflowExecutionArgs(%+v)", *p)
 }
 
 // Attributes:
-//  - Success
 //  - BadRequestError
 //  - InternalServiceError
 //  - EntityNotExistError
 //  - ShardOwnershipLostError
-type HistoryServiceRecordActivityTaskHeartbeatResult struct {
-  Success *shared.RecordActivityTaskHeartbeatResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+type HistoryServiceRequestCancelWorkflowExecutionResult struct {
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
   ShardOwnershipLostError *ShardOwnershipLostError `thrift:"shardOwnershipLostError,4" db:"shardOwnershipLostError" json:"shardOwnershipLostError,omitempty"`
 }
 
-func NewHistoryServiceRecordActivityTaskHeartbeatResult() *HistoryServiceRecordActivityTaskHeartbeatResult {
-  return &HistoryServiceRecordActivityTaskHeartbeatResult{}
+func NewHistoryServiceRequestCancelWorkflowExecutionResult() *HistoryServiceRequestCancelWorkflowExecutionResult {
+  return &HistoryServiceRequestCancelWorkflowExecutionResult{}
 }
 
-var HistoryServiceRecordActivityTaskHeartbeatResult_Success_DEFAULT *shared.RecordActivityTaskHeartbeatResponse
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult) GetSuccess() *shared.RecordActivityTaskHeartbeatResponse {
-  if !p.IsSetSuccess() {
-    return HistoryServiceRecordActivityTaskHeartbeatResult_Success_DEFAULT
-  }
-return p.Success
-}
-var HistoryServiceRecordActivityTaskHeartbeatResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult) GetBadRequestError() *shared.BadRequestError {
+var HistoryServiceRequestCancelWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *HistoryServiceRequestCancelWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return HistoryServiceRecordActivityTaskHeartbeatResult_BadRequestError_DEFAULT
+    return HistoryServiceRequestCancelWorkflowExecutionResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var HistoryServiceRecordActivityTaskHeartbeatResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult) GetInternalServiceError() *shared.InternalServiceError {
+var HistoryServiceRequestCancelWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *HistoryServiceRequestCancelWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return HistoryServiceRecordActivityTaskHeartbeatResult_InternalServiceError_DEFAULT
+    return HistoryServiceRequestCancelWorkflowExecutionResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var HistoryServiceRecordActivityTaskHeartbeatResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var HistoryServiceRequestCancelWorkflowExecutionResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *HistoryServiceRequestCancelWorkflowExecutionResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return HistoryServiceRecordActivityTaskHeartbeatResult_EntityNotExistError_DEFAULT
+    return HistoryServiceRequestCancelWorkflowExecutionResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-var HistoryServiceRecordActivityTaskHeartbeatResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
+var HistoryServiceRequestCancelWorkflowExecutionResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
+func (p *HistoryServiceRequestCancelWorkflowExecutionResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
   if !p.IsSetShardOwnershipLostError() {
-    return HistoryServiceRecordActivityTaskHeartbeatResult_ShardOwnershipLostError_DEFAULT
+    return HistoryServiceRequestCancelWorkflowExecutionResult_ShardOwnershipLostError_DEFAULT
   }
 return p.ShardOwnershipLostError
 }
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult) IsSetSuccess() bool {
-  return p.Success != nil
-}
-
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult) IsSetBadRequestError() bool {
+func (p *HistoryServiceRequestCancelWorkflowExecutionResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult) IsSetInternalServiceError() bool {
+func (p *HistoryServiceRequestCancelWorkflowExecutionResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult) IsSetEntityNotExistError() bool {
+func (p *HistoryServiceRequestCancelWorkflowExecutionResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult) IsSetShardOwnershipLostError() bool {
+func (p *HistoryServiceRequestCancelWorkflowExecutionResult) IsSetShardOwnershipLostError() bool {
   return p.ShardOwnershipLostError != nil
 }
 
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRequestCancelWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -7616,10 +12402,6 @@ func (p *HistoryServiceRecordActivityTaskHeartbeatResult) Read(iprot thrift.TPro
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
-    case 0:
-      if err := p.ReadField0(iprot); err != nil {
-        return err
-      }
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -7651,15 +12433,7 @@ func (p *HistoryServiceRecordActivityTaskHeartbeatResult) Read(iprot thrift.TPro
   return nil
 }
 
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult)  ReadField0(iprot thrift.TProtocol) error {
-  p.Success = &shared.RecordActivityTaskHeartbeatResponse{}
-  if err := p.Success.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
-  }
-  return nil
-}
-
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRequestCancelWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -7667,7 +12441,7 @@ func (p *HistoryServiceRecordActivityTaskHeartbeatResult)  ReadField1(iprot thri
   return nil
 }
 
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRequestCancelWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -7675,7 +12449,7 @@ func (p *HistoryServiceRecordActivityTaskHeartbeatResult)  ReadField2(iprot thri
   return nil
 }
 
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRequestCancelWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -7683,7 +12457,7 @@ func (p *HistoryServiceRecordActivityTaskHeartbeatResult)  ReadField3(iprot thri
   return nil
 }
 
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult)  ReadField4(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRequestCancelWorkflowExecutionResult)  ReadField4(iprot thrift.TProtocol) error {
   p.ShardOwnershipLostError = &ShardOwnershipLostError{}
   if err := p.ShardOwnershipLostError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ShardOwnershipLostError), err)
@@ -7691,37 +12465,23 @@ func (p *HistoryServiceRecordActivityTaskHeartbeatResult)  ReadField4(iprot thri
   return nil
 }
 
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RecordActivityTaskHeartbeat_result"); err != nil {
+func (p *HistoryServiceRequestCancelWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RequestCancelWorkflowExecution_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
-    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
-    if err := p.writeField3(oprot); err != nil { return err }
-    if err := p.writeField4(oprot); err != nil { return err }
-  }
-  if err := oprot.WriteFieldStop(); err != nil {
-    return thrift.PrependError("write field stop error: ", err) }
-  if err := oprot.WriteStructEnd(); err != nil {
-    return thrift.PrependError("write struct stop error: ", err) }
-  return nil
-}
-
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult) writeField0(oprot thrift.TProtocol) (err error) {
-  if p.IsSetSuccess() {
-    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
-    if err := p.Success.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+    if err := p.writeField3(oprot); err != nil { return err }
+    if err := p.writeField4(oprot); err != nil { return err }
   }
-  return err
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
 }
 
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceRequestCancelWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -7734,7 +12494,7 @@ func (p *HistoryServiceRecordActivityTaskHeartbeatResult) writeField1(oprot thri
   return err
 }
 
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceRequestCancelWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -7747,7 +12507,7 @@ func (p *HistoryServiceRecordActivityTaskHeartbeatResult) writeField2(oprot thri
   return err
 }
 
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceRequestCancelWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -7760,7 +12520,7 @@ func (p *HistoryServiceRecordActivityTaskHeartbeatResult) writeField3(oprot thri
   return err
 }
 
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult) writeField4(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceRequestCancelWorkflowExecutionResult) writeField4(oprot thrift.TProtocol) (err error) {
   if p.IsSetShardOwnershipLostError() {
     if err := oprot.WriteFieldBegin("shardOwnershipLostError", thrift.STRUCT, 4); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:shardOwnershipLostError: ", p), err) }
@@ -7773,35 +12533,35 @@ func (p *HistoryServiceRecordActivityTaskHeartbeatResult) writeField4(oprot thri
   return err
 }
 
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult) String() string {
+func (p *HistoryServiceRequestCancelWorkflowExecutionResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceRecordActivityTaskHeartbeatResult(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceRequestCancelWorkflowExecutionResult(%+v)", *p)
 }
 
 // Attributes:
-//  - CompleteRequest
-type HistoryServiceRespondActivityTaskCompletedArgs struct {
-  CompleteRequest *RespondActivityTaskCompletedRequest `thrift:"completeRequest,1" db:"completeRequest" json:"completeRequest"`
+//  - ScheduleRequest
+type HistoryServiceScheduleDecisionTaskArgs struct {
+  ScheduleRequest *ScheduleDecisionTaskRequest `thrift:"scheduleRequest,1" db:"scheduleRequest" json:"scheduleRequest"`
 }
 
-func NewHistoryServiceRespondActivityTaskCompletedArgs() *HistoryServiceRespondActivityTaskCompletedArgs {
-  return &HistoryServiceRespondActivityTaskCompletedArgs{}
+func NewHistoryServiceScheduleDecisionTaskArgs() *HistoryServiceScheduleDecisionTaskArgs {
+  return &HistoryServiceScheduleDecisionTaskArgs{}
 }
 
-var HistoryServiceRespondActivityTaskCompletedArgs_CompleteRequest_DEFAULT *RespondActivityTaskCompletedRequest
-func (p *HistoryServiceRespondActivityTaskCompletedArgs) GetCompleteRequest() *RespondActivityTaskCompletedRequest {
-  if !p.IsSetCompleteRequest() {
-    return HistoryServiceRespondActivityTaskCompletedArgs_CompleteRequest_DEFAULT
+var HistoryServiceScheduleDecisionTaskArgs_ScheduleRequest_DEFAULT *ScheduleDecisionTaskRequest
+func (p *HistoryServiceScheduleDecisionTaskArgs) GetScheduleRequest() *ScheduleDecisionTaskRequest {
+  if !p.IsSetScheduleRequest() {
+    return HistoryServiceScheduleDecisionTaskArgs_ScheduleRequest_DEFAULT
   }
-return p.CompleteRequest
+return p.ScheduleRequest
 }
-func (p *HistoryServiceRespondActivityTaskCompletedArgs) IsSetCompleteRequest() bool {
-  return p.CompleteRequest != nil
+func (p *HistoryServiceScheduleDecisionTaskArgs) IsSetScheduleRequest() bool {
+  return p.ScheduleRequest != nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCompletedArgs) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceScheduleDecisionTaskArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -7833,16 +12593,16 @@ func (p *HistoryServiceRespondActivityTaskCompletedArgs) Read(iprot thrift.TProt
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCompletedArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.CompleteRequest = &RespondActivityTaskCompletedRequest{}
-  if err := p.CompleteRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CompleteRequest), err)
+func (p *HistoryServiceScheduleDecisionTaskArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.ScheduleRequest = &ScheduleDecisionTaskRequest{}
+  if err := p.ScheduleRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ScheduleRequest), err)
   }
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCompletedArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondActivityTaskCompleted_args"); err != nil {
+func (p *HistoryServiceScheduleDecisionTaskArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ScheduleDecisionTask_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -7854,22 +12614,22 @@ func (p *HistoryServiceRespondActivityTaskCompletedArgs) Write(oprot thrift.TPro
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCompletedArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("completeRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:completeRequest: ", p), err) }
-  if err := p.CompleteRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CompleteRequest), err)
+func (p *HistoryServiceScheduleDecisionTaskArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("scheduleRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:scheduleRequest: ", p), err) }
+  if err := p.ScheduleRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ScheduleRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:completeRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:scheduleRequest: ", p), err) }
   return err
 }
 
-func (p *HistoryServiceRespondActivityTaskCompletedArgs) String() string {
+func (p *HistoryServiceScheduleDecisionTaskArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceRespondActivityTaskCompletedArgs(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceScheduleDecisionTaskArgs(%+v)", *p)
 }
 
 // Attributes:
@@ -7877,62 +12637,62 @@ func (p *HistoryServiceRespondActivityTaskCompletedArgs) String() string {
 //  - InternalServiceError
 //  - EntityNotExistError
 //  - ShardOwnershipLostError
-type HistoryServiceRespondActivityTaskCompletedResult struct {
+type HistoryServiceScheduleDecisionTaskResult struct {
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
   ShardOwnershipLostError *ShardOwnershipLostError `thrift:"shardOwnershipLostError,4" db:"shardOwnershipLostError" json:"shardOwnershipLostError,omitempty"`
 }
 
-func NewHistoryServiceRespondActivityTaskCompletedResult() *HistoryServiceRespondActivityTaskCompletedResult {
-  return &HistoryServiceRespondActivityTaskCompletedResult{}
+func NewHistoryServiceScheduleDecisionTaskResult() *HistoryServiceScheduleDecisionTaskResult {
+  return &HistoryServiceScheduleDecisionTaskResult{}
 }
 
-var HistoryServiceRespondActivityTaskCompletedResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *HistoryServiceRespondActivityTaskCompletedResult) GetBadRequestError() *shared.BadRequestError {
+var HistoryServiceScheduleDecisionTaskResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *HistoryServiceScheduleDecisionTaskResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return HistoryServiceRespondActivityTaskCompletedResult_BadRequestError_DEFAULT
+    return HistoryServiceScheduleDecisionTaskResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var HistoryServiceRespondActivityTaskCompletedResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *HistoryServiceRespondActivityTaskCompletedResult) GetInternalServiceError() *shared.InternalServiceError {
+var HistoryServiceScheduleDecisionTaskResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *HistoryServiceScheduleDecisionTaskResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return HistoryServiceRespondActivityTaskCompletedResult_InternalServiceError_DEFAULT
+    return HistoryServiceScheduleDecisionTaskResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var HistoryServiceRespondActivityTaskCompletedResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *HistoryServiceRespondActivityTaskCompletedResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var HistoryServiceScheduleDecisionTaskResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *HistoryServiceScheduleDecisionTaskResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return HistoryServiceRespondActivityTaskCompletedResult_EntityNotExistError_DEFAULT
+    return HistoryServiceScheduleDecisionTaskResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-var HistoryServiceRespondActivityTaskCompletedResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
-func (p *HistoryServiceRespondActivityTaskCompletedResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
+var HistoryServiceScheduleDecisionTaskResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
+func (p *HistoryServiceScheduleDecisionTaskResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
   if !p.IsSetShardOwnershipLostError() {
-    return HistoryServiceRespondActivityTaskCompletedResult_ShardOwnershipLostError_DEFAULT
+    return HistoryServiceScheduleDecisionTaskResult_ShardOwnershipLostError_DEFAULT
   }
 return p.ShardOwnershipLostError
 }
-func (p *HistoryServiceRespondActivityTaskCompletedResult) IsSetBadRequestError() bool {
+func (p *HistoryServiceScheduleDecisionTaskResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCompletedResult) IsSetInternalServiceError() bool {
+func (p *HistoryServiceScheduleDecisionTaskResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCompletedResult) IsSetEntityNotExistError() bool {
+func (p *HistoryServiceScheduleDecisionTaskResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCompletedResult) IsSetShardOwnershipLostError() bool {
+func (p *HistoryServiceScheduleDecisionTaskResult) IsSetShardOwnershipLostError() bool {
   return p.ShardOwnershipLostError != nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCompletedResult) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceScheduleDecisionTaskResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -7976,7 +12736,7 @@ func (p *HistoryServiceRespondActivityTaskCompletedResult) Read(iprot thrift.TPr
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCompletedResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *HistoryServiceScheduleDecisionTaskResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -7984,7 +12744,7 @@ func (p *HistoryServiceRespondActivityTaskCompletedResult)  ReadField1(iprot thr
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCompletedResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *HistoryServiceScheduleDecisionTaskResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -7992,7 +12752,7 @@ func (p *HistoryServiceRespondActivityTaskCompletedResult)  ReadField2(iprot thr
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCompletedResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *HistoryServiceScheduleDecisionTaskResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -8000,7 +12760,7 @@ func (p *HistoryServiceRespondActivityTaskCompletedResult)  ReadField3(iprot thr
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCompletedResult)  ReadField4(iprot thrift.TProtocol) error {
+func (p *HistoryServiceScheduleDecisionTaskResult)  ReadField4(iprot thrift.TProtocol) error {
   p.ShardOwnershipLostError = &ShardOwnershipLostError{}
   if err := p.ShardOwnershipLostError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ShardOwnershipLostError), err)
@@ -8008,8 +12768,8 @@ func (p *HistoryServiceRespondActivityTaskCompletedResult)  ReadField4(iprot thr
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCompletedResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondActivityTaskCompleted_result"); err != nil {
+func (p *HistoryServiceScheduleDecisionTaskResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ScheduleDecisionTask_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -8024,7 +12784,7 @@ func (p *HistoryServiceRespondActivityTaskCompletedResult) Write(oprot thrift.TP
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCompletedResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceScheduleDecisionTaskResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -8037,7 +12797,7 @@ func (p *HistoryServiceRespondActivityTaskCompletedResult) writeField1(oprot thr
   return err
 }
 
-func (p *HistoryServiceRespondActivityTaskCompletedResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceScheduleDecisionTaskResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -8050,7 +12810,7 @@ func (p *HistoryServiceRespondActivityTaskCompletedResult) writeField2(oprot thr
   return err
 }
 
-func (p *HistoryServiceRespondActivityTaskCompletedResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceScheduleDecisionTaskResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -8063,7 +12823,7 @@ func (p *HistoryServiceRespondActivityTaskCompletedResult) writeField3(oprot thr
   return err
 }
 
-func (p *HistoryServiceRespondActivityTaskCompletedResult) writeField4(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceScheduleDecisionTaskResult) writeField4(oprot thrift.TProtocol) (err error) {
   if p.IsSetShardOwnershipLostError() {
     if err := oprot.WriteFieldBegin("shardOwnershipLostError", thrift.STRUCT, 4); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:shardOwnershipLostError: ", p), err) }
@@ -8076,35 +12836,35 @@ func (p *HistoryServiceRespondActivityTaskCompletedResult) writeField4(oprot thr
   return err
 }
 
-func (p *HistoryServiceRespondActivityTaskCompletedResult) String() string {
+func (p *HistoryServiceScheduleDecisionTaskResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceRespondActivityTaskCompletedResult(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceScheduleDecisionTaskResult(%+v)", *p)
 }
 
 // Attributes:
-//  - FailRequest
-type HistoryServiceRespondActivityTaskFailedArgs struct {
-  FailRequest *RespondActivityTaskFailedRequest `thrift:"failRequest,1" db:"failRequest" json:"failRequest"`
+//  - CompletionRequest
+type HistoryServiceRecordChildExecutionCompletedArgs struct {
+  CompletionRequest *RecordChildExecutionCompletedRequest `thrift:"completionRequest,1" db:"completionRequest" json:"completionRequest"`
 }
 
-func NewHistoryServiceRespondActivityTaskFailedArgs() *HistoryServiceRespondActivityTaskFailedArgs {
-  return &HistoryServiceRespondActivityTaskFailedArgs{}
+func NewHistoryServiceRecordChildExecutionCompletedArgs() *HistoryServiceRecordChildExecutionCompletedArgs {
+  return &HistoryServiceRecordChildExecutionCompletedArgs{}
 }
 
-var HistoryServiceRespondActivityTaskFailedArgs_FailRequest_DEFAULT *RespondActivityTaskFailedRequest
-func (p *HistoryServiceRespondActivityTaskFailedArgs) GetFailRequest() *RespondActivityTaskFailedRequest {
-  if !p.IsSetFailRequest() {
-    return HistoryServiceRespondActivityTaskFailedArgs_FailRequest_DEFAULT
+var HistoryServiceRecordChildExecutionCompletedArgs_CompletionRequest_DEFAULT *RecordChildExecutionCompletedRequest
+func (p *HistoryServiceRecordChildExecutionCompletedArgs) GetCompletionRequest() *RecordChildExecutionCompletedRequest {
+  if !p.IsSetCompletionRequest() {
+    return HistoryServiceRecordChildExecutionCompletedArgs_CompletionRequest_DEFAULT
   }
-return p.FailRequest
+return p.CompletionRequest
 }
-func (p *HistoryServiceRespondActivityTaskFailedArgs) IsSetFailRequest() bool {
-  return p.FailRequest != nil
+func (p *HistoryServiceRecordChildExecutionCompletedArgs) IsSetCompletionRequest() bool {
+  return p.CompletionRequest != nil
 }
 
-func (p *HistoryServiceRespondActivityTaskFailedArgs) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRecordChildExecutionCompletedArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -8136,16 +12896,16 @@ func (p *HistoryServiceRespondActivityTaskFailedArgs) Read(iprot thrift.TProtoco
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskFailedArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.FailRequest = &RespondActivityTaskFailedRequest{}
-  if err := p.FailRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.FailRequest), err)
+func (p *HistoryServiceRecordChildExecutionCompletedArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.CompletionRequest = &RecordChildExecutionCompletedRequest{}
+  if err := p.CompletionRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CompletionRequest), err)
   }
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskFailedArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondActivityTaskFailed_args"); err != nil {
+func (p *HistoryServiceRecordChildExecutionCompletedArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RecordChildExecutionCompleted_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -8157,22 +12917,22 @@ func (p *HistoryServiceRespondActivityTaskFailedArgs) Write(oprot thrift.TProtoc
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskFailedArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("failRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:failRequest: ", p), err) }
-  if err := p.FailRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.FailRequest), err)
+func (p *HistoryServiceRecordChildExecutionCompletedArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("completionRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:completionRequest: ", p), err) }
+  if err := p.CompletionRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CompletionRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:failRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:completionRequest: ", p), err) }
   return err
 }
 
-func (p *HistoryServiceRespondActivityTaskFailedArgs) String() string {
+func (p *HistoryServiceRecordChildExecutionCompletedArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceRespondActivityTaskFailedArgs(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceRecordChildExecutionCompletedArgs(%+v)", *p)
 }
 
 // Attributes:
@@ -8180,62 +12940,62 @@ func (p *HistoryServiceRespondActivityTaskFailedArgs) String() string {
 //  - InternalServiceError
 //  - EntityNotExistError
 //  - ShardOwnershipLostError
-type HistoryServiceRespondActivityTaskFailedResult struct {
+type HistoryServiceRecordChildExecutionCompletedResult struct {
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
   ShardOwnershipLostError *ShardOwnershipLostError `thrift:"shardOwnershipLostError,4" db:"shardOwnershipLostError" json:"shardOwnershipLostError,omitempty"`
 }
 
-func NewHistoryServiceRespondActivityTaskFailedResult() *HistoryServiceRespondActivityTaskFailedResult {
-  return &HistoryServiceRespondActivityTaskFailedResult{}
+func NewHistoryServiceRecordChildExecutionCompletedResult() *HistoryServiceRecordChildExecutionCompletedResult {
+  return &HistoryServiceRecordChildExecutionCompletedResult{}
 }
 
-var HistoryServiceRespondActivityTaskFailedResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *HistoryServiceRespondActivityTaskFailedResult) GetBadRequestError() *shared.BadRequestError {
+var HistoryServiceRecordChildExecutionCompletedResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *HistoryServiceRecordChildExecutionCompletedResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return HistoryServiceRespondActivityTaskFailedResult_BadRequestError_DEFAULT
+    return HistoryServiceRecordChildExecutionCompletedResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var HistoryServiceRespondActivityTaskFailedResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *HistoryServiceRespondActivityTaskFailedResult) GetInternalServiceError() *shared.InternalServiceError {
+var HistoryServiceRecordChildExecutionCompletedResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *HistoryServiceRecordChildExecutionCompletedResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return HistoryServiceRespondActivityTaskFailedResult_InternalServiceError_DEFAULT
+    return HistoryServiceRecordChildExecutionCompletedResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var HistoryServiceRespondActivityTaskFailedResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *HistoryServiceRespondActivityTaskFailedResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var HistoryServiceRecordChildExecutionCompletedResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *HistoryServiceRecordChildExecutionCompletedResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return HistoryServiceRespondActivityTaskFailedResult_EntityNotExistError_DEFAULT
+    return HistoryServiceRecordChildExecutionCompletedResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-var HistoryServiceRespondActivityTaskFailedResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
-func (p *HistoryServiceRespondActivityTaskFailedResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
+var HistoryServiceRecordChildExecutionCompletedResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
+func (p *HistoryServiceRecordChildExecutionCompletedResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
   if !p.IsSetShardOwnershipLostError() {
-    return HistoryServiceRespondActivityTaskFailedResult_ShardOwnershipLostError_DEFAULT
+    return HistoryServiceRecordChildExecutionCompletedResult_ShardOwnershipLostError_DEFAULT
   }
 return p.ShardOwnershipLostError
 }
-func (p *HistoryServiceRespondActivityTaskFailedResult) IsSetBadRequestError() bool {
+func (p *HistoryServiceRecordChildExecutionCompletedResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *HistoryServiceRespondActivityTaskFailedResult) IsSetInternalServiceError() bool {
+func (p *HistoryServiceRecordChildExecutionCompletedResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *HistoryServiceRespondActivityTaskFailedResult) IsSetEntityNotExistError() bool {
+func (p *HistoryServiceRecordChildExecutionCompletedResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *HistoryServiceRespondActivityTaskFailedResult) IsSetShardOwnershipLostError() bool {
+func (p *HistoryServiceRecordChildExecutionCompletedResult) IsSetShardOwnershipLostError() bool {
   return p.ShardOwnershipLostError != nil
 }
 
-func (p *HistoryServiceRespondActivityTaskFailedResult) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRecordChildExecutionCompletedResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -8279,7 +13039,7 @@ func (p *HistoryServiceRespondActivityTaskFailedResult) Read(iprot thrift.TProto
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskFailedResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRecordChildExecutionCompletedResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -8287,7 +13047,7 @@ func (p *HistoryServiceRespondActivityTaskFailedResult)  ReadField1(iprot thrift
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskFailedResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRecordChildExecutionCompletedResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -8295,7 +13055,7 @@ func (p *HistoryServiceRespondActivityTaskFailedResult)  ReadField2(iprot thrift
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskFailedResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRecordChildExecutionCompletedResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -8303,7 +13063,7 @@ func (p *HistoryServiceRespondActivityTaskFailedResult)  ReadField3(iprot thrift
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskFailedResult)  ReadField4(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRecordChildExecutionCompletedResult)  ReadField4(iprot thrift.TProtocol) error {
   p.ShardOwnershipLostError = &ShardOwnershipLostError{}
   if err := p.ShardOwnershipLostError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ShardOwnershipLostError), err)
@@ -8311,8 +13071,8 @@ func (p *HistoryServiceRespondActivityTaskFailedResult)  ReadField4(iprot thrift
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskFailedResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondActivityTaskFailed_result"); err != nil {
+func (p *HistoryServiceRecordChildExecutionCompletedResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RecordChildExecutionCompleted_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -8327,7 +13087,7 @@ func (p *HistoryServiceRespondActivityTaskFailedResult) Write(oprot thrift.TProt
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskFailedResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceRecordChildExecutionCompletedResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -8340,7 +13100,7 @@ func (p *HistoryServiceRespondActivityTaskFailedResult) writeField1(oprot thrift
   return err
 }
 
-func (p *HistoryServiceRespondActivityTaskFailedResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceRecordChildExecutionCompletedResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -8353,7 +13113,7 @@ func (p *HistoryServiceRespondActivityTaskFailedResult) writeField2(oprot thrift
   return err
 }
 
-func (p *HistoryServiceRespondActivityTaskFailedResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceRecordChildExecutionCompletedResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -8366,7 +13126,7 @@ func (p *HistoryServiceRespondActivityTaskFailedResult) writeField3(oprot thrift
   return err
 }
 
-func (p *HistoryServiceRespondActivityTaskFailedResult) writeField4(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceRecordChildExecutionCompletedResult) writeField4(oprot thrift.TProtocol) (err error) {
   if p.IsSetShardOwnershipLostError() {
     if err := oprot.WriteFieldBegin("shardOwnershipLostError", thrift.STRUCT, 4); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:shardOwnershipLostError: ", p), err) }
@@ -8379,35 +13139,37 @@ func (p *HistoryServiceRespondActivityTaskFailedResult) writeField4(oprot thrift
   return err
 }
 
-func (p *HistoryServiceRespondActivityTaskFailedResult) String() string {
+func (p *HistoryServiceRecordChildExecutionCompletedResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceRespondActivityTaskFailedResult(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceRecordChildExecutionCompletedResult(%+v)", *p)
 }
 
+
+
 // Attributes:
-//  - CanceledRequest
-type HistoryServiceRespondActivityTaskCanceledArgs struct {
-  CanceledRequest *RespondActivityTaskCanceledRequest `thrift:"canceledRequest,1" db:"canceledRequest" json:"canceledRequest"`
+//  - Request
+type HistoryServiceDescribeShardArgs struct {
+  Request *DescribeShardRequest `thrift:"request,1" db:"request" json:"request"`
 }
 
-func NewHistoryServiceRespondActivityTaskCanceledArgs() *HistoryServiceRespondActivityTaskCanceledArgs {
-  return &HistoryServiceRespondActivityTaskCanceledArgs{}
+func NewHistoryServiceDescribeShardArgs() *HistoryServiceDescribeShardArgs {
+  return &HistoryServiceDescribeShardArgs{}
 }
 
-var HistoryServiceRespondActivityTaskCanceledArgs_CanceledRequest_DEFAULT *RespondActivityTaskCanceledRequest
-func (p *HistoryServiceRespondActivityTaskCanceledArgs) GetCanceledRequest() *RespondActivityTaskCanceledRequest {
-  if !p.IsSetCanceledRequest() {
-    return HistoryServiceRespondActivityTaskCanceledArgs_CanceledRequest_DEFAULT
+var HistoryServiceDescribeShardArgs_Request_DEFAULT *DescribeShardRequest
+func (p *HistoryServiceDescribeShardArgs) GetRequest() *DescribeShardRequest {
+  if !p.IsSetRequest() {
+    return HistoryServiceDescribeShardArgs_Request_DEFAULT
   }
-return p.CanceledRequest
+return p.Request
 }
-func (p *HistoryServiceRespondActivityTaskCanceledArgs) IsSetCanceledRequest() bool {
-  return p.CanceledRequest != nil
+func (p *HistoryServiceDescribeShardArgs) IsSetRequest() bool {
+  return p.Request != nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCanceledArgs) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceDescribeShardArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -8439,16 +13201,16 @@ func (p *HistoryServiceRespondActivityTaskCanceledArgs) Read(iprot thrift.TProto
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCanceledArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.CanceledRequest = &RespondActivityTaskCanceledRequest{}
-  if err := p.CanceledRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CanceledRequest), err)
+func (p *HistoryServiceDescribeShardArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.Request = &DescribeShardRequest{}
+  if err := p.Request.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Request), err)
   }
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCanceledArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondActivityTaskCanceled_args"); err != nil {
+func (p *HistoryServiceDescribeShardArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("DescribeShard_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -8460,85 +13222,98 @@ func (p *HistoryServiceRespondActivityTaskCanceledArgs) Write(oprot thrift.TProt
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCanceledArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("canceledRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:canceledRequest: ", p), err) }
-  if err := p.CanceledRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CanceledRequest), err)
+func (p *HistoryServiceDescribeShardArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:request: ", p), err) }
+  if err := p.Request.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Request), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:canceledRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:request: ", p), err) }
   return err
 }
 
-func (p *HistoryServiceRespondActivityTaskCanceledArgs) String() string {
+func (p *HistoryServiceDescribeShardArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceRespondActivityTaskCanceledArgs(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceDescribeShardArgs(%+v)", *p)
 }
 
 // Attributes:
+//  - Success
 //  - BadRequestError
 //  - InternalServiceError
 //  - EntityNotExistError
 //  - ShardOwnershipLostError
-type HistoryServiceRespondActivityTaskCanceledResult struct {
+type HistoryServiceDescribeShardResult struct {
+  Success *DescribeShardResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
   ShardOwnershipLostError *ShardOwnershipLostError `thrift:"shardOwnershipLostError,4" db:"shardOwnershipLostError" json:"shardOwnershipLostError,omitempty"`
 }
 
-func NewHistoryServiceRespondActivityTaskCanceledResult() *HistoryServiceRespondActivityTaskCanceledResult {
-  return &HistoryServiceRespondActivityTaskCanceledResult{}
+func NewHistoryServiceDescribeShardResult() *HistoryServiceDescribeShardResult {
+  return &HistoryServiceDescribeShardResult{}
 }
 
-var HistoryServiceRespondActivityTaskCanceledResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *HistoryServiceRespondActivityTaskCanceledResult) GetBadRequestError() *shared.BadRequestError {
+var HistoryServiceDescribeShardResult_Success_DEFAULT *DescribeShardResponse
+func (p *HistoryServiceDescribeShardResult) GetSuccess() *DescribeShardResponse {
+  if !p.IsSetSuccess() {
+    return HistoryServiceDescribeShardResult_Success_DEFAULT
+  }
+return p.Success
+}
+var HistoryServiceDescribeShardResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *HistoryServiceDescribeShardResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return HistoryServiceRespondActivityTaskCanceledResult_BadRequestError_DEFAULT
+    return HistoryServiceDescribeShardResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var HistoryServiceRespondActivityTaskCanceledResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *HistoryServiceRespondActivityTaskCanceledResult) GetInternalServiceError() *shared.InternalServiceError {
+var HistoryServiceDescribeShardResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *HistoryServiceDescribeShardResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return HistoryServiceRespondActivityTaskCanceledResult_InternalServiceError_DEFAULT
+    return HistoryServiceDescribeShardResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var HistoryServiceRespondActivityTaskCanceledResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *HistoryServiceRespondActivityTaskCanceledResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var HistoryServiceDescribeShardResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *HistoryServiceDescribeShardResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return HistoryServiceRespondActivityTaskCanceledResult_EntityNotExistError_DEFAULT
+    return HistoryServiceDescribeShardResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-var HistoryServiceRespondActivityTaskCanceledResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
-func (p *HistoryServiceRespondActivityTaskCanceledResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
+var HistoryServiceDescribeShardResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
+func (p *HistoryServiceDescribeShardResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
   if !p.IsSetShardOwnershipLostError() {
-    return HistoryServiceRespondActivityTaskCanceledResult_ShardOwnershipLostError_DEFAULT
+    return HistoryServiceDescribeShardResult_ShardOwnershipLostError_DEFAULT
   }
 return p.ShardOwnershipLostError
 }
-func (p *HistoryServiceRespondActivityTaskCanceledResult) IsSetBadRequestError() bool {
+func (p *HistoryServiceDescribeShardResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *HistoryServiceDescribeShardResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCanceledResult) IsSetInternalServiceError() bool {
+func (p *HistoryServiceDescribeShardResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCanceledResult) IsSetEntityNotExistError() bool {
+func (p *HistoryServiceDescribeShardResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCanceledResult) IsSetShardOwnershipLostError() bool {
+func (p *HistoryServiceDescribeShardResult) IsSetShardOwnershipLostError() bool {
   return p.ShardOwnershipLostError != nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCanceledResult) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceDescribeShardResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -8551,6 +13326,10 @@ func (p *HistoryServiceRespondActivityTaskCanceledResult) Read(iprot thrift.TPro
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -8582,7 +13361,15 @@ func (p *HistoryServiceRespondActivityTaskCanceledResult) Read(iprot thrift.TPro
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCanceledResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *HistoryServiceDescribeShardResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &DescribeShardResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceDescribeShardResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -8590,7 +13377,7 @@ func (p *HistoryServiceRespondActivityTaskCanceledResult)  ReadField1(iprot thri
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCanceledResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *HistoryServiceDescribeShardResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -8598,7 +13385,7 @@ func (p *HistoryServiceRespondActivityTaskCanceledResult)  ReadField2(iprot thri
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCanceledResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *HistoryServiceDescribeShardResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -8606,7 +13393,7 @@ func (p *HistoryServiceRespondActivityTaskCanceledResult)  ReadField3(iprot thri
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCanceledResult)  ReadField4(iprot thrift.TProtocol) error {
+func (p *HistoryServiceDescribeShardResult)  ReadField4(iprot thrift.TProtocol) error {
   p.ShardOwnershipLostError = &ShardOwnershipLostError{}
   if err := p.ShardOwnershipLostError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ShardOwnershipLostError), err)
@@ -8614,10 +13401,11 @@ func (p *HistoryServiceRespondActivityTaskCanceledResult)  ReadField4(iprot thri
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCanceledResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondActivityTaskCanceled_result"); err != nil {
+func (p *HistoryServiceDescribeShardResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("DescribeShard_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
@@ -8630,7 +13418,20 @@ func (p *HistoryServiceRespondActivityTaskCanceledResult) Write(oprot thrift.TPr
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCanceledResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceDescribeShardResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceDescribeShardResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -8643,7 +13444,7 @@ func (p *HistoryServiceRespondActivityTaskCanceledResult) writeField1(oprot thri
   return err
 }
 
-func (p *HistoryServiceRespondActivityTaskCanceledResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceDescribeShardResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -8656,7 +13457,7 @@ func (p *HistoryServiceRespondActivityTaskCanceledResult) writeField2(oprot thri
   return err
 }
 
-func (p *HistoryServiceRespondActivityTaskCanceledResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceDescribeShardResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -8669,7 +13470,7 @@ func (p *HistoryServiceRespondActivityTaskCanceledResult) writeField3(oprot thri
   return err
 }
 
-func (p *HistoryServiceRespondActivityTaskCanceledResult) writeField4(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceDescribeShardResult) writeField4(oprot thrift.TProtocol) (err error) {
   if p.IsSetShardOwnershipLostError() {
     if err := oprot.WriteFieldBegin("shardOwnershipLostError", thrift.STRUCT, 4); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:shardOwnershipLostError: ", p), err) }
@@ -8682,35 +13483,35 @@ func (p *HistoryServiceRespondActivityTaskCanceledResult) writeField4(oprot thri
   return err
 }
 
-func (p *HistoryServiceRespondActivityTaskCanceledResult) String() string {
+func (p *HistoryServiceDescribeShardResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceRespondActivityTaskCanceledResult(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceDescribeShardResult(%+v)", *p)
 }
 
 // Attributes:
-//  - SignalRequest
-type HistoryServiceSignalWorkflowExecutionArgs struct {
-  SignalRequest *SignalWorkflowExecutionRequest `thrift:"signalRequest,1" db:"signalRequest" json:"signalRequest"`
+//  - Request
+type HistoryServiceInvalidateMutableStateArgs struct {
+  Request *InvalidateMutableStateRequest `thrift:"request,1" db:"request" json:"request"`
 }
 
-func NewHistoryServiceSignalWorkflowExecutionArgs() *HistoryServiceSignalWorkflowExecutionArgs {
-  return &HistoryServiceSignalWorkflowExecutionArgs{}
+func NewHistoryServiceInvalidateMutableStateArgs() *HistoryServiceInvalidateMutableStateArgs {
+  return &HistoryServiceInvalidateMutableStateArgs{}
 }
 
-var HistoryServiceSignalWorkflowExecutionArgs_SignalRequest_DEFAULT *SignalWorkflowExecutionRequest
-func (p *HistoryServiceSignalWorkflowExecutionArgs) GetSignalRequest() *SignalWorkflowExecutionRequest {
-  if !p.IsSetSignalRequest() {
-    return HistoryServiceSignalWorkflowExecutionArgs_SignalRequest_DEFAULT
+var HistoryServiceInvalidateMutableStateArgs_Request_DEFAULT *InvalidateMutableStateRequest
+func (p *HistoryServiceInvalidateMutableStateArgs) GetRequest() *InvalidateMutableStateRequest {
+  if !p.IsSetRequest() {
+    return HistoryServiceInvalidateMutableStateArgs_Request_DEFAULT
   }
-return p.SignalRequest
+return p.Request
 }
-func (p *HistoryServiceSignalWorkflowExecutionArgs) IsSetSignalRequest() bool {
-  return p.SignalRequest != nil
+func (p *HistoryServiceInvalidateMutableStateArgs) IsSetRequest() bool {
+  return p.Request != nil
 }
 
-func (p *HistoryServiceSignalWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceInvalidateMutableStateArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -8742,16 +13543,16 @@ func (p *HistoryServiceSignalWorkflowExecutionArgs) Read(iprot thrift.TProtocol)
   return nil
 }
 
-func (p *HistoryServiceSignalWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.SignalRequest = &SignalWorkflowExecutionRequest{}
-  if err := p.SignalRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.SignalRequest), err)
+func (p *HistoryServiceInvalidateMutableStateArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.Request = &InvalidateMutableStateRequest{}
+  if err := p.Request.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Request), err)
   }
   return nil
 }
 
-func (p *HistoryServiceSignalWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("SignalWorkflowExecution_args"); err != nil {
+func (p *HistoryServiceInvalidateMutableStateArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("InvalidateMutableState_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -8763,22 +13564,22 @@ func (p *HistoryServiceSignalWorkflowExecutionArgs) Write(oprot thrift.TProtocol
   return nil
 }
 
-func (p *HistoryServiceSignalWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("signalRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:signalRequest: ", p), err) }
-  if err := p.SignalRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.SignalRequest), err)
+func (p *HistoryServiceInvalidateMutableStateArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:request: ", p), err) }
+  if err := p.Request.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Request), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:signalRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:request: ", p), err) }
   return err
 }
 
-func (p *HistoryServiceSignalWorkflowExecutionArgs) String() string {
+func (p *HistoryServiceInvalidateMutableStateArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceSignalWorkflowExecutionArgs(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceInvalidateMutableStateArgs(%+v)", *p)
 }
 
 // Attributes:
@@ -8786,62 +13587,62 @@ func (p *HistoryServiceSignalWorkflowExecutionArgs) String() string {
 //  - InternalServiceError
 //  - EntityNotExistError
 //  - ShardOwnershipLostError
-type HistoryServiceSignalWorkflowExecutionResult struct {
+type HistoryServiceInvalidateMutableStateResult struct {
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
   ShardOwnershipLostError *ShardOwnershipLostError `thrift:"shardOwnershipLostError,4" db:"shardOwnershipLostError" json:"shardOwnershipLostError,omitempty"`
 }
 
-func NewHistoryServiceSignalWorkflowExecutionResult() *HistoryServiceSignalWorkflowExecutionResult {
-  return &HistoryServiceSignalWorkflowExecutionResult{}
+func NewHistoryServiceInvalidateMutableStateResult() *HistoryServiceInvalidateMutableStateResult {
+  return &HistoryServiceInvalidateMutableStateResult{}
 }
 
-var HistoryServiceSignalWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *HistoryServiceSignalWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
+var HistoryServiceInvalidateMutableStateResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *HistoryServiceInvalidateMutableStateResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return HistoryServiceSignalWorkflowExecutionResult_BadRequestError_DEFAULT
+    return HistoryServiceInvalidateMutableStateResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var HistoryServiceSignalWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *HistoryServiceSignalWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
+var HistoryServiceInvalidateMutableStateResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *HistoryServiceInvalidateMutableStateResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return HistoryServiceSignalWorkflowExecutionResult_InternalServiceError_DEFAULT
+    return HistoryServiceInvalidateMutableStateResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var HistoryServiceSignalWorkflowExecutionResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *HistoryServiceSignalWorkflowExecutionResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var HistoryServiceInvalidateMutableStateResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *HistoryServiceInvalidateMutableStateResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return HistoryServiceSignalWorkflowExecutionResult_EntityNotExistError_DEFAULT
+    return HistoryServiceInvalidateMutableStateResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-var HistoryServiceSignalWorkflowExecutionResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
-func (p *HistoryServiceSignalWorkflowExecutionResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
+var HistoryServiceInvalidateMutableStateResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
+func (p *HistoryServiceInvalidateMutableStateResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
   if !p.IsSetShardOwnershipLostError() {
-    return HistoryServiceSignalWorkflowExecutionResult_ShardOwnershipLostError_DEFAULT
+    return HistoryServiceInvalidateMutableStateResult_ShardOwnershipLostError_DEFAULT
   }
 return p.ShardOwnershipLostError
 }
-func (p *HistoryServiceSignalWorkflowExecutionResult) IsSetBadRequestError() bool {
+func (p *HistoryServiceInvalidateMutableStateResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *HistoryServiceSignalWorkflowExecutionResult) IsSetInternalServiceError() bool {
+func (p *HistoryServiceInvalidateMutableStateResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *HistoryServiceSignalWorkflowExecutionResult) IsSetEntityNotExistError() bool {
+func (p *HistoryServiceInvalidateMutableStateResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *HistoryServiceSignalWorkflowExecutionResult) IsSetShardOwnershipLostError() bool {
+func (p *HistoryServiceInvalidateMutableStateResult) IsSetShardOwnershipLostError() bool {
   return p.ShardOwnershipLostError != nil
 }
 
-func (p *HistoryServiceSignalWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceInvalidateMutableStateResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -8885,7 +13686,7 @@ func (p *HistoryServiceSignalWorkflowExecutionResult) Read(iprot thrift.TProtoco
   return nil
 }
 
-func (p *HistoryServiceSignalWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *HistoryServiceInvalidateMutableStateResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -8893,7 +13694,7 @@ func (p *HistoryServiceSignalWorkflowExecutionResult)  ReadField1(iprot thrift.T
   return nil
 }
 
-func (p *HistoryServiceSignalWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *HistoryServiceInvalidateMutableStateResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -8901,7 +13702,7 @@ func (p *HistoryServiceSignalWorkflowExecutionResult)  ReadField2(iprot thrift.T
   return nil
 }
 
-func (p *HistoryServiceSignalWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *HistoryServiceInvalidateMutableStateResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -8909,7 +13710,7 @@ func (p *HistoryServiceSignalWorkflowExecutionResult)  ReadField3(iprot thrift.T
   return nil
 }
 
-func (p *HistoryServiceSignalWorkflowExecutionResult)  ReadField4(iprot thrift.TProtocol) error {
+func (p *HistoryServiceInvalidateMutableStateResult)  ReadField4(iprot thrift.TProtocol) error {
   p.ShardOwnershipLostError = &ShardOwnershipLostError{}
   if err := p.ShardOwnershipLostError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ShardOwnershipLostError), err)
@@ -8917,8 +13718,8 @@ func (p *HistoryServiceSignalWorkflowExecutionResult)  ReadField4(iprot thrift.T
   return nil
 }
 
-func (p *HistoryServiceSignalWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("SignalWorkflowExecution_result"); err != nil {
+func (p *HistoryServiceInvalidateMutableStateResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("InvalidateMutableState_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -8933,7 +13734,7 @@ func (p *HistoryServiceSignalWorkflowExecutionResult) Write(oprot thrift.TProtoc
   return nil
 }
 
-func (p *HistoryServiceSignalWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceInvalidateMutableStateResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -8946,7 +13747,7 @@ func (p *HistoryServiceSignalWorkflowExecutionResult) writeField1(oprot thrift.T
   return err
 }
 
-func (p *HistoryServiceSignalWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceInvalidateMutableStateResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -8959,7 +13760,7 @@ func (p *HistoryServiceSignalWorkflowExecutionResult) writeField2(oprot thrift.T
   return err
 }
 
-func (p *HistoryServiceSignalWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceInvalidateMutableStateResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -8972,7 +13773,7 @@ func (p *HistoryServiceSignalWorkflowExecutionResult) writeField3(oprot thrift.T
   return err
 }
 
-func (p *HistoryServiceSignalWorkflowExecutionResult) writeField4(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceInvalidateMutableStateResult) writeField4(oprot thrift.TProtocol) (err error) {
   if p.IsSetShardOwnershipLostError() {
     if err := oprot.WriteFieldBegin("shardOwnershipLostError", thrift.STRUCT, 4); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:shardOwnershipLostError: ", p), err) }
@@ -8985,35 +13786,35 @@ func (p *HistoryServiceSignalWorkflowExecutionResult) writeField4(oprot thrift.T
   return err
 }
 
-func (p *HistoryServiceSignalWorkflowExecutionResult) String() string {
+func (p *HistoryServiceInvalidateMutableStateResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceSignalWorkflowExecutionResult(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceInvalidateMutableStateResult(%+v)", *p)
 }
 
 // Attributes:
-//  - TerminateRequest
-type HistoryServiceTerminateWorkflowExecutionArgs struct {
-  TerminateRequest *TerminateWorkflowExecutionRequest `thrift:"terminateRequest,1" db:"terminateRequest" json:"terminateRequest"`
+//  - Request
+type HistoryServiceSetTaskProcessingPausedArgs struct {
+  Request *SetTaskProcessingPausedRequest `thrift:"request,1" db:"request" json:"request"`
 }
 
-func NewHistoryServiceTerminateWorkflowExecutionArgs() *HistoryServiceTerminateWorkflowExecutionArgs {
-  return &HistoryServiceTerminateWorkflowExecutionArgs{}
+func NewHistoryServiceSetTaskProcessingPausedArgs() *HistoryServiceSetTaskProcessingPausedArgs {
+  return &HistoryServiceSetTaskProcessingPausedArgs{}
 }
 
-var HistoryServiceTerminateWorkflowExecutionArgs_TerminateRequest_DEFAULT *TerminateWorkflowExecutionRequest
-func (p *HistoryServiceTerminateWorkflowExecutionArgs) GetTerminateRequest() *TerminateWorkflowExecutionRequest {
-  if !p.IsSetTerminateRequest() {
-    return HistoryServiceTerminateWorkflowExecutionArgs_TerminateRequest_DEFAULT
+var HistoryServiceSetTaskProcessingPausedArgs_Request_DEFAULT *SetTaskProcessingPausedRequest
+func (p *HistoryServiceSetTaskProcessingPausedArgs) GetRequest() *SetTaskProcessingPausedRequest {
+  if !p.IsSetRequest() {
+    return HistoryServiceSetTaskProcessingPausedArgs_Request_DEFAULT
   }
-return p.TerminateRequest
+return p.Request
 }
-func (p *HistoryServiceTerminateWorkflowExecutionArgs) IsSetTerminateRequest() bool {
-  return p.TerminateRequest != nil
+func (p *HistoryServiceSetTaskProcessingPausedArgs) IsSetRequest() bool {
+  return p.Request != nil
 }
 
-func (p *HistoryServiceTerminateWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceSetTaskProcessingPausedArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -9045,16 +13846,16 @@ func (p *HistoryServiceTerminateWorkflowExecutionArgs) Read(iprot thrift.TProtoc
   return nil
 }
 
-func (p *HistoryServiceTerminateWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.TerminateRequest = &TerminateWorkflowExecutionRequest{}
-  if err := p.TerminateRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TerminateRequest), err)
+func (p *HistoryServiceSetTaskProcessingPausedArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.Request = &SetTaskProcessingPausedRequest{}
+  if err := p.Request.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Request), err)
   }
   return nil
 }
 
-func (p *HistoryServiceTerminateWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("TerminateWorkflowExecution_args"); err != nil {
+func (p *HistoryServiceSetTaskProcessingPausedArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("SetTaskProcessingPaused_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -9066,22 +13867,22 @@ func (p *HistoryServiceTerminateWorkflowExecutionArgs) Write(oprot thrift.TProto
   return nil
 }
 
-func (p *HistoryServiceTerminateWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("terminateRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:terminateRequest: ", p), err) }
-  if err := p.TerminateRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TerminateRequest), err)
+func (p *HistoryServiceSetTaskProcessingPausedArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:request: ", p), err) }
+  if err := p.Request.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Request), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:terminateRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:request: ", p), err) }
   return err
 }
 
-func (p *HistoryServiceTerminateWorkflowExecutionArgs) String() string {
+func (p *HistoryServiceSetTaskProcessingPausedArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceTerminateWorkflowExecutionArgs(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceSetTaskProcessingPausedArgs(%+v)", *p)
 }
 
 // Attributes:
@@ -9089,62 +13890,62 @@ func (p *HistoryServiceTerminateWorkflowExecutionArgs) String() string {
 //  - InternalServiceError
 //  - EntityNotExistError
 //  - ShardOwnershipLostError
-type HistoryServiceTerminateWorkflowExecutionResult struct {
+type HistoryServiceSetTaskProcessingPausedResult struct {
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
   ShardOwnershipLostError *ShardOwnershipLostError `thrift:"shardOwnershipLostError,4" db:"shardOwnershipLostError" json:"shardOwnershipLostError,omitempty"`
 }
 
-func NewHistoryServiceTerminateWorkflowExecutionResult() *HistoryServiceTerminateWorkflowExecutionResult {
-  return &HistoryServiceTerminateWorkflowExecutionResult{}
+func NewHistoryServiceSetTaskProcessingPausedResult() *HistoryServiceSetTaskProcessingPausedResult {
+  return &HistoryServiceSetTaskProcessingPausedResult{}
 }
 
-var HistoryServiceTerminateWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *HistoryServiceTerminateWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
+var HistoryServiceSetTaskProcessingPausedResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *HistoryServiceSetTaskProcessingPausedResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return HistoryServiceTerminateWorkflowExecutionResult_BadRequestError_DEFAULT
+    return HistoryServiceSetTaskProcessingPausedResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var HistoryServiceTerminateWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *HistoryServiceTerminateWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
+var HistoryServiceSetTaskProcessingPausedResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *HistoryServiceSetTaskProcessingPausedResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return HistoryServiceTerminateWorkflowExecutionResult_InternalServiceError_DEFAULT
+    return HistoryServiceSetTaskProcessingPausedResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var HistoryServiceTerminateWorkflowExecutionResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *HistoryServiceTerminateWorkflowExecutionResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var HistoryServiceSetTaskProcessingPausedResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *HistoryServiceSetTaskProcessingPausedResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return HistoryServiceTerminateWorkflowExecutionResult_EntityNotExistError_DEFAULT
+    return HistoryServiceSetTaskProcessingPausedResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-var HistoryServiceTerminateWorkflowExecutionResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
-func (p *HistoryServiceTerminateWorkflowExecutionResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
+var HistoryServiceSetTaskProcessingPausedResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
+func (p *HistoryServiceSetTaskProcessingPausedResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
   if !p.IsSetShardOwnershipLostError() {
-    return HistoryServiceTerminateWorkflowExecutionResult_ShardOwnershipLostError_DEFAULT
+    return HistoryServiceSetTaskProcessingPausedResult_ShardOwnershipLostError_DEFAULT
   }
 return p.ShardOwnershipLostError
 }
-func (p *HistoryServiceTerminateWorkflowExecutionResult) IsSetBadRequestError() bool {
+func (p *HistoryServiceSetTaskProcessingPausedResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *HistoryServiceTerminateWorkflowExecutionResult) IsSetInternalServiceError() bool {
+func (p *HistoryServiceSetTaskProcessingPausedResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *HistoryServiceTerminateWorkflowExecutionResult) IsSetEntityNotExistError() bool {
+func (p *HistoryServiceSetTaskProcessingPausedResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *HistoryServiceTerminateWorkflowExecutionResult) IsSetShardOwnershipLostError() bool {
+func (p *HistoryServiceSetTaskProcessingPausedResult) IsSetShardOwnershipLostError() bool {
   return p.ShardOwnershipLostError != nil
 }
 
-func (p *HistoryServiceTerminateWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceSetTaskProcessingPausedResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -9188,7 +13989,7 @@ func (p *HistoryServiceTerminateWorkflowExecutionResult) Read(iprot thrift.TProt
   return nil
 }
 
-func (p *HistoryServiceTerminateWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *HistoryServiceSetTaskProcessingPausedResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -9196,7 +13997,7 @@ func (p *HistoryServiceTerminateWorkflowExecutionResult)  ReadField1(iprot thrif
   return nil
 }
 
-func (p *HistoryServiceTerminateWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *HistoryServiceSetTaskProcessingPausedResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -9204,7 +14005,7 @@ func (p *HistoryServiceTerminateWorkflowExecutionResult)  ReadField2(iprot thrif
   return nil
 }
 
-func (p *HistoryServiceTerminateWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *HistoryServiceSetTaskProcessingPausedResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -9212,7 +14013,7 @@ func (p *HistoryServiceTerminateWorkflowExecutionResult)  ReadField3(iprot thrif
   return nil
 }
 
-func (p *HistoryServiceTerminateWorkflowExecutionResult)  ReadField4(iprot thrift.TProtocol) error {
+func (p *HistoryServiceSetTaskProcessingPausedResult)  ReadField4(iprot thrift.TProtocol) error {
   p.ShardOwnershipLostError = &ShardOwnershipLostError{}
   if err := p.ShardOwnershipLostError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ShardOwnershipLostError), err)
@@ -9220,8 +14021,8 @@ func (p *HistoryServiceTerminateWorkflowExecutionResult)  ReadField4(iprot thrif
   return nil
 }
 
-func (p *HistoryServiceTerminateWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("TerminateWorkflowExecution_result"); err != nil {
+func (p *HistoryServiceSetTaskProcessingPausedResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("SetTaskProcessingPaused_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -9236,7 +14037,7 @@ func (p *HistoryServiceTerminateWorkflowExecutionResult) Write(oprot thrift.TPro
   return nil
 }
 
-func (p *HistoryServiceTerminateWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceSetTaskProcessingPausedResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -9249,7 +14050,7 @@ func (p *HistoryServiceTerminateWorkflowExecutionResult) writeField1(oprot thrif
   return err
 }
 
-func (p *HistoryServiceTerminateWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceSetTaskProcessingPausedResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -9262,7 +14063,7 @@ func (p *HistoryServiceTerminateWorkflowExecutionResult) writeField2(oprot thrif
   return err
 }
 
-func (p *HistoryServiceTerminateWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceSetTaskProcessingPausedResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -9275,7 +14076,7 @@ func (p *HistoryServiceTerminateWorkflowExecutionResult) writeField3(oprot thrif
   return err
 }
 
-func (p *HistoryServiceTerminateWorkflowExecutionResult) writeField4(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceSetTaskProcessingPausedResult) writeField4(oprot thrift.TProtocol) (err error) {
   if p.IsSetShardOwnershipLostError() {
     if err := oprot.WriteFieldBegin("shardOwnershipLostError", thrift.STRUCT, 4); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:shardOwnershipLostError: ", p), err) }
@@ -9288,35 +14089,35 @@ func (p *HistoryServiceTerminateWorkflowExecutionResult) writeField4(oprot thrif
   return err
 }
 
-func (p *HistoryServiceTerminateWorkflowExecutionResult) String() string {
+func (p *HistoryServiceSetTaskProcessingPausedResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceTerminateWorkflowExecutionResult(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceSetTaskProcessingPausedResult(%+v)", *p)
 }
 
 // Attributes:
-//  - CancelRequest
-type HistoryServiceRequestCancelWorkflowExecutionArgs struct {
-  CancelRequest *RequestCancelWorkflowExecutionRequest `thrift:"cancelRequest,1" db:"cancelRequest" json:"cancelRequest"`
+//  - Request
+type HistoryServiceListConcreteExecutionsArgs struct {
+  Request *ListConcreteExecutionsRequest `thrift:"request,1" db:"request" json:"request"`
 }
 
-func NewHistoryServiceRequestCancelWorkflowExecutionArgs() *HistoryServiceRequestCancelWorkflowExecutionArgs {
-  return &HistoryServiceRequestCancelWorkflowExecutionArgs{}
+func NewHistoryServiceListConcreteExecutionsArgs() *HistoryServiceListConcreteExecutionsArgs {
+  return &HistoryServiceListConcreteExecutionsArgs{}
 }
 
-var HistoryServiceRequestCancelWorkflowExecutionArgs_CancelRequest_DEFAULT *RequestCancelWorkflowExecutionRequest
-func (p *HistoryServiceRequestCancelWorkflowExecutionArgs) GetCancelRequest() *RequestCancelWorkflowExecutionRequest {
-  if !p.IsSetCancelRequest() {
-    return HistoryServiceRequestCancelWorkflowExecutionArgs_CancelRequest_DEFAULT
+var HistoryServiceListConcreteExecutionsArgs_Request_DEFAULT *ListConcreteExecutionsRequest
+func (p *HistoryServiceListConcreteExecutionsArgs) GetRequest() *ListConcreteExecutionsRequest {
+  if !p.IsSetRequest() {
+    return HistoryServiceListConcreteExecutionsArgs_Request_DEFAULT
   }
-return p.CancelRequest
+return p.Request
 }
-func (p *HistoryServiceRequestCancelWorkflowExecutionArgs) IsSetCancelRequest() bool {
-  return p.CancelRequest != nil
+func (p *HistoryServiceListConcreteExecutionsArgs) IsSetRequest() bool {
+  return p.Request != nil
 }
 
-func (p *HistoryServiceRequestCancelWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceListConcreteExecutionsArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -9348,16 +14149,16 @@ func (p *HistoryServiceRequestCancelWorkflowExecutionArgs) Read(iprot thrift.TPr
   return nil
 }
 
-func (p *HistoryServiceRequestCancelWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.CancelRequest = &RequestCancelWorkflowExecutionRequest{}
-  if err := p.CancelRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CancelRequest), err)
+func (p *HistoryServiceListConcreteExecutionsArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.Request = &ListConcreteExecutionsRequest{}
+  if err := p.Request.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Request), err)
   }
   return nil
 }
 
-func (p *HistoryServiceRequestCancelWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RequestCancelWorkflowExecution_args"); err != nil {
+func (p *HistoryServiceListConcreteExecutionsArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ListConcreteExecutions_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -9369,85 +14170,98 @@ func (p *HistoryServiceRequestCancelWorkflowExecutionArgs) Write(oprot thrift.TP
   return nil
 }
 
-func (p *HistoryServiceRequestCancelWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("cancelRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:cancelRequest: ", p), err) }
-  if err := p.CancelRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CancelRequest), err)
+func (p *HistoryServiceListConcreteExecutionsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:request: ", p), err) }
+  if err := p.Request.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Request), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:cancelRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:request: ", p), err) }
   return err
 }
 
-func (p *HistoryServiceRequestCancelWorkflowExecutionArgs) String() string {
+func (p *HistoryServiceListConcreteExecutionsArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceRequestCancelWorkflowExecutionArgs(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceListConcreteExecutionsArgs(%+v)", *p)
 }
 
 // Attributes:
+//  - Success
 //  - BadRequestError
 //  - InternalServiceError
 //  - EntityNotExistError
 //  - ShardOwnershipLostError
-type HistoryServiceRequestCancelWorkflowExecutionResult struct {
+type HistoryServiceListConcreteExecutionsResult struct {
+  Success *ListConcreteExecutionsResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
   ShardOwnershipLostError *ShardOwnershipLostError `thrift:"shardOwnershipLostError,4" db:"shardOwnershipLostError" json:"shardOwnershipLostError,omitempty"`
 }
 
-func NewHistoryServiceRequestCancelWorkflowExecutionResult() *HistoryServiceRequestCancelWorkflowExecutionResult {
-  return &HistoryServiceRequestCancelWorkflowExecutionResult{}
+func NewHistoryServiceListConcreteExecutionsResult() *HistoryServiceListConcreteExecutionsResult {
+  return &HistoryServiceListConcreteExecutionsResult{}
 }
 
-var HistoryServiceRequestCancelWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *HistoryServiceRequestCancelWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
+var HistoryServiceListConcreteExecutionsResult_Success_DEFAULT *ListConcreteExecutionsResponse
+func (p *HistoryServiceListConcreteExecutionsResult) GetSuccess() *ListConcreteExecutionsResponse {
+  if !p.IsSetSuccess() {
+    return HistoryServiceListConcreteExecutionsResult_Success_DEFAULT
+  }
+return p.Success
+}
+var HistoryServiceListConcreteExecutionsResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *HistoryServiceListConcreteExecutionsResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return HistoryServiceRequestCancelWorkflowExecutionResult_BadRequestError_DEFAULT
+    return HistoryServiceListConcreteExecutionsResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var HistoryServiceRequestCancelWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *HistoryServiceRequestCancelWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
+var HistoryServiceListConcreteExecutionsResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *HistoryServiceListConcreteExecutionsResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return HistoryServiceRequestCancelWorkflowExecutionResult_InternalServiceError_DEFAULT
+    return HistoryServiceListConcreteExecutionsResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var HistoryServiceRequestCancelWorkflowExecutionResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *HistoryServiceRequestCancelWorkflowExecutionResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var HistoryServiceListConcreteExecutionsResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *HistoryServiceListConcreteExecutionsResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return HistoryServiceRequestCancelWorkflowExecutionResult_EntityNotExistError_DEFAULT
+    return HistoryServiceListConcreteExecutionsResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-var HistoryServiceRequestCancelWorkflowExecutionResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
-func (p *HistoryServiceRequestCancelWorkflowExecutionResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
+var HistoryServiceListConcreteExecutionsResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
+func (p *HistoryServiceListConcreteExecutionsResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
   if !p.IsSetShardOwnershipLostError() {
-    return HistoryServiceRequestCancelWorkflowExecutionResult_ShardOwnershipLostError_DEFAULT
+    return HistoryServiceListConcreteExecutionsResult_ShardOwnershipLostError_DEFAULT
   }
 return p.ShardOwnershipLostError
 }
-func (p *HistoryServiceRequestCancelWorkflowExecutionResult) IsSetBadRequestError() bool {
+func (p *HistoryServiceListConcreteExecutionsResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *HistoryServiceListConcreteExecutionsResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *HistoryServiceRequestCancelWorkflowExecutionResult) IsSetInternalServiceError() bool {
+func (p *HistoryServiceListConcreteExecutionsResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *HistoryServiceRequestCancelWorkflowExecutionResult) IsSetEntityNotExistError() bool {
+func (p *HistoryServiceListConcreteExecutionsResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *HistoryServiceRequestCancelWorkflowExecutionResult) IsSetShardOwnershipLostError() bool {
+func (p *HistoryServiceListConcreteExecutionsResult) IsSetShardOwnershipLostError() bool {
   return p.ShardOwnershipLostError != nil
 }
 
-func (p *HistoryServiceRequestCancelWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceListConcreteExecutionsResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -9460,6 +14274,10 @@ func (p *HistoryServiceRequestCancelWorkflowExecutionResult) Read(iprot thrift.T
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -9491,7 +14309,15 @@ func (p *HistoryServiceRequestCancelWorkflowExecutionResult) Read(iprot thrift.T
   return nil
 }
 
-func (p *HistoryServiceRequestCancelWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *HistoryServiceListConcreteExecutionsResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &ListConcreteExecutionsResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceListConcreteExecutionsResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -9499,7 +14325,7 @@ func (p *HistoryServiceRequestCancelWorkflowExecutionResult)  ReadField1(iprot t
   return nil
 }
 
-func (p *HistoryServiceRequestCancelWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *HistoryServiceListConcreteExecutionsResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -9507,7 +14333,7 @@ func (p *HistoryServiceRequestCancelWorkflowExecutionResult)  ReadField2(iprot t
   return nil
 }
 
-func (p *HistoryServiceRequestCancelWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *HistoryServiceListConcreteExecutionsResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -9515,7 +14341,7 @@ func (p *HistoryServiceRequestCancelWorkflowExecutionResult)  ReadField3(iprot t
   return nil
 }
 
-func (p *HistoryServiceRequestCancelWorkflowExecutionResult)  ReadField4(iprot thrift.TProtocol) error {
+func (p *HistoryServiceListConcreteExecutionsResult)  ReadField4(iprot thrift.TProtocol) error {
   p.ShardOwnershipLostError = &ShardOwnershipLostError{}
   if err := p.ShardOwnershipLostError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ShardOwnershipLostError), err)
@@ -9523,10 +14349,11 @@ func (p *HistoryServiceRequestCancelWorkflowExecutionResult)  ReadField4(iprot t
   return nil
 }
 
-func (p *HistoryServiceRequestCancelWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RequestCancelWorkflowExecution_result"); err != nil {
+func (p *HistoryServiceListConcreteExecutionsResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ListConcreteExecutions_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
@@ -9539,7 +14366,20 @@ func (p *HistoryServiceRequestCancelWorkflowExecutionResult) Write(oprot thrift.
   return nil
 }
 
-func (p *HistoryServiceRequestCancelWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceListConcreteExecutionsResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceListConcreteExecutionsResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -9552,7 +14392,7 @@ func (p *HistoryServiceRequestCancelWorkflowExecutionResult) writeField1(oprot t
   return err
 }
 
-func (p *HistoryServiceRequestCancelWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceListConcreteExecutionsResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -9565,7 +14405,7 @@ func (p *HistoryServiceRequestCancelWorkflowExecutionResult) writeField2(oprot t
   return err
 }
 
-func (p *HistoryServiceRequestCancelWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceListConcreteExecutionsResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -9578,7 +14418,7 @@ func (p *HistoryServiceRequestCancelWorkflowExecutionResult) writeField3(oprot t
   return err
 }
 
-func (p *HistoryServiceRequestCancelWorkflowExecutionResult) writeField4(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceListConcreteExecutionsResult) writeField4(oprot thrift.TProtocol) (err error) {
   if p.IsSetShardOwnershipLostError() {
     if err := oprot.WriteFieldBegin("shardOwnershipLostError", thrift.STRUCT, 4); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:shardOwnershipLostError: ", p), err) }
@@ -9591,35 +14431,35 @@ func (p *HistoryServiceRequestCancelWorkflowExecutionResult) writeField4(oprot t
   return err
 }
 
-func (p *HistoryServiceRequestCancelWorkflowExecutionResult) String() string {
+func (p *HistoryServiceListConcreteExecutionsResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceRequestCancelWorkflowExecutionResult(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceListConcreteExecutionsResult(%+v)", *p)
 }
 
 // Attributes:
-//  - ScheduleRequest
-type HistoryServiceScheduleDecisionTaskArgs struct {
-  ScheduleRequest *ScheduleDecisionTaskRequest `thrift:"scheduleRequest,1" db:"scheduleRequest" json:"scheduleRequest"`
+//  - SignalWithStartRequest
+type HistoryServiceSignalWithStartWorkflowExecutionArgs struct {
+  SignalWithStartRequest *SignalWithStartWorkflowExecutionRequest `thrift:"signalWithStartRequest,1" db:"signalWithStartRequest" json:"signalWithStartRequest"`
 }
 
-func NewHistoryServiceScheduleDecisionTaskArgs() *HistoryServiceScheduleDecisionTaskArgs {
-  return &HistoryServiceScheduleDecisionTaskArgs{}
+func NewHistoryServiceSignalWithStartWorkflowExecutionArgs() *HistoryServiceSignalWithStartWorkflowExecutionArgs {
+  return &HistoryServiceSignalWithStartWorkflowExecutionArgs{}
 }
 
-var HistoryServiceScheduleDecisionTaskArgs_ScheduleRequest_DEFAULT *ScheduleDecisionTaskRequest
-func (p *HistoryServiceScheduleDecisionTaskArgs) GetScheduleRequest() *ScheduleDecisionTaskRequest {
-  if !p.IsSetScheduleRequest() {
-    return HistoryServiceScheduleDecisionTaskArgs_ScheduleRequest_DEFAULT
+var HistoryServiceSignalWithStartWorkflowExecutionArgs_SignalWithStartRequest_DEFAULT *SignalWithStartWorkflowExecutionRequest
+func (p *HistoryServiceSignalWithStartWorkflowExecutionArgs) GetSignalWithStartRequest() *SignalWithStartWorkflowExecutionRequest {
+  if !p.IsSetSignalWithStartRequest() {
+    return HistoryServiceSignalWithStartWorkflowExecutionArgs_SignalWithStartRequest_DEFAULT
   }
-return p.ScheduleRequest
+return p.SignalWithStartRequest
 }
-func (p *HistoryServiceScheduleDecisionTaskArgs) IsSetScheduleRequest() bool {
-  return p.ScheduleRequest != nil
+func (p *HistoryServiceSignalWithStartWorkflowExecutionArgs) IsSetSignalWithStartRequest() bool {
+  return p.SignalWithStartRequest != nil
 }
 
-func (p *HistoryServiceScheduleDecisionTaskArgs) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceSignalWithStartWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -9651,16 +14491,16 @@ func (p *HistoryServiceScheduleDecisionTaskArgs) Read(iprot thrift.TProtocol) er
   return nil
 }
 
-func (p *HistoryServiceScheduleDecisionTaskArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.ScheduleRequest = &ScheduleDecisionTaskRequest{}
-  if err := p.ScheduleRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ScheduleRequest), err)
+func (p *HistoryServiceSignalWithStartWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.SignalWithStartRequest = &SignalWithStartWorkflowExecutionRequest{}
+  if err := p.SignalWithStartRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.SignalWithStartRequest), err)
   }
   return nil
 }
 
-func (p *HistoryServiceScheduleDecisionTaskArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("ScheduleDecisionTask_args"); err != nil {
+func (p *HistoryServiceSignalWithStartWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("SignalWithStartWorkflowExecution_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -9672,85 +14512,98 @@ func (p *HistoryServiceScheduleDecisionTaskArgs) Write(oprot thrift.TProtocol) e
   return nil
 }
 
-func (p *HistoryServiceScheduleDecisionTaskArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("scheduleRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:scheduleRequest: ", p), err) }
-  if err := p.ScheduleRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ScheduleRequest), err)
+func (p *HistoryServiceSignalWithStartWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("signalWithStartRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:signalWithStartRequest: ", p), err) }
+  if err := p.SignalWithStartRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.SignalWithStartRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:scheduleRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:signalWithStartRequest: ", p), err) }
   return err
 }
 
-func (p *HistoryServiceScheduleDecisionTaskArgs) String() string {
+func (p *HistoryServiceSignalWithStartWorkflowExecutionArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceScheduleDecisionTaskArgs(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceSignalWithStartWorkflowExecutionArgs(%+v)", *p)
 }
 
 // Attributes:
+//  - Success
 //  - BadRequestError
 //  - InternalServiceError
-//  - EntityNotExistError
+//  - SessionAlreadyExistError
 //  - ShardOwnershipLostError
-type HistoryServiceScheduleDecisionTaskResult struct {
+type HistoryServiceSignalWithStartWorkflowExecutionResult struct {
+  Success *shared.StartWorkflowExecutionResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
-  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+  SessionAlreadyExistError *shared.WorkflowExecutionAlreadyStartedError `thrift:"sessionAlreadyExistError,3" db:"sessionAlreadyExistError" json:"sessionAlreadyExistError,omitempty"`
   ShardOwnershipLostError *ShardOwnershipLostError `thrift:"shardOwnershipLostError,4" db:"shardOwnershipLostError" json:"shardOwnershipLostError,omitempty"`
 }
 
-func NewHistoryServiceScheduleDecisionTaskResult() *HistoryServiceScheduleDecisionTaskResult {
-  return &HistoryServiceScheduleDecisionTaskResult{}
+func NewHistoryServiceSignalWithStartWorkflowExecutionResult() *HistoryServiceSignalWithStartWorkflowExecutionResult {
+  return &HistoryServiceSignalWithStartWorkflowExecutionResult{}
 }
 
-var HistoryServiceScheduleDecisionTaskResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *HistoryServiceScheduleDecisionTaskResult) GetBadRequestError() *shared.BadRequestError {
+var HistoryServiceSignalWithStartWorkflowExecutionResult_Success_DEFAULT *shared.StartWorkflowExecutionResponse
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult) GetSuccess() *shared.StartWorkflowExecutionResponse {
+  if !p.IsSetSuccess() {
+    return HistoryServiceSignalWithStartWorkflowExecutionResult_Success_DEFAULT
+  }
+return p.Success
+}
+var HistoryServiceSignalWithStartWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return HistoryServiceScheduleDecisionTaskResult_BadRequestError_DEFAULT
+    return HistoryServiceSignalWithStartWorkflowExecutionResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var HistoryServiceScheduleDecisionTaskResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *HistoryServiceScheduleDecisionTaskResult) GetInternalServiceError() *shared.InternalServiceError {
+var HistoryServiceSignalWithStartWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return HistoryServiceScheduleDecisionTaskResult_InternalServiceError_DEFAULT
+    return HistoryServiceSignalWithStartWorkflowExecutionResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var HistoryServiceScheduleDecisionTaskResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *HistoryServiceScheduleDecisionTaskResult) GetEntityNotExistError() *shared.EntityNotExistsError {
-  if !p.IsSetEntityNotExistError() {
-    return HistoryServiceScheduleDecisionTaskResult_EntityNotExistError_DEFAULT
+var HistoryServiceSignalWithStartWorkflowExecutionResult_SessionAlreadyExistError_DEFAULT *shared.WorkflowExecutionAlreadyStartedError
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult) GetSessionAlreadyExistError() *shared.WorkflowExecutionAlreadyStartedError {
+  if !p.IsSetSessionAlreadyExistError() {
+    return HistoryServiceSignalWithStartWorkflowExecutionResult_SessionAlreadyExistError_DEFAULT
   }
-return p.EntityNotExistError
+return p.SessionAlreadyExistError
 }
-var HistoryServiceScheduleDecisionTaskResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
-func (p *HistoryServiceScheduleDecisionTaskResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
+var HistoryServiceSignalWithStartWorkflowExecutionResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
   if !p.IsSetShardOwnershipLostError() {
-    return HistoryServiceScheduleDecisionTaskResult_ShardOwnershipLostError_DEFAULT
+    return HistoryServiceSignalWithStartWorkflowExecutionResult_ShardOwnershipLostError_DEFAULT
   }
 return p.ShardOwnershipLostError
 }
-func (p *HistoryServiceScheduleDecisionTaskResult) IsSetBadRequestError() bool {
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *HistoryServiceScheduleDecisionTaskResult) IsSetInternalServiceError() bool {
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *HistoryServiceScheduleDecisionTaskResult) IsSetEntityNotExistError() bool {
-  return p.EntityNotExistError != nil
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult) IsSetSessionAlreadyExistError() bool {
+  return p.SessionAlreadyExistError != nil
 }
 
-func (p *HistoryServiceScheduleDecisionTaskResult) IsSetShardOwnershipLostError() bool {
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult) IsSetShardOwnershipLostError() bool {
   return p.ShardOwnershipLostError != nil
 }
 
-func (p *HistoryServiceScheduleDecisionTaskResult) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -9763,6 +14616,10 @@ func (p *HistoryServiceScheduleDecisionTaskResult) Read(iprot thrift.TProtocol)
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -9794,7 +14651,15 @@ func (p *HistoryServiceScheduleDecisionTaskResult) Read(iprot thrift.TProtocol)
   return nil
 }
 
-func (p *HistoryServiceScheduleDecisionTaskResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.StartWorkflowExecutionResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -9802,7 +14667,7 @@ func (p *HistoryServiceScheduleDecisionTaskResult)  ReadField1(iprot thrift.TPro
   return nil
 }
 
-func (p *HistoryServiceScheduleDecisionTaskResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -9810,15 +14675,15 @@ func (p *HistoryServiceScheduleDecisionTaskResult)  ReadField2(iprot thrift.TPro
   return nil
 }
 
-func (p *HistoryServiceScheduleDecisionTaskResult)  ReadField3(iprot thrift.TProtocol) error {
-  p.EntityNotExistError = &shared.EntityNotExistsError{}
-  if err := p.EntityNotExistError.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.SessionAlreadyExistError = &shared.WorkflowExecutionAlreadyStartedError{}
+  if err := p.SessionAlreadyExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.SessionAlreadyExistError), err)
   }
   return nil
 }
 
-func (p *HistoryServiceScheduleDecisionTaskResult)  ReadField4(iprot thrift.TProtocol) error {
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult)  ReadField4(iprot thrift.TProtocol) error {
   p.ShardOwnershipLostError = &ShardOwnershipLostError{}
   if err := p.ShardOwnershipLostError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ShardOwnershipLostError), err)
@@ -9826,10 +14691,11 @@ func (p *HistoryServiceScheduleDecisionTaskResult)  ReadField4(iprot thrift.TPro
   return nil
 }
 
-func (p *HistoryServiceScheduleDecisionTaskResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("ScheduleDecisionTask_result"); err != nil {
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("SignalWithStartWorkflowExecution_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
@@ -9842,7 +14708,20 @@ func (p *HistoryServiceScheduleDecisionTaskResult) Write(oprot thrift.TProtocol)
   return nil
 }
 
-func (p *HistoryServiceScheduleDecisionTaskResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -9855,7 +14734,7 @@ func (p *HistoryServiceScheduleDecisionTaskResult) writeField1(oprot thrift.TPro
   return err
 }
 
-func (p *HistoryServiceScheduleDecisionTaskResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -9868,20 +14747,20 @@ func (p *HistoryServiceScheduleDecisionTaskResult) writeField2(oprot thrift.TPro
   return err
 }
 
-func (p *HistoryServiceScheduleDecisionTaskResult) writeField3(oprot thrift.TProtocol) (err error) {
-  if p.IsSetEntityNotExistError() {
-    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
-    if err := p.EntityNotExistError.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSessionAlreadyExistError() {
+    if err := oprot.WriteFieldBegin("sessionAlreadyExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:sessionAlreadyExistError: ", p), err) }
+    if err := p.SessionAlreadyExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.SessionAlreadyExistError), err)
     }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:sessionAlreadyExistError: ", p), err) }
   }
   return err
 }
 
-func (p *HistoryServiceScheduleDecisionTaskResult) writeField4(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult) writeField4(oprot thrift.TProtocol) (err error) {
   if p.IsSetShardOwnershipLostError() {
     if err := oprot.WriteFieldBegin("shardOwnershipLostError", thrift.STRUCT, 4); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:shardOwnershipLostError: ", p), err) }
@@ -9894,35 +14773,35 @@ func (p *HistoryServiceScheduleDecisionTaskResult) writeField4(oprot thrift.TPro
   return err
 }
 
-func (p *HistoryServiceScheduleDecisionTaskResult) String() string {
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceScheduleDecisionTaskResult(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceSignalWithStartWorkflowExecutionResult(%+v)", *p)
 }
 
 // Attributes:
-//  - CompletionRequest
-type HistoryServiceRecordChildExecutionCompletedArgs struct {
-  CompletionRequest *RecordChildExecutionCompletedRequest `thrift:"completionRequest,1" db:"completionRequest" json:"completionRequest"`
+//  - ResetRequest
+type HistoryServiceResetWorkflowExecutionArgs struct {
+  ResetRequest *ResetWorkflowExecutionRequest `thrift:"resetRequest,1" db:"resetRequest" json:"resetRequest"`
 }
 
-func NewHistoryServiceRecordChildExecutionCompletedArgs() *HistoryServiceRecordChildExecutionCompletedArgs {
-  return &HistoryServiceRecordChildExecutionCompletedArgs{}
+func NewHistoryServiceResetWorkflowExecutionArgs() *HistoryServiceResetWorkflowExecutionArgs {
+  return &HistoryServiceResetWorkflowExecutionArgs{}
 }
 
-var HistoryServiceRecordChildExecutionCompletedArgs_CompletionRequest_DEFAULT *RecordChildExecutionCompletedRequest
-func (p *HistoryServiceRecordChildExecutionCompletedArgs) GetCompletionRequest() *RecordChildExecutionCompletedRequest {
-  if !p.IsSetCompletionRequest() {
-    return HistoryServiceRecordChildExecutionCompletedArgs_CompletionRequest_DEFAULT
+var HistoryServiceResetWorkflowExecutionArgs_ResetRequest_DEFAULT *ResetWorkflowExecutionRequest
+func (p *HistoryServiceResetWorkflowExecutionArgs) GetResetRequest() *ResetWorkflowExecutionRequest {
+  if !p.IsSetResetRequest() {
+    return HistoryServiceResetWorkflowExecutionArgs_ResetRequest_DEFAULT
   }
-return p.CompletionRequest
+return p.ResetRequest
 }
-func (p *HistoryServiceRecordChildExecutionCompletedArgs) IsSetCompletionRequest() bool {
-  return p.CompletionRequest != nil
+func (p *HistoryServiceResetWorkflowExecutionArgs) IsSetResetRequest() bool {
+  return p.ResetRequest != nil
 }
 
-func (p *HistoryServiceRecordChildExecutionCompletedArgs) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceResetWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -9954,16 +14833,16 @@ func (p *HistoryServiceRecordChildExecutionCompletedArgs) Read(iprot thrift.TPro
   return nil
 }
 
-func (p *HistoryServiceRecordChildExecutionCompletedArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.CompletionRequest = &RecordChildExecutionCompletedRequest{}
-  if err := p.CompletionRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CompletionRequest), err)
+func (p *HistoryServiceResetWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.ResetRequest = &ResetWorkflowExecutionRequest{}
+  if err := p.ResetRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ResetRequest), err)
   }
   return nil
 }
 
-func (p *HistoryServiceRecordChildExecutionCompletedArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RecordChildExecutionCompleted_args"); err != nil {
+func (p *HistoryServiceResetWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ResetWorkflowExecution_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -9975,85 +14854,98 @@ func (p *HistoryServiceRecordChildExecutionCompletedArgs) Write(oprot thrift.TPr
   return nil
 }
 
-func (p *HistoryServiceRecordChildExecutionCompletedArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("completionRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:completionRequest: ", p), err) }
-  if err := p.CompletionRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CompletionRequest), err)
+func (p *HistoryServiceResetWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("resetRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:resetRequest: ", p), err) }
+  if err := p.ResetRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ResetRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:completionRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:resetRequest: ", p), err) }
   return err
 }
 
-func (p *HistoryServiceRecordChildExecutionCompletedArgs) String() string {
+func (p *HistoryServiceResetWorkflowExecutionArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceRecordChildExecutionCompletedArgs(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceResetWorkflowExecutionArgs(%+v)", *p)
 }
 
 // Attributes:
+//  - Success
 //  - BadRequestError
 //  - InternalServiceError
 //  - EntityNotExistError
 //  - ShardOwnershipLostError
-type HistoryServiceRecordChildExecutionCompletedResult struct {
+type HistoryServiceResetWorkflowExecutionResult struct {
+  Success *shared.ResetWorkflowExecutionResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
   ShardOwnershipLostError *ShardOwnershipLostError `thrift:"shardOwnershipLostError,4" db:"shardOwnershipLostError" json:"shardOwnershipLostError,omitempty"`
 }
 
-func NewHistoryServiceRecordChildExecutionCompletedResult() *HistoryServiceRecordChildExecutionCompletedResult {
-  return &HistoryServiceRecordChildExecutionCompletedResult{}
+func NewHistoryServiceResetWorkflowExecutionResult() *HistoryServiceResetWorkflowExecutionResult {
+  return &HistoryServiceResetWorkflowExecutionResult{}
 }
 
-var HistoryServiceRecordChildExecutionCompletedResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *HistoryServiceRecordChildExecutionCompletedResult) GetBadRequestError() *shared.BadRequestError {
+var HistoryServiceResetWorkflowExecutionResult_Success_DEFAULT *shared.ResetWorkflowExecutionResponse
+func (p *HistoryServiceResetWorkflowExecutionResult) GetSuccess() *shared.ResetWorkflowExecutionResponse {
+  if !p.IsSetSuccess() {
+    return HistoryServiceResetWorkflowExecutionResult_Success_DEFAULT
+  }
+return p.Success
+}
+var HistoryServiceResetWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *HistoryServiceResetWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return HistoryServiceRecordChildExecutionCompletedResult_BadRequestError_DEFAULT
+    return HistoryServiceResetWorkflowExecutionResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var HistoryServiceRecordChildExecutionCompletedResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *HistoryServiceRecordChildExecutionCompletedResult) GetInternalServiceError() *shared.InternalServiceError {
+var HistoryServiceResetWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *HistoryServiceResetWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return HistoryServiceRecordChildExecutionCompletedResult_InternalServiceError_DEFAULT
+    return HistoryServiceResetWorkflowExecutionResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var HistoryServiceRecordChildExecutionCompletedResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *HistoryServiceRecordChildExecutionCompletedResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var HistoryServiceResetWorkflowExecutionResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *HistoryServiceResetWorkflowExecutionResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return HistoryServiceRecordChildExecutionCompletedResult_EntityNotExistError_DEFAULT
+    return HistoryServiceResetWorkflowExecutionResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-var HistoryServiceRecordChildExecutionCompletedResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
-func (p *HistoryServiceRecordChildExecutionCompletedResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
+var HistoryServiceResetWorkflowExecutionResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
+func (p *HistoryServiceResetWorkflowExecutionResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
   if !p.IsSetShardOwnershipLostError() {
-    return HistoryServiceRecordChildExecutionCompletedResult_ShardOwnershipLostError_DEFAULT
+    return HistoryServiceResetWorkflowExecutionResult_ShardOwnershipLostError_DEFAULT
   }
 return p.ShardOwnershipLostError
 }
-func (p *HistoryServiceRecordChildExecutionCompletedResult) IsSetBadRequestError() bool {
+func (p *HistoryServiceResetWorkflowExecutionResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *HistoryServiceResetWorkflowExecutionResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *HistoryServiceRecordChildExecutionCompletedResult) IsSetInternalServiceError() bool {
+func (p *HistoryServiceResetWorkflowExecutionResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *HistoryServiceRecordChildExecutionCompletedResult) IsSetEntityNotExistError() bool {
+func (p *HistoryServiceResetWorkflowExecutionResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *HistoryServiceRecordChildExecutionCompletedResult) IsSetShardOwnershipLostError() bool {
+func (p *HistoryServiceResetWorkflowExecutionResult) IsSetShardOwnershipLostError() bool {
   return p.ShardOwnershipLostError != nil
 }
 
-func (p *HistoryServiceRecordChildExecutionCompletedResult) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceResetWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -10066,6 +14958,10 @@ func (p *HistoryServiceRecordChildExecutionCompletedResult) Read(iprot thrift.TP
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -10097,7 +14993,15 @@ func (p *HistoryServiceRecordChildExecutionCompletedResult) Read(iprot thrift.TP
   return nil
 }
 
-func (p *HistoryServiceRecordChildExecutionCompletedResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *HistoryServiceResetWorkflowExecutionResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.ResetWorkflowExecutionResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceResetWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -10105,7 +15009,7 @@ func (p *HistoryServiceRecordChildExecutionCompletedResult)  ReadField1(iprot th
   return nil
 }
 
-func (p *HistoryServiceRecordChildExecutionCompletedResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *HistoryServiceResetWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -10113,7 +15017,7 @@ func (p *HistoryServiceRecordChildExecutionCompletedResult)  ReadField2(iprot th
   return nil
 }
 
-func (p *HistoryServiceRecordChildExecutionCompletedResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *HistoryServiceResetWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -10121,7 +15025,7 @@ func (p *HistoryServiceRecordChildExecutionCompletedResult)  ReadField3(iprot th
   return nil
 }
 
-func (p *HistoryServiceRecordChildExecutionCompletedResult)  ReadField4(iprot thrift.TProtocol) error {
+func (p *HistoryServiceResetWorkflowExecutionResult)  ReadField4(iprot thrift.TProtocol) error {
   p.ShardOwnershipLostError = &ShardOwnershipLostError{}
   if err := p.ShardOwnershipLostError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ShardOwnershipLostError), err)
@@ -10129,10 +15033,11 @@ func (p *HistoryServiceRecordChildExecutionCompletedResult)  ReadField4(iprot th
   return nil
 }
 
-func (p *HistoryServiceRecordChildExecutionCompletedResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RecordChildExecutionCompleted_result"); err != nil {
+func (p *HistoryServiceResetWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ResetWorkflowExecution_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
@@ -10145,7 +15050,20 @@ func (p *HistoryServiceRecordChildExecutionCompletedResult) Write(oprot thrift.T
   return nil
 }
 
-func (p *HistoryServiceRecordChildExecutionCompletedResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceResetWorkflowExecutionResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceResetWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -10158,7 +15076,7 @@ func (p *HistoryServiceRecordChildExecutionCompletedResult) writeField1(oprot th
   return err
 }
 
-func (p *HistoryServiceRecordChildExecutionCompletedResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceResetWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -10171,7 +15089,7 @@ func (p *HistoryServiceRecordChildExecutionCompletedResult) writeField2(oprot th
   return err
 }
 
-func (p *HistoryServiceRecordChildExecutionCompletedResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceResetWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -10184,7 +15102,7 @@ func (p *HistoryServiceRecordChildExecutionCompletedResult) writeField3(oprot th
   return err
 }
 
-func (p *HistoryServiceRecordChildExecutionCompletedResult) writeField4(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceResetWorkflowExecutionResult) writeField4(oprot thrift.TProtocol) (err error) {
   if p.IsSetShardOwnershipLostError() {
     if err := oprot.WriteFieldBegin("shardOwnershipLostError", thrift.STRUCT, 4); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:shardOwnershipLostError: ", p), err) }
@@ -10197,11 +15115,9 @@ func (p *HistoryServiceRecordChildExecutionCompletedResult) writeField4(oprot th
   return err
 }
 
-func (p *HistoryServiceRecordChildExecutionCompletedResult) String() string {
+func (p *HistoryServiceResetWorkflowExecutionResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceRecordChildExecutionCompletedResult(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceResetWorkflowExecutionResult(%+v)", *p)
 }
-
-
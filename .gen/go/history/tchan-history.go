@@ -38,17 +38,23 @@ var _ = shared.GoUnusedProtection__
 
 // TChanHistoryService is the interface that defines the server handler and client interface.
 type TChanHistoryService interface {
+	DescribeShard(ctx thrift.Context, request *DescribeShardRequest) (*DescribeShardResponse, error)
 	GetWorkflowExecutionNextEventID(ctx thrift.Context, getRequest *GetWorkflowExecutionNextEventIDRequest) (*GetWorkflowExecutionNextEventIDResponse, error)
+	InvalidateMutableState(ctx thrift.Context, request *InvalidateMutableStateRequest) error
+	ListConcreteExecutions(ctx thrift.Context, request *ListConcreteExecutionsRequest) (*ListConcreteExecutionsResponse, error)
 	RecordActivityTaskHeartbeat(ctx thrift.Context, heartbeatRequest *RecordActivityTaskHeartbeatRequest) (*shared.RecordActivityTaskHeartbeatResponse, error)
 	RecordActivityTaskStarted(ctx thrift.Context, addRequest *RecordActivityTaskStartedRequest) (*RecordActivityTaskStartedResponse, error)
 	RecordChildExecutionCompleted(ctx thrift.Context, completionRequest *RecordChildExecutionCompletedRequest) error
 	RecordDecisionTaskStarted(ctx thrift.Context, addRequest *RecordDecisionTaskStartedRequest) (*RecordDecisionTaskStartedResponse, error)
 	RequestCancelWorkflowExecution(ctx thrift.Context, cancelRequest *RequestCancelWorkflowExecutionRequest) error
+	ResetWorkflowExecution(ctx thrift.Context, resetRequest *ResetWorkflowExecutionRequest) (*shared.ResetWorkflowExecutionResponse, error)
 	RespondActivityTaskCanceled(ctx thrift.Context, canceledRequest *RespondActivityTaskCanceledRequest) error
 	RespondActivityTaskCompleted(ctx thrift.Context, completeRequest *RespondActivityTaskCompletedRequest) error
 	RespondActivityTaskFailed(ctx thrift.Context, failRequest *RespondActivityTaskFailedRequest) error
-	RespondDecisionTaskCompleted(ctx thrift.Context, completeRequest *RespondDecisionTaskCompletedRequest) error
+	RespondDecisionTaskCompleted(ctx thrift.Context, completeRequest *RespondDecisionTaskCompletedRequest) (*shared.RespondDecisionTaskCompletedResponse, error)
 	ScheduleDecisionTask(ctx thrift.Context, scheduleRequest *ScheduleDecisionTaskRequest) error
+	SetTaskProcessingPaused(ctx thrift.Context, request *SetTaskProcessingPausedRequest) error
+	SignalWithStartWorkflowExecution(ctx thrift.Context, signalWithStartRequest *SignalWithStartWorkflowExecutionRequest) (*shared.StartWorkflowExecutionResponse, error)
 	SignalWorkflowExecution(ctx thrift.Context, signalRequest *SignalWorkflowExecutionRequest) error
 	StartWorkflowExecution(ctx thrift.Context, startRequest *StartWorkflowExecutionRequest) (*shared.StartWorkflowExecutionResponse, error)
 	TerminateWorkflowExecution(ctx thrift.Context, terminateRequest *TerminateWorkflowExecutionRequest) error
@@ -73,6 +79,30 @@ func NewTChanHistoryServiceClient(client thrift.TChanClient) TChanHistoryService
 	return NewTChanHistoryServiceInheritedClient("HistoryService", client)
 }
 
+func (c *tchanHistoryServiceClient) DescribeShard(ctx thrift.Context, request *DescribeShardRequest) (*DescribeShardResponse, error) {
+	var resp HistoryServiceDescribeShardResult
+	args := HistoryServiceDescribeShardArgs{
+		Request: request,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "DescribeShard", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.EntityNotExistError != nil:
+			err = resp.EntityNotExistError
+		case resp.ShardOwnershipLostError != nil:
+			err = resp.ShardOwnershipLostError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for DescribeShard")
+		}
+	}
+
+	return resp.GetSuccess(), err
+}
+
 func (c *tchanHistoryServiceClient) GetWorkflowExecutionNextEventID(ctx thrift.Context, getRequest *GetWorkflowExecutionNextEventIDRequest) (*GetWorkflowExecutionNextEventIDResponse, error) {
 	var resp HistoryServiceGetWorkflowExecutionNextEventIDResult
 	args := HistoryServiceGetWorkflowExecutionNextEventIDArgs{
@@ -97,6 +127,54 @@ func (c *tchanHistoryServiceClient) GetWorkflowExecutionNextEventID(ctx thrift.C
 	return resp.GetSuccess(), err
 }
 
+func (c *tchanHistoryServiceClient) InvalidateMutableState(ctx thrift.Context, request *InvalidateMutableStateRequest) error {
+	var resp HistoryServiceInvalidateMutableStateResult
+	args := HistoryServiceInvalidateMutableStateArgs{
+		Request: request,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "InvalidateMutableState", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.EntityNotExistError != nil:
+			err = resp.EntityNotExistError
+		case resp.ShardOwnershipLostError != nil:
+			err = resp.ShardOwnershipLostError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for InvalidateMutableState")
+		}
+	}
+
+	return err
+}
+
+func (c *tchanHistoryServiceClient) ListConcreteExecutions(ctx thrift.Context, request *ListConcreteExecutionsRequest) (*ListConcreteExecutionsResponse, error) {
+	var resp HistoryServiceListConcreteExecutionsResult
+	args := HistoryServiceListConcreteExecutionsArgs{
+		Request: request,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "ListConcreteExecutions", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.EntityNotExistError != nil:
+			err = resp.EntityNotExistError
+		case resp.ShardOwnershipLostError != nil:
+			err = resp.ShardOwnershipLostError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for ListConcreteExecutions")
+		}
+	}
+
+	return resp.GetSuccess(), err
+}
+
 func (c *tchanHistoryServiceClient) RecordActivityTaskHeartbeat(ctx thrift.Context, heartbeatRequest *RecordActivityTaskHeartbeatRequest) (*shared.RecordActivityTaskHeartbeatResponse, error) {
 	var resp HistoryServiceRecordActivityTaskHeartbeatResult
 	args := HistoryServiceRecordActivityTaskHeartbeatArgs{
@@ -221,6 +299,30 @@ func (c *tchanHistoryServiceClient) RequestCancelWorkflowExecution(ctx thrift.Co
 	return err
 }
 
+func (c *tchanHistoryServiceClient) ResetWorkflowExecution(ctx thrift.Context, resetRequest *ResetWorkflowExecutionRequest) (*shared.ResetWorkflowExecutionResponse, error) {
+	var resp HistoryServiceResetWorkflowExecutionResult
+	args := HistoryServiceResetWorkflowExecutionArgs{
+		ResetRequest: resetRequest,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "ResetWorkflowExecution", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.EntityNotExistError != nil:
+			err = resp.EntityNotExistError
+		case resp.ShardOwnershipLostError != nil:
+			err = resp.ShardOwnershipLostError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for ResetWorkflowExecution")
+		}
+	}
+
+	return resp.GetSuccess(), err
+}
+
 func (c *tchanHistoryServiceClient) RespondActivityTaskCanceled(ctx thrift.Context, canceledRequest *RespondActivityTaskCanceledRequest) error {
 	var resp HistoryServiceRespondActivityTaskCanceledResult
 	args := HistoryServiceRespondActivityTaskCanceledArgs{
@@ -293,7 +395,7 @@ func (c *tchanHistoryServiceClient) RespondActivityTaskFailed(ctx thrift.Context
 	return err
 }
 
-func (c *tchanHistoryServiceClient) RespondDecisionTaskCompleted(ctx thrift.Context, completeRequest *RespondDecisionTaskCompletedRequest) error {
+func (c *tchanHistoryServiceClient) RespondDecisionTaskCompleted(ctx thrift.Context, completeRequest *RespondDecisionTaskCompletedRequest) (*shared.RespondDecisionTaskCompletedResponse, error) {
 	var resp HistoryServiceRespondDecisionTaskCompletedResult
 	args := HistoryServiceRespondDecisionTaskCompletedArgs{
 		CompleteRequest: completeRequest,
@@ -314,7 +416,7 @@ func (c *tchanHistoryServiceClient) RespondDecisionTaskCompleted(ctx thrift.Cont
 		}
 	}
 
-	return err
+	return resp.GetSuccess(), err
 }
 
 func (c *tchanHistoryServiceClient) ScheduleDecisionTask(ctx thrift.Context, scheduleRequest *ScheduleDecisionTaskRequest) error {
@@ -341,6 +443,54 @@ func (c *tchanHistoryServiceClient) ScheduleDecisionTask(ctx thrift.Context, sch
 	return err
 }
 
+func (c *tchanHistoryServiceClient) SetTaskProcessingPaused(ctx thrift.Context, request *SetTaskProcessingPausedRequest) error {
+	var resp HistoryServiceSetTaskProcessingPausedResult
+	args := HistoryServiceSetTaskProcessingPausedArgs{
+		Request: request,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "SetTaskProcessingPaused", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.EntityNotExistError != nil:
+			err = resp.EntityNotExistError
+		case resp.ShardOwnershipLostError != nil:
+			err = resp.ShardOwnershipLostError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for SetTaskProcessingPaused")
+		}
+	}
+
+	return err
+}
+
+func (c *tchanHistoryServiceClient) SignalWithStartWorkflowExecution(ctx thrift.Context, signalWithStartRequest *SignalWithStartWorkflowExecutionRequest) (*shared.StartWorkflowExecutionResponse, error) {
+	var resp HistoryServiceSignalWithStartWorkflowExecutionResult
+	args := HistoryServiceSignalWithStartWorkflowExecutionArgs{
+		SignalWithStartRequest: signalWithStartRequest,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "SignalWithStartWorkflowExecution", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.SessionAlreadyExistError != nil:
+			err = resp.SessionAlreadyExistError
+		case resp.ShardOwnershipLostError != nil:
+			err = resp.ShardOwnershipLostError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for SignalWithStartWorkflowExecution")
+		}
+	}
+
+	return resp.GetSuccess(), err
+}
+
 func (c *tchanHistoryServiceClient) SignalWorkflowExecution(ctx thrift.Context, signalRequest *SignalWorkflowExecutionRequest) error {
 	var resp HistoryServiceSignalWorkflowExecutionResult
 	args := HistoryServiceSignalWorkflowExecutionArgs{
@@ -431,17 +581,23 @@ func (s *tchanHistoryServiceServer) Service() string {
 
 func (s *tchanHistoryServiceServer) Methods() []string {
 	return []string{
+		"DescribeShard",
 		"GetWorkflowExecutionNextEventID",
+		"InvalidateMutableState",
+		"ListConcreteExecutions",
 		"RecordActivityTaskHeartbeat",
 		"RecordActivityTaskStarted",
 		"RecordChildExecutionCompleted",
 		"RecordDecisionTaskStarted",
 		"RequestCancelWorkflowExecution",
+		"ResetWorkflowExecution",
 		"RespondActivityTaskCanceled",
 		"RespondActivityTaskCompleted",
 		"RespondActivityTaskFailed",
 		"RespondDecisionTaskCompleted",
 		"ScheduleDecisionTask",
+		"SetTaskProcessingPaused",
+		"SignalWithStartWorkflowExecution",
 		"SignalWorkflowExecution",
 		"StartWorkflowExecution",
 		"TerminateWorkflowExecution",
@@ -450,8 +606,14 @@ func (s *tchanHistoryServiceServer) Methods() []string {
 
 func (s *tchanHistoryServiceServer) Handle(ctx thrift.Context, methodName string, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
 	switch methodName {
+	case "DescribeShard":
+		return s.handleDescribeShard(ctx, protocol)
 	case "GetWorkflowExecutionNextEventID":
 		return s.handleGetWorkflowExecutionNextEventID(ctx, protocol)
+	case "InvalidateMutableState":
+		return s.handleInvalidateMutableState(ctx, protocol)
+	case "ListConcreteExecutions":
+		return s.handleListConcreteExecutions(ctx, protocol)
 	case "RecordActivityTaskHeartbeat":
 		return s.handleRecordActivityTaskHeartbeat(ctx, protocol)
 	case "RecordActivityTaskStarted":
@@ -462,6 +624,8 @@ func (s *tchanHistoryServiceServer) Handle(ctx thrift.Context, methodName string
 		return s.handleRecordDecisionTaskStarted(ctx, protocol)
 	case "RequestCancelWorkflowExecution":
 		return s.handleRequestCancelWorkflowExecution(ctx, protocol)
+	case "ResetWorkflowExecution":
+		return s.handleResetWorkflowExecution(ctx, protocol)
 	case "RespondActivityTaskCanceled":
 		return s.handleRespondActivityTaskCanceled(ctx, protocol)
 	case "RespondActivityTaskCompleted":
@@ -472,6 +636,10 @@ func (s *tchanHistoryServiceServer) Handle(ctx thrift.Context, methodName string
 		return s.handleRespondDecisionTaskCompleted(ctx, protocol)
 	case "ScheduleDecisionTask":
 		return s.handleScheduleDecisionTask(ctx, protocol)
+	case "SetTaskProcessingPaused":
+		return s.handleSetTaskProcessingPaused(ctx, protocol)
+	case "SignalWithStartWorkflowExecution":
+		return s.handleSignalWithStartWorkflowExecution(ctx, protocol)
 	case "SignalWorkflowExecution":
 		return s.handleSignalWorkflowExecution(ctx, protocol)
 	case "StartWorkflowExecution":
@@ -484,6 +652,49 @@ func (s *tchanHistoryServiceServer) Handle(ctx thrift.Context, methodName string
 	}
 }
 
+func (s *tchanHistoryServiceServer) handleDescribeShard(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req HistoryServiceDescribeShardArgs
+	var res HistoryServiceDescribeShardResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	r, err :=
+		s.handler.DescribeShard(ctx, req.Request)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.EntityNotExistsError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for entityNotExistError returned non-nil error type *shared.EntityNotExistsError but nil value")
+			}
+			res.EntityNotExistError = v
+		case *ShardOwnershipLostError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for shardOwnershipLostError returned non-nil error type *ShardOwnershipLostError but nil value")
+			}
+			res.ShardOwnershipLostError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+		res.Success = r
+	}
+
+	return err == nil, &res, nil
+}
+
 func (s *tchanHistoryServiceServer) handleGetWorkflowExecutionNextEventID(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
 	var req HistoryServiceGetWorkflowExecutionNextEventIDArgs
 	var res HistoryServiceGetWorkflowExecutionNextEventIDResult
@@ -527,6 +738,91 @@ func (s *tchanHistoryServiceServer) handleGetWorkflowExecutionNextEventID(ctx th
 	return err == nil, &res, nil
 }
 
+func (s *tchanHistoryServiceServer) handleInvalidateMutableState(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req HistoryServiceInvalidateMutableStateArgs
+	var res HistoryServiceInvalidateMutableStateResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	err :=
+		s.handler.InvalidateMutableState(ctx, req.Request)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.EntityNotExistsError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for entityNotExistError returned non-nil error type *shared.EntityNotExistsError but nil value")
+			}
+			res.EntityNotExistError = v
+		case *ShardOwnershipLostError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for shardOwnershipLostError returned non-nil error type *ShardOwnershipLostError but nil value")
+			}
+			res.ShardOwnershipLostError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+	}
+
+	return err == nil, &res, nil
+}
+
+func (s *tchanHistoryServiceServer) handleListConcreteExecutions(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req HistoryServiceListConcreteExecutionsArgs
+	var res HistoryServiceListConcreteExecutionsResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	r, err :=
+		s.handler.ListConcreteExecutions(ctx, req.Request)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.EntityNotExistsError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for entityNotExistError returned non-nil error type *shared.EntityNotExistsError but nil value")
+			}
+			res.EntityNotExistError = v
+		case *ShardOwnershipLostError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for shardOwnershipLostError returned non-nil error type *ShardOwnershipLostError but nil value")
+			}
+			res.ShardOwnershipLostError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+		res.Success = r
+	}
+
+	return err == nil, &res, nil
+}
+
 func (s *tchanHistoryServiceServer) handleRecordActivityTaskHeartbeat(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
 	var req HistoryServiceRecordActivityTaskHeartbeatArgs
 	var res HistoryServiceRecordActivityTaskHeartbeatResult
@@ -750,6 +1046,49 @@ func (s *tchanHistoryServiceServer) handleRequestCancelWorkflowExecution(ctx thr
 	return err == nil, &res, nil
 }
 
+func (s *tchanHistoryServiceServer) handleResetWorkflowExecution(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req HistoryServiceResetWorkflowExecutionArgs
+	var res HistoryServiceResetWorkflowExecutionResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	r, err :=
+		s.handler.ResetWorkflowExecution(ctx, req.ResetRequest)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.EntityNotExistsError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for entityNotExistError returned non-nil error type *shared.EntityNotExistsError but nil value")
+			}
+			res.EntityNotExistError = v
+		case *ShardOwnershipLostError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for shardOwnershipLostError returned non-nil error type *ShardOwnershipLostError but nil value")
+			}
+			res.ShardOwnershipLostError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+		res.Success = r
+	}
+
+	return err == nil, &res, nil
+}
+
 func (s *tchanHistoryServiceServer) handleRespondActivityTaskCanceled(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
 	var req HistoryServiceRespondActivityTaskCanceledArgs
 	var res HistoryServiceRespondActivityTaskCanceledResult
@@ -884,7 +1223,7 @@ func (s *tchanHistoryServiceServer) handleRespondDecisionTaskCompleted(ctx thrif
 		return false, nil, err
 	}
 
-	err :=
+	r, err :=
 		s.handler.RespondDecisionTaskCompleted(ctx, req.CompleteRequest)
 
 	if err != nil {
@@ -913,6 +1252,7 @@ func (s *tchanHistoryServiceServer) handleRespondDecisionTaskCompleted(ctx thrif
 			return false, nil, err
 		}
 	} else {
+		res.Success = r
 	}
 
 	return err == nil, &res, nil
@@ -960,6 +1300,91 @@ func (s *tchanHistoryServiceServer) handleScheduleDecisionTask(ctx thrift.Contex
 	return err == nil, &res, nil
 }
 
+func (s *tchanHistoryServiceServer) handleSetTaskProcessingPaused(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req HistoryServiceSetTaskProcessingPausedArgs
+	var res HistoryServiceSetTaskProcessingPausedResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	err :=
+		s.handler.SetTaskProcessingPaused(ctx, req.Request)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.EntityNotExistsError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for entityNotExistError returned non-nil error type *shared.EntityNotExistsError but nil value")
+			}
+			res.EntityNotExistError = v
+		case *ShardOwnershipLostError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for shardOwnershipLostError returned non-nil error type *ShardOwnershipLostError but nil value")
+			}
+			res.ShardOwnershipLostError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+	}
+
+	return err == nil, &res, nil
+}
+
+func (s *tchanHistoryServiceServer) handleSignalWithStartWorkflowExecution(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req HistoryServiceSignalWithStartWorkflowExecutionArgs
+	var res HistoryServiceSignalWithStartWorkflowExecutionResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	r, err :=
+		s.handler.SignalWithStartWorkflowExecution(ctx, req.SignalWithStartRequest)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.WorkflowExecutionAlreadyStartedError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for sessionAlreadyExistError returned non-nil error type *shared.WorkflowExecutionAlreadyStartedError but nil value")
+			}
+			res.SessionAlreadyExistError = v
+		case *ShardOwnershipLostError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for shardOwnershipLostError returned non-nil error type *ShardOwnershipLostError but nil value")
+			}
+			res.ShardOwnershipLostError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+		res.Success = r
+	}
+
+	return err == nil, &res, nil
+}
+
 func (s *tchanHistoryServiceServer) handleSignalWorkflowExecution(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
 	var req HistoryServiceSignalWorkflowExecutionArgs
 	var res HistoryServiceSignalWorkflowExecutionResult
@@ -178,6 +178,7 @@ func (p *PollForDecisionTaskRequest) String() string {
 //  - WorkflowType
 //  - PreviousStartedEventId
 //  - StartedEventId
+//  - Query
 type PollForDecisionTaskResponse struct {
   // unused fields # 1 to 9
   TaskToken []byte `thrift:"taskToken,10" db:"taskToken" json:"taskToken,omitempty"`
@@ -189,6 +190,8 @@ type PollForDecisionTaskResponse struct {
   PreviousStartedEventId *int64 `thrift:"previousStartedEventId,40" db:"previousStartedEventId" json:"previousStartedEventId,omitempty"`
   // unused fields # 41 to 49
   StartedEventId *int64 `thrift:"startedEventId,50" db:"startedEventId" json:"startedEventId,omitempty"`
+  // unused fields # 51 to 59
+  Query *shared.WorkflowQuery `thrift:"query,60" db:"query" json:"query,omitempty"`
 }
 
 func NewPollForDecisionTaskResponse() *PollForDecisionTaskResponse {
@@ -228,6 +231,13 @@ func (p *PollForDecisionTaskResponse) GetStartedEventId() int64 {
   }
 return *p.StartedEventId
 }
+var PollForDecisionTaskResponse_Query_DEFAULT *shared.WorkflowQuery
+func (p *PollForDecisionTaskResponse) GetQuery() *shared.WorkflowQuery {
+  if !p.IsSetQuery() {
+    return PollForDecisionTaskResponse_Query_DEFAULT
+  }
+return p.Query
+}
 func (p *PollForDecisionTaskResponse) IsSetTaskToken() bool {
   return p.TaskToken != nil
 }
@@ -248,6 +258,10 @@ func (p *PollForDecisionTaskResponse) IsSetStartedEventId() bool {
   return p.StartedEventId != nil
 }
 
+func (p *PollForDecisionTaskResponse) IsSetQuery() bool {
+  return p.Query != nil
+}
+
 func (p *PollForDecisionTaskResponse) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
@@ -281,6 +295,10 @@ func (p *PollForDecisionTaskResponse) Read(iprot thrift.TProtocol) error {
       if err := p.ReadField50(iprot); err != nil {
         return err
       }
+    case 60:
+      if err := p.ReadField60(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -339,6 +357,14 @@ func (p *PollForDecisionTaskResponse)  ReadField50(iprot thrift.TProtocol) error
   return nil
 }
 
+func (p *PollForDecisionTaskResponse)  ReadField60(iprot thrift.TProtocol) error {
+  p.Query = &shared.WorkflowQuery{}
+  if err := p.Query.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Query), err)
+  }
+  return nil
+}
+
 func (p *PollForDecisionTaskResponse) Write(oprot thrift.TProtocol) error {
   if err := oprot.WriteStructBegin("PollForDecisionTaskResponse"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
@@ -348,6 +374,7 @@ func (p *PollForDecisionTaskResponse) Write(oprot thrift.TProtocol) error {
     if err := p.writeField30(oprot); err != nil { return err }
     if err := p.writeField40(oprot); err != nil { return err }
     if err := p.writeField50(oprot); err != nil { return err }
+    if err := p.writeField60(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -418,6 +445,19 @@ func (p *PollForDecisionTaskResponse) writeField50(oprot thrift.TProtocol) (err
   return err
 }
 
+func (p *PollForDecisionTaskResponse) writeField60(oprot thrift.TProtocol) (err error) {
+  if p.IsSetQuery() {
+    if err := oprot.WriteFieldBegin("query", thrift.STRUCT, 60); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:query: ", p), err) }
+    if err := p.Query.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Query), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:query: ", p), err) }
+  }
+  return err
+}
+
 func (p *PollForDecisionTaskResponse) String() string {
   if p == nil {
     return "<nil>"
@@ -1070,716 +1110,2788 @@ func (p *AddActivityTaskRequest) String() string {
   return fmt.Sprintf("AddActivityTaskRequest(%+v)", *p)
 }
 
-type MatchingService interface {  //MatchingService API is exposed to provide support for polling from long running applications.
-  //Such applications are expected to have a worker which regularly polls for DecisionTask and ActivityTask.  For each
-  //DecisionTask, application is expected to process the history of events for that session and respond back with next
-  //decisions.  For each ActivityTask, application is expected to execute the actual logic for that task and respond back
-  //with completion or failure.
-  //
-
-  // PollForDecisionTask is called by frontend to process DecisionTask from a specific taskList.  A
-  // DecisionTask is dispatched to callers for active workflow executions, with pending decisions.
-  // 
-  // 
-  // Parameters:
-  //  - PollRequest
-  PollForDecisionTask(pollRequest *PollForDecisionTaskRequest) (r *PollForDecisionTaskResponse, err error)
-  // PollForActivityTask is called by frontend to process ActivityTask from a specific taskList.  ActivityTask
-  // is dispatched to callers whenever a ScheduleTask decision is made for a workflow execution.
-  // 
-  // 
-  // Parameters:
-  //  - PollRequest
-  PollForActivityTask(pollRequest *PollForActivityTaskRequest) (r *shared.PollForActivityTaskResponse, err error)
-  // AddDecisionTask is called by the history service when a decision task is scheduled, so that it can be dispatched
-  // by the MatchingEngine.
-  // 
-  // 
-  // Parameters:
-  //  - AddRequest
-  AddDecisionTask(addRequest *AddDecisionTaskRequest) (err error)
-  // AddActivityTask is called by the history service when a decision task is scheduled, so that it can be dispatched
-  // by the MatchingEngine.
-  // 
-  // 
-  // Parameters:
-  //  - AddRequest
-  AddActivityTask(addRequest *AddActivityTaskRequest) (err error)
+// Attributes:
+//  - DomainUUID
+//  - TaskList
+//  - TaskListType
+//  - Confirmation
+//  - DryRun
+type DrainTaskListRequest struct {
+  // unused fields # 1 to 9
+  DomainUUID *string `thrift:"domainUUID,10" db:"domainUUID" json:"domainUUID,omitempty"`
+  // unused fields # 11 to 19
+  TaskList *shared.TaskList `thrift:"taskList,20" db:"taskList" json:"taskList,omitempty"`
+  // unused fields # 21 to 29
+  TaskListType *shared.TaskListType `thrift:"taskListType,30" db:"taskListType" json:"taskListType,omitempty"`
+  // unused fields # 31 to 39
+  Confirmation *string `thrift:"confirmation,40" db:"confirmation" json:"confirmation,omitempty"`
+  // unused fields # 41 to 49
+  DryRun *bool `thrift:"dryRun,50" db:"dryRun" json:"dryRun,omitempty"`
 }
 
-//MatchingService API is exposed to provide support for polling from long running applications.
-//Such applications are expected to have a worker which regularly polls for DecisionTask and ActivityTask.  For each
-//DecisionTask, application is expected to process the history of events for that session and respond back with next
-//decisions.  For each ActivityTask, application is expected to execute the actual logic for that task and respond back
-//with completion or failure.
-//
-type MatchingServiceClient struct {
-  Transport thrift.TTransport
-  ProtocolFactory thrift.TProtocolFactory
-  InputProtocol thrift.TProtocol
-  OutputProtocol thrift.TProtocol
-  SeqId int32
+func NewDrainTaskListRequest() *DrainTaskListRequest {
+  return &DrainTaskListRequest{}
 }
 
-func NewMatchingServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *MatchingServiceClient {
-  return &MatchingServiceClient{Transport: t,
-    ProtocolFactory: f,
-    InputProtocol: f.GetProtocol(t),
-    OutputProtocol: f.GetProtocol(t),
-    SeqId: 0,
+var DrainTaskListRequest_DomainUUID_DEFAULT string
+func (p *DrainTaskListRequest) GetDomainUUID() string {
+  if !p.IsSetDomainUUID() {
+    return DrainTaskListRequest_DomainUUID_DEFAULT
   }
+return *p.DomainUUID
 }
-
-func NewMatchingServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *MatchingServiceClient {
-  return &MatchingServiceClient{Transport: t,
-    ProtocolFactory: nil,
-    InputProtocol: iprot,
-    OutputProtocol: oprot,
-    SeqId: 0,
+var DrainTaskListRequest_TaskList_DEFAULT *shared.TaskList
+func (p *DrainTaskListRequest) GetTaskList() *shared.TaskList {
+  if !p.IsSetTaskList() {
+    return DrainTaskListRequest_TaskList_DEFAULT
   }
+return p.TaskList
 }
-
-// PollForDecisionTask is called by frontend to process DecisionTask from a specific taskList.  A
-// DecisionTask is dispatched to callers for active workflow executions, with pending decisions.
-// 
-// 
-// Parameters:
-//  - PollRequest
-func (p *MatchingServiceClient) PollForDecisionTask(pollRequest *PollForDecisionTaskRequest) (r *PollForDecisionTaskResponse, err error) {
-  if err = p.sendPollForDecisionTask(pollRequest); err != nil { return }
-  return p.recvPollForDecisionTask()
-}
-
-func (p *MatchingServiceClient) sendPollForDecisionTask(pollRequest *PollForDecisionTaskRequest)(err error) {
-  oprot := p.OutputProtocol
-  if oprot == nil {
-    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
-    p.OutputProtocol = oprot
-  }
-  p.SeqId++
-  if err = oprot.WriteMessageBegin("PollForDecisionTask", thrift.CALL, p.SeqId); err != nil {
-      return
-  }
-  args := MatchingServicePollForDecisionTaskArgs{
-  PollRequest : pollRequest,
+var DrainTaskListRequest_TaskListType_DEFAULT shared.TaskListType
+func (p *DrainTaskListRequest) GetTaskListType() shared.TaskListType {
+  if !p.IsSetTaskListType() {
+    return DrainTaskListRequest_TaskListType_DEFAULT
   }
-  if err = args.Write(oprot); err != nil {
-      return
+return *p.TaskListType
+}
+var DrainTaskListRequest_Confirmation_DEFAULT string
+func (p *DrainTaskListRequest) GetConfirmation() string {
+  if !p.IsSetConfirmation() {
+    return DrainTaskListRequest_Confirmation_DEFAULT
   }
-  if err = oprot.WriteMessageEnd(); err != nil {
-      return
+return *p.Confirmation
+}
+var DrainTaskListRequest_DryRun_DEFAULT bool
+func (p *DrainTaskListRequest) GetDryRun() bool {
+  if !p.IsSetDryRun() {
+    return DrainTaskListRequest_DryRun_DEFAULT
   }
-  return oprot.Flush()
+return *p.DryRun
+}
+func (p *DrainTaskListRequest) IsSetDomainUUID() bool {
+  return p.DomainUUID != nil
+}
+
+func (p *DrainTaskListRequest) IsSetTaskList() bool {
+  return p.TaskList != nil
 }
 
+func (p *DrainTaskListRequest) IsSetTaskListType() bool {
+  return p.TaskListType != nil
+}
 
-func (p *MatchingServiceClient) recvPollForDecisionTask() (value *PollForDecisionTaskResponse, err error) {
-  iprot := p.InputProtocol
-  if iprot == nil {
-    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
-    p.InputProtocol = iprot
-  }
-  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
-  if err != nil {
-    return
-  }
-  if method != "PollForDecisionTask" {
-    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "PollForDecisionTask failed: wrong method name")
-    return
-  }
-  if p.SeqId != seqId {
-    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "PollForDecisionTask failed: out of sequence response")
-    return
+func (p *DrainTaskListRequest) IsSetConfirmation() bool {
+  return p.Confirmation != nil
+}
+
+func (p *DrainTaskListRequest) IsSetDryRun() bool {
+  return p.DryRun != nil
+}
+
+func (p *DrainTaskListRequest) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
-  if mTypeId == thrift.EXCEPTION {
-    error0 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-    var error1 error
-    error1, err = error0.Read(iprot)
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
     if err != nil {
-      return
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
     }
-    if err = iprot.ReadMessageEnd(); err != nil {
-      return
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
+    case 50:
+      if err := p.ReadField50(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
     }
-    err = error1
-    return
-  }
-  if mTypeId != thrift.REPLY {
-    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "PollForDecisionTask failed: invalid message type")
-    return
-  }
-  result := MatchingServicePollForDecisionTaskResult{}
-  if err = result.Read(iprot); err != nil {
-    return
-  }
-  if err = iprot.ReadMessageEnd(); err != nil {
-    return
   }
-  if result.BadRequestError != nil {
-    err = result.BadRequestError
-    return 
-  } else   if result.InternalServiceError != nil {
-    err = result.InternalServiceError
-    return 
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
   }
-  value = result.GetSuccess()
-  return
+  return nil
 }
 
-// PollForActivityTask is called by frontend to process ActivityTask from a specific taskList.  ActivityTask
-// is dispatched to callers whenever a ScheduleTask decision is made for a workflow execution.
-// 
-// 
-// Parameters:
-//  - PollRequest
-func (p *MatchingServiceClient) PollForActivityTask(pollRequest *PollForActivityTaskRequest) (r *shared.PollForActivityTaskResponse, err error) {
-  if err = p.sendPollForActivityTask(pollRequest); err != nil { return }
-  return p.recvPollForActivityTask()
+func (p *DrainTaskListRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.DomainUUID = &v
+}
+  return nil
 }
 
-func (p *MatchingServiceClient) sendPollForActivityTask(pollRequest *PollForActivityTaskRequest)(err error) {
-  oprot := p.OutputProtocol
-  if oprot == nil {
-    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
-    p.OutputProtocol = oprot
-  }
-  p.SeqId++
-  if err = oprot.WriteMessageBegin("PollForActivityTask", thrift.CALL, p.SeqId); err != nil {
-      return
-  }
-  args := MatchingServicePollForActivityTaskArgs{
-  PollRequest : pollRequest,
-  }
-  if err = args.Write(oprot); err != nil {
-      return
-  }
-  if err = oprot.WriteMessageEnd(); err != nil {
-      return
+func (p *DrainTaskListRequest)  ReadField20(iprot thrift.TProtocol) error {
+  p.TaskList = &shared.TaskList{}
+  if err := p.TaskList.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TaskList), err)
   }
-  return oprot.Flush()
+  return nil
 }
 
+func (p *DrainTaskListRequest)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
+} else {
+  temp := shared.TaskListType(v)
+  p.TaskListType = &temp
+}
+  return nil
+}
 
-func (p *MatchingServiceClient) recvPollForActivityTask() (value *shared.PollForActivityTaskResponse, err error) {
-  iprot := p.InputProtocol
-  if iprot == nil {
-    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
-    p.InputProtocol = iprot
-  }
-  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
-  if err != nil {
-    return
-  }
-  if method != "PollForActivityTask" {
-    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "PollForActivityTask failed: wrong method name")
-    return
+func (p *DrainTaskListRequest)  ReadField40(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 40: ", err)
+} else {
+  p.Confirmation = &v
+}
+  return nil
+}
+
+func (p *DrainTaskListRequest)  ReadField50(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(); err != nil {
+  return thrift.PrependError("error reading field 50: ", err)
+} else {
+  p.DryRun = &v
+}
+  return nil
+}
+
+func (p *DrainTaskListRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("DrainTaskListRequest"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
+    if err := p.writeField40(oprot); err != nil { return err }
+    if err := p.writeField50(oprot); err != nil { return err }
   }
-  if p.SeqId != seqId {
-    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "PollForActivityTask failed: out of sequence response")
-    return
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *DrainTaskListRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomainUUID() {
+    if err := oprot.WriteFieldBegin("domainUUID", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domainUUID: ", p), err) }
+    if err := oprot.WriteString(string(*p.DomainUUID)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domainUUID (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domainUUID: ", p), err) }
   }
-  if mTypeId == thrift.EXCEPTION {
-    error2 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-    var error3 error
-    error3, err = error2.Read(iprot)
-    if err != nil {
-      return
-    }
-    if err = iprot.ReadMessageEnd(); err != nil {
-      return
+  return err
+}
+
+func (p *DrainTaskListRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTaskList() {
+    if err := oprot.WriteFieldBegin("taskList", thrift.STRUCT, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:taskList: ", p), err) }
+    if err := p.TaskList.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TaskList), err)
     }
-    err = error3
-    return
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:taskList: ", p), err) }
   }
-  if mTypeId != thrift.REPLY {
-    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "PollForActivityTask failed: invalid message type")
-    return
+  return err
+}
+
+func (p *DrainTaskListRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTaskListType() {
+    if err := oprot.WriteFieldBegin("taskListType", thrift.I32, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:taskListType: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.TaskListType)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.taskListType (30) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:taskListType: ", p), err) }
   }
-  result := MatchingServicePollForActivityTaskResult{}
-  if err = result.Read(iprot); err != nil {
-    return
+  return err
+}
+
+func (p *DrainTaskListRequest) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetConfirmation() {
+    if err := oprot.WriteFieldBegin("confirmation", thrift.STRING, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:confirmation: ", p), err) }
+    if err := oprot.WriteString(string(*p.Confirmation)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.confirmation (40) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:confirmation: ", p), err) }
   }
-  if err = iprot.ReadMessageEnd(); err != nil {
-    return
+  return err
+}
+
+func (p *DrainTaskListRequest) writeField50(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDryRun() {
+    if err := oprot.WriteFieldBegin("dryRun", thrift.BOOL, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:dryRun: ", p), err) }
+    if err := oprot.WriteBool(bool(*p.DryRun)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.dryRun (50) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:dryRun: ", p), err) }
   }
-  if result.BadRequestError != nil {
-    err = result.BadRequestError
-    return 
-  } else   if result.InternalServiceError != nil {
-    err = result.InternalServiceError
-    return 
+  return err
+}
+
+func (p *DrainTaskListRequest) String() string {
+  if p == nil {
+    return "<nil>"
   }
-  value = result.GetSuccess()
-  return
+  return fmt.Sprintf("DrainTaskListRequest(%+v)", *p)
 }
 
-// AddDecisionTask is called by the history service when a decision task is scheduled, so that it can be dispatched
-// by the MatchingEngine.
-// 
-// 
-// Parameters:
-//  - AddRequest
-func (p *MatchingServiceClient) AddDecisionTask(addRequest *AddDecisionTaskRequest) (err error) {
-  if err = p.sendAddDecisionTask(addRequest); err != nil { return }
-  return p.recvAddDecisionTask()
+// Attributes:
+//  - AckLevel
+//  - MaxReadLevel
+//  - DrainedTasks
+type DrainTaskListResponse struct {
+  // unused fields # 1 to 9
+  AckLevel *int64 `thrift:"ackLevel,10" db:"ackLevel" json:"ackLevel,omitempty"`
+  // unused fields # 11 to 19
+  MaxReadLevel *int64 `thrift:"maxReadLevel,20" db:"maxReadLevel" json:"maxReadLevel,omitempty"`
+  // unused fields # 21 to 29
+  DrainedTasks *int64 `thrift:"drainedTasks,30" db:"drainedTasks" json:"drainedTasks,omitempty"`
 }
 
-func (p *MatchingServiceClient) sendAddDecisionTask(addRequest *AddDecisionTaskRequest)(err error) {
-  oprot := p.OutputProtocol
-  if oprot == nil {
-    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
-    p.OutputProtocol = oprot
-  }
-  p.SeqId++
-  if err = oprot.WriteMessageBegin("AddDecisionTask", thrift.CALL, p.SeqId); err != nil {
-      return
-  }
-  args := MatchingServiceAddDecisionTaskArgs{
-  AddRequest : addRequest,
+func NewDrainTaskListResponse() *DrainTaskListResponse {
+  return &DrainTaskListResponse{}
+}
+
+var DrainTaskListResponse_AckLevel_DEFAULT int64
+func (p *DrainTaskListResponse) GetAckLevel() int64 {
+  if !p.IsSetAckLevel() {
+    return DrainTaskListResponse_AckLevel_DEFAULT
   }
-  if err = args.Write(oprot); err != nil {
-      return
+return *p.AckLevel
+}
+var DrainTaskListResponse_MaxReadLevel_DEFAULT int64
+func (p *DrainTaskListResponse) GetMaxReadLevel() int64 {
+  if !p.IsSetMaxReadLevel() {
+    return DrainTaskListResponse_MaxReadLevel_DEFAULT
   }
-  if err = oprot.WriteMessageEnd(); err != nil {
-      return
+return *p.MaxReadLevel
+}
+var DrainTaskListResponse_DrainedTasks_DEFAULT int64
+func (p *DrainTaskListResponse) GetDrainedTasks() int64 {
+  if !p.IsSetDrainedTasks() {
+    return DrainTaskListResponse_DrainedTasks_DEFAULT
   }
-  return oprot.Flush()
+return *p.DrainedTasks
+}
+func (p *DrainTaskListResponse) IsSetAckLevel() bool {
+  return p.AckLevel != nil
 }
 
+func (p *DrainTaskListResponse) IsSetMaxReadLevel() bool {
+  return p.MaxReadLevel != nil
+}
 
-func (p *MatchingServiceClient) recvAddDecisionTask() (err error) {
-  iprot := p.InputProtocol
-  if iprot == nil {
-    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
-    p.InputProtocol = iprot
-  }
-  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
-  if err != nil {
-    return
-  }
-  if method != "AddDecisionTask" {
-    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "AddDecisionTask failed: wrong method name")
-    return
-  }
-  if p.SeqId != seqId {
-    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "AddDecisionTask failed: out of sequence response")
-    return
+func (p *DrainTaskListResponse) IsSetDrainedTasks() bool {
+  return p.DrainedTasks != nil
+}
+
+func (p *DrainTaskListResponse) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
-  if mTypeId == thrift.EXCEPTION {
-    error4 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-    var error5 error
-    error5, err = error4.Read(iprot)
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
     if err != nil {
-      return
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
     }
-    if err = iprot.ReadMessageEnd(); err != nil {
-      return
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
     }
-    err = error5
-    return
-  }
-  if mTypeId != thrift.REPLY {
-    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "AddDecisionTask failed: invalid message type")
-    return
-  }
-  result := MatchingServiceAddDecisionTaskResult{}
-  if err = result.Read(iprot); err != nil {
-    return
-  }
-  if err = iprot.ReadMessageEnd(); err != nil {
-    return
   }
-  if result.BadRequestError != nil {
-    err = result.BadRequestError
-    return 
-  } else   if result.InternalServiceError != nil {
-    err = result.InternalServiceError
-    return 
-  } else   if result.ServiceBusyError != nil {
-    err = result.ServiceBusyError
-    return 
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
   }
-  return
+  return nil
 }
 
-// AddActivityTask is called by the history service when a decision task is scheduled, so that it can be dispatched
-// by the MatchingEngine.
-// 
-// 
-// Parameters:
-//  - AddRequest
-func (p *MatchingServiceClient) AddActivityTask(addRequest *AddActivityTaskRequest) (err error) {
-  if err = p.sendAddActivityTask(addRequest); err != nil { return }
-  return p.recvAddActivityTask()
+func (p *DrainTaskListResponse)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.AckLevel = &v
+}
+  return nil
 }
 
-func (p *MatchingServiceClient) sendAddActivityTask(addRequest *AddActivityTaskRequest)(err error) {
-  oprot := p.OutputProtocol
-  if oprot == nil {
-    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
-    p.OutputProtocol = oprot
-  }
-  p.SeqId++
-  if err = oprot.WriteMessageBegin("AddActivityTask", thrift.CALL, p.SeqId); err != nil {
-      return
-  }
-  args := MatchingServiceAddActivityTaskArgs{
-  AddRequest : addRequest,
-  }
-  if err = args.Write(oprot); err != nil {
-      return
-  }
-  if err = oprot.WriteMessageEnd(); err != nil {
-      return
-  }
+func (p *DrainTaskListResponse)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 20: ", err)
+} else {
+  p.MaxReadLevel = &v
+}
+  return nil
+}
+
+func (p *DrainTaskListResponse)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
+} else {
+  p.DrainedTasks = &v
+}
+  return nil
+}
+
+func (p *DrainTaskListResponse) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("DrainTaskListResponse"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *DrainTaskListResponse) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetAckLevel() {
+    if err := oprot.WriteFieldBegin("ackLevel", thrift.I64, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:ackLevel: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.AckLevel)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.ackLevel (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:ackLevel: ", p), err) }
+  }
+  return err
+}
+
+func (p *DrainTaskListResponse) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetMaxReadLevel() {
+    if err := oprot.WriteFieldBegin("maxReadLevel", thrift.I64, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:maxReadLevel: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.MaxReadLevel)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.maxReadLevel (20) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:maxReadLevel: ", p), err) }
+  }
+  return err
+}
+
+func (p *DrainTaskListResponse) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDrainedTasks() {
+    if err := oprot.WriteFieldBegin("drainedTasks", thrift.I64, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:drainedTasks: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.DrainedTasks)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.drainedTasks (30) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:drainedTasks: ", p), err) }
+  }
+  return err
+}
+
+func (p *DrainTaskListResponse) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("DrainTaskListResponse(%+v)", *p)
+}
+
+// Attributes:
+//  - DomainUUID
+//  - TaskList
+//  - QueryRequest
+type QueryWorkflowRequest struct {
+  // unused fields # 1 to 9
+  DomainUUID *string `thrift:"domainUUID,10" db:"domainUUID" json:"domainUUID,omitempty"`
+  // unused fields # 11 to 19
+  TaskList *shared.TaskList `thrift:"taskList,20" db:"taskList" json:"taskList,omitempty"`
+  // unused fields # 21 to 29
+  QueryRequest *shared.QueryWorkflowRequest `thrift:"queryRequest,30" db:"queryRequest" json:"queryRequest,omitempty"`
+}
+
+func NewQueryWorkflowRequest() *QueryWorkflowRequest {
+  return &QueryWorkflowRequest{}
+}
+
+var QueryWorkflowRequest_DomainUUID_DEFAULT string
+func (p *QueryWorkflowRequest) GetDomainUUID() string {
+  if !p.IsSetDomainUUID() {
+    return QueryWorkflowRequest_DomainUUID_DEFAULT
+  }
+return *p.DomainUUID
+}
+var QueryWorkflowRequest_TaskList_DEFAULT *shared.TaskList
+func (p *QueryWorkflowRequest) GetTaskList() *shared.TaskList {
+  if !p.IsSetTaskList() {
+    return QueryWorkflowRequest_TaskList_DEFAULT
+  }
+return p.TaskList
+}
+var QueryWorkflowRequest_QueryRequest_DEFAULT *shared.QueryWorkflowRequest
+func (p *QueryWorkflowRequest) GetQueryRequest() *shared.QueryWorkflowRequest {
+  if !p.IsSetQueryRequest() {
+    return QueryWorkflowRequest_QueryRequest_DEFAULT
+  }
+return p.QueryRequest
+}
+func (p *QueryWorkflowRequest) IsSetDomainUUID() bool {
+  return p.DomainUUID != nil
+}
+
+func (p *QueryWorkflowRequest) IsSetTaskList() bool {
+  return p.TaskList != nil
+}
+
+func (p *QueryWorkflowRequest) IsSetQueryRequest() bool {
+  return p.QueryRequest != nil
+}
+
+func (p *QueryWorkflowRequest) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *QueryWorkflowRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.DomainUUID = &v
+}
+  return nil
+}
+
+func (p *QueryWorkflowRequest)  ReadField20(iprot thrift.TProtocol) error {
+  p.TaskList = &shared.TaskList{}
+  if err := p.TaskList.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TaskList), err)
+  }
+  return nil
+}
+
+func (p *QueryWorkflowRequest)  ReadField30(iprot thrift.TProtocol) error {
+  p.QueryRequest = &shared.QueryWorkflowRequest{}
+  if err := p.QueryRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.QueryRequest), err)
+  }
+  return nil
+}
+
+func (p *QueryWorkflowRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("QueryWorkflowRequest"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *QueryWorkflowRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomainUUID() {
+    if err := oprot.WriteFieldBegin("domainUUID", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domainUUID: ", p), err) }
+    if err := oprot.WriteString(string(*p.DomainUUID)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domainUUID (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domainUUID: ", p), err) }
+  }
+  return err
+}
+
+func (p *QueryWorkflowRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTaskList() {
+    if err := oprot.WriteFieldBegin("taskList", thrift.STRUCT, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:taskList: ", p), err) }
+    if err := p.TaskList.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TaskList), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:taskList: ", p), err) }
+  }
+  return err
+}
+
+func (p *QueryWorkflowRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetQueryRequest() {
+    if err := oprot.WriteFieldBegin("queryRequest", thrift.STRUCT, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:queryRequest: ", p), err) }
+    if err := p.QueryRequest.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.QueryRequest), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:queryRequest: ", p), err) }
+  }
+  return err
+}
+
+func (p *QueryWorkflowRequest) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("QueryWorkflowRequest(%+v)", *p)
+}
+
+// Attributes:
+//  - DomainUUID
+//  - TaskList
+//  - TaskID
+//  - CompletedRequest
+type RespondQueryTaskCompletedRequest struct {
+  // unused fields # 1 to 9
+  DomainUUID *string `thrift:"domainUUID,10" db:"domainUUID" json:"domainUUID,omitempty"`
+  // unused fields # 11 to 19
+  TaskList *shared.TaskList `thrift:"taskList,20" db:"taskList" json:"taskList,omitempty"`
+  // unused fields # 21 to 29
+  TaskID *string `thrift:"taskID,30" db:"taskID" json:"taskID,omitempty"`
+  // unused fields # 31 to 39
+  CompletedRequest *shared.RespondQueryTaskCompletedRequest `thrift:"completedRequest,40" db:"completedRequest" json:"completedRequest,omitempty"`
+}
+
+func NewRespondQueryTaskCompletedRequest() *RespondQueryTaskCompletedRequest {
+  return &RespondQueryTaskCompletedRequest{}
+}
+
+var RespondQueryTaskCompletedRequest_DomainUUID_DEFAULT string
+func (p *RespondQueryTaskCompletedRequest) GetDomainUUID() string {
+  if !p.IsSetDomainUUID() {
+    return RespondQueryTaskCompletedRequest_DomainUUID_DEFAULT
+  }
+return *p.DomainUUID
+}
+var RespondQueryTaskCompletedRequest_TaskList_DEFAULT *shared.TaskList
+func (p *RespondQueryTaskCompletedRequest) GetTaskList() *shared.TaskList {
+  if !p.IsSetTaskList() {
+    return RespondQueryTaskCompletedRequest_TaskList_DEFAULT
+  }
+return p.TaskList
+}
+var RespondQueryTaskCompletedRequest_TaskID_DEFAULT string
+func (p *RespondQueryTaskCompletedRequest) GetTaskID() string {
+  if !p.IsSetTaskID() {
+    return RespondQueryTaskCompletedRequest_TaskID_DEFAULT
+  }
+return *p.TaskID
+}
+var RespondQueryTaskCompletedRequest_CompletedRequest_DEFAULT *shared.RespondQueryTaskCompletedRequest
+func (p *RespondQueryTaskCompletedRequest) GetCompletedRequest() *shared.RespondQueryTaskCompletedRequest {
+  if !p.IsSetCompletedRequest() {
+    return RespondQueryTaskCompletedRequest_CompletedRequest_DEFAULT
+  }
+return p.CompletedRequest
+}
+func (p *RespondQueryTaskCompletedRequest) IsSetDomainUUID() bool {
+  return p.DomainUUID != nil
+}
+
+func (p *RespondQueryTaskCompletedRequest) IsSetTaskList() bool {
+  return p.TaskList != nil
+}
+
+func (p *RespondQueryTaskCompletedRequest) IsSetTaskID() bool {
+  return p.TaskID != nil
+}
+
+func (p *RespondQueryTaskCompletedRequest) IsSetCompletedRequest() bool {
+  return p.CompletedRequest != nil
+}
+
+func (p *RespondQueryTaskCompletedRequest) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *RespondQueryTaskCompletedRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.DomainUUID = &v
+}
+  return nil
+}
+
+func (p *RespondQueryTaskCompletedRequest)  ReadField20(iprot thrift.TProtocol) error {
+  p.TaskList = &shared.TaskList{}
+  if err := p.TaskList.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TaskList), err)
+  }
+  return nil
+}
+
+func (p *RespondQueryTaskCompletedRequest)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
+} else {
+  p.TaskID = &v
+}
+  return nil
+}
+
+func (p *RespondQueryTaskCompletedRequest)  ReadField40(iprot thrift.TProtocol) error {
+  p.CompletedRequest = &shared.RespondQueryTaskCompletedRequest{}
+  if err := p.CompletedRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CompletedRequest), err)
+  }
+  return nil
+}
+
+func (p *RespondQueryTaskCompletedRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondQueryTaskCompletedRequest"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
+    if err := p.writeField40(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *RespondQueryTaskCompletedRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomainUUID() {
+    if err := oprot.WriteFieldBegin("domainUUID", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domainUUID: ", p), err) }
+    if err := oprot.WriteString(string(*p.DomainUUID)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domainUUID (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domainUUID: ", p), err) }
+  }
+  return err
+}
+
+func (p *RespondQueryTaskCompletedRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTaskList() {
+    if err := oprot.WriteFieldBegin("taskList", thrift.STRUCT, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:taskList: ", p), err) }
+    if err := p.TaskList.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TaskList), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:taskList: ", p), err) }
+  }
+  return err
+}
+
+func (p *RespondQueryTaskCompletedRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTaskID() {
+    if err := oprot.WriteFieldBegin("taskID", thrift.STRING, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:taskID: ", p), err) }
+    if err := oprot.WriteString(string(*p.TaskID)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.taskID (30) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:taskID: ", p), err) }
+  }
+  return err
+}
+
+func (p *RespondQueryTaskCompletedRequest) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetCompletedRequest() {
+    if err := oprot.WriteFieldBegin("completedRequest", thrift.STRUCT, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:completedRequest: ", p), err) }
+    if err := p.CompletedRequest.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CompletedRequest), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:completedRequest: ", p), err) }
+  }
+  return err
+}
+
+func (p *RespondQueryTaskCompletedRequest) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("RespondQueryTaskCompletedRequest(%+v)", *p)
+}
+
+type MatchingService interface {  //MatchingService API is exposed to provide support for polling from long running applications.
+  //Such applications are expected to have a worker which regularly polls for DecisionTask and ActivityTask.  For each
+  //DecisionTask, application is expected to process the history of events for that session and respond back with next
+  //decisions.  For each ActivityTask, application is expected to execute the actual logic for that task and respond back
+  //with completion or failure.
+  //
+
+  // PollForDecisionTask is called by frontend to process DecisionTask from a specific taskList.  A
+  // DecisionTask is dispatched to callers for active workflow executions, with pending decisions.
+  // 
+  // 
+  // Parameters:
+  //  - PollRequest
+  PollForDecisionTask(pollRequest *PollForDecisionTaskRequest) (r *PollForDecisionTaskResponse, err error)
+  // PollForActivityTask is called by frontend to process ActivityTask from a specific taskList.  ActivityTask
+  // is dispatched to callers whenever a ScheduleTask decision is made for a workflow execution.
+  // 
+  // 
+  // Parameters:
+  //  - PollRequest
+  PollForActivityTask(pollRequest *PollForActivityTaskRequest) (r *shared.PollForActivityTaskResponse, err error)
+  // AddDecisionTask is called by the history service when a decision task is scheduled, so that it can be dispatched
+  // by the MatchingEngine.
+  // 
+  // 
+  // Parameters:
+  //  - AddRequest
+  AddDecisionTask(addRequest *AddDecisionTaskRequest) (err error)
+  // AddActivityTask is called by the history service when a decision task is scheduled, so that it can be dispatched
+  // by the MatchingEngine.
+  // 
+  // 
+  // Parameters:
+  //  - AddRequest
+  AddActivityTask(addRequest *AddActivityTaskRequest) (err error)
+  // DrainTaskList is an admin operation which discards the backlog of a task list, e.g. after its domain is
+  // abandoned.  Tasks are completed in ranges up to the task list read level at the time of the call.  The request
+  // has to carry the task list name as confirmation, unless it is a dry run which only reports the backlog.
+  // 
+  // 
+  // Parameters:
+  //  - DrainRequest
+  DrainTaskList(drainRequest *DrainTaskListRequest) (r *DrainTaskListResponse, err error)
+  // QueryWorkflow is called by frontend to dispatch a query to a worker polling the decision task list of the
+  // workflow execution.  It blocks until the worker answers the query with RespondQueryTaskCompleted.
+  // 
+  // Parameters:
+  //  - QueryRequest
+  QueryWorkflow(queryRequest *QueryWorkflowRequest) (r *shared.QueryWorkflowResponse, err error)
+  // RespondQueryTaskCompleted is called by frontend to deliver the answer of a worker to the pending QueryWorkflow
+  // call of the query task.
+  // 
+  // Parameters:
+  //  - Request
+  RespondQueryTaskCompleted(request *RespondQueryTaskCompletedRequest) (err error)
+}
+
+//MatchingService API is exposed to provide support for polling from long running applications.
+//Such applications are expected to have a worker which regularly polls for DecisionTask and ActivityTask.  For each
+//DecisionTask, application is expected to process the history of events for that session and respond back with next
+//decisions.  For each ActivityTask, application is expected to execute the actual logic for that task and respond back
+//with completion or failure.
+//
+type MatchingServiceClient struct {
+  Transport thrift.TTransport
+  ProtocolFactory thrift.TProtocolFactory
+  InputProtocol thrift.TProtocol
+  OutputProtocol thrift.TProtocol
+  SeqId int32
+}
+
+func NewMatchingServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *MatchingServiceClient {
+  return &MatchingServiceClient{Transport: t,
+    ProtocolFactory: f,
+    InputProtocol: f.GetProtocol(t),
+    OutputProtocol: f.GetProtocol(t),
+    SeqId: 0,
+  }
+}
+
+func NewMatchingServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *MatchingServiceClient {
+  return &MatchingServiceClient{Transport: t,
+    ProtocolFactory: nil,
+    InputProtocol: iprot,
+    OutputProtocol: oprot,
+    SeqId: 0,
+  }
+}
+
+// PollForDecisionTask is called by frontend to process DecisionTask from a specific taskList.  A
+// DecisionTask is dispatched to callers for active workflow executions, with pending decisions.
+// 
+// 
+// Parameters:
+//  - PollRequest
+func (p *MatchingServiceClient) PollForDecisionTask(pollRequest *PollForDecisionTaskRequest) (r *PollForDecisionTaskResponse, err error) {
+  if err = p.sendPollForDecisionTask(pollRequest); err != nil { return }
+  return p.recvPollForDecisionTask()
+}
+
+func (p *MatchingServiceClient) sendPollForDecisionTask(pollRequest *PollForDecisionTaskRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
+  }
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("PollForDecisionTask", thrift.CALL, p.SeqId); err != nil {
+      return
+  }
+  args := MatchingServicePollForDecisionTaskArgs{
+  PollRequest : pollRequest,
+  }
+  if err = args.Write(oprot); err != nil {
+      return
+  }
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
+  }
+  return oprot.Flush()
+}
+
+
+func (p *MatchingServiceClient) recvPollForDecisionTask() (value *PollForDecisionTaskResponse, err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
+  }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
+  }
+  if method != "PollForDecisionTask" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "PollForDecisionTask failed: wrong method name")
+    return
+  }
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "PollForDecisionTask failed: out of sequence response")
+    return
+  }
+  if mTypeId == thrift.EXCEPTION {
+    error0 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error1 error
+    error1, err = error0.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error1
+    return
+  }
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "PollForDecisionTask failed: invalid message type")
+    return
+  }
+  result := MatchingServicePollForDecisionTaskResult{}
+  if err = result.Read(iprot); err != nil {
+    return
+  }
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  }
+  value = result.GetSuccess()
+  return
+}
+
+// PollForActivityTask is called by frontend to process ActivityTask from a specific taskList.  ActivityTask
+// is dispatched to callers whenever a ScheduleTask decision is made for a workflow execution.
+// 
+// 
+// Parameters:
+//  - PollRequest
+func (p *MatchingServiceClient) PollForActivityTask(pollRequest *PollForActivityTaskRequest) (r *shared.PollForActivityTaskResponse, err error) {
+  if err = p.sendPollForActivityTask(pollRequest); err != nil { return }
+  return p.recvPollForActivityTask()
+}
+
+func (p *MatchingServiceClient) sendPollForActivityTask(pollRequest *PollForActivityTaskRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
+  }
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("PollForActivityTask", thrift.CALL, p.SeqId); err != nil {
+      return
+  }
+  args := MatchingServicePollForActivityTaskArgs{
+  PollRequest : pollRequest,
+  }
+  if err = args.Write(oprot); err != nil {
+      return
+  }
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
+  }
+  return oprot.Flush()
+}
+
+
+func (p *MatchingServiceClient) recvPollForActivityTask() (value *shared.PollForActivityTaskResponse, err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
+  }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
+  }
+  if method != "PollForActivityTask" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "PollForActivityTask failed: wrong method name")
+    return
+  }
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "PollForActivityTask failed: out of sequence response")
+    return
+  }
+  if mTypeId == thrift.EXCEPTION {
+    error2 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error3 error
+    error3, err = error2.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error3
+    return
+  }
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "PollForActivityTask failed: invalid message type")
+    return
+  }
+  result := MatchingServicePollForActivityTaskResult{}
+  if err = result.Read(iprot); err != nil {
+    return
+  }
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  }
+  value = result.GetSuccess()
+  return
+}
+
+// AddDecisionTask is called by the history service when a decision task is scheduled, so that it can be dispatched
+// by the MatchingEngine.
+// 
+// 
+// Parameters:
+//  - AddRequest
+func (p *MatchingServiceClient) AddDecisionTask(addRequest *AddDecisionTaskRequest) (err error) {
+  if err = p.sendAddDecisionTask(addRequest); err != nil { return }
+  return p.recvAddDecisionTask()
+}
+
+func (p *MatchingServiceClient) sendAddDecisionTask(addRequest *AddDecisionTaskRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
+  }
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("AddDecisionTask", thrift.CALL, p.SeqId); err != nil {
+      return
+  }
+  args := MatchingServiceAddDecisionTaskArgs{
+  AddRequest : addRequest,
+  }
+  if err = args.Write(oprot); err != nil {
+      return
+  }
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
+  }
+  return oprot.Flush()
+}
+
+
+func (p *MatchingServiceClient) recvAddDecisionTask() (err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
+  }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
+  }
+  if method != "AddDecisionTask" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "AddDecisionTask failed: wrong method name")
+    return
+  }
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "AddDecisionTask failed: out of sequence response")
+    return
+  }
+  if mTypeId == thrift.EXCEPTION {
+    error4 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error5 error
+    error5, err = error4.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error5
+    return
+  }
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "AddDecisionTask failed: invalid message type")
+    return
+  }
+  result := MatchingServiceAddDecisionTaskResult{}
+  if err = result.Read(iprot); err != nil {
+    return
+  }
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.ServiceBusyError != nil {
+    err = result.ServiceBusyError
+    return 
+  }
+  return
+}
+
+// AddActivityTask is called by the history service when a decision task is scheduled, so that it can be dispatched
+// by the MatchingEngine.
+// 
+// 
+// Parameters:
+//  - AddRequest
+func (p *MatchingServiceClient) AddActivityTask(addRequest *AddActivityTaskRequest) (err error) {
+  if err = p.sendAddActivityTask(addRequest); err != nil { return }
+  return p.recvAddActivityTask()
+}
+
+func (p *MatchingServiceClient) sendAddActivityTask(addRequest *AddActivityTaskRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
+  }
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("AddActivityTask", thrift.CALL, p.SeqId); err != nil {
+      return
+  }
+  args := MatchingServiceAddActivityTaskArgs{
+  AddRequest : addRequest,
+  }
+  if err = args.Write(oprot); err != nil {
+      return
+  }
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
+  }
+  return oprot.Flush()
+}
+
+
+func (p *MatchingServiceClient) recvAddActivityTask() (err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
+  }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
+  }
+  if method != "AddActivityTask" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "AddActivityTask failed: wrong method name")
+    return
+  }
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "AddActivityTask failed: out of sequence response")
+    return
+  }
+  if mTypeId == thrift.EXCEPTION {
+    error6 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error7 error
+    error7, err = error6.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error7
+    return
+  }
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "AddActivityTask failed: invalid message type")
+    return
+  }
+  result := MatchingServiceAddActivityTaskResult{}
+  if err = result.Read(iprot); err != nil {
+    return
+  }
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.ServiceBusyError != nil {
+    err = result.ServiceBusyError
+    return 
+  }
+  return
+}
+
+
+// DrainTaskList is an admin operation which discards the backlog of a task list, e.g. after its domain is
+// abandoned.  Tasks are completed in ranges up to the task list read level at the time of the call.  The request
+// has to carry the task list name as confirmation, unless it is a dry run which only reports the backlog.
+// 
+// 
+// Parameters:
+//  - DrainRequest
+func (p *MatchingServiceClient) DrainTaskList(drainRequest *DrainTaskListRequest) (r *DrainTaskListResponse, err error) {
+  if err = p.sendDrainTaskList(drainRequest); err != nil { return }
+  return p.recvDrainTaskList()
+}
+
+func (p *MatchingServiceClient) sendDrainTaskList(drainRequest *DrainTaskListRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
+  }
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("DrainTaskList", thrift.CALL, p.SeqId); err != nil {
+      return
+  }
+  args := MatchingServiceDrainTaskListArgs{
+  DrainRequest : drainRequest,
+  }
+  if err = args.Write(oprot); err != nil {
+      return
+  }
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
+  }
+  return oprot.Flush()
+}
+
+
+func (p *MatchingServiceClient) recvDrainTaskList() (value *DrainTaskListResponse, err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
+  }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
+  }
+  if method != "DrainTaskList" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "DrainTaskList failed: wrong method name")
+    return
+  }
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "DrainTaskList failed: out of sequence response")
+    return
+  }
+  if mTypeId == thrift.EXCEPTION {
+    error2 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error3 error
+    error3, err = error2.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error3
+    return
+  }
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "DrainTaskList failed: invalid message type")
+    return
+  }
+  result := MatchingServiceDrainTaskListResult{}
+  if err = result.Read(iprot); err != nil {
+    return
+  }
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  }
+  value = result.GetSuccess()
+  return
+}
+
+// QueryWorkflow is called by frontend to dispatch a query to a worker polling the decision task list of the
+// workflow execution.  It blocks until the worker answers the query with RespondQueryTaskCompleted.
+// 
+// Parameters:
+//  - QueryRequest
+func (p *MatchingServiceClient) QueryWorkflow(queryRequest *QueryWorkflowRequest) (r *shared.QueryWorkflowResponse, err error) {
+  if err = p.sendQueryWorkflow(queryRequest); err != nil { return }
+  return p.recvQueryWorkflow()
+}
+
+func (p *MatchingServiceClient) sendQueryWorkflow(queryRequest *QueryWorkflowRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
+  }
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("QueryWorkflow", thrift.CALL, p.SeqId); err != nil {
+      return
+  }
+  args := MatchingServiceQueryWorkflowArgs{
+  QueryRequest : queryRequest,
+  }
+  if err = args.Write(oprot); err != nil {
+      return
+  }
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
+  }
+  return oprot.Flush()
+}
+
+
+func (p *MatchingServiceClient) recvQueryWorkflow() (value *shared.QueryWorkflowResponse, err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
+  }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
+  }
+  if method != "QueryWorkflow" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "QueryWorkflow failed: wrong method name")
+    return
+  }
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "QueryWorkflow failed: out of sequence response")
+    return
+  }
+  if mTypeId == thrift.EXCEPTION {
+    error2 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error3 error
+    error3, err = error2.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error3
+    return
+  }
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "QueryWorkflow failed: invalid message type")
+    return
+  }
+  result := MatchingServiceQueryWorkflowResult{}
+  if err = result.Read(iprot); err != nil {
+    return
+  }
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  } else   if result.QueryFailedError != nil {
+    err = result.QueryFailedError
+    return 
+  }
+  value = result.GetSuccess()
+  return
+}
+
+// RespondQueryTaskCompleted is called by frontend to deliver the answer of a worker to the pending QueryWorkflow
+// call of the query task.
+// 
+// Parameters:
+//  - Request
+func (p *MatchingServiceClient) RespondQueryTaskCompleted(request *RespondQueryTaskCompletedRequest) (err error) {
+  if err = p.sendRespondQueryTaskCompleted(request); err != nil { return }
+  return p.recvRespondQueryTaskCompleted()
+}
+
+func (p *MatchingServiceClient) sendRespondQueryTaskCompleted(request *RespondQueryTaskCompletedRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
+  }
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("RespondQueryTaskCompleted", thrift.CALL, p.SeqId); err != nil {
+      return
+  }
+  args := MatchingServiceRespondQueryTaskCompletedArgs{
+  Request : request,
+  }
+  if err = args.Write(oprot); err != nil {
+      return
+  }
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
+  }
   return oprot.Flush()
 }
 
 
-func (p *MatchingServiceClient) recvAddActivityTask() (err error) {
-  iprot := p.InputProtocol
-  if iprot == nil {
-    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
-    p.InputProtocol = iprot
+func (p *MatchingServiceClient) recvRespondQueryTaskCompleted() (err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
+  }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
+  }
+  if method != "RespondQueryTaskCompleted" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RespondQueryTaskCompleted failed: wrong method name")
+    return
+  }
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RespondQueryTaskCompleted failed: out of sequence response")
+    return
+  }
+  if mTypeId == thrift.EXCEPTION {
+    error4 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error5 error
+    error5, err = error4.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error5
+    return
+  }
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RespondQueryTaskCompleted failed: invalid message type")
+    return
+  }
+  result := MatchingServiceRespondQueryTaskCompletedResult{}
+  if err = result.Read(iprot); err != nil {
+    return
+  }
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  }
+  return
+}
+
+type MatchingServiceProcessor struct {
+  processorMap map[string]thrift.TProcessorFunction
+  handler MatchingService
+}
+
+func (p *MatchingServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+  p.processorMap[key] = processor
+}
+
+func (p *MatchingServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+  processor, ok = p.processorMap[key]
+  return processor, ok
+}
+
+func (p *MatchingServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+  return p.processorMap
+}
+
+func NewMatchingServiceProcessor(handler MatchingService) *MatchingServiceProcessor {
+
+  self8 := &MatchingServiceProcessor{handler:handler, processorMap:make(map[string]thrift.TProcessorFunction)}
+  self8.processorMap["PollForDecisionTask"] = &matchingServiceProcessorPollForDecisionTask{handler:handler}
+  self8.processorMap["PollForActivityTask"] = &matchingServiceProcessorPollForActivityTask{handler:handler}
+  self8.processorMap["AddDecisionTask"] = &matchingServiceProcessorAddDecisionTask{handler:handler}
+  self8.processorMap["AddActivityTask"] = &matchingServiceProcessorAddActivityTask{handler:handler}
+  self8.processorMap["DrainTaskList"] = &matchingServiceProcessorDrainTaskList{handler:handler}
+  self8.processorMap["QueryWorkflow"] = &matchingServiceProcessorQueryWorkflow{handler:handler}
+  self8.processorMap["RespondQueryTaskCompleted"] = &matchingServiceProcessorRespondQueryTaskCompleted{handler:handler}
+return self8
+}
+
+func (p *MatchingServiceProcessor) Process(iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  name, _, seqId, err := iprot.ReadMessageBegin()
+  if err != nil { return false, err }
+  if processor, ok := p.GetProcessorFunction(name); ok {
+    return processor.Process(seqId, iprot, oprot)
+  }
+  iprot.Skip(thrift.STRUCT)
+  iprot.ReadMessageEnd()
+  x9 := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function " + name)
+  oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+  x9.Write(oprot)
+  oprot.WriteMessageEnd()
+  oprot.Flush()
+  return false, x9
+
+}
+
+type matchingServiceProcessorPollForDecisionTask struct {
+  handler MatchingService
+}
+
+func (p *matchingServiceProcessorPollForDecisionTask) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := MatchingServicePollForDecisionTaskArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("PollForDecisionTask", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := MatchingServicePollForDecisionTaskResult{}
+var retval *PollForDecisionTaskResponse
+  var err2 error
+  if retval, err2 = p.handler.PollForDecisionTask(args.PollRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PollForDecisionTask: " + err2.Error())
+    oprot.WriteMessageBegin("PollForDecisionTask", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("PollForDecisionTask", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type matchingServiceProcessorPollForActivityTask struct {
+  handler MatchingService
+}
+
+func (p *matchingServiceProcessorPollForActivityTask) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := MatchingServicePollForActivityTaskArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("PollForActivityTask", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := MatchingServicePollForActivityTaskResult{}
+var retval *shared.PollForActivityTaskResponse
+  var err2 error
+  if retval, err2 = p.handler.PollForActivityTask(args.PollRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PollForActivityTask: " + err2.Error())
+    oprot.WriteMessageBegin("PollForActivityTask", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("PollForActivityTask", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type matchingServiceProcessorAddDecisionTask struct {
+  handler MatchingService
+}
+
+func (p *matchingServiceProcessorAddDecisionTask) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := MatchingServiceAddDecisionTaskArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("AddDecisionTask", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := MatchingServiceAddDecisionTaskResult{}
+  var err2 error
+  if err2 = p.handler.AddDecisionTask(args.AddRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.ServiceBusyError:
+  result.ServiceBusyError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AddDecisionTask: " + err2.Error())
+    oprot.WriteMessageBegin("AddDecisionTask", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("AddDecisionTask", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type matchingServiceProcessorAddActivityTask struct {
+  handler MatchingService
+}
+
+func (p *matchingServiceProcessorAddActivityTask) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := MatchingServiceAddActivityTaskArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("AddActivityTask", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := MatchingServiceAddActivityTaskResult{}
+  var err2 error
+  if err2 = p.handler.AddActivityTask(args.AddRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.ServiceBusyError:
+  result.ServiceBusyError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AddActivityTask: " + err2.Error())
+    oprot.WriteMessageBegin("AddActivityTask", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("AddActivityTask", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type matchingServiceProcessorDrainTaskList struct {
+  handler MatchingService
+}
+
+func (p *matchingServiceProcessorDrainTaskList) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := MatchingServiceDrainTaskListArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("DrainTaskList", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := MatchingServiceDrainTaskListResult{}
+var retval *DrainTaskListResponse
+  var err2 error
+  if retval, err2 = p.handler.DrainTaskList(args.DrainRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DrainTaskList: " + err2.Error())
+    oprot.WriteMessageBegin("DrainTaskList", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("DrainTaskList", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type matchingServiceProcessorQueryWorkflow struct {
+  handler MatchingService
+}
+
+func (p *matchingServiceProcessorQueryWorkflow) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := MatchingServiceQueryWorkflowArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("QueryWorkflow", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := MatchingServiceQueryWorkflowResult{}
+var retval *shared.QueryWorkflowResponse
+  var err2 error
+  if retval, err2 = p.handler.QueryWorkflow(args.QueryRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    case *shared.QueryFailedError:
+  result.QueryFailedError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing QueryWorkflow: " + err2.Error())
+    oprot.WriteMessageBegin("QueryWorkflow", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("QueryWorkflow", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type matchingServiceProcessorRespondQueryTaskCompleted struct {
+  handler MatchingService
+}
+
+func (p *matchingServiceProcessorRespondQueryTaskCompleted) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := MatchingServiceRespondQueryTaskCompletedArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("RespondQueryTaskCompleted", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := MatchingServiceRespondQueryTaskCompletedResult{}
+  var err2 error
+  if err2 = p.handler.RespondQueryTaskCompleted(args.Request); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondQueryTaskCompleted: " + err2.Error())
+    oprot.WriteMessageBegin("RespondQueryTaskCompleted", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("RespondQueryTaskCompleted", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+
+// HELPER FUNCTIONS AND STRUCTURES
+
+// Attributes:
+//  - PollRequest
+type MatchingServicePollForDecisionTaskArgs struct {
+  PollRequest *PollForDecisionTaskRequest `thrift:"pollRequest,1" db:"pollRequest" json:"pollRequest"`
+}
+
+func NewMatchingServicePollForDecisionTaskArgs() *MatchingServicePollForDecisionTaskArgs {
+  return &MatchingServicePollForDecisionTaskArgs{}
+}
+
+var MatchingServicePollForDecisionTaskArgs_PollRequest_DEFAULT *PollForDecisionTaskRequest
+func (p *MatchingServicePollForDecisionTaskArgs) GetPollRequest() *PollForDecisionTaskRequest {
+  if !p.IsSetPollRequest() {
+    return MatchingServicePollForDecisionTaskArgs_PollRequest_DEFAULT
+  }
+return p.PollRequest
+}
+func (p *MatchingServicePollForDecisionTaskArgs) IsSetPollRequest() bool {
+  return p.PollRequest != nil
+}
+
+func (p *MatchingServicePollForDecisionTaskArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *MatchingServicePollForDecisionTaskArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.PollRequest = &PollForDecisionTaskRequest{}
+  if err := p.PollRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.PollRequest), err)
+  }
+  return nil
+}
+
+func (p *MatchingServicePollForDecisionTaskArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("PollForDecisionTask_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *MatchingServicePollForDecisionTaskArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("pollRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:pollRequest: ", p), err) }
+  if err := p.PollRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.PollRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:pollRequest: ", p), err) }
+  return err
+}
+
+func (p *MatchingServicePollForDecisionTaskArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("MatchingServicePollForDecisionTaskArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - BadRequestError
+//  - InternalServiceError
+type MatchingServicePollForDecisionTaskResult struct {
+  Success *PollForDecisionTaskResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+}
+
+func NewMatchingServicePollForDecisionTaskResult() *MatchingServicePollForDecisionTaskResult {
+  return &MatchingServicePollForDecisionTaskResult{}
+}
+
+var MatchingServicePollForDecisionTaskResult_Success_DEFAULT *PollForDecisionTaskResponse
+func (p *MatchingServicePollForDecisionTaskResult) GetSuccess() *PollForDecisionTaskResponse {
+  if !p.IsSetSuccess() {
+    return MatchingServicePollForDecisionTaskResult_Success_DEFAULT
+  }
+return p.Success
+}
+var MatchingServicePollForDecisionTaskResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *MatchingServicePollForDecisionTaskResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return MatchingServicePollForDecisionTaskResult_BadRequestError_DEFAULT
+  }
+return p.BadRequestError
+}
+var MatchingServicePollForDecisionTaskResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *MatchingServicePollForDecisionTaskResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return MatchingServicePollForDecisionTaskResult_InternalServiceError_DEFAULT
+  }
+return p.InternalServiceError
+}
+func (p *MatchingServicePollForDecisionTaskResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *MatchingServicePollForDecisionTaskResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
+
+func (p *MatchingServicePollForDecisionTaskResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
+}
+
+func (p *MatchingServicePollForDecisionTaskResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *MatchingServicePollForDecisionTaskResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &PollForDecisionTaskResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *MatchingServicePollForDecisionTaskResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
+  }
+  return nil
+}
+
+func (p *MatchingServicePollForDecisionTaskResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
+  }
+  return nil
+}
+
+func (p *MatchingServicePollForDecisionTaskResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("PollForDecisionTask_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *MatchingServicePollForDecisionTaskResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *MatchingServicePollForDecisionTaskResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
+  }
+  return err
+}
+
+func (p *MatchingServicePollForDecisionTaskResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
+  }
+  return err
+}
+
+func (p *MatchingServicePollForDecisionTaskResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("MatchingServicePollForDecisionTaskResult(%+v)", *p)
+}
+
+// Attributes:
+//  - PollRequest
+type MatchingServicePollForActivityTaskArgs struct {
+  PollRequest *PollForActivityTaskRequest `thrift:"pollRequest,1" db:"pollRequest" json:"pollRequest"`
+}
+
+func NewMatchingServicePollForActivityTaskArgs() *MatchingServicePollForActivityTaskArgs {
+  return &MatchingServicePollForActivityTaskArgs{}
+}
+
+var MatchingServicePollForActivityTaskArgs_PollRequest_DEFAULT *PollForActivityTaskRequest
+func (p *MatchingServicePollForActivityTaskArgs) GetPollRequest() *PollForActivityTaskRequest {
+  if !p.IsSetPollRequest() {
+    return MatchingServicePollForActivityTaskArgs_PollRequest_DEFAULT
+  }
+return p.PollRequest
+}
+func (p *MatchingServicePollForActivityTaskArgs) IsSetPollRequest() bool {
+  return p.PollRequest != nil
+}
+
+func (p *MatchingServicePollForActivityTaskArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *MatchingServicePollForActivityTaskArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.PollRequest = &PollForActivityTaskRequest{}
+  if err := p.PollRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.PollRequest), err)
+  }
+  return nil
+}
+
+func (p *MatchingServicePollForActivityTaskArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("PollForActivityTask_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *MatchingServicePollForActivityTaskArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("pollRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:pollRequest: ", p), err) }
+  if err := p.PollRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.PollRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:pollRequest: ", p), err) }
+  return err
+}
+
+func (p *MatchingServicePollForActivityTaskArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("MatchingServicePollForActivityTaskArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - BadRequestError
+//  - InternalServiceError
+type MatchingServicePollForActivityTaskResult struct {
+  Success *shared.PollForActivityTaskResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+}
+
+func NewMatchingServicePollForActivityTaskResult() *MatchingServicePollForActivityTaskResult {
+  return &MatchingServicePollForActivityTaskResult{}
+}
+
+var MatchingServicePollForActivityTaskResult_Success_DEFAULT *shared.PollForActivityTaskResponse
+func (p *MatchingServicePollForActivityTaskResult) GetSuccess() *shared.PollForActivityTaskResponse {
+  if !p.IsSetSuccess() {
+    return MatchingServicePollForActivityTaskResult_Success_DEFAULT
   }
-  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
-  if err != nil {
-    return
+return p.Success
+}
+var MatchingServicePollForActivityTaskResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *MatchingServicePollForActivityTaskResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return MatchingServicePollForActivityTaskResult_BadRequestError_DEFAULT
   }
-  if method != "AddActivityTask" {
-    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "AddActivityTask failed: wrong method name")
-    return
+return p.BadRequestError
+}
+var MatchingServicePollForActivityTaskResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *MatchingServicePollForActivityTaskResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return MatchingServicePollForActivityTaskResult_InternalServiceError_DEFAULT
   }
-  if p.SeqId != seqId {
-    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "AddActivityTask failed: out of sequence response")
-    return
+return p.InternalServiceError
+}
+func (p *MatchingServicePollForActivityTaskResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *MatchingServicePollForActivityTaskResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
+
+func (p *MatchingServicePollForActivityTaskResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
+}
+
+func (p *MatchingServicePollForActivityTaskResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
-  if mTypeId == thrift.EXCEPTION {
-    error6 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-    var error7 error
-    error7, err = error6.Read(iprot)
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
     if err != nil {
-      return
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
     }
-    if err = iprot.ReadMessageEnd(); err != nil {
-      return
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
     }
-    err = error7
-    return
   }
-  if mTypeId != thrift.REPLY {
-    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "AddActivityTask failed: invalid message type")
-    return
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
   }
-  result := MatchingServiceAddActivityTaskResult{}
-  if err = result.Read(iprot); err != nil {
-    return
+  return nil
+}
+
+func (p *MatchingServicePollForActivityTaskResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.PollForActivityTaskResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
   }
-  if err = iprot.ReadMessageEnd(); err != nil {
-    return
+  return nil
+}
+
+func (p *MatchingServicePollForActivityTaskResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
   }
-  if result.BadRequestError != nil {
-    err = result.BadRequestError
-    return 
-  } else   if result.InternalServiceError != nil {
-    err = result.InternalServiceError
-    return 
-  } else   if result.ServiceBusyError != nil {
-    err = result.ServiceBusyError
-    return 
+  return nil
+}
+
+func (p *MatchingServicePollForActivityTaskResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
   }
-  return
+  return nil
 }
 
+func (p *MatchingServicePollForActivityTaskResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("PollForActivityTask_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
 
-type MatchingServiceProcessor struct {
-  processorMap map[string]thrift.TProcessorFunction
-  handler MatchingService
+func (p *MatchingServicePollForActivityTaskResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
 }
 
-func (p *MatchingServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-  p.processorMap[key] = processor
+func (p *MatchingServicePollForActivityTaskResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
+  }
+  return err
 }
 
-func (p *MatchingServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-  processor, ok = p.processorMap[key]
-  return processor, ok
+func (p *MatchingServicePollForActivityTaskResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
+  }
+  return err
 }
 
-func (p *MatchingServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-  return p.processorMap
+func (p *MatchingServicePollForActivityTaskResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("MatchingServicePollForActivityTaskResult(%+v)", *p)
 }
 
-func NewMatchingServiceProcessor(handler MatchingService) *MatchingServiceProcessor {
+// Attributes:
+//  - AddRequest
+type MatchingServiceAddDecisionTaskArgs struct {
+  AddRequest *AddDecisionTaskRequest `thrift:"addRequest,1" db:"addRequest" json:"addRequest"`
+}
 
-  self8 := &MatchingServiceProcessor{handler:handler, processorMap:make(map[string]thrift.TProcessorFunction)}
-  self8.processorMap["PollForDecisionTask"] = &matchingServiceProcessorPollForDecisionTask{handler:handler}
-  self8.processorMap["PollForActivityTask"] = &matchingServiceProcessorPollForActivityTask{handler:handler}
-  self8.processorMap["AddDecisionTask"] = &matchingServiceProcessorAddDecisionTask{handler:handler}
-  self8.processorMap["AddActivityTask"] = &matchingServiceProcessorAddActivityTask{handler:handler}
-return self8
+func NewMatchingServiceAddDecisionTaskArgs() *MatchingServiceAddDecisionTaskArgs {
+  return &MatchingServiceAddDecisionTaskArgs{}
 }
 
-func (p *MatchingServiceProcessor) Process(iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  name, _, seqId, err := iprot.ReadMessageBegin()
-  if err != nil { return false, err }
-  if processor, ok := p.GetProcessorFunction(name); ok {
-    return processor.Process(seqId, iprot, oprot)
+var MatchingServiceAddDecisionTaskArgs_AddRequest_DEFAULT *AddDecisionTaskRequest
+func (p *MatchingServiceAddDecisionTaskArgs) GetAddRequest() *AddDecisionTaskRequest {
+  if !p.IsSetAddRequest() {
+    return MatchingServiceAddDecisionTaskArgs_AddRequest_DEFAULT
   }
-  iprot.Skip(thrift.STRUCT)
-  iprot.ReadMessageEnd()
-  x9 := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function " + name)
-  oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-  x9.Write(oprot)
-  oprot.WriteMessageEnd()
-  oprot.Flush()
-  return false, x9
+return p.AddRequest
+}
+func (p *MatchingServiceAddDecisionTaskArgs) IsSetAddRequest() bool {
+  return p.AddRequest != nil
+}
 
+func (p *MatchingServiceAddDecisionTaskArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
 }
 
-type matchingServiceProcessorPollForDecisionTask struct {
-  handler MatchingService
+func (p *MatchingServiceAddDecisionTaskArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.AddRequest = &AddDecisionTaskRequest{}
+  if err := p.AddRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.AddRequest), err)
+  }
+  return nil
 }
 
-func (p *matchingServiceProcessorPollForDecisionTask) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := MatchingServicePollForDecisionTaskArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("PollForDecisionTask", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
+func (p *MatchingServiceAddDecisionTaskArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("AddDecisionTask_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
   }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
 
-  iprot.ReadMessageEnd()
-  result := MatchingServicePollForDecisionTaskResult{}
-var retval *PollForDecisionTaskResponse
-  var err2 error
-  if retval, err2 = p.handler.PollForDecisionTask(args.PollRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PollForDecisionTask: " + err2.Error())
-    oprot.WriteMessageBegin("PollForDecisionTask", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
+func (p *MatchingServiceAddDecisionTaskArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("addRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:addRequest: ", p), err) }
+  if err := p.AddRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.AddRequest), err)
   }
-  } else {
-    result.Success = retval
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:addRequest: ", p), err) }
+  return err
 }
-  if err2 = oprot.WriteMessageBegin("PollForDecisionTask", thrift.REPLY, seqId); err2 != nil {
-    err = err2
-  }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
-  }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
-  }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
-  }
-  if err != nil {
-    return
+
+func (p *MatchingServiceAddDecisionTaskArgs) String() string {
+  if p == nil {
+    return "<nil>"
   }
-  return true, err
+  return fmt.Sprintf("MatchingServiceAddDecisionTaskArgs(%+v)", *p)
 }
 
-type matchingServiceProcessorPollForActivityTask struct {
-  handler MatchingService
+// Attributes:
+//  - BadRequestError
+//  - InternalServiceError
+//  - ServiceBusyError
+type MatchingServiceAddDecisionTaskResult struct {
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  ServiceBusyError *shared.ServiceBusyError `thrift:"serviceBusyError,3" db:"serviceBusyError" json:"serviceBusyError,omitempty"`
 }
 
-func (p *matchingServiceProcessorPollForActivityTask) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := MatchingServicePollForActivityTaskArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("PollForActivityTask", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
-  }
+func NewMatchingServiceAddDecisionTaskResult() *MatchingServiceAddDecisionTaskResult {
+  return &MatchingServiceAddDecisionTaskResult{}
+}
 
-  iprot.ReadMessageEnd()
-  result := MatchingServicePollForActivityTaskResult{}
-var retval *shared.PollForActivityTaskResponse
-  var err2 error
-  if retval, err2 = p.handler.PollForActivityTask(args.PollRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PollForActivityTask: " + err2.Error())
-    oprot.WriteMessageBegin("PollForActivityTask", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
+var MatchingServiceAddDecisionTaskResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *MatchingServiceAddDecisionTaskResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return MatchingServiceAddDecisionTaskResult_BadRequestError_DEFAULT
   }
-  } else {
-    result.Success = retval
+return p.BadRequestError
 }
-  if err2 = oprot.WriteMessageBegin("PollForActivityTask", thrift.REPLY, seqId); err2 != nil {
-    err = err2
-  }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
-  }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
-  }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+var MatchingServiceAddDecisionTaskResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *MatchingServiceAddDecisionTaskResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return MatchingServiceAddDecisionTaskResult_InternalServiceError_DEFAULT
   }
-  if err != nil {
-    return
+return p.InternalServiceError
+}
+var MatchingServiceAddDecisionTaskResult_ServiceBusyError_DEFAULT *shared.ServiceBusyError
+func (p *MatchingServiceAddDecisionTaskResult) GetServiceBusyError() *shared.ServiceBusyError {
+  if !p.IsSetServiceBusyError() {
+    return MatchingServiceAddDecisionTaskResult_ServiceBusyError_DEFAULT
   }
-  return true, err
+return p.ServiceBusyError
+}
+func (p *MatchingServiceAddDecisionTaskResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
 }
 
-type matchingServiceProcessorAddDecisionTask struct {
-  handler MatchingService
+func (p *MatchingServiceAddDecisionTaskResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
 }
 
-func (p *matchingServiceProcessorAddDecisionTask) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := MatchingServiceAddDecisionTaskArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("AddDecisionTask", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
+func (p *MatchingServiceAddDecisionTaskResult) IsSetServiceBusyError() bool {
+  return p.ServiceBusyError != nil
+}
+
+func (p *MatchingServiceAddDecisionTaskResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
 
-  iprot.ReadMessageEnd()
-  result := MatchingServiceAddDecisionTaskResult{}
-  var err2 error
-  if err2 = p.handler.AddDecisionTask(args.AddRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.ServiceBusyError:
-  result.ServiceBusyError = v
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
     default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AddDecisionTask: " + err2.Error())
-    oprot.WriteMessageBegin("AddDecisionTask", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
-  }
-  }
-  if err2 = oprot.WriteMessageBegin("AddDecisionTask", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  return nil
+}
+
+func (p *MatchingServiceAddDecisionTaskResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  return nil
+}
+
+func (p *MatchingServiceAddDecisionTaskResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
   }
-  if err != nil {
-    return
+  return nil
+}
+
+func (p *MatchingServiceAddDecisionTaskResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.ServiceBusyError = &shared.ServiceBusyError{}
+  if err := p.ServiceBusyError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ServiceBusyError), err)
   }
-  return true, err
+  return nil
 }
 
-type matchingServiceProcessorAddActivityTask struct {
-  handler MatchingService
+func (p *MatchingServiceAddDecisionTaskResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("AddDecisionTask_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
 }
 
-func (p *matchingServiceProcessorAddActivityTask) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := MatchingServiceAddActivityTaskArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("AddActivityTask", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
+func (p *MatchingServiceAddDecisionTaskResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
   }
+  return err
+}
 
-  iprot.ReadMessageEnd()
-  result := MatchingServiceAddActivityTaskResult{}
-  var err2 error
-  if err2 = p.handler.AddActivityTask(args.AddRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.ServiceBusyError:
-  result.ServiceBusyError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AddActivityTask: " + err2.Error())
-    oprot.WriteMessageBegin("AddActivityTask", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
-  }
-  }
-  if err2 = oprot.WriteMessageBegin("AddActivityTask", thrift.REPLY, seqId); err2 != nil {
-    err = err2
-  }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
-  }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
-  }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
-  }
-  if err != nil {
-    return
+func (p *MatchingServiceAddDecisionTaskResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
   }
-  return true, err
+  return err
 }
 
+func (p *MatchingServiceAddDecisionTaskResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetServiceBusyError() {
+    if err := oprot.WriteFieldBegin("serviceBusyError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:serviceBusyError: ", p), err) }
+    if err := p.ServiceBusyError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ServiceBusyError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:serviceBusyError: ", p), err) }
+  }
+  return err
+}
 
-// HELPER FUNCTIONS AND STRUCTURES
+func (p *MatchingServiceAddDecisionTaskResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("MatchingServiceAddDecisionTaskResult(%+v)", *p)
+}
 
 // Attributes:
-//  - PollRequest
-type MatchingServicePollForDecisionTaskArgs struct {
-  PollRequest *PollForDecisionTaskRequest `thrift:"pollRequest,1" db:"pollRequest" json:"pollRequest"`
+//  - AddRequest
+type MatchingServiceAddActivityTaskArgs struct {
+  AddRequest *AddActivityTaskRequest `thrift:"addRequest,1" db:"addRequest" json:"addRequest"`
 }
 
-func NewMatchingServicePollForDecisionTaskArgs() *MatchingServicePollForDecisionTaskArgs {
-  return &MatchingServicePollForDecisionTaskArgs{}
+func NewMatchingServiceAddActivityTaskArgs() *MatchingServiceAddActivityTaskArgs {
+  return &MatchingServiceAddActivityTaskArgs{}
 }
 
-var MatchingServicePollForDecisionTaskArgs_PollRequest_DEFAULT *PollForDecisionTaskRequest
-func (p *MatchingServicePollForDecisionTaskArgs) GetPollRequest() *PollForDecisionTaskRequest {
-  if !p.IsSetPollRequest() {
-    return MatchingServicePollForDecisionTaskArgs_PollRequest_DEFAULT
+var MatchingServiceAddActivityTaskArgs_AddRequest_DEFAULT *AddActivityTaskRequest
+func (p *MatchingServiceAddActivityTaskArgs) GetAddRequest() *AddActivityTaskRequest {
+  if !p.IsSetAddRequest() {
+    return MatchingServiceAddActivityTaskArgs_AddRequest_DEFAULT
   }
-return p.PollRequest
+return p.AddRequest
 }
-func (p *MatchingServicePollForDecisionTaskArgs) IsSetPollRequest() bool {
-  return p.PollRequest != nil
+func (p *MatchingServiceAddActivityTaskArgs) IsSetAddRequest() bool {
+  return p.AddRequest != nil
 }
 
-func (p *MatchingServicePollForDecisionTaskArgs) Read(iprot thrift.TProtocol) error {
+func (p *MatchingServiceAddActivityTaskArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -1811,16 +3923,16 @@ func (p *MatchingServicePollForDecisionTaskArgs) Read(iprot thrift.TProtocol) er
   return nil
 }
 
-func (p *MatchingServicePollForDecisionTaskArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.PollRequest = &PollForDecisionTaskRequest{}
-  if err := p.PollRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.PollRequest), err)
+func (p *MatchingServiceAddActivityTaskArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.AddRequest = &AddActivityTaskRequest{}
+  if err := p.AddRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.AddRequest), err)
   }
   return nil
 }
 
-func (p *MatchingServicePollForDecisionTaskArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("PollForDecisionTask_args"); err != nil {
+func (p *MatchingServiceAddActivityTaskArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("AddActivityTask_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -1832,72 +3944,72 @@ func (p *MatchingServicePollForDecisionTaskArgs) Write(oprot thrift.TProtocol) e
   return nil
 }
 
-func (p *MatchingServicePollForDecisionTaskArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("pollRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:pollRequest: ", p), err) }
-  if err := p.PollRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.PollRequest), err)
+func (p *MatchingServiceAddActivityTaskArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("addRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:addRequest: ", p), err) }
+  if err := p.AddRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.AddRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:pollRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:addRequest: ", p), err) }
   return err
 }
 
-func (p *MatchingServicePollForDecisionTaskArgs) String() string {
+func (p *MatchingServiceAddActivityTaskArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("MatchingServicePollForDecisionTaskArgs(%+v)", *p)
+  return fmt.Sprintf("MatchingServiceAddActivityTaskArgs(%+v)", *p)
 }
 
 // Attributes:
-//  - Success
 //  - BadRequestError
 //  - InternalServiceError
-type MatchingServicePollForDecisionTaskResult struct {
-  Success *PollForDecisionTaskResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+//  - ServiceBusyError
+type MatchingServiceAddActivityTaskResult struct {
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  ServiceBusyError *shared.ServiceBusyError `thrift:"serviceBusyError,3" db:"serviceBusyError" json:"serviceBusyError,omitempty"`
 }
 
-func NewMatchingServicePollForDecisionTaskResult() *MatchingServicePollForDecisionTaskResult {
-  return &MatchingServicePollForDecisionTaskResult{}
+func NewMatchingServiceAddActivityTaskResult() *MatchingServiceAddActivityTaskResult {
+  return &MatchingServiceAddActivityTaskResult{}
 }
 
-var MatchingServicePollForDecisionTaskResult_Success_DEFAULT *PollForDecisionTaskResponse
-func (p *MatchingServicePollForDecisionTaskResult) GetSuccess() *PollForDecisionTaskResponse {
-  if !p.IsSetSuccess() {
-    return MatchingServicePollForDecisionTaskResult_Success_DEFAULT
-  }
-return p.Success
-}
-var MatchingServicePollForDecisionTaskResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *MatchingServicePollForDecisionTaskResult) GetBadRequestError() *shared.BadRequestError {
+var MatchingServiceAddActivityTaskResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *MatchingServiceAddActivityTaskResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return MatchingServicePollForDecisionTaskResult_BadRequestError_DEFAULT
+    return MatchingServiceAddActivityTaskResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var MatchingServicePollForDecisionTaskResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *MatchingServicePollForDecisionTaskResult) GetInternalServiceError() *shared.InternalServiceError {
+var MatchingServiceAddActivityTaskResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *MatchingServiceAddActivityTaskResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return MatchingServicePollForDecisionTaskResult_InternalServiceError_DEFAULT
+    return MatchingServiceAddActivityTaskResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-func (p *MatchingServicePollForDecisionTaskResult) IsSetSuccess() bool {
-  return p.Success != nil
+var MatchingServiceAddActivityTaskResult_ServiceBusyError_DEFAULT *shared.ServiceBusyError
+func (p *MatchingServiceAddActivityTaskResult) GetServiceBusyError() *shared.ServiceBusyError {
+  if !p.IsSetServiceBusyError() {
+    return MatchingServiceAddActivityTaskResult_ServiceBusyError_DEFAULT
+  }
+return p.ServiceBusyError
 }
-
-func (p *MatchingServicePollForDecisionTaskResult) IsSetBadRequestError() bool {
+func (p *MatchingServiceAddActivityTaskResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *MatchingServicePollForDecisionTaskResult) IsSetInternalServiceError() bool {
+func (p *MatchingServiceAddActivityTaskResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *MatchingServicePollForDecisionTaskResult) Read(iprot thrift.TProtocol) error {
+func (p *MatchingServiceAddActivityTaskResult) IsSetServiceBusyError() bool {
+  return p.ServiceBusyError != nil
+}
+
+func (p *MatchingServiceAddActivityTaskResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -1910,10 +4022,6 @@ func (p *MatchingServicePollForDecisionTaskResult) Read(iprot thrift.TProtocol)
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
-    case 0:
-      if err := p.ReadField0(iprot); err != nil {
-        return err
-      }
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -1922,6 +4030,10 @@ func (p *MatchingServicePollForDecisionTaskResult) Read(iprot thrift.TProtocol)
       if err := p.ReadField2(iprot); err != nil {
         return err
       }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -1937,15 +4049,7 @@ func (p *MatchingServicePollForDecisionTaskResult) Read(iprot thrift.TProtocol)
   return nil
 }
 
-func (p *MatchingServicePollForDecisionTaskResult)  ReadField0(iprot thrift.TProtocol) error {
-  p.Success = &PollForDecisionTaskResponse{}
-  if err := p.Success.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
-  }
-  return nil
-}
-
-func (p *MatchingServicePollForDecisionTaskResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *MatchingServiceAddActivityTaskResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -1953,7 +4057,7 @@ func (p *MatchingServicePollForDecisionTaskResult)  ReadField1(iprot thrift.TPro
   return nil
 }
 
-func (p *MatchingServicePollForDecisionTaskResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *MatchingServiceAddActivityTaskResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -1961,13 +4065,21 @@ func (p *MatchingServicePollForDecisionTaskResult)  ReadField2(iprot thrift.TPro
   return nil
 }
 
-func (p *MatchingServicePollForDecisionTaskResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("PollForDecisionTask_result"); err != nil {
+func (p *MatchingServiceAddActivityTaskResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.ServiceBusyError = &shared.ServiceBusyError{}
+  if err := p.ServiceBusyError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ServiceBusyError), err)
+  }
+  return nil
+}
+
+func (p *MatchingServiceAddActivityTaskResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("AddActivityTask_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
-    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -1976,20 +4088,7 @@ func (p *MatchingServicePollForDecisionTaskResult) Write(oprot thrift.TProtocol)
   return nil
 }
 
-func (p *MatchingServicePollForDecisionTaskResult) writeField0(oprot thrift.TProtocol) (err error) {
-  if p.IsSetSuccess() {
-    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
-    if err := p.Success.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
-  }
-  return err
-}
-
-func (p *MatchingServicePollForDecisionTaskResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *MatchingServiceAddActivityTaskResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -2002,7 +4101,7 @@ func (p *MatchingServicePollForDecisionTaskResult) writeField1(oprot thrift.TPro
   return err
 }
 
-func (p *MatchingServicePollForDecisionTaskResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *MatchingServiceAddActivityTaskResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -2015,35 +4114,48 @@ func (p *MatchingServicePollForDecisionTaskResult) writeField2(oprot thrift.TPro
   return err
 }
 
-func (p *MatchingServicePollForDecisionTaskResult) String() string {
+func (p *MatchingServiceAddActivityTaskResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetServiceBusyError() {
+    if err := oprot.WriteFieldBegin("serviceBusyError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:serviceBusyError: ", p), err) }
+    if err := p.ServiceBusyError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ServiceBusyError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:serviceBusyError: ", p), err) }
+  }
+  return err
+}
+
+func (p *MatchingServiceAddActivityTaskResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("MatchingServicePollForDecisionTaskResult(%+v)", *p)
+  return fmt.Sprintf("MatchingServiceAddActivityTaskResult(%+v)", *p)
 }
 
 // Attributes:
-//  - PollRequest
-type MatchingServicePollForActivityTaskArgs struct {
-  PollRequest *PollForActivityTaskRequest `thrift:"pollRequest,1" db:"pollRequest" json:"pollRequest"`
+//  - DrainRequest
+type MatchingServiceDrainTaskListArgs struct {
+  DrainRequest *DrainTaskListRequest `thrift:"drainRequest,1" db:"drainRequest" json:"drainRequest"`
 }
 
-func NewMatchingServicePollForActivityTaskArgs() *MatchingServicePollForActivityTaskArgs {
-  return &MatchingServicePollForActivityTaskArgs{}
+func NewMatchingServiceDrainTaskListArgs() *MatchingServiceDrainTaskListArgs {
+  return &MatchingServiceDrainTaskListArgs{}
 }
 
-var MatchingServicePollForActivityTaskArgs_PollRequest_DEFAULT *PollForActivityTaskRequest
-func (p *MatchingServicePollForActivityTaskArgs) GetPollRequest() *PollForActivityTaskRequest {
-  if !p.IsSetPollRequest() {
-    return MatchingServicePollForActivityTaskArgs_PollRequest_DEFAULT
+var MatchingServiceDrainTaskListArgs_DrainRequest_DEFAULT *DrainTaskListRequest
+func (p *MatchingServiceDrainTaskListArgs) GetDrainRequest() *DrainTaskListRequest {
+  if !p.IsSetDrainRequest() {
+    return MatchingServiceDrainTaskListArgs_DrainRequest_DEFAULT
   }
-return p.PollRequest
+return p.DrainRequest
 }
-func (p *MatchingServicePollForActivityTaskArgs) IsSetPollRequest() bool {
-  return p.PollRequest != nil
+func (p *MatchingServiceDrainTaskListArgs) IsSetDrainRequest() bool {
+  return p.DrainRequest != nil
 }
 
-func (p *MatchingServicePollForActivityTaskArgs) Read(iprot thrift.TProtocol) error {
+func (p *MatchingServiceDrainTaskListArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -2075,16 +4187,16 @@ func (p *MatchingServicePollForActivityTaskArgs) Read(iprot thrift.TProtocol) er
   return nil
 }
 
-func (p *MatchingServicePollForActivityTaskArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.PollRequest = &PollForActivityTaskRequest{}
-  if err := p.PollRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.PollRequest), err)
+func (p *MatchingServiceDrainTaskListArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.DrainRequest = &DrainTaskListRequest{}
+  if err := p.DrainRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DrainRequest), err)
   }
   return nil
 }
 
-func (p *MatchingServicePollForActivityTaskArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("PollForActivityTask_args"); err != nil {
+func (p *MatchingServiceDrainTaskListArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("DrainTaskList_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -2096,72 +4208,72 @@ func (p *MatchingServicePollForActivityTaskArgs) Write(oprot thrift.TProtocol) e
   return nil
 }
 
-func (p *MatchingServicePollForActivityTaskArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("pollRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:pollRequest: ", p), err) }
-  if err := p.PollRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.PollRequest), err)
+func (p *MatchingServiceDrainTaskListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("drainRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:drainRequest: ", p), err) }
+  if err := p.DrainRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DrainRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:pollRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:drainRequest: ", p), err) }
   return err
 }
 
-func (p *MatchingServicePollForActivityTaskArgs) String() string {
+func (p *MatchingServiceDrainTaskListArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("MatchingServicePollForActivityTaskArgs(%+v)", *p)
+  return fmt.Sprintf("MatchingServiceDrainTaskListArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Success
 //  - BadRequestError
 //  - InternalServiceError
-type MatchingServicePollForActivityTaskResult struct {
-  Success *shared.PollForActivityTaskResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+type MatchingServiceDrainTaskListResult struct {
+  Success *DrainTaskListResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
 }
 
-func NewMatchingServicePollForActivityTaskResult() *MatchingServicePollForActivityTaskResult {
-  return &MatchingServicePollForActivityTaskResult{}
+func NewMatchingServiceDrainTaskListResult() *MatchingServiceDrainTaskListResult {
+  return &MatchingServiceDrainTaskListResult{}
 }
 
-var MatchingServicePollForActivityTaskResult_Success_DEFAULT *shared.PollForActivityTaskResponse
-func (p *MatchingServicePollForActivityTaskResult) GetSuccess() *shared.PollForActivityTaskResponse {
+var MatchingServiceDrainTaskListResult_Success_DEFAULT *DrainTaskListResponse
+func (p *MatchingServiceDrainTaskListResult) GetSuccess() *DrainTaskListResponse {
   if !p.IsSetSuccess() {
-    return MatchingServicePollForActivityTaskResult_Success_DEFAULT
+    return MatchingServiceDrainTaskListResult_Success_DEFAULT
   }
 return p.Success
 }
-var MatchingServicePollForActivityTaskResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *MatchingServicePollForActivityTaskResult) GetBadRequestError() *shared.BadRequestError {
+var MatchingServiceDrainTaskListResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *MatchingServiceDrainTaskListResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return MatchingServicePollForActivityTaskResult_BadRequestError_DEFAULT
+    return MatchingServiceDrainTaskListResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var MatchingServicePollForActivityTaskResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *MatchingServicePollForActivityTaskResult) GetInternalServiceError() *shared.InternalServiceError {
+var MatchingServiceDrainTaskListResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *MatchingServiceDrainTaskListResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return MatchingServicePollForActivityTaskResult_InternalServiceError_DEFAULT
+    return MatchingServiceDrainTaskListResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-func (p *MatchingServicePollForActivityTaskResult) IsSetSuccess() bool {
+func (p *MatchingServiceDrainTaskListResult) IsSetSuccess() bool {
   return p.Success != nil
 }
 
-func (p *MatchingServicePollForActivityTaskResult) IsSetBadRequestError() bool {
+func (p *MatchingServiceDrainTaskListResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *MatchingServicePollForActivityTaskResult) IsSetInternalServiceError() bool {
+func (p *MatchingServiceDrainTaskListResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *MatchingServicePollForActivityTaskResult) Read(iprot thrift.TProtocol) error {
+func (p *MatchingServiceDrainTaskListResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -2201,15 +4313,15 @@ func (p *MatchingServicePollForActivityTaskResult) Read(iprot thrift.TProtocol)
   return nil
 }
 
-func (p *MatchingServicePollForActivityTaskResult)  ReadField0(iprot thrift.TProtocol) error {
-  p.Success = &shared.PollForActivityTaskResponse{}
+func (p *MatchingServiceDrainTaskListResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &DrainTaskListResponse{}
   if err := p.Success.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
   }
   return nil
 }
 
-func (p *MatchingServicePollForActivityTaskResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *MatchingServiceDrainTaskListResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -2217,7 +4329,7 @@ func (p *MatchingServicePollForActivityTaskResult)  ReadField1(iprot thrift.TPro
   return nil
 }
 
-func (p *MatchingServicePollForActivityTaskResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *MatchingServiceDrainTaskListResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -2225,8 +4337,8 @@ func (p *MatchingServicePollForActivityTaskResult)  ReadField2(iprot thrift.TPro
   return nil
 }
 
-func (p *MatchingServicePollForActivityTaskResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("PollForActivityTask_result"); err != nil {
+func (p *MatchingServiceDrainTaskListResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("DrainTaskList_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField0(oprot); err != nil { return err }
@@ -2240,7 +4352,7 @@ func (p *MatchingServicePollForActivityTaskResult) Write(oprot thrift.TProtocol)
   return nil
 }
 
-func (p *MatchingServicePollForActivityTaskResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *MatchingServiceDrainTaskListResult) writeField0(oprot thrift.TProtocol) (err error) {
   if p.IsSetSuccess() {
     if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
@@ -2253,7 +4365,7 @@ func (p *MatchingServicePollForActivityTaskResult) writeField0(oprot thrift.TPro
   return err
 }
 
-func (p *MatchingServicePollForActivityTaskResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *MatchingServiceDrainTaskListResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -2266,7 +4378,7 @@ func (p *MatchingServicePollForActivityTaskResult) writeField1(oprot thrift.TPro
   return err
 }
 
-func (p *MatchingServicePollForActivityTaskResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *MatchingServiceDrainTaskListResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -2279,35 +4391,35 @@ func (p *MatchingServicePollForActivityTaskResult) writeField2(oprot thrift.TPro
   return err
 }
 
-func (p *MatchingServicePollForActivityTaskResult) String() string {
+func (p *MatchingServiceDrainTaskListResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("MatchingServicePollForActivityTaskResult(%+v)", *p)
+  return fmt.Sprintf("MatchingServiceDrainTaskListResult(%+v)", *p)
 }
 
 // Attributes:
-//  - AddRequest
-type MatchingServiceAddDecisionTaskArgs struct {
-  AddRequest *AddDecisionTaskRequest `thrift:"addRequest,1" db:"addRequest" json:"addRequest"`
+//  - QueryRequest
+type MatchingServiceQueryWorkflowArgs struct {
+  QueryRequest *QueryWorkflowRequest `thrift:"queryRequest,1" db:"queryRequest" json:"queryRequest"`
 }
 
-func NewMatchingServiceAddDecisionTaskArgs() *MatchingServiceAddDecisionTaskArgs {
-  return &MatchingServiceAddDecisionTaskArgs{}
+func NewMatchingServiceQueryWorkflowArgs() *MatchingServiceQueryWorkflowArgs {
+  return &MatchingServiceQueryWorkflowArgs{}
 }
 
-var MatchingServiceAddDecisionTaskArgs_AddRequest_DEFAULT *AddDecisionTaskRequest
-func (p *MatchingServiceAddDecisionTaskArgs) GetAddRequest() *AddDecisionTaskRequest {
-  if !p.IsSetAddRequest() {
-    return MatchingServiceAddDecisionTaskArgs_AddRequest_DEFAULT
+var MatchingServiceQueryWorkflowArgs_QueryRequest_DEFAULT *QueryWorkflowRequest
+func (p *MatchingServiceQueryWorkflowArgs) GetQueryRequest() *QueryWorkflowRequest {
+  if !p.IsSetQueryRequest() {
+    return MatchingServiceQueryWorkflowArgs_QueryRequest_DEFAULT
   }
-return p.AddRequest
+return p.QueryRequest
 }
-func (p *MatchingServiceAddDecisionTaskArgs) IsSetAddRequest() bool {
-  return p.AddRequest != nil
+func (p *MatchingServiceQueryWorkflowArgs) IsSetQueryRequest() bool {
+  return p.QueryRequest != nil
 }
 
-func (p *MatchingServiceAddDecisionTaskArgs) Read(iprot thrift.TProtocol) error {
+func (p *MatchingServiceQueryWorkflowArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -2339,16 +4451,16 @@ func (p *MatchingServiceAddDecisionTaskArgs) Read(iprot thrift.TProtocol) error
   return nil
 }
 
-func (p *MatchingServiceAddDecisionTaskArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.AddRequest = &AddDecisionTaskRequest{}
-  if err := p.AddRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.AddRequest), err)
+func (p *MatchingServiceQueryWorkflowArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.QueryRequest = &QueryWorkflowRequest{}
+  if err := p.QueryRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.QueryRequest), err)
   }
   return nil
 }
 
-func (p *MatchingServiceAddDecisionTaskArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("AddDecisionTask_args"); err != nil {
+func (p *MatchingServiceQueryWorkflowArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("QueryWorkflow_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -2360,72 +4472,98 @@ func (p *MatchingServiceAddDecisionTaskArgs) Write(oprot thrift.TProtocol) error
   return nil
 }
 
-func (p *MatchingServiceAddDecisionTaskArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("addRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:addRequest: ", p), err) }
-  if err := p.AddRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.AddRequest), err)
+func (p *MatchingServiceQueryWorkflowArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("queryRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:queryRequest: ", p), err) }
+  if err := p.QueryRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.QueryRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:addRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:queryRequest: ", p), err) }
   return err
 }
 
-func (p *MatchingServiceAddDecisionTaskArgs) String() string {
+func (p *MatchingServiceQueryWorkflowArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("MatchingServiceAddDecisionTaskArgs(%+v)", *p)
+  return fmt.Sprintf("MatchingServiceQueryWorkflowArgs(%+v)", *p)
 }
 
 // Attributes:
+//  - Success
 //  - BadRequestError
 //  - InternalServiceError
-//  - ServiceBusyError
-type MatchingServiceAddDecisionTaskResult struct {
+//  - EntityNotExistError
+//  - QueryFailedError
+type MatchingServiceQueryWorkflowResult struct {
+  Success *shared.QueryWorkflowResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
-  ServiceBusyError *shared.ServiceBusyError `thrift:"serviceBusyError,3" db:"serviceBusyError" json:"serviceBusyError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+  QueryFailedError *shared.QueryFailedError `thrift:"queryFailedError,4" db:"queryFailedError" json:"queryFailedError,omitempty"`
 }
 
-func NewMatchingServiceAddDecisionTaskResult() *MatchingServiceAddDecisionTaskResult {
-  return &MatchingServiceAddDecisionTaskResult{}
+func NewMatchingServiceQueryWorkflowResult() *MatchingServiceQueryWorkflowResult {
+  return &MatchingServiceQueryWorkflowResult{}
 }
 
-var MatchingServiceAddDecisionTaskResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *MatchingServiceAddDecisionTaskResult) GetBadRequestError() *shared.BadRequestError {
+var MatchingServiceQueryWorkflowResult_Success_DEFAULT *shared.QueryWorkflowResponse
+func (p *MatchingServiceQueryWorkflowResult) GetSuccess() *shared.QueryWorkflowResponse {
+  if !p.IsSetSuccess() {
+    return MatchingServiceQueryWorkflowResult_Success_DEFAULT
+  }
+return p.Success
+}
+var MatchingServiceQueryWorkflowResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *MatchingServiceQueryWorkflowResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return MatchingServiceAddDecisionTaskResult_BadRequestError_DEFAULT
+    return MatchingServiceQueryWorkflowResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var MatchingServiceAddDecisionTaskResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *MatchingServiceAddDecisionTaskResult) GetInternalServiceError() *shared.InternalServiceError {
+var MatchingServiceQueryWorkflowResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *MatchingServiceQueryWorkflowResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return MatchingServiceAddDecisionTaskResult_InternalServiceError_DEFAULT
+    return MatchingServiceQueryWorkflowResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var MatchingServiceAddDecisionTaskResult_ServiceBusyError_DEFAULT *shared.ServiceBusyError
-func (p *MatchingServiceAddDecisionTaskResult) GetServiceBusyError() *shared.ServiceBusyError {
-  if !p.IsSetServiceBusyError() {
-    return MatchingServiceAddDecisionTaskResult_ServiceBusyError_DEFAULT
+var MatchingServiceQueryWorkflowResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *MatchingServiceQueryWorkflowResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return MatchingServiceQueryWorkflowResult_EntityNotExistError_DEFAULT
   }
-return p.ServiceBusyError
+return p.EntityNotExistError
 }
-func (p *MatchingServiceAddDecisionTaskResult) IsSetBadRequestError() bool {
+var MatchingServiceQueryWorkflowResult_QueryFailedError_DEFAULT *shared.QueryFailedError
+func (p *MatchingServiceQueryWorkflowResult) GetQueryFailedError() *shared.QueryFailedError {
+  if !p.IsSetQueryFailedError() {
+    return MatchingServiceQueryWorkflowResult_QueryFailedError_DEFAULT
+  }
+return p.QueryFailedError
+}
+func (p *MatchingServiceQueryWorkflowResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *MatchingServiceQueryWorkflowResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *MatchingServiceAddDecisionTaskResult) IsSetInternalServiceError() bool {
+func (p *MatchingServiceQueryWorkflowResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *MatchingServiceAddDecisionTaskResult) IsSetServiceBusyError() bool {
-  return p.ServiceBusyError != nil
+func (p *MatchingServiceQueryWorkflowResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
 }
 
-func (p *MatchingServiceAddDecisionTaskResult) Read(iprot thrift.TProtocol) error {
+func (p *MatchingServiceQueryWorkflowResult) IsSetQueryFailedError() bool {
+  return p.QueryFailedError != nil
+}
+
+func (p *MatchingServiceQueryWorkflowResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -2438,6 +4576,10 @@ func (p *MatchingServiceAddDecisionTaskResult) Read(iprot thrift.TProtocol) erro
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -2450,6 +4592,10 @@ func (p *MatchingServiceAddDecisionTaskResult) Read(iprot thrift.TProtocol) erro
       if err := p.ReadField3(iprot); err != nil {
         return err
       }
+    case 4:
+      if err := p.ReadField4(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -2465,7 +4611,15 @@ func (p *MatchingServiceAddDecisionTaskResult) Read(iprot thrift.TProtocol) erro
   return nil
 }
 
-func (p *MatchingServiceAddDecisionTaskResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *MatchingServiceQueryWorkflowResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.QueryWorkflowResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *MatchingServiceQueryWorkflowResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -2473,7 +4627,7 @@ func (p *MatchingServiceAddDecisionTaskResult)  ReadField1(iprot thrift.TProtoco
   return nil
 }
 
-func (p *MatchingServiceAddDecisionTaskResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *MatchingServiceQueryWorkflowResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -2481,21 +4635,31 @@ func (p *MatchingServiceAddDecisionTaskResult)  ReadField2(iprot thrift.TProtoco
   return nil
 }
 
-func (p *MatchingServiceAddDecisionTaskResult)  ReadField3(iprot thrift.TProtocol) error {
-  p.ServiceBusyError = &shared.ServiceBusyError{}
-  if err := p.ServiceBusyError.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ServiceBusyError), err)
+func (p *MatchingServiceQueryWorkflowResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
   }
   return nil
 }
 
-func (p *MatchingServiceAddDecisionTaskResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("AddDecisionTask_result"); err != nil {
+func (p *MatchingServiceQueryWorkflowResult)  ReadField4(iprot thrift.TProtocol) error {
+  p.QueryFailedError = &shared.QueryFailedError{}
+  if err := p.QueryFailedError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.QueryFailedError), err)
+  }
+  return nil
+}
+
+func (p *MatchingServiceQueryWorkflowResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("QueryWorkflow_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
+    if err := p.writeField4(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -2504,7 +4668,20 @@ func (p *MatchingServiceAddDecisionTaskResult) Write(oprot thrift.TProtocol) err
   return nil
 }
 
-func (p *MatchingServiceAddDecisionTaskResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *MatchingServiceQueryWorkflowResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *MatchingServiceQueryWorkflowResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -2517,7 +4694,7 @@ func (p *MatchingServiceAddDecisionTaskResult) writeField1(oprot thrift.TProtoco
   return err
 }
 
-func (p *MatchingServiceAddDecisionTaskResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *MatchingServiceQueryWorkflowResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -2530,48 +4707,61 @@ func (p *MatchingServiceAddDecisionTaskResult) writeField2(oprot thrift.TProtoco
   return err
 }
 
-func (p *MatchingServiceAddDecisionTaskResult) writeField3(oprot thrift.TProtocol) (err error) {
-  if p.IsSetServiceBusyError() {
-    if err := oprot.WriteFieldBegin("serviceBusyError", thrift.STRUCT, 3); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:serviceBusyError: ", p), err) }
-    if err := p.ServiceBusyError.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ServiceBusyError), err)
+func (p *MatchingServiceQueryWorkflowResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
     }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:serviceBusyError: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
   }
   return err
 }
 
-func (p *MatchingServiceAddDecisionTaskResult) String() string {
+func (p *MatchingServiceQueryWorkflowResult) writeField4(oprot thrift.TProtocol) (err error) {
+  if p.IsSetQueryFailedError() {
+    if err := oprot.WriteFieldBegin("queryFailedError", thrift.STRUCT, 4); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:queryFailedError: ", p), err) }
+    if err := p.QueryFailedError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.QueryFailedError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 4:queryFailedError: ", p), err) }
+  }
+  return err
+}
+
+func (p *MatchingServiceQueryWorkflowResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("MatchingServiceAddDecisionTaskResult(%+v)", *p)
+  return fmt.Sprintf("MatchingServiceQueryWorkflowResult(%+v)", *p)
 }
 
 // Attributes:
-//  - AddRequest
-type MatchingServiceAddActivityTaskArgs struct {
-  AddRequest *AddActivityTaskRequest `thrift:"addRequest,1" db:"addRequest" json:"addRequest"`
+//  - Request
+type MatchingServiceRespondQueryTaskCompletedArgs struct {
+  Request *RespondQueryTaskCompletedRequest `thrift:"request,1" db:"request" json:"request"`
 }
 
-func NewMatchingServiceAddActivityTaskArgs() *MatchingServiceAddActivityTaskArgs {
-  return &MatchingServiceAddActivityTaskArgs{}
+func NewMatchingServiceRespondQueryTaskCompletedArgs() *MatchingServiceRespondQueryTaskCompletedArgs {
+  return &MatchingServiceRespondQueryTaskCompletedArgs{}
 }
 
-var MatchingServiceAddActivityTaskArgs_AddRequest_DEFAULT *AddActivityTaskRequest
-func (p *MatchingServiceAddActivityTaskArgs) GetAddRequest() *AddActivityTaskRequest {
-  if !p.IsSetAddRequest() {
-    return MatchingServiceAddActivityTaskArgs_AddRequest_DEFAULT
+var MatchingServiceRespondQueryTaskCompletedArgs_Request_DEFAULT *RespondQueryTaskCompletedRequest
+func (p *MatchingServiceRespondQueryTaskCompletedArgs) GetRequest() *RespondQueryTaskCompletedRequest {
+  if !p.IsSetRequest() {
+    return MatchingServiceRespondQueryTaskCompletedArgs_Request_DEFAULT
   }
-return p.AddRequest
+return p.Request
 }
-func (p *MatchingServiceAddActivityTaskArgs) IsSetAddRequest() bool {
-  return p.AddRequest != nil
+func (p *MatchingServiceRespondQueryTaskCompletedArgs) IsSetRequest() bool {
+  return p.Request != nil
 }
 
-func (p *MatchingServiceAddActivityTaskArgs) Read(iprot thrift.TProtocol) error {
+func (p *MatchingServiceRespondQueryTaskCompletedArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -2603,16 +4793,16 @@ func (p *MatchingServiceAddActivityTaskArgs) Read(iprot thrift.TProtocol) error
   return nil
 }
 
-func (p *MatchingServiceAddActivityTaskArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.AddRequest = &AddActivityTaskRequest{}
-  if err := p.AddRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.AddRequest), err)
+func (p *MatchingServiceRespondQueryTaskCompletedArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.Request = &RespondQueryTaskCompletedRequest{}
+  if err := p.Request.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Request), err)
   }
   return nil
 }
 
-func (p *MatchingServiceAddActivityTaskArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("AddActivityTask_args"); err != nil {
+func (p *MatchingServiceRespondQueryTaskCompletedArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondQueryTaskCompleted_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -2624,72 +4814,72 @@ func (p *MatchingServiceAddActivityTaskArgs) Write(oprot thrift.TProtocol) error
   return nil
 }
 
-func (p *MatchingServiceAddActivityTaskArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("addRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:addRequest: ", p), err) }
-  if err := p.AddRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.AddRequest), err)
+func (p *MatchingServiceRespondQueryTaskCompletedArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:request: ", p), err) }
+  if err := p.Request.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Request), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:addRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:request: ", p), err) }
   return err
 }
 
-func (p *MatchingServiceAddActivityTaskArgs) String() string {
+func (p *MatchingServiceRespondQueryTaskCompletedArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("MatchingServiceAddActivityTaskArgs(%+v)", *p)
+  return fmt.Sprintf("MatchingServiceRespondQueryTaskCompletedArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - BadRequestError
 //  - InternalServiceError
-//  - ServiceBusyError
-type MatchingServiceAddActivityTaskResult struct {
+//  - EntityNotExistError
+type MatchingServiceRespondQueryTaskCompletedResult struct {
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
-  ServiceBusyError *shared.ServiceBusyError `thrift:"serviceBusyError,3" db:"serviceBusyError" json:"serviceBusyError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
 }
 
-func NewMatchingServiceAddActivityTaskResult() *MatchingServiceAddActivityTaskResult {
-  return &MatchingServiceAddActivityTaskResult{}
+func NewMatchingServiceRespondQueryTaskCompletedResult() *MatchingServiceRespondQueryTaskCompletedResult {
+  return &MatchingServiceRespondQueryTaskCompletedResult{}
 }
 
-var MatchingServiceAddActivityTaskResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *MatchingServiceAddActivityTaskResult) GetBadRequestError() *shared.BadRequestError {
+var MatchingServiceRespondQueryTaskCompletedResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *MatchingServiceRespondQueryTaskCompletedResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return MatchingServiceAddActivityTaskResult_BadRequestError_DEFAULT
+    return MatchingServiceRespondQueryTaskCompletedResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var MatchingServiceAddActivityTaskResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *MatchingServiceAddActivityTaskResult) GetInternalServiceError() *shared.InternalServiceError {
+var MatchingServiceRespondQueryTaskCompletedResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *MatchingServiceRespondQueryTaskCompletedResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return MatchingServiceAddActivityTaskResult_InternalServiceError_DEFAULT
+    return MatchingServiceRespondQueryTaskCompletedResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var MatchingServiceAddActivityTaskResult_ServiceBusyError_DEFAULT *shared.ServiceBusyError
-func (p *MatchingServiceAddActivityTaskResult) GetServiceBusyError() *shared.ServiceBusyError {
-  if !p.IsSetServiceBusyError() {
-    return MatchingServiceAddActivityTaskResult_ServiceBusyError_DEFAULT
+var MatchingServiceRespondQueryTaskCompletedResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *MatchingServiceRespondQueryTaskCompletedResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return MatchingServiceRespondQueryTaskCompletedResult_EntityNotExistError_DEFAULT
   }
-return p.ServiceBusyError
+return p.EntityNotExistError
 }
-func (p *MatchingServiceAddActivityTaskResult) IsSetBadRequestError() bool {
+func (p *MatchingServiceRespondQueryTaskCompletedResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *MatchingServiceAddActivityTaskResult) IsSetInternalServiceError() bool {
+func (p *MatchingServiceRespondQueryTaskCompletedResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *MatchingServiceAddActivityTaskResult) IsSetServiceBusyError() bool {
-  return p.ServiceBusyError != nil
+func (p *MatchingServiceRespondQueryTaskCompletedResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
 }
 
-func (p *MatchingServiceAddActivityTaskResult) Read(iprot thrift.TProtocol) error {
+func (p *MatchingServiceRespondQueryTaskCompletedResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -2729,7 +4919,7 @@ func (p *MatchingServiceAddActivityTaskResult) Read(iprot thrift.TProtocol) erro
   return nil
 }
 
-func (p *MatchingServiceAddActivityTaskResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *MatchingServiceRespondQueryTaskCompletedResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -2737,7 +4927,7 @@ func (p *MatchingServiceAddActivityTaskResult)  ReadField1(iprot thrift.TProtoco
   return nil
 }
 
-func (p *MatchingServiceAddActivityTaskResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *MatchingServiceRespondQueryTaskCompletedResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -2745,16 +4935,16 @@ func (p *MatchingServiceAddActivityTaskResult)  ReadField2(iprot thrift.TProtoco
   return nil
 }
 
-func (p *MatchingServiceAddActivityTaskResult)  ReadField3(iprot thrift.TProtocol) error {
-  p.ServiceBusyError = &shared.ServiceBusyError{}
-  if err := p.ServiceBusyError.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ServiceBusyError), err)
+func (p *MatchingServiceRespondQueryTaskCompletedResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
   }
   return nil
 }
 
-func (p *MatchingServiceAddActivityTaskResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("AddActivityTask_result"); err != nil {
+func (p *MatchingServiceRespondQueryTaskCompletedResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondQueryTaskCompleted_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -2768,7 +4958,7 @@ func (p *MatchingServiceAddActivityTaskResult) Write(oprot thrift.TProtocol) err
   return nil
 }
 
-func (p *MatchingServiceAddActivityTaskResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *MatchingServiceRespondQueryTaskCompletedResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -2781,7 +4971,7 @@ func (p *MatchingServiceAddActivityTaskResult) writeField1(oprot thrift.TProtoco
   return err
 }
 
-func (p *MatchingServiceAddActivityTaskResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *MatchingServiceRespondQueryTaskCompletedResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -2794,24 +4984,22 @@ func (p *MatchingServiceAddActivityTaskResult) writeField2(oprot thrift.TProtoco
   return err
 }
 
-func (p *MatchingServiceAddActivityTaskResult) writeField3(oprot thrift.TProtocol) (err error) {
-  if p.IsSetServiceBusyError() {
-    if err := oprot.WriteFieldBegin("serviceBusyError", thrift.STRUCT, 3); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:serviceBusyError: ", p), err) }
-    if err := p.ServiceBusyError.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ServiceBusyError), err)
+func (p *MatchingServiceRespondQueryTaskCompletedResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
     }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:serviceBusyError: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
   }
   return err
 }
 
-func (p *MatchingServiceAddActivityTaskResult) String() string {
+func (p *MatchingServiceRespondQueryTaskCompletedResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("MatchingServiceAddActivityTaskResult(%+v)", *p)
+  return fmt.Sprintf("MatchingServiceRespondQueryTaskCompletedResult(%+v)", *p)
 }
-
-
@@ -0,0 +1,1105 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Autogenerated by Thrift Compiler (0.10.0)
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+package persistenceblobs
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/apache/thrift/lib/go/thrift"
+)
+
+// (needed to ensure safety because of naive import list construction.)
+var _ = thrift.ZERO
+var _ = fmt.Printf
+var _ = bytes.Equal
+
+// Attributes:
+//  - ScheduleId
+//  - ScheduledEvent
+//  - StartedId
+//  - StartedEvent
+//  - ActivityId
+//  - RequestId
+//  - Details
+//  - ScheduleToStartTimeoutSeconds
+//  - ScheduleToCloseTimeoutSeconds
+//  - StartToCloseTimeoutSeconds
+//  - HeartbeatTimeoutSeconds
+//  - CancelRequested
+//  - CancelRequestId
+//  - LastHeartbeatUpdatedTimeNanos
+type ActivityInfo struct {
+  // unused fields # 1 to 9
+  ScheduleId *int64 `thrift:"scheduleId,10" db:"scheduleId" json:"scheduleId,omitempty"`
+  // unused fields # 11 to 19
+  ScheduledEvent []byte `thrift:"scheduledEvent,20" db:"scheduledEvent" json:"scheduledEvent,omitempty"`
+  // unused fields # 21 to 29
+  StartedId *int64 `thrift:"startedId,30" db:"startedId" json:"startedId,omitempty"`
+  // unused fields # 31 to 39
+  StartedEvent []byte `thrift:"startedEvent,40" db:"startedEvent" json:"startedEvent,omitempty"`
+  // unused fields # 41 to 49
+  ActivityId *string `thrift:"activityId,50" db:"activityId" json:"activityId,omitempty"`
+  // unused fields # 51 to 59
+  RequestId *string `thrift:"requestId,60" db:"requestId" json:"requestId,omitempty"`
+  // unused fields # 61 to 69
+  Details []byte `thrift:"details,70" db:"details" json:"details,omitempty"`
+  // unused fields # 71 to 79
+  ScheduleToStartTimeoutSeconds *int32 `thrift:"scheduleToStartTimeoutSeconds,80" db:"scheduleToStartTimeoutSeconds" json:"scheduleToStartTimeoutSeconds,omitempty"`
+  // unused fields # 81 to 89
+  ScheduleToCloseTimeoutSeconds *int32 `thrift:"scheduleToCloseTimeoutSeconds,90" db:"scheduleToCloseTimeoutSeconds" json:"scheduleToCloseTimeoutSeconds,omitempty"`
+  // unused fields # 91 to 99
+  StartToCloseTimeoutSeconds *int32 `thrift:"startToCloseTimeoutSeconds,100" db:"startToCloseTimeoutSeconds" json:"startToCloseTimeoutSeconds,omitempty"`
+  // unused fields # 101 to 109
+  HeartbeatTimeoutSeconds *int32 `thrift:"heartbeatTimeoutSeconds,110" db:"heartbeatTimeoutSeconds" json:"heartbeatTimeoutSeconds,omitempty"`
+  // unused fields # 111 to 119
+  CancelRequested *bool `thrift:"cancelRequested,120" db:"cancelRequested" json:"cancelRequested,omitempty"`
+  // unused fields # 121 to 129
+  CancelRequestId *int64 `thrift:"cancelRequestId,130" db:"cancelRequestId" json:"cancelRequestId,omitempty"`
+  // unused fields # 131 to 139
+  LastHeartbeatUpdatedTimeNanos *int64 `thrift:"lastHeartbeatUpdatedTimeNanos,140" db:"lastHeartbeatUpdatedTimeNanos" json:"lastHeartbeatUpdatedTimeNanos,omitempty"`
+}
+
+func NewActivityInfo() *ActivityInfo {
+  return &ActivityInfo{}
+}
+
+var ActivityInfo_ScheduleId_DEFAULT int64
+func (p *ActivityInfo) GetScheduleId() int64 {
+  if !p.IsSetScheduleId() {
+    return ActivityInfo_ScheduleId_DEFAULT
+  }
+return *p.ScheduleId
+}
+var ActivityInfo_ScheduledEvent_DEFAULT []byte
+func (p *ActivityInfo) GetScheduledEvent() []byte {
+  return p.ScheduledEvent
+}
+var ActivityInfo_StartedId_DEFAULT int64
+func (p *ActivityInfo) GetStartedId() int64 {
+  if !p.IsSetStartedId() {
+    return ActivityInfo_StartedId_DEFAULT
+  }
+return *p.StartedId
+}
+var ActivityInfo_StartedEvent_DEFAULT []byte
+func (p *ActivityInfo) GetStartedEvent() []byte {
+  return p.StartedEvent
+}
+var ActivityInfo_ActivityId_DEFAULT string
+func (p *ActivityInfo) GetActivityId() string {
+  if !p.IsSetActivityId() {
+    return ActivityInfo_ActivityId_DEFAULT
+  }
+return *p.ActivityId
+}
+var ActivityInfo_RequestId_DEFAULT string
+func (p *ActivityInfo) GetRequestId() string {
+  if !p.IsSetRequestId() {
+    return ActivityInfo_RequestId_DEFAULT
+  }
+return *p.RequestId
+}
+var ActivityInfo_Details_DEFAULT []byte
+func (p *ActivityInfo) GetDetails() []byte {
+  return p.Details
+}
+var ActivityInfo_ScheduleToStartTimeoutSeconds_DEFAULT int32
+func (p *ActivityInfo) GetScheduleToStartTimeoutSeconds() int32 {
+  if !p.IsSetScheduleToStartTimeoutSeconds() {
+    return ActivityInfo_ScheduleToStartTimeoutSeconds_DEFAULT
+  }
+return *p.ScheduleToStartTimeoutSeconds
+}
+var ActivityInfo_ScheduleToCloseTimeoutSeconds_DEFAULT int32
+func (p *ActivityInfo) GetScheduleToCloseTimeoutSeconds() int32 {
+  if !p.IsSetScheduleToCloseTimeoutSeconds() {
+    return ActivityInfo_ScheduleToCloseTimeoutSeconds_DEFAULT
+  }
+return *p.ScheduleToCloseTimeoutSeconds
+}
+var ActivityInfo_StartToCloseTimeoutSeconds_DEFAULT int32
+func (p *ActivityInfo) GetStartToCloseTimeoutSeconds() int32 {
+  if !p.IsSetStartToCloseTimeoutSeconds() {
+    return ActivityInfo_StartToCloseTimeoutSeconds_DEFAULT
+  }
+return *p.StartToCloseTimeoutSeconds
+}
+var ActivityInfo_HeartbeatTimeoutSeconds_DEFAULT int32
+func (p *ActivityInfo) GetHeartbeatTimeoutSeconds() int32 {
+  if !p.IsSetHeartbeatTimeoutSeconds() {
+    return ActivityInfo_HeartbeatTimeoutSeconds_DEFAULT
+  }
+return *p.HeartbeatTimeoutSeconds
+}
+var ActivityInfo_CancelRequested_DEFAULT bool
+func (p *ActivityInfo) GetCancelRequested() bool {
+  if !p.IsSetCancelRequested() {
+    return ActivityInfo_CancelRequested_DEFAULT
+  }
+return *p.CancelRequested
+}
+var ActivityInfo_CancelRequestId_DEFAULT int64
+func (p *ActivityInfo) GetCancelRequestId() int64 {
+  if !p.IsSetCancelRequestId() {
+    return ActivityInfo_CancelRequestId_DEFAULT
+  }
+return *p.CancelRequestId
+}
+var ActivityInfo_LastHeartbeatUpdatedTimeNanos_DEFAULT int64
+func (p *ActivityInfo) GetLastHeartbeatUpdatedTimeNanos() int64 {
+  if !p.IsSetLastHeartbeatUpdatedTimeNanos() {
+    return ActivityInfo_LastHeartbeatUpdatedTimeNanos_DEFAULT
+  }
+return *p.LastHeartbeatUpdatedTimeNanos
+}
+func (p *ActivityInfo) IsSetScheduleId() bool {
+  return p.ScheduleId != nil
+}
+
+func (p *ActivityInfo) IsSetScheduledEvent() bool {
+  return p.ScheduledEvent != nil
+}
+
+func (p *ActivityInfo) IsSetStartedId() bool {
+  return p.StartedId != nil
+}
+
+func (p *ActivityInfo) IsSetStartedEvent() bool {
+  return p.StartedEvent != nil
+}
+
+func (p *ActivityInfo) IsSetActivityId() bool {
+  return p.ActivityId != nil
+}
+
+func (p *ActivityInfo) IsSetRequestId() bool {
+  return p.RequestId != nil
+}
+
+func (p *ActivityInfo) IsSetDetails() bool {
+  return p.Details != nil
+}
+
+func (p *ActivityInfo) IsSetScheduleToStartTimeoutSeconds() bool {
+  return p.ScheduleToStartTimeoutSeconds != nil
+}
+
+func (p *ActivityInfo) IsSetScheduleToCloseTimeoutSeconds() bool {
+  return p.ScheduleToCloseTimeoutSeconds != nil
+}
+
+func (p *ActivityInfo) IsSetStartToCloseTimeoutSeconds() bool {
+  return p.StartToCloseTimeoutSeconds != nil
+}
+
+func (p *ActivityInfo) IsSetHeartbeatTimeoutSeconds() bool {
+  return p.HeartbeatTimeoutSeconds != nil
+}
+
+func (p *ActivityInfo) IsSetCancelRequested() bool {
+  return p.CancelRequested != nil
+}
+
+func (p *ActivityInfo) IsSetCancelRequestId() bool {
+  return p.CancelRequestId != nil
+}
+
+func (p *ActivityInfo) IsSetLastHeartbeatUpdatedTimeNanos() bool {
+  return p.LastHeartbeatUpdatedTimeNanos != nil
+}
+
+func (p *ActivityInfo) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
+    case 50:
+      if err := p.ReadField50(iprot); err != nil {
+        return err
+      }
+    case 60:
+      if err := p.ReadField60(iprot); err != nil {
+        return err
+      }
+    case 70:
+      if err := p.ReadField70(iprot); err != nil {
+        return err
+      }
+    case 80:
+      if err := p.ReadField80(iprot); err != nil {
+        return err
+      }
+    case 90:
+      if err := p.ReadField90(iprot); err != nil {
+        return err
+      }
+    case 100:
+      if err := p.ReadField100(iprot); err != nil {
+        return err
+      }
+    case 110:
+      if err := p.ReadField110(iprot); err != nil {
+        return err
+      }
+    case 120:
+      if err := p.ReadField120(iprot); err != nil {
+        return err
+      }
+    case 130:
+      if err := p.ReadField130(iprot); err != nil {
+        return err
+      }
+    case 140:
+      if err := p.ReadField140(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ActivityInfo)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.ScheduleId = &v
+}
+  return nil
+}
+
+func (p *ActivityInfo)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 20: ", err)
+} else {
+  p.ScheduledEvent = v
+}
+  return nil
+}
+
+func (p *ActivityInfo)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
+} else {
+  p.StartedId = &v
+}
+  return nil
+}
+
+func (p *ActivityInfo)  ReadField40(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 40: ", err)
+} else {
+  p.StartedEvent = v
+}
+  return nil
+}
+
+func (p *ActivityInfo)  ReadField50(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 50: ", err)
+} else {
+  p.ActivityId = &v
+}
+  return nil
+}
+
+func (p *ActivityInfo)  ReadField60(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 60: ", err)
+} else {
+  p.RequestId = &v
+}
+  return nil
+}
+
+func (p *ActivityInfo)  ReadField70(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 70: ", err)
+} else {
+  p.Details = v
+}
+  return nil
+}
+
+func (p *ActivityInfo)  ReadField80(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 80: ", err)
+} else {
+  p.ScheduleToStartTimeoutSeconds = &v
+}
+  return nil
+}
+
+func (p *ActivityInfo)  ReadField90(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 90: ", err)
+} else {
+  p.ScheduleToCloseTimeoutSeconds = &v
+}
+  return nil
+}
+
+func (p *ActivityInfo)  ReadField100(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 100: ", err)
+} else {
+  p.StartToCloseTimeoutSeconds = &v
+}
+  return nil
+}
+
+func (p *ActivityInfo)  ReadField110(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 110: ", err)
+} else {
+  p.HeartbeatTimeoutSeconds = &v
+}
+  return nil
+}
+
+func (p *ActivityInfo)  ReadField120(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(); err != nil {
+  return thrift.PrependError("error reading field 120: ", err)
+} else {
+  p.CancelRequested = &v
+}
+  return nil
+}
+
+func (p *ActivityInfo)  ReadField130(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 130: ", err)
+} else {
+  p.CancelRequestId = &v
+}
+  return nil
+}
+
+func (p *ActivityInfo)  ReadField140(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 140: ", err)
+} else {
+  p.LastHeartbeatUpdatedTimeNanos = &v
+}
+  return nil
+}
+
+func (p *ActivityInfo) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ActivityInfo"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
+    if err := p.writeField40(oprot); err != nil { return err }
+    if err := p.writeField50(oprot); err != nil { return err }
+    if err := p.writeField60(oprot); err != nil { return err }
+    if err := p.writeField70(oprot); err != nil { return err }
+    if err := p.writeField80(oprot); err != nil { return err }
+    if err := p.writeField90(oprot); err != nil { return err }
+    if err := p.writeField100(oprot); err != nil { return err }
+    if err := p.writeField110(oprot); err != nil { return err }
+    if err := p.writeField120(oprot); err != nil { return err }
+    if err := p.writeField130(oprot); err != nil { return err }
+    if err := p.writeField140(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ActivityInfo) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetScheduleId() {
+    if err := oprot.WriteFieldBegin("scheduleId", thrift.I64, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:scheduleId: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.ScheduleId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.scheduleId (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:scheduleId: ", p), err) }
+  }
+  return err
+}
+
+func (p *ActivityInfo) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetScheduledEvent() {
+    if err := oprot.WriteFieldBegin("scheduledEvent", thrift.STRING, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:scheduledEvent: ", p), err) }
+    if err := oprot.WriteBinary(p.ScheduledEvent); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.scheduledEvent (20) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:scheduledEvent: ", p), err) }
+  }
+  return err
+}
+
+func (p *ActivityInfo) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetStartedId() {
+    if err := oprot.WriteFieldBegin("startedId", thrift.I64, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:startedId: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.StartedId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.startedId (30) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:startedId: ", p), err) }
+  }
+  return err
+}
+
+func (p *ActivityInfo) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetStartedEvent() {
+    if err := oprot.WriteFieldBegin("startedEvent", thrift.STRING, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:startedEvent: ", p), err) }
+    if err := oprot.WriteBinary(p.StartedEvent); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.startedEvent (40) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:startedEvent: ", p), err) }
+  }
+  return err
+}
+
+func (p *ActivityInfo) writeField50(oprot thrift.TProtocol) (err error) {
+  if p.IsSetActivityId() {
+    if err := oprot.WriteFieldBegin("activityId", thrift.STRING, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:activityId: ", p), err) }
+    if err := oprot.WriteString(string(*p.ActivityId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.activityId (50) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:activityId: ", p), err) }
+  }
+  return err
+}
+
+func (p *ActivityInfo) writeField60(oprot thrift.TProtocol) (err error) {
+  if p.IsSetRequestId() {
+    if err := oprot.WriteFieldBegin("requestId", thrift.STRING, 60); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:requestId: ", p), err) }
+    if err := oprot.WriteString(string(*p.RequestId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.requestId (60) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:requestId: ", p), err) }
+  }
+  return err
+}
+
+func (p *ActivityInfo) writeField70(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDetails() {
+    if err := oprot.WriteFieldBegin("details", thrift.STRING, 70); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 70:details: ", p), err) }
+    if err := oprot.WriteBinary(p.Details); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.details (70) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 70:details: ", p), err) }
+  }
+  return err
+}
+
+func (p *ActivityInfo) writeField80(oprot thrift.TProtocol) (err error) {
+  if p.IsSetScheduleToStartTimeoutSeconds() {
+    if err := oprot.WriteFieldBegin("scheduleToStartTimeoutSeconds", thrift.I32, 80); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 80:scheduleToStartTimeoutSeconds: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.ScheduleToStartTimeoutSeconds)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.scheduleToStartTimeoutSeconds (80) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 80:scheduleToStartTimeoutSeconds: ", p), err) }
+  }
+  return err
+}
+
+func (p *ActivityInfo) writeField90(oprot thrift.TProtocol) (err error) {
+  if p.IsSetScheduleToCloseTimeoutSeconds() {
+    if err := oprot.WriteFieldBegin("scheduleToCloseTimeoutSeconds", thrift.I32, 90); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 90:scheduleToCloseTimeoutSeconds: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.ScheduleToCloseTimeoutSeconds)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.scheduleToCloseTimeoutSeconds (90) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 90:scheduleToCloseTimeoutSeconds: ", p), err) }
+  }
+  return err
+}
+
+func (p *ActivityInfo) writeField100(oprot thrift.TProtocol) (err error) {
+  if p.IsSetStartToCloseTimeoutSeconds() {
+    if err := oprot.WriteFieldBegin("startToCloseTimeoutSeconds", thrift.I32, 100); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 100:startToCloseTimeoutSeconds: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.StartToCloseTimeoutSeconds)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.startToCloseTimeoutSeconds (100) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 100:startToCloseTimeoutSeconds: ", p), err) }
+  }
+  return err
+}
+
+func (p *ActivityInfo) writeField110(oprot thrift.TProtocol) (err error) {
+  if p.IsSetHeartbeatTimeoutSeconds() {
+    if err := oprot.WriteFieldBegin("heartbeatTimeoutSeconds", thrift.I32, 110); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 110:heartbeatTimeoutSeconds: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.HeartbeatTimeoutSeconds)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.heartbeatTimeoutSeconds (110) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 110:heartbeatTimeoutSeconds: ", p), err) }
+  }
+  return err
+}
+
+func (p *ActivityInfo) writeField120(oprot thrift.TProtocol) (err error) {
+  if p.IsSetCancelRequested() {
+    if err := oprot.WriteFieldBegin("cancelRequested", thrift.BOOL, 120); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 120:cancelRequested: ", p), err) }
+    if err := oprot.WriteBool(bool(*p.CancelRequested)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.cancelRequested (120) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 120:cancelRequested: ", p), err) }
+  }
+  return err
+}
+
+func (p *ActivityInfo) writeField130(oprot thrift.TProtocol) (err error) {
+  if p.IsSetCancelRequestId() {
+    if err := oprot.WriteFieldBegin("cancelRequestId", thrift.I64, 130); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 130:cancelRequestId: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.CancelRequestId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.cancelRequestId (130) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 130:cancelRequestId: ", p), err) }
+  }
+  return err
+}
+
+func (p *ActivityInfo) writeField140(oprot thrift.TProtocol) (err error) {
+  if p.IsSetLastHeartbeatUpdatedTimeNanos() {
+    if err := oprot.WriteFieldBegin("lastHeartbeatUpdatedTimeNanos", thrift.I64, 140); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 140:lastHeartbeatUpdatedTimeNanos: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.LastHeartbeatUpdatedTimeNanos)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.lastHeartbeatUpdatedTimeNanos (140) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 140:lastHeartbeatUpdatedTimeNanos: ", p), err) }
+  }
+  return err
+}
+
+func (p *ActivityInfo) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ActivityInfo(%+v)", *p)
+}
+
+// Attributes:
+//  - TimerId
+//  - StartedId
+//  - ExpiryTimeNanos
+//  - TaskId
+type TimerInfo struct {
+  // unused fields # 1 to 9
+  TimerId *string `thrift:"timerId,10" db:"timerId" json:"timerId,omitempty"`
+  // unused fields # 11 to 19
+  StartedId *int64 `thrift:"startedId,20" db:"startedId" json:"startedId,omitempty"`
+  // unused fields # 21 to 29
+  ExpiryTimeNanos *int64 `thrift:"expiryTimeNanos,30" db:"expiryTimeNanos" json:"expiryTimeNanos,omitempty"`
+  // unused fields # 31 to 39
+  TaskId *int64 `thrift:"taskId,40" db:"taskId" json:"taskId,omitempty"`
+}
+
+func NewTimerInfo() *TimerInfo {
+  return &TimerInfo{}
+}
+
+var TimerInfo_TimerId_DEFAULT string
+func (p *TimerInfo) GetTimerId() string {
+  if !p.IsSetTimerId() {
+    return TimerInfo_TimerId_DEFAULT
+  }
+return *p.TimerId
+}
+var TimerInfo_StartedId_DEFAULT int64
+func (p *TimerInfo) GetStartedId() int64 {
+  if !p.IsSetStartedId() {
+    return TimerInfo_StartedId_DEFAULT
+  }
+return *p.StartedId
+}
+var TimerInfo_ExpiryTimeNanos_DEFAULT int64
+func (p *TimerInfo) GetExpiryTimeNanos() int64 {
+  if !p.IsSetExpiryTimeNanos() {
+    return TimerInfo_ExpiryTimeNanos_DEFAULT
+  }
+return *p.ExpiryTimeNanos
+}
+var TimerInfo_TaskId_DEFAULT int64
+func (p *TimerInfo) GetTaskId() int64 {
+  if !p.IsSetTaskId() {
+    return TimerInfo_TaskId_DEFAULT
+  }
+return *p.TaskId
+}
+func (p *TimerInfo) IsSetTimerId() bool {
+  return p.TimerId != nil
+}
+
+func (p *TimerInfo) IsSetStartedId() bool {
+  return p.StartedId != nil
+}
+
+func (p *TimerInfo) IsSetExpiryTimeNanos() bool {
+  return p.ExpiryTimeNanos != nil
+}
+
+func (p *TimerInfo) IsSetTaskId() bool {
+  return p.TaskId != nil
+}
+
+func (p *TimerInfo) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *TimerInfo)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.TimerId = &v
+}
+  return nil
+}
+
+func (p *TimerInfo)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 20: ", err)
+} else {
+  p.StartedId = &v
+}
+  return nil
+}
+
+func (p *TimerInfo)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
+} else {
+  p.ExpiryTimeNanos = &v
+}
+  return nil
+}
+
+func (p *TimerInfo)  ReadField40(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 40: ", err)
+} else {
+  p.TaskId = &v
+}
+  return nil
+}
+
+func (p *TimerInfo) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("TimerInfo"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
+    if err := p.writeField40(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *TimerInfo) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTimerId() {
+    if err := oprot.WriteFieldBegin("timerId", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:timerId: ", p), err) }
+    if err := oprot.WriteString(string(*p.TimerId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.timerId (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:timerId: ", p), err) }
+  }
+  return err
+}
+
+func (p *TimerInfo) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetStartedId() {
+    if err := oprot.WriteFieldBegin("startedId", thrift.I64, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:startedId: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.StartedId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.startedId (20) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:startedId: ", p), err) }
+  }
+  return err
+}
+
+func (p *TimerInfo) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetExpiryTimeNanos() {
+    if err := oprot.WriteFieldBegin("expiryTimeNanos", thrift.I64, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:expiryTimeNanos: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.ExpiryTimeNanos)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.expiryTimeNanos (30) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:expiryTimeNanos: ", p), err) }
+  }
+  return err
+}
+
+func (p *TimerInfo) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTaskId() {
+    if err := oprot.WriteFieldBegin("taskId", thrift.I64, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:taskId: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.TaskId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.taskId (40) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:taskId: ", p), err) }
+  }
+  return err
+}
+
+func (p *TimerInfo) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("TimerInfo(%+v)", *p)
+}
+
+// Attributes:
+//  - InitiatedId
+//  - InitiatedEvent
+//  - StartedId
+//  - StartedEvent
+//  - CreateRequestId
+type ChildExecutionInfo struct {
+  // unused fields # 1 to 9
+  InitiatedId *int64 `thrift:"initiatedId,10" db:"initiatedId" json:"initiatedId,omitempty"`
+  // unused fields # 11 to 19
+  InitiatedEvent []byte `thrift:"initiatedEvent,20" db:"initiatedEvent" json:"initiatedEvent,omitempty"`
+  // unused fields # 21 to 29
+  StartedId *int64 `thrift:"startedId,30" db:"startedId" json:"startedId,omitempty"`
+  // unused fields # 31 to 39
+  StartedEvent []byte `thrift:"startedEvent,40" db:"startedEvent" json:"startedEvent,omitempty"`
+  // unused fields # 41 to 49
+  CreateRequestId *string `thrift:"createRequestId,50" db:"createRequestId" json:"createRequestId,omitempty"`
+}
+
+func NewChildExecutionInfo() *ChildExecutionInfo {
+  return &ChildExecutionInfo{}
+}
+
+var ChildExecutionInfo_InitiatedId_DEFAULT int64
+func (p *ChildExecutionInfo) GetInitiatedId() int64 {
+  if !p.IsSetInitiatedId() {
+    return ChildExecutionInfo_InitiatedId_DEFAULT
+  }
+return *p.InitiatedId
+}
+var ChildExecutionInfo_InitiatedEvent_DEFAULT []byte
+func (p *ChildExecutionInfo) GetInitiatedEvent() []byte {
+  return p.InitiatedEvent
+}
+var ChildExecutionInfo_StartedId_DEFAULT int64
+func (p *ChildExecutionInfo) GetStartedId() int64 {
+  if !p.IsSetStartedId() {
+    return ChildExecutionInfo_StartedId_DEFAULT
+  }
+return *p.StartedId
+}
+var ChildExecutionInfo_StartedEvent_DEFAULT []byte
+func (p *ChildExecutionInfo) GetStartedEvent() []byte {
+  return p.StartedEvent
+}
+var ChildExecutionInfo_CreateRequestId_DEFAULT string
+func (p *ChildExecutionInfo) GetCreateRequestId() string {
+  if !p.IsSetCreateRequestId() {
+    return ChildExecutionInfo_CreateRequestId_DEFAULT
+  }
+return *p.CreateRequestId
+}
+func (p *ChildExecutionInfo) IsSetInitiatedId() bool {
+  return p.InitiatedId != nil
+}
+
+func (p *ChildExecutionInfo) IsSetInitiatedEvent() bool {
+  return p.InitiatedEvent != nil
+}
+
+func (p *ChildExecutionInfo) IsSetStartedId() bool {
+  return p.StartedId != nil
+}
+
+func (p *ChildExecutionInfo) IsSetStartedEvent() bool {
+  return p.StartedEvent != nil
+}
+
+func (p *ChildExecutionInfo) IsSetCreateRequestId() bool {
+  return p.CreateRequestId != nil
+}
+
+func (p *ChildExecutionInfo) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
+    case 50:
+      if err := p.ReadField50(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ChildExecutionInfo)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.InitiatedId = &v
+}
+  return nil
+}
+
+func (p *ChildExecutionInfo)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 20: ", err)
+} else {
+  p.InitiatedEvent = v
+}
+  return nil
+}
+
+func (p *ChildExecutionInfo)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
+} else {
+  p.StartedId = &v
+}
+  return nil
+}
+
+func (p *ChildExecutionInfo)  ReadField40(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 40: ", err)
+} else {
+  p.StartedEvent = v
+}
+  return nil
+}
+
+func (p *ChildExecutionInfo)  ReadField50(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 50: ", err)
+} else {
+  p.CreateRequestId = &v
+}
+  return nil
+}
+
+func (p *ChildExecutionInfo) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ChildExecutionInfo"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
+    if err := p.writeField40(oprot); err != nil { return err }
+    if err := p.writeField50(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ChildExecutionInfo) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInitiatedId() {
+    if err := oprot.WriteFieldBegin("initiatedId", thrift.I64, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:initiatedId: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.InitiatedId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.initiatedId (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:initiatedId: ", p), err) }
+  }
+  return err
+}
+
+func (p *ChildExecutionInfo) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInitiatedEvent() {
+    if err := oprot.WriteFieldBegin("initiatedEvent", thrift.STRING, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:initiatedEvent: ", p), err) }
+    if err := oprot.WriteBinary(p.InitiatedEvent); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.initiatedEvent (20) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:initiatedEvent: ", p), err) }
+  }
+  return err
+}
+
+func (p *ChildExecutionInfo) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetStartedId() {
+    if err := oprot.WriteFieldBegin("startedId", thrift.I64, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:startedId: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.StartedId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.startedId (30) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:startedId: ", p), err) }
+  }
+  return err
+}
+
+func (p *ChildExecutionInfo) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetStartedEvent() {
+    if err := oprot.WriteFieldBegin("startedEvent", thrift.STRING, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:startedEvent: ", p), err) }
+    if err := oprot.WriteBinary(p.StartedEvent); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.startedEvent (40) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:startedEvent: ", p), err) }
+  }
+  return err
+}
+
+func (p *ChildExecutionInfo) writeField50(oprot thrift.TProtocol) (err error) {
+  if p.IsSetCreateRequestId() {
+    if err := oprot.WriteFieldBegin("createRequestId", thrift.STRING, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:createRequestId: ", p), err) }
+    if err := oprot.WriteString(string(*p.CreateRequestId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.createRequestId (50) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:createRequestId: ", p), err) }
+  }
+  return err
+}
+
+func (p *ChildExecutionInfo) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ChildExecutionInfo(%+v)", *p)
+}
@@ -405,6 +405,7 @@ const (
   DecisionTaskFailedCause_BAD_CANCEL_WORKFLOW_EXECUTION_ATTRIBUTES DecisionTaskFailedCause = 8
   DecisionTaskFailedCause_BAD_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_ATTRIBUTES DecisionTaskFailedCause = 9
   DecisionTaskFailedCause_BAD_CONTINUE_AS_NEW_ATTRIBUTES DecisionTaskFailedCause = 10
+  DecisionTaskFailedCause_RESET_WORKFLOW DecisionTaskFailedCause = 11
 )
 
 func (p DecisionTaskFailedCause) String() string {
@@ -420,6 +421,7 @@ func (p DecisionTaskFailedCause) String() string {
   case DecisionTaskFailedCause_BAD_CANCEL_WORKFLOW_EXECUTION_ATTRIBUTES: return "BAD_CANCEL_WORKFLOW_EXECUTION_ATTRIBUTES"
   case DecisionTaskFailedCause_BAD_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_ATTRIBUTES: return "BAD_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_ATTRIBUTES"
   case DecisionTaskFailedCause_BAD_CONTINUE_AS_NEW_ATTRIBUTES: return "BAD_CONTINUE_AS_NEW_ATTRIBUTES"
+  case DecisionTaskFailedCause_RESET_WORKFLOW: return "RESET_WORKFLOW"
   }
   return "<UNSET>"
 }
@@ -437,6 +439,7 @@ func DecisionTaskFailedCauseFromString(s string) (DecisionTaskFailedCause, error
   case "BAD_CANCEL_WORKFLOW_EXECUTION_ATTRIBUTES": return DecisionTaskFailedCause_BAD_CANCEL_WORKFLOW_EXECUTION_ATTRIBUTES, nil 
   case "BAD_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_ATTRIBUTES": return DecisionTaskFailedCause_BAD_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_ATTRIBUTES, nil 
   case "BAD_CONTINUE_AS_NEW_ATTRIBUTES": return DecisionTaskFailedCause_BAD_CONTINUE_AS_NEW_ATTRIBUTES, nil 
+  case "RESET_WORKFLOW": return DecisionTaskFailedCause_RESET_WORKFLOW, nil 
   }
   return DecisionTaskFailedCause(0), fmt.Errorf("not a valid DecisionTaskFailedCause string")
 }
@@ -693,6 +696,283 @@ func (p * ChildPolicy) Value() (driver.Value, error) {
   }
 return int64(*p), nil
 }
+type DomainChangeType int64
+const (
+  DomainChangeType_REGISTERED DomainChangeType = 0
+  DomainChangeType_UPDATED DomainChangeType = 1
+  DomainChangeType_DEPRECATED DomainChangeType = 2
+)
+
+func (p DomainChangeType) String() string {
+  switch p {
+  case DomainChangeType_REGISTERED: return "REGISTERED"
+  case DomainChangeType_UPDATED: return "UPDATED"
+  case DomainChangeType_DEPRECATED: return "DEPRECATED"
+  }
+  return "<UNSET>"
+}
+
+func DomainChangeTypeFromString(s string) (DomainChangeType, error) {
+  switch s {
+  case "REGISTERED": return DomainChangeType_REGISTERED, nil 
+  case "UPDATED": return DomainChangeType_UPDATED, nil 
+  case "DEPRECATED": return DomainChangeType_DEPRECATED, nil 
+  }
+  return DomainChangeType(0), fmt.Errorf("not a valid DomainChangeType string")
+}
+
+
+func DomainChangeTypePtr(v DomainChangeType) *DomainChangeType { return &v }
+
+func (p DomainChangeType) MarshalText() ([]byte, error) {
+return []byte(p.String()), nil
+}
+
+func (p *DomainChangeType) UnmarshalText(text []byte) error {
+q, err := DomainChangeTypeFromString(string(text))
+if (err != nil) {
+return err
+}
+*p = q
+return nil
+}
+
+func (p *DomainChangeType) Scan(value interface{}) error {
+v, ok := value.(int64)
+if !ok {
+return errors.New("Scan value is not int64")
+}
+*p = DomainChangeType(v)
+return nil
+}
+
+func (p * DomainChangeType) Value() (driver.Value, error) {
+  if p == nil {
+    return nil, nil
+  }
+return int64(*p), nil
+}
+type TaskListType int64
+const (
+  TaskListType_Decision TaskListType = 0
+  TaskListType_Activity TaskListType = 1
+)
+
+func (p TaskListType) String() string {
+  switch p {
+  case TaskListType_Decision: return "Decision"
+  case TaskListType_Activity: return "Activity"
+  }
+  return "<UNSET>"
+}
+
+func TaskListTypeFromString(s string) (TaskListType, error) {
+  switch s {
+  case "Decision": return TaskListType_Decision, nil 
+  case "Activity": return TaskListType_Activity, nil 
+  }
+  return TaskListType(0), fmt.Errorf("not a valid TaskListType string")
+}
+
+
+func TaskListTypePtr(v TaskListType) *TaskListType { return &v }
+
+func (p TaskListType) MarshalText() ([]byte, error) {
+return []byte(p.String()), nil
+}
+
+func (p *TaskListType) UnmarshalText(text []byte) error {
+q, err := TaskListTypeFromString(string(text))
+if (err != nil) {
+return err
+}
+*p = q
+return nil
+}
+
+func (p *TaskListType) Scan(value interface{}) error {
+v, ok := value.(int64)
+if !ok {
+return errors.New("Scan value is not int64")
+}
+*p = TaskListType(v)
+return nil
+}
+
+func (p * TaskListType) Value() (driver.Value, error) {
+  if p == nil {
+    return nil, nil
+  }
+return int64(*p), nil
+}
+type QueryTaskCompletedType int64
+const (
+  QueryTaskCompletedType_COMPLETED QueryTaskCompletedType = 0
+  QueryTaskCompletedType_FAILED QueryTaskCompletedType = 1
+)
+
+func (p QueryTaskCompletedType) String() string {
+  switch p {
+  case QueryTaskCompletedType_COMPLETED: return "COMPLETED"
+  case QueryTaskCompletedType_FAILED: return "FAILED"
+  }
+  return "<UNSET>"
+}
+
+func QueryTaskCompletedTypeFromString(s string) (QueryTaskCompletedType, error) {
+  switch s {
+  case "COMPLETED": return QueryTaskCompletedType_COMPLETED, nil 
+  case "FAILED": return QueryTaskCompletedType_FAILED, nil 
+  }
+  return QueryTaskCompletedType(0), fmt.Errorf("not a valid QueryTaskCompletedType string")
+}
+
+
+func QueryTaskCompletedTypePtr(v QueryTaskCompletedType) *QueryTaskCompletedType { return &v }
+
+func (p QueryTaskCompletedType) MarshalText() ([]byte, error) {
+return []byte(p.String()), nil
+}
+
+func (p *QueryTaskCompletedType) UnmarshalText(text []byte) error {
+q, err := QueryTaskCompletedTypeFromString(string(text))
+if (err != nil) {
+return err
+}
+*p = q
+return nil
+}
+
+func (p *QueryTaskCompletedType) Scan(value interface{}) error {
+v, ok := value.(int64)
+if !ok {
+return errors.New("Scan value is not int64")
+}
+*p = QueryTaskCompletedType(v)
+return nil
+}
+
+func (p * QueryTaskCompletedType) Value() (driver.Value, error) {
+  if p == nil {
+    return nil, nil
+  }
+return int64(*p), nil
+}
+type BatchOperationType int64
+const (
+  BatchOperationType_SIGNAL BatchOperationType = 0
+  BatchOperationType_CANCEL BatchOperationType = 1
+  BatchOperationType_TERMINATE BatchOperationType = 2
+)
+
+func (p BatchOperationType) String() string {
+  switch p {
+  case BatchOperationType_SIGNAL: return "SIGNAL"
+  case BatchOperationType_CANCEL: return "CANCEL"
+  case BatchOperationType_TERMINATE: return "TERMINATE"
+  }
+  return "<UNSET>"
+}
+
+func BatchOperationTypeFromString(s string) (BatchOperationType, error) {
+  switch s {
+  case "SIGNAL": return BatchOperationType_SIGNAL, nil 
+  case "CANCEL": return BatchOperationType_CANCEL, nil 
+  case "TERMINATE": return BatchOperationType_TERMINATE, nil 
+  }
+  return BatchOperationType(0), fmt.Errorf("not a valid BatchOperationType string")
+}
+
+
+func BatchOperationTypePtr(v BatchOperationType) *BatchOperationType { return &v }
+
+func (p BatchOperationType) MarshalText() ([]byte, error) {
+return []byte(p.String()), nil
+}
+
+func (p *BatchOperationType) UnmarshalText(text []byte) error {
+q, err := BatchOperationTypeFromString(string(text))
+if (err != nil) {
+return err
+}
+*p = q
+return nil
+}
+
+func (p *BatchOperationType) Scan(value interface{}) error {
+v, ok := value.(int64)
+if !ok {
+return errors.New("Scan value is not int64")
+}
+*p = BatchOperationType(v)
+return nil
+}
+
+func (p * BatchOperationType) Value() (driver.Value, error) {
+  if p == nil {
+    return nil, nil
+  }
+return int64(*p), nil
+}
+type BatchOperationState int64
+const (
+  BatchOperationState_RUNNING BatchOperationState = 0
+  BatchOperationState_COMPLETED BatchOperationState = 1
+  BatchOperationState_FAILED BatchOperationState = 2
+  BatchOperationState_STOPPED BatchOperationState = 3
+)
+
+func (p BatchOperationState) String() string {
+  switch p {
+  case BatchOperationState_RUNNING: return "RUNNING"
+  case BatchOperationState_COMPLETED: return "COMPLETED"
+  case BatchOperationState_FAILED: return "FAILED"
+  case BatchOperationState_STOPPED: return "STOPPED"
+  }
+  return "<UNSET>"
+}
+
+func BatchOperationStateFromString(s string) (BatchOperationState, error) {
+  switch s {
+  case "RUNNING": return BatchOperationState_RUNNING, nil 
+  case "COMPLETED": return BatchOperationState_COMPLETED, nil 
+  case "FAILED": return BatchOperationState_FAILED, nil 
+  case "STOPPED": return BatchOperationState_STOPPED, nil 
+  }
+  return BatchOperationState(0), fmt.Errorf("not a valid BatchOperationState string")
+}
+
+
+func BatchOperationStatePtr(v BatchOperationState) *BatchOperationState { return &v }
+
+func (p BatchOperationState) MarshalText() ([]byte, error) {
+return []byte(p.String()), nil
+}
+
+func (p *BatchOperationState) UnmarshalText(text []byte) error {
+q, err := BatchOperationStateFromString(string(text))
+if (err != nil) {
+return err
+}
+*p = q
+return nil
+}
+
+func (p *BatchOperationState) Scan(value interface{}) error {
+v, ok := value.(int64)
+if !ok {
+return errors.New("Scan value is not int64")
+}
+*p = BatchOperationState(v)
+return nil
+}
+
+func (p * BatchOperationState) Value() (driver.Value, error) {
+  if p == nil {
+    return nil, nil
+  }
+return int64(*p), nil
+}
 // Attributes:
 //  - Message
 type BadRequestError struct {
@@ -1343,32 +1623,25 @@ func (p *ServiceBusyError) Error() string {
 }
 
 // Attributes:
-//  - Name
-type WorkflowType struct {
-  // unused fields # 1 to 9
-  Name *string `thrift:"name,10" db:"name" json:"name,omitempty"`
+//  - Message
+type QueryFailedError struct {
+  Message string `thrift:"message,1,required" db:"message" json:"message"`
 }
 
-func NewWorkflowType() *WorkflowType {
-  return &WorkflowType{}
+func NewQueryFailedError() *QueryFailedError {
+  return &QueryFailedError{}
 }
 
-var WorkflowType_Name_DEFAULT string
-func (p *WorkflowType) GetName() string {
-  if !p.IsSetName() {
-    return WorkflowType_Name_DEFAULT
-  }
-return *p.Name
-}
-func (p *WorkflowType) IsSetName() bool {
-  return p.Name != nil
-}
 
-func (p *WorkflowType) Read(iprot thrift.TProtocol) error {
+func (p *QueryFailedError) GetMessage() string {
+  return p.Message
+}
+func (p *QueryFailedError) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
 
+  var issetMessage bool = false;
 
   for {
     _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
@@ -1377,10 +1650,11 @@ func (p *WorkflowType) Read(iprot thrift.TProtocol) error {
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
-    case 10:
-      if err := p.ReadField10(iprot); err != nil {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
         return err
       }
+      issetMessage = true
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -1393,23 +1667,26 @@ func (p *WorkflowType) Read(iprot thrift.TProtocol) error {
   if err := iprot.ReadStructEnd(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
   }
+  if !issetMessage{
+    return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Message is not set"));
+  }
   return nil
 }
 
-func (p *WorkflowType)  ReadField10(iprot thrift.TProtocol) error {
+func (p *QueryFailedError)  ReadField1(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 10: ", err)
+  return thrift.PrependError("error reading field 1: ", err)
 } else {
-  p.Name = &v
+  p.Message = v
 }
   return nil
 }
 
-func (p *WorkflowType) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("WorkflowType"); err != nil {
+func (p *QueryFailedError) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("QueryFailedError"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
-    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -1418,48 +1695,50 @@ func (p *WorkflowType) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *WorkflowType) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetName() {
-    if err := oprot.WriteFieldBegin("name", thrift.STRING, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:name: ", p), err) }
-    if err := oprot.WriteString(string(*p.Name)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.name (10) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:name: ", p), err) }
-  }
+func (p *QueryFailedError) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("message", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:message: ", p), err) }
+  if err := oprot.WriteString(string(p.Message)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.message (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:message: ", p), err) }
   return err
 }
 
-func (p *WorkflowType) String() string {
+func (p *QueryFailedError) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowType(%+v)", *p)
+  return fmt.Sprintf("QueryFailedError(%+v)", *p)
+}
+
+func (p *QueryFailedError) Error() string {
+  return p.String()
 }
 
 // Attributes:
 //  - Name
-type ActivityType struct {
+type WorkflowType struct {
   // unused fields # 1 to 9
   Name *string `thrift:"name,10" db:"name" json:"name,omitempty"`
 }
 
-func NewActivityType() *ActivityType {
-  return &ActivityType{}
+func NewWorkflowType() *WorkflowType {
+  return &WorkflowType{}
 }
 
-var ActivityType_Name_DEFAULT string
-func (p *ActivityType) GetName() string {
+var WorkflowType_Name_DEFAULT string
+func (p *WorkflowType) GetName() string {
   if !p.IsSetName() {
-    return ActivityType_Name_DEFAULT
+    return WorkflowType_Name_DEFAULT
   }
 return *p.Name
 }
-func (p *ActivityType) IsSetName() bool {
+func (p *WorkflowType) IsSetName() bool {
   return p.Name != nil
 }
 
-func (p *ActivityType) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowType) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -1491,7 +1770,102 @@ func (p *ActivityType) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *ActivityType)  ReadField10(iprot thrift.TProtocol) error {
+func (p *WorkflowType)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.Name = &v
+}
+  return nil
+}
+
+func (p *WorkflowType) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("WorkflowType"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowType) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetName() {
+    if err := oprot.WriteFieldBegin("name", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:name: ", p), err) }
+    if err := oprot.WriteString(string(*p.Name)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.name (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:name: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowType) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowType(%+v)", *p)
+}
+
+// Attributes:
+//  - Name
+type ActivityType struct {
+  // unused fields # 1 to 9
+  Name *string `thrift:"name,10" db:"name" json:"name,omitempty"`
+}
+
+func NewActivityType() *ActivityType {
+  return &ActivityType{}
+}
+
+var ActivityType_Name_DEFAULT string
+func (p *ActivityType) GetName() string {
+  if !p.IsSetName() {
+    return ActivityType_Name_DEFAULT
+  }
+return *p.Name
+}
+func (p *ActivityType) IsSetName() bool {
+  return p.Name != nil
+}
+
+func (p *ActivityType) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ActivityType)  ReadField10(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 10: ", err)
 } else {
@@ -1769,6 +2143,8 @@ func (p *WorkflowExecution) String() string {
 //  - CloseTime
 //  - CloseStatus
 //  - HistoryLength
+//  - FirstExecutionRunId
+//  - TraceId
 type WorkflowExecutionInfo struct {
   // unused fields # 1 to 9
   Execution *WorkflowExecution `thrift:"execution,10" db:"execution" json:"execution,omitempty"`
@@ -1782,6 +2158,10 @@ type WorkflowExecutionInfo struct {
   CloseStatus *WorkflowExecutionCloseStatus `thrift:"closeStatus,50" db:"closeStatus" json:"closeStatus,omitempty"`
   // unused fields # 51 to 59
   HistoryLength *int64 `thrift:"historyLength,60" db:"historyLength" json:"historyLength,omitempty"`
+  // unused fields # 61 to 69
+  FirstExecutionRunId *string `thrift:"firstExecutionRunId,70" db:"firstExecutionRunId" json:"firstExecutionRunId,omitempty"`
+  // unused fields # 71 to 79
+  TraceId *string `thrift:"traceId,80" db:"traceId" json:"traceId,omitempty"`
 }
 
 func NewWorkflowExecutionInfo() *WorkflowExecutionInfo {
@@ -1830,6 +2210,20 @@ func (p *WorkflowExecutionInfo) GetHistoryLength() int64 {
   }
 return *p.HistoryLength
 }
+var WorkflowExecutionInfo_FirstExecutionRunId_DEFAULT string
+func (p *WorkflowExecutionInfo) GetFirstExecutionRunId() string {
+  if !p.IsSetFirstExecutionRunId() {
+    return WorkflowExecutionInfo_FirstExecutionRunId_DEFAULT
+  }
+return *p.FirstExecutionRunId
+}
+var WorkflowExecutionInfo_TraceId_DEFAULT string
+func (p *WorkflowExecutionInfo) GetTraceId() string {
+  if !p.IsSetTraceId() {
+    return WorkflowExecutionInfo_TraceId_DEFAULT
+  }
+return *p.TraceId
+}
 func (p *WorkflowExecutionInfo) IsSetExecution() bool {
   return p.Execution != nil
 }
@@ -1854,6 +2248,14 @@ func (p *WorkflowExecutionInfo) IsSetHistoryLength() bool {
   return p.HistoryLength != nil
 }
 
+func (p *WorkflowExecutionInfo) IsSetFirstExecutionRunId() bool {
+  return p.FirstExecutionRunId != nil
+}
+
+func (p *WorkflowExecutionInfo) IsSetTraceId() bool {
+  return p.TraceId != nil
+}
+
 func (p *WorkflowExecutionInfo) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
@@ -1891,6 +2293,14 @@ func (p *WorkflowExecutionInfo) Read(iprot thrift.TProtocol) error {
       if err := p.ReadField60(iprot); err != nil {
         return err
       }
+    case 70:
+      if err := p.ReadField70(iprot); err != nil {
+        return err
+      }
+    case 80:
+      if err := p.ReadField80(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -1959,6 +2369,24 @@ func (p *WorkflowExecutionInfo)  ReadField60(iprot thrift.TProtocol) error {
   return nil
 }
 
+func (p *WorkflowExecutionInfo)  ReadField70(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 70: ", err)
+} else {
+  p.FirstExecutionRunId = &v
+}
+  return nil
+}
+
+func (p *WorkflowExecutionInfo)  ReadField80(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 80: ", err)
+} else {
+  p.TraceId = &v
+}
+  return nil
+}
+
 func (p *WorkflowExecutionInfo) Write(oprot thrift.TProtocol) error {
   if err := oprot.WriteStructBegin("WorkflowExecutionInfo"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
@@ -1969,6 +2397,8 @@ func (p *WorkflowExecutionInfo) Write(oprot thrift.TProtocol) error {
     if err := p.writeField40(oprot); err != nil { return err }
     if err := p.writeField50(oprot); err != nil { return err }
     if err := p.writeField60(oprot); err != nil { return err }
+    if err := p.writeField70(oprot); err != nil { return err }
+    if err := p.writeField80(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -2051,6 +2481,30 @@ func (p *WorkflowExecutionInfo) writeField60(oprot thrift.TProtocol) (err error)
   return err
 }
 
+func (p *WorkflowExecutionInfo) writeField70(oprot thrift.TProtocol) (err error) {
+  if p.IsSetFirstExecutionRunId() {
+    if err := oprot.WriteFieldBegin("firstExecutionRunId", thrift.STRING, 70); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 70:firstExecutionRunId: ", p), err) }
+    if err := oprot.WriteString(string(*p.FirstExecutionRunId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.firstExecutionRunId (70) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 70:firstExecutionRunId: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowExecutionInfo) writeField80(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTraceId() {
+    if err := oprot.WriteFieldBegin("traceId", thrift.STRING, 80); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 80:traceId: ", p), err) }
+    if err := oprot.WriteString(string(*p.TraceId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.traceId (80) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 80:traceId: ", p), err) }
+  }
+  return err
+}
+
 func (p *WorkflowExecutionInfo) String() string {
   if p == nil {
     return "<nil>"
@@ -4669,6 +5123,8 @@ func (p *Decision) String() string {
 //  - ExecutionStartToCloseTimeoutSeconds
 //  - TaskStartToCloseTimeoutSeconds
 //  - Identity
+//  - FirstExecutionRunId
+//  - TraceId
 type WorkflowExecutionStartedEventAttributes struct {
   // unused fields # 1 to 9
   WorkflowType *WorkflowType `thrift:"workflowType,10" db:"workflowType" json:"workflowType,omitempty"`
@@ -4682,6 +5138,10 @@ type WorkflowExecutionStartedEventAttributes struct {
   TaskStartToCloseTimeoutSeconds *int32 `thrift:"taskStartToCloseTimeoutSeconds,50" db:"taskStartToCloseTimeoutSeconds" json:"taskStartToCloseTimeoutSeconds,omitempty"`
   // unused fields # 51 to 59
   Identity *string `thrift:"identity,60" db:"identity" json:"identity,omitempty"`
+  // unused fields # 61 to 69
+  FirstExecutionRunId *string `thrift:"firstExecutionRunId,70" db:"firstExecutionRunId" json:"firstExecutionRunId,omitempty"`
+  // unused fields # 71 to 79
+  TraceId *string `thrift:"traceId,80" db:"traceId" json:"traceId,omitempty"`
 }
 
 func NewWorkflowExecutionStartedEventAttributes() *WorkflowExecutionStartedEventAttributes {
@@ -4728,6 +5188,20 @@ func (p *WorkflowExecutionStartedEventAttributes) GetIdentity() string {
   }
 return *p.Identity
 }
+var WorkflowExecutionStartedEventAttributes_FirstExecutionRunId_DEFAULT string
+func (p *WorkflowExecutionStartedEventAttributes) GetFirstExecutionRunId() string {
+  if !p.IsSetFirstExecutionRunId() {
+    return WorkflowExecutionStartedEventAttributes_FirstExecutionRunId_DEFAULT
+  }
+return *p.FirstExecutionRunId
+}
+var WorkflowExecutionStartedEventAttributes_TraceId_DEFAULT string
+func (p *WorkflowExecutionStartedEventAttributes) GetTraceId() string {
+  if !p.IsSetTraceId() {
+    return WorkflowExecutionStartedEventAttributes_TraceId_DEFAULT
+  }
+return *p.TraceId
+}
 func (p *WorkflowExecutionStartedEventAttributes) IsSetWorkflowType() bool {
   return p.WorkflowType != nil
 }
@@ -4752,6 +5226,14 @@ func (p *WorkflowExecutionStartedEventAttributes) IsSetIdentity() bool {
   return p.Identity != nil
 }
 
+func (p *WorkflowExecutionStartedEventAttributes) IsSetFirstExecutionRunId() bool {
+  return p.FirstExecutionRunId != nil
+}
+
+func (p *WorkflowExecutionStartedEventAttributes) IsSetTraceId() bool {
+  return p.TraceId != nil
+}
+
 func (p *WorkflowExecutionStartedEventAttributes) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
@@ -4789,6 +5271,14 @@ func (p *WorkflowExecutionStartedEventAttributes) Read(iprot thrift.TProtocol) e
       if err := p.ReadField60(iprot); err != nil {
         return err
       }
+    case 70:
+      if err := p.ReadField70(iprot); err != nil {
+        return err
+      }
+    case 80:
+      if err := p.ReadField80(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -4856,6 +5346,24 @@ func (p *WorkflowExecutionStartedEventAttributes)  ReadField60(iprot thrift.TPro
   return nil
 }
 
+func (p *WorkflowExecutionStartedEventAttributes)  ReadField70(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 70: ", err)
+} else {
+  p.FirstExecutionRunId = &v
+}
+  return nil
+}
+
+func (p *WorkflowExecutionStartedEventAttributes)  ReadField80(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 80: ", err)
+} else {
+  p.TraceId = &v
+}
+  return nil
+}
+
 func (p *WorkflowExecutionStartedEventAttributes) Write(oprot thrift.TProtocol) error {
   if err := oprot.WriteStructBegin("WorkflowExecutionStartedEventAttributes"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
@@ -4866,6 +5374,8 @@ func (p *WorkflowExecutionStartedEventAttributes) Write(oprot thrift.TProtocol)
     if err := p.writeField40(oprot); err != nil { return err }
     if err := p.writeField50(oprot); err != nil { return err }
     if err := p.writeField60(oprot); err != nil { return err }
+    if err := p.writeField70(oprot); err != nil { return err }
+    if err := p.writeField80(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -4948,6 +5458,30 @@ func (p *WorkflowExecutionStartedEventAttributes) writeField60(oprot thrift.TPro
   return err
 }
 
+func (p *WorkflowExecutionStartedEventAttributes) writeField70(oprot thrift.TProtocol) (err error) {
+  if p.IsSetFirstExecutionRunId() {
+    if err := oprot.WriteFieldBegin("firstExecutionRunId", thrift.STRING, 70); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 70:firstExecutionRunId: ", p), err) }
+    if err := oprot.WriteString(string(*p.FirstExecutionRunId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.firstExecutionRunId (70) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 70:firstExecutionRunId: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowExecutionStartedEventAttributes) writeField80(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTraceId() {
+    if err := oprot.WriteFieldBegin("traceId", thrift.STRING, 80); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 80:traceId: ", p), err) }
+    if err := oprot.WriteString(string(*p.TraceId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.traceId (80) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 80:traceId: ", p), err) }
+  }
+  return err
+}
+
 func (p *WorkflowExecutionStartedEventAttributes) String() string {
   if p == nil {
     return "<nil>"
@@ -15589,11 +16123,23 @@ func (p *DomainInfo) String() string {
 // Attributes:
 //  - WorkflowExecutionRetentionPeriodInDays
 //  - EmitMetric
+//  - ArchivalEnabled
+//  - DefaultTaskList
+//  - TaskListOverrides
+//  - TerminationProtected
 type DomainConfiguration struct {
   // unused fields # 1 to 9
   WorkflowExecutionRetentionPeriodInDays *int32 `thrift:"workflowExecutionRetentionPeriodInDays,10" db:"workflowExecutionRetentionPeriodInDays" json:"workflowExecutionRetentionPeriodInDays,omitempty"`
   // unused fields # 11 to 19
   EmitMetric *bool `thrift:"emitMetric,20" db:"emitMetric" json:"emitMetric,omitempty"`
+  // unused fields # 21 to 29
+  ArchivalEnabled *bool `thrift:"archivalEnabled,30" db:"archivalEnabled" json:"archivalEnabled,omitempty"`
+  // unused fields # 31 to 39
+  DefaultTaskList *string `thrift:"defaultTaskList,40" db:"defaultTaskList" json:"defaultTaskList,omitempty"`
+  // unused fields # 41 to 49
+  TaskListOverrides map[string]string `thrift:"taskListOverrides,50" db:"taskListOverrides" json:"taskListOverrides,omitempty"`
+  // unused fields # 51 to 59
+  TerminationProtected *bool `thrift:"terminationProtected,60" db:"terminationProtected" json:"terminationProtected,omitempty"`
 }
 
 func NewDomainConfiguration() *DomainConfiguration {
@@ -15614,6 +16160,32 @@ func (p *DomainConfiguration) GetEmitMetric() bool {
   }
 return *p.EmitMetric
 }
+var DomainConfiguration_ArchivalEnabled_DEFAULT bool
+func (p *DomainConfiguration) GetArchivalEnabled() bool {
+  if !p.IsSetArchivalEnabled() {
+    return DomainConfiguration_ArchivalEnabled_DEFAULT
+  }
+return *p.ArchivalEnabled
+}
+var DomainConfiguration_DefaultTaskList_DEFAULT string
+func (p *DomainConfiguration) GetDefaultTaskList() string {
+  if !p.IsSetDefaultTaskList() {
+    return DomainConfiguration_DefaultTaskList_DEFAULT
+  }
+return *p.DefaultTaskList
+}
+var DomainConfiguration_TaskListOverrides_DEFAULT map[string]string
+
+func (p *DomainConfiguration) GetTaskListOverrides() map[string]string {
+  return p.TaskListOverrides
+}
+var DomainConfiguration_TerminationProtected_DEFAULT bool
+func (p *DomainConfiguration) GetTerminationProtected() bool {
+  if !p.IsSetTerminationProtected() {
+    return DomainConfiguration_TerminationProtected_DEFAULT
+  }
+return *p.TerminationProtected
+}
 func (p *DomainConfiguration) IsSetWorkflowExecutionRetentionPeriodInDays() bool {
   return p.WorkflowExecutionRetentionPeriodInDays != nil
 }
@@ -15622,6 +16194,22 @@ func (p *DomainConfiguration) IsSetEmitMetric() bool {
   return p.EmitMetric != nil
 }
 
+func (p *DomainConfiguration) IsSetArchivalEnabled() bool {
+  return p.ArchivalEnabled != nil
+}
+
+func (p *DomainConfiguration) IsSetDefaultTaskList() bool {
+  return p.DefaultTaskList != nil
+}
+
+func (p *DomainConfiguration) IsSetTaskListOverrides() bool {
+  return p.TaskListOverrides != nil
+}
+
+func (p *DomainConfiguration) IsSetTerminationProtected() bool {
+  return p.TerminationProtected != nil
+}
+
 func (p *DomainConfiguration) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
@@ -15643,6 +16231,22 @@ func (p *DomainConfiguration) Read(iprot thrift.TProtocol) error {
       if err := p.ReadField20(iprot); err != nil {
         return err
       }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
+    case 50:
+      if err := p.ReadField50(iprot); err != nil {
+        return err
+      }
+    case 60:
+      if err := p.ReadField60(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -15676,15 +16280,74 @@ func (p *DomainConfiguration)  ReadField20(iprot thrift.TProtocol) error {
   return nil
 }
 
+func (p *DomainConfiguration)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
+} else {
+  p.ArchivalEnabled = &v
+}
+  return nil
+}
+
+func (p *DomainConfiguration)  ReadField40(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 40: ", err)
+} else {
+  p.DefaultTaskList = &v
+}
+  return nil
+}
+
+func (p *DomainConfiguration)  ReadField50(iprot thrift.TProtocol) error {
+  _, _, size, err := iprot.ReadMapBegin()
+  if err != nil {
+    return thrift.PrependError("error reading map begin: ", err)
+  }
+  tMap := make(map[string]string, size)
+  p.TaskListOverrides =  tMap
+  for i := 0; i < size; i ++ {
+    var _key string
+    if v, err := iprot.ReadString(); err != nil {
+    return thrift.PrependError("error reading field 0: ", err)
+} else {
+    _key = v
+}
+    var _val string
+    if v, err := iprot.ReadString(); err != nil {
+    return thrift.PrependError("error reading field 0: ", err)
+} else {
+    _val = v
+}
+    p.TaskListOverrides[_key] = _val
+  }
+  if err := iprot.ReadMapEnd(); err != nil {
+    return thrift.PrependError("error reading map end: ", err)
+  }
+  return nil
+}
+
+func (p *DomainConfiguration)  ReadField60(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(); err != nil {
+  return thrift.PrependError("error reading field 60: ", err)
+} else {
+  p.TerminationProtected = &v
+}
+  return nil
+}
+
 func (p *DomainConfiguration) Write(oprot thrift.TProtocol) error {
   if err := oprot.WriteStructBegin("DomainConfiguration"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
     if err := p.writeField20(oprot); err != nil { return err }
-  }
-  if err := oprot.WriteFieldStop(); err != nil {
-    return thrift.PrependError("write field stop error: ", err) }
+    if err := p.writeField30(oprot); err != nil { return err }
+    if err := p.writeField40(oprot); err != nil { return err }
+    if err := p.writeField50(oprot); err != nil { return err }
+    if err := p.writeField60(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
   if err := oprot.WriteStructEnd(); err != nil {
     return thrift.PrependError("write struct stop error: ", err) }
   return nil
@@ -15714,6 +16377,64 @@ func (p *DomainConfiguration) writeField20(oprot thrift.TProtocol) (err error) {
   return err
 }
 
+func (p *DomainConfiguration) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetArchivalEnabled() {
+    if err := oprot.WriteFieldBegin("archivalEnabled", thrift.BOOL, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:archivalEnabled: ", p), err) }
+    if err := oprot.WriteBool(bool(*p.ArchivalEnabled)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.archivalEnabled (30) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:archivalEnabled: ", p), err) }
+  }
+  return err
+}
+
+func (p *DomainConfiguration) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDefaultTaskList() {
+    if err := oprot.WriteFieldBegin("defaultTaskList", thrift.STRING, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:defaultTaskList: ", p), err) }
+    if err := oprot.WriteString(string(*p.DefaultTaskList)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.defaultTaskList (40) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:defaultTaskList: ", p), err) }
+  }
+  return err
+}
+
+func (p *DomainConfiguration) writeField50(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTaskListOverrides() {
+    if err := oprot.WriteFieldBegin("taskListOverrides", thrift.MAP, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:taskListOverrides: ", p), err) }
+    if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.TaskListOverrides)); err != nil {
+      return thrift.PrependError("error writing map begin: ", err)
+    }
+    for k, v := range p.TaskListOverrides {
+      if err := oprot.WriteString(string(k)); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err) }
+      if err := oprot.WriteString(string(v)); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err) }
+    }
+    if err := oprot.WriteMapEnd(); err != nil {
+      return thrift.PrependError("error writing map end: ", err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:taskListOverrides: ", p), err) }
+  }
+  return err
+}
+
+func (p *DomainConfiguration) writeField60(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTerminationProtected() {
+    if err := oprot.WriteFieldBegin("terminationProtected", thrift.BOOL, 60); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:terminationProtected: ", p), err) }
+    if err := oprot.WriteBool(bool(*p.TerminationProtected)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.terminationProtected (60) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:terminationProtected: ", p), err) }
+  }
+  return err
+}
+
 func (p *DomainConfiguration) String() string {
   if p == nil {
     return "<nil>"
@@ -15862,6 +16583,10 @@ func (p *UpdateDomainInfo) String() string {
 //  - OwnerEmail
 //  - WorkflowExecutionRetentionPeriodInDays
 //  - EmitMetric
+//  - ArchivalEnabled
+//  - DefaultTaskList
+//  - TaskListOverrides
+//  - TerminationProtected
 type RegisterDomainRequest struct {
   // unused fields # 1 to 9
   Name *string `thrift:"name,10" db:"name" json:"name,omitempty"`
@@ -15873,6 +16598,14 @@ type RegisterDomainRequest struct {
   WorkflowExecutionRetentionPeriodInDays *int32 `thrift:"workflowExecutionRetentionPeriodInDays,40" db:"workflowExecutionRetentionPeriodInDays" json:"workflowExecutionRetentionPeriodInDays,omitempty"`
   // unused fields # 41 to 49
   EmitMetric *bool `thrift:"emitMetric,50" db:"emitMetric" json:"emitMetric,omitempty"`
+  // unused fields # 51 to 59
+  ArchivalEnabled *bool `thrift:"archivalEnabled,60" db:"archivalEnabled" json:"archivalEnabled,omitempty"`
+  // unused fields # 61 to 69
+  DefaultTaskList *string `thrift:"defaultTaskList,70" db:"defaultTaskList" json:"defaultTaskList,omitempty"`
+  // unused fields # 71 to 79
+  TaskListOverrides map[string]string `thrift:"taskListOverrides,80" db:"taskListOverrides" json:"taskListOverrides,omitempty"`
+  // unused fields # 81 to 89
+  TerminationProtected *bool `thrift:"terminationProtected,90" db:"terminationProtected" json:"terminationProtected,omitempty"`
 }
 
 func NewRegisterDomainRequest() *RegisterDomainRequest {
@@ -15914,6 +16647,32 @@ func (p *RegisterDomainRequest) GetEmitMetric() bool {
   }
 return *p.EmitMetric
 }
+var RegisterDomainRequest_ArchivalEnabled_DEFAULT bool
+func (p *RegisterDomainRequest) GetArchivalEnabled() bool {
+  if !p.IsSetArchivalEnabled() {
+    return RegisterDomainRequest_ArchivalEnabled_DEFAULT
+  }
+return *p.ArchivalEnabled
+}
+var RegisterDomainRequest_DefaultTaskList_DEFAULT string
+func (p *RegisterDomainRequest) GetDefaultTaskList() string {
+  if !p.IsSetDefaultTaskList() {
+    return RegisterDomainRequest_DefaultTaskList_DEFAULT
+  }
+return *p.DefaultTaskList
+}
+var RegisterDomainRequest_TaskListOverrides_DEFAULT map[string]string
+
+func (p *RegisterDomainRequest) GetTaskListOverrides() map[string]string {
+  return p.TaskListOverrides
+}
+var RegisterDomainRequest_TerminationProtected_DEFAULT bool
+func (p *RegisterDomainRequest) GetTerminationProtected() bool {
+  if !p.IsSetTerminationProtected() {
+    return RegisterDomainRequest_TerminationProtected_DEFAULT
+  }
+return *p.TerminationProtected
+}
 func (p *RegisterDomainRequest) IsSetName() bool {
   return p.Name != nil
 }
@@ -15934,6 +16693,22 @@ func (p *RegisterDomainRequest) IsSetEmitMetric() bool {
   return p.EmitMetric != nil
 }
 
+func (p *RegisterDomainRequest) IsSetArchivalEnabled() bool {
+  return p.ArchivalEnabled != nil
+}
+
+func (p *RegisterDomainRequest) IsSetDefaultTaskList() bool {
+  return p.DefaultTaskList != nil
+}
+
+func (p *RegisterDomainRequest) IsSetTaskListOverrides() bool {
+  return p.TaskListOverrides != nil
+}
+
+func (p *RegisterDomainRequest) IsSetTerminationProtected() bool {
+  return p.TerminationProtected != nil
+}
+
 func (p *RegisterDomainRequest) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
@@ -15967,6 +16742,22 @@ func (p *RegisterDomainRequest) Read(iprot thrift.TProtocol) error {
       if err := p.ReadField50(iprot); err != nil {
         return err
       }
+    case 60:
+      if err := p.ReadField60(iprot); err != nil {
+        return err
+      }
+    case 70:
+      if err := p.ReadField70(iprot); err != nil {
+        return err
+      }
+    case 80:
+      if err := p.ReadField80(iprot); err != nil {
+        return err
+      }
+    case 90:
+      if err := p.ReadField90(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -16027,6 +16818,61 @@ func (p *RegisterDomainRequest)  ReadField50(iprot thrift.TProtocol) error {
   return nil
 }
 
+func (p *RegisterDomainRequest)  ReadField60(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(); err != nil {
+  return thrift.PrependError("error reading field 60: ", err)
+} else {
+  p.ArchivalEnabled = &v
+}
+  return nil
+}
+
+func (p *RegisterDomainRequest)  ReadField70(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 70: ", err)
+} else {
+  p.DefaultTaskList = &v
+}
+  return nil
+}
+
+func (p *RegisterDomainRequest)  ReadField80(iprot thrift.TProtocol) error {
+  _, _, size, err := iprot.ReadMapBegin()
+  if err != nil {
+    return thrift.PrependError("error reading map begin: ", err)
+  }
+  tMap := make(map[string]string, size)
+  p.TaskListOverrides =  tMap
+  for i := 0; i < size; i ++ {
+    var _key string
+    if v, err := iprot.ReadString(); err != nil {
+    return thrift.PrependError("error reading field 0: ", err)
+} else {
+    _key = v
+}
+    var _val string
+    if v, err := iprot.ReadString(); err != nil {
+    return thrift.PrependError("error reading field 0: ", err)
+} else {
+    _val = v
+}
+    p.TaskListOverrides[_key] = _val
+  }
+  if err := iprot.ReadMapEnd(); err != nil {
+    return thrift.PrependError("error reading map end: ", err)
+  }
+  return nil
+}
+
+func (p *RegisterDomainRequest)  ReadField90(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(); err != nil {
+  return thrift.PrependError("error reading field 90: ", err)
+} else {
+  p.TerminationProtected = &v
+}
+  return nil
+}
+
 func (p *RegisterDomainRequest) Write(oprot thrift.TProtocol) error {
   if err := oprot.WriteStructBegin("RegisterDomainRequest"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
@@ -16036,6 +16882,10 @@ func (p *RegisterDomainRequest) Write(oprot thrift.TProtocol) error {
     if err := p.writeField30(oprot); err != nil { return err }
     if err := p.writeField40(oprot); err != nil { return err }
     if err := p.writeField50(oprot); err != nil { return err }
+    if err := p.writeField60(oprot); err != nil { return err }
+    if err := p.writeField70(oprot); err != nil { return err }
+    if err := p.writeField80(oprot); err != nil { return err }
+    if err := p.writeField90(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -16104,6 +16954,64 @@ func (p *RegisterDomainRequest) writeField50(oprot thrift.TProtocol) (err error)
   return err
 }
 
+func (p *RegisterDomainRequest) writeField60(oprot thrift.TProtocol) (err error) {
+  if p.IsSetArchivalEnabled() {
+    if err := oprot.WriteFieldBegin("archivalEnabled", thrift.BOOL, 60); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:archivalEnabled: ", p), err) }
+    if err := oprot.WriteBool(bool(*p.ArchivalEnabled)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.archivalEnabled (60) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:archivalEnabled: ", p), err) }
+  }
+  return err
+}
+
+func (p *RegisterDomainRequest) writeField70(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDefaultTaskList() {
+    if err := oprot.WriteFieldBegin("defaultTaskList", thrift.STRING, 70); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 70:defaultTaskList: ", p), err) }
+    if err := oprot.WriteString(string(*p.DefaultTaskList)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.defaultTaskList (70) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 70:defaultTaskList: ", p), err) }
+  }
+  return err
+}
+
+func (p *RegisterDomainRequest) writeField80(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTaskListOverrides() {
+    if err := oprot.WriteFieldBegin("taskListOverrides", thrift.MAP, 80); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 80:taskListOverrides: ", p), err) }
+    if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.TaskListOverrides)); err != nil {
+      return thrift.PrependError("error writing map begin: ", err)
+    }
+    for k, v := range p.TaskListOverrides {
+      if err := oprot.WriteString(string(k)); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err) }
+      if err := oprot.WriteString(string(v)); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err) }
+    }
+    if err := oprot.WriteMapEnd(); err != nil {
+      return thrift.PrependError("error writing map end: ", err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 80:taskListOverrides: ", p), err) }
+  }
+  return err
+}
+
+func (p *RegisterDomainRequest) writeField90(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTerminationProtected() {
+    if err := oprot.WriteFieldBegin("terminationProtected", thrift.BOOL, 90); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 90:terminationProtected: ", p), err) }
+    if err := oprot.WriteBool(bool(*p.TerminationProtected)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.terminationProtected (90) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 90:terminationProtected: ", p), err) }
+  }
+  return err
+}
+
 func (p *RegisterDomainRequest) String() string {
   if p == nil {
     return "<nil>"
@@ -16345,6 +17253,7 @@ func (p *DescribeDomainResponse) String() string {
 //  - Name
 //  - UpdatedInfo
 //  - Configuration
+//  - ValidateOnly
 type UpdateDomainRequest struct {
   // unused fields # 1 to 9
   Name *string `thrift:"name,10" db:"name" json:"name,omitempty"`
@@ -16352,6 +17261,8 @@ type UpdateDomainRequest struct {
   UpdatedInfo *UpdateDomainInfo `thrift:"updatedInfo,20" db:"updatedInfo" json:"updatedInfo,omitempty"`
   // unused fields # 21 to 29
   Configuration *DomainConfiguration `thrift:"configuration,30" db:"configuration" json:"configuration,omitempty"`
+  // unused fields # 31 to 39
+  ValidateOnly *bool `thrift:"validateOnly,40" db:"validateOnly" json:"validateOnly,omitempty"`
 }
 
 func NewUpdateDomainRequest() *UpdateDomainRequest {
@@ -16379,6 +17290,13 @@ func (p *UpdateDomainRequest) GetConfiguration() *DomainConfiguration {
   }
 return p.Configuration
 }
+var UpdateDomainRequest_ValidateOnly_DEFAULT bool
+func (p *UpdateDomainRequest) GetValidateOnly() bool {
+  if !p.IsSetValidateOnly() {
+    return UpdateDomainRequest_ValidateOnly_DEFAULT
+  }
+return *p.ValidateOnly
+}
 func (p *UpdateDomainRequest) IsSetName() bool {
   return p.Name != nil
 }
@@ -16391,6 +17309,10 @@ func (p *UpdateDomainRequest) IsSetConfiguration() bool {
   return p.Configuration != nil
 }
 
+func (p *UpdateDomainRequest) IsSetValidateOnly() bool {
+  return p.ValidateOnly != nil
+}
+
 func (p *UpdateDomainRequest) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
@@ -16416,6 +17338,10 @@ func (p *UpdateDomainRequest) Read(iprot thrift.TProtocol) error {
       if err := p.ReadField30(iprot); err != nil {
         return err
       }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -16456,6 +17382,15 @@ func (p *UpdateDomainRequest)  ReadField30(iprot thrift.TProtocol) error {
   return nil
 }
 
+func (p *UpdateDomainRequest)  ReadField40(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(); err != nil {
+  return thrift.PrependError("error reading field 40: ", err)
+} else {
+  p.ValidateOnly = &v
+}
+  return nil
+}
+
 func (p *UpdateDomainRequest) Write(oprot thrift.TProtocol) error {
   if err := oprot.WriteStructBegin("UpdateDomainRequest"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
@@ -16463,6 +17398,7 @@ func (p *UpdateDomainRequest) Write(oprot thrift.TProtocol) error {
     if err := p.writeField10(oprot); err != nil { return err }
     if err := p.writeField20(oprot); err != nil { return err }
     if err := p.writeField30(oprot); err != nil { return err }
+    if err := p.writeField40(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -16509,6 +17445,18 @@ func (p *UpdateDomainRequest) writeField30(oprot thrift.TProtocol) (err error) {
   return err
 }
 
+func (p *UpdateDomainRequest) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetValidateOnly() {
+    if err := oprot.WriteFieldBegin("validateOnly", thrift.BOOL, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:validateOnly: ", p), err) }
+    if err := oprot.WriteBool(bool(*p.ValidateOnly)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.validateOnly (40) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:validateOnly: ", p), err) }
+  }
+  return err
+}
+
 func (p *UpdateDomainRequest) String() string {
   if p == nil {
     return "<nil>"
@@ -16519,11 +17467,14 @@ func (p *UpdateDomainRequest) String() string {
 // Attributes:
 //  - DomainInfo
 //  - Configuration
+//  - Changes
 type UpdateDomainResponse struct {
   // unused fields # 1 to 9
   DomainInfo *DomainInfo `thrift:"domainInfo,10" db:"domainInfo" json:"domainInfo,omitempty"`
   // unused fields # 11 to 19
   Configuration *DomainConfiguration `thrift:"configuration,20" db:"configuration" json:"configuration,omitempty"`
+  // unused fields # 21 to 29
+  Changes []string `thrift:"changes,30" db:"changes" json:"changes,omitempty"`
 }
 
 func NewUpdateDomainResponse() *UpdateDomainResponse {
@@ -16544,6 +17495,11 @@ func (p *UpdateDomainResponse) GetConfiguration() *DomainConfiguration {
   }
 return p.Configuration
 }
+var UpdateDomainResponse_Changes_DEFAULT []string
+
+func (p *UpdateDomainResponse) GetChanges() []string {
+  return p.Changes
+}
 func (p *UpdateDomainResponse) IsSetDomainInfo() bool {
   return p.DomainInfo != nil
 }
@@ -16552,6 +17508,10 @@ func (p *UpdateDomainResponse) IsSetConfiguration() bool {
   return p.Configuration != nil
 }
 
+func (p *UpdateDomainResponse) IsSetChanges() bool {
+  return p.Changes != nil
+}
+
 func (p *UpdateDomainResponse) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
@@ -16573,6 +17533,10 @@ func (p *UpdateDomainResponse) Read(iprot thrift.TProtocol) error {
       if err := p.ReadField20(iprot); err != nil {
         return err
       }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -16604,12 +17568,35 @@ func (p *UpdateDomainResponse)  ReadField20(iprot thrift.TProtocol) error {
   return nil
 }
 
+func (p *UpdateDomainResponse)  ReadField30(iprot thrift.TProtocol) error {
+  _, size, err := iprot.ReadListBegin()
+  if err != nil {
+    return thrift.PrependError("error reading list begin: ", err)
+  }
+  tSlice := make([]string, 0, size)
+  p.Changes =  tSlice
+  for i := 0; i < size; i ++ {
+var _elem8 string
+    if v, err := iprot.ReadString(); err != nil {
+    return thrift.PrependError("error reading field 0: ", err)
+} else {
+    _elem8 = v
+}
+    p.Changes = append(p.Changes, _elem8)
+  }
+  if err := iprot.ReadListEnd(); err != nil {
+    return thrift.PrependError("error reading list end: ", err)
+  }
+  return nil
+}
+
 func (p *UpdateDomainResponse) Write(oprot thrift.TProtocol) error {
   if err := oprot.WriteStructBegin("UpdateDomainResponse"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
     if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -16644,6 +17631,26 @@ func (p *UpdateDomainResponse) writeField20(oprot thrift.TProtocol) (err error)
   return err
 }
 
+func (p *UpdateDomainResponse) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetChanges() {
+    if err := oprot.WriteFieldBegin("changes", thrift.LIST, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:changes: ", p), err) }
+    if err := oprot.WriteListBegin(thrift.STRING, len(p.Changes)); err != nil {
+      return thrift.PrependError("error writing list begin: ", err)
+    }
+    for _, v := range p.Changes {
+      if err := oprot.WriteString(string(v)); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err) }
+    }
+    if err := oprot.WriteListEnd(); err != nil {
+      return thrift.PrependError("error writing list end: ", err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:changes: ", p), err) }
+  }
+  return err
+}
+
 func (p *UpdateDomainResponse) String() string {
   if p == nil {
     return "<nil>"
@@ -16747,138 +17754,84 @@ func (p *DeprecateDomainRequest) String() string {
 }
 
 // Attributes:
-//  - Domain
-//  - WorkflowId
-//  - WorkflowType
-//  - TaskList
-//  - Input
-//  - ExecutionStartToCloseTimeoutSeconds
-//  - TaskStartToCloseTimeoutSeconds
-//  - Identity
-//  - RequestId
-type StartWorkflowExecutionRequest struct {
+//  - NotificationVersion
+//  - ChangeType
+//  - DomainId
+//  - DomainInfo
+//  - Configuration
+type DomainChangeEvent struct {
   // unused fields # 1 to 9
-  Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
+  NotificationVersion *int64 `thrift:"notificationVersion,10" db:"notificationVersion" json:"notificationVersion,omitempty"`
   // unused fields # 11 to 19
-  WorkflowId *string `thrift:"workflowId,20" db:"workflowId" json:"workflowId,omitempty"`
+  ChangeType *DomainChangeType `thrift:"changeType,20" db:"changeType" json:"changeType,omitempty"`
   // unused fields # 21 to 29
-  WorkflowType *WorkflowType `thrift:"workflowType,30" db:"workflowType" json:"workflowType,omitempty"`
+  DomainId *string `thrift:"domainId,30" db:"domainId" json:"domainId,omitempty"`
   // unused fields # 31 to 39
-  TaskList *TaskList `thrift:"taskList,40" db:"taskList" json:"taskList,omitempty"`
+  DomainInfo *DomainInfo `thrift:"domainInfo,40" db:"domainInfo" json:"domainInfo,omitempty"`
   // unused fields # 41 to 49
-  Input []byte `thrift:"input,50" db:"input" json:"input,omitempty"`
-  // unused fields # 51 to 59
-  ExecutionStartToCloseTimeoutSeconds *int32 `thrift:"executionStartToCloseTimeoutSeconds,60" db:"executionStartToCloseTimeoutSeconds" json:"executionStartToCloseTimeoutSeconds,omitempty"`
-  // unused fields # 61 to 69
-  TaskStartToCloseTimeoutSeconds *int32 `thrift:"taskStartToCloseTimeoutSeconds,70" db:"taskStartToCloseTimeoutSeconds" json:"taskStartToCloseTimeoutSeconds,omitempty"`
-  // unused fields # 71 to 79
-  Identity *string `thrift:"identity,80" db:"identity" json:"identity,omitempty"`
-  // unused fields # 81 to 89
-  RequestId *string `thrift:"requestId,90" db:"requestId" json:"requestId,omitempty"`
+  Configuration *DomainConfiguration `thrift:"configuration,50" db:"configuration" json:"configuration,omitempty"`
 }
 
-func NewStartWorkflowExecutionRequest() *StartWorkflowExecutionRequest {
-  return &StartWorkflowExecutionRequest{}
+func NewDomainChangeEvent() *DomainChangeEvent {
+  return &DomainChangeEvent{}
 }
 
-var StartWorkflowExecutionRequest_Domain_DEFAULT string
-func (p *StartWorkflowExecutionRequest) GetDomain() string {
-  if !p.IsSetDomain() {
-    return StartWorkflowExecutionRequest_Domain_DEFAULT
+var DomainChangeEvent_NotificationVersion_DEFAULT int64
+func (p *DomainChangeEvent) GetNotificationVersion() int64 {
+  if !p.IsSetNotificationVersion() {
+    return DomainChangeEvent_NotificationVersion_DEFAULT
   }
-return *p.Domain
+return *p.NotificationVersion
 }
-var StartWorkflowExecutionRequest_WorkflowId_DEFAULT string
-func (p *StartWorkflowExecutionRequest) GetWorkflowId() string {
-  if !p.IsSetWorkflowId() {
-    return StartWorkflowExecutionRequest_WorkflowId_DEFAULT
+var DomainChangeEvent_ChangeType_DEFAULT DomainChangeType
+func (p *DomainChangeEvent) GetChangeType() DomainChangeType {
+  if !p.IsSetChangeType() {
+    return DomainChangeEvent_ChangeType_DEFAULT
   }
-return *p.WorkflowId
+return *p.ChangeType
 }
-var StartWorkflowExecutionRequest_WorkflowType_DEFAULT *WorkflowType
-func (p *StartWorkflowExecutionRequest) GetWorkflowType() *WorkflowType {
-  if !p.IsSetWorkflowType() {
-    return StartWorkflowExecutionRequest_WorkflowType_DEFAULT
+var DomainChangeEvent_DomainId_DEFAULT string
+func (p *DomainChangeEvent) GetDomainId() string {
+  if !p.IsSetDomainId() {
+    return DomainChangeEvent_DomainId_DEFAULT
   }
-return p.WorkflowType
+return *p.DomainId
 }
-var StartWorkflowExecutionRequest_TaskList_DEFAULT *TaskList
-func (p *StartWorkflowExecutionRequest) GetTaskList() *TaskList {
-  if !p.IsSetTaskList() {
-    return StartWorkflowExecutionRequest_TaskList_DEFAULT
+var DomainChangeEvent_DomainInfo_DEFAULT *DomainInfo
+func (p *DomainChangeEvent) GetDomainInfo() *DomainInfo {
+  if !p.IsSetDomainInfo() {
+    return DomainChangeEvent_DomainInfo_DEFAULT
   }
-return p.TaskList
+return p.DomainInfo
+}
+var DomainChangeEvent_Configuration_DEFAULT *DomainConfiguration
+func (p *DomainChangeEvent) GetConfiguration() *DomainConfiguration {
+  if !p.IsSetConfiguration() {
+    return DomainChangeEvent_Configuration_DEFAULT
+  }
+return p.Configuration
+}
+func (p *DomainChangeEvent) IsSetNotificationVersion() bool {
+  return p.NotificationVersion != nil
 }
-var StartWorkflowExecutionRequest_Input_DEFAULT []byte
 
-func (p *StartWorkflowExecutionRequest) GetInput() []byte {
-  return p.Input
+func (p *DomainChangeEvent) IsSetChangeType() bool {
+  return p.ChangeType != nil
 }
-var StartWorkflowExecutionRequest_ExecutionStartToCloseTimeoutSeconds_DEFAULT int32
-func (p *StartWorkflowExecutionRequest) GetExecutionStartToCloseTimeoutSeconds() int32 {
-  if !p.IsSetExecutionStartToCloseTimeoutSeconds() {
-    return StartWorkflowExecutionRequest_ExecutionStartToCloseTimeoutSeconds_DEFAULT
-  }
-return *p.ExecutionStartToCloseTimeoutSeconds
+
+func (p *DomainChangeEvent) IsSetDomainId() bool {
+  return p.DomainId != nil
 }
-var StartWorkflowExecutionRequest_TaskStartToCloseTimeoutSeconds_DEFAULT int32
-func (p *StartWorkflowExecutionRequest) GetTaskStartToCloseTimeoutSeconds() int32 {
-  if !p.IsSetTaskStartToCloseTimeoutSeconds() {
-    return StartWorkflowExecutionRequest_TaskStartToCloseTimeoutSeconds_DEFAULT
-  }
-return *p.TaskStartToCloseTimeoutSeconds
-}
-var StartWorkflowExecutionRequest_Identity_DEFAULT string
-func (p *StartWorkflowExecutionRequest) GetIdentity() string {
-  if !p.IsSetIdentity() {
-    return StartWorkflowExecutionRequest_Identity_DEFAULT
-  }
-return *p.Identity
-}
-var StartWorkflowExecutionRequest_RequestId_DEFAULT string
-func (p *StartWorkflowExecutionRequest) GetRequestId() string {
-  if !p.IsSetRequestId() {
-    return StartWorkflowExecutionRequest_RequestId_DEFAULT
-  }
-return *p.RequestId
-}
-func (p *StartWorkflowExecutionRequest) IsSetDomain() bool {
-  return p.Domain != nil
-}
-
-func (p *StartWorkflowExecutionRequest) IsSetWorkflowId() bool {
-  return p.WorkflowId != nil
-}
-
-func (p *StartWorkflowExecutionRequest) IsSetWorkflowType() bool {
-  return p.WorkflowType != nil
-}
-
-func (p *StartWorkflowExecutionRequest) IsSetTaskList() bool {
-  return p.TaskList != nil
-}
-
-func (p *StartWorkflowExecutionRequest) IsSetInput() bool {
-  return p.Input != nil
+
+func (p *DomainChangeEvent) IsSetDomainInfo() bool {
+  return p.DomainInfo != nil
 }
 
-func (p *StartWorkflowExecutionRequest) IsSetExecutionStartToCloseTimeoutSeconds() bool {
-  return p.ExecutionStartToCloseTimeoutSeconds != nil
-}
-
-func (p *StartWorkflowExecutionRequest) IsSetTaskStartToCloseTimeoutSeconds() bool {
-  return p.TaskStartToCloseTimeoutSeconds != nil
-}
-
-func (p *StartWorkflowExecutionRequest) IsSetIdentity() bool {
-  return p.Identity != nil
-}
-
-func (p *StartWorkflowExecutionRequest) IsSetRequestId() bool {
-  return p.RequestId != nil
+func (p *DomainChangeEvent) IsSetConfiguration() bool {
+  return p.Configuration != nil
 }
 
-func (p *StartWorkflowExecutionRequest) Read(iprot thrift.TProtocol) error {
+func (p *DomainChangeEvent) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -16911,22 +17864,6 @@ func (p *StartWorkflowExecutionRequest) Read(iprot thrift.TProtocol) error {
       if err := p.ReadField50(iprot); err != nil {
         return err
       }
-    case 60:
-      if err := p.ReadField60(iprot); err != nil {
-        return err
-      }
-    case 70:
-      if err := p.ReadField70(iprot); err != nil {
-        return err
-      }
-    case 80:
-      if err := p.ReadField80(iprot); err != nil {
-        return err
-      }
-    case 90:
-      if err := p.ReadField90(iprot); err != nil {
-        return err
-      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -16942,87 +17879,52 @@ func (p *StartWorkflowExecutionRequest) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *StartWorkflowExecutionRequest)  ReadField10(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
+func (p *DomainChangeEvent)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
   return thrift.PrependError("error reading field 10: ", err)
 } else {
-  p.Domain = &v
-}
-  return nil
-}
-
-func (p *StartWorkflowExecutionRequest)  ReadField20(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 20: ", err)
-} else {
-  p.WorkflowId = &v
-}
-  return nil
-}
-
-func (p *StartWorkflowExecutionRequest)  ReadField30(iprot thrift.TProtocol) error {
-  p.WorkflowType = &WorkflowType{}
-  if err := p.WorkflowType.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowType), err)
-  }
-  return nil
-}
-
-func (p *StartWorkflowExecutionRequest)  ReadField40(iprot thrift.TProtocol) error {
-  p.TaskList = &TaskList{}
-  if err := p.TaskList.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TaskList), err)
-  }
-  return nil
-}
-
-func (p *StartWorkflowExecutionRequest)  ReadField50(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBinary(); err != nil {
-  return thrift.PrependError("error reading field 50: ", err)
-} else {
-  p.Input = v
+  p.NotificationVersion = &v
 }
   return nil
 }
 
-func (p *StartWorkflowExecutionRequest)  ReadField60(iprot thrift.TProtocol) error {
+func (p *DomainChangeEvent)  ReadField20(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadI32(); err != nil {
-  return thrift.PrependError("error reading field 60: ", err)
+  return thrift.PrependError("error reading field 20: ", err)
 } else {
-  p.ExecutionStartToCloseTimeoutSeconds = &v
+  temp := DomainChangeType(v)
+  p.ChangeType = &temp
 }
   return nil
 }
 
-func (p *StartWorkflowExecutionRequest)  ReadField70(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI32(); err != nil {
-  return thrift.PrependError("error reading field 70: ", err)
+func (p *DomainChangeEvent)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
 } else {
-  p.TaskStartToCloseTimeoutSeconds = &v
+  p.DomainId = &v
 }
   return nil
 }
 
-func (p *StartWorkflowExecutionRequest)  ReadField80(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 80: ", err)
-} else {
-  p.Identity = &v
-}
+func (p *DomainChangeEvent)  ReadField40(iprot thrift.TProtocol) error {
+  p.DomainInfo = &DomainInfo{}
+  if err := p.DomainInfo.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DomainInfo), err)
+  }
   return nil
 }
 
-func (p *StartWorkflowExecutionRequest)  ReadField90(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 90: ", err)
-} else {
-  p.RequestId = &v
-}
+func (p *DomainChangeEvent)  ReadField50(iprot thrift.TProtocol) error {
+  p.Configuration = &DomainConfiguration{}
+  if err := p.Configuration.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Configuration), err)
+  }
   return nil
 }
 
-func (p *StartWorkflowExecutionRequest) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("StartWorkflowExecutionRequest"); err != nil {
+func (p *DomainChangeEvent) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("DomainChangeEvent"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
@@ -17030,10 +17932,6 @@ func (p *StartWorkflowExecutionRequest) Write(oprot thrift.TProtocol) error {
     if err := p.writeField30(oprot); err != nil { return err }
     if err := p.writeField40(oprot); err != nil { return err }
     if err := p.writeField50(oprot); err != nil { return err }
-    if err := p.writeField60(oprot); err != nil { return err }
-    if err := p.writeField70(oprot); err != nil { return err }
-    if err := p.writeField80(oprot); err != nil { return err }
-    if err := p.writeField90(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -17042,146 +17940,113 @@ func (p *StartWorkflowExecutionRequest) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *StartWorkflowExecutionRequest) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetDomain() {
-    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
-    if err := oprot.WriteString(string(*p.Domain)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.domain (10) field write error: ", p), err) }
+func (p *DomainChangeEvent) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetNotificationVersion() {
+    if err := oprot.WriteFieldBegin("notificationVersion", thrift.I64, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:notificationVersion: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.NotificationVersion)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.notificationVersion (10) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domain: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:notificationVersion: ", p), err) }
   }
   return err
 }
 
-func (p *StartWorkflowExecutionRequest) writeField20(oprot thrift.TProtocol) (err error) {
-  if p.IsSetWorkflowId() {
-    if err := oprot.WriteFieldBegin("workflowId", thrift.STRING, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:workflowId: ", p), err) }
-    if err := oprot.WriteString(string(*p.WorkflowId)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.workflowId (20) field write error: ", p), err) }
+func (p *DomainChangeEvent) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetChangeType() {
+    if err := oprot.WriteFieldBegin("changeType", thrift.I32, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:changeType: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.ChangeType)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.changeType (20) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:workflowId: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:changeType: ", p), err) }
   }
   return err
 }
 
-func (p *StartWorkflowExecutionRequest) writeField30(oprot thrift.TProtocol) (err error) {
-  if p.IsSetWorkflowType() {
-    if err := oprot.WriteFieldBegin("workflowType", thrift.STRUCT, 30); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:workflowType: ", p), err) }
-    if err := p.WorkflowType.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowType), err)
-    }
+func (p *DomainChangeEvent) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomainId() {
+    if err := oprot.WriteFieldBegin("domainId", thrift.STRING, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:domainId: ", p), err) }
+    if err := oprot.WriteString(string(*p.DomainId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domainId (30) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:workflowType: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:domainId: ", p), err) }
   }
   return err
 }
 
-func (p *StartWorkflowExecutionRequest) writeField40(oprot thrift.TProtocol) (err error) {
-  if p.IsSetTaskList() {
-    if err := oprot.WriteFieldBegin("taskList", thrift.STRUCT, 40); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:taskList: ", p), err) }
-    if err := p.TaskList.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TaskList), err)
+func (p *DomainChangeEvent) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomainInfo() {
+    if err := oprot.WriteFieldBegin("domainInfo", thrift.STRUCT, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:domainInfo: ", p), err) }
+    if err := p.DomainInfo.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DomainInfo), err)
     }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:taskList: ", p), err) }
-  }
-  return err
-}
-
-func (p *StartWorkflowExecutionRequest) writeField50(oprot thrift.TProtocol) (err error) {
-  if p.IsSetInput() {
-    if err := oprot.WriteFieldBegin("input", thrift.STRING, 50); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:input: ", p), err) }
-    if err := oprot.WriteBinary(p.Input); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.input (50) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:input: ", p), err) }
-  }
-  return err
-}
-
-func (p *StartWorkflowExecutionRequest) writeField60(oprot thrift.TProtocol) (err error) {
-  if p.IsSetExecutionStartToCloseTimeoutSeconds() {
-    if err := oprot.WriteFieldBegin("executionStartToCloseTimeoutSeconds", thrift.I32, 60); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:executionStartToCloseTimeoutSeconds: ", p), err) }
-    if err := oprot.WriteI32(int32(*p.ExecutionStartToCloseTimeoutSeconds)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.executionStartToCloseTimeoutSeconds (60) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:executionStartToCloseTimeoutSeconds: ", p), err) }
-  }
-  return err
-}
-
-func (p *StartWorkflowExecutionRequest) writeField70(oprot thrift.TProtocol) (err error) {
-  if p.IsSetTaskStartToCloseTimeoutSeconds() {
-    if err := oprot.WriteFieldBegin("taskStartToCloseTimeoutSeconds", thrift.I32, 70); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 70:taskStartToCloseTimeoutSeconds: ", p), err) }
-    if err := oprot.WriteI32(int32(*p.TaskStartToCloseTimeoutSeconds)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.taskStartToCloseTimeoutSeconds (70) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 70:taskStartToCloseTimeoutSeconds: ", p), err) }
-  }
-  return err
-}
-
-func (p *StartWorkflowExecutionRequest) writeField80(oprot thrift.TProtocol) (err error) {
-  if p.IsSetIdentity() {
-    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 80); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 80:identity: ", p), err) }
-    if err := oprot.WriteString(string(*p.Identity)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.identity (80) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 80:identity: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:domainInfo: ", p), err) }
   }
   return err
 }
 
-func (p *StartWorkflowExecutionRequest) writeField90(oprot thrift.TProtocol) (err error) {
-  if p.IsSetRequestId() {
-    if err := oprot.WriteFieldBegin("requestId", thrift.STRING, 90); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 90:requestId: ", p), err) }
-    if err := oprot.WriteString(string(*p.RequestId)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.requestId (90) field write error: ", p), err) }
+func (p *DomainChangeEvent) writeField50(oprot thrift.TProtocol) (err error) {
+  if p.IsSetConfiguration() {
+    if err := oprot.WriteFieldBegin("configuration", thrift.STRUCT, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:configuration: ", p), err) }
+    if err := p.Configuration.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Configuration), err)
+    }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 90:requestId: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:configuration: ", p), err) }
   }
   return err
 }
 
-func (p *StartWorkflowExecutionRequest) String() string {
+func (p *DomainChangeEvent) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("StartWorkflowExecutionRequest(%+v)", *p)
+  return fmt.Sprintf("DomainChangeEvent(%+v)", *p)
 }
 
+
 // Attributes:
-//  - RunId
-type StartWorkflowExecutionResponse struct {
+//  - LastNotificationVersion
+//  - MaximumPageSize
+type GetDomainReplicationMessagesRequest struct {
   // unused fields # 1 to 9
-  RunId *string `thrift:"runId,10" db:"runId" json:"runId,omitempty"`
+  LastNotificationVersion *int64 `thrift:"lastNotificationVersion,10" db:"lastNotificationVersion" json:"lastNotificationVersion,omitempty"`
+  // unused fields # 11 to 19
+  MaximumPageSize *int32 `thrift:"maximumPageSize,20" db:"maximumPageSize" json:"maximumPageSize,omitempty"`
 }
 
-func NewStartWorkflowExecutionResponse() *StartWorkflowExecutionResponse {
-  return &StartWorkflowExecutionResponse{}
+func NewGetDomainReplicationMessagesRequest() *GetDomainReplicationMessagesRequest {
+  return &GetDomainReplicationMessagesRequest{}
 }
 
-var StartWorkflowExecutionResponse_RunId_DEFAULT string
-func (p *StartWorkflowExecutionResponse) GetRunId() string {
-  if !p.IsSetRunId() {
-    return StartWorkflowExecutionResponse_RunId_DEFAULT
+var GetDomainReplicationMessagesRequest_LastNotificationVersion_DEFAULT int64
+func (p *GetDomainReplicationMessagesRequest) GetLastNotificationVersion() int64 {
+  if !p.IsSetLastNotificationVersion() {
+    return GetDomainReplicationMessagesRequest_LastNotificationVersion_DEFAULT
   }
-return *p.RunId
+return *p.LastNotificationVersion
 }
-func (p *StartWorkflowExecutionResponse) IsSetRunId() bool {
-  return p.RunId != nil
+var GetDomainReplicationMessagesRequest_MaximumPageSize_DEFAULT int32
+func (p *GetDomainReplicationMessagesRequest) GetMaximumPageSize() int32 {
+  if !p.IsSetMaximumPageSize() {
+    return GetDomainReplicationMessagesRequest_MaximumPageSize_DEFAULT
+  }
+return *p.MaximumPageSize
+}
+func (p *GetDomainReplicationMessagesRequest) IsSetLastNotificationVersion() bool {
+  return p.LastNotificationVersion != nil
 }
 
-func (p *StartWorkflowExecutionResponse) Read(iprot thrift.TProtocol) error {
+func (p *GetDomainReplicationMessagesRequest) IsSetMaximumPageSize() bool {
+  return p.MaximumPageSize != nil
+}
+
+func (p *GetDomainReplicationMessagesRequest) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -17198,7 +18063,11 @@ func (p *StartWorkflowExecutionResponse) Read(iprot thrift.TProtocol) error {
       if err := p.ReadField10(iprot); err != nil {
         return err
       }
-    default:
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
       }
@@ -17213,20 +18082,30 @@ func (p *StartWorkflowExecutionResponse) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *StartWorkflowExecutionResponse)  ReadField10(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
+func (p *GetDomainReplicationMessagesRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
   return thrift.PrependError("error reading field 10: ", err)
 } else {
-  p.RunId = &v
+  p.LastNotificationVersion = &v
 }
   return nil
 }
 
-func (p *StartWorkflowExecutionResponse) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("StartWorkflowExecutionResponse"); err != nil {
+func (p *GetDomainReplicationMessagesRequest)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 20: ", err)
+} else {
+  p.MaximumPageSize = &v
+}
+  return nil
+}
+
+func (p *GetDomainReplicationMessagesRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetDomainReplicationMessagesRequest"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -17235,76 +18114,73 @@ func (p *StartWorkflowExecutionResponse) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *StartWorkflowExecutionResponse) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetRunId() {
-    if err := oprot.WriteFieldBegin("runId", thrift.STRING, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:runId: ", p), err) }
-    if err := oprot.WriteString(string(*p.RunId)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.runId (10) field write error: ", p), err) }
+func (p *GetDomainReplicationMessagesRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetLastNotificationVersion() {
+    if err := oprot.WriteFieldBegin("lastNotificationVersion", thrift.I64, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:lastNotificationVersion: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.LastNotificationVersion)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.lastNotificationVersion (10) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:runId: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:lastNotificationVersion: ", p), err) }
   }
   return err
 }
 
-func (p *StartWorkflowExecutionResponse) String() string {
+func (p *GetDomainReplicationMessagesRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetMaximumPageSize() {
+    if err := oprot.WriteFieldBegin("maximumPageSize", thrift.I32, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:maximumPageSize: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.MaximumPageSize)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.maximumPageSize (20) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:maximumPageSize: ", p), err) }
+  }
+  return err
+}
+
+func (p *GetDomainReplicationMessagesRequest) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("StartWorkflowExecutionResponse(%+v)", *p)
+  return fmt.Sprintf("GetDomainReplicationMessagesRequest(%+v)", *p)
 }
 
+
 // Attributes:
-//  - Domain
-//  - TaskList
-//  - Identity
-type PollForDecisionTaskRequest struct {
+//  - Events
+//  - LastNotificationVersion
+type GetDomainReplicationMessagesResponse struct {
   // unused fields # 1 to 9
-  Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
+  Events []*DomainChangeEvent `thrift:"events,10" db:"events" json:"events,omitempty"`
   // unused fields # 11 to 19
-  TaskList *TaskList `thrift:"taskList,20" db:"taskList" json:"taskList,omitempty"`
-  // unused fields # 21 to 29
-  Identity *string `thrift:"identity,30" db:"identity" json:"identity,omitempty"`
+  LastNotificationVersion *int64 `thrift:"lastNotificationVersion,20" db:"lastNotificationVersion" json:"lastNotificationVersion,omitempty"`
 }
 
-func NewPollForDecisionTaskRequest() *PollForDecisionTaskRequest {
-  return &PollForDecisionTaskRequest{}
+func NewGetDomainReplicationMessagesResponse() *GetDomainReplicationMessagesResponse {
+  return &GetDomainReplicationMessagesResponse{}
 }
 
-var PollForDecisionTaskRequest_Domain_DEFAULT string
-func (p *PollForDecisionTaskRequest) GetDomain() string {
-  if !p.IsSetDomain() {
-    return PollForDecisionTaskRequest_Domain_DEFAULT
-  }
-return *p.Domain
-}
-var PollForDecisionTaskRequest_TaskList_DEFAULT *TaskList
-func (p *PollForDecisionTaskRequest) GetTaskList() *TaskList {
-  if !p.IsSetTaskList() {
-    return PollForDecisionTaskRequest_TaskList_DEFAULT
-  }
-return p.TaskList
+var GetDomainReplicationMessagesResponse_Events_DEFAULT []*DomainChangeEvent
+
+func (p *GetDomainReplicationMessagesResponse) GetEvents() []*DomainChangeEvent {
+  return p.Events
 }
-var PollForDecisionTaskRequest_Identity_DEFAULT string
-func (p *PollForDecisionTaskRequest) GetIdentity() string {
-  if !p.IsSetIdentity() {
-    return PollForDecisionTaskRequest_Identity_DEFAULT
+var GetDomainReplicationMessagesResponse_LastNotificationVersion_DEFAULT int64
+func (p *GetDomainReplicationMessagesResponse) GetLastNotificationVersion() int64 {
+  if !p.IsSetLastNotificationVersion() {
+    return GetDomainReplicationMessagesResponse_LastNotificationVersion_DEFAULT
   }
-return *p.Identity
+return *p.LastNotificationVersion
 }
-func (p *PollForDecisionTaskRequest) IsSetDomain() bool {
-  return p.Domain != nil
-}
-
-func (p *PollForDecisionTaskRequest) IsSetTaskList() bool {
-  return p.TaskList != nil
+func (p *GetDomainReplicationMessagesResponse) IsSetEvents() bool {
+  return p.Events != nil
 }
 
-func (p *PollForDecisionTaskRequest) IsSetIdentity() bool {
-  return p.Identity != nil
+func (p *GetDomainReplicationMessagesResponse) IsSetLastNotificationVersion() bool {
+  return p.LastNotificationVersion != nil
 }
 
-func (p *PollForDecisionTaskRequest) Read(iprot thrift.TProtocol) error {
+func (p *GetDomainReplicationMessagesResponse) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -17325,10 +18201,6 @@ func (p *PollForDecisionTaskRequest) Read(iprot thrift.TProtocol) error {
       if err := p.ReadField20(iprot); err != nil {
         return err
       }
-    case 30:
-      if err := p.ReadField30(iprot); err != nil {
-        return err
-      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -17344,39 +18216,41 @@ func (p *PollForDecisionTaskRequest) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *PollForDecisionTaskRequest)  ReadField10(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 10: ", err)
-} else {
-  p.Domain = &v
-}
-  return nil
-}
-
-func (p *PollForDecisionTaskRequest)  ReadField20(iprot thrift.TProtocol) error {
-  p.TaskList = &TaskList{}
-  if err := p.TaskList.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TaskList), err)
+func (p *GetDomainReplicationMessagesResponse)  ReadField10(iprot thrift.TProtocol) error {
+  _, size, err := iprot.ReadListBegin()
+  if err != nil {
+    return thrift.PrependError("error reading list begin: ", err)
+  }
+  tSlice := make([]*DomainChangeEvent, 0, size)
+  p.Events =  tSlice
+  for i := 0; i < size; i ++ {
+    _elem7 := &DomainChangeEvent{}
+    if err := _elem7.Read(iprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem7), err)
+    }
+    p.Events = append(p.Events, _elem7)
+  }
+  if err := iprot.ReadListEnd(); err != nil {
+    return thrift.PrependError("error reading list end: ", err)
   }
   return nil
 }
 
-func (p *PollForDecisionTaskRequest)  ReadField30(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 30: ", err)
+func (p *GetDomainReplicationMessagesResponse)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 20: ", err)
 } else {
-  p.Identity = &v
+  p.LastNotificationVersion = &v
 }
   return nil
 }
 
-func (p *PollForDecisionTaskRequest) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("PollForDecisionTaskRequest"); err != nil {
+func (p *GetDomainReplicationMessagesResponse) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetDomainReplicationMessagesResponse"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
     if err := p.writeField20(oprot); err != nil { return err }
-    if err := p.writeField30(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -17385,153 +18259,67 @@ func (p *PollForDecisionTaskRequest) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *PollForDecisionTaskRequest) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetDomain() {
-    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
-    if err := oprot.WriteString(string(*p.Domain)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.domain (10) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domain: ", p), err) }
-  }
-  return err
-}
-
-func (p *PollForDecisionTaskRequest) writeField20(oprot thrift.TProtocol) (err error) {
-  if p.IsSetTaskList() {
-    if err := oprot.WriteFieldBegin("taskList", thrift.STRUCT, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:taskList: ", p), err) }
-    if err := p.TaskList.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TaskList), err)
+func (p *GetDomainReplicationMessagesResponse) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEvents() {
+    if err := oprot.WriteFieldBegin("events", thrift.LIST, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:events: ", p), err) }
+    if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Events)); err != nil {
+      return thrift.PrependError("error writing list begin: ", err)
+    }
+    for _, v := range p.Events {
+      if err := v.Write(oprot); err != nil {
+        return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+      }
+    }
+    if err := oprot.WriteListEnd(); err != nil {
+      return thrift.PrependError("error writing list end: ", err)
     }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:taskList: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:events: ", p), err) }
   }
   return err
 }
 
-func (p *PollForDecisionTaskRequest) writeField30(oprot thrift.TProtocol) (err error) {
-  if p.IsSetIdentity() {
-    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 30); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:identity: ", p), err) }
-    if err := oprot.WriteString(string(*p.Identity)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.identity (30) field write error: ", p), err) }
+func (p *GetDomainReplicationMessagesResponse) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetLastNotificationVersion() {
+    if err := oprot.WriteFieldBegin("lastNotificationVersion", thrift.I64, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:lastNotificationVersion: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.LastNotificationVersion)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.lastNotificationVersion (20) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:identity: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:lastNotificationVersion: ", p), err) }
   }
   return err
 }
 
-func (p *PollForDecisionTaskRequest) String() string {
+func (p *GetDomainReplicationMessagesResponse) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("PollForDecisionTaskRequest(%+v)", *p)
+  return fmt.Sprintf("GetDomainReplicationMessagesResponse(%+v)", *p)
 }
 
 // Attributes:
-//  - TaskToken
-//  - WorkflowExecution
-//  - WorkflowType
-//  - PreviousStartedEventId
-//  - StartedEventId
-//  - History
-//  - NextPageToken
-type PollForDecisionTaskResponse struct {
+//  - IndexedFields
+type SearchAttributes struct {
   // unused fields # 1 to 9
-  TaskToken []byte `thrift:"taskToken,10" db:"taskToken" json:"taskToken,omitempty"`
-  // unused fields # 11 to 19
-  WorkflowExecution *WorkflowExecution `thrift:"workflowExecution,20" db:"workflowExecution" json:"workflowExecution,omitempty"`
-  // unused fields # 21 to 29
-  WorkflowType *WorkflowType `thrift:"workflowType,30" db:"workflowType" json:"workflowType,omitempty"`
-  // unused fields # 31 to 39
-  PreviousStartedEventId *int64 `thrift:"previousStartedEventId,40" db:"previousStartedEventId" json:"previousStartedEventId,omitempty"`
-  // unused fields # 41 to 49
-  StartedEventId *int64 `thrift:"startedEventId,50" db:"startedEventId" json:"startedEventId,omitempty"`
-  // unused fields # 51 to 59
-  History *History `thrift:"history,60" db:"history" json:"history,omitempty"`
-  // unused fields # 61 to 69
-  NextPageToken []byte `thrift:"nextPageToken,70" db:"nextPageToken" json:"nextPageToken,omitempty"`
-}
-
-func NewPollForDecisionTaskResponse() *PollForDecisionTaskResponse {
-  return &PollForDecisionTaskResponse{}
-}
-
-var PollForDecisionTaskResponse_TaskToken_DEFAULT []byte
-
-func (p *PollForDecisionTaskResponse) GetTaskToken() []byte {
-  return p.TaskToken
-}
-var PollForDecisionTaskResponse_WorkflowExecution_DEFAULT *WorkflowExecution
-func (p *PollForDecisionTaskResponse) GetWorkflowExecution() *WorkflowExecution {
-  if !p.IsSetWorkflowExecution() {
-    return PollForDecisionTaskResponse_WorkflowExecution_DEFAULT
-  }
-return p.WorkflowExecution
-}
-var PollForDecisionTaskResponse_WorkflowType_DEFAULT *WorkflowType
-func (p *PollForDecisionTaskResponse) GetWorkflowType() *WorkflowType {
-  if !p.IsSetWorkflowType() {
-    return PollForDecisionTaskResponse_WorkflowType_DEFAULT
-  }
-return p.WorkflowType
-}
-var PollForDecisionTaskResponse_PreviousStartedEventId_DEFAULT int64
-func (p *PollForDecisionTaskResponse) GetPreviousStartedEventId() int64 {
-  if !p.IsSetPreviousStartedEventId() {
-    return PollForDecisionTaskResponse_PreviousStartedEventId_DEFAULT
-  }
-return *p.PreviousStartedEventId
-}
-var PollForDecisionTaskResponse_StartedEventId_DEFAULT int64
-func (p *PollForDecisionTaskResponse) GetStartedEventId() int64 {
-  if !p.IsSetStartedEventId() {
-    return PollForDecisionTaskResponse_StartedEventId_DEFAULT
-  }
-return *p.StartedEventId
-}
-var PollForDecisionTaskResponse_History_DEFAULT *History
-func (p *PollForDecisionTaskResponse) GetHistory() *History {
-  if !p.IsSetHistory() {
-    return PollForDecisionTaskResponse_History_DEFAULT
-  }
-return p.History
-}
-var PollForDecisionTaskResponse_NextPageToken_DEFAULT []byte
-
-func (p *PollForDecisionTaskResponse) GetNextPageToken() []byte {
-  return p.NextPageToken
-}
-func (p *PollForDecisionTaskResponse) IsSetTaskToken() bool {
-  return p.TaskToken != nil
-}
-
-func (p *PollForDecisionTaskResponse) IsSetWorkflowExecution() bool {
-  return p.WorkflowExecution != nil
-}
-
-func (p *PollForDecisionTaskResponse) IsSetWorkflowType() bool {
-  return p.WorkflowType != nil
+  IndexedFields map[string][]byte `thrift:"indexedFields,10" db:"indexedFields" json:"indexedFields,omitempty"`
 }
 
-func (p *PollForDecisionTaskResponse) IsSetPreviousStartedEventId() bool {
-  return p.PreviousStartedEventId != nil
+func NewSearchAttributes() *SearchAttributes {
+  return &SearchAttributes{}
 }
 
-func (p *PollForDecisionTaskResponse) IsSetStartedEventId() bool {
-  return p.StartedEventId != nil
-}
+var SearchAttributes_IndexedFields_DEFAULT map[string][]byte
 
-func (p *PollForDecisionTaskResponse) IsSetHistory() bool {
-  return p.History != nil
+func (p *SearchAttributes) GetIndexedFields() map[string][]byte {
+  return p.IndexedFields
 }
-
-func (p *PollForDecisionTaskResponse) IsSetNextPageToken() bool {
-  return p.NextPageToken != nil
+func (p *SearchAttributes) IsSetIndexedFields() bool {
+  return p.IndexedFields != nil
 }
 
-func (p *PollForDecisionTaskResponse) Read(iprot thrift.TProtocol) error {
+func (p *SearchAttributes) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -17548,9 +18336,5679 @@ func (p *PollForDecisionTaskResponse) Read(iprot thrift.TProtocol) error {
       if err := p.ReadField10(iprot); err != nil {
         return err
       }
-    case 20:
-      if err := p.ReadField20(iprot); err != nil {
-        return err
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *SearchAttributes)  ReadField10(iprot thrift.TProtocol) error {
+  _, _, size, err := iprot.ReadMapBegin()
+  if err != nil {
+    return thrift.PrependError("error reading map begin: ", err)
+  }
+  tMap := make(map[string][]byte, size)
+  p.IndexedFields =  tMap
+  for i := 0; i < size; i ++ {
+    var _elem4 string
+    if v, err := iprot.ReadString(); err != nil {
+    return thrift.PrependError("error reading field 0: ", err)
+} else {
+    _elem4 = v
+}
+    var _elem5 []byte
+    if v, err := iprot.ReadBinary(); err != nil {
+    return thrift.PrependError("error reading field 0: ", err)
+} else {
+    _elem5 = v
+}
+    p.IndexedFields[_elem4] = _elem5
+  }
+  if err := iprot.ReadMapEnd(); err != nil {
+    return thrift.PrependError("error reading map end: ", err)
+  }
+  return nil
+}
+
+func (p *SearchAttributes) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("SearchAttributes"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *SearchAttributes) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetIndexedFields() {
+    if err := oprot.WriteFieldBegin("indexedFields", thrift.MAP, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:indexedFields: ", p), err) }
+    if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.IndexedFields)); err != nil {
+      return thrift.PrependError("error writing map begin: ", err)
+    }
+    for k, v := range p.IndexedFields {
+      if err := oprot.WriteString(string(k)); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err) }
+      if err := oprot.WriteBinary(v); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err) }
+    }
+    if err := oprot.WriteMapEnd(); err != nil {
+      return thrift.PrependError("error writing map end: ", err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:indexedFields: ", p), err) }
+  }
+  return err
+}
+
+func (p *SearchAttributes) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("SearchAttributes(%+v)", *p)
+}
+
+// Attributes:
+//  - Domain
+//  - WorkflowId
+//  - WorkflowType
+//  - TaskList
+//  - Input
+//  - ExecutionStartToCloseTimeoutSeconds
+//  - TaskStartToCloseTimeoutSeconds
+//  - Identity
+//  - RequestId
+//  - SearchAttributes
+//  - CompletionCallbackUrl
+//  - RequestEagerExecution
+//  - TraceId
+type StartWorkflowExecutionRequest struct {
+  // unused fields # 1 to 9
+  Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
+  // unused fields # 11 to 19
+  WorkflowId *string `thrift:"workflowId,20" db:"workflowId" json:"workflowId,omitempty"`
+  // unused fields # 21 to 29
+  WorkflowType *WorkflowType `thrift:"workflowType,30" db:"workflowType" json:"workflowType,omitempty"`
+  // unused fields # 31 to 39
+  TaskList *TaskList `thrift:"taskList,40" db:"taskList" json:"taskList,omitempty"`
+  // unused fields # 41 to 49
+  Input []byte `thrift:"input,50" db:"input" json:"input,omitempty"`
+  // unused fields # 51 to 59
+  ExecutionStartToCloseTimeoutSeconds *int32 `thrift:"executionStartToCloseTimeoutSeconds,60" db:"executionStartToCloseTimeoutSeconds" json:"executionStartToCloseTimeoutSeconds,omitempty"`
+  // unused fields # 61 to 69
+  TaskStartToCloseTimeoutSeconds *int32 `thrift:"taskStartToCloseTimeoutSeconds,70" db:"taskStartToCloseTimeoutSeconds" json:"taskStartToCloseTimeoutSeconds,omitempty"`
+  // unused fields # 71 to 79
+  Identity *string `thrift:"identity,80" db:"identity" json:"identity,omitempty"`
+  // unused fields # 81 to 89
+  RequestId *string `thrift:"requestId,90" db:"requestId" json:"requestId,omitempty"`
+  // unused fields # 91 to 99
+  SearchAttributes *SearchAttributes `thrift:"searchAttributes,100" db:"searchAttributes" json:"searchAttributes,omitempty"`
+  // unused fields # 101 to 109
+  CompletionCallbackUrl *string `thrift:"completionCallbackUrl,110" db:"completionCallbackUrl" json:"completionCallbackUrl,omitempty"`
+  // unused fields # 111 to 119
+  RequestEagerExecution *bool `thrift:"requestEagerExecution,120" db:"requestEagerExecution" json:"requestEagerExecution,omitempty"`
+  // unused fields # 121 to 129
+  TraceId *string `thrift:"traceId,130" db:"traceId" json:"traceId,omitempty"`
+}
+
+func NewStartWorkflowExecutionRequest() *StartWorkflowExecutionRequest {
+  return &StartWorkflowExecutionRequest{}
+}
+
+var StartWorkflowExecutionRequest_Domain_DEFAULT string
+func (p *StartWorkflowExecutionRequest) GetDomain() string {
+  if !p.IsSetDomain() {
+    return StartWorkflowExecutionRequest_Domain_DEFAULT
+  }
+return *p.Domain
+}
+var StartWorkflowExecutionRequest_WorkflowId_DEFAULT string
+func (p *StartWorkflowExecutionRequest) GetWorkflowId() string {
+  if !p.IsSetWorkflowId() {
+    return StartWorkflowExecutionRequest_WorkflowId_DEFAULT
+  }
+return *p.WorkflowId
+}
+var StartWorkflowExecutionRequest_WorkflowType_DEFAULT *WorkflowType
+func (p *StartWorkflowExecutionRequest) GetWorkflowType() *WorkflowType {
+  if !p.IsSetWorkflowType() {
+    return StartWorkflowExecutionRequest_WorkflowType_DEFAULT
+  }
+return p.WorkflowType
+}
+var StartWorkflowExecutionRequest_TaskList_DEFAULT *TaskList
+func (p *StartWorkflowExecutionRequest) GetTaskList() *TaskList {
+  if !p.IsSetTaskList() {
+    return StartWorkflowExecutionRequest_TaskList_DEFAULT
+  }
+return p.TaskList
+}
+var StartWorkflowExecutionRequest_Input_DEFAULT []byte
+
+func (p *StartWorkflowExecutionRequest) GetInput() []byte {
+  return p.Input
+}
+var StartWorkflowExecutionRequest_ExecutionStartToCloseTimeoutSeconds_DEFAULT int32
+func (p *StartWorkflowExecutionRequest) GetExecutionStartToCloseTimeoutSeconds() int32 {
+  if !p.IsSetExecutionStartToCloseTimeoutSeconds() {
+    return StartWorkflowExecutionRequest_ExecutionStartToCloseTimeoutSeconds_DEFAULT
+  }
+return *p.ExecutionStartToCloseTimeoutSeconds
+}
+var StartWorkflowExecutionRequest_TaskStartToCloseTimeoutSeconds_DEFAULT int32
+func (p *StartWorkflowExecutionRequest) GetTaskStartToCloseTimeoutSeconds() int32 {
+  if !p.IsSetTaskStartToCloseTimeoutSeconds() {
+    return StartWorkflowExecutionRequest_TaskStartToCloseTimeoutSeconds_DEFAULT
+  }
+return *p.TaskStartToCloseTimeoutSeconds
+}
+var StartWorkflowExecutionRequest_Identity_DEFAULT string
+func (p *StartWorkflowExecutionRequest) GetIdentity() string {
+  if !p.IsSetIdentity() {
+    return StartWorkflowExecutionRequest_Identity_DEFAULT
+  }
+return *p.Identity
+}
+var StartWorkflowExecutionRequest_RequestId_DEFAULT string
+func (p *StartWorkflowExecutionRequest) GetRequestId() string {
+  if !p.IsSetRequestId() {
+    return StartWorkflowExecutionRequest_RequestId_DEFAULT
+  }
+return *p.RequestId
+}
+var StartWorkflowExecutionRequest_SearchAttributes_DEFAULT *SearchAttributes
+func (p *StartWorkflowExecutionRequest) GetSearchAttributes() *SearchAttributes {
+  if !p.IsSetSearchAttributes() {
+    return StartWorkflowExecutionRequest_SearchAttributes_DEFAULT
+  }
+return p.SearchAttributes
+}
+var StartWorkflowExecutionRequest_CompletionCallbackUrl_DEFAULT string
+func (p *StartWorkflowExecutionRequest) GetCompletionCallbackUrl() string {
+  if !p.IsSetCompletionCallbackUrl() {
+    return StartWorkflowExecutionRequest_CompletionCallbackUrl_DEFAULT
+  }
+return *p.CompletionCallbackUrl
+}
+var StartWorkflowExecutionRequest_RequestEagerExecution_DEFAULT bool
+func (p *StartWorkflowExecutionRequest) GetRequestEagerExecution() bool {
+  if !p.IsSetRequestEagerExecution() {
+    return StartWorkflowExecutionRequest_RequestEagerExecution_DEFAULT
+  }
+return *p.RequestEagerExecution
+}
+var StartWorkflowExecutionRequest_TraceId_DEFAULT string
+func (p *StartWorkflowExecutionRequest) GetTraceId() string {
+  if !p.IsSetTraceId() {
+    return StartWorkflowExecutionRequest_TraceId_DEFAULT
+  }
+return *p.TraceId
+}
+func (p *StartWorkflowExecutionRequest) IsSetDomain() bool {
+  return p.Domain != nil
+}
+
+func (p *StartWorkflowExecutionRequest) IsSetWorkflowId() bool {
+  return p.WorkflowId != nil
+}
+
+func (p *StartWorkflowExecutionRequest) IsSetWorkflowType() bool {
+  return p.WorkflowType != nil
+}
+
+func (p *StartWorkflowExecutionRequest) IsSetTaskList() bool {
+  return p.TaskList != nil
+}
+
+func (p *StartWorkflowExecutionRequest) IsSetInput() bool {
+  return p.Input != nil
+}
+
+func (p *StartWorkflowExecutionRequest) IsSetExecutionStartToCloseTimeoutSeconds() bool {
+  return p.ExecutionStartToCloseTimeoutSeconds != nil
+}
+
+func (p *StartWorkflowExecutionRequest) IsSetTaskStartToCloseTimeoutSeconds() bool {
+  return p.TaskStartToCloseTimeoutSeconds != nil
+}
+
+func (p *StartWorkflowExecutionRequest) IsSetIdentity() bool {
+  return p.Identity != nil
+}
+
+func (p *StartWorkflowExecutionRequest) IsSetRequestId() bool {
+  return p.RequestId != nil
+}
+
+func (p *StartWorkflowExecutionRequest) IsSetSearchAttributes() bool {
+  return p.SearchAttributes != nil
+}
+
+func (p *StartWorkflowExecutionRequest) IsSetCompletionCallbackUrl() bool {
+  return p.CompletionCallbackUrl != nil
+}
+
+func (p *StartWorkflowExecutionRequest) IsSetRequestEagerExecution() bool {
+  return p.RequestEagerExecution != nil
+}
+
+func (p *StartWorkflowExecutionRequest) IsSetTraceId() bool {
+  return p.TraceId != nil
+}
+
+func (p *StartWorkflowExecutionRequest) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
+    case 50:
+      if err := p.ReadField50(iprot); err != nil {
+        return err
+      }
+    case 60:
+      if err := p.ReadField60(iprot); err != nil {
+        return err
+      }
+    case 70:
+      if err := p.ReadField70(iprot); err != nil {
+        return err
+      }
+    case 80:
+      if err := p.ReadField80(iprot); err != nil {
+        return err
+      }
+    case 90:
+      if err := p.ReadField90(iprot); err != nil {
+        return err
+      }
+    case 100:
+      if err := p.ReadField100(iprot); err != nil {
+        return err
+      }
+    case 110:
+      if err := p.ReadField110(iprot); err != nil {
+        return err
+      }
+    case 120:
+      if err := p.ReadField120(iprot); err != nil {
+        return err
+      }
+    case 130:
+      if err := p.ReadField130(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *StartWorkflowExecutionRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.Domain = &v
+}
+  return nil
+}
+
+func (p *StartWorkflowExecutionRequest)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 20: ", err)
+} else {
+  p.WorkflowId = &v
+}
+  return nil
+}
+
+func (p *StartWorkflowExecutionRequest)  ReadField30(iprot thrift.TProtocol) error {
+  p.WorkflowType = &WorkflowType{}
+  if err := p.WorkflowType.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowType), err)
+  }
+  return nil
+}
+
+func (p *StartWorkflowExecutionRequest)  ReadField40(iprot thrift.TProtocol) error {
+  p.TaskList = &TaskList{}
+  if err := p.TaskList.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TaskList), err)
+  }
+  return nil
+}
+
+func (p *StartWorkflowExecutionRequest)  ReadField50(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 50: ", err)
+} else {
+  p.Input = v
+}
+  return nil
+}
+
+func (p *StartWorkflowExecutionRequest)  ReadField60(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 60: ", err)
+} else {
+  p.ExecutionStartToCloseTimeoutSeconds = &v
+}
+  return nil
+}
+
+func (p *StartWorkflowExecutionRequest)  ReadField70(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 70: ", err)
+} else {
+  p.TaskStartToCloseTimeoutSeconds = &v
+}
+  return nil
+}
+
+func (p *StartWorkflowExecutionRequest)  ReadField80(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 80: ", err)
+} else {
+  p.Identity = &v
+}
+  return nil
+}
+
+func (p *StartWorkflowExecutionRequest)  ReadField90(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 90: ", err)
+} else {
+  p.RequestId = &v
+}
+  return nil
+}
+
+func (p *StartWorkflowExecutionRequest)  ReadField100(iprot thrift.TProtocol) error {
+  p.SearchAttributes = &SearchAttributes{}
+  if err := p.SearchAttributes.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.SearchAttributes), err)
+  }
+  return nil
+}
+
+func (p *StartWorkflowExecutionRequest)  ReadField110(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 110: ", err)
+} else {
+  p.CompletionCallbackUrl = &v
+}
+  return nil
+}
+
+func (p *StartWorkflowExecutionRequest)  ReadField120(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(); err != nil {
+  return thrift.PrependError("error reading field 120: ", err)
+} else {
+  p.RequestEagerExecution = &v
+}
+  return nil
+}
+
+func (p *StartWorkflowExecutionRequest)  ReadField130(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 130: ", err)
+} else {
+  p.TraceId = &v
+}
+  return nil
+}
+
+func (p *StartWorkflowExecutionRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("StartWorkflowExecutionRequest"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
+    if err := p.writeField40(oprot); err != nil { return err }
+    if err := p.writeField50(oprot); err != nil { return err }
+    if err := p.writeField60(oprot); err != nil { return err }
+    if err := p.writeField70(oprot); err != nil { return err }
+    if err := p.writeField80(oprot); err != nil { return err }
+    if err := p.writeField90(oprot); err != nil { return err }
+    if err := p.writeField100(oprot); err != nil { return err }
+    if err := p.writeField110(oprot); err != nil { return err }
+    if err := p.writeField120(oprot); err != nil { return err }
+    if err := p.writeField130(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *StartWorkflowExecutionRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomain() {
+    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
+    if err := oprot.WriteString(string(*p.Domain)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domain (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domain: ", p), err) }
+  }
+  return err
+}
+
+func (p *StartWorkflowExecutionRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowId() {
+    if err := oprot.WriteFieldBegin("workflowId", thrift.STRING, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:workflowId: ", p), err) }
+    if err := oprot.WriteString(string(*p.WorkflowId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.workflowId (20) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:workflowId: ", p), err) }
+  }
+  return err
+}
+
+func (p *StartWorkflowExecutionRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowType() {
+    if err := oprot.WriteFieldBegin("workflowType", thrift.STRUCT, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:workflowType: ", p), err) }
+    if err := p.WorkflowType.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowType), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:workflowType: ", p), err) }
+  }
+  return err
+}
+
+func (p *StartWorkflowExecutionRequest) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTaskList() {
+    if err := oprot.WriteFieldBegin("taskList", thrift.STRUCT, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:taskList: ", p), err) }
+    if err := p.TaskList.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TaskList), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:taskList: ", p), err) }
+  }
+  return err
+}
+
+func (p *StartWorkflowExecutionRequest) writeField50(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInput() {
+    if err := oprot.WriteFieldBegin("input", thrift.STRING, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:input: ", p), err) }
+    if err := oprot.WriteBinary(p.Input); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.input (50) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:input: ", p), err) }
+  }
+  return err
+}
+
+func (p *StartWorkflowExecutionRequest) writeField60(oprot thrift.TProtocol) (err error) {
+  if p.IsSetExecutionStartToCloseTimeoutSeconds() {
+    if err := oprot.WriteFieldBegin("executionStartToCloseTimeoutSeconds", thrift.I32, 60); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:executionStartToCloseTimeoutSeconds: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.ExecutionStartToCloseTimeoutSeconds)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.executionStartToCloseTimeoutSeconds (60) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:executionStartToCloseTimeoutSeconds: ", p), err) }
+  }
+  return err
+}
+
+func (p *StartWorkflowExecutionRequest) writeField70(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTaskStartToCloseTimeoutSeconds() {
+    if err := oprot.WriteFieldBegin("taskStartToCloseTimeoutSeconds", thrift.I32, 70); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 70:taskStartToCloseTimeoutSeconds: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.TaskStartToCloseTimeoutSeconds)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.taskStartToCloseTimeoutSeconds (70) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 70:taskStartToCloseTimeoutSeconds: ", p), err) }
+  }
+  return err
+}
+
+func (p *StartWorkflowExecutionRequest) writeField80(oprot thrift.TProtocol) (err error) {
+  if p.IsSetIdentity() {
+    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 80); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 80:identity: ", p), err) }
+    if err := oprot.WriteString(string(*p.Identity)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.identity (80) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 80:identity: ", p), err) }
+  }
+  return err
+}
+
+func (p *StartWorkflowExecutionRequest) writeField90(oprot thrift.TProtocol) (err error) {
+  if p.IsSetRequestId() {
+    if err := oprot.WriteFieldBegin("requestId", thrift.STRING, 90); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 90:requestId: ", p), err) }
+    if err := oprot.WriteString(string(*p.RequestId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.requestId (90) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 90:requestId: ", p), err) }
+  }
+  return err
+}
+
+func (p *StartWorkflowExecutionRequest) writeField100(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSearchAttributes() {
+    if err := oprot.WriteFieldBegin("searchAttributes", thrift.STRUCT, 100); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 100:searchAttributes: ", p), err) }
+    if err := p.SearchAttributes.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.SearchAttributes), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 100:searchAttributes: ", p), err) }
+  }
+  return err
+}
+
+func (p *StartWorkflowExecutionRequest) writeField110(oprot thrift.TProtocol) (err error) {
+  if p.IsSetCompletionCallbackUrl() {
+    if err := oprot.WriteFieldBegin("completionCallbackUrl", thrift.STRING, 110); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 110:completionCallbackUrl: ", p), err) }
+    if err := oprot.WriteString(string(*p.CompletionCallbackUrl)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.completionCallbackUrl (110) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 110:completionCallbackUrl: ", p), err) }
+  }
+  return err
+}
+
+func (p *StartWorkflowExecutionRequest) writeField120(oprot thrift.TProtocol) (err error) {
+  if p.IsSetRequestEagerExecution() {
+    if err := oprot.WriteFieldBegin("requestEagerExecution", thrift.BOOL, 120); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 120:requestEagerExecution: ", p), err) }
+    if err := oprot.WriteBool(bool(*p.RequestEagerExecution)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.requestEagerExecution (120) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 120:requestEagerExecution: ", p), err) }
+  }
+  return err
+}
+
+func (p *StartWorkflowExecutionRequest) writeField130(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTraceId() {
+    if err := oprot.WriteFieldBegin("traceId", thrift.STRING, 130); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 130:traceId: ", p), err) }
+    if err := oprot.WriteString(string(*p.TraceId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.traceId (130) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 130:traceId: ", p), err) }
+  }
+  return err
+}
+
+func (p *StartWorkflowExecutionRequest) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("StartWorkflowExecutionRequest(%+v)", *p)
+}
+
+
+// Attributes:
+//  - MaxTasksPerSecond
+type TaskListMetadata struct {
+  // unused fields # 1 to 9
+  MaxTasksPerSecond *float64 `thrift:"maxTasksPerSecond,10" db:"maxTasksPerSecond" json:"maxTasksPerSecond,omitempty"`
+}
+
+func NewTaskListMetadata() *TaskListMetadata {
+  return &TaskListMetadata{}
+}
+
+var TaskListMetadata_MaxTasksPerSecond_DEFAULT float64
+func (p *TaskListMetadata) GetMaxTasksPerSecond() float64 {
+  if !p.IsSetMaxTasksPerSecond() {
+    return TaskListMetadata_MaxTasksPerSecond_DEFAULT
+  }
+return *p.MaxTasksPerSecond
+}
+func (p *TaskListMetadata) IsSetMaxTasksPerSecond() bool {
+  return p.MaxTasksPerSecond != nil
+}
+
+func (p *TaskListMetadata) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *TaskListMetadata)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadDouble(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.MaxTasksPerSecond = &v
+}
+  return nil
+}
+
+func (p *TaskListMetadata) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("TaskListMetadata"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *TaskListMetadata) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetMaxTasksPerSecond() {
+    if err := oprot.WriteFieldBegin("maxTasksPerSecond", thrift.DOUBLE, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:maxTasksPerSecond: ", p), err) }
+    if err := oprot.WriteDouble(float64(*p.MaxTasksPerSecond)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.maxTasksPerSecond (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:maxTasksPerSecond: ", p), err) }
+  }
+  return err
+}
+
+func (p *TaskListMetadata) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("TaskListMetadata(%+v)", *p)
+}
+
+// Attributes:
+//  - Domain
+//  - TaskList
+//  - Identity
+//  - TaskListMetadata
+type PollForDecisionTaskRequest struct {
+  // unused fields # 1 to 9
+  Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
+  // unused fields # 11 to 19
+  TaskList *TaskList `thrift:"taskList,20" db:"taskList" json:"taskList,omitempty"`
+  // unused fields # 21 to 29
+  Identity *string `thrift:"identity,30" db:"identity" json:"identity,omitempty"`
+  // unused fields # 31 to 39
+  TaskListMetadata *TaskListMetadata `thrift:"taskListMetadata,40" db:"taskListMetadata" json:"taskListMetadata,omitempty"`
+}
+
+func NewPollForDecisionTaskRequest() *PollForDecisionTaskRequest {
+  return &PollForDecisionTaskRequest{}
+}
+
+var PollForDecisionTaskRequest_Domain_DEFAULT string
+func (p *PollForDecisionTaskRequest) GetDomain() string {
+  if !p.IsSetDomain() {
+    return PollForDecisionTaskRequest_Domain_DEFAULT
+  }
+return *p.Domain
+}
+var PollForDecisionTaskRequest_TaskList_DEFAULT *TaskList
+func (p *PollForDecisionTaskRequest) GetTaskList() *TaskList {
+  if !p.IsSetTaskList() {
+    return PollForDecisionTaskRequest_TaskList_DEFAULT
+  }
+return p.TaskList
+}
+var PollForDecisionTaskRequest_Identity_DEFAULT string
+func (p *PollForDecisionTaskRequest) GetIdentity() string {
+  if !p.IsSetIdentity() {
+    return PollForDecisionTaskRequest_Identity_DEFAULT
+  }
+return *p.Identity
+}
+var PollForDecisionTaskRequest_TaskListMetadata_DEFAULT *TaskListMetadata
+func (p *PollForDecisionTaskRequest) GetTaskListMetadata() *TaskListMetadata {
+  if !p.IsSetTaskListMetadata() {
+    return PollForDecisionTaskRequest_TaskListMetadata_DEFAULT
+  }
+return p.TaskListMetadata
+}
+func (p *PollForDecisionTaskRequest) IsSetDomain() bool {
+  return p.Domain != nil
+}
+
+func (p *PollForDecisionTaskRequest) IsSetTaskList() bool {
+  return p.TaskList != nil
+}
+
+func (p *PollForDecisionTaskRequest) IsSetIdentity() bool {
+  return p.Identity != nil
+}
+
+func (p *PollForDecisionTaskRequest) IsSetTaskListMetadata() bool {
+  return p.TaskListMetadata != nil
+}
+
+func (p *PollForDecisionTaskRequest) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *PollForDecisionTaskRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.Domain = &v
+}
+  return nil
+}
+
+func (p *PollForDecisionTaskRequest)  ReadField20(iprot thrift.TProtocol) error {
+  p.TaskList = &TaskList{}
+  if err := p.TaskList.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TaskList), err)
+  }
+  return nil
+}
+
+func (p *PollForDecisionTaskRequest)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
+} else {
+  p.Identity = &v
+}
+  return nil
+}
+
+func (p *PollForDecisionTaskRequest)  ReadField40(iprot thrift.TProtocol) error {
+  p.TaskListMetadata = &TaskListMetadata{}
+  if err := p.TaskListMetadata.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TaskListMetadata), err)
+  }
+  return nil
+}
+
+func (p *PollForDecisionTaskRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("PollForDecisionTaskRequest"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
+    if err := p.writeField40(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *PollForDecisionTaskRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomain() {
+    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
+    if err := oprot.WriteString(string(*p.Domain)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domain (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domain: ", p), err) }
+  }
+  return err
+}
+
+func (p *PollForDecisionTaskRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTaskList() {
+    if err := oprot.WriteFieldBegin("taskList", thrift.STRUCT, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:taskList: ", p), err) }
+    if err := p.TaskList.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TaskList), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:taskList: ", p), err) }
+  }
+  return err
+}
+
+func (p *PollForDecisionTaskRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetIdentity() {
+    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:identity: ", p), err) }
+    if err := oprot.WriteString(string(*p.Identity)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.identity (30) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:identity: ", p), err) }
+  }
+  return err
+}
+
+func (p *PollForDecisionTaskRequest) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTaskListMetadata() {
+    if err := oprot.WriteFieldBegin("taskListMetadata", thrift.STRUCT, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:taskListMetadata: ", p), err) }
+    if err := p.TaskListMetadata.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TaskListMetadata), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:taskListMetadata: ", p), err) }
+  }
+  return err
+}
+
+func (p *PollForDecisionTaskRequest) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("PollForDecisionTaskRequest(%+v)", *p)
+}
+
+// Attributes:
+//  - QueryType
+//  - QueryArgs
+type WorkflowQuery struct {
+  // unused fields # 1 to 9
+  QueryType *string `thrift:"queryType,10" db:"queryType" json:"queryType,omitempty"`
+  // unused fields # 11 to 19
+  QueryArgs []byte `thrift:"queryArgs,20" db:"queryArgs" json:"queryArgs,omitempty"`
+}
+
+func NewWorkflowQuery() *WorkflowQuery {
+  return &WorkflowQuery{}
+}
+
+var WorkflowQuery_QueryType_DEFAULT string
+func (p *WorkflowQuery) GetQueryType() string {
+  if !p.IsSetQueryType() {
+    return WorkflowQuery_QueryType_DEFAULT
+  }
+return *p.QueryType
+}
+var WorkflowQuery_QueryArgs_DEFAULT []byte
+
+func (p *WorkflowQuery) GetQueryArgs() []byte {
+  return p.QueryArgs
+}
+func (p *WorkflowQuery) IsSetQueryType() bool {
+  return p.QueryType != nil
+}
+
+func (p *WorkflowQuery) IsSetQueryArgs() bool {
+  return p.QueryArgs != nil
+}
+
+func (p *WorkflowQuery) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowQuery)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.QueryType = &v
+}
+  return nil
+}
+
+func (p *WorkflowQuery)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 20: ", err)
+} else {
+  p.QueryArgs = v
+}
+  return nil
+}
+
+func (p *WorkflowQuery) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("WorkflowQuery"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowQuery) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetQueryType() {
+    if err := oprot.WriteFieldBegin("queryType", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:queryType: ", p), err) }
+    if err := oprot.WriteString(string(*p.QueryType)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.queryType (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:queryType: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowQuery) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetQueryArgs() {
+    if err := oprot.WriteFieldBegin("queryArgs", thrift.STRING, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:queryArgs: ", p), err) }
+    if err := oprot.WriteBinary(p.QueryArgs); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.queryArgs (20) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:queryArgs: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowQuery) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowQuery(%+v)", *p)
+}
+
+// Attributes:
+//  - TaskToken
+//  - WorkflowExecution
+//  - WorkflowType
+//  - PreviousStartedEventId
+//  - StartedEventId
+//  - History
+//  - NextPageToken
+//  - Query
+type PollForDecisionTaskResponse struct {
+  // unused fields # 1 to 9
+  TaskToken []byte `thrift:"taskToken,10" db:"taskToken" json:"taskToken,omitempty"`
+  // unused fields # 11 to 19
+  WorkflowExecution *WorkflowExecution `thrift:"workflowExecution,20" db:"workflowExecution" json:"workflowExecution,omitempty"`
+  // unused fields # 21 to 29
+  WorkflowType *WorkflowType `thrift:"workflowType,30" db:"workflowType" json:"workflowType,omitempty"`
+  // unused fields # 31 to 39
+  PreviousStartedEventId *int64 `thrift:"previousStartedEventId,40" db:"previousStartedEventId" json:"previousStartedEventId,omitempty"`
+  // unused fields # 41 to 49
+  StartedEventId *int64 `thrift:"startedEventId,50" db:"startedEventId" json:"startedEventId,omitempty"`
+  // unused fields # 51 to 59
+  History *History `thrift:"history,60" db:"history" json:"history,omitempty"`
+  // unused fields # 61 to 69
+  NextPageToken []byte `thrift:"nextPageToken,70" db:"nextPageToken" json:"nextPageToken,omitempty"`
+  // unused fields # 71 to 79
+  Query *WorkflowQuery `thrift:"query,80" db:"query" json:"query,omitempty"`
+}
+
+func NewPollForDecisionTaskResponse() *PollForDecisionTaskResponse {
+  return &PollForDecisionTaskResponse{}
+}
+
+var PollForDecisionTaskResponse_TaskToken_DEFAULT []byte
+
+func (p *PollForDecisionTaskResponse) GetTaskToken() []byte {
+  return p.TaskToken
+}
+var PollForDecisionTaskResponse_WorkflowExecution_DEFAULT *WorkflowExecution
+func (p *PollForDecisionTaskResponse) GetWorkflowExecution() *WorkflowExecution {
+  if !p.IsSetWorkflowExecution() {
+    return PollForDecisionTaskResponse_WorkflowExecution_DEFAULT
+  }
+return p.WorkflowExecution
+}
+var PollForDecisionTaskResponse_WorkflowType_DEFAULT *WorkflowType
+func (p *PollForDecisionTaskResponse) GetWorkflowType() *WorkflowType {
+  if !p.IsSetWorkflowType() {
+    return PollForDecisionTaskResponse_WorkflowType_DEFAULT
+  }
+return p.WorkflowType
+}
+var PollForDecisionTaskResponse_PreviousStartedEventId_DEFAULT int64
+func (p *PollForDecisionTaskResponse) GetPreviousStartedEventId() int64 {
+  if !p.IsSetPreviousStartedEventId() {
+    return PollForDecisionTaskResponse_PreviousStartedEventId_DEFAULT
+  }
+return *p.PreviousStartedEventId
+}
+var PollForDecisionTaskResponse_StartedEventId_DEFAULT int64
+func (p *PollForDecisionTaskResponse) GetStartedEventId() int64 {
+  if !p.IsSetStartedEventId() {
+    return PollForDecisionTaskResponse_StartedEventId_DEFAULT
+  }
+return *p.StartedEventId
+}
+var PollForDecisionTaskResponse_History_DEFAULT *History
+func (p *PollForDecisionTaskResponse) GetHistory() *History {
+  if !p.IsSetHistory() {
+    return PollForDecisionTaskResponse_History_DEFAULT
+  }
+return p.History
+}
+var PollForDecisionTaskResponse_NextPageToken_DEFAULT []byte
+
+func (p *PollForDecisionTaskResponse) GetNextPageToken() []byte {
+  return p.NextPageToken
+}
+var PollForDecisionTaskResponse_Query_DEFAULT *WorkflowQuery
+func (p *PollForDecisionTaskResponse) GetQuery() *WorkflowQuery {
+  if !p.IsSetQuery() {
+    return PollForDecisionTaskResponse_Query_DEFAULT
+  }
+return p.Query
+}
+func (p *PollForDecisionTaskResponse) IsSetTaskToken() bool {
+  return p.TaskToken != nil
+}
+
+func (p *PollForDecisionTaskResponse) IsSetWorkflowExecution() bool {
+  return p.WorkflowExecution != nil
+}
+
+func (p *PollForDecisionTaskResponse) IsSetWorkflowType() bool {
+  return p.WorkflowType != nil
+}
+
+func (p *PollForDecisionTaskResponse) IsSetPreviousStartedEventId() bool {
+  return p.PreviousStartedEventId != nil
+}
+
+func (p *PollForDecisionTaskResponse) IsSetStartedEventId() bool {
+  return p.StartedEventId != nil
+}
+
+func (p *PollForDecisionTaskResponse) IsSetHistory() bool {
+  return p.History != nil
+}
+
+func (p *PollForDecisionTaskResponse) IsSetNextPageToken() bool {
+  return p.NextPageToken != nil
+}
+
+func (p *PollForDecisionTaskResponse) IsSetQuery() bool {
+  return p.Query != nil
+}
+
+func (p *PollForDecisionTaskResponse) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
+    case 50:
+      if err := p.ReadField50(iprot); err != nil {
+        return err
+      }
+    case 60:
+      if err := p.ReadField60(iprot); err != nil {
+        return err
+      }
+    case 70:
+      if err := p.ReadField70(iprot); err != nil {
+        return err
+      }
+    case 80:
+      if err := p.ReadField80(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *PollForDecisionTaskResponse)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.TaskToken = v
+}
+  return nil
+}
+
+func (p *PollForDecisionTaskResponse)  ReadField20(iprot thrift.TProtocol) error {
+  p.WorkflowExecution = &WorkflowExecution{}
+  if err := p.WorkflowExecution.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecution), err)
+  }
+  return nil
+}
+
+func (p *PollForDecisionTaskResponse)  ReadField30(iprot thrift.TProtocol) error {
+  p.WorkflowType = &WorkflowType{}
+  if err := p.WorkflowType.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowType), err)
+  }
+  return nil
+}
+
+func (p *PollForDecisionTaskResponse)  ReadField40(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 40: ", err)
+} else {
+  p.PreviousStartedEventId = &v
+}
+  return nil
+}
+
+func (p *PollForDecisionTaskResponse)  ReadField50(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 50: ", err)
+} else {
+  p.StartedEventId = &v
+}
+  return nil
+}
+
+func (p *PollForDecisionTaskResponse)  ReadField60(iprot thrift.TProtocol) error {
+  p.History = &History{}
+  if err := p.History.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.History), err)
+  }
+  return nil
+}
+
+func (p *PollForDecisionTaskResponse)  ReadField70(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 70: ", err)
+} else {
+  p.NextPageToken = v
+}
+  return nil
+}
+
+func (p *PollForDecisionTaskResponse)  ReadField80(iprot thrift.TProtocol) error {
+  p.Query = &WorkflowQuery{}
+  if err := p.Query.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Query), err)
+  }
+  return nil
+}
+
+func (p *PollForDecisionTaskResponse) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("PollForDecisionTaskResponse"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
+    if err := p.writeField40(oprot); err != nil { return err }
+    if err := p.writeField50(oprot); err != nil { return err }
+    if err := p.writeField60(oprot); err != nil { return err }
+    if err := p.writeField70(oprot); err != nil { return err }
+    if err := p.writeField80(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *PollForDecisionTaskResponse) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTaskToken() {
+    if err := oprot.WriteFieldBegin("taskToken", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:taskToken: ", p), err) }
+    if err := oprot.WriteBinary(p.TaskToken); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.taskToken (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:taskToken: ", p), err) }
+  }
+  return err
+}
+
+func (p *PollForDecisionTaskResponse) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowExecution() {
+    if err := oprot.WriteFieldBegin("workflowExecution", thrift.STRUCT, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:workflowExecution: ", p), err) }
+    if err := p.WorkflowExecution.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowExecution), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:workflowExecution: ", p), err) }
+  }
+  return err
+}
+
+func (p *PollForDecisionTaskResponse) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowType() {
+    if err := oprot.WriteFieldBegin("workflowType", thrift.STRUCT, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:workflowType: ", p), err) }
+    if err := p.WorkflowType.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowType), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:workflowType: ", p), err) }
+  }
+  return err
+}
+
+func (p *PollForDecisionTaskResponse) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetPreviousStartedEventId() {
+    if err := oprot.WriteFieldBegin("previousStartedEventId", thrift.I64, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:previousStartedEventId: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.PreviousStartedEventId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.previousStartedEventId (40) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:previousStartedEventId: ", p), err) }
+  }
+  return err
+}
+
+func (p *PollForDecisionTaskResponse) writeField50(oprot thrift.TProtocol) (err error) {
+  if p.IsSetStartedEventId() {
+    if err := oprot.WriteFieldBegin("startedEventId", thrift.I64, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:startedEventId: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.StartedEventId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.startedEventId (50) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:startedEventId: ", p), err) }
+  }
+  return err
+}
+
+func (p *PollForDecisionTaskResponse) writeField60(oprot thrift.TProtocol) (err error) {
+  if p.IsSetHistory() {
+    if err := oprot.WriteFieldBegin("history", thrift.STRUCT, 60); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:history: ", p), err) }
+    if err := p.History.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.History), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:history: ", p), err) }
+  }
+  return err
+}
+
+func (p *PollForDecisionTaskResponse) writeField70(oprot thrift.TProtocol) (err error) {
+  if p.IsSetNextPageToken() {
+    if err := oprot.WriteFieldBegin("nextPageToken", thrift.STRING, 70); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 70:nextPageToken: ", p), err) }
+    if err := oprot.WriteBinary(p.NextPageToken); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.nextPageToken (70) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 70:nextPageToken: ", p), err) }
+  }
+  return err
+}
+
+func (p *PollForDecisionTaskResponse) writeField80(oprot thrift.TProtocol) (err error) {
+  if p.IsSetQuery() {
+    if err := oprot.WriteFieldBegin("query", thrift.STRUCT, 80); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 80:query: ", p), err) }
+    if err := p.Query.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Query), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 80:query: ", p), err) }
+  }
+  return err
+}
+
+func (p *PollForDecisionTaskResponse) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("PollForDecisionTaskResponse(%+v)", *p)
+}
+
+// Attributes:
+//  - RunId
+//  - DecisionTask
+type StartWorkflowExecutionResponse struct {
+  // unused fields # 1 to 9
+  RunId *string `thrift:"runId,10" db:"runId" json:"runId,omitempty"`
+  // unused fields # 11 to 19
+  DecisionTask *PollForDecisionTaskResponse `thrift:"decisionTask,20" db:"decisionTask" json:"decisionTask,omitempty"`
+}
+
+func NewStartWorkflowExecutionResponse() *StartWorkflowExecutionResponse {
+  return &StartWorkflowExecutionResponse{}
+}
+
+var StartWorkflowExecutionResponse_RunId_DEFAULT string
+func (p *StartWorkflowExecutionResponse) GetRunId() string {
+  if !p.IsSetRunId() {
+    return StartWorkflowExecutionResponse_RunId_DEFAULT
+  }
+return *p.RunId
+}
+var StartWorkflowExecutionResponse_DecisionTask_DEFAULT *PollForDecisionTaskResponse
+func (p *StartWorkflowExecutionResponse) GetDecisionTask() *PollForDecisionTaskResponse {
+  if !p.IsSetDecisionTask() {
+    return StartWorkflowExecutionResponse_DecisionTask_DEFAULT
+  }
+return p.DecisionTask
+}
+func (p *StartWorkflowExecutionResponse) IsSetRunId() bool {
+  return p.RunId != nil
+}
+
+func (p *StartWorkflowExecutionResponse) IsSetDecisionTask() bool {
+  return p.DecisionTask != nil
+}
+
+func (p *StartWorkflowExecutionResponse) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *StartWorkflowExecutionResponse)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.RunId = &v
+}
+  return nil
+}
+
+func (p *StartWorkflowExecutionResponse)  ReadField20(iprot thrift.TProtocol) error {
+  p.DecisionTask = &PollForDecisionTaskResponse{}
+  if err := p.DecisionTask.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DecisionTask), err)
+  }
+  return nil
+}
+
+func (p *StartWorkflowExecutionResponse) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("StartWorkflowExecutionResponse"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *StartWorkflowExecutionResponse) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetRunId() {
+    if err := oprot.WriteFieldBegin("runId", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:runId: ", p), err) }
+    if err := oprot.WriteString(string(*p.RunId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.runId (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:runId: ", p), err) }
+  }
+  return err
+}
+
+func (p *StartWorkflowExecutionResponse) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDecisionTask() {
+    if err := oprot.WriteFieldBegin("decisionTask", thrift.STRUCT, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:decisionTask: ", p), err) }
+    if err := p.DecisionTask.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DecisionTask), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:decisionTask: ", p), err) }
+  }
+  return err
+}
+
+func (p *StartWorkflowExecutionResponse) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("StartWorkflowExecutionResponse(%+v)", *p)
+}
+
+// Attributes:
+//  - TaskToken
+//  - Decisions
+//  - ExecutionContext
+//  - Identity
+//  - EagerActivityTaskList
+type RespondDecisionTaskCompletedRequest struct {
+  // unused fields # 1 to 9
+  TaskToken []byte `thrift:"taskToken,10" db:"taskToken" json:"taskToken,omitempty"`
+  // unused fields # 11 to 19
+  Decisions []*Decision `thrift:"decisions,20" db:"decisions" json:"decisions,omitempty"`
+  // unused fields # 21 to 29
+  ExecutionContext []byte `thrift:"executionContext,30" db:"executionContext" json:"executionContext,omitempty"`
+  // unused fields # 31 to 39
+  Identity *string `thrift:"identity,40" db:"identity" json:"identity,omitempty"`
+  // unused fields # 41 to 49
+  EagerActivityTaskList *TaskList `thrift:"eagerActivityTaskList,50" db:"eagerActivityTaskList" json:"eagerActivityTaskList,omitempty"`
+}
+
+func NewRespondDecisionTaskCompletedRequest() *RespondDecisionTaskCompletedRequest {
+  return &RespondDecisionTaskCompletedRequest{}
+}
+
+var RespondDecisionTaskCompletedRequest_TaskToken_DEFAULT []byte
+
+func (p *RespondDecisionTaskCompletedRequest) GetTaskToken() []byte {
+  return p.TaskToken
+}
+var RespondDecisionTaskCompletedRequest_Decisions_DEFAULT []*Decision
+
+func (p *RespondDecisionTaskCompletedRequest) GetDecisions() []*Decision {
+  return p.Decisions
+}
+var RespondDecisionTaskCompletedRequest_ExecutionContext_DEFAULT []byte
+
+func (p *RespondDecisionTaskCompletedRequest) GetExecutionContext() []byte {
+  return p.ExecutionContext
+}
+var RespondDecisionTaskCompletedRequest_Identity_DEFAULT string
+func (p *RespondDecisionTaskCompletedRequest) GetIdentity() string {
+  if !p.IsSetIdentity() {
+    return RespondDecisionTaskCompletedRequest_Identity_DEFAULT
+  }
+return *p.Identity
+}
+var RespondDecisionTaskCompletedRequest_EagerActivityTaskList_DEFAULT *TaskList
+func (p *RespondDecisionTaskCompletedRequest) GetEagerActivityTaskList() *TaskList {
+  if !p.IsSetEagerActivityTaskList() {
+    return RespondDecisionTaskCompletedRequest_EagerActivityTaskList_DEFAULT
+  }
+return p.EagerActivityTaskList
+}
+func (p *RespondDecisionTaskCompletedRequest) IsSetTaskToken() bool {
+  return p.TaskToken != nil
+}
+
+func (p *RespondDecisionTaskCompletedRequest) IsSetDecisions() bool {
+  return p.Decisions != nil
+}
+
+func (p *RespondDecisionTaskCompletedRequest) IsSetExecutionContext() bool {
+  return p.ExecutionContext != nil
+}
+
+func (p *RespondDecisionTaskCompletedRequest) IsSetIdentity() bool {
+  return p.Identity != nil
+}
+
+func (p *RespondDecisionTaskCompletedRequest) IsSetEagerActivityTaskList() bool {
+  return p.EagerActivityTaskList != nil
+}
+
+func (p *RespondDecisionTaskCompletedRequest) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
+    case 50:
+      if err := p.ReadField50(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *RespondDecisionTaskCompletedRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.TaskToken = v
+}
+  return nil
+}
+
+func (p *RespondDecisionTaskCompletedRequest)  ReadField20(iprot thrift.TProtocol) error {
+  _, size, err := iprot.ReadListBegin()
+  if err != nil {
+    return thrift.PrependError("error reading list begin: ", err)
+  }
+  tSlice := make([]*Decision, 0, size)
+  p.Decisions =  tSlice
+  for i := 0; i < size; i ++ {
+    _elem1 := &Decision{}
+    if err := _elem1.Read(iprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem1), err)
+    }
+    p.Decisions = append(p.Decisions, _elem1)
+  }
+  if err := iprot.ReadListEnd(); err != nil {
+    return thrift.PrependError("error reading list end: ", err)
+  }
+  return nil
+}
+
+func (p *RespondDecisionTaskCompletedRequest)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
+} else {
+  p.ExecutionContext = v
+}
+  return nil
+}
+
+func (p *RespondDecisionTaskCompletedRequest)  ReadField40(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 40: ", err)
+} else {
+  p.Identity = &v
+}
+  return nil
+}
+
+func (p *RespondDecisionTaskCompletedRequest)  ReadField50(iprot thrift.TProtocol) error {
+  p.EagerActivityTaskList = &TaskList{}
+  if err := p.EagerActivityTaskList.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EagerActivityTaskList), err)
+  }
+  return nil
+}
+
+func (p *RespondDecisionTaskCompletedRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondDecisionTaskCompletedRequest"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
+    if err := p.writeField40(oprot); err != nil { return err }
+    if err := p.writeField50(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *RespondDecisionTaskCompletedRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTaskToken() {
+    if err := oprot.WriteFieldBegin("taskToken", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:taskToken: ", p), err) }
+    if err := oprot.WriteBinary(p.TaskToken); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.taskToken (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:taskToken: ", p), err) }
+  }
+  return err
+}
+
+func (p *RespondDecisionTaskCompletedRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDecisions() {
+    if err := oprot.WriteFieldBegin("decisions", thrift.LIST, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:decisions: ", p), err) }
+    if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Decisions)); err != nil {
+      return thrift.PrependError("error writing list begin: ", err)
+    }
+    for _, v := range p.Decisions {
+      if err := v.Write(oprot); err != nil {
+        return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+      }
+    }
+    if err := oprot.WriteListEnd(); err != nil {
+      return thrift.PrependError("error writing list end: ", err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:decisions: ", p), err) }
+  }
+  return err
+}
+
+func (p *RespondDecisionTaskCompletedRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetExecutionContext() {
+    if err := oprot.WriteFieldBegin("executionContext", thrift.STRING, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:executionContext: ", p), err) }
+    if err := oprot.WriteBinary(p.ExecutionContext); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.executionContext (30) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:executionContext: ", p), err) }
+  }
+  return err
+}
+
+func (p *RespondDecisionTaskCompletedRequest) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetIdentity() {
+    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:identity: ", p), err) }
+    if err := oprot.WriteString(string(*p.Identity)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.identity (40) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:identity: ", p), err) }
+  }
+  return err
+}
+
+func (p *RespondDecisionTaskCompletedRequest) writeField50(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEagerActivityTaskList() {
+    if err := oprot.WriteFieldBegin("eagerActivityTaskList", thrift.STRUCT, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:eagerActivityTaskList: ", p), err) }
+    if err := p.EagerActivityTaskList.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EagerActivityTaskList), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:eagerActivityTaskList: ", p), err) }
+  }
+  return err
+}
+
+func (p *RespondDecisionTaskCompletedRequest) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("RespondDecisionTaskCompletedRequest(%+v)", *p)
+}
+
+// Attributes:
+//  - Domain
+//  - TaskList
+//  - Identity
+//  - TaskListMetadata
+type PollForActivityTaskRequest struct {
+  // unused fields # 1 to 9
+  Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
+  // unused fields # 11 to 19
+  TaskList *TaskList `thrift:"taskList,20" db:"taskList" json:"taskList,omitempty"`
+  // unused fields # 21 to 29
+  Identity *string `thrift:"identity,30" db:"identity" json:"identity,omitempty"`
+  // unused fields # 31 to 39
+  TaskListMetadata *TaskListMetadata `thrift:"taskListMetadata,40" db:"taskListMetadata" json:"taskListMetadata,omitempty"`
+}
+
+func NewPollForActivityTaskRequest() *PollForActivityTaskRequest {
+  return &PollForActivityTaskRequest{}
+}
+
+var PollForActivityTaskRequest_Domain_DEFAULT string
+func (p *PollForActivityTaskRequest) GetDomain() string {
+  if !p.IsSetDomain() {
+    return PollForActivityTaskRequest_Domain_DEFAULT
+  }
+return *p.Domain
+}
+var PollForActivityTaskRequest_TaskList_DEFAULT *TaskList
+func (p *PollForActivityTaskRequest) GetTaskList() *TaskList {
+  if !p.IsSetTaskList() {
+    return PollForActivityTaskRequest_TaskList_DEFAULT
+  }
+return p.TaskList
+}
+var PollForActivityTaskRequest_Identity_DEFAULT string
+func (p *PollForActivityTaskRequest) GetIdentity() string {
+  if !p.IsSetIdentity() {
+    return PollForActivityTaskRequest_Identity_DEFAULT
+  }
+return *p.Identity
+}
+var PollForActivityTaskRequest_TaskListMetadata_DEFAULT *TaskListMetadata
+func (p *PollForActivityTaskRequest) GetTaskListMetadata() *TaskListMetadata {
+  if !p.IsSetTaskListMetadata() {
+    return PollForActivityTaskRequest_TaskListMetadata_DEFAULT
+  }
+return p.TaskListMetadata
+}
+func (p *PollForActivityTaskRequest) IsSetDomain() bool {
+  return p.Domain != nil
+}
+
+func (p *PollForActivityTaskRequest) IsSetTaskList() bool {
+  return p.TaskList != nil
+}
+
+func (p *PollForActivityTaskRequest) IsSetIdentity() bool {
+  return p.Identity != nil
+}
+
+func (p *PollForActivityTaskRequest) IsSetTaskListMetadata() bool {
+  return p.TaskListMetadata != nil
+}
+
+func (p *PollForActivityTaskRequest) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *PollForActivityTaskRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.Domain = &v
+}
+  return nil
+}
+
+func (p *PollForActivityTaskRequest)  ReadField20(iprot thrift.TProtocol) error {
+  p.TaskList = &TaskList{}
+  if err := p.TaskList.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TaskList), err)
+  }
+  return nil
+}
+
+func (p *PollForActivityTaskRequest)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
+} else {
+  p.Identity = &v
+}
+  return nil
+}
+
+func (p *PollForActivityTaskRequest)  ReadField40(iprot thrift.TProtocol) error {
+  p.TaskListMetadata = &TaskListMetadata{}
+  if err := p.TaskListMetadata.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TaskListMetadata), err)
+  }
+  return nil
+}
+
+func (p *PollForActivityTaskRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("PollForActivityTaskRequest"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
+    if err := p.writeField40(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *PollForActivityTaskRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomain() {
+    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
+    if err := oprot.WriteString(string(*p.Domain)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domain (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domain: ", p), err) }
+  }
+  return err
+}
+
+func (p *PollForActivityTaskRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTaskList() {
+    if err := oprot.WriteFieldBegin("taskList", thrift.STRUCT, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:taskList: ", p), err) }
+    if err := p.TaskList.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TaskList), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:taskList: ", p), err) }
+  }
+  return err
+}
+
+func (p *PollForActivityTaskRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetIdentity() {
+    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:identity: ", p), err) }
+    if err := oprot.WriteString(string(*p.Identity)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.identity (30) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:identity: ", p), err) }
+  }
+  return err
+}
+
+func (p *PollForActivityTaskRequest) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTaskListMetadata() {
+    if err := oprot.WriteFieldBegin("taskListMetadata", thrift.STRUCT, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:taskListMetadata: ", p), err) }
+    if err := p.TaskListMetadata.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TaskListMetadata), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:taskListMetadata: ", p), err) }
+  }
+  return err
+}
+
+func (p *PollForActivityTaskRequest) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("PollForActivityTaskRequest(%+v)", *p)
+}
+
+// Attributes:
+//  - TaskToken
+//  - WorkflowExecution
+//  - ActivityId
+//  - ActivityType
+//  - Input
+//  - StartedEventId
+//  - ScheduledTimestamp
+//  - ScheduleToCloseTimeoutSeconds
+//  - StartedTimestamp
+//  - StartToCloseTimeoutSeconds
+//  - HeartbeatTimeoutSeconds
+type PollForActivityTaskResponse struct {
+  // unused fields # 1 to 9
+  TaskToken []byte `thrift:"taskToken,10" db:"taskToken" json:"taskToken,omitempty"`
+  // unused fields # 11 to 19
+  WorkflowExecution *WorkflowExecution `thrift:"workflowExecution,20" db:"workflowExecution" json:"workflowExecution,omitempty"`
+  // unused fields # 21 to 29
+  ActivityId *string `thrift:"activityId,30" db:"activityId" json:"activityId,omitempty"`
+  // unused fields # 31 to 39
+  ActivityType *ActivityType `thrift:"activityType,40" db:"activityType" json:"activityType,omitempty"`
+  // unused fields # 41 to 49
+  Input []byte `thrift:"input,50" db:"input" json:"input,omitempty"`
+  // unused fields # 51 to 59
+  StartedEventId *int64 `thrift:"startedEventId,60" db:"startedEventId" json:"startedEventId,omitempty"`
+  // unused fields # 61 to 69
+  ScheduledTimestamp *int64 `thrift:"scheduledTimestamp,70" db:"scheduledTimestamp" json:"scheduledTimestamp,omitempty"`
+  // unused fields # 71 to 79
+  ScheduleToCloseTimeoutSeconds *int32 `thrift:"scheduleToCloseTimeoutSeconds,80" db:"scheduleToCloseTimeoutSeconds" json:"scheduleToCloseTimeoutSeconds,omitempty"`
+  // unused fields # 81 to 89
+  StartedTimestamp *int64 `thrift:"startedTimestamp,90" db:"startedTimestamp" json:"startedTimestamp,omitempty"`
+  // unused fields # 91 to 99
+  StartToCloseTimeoutSeconds *int32 `thrift:"startToCloseTimeoutSeconds,100" db:"startToCloseTimeoutSeconds" json:"startToCloseTimeoutSeconds,omitempty"`
+  // unused fields # 101 to 109
+  HeartbeatTimeoutSeconds *int32 `thrift:"heartbeatTimeoutSeconds,110" db:"heartbeatTimeoutSeconds" json:"heartbeatTimeoutSeconds,omitempty"`
+}
+
+func NewPollForActivityTaskResponse() *PollForActivityTaskResponse {
+  return &PollForActivityTaskResponse{}
+}
+
+var PollForActivityTaskResponse_TaskToken_DEFAULT []byte
+
+func (p *PollForActivityTaskResponse) GetTaskToken() []byte {
+  return p.TaskToken
+}
+var PollForActivityTaskResponse_WorkflowExecution_DEFAULT *WorkflowExecution
+func (p *PollForActivityTaskResponse) GetWorkflowExecution() *WorkflowExecution {
+  if !p.IsSetWorkflowExecution() {
+    return PollForActivityTaskResponse_WorkflowExecution_DEFAULT
+  }
+return p.WorkflowExecution
+}
+var PollForActivityTaskResponse_ActivityId_DEFAULT string
+func (p *PollForActivityTaskResponse) GetActivityId() string {
+  if !p.IsSetActivityId() {
+    return PollForActivityTaskResponse_ActivityId_DEFAULT
+  }
+return *p.ActivityId
+}
+var PollForActivityTaskResponse_ActivityType_DEFAULT *ActivityType
+func (p *PollForActivityTaskResponse) GetActivityType() *ActivityType {
+  if !p.IsSetActivityType() {
+    return PollForActivityTaskResponse_ActivityType_DEFAULT
+  }
+return p.ActivityType
+}
+var PollForActivityTaskResponse_Input_DEFAULT []byte
+
+func (p *PollForActivityTaskResponse) GetInput() []byte {
+  return p.Input
+}
+var PollForActivityTaskResponse_StartedEventId_DEFAULT int64
+func (p *PollForActivityTaskResponse) GetStartedEventId() int64 {
+  if !p.IsSetStartedEventId() {
+    return PollForActivityTaskResponse_StartedEventId_DEFAULT
+  }
+return *p.StartedEventId
+}
+var PollForActivityTaskResponse_ScheduledTimestamp_DEFAULT int64
+func (p *PollForActivityTaskResponse) GetScheduledTimestamp() int64 {
+  if !p.IsSetScheduledTimestamp() {
+    return PollForActivityTaskResponse_ScheduledTimestamp_DEFAULT
+  }
+return *p.ScheduledTimestamp
+}
+var PollForActivityTaskResponse_ScheduleToCloseTimeoutSeconds_DEFAULT int32
+func (p *PollForActivityTaskResponse) GetScheduleToCloseTimeoutSeconds() int32 {
+  if !p.IsSetScheduleToCloseTimeoutSeconds() {
+    return PollForActivityTaskResponse_ScheduleToCloseTimeoutSeconds_DEFAULT
+  }
+return *p.ScheduleToCloseTimeoutSeconds
+}
+var PollForActivityTaskResponse_StartedTimestamp_DEFAULT int64
+func (p *PollForActivityTaskResponse) GetStartedTimestamp() int64 {
+  if !p.IsSetStartedTimestamp() {
+    return PollForActivityTaskResponse_StartedTimestamp_DEFAULT
+  }
+return *p.StartedTimestamp
+}
+var PollForActivityTaskResponse_StartToCloseTimeoutSeconds_DEFAULT int32
+func (p *PollForActivityTaskResponse) GetStartToCloseTimeoutSeconds() int32 {
+  if !p.IsSetStartToCloseTimeoutSeconds() {
+    return PollForActivityTaskResponse_StartToCloseTimeoutSeconds_DEFAULT
+  }
+return *p.StartToCloseTimeoutSeconds
+}
+var PollForActivityTaskResponse_HeartbeatTimeoutSeconds_DEFAULT int32
+func (p *PollForActivityTaskResponse) GetHeartbeatTimeoutSeconds() int32 {
+  if !p.IsSetHeartbeatTimeoutSeconds() {
+    return PollForActivityTaskResponse_HeartbeatTimeoutSeconds_DEFAULT
+  }
+return *p.HeartbeatTimeoutSeconds
+}
+func (p *PollForActivityTaskResponse) IsSetTaskToken() bool {
+  return p.TaskToken != nil
+}
+
+func (p *PollForActivityTaskResponse) IsSetWorkflowExecution() bool {
+  return p.WorkflowExecution != nil
+}
+
+func (p *PollForActivityTaskResponse) IsSetActivityId() bool {
+  return p.ActivityId != nil
+}
+
+func (p *PollForActivityTaskResponse) IsSetActivityType() bool {
+  return p.ActivityType != nil
+}
+
+func (p *PollForActivityTaskResponse) IsSetInput() bool {
+  return p.Input != nil
+}
+
+func (p *PollForActivityTaskResponse) IsSetStartedEventId() bool {
+  return p.StartedEventId != nil
+}
+
+func (p *PollForActivityTaskResponse) IsSetScheduledTimestamp() bool {
+  return p.ScheduledTimestamp != nil
+}
+
+func (p *PollForActivityTaskResponse) IsSetScheduleToCloseTimeoutSeconds() bool {
+  return p.ScheduleToCloseTimeoutSeconds != nil
+}
+
+func (p *PollForActivityTaskResponse) IsSetStartedTimestamp() bool {
+  return p.StartedTimestamp != nil
+}
+
+func (p *PollForActivityTaskResponse) IsSetStartToCloseTimeoutSeconds() bool {
+  return p.StartToCloseTimeoutSeconds != nil
+}
+
+func (p *PollForActivityTaskResponse) IsSetHeartbeatTimeoutSeconds() bool {
+  return p.HeartbeatTimeoutSeconds != nil
+}
+
+func (p *PollForActivityTaskResponse) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
+    case 50:
+      if err := p.ReadField50(iprot); err != nil {
+        return err
+      }
+    case 60:
+      if err := p.ReadField60(iprot); err != nil {
+        return err
+      }
+    case 70:
+      if err := p.ReadField70(iprot); err != nil {
+        return err
+      }
+    case 80:
+      if err := p.ReadField80(iprot); err != nil {
+        return err
+      }
+    case 90:
+      if err := p.ReadField90(iprot); err != nil {
+        return err
+      }
+    case 100:
+      if err := p.ReadField100(iprot); err != nil {
+        return err
+      }
+    case 110:
+      if err := p.ReadField110(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *PollForActivityTaskResponse)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.TaskToken = v
+}
+  return nil
+}
+
+func (p *PollForActivityTaskResponse)  ReadField20(iprot thrift.TProtocol) error {
+  p.WorkflowExecution = &WorkflowExecution{}
+  if err := p.WorkflowExecution.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecution), err)
+  }
+  return nil
+}
+
+func (p *PollForActivityTaskResponse)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
+} else {
+  p.ActivityId = &v
+}
+  return nil
+}
+
+func (p *PollForActivityTaskResponse)  ReadField40(iprot thrift.TProtocol) error {
+  p.ActivityType = &ActivityType{}
+  if err := p.ActivityType.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ActivityType), err)
+  }
+  return nil
+}
+
+func (p *PollForActivityTaskResponse)  ReadField50(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 50: ", err)
+} else {
+  p.Input = v
+}
+  return nil
+}
+
+func (p *PollForActivityTaskResponse)  ReadField60(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 60: ", err)
+} else {
+  p.StartedEventId = &v
+}
+  return nil
+}
+
+func (p *PollForActivityTaskResponse)  ReadField70(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 70: ", err)
+} else {
+  p.ScheduledTimestamp = &v
+}
+  return nil
+}
+
+func (p *PollForActivityTaskResponse)  ReadField80(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 80: ", err)
+} else {
+  p.ScheduleToCloseTimeoutSeconds = &v
+}
+  return nil
+}
+
+func (p *PollForActivityTaskResponse)  ReadField90(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 90: ", err)
+} else {
+  p.StartedTimestamp = &v
+}
+  return nil
+}
+
+func (p *PollForActivityTaskResponse)  ReadField100(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 100: ", err)
+} else {
+  p.StartToCloseTimeoutSeconds = &v
+}
+  return nil
+}
+
+func (p *PollForActivityTaskResponse)  ReadField110(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 110: ", err)
+} else {
+  p.HeartbeatTimeoutSeconds = &v
+}
+  return nil
+}
+
+func (p *PollForActivityTaskResponse) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("PollForActivityTaskResponse"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
+    if err := p.writeField40(oprot); err != nil { return err }
+    if err := p.writeField50(oprot); err != nil { return err }
+    if err := p.writeField60(oprot); err != nil { return err }
+    if err := p.writeField70(oprot); err != nil { return err }
+    if err := p.writeField80(oprot); err != nil { return err }
+    if err := p.writeField90(oprot); err != nil { return err }
+    if err := p.writeField100(oprot); err != nil { return err }
+    if err := p.writeField110(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *PollForActivityTaskResponse) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTaskToken() {
+    if err := oprot.WriteFieldBegin("taskToken", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:taskToken: ", p), err) }
+    if err := oprot.WriteBinary(p.TaskToken); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.taskToken (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:taskToken: ", p), err) }
+  }
+  return err
+}
+
+func (p *PollForActivityTaskResponse) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowExecution() {
+    if err := oprot.WriteFieldBegin("workflowExecution", thrift.STRUCT, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:workflowExecution: ", p), err) }
+    if err := p.WorkflowExecution.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowExecution), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:workflowExecution: ", p), err) }
+  }
+  return err
+}
+
+func (p *PollForActivityTaskResponse) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetActivityId() {
+    if err := oprot.WriteFieldBegin("activityId", thrift.STRING, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:activityId: ", p), err) }
+    if err := oprot.WriteString(string(*p.ActivityId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.activityId (30) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:activityId: ", p), err) }
+  }
+  return err
+}
+
+func (p *PollForActivityTaskResponse) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetActivityType() {
+    if err := oprot.WriteFieldBegin("activityType", thrift.STRUCT, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:activityType: ", p), err) }
+    if err := p.ActivityType.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ActivityType), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:activityType: ", p), err) }
+  }
+  return err
+}
+
+func (p *PollForActivityTaskResponse) writeField50(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInput() {
+    if err := oprot.WriteFieldBegin("input", thrift.STRING, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:input: ", p), err) }
+    if err := oprot.WriteBinary(p.Input); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.input (50) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:input: ", p), err) }
+  }
+  return err
+}
+
+func (p *PollForActivityTaskResponse) writeField60(oprot thrift.TProtocol) (err error) {
+  if p.IsSetStartedEventId() {
+    if err := oprot.WriteFieldBegin("startedEventId", thrift.I64, 60); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:startedEventId: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.StartedEventId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.startedEventId (60) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:startedEventId: ", p), err) }
+  }
+  return err
+}
+
+func (p *PollForActivityTaskResponse) writeField70(oprot thrift.TProtocol) (err error) {
+  if p.IsSetScheduledTimestamp() {
+    if err := oprot.WriteFieldBegin("scheduledTimestamp", thrift.I64, 70); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 70:scheduledTimestamp: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.ScheduledTimestamp)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.scheduledTimestamp (70) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 70:scheduledTimestamp: ", p), err) }
+  }
+  return err
+}
+
+func (p *PollForActivityTaskResponse) writeField80(oprot thrift.TProtocol) (err error) {
+  if p.IsSetScheduleToCloseTimeoutSeconds() {
+    if err := oprot.WriteFieldBegin("scheduleToCloseTimeoutSeconds", thrift.I32, 80); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 80:scheduleToCloseTimeoutSeconds: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.ScheduleToCloseTimeoutSeconds)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.scheduleToCloseTimeoutSeconds (80) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 80:scheduleToCloseTimeoutSeconds: ", p), err) }
+  }
+  return err
+}
+
+func (p *PollForActivityTaskResponse) writeField90(oprot thrift.TProtocol) (err error) {
+  if p.IsSetStartedTimestamp() {
+    if err := oprot.WriteFieldBegin("startedTimestamp", thrift.I64, 90); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 90:startedTimestamp: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.StartedTimestamp)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.startedTimestamp (90) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 90:startedTimestamp: ", p), err) }
+  }
+  return err
+}
+
+func (p *PollForActivityTaskResponse) writeField100(oprot thrift.TProtocol) (err error) {
+  if p.IsSetStartToCloseTimeoutSeconds() {
+    if err := oprot.WriteFieldBegin("startToCloseTimeoutSeconds", thrift.I32, 100); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 100:startToCloseTimeoutSeconds: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.StartToCloseTimeoutSeconds)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.startToCloseTimeoutSeconds (100) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 100:startToCloseTimeoutSeconds: ", p), err) }
+  }
+  return err
+}
+
+func (p *PollForActivityTaskResponse) writeField110(oprot thrift.TProtocol) (err error) {
+  if p.IsSetHeartbeatTimeoutSeconds() {
+    if err := oprot.WriteFieldBegin("heartbeatTimeoutSeconds", thrift.I32, 110); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 110:heartbeatTimeoutSeconds: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.HeartbeatTimeoutSeconds)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.heartbeatTimeoutSeconds (110) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 110:heartbeatTimeoutSeconds: ", p), err) }
+  }
+  return err
+}
+
+func (p *PollForActivityTaskResponse) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("PollForActivityTaskResponse(%+v)", *p)
+}
+
+// Attributes:
+//  - ActivityTask
+type RespondDecisionTaskCompletedResponse struct {
+  // unused fields # 1 to 9
+  ActivityTask *PollForActivityTaskResponse `thrift:"activityTask,10" db:"activityTask" json:"activityTask,omitempty"`
+}
+
+func NewRespondDecisionTaskCompletedResponse() *RespondDecisionTaskCompletedResponse {
+  return &RespondDecisionTaskCompletedResponse{}
+}
+
+var RespondDecisionTaskCompletedResponse_ActivityTask_DEFAULT *PollForActivityTaskResponse
+func (p *RespondDecisionTaskCompletedResponse) GetActivityTask() *PollForActivityTaskResponse {
+  if !p.IsSetActivityTask() {
+    return RespondDecisionTaskCompletedResponse_ActivityTask_DEFAULT
+  }
+return p.ActivityTask
+}
+func (p *RespondDecisionTaskCompletedResponse) IsSetActivityTask() bool {
+  return p.ActivityTask != nil
+}
+
+func (p *RespondDecisionTaskCompletedResponse) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *RespondDecisionTaskCompletedResponse)  ReadField10(iprot thrift.TProtocol) error {
+  p.ActivityTask = &PollForActivityTaskResponse{}
+  if err := p.ActivityTask.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ActivityTask), err)
+  }
+  return nil
+}
+
+func (p *RespondDecisionTaskCompletedResponse) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondDecisionTaskCompletedResponse"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *RespondDecisionTaskCompletedResponse) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetActivityTask() {
+    if err := oprot.WriteFieldBegin("activityTask", thrift.STRUCT, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:activityTask: ", p), err) }
+    if err := p.ActivityTask.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ActivityTask), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:activityTask: ", p), err) }
+  }
+  return err
+}
+
+func (p *RespondDecisionTaskCompletedResponse) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("RespondDecisionTaskCompletedResponse(%+v)", *p)
+}
+
+// Attributes:
+//  - TaskToken
+//  - Details
+//  - Identity
+type RecordActivityTaskHeartbeatRequest struct {
+  // unused fields # 1 to 9
+  TaskToken []byte `thrift:"taskToken,10" db:"taskToken" json:"taskToken,omitempty"`
+  // unused fields # 11 to 19
+  Details []byte `thrift:"details,20" db:"details" json:"details,omitempty"`
+  // unused fields # 21 to 29
+  Identity *string `thrift:"identity,30" db:"identity" json:"identity,omitempty"`
+}
+
+func NewRecordActivityTaskHeartbeatRequest() *RecordActivityTaskHeartbeatRequest {
+  return &RecordActivityTaskHeartbeatRequest{}
+}
+
+var RecordActivityTaskHeartbeatRequest_TaskToken_DEFAULT []byte
+
+func (p *RecordActivityTaskHeartbeatRequest) GetTaskToken() []byte {
+  return p.TaskToken
+}
+var RecordActivityTaskHeartbeatRequest_Details_DEFAULT []byte
+
+func (p *RecordActivityTaskHeartbeatRequest) GetDetails() []byte {
+  return p.Details
+}
+var RecordActivityTaskHeartbeatRequest_Identity_DEFAULT string
+func (p *RecordActivityTaskHeartbeatRequest) GetIdentity() string {
+  if !p.IsSetIdentity() {
+    return RecordActivityTaskHeartbeatRequest_Identity_DEFAULT
+  }
+return *p.Identity
+}
+func (p *RecordActivityTaskHeartbeatRequest) IsSetTaskToken() bool {
+  return p.TaskToken != nil
+}
+
+func (p *RecordActivityTaskHeartbeatRequest) IsSetDetails() bool {
+  return p.Details != nil
+}
+
+func (p *RecordActivityTaskHeartbeatRequest) IsSetIdentity() bool {
+  return p.Identity != nil
+}
+
+func (p *RecordActivityTaskHeartbeatRequest) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *RecordActivityTaskHeartbeatRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.TaskToken = v
+}
+  return nil
+}
+
+func (p *RecordActivityTaskHeartbeatRequest)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 20: ", err)
+} else {
+  p.Details = v
+}
+  return nil
+}
+
+func (p *RecordActivityTaskHeartbeatRequest)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
+} else {
+  p.Identity = &v
+}
+  return nil
+}
+
+func (p *RecordActivityTaskHeartbeatRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RecordActivityTaskHeartbeatRequest"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *RecordActivityTaskHeartbeatRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTaskToken() {
+    if err := oprot.WriteFieldBegin("taskToken", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:taskToken: ", p), err) }
+    if err := oprot.WriteBinary(p.TaskToken); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.taskToken (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:taskToken: ", p), err) }
+  }
+  return err
+}
+
+func (p *RecordActivityTaskHeartbeatRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDetails() {
+    if err := oprot.WriteFieldBegin("details", thrift.STRING, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:details: ", p), err) }
+    if err := oprot.WriteBinary(p.Details); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.details (20) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:details: ", p), err) }
+  }
+  return err
+}
+
+func (p *RecordActivityTaskHeartbeatRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetIdentity() {
+    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:identity: ", p), err) }
+    if err := oprot.WriteString(string(*p.Identity)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.identity (30) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:identity: ", p), err) }
+  }
+  return err
+}
+
+func (p *RecordActivityTaskHeartbeatRequest) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("RecordActivityTaskHeartbeatRequest(%+v)", *p)
+}
+
+// Attributes:
+//  - CancelRequested
+type RecordActivityTaskHeartbeatResponse struct {
+  // unused fields # 1 to 9
+  CancelRequested *bool `thrift:"cancelRequested,10" db:"cancelRequested" json:"cancelRequested,omitempty"`
+}
+
+func NewRecordActivityTaskHeartbeatResponse() *RecordActivityTaskHeartbeatResponse {
+  return &RecordActivityTaskHeartbeatResponse{}
+}
+
+var RecordActivityTaskHeartbeatResponse_CancelRequested_DEFAULT bool
+func (p *RecordActivityTaskHeartbeatResponse) GetCancelRequested() bool {
+  if !p.IsSetCancelRequested() {
+    return RecordActivityTaskHeartbeatResponse_CancelRequested_DEFAULT
+  }
+return *p.CancelRequested
+}
+func (p *RecordActivityTaskHeartbeatResponse) IsSetCancelRequested() bool {
+  return p.CancelRequested != nil
+}
+
+func (p *RecordActivityTaskHeartbeatResponse) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *RecordActivityTaskHeartbeatResponse)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.CancelRequested = &v
+}
+  return nil
+}
+
+func (p *RecordActivityTaskHeartbeatResponse) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RecordActivityTaskHeartbeatResponse"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *RecordActivityTaskHeartbeatResponse) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetCancelRequested() {
+    if err := oprot.WriteFieldBegin("cancelRequested", thrift.BOOL, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:cancelRequested: ", p), err) }
+    if err := oprot.WriteBool(bool(*p.CancelRequested)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.cancelRequested (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:cancelRequested: ", p), err) }
+  }
+  return err
+}
+
+func (p *RecordActivityTaskHeartbeatResponse) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("RecordActivityTaskHeartbeatResponse(%+v)", *p)
+}
+
+// Attributes:
+//  - TaskToken
+//  - Result_
+//  - Identity
+type RespondActivityTaskCompletedRequest struct {
+  // unused fields # 1 to 9
+  TaskToken []byte `thrift:"taskToken,10" db:"taskToken" json:"taskToken,omitempty"`
+  // unused fields # 11 to 19
+  Result_ []byte `thrift:"result,20" db:"result" json:"result,omitempty"`
+  // unused fields # 21 to 29
+  Identity *string `thrift:"identity,30" db:"identity" json:"identity,omitempty"`
+}
+
+func NewRespondActivityTaskCompletedRequest() *RespondActivityTaskCompletedRequest {
+  return &RespondActivityTaskCompletedRequest{}
+}
+
+var RespondActivityTaskCompletedRequest_TaskToken_DEFAULT []byte
+
+func (p *RespondActivityTaskCompletedRequest) GetTaskToken() []byte {
+  return p.TaskToken
+}
+var RespondActivityTaskCompletedRequest_Result__DEFAULT []byte
+
+func (p *RespondActivityTaskCompletedRequest) GetResult_() []byte {
+  return p.Result_
+}
+var RespondActivityTaskCompletedRequest_Identity_DEFAULT string
+func (p *RespondActivityTaskCompletedRequest) GetIdentity() string {
+  if !p.IsSetIdentity() {
+    return RespondActivityTaskCompletedRequest_Identity_DEFAULT
+  }
+return *p.Identity
+}
+func (p *RespondActivityTaskCompletedRequest) IsSetTaskToken() bool {
+  return p.TaskToken != nil
+}
+
+func (p *RespondActivityTaskCompletedRequest) IsSetResult_() bool {
+  return p.Result_ != nil
+}
+
+func (p *RespondActivityTaskCompletedRequest) IsSetIdentity() bool {
+  return p.Identity != nil
+}
+
+func (p *RespondActivityTaskCompletedRequest) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *RespondActivityTaskCompletedRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.TaskToken = v
+}
+  return nil
+}
+
+func (p *RespondActivityTaskCompletedRequest)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 20: ", err)
+} else {
+  p.Result_ = v
+}
+  return nil
+}
+
+func (p *RespondActivityTaskCompletedRequest)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
+} else {
+  p.Identity = &v
+}
+  return nil
+}
+
+func (p *RespondActivityTaskCompletedRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskCompletedRequest"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *RespondActivityTaskCompletedRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTaskToken() {
+    if err := oprot.WriteFieldBegin("taskToken", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:taskToken: ", p), err) }
+    if err := oprot.WriteBinary(p.TaskToken); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.taskToken (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:taskToken: ", p), err) }
+  }
+  return err
+}
+
+func (p *RespondActivityTaskCompletedRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetResult_() {
+    if err := oprot.WriteFieldBegin("result", thrift.STRING, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:result: ", p), err) }
+    if err := oprot.WriteBinary(p.Result_); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.result (20) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:result: ", p), err) }
+  }
+  return err
+}
+
+func (p *RespondActivityTaskCompletedRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetIdentity() {
+    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:identity: ", p), err) }
+    if err := oprot.WriteString(string(*p.Identity)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.identity (30) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:identity: ", p), err) }
+  }
+  return err
+}
+
+func (p *RespondActivityTaskCompletedRequest) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("RespondActivityTaskCompletedRequest(%+v)", *p)
+}
+
+// Attributes:
+//  - TaskToken
+//  - Reason
+//  - Details
+//  - Identity
+type RespondActivityTaskFailedRequest struct {
+  // unused fields # 1 to 9
+  TaskToken []byte `thrift:"taskToken,10" db:"taskToken" json:"taskToken,omitempty"`
+  // unused fields # 11 to 19
+  Reason *string `thrift:"reason,20" db:"reason" json:"reason,omitempty"`
+  // unused fields # 21 to 29
+  Details []byte `thrift:"details,30" db:"details" json:"details,omitempty"`
+  // unused fields # 31 to 39
+  Identity *string `thrift:"identity,40" db:"identity" json:"identity,omitempty"`
+}
+
+func NewRespondActivityTaskFailedRequest() *RespondActivityTaskFailedRequest {
+  return &RespondActivityTaskFailedRequest{}
+}
+
+var RespondActivityTaskFailedRequest_TaskToken_DEFAULT []byte
+
+func (p *RespondActivityTaskFailedRequest) GetTaskToken() []byte {
+  return p.TaskToken
+}
+var RespondActivityTaskFailedRequest_Reason_DEFAULT string
+func (p *RespondActivityTaskFailedRequest) GetReason() string {
+  if !p.IsSetReason() {
+    return RespondActivityTaskFailedRequest_Reason_DEFAULT
+  }
+return *p.Reason
+}
+var RespondActivityTaskFailedRequest_Details_DEFAULT []byte
+
+func (p *RespondActivityTaskFailedRequest) GetDetails() []byte {
+  return p.Details
+}
+var RespondActivityTaskFailedRequest_Identity_DEFAULT string
+func (p *RespondActivityTaskFailedRequest) GetIdentity() string {
+  if !p.IsSetIdentity() {
+    return RespondActivityTaskFailedRequest_Identity_DEFAULT
+  }
+return *p.Identity
+}
+func (p *RespondActivityTaskFailedRequest) IsSetTaskToken() bool {
+  return p.TaskToken != nil
+}
+
+func (p *RespondActivityTaskFailedRequest) IsSetReason() bool {
+  return p.Reason != nil
+}
+
+func (p *RespondActivityTaskFailedRequest) IsSetDetails() bool {
+  return p.Details != nil
+}
+
+func (p *RespondActivityTaskFailedRequest) IsSetIdentity() bool {
+  return p.Identity != nil
+}
+
+func (p *RespondActivityTaskFailedRequest) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *RespondActivityTaskFailedRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.TaskToken = v
+}
+  return nil
+}
+
+func (p *RespondActivityTaskFailedRequest)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 20: ", err)
+} else {
+  p.Reason = &v
+}
+  return nil
+}
+
+func (p *RespondActivityTaskFailedRequest)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
+} else {
+  p.Details = v
+}
+  return nil
+}
+
+func (p *RespondActivityTaskFailedRequest)  ReadField40(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 40: ", err)
+} else {
+  p.Identity = &v
+}
+  return nil
+}
+
+func (p *RespondActivityTaskFailedRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskFailedRequest"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
+    if err := p.writeField40(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *RespondActivityTaskFailedRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTaskToken() {
+    if err := oprot.WriteFieldBegin("taskToken", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:taskToken: ", p), err) }
+    if err := oprot.WriteBinary(p.TaskToken); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.taskToken (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:taskToken: ", p), err) }
+  }
+  return err
+}
+
+func (p *RespondActivityTaskFailedRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetReason() {
+    if err := oprot.WriteFieldBegin("reason", thrift.STRING, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:reason: ", p), err) }
+    if err := oprot.WriteString(string(*p.Reason)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.reason (20) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:reason: ", p), err) }
+  }
+  return err
+}
+
+func (p *RespondActivityTaskFailedRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDetails() {
+    if err := oprot.WriteFieldBegin("details", thrift.STRING, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:details: ", p), err) }
+    if err := oprot.WriteBinary(p.Details); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.details (30) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:details: ", p), err) }
+  }
+  return err
+}
+
+func (p *RespondActivityTaskFailedRequest) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetIdentity() {
+    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:identity: ", p), err) }
+    if err := oprot.WriteString(string(*p.Identity)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.identity (40) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:identity: ", p), err) }
+  }
+  return err
+}
+
+func (p *RespondActivityTaskFailedRequest) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("RespondActivityTaskFailedRequest(%+v)", *p)
+}
+
+// Attributes:
+//  - TaskToken
+//  - Details
+//  - Identity
+type RespondActivityTaskCanceledRequest struct {
+  // unused fields # 1 to 9
+  TaskToken []byte `thrift:"taskToken,10" db:"taskToken" json:"taskToken,omitempty"`
+  // unused fields # 11 to 19
+  Details []byte `thrift:"details,20" db:"details" json:"details,omitempty"`
+  // unused fields # 21 to 29
+  Identity *string `thrift:"identity,30" db:"identity" json:"identity,omitempty"`
+}
+
+func NewRespondActivityTaskCanceledRequest() *RespondActivityTaskCanceledRequest {
+  return &RespondActivityTaskCanceledRequest{}
+}
+
+var RespondActivityTaskCanceledRequest_TaskToken_DEFAULT []byte
+
+func (p *RespondActivityTaskCanceledRequest) GetTaskToken() []byte {
+  return p.TaskToken
+}
+var RespondActivityTaskCanceledRequest_Details_DEFAULT []byte
+
+func (p *RespondActivityTaskCanceledRequest) GetDetails() []byte {
+  return p.Details
+}
+var RespondActivityTaskCanceledRequest_Identity_DEFAULT string
+func (p *RespondActivityTaskCanceledRequest) GetIdentity() string {
+  if !p.IsSetIdentity() {
+    return RespondActivityTaskCanceledRequest_Identity_DEFAULT
+  }
+return *p.Identity
+}
+func (p *RespondActivityTaskCanceledRequest) IsSetTaskToken() bool {
+  return p.TaskToken != nil
+}
+
+func (p *RespondActivityTaskCanceledRequest) IsSetDetails() bool {
+  return p.Details != nil
+}
+
+func (p *RespondActivityTaskCanceledRequest) IsSetIdentity() bool {
+  return p.Identity != nil
+}
+
+func (p *RespondActivityTaskCanceledRequest) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *RespondActivityTaskCanceledRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.TaskToken = v
+}
+  return nil
+}
+
+func (p *RespondActivityTaskCanceledRequest)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 20: ", err)
+} else {
+  p.Details = v
+}
+  return nil
+}
+
+func (p *RespondActivityTaskCanceledRequest)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
+} else {
+  p.Identity = &v
+}
+  return nil
+}
+
+func (p *RespondActivityTaskCanceledRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskCanceledRequest"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *RespondActivityTaskCanceledRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTaskToken() {
+    if err := oprot.WriteFieldBegin("taskToken", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:taskToken: ", p), err) }
+    if err := oprot.WriteBinary(p.TaskToken); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.taskToken (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:taskToken: ", p), err) }
+  }
+  return err
+}
+
+func (p *RespondActivityTaskCanceledRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDetails() {
+    if err := oprot.WriteFieldBegin("details", thrift.STRING, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:details: ", p), err) }
+    if err := oprot.WriteBinary(p.Details); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.details (20) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:details: ", p), err) }
+  }
+  return err
+}
+
+func (p *RespondActivityTaskCanceledRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetIdentity() {
+    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:identity: ", p), err) }
+    if err := oprot.WriteString(string(*p.Identity)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.identity (30) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:identity: ", p), err) }
+  }
+  return err
+}
+
+func (p *RespondActivityTaskCanceledRequest) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("RespondActivityTaskCanceledRequest(%+v)", *p)
+}
+
+// Attributes:
+//  - Domain
+//  - WorkflowExecution
+//  - Identity
+//  - TerminateAfterSeconds
+//  - RequestId
+type RequestCancelWorkflowExecutionRequest struct {
+  // unused fields # 1 to 9
+  Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
+  // unused fields # 11 to 19
+  WorkflowExecution *WorkflowExecution `thrift:"workflowExecution,20" db:"workflowExecution" json:"workflowExecution,omitempty"`
+  // unused fields # 21 to 29
+  Identity *string `thrift:"identity,30" db:"identity" json:"identity,omitempty"`
+  // unused fields # 31 to 39
+  TerminateAfterSeconds *int32 `thrift:"terminateAfterSeconds,40" db:"terminateAfterSeconds" json:"terminateAfterSeconds,omitempty"`
+  // unused fields # 41 to 49
+  RequestId *string `thrift:"requestId,50" db:"requestId" json:"requestId,omitempty"`
+}
+
+func NewRequestCancelWorkflowExecutionRequest() *RequestCancelWorkflowExecutionRequest {
+  return &RequestCancelWorkflowExecutionRequest{}
+}
+
+var RequestCancelWorkflowExecutionRequest_Domain_DEFAULT string
+func (p *RequestCancelWorkflowExecutionRequest) GetDomain() string {
+  if !p.IsSetDomain() {
+    return RequestCancelWorkflowExecutionRequest_Domain_DEFAULT
+  }
+return *p.Domain
+}
+var RequestCancelWorkflowExecutionRequest_WorkflowExecution_DEFAULT *WorkflowExecution
+func (p *RequestCancelWorkflowExecutionRequest) GetWorkflowExecution() *WorkflowExecution {
+  if !p.IsSetWorkflowExecution() {
+    return RequestCancelWorkflowExecutionRequest_WorkflowExecution_DEFAULT
+  }
+return p.WorkflowExecution
+}
+var RequestCancelWorkflowExecutionRequest_Identity_DEFAULT string
+func (p *RequestCancelWorkflowExecutionRequest) GetIdentity() string {
+  if !p.IsSetIdentity() {
+    return RequestCancelWorkflowExecutionRequest_Identity_DEFAULT
+  }
+return *p.Identity
+}
+var RequestCancelWorkflowExecutionRequest_TerminateAfterSeconds_DEFAULT int32
+func (p *RequestCancelWorkflowExecutionRequest) GetTerminateAfterSeconds() int32 {
+  if !p.IsSetTerminateAfterSeconds() {
+    return RequestCancelWorkflowExecutionRequest_TerminateAfterSeconds_DEFAULT
+  }
+return *p.TerminateAfterSeconds
+}
+var RequestCancelWorkflowExecutionRequest_RequestId_DEFAULT string
+func (p *RequestCancelWorkflowExecutionRequest) GetRequestId() string {
+  if !p.IsSetRequestId() {
+    return RequestCancelWorkflowExecutionRequest_RequestId_DEFAULT
+  }
+return *p.RequestId
+}
+func (p *RequestCancelWorkflowExecutionRequest) IsSetDomain() bool {
+  return p.Domain != nil
+}
+
+func (p *RequestCancelWorkflowExecutionRequest) IsSetWorkflowExecution() bool {
+  return p.WorkflowExecution != nil
+}
+
+func (p *RequestCancelWorkflowExecutionRequest) IsSetIdentity() bool {
+  return p.Identity != nil
+}
+
+func (p *RequestCancelWorkflowExecutionRequest) IsSetTerminateAfterSeconds() bool {
+  return p.TerminateAfterSeconds != nil
+}
+
+func (p *RequestCancelWorkflowExecutionRequest) IsSetRequestId() bool {
+  return p.RequestId != nil
+}
+
+func (p *RequestCancelWorkflowExecutionRequest) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
+    case 50:
+      if err := p.ReadField50(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *RequestCancelWorkflowExecutionRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.Domain = &v
+}
+  return nil
+}
+
+func (p *RequestCancelWorkflowExecutionRequest)  ReadField20(iprot thrift.TProtocol) error {
+  p.WorkflowExecution = &WorkflowExecution{}
+  if err := p.WorkflowExecution.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecution), err)
+  }
+  return nil
+}
+
+func (p *RequestCancelWorkflowExecutionRequest)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
+} else {
+  p.Identity = &v
+}
+  return nil
+}
+
+func (p *RequestCancelWorkflowExecutionRequest)  ReadField40(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 40: ", err)
+} else {
+  p.TerminateAfterSeconds = &v
+}
+  return nil
+}
+
+func (p *RequestCancelWorkflowExecutionRequest)  ReadField50(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 50: ", err)
+} else {
+  p.RequestId = &v
+}
+  return nil
+}
+
+func (p *RequestCancelWorkflowExecutionRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RequestCancelWorkflowExecutionRequest"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
+    if err := p.writeField40(oprot); err != nil { return err }
+    if err := p.writeField50(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *RequestCancelWorkflowExecutionRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomain() {
+    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
+    if err := oprot.WriteString(string(*p.Domain)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domain (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domain: ", p), err) }
+  }
+  return err
+}
+
+func (p *RequestCancelWorkflowExecutionRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowExecution() {
+    if err := oprot.WriteFieldBegin("workflowExecution", thrift.STRUCT, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:workflowExecution: ", p), err) }
+    if err := p.WorkflowExecution.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowExecution), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:workflowExecution: ", p), err) }
+  }
+  return err
+}
+
+func (p *RequestCancelWorkflowExecutionRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetIdentity() {
+    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:identity: ", p), err) }
+    if err := oprot.WriteString(string(*p.Identity)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.identity (30) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:identity: ", p), err) }
+  }
+  return err
+}
+
+func (p *RequestCancelWorkflowExecutionRequest) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTerminateAfterSeconds() {
+    if err := oprot.WriteFieldBegin("terminateAfterSeconds", thrift.I32, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:terminateAfterSeconds: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.TerminateAfterSeconds)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.terminateAfterSeconds (40) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:terminateAfterSeconds: ", p), err) }
+  }
+  return err
+}
+
+func (p *RequestCancelWorkflowExecutionRequest) writeField50(oprot thrift.TProtocol) (err error) {
+  if p.IsSetRequestId() {
+    if err := oprot.WriteFieldBegin("requestId", thrift.STRING, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:requestId: ", p), err) }
+    if err := oprot.WriteString(string(*p.RequestId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.requestId (50) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:requestId: ", p), err) }
+  }
+  return err
+}
+
+func (p *RequestCancelWorkflowExecutionRequest) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("RequestCancelWorkflowExecutionRequest(%+v)", *p)
+}
+
+// Attributes:
+//  - Domain
+//  - Execution
+//  - MaximumPageSize
+//  - NextPageToken
+type GetWorkflowExecutionHistoryRequest struct {
+  // unused fields # 1 to 9
+  Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
+  // unused fields # 11 to 19
+  Execution *WorkflowExecution `thrift:"execution,20" db:"execution" json:"execution,omitempty"`
+  // unused fields # 21 to 29
+  MaximumPageSize *int32 `thrift:"maximumPageSize,30" db:"maximumPageSize" json:"maximumPageSize,omitempty"`
+  // unused fields # 31 to 39
+  NextPageToken []byte `thrift:"nextPageToken,40" db:"nextPageToken" json:"nextPageToken,omitempty"`
+}
+
+func NewGetWorkflowExecutionHistoryRequest() *GetWorkflowExecutionHistoryRequest {
+  return &GetWorkflowExecutionHistoryRequest{}
+}
+
+var GetWorkflowExecutionHistoryRequest_Domain_DEFAULT string
+func (p *GetWorkflowExecutionHistoryRequest) GetDomain() string {
+  if !p.IsSetDomain() {
+    return GetWorkflowExecutionHistoryRequest_Domain_DEFAULT
+  }
+return *p.Domain
+}
+var GetWorkflowExecutionHistoryRequest_Execution_DEFAULT *WorkflowExecution
+func (p *GetWorkflowExecutionHistoryRequest) GetExecution() *WorkflowExecution {
+  if !p.IsSetExecution() {
+    return GetWorkflowExecutionHistoryRequest_Execution_DEFAULT
+  }
+return p.Execution
+}
+var GetWorkflowExecutionHistoryRequest_MaximumPageSize_DEFAULT int32
+func (p *GetWorkflowExecutionHistoryRequest) GetMaximumPageSize() int32 {
+  if !p.IsSetMaximumPageSize() {
+    return GetWorkflowExecutionHistoryRequest_MaximumPageSize_DEFAULT
+  }
+return *p.MaximumPageSize
+}
+var GetWorkflowExecutionHistoryRequest_NextPageToken_DEFAULT []byte
+
+func (p *GetWorkflowExecutionHistoryRequest) GetNextPageToken() []byte {
+  return p.NextPageToken
+}
+func (p *GetWorkflowExecutionHistoryRequest) IsSetDomain() bool {
+  return p.Domain != nil
+}
+
+func (p *GetWorkflowExecutionHistoryRequest) IsSetExecution() bool {
+  return p.Execution != nil
+}
+
+func (p *GetWorkflowExecutionHistoryRequest) IsSetMaximumPageSize() bool {
+  return p.MaximumPageSize != nil
+}
+
+func (p *GetWorkflowExecutionHistoryRequest) IsSetNextPageToken() bool {
+  return p.NextPageToken != nil
+}
+
+func (p *GetWorkflowExecutionHistoryRequest) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *GetWorkflowExecutionHistoryRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.Domain = &v
+}
+  return nil
+}
+
+func (p *GetWorkflowExecutionHistoryRequest)  ReadField20(iprot thrift.TProtocol) error {
+  p.Execution = &WorkflowExecution{}
+  if err := p.Execution.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Execution), err)
+  }
+  return nil
+}
+
+func (p *GetWorkflowExecutionHistoryRequest)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
+} else {
+  p.MaximumPageSize = &v
+}
+  return nil
+}
+
+func (p *GetWorkflowExecutionHistoryRequest)  ReadField40(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 40: ", err)
+} else {
+  p.NextPageToken = v
+}
+  return nil
+}
+
+func (p *GetWorkflowExecutionHistoryRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetWorkflowExecutionHistoryRequest"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
+    if err := p.writeField40(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *GetWorkflowExecutionHistoryRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomain() {
+    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
+    if err := oprot.WriteString(string(*p.Domain)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domain (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domain: ", p), err) }
+  }
+  return err
+}
+
+func (p *GetWorkflowExecutionHistoryRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetExecution() {
+    if err := oprot.WriteFieldBegin("execution", thrift.STRUCT, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:execution: ", p), err) }
+    if err := p.Execution.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Execution), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:execution: ", p), err) }
+  }
+  return err
+}
+
+func (p *GetWorkflowExecutionHistoryRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetMaximumPageSize() {
+    if err := oprot.WriteFieldBegin("maximumPageSize", thrift.I32, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:maximumPageSize: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.MaximumPageSize)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.maximumPageSize (30) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:maximumPageSize: ", p), err) }
+  }
+  return err
+}
+
+func (p *GetWorkflowExecutionHistoryRequest) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetNextPageToken() {
+    if err := oprot.WriteFieldBegin("nextPageToken", thrift.STRING, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:nextPageToken: ", p), err) }
+    if err := oprot.WriteBinary(p.NextPageToken); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.nextPageToken (40) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:nextPageToken: ", p), err) }
+  }
+  return err
+}
+
+func (p *GetWorkflowExecutionHistoryRequest) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("GetWorkflowExecutionHistoryRequest(%+v)", *p)
+}
+
+// Attributes:
+//  - History
+//  - NextPageToken
+type GetWorkflowExecutionHistoryResponse struct {
+  // unused fields # 1 to 9
+  History *History `thrift:"history,10" db:"history" json:"history,omitempty"`
+  // unused fields # 11 to 19
+  NextPageToken []byte `thrift:"nextPageToken,20" db:"nextPageToken" json:"nextPageToken,omitempty"`
+}
+
+func NewGetWorkflowExecutionHistoryResponse() *GetWorkflowExecutionHistoryResponse {
+  return &GetWorkflowExecutionHistoryResponse{}
+}
+
+var GetWorkflowExecutionHistoryResponse_History_DEFAULT *History
+func (p *GetWorkflowExecutionHistoryResponse) GetHistory() *History {
+  if !p.IsSetHistory() {
+    return GetWorkflowExecutionHistoryResponse_History_DEFAULT
+  }
+return p.History
+}
+var GetWorkflowExecutionHistoryResponse_NextPageToken_DEFAULT []byte
+
+func (p *GetWorkflowExecutionHistoryResponse) GetNextPageToken() []byte {
+  return p.NextPageToken
+}
+func (p *GetWorkflowExecutionHistoryResponse) IsSetHistory() bool {
+  return p.History != nil
+}
+
+func (p *GetWorkflowExecutionHistoryResponse) IsSetNextPageToken() bool {
+  return p.NextPageToken != nil
+}
+
+func (p *GetWorkflowExecutionHistoryResponse) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *GetWorkflowExecutionHistoryResponse)  ReadField10(iprot thrift.TProtocol) error {
+  p.History = &History{}
+  if err := p.History.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.History), err)
+  }
+  return nil
+}
+
+func (p *GetWorkflowExecutionHistoryResponse)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 20: ", err)
+} else {
+  p.NextPageToken = v
+}
+  return nil
+}
+
+func (p *GetWorkflowExecutionHistoryResponse) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetWorkflowExecutionHistoryResponse"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *GetWorkflowExecutionHistoryResponse) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetHistory() {
+    if err := oprot.WriteFieldBegin("history", thrift.STRUCT, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:history: ", p), err) }
+    if err := p.History.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.History), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:history: ", p), err) }
+  }
+  return err
+}
+
+func (p *GetWorkflowExecutionHistoryResponse) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetNextPageToken() {
+    if err := oprot.WriteFieldBegin("nextPageToken", thrift.STRING, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:nextPageToken: ", p), err) }
+    if err := oprot.WriteBinary(p.NextPageToken); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.nextPageToken (20) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:nextPageToken: ", p), err) }
+  }
+  return err
+}
+
+func (p *GetWorkflowExecutionHistoryResponse) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("GetWorkflowExecutionHistoryResponse(%+v)", *p)
+}
+
+// Attributes:
+//  - Domain
+//  - Execution
+//  - NextPageToken
+type GetWorkflowResultRequest struct {
+  // unused fields # 1 to 9
+  Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
+  // unused fields # 11 to 19
+  Execution *WorkflowExecution `thrift:"execution,20" db:"execution" json:"execution,omitempty"`
+  // unused fields # 21 to 29
+  NextPageToken []byte `thrift:"nextPageToken,30" db:"nextPageToken" json:"nextPageToken,omitempty"`
+}
+
+func NewGetWorkflowResultRequest() *GetWorkflowResultRequest {
+  return &GetWorkflowResultRequest{}
+}
+
+var GetWorkflowResultRequest_Domain_DEFAULT string
+func (p *GetWorkflowResultRequest) GetDomain() string {
+  if !p.IsSetDomain() {
+    return GetWorkflowResultRequest_Domain_DEFAULT
+  }
+return *p.Domain
+}
+var GetWorkflowResultRequest_Execution_DEFAULT *WorkflowExecution
+func (p *GetWorkflowResultRequest) GetExecution() *WorkflowExecution {
+  if !p.IsSetExecution() {
+    return GetWorkflowResultRequest_Execution_DEFAULT
+  }
+return p.Execution
+}
+var GetWorkflowResultRequest_NextPageToken_DEFAULT []byte
+
+func (p *GetWorkflowResultRequest) GetNextPageToken() []byte {
+  return p.NextPageToken
+}
+func (p *GetWorkflowResultRequest) IsSetDomain() bool {
+  return p.Domain != nil
+}
+
+func (p *GetWorkflowResultRequest) IsSetExecution() bool {
+  return p.Execution != nil
+}
+
+func (p *GetWorkflowResultRequest) IsSetNextPageToken() bool {
+  return p.NextPageToken != nil
+}
+
+func (p *GetWorkflowResultRequest) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *GetWorkflowResultRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.Domain = &v
+}
+  return nil
+}
+
+func (p *GetWorkflowResultRequest)  ReadField20(iprot thrift.TProtocol) error {
+  p.Execution = &WorkflowExecution{}
+  if err := p.Execution.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Execution), err)
+  }
+  return nil
+}
+
+func (p *GetWorkflowResultRequest)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
+} else {
+  p.NextPageToken = v
+}
+  return nil
+}
+
+func (p *GetWorkflowResultRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetWorkflowResultRequest"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *GetWorkflowResultRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomain() {
+    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
+    if err := oprot.WriteString(string(*p.Domain)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domain (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domain: ", p), err) }
+  }
+  return err
+}
+
+func (p *GetWorkflowResultRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetExecution() {
+    if err := oprot.WriteFieldBegin("execution", thrift.STRUCT, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:execution: ", p), err) }
+    if err := p.Execution.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Execution), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:execution: ", p), err) }
+  }
+  return err
+}
+
+func (p *GetWorkflowResultRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetNextPageToken() {
+    if err := oprot.WriteFieldBegin("nextPageToken", thrift.STRING, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:nextPageToken: ", p), err) }
+    if err := oprot.WriteBinary(p.NextPageToken); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.nextPageToken (30) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:nextPageToken: ", p), err) }
+  }
+  return err
+}
+
+func (p *GetWorkflowResultRequest) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("GetWorkflowResultRequest(%+v)", *p)
+}
+
+// Attributes:
+//  - CloseEvent
+//  - NextPageToken
+type GetWorkflowResultResponse struct {
+  // unused fields # 1 to 9
+  CloseEvent *HistoryEvent `thrift:"closeEvent,10" db:"closeEvent" json:"closeEvent,omitempty"`
+  // unused fields # 11 to 19
+  NextPageToken []byte `thrift:"nextPageToken,20" db:"nextPageToken" json:"nextPageToken,omitempty"`
+}
+
+func NewGetWorkflowResultResponse() *GetWorkflowResultResponse {
+  return &GetWorkflowResultResponse{}
+}
+
+var GetWorkflowResultResponse_CloseEvent_DEFAULT *HistoryEvent
+func (p *GetWorkflowResultResponse) GetCloseEvent() *HistoryEvent {
+  if !p.IsSetCloseEvent() {
+    return GetWorkflowResultResponse_CloseEvent_DEFAULT
+  }
+return p.CloseEvent
+}
+var GetWorkflowResultResponse_NextPageToken_DEFAULT []byte
+
+func (p *GetWorkflowResultResponse) GetNextPageToken() []byte {
+  return p.NextPageToken
+}
+func (p *GetWorkflowResultResponse) IsSetCloseEvent() bool {
+  return p.CloseEvent != nil
+}
+
+func (p *GetWorkflowResultResponse) IsSetNextPageToken() bool {
+  return p.NextPageToken != nil
+}
+
+func (p *GetWorkflowResultResponse) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *GetWorkflowResultResponse)  ReadField10(iprot thrift.TProtocol) error {
+  p.CloseEvent = &HistoryEvent{}
+  if err := p.CloseEvent.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CloseEvent), err)
+  }
+  return nil
+}
+
+func (p *GetWorkflowResultResponse)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 20: ", err)
+} else {
+  p.NextPageToken = v
+}
+  return nil
+}
+
+func (p *GetWorkflowResultResponse) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetWorkflowResultResponse"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *GetWorkflowResultResponse) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetCloseEvent() {
+    if err := oprot.WriteFieldBegin("closeEvent", thrift.STRUCT, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:closeEvent: ", p), err) }
+    if err := p.CloseEvent.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CloseEvent), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:closeEvent: ", p), err) }
+  }
+  return err
+}
+
+func (p *GetWorkflowResultResponse) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetNextPageToken() {
+    if err := oprot.WriteFieldBegin("nextPageToken", thrift.STRING, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:nextPageToken: ", p), err) }
+    if err := oprot.WriteBinary(p.NextPageToken); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.nextPageToken (20) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:nextPageToken: ", p), err) }
+  }
+  return err
+}
+
+func (p *GetWorkflowResultResponse) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("GetWorkflowResultResponse(%+v)", *p)
+}
+
+// Attributes:
+//  - Domain
+//  - WorkflowExecution
+//  - SignalName
+//  - Input
+//  - Identity
+//  - RequestId
+type SignalWorkflowExecutionRequest struct {
+  // unused fields # 1 to 9
+  Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
+  // unused fields # 11 to 19
+  WorkflowExecution *WorkflowExecution `thrift:"workflowExecution,20" db:"workflowExecution" json:"workflowExecution,omitempty"`
+  // unused fields # 21 to 29
+  SignalName *string `thrift:"signalName,30" db:"signalName" json:"signalName,omitempty"`
+  // unused fields # 31 to 39
+  Input []byte `thrift:"input,40" db:"input" json:"input,omitempty"`
+  // unused fields # 41 to 49
+  Identity *string `thrift:"identity,50" db:"identity" json:"identity,omitempty"`
+  // unused fields # 51 to 59
+  RequestId *string `thrift:"requestId,60" db:"requestId" json:"requestId,omitempty"`
+}
+
+func NewSignalWorkflowExecutionRequest() *SignalWorkflowExecutionRequest {
+  return &SignalWorkflowExecutionRequest{}
+}
+
+var SignalWorkflowExecutionRequest_Domain_DEFAULT string
+func (p *SignalWorkflowExecutionRequest) GetDomain() string {
+  if !p.IsSetDomain() {
+    return SignalWorkflowExecutionRequest_Domain_DEFAULT
+  }
+return *p.Domain
+}
+var SignalWorkflowExecutionRequest_WorkflowExecution_DEFAULT *WorkflowExecution
+func (p *SignalWorkflowExecutionRequest) GetWorkflowExecution() *WorkflowExecution {
+  if !p.IsSetWorkflowExecution() {
+    return SignalWorkflowExecutionRequest_WorkflowExecution_DEFAULT
+  }
+return p.WorkflowExecution
+}
+var SignalWorkflowExecutionRequest_SignalName_DEFAULT string
+func (p *SignalWorkflowExecutionRequest) GetSignalName() string {
+  if !p.IsSetSignalName() {
+    return SignalWorkflowExecutionRequest_SignalName_DEFAULT
+  }
+return *p.SignalName
+}
+var SignalWorkflowExecutionRequest_Input_DEFAULT []byte
+
+func (p *SignalWorkflowExecutionRequest) GetInput() []byte {
+  return p.Input
+}
+var SignalWorkflowExecutionRequest_Identity_DEFAULT string
+func (p *SignalWorkflowExecutionRequest) GetIdentity() string {
+  if !p.IsSetIdentity() {
+    return SignalWorkflowExecutionRequest_Identity_DEFAULT
+  }
+return *p.Identity
+}
+var SignalWorkflowExecutionRequest_RequestId_DEFAULT string
+func (p *SignalWorkflowExecutionRequest) GetRequestId() string {
+  if !p.IsSetRequestId() {
+    return SignalWorkflowExecutionRequest_RequestId_DEFAULT
+  }
+return *p.RequestId
+}
+func (p *SignalWorkflowExecutionRequest) IsSetDomain() bool {
+  return p.Domain != nil
+}
+
+func (p *SignalWorkflowExecutionRequest) IsSetWorkflowExecution() bool {
+  return p.WorkflowExecution != nil
+}
+
+func (p *SignalWorkflowExecutionRequest) IsSetSignalName() bool {
+  return p.SignalName != nil
+}
+
+func (p *SignalWorkflowExecutionRequest) IsSetInput() bool {
+  return p.Input != nil
+}
+
+func (p *SignalWorkflowExecutionRequest) IsSetIdentity() bool {
+  return p.Identity != nil
+}
+
+func (p *SignalWorkflowExecutionRequest) IsSetRequestId() bool {
+  return p.RequestId != nil
+}
+
+func (p *SignalWorkflowExecutionRequest) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
+    case 50:
+      if err := p.ReadField50(iprot); err != nil {
+        return err
+      }
+    case 60:
+      if err := p.ReadField60(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *SignalWorkflowExecutionRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.Domain = &v
+}
+  return nil
+}
+
+func (p *SignalWorkflowExecutionRequest)  ReadField20(iprot thrift.TProtocol) error {
+  p.WorkflowExecution = &WorkflowExecution{}
+  if err := p.WorkflowExecution.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecution), err)
+  }
+  return nil
+}
+
+func (p *SignalWorkflowExecutionRequest)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
+} else {
+  p.SignalName = &v
+}
+  return nil
+}
+
+func (p *SignalWorkflowExecutionRequest)  ReadField40(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 40: ", err)
+} else {
+  p.Input = v
+}
+  return nil
+}
+
+func (p *SignalWorkflowExecutionRequest)  ReadField50(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 50: ", err)
+} else {
+  p.Identity = &v
+}
+  return nil
+}
+
+func (p *SignalWorkflowExecutionRequest)  ReadField60(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 60: ", err)
+} else {
+  p.RequestId = &v
+}
+  return nil
+}
+
+func (p *SignalWorkflowExecutionRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("SignalWorkflowExecutionRequest"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
+    if err := p.writeField40(oprot); err != nil { return err }
+    if err := p.writeField50(oprot); err != nil { return err }
+    if err := p.writeField60(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *SignalWorkflowExecutionRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomain() {
+    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
+    if err := oprot.WriteString(string(*p.Domain)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domain (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domain: ", p), err) }
+  }
+  return err
+}
+
+func (p *SignalWorkflowExecutionRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowExecution() {
+    if err := oprot.WriteFieldBegin("workflowExecution", thrift.STRUCT, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:workflowExecution: ", p), err) }
+    if err := p.WorkflowExecution.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowExecution), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:workflowExecution: ", p), err) }
+  }
+  return err
+}
+
+func (p *SignalWorkflowExecutionRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSignalName() {
+    if err := oprot.WriteFieldBegin("signalName", thrift.STRING, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:signalName: ", p), err) }
+    if err := oprot.WriteString(string(*p.SignalName)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.signalName (30) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:signalName: ", p), err) }
+  }
+  return err
+}
+
+func (p *SignalWorkflowExecutionRequest) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInput() {
+    if err := oprot.WriteFieldBegin("input", thrift.STRING, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:input: ", p), err) }
+    if err := oprot.WriteBinary(p.Input); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.input (40) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:input: ", p), err) }
+  }
+  return err
+}
+
+func (p *SignalWorkflowExecutionRequest) writeField50(oprot thrift.TProtocol) (err error) {
+  if p.IsSetIdentity() {
+    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:identity: ", p), err) }
+    if err := oprot.WriteString(string(*p.Identity)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.identity (50) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:identity: ", p), err) }
+  }
+  return err
+}
+
+func (p *SignalWorkflowExecutionRequest) writeField60(oprot thrift.TProtocol) (err error) {
+  if p.IsSetRequestId() {
+    if err := oprot.WriteFieldBegin("requestId", thrift.STRING, 60); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:requestId: ", p), err) }
+    if err := oprot.WriteString(string(*p.RequestId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.requestId (60) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:requestId: ", p), err) }
+  }
+  return err
+}
+
+func (p *SignalWorkflowExecutionRequest) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("SignalWorkflowExecutionRequest(%+v)", *p)
+}
+
+// Attributes:
+//  - Domain
+//  - WorkflowId
+//  - WorkflowType
+//  - TaskList
+//  - Input
+//  - ExecutionStartToCloseTimeoutSeconds
+//  - TaskStartToCloseTimeoutSeconds
+//  - Identity
+//  - RequestId
+//  - SearchAttributes
+//  - CompletionCallbackUrl
+//  - SignalName
+//  - SignalInput
+type SignalWithStartWorkflowExecutionRequest struct {
+  // unused fields # 1 to 9
+  Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
+  // unused fields # 11 to 19
+  WorkflowId *string `thrift:"workflowId,20" db:"workflowId" json:"workflowId,omitempty"`
+  // unused fields # 21 to 29
+  WorkflowType *WorkflowType `thrift:"workflowType,30" db:"workflowType" json:"workflowType,omitempty"`
+  // unused fields # 31 to 39
+  TaskList *TaskList `thrift:"taskList,40" db:"taskList" json:"taskList,omitempty"`
+  // unused fields # 41 to 49
+  Input []byte `thrift:"input,50" db:"input" json:"input,omitempty"`
+  // unused fields # 51 to 59
+  ExecutionStartToCloseTimeoutSeconds *int32 `thrift:"executionStartToCloseTimeoutSeconds,60" db:"executionStartToCloseTimeoutSeconds" json:"executionStartToCloseTimeoutSeconds,omitempty"`
+  // unused fields # 61 to 69
+  TaskStartToCloseTimeoutSeconds *int32 `thrift:"taskStartToCloseTimeoutSeconds,70" db:"taskStartToCloseTimeoutSeconds" json:"taskStartToCloseTimeoutSeconds,omitempty"`
+  // unused fields # 71 to 79
+  Identity *string `thrift:"identity,80" db:"identity" json:"identity,omitempty"`
+  // unused fields # 81 to 89
+  RequestId *string `thrift:"requestId,90" db:"requestId" json:"requestId,omitempty"`
+  // unused fields # 91 to 99
+  SearchAttributes *SearchAttributes `thrift:"searchAttributes,100" db:"searchAttributes" json:"searchAttributes,omitempty"`
+  // unused fields # 101 to 109
+  CompletionCallbackUrl *string `thrift:"completionCallbackUrl,110" db:"completionCallbackUrl" json:"completionCallbackUrl,omitempty"`
+  // unused fields # 111 to 119
+  SignalName *string `thrift:"signalName,120" db:"signalName" json:"signalName,omitempty"`
+  // unused fields # 121 to 129
+  SignalInput []byte `thrift:"signalInput,130" db:"signalInput" json:"signalInput,omitempty"`
+}
+
+func NewSignalWithStartWorkflowExecutionRequest() *SignalWithStartWorkflowExecutionRequest {
+  return &SignalWithStartWorkflowExecutionRequest{}
+}
+
+var SignalWithStartWorkflowExecutionRequest_Domain_DEFAULT string
+func (p *SignalWithStartWorkflowExecutionRequest) GetDomain() string {
+  if !p.IsSetDomain() {
+    return SignalWithStartWorkflowExecutionRequest_Domain_DEFAULT
+  }
+return *p.Domain
+}
+var SignalWithStartWorkflowExecutionRequest_WorkflowId_DEFAULT string
+func (p *SignalWithStartWorkflowExecutionRequest) GetWorkflowId() string {
+  if !p.IsSetWorkflowId() {
+    return SignalWithStartWorkflowExecutionRequest_WorkflowId_DEFAULT
+  }
+return *p.WorkflowId
+}
+var SignalWithStartWorkflowExecutionRequest_WorkflowType_DEFAULT *WorkflowType
+func (p *SignalWithStartWorkflowExecutionRequest) GetWorkflowType() *WorkflowType {
+  if !p.IsSetWorkflowType() {
+    return SignalWithStartWorkflowExecutionRequest_WorkflowType_DEFAULT
+  }
+return p.WorkflowType
+}
+var SignalWithStartWorkflowExecutionRequest_TaskList_DEFAULT *TaskList
+func (p *SignalWithStartWorkflowExecutionRequest) GetTaskList() *TaskList {
+  if !p.IsSetTaskList() {
+    return SignalWithStartWorkflowExecutionRequest_TaskList_DEFAULT
+  }
+return p.TaskList
+}
+var SignalWithStartWorkflowExecutionRequest_Input_DEFAULT []byte
+
+func (p *SignalWithStartWorkflowExecutionRequest) GetInput() []byte {
+  return p.Input
+}
+var SignalWithStartWorkflowExecutionRequest_ExecutionStartToCloseTimeoutSeconds_DEFAULT int32
+func (p *SignalWithStartWorkflowExecutionRequest) GetExecutionStartToCloseTimeoutSeconds() int32 {
+  if !p.IsSetExecutionStartToCloseTimeoutSeconds() {
+    return SignalWithStartWorkflowExecutionRequest_ExecutionStartToCloseTimeoutSeconds_DEFAULT
+  }
+return *p.ExecutionStartToCloseTimeoutSeconds
+}
+var SignalWithStartWorkflowExecutionRequest_TaskStartToCloseTimeoutSeconds_DEFAULT int32
+func (p *SignalWithStartWorkflowExecutionRequest) GetTaskStartToCloseTimeoutSeconds() int32 {
+  if !p.IsSetTaskStartToCloseTimeoutSeconds() {
+    return SignalWithStartWorkflowExecutionRequest_TaskStartToCloseTimeoutSeconds_DEFAULT
+  }
+return *p.TaskStartToCloseTimeoutSeconds
+}
+var SignalWithStartWorkflowExecutionRequest_Identity_DEFAULT string
+func (p *SignalWithStartWorkflowExecutionRequest) GetIdentity() string {
+  if !p.IsSetIdentity() {
+    return SignalWithStartWorkflowExecutionRequest_Identity_DEFAULT
+  }
+return *p.Identity
+}
+var SignalWithStartWorkflowExecutionRequest_RequestId_DEFAULT string
+func (p *SignalWithStartWorkflowExecutionRequest) GetRequestId() string {
+  if !p.IsSetRequestId() {
+    return SignalWithStartWorkflowExecutionRequest_RequestId_DEFAULT
+  }
+return *p.RequestId
+}
+var SignalWithStartWorkflowExecutionRequest_SearchAttributes_DEFAULT *SearchAttributes
+func (p *SignalWithStartWorkflowExecutionRequest) GetSearchAttributes() *SearchAttributes {
+  if !p.IsSetSearchAttributes() {
+    return SignalWithStartWorkflowExecutionRequest_SearchAttributes_DEFAULT
+  }
+return p.SearchAttributes
+}
+var SignalWithStartWorkflowExecutionRequest_CompletionCallbackUrl_DEFAULT string
+func (p *SignalWithStartWorkflowExecutionRequest) GetCompletionCallbackUrl() string {
+  if !p.IsSetCompletionCallbackUrl() {
+    return SignalWithStartWorkflowExecutionRequest_CompletionCallbackUrl_DEFAULT
+  }
+return *p.CompletionCallbackUrl
+}
+var SignalWithStartWorkflowExecutionRequest_SignalName_DEFAULT string
+func (p *SignalWithStartWorkflowExecutionRequest) GetSignalName() string {
+  if !p.IsSetSignalName() {
+    return SignalWithStartWorkflowExecutionRequest_SignalName_DEFAULT
+  }
+return *p.SignalName
+}
+var SignalWithStartWorkflowExecutionRequest_SignalInput_DEFAULT []byte
+
+func (p *SignalWithStartWorkflowExecutionRequest) GetSignalInput() []byte {
+  return p.SignalInput
+}
+func (p *SignalWithStartWorkflowExecutionRequest) IsSetDomain() bool {
+  return p.Domain != nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) IsSetWorkflowId() bool {
+  return p.WorkflowId != nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) IsSetWorkflowType() bool {
+  return p.WorkflowType != nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) IsSetTaskList() bool {
+  return p.TaskList != nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) IsSetInput() bool {
+  return p.Input != nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) IsSetExecutionStartToCloseTimeoutSeconds() bool {
+  return p.ExecutionStartToCloseTimeoutSeconds != nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) IsSetTaskStartToCloseTimeoutSeconds() bool {
+  return p.TaskStartToCloseTimeoutSeconds != nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) IsSetIdentity() bool {
+  return p.Identity != nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) IsSetRequestId() bool {
+  return p.RequestId != nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) IsSetSearchAttributes() bool {
+  return p.SearchAttributes != nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) IsSetCompletionCallbackUrl() bool {
+  return p.CompletionCallbackUrl != nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) IsSetSignalName() bool {
+  return p.SignalName != nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) IsSetSignalInput() bool {
+  return p.SignalInput != nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
+    case 50:
+      if err := p.ReadField50(iprot); err != nil {
+        return err
+      }
+    case 60:
+      if err := p.ReadField60(iprot); err != nil {
+        return err
+      }
+    case 70:
+      if err := p.ReadField70(iprot); err != nil {
+        return err
+      }
+    case 80:
+      if err := p.ReadField80(iprot); err != nil {
+        return err
+      }
+    case 90:
+      if err := p.ReadField90(iprot); err != nil {
+        return err
+      }
+    case 100:
+      if err := p.ReadField100(iprot); err != nil {
+        return err
+      }
+    case 110:
+      if err := p.ReadField110(iprot); err != nil {
+        return err
+      }
+    case 120:
+      if err := p.ReadField120(iprot); err != nil {
+        return err
+      }
+    case 130:
+      if err := p.ReadField130(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.Domain = &v
+}
+  return nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 20: ", err)
+} else {
+  p.WorkflowId = &v
+}
+  return nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest)  ReadField30(iprot thrift.TProtocol) error {
+  p.WorkflowType = &WorkflowType{}
+  if err := p.WorkflowType.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowType), err)
+  }
+  return nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest)  ReadField40(iprot thrift.TProtocol) error {
+  p.TaskList = &TaskList{}
+  if err := p.TaskList.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TaskList), err)
+  }
+  return nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest)  ReadField50(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 50: ", err)
+} else {
+  p.Input = v
+}
+  return nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest)  ReadField60(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 60: ", err)
+} else {
+  p.ExecutionStartToCloseTimeoutSeconds = &v
+}
+  return nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest)  ReadField70(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 70: ", err)
+} else {
+  p.TaskStartToCloseTimeoutSeconds = &v
+}
+  return nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest)  ReadField80(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 80: ", err)
+} else {
+  p.Identity = &v
+}
+  return nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest)  ReadField90(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 90: ", err)
+} else {
+  p.RequestId = &v
+}
+  return nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest)  ReadField100(iprot thrift.TProtocol) error {
+  p.SearchAttributes = &SearchAttributes{}
+  if err := p.SearchAttributes.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.SearchAttributes), err)
+  }
+  return nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest)  ReadField110(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 110: ", err)
+} else {
+  p.CompletionCallbackUrl = &v
+}
+  return nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest)  ReadField120(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 120: ", err)
+} else {
+  p.SignalName = &v
+}
+  return nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest)  ReadField130(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 130: ", err)
+} else {
+  p.SignalInput = v
+}
+  return nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("SignalWithStartWorkflowExecutionRequest"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
+    if err := p.writeField40(oprot); err != nil { return err }
+    if err := p.writeField50(oprot); err != nil { return err }
+    if err := p.writeField60(oprot); err != nil { return err }
+    if err := p.writeField70(oprot); err != nil { return err }
+    if err := p.writeField80(oprot); err != nil { return err }
+    if err := p.writeField90(oprot); err != nil { return err }
+    if err := p.writeField100(oprot); err != nil { return err }
+    if err := p.writeField110(oprot); err != nil { return err }
+    if err := p.writeField120(oprot); err != nil { return err }
+    if err := p.writeField130(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomain() {
+    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
+    if err := oprot.WriteString(string(*p.Domain)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domain (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domain: ", p), err) }
+  }
+  return err
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowId() {
+    if err := oprot.WriteFieldBegin("workflowId", thrift.STRING, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:workflowId: ", p), err) }
+    if err := oprot.WriteString(string(*p.WorkflowId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.workflowId (20) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:workflowId: ", p), err) }
+  }
+  return err
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowType() {
+    if err := oprot.WriteFieldBegin("workflowType", thrift.STRUCT, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:workflowType: ", p), err) }
+    if err := p.WorkflowType.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowType), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:workflowType: ", p), err) }
+  }
+  return err
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTaskList() {
+    if err := oprot.WriteFieldBegin("taskList", thrift.STRUCT, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:taskList: ", p), err) }
+    if err := p.TaskList.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TaskList), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:taskList: ", p), err) }
+  }
+  return err
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) writeField50(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInput() {
+    if err := oprot.WriteFieldBegin("input", thrift.STRING, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:input: ", p), err) }
+    if err := oprot.WriteBinary(p.Input); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.input (50) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:input: ", p), err) }
+  }
+  return err
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) writeField60(oprot thrift.TProtocol) (err error) {
+  if p.IsSetExecutionStartToCloseTimeoutSeconds() {
+    if err := oprot.WriteFieldBegin("executionStartToCloseTimeoutSeconds", thrift.I32, 60); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:executionStartToCloseTimeoutSeconds: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.ExecutionStartToCloseTimeoutSeconds)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.executionStartToCloseTimeoutSeconds (60) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:executionStartToCloseTimeoutSeconds: ", p), err) }
+  }
+  return err
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) writeField70(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTaskStartToCloseTimeoutSeconds() {
+    if err := oprot.WriteFieldBegin("taskStartToCloseTimeoutSeconds", thrift.I32, 70); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 70:taskStartToCloseTimeoutSeconds: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.TaskStartToCloseTimeoutSeconds)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.taskStartToCloseTimeoutSeconds (70) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 70:taskStartToCloseTimeoutSeconds: ", p), err) }
+  }
+  return err
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) writeField80(oprot thrift.TProtocol) (err error) {
+  if p.IsSetIdentity() {
+    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 80); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 80:identity: ", p), err) }
+    if err := oprot.WriteString(string(*p.Identity)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.identity (80) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 80:identity: ", p), err) }
+  }
+  return err
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) writeField90(oprot thrift.TProtocol) (err error) {
+  if p.IsSetRequestId() {
+    if err := oprot.WriteFieldBegin("requestId", thrift.STRING, 90); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 90:requestId: ", p), err) }
+    if err := oprot.WriteString(string(*p.RequestId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.requestId (90) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 90:requestId: ", p), err) }
+  }
+  return err
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) writeField100(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSearchAttributes() {
+    if err := oprot.WriteFieldBegin("searchAttributes", thrift.STRUCT, 100); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 100:searchAttributes: ", p), err) }
+    if err := p.SearchAttributes.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.SearchAttributes), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 100:searchAttributes: ", p), err) }
+  }
+  return err
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) writeField110(oprot thrift.TProtocol) (err error) {
+  if p.IsSetCompletionCallbackUrl() {
+    if err := oprot.WriteFieldBegin("completionCallbackUrl", thrift.STRING, 110); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 110:completionCallbackUrl: ", p), err) }
+    if err := oprot.WriteString(string(*p.CompletionCallbackUrl)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.completionCallbackUrl (110) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 110:completionCallbackUrl: ", p), err) }
+  }
+  return err
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) writeField120(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSignalName() {
+    if err := oprot.WriteFieldBegin("signalName", thrift.STRING, 120); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 120:signalName: ", p), err) }
+    if err := oprot.WriteString(string(*p.SignalName)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.signalName (120) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 120:signalName: ", p), err) }
+  }
+  return err
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) writeField130(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSignalInput() {
+    if err := oprot.WriteFieldBegin("signalInput", thrift.STRING, 130); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 130:signalInput: ", p), err) }
+    if err := oprot.WriteBinary(p.SignalInput); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.signalInput (130) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 130:signalInput: ", p), err) }
+  }
+  return err
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("SignalWithStartWorkflowExecutionRequest(%+v)", *p)
+}
+
+// Attributes:
+//  - Domain
+//  - WorkflowExecution
+//  - Reason
+//  - Details
+//  - Identity
+//  - AdminOverride
+type TerminateWorkflowExecutionRequest struct {
+  // unused fields # 1 to 9
+  Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
+  // unused fields # 11 to 19
+  WorkflowExecution *WorkflowExecution `thrift:"workflowExecution,20" db:"workflowExecution" json:"workflowExecution,omitempty"`
+  // unused fields # 21 to 29
+  Reason *string `thrift:"reason,30" db:"reason" json:"reason,omitempty"`
+  // unused fields # 31 to 39
+  Details []byte `thrift:"details,40" db:"details" json:"details,omitempty"`
+  // unused fields # 41 to 49
+  Identity *string `thrift:"identity,50" db:"identity" json:"identity,omitempty"`
+  // unused fields # 51 to 59
+  AdminOverride *bool `thrift:"adminOverride,60" db:"adminOverride" json:"adminOverride,omitempty"`
+}
+
+func NewTerminateWorkflowExecutionRequest() *TerminateWorkflowExecutionRequest {
+  return &TerminateWorkflowExecutionRequest{}
+}
+
+var TerminateWorkflowExecutionRequest_Domain_DEFAULT string
+func (p *TerminateWorkflowExecutionRequest) GetDomain() string {
+  if !p.IsSetDomain() {
+    return TerminateWorkflowExecutionRequest_Domain_DEFAULT
+  }
+return *p.Domain
+}
+var TerminateWorkflowExecutionRequest_WorkflowExecution_DEFAULT *WorkflowExecution
+func (p *TerminateWorkflowExecutionRequest) GetWorkflowExecution() *WorkflowExecution {
+  if !p.IsSetWorkflowExecution() {
+    return TerminateWorkflowExecutionRequest_WorkflowExecution_DEFAULT
+  }
+return p.WorkflowExecution
+}
+var TerminateWorkflowExecutionRequest_Reason_DEFAULT string
+func (p *TerminateWorkflowExecutionRequest) GetReason() string {
+  if !p.IsSetReason() {
+    return TerminateWorkflowExecutionRequest_Reason_DEFAULT
+  }
+return *p.Reason
+}
+var TerminateWorkflowExecutionRequest_Details_DEFAULT []byte
+
+func (p *TerminateWorkflowExecutionRequest) GetDetails() []byte {
+  return p.Details
+}
+var TerminateWorkflowExecutionRequest_Identity_DEFAULT string
+func (p *TerminateWorkflowExecutionRequest) GetIdentity() string {
+  if !p.IsSetIdentity() {
+    return TerminateWorkflowExecutionRequest_Identity_DEFAULT
+  }
+return *p.Identity
+}
+var TerminateWorkflowExecutionRequest_AdminOverride_DEFAULT bool
+func (p *TerminateWorkflowExecutionRequest) GetAdminOverride() bool {
+  if !p.IsSetAdminOverride() {
+    return TerminateWorkflowExecutionRequest_AdminOverride_DEFAULT
+  }
+return *p.AdminOverride
+}
+func (p *TerminateWorkflowExecutionRequest) IsSetDomain() bool {
+  return p.Domain != nil
+}
+
+func (p *TerminateWorkflowExecutionRequest) IsSetWorkflowExecution() bool {
+  return p.WorkflowExecution != nil
+}
+
+func (p *TerminateWorkflowExecutionRequest) IsSetReason() bool {
+  return p.Reason != nil
+}
+
+func (p *TerminateWorkflowExecutionRequest) IsSetDetails() bool {
+  return p.Details != nil
+}
+
+func (p *TerminateWorkflowExecutionRequest) IsSetIdentity() bool {
+  return p.Identity != nil
+}
+
+func (p *TerminateWorkflowExecutionRequest) IsSetAdminOverride() bool {
+  return p.AdminOverride != nil
+}
+
+func (p *TerminateWorkflowExecutionRequest) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
+    case 50:
+      if err := p.ReadField50(iprot); err != nil {
+        return err
+      }
+    case 60:
+      if err := p.ReadField60(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *TerminateWorkflowExecutionRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.Domain = &v
+}
+  return nil
+}
+
+func (p *TerminateWorkflowExecutionRequest)  ReadField20(iprot thrift.TProtocol) error {
+  p.WorkflowExecution = &WorkflowExecution{}
+  if err := p.WorkflowExecution.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecution), err)
+  }
+  return nil
+}
+
+func (p *TerminateWorkflowExecutionRequest)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
+} else {
+  p.Reason = &v
+}
+  return nil
+}
+
+func (p *TerminateWorkflowExecutionRequest)  ReadField40(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 40: ", err)
+} else {
+  p.Details = v
+}
+  return nil
+}
+
+func (p *TerminateWorkflowExecutionRequest)  ReadField50(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 50: ", err)
+} else {
+  p.Identity = &v
+}
+  return nil
+}
+
+func (p *TerminateWorkflowExecutionRequest)  ReadField60(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(); err != nil {
+  return thrift.PrependError("error reading field 60: ", err)
+} else {
+  p.AdminOverride = &v
+}
+  return nil
+}
+
+func (p *TerminateWorkflowExecutionRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("TerminateWorkflowExecutionRequest"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
+    if err := p.writeField40(oprot); err != nil { return err }
+    if err := p.writeField50(oprot); err != nil { return err }
+    if err := p.writeField60(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *TerminateWorkflowExecutionRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomain() {
+    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
+    if err := oprot.WriteString(string(*p.Domain)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domain (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domain: ", p), err) }
+  }
+  return err
+}
+
+func (p *TerminateWorkflowExecutionRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowExecution() {
+    if err := oprot.WriteFieldBegin("workflowExecution", thrift.STRUCT, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:workflowExecution: ", p), err) }
+    if err := p.WorkflowExecution.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowExecution), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:workflowExecution: ", p), err) }
+  }
+  return err
+}
+
+func (p *TerminateWorkflowExecutionRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetReason() {
+    if err := oprot.WriteFieldBegin("reason", thrift.STRING, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:reason: ", p), err) }
+    if err := oprot.WriteString(string(*p.Reason)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.reason (30) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:reason: ", p), err) }
+  }
+  return err
+}
+
+func (p *TerminateWorkflowExecutionRequest) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDetails() {
+    if err := oprot.WriteFieldBegin("details", thrift.STRING, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:details: ", p), err) }
+    if err := oprot.WriteBinary(p.Details); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.details (40) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:details: ", p), err) }
+  }
+  return err
+}
+
+func (p *TerminateWorkflowExecutionRequest) writeField50(oprot thrift.TProtocol) (err error) {
+  if p.IsSetIdentity() {
+    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:identity: ", p), err) }
+    if err := oprot.WriteString(string(*p.Identity)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.identity (50) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:identity: ", p), err) }
+  }
+  return err
+}
+
+func (p *TerminateWorkflowExecutionRequest) writeField60(oprot thrift.TProtocol) (err error) {
+  if p.IsSetAdminOverride() {
+    if err := oprot.WriteFieldBegin("adminOverride", thrift.BOOL, 60); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:adminOverride: ", p), err) }
+    if err := oprot.WriteBool(bool(*p.AdminOverride)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.adminOverride (60) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:adminOverride: ", p), err) }
+  }
+  return err
+}
+
+func (p *TerminateWorkflowExecutionRequest) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("TerminateWorkflowExecutionRequest(%+v)", *p)
+}
+
+// Attributes:
+//  - Domain
+//  - WorkflowExecution
+//  - Reason
+//  - DecisionFinishEventId
+//  - RequestId
+//  - Identity
+type ResetWorkflowExecutionRequest struct {
+  // unused fields # 1 to 9
+  Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
+  // unused fields # 11 to 19
+  WorkflowExecution *WorkflowExecution `thrift:"workflowExecution,20" db:"workflowExecution" json:"workflowExecution,omitempty"`
+  // unused fields # 21 to 29
+  Reason *string `thrift:"reason,30" db:"reason" json:"reason,omitempty"`
+  // unused fields # 31 to 39
+  DecisionFinishEventId *int64 `thrift:"decisionFinishEventId,40" db:"decisionFinishEventId" json:"decisionFinishEventId,omitempty"`
+  // unused fields # 41 to 49
+  RequestId *string `thrift:"requestId,50" db:"requestId" json:"requestId,omitempty"`
+  // unused fields # 51 to 59
+  Identity *string `thrift:"identity,60" db:"identity" json:"identity,omitempty"`
+}
+
+func NewResetWorkflowExecutionRequest() *ResetWorkflowExecutionRequest {
+  return &ResetWorkflowExecutionRequest{}
+}
+
+var ResetWorkflowExecutionRequest_Domain_DEFAULT string
+func (p *ResetWorkflowExecutionRequest) GetDomain() string {
+  if !p.IsSetDomain() {
+    return ResetWorkflowExecutionRequest_Domain_DEFAULT
+  }
+return *p.Domain
+}
+var ResetWorkflowExecutionRequest_WorkflowExecution_DEFAULT *WorkflowExecution
+func (p *ResetWorkflowExecutionRequest) GetWorkflowExecution() *WorkflowExecution {
+  if !p.IsSetWorkflowExecution() {
+    return ResetWorkflowExecutionRequest_WorkflowExecution_DEFAULT
+  }
+return p.WorkflowExecution
+}
+var ResetWorkflowExecutionRequest_Reason_DEFAULT string
+func (p *ResetWorkflowExecutionRequest) GetReason() string {
+  if !p.IsSetReason() {
+    return ResetWorkflowExecutionRequest_Reason_DEFAULT
+  }
+return *p.Reason
+}
+var ResetWorkflowExecutionRequest_DecisionFinishEventId_DEFAULT int64
+func (p *ResetWorkflowExecutionRequest) GetDecisionFinishEventId() int64 {
+  if !p.IsSetDecisionFinishEventId() {
+    return ResetWorkflowExecutionRequest_DecisionFinishEventId_DEFAULT
+  }
+return *p.DecisionFinishEventId
+}
+var ResetWorkflowExecutionRequest_RequestId_DEFAULT string
+func (p *ResetWorkflowExecutionRequest) GetRequestId() string {
+  if !p.IsSetRequestId() {
+    return ResetWorkflowExecutionRequest_RequestId_DEFAULT
+  }
+return *p.RequestId
+}
+var ResetWorkflowExecutionRequest_Identity_DEFAULT string
+func (p *ResetWorkflowExecutionRequest) GetIdentity() string {
+  if !p.IsSetIdentity() {
+    return ResetWorkflowExecutionRequest_Identity_DEFAULT
+  }
+return *p.Identity
+}
+func (p *ResetWorkflowExecutionRequest) IsSetDomain() bool {
+  return p.Domain != nil
+}
+
+func (p *ResetWorkflowExecutionRequest) IsSetWorkflowExecution() bool {
+  return p.WorkflowExecution != nil
+}
+
+func (p *ResetWorkflowExecutionRequest) IsSetReason() bool {
+  return p.Reason != nil
+}
+
+func (p *ResetWorkflowExecutionRequest) IsSetDecisionFinishEventId() bool {
+  return p.DecisionFinishEventId != nil
+}
+
+func (p *ResetWorkflowExecutionRequest) IsSetRequestId() bool {
+  return p.RequestId != nil
+}
+
+func (p *ResetWorkflowExecutionRequest) IsSetIdentity() bool {
+  return p.Identity != nil
+}
+
+func (p *ResetWorkflowExecutionRequest) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
       }
     case 30:
       if err := p.ReadField30(iprot); err != nil {
@@ -17568,10 +24026,6 @@ func (p *PollForDecisionTaskResponse) Read(iprot thrift.TProtocol) error {
       if err := p.ReadField60(iprot); err != nil {
         return err
       }
-    case 70:
-      if err := p.ReadField70(iprot); err != nil {
-        return err
-      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -17587,16 +24041,16 @@ func (p *PollForDecisionTaskResponse) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *PollForDecisionTaskResponse)  ReadField10(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBinary(); err != nil {
+func (p *ResetWorkflowExecutionRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 10: ", err)
 } else {
-  p.TaskToken = v
+  p.Domain = &v
 }
   return nil
 }
 
-func (p *PollForDecisionTaskResponse)  ReadField20(iprot thrift.TProtocol) error {
+func (p *ResetWorkflowExecutionRequest)  ReadField20(iprot thrift.TProtocol) error {
   p.WorkflowExecution = &WorkflowExecution{}
   if err := p.WorkflowExecution.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecution), err)
@@ -17604,51 +24058,44 @@ func (p *PollForDecisionTaskResponse)  ReadField20(iprot thrift.TProtocol) error
   return nil
 }
 
-func (p *PollForDecisionTaskResponse)  ReadField30(iprot thrift.TProtocol) error {
-  p.WorkflowType = &WorkflowType{}
-  if err := p.WorkflowType.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowType), err)
-  }
+func (p *ResetWorkflowExecutionRequest)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
+} else {
+  p.Reason = &v
+}
   return nil
 }
 
-func (p *PollForDecisionTaskResponse)  ReadField40(iprot thrift.TProtocol) error {
+func (p *ResetWorkflowExecutionRequest)  ReadField40(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadI64(); err != nil {
   return thrift.PrependError("error reading field 40: ", err)
 } else {
-  p.PreviousStartedEventId = &v
+  p.DecisionFinishEventId = &v
 }
   return nil
 }
 
-func (p *PollForDecisionTaskResponse)  ReadField50(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI64(); err != nil {
+func (p *ResetWorkflowExecutionRequest)  ReadField50(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 50: ", err)
 } else {
-  p.StartedEventId = &v
-}
-  return nil
+  p.RequestId = &v
 }
-
-func (p *PollForDecisionTaskResponse)  ReadField60(iprot thrift.TProtocol) error {
-  p.History = &History{}
-  if err := p.History.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.History), err)
-  }
   return nil
 }
 
-func (p *PollForDecisionTaskResponse)  ReadField70(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBinary(); err != nil {
-  return thrift.PrependError("error reading field 70: ", err)
+func (p *ResetWorkflowExecutionRequest)  ReadField60(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 60: ", err)
 } else {
-  p.NextPageToken = v
+  p.Identity = &v
 }
   return nil
 }
 
-func (p *PollForDecisionTaskResponse) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("PollForDecisionTaskResponse"); err != nil {
+func (p *ResetWorkflowExecutionRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ResetWorkflowExecutionRequest"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
@@ -17657,7 +24104,6 @@ func (p *PollForDecisionTaskResponse) Write(oprot thrift.TProtocol) error {
     if err := p.writeField40(oprot); err != nil { return err }
     if err := p.writeField50(oprot); err != nil { return err }
     if err := p.writeField60(oprot); err != nil { return err }
-    if err := p.writeField70(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -17666,19 +24112,19 @@ func (p *PollForDecisionTaskResponse) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *PollForDecisionTaskResponse) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetTaskToken() {
-    if err := oprot.WriteFieldBegin("taskToken", thrift.STRING, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:taskToken: ", p), err) }
-    if err := oprot.WriteBinary(p.TaskToken); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.taskToken (10) field write error: ", p), err) }
+func (p *ResetWorkflowExecutionRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomain() {
+    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
+    if err := oprot.WriteString(string(*p.Domain)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domain (10) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:taskToken: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domain: ", p), err) }
   }
   return err
 }
 
-func (p *PollForDecisionTaskResponse) writeField20(oprot thrift.TProtocol) (err error) {
+func (p *ResetWorkflowExecutionRequest) writeField20(oprot thrift.TProtocol) (err error) {
   if p.IsSetWorkflowExecution() {
     if err := oprot.WriteFieldBegin("workflowExecution", thrift.STRUCT, 20); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:workflowExecution: ", p), err) }
@@ -17691,134 +24137,207 @@ func (p *PollForDecisionTaskResponse) writeField20(oprot thrift.TProtocol) (err
   return err
 }
 
-func (p *PollForDecisionTaskResponse) writeField30(oprot thrift.TProtocol) (err error) {
-  if p.IsSetWorkflowType() {
-    if err := oprot.WriteFieldBegin("workflowType", thrift.STRUCT, 30); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:workflowType: ", p), err) }
-    if err := p.WorkflowType.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowType), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:workflowType: ", p), err) }
-  }
-  return err
-}
-
-func (p *PollForDecisionTaskResponse) writeField40(oprot thrift.TProtocol) (err error) {
-  if p.IsSetPreviousStartedEventId() {
-    if err := oprot.WriteFieldBegin("previousStartedEventId", thrift.I64, 40); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:previousStartedEventId: ", p), err) }
-    if err := oprot.WriteI64(int64(*p.PreviousStartedEventId)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.previousStartedEventId (40) field write error: ", p), err) }
+func (p *ResetWorkflowExecutionRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetReason() {
+    if err := oprot.WriteFieldBegin("reason", thrift.STRING, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:reason: ", p), err) }
+    if err := oprot.WriteString(string(*p.Reason)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.reason (30) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:previousStartedEventId: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:reason: ", p), err) }
   }
   return err
 }
 
-func (p *PollForDecisionTaskResponse) writeField50(oprot thrift.TProtocol) (err error) {
-  if p.IsSetStartedEventId() {
-    if err := oprot.WriteFieldBegin("startedEventId", thrift.I64, 50); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:startedEventId: ", p), err) }
-    if err := oprot.WriteI64(int64(*p.StartedEventId)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.startedEventId (50) field write error: ", p), err) }
+func (p *ResetWorkflowExecutionRequest) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDecisionFinishEventId() {
+    if err := oprot.WriteFieldBegin("decisionFinishEventId", thrift.I64, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:decisionFinishEventId: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.DecisionFinishEventId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.decisionFinishEventId (40) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:startedEventId: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:decisionFinishEventId: ", p), err) }
   }
   return err
 }
 
-func (p *PollForDecisionTaskResponse) writeField60(oprot thrift.TProtocol) (err error) {
-  if p.IsSetHistory() {
-    if err := oprot.WriteFieldBegin("history", thrift.STRUCT, 60); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:history: ", p), err) }
-    if err := p.History.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.History), err)
-    }
+func (p *ResetWorkflowExecutionRequest) writeField50(oprot thrift.TProtocol) (err error) {
+  if p.IsSetRequestId() {
+    if err := oprot.WriteFieldBegin("requestId", thrift.STRING, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:requestId: ", p), err) }
+    if err := oprot.WriteString(string(*p.RequestId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.requestId (50) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:history: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:requestId: ", p), err) }
   }
   return err
 }
 
-func (p *PollForDecisionTaskResponse) writeField70(oprot thrift.TProtocol) (err error) {
-  if p.IsSetNextPageToken() {
-    if err := oprot.WriteFieldBegin("nextPageToken", thrift.STRING, 70); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 70:nextPageToken: ", p), err) }
-    if err := oprot.WriteBinary(p.NextPageToken); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.nextPageToken (70) field write error: ", p), err) }
+func (p *ResetWorkflowExecutionRequest) writeField60(oprot thrift.TProtocol) (err error) {
+  if p.IsSetIdentity() {
+    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 60); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:identity: ", p), err) }
+    if err := oprot.WriteString(string(*p.Identity)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.identity (60) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 70:nextPageToken: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:identity: ", p), err) }
   }
   return err
 }
 
-func (p *PollForDecisionTaskResponse) String() string {
+func (p *ResetWorkflowExecutionRequest) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("PollForDecisionTaskResponse(%+v)", *p)
+  return fmt.Sprintf("ResetWorkflowExecutionRequest(%+v)", *p)
 }
 
 // Attributes:
-//  - TaskToken
-//  - Decisions
-//  - ExecutionContext
-//  - Identity
-type RespondDecisionTaskCompletedRequest struct {
+//  - RunId
+type ResetWorkflowExecutionResponse struct {
   // unused fields # 1 to 9
-  TaskToken []byte `thrift:"taskToken,10" db:"taskToken" json:"taskToken,omitempty"`
-  // unused fields # 11 to 19
-  Decisions []*Decision `thrift:"decisions,20" db:"decisions" json:"decisions,omitempty"`
-  // unused fields # 21 to 29
-  ExecutionContext []byte `thrift:"executionContext,30" db:"executionContext" json:"executionContext,omitempty"`
-  // unused fields # 31 to 39
-  Identity *string `thrift:"identity,40" db:"identity" json:"identity,omitempty"`
+  RunId *string `thrift:"runId,10" db:"runId" json:"runId,omitempty"`
 }
 
-func NewRespondDecisionTaskCompletedRequest() *RespondDecisionTaskCompletedRequest {
-  return &RespondDecisionTaskCompletedRequest{}
+func NewResetWorkflowExecutionResponse() *ResetWorkflowExecutionResponse {
+  return &ResetWorkflowExecutionResponse{}
+}
+
+var ResetWorkflowExecutionResponse_RunId_DEFAULT string
+func (p *ResetWorkflowExecutionResponse) GetRunId() string {
+  if !p.IsSetRunId() {
+    return ResetWorkflowExecutionResponse_RunId_DEFAULT
+  }
+return *p.RunId
+}
+func (p *ResetWorkflowExecutionResponse) IsSetRunId() bool {
+  return p.RunId != nil
+}
+
+func (p *ResetWorkflowExecutionResponse) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ResetWorkflowExecutionResponse)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.RunId = &v
+}
+  return nil
+}
+
+func (p *ResetWorkflowExecutionResponse) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ResetWorkflowExecutionResponse"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ResetWorkflowExecutionResponse) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetRunId() {
+    if err := oprot.WriteFieldBegin("runId", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:runId: ", p), err) }
+    if err := oprot.WriteString(string(*p.RunId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.runId (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:runId: ", p), err) }
+  }
+  return err
+}
+
+func (p *ResetWorkflowExecutionResponse) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ResetWorkflowExecutionResponse(%+v)", *p)
 }
 
-var RespondDecisionTaskCompletedRequest_TaskToken_DEFAULT []byte
-
-func (p *RespondDecisionTaskCompletedRequest) GetTaskToken() []byte {
-  return p.TaskToken
+// Attributes:
+//  - Domain
+//  - Execution
+//  - Query
+type QueryWorkflowRequest struct {
+  // unused fields # 1 to 9
+  Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
+  // unused fields # 11 to 19
+  Execution *WorkflowExecution `thrift:"execution,20" db:"execution" json:"execution,omitempty"`
+  // unused fields # 21 to 29
+  Query *WorkflowQuery `thrift:"query,30" db:"query" json:"query,omitempty"`
 }
-var RespondDecisionTaskCompletedRequest_Decisions_DEFAULT []*Decision
 
-func (p *RespondDecisionTaskCompletedRequest) GetDecisions() []*Decision {
-  return p.Decisions
+func NewQueryWorkflowRequest() *QueryWorkflowRequest {
+  return &QueryWorkflowRequest{}
 }
-var RespondDecisionTaskCompletedRequest_ExecutionContext_DEFAULT []byte
 
-func (p *RespondDecisionTaskCompletedRequest) GetExecutionContext() []byte {
-  return p.ExecutionContext
+var QueryWorkflowRequest_Domain_DEFAULT string
+func (p *QueryWorkflowRequest) GetDomain() string {
+  if !p.IsSetDomain() {
+    return QueryWorkflowRequest_Domain_DEFAULT
+  }
+return *p.Domain
 }
-var RespondDecisionTaskCompletedRequest_Identity_DEFAULT string
-func (p *RespondDecisionTaskCompletedRequest) GetIdentity() string {
-  if !p.IsSetIdentity() {
-    return RespondDecisionTaskCompletedRequest_Identity_DEFAULT
+var QueryWorkflowRequest_Execution_DEFAULT *WorkflowExecution
+func (p *QueryWorkflowRequest) GetExecution() *WorkflowExecution {
+  if !p.IsSetExecution() {
+    return QueryWorkflowRequest_Execution_DEFAULT
   }
-return *p.Identity
+return p.Execution
 }
-func (p *RespondDecisionTaskCompletedRequest) IsSetTaskToken() bool {
-  return p.TaskToken != nil
+var QueryWorkflowRequest_Query_DEFAULT *WorkflowQuery
+func (p *QueryWorkflowRequest) GetQuery() *WorkflowQuery {
+  if !p.IsSetQuery() {
+    return QueryWorkflowRequest_Query_DEFAULT
+  }
+return p.Query
 }
-
-func (p *RespondDecisionTaskCompletedRequest) IsSetDecisions() bool {
-  return p.Decisions != nil
+func (p *QueryWorkflowRequest) IsSetDomain() bool {
+  return p.Domain != nil
 }
 
-func (p *RespondDecisionTaskCompletedRequest) IsSetExecutionContext() bool {
-  return p.ExecutionContext != nil
+func (p *QueryWorkflowRequest) IsSetExecution() bool {
+  return p.Execution != nil
 }
 
-func (p *RespondDecisionTaskCompletedRequest) IsSetIdentity() bool {
-  return p.Identity != nil
+func (p *QueryWorkflowRequest) IsSetQuery() bool {
+  return p.Query != nil
 }
 
-func (p *RespondDecisionTaskCompletedRequest) Read(iprot thrift.TProtocol) error {
+func (p *QueryWorkflowRequest) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -17843,10 +24362,6 @@ func (p *RespondDecisionTaskCompletedRequest) Read(iprot thrift.TProtocol) error
       if err := p.ReadField30(iprot); err != nil {
         return err
       }
-    case 40:
-      if err := p.ReadField40(iprot); err != nil {
-        return err
-      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -17862,61 +24377,38 @@ func (p *RespondDecisionTaskCompletedRequest) Read(iprot thrift.TProtocol) error
   return nil
 }
 
-func (p *RespondDecisionTaskCompletedRequest)  ReadField10(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBinary(); err != nil {
+func (p *QueryWorkflowRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 10: ", err)
 } else {
-  p.TaskToken = v
+  p.Domain = &v
 }
   return nil
 }
 
-func (p *RespondDecisionTaskCompletedRequest)  ReadField20(iprot thrift.TProtocol) error {
-  _, size, err := iprot.ReadListBegin()
-  if err != nil {
-    return thrift.PrependError("error reading list begin: ", err)
-  }
-  tSlice := make([]*Decision, 0, size)
-  p.Decisions =  tSlice
-  for i := 0; i < size; i ++ {
-    _elem1 := &Decision{}
-    if err := _elem1.Read(iprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem1), err)
-    }
-    p.Decisions = append(p.Decisions, _elem1)
-  }
-  if err := iprot.ReadListEnd(); err != nil {
-    return thrift.PrependError("error reading list end: ", err)
+func (p *QueryWorkflowRequest)  ReadField20(iprot thrift.TProtocol) error {
+  p.Execution = &WorkflowExecution{}
+  if err := p.Execution.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Execution), err)
   }
   return nil
 }
 
-func (p *RespondDecisionTaskCompletedRequest)  ReadField30(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBinary(); err != nil {
-  return thrift.PrependError("error reading field 30: ", err)
-} else {
-  p.ExecutionContext = v
-}
-  return nil
-}
-
-func (p *RespondDecisionTaskCompletedRequest)  ReadField40(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 40: ", err)
-} else {
-  p.Identity = &v
-}
+func (p *QueryWorkflowRequest)  ReadField30(iprot thrift.TProtocol) error {
+  p.Query = &WorkflowQuery{}
+  if err := p.Query.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Query), err)
+  }
   return nil
 }
 
-func (p *RespondDecisionTaskCompletedRequest) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondDecisionTaskCompletedRequest"); err != nil {
+func (p *QueryWorkflowRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("QueryWorkflowRequest"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
     if err := p.writeField20(oprot); err != nil { return err }
     if err := p.writeField30(oprot); err != nil { return err }
-    if err := p.writeField40(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -17925,121 +24417,205 @@ func (p *RespondDecisionTaskCompletedRequest) Write(oprot thrift.TProtocol) erro
   return nil
 }
 
-func (p *RespondDecisionTaskCompletedRequest) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetTaskToken() {
-    if err := oprot.WriteFieldBegin("taskToken", thrift.STRING, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:taskToken: ", p), err) }
-    if err := oprot.WriteBinary(p.TaskToken); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.taskToken (10) field write error: ", p), err) }
+func (p *QueryWorkflowRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomain() {
+    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
+    if err := oprot.WriteString(string(*p.Domain)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domain (10) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:taskToken: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domain: ", p), err) }
   }
   return err
 }
 
-func (p *RespondDecisionTaskCompletedRequest) writeField20(oprot thrift.TProtocol) (err error) {
-  if p.IsSetDecisions() {
-    if err := oprot.WriteFieldBegin("decisions", thrift.LIST, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:decisions: ", p), err) }
-    if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Decisions)); err != nil {
-      return thrift.PrependError("error writing list begin: ", err)
-    }
-    for _, v := range p.Decisions {
-      if err := v.Write(oprot); err != nil {
-        return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
-      }
-    }
-    if err := oprot.WriteListEnd(); err != nil {
-      return thrift.PrependError("error writing list end: ", err)
+func (p *QueryWorkflowRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetExecution() {
+    if err := oprot.WriteFieldBegin("execution", thrift.STRUCT, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:execution: ", p), err) }
+    if err := p.Execution.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Execution), err)
     }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:decisions: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:execution: ", p), err) }
   }
   return err
 }
 
-func (p *RespondDecisionTaskCompletedRequest) writeField30(oprot thrift.TProtocol) (err error) {
-  if p.IsSetExecutionContext() {
-    if err := oprot.WriteFieldBegin("executionContext", thrift.STRING, 30); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:executionContext: ", p), err) }
-    if err := oprot.WriteBinary(p.ExecutionContext); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.executionContext (30) field write error: ", p), err) }
+func (p *QueryWorkflowRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetQuery() {
+    if err := oprot.WriteFieldBegin("query", thrift.STRUCT, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:query: ", p), err) }
+    if err := p.Query.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Query), err)
+    }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:executionContext: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:query: ", p), err) }
   }
   return err
 }
 
-func (p *RespondDecisionTaskCompletedRequest) writeField40(oprot thrift.TProtocol) (err error) {
-  if p.IsSetIdentity() {
-    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 40); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:identity: ", p), err) }
-    if err := oprot.WriteString(string(*p.Identity)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.identity (40) field write error: ", p), err) }
+func (p *QueryWorkflowRequest) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("QueryWorkflowRequest(%+v)", *p)
+}
+
+// Attributes:
+//  - QueryResult
+type QueryWorkflowResponse struct {
+  // unused fields # 1 to 9
+  QueryResult []byte `thrift:"queryResult,10" db:"queryResult" json:"queryResult,omitempty"`
+}
+
+func NewQueryWorkflowResponse() *QueryWorkflowResponse {
+  return &QueryWorkflowResponse{}
+}
+
+var QueryWorkflowResponse_QueryResult_DEFAULT []byte
+
+func (p *QueryWorkflowResponse) GetQueryResult() []byte {
+  return p.QueryResult
+}
+func (p *QueryWorkflowResponse) IsSetQueryResult() bool {
+  return p.QueryResult != nil
+}
+
+func (p *QueryWorkflowResponse) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *QueryWorkflowResponse)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.QueryResult = v
+}
+  return nil
+}
+
+func (p *QueryWorkflowResponse) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("QueryWorkflowResponse"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *QueryWorkflowResponse) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetQueryResult() {
+    if err := oprot.WriteFieldBegin("queryResult", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:queryResult: ", p), err) }
+    if err := oprot.WriteBinary(p.QueryResult); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.queryResult (10) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:identity: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:queryResult: ", p), err) }
   }
   return err
 }
 
-func (p *RespondDecisionTaskCompletedRequest) String() string {
+func (p *QueryWorkflowResponse) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("RespondDecisionTaskCompletedRequest(%+v)", *p)
+  return fmt.Sprintf("QueryWorkflowResponse(%+v)", *p)
 }
 
 // Attributes:
-//  - Domain
-//  - TaskList
-//  - Identity
-type PollForActivityTaskRequest struct {
+//  - TaskToken
+//  - CompletedType
+//  - QueryResult
+//  - ErrorMessage
+type RespondQueryTaskCompletedRequest struct {
   // unused fields # 1 to 9
-  Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
+  TaskToken []byte `thrift:"taskToken,10" db:"taskToken" json:"taskToken,omitempty"`
   // unused fields # 11 to 19
-  TaskList *TaskList `thrift:"taskList,20" db:"taskList" json:"taskList,omitempty"`
+  CompletedType *QueryTaskCompletedType `thrift:"completedType,20" db:"completedType" json:"completedType,omitempty"`
   // unused fields # 21 to 29
-  Identity *string `thrift:"identity,30" db:"identity" json:"identity,omitempty"`
+  QueryResult []byte `thrift:"queryResult,30" db:"queryResult" json:"queryResult,omitempty"`
+  // unused fields # 31 to 39
+  ErrorMessage *string `thrift:"errorMessage,40" db:"errorMessage" json:"errorMessage,omitempty"`
 }
 
-func NewPollForActivityTaskRequest() *PollForActivityTaskRequest {
-  return &PollForActivityTaskRequest{}
+func NewRespondQueryTaskCompletedRequest() *RespondQueryTaskCompletedRequest {
+  return &RespondQueryTaskCompletedRequest{}
 }
 
-var PollForActivityTaskRequest_Domain_DEFAULT string
-func (p *PollForActivityTaskRequest) GetDomain() string {
-  if !p.IsSetDomain() {
-    return PollForActivityTaskRequest_Domain_DEFAULT
-  }
-return *p.Domain
+var RespondQueryTaskCompletedRequest_TaskToken_DEFAULT []byte
+
+func (p *RespondQueryTaskCompletedRequest) GetTaskToken() []byte {
+  return p.TaskToken
 }
-var PollForActivityTaskRequest_TaskList_DEFAULT *TaskList
-func (p *PollForActivityTaskRequest) GetTaskList() *TaskList {
-  if !p.IsSetTaskList() {
-    return PollForActivityTaskRequest_TaskList_DEFAULT
+var RespondQueryTaskCompletedRequest_CompletedType_DEFAULT QueryTaskCompletedType
+func (p *RespondQueryTaskCompletedRequest) GetCompletedType() QueryTaskCompletedType {
+  if !p.IsSetCompletedType() {
+    return RespondQueryTaskCompletedRequest_CompletedType_DEFAULT
   }
-return p.TaskList
+return *p.CompletedType
 }
-var PollForActivityTaskRequest_Identity_DEFAULT string
-func (p *PollForActivityTaskRequest) GetIdentity() string {
-  if !p.IsSetIdentity() {
-    return PollForActivityTaskRequest_Identity_DEFAULT
+var RespondQueryTaskCompletedRequest_QueryResult_DEFAULT []byte
+
+func (p *RespondQueryTaskCompletedRequest) GetQueryResult() []byte {
+  return p.QueryResult
+}
+var RespondQueryTaskCompletedRequest_ErrorMessage_DEFAULT string
+func (p *RespondQueryTaskCompletedRequest) GetErrorMessage() string {
+  if !p.IsSetErrorMessage() {
+    return RespondQueryTaskCompletedRequest_ErrorMessage_DEFAULT
   }
-return *p.Identity
+return *p.ErrorMessage
 }
-func (p *PollForActivityTaskRequest) IsSetDomain() bool {
-  return p.Domain != nil
+func (p *RespondQueryTaskCompletedRequest) IsSetTaskToken() bool {
+  return p.TaskToken != nil
 }
 
-func (p *PollForActivityTaskRequest) IsSetTaskList() bool {
-  return p.TaskList != nil
+func (p *RespondQueryTaskCompletedRequest) IsSetCompletedType() bool {
+  return p.CompletedType != nil
 }
 
-func (p *PollForActivityTaskRequest) IsSetIdentity() bool {
-  return p.Identity != nil
+func (p *RespondQueryTaskCompletedRequest) IsSetQueryResult() bool {
+  return p.QueryResult != nil
 }
 
-func (p *PollForActivityTaskRequest) Read(iprot thrift.TProtocol) error {
+func (p *RespondQueryTaskCompletedRequest) IsSetErrorMessage() bool {
+  return p.ErrorMessage != nil
+}
+
+func (p *RespondQueryTaskCompletedRequest) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -18064,6 +24640,10 @@ func (p *PollForActivityTaskRequest) Read(iprot thrift.TProtocol) error {
       if err := p.ReadField30(iprot); err != nil {
         return err
       }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -18079,39 +24659,51 @@ func (p *PollForActivityTaskRequest) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *PollForActivityTaskRequest)  ReadField10(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
+func (p *RespondQueryTaskCompletedRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
   return thrift.PrependError("error reading field 10: ", err)
 } else {
-  p.Domain = &v
+  p.TaskToken = v
+}
+  return nil
+}
+
+func (p *RespondQueryTaskCompletedRequest)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 20: ", err)
+} else {
+  temp := QueryTaskCompletedType(v)
+  p.CompletedType = &temp
 }
   return nil
 }
 
-func (p *PollForActivityTaskRequest)  ReadField20(iprot thrift.TProtocol) error {
-  p.TaskList = &TaskList{}
-  if err := p.TaskList.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TaskList), err)
-  }
+func (p *RespondQueryTaskCompletedRequest)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
+} else {
+  p.QueryResult = v
+}
   return nil
 }
 
-func (p *PollForActivityTaskRequest)  ReadField30(iprot thrift.TProtocol) error {
+func (p *RespondQueryTaskCompletedRequest)  ReadField40(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 30: ", err)
+  return thrift.PrependError("error reading field 40: ", err)
 } else {
-  p.Identity = &v
+  p.ErrorMessage = &v
 }
   return nil
 }
 
-func (p *PollForActivityTaskRequest) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("PollForActivityTaskRequest"); err != nil {
+func (p *RespondQueryTaskCompletedRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondQueryTaskCompletedRequest"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
     if err := p.writeField20(oprot); err != nil { return err }
     if err := p.writeField30(oprot); err != nil { return err }
+    if err := p.writeField40(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -18120,209 +24712,152 @@ func (p *PollForActivityTaskRequest) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *PollForActivityTaskRequest) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetDomain() {
-    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
-    if err := oprot.WriteString(string(*p.Domain)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.domain (10) field write error: ", p), err) }
+func (p *RespondQueryTaskCompletedRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTaskToken() {
+    if err := oprot.WriteFieldBegin("taskToken", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:taskToken: ", p), err) }
+    if err := oprot.WriteBinary(p.TaskToken); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.taskToken (10) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domain: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:taskToken: ", p), err) }
   }
   return err
 }
 
-func (p *PollForActivityTaskRequest) writeField20(oprot thrift.TProtocol) (err error) {
-  if p.IsSetTaskList() {
-    if err := oprot.WriteFieldBegin("taskList", thrift.STRUCT, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:taskList: ", p), err) }
-    if err := p.TaskList.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TaskList), err)
-    }
+func (p *RespondQueryTaskCompletedRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetCompletedType() {
+    if err := oprot.WriteFieldBegin("completedType", thrift.I32, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:completedType: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.CompletedType)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.completedType (20) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:taskList: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:completedType: ", p), err) }
   }
   return err
 }
 
-func (p *PollForActivityTaskRequest) writeField30(oprot thrift.TProtocol) (err error) {
-  if p.IsSetIdentity() {
-    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 30); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:identity: ", p), err) }
-    if err := oprot.WriteString(string(*p.Identity)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.identity (30) field write error: ", p), err) }
+func (p *RespondQueryTaskCompletedRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetQueryResult() {
+    if err := oprot.WriteFieldBegin("queryResult", thrift.STRING, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:queryResult: ", p), err) }
+    if err := oprot.WriteBinary(p.QueryResult); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.queryResult (30) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:identity: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:queryResult: ", p), err) }
   }
   return err
 }
 
-func (p *PollForActivityTaskRequest) String() string {
+func (p *RespondQueryTaskCompletedRequest) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetErrorMessage() {
+    if err := oprot.WriteFieldBegin("errorMessage", thrift.STRING, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:errorMessage: ", p), err) }
+    if err := oprot.WriteString(string(*p.ErrorMessage)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.errorMessage (40) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:errorMessage: ", p), err) }
+  }
+  return err
+}
+
+func (p *RespondQueryTaskCompletedRequest) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("PollForActivityTaskRequest(%+v)", *p)
+  return fmt.Sprintf("RespondQueryTaskCompletedRequest(%+v)", *p)
 }
 
 // Attributes:
-//  - TaskToken
-//  - WorkflowExecution
-//  - ActivityId
-//  - ActivityType
-//  - Input
-//  - StartedEventId
-//  - ScheduledTimestamp
-//  - ScheduleToCloseTimeoutSeconds
-//  - StartedTimestamp
-//  - StartToCloseTimeoutSeconds
-//  - HeartbeatTimeoutSeconds
-type PollForActivityTaskResponse struct {
+//  - Domain
+//  - MaximumPageSize
+//  - NextPageToken
+//  - StartTimeFilter
+//  - ExecutionFilter
+//  - TypeFilter
+type ListOpenWorkflowExecutionsRequest struct {
   // unused fields # 1 to 9
-  TaskToken []byte `thrift:"taskToken,10" db:"taskToken" json:"taskToken,omitempty"`
+  Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
   // unused fields # 11 to 19
-  WorkflowExecution *WorkflowExecution `thrift:"workflowExecution,20" db:"workflowExecution" json:"workflowExecution,omitempty"`
+  MaximumPageSize *int32 `thrift:"maximumPageSize,20" db:"maximumPageSize" json:"maximumPageSize,omitempty"`
   // unused fields # 21 to 29
-  ActivityId *string `thrift:"activityId,30" db:"activityId" json:"activityId,omitempty"`
+  NextPageToken []byte `thrift:"nextPageToken,30" db:"nextPageToken" json:"nextPageToken,omitempty"`
   // unused fields # 31 to 39
-  ActivityType *ActivityType `thrift:"activityType,40" db:"activityType" json:"activityType,omitempty"`
+  StartTimeFilter *StartTimeFilter `thrift:"StartTimeFilter,40" db:"StartTimeFilter" json:"StartTimeFilter,omitempty"`
   // unused fields # 41 to 49
-  Input []byte `thrift:"input,50" db:"input" json:"input,omitempty"`
+  ExecutionFilter *WorkflowExecutionFilter `thrift:"executionFilter,50" db:"executionFilter" json:"executionFilter,omitempty"`
   // unused fields # 51 to 59
-  StartedEventId *int64 `thrift:"startedEventId,60" db:"startedEventId" json:"startedEventId,omitempty"`
-  // unused fields # 61 to 69
-  ScheduledTimestamp *int64 `thrift:"scheduledTimestamp,70" db:"scheduledTimestamp" json:"scheduledTimestamp,omitempty"`
-  // unused fields # 71 to 79
-  ScheduleToCloseTimeoutSeconds *int32 `thrift:"scheduleToCloseTimeoutSeconds,80" db:"scheduleToCloseTimeoutSeconds" json:"scheduleToCloseTimeoutSeconds,omitempty"`
-  // unused fields # 81 to 89
-  StartedTimestamp *int64 `thrift:"startedTimestamp,90" db:"startedTimestamp" json:"startedTimestamp,omitempty"`
-  // unused fields # 91 to 99
-  StartToCloseTimeoutSeconds *int32 `thrift:"startToCloseTimeoutSeconds,100" db:"startToCloseTimeoutSeconds" json:"startToCloseTimeoutSeconds,omitempty"`
-  // unused fields # 101 to 109
-  HeartbeatTimeoutSeconds *int32 `thrift:"heartbeatTimeoutSeconds,110" db:"heartbeatTimeoutSeconds" json:"heartbeatTimeoutSeconds,omitempty"`
+  TypeFilter *WorkflowTypeFilter `thrift:"typeFilter,60" db:"typeFilter" json:"typeFilter,omitempty"`
 }
 
-func NewPollForActivityTaskResponse() *PollForActivityTaskResponse {
-  return &PollForActivityTaskResponse{}
+func NewListOpenWorkflowExecutionsRequest() *ListOpenWorkflowExecutionsRequest {
+  return &ListOpenWorkflowExecutionsRequest{}
 }
 
-var PollForActivityTaskResponse_TaskToken_DEFAULT []byte
-
-func (p *PollForActivityTaskResponse) GetTaskToken() []byte {
-  return p.TaskToken
-}
-var PollForActivityTaskResponse_WorkflowExecution_DEFAULT *WorkflowExecution
-func (p *PollForActivityTaskResponse) GetWorkflowExecution() *WorkflowExecution {
-  if !p.IsSetWorkflowExecution() {
-    return PollForActivityTaskResponse_WorkflowExecution_DEFAULT
-  }
-return p.WorkflowExecution
-}
-var PollForActivityTaskResponse_ActivityId_DEFAULT string
-func (p *PollForActivityTaskResponse) GetActivityId() string {
-  if !p.IsSetActivityId() {
-    return PollForActivityTaskResponse_ActivityId_DEFAULT
+var ListOpenWorkflowExecutionsRequest_Domain_DEFAULT string
+func (p *ListOpenWorkflowExecutionsRequest) GetDomain() string {
+  if !p.IsSetDomain() {
+    return ListOpenWorkflowExecutionsRequest_Domain_DEFAULT
   }
-return *p.ActivityId
+return *p.Domain
 }
-var PollForActivityTaskResponse_ActivityType_DEFAULT *ActivityType
-func (p *PollForActivityTaskResponse) GetActivityType() *ActivityType {
-  if !p.IsSetActivityType() {
-    return PollForActivityTaskResponse_ActivityType_DEFAULT
+var ListOpenWorkflowExecutionsRequest_MaximumPageSize_DEFAULT int32
+func (p *ListOpenWorkflowExecutionsRequest) GetMaximumPageSize() int32 {
+  if !p.IsSetMaximumPageSize() {
+    return ListOpenWorkflowExecutionsRequest_MaximumPageSize_DEFAULT
   }
-return p.ActivityType
+return *p.MaximumPageSize
 }
-var PollForActivityTaskResponse_Input_DEFAULT []byte
+var ListOpenWorkflowExecutionsRequest_NextPageToken_DEFAULT []byte
 
-func (p *PollForActivityTaskResponse) GetInput() []byte {
-  return p.Input
-}
-var PollForActivityTaskResponse_StartedEventId_DEFAULT int64
-func (p *PollForActivityTaskResponse) GetStartedEventId() int64 {
-  if !p.IsSetStartedEventId() {
-    return PollForActivityTaskResponse_StartedEventId_DEFAULT
-  }
-return *p.StartedEventId
-}
-var PollForActivityTaskResponse_ScheduledTimestamp_DEFAULT int64
-func (p *PollForActivityTaskResponse) GetScheduledTimestamp() int64 {
-  if !p.IsSetScheduledTimestamp() {
-    return PollForActivityTaskResponse_ScheduledTimestamp_DEFAULT
-  }
-return *p.ScheduledTimestamp
-}
-var PollForActivityTaskResponse_ScheduleToCloseTimeoutSeconds_DEFAULT int32
-func (p *PollForActivityTaskResponse) GetScheduleToCloseTimeoutSeconds() int32 {
-  if !p.IsSetScheduleToCloseTimeoutSeconds() {
-    return PollForActivityTaskResponse_ScheduleToCloseTimeoutSeconds_DEFAULT
-  }
-return *p.ScheduleToCloseTimeoutSeconds
+func (p *ListOpenWorkflowExecutionsRequest) GetNextPageToken() []byte {
+  return p.NextPageToken
 }
-var PollForActivityTaskResponse_StartedTimestamp_DEFAULT int64
-func (p *PollForActivityTaskResponse) GetStartedTimestamp() int64 {
-  if !p.IsSetStartedTimestamp() {
-    return PollForActivityTaskResponse_StartedTimestamp_DEFAULT
+var ListOpenWorkflowExecutionsRequest_StartTimeFilter_DEFAULT *StartTimeFilter
+func (p *ListOpenWorkflowExecutionsRequest) GetStartTimeFilter() *StartTimeFilter {
+  if !p.IsSetStartTimeFilter() {
+    return ListOpenWorkflowExecutionsRequest_StartTimeFilter_DEFAULT
   }
-return *p.StartedTimestamp
+return p.StartTimeFilter
 }
-var PollForActivityTaskResponse_StartToCloseTimeoutSeconds_DEFAULT int32
-func (p *PollForActivityTaskResponse) GetStartToCloseTimeoutSeconds() int32 {
-  if !p.IsSetStartToCloseTimeoutSeconds() {
-    return PollForActivityTaskResponse_StartToCloseTimeoutSeconds_DEFAULT
+var ListOpenWorkflowExecutionsRequest_ExecutionFilter_DEFAULT *WorkflowExecutionFilter
+func (p *ListOpenWorkflowExecutionsRequest) GetExecutionFilter() *WorkflowExecutionFilter {
+  if !p.IsSetExecutionFilter() {
+    return ListOpenWorkflowExecutionsRequest_ExecutionFilter_DEFAULT
   }
-return *p.StartToCloseTimeoutSeconds
+return p.ExecutionFilter
 }
-var PollForActivityTaskResponse_HeartbeatTimeoutSeconds_DEFAULT int32
-func (p *PollForActivityTaskResponse) GetHeartbeatTimeoutSeconds() int32 {
-  if !p.IsSetHeartbeatTimeoutSeconds() {
-    return PollForActivityTaskResponse_HeartbeatTimeoutSeconds_DEFAULT
+var ListOpenWorkflowExecutionsRequest_TypeFilter_DEFAULT *WorkflowTypeFilter
+func (p *ListOpenWorkflowExecutionsRequest) GetTypeFilter() *WorkflowTypeFilter {
+  if !p.IsSetTypeFilter() {
+    return ListOpenWorkflowExecutionsRequest_TypeFilter_DEFAULT
   }
-return *p.HeartbeatTimeoutSeconds
-}
-func (p *PollForActivityTaskResponse) IsSetTaskToken() bool {
-  return p.TaskToken != nil
-}
-
-func (p *PollForActivityTaskResponse) IsSetWorkflowExecution() bool {
-  return p.WorkflowExecution != nil
-}
-
-func (p *PollForActivityTaskResponse) IsSetActivityId() bool {
-  return p.ActivityId != nil
-}
-
-func (p *PollForActivityTaskResponse) IsSetActivityType() bool {
-  return p.ActivityType != nil
-}
-
-func (p *PollForActivityTaskResponse) IsSetInput() bool {
-  return p.Input != nil
+return p.TypeFilter
 }
-
-func (p *PollForActivityTaskResponse) IsSetStartedEventId() bool {
-  return p.StartedEventId != nil
+func (p *ListOpenWorkflowExecutionsRequest) IsSetDomain() bool {
+  return p.Domain != nil
 }
 
-func (p *PollForActivityTaskResponse) IsSetScheduledTimestamp() bool {
-  return p.ScheduledTimestamp != nil
+func (p *ListOpenWorkflowExecutionsRequest) IsSetMaximumPageSize() bool {
+  return p.MaximumPageSize != nil
 }
 
-func (p *PollForActivityTaskResponse) IsSetScheduleToCloseTimeoutSeconds() bool {
-  return p.ScheduleToCloseTimeoutSeconds != nil
+func (p *ListOpenWorkflowExecutionsRequest) IsSetNextPageToken() bool {
+  return p.NextPageToken != nil
 }
 
-func (p *PollForActivityTaskResponse) IsSetStartedTimestamp() bool {
-  return p.StartedTimestamp != nil
+func (p *ListOpenWorkflowExecutionsRequest) IsSetStartTimeFilter() bool {
+  return p.StartTimeFilter != nil
 }
 
-func (p *PollForActivityTaskResponse) IsSetStartToCloseTimeoutSeconds() bool {
-  return p.StartToCloseTimeoutSeconds != nil
+func (p *ListOpenWorkflowExecutionsRequest) IsSetExecutionFilter() bool {
+  return p.ExecutionFilter != nil
 }
 
-func (p *PollForActivityTaskResponse) IsSetHeartbeatTimeoutSeconds() bool {
-  return p.HeartbeatTimeoutSeconds != nil
+func (p *ListOpenWorkflowExecutionsRequest) IsSetTypeFilter() bool {
+  return p.TypeFilter != nil
 }
 
-func (p *PollForActivityTaskResponse) Read(iprot thrift.TProtocol) error {
+func (p *ListOpenWorkflowExecutionsRequest) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -18359,26 +24894,6 @@ func (p *PollForActivityTaskResponse) Read(iprot thrift.TProtocol) error {
       if err := p.ReadField60(iprot); err != nil {
         return err
       }
-    case 70:
-      if err := p.ReadField70(iprot); err != nil {
-        return err
-      }
-    case 80:
-      if err := p.ReadField80(iprot); err != nil {
-        return err
-      }
-    case 90:
-      if err := p.ReadField90(iprot); err != nil {
-        return err
-      }
-    case 100:
-      if err := p.ReadField100(iprot); err != nil {
-        return err
-      }
-    case 110:
-      if err := p.ReadField110(iprot); err != nil {
-        return err
-      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -18394,105 +24909,59 @@ func (p *PollForActivityTaskResponse) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *PollForActivityTaskResponse)  ReadField10(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBinary(); err != nil {
+func (p *ListOpenWorkflowExecutionsRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 10: ", err)
 } else {
-  p.TaskToken = v
-}
-  return nil
+  p.Domain = &v
 }
-
-func (p *PollForActivityTaskResponse)  ReadField20(iprot thrift.TProtocol) error {
-  p.WorkflowExecution = &WorkflowExecution{}
-  if err := p.WorkflowExecution.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecution), err)
-  }
   return nil
 }
 
-func (p *PollForActivityTaskResponse)  ReadField30(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 30: ", err)
+func (p *ListOpenWorkflowExecutionsRequest)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 20: ", err)
 } else {
-  p.ActivityId = &v
-}
-  return nil
+  p.MaximumPageSize = &v
 }
-
-func (p *PollForActivityTaskResponse)  ReadField40(iprot thrift.TProtocol) error {
-  p.ActivityType = &ActivityType{}
-  if err := p.ActivityType.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ActivityType), err)
-  }
   return nil
 }
 
-func (p *PollForActivityTaskResponse)  ReadField50(iprot thrift.TProtocol) error {
+func (p *ListOpenWorkflowExecutionsRequest)  ReadField30(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadBinary(); err != nil {
-  return thrift.PrependError("error reading field 50: ", err)
-} else {
-  p.Input = v
-}
-  return nil
-}
-
-func (p *PollForActivityTaskResponse)  ReadField60(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI64(); err != nil {
-  return thrift.PrependError("error reading field 60: ", err)
-} else {
-  p.StartedEventId = &v
-}
-  return nil
-}
-
-func (p *PollForActivityTaskResponse)  ReadField70(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI64(); err != nil {
-  return thrift.PrependError("error reading field 70: ", err)
-} else {
-  p.ScheduledTimestamp = &v
-}
-  return nil
-}
-
-func (p *PollForActivityTaskResponse)  ReadField80(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI32(); err != nil {
-  return thrift.PrependError("error reading field 80: ", err)
+  return thrift.PrependError("error reading field 30: ", err)
 } else {
-  p.ScheduleToCloseTimeoutSeconds = &v
+  p.NextPageToken = v
 }
   return nil
 }
 
-func (p *PollForActivityTaskResponse)  ReadField90(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI64(); err != nil {
-  return thrift.PrependError("error reading field 90: ", err)
-} else {
-  p.StartedTimestamp = &v
-}
+func (p *ListOpenWorkflowExecutionsRequest)  ReadField40(iprot thrift.TProtocol) error {
+  p.StartTimeFilter = &StartTimeFilter{}
+  if err := p.StartTimeFilter.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.StartTimeFilter), err)
+  }
   return nil
 }
 
-func (p *PollForActivityTaskResponse)  ReadField100(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI32(); err != nil {
-  return thrift.PrependError("error reading field 100: ", err)
-} else {
-  p.StartToCloseTimeoutSeconds = &v
-}
+func (p *ListOpenWorkflowExecutionsRequest)  ReadField50(iprot thrift.TProtocol) error {
+  p.ExecutionFilter = &WorkflowExecutionFilter{}
+  if err := p.ExecutionFilter.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ExecutionFilter), err)
+  }
   return nil
 }
 
-func (p *PollForActivityTaskResponse)  ReadField110(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI32(); err != nil {
-  return thrift.PrependError("error reading field 110: ", err)
-} else {
-  p.HeartbeatTimeoutSeconds = &v
-}
+func (p *ListOpenWorkflowExecutionsRequest)  ReadField60(iprot thrift.TProtocol) error {
+  p.TypeFilter = &WorkflowTypeFilter{}
+  if err := p.TypeFilter.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TypeFilter), err)
+  }
   return nil
 }
 
-func (p *PollForActivityTaskResponse) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("PollForActivityTaskResponse"); err != nil {
+func (p *ListOpenWorkflowExecutionsRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ListOpenWorkflowExecutionsRequest"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
@@ -18501,11 +24970,6 @@ func (p *PollForActivityTaskResponse) Write(oprot thrift.TProtocol) error {
     if err := p.writeField40(oprot); err != nil { return err }
     if err := p.writeField50(oprot); err != nil { return err }
     if err := p.writeField60(oprot); err != nil { return err }
-    if err := p.writeField70(oprot); err != nil { return err }
-    if err := p.writeField80(oprot); err != nil { return err }
-    if err := p.writeField90(oprot); err != nil { return err }
-    if err := p.writeField100(oprot); err != nil { return err }
-    if err := p.writeField110(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -18514,194 +24978,121 @@ func (p *PollForActivityTaskResponse) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *PollForActivityTaskResponse) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetTaskToken() {
-    if err := oprot.WriteFieldBegin("taskToken", thrift.STRING, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:taskToken: ", p), err) }
-    if err := oprot.WriteBinary(p.TaskToken); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.taskToken (10) field write error: ", p), err) }
+func (p *ListOpenWorkflowExecutionsRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomain() {
+    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
+    if err := oprot.WriteString(string(*p.Domain)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domain (10) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:taskToken: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domain: ", p), err) }
   }
   return err
 }
 
-func (p *PollForActivityTaskResponse) writeField20(oprot thrift.TProtocol) (err error) {
-  if p.IsSetWorkflowExecution() {
-    if err := oprot.WriteFieldBegin("workflowExecution", thrift.STRUCT, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:workflowExecution: ", p), err) }
-    if err := p.WorkflowExecution.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowExecution), err)
-    }
+func (p *ListOpenWorkflowExecutionsRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetMaximumPageSize() {
+    if err := oprot.WriteFieldBegin("maximumPageSize", thrift.I32, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:maximumPageSize: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.MaximumPageSize)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.maximumPageSize (20) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:workflowExecution: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:maximumPageSize: ", p), err) }
   }
   return err
 }
 
-func (p *PollForActivityTaskResponse) writeField30(oprot thrift.TProtocol) (err error) {
-  if p.IsSetActivityId() {
-    if err := oprot.WriteFieldBegin("activityId", thrift.STRING, 30); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:activityId: ", p), err) }
-    if err := oprot.WriteString(string(*p.ActivityId)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.activityId (30) field write error: ", p), err) }
+func (p *ListOpenWorkflowExecutionsRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetNextPageToken() {
+    if err := oprot.WriteFieldBegin("nextPageToken", thrift.STRING, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:nextPageToken: ", p), err) }
+    if err := oprot.WriteBinary(p.NextPageToken); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.nextPageToken (30) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:activityId: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:nextPageToken: ", p), err) }
   }
   return err
 }
 
-func (p *PollForActivityTaskResponse) writeField40(oprot thrift.TProtocol) (err error) {
-  if p.IsSetActivityType() {
-    if err := oprot.WriteFieldBegin("activityType", thrift.STRUCT, 40); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:activityType: ", p), err) }
-    if err := p.ActivityType.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ActivityType), err)
+func (p *ListOpenWorkflowExecutionsRequest) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetStartTimeFilter() {
+    if err := oprot.WriteFieldBegin("StartTimeFilter", thrift.STRUCT, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:StartTimeFilter: ", p), err) }
+    if err := p.StartTimeFilter.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.StartTimeFilter), err)
     }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:activityType: ", p), err) }
-  }
-  return err
-}
-
-func (p *PollForActivityTaskResponse) writeField50(oprot thrift.TProtocol) (err error) {
-  if p.IsSetInput() {
-    if err := oprot.WriteFieldBegin("input", thrift.STRING, 50); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:input: ", p), err) }
-    if err := oprot.WriteBinary(p.Input); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.input (50) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:input: ", p), err) }
-  }
-  return err
-}
-
-func (p *PollForActivityTaskResponse) writeField60(oprot thrift.TProtocol) (err error) {
-  if p.IsSetStartedEventId() {
-    if err := oprot.WriteFieldBegin("startedEventId", thrift.I64, 60); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:startedEventId: ", p), err) }
-    if err := oprot.WriteI64(int64(*p.StartedEventId)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.startedEventId (60) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:startedEventId: ", p), err) }
-  }
-  return err
-}
-
-func (p *PollForActivityTaskResponse) writeField70(oprot thrift.TProtocol) (err error) {
-  if p.IsSetScheduledTimestamp() {
-    if err := oprot.WriteFieldBegin("scheduledTimestamp", thrift.I64, 70); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 70:scheduledTimestamp: ", p), err) }
-    if err := oprot.WriteI64(int64(*p.ScheduledTimestamp)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.scheduledTimestamp (70) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 70:scheduledTimestamp: ", p), err) }
-  }
-  return err
-}
-
-func (p *PollForActivityTaskResponse) writeField80(oprot thrift.TProtocol) (err error) {
-  if p.IsSetScheduleToCloseTimeoutSeconds() {
-    if err := oprot.WriteFieldBegin("scheduleToCloseTimeoutSeconds", thrift.I32, 80); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 80:scheduleToCloseTimeoutSeconds: ", p), err) }
-    if err := oprot.WriteI32(int32(*p.ScheduleToCloseTimeoutSeconds)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.scheduleToCloseTimeoutSeconds (80) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 80:scheduleToCloseTimeoutSeconds: ", p), err) }
-  }
-  return err
-}
-
-func (p *PollForActivityTaskResponse) writeField90(oprot thrift.TProtocol) (err error) {
-  if p.IsSetStartedTimestamp() {
-    if err := oprot.WriteFieldBegin("startedTimestamp", thrift.I64, 90); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 90:startedTimestamp: ", p), err) }
-    if err := oprot.WriteI64(int64(*p.StartedTimestamp)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.startedTimestamp (90) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 90:startedTimestamp: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:StartTimeFilter: ", p), err) }
   }
   return err
 }
 
-func (p *PollForActivityTaskResponse) writeField100(oprot thrift.TProtocol) (err error) {
-  if p.IsSetStartToCloseTimeoutSeconds() {
-    if err := oprot.WriteFieldBegin("startToCloseTimeoutSeconds", thrift.I32, 100); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 100:startToCloseTimeoutSeconds: ", p), err) }
-    if err := oprot.WriteI32(int32(*p.StartToCloseTimeoutSeconds)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.startToCloseTimeoutSeconds (100) field write error: ", p), err) }
+func (p *ListOpenWorkflowExecutionsRequest) writeField50(oprot thrift.TProtocol) (err error) {
+  if p.IsSetExecutionFilter() {
+    if err := oprot.WriteFieldBegin("executionFilter", thrift.STRUCT, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:executionFilter: ", p), err) }
+    if err := p.ExecutionFilter.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ExecutionFilter), err)
+    }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 100:startToCloseTimeoutSeconds: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:executionFilter: ", p), err) }
   }
   return err
 }
 
-func (p *PollForActivityTaskResponse) writeField110(oprot thrift.TProtocol) (err error) {
-  if p.IsSetHeartbeatTimeoutSeconds() {
-    if err := oprot.WriteFieldBegin("heartbeatTimeoutSeconds", thrift.I32, 110); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 110:heartbeatTimeoutSeconds: ", p), err) }
-    if err := oprot.WriteI32(int32(*p.HeartbeatTimeoutSeconds)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.heartbeatTimeoutSeconds (110) field write error: ", p), err) }
+func (p *ListOpenWorkflowExecutionsRequest) writeField60(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTypeFilter() {
+    if err := oprot.WriteFieldBegin("typeFilter", thrift.STRUCT, 60); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:typeFilter: ", p), err) }
+    if err := p.TypeFilter.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TypeFilter), err)
+    }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 110:heartbeatTimeoutSeconds: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:typeFilter: ", p), err) }
   }
   return err
 }
 
-func (p *PollForActivityTaskResponse) String() string {
+func (p *ListOpenWorkflowExecutionsRequest) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("PollForActivityTaskResponse(%+v)", *p)
+  return fmt.Sprintf("ListOpenWorkflowExecutionsRequest(%+v)", *p)
 }
 
 // Attributes:
-//  - TaskToken
-//  - Details
-//  - Identity
-type RecordActivityTaskHeartbeatRequest struct {
+//  - Executions
+//  - NextPageToken
+type ListOpenWorkflowExecutionsResponse struct {
   // unused fields # 1 to 9
-  TaskToken []byte `thrift:"taskToken,10" db:"taskToken" json:"taskToken,omitempty"`
+  Executions []*WorkflowExecutionInfo `thrift:"executions,10" db:"executions" json:"executions,omitempty"`
   // unused fields # 11 to 19
-  Details []byte `thrift:"details,20" db:"details" json:"details,omitempty"`
-  // unused fields # 21 to 29
-  Identity *string `thrift:"identity,30" db:"identity" json:"identity,omitempty"`
+  NextPageToken []byte `thrift:"nextPageToken,20" db:"nextPageToken" json:"nextPageToken,omitempty"`
 }
 
-func NewRecordActivityTaskHeartbeatRequest() *RecordActivityTaskHeartbeatRequest {
-  return &RecordActivityTaskHeartbeatRequest{}
+func NewListOpenWorkflowExecutionsResponse() *ListOpenWorkflowExecutionsResponse {
+  return &ListOpenWorkflowExecutionsResponse{}
 }
 
-var RecordActivityTaskHeartbeatRequest_TaskToken_DEFAULT []byte
+var ListOpenWorkflowExecutionsResponse_Executions_DEFAULT []*WorkflowExecutionInfo
 
-func (p *RecordActivityTaskHeartbeatRequest) GetTaskToken() []byte {
-  return p.TaskToken
+func (p *ListOpenWorkflowExecutionsResponse) GetExecutions() []*WorkflowExecutionInfo {
+  return p.Executions
 }
-var RecordActivityTaskHeartbeatRequest_Details_DEFAULT []byte
+var ListOpenWorkflowExecutionsResponse_NextPageToken_DEFAULT []byte
 
-func (p *RecordActivityTaskHeartbeatRequest) GetDetails() []byte {
-  return p.Details
-}
-var RecordActivityTaskHeartbeatRequest_Identity_DEFAULT string
-func (p *RecordActivityTaskHeartbeatRequest) GetIdentity() string {
-  if !p.IsSetIdentity() {
-    return RecordActivityTaskHeartbeatRequest_Identity_DEFAULT
-  }
-return *p.Identity
-}
-func (p *RecordActivityTaskHeartbeatRequest) IsSetTaskToken() bool {
-  return p.TaskToken != nil
+func (p *ListOpenWorkflowExecutionsResponse) GetNextPageToken() []byte {
+  return p.NextPageToken
 }
-
-func (p *RecordActivityTaskHeartbeatRequest) IsSetDetails() bool {
-  return p.Details != nil
+func (p *ListOpenWorkflowExecutionsResponse) IsSetExecutions() bool {
+  return p.Executions != nil
 }
 
-func (p *RecordActivityTaskHeartbeatRequest) IsSetIdentity() bool {
-  return p.Identity != nil
+func (p *ListOpenWorkflowExecutionsResponse) IsSetNextPageToken() bool {
+  return p.NextPageToken != nil
 }
 
-func (p *RecordActivityTaskHeartbeatRequest) Read(iprot thrift.TProtocol) error {
+func (p *ListOpenWorkflowExecutionsResponse) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -18722,10 +25113,6 @@ func (p *RecordActivityTaskHeartbeatRequest) Read(iprot thrift.TProtocol) error
       if err := p.ReadField20(iprot); err != nil {
         return err
       }
-    case 30:
-      if err := p.ReadField30(iprot); err != nil {
-        return err
-      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -18741,40 +25128,41 @@ func (p *RecordActivityTaskHeartbeatRequest) Read(iprot thrift.TProtocol) error
   return nil
 }
 
-func (p *RecordActivityTaskHeartbeatRequest)  ReadField10(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBinary(); err != nil {
-  return thrift.PrependError("error reading field 10: ", err)
-} else {
-  p.TaskToken = v
-}
+func (p *ListOpenWorkflowExecutionsResponse)  ReadField10(iprot thrift.TProtocol) error {
+  _, size, err := iprot.ReadListBegin()
+  if err != nil {
+    return thrift.PrependError("error reading list begin: ", err)
+  }
+  tSlice := make([]*WorkflowExecutionInfo, 0, size)
+  p.Executions =  tSlice
+  for i := 0; i < size; i ++ {
+    _elem2 := &WorkflowExecutionInfo{}
+    if err := _elem2.Read(iprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem2), err)
+    }
+    p.Executions = append(p.Executions, _elem2)
+  }
+  if err := iprot.ReadListEnd(); err != nil {
+    return thrift.PrependError("error reading list end: ", err)
+  }
   return nil
 }
 
-func (p *RecordActivityTaskHeartbeatRequest)  ReadField20(iprot thrift.TProtocol) error {
+func (p *ListOpenWorkflowExecutionsResponse)  ReadField20(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadBinary(); err != nil {
   return thrift.PrependError("error reading field 20: ", err)
 } else {
-  p.Details = v
-}
-  return nil
-}
-
-func (p *RecordActivityTaskHeartbeatRequest)  ReadField30(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 30: ", err)
-} else {
-  p.Identity = &v
+  p.NextPageToken = v
 }
   return nil
 }
 
-func (p *RecordActivityTaskHeartbeatRequest) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RecordActivityTaskHeartbeatRequest"); err != nil {
+func (p *ListOpenWorkflowExecutionsResponse) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ListOpenWorkflowExecutionsResponse"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
     if err := p.writeField20(oprot); err != nil { return err }
-    if err := p.writeField30(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -18783,72 +25171,151 @@ func (p *RecordActivityTaskHeartbeatRequest) Write(oprot thrift.TProtocol) error
   return nil
 }
 
-func (p *RecordActivityTaskHeartbeatRequest) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetTaskToken() {
-    if err := oprot.WriteFieldBegin("taskToken", thrift.STRING, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:taskToken: ", p), err) }
-    if err := oprot.WriteBinary(p.TaskToken); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.taskToken (10) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:taskToken: ", p), err) }
-  }
-  return err
-}
-
-func (p *RecordActivityTaskHeartbeatRequest) writeField20(oprot thrift.TProtocol) (err error) {
-  if p.IsSetDetails() {
-    if err := oprot.WriteFieldBegin("details", thrift.STRING, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:details: ", p), err) }
-    if err := oprot.WriteBinary(p.Details); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.details (20) field write error: ", p), err) }
+func (p *ListOpenWorkflowExecutionsResponse) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetExecutions() {
+    if err := oprot.WriteFieldBegin("executions", thrift.LIST, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:executions: ", p), err) }
+    if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Executions)); err != nil {
+      return thrift.PrependError("error writing list begin: ", err)
+    }
+    for _, v := range p.Executions {
+      if err := v.Write(oprot); err != nil {
+        return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+      }
+    }
+    if err := oprot.WriteListEnd(); err != nil {
+      return thrift.PrependError("error writing list end: ", err)
+    }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:details: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:executions: ", p), err) }
   }
   return err
 }
 
-func (p *RecordActivityTaskHeartbeatRequest) writeField30(oprot thrift.TProtocol) (err error) {
-  if p.IsSetIdentity() {
-    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 30); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:identity: ", p), err) }
-    if err := oprot.WriteString(string(*p.Identity)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.identity (30) field write error: ", p), err) }
+func (p *ListOpenWorkflowExecutionsResponse) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetNextPageToken() {
+    if err := oprot.WriteFieldBegin("nextPageToken", thrift.STRING, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:nextPageToken: ", p), err) }
+    if err := oprot.WriteBinary(p.NextPageToken); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.nextPageToken (20) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:identity: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:nextPageToken: ", p), err) }
   }
   return err
 }
 
-func (p *RecordActivityTaskHeartbeatRequest) String() string {
+func (p *ListOpenWorkflowExecutionsResponse) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("RecordActivityTaskHeartbeatRequest(%+v)", *p)
+  return fmt.Sprintf("ListOpenWorkflowExecutionsResponse(%+v)", *p)
 }
 
 // Attributes:
-//  - CancelRequested
-type RecordActivityTaskHeartbeatResponse struct {
+//  - Domain
+//  - MaximumPageSize
+//  - NextPageToken
+//  - StartTimeFilter
+//  - ExecutionFilter
+//  - TypeFilter
+//  - StatusFilter
+type ListClosedWorkflowExecutionsRequest struct {
   // unused fields # 1 to 9
-  CancelRequested *bool `thrift:"cancelRequested,10" db:"cancelRequested" json:"cancelRequested,omitempty"`
+  Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
+  // unused fields # 11 to 19
+  MaximumPageSize *int32 `thrift:"maximumPageSize,20" db:"maximumPageSize" json:"maximumPageSize,omitempty"`
+  // unused fields # 21 to 29
+  NextPageToken []byte `thrift:"nextPageToken,30" db:"nextPageToken" json:"nextPageToken,omitempty"`
+  // unused fields # 31 to 39
+  StartTimeFilter *StartTimeFilter `thrift:"StartTimeFilter,40" db:"StartTimeFilter" json:"StartTimeFilter,omitempty"`
+  // unused fields # 41 to 49
+  ExecutionFilter *WorkflowExecutionFilter `thrift:"executionFilter,50" db:"executionFilter" json:"executionFilter,omitempty"`
+  // unused fields # 51 to 59
+  TypeFilter *WorkflowTypeFilter `thrift:"typeFilter,60" db:"typeFilter" json:"typeFilter,omitempty"`
+  // unused fields # 61 to 69
+  StatusFilter *WorkflowExecutionCloseStatus `thrift:"statusFilter,70" db:"statusFilter" json:"statusFilter,omitempty"`
 }
 
-func NewRecordActivityTaskHeartbeatResponse() *RecordActivityTaskHeartbeatResponse {
-  return &RecordActivityTaskHeartbeatResponse{}
+func NewListClosedWorkflowExecutionsRequest() *ListClosedWorkflowExecutionsRequest {
+  return &ListClosedWorkflowExecutionsRequest{}
 }
 
-var RecordActivityTaskHeartbeatResponse_CancelRequested_DEFAULT bool
-func (p *RecordActivityTaskHeartbeatResponse) GetCancelRequested() bool {
-  if !p.IsSetCancelRequested() {
-    return RecordActivityTaskHeartbeatResponse_CancelRequested_DEFAULT
+var ListClosedWorkflowExecutionsRequest_Domain_DEFAULT string
+func (p *ListClosedWorkflowExecutionsRequest) GetDomain() string {
+  if !p.IsSetDomain() {
+    return ListClosedWorkflowExecutionsRequest_Domain_DEFAULT
   }
-return *p.CancelRequested
+return *p.Domain
 }
-func (p *RecordActivityTaskHeartbeatResponse) IsSetCancelRequested() bool {
-  return p.CancelRequested != nil
+var ListClosedWorkflowExecutionsRequest_MaximumPageSize_DEFAULT int32
+func (p *ListClosedWorkflowExecutionsRequest) GetMaximumPageSize() int32 {
+  if !p.IsSetMaximumPageSize() {
+    return ListClosedWorkflowExecutionsRequest_MaximumPageSize_DEFAULT
+  }
+return *p.MaximumPageSize
 }
+var ListClosedWorkflowExecutionsRequest_NextPageToken_DEFAULT []byte
 
-func (p *RecordActivityTaskHeartbeatResponse) Read(iprot thrift.TProtocol) error {
+func (p *ListClosedWorkflowExecutionsRequest) GetNextPageToken() []byte {
+  return p.NextPageToken
+}
+var ListClosedWorkflowExecutionsRequest_StartTimeFilter_DEFAULT *StartTimeFilter
+func (p *ListClosedWorkflowExecutionsRequest) GetStartTimeFilter() *StartTimeFilter {
+  if !p.IsSetStartTimeFilter() {
+    return ListClosedWorkflowExecutionsRequest_StartTimeFilter_DEFAULT
+  }
+return p.StartTimeFilter
+}
+var ListClosedWorkflowExecutionsRequest_ExecutionFilter_DEFAULT *WorkflowExecutionFilter
+func (p *ListClosedWorkflowExecutionsRequest) GetExecutionFilter() *WorkflowExecutionFilter {
+  if !p.IsSetExecutionFilter() {
+    return ListClosedWorkflowExecutionsRequest_ExecutionFilter_DEFAULT
+  }
+return p.ExecutionFilter
+}
+var ListClosedWorkflowExecutionsRequest_TypeFilter_DEFAULT *WorkflowTypeFilter
+func (p *ListClosedWorkflowExecutionsRequest) GetTypeFilter() *WorkflowTypeFilter {
+  if !p.IsSetTypeFilter() {
+    return ListClosedWorkflowExecutionsRequest_TypeFilter_DEFAULT
+  }
+return p.TypeFilter
+}
+var ListClosedWorkflowExecutionsRequest_StatusFilter_DEFAULT WorkflowExecutionCloseStatus
+func (p *ListClosedWorkflowExecutionsRequest) GetStatusFilter() WorkflowExecutionCloseStatus {
+  if !p.IsSetStatusFilter() {
+    return ListClosedWorkflowExecutionsRequest_StatusFilter_DEFAULT
+  }
+return *p.StatusFilter
+}
+func (p *ListClosedWorkflowExecutionsRequest) IsSetDomain() bool {
+  return p.Domain != nil
+}
+
+func (p *ListClosedWorkflowExecutionsRequest) IsSetMaximumPageSize() bool {
+  return p.MaximumPageSize != nil
+}
+
+func (p *ListClosedWorkflowExecutionsRequest) IsSetNextPageToken() bool {
+  return p.NextPageToken != nil
+}
+
+func (p *ListClosedWorkflowExecutionsRequest) IsSetStartTimeFilter() bool {
+  return p.StartTimeFilter != nil
+}
+
+func (p *ListClosedWorkflowExecutionsRequest) IsSetExecutionFilter() bool {
+  return p.ExecutionFilter != nil
+}
+
+func (p *ListClosedWorkflowExecutionsRequest) IsSetTypeFilter() bool {
+  return p.TypeFilter != nil
+}
+
+func (p *ListClosedWorkflowExecutionsRequest) IsSetStatusFilter() bool {
+  return p.StatusFilter != nil
+}
+
+func (p *ListClosedWorkflowExecutionsRequest) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -18865,6 +25332,30 @@ func (p *RecordActivityTaskHeartbeatResponse) Read(iprot thrift.TProtocol) error
       if err := p.ReadField10(iprot); err != nil {
         return err
       }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
+    case 50:
+      if err := p.ReadField50(iprot); err != nil {
+        return err
+      }
+    case 60:
+      if err := p.ReadField60(iprot); err != nil {
+        return err
+      }
+    case 70:
+      if err := p.ReadField70(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -18880,20 +25371,78 @@ func (p *RecordActivityTaskHeartbeatResponse) Read(iprot thrift.TProtocol) error
   return nil
 }
 
-func (p *RecordActivityTaskHeartbeatResponse)  ReadField10(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBool(); err != nil {
+func (p *ListClosedWorkflowExecutionsRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 10: ", err)
 } else {
-  p.CancelRequested = &v
+  p.Domain = &v
 }
   return nil
 }
 
-func (p *RecordActivityTaskHeartbeatResponse) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RecordActivityTaskHeartbeatResponse"); err != nil {
+func (p *ListClosedWorkflowExecutionsRequest)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 20: ", err)
+} else {
+  p.MaximumPageSize = &v
+}
+  return nil
+}
+
+func (p *ListClosedWorkflowExecutionsRequest)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
+} else {
+  p.NextPageToken = v
+}
+  return nil
+}
+
+func (p *ListClosedWorkflowExecutionsRequest)  ReadField40(iprot thrift.TProtocol) error {
+  p.StartTimeFilter = &StartTimeFilter{}
+  if err := p.StartTimeFilter.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.StartTimeFilter), err)
+  }
+  return nil
+}
+
+func (p *ListClosedWorkflowExecutionsRequest)  ReadField50(iprot thrift.TProtocol) error {
+  p.ExecutionFilter = &WorkflowExecutionFilter{}
+  if err := p.ExecutionFilter.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ExecutionFilter), err)
+  }
+  return nil
+}
+
+func (p *ListClosedWorkflowExecutionsRequest)  ReadField60(iprot thrift.TProtocol) error {
+  p.TypeFilter = &WorkflowTypeFilter{}
+  if err := p.TypeFilter.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TypeFilter), err)
+  }
+  return nil
+}
+
+func (p *ListClosedWorkflowExecutionsRequest)  ReadField70(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 70: ", err)
+} else {
+  temp := WorkflowExecutionCloseStatus(v)
+  p.StatusFilter = &temp
+}
+  return nil
+}
+
+func (p *ListClosedWorkflowExecutionsRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ListClosedWorkflowExecutionsRequest"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
+    if err := p.writeField40(oprot); err != nil { return err }
+    if err := p.writeField50(oprot); err != nil { return err }
+    if err := p.writeField60(oprot); err != nil { return err }
+    if err := p.writeField70(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -18902,72 +25451,133 @@ func (p *RecordActivityTaskHeartbeatResponse) Write(oprot thrift.TProtocol) erro
   return nil
 }
 
-func (p *RecordActivityTaskHeartbeatResponse) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetCancelRequested() {
-    if err := oprot.WriteFieldBegin("cancelRequested", thrift.BOOL, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:cancelRequested: ", p), err) }
-    if err := oprot.WriteBool(bool(*p.CancelRequested)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.cancelRequested (10) field write error: ", p), err) }
+func (p *ListClosedWorkflowExecutionsRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomain() {
+    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
+    if err := oprot.WriteString(string(*p.Domain)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domain (10) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:cancelRequested: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domain: ", p), err) }
   }
   return err
 }
 
-func (p *RecordActivityTaskHeartbeatResponse) String() string {
+func (p *ListClosedWorkflowExecutionsRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetMaximumPageSize() {
+    if err := oprot.WriteFieldBegin("maximumPageSize", thrift.I32, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:maximumPageSize: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.MaximumPageSize)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.maximumPageSize (20) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:maximumPageSize: ", p), err) }
+  }
+  return err
+}
+
+func (p *ListClosedWorkflowExecutionsRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetNextPageToken() {
+    if err := oprot.WriteFieldBegin("nextPageToken", thrift.STRING, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:nextPageToken: ", p), err) }
+    if err := oprot.WriteBinary(p.NextPageToken); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.nextPageToken (30) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:nextPageToken: ", p), err) }
+  }
+  return err
+}
+
+func (p *ListClosedWorkflowExecutionsRequest) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetStartTimeFilter() {
+    if err := oprot.WriteFieldBegin("StartTimeFilter", thrift.STRUCT, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:StartTimeFilter: ", p), err) }
+    if err := p.StartTimeFilter.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.StartTimeFilter), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:StartTimeFilter: ", p), err) }
+  }
+  return err
+}
+
+func (p *ListClosedWorkflowExecutionsRequest) writeField50(oprot thrift.TProtocol) (err error) {
+  if p.IsSetExecutionFilter() {
+    if err := oprot.WriteFieldBegin("executionFilter", thrift.STRUCT, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:executionFilter: ", p), err) }
+    if err := p.ExecutionFilter.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ExecutionFilter), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:executionFilter: ", p), err) }
+  }
+  return err
+}
+
+func (p *ListClosedWorkflowExecutionsRequest) writeField60(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTypeFilter() {
+    if err := oprot.WriteFieldBegin("typeFilter", thrift.STRUCT, 60); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:typeFilter: ", p), err) }
+    if err := p.TypeFilter.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TypeFilter), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:typeFilter: ", p), err) }
+  }
+  return err
+}
+
+func (p *ListClosedWorkflowExecutionsRequest) writeField70(oprot thrift.TProtocol) (err error) {
+  if p.IsSetStatusFilter() {
+    if err := oprot.WriteFieldBegin("statusFilter", thrift.I32, 70); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 70:statusFilter: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.StatusFilter)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.statusFilter (70) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 70:statusFilter: ", p), err) }
+  }
+  return err
+}
+
+func (p *ListClosedWorkflowExecutionsRequest) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("RecordActivityTaskHeartbeatResponse(%+v)", *p)
+  return fmt.Sprintf("ListClosedWorkflowExecutionsRequest(%+v)", *p)
 }
 
 // Attributes:
-//  - TaskToken
-//  - Result_
-//  - Identity
-type RespondActivityTaskCompletedRequest struct {
+//  - Executions
+//  - NextPageToken
+type ListClosedWorkflowExecutionsResponse struct {
   // unused fields # 1 to 9
-  TaskToken []byte `thrift:"taskToken,10" db:"taskToken" json:"taskToken,omitempty"`
+  Executions []*WorkflowExecutionInfo `thrift:"executions,10" db:"executions" json:"executions,omitempty"`
   // unused fields # 11 to 19
-  Result_ []byte `thrift:"result,20" db:"result" json:"result,omitempty"`
-  // unused fields # 21 to 29
-  Identity *string `thrift:"identity,30" db:"identity" json:"identity,omitempty"`
+  NextPageToken []byte `thrift:"nextPageToken,20" db:"nextPageToken" json:"nextPageToken,omitempty"`
 }
 
-func NewRespondActivityTaskCompletedRequest() *RespondActivityTaskCompletedRequest {
-  return &RespondActivityTaskCompletedRequest{}
+func NewListClosedWorkflowExecutionsResponse() *ListClosedWorkflowExecutionsResponse {
+  return &ListClosedWorkflowExecutionsResponse{}
 }
 
-var RespondActivityTaskCompletedRequest_TaskToken_DEFAULT []byte
+var ListClosedWorkflowExecutionsResponse_Executions_DEFAULT []*WorkflowExecutionInfo
 
-func (p *RespondActivityTaskCompletedRequest) GetTaskToken() []byte {
-  return p.TaskToken
+func (p *ListClosedWorkflowExecutionsResponse) GetExecutions() []*WorkflowExecutionInfo {
+  return p.Executions
 }
-var RespondActivityTaskCompletedRequest_Result__DEFAULT []byte
+var ListClosedWorkflowExecutionsResponse_NextPageToken_DEFAULT []byte
 
-func (p *RespondActivityTaskCompletedRequest) GetResult_() []byte {
-  return p.Result_
-}
-var RespondActivityTaskCompletedRequest_Identity_DEFAULT string
-func (p *RespondActivityTaskCompletedRequest) GetIdentity() string {
-  if !p.IsSetIdentity() {
-    return RespondActivityTaskCompletedRequest_Identity_DEFAULT
-  }
-return *p.Identity
-}
-func (p *RespondActivityTaskCompletedRequest) IsSetTaskToken() bool {
-  return p.TaskToken != nil
+func (p *ListClosedWorkflowExecutionsResponse) GetNextPageToken() []byte {
+  return p.NextPageToken
 }
-
-func (p *RespondActivityTaskCompletedRequest) IsSetResult_() bool {
-  return p.Result_ != nil
+func (p *ListClosedWorkflowExecutionsResponse) IsSetExecutions() bool {
+  return p.Executions != nil
 }
 
-func (p *RespondActivityTaskCompletedRequest) IsSetIdentity() bool {
-  return p.Identity != nil
+func (p *ListClosedWorkflowExecutionsResponse) IsSetNextPageToken() bool {
+  return p.NextPageToken != nil
 }
 
-func (p *RespondActivityTaskCompletedRequest) Read(iprot thrift.TProtocol) error {
+func (p *ListClosedWorkflowExecutionsResponse) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -18988,10 +25598,6 @@ func (p *RespondActivityTaskCompletedRequest) Read(iprot thrift.TProtocol) error
       if err := p.ReadField20(iprot); err != nil {
         return err
       }
-    case 30:
-      if err := p.ReadField30(iprot); err != nil {
-        return err
-      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -19007,40 +25613,41 @@ func (p *RespondActivityTaskCompletedRequest) Read(iprot thrift.TProtocol) error
   return nil
 }
 
-func (p *RespondActivityTaskCompletedRequest)  ReadField10(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBinary(); err != nil {
-  return thrift.PrependError("error reading field 10: ", err)
-} else {
-  p.TaskToken = v
-}
-  return nil
-}
-
-func (p *RespondActivityTaskCompletedRequest)  ReadField20(iprot thrift.TProtocol) error {
+func (p *ListClosedWorkflowExecutionsResponse)  ReadField10(iprot thrift.TProtocol) error {
+  _, size, err := iprot.ReadListBegin()
+  if err != nil {
+    return thrift.PrependError("error reading list begin: ", err)
+  }
+  tSlice := make([]*WorkflowExecutionInfo, 0, size)
+  p.Executions =  tSlice
+  for i := 0; i < size; i ++ {
+    _elem3 := &WorkflowExecutionInfo{}
+    if err := _elem3.Read(iprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem3), err)
+    }
+    p.Executions = append(p.Executions, _elem3)
+  }
+  if err := iprot.ReadListEnd(); err != nil {
+    return thrift.PrependError("error reading list end: ", err)
+  }
+  return nil
+}
+
+func (p *ListClosedWorkflowExecutionsResponse)  ReadField20(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadBinary(); err != nil {
   return thrift.PrependError("error reading field 20: ", err)
 } else {
-  p.Result_ = v
-}
-  return nil
-}
-
-func (p *RespondActivityTaskCompletedRequest)  ReadField30(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 30: ", err)
-} else {
-  p.Identity = &v
+  p.NextPageToken = v
 }
   return nil
 }
 
-func (p *RespondActivityTaskCompletedRequest) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondActivityTaskCompletedRequest"); err != nil {
+func (p *ListClosedWorkflowExecutionsResponse) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ListClosedWorkflowExecutionsResponse"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
     if err := p.writeField20(oprot); err != nil { return err }
-    if err := p.writeField30(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -19049,110 +25656,95 @@ func (p *RespondActivityTaskCompletedRequest) Write(oprot thrift.TProtocol) erro
   return nil
 }
 
-func (p *RespondActivityTaskCompletedRequest) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetTaskToken() {
-    if err := oprot.WriteFieldBegin("taskToken", thrift.STRING, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:taskToken: ", p), err) }
-    if err := oprot.WriteBinary(p.TaskToken); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.taskToken (10) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:taskToken: ", p), err) }
-  }
-  return err
-}
-
-func (p *RespondActivityTaskCompletedRequest) writeField20(oprot thrift.TProtocol) (err error) {
-  if p.IsSetResult_() {
-    if err := oprot.WriteFieldBegin("result", thrift.STRING, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:result: ", p), err) }
-    if err := oprot.WriteBinary(p.Result_); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.result (20) field write error: ", p), err) }
+func (p *ListClosedWorkflowExecutionsResponse) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetExecutions() {
+    if err := oprot.WriteFieldBegin("executions", thrift.LIST, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:executions: ", p), err) }
+    if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Executions)); err != nil {
+      return thrift.PrependError("error writing list begin: ", err)
+    }
+    for _, v := range p.Executions {
+      if err := v.Write(oprot); err != nil {
+        return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+      }
+    }
+    if err := oprot.WriteListEnd(); err != nil {
+      return thrift.PrependError("error writing list end: ", err)
+    }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:result: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:executions: ", p), err) }
   }
   return err
 }
 
-func (p *RespondActivityTaskCompletedRequest) writeField30(oprot thrift.TProtocol) (err error) {
-  if p.IsSetIdentity() {
-    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 30); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:identity: ", p), err) }
-    if err := oprot.WriteString(string(*p.Identity)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.identity (30) field write error: ", p), err) }
+func (p *ListClosedWorkflowExecutionsResponse) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetNextPageToken() {
+    if err := oprot.WriteFieldBegin("nextPageToken", thrift.STRING, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:nextPageToken: ", p), err) }
+    if err := oprot.WriteBinary(p.NextPageToken); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.nextPageToken (20) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:identity: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:nextPageToken: ", p), err) }
   }
   return err
 }
 
-func (p *RespondActivityTaskCompletedRequest) String() string {
+func (p *ListClosedWorkflowExecutionsResponse) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("RespondActivityTaskCompletedRequest(%+v)", *p)
+  return fmt.Sprintf("ListClosedWorkflowExecutionsResponse(%+v)", *p)
 }
 
 // Attributes:
-//  - TaskToken
-//  - Reason
-//  - Details
-//  - Identity
-type RespondActivityTaskFailedRequest struct {
+//  - Domain
+//  - MaximumPageSize
+//  - NextPageToken
+type ScanWorkflowExecutionsRequest struct {
   // unused fields # 1 to 9
-  TaskToken []byte `thrift:"taskToken,10" db:"taskToken" json:"taskToken,omitempty"`
+  Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
   // unused fields # 11 to 19
-  Reason *string `thrift:"reason,20" db:"reason" json:"reason,omitempty"`
+  MaximumPageSize *int32 `thrift:"maximumPageSize,20" db:"maximumPageSize" json:"maximumPageSize,omitempty"`
   // unused fields # 21 to 29
-  Details []byte `thrift:"details,30" db:"details" json:"details,omitempty"`
-  // unused fields # 31 to 39
-  Identity *string `thrift:"identity,40" db:"identity" json:"identity,omitempty"`
+  NextPageToken []byte `thrift:"nextPageToken,30" db:"nextPageToken" json:"nextPageToken,omitempty"`
 }
 
-func NewRespondActivityTaskFailedRequest() *RespondActivityTaskFailedRequest {
-  return &RespondActivityTaskFailedRequest{}
+func NewScanWorkflowExecutionsRequest() *ScanWorkflowExecutionsRequest {
+  return &ScanWorkflowExecutionsRequest{}
 }
 
-var RespondActivityTaskFailedRequest_TaskToken_DEFAULT []byte
-
-func (p *RespondActivityTaskFailedRequest) GetTaskToken() []byte {
-  return p.TaskToken
-}
-var RespondActivityTaskFailedRequest_Reason_DEFAULT string
-func (p *RespondActivityTaskFailedRequest) GetReason() string {
-  if !p.IsSetReason() {
-    return RespondActivityTaskFailedRequest_Reason_DEFAULT
+var ScanWorkflowExecutionsRequest_Domain_DEFAULT string
+func (p *ScanWorkflowExecutionsRequest) GetDomain() string {
+  if !p.IsSetDomain() {
+    return ScanWorkflowExecutionsRequest_Domain_DEFAULT
   }
-return *p.Reason
-}
-var RespondActivityTaskFailedRequest_Details_DEFAULT []byte
-
-func (p *RespondActivityTaskFailedRequest) GetDetails() []byte {
-  return p.Details
+return *p.Domain
 }
-var RespondActivityTaskFailedRequest_Identity_DEFAULT string
-func (p *RespondActivityTaskFailedRequest) GetIdentity() string {
-  if !p.IsSetIdentity() {
-    return RespondActivityTaskFailedRequest_Identity_DEFAULT
+var ScanWorkflowExecutionsRequest_MaximumPageSize_DEFAULT int32
+func (p *ScanWorkflowExecutionsRequest) GetMaximumPageSize() int32 {
+  if !p.IsSetMaximumPageSize() {
+    return ScanWorkflowExecutionsRequest_MaximumPageSize_DEFAULT
   }
-return *p.Identity
-}
-func (p *RespondActivityTaskFailedRequest) IsSetTaskToken() bool {
-  return p.TaskToken != nil
+return *p.MaximumPageSize
 }
+var ScanWorkflowExecutionsRequest_NextPageToken_DEFAULT []byte
 
-func (p *RespondActivityTaskFailedRequest) IsSetReason() bool {
-  return p.Reason != nil
+func (p *ScanWorkflowExecutionsRequest) GetNextPageToken() []byte {
+  return p.NextPageToken
+}
+func (p *ScanWorkflowExecutionsRequest) IsSetDomain() bool {
+  return p.Domain != nil
 }
 
-func (p *RespondActivityTaskFailedRequest) IsSetDetails() bool {
-  return p.Details != nil
+func (p *ScanWorkflowExecutionsRequest) IsSetMaximumPageSize() bool {
+  return p.MaximumPageSize != nil
 }
 
-func (p *RespondActivityTaskFailedRequest) IsSetIdentity() bool {
-  return p.Identity != nil
+func (p *ScanWorkflowExecutionsRequest) IsSetNextPageToken() bool {
+  return p.NextPageToken != nil
 }
 
-func (p *RespondActivityTaskFailedRequest) Read(iprot thrift.TProtocol) error {
+func (p *ScanWorkflowExecutionsRequest) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -19177,10 +25769,6 @@ func (p *RespondActivityTaskFailedRequest) Read(iprot thrift.TProtocol) error {
       if err := p.ReadField30(iprot); err != nil {
         return err
       }
-    case 40:
-      if err := p.ReadField40(iprot); err != nil {
-        return err
-      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -19196,50 +25784,40 @@ func (p *RespondActivityTaskFailedRequest) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *RespondActivityTaskFailedRequest)  ReadField10(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBinary(); err != nil {
+func (p *ScanWorkflowExecutionsRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 10: ", err)
 } else {
-  p.TaskToken = v
+  p.Domain = &v
 }
   return nil
 }
 
-func (p *RespondActivityTaskFailedRequest)  ReadField20(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
+func (p *ScanWorkflowExecutionsRequest)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
   return thrift.PrependError("error reading field 20: ", err)
 } else {
-  p.Reason = &v
+  p.MaximumPageSize = &v
 }
   return nil
 }
 
-func (p *RespondActivityTaskFailedRequest)  ReadField30(iprot thrift.TProtocol) error {
+func (p *ScanWorkflowExecutionsRequest)  ReadField30(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadBinary(); err != nil {
   return thrift.PrependError("error reading field 30: ", err)
 } else {
-  p.Details = v
-}
-  return nil
-}
-
-func (p *RespondActivityTaskFailedRequest)  ReadField40(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 40: ", err)
-} else {
-  p.Identity = &v
+  p.NextPageToken = v
 }
   return nil
 }
 
-func (p *RespondActivityTaskFailedRequest) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondActivityTaskFailedRequest"); err != nil {
+func (p *ScanWorkflowExecutionsRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ScanWorkflowExecutionsRequest"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
     if err := p.writeField20(oprot); err != nil { return err }
     if err := p.writeField30(oprot); err != nil { return err }
-    if err := p.writeField40(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -19248,108 +25826,82 @@ func (p *RespondActivityTaskFailedRequest) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *RespondActivityTaskFailedRequest) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetTaskToken() {
-    if err := oprot.WriteFieldBegin("taskToken", thrift.STRING, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:taskToken: ", p), err) }
-    if err := oprot.WriteBinary(p.TaskToken); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.taskToken (10) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:taskToken: ", p), err) }
-  }
-  return err
-}
-
-func (p *RespondActivityTaskFailedRequest) writeField20(oprot thrift.TProtocol) (err error) {
-  if p.IsSetReason() {
-    if err := oprot.WriteFieldBegin("reason", thrift.STRING, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:reason: ", p), err) }
-    if err := oprot.WriteString(string(*p.Reason)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.reason (20) field write error: ", p), err) }
+func (p *ScanWorkflowExecutionsRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomain() {
+    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
+    if err := oprot.WriteString(string(*p.Domain)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domain (10) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:reason: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domain: ", p), err) }
   }
   return err
 }
 
-func (p *RespondActivityTaskFailedRequest) writeField30(oprot thrift.TProtocol) (err error) {
-  if p.IsSetDetails() {
-    if err := oprot.WriteFieldBegin("details", thrift.STRING, 30); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:details: ", p), err) }
-    if err := oprot.WriteBinary(p.Details); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.details (30) field write error: ", p), err) }
+func (p *ScanWorkflowExecutionsRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetMaximumPageSize() {
+    if err := oprot.WriteFieldBegin("maximumPageSize", thrift.I32, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:maximumPageSize: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.MaximumPageSize)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.maximumPageSize (20) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:details: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:maximumPageSize: ", p), err) }
   }
   return err
 }
 
-func (p *RespondActivityTaskFailedRequest) writeField40(oprot thrift.TProtocol) (err error) {
-  if p.IsSetIdentity() {
-    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 40); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:identity: ", p), err) }
-    if err := oprot.WriteString(string(*p.Identity)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.identity (40) field write error: ", p), err) }
+func (p *ScanWorkflowExecutionsRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetNextPageToken() {
+    if err := oprot.WriteFieldBegin("nextPageToken", thrift.STRING, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:nextPageToken: ", p), err) }
+    if err := oprot.WriteBinary(p.NextPageToken); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.nextPageToken (30) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:identity: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:nextPageToken: ", p), err) }
   }
   return err
 }
 
-func (p *RespondActivityTaskFailedRequest) String() string {
+func (p *ScanWorkflowExecutionsRequest) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("RespondActivityTaskFailedRequest(%+v)", *p)
+  return fmt.Sprintf("ScanWorkflowExecutionsRequest(%+v)", *p)
 }
 
 // Attributes:
-//  - TaskToken
-//  - Details
-//  - Identity
-type RespondActivityTaskCanceledRequest struct {
+//  - Executions
+//  - NextPageToken
+type ScanWorkflowExecutionsResponse struct {
   // unused fields # 1 to 9
-  TaskToken []byte `thrift:"taskToken,10" db:"taskToken" json:"taskToken,omitempty"`
+  Executions []*WorkflowExecutionInfo `thrift:"executions,10" db:"executions" json:"executions,omitempty"`
   // unused fields # 11 to 19
-  Details []byte `thrift:"details,20" db:"details" json:"details,omitempty"`
-  // unused fields # 21 to 29
-  Identity *string `thrift:"identity,30" db:"identity" json:"identity,omitempty"`
-}
-
-func NewRespondActivityTaskCanceledRequest() *RespondActivityTaskCanceledRequest {
-  return &RespondActivityTaskCanceledRequest{}
-}
-
-var RespondActivityTaskCanceledRequest_TaskToken_DEFAULT []byte
-
-func (p *RespondActivityTaskCanceledRequest) GetTaskToken() []byte {
-  return p.TaskToken
-}
-var RespondActivityTaskCanceledRequest_Details_DEFAULT []byte
-
-func (p *RespondActivityTaskCanceledRequest) GetDetails() []byte {
-  return p.Details
-}
-var RespondActivityTaskCanceledRequest_Identity_DEFAULT string
-func (p *RespondActivityTaskCanceledRequest) GetIdentity() string {
-  if !p.IsSetIdentity() {
-    return RespondActivityTaskCanceledRequest_Identity_DEFAULT
-  }
-return *p.Identity
+  NextPageToken []byte `thrift:"nextPageToken,20" db:"nextPageToken" json:"nextPageToken,omitempty"`
 }
-func (p *RespondActivityTaskCanceledRequest) IsSetTaskToken() bool {
-  return p.TaskToken != nil
+
+func NewScanWorkflowExecutionsResponse() *ScanWorkflowExecutionsResponse {
+  return &ScanWorkflowExecutionsResponse{}
 }
 
-func (p *RespondActivityTaskCanceledRequest) IsSetDetails() bool {
-  return p.Details != nil
+var ScanWorkflowExecutionsResponse_Executions_DEFAULT []*WorkflowExecutionInfo
+
+func (p *ScanWorkflowExecutionsResponse) GetExecutions() []*WorkflowExecutionInfo {
+  return p.Executions
 }
+var ScanWorkflowExecutionsResponse_NextPageToken_DEFAULT []byte
 
-func (p *RespondActivityTaskCanceledRequest) IsSetIdentity() bool {
-  return p.Identity != nil
+func (p *ScanWorkflowExecutionsResponse) GetNextPageToken() []byte {
+  return p.NextPageToken
+}
+func (p *ScanWorkflowExecutionsResponse) IsSetExecutions() bool {
+  return p.Executions != nil
 }
 
-func (p *RespondActivityTaskCanceledRequest) Read(iprot thrift.TProtocol) error {
+func (p *ScanWorkflowExecutionsResponse) IsSetNextPageToken() bool {
+  return p.NextPageToken != nil
+}
+
+func (p *ScanWorkflowExecutionsResponse) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -19370,10 +25922,6 @@ func (p *RespondActivityTaskCanceledRequest) Read(iprot thrift.TProtocol) error
       if err := p.ReadField20(iprot); err != nil {
         return err
       }
-    case 30:
-      if err := p.ReadField30(iprot); err != nil {
-        return err
-      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -19389,40 +25937,41 @@ func (p *RespondActivityTaskCanceledRequest) Read(iprot thrift.TProtocol) error
   return nil
 }
 
-func (p *RespondActivityTaskCanceledRequest)  ReadField10(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBinary(); err != nil {
-  return thrift.PrependError("error reading field 10: ", err)
-} else {
-  p.TaskToken = v
-}
+func (p *ScanWorkflowExecutionsResponse)  ReadField10(iprot thrift.TProtocol) error {
+  _, size, err := iprot.ReadListBegin()
+  if err != nil {
+    return thrift.PrependError("error reading list begin: ", err)
+  }
+  tSlice := make([]*WorkflowExecutionInfo, 0, size)
+  p.Executions =  tSlice
+  for i := 0; i < size; i ++ {
+    _elem6 := &WorkflowExecutionInfo{}
+    if err := _elem6.Read(iprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem6), err)
+    }
+    p.Executions = append(p.Executions, _elem6)
+  }
+  if err := iprot.ReadListEnd(); err != nil {
+    return thrift.PrependError("error reading list end: ", err)
+  }
   return nil
 }
 
-func (p *RespondActivityTaskCanceledRequest)  ReadField20(iprot thrift.TProtocol) error {
+func (p *ScanWorkflowExecutionsResponse)  ReadField20(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadBinary(); err != nil {
   return thrift.PrependError("error reading field 20: ", err)
 } else {
-  p.Details = v
-}
-  return nil
-}
-
-func (p *RespondActivityTaskCanceledRequest)  ReadField30(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 30: ", err)
-} else {
-  p.Identity = &v
+  p.NextPageToken = v
 }
   return nil
 }
 
-func (p *RespondActivityTaskCanceledRequest) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondActivityTaskCanceledRequest"); err != nil {
+func (p *ScanWorkflowExecutionsResponse) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ScanWorkflowExecutionsResponse"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
     if err := p.writeField20(oprot); err != nil { return err }
-    if err := p.writeField30(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -19431,100 +25980,111 @@ func (p *RespondActivityTaskCanceledRequest) Write(oprot thrift.TProtocol) error
   return nil
 }
 
-func (p *RespondActivityTaskCanceledRequest) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetTaskToken() {
-    if err := oprot.WriteFieldBegin("taskToken", thrift.STRING, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:taskToken: ", p), err) }
-    if err := oprot.WriteBinary(p.TaskToken); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.taskToken (10) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:taskToken: ", p), err) }
-  }
-  return err
-}
-
-func (p *RespondActivityTaskCanceledRequest) writeField20(oprot thrift.TProtocol) (err error) {
-  if p.IsSetDetails() {
-    if err := oprot.WriteFieldBegin("details", thrift.STRING, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:details: ", p), err) }
-    if err := oprot.WriteBinary(p.Details); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.details (20) field write error: ", p), err) }
+func (p *ScanWorkflowExecutionsResponse) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetExecutions() {
+    if err := oprot.WriteFieldBegin("executions", thrift.LIST, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:executions: ", p), err) }
+    if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Executions)); err != nil {
+      return thrift.PrependError("error writing list begin: ", err)
+    }
+    for _, v := range p.Executions {
+      if err := v.Write(oprot); err != nil {
+        return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+      }
+    }
+    if err := oprot.WriteListEnd(); err != nil {
+      return thrift.PrependError("error writing list end: ", err)
+    }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:details: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:executions: ", p), err) }
   }
   return err
 }
 
-func (p *RespondActivityTaskCanceledRequest) writeField30(oprot thrift.TProtocol) (err error) {
-  if p.IsSetIdentity() {
-    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 30); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:identity: ", p), err) }
-    if err := oprot.WriteString(string(*p.Identity)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.identity (30) field write error: ", p), err) }
+func (p *ScanWorkflowExecutionsResponse) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetNextPageToken() {
+    if err := oprot.WriteFieldBegin("nextPageToken", thrift.STRING, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:nextPageToken: ", p), err) }
+    if err := oprot.WriteBinary(p.NextPageToken); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.nextPageToken (20) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:identity: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:nextPageToken: ", p), err) }
   }
   return err
 }
 
-func (p *RespondActivityTaskCanceledRequest) String() string {
+func (p *ScanWorkflowExecutionsResponse) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("RespondActivityTaskCanceledRequest(%+v)", *p)
+  return fmt.Sprintf("ScanWorkflowExecutionsResponse(%+v)", *p)
 }
 
 // Attributes:
 //  - Domain
-//  - WorkflowExecution
-//  - Identity
-type RequestCancelWorkflowExecutionRequest struct {
+//  - StartTimeFilter
+//  - ExecutionFilter
+//  - TypeFilter
+type CountOpenWorkflowExecutionsRequest struct {
   // unused fields # 1 to 9
   Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
   // unused fields # 11 to 19
-  WorkflowExecution *WorkflowExecution `thrift:"workflowExecution,20" db:"workflowExecution" json:"workflowExecution,omitempty"`
+  StartTimeFilter *StartTimeFilter `thrift:"StartTimeFilter,20" db:"StartTimeFilter" json:"StartTimeFilter,omitempty"`
   // unused fields # 21 to 29
-  Identity *string `thrift:"identity,30" db:"identity" json:"identity,omitempty"`
+  ExecutionFilter *WorkflowExecutionFilter `thrift:"executionFilter,30" db:"executionFilter" json:"executionFilter,omitempty"`
+  // unused fields # 31 to 39
+  TypeFilter *WorkflowTypeFilter `thrift:"typeFilter,40" db:"typeFilter" json:"typeFilter,omitempty"`
 }
 
-func NewRequestCancelWorkflowExecutionRequest() *RequestCancelWorkflowExecutionRequest {
-  return &RequestCancelWorkflowExecutionRequest{}
+func NewCountOpenWorkflowExecutionsRequest() *CountOpenWorkflowExecutionsRequest {
+  return &CountOpenWorkflowExecutionsRequest{}
 }
 
-var RequestCancelWorkflowExecutionRequest_Domain_DEFAULT string
-func (p *RequestCancelWorkflowExecutionRequest) GetDomain() string {
+var CountOpenWorkflowExecutionsRequest_Domain_DEFAULT string
+func (p *CountOpenWorkflowExecutionsRequest) GetDomain() string {
   if !p.IsSetDomain() {
-    return RequestCancelWorkflowExecutionRequest_Domain_DEFAULT
+    return CountOpenWorkflowExecutionsRequest_Domain_DEFAULT
   }
 return *p.Domain
 }
-var RequestCancelWorkflowExecutionRequest_WorkflowExecution_DEFAULT *WorkflowExecution
-func (p *RequestCancelWorkflowExecutionRequest) GetWorkflowExecution() *WorkflowExecution {
-  if !p.IsSetWorkflowExecution() {
-    return RequestCancelWorkflowExecutionRequest_WorkflowExecution_DEFAULT
+var CountOpenWorkflowExecutionsRequest_StartTimeFilter_DEFAULT *StartTimeFilter
+func (p *CountOpenWorkflowExecutionsRequest) GetStartTimeFilter() *StartTimeFilter {
+  if !p.IsSetStartTimeFilter() {
+    return CountOpenWorkflowExecutionsRequest_StartTimeFilter_DEFAULT
   }
-return p.WorkflowExecution
+return p.StartTimeFilter
 }
-var RequestCancelWorkflowExecutionRequest_Identity_DEFAULT string
-func (p *RequestCancelWorkflowExecutionRequest) GetIdentity() string {
-  if !p.IsSetIdentity() {
-    return RequestCancelWorkflowExecutionRequest_Identity_DEFAULT
+var CountOpenWorkflowExecutionsRequest_ExecutionFilter_DEFAULT *WorkflowExecutionFilter
+func (p *CountOpenWorkflowExecutionsRequest) GetExecutionFilter() *WorkflowExecutionFilter {
+  if !p.IsSetExecutionFilter() {
+    return CountOpenWorkflowExecutionsRequest_ExecutionFilter_DEFAULT
   }
-return *p.Identity
+return p.ExecutionFilter
 }
-func (p *RequestCancelWorkflowExecutionRequest) IsSetDomain() bool {
+var CountOpenWorkflowExecutionsRequest_TypeFilter_DEFAULT *WorkflowTypeFilter
+func (p *CountOpenWorkflowExecutionsRequest) GetTypeFilter() *WorkflowTypeFilter {
+  if !p.IsSetTypeFilter() {
+    return CountOpenWorkflowExecutionsRequest_TypeFilter_DEFAULT
+  }
+return p.TypeFilter
+}
+func (p *CountOpenWorkflowExecutionsRequest) IsSetDomain() bool {
   return p.Domain != nil
 }
 
-func (p *RequestCancelWorkflowExecutionRequest) IsSetWorkflowExecution() bool {
-  return p.WorkflowExecution != nil
+func (p *CountOpenWorkflowExecutionsRequest) IsSetStartTimeFilter() bool {
+  return p.StartTimeFilter != nil
 }
 
-func (p *RequestCancelWorkflowExecutionRequest) IsSetIdentity() bool {
-  return p.Identity != nil
+func (p *CountOpenWorkflowExecutionsRequest) IsSetExecutionFilter() bool {
+  return p.ExecutionFilter != nil
 }
 
-func (p *RequestCancelWorkflowExecutionRequest) Read(iprot thrift.TProtocol) error {
+func (p *CountOpenWorkflowExecutionsRequest) IsSetTypeFilter() bool {
+  return p.TypeFilter != nil
+}
+
+func (p *CountOpenWorkflowExecutionsRequest) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -19549,6 +26109,10 @@ func (p *RequestCancelWorkflowExecutionRequest) Read(iprot thrift.TProtocol) err
       if err := p.ReadField30(iprot); err != nil {
         return err
       }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -19564,7 +26128,7 @@ func (p *RequestCancelWorkflowExecutionRequest) Read(iprot thrift.TProtocol) err
   return nil
 }
 
-func (p *RequestCancelWorkflowExecutionRequest)  ReadField10(iprot thrift.TProtocol) error {
+func (p *CountOpenWorkflowExecutionsRequest)  ReadField10(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 10: ", err)
 } else {
@@ -19573,30 +26137,38 @@ func (p *RequestCancelWorkflowExecutionRequest)  ReadField10(iprot thrift.TProto
   return nil
 }
 
-func (p *RequestCancelWorkflowExecutionRequest)  ReadField20(iprot thrift.TProtocol) error {
-  p.WorkflowExecution = &WorkflowExecution{}
-  if err := p.WorkflowExecution.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecution), err)
+func (p *CountOpenWorkflowExecutionsRequest)  ReadField20(iprot thrift.TProtocol) error {
+  p.StartTimeFilter = &StartTimeFilter{}
+  if err := p.StartTimeFilter.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.StartTimeFilter), err)
   }
   return nil
 }
 
-func (p *RequestCancelWorkflowExecutionRequest)  ReadField30(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 30: ", err)
-} else {
-  p.Identity = &v
+func (p *CountOpenWorkflowExecutionsRequest)  ReadField30(iprot thrift.TProtocol) error {
+  p.ExecutionFilter = &WorkflowExecutionFilter{}
+  if err := p.ExecutionFilter.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ExecutionFilter), err)
+  }
+  return nil
 }
+
+func (p *CountOpenWorkflowExecutionsRequest)  ReadField40(iprot thrift.TProtocol) error {
+  p.TypeFilter = &WorkflowTypeFilter{}
+  if err := p.TypeFilter.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TypeFilter), err)
+  }
   return nil
 }
 
-func (p *RequestCancelWorkflowExecutionRequest) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RequestCancelWorkflowExecutionRequest"); err != nil {
+func (p *CountOpenWorkflowExecutionsRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("CountOpenWorkflowExecutionsRequest"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
     if err := p.writeField20(oprot); err != nil { return err }
     if err := p.writeField30(oprot); err != nil { return err }
+    if err := p.writeField40(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -19605,7 +26177,7 @@ func (p *RequestCancelWorkflowExecutionRequest) Write(oprot thrift.TProtocol) er
   return nil
 }
 
-func (p *RequestCancelWorkflowExecutionRequest) writeField10(oprot thrift.TProtocol) (err error) {
+func (p *CountOpenWorkflowExecutionsRequest) writeField10(oprot thrift.TProtocol) (err error) {
   if p.IsSetDomain() {
     if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
@@ -19617,101 +26189,226 @@ func (p *RequestCancelWorkflowExecutionRequest) writeField10(oprot thrift.TProto
   return err
 }
 
-func (p *RequestCancelWorkflowExecutionRequest) writeField20(oprot thrift.TProtocol) (err error) {
-  if p.IsSetWorkflowExecution() {
-    if err := oprot.WriteFieldBegin("workflowExecution", thrift.STRUCT, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:workflowExecution: ", p), err) }
-    if err := p.WorkflowExecution.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowExecution), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:workflowExecution: ", p), err) }
+func (p *CountOpenWorkflowExecutionsRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetStartTimeFilter() {
+    if err := oprot.WriteFieldBegin("StartTimeFilter", thrift.STRUCT, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:StartTimeFilter: ", p), err) }
+    if err := p.StartTimeFilter.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.StartTimeFilter), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:StartTimeFilter: ", p), err) }
+  }
+  return err
+}
+
+func (p *CountOpenWorkflowExecutionsRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetExecutionFilter() {
+    if err := oprot.WriteFieldBegin("executionFilter", thrift.STRUCT, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:executionFilter: ", p), err) }
+    if err := p.ExecutionFilter.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ExecutionFilter), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:executionFilter: ", p), err) }
+  }
+  return err
+}
+
+func (p *CountOpenWorkflowExecutionsRequest) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTypeFilter() {
+    if err := oprot.WriteFieldBegin("typeFilter", thrift.STRUCT, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:typeFilter: ", p), err) }
+    if err := p.TypeFilter.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TypeFilter), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:typeFilter: ", p), err) }
+  }
+  return err
+}
+
+func (p *CountOpenWorkflowExecutionsRequest) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("CountOpenWorkflowExecutionsRequest(%+v)", *p)
+}
+
+// Attributes:
+//  - Count
+type CountOpenWorkflowExecutionsResponse struct {
+  // unused fields # 1 to 9
+  Count *int64 `thrift:"count,10" db:"count" json:"count,omitempty"`
+}
+
+func NewCountOpenWorkflowExecutionsResponse() *CountOpenWorkflowExecutionsResponse {
+  return &CountOpenWorkflowExecutionsResponse{}
+}
+
+var CountOpenWorkflowExecutionsResponse_Count_DEFAULT int64
+func (p *CountOpenWorkflowExecutionsResponse) GetCount() int64 {
+  if !p.IsSetCount() {
+    return CountOpenWorkflowExecutionsResponse_Count_DEFAULT
+  }
+return *p.Count
+}
+func (p *CountOpenWorkflowExecutionsResponse) IsSetCount() bool {
+  return p.Count != nil
+}
+
+func (p *CountOpenWorkflowExecutionsResponse) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *CountOpenWorkflowExecutionsResponse)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.Count = &v
+}
+  return nil
+}
+
+func (p *CountOpenWorkflowExecutionsResponse) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("CountOpenWorkflowExecutionsResponse"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
   }
-  return err
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
 }
 
-func (p *RequestCancelWorkflowExecutionRequest) writeField30(oprot thrift.TProtocol) (err error) {
-  if p.IsSetIdentity() {
-    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 30); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:identity: ", p), err) }
-    if err := oprot.WriteString(string(*p.Identity)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.identity (30) field write error: ", p), err) }
+func (p *CountOpenWorkflowExecutionsResponse) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetCount() {
+    if err := oprot.WriteFieldBegin("count", thrift.I64, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:count: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.Count)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.count (10) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:identity: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:count: ", p), err) }
   }
   return err
 }
 
-func (p *RequestCancelWorkflowExecutionRequest) String() string {
+func (p *CountOpenWorkflowExecutionsResponse) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("RequestCancelWorkflowExecutionRequest(%+v)", *p)
+  return fmt.Sprintf("CountOpenWorkflowExecutionsResponse(%+v)", *p)
 }
 
 // Attributes:
 //  - Domain
-//  - Execution
-//  - MaximumPageSize
-//  - NextPageToken
-type GetWorkflowExecutionHistoryRequest struct {
+//  - StartTimeFilter
+//  - ExecutionFilter
+//  - TypeFilter
+//  - StatusFilter
+type CountClosedWorkflowExecutionsRequest struct {
   // unused fields # 1 to 9
   Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
   // unused fields # 11 to 19
-  Execution *WorkflowExecution `thrift:"execution,20" db:"execution" json:"execution,omitempty"`
+  StartTimeFilter *StartTimeFilter `thrift:"StartTimeFilter,20" db:"StartTimeFilter" json:"StartTimeFilter,omitempty"`
   // unused fields # 21 to 29
-  MaximumPageSize *int32 `thrift:"maximumPageSize,30" db:"maximumPageSize" json:"maximumPageSize,omitempty"`
+  ExecutionFilter *WorkflowExecutionFilter `thrift:"executionFilter,30" db:"executionFilter" json:"executionFilter,omitempty"`
   // unused fields # 31 to 39
-  NextPageToken []byte `thrift:"nextPageToken,40" db:"nextPageToken" json:"nextPageToken,omitempty"`
+  TypeFilter *WorkflowTypeFilter `thrift:"typeFilter,40" db:"typeFilter" json:"typeFilter,omitempty"`
+  // unused fields # 41 to 49
+  StatusFilter *WorkflowExecutionCloseStatus `thrift:"statusFilter,50" db:"statusFilter" json:"statusFilter,omitempty"`
 }
 
-func NewGetWorkflowExecutionHistoryRequest() *GetWorkflowExecutionHistoryRequest {
-  return &GetWorkflowExecutionHistoryRequest{}
+func NewCountClosedWorkflowExecutionsRequest() *CountClosedWorkflowExecutionsRequest {
+  return &CountClosedWorkflowExecutionsRequest{}
 }
 
-var GetWorkflowExecutionHistoryRequest_Domain_DEFAULT string
-func (p *GetWorkflowExecutionHistoryRequest) GetDomain() string {
+var CountClosedWorkflowExecutionsRequest_Domain_DEFAULT string
+func (p *CountClosedWorkflowExecutionsRequest) GetDomain() string {
   if !p.IsSetDomain() {
-    return GetWorkflowExecutionHistoryRequest_Domain_DEFAULT
+    return CountClosedWorkflowExecutionsRequest_Domain_DEFAULT
   }
 return *p.Domain
 }
-var GetWorkflowExecutionHistoryRequest_Execution_DEFAULT *WorkflowExecution
-func (p *GetWorkflowExecutionHistoryRequest) GetExecution() *WorkflowExecution {
-  if !p.IsSetExecution() {
-    return GetWorkflowExecutionHistoryRequest_Execution_DEFAULT
+var CountClosedWorkflowExecutionsRequest_StartTimeFilter_DEFAULT *StartTimeFilter
+func (p *CountClosedWorkflowExecutionsRequest) GetStartTimeFilter() *StartTimeFilter {
+  if !p.IsSetStartTimeFilter() {
+    return CountClosedWorkflowExecutionsRequest_StartTimeFilter_DEFAULT
   }
-return p.Execution
+return p.StartTimeFilter
 }
-var GetWorkflowExecutionHistoryRequest_MaximumPageSize_DEFAULT int32
-func (p *GetWorkflowExecutionHistoryRequest) GetMaximumPageSize() int32 {
-  if !p.IsSetMaximumPageSize() {
-    return GetWorkflowExecutionHistoryRequest_MaximumPageSize_DEFAULT
+var CountClosedWorkflowExecutionsRequest_ExecutionFilter_DEFAULT *WorkflowExecutionFilter
+func (p *CountClosedWorkflowExecutionsRequest) GetExecutionFilter() *WorkflowExecutionFilter {
+  if !p.IsSetExecutionFilter() {
+    return CountClosedWorkflowExecutionsRequest_ExecutionFilter_DEFAULT
   }
-return *p.MaximumPageSize
+return p.ExecutionFilter
 }
-var GetWorkflowExecutionHistoryRequest_NextPageToken_DEFAULT []byte
-
-func (p *GetWorkflowExecutionHistoryRequest) GetNextPageToken() []byte {
-  return p.NextPageToken
+var CountClosedWorkflowExecutionsRequest_TypeFilter_DEFAULT *WorkflowTypeFilter
+func (p *CountClosedWorkflowExecutionsRequest) GetTypeFilter() *WorkflowTypeFilter {
+  if !p.IsSetTypeFilter() {
+    return CountClosedWorkflowExecutionsRequest_TypeFilter_DEFAULT
+  }
+return p.TypeFilter
 }
-func (p *GetWorkflowExecutionHistoryRequest) IsSetDomain() bool {
+var CountClosedWorkflowExecutionsRequest_StatusFilter_DEFAULT WorkflowExecutionCloseStatus
+func (p *CountClosedWorkflowExecutionsRequest) GetStatusFilter() WorkflowExecutionCloseStatus {
+  if !p.IsSetStatusFilter() {
+    return CountClosedWorkflowExecutionsRequest_StatusFilter_DEFAULT
+  }
+return *p.StatusFilter
+}
+func (p *CountClosedWorkflowExecutionsRequest) IsSetDomain() bool {
   return p.Domain != nil
 }
 
-func (p *GetWorkflowExecutionHistoryRequest) IsSetExecution() bool {
-  return p.Execution != nil
+func (p *CountClosedWorkflowExecutionsRequest) IsSetStartTimeFilter() bool {
+  return p.StartTimeFilter != nil
 }
 
-func (p *GetWorkflowExecutionHistoryRequest) IsSetMaximumPageSize() bool {
-  return p.MaximumPageSize != nil
+func (p *CountClosedWorkflowExecutionsRequest) IsSetExecutionFilter() bool {
+  return p.ExecutionFilter != nil
 }
 
-func (p *GetWorkflowExecutionHistoryRequest) IsSetNextPageToken() bool {
-  return p.NextPageToken != nil
+func (p *CountClosedWorkflowExecutionsRequest) IsSetTypeFilter() bool {
+  return p.TypeFilter != nil
 }
 
-func (p *GetWorkflowExecutionHistoryRequest) Read(iprot thrift.TProtocol) error {
+func (p *CountClosedWorkflowExecutionsRequest) IsSetStatusFilter() bool {
+  return p.StatusFilter != nil
+}
+
+func (p *CountClosedWorkflowExecutionsRequest) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -19740,6 +26437,10 @@ func (p *GetWorkflowExecutionHistoryRequest) Read(iprot thrift.TProtocol) error
       if err := p.ReadField40(iprot); err != nil {
         return err
       }
+    case 50:
+      if err := p.ReadField50(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -19755,7 +26456,7 @@ func (p *GetWorkflowExecutionHistoryRequest) Read(iprot thrift.TProtocol) error
   return nil
 }
 
-func (p *GetWorkflowExecutionHistoryRequest)  ReadField10(iprot thrift.TProtocol) error {
+func (p *CountClosedWorkflowExecutionsRequest)  ReadField10(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 10: ", err)
 } else {
@@ -19764,40 +26465,49 @@ func (p *GetWorkflowExecutionHistoryRequest)  ReadField10(iprot thrift.TProtocol
   return nil
 }
 
-func (p *GetWorkflowExecutionHistoryRequest)  ReadField20(iprot thrift.TProtocol) error {
-  p.Execution = &WorkflowExecution{}
-  if err := p.Execution.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Execution), err)
+func (p *CountClosedWorkflowExecutionsRequest)  ReadField20(iprot thrift.TProtocol) error {
+  p.StartTimeFilter = &StartTimeFilter{}
+  if err := p.StartTimeFilter.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.StartTimeFilter), err)
   }
   return nil
 }
 
-func (p *GetWorkflowExecutionHistoryRequest)  ReadField30(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI32(); err != nil {
-  return thrift.PrependError("error reading field 30: ", err)
-} else {
-  p.MaximumPageSize = &v
+func (p *CountClosedWorkflowExecutionsRequest)  ReadField30(iprot thrift.TProtocol) error {
+  p.ExecutionFilter = &WorkflowExecutionFilter{}
+  if err := p.ExecutionFilter.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ExecutionFilter), err)
+  }
+  return nil
 }
+
+func (p *CountClosedWorkflowExecutionsRequest)  ReadField40(iprot thrift.TProtocol) error {
+  p.TypeFilter = &WorkflowTypeFilter{}
+  if err := p.TypeFilter.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TypeFilter), err)
+  }
   return nil
 }
 
-func (p *GetWorkflowExecutionHistoryRequest)  ReadField40(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBinary(); err != nil {
-  return thrift.PrependError("error reading field 40: ", err)
+func (p *CountClosedWorkflowExecutionsRequest)  ReadField50(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 50: ", err)
 } else {
-  p.NextPageToken = v
+  temp := WorkflowExecutionCloseStatus(v)
+  p.StatusFilter = &temp
 }
   return nil
 }
 
-func (p *GetWorkflowExecutionHistoryRequest) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("GetWorkflowExecutionHistoryRequest"); err != nil {
+func (p *CountClosedWorkflowExecutionsRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("CountClosedWorkflowExecutionsRequest"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
     if err := p.writeField20(oprot); err != nil { return err }
     if err := p.writeField30(oprot); err != nil { return err }
     if err := p.writeField40(oprot); err != nil { return err }
+    if err := p.writeField50(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -19806,7 +26516,7 @@ func (p *GetWorkflowExecutionHistoryRequest) Write(oprot thrift.TProtocol) error
   return nil
 }
 
-func (p *GetWorkflowExecutionHistoryRequest) writeField10(oprot thrift.TProtocol) (err error) {
+func (p *CountClosedWorkflowExecutionsRequest) writeField10(oprot thrift.TProtocol) (err error) {
   if p.IsSetDomain() {
     if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
@@ -19818,85 +26528,87 @@ func (p *GetWorkflowExecutionHistoryRequest) writeField10(oprot thrift.TProtocol
   return err
 }
 
-func (p *GetWorkflowExecutionHistoryRequest) writeField20(oprot thrift.TProtocol) (err error) {
-  if p.IsSetExecution() {
-    if err := oprot.WriteFieldBegin("execution", thrift.STRUCT, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:execution: ", p), err) }
-    if err := p.Execution.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Execution), err)
+func (p *CountClosedWorkflowExecutionsRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetStartTimeFilter() {
+    if err := oprot.WriteFieldBegin("StartTimeFilter", thrift.STRUCT, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:StartTimeFilter: ", p), err) }
+    if err := p.StartTimeFilter.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.StartTimeFilter), err)
     }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:execution: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:StartTimeFilter: ", p), err) }
   }
   return err
 }
 
-func (p *GetWorkflowExecutionHistoryRequest) writeField30(oprot thrift.TProtocol) (err error) {
-  if p.IsSetMaximumPageSize() {
-    if err := oprot.WriteFieldBegin("maximumPageSize", thrift.I32, 30); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:maximumPageSize: ", p), err) }
-    if err := oprot.WriteI32(int32(*p.MaximumPageSize)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.maximumPageSize (30) field write error: ", p), err) }
+func (p *CountClosedWorkflowExecutionsRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetExecutionFilter() {
+    if err := oprot.WriteFieldBegin("executionFilter", thrift.STRUCT, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:executionFilter: ", p), err) }
+    if err := p.ExecutionFilter.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ExecutionFilter), err)
+    }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:maximumPageSize: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:executionFilter: ", p), err) }
   }
   return err
 }
 
-func (p *GetWorkflowExecutionHistoryRequest) writeField40(oprot thrift.TProtocol) (err error) {
-  if p.IsSetNextPageToken() {
-    if err := oprot.WriteFieldBegin("nextPageToken", thrift.STRING, 40); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:nextPageToken: ", p), err) }
-    if err := oprot.WriteBinary(p.NextPageToken); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.nextPageToken (40) field write error: ", p), err) }
+func (p *CountClosedWorkflowExecutionsRequest) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTypeFilter() {
+    if err := oprot.WriteFieldBegin("typeFilter", thrift.STRUCT, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:typeFilter: ", p), err) }
+    if err := p.TypeFilter.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TypeFilter), err)
+    }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:nextPageToken: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:typeFilter: ", p), err) }
   }
   return err
 }
 
-func (p *GetWorkflowExecutionHistoryRequest) String() string {
+func (p *CountClosedWorkflowExecutionsRequest) writeField50(oprot thrift.TProtocol) (err error) {
+  if p.IsSetStatusFilter() {
+    if err := oprot.WriteFieldBegin("statusFilter", thrift.I32, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:statusFilter: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.StatusFilter)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.statusFilter (50) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:statusFilter: ", p), err) }
+  }
+  return err
+}
+
+func (p *CountClosedWorkflowExecutionsRequest) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("GetWorkflowExecutionHistoryRequest(%+v)", *p)
+  return fmt.Sprintf("CountClosedWorkflowExecutionsRequest(%+v)", *p)
 }
 
 // Attributes:
-//  - History
-//  - NextPageToken
-type GetWorkflowExecutionHistoryResponse struct {
+//  - Count
+type CountClosedWorkflowExecutionsResponse struct {
   // unused fields # 1 to 9
-  History *History `thrift:"history,10" db:"history" json:"history,omitempty"`
-  // unused fields # 11 to 19
-  NextPageToken []byte `thrift:"nextPageToken,20" db:"nextPageToken" json:"nextPageToken,omitempty"`
+  Count *int64 `thrift:"count,10" db:"count" json:"count,omitempty"`
 }
 
-func NewGetWorkflowExecutionHistoryResponse() *GetWorkflowExecutionHistoryResponse {
-  return &GetWorkflowExecutionHistoryResponse{}
+func NewCountClosedWorkflowExecutionsResponse() *CountClosedWorkflowExecutionsResponse {
+  return &CountClosedWorkflowExecutionsResponse{}
 }
 
-var GetWorkflowExecutionHistoryResponse_History_DEFAULT *History
-func (p *GetWorkflowExecutionHistoryResponse) GetHistory() *History {
-  if !p.IsSetHistory() {
-    return GetWorkflowExecutionHistoryResponse_History_DEFAULT
+var CountClosedWorkflowExecutionsResponse_Count_DEFAULT int64
+func (p *CountClosedWorkflowExecutionsResponse) GetCount() int64 {
+  if !p.IsSetCount() {
+    return CountClosedWorkflowExecutionsResponse_Count_DEFAULT
   }
-return p.History
+return *p.Count
 }
-var GetWorkflowExecutionHistoryResponse_NextPageToken_DEFAULT []byte
-
-func (p *GetWorkflowExecutionHistoryResponse) GetNextPageToken() []byte {
-  return p.NextPageToken
-}
-func (p *GetWorkflowExecutionHistoryResponse) IsSetHistory() bool {
-  return p.History != nil
-}
-
-func (p *GetWorkflowExecutionHistoryResponse) IsSetNextPageToken() bool {
-  return p.NextPageToken != nil
+func (p *CountClosedWorkflowExecutionsResponse) IsSetCount() bool {
+  return p.Count != nil
 }
 
-func (p *GetWorkflowExecutionHistoryResponse) Read(iprot thrift.TProtocol) error {
+func (p *CountClosedWorkflowExecutionsResponse) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -19913,10 +26625,6 @@ func (p *GetWorkflowExecutionHistoryResponse) Read(iprot thrift.TProtocol) error
       if err := p.ReadField10(iprot); err != nil {
         return err
       }
-    case 20:
-      if err := p.ReadField20(iprot); err != nil {
-        return err
-      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -19932,29 +26640,20 @@ func (p *GetWorkflowExecutionHistoryResponse) Read(iprot thrift.TProtocol) error
   return nil
 }
 
-func (p *GetWorkflowExecutionHistoryResponse)  ReadField10(iprot thrift.TProtocol) error {
-  p.History = &History{}
-  if err := p.History.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.History), err)
-  }
-  return nil
-}
-
-func (p *GetWorkflowExecutionHistoryResponse)  ReadField20(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBinary(); err != nil {
-  return thrift.PrependError("error reading field 20: ", err)
+func (p *CountClosedWorkflowExecutionsResponse)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
 } else {
-  p.NextPageToken = v
+  p.Count = &v
 }
   return nil
 }
 
-func (p *GetWorkflowExecutionHistoryResponse) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("GetWorkflowExecutionHistoryResponse"); err != nil {
+func (p *CountClosedWorkflowExecutionsResponse) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("CountClosedWorkflowExecutionsResponse"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
-    if err := p.writeField20(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -19963,115 +26662,90 @@ func (p *GetWorkflowExecutionHistoryResponse) Write(oprot thrift.TProtocol) erro
   return nil
 }
 
-func (p *GetWorkflowExecutionHistoryResponse) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetHistory() {
-    if err := oprot.WriteFieldBegin("history", thrift.STRUCT, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:history: ", p), err) }
-    if err := p.History.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.History), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:history: ", p), err) }
-  }
-  return err
-}
-
-func (p *GetWorkflowExecutionHistoryResponse) writeField20(oprot thrift.TProtocol) (err error) {
-  if p.IsSetNextPageToken() {
-    if err := oprot.WriteFieldBegin("nextPageToken", thrift.STRING, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:nextPageToken: ", p), err) }
-    if err := oprot.WriteBinary(p.NextPageToken); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.nextPageToken (20) field write error: ", p), err) }
+func (p *CountClosedWorkflowExecutionsResponse) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetCount() {
+    if err := oprot.WriteFieldBegin("count", thrift.I64, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:count: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.Count)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.count (10) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:nextPageToken: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:count: ", p), err) }
   }
   return err
 }
 
-func (p *GetWorkflowExecutionHistoryResponse) String() string {
+func (p *CountClosedWorkflowExecutionsResponse) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("GetWorkflowExecutionHistoryResponse(%+v)", *p)
+  return fmt.Sprintf("CountClosedWorkflowExecutionsResponse(%+v)", *p)
 }
 
 // Attributes:
-//  - Domain
-//  - WorkflowExecution
-//  - SignalName
-//  - Input
-//  - Identity
-type SignalWorkflowExecutionRequest struct {
+//  - SupportsStickyQuery
+//  - SupportsSignalWithStart
+//  - SupportsRawHistory
+//  - MaxBlobSizeBytes
+type ClusterCapabilities struct {
   // unused fields # 1 to 9
-  Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
+  SupportsStickyQuery *bool `thrift:"supportsStickyQuery,10" db:"supportsStickyQuery" json:"supportsStickyQuery,omitempty"`
   // unused fields # 11 to 19
-  WorkflowExecution *WorkflowExecution `thrift:"workflowExecution,20" db:"workflowExecution" json:"workflowExecution,omitempty"`
+  SupportsSignalWithStart *bool `thrift:"supportsSignalWithStart,20" db:"supportsSignalWithStart" json:"supportsSignalWithStart,omitempty"`
   // unused fields # 21 to 29
-  SignalName *string `thrift:"signalName,30" db:"signalName" json:"signalName,omitempty"`
-  // unused fields # 31 to 39
-  Input []byte `thrift:"input,40" db:"input" json:"input,omitempty"`
-  // unused fields # 41 to 49
-  Identity *string `thrift:"identity,50" db:"identity" json:"identity,omitempty"`
-}
-
-func NewSignalWorkflowExecutionRequest() *SignalWorkflowExecutionRequest {
-  return &SignalWorkflowExecutionRequest{}
-}
-
-var SignalWorkflowExecutionRequest_Domain_DEFAULT string
-func (p *SignalWorkflowExecutionRequest) GetDomain() string {
-  if !p.IsSetDomain() {
-    return SignalWorkflowExecutionRequest_Domain_DEFAULT
-  }
-return *p.Domain
+  SupportsRawHistory *bool `thrift:"supportsRawHistory,30" db:"supportsRawHistory" json:"supportsRawHistory,omitempty"`
+  // unused fields # 31 to 39
+  MaxBlobSizeBytes *int64 `thrift:"maxBlobSizeBytes,40" db:"maxBlobSizeBytes" json:"maxBlobSizeBytes,omitempty"`
 }
-var SignalWorkflowExecutionRequest_WorkflowExecution_DEFAULT *WorkflowExecution
-func (p *SignalWorkflowExecutionRequest) GetWorkflowExecution() *WorkflowExecution {
-  if !p.IsSetWorkflowExecution() {
-    return SignalWorkflowExecutionRequest_WorkflowExecution_DEFAULT
-  }
-return p.WorkflowExecution
+
+func NewClusterCapabilities() *ClusterCapabilities {
+  return &ClusterCapabilities{}
 }
-var SignalWorkflowExecutionRequest_SignalName_DEFAULT string
-func (p *SignalWorkflowExecutionRequest) GetSignalName() string {
-  if !p.IsSetSignalName() {
-    return SignalWorkflowExecutionRequest_SignalName_DEFAULT
+
+var ClusterCapabilities_SupportsStickyQuery_DEFAULT bool
+func (p *ClusterCapabilities) GetSupportsStickyQuery() bool {
+  if !p.IsSetSupportsStickyQuery() {
+    return ClusterCapabilities_SupportsStickyQuery_DEFAULT
   }
-return *p.SignalName
+return *p.SupportsStickyQuery
 }
-var SignalWorkflowExecutionRequest_Input_DEFAULT []byte
-
-func (p *SignalWorkflowExecutionRequest) GetInput() []byte {
-  return p.Input
+var ClusterCapabilities_SupportsSignalWithStart_DEFAULT bool
+func (p *ClusterCapabilities) GetSupportsSignalWithStart() bool {
+  if !p.IsSetSupportsSignalWithStart() {
+    return ClusterCapabilities_SupportsSignalWithStart_DEFAULT
+  }
+return *p.SupportsSignalWithStart
 }
-var SignalWorkflowExecutionRequest_Identity_DEFAULT string
-func (p *SignalWorkflowExecutionRequest) GetIdentity() string {
-  if !p.IsSetIdentity() {
-    return SignalWorkflowExecutionRequest_Identity_DEFAULT
+var ClusterCapabilities_SupportsRawHistory_DEFAULT bool
+func (p *ClusterCapabilities) GetSupportsRawHistory() bool {
+  if !p.IsSetSupportsRawHistory() {
+    return ClusterCapabilities_SupportsRawHistory_DEFAULT
   }
-return *p.Identity
+return *p.SupportsRawHistory
 }
-func (p *SignalWorkflowExecutionRequest) IsSetDomain() bool {
-  return p.Domain != nil
+var ClusterCapabilities_MaxBlobSizeBytes_DEFAULT int64
+func (p *ClusterCapabilities) GetMaxBlobSizeBytes() int64 {
+  if !p.IsSetMaxBlobSizeBytes() {
+    return ClusterCapabilities_MaxBlobSizeBytes_DEFAULT
+  }
+return *p.MaxBlobSizeBytes
 }
-
-func (p *SignalWorkflowExecutionRequest) IsSetWorkflowExecution() bool {
-  return p.WorkflowExecution != nil
+func (p *ClusterCapabilities) IsSetSupportsStickyQuery() bool {
+  return p.SupportsStickyQuery != nil
 }
 
-func (p *SignalWorkflowExecutionRequest) IsSetSignalName() bool {
-  return p.SignalName != nil
+func (p *ClusterCapabilities) IsSetSupportsSignalWithStart() bool {
+  return p.SupportsSignalWithStart != nil
 }
 
-func (p *SignalWorkflowExecutionRequest) IsSetInput() bool {
-  return p.Input != nil
+func (p *ClusterCapabilities) IsSetSupportsRawHistory() bool {
+  return p.SupportsRawHistory != nil
 }
 
-func (p *SignalWorkflowExecutionRequest) IsSetIdentity() bool {
-  return p.Identity != nil
+func (p *ClusterCapabilities) IsSetMaxBlobSizeBytes() bool {
+  return p.MaxBlobSizeBytes != nil
 }
 
-func (p *SignalWorkflowExecutionRequest) Read(iprot thrift.TProtocol) error {
+func (p *ClusterCapabilities) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -20100,10 +26774,6 @@ func (p *SignalWorkflowExecutionRequest) Read(iprot thrift.TProtocol) error {
       if err := p.ReadField40(iprot); err != nil {
         return err
       }
-    case 50:
-      if err := p.ReadField50(iprot); err != nil {
-        return err
-      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -20119,59 +26789,50 @@ func (p *SignalWorkflowExecutionRequest) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *SignalWorkflowExecutionRequest)  ReadField10(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
+func (p *ClusterCapabilities)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(); err != nil {
   return thrift.PrependError("error reading field 10: ", err)
 } else {
-  p.Domain = &v
-}
-  return nil
+  p.SupportsStickyQuery = &v
 }
-
-func (p *SignalWorkflowExecutionRequest)  ReadField20(iprot thrift.TProtocol) error {
-  p.WorkflowExecution = &WorkflowExecution{}
-  if err := p.WorkflowExecution.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecution), err)
-  }
   return nil
 }
 
-func (p *SignalWorkflowExecutionRequest)  ReadField30(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 30: ", err)
+func (p *ClusterCapabilities)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(); err != nil {
+  return thrift.PrependError("error reading field 20: ", err)
 } else {
-  p.SignalName = &v
+  p.SupportsSignalWithStart = &v
 }
   return nil
 }
 
-func (p *SignalWorkflowExecutionRequest)  ReadField40(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBinary(); err != nil {
-  return thrift.PrependError("error reading field 40: ", err)
+func (p *ClusterCapabilities)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
 } else {
-  p.Input = v
+  p.SupportsRawHistory = &v
 }
   return nil
 }
 
-func (p *SignalWorkflowExecutionRequest)  ReadField50(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 50: ", err)
+func (p *ClusterCapabilities)  ReadField40(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 40: ", err)
 } else {
-  p.Identity = &v
+  p.MaxBlobSizeBytes = &v
 }
   return nil
 }
 
-func (p *SignalWorkflowExecutionRequest) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("SignalWorkflowExecutionRequest"); err != nil {
+func (p *ClusterCapabilities) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ClusterCapabilities"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
     if err := p.writeField20(oprot); err != nil { return err }
     if err := p.writeField30(oprot); err != nil { return err }
     if err := p.writeField40(oprot); err != nil { return err }
-    if err := p.writeField50(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -20180,151 +26841,273 @@ func (p *SignalWorkflowExecutionRequest) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *SignalWorkflowExecutionRequest) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetDomain() {
-    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
-    if err := oprot.WriteString(string(*p.Domain)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.domain (10) field write error: ", p), err) }
+func (p *ClusterCapabilities) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSupportsStickyQuery() {
+    if err := oprot.WriteFieldBegin("supportsStickyQuery", thrift.BOOL, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:supportsStickyQuery: ", p), err) }
+    if err := oprot.WriteBool(bool(*p.SupportsStickyQuery)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.supportsStickyQuery (10) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domain: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:supportsStickyQuery: ", p), err) }
   }
   return err
 }
 
-func (p *SignalWorkflowExecutionRequest) writeField20(oprot thrift.TProtocol) (err error) {
-  if p.IsSetWorkflowExecution() {
-    if err := oprot.WriteFieldBegin("workflowExecution", thrift.STRUCT, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:workflowExecution: ", p), err) }
-    if err := p.WorkflowExecution.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowExecution), err)
-    }
+func (p *ClusterCapabilities) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSupportsSignalWithStart() {
+    if err := oprot.WriteFieldBegin("supportsSignalWithStart", thrift.BOOL, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:supportsSignalWithStart: ", p), err) }
+    if err := oprot.WriteBool(bool(*p.SupportsSignalWithStart)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.supportsSignalWithStart (20) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:workflowExecution: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:supportsSignalWithStart: ", p), err) }
   }
   return err
 }
 
-func (p *SignalWorkflowExecutionRequest) writeField30(oprot thrift.TProtocol) (err error) {
-  if p.IsSetSignalName() {
-    if err := oprot.WriteFieldBegin("signalName", thrift.STRING, 30); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:signalName: ", p), err) }
-    if err := oprot.WriteString(string(*p.SignalName)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.signalName (30) field write error: ", p), err) }
+func (p *ClusterCapabilities) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSupportsRawHistory() {
+    if err := oprot.WriteFieldBegin("supportsRawHistory", thrift.BOOL, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:supportsRawHistory: ", p), err) }
+    if err := oprot.WriteBool(bool(*p.SupportsRawHistory)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.supportsRawHistory (30) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:signalName: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:supportsRawHistory: ", p), err) }
   }
   return err
 }
 
-func (p *SignalWorkflowExecutionRequest) writeField40(oprot thrift.TProtocol) (err error) {
-  if p.IsSetInput() {
-    if err := oprot.WriteFieldBegin("input", thrift.STRING, 40); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:input: ", p), err) }
-    if err := oprot.WriteBinary(p.Input); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.input (40) field write error: ", p), err) }
+func (p *ClusterCapabilities) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetMaxBlobSizeBytes() {
+    if err := oprot.WriteFieldBegin("maxBlobSizeBytes", thrift.I64, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:maxBlobSizeBytes: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.MaxBlobSizeBytes)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.maxBlobSizeBytes (40) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:input: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:maxBlobSizeBytes: ", p), err) }
   }
   return err
 }
 
-func (p *SignalWorkflowExecutionRequest) writeField50(oprot thrift.TProtocol) (err error) {
-  if p.IsSetIdentity() {
-    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 50); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:identity: ", p), err) }
-    if err := oprot.WriteString(string(*p.Identity)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.identity (50) field write error: ", p), err) }
+func (p *ClusterCapabilities) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ClusterCapabilities(%+v)", *p)
+}
+
+type GetClusterInfoRequest struct {
+}
+
+func NewGetClusterInfoRequest() *GetClusterInfoRequest {
+  return &GetClusterInfoRequest{}
+}
+
+func (p *GetClusterInfoRequest) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *GetClusterInfoRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetClusterInfoRequest"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *GetClusterInfoRequest) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("GetClusterInfoRequest(%+v)", *p)
+}
+
+// Attributes:
+//  - Capabilities
+type GetClusterInfoResponse struct {
+  // unused fields # 1 to 9
+  Capabilities *ClusterCapabilities `thrift:"capabilities,10" db:"capabilities" json:"capabilities,omitempty"`
+}
+
+func NewGetClusterInfoResponse() *GetClusterInfoResponse {
+  return &GetClusterInfoResponse{}
+}
+
+var GetClusterInfoResponse_Capabilities_DEFAULT *ClusterCapabilities
+func (p *GetClusterInfoResponse) GetCapabilities() *ClusterCapabilities {
+  if !p.IsSetCapabilities() {
+    return GetClusterInfoResponse_Capabilities_DEFAULT
+  }
+return p.Capabilities
+}
+func (p *GetClusterInfoResponse) IsSetCapabilities() bool {
+  return p.Capabilities != nil
+}
+
+func (p *GetClusterInfoResponse) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *GetClusterInfoResponse)  ReadField10(iprot thrift.TProtocol) error {
+  p.Capabilities = &ClusterCapabilities{}
+  if err := p.Capabilities.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Capabilities), err)
+  }
+  return nil
+}
+
+func (p *GetClusterInfoResponse) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetClusterInfoResponse"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *GetClusterInfoResponse) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetCapabilities() {
+    if err := oprot.WriteFieldBegin("capabilities", thrift.STRUCT, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:capabilities: ", p), err) }
+    if err := p.Capabilities.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Capabilities), err)
+    }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:identity: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:capabilities: ", p), err) }
   }
   return err
 }
 
-func (p *SignalWorkflowExecutionRequest) String() string {
+func (p *GetClusterInfoResponse) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("SignalWorkflowExecutionRequest(%+v)", *p)
+  return fmt.Sprintf("GetClusterInfoResponse(%+v)", *p)
 }
 
 // Attributes:
 //  - Domain
-//  - WorkflowExecution
-//  - Reason
-//  - Details
-//  - Identity
-type TerminateWorkflowExecutionRequest struct {
+//  - MaximumPageSize
+//  - NextPageToken
+//  - Query
+type ListWorkflowExecutionsWithQueryRequest struct {
   // unused fields # 1 to 9
   Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
   // unused fields # 11 to 19
-  WorkflowExecution *WorkflowExecution `thrift:"workflowExecution,20" db:"workflowExecution" json:"workflowExecution,omitempty"`
+  MaximumPageSize *int32 `thrift:"maximumPageSize,20" db:"maximumPageSize" json:"maximumPageSize,omitempty"`
   // unused fields # 21 to 29
-  Reason *string `thrift:"reason,30" db:"reason" json:"reason,omitempty"`
+  NextPageToken []byte `thrift:"nextPageToken,30" db:"nextPageToken" json:"nextPageToken,omitempty"`
   // unused fields # 31 to 39
-  Details []byte `thrift:"details,40" db:"details" json:"details,omitempty"`
-  // unused fields # 41 to 49
-  Identity *string `thrift:"identity,50" db:"identity" json:"identity,omitempty"`
+  Query *string `thrift:"query,40" db:"query" json:"query,omitempty"`
 }
 
-func NewTerminateWorkflowExecutionRequest() *TerminateWorkflowExecutionRequest {
-  return &TerminateWorkflowExecutionRequest{}
+func NewListWorkflowExecutionsWithQueryRequest() *ListWorkflowExecutionsWithQueryRequest {
+  return &ListWorkflowExecutionsWithQueryRequest{}
 }
 
-var TerminateWorkflowExecutionRequest_Domain_DEFAULT string
-func (p *TerminateWorkflowExecutionRequest) GetDomain() string {
+var ListWorkflowExecutionsWithQueryRequest_Domain_DEFAULT string
+func (p *ListWorkflowExecutionsWithQueryRequest) GetDomain() string {
   if !p.IsSetDomain() {
-    return TerminateWorkflowExecutionRequest_Domain_DEFAULT
+    return ListWorkflowExecutionsWithQueryRequest_Domain_DEFAULT
   }
 return *p.Domain
 }
-var TerminateWorkflowExecutionRequest_WorkflowExecution_DEFAULT *WorkflowExecution
-func (p *TerminateWorkflowExecutionRequest) GetWorkflowExecution() *WorkflowExecution {
-  if !p.IsSetWorkflowExecution() {
-    return TerminateWorkflowExecutionRequest_WorkflowExecution_DEFAULT
-  }
-return p.WorkflowExecution
-}
-var TerminateWorkflowExecutionRequest_Reason_DEFAULT string
-func (p *TerminateWorkflowExecutionRequest) GetReason() string {
-  if !p.IsSetReason() {
-    return TerminateWorkflowExecutionRequest_Reason_DEFAULT
+var ListWorkflowExecutionsWithQueryRequest_MaximumPageSize_DEFAULT int32
+func (p *ListWorkflowExecutionsWithQueryRequest) GetMaximumPageSize() int32 {
+  if !p.IsSetMaximumPageSize() {
+    return ListWorkflowExecutionsWithQueryRequest_MaximumPageSize_DEFAULT
   }
-return *p.Reason
+return *p.MaximumPageSize
 }
-var TerminateWorkflowExecutionRequest_Details_DEFAULT []byte
+var ListWorkflowExecutionsWithQueryRequest_NextPageToken_DEFAULT []byte
 
-func (p *TerminateWorkflowExecutionRequest) GetDetails() []byte {
-  return p.Details
+func (p *ListWorkflowExecutionsWithQueryRequest) GetNextPageToken() []byte {
+  return p.NextPageToken
 }
-var TerminateWorkflowExecutionRequest_Identity_DEFAULT string
-func (p *TerminateWorkflowExecutionRequest) GetIdentity() string {
-  if !p.IsSetIdentity() {
-    return TerminateWorkflowExecutionRequest_Identity_DEFAULT
+var ListWorkflowExecutionsWithQueryRequest_Query_DEFAULT string
+func (p *ListWorkflowExecutionsWithQueryRequest) GetQuery() string {
+  if !p.IsSetQuery() {
+    return ListWorkflowExecutionsWithQueryRequest_Query_DEFAULT
   }
-return *p.Identity
+return *p.Query
 }
-func (p *TerminateWorkflowExecutionRequest) IsSetDomain() bool {
+func (p *ListWorkflowExecutionsWithQueryRequest) IsSetDomain() bool {
   return p.Domain != nil
 }
 
-func (p *TerminateWorkflowExecutionRequest) IsSetWorkflowExecution() bool {
-  return p.WorkflowExecution != nil
-}
-
-func (p *TerminateWorkflowExecutionRequest) IsSetReason() bool {
-  return p.Reason != nil
+func (p *ListWorkflowExecutionsWithQueryRequest) IsSetMaximumPageSize() bool {
+  return p.MaximumPageSize != nil
 }
 
-func (p *TerminateWorkflowExecutionRequest) IsSetDetails() bool {
-  return p.Details != nil
+func (p *ListWorkflowExecutionsWithQueryRequest) IsSetNextPageToken() bool {
+  return p.NextPageToken != nil
 }
 
-func (p *TerminateWorkflowExecutionRequest) IsSetIdentity() bool {
-  return p.Identity != nil
+func (p *ListWorkflowExecutionsWithQueryRequest) IsSetQuery() bool {
+  return p.Query != nil
 }
 
-func (p *TerminateWorkflowExecutionRequest) Read(iprot thrift.TProtocol) error {
+func (p *ListWorkflowExecutionsWithQueryRequest) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -20353,10 +27136,6 @@ func (p *TerminateWorkflowExecutionRequest) Read(iprot thrift.TProtocol) error {
       if err := p.ReadField40(iprot); err != nil {
         return err
       }
-    case 50:
-      if err := p.ReadField50(iprot); err != nil {
-        return err
-      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -20372,7 +27151,7 @@ func (p *TerminateWorkflowExecutionRequest) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *TerminateWorkflowExecutionRequest)  ReadField10(iprot thrift.TProtocol) error {
+func (p *ListWorkflowExecutionsWithQueryRequest)  ReadField10(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 10: ", err)
 } else {
@@ -20381,50 +27160,41 @@ func (p *TerminateWorkflowExecutionRequest)  ReadField10(iprot thrift.TProtocol)
   return nil
 }
 
-func (p *TerminateWorkflowExecutionRequest)  ReadField20(iprot thrift.TProtocol) error {
-  p.WorkflowExecution = &WorkflowExecution{}
-  if err := p.WorkflowExecution.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowExecution), err)
-  }
-  return nil
-}
-
-func (p *TerminateWorkflowExecutionRequest)  ReadField30(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 30: ", err)
+func (p *ListWorkflowExecutionsWithQueryRequest)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 20: ", err)
 } else {
-  p.Reason = &v
+  p.MaximumPageSize = &v
 }
   return nil
 }
 
-func (p *TerminateWorkflowExecutionRequest)  ReadField40(iprot thrift.TProtocol) error {
+func (p *ListWorkflowExecutionsWithQueryRequest)  ReadField30(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadBinary(); err != nil {
-  return thrift.PrependError("error reading field 40: ", err)
+  return thrift.PrependError("error reading field 30: ", err)
 } else {
-  p.Details = v
+  p.NextPageToken = v
 }
   return nil
 }
 
-func (p *TerminateWorkflowExecutionRequest)  ReadField50(iprot thrift.TProtocol) error {
+func (p *ListWorkflowExecutionsWithQueryRequest)  ReadField40(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 50: ", err)
+  return thrift.PrependError("error reading field 40: ", err)
 } else {
-  p.Identity = &v
+  p.Query = &v
 }
   return nil
 }
 
-func (p *TerminateWorkflowExecutionRequest) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("TerminateWorkflowExecutionRequest"); err != nil {
+func (p *ListWorkflowExecutionsWithQueryRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ListWorkflowExecutionsWithQueryRequest"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
     if err := p.writeField20(oprot); err != nil { return err }
     if err := p.writeField30(oprot); err != nil { return err }
     if err := p.writeField40(oprot); err != nil { return err }
-    if err := p.writeField50(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -20433,7 +27203,7 @@ func (p *TerminateWorkflowExecutionRequest) Write(oprot thrift.TProtocol) error
   return nil
 }
 
-func (p *TerminateWorkflowExecutionRequest) writeField10(oprot thrift.TProtocol) (err error) {
+func (p *ListWorkflowExecutionsWithQueryRequest) writeField10(oprot thrift.TProtocol) (err error) {
   if p.IsSetDomain() {
     if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
@@ -20445,153 +27215,318 @@ func (p *TerminateWorkflowExecutionRequest) writeField10(oprot thrift.TProtocol)
   return err
 }
 
-func (p *TerminateWorkflowExecutionRequest) writeField20(oprot thrift.TProtocol) (err error) {
-  if p.IsSetWorkflowExecution() {
-    if err := oprot.WriteFieldBegin("workflowExecution", thrift.STRUCT, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:workflowExecution: ", p), err) }
-    if err := p.WorkflowExecution.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowExecution), err)
-    }
+func (p *ListWorkflowExecutionsWithQueryRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetMaximumPageSize() {
+    if err := oprot.WriteFieldBegin("maximumPageSize", thrift.I32, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:maximumPageSize: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.MaximumPageSize)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.maximumPageSize (20) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:workflowExecution: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:maximumPageSize: ", p), err) }
   }
   return err
 }
 
-func (p *TerminateWorkflowExecutionRequest) writeField30(oprot thrift.TProtocol) (err error) {
-  if p.IsSetReason() {
-    if err := oprot.WriteFieldBegin("reason", thrift.STRING, 30); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:reason: ", p), err) }
-    if err := oprot.WriteString(string(*p.Reason)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.reason (30) field write error: ", p), err) }
+func (p *ListWorkflowExecutionsWithQueryRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetNextPageToken() {
+    if err := oprot.WriteFieldBegin("nextPageToken", thrift.STRING, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:nextPageToken: ", p), err) }
+    if err := oprot.WriteBinary(p.NextPageToken); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.nextPageToken (30) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:reason: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:nextPageToken: ", p), err) }
   }
   return err
 }
 
-func (p *TerminateWorkflowExecutionRequest) writeField40(oprot thrift.TProtocol) (err error) {
-  if p.IsSetDetails() {
-    if err := oprot.WriteFieldBegin("details", thrift.STRING, 40); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:details: ", p), err) }
-    if err := oprot.WriteBinary(p.Details); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.details (40) field write error: ", p), err) }
+func (p *ListWorkflowExecutionsWithQueryRequest) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetQuery() {
+    if err := oprot.WriteFieldBegin("query", thrift.STRING, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:query: ", p), err) }
+    if err := oprot.WriteString(string(*p.Query)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.query (40) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:details: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:query: ", p), err) }
   }
   return err
 }
 
-func (p *TerminateWorkflowExecutionRequest) writeField50(oprot thrift.TProtocol) (err error) {
-  if p.IsSetIdentity() {
-    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 50); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:identity: ", p), err) }
-    if err := oprot.WriteString(string(*p.Identity)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.identity (50) field write error: ", p), err) }
+func (p *ListWorkflowExecutionsWithQueryRequest) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ListWorkflowExecutionsWithQueryRequest(%+v)", *p)
+}
+
+// Attributes:
+//  - Executions
+//  - NextPageToken
+type ListWorkflowExecutionsWithQueryResponse struct {
+  // unused fields # 1 to 9
+  Executions []*WorkflowExecutionInfo `thrift:"executions,10" db:"executions" json:"executions,omitempty"`
+  // unused fields # 11 to 19
+  NextPageToken []byte `thrift:"nextPageToken,20" db:"nextPageToken" json:"nextPageToken,omitempty"`
+}
+
+func NewListWorkflowExecutionsWithQueryResponse() *ListWorkflowExecutionsWithQueryResponse {
+  return &ListWorkflowExecutionsWithQueryResponse{}
+}
+
+var ListWorkflowExecutionsWithQueryResponse_Executions_DEFAULT []*WorkflowExecutionInfo
+
+func (p *ListWorkflowExecutionsWithQueryResponse) GetExecutions() []*WorkflowExecutionInfo {
+  return p.Executions
+}
+var ListWorkflowExecutionsWithQueryResponse_NextPageToken_DEFAULT []byte
+
+func (p *ListWorkflowExecutionsWithQueryResponse) GetNextPageToken() []byte {
+  return p.NextPageToken
+}
+func (p *ListWorkflowExecutionsWithQueryResponse) IsSetExecutions() bool {
+  return p.Executions != nil
+}
+
+func (p *ListWorkflowExecutionsWithQueryResponse) IsSetNextPageToken() bool {
+  return p.NextPageToken != nil
+}
+
+func (p *ListWorkflowExecutionsWithQueryResponse) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ListWorkflowExecutionsWithQueryResponse)  ReadField10(iprot thrift.TProtocol) error {
+  _, size, err := iprot.ReadListBegin()
+  if err != nil {
+    return thrift.PrependError("error reading list begin: ", err)
+  }
+  tSlice := make([]*WorkflowExecutionInfo, 0, size)
+  p.Executions =  tSlice
+  for i := 0; i < size; i ++ {
+    _elem := &WorkflowExecutionInfo{}
+    if err := _elem.Read(iprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem), err)
+    }
+    p.Executions = append(p.Executions, _elem)
+  }
+  if err := iprot.ReadListEnd(); err != nil {
+    return thrift.PrependError("error reading list end: ", err)
+  }
+  return nil
+}
+
+func (p *ListWorkflowExecutionsWithQueryResponse)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 20: ", err)
+} else {
+  p.NextPageToken = v
+}
+  return nil
+}
+
+func (p *ListWorkflowExecutionsWithQueryResponse) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ListWorkflowExecutionsWithQueryResponse"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ListWorkflowExecutionsWithQueryResponse) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetExecutions() {
+    if err := oprot.WriteFieldBegin("executions", thrift.LIST, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:executions: ", p), err) }
+    if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Executions)); err != nil {
+      return thrift.PrependError("error writing list begin: ", err)
+    }
+    for _, v := range p.Executions {
+      if err := v.Write(oprot); err != nil {
+        return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+      }
+    }
+    if err := oprot.WriteListEnd(); err != nil {
+      return thrift.PrependError("error writing list end: ", err)
+    }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:identity: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:executions: ", p), err) }
   }
   return err
 }
 
-func (p *TerminateWorkflowExecutionRequest) String() string {
+func (p *ListWorkflowExecutionsWithQueryResponse) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetNextPageToken() {
+    if err := oprot.WriteFieldBegin("nextPageToken", thrift.STRING, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:nextPageToken: ", p), err) }
+    if err := oprot.WriteBinary(p.NextPageToken); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.nextPageToken (20) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:nextPageToken: ", p), err) }
+  }
+  return err
+}
+
+func (p *ListWorkflowExecutionsWithQueryResponse) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("TerminateWorkflowExecutionRequest(%+v)", *p)
+  return fmt.Sprintf("ListWorkflowExecutionsWithQueryResponse(%+v)", *p)
 }
-
 // Attributes:
 //  - Domain
-//  - MaximumPageSize
-//  - NextPageToken
-//  - StartTimeFilter
-//  - ExecutionFilter
-//  - TypeFilter
-type ListOpenWorkflowExecutionsRequest struct {
+//  - Query
+//  - OperationType
+//  - Reason
+//  - SignalName
+//  - SignalInput
+//  - RequestsPerSecond
+//  - Identity
+type StartBatchOperationRequest struct {
   // unused fields # 1 to 9
   Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
   // unused fields # 11 to 19
-  MaximumPageSize *int32 `thrift:"maximumPageSize,20" db:"maximumPageSize" json:"maximumPageSize,omitempty"`
+  Query *string `thrift:"query,20" db:"query" json:"query,omitempty"`
   // unused fields # 21 to 29
-  NextPageToken []byte `thrift:"nextPageToken,30" db:"nextPageToken" json:"nextPageToken,omitempty"`
+  OperationType *BatchOperationType `thrift:"operationType,30" db:"operationType" json:"operationType,omitempty"`
   // unused fields # 31 to 39
-  StartTimeFilter *StartTimeFilter `thrift:"StartTimeFilter,40" db:"StartTimeFilter" json:"StartTimeFilter,omitempty"`
+  Reason *string `thrift:"reason,40" db:"reason" json:"reason,omitempty"`
   // unused fields # 41 to 49
-  ExecutionFilter *WorkflowExecutionFilter `thrift:"executionFilter,50" db:"executionFilter" json:"executionFilter,omitempty"`
+  SignalName *string `thrift:"signalName,50" db:"signalName" json:"signalName,omitempty"`
   // unused fields # 51 to 59
-  TypeFilter *WorkflowTypeFilter `thrift:"typeFilter,60" db:"typeFilter" json:"typeFilter,omitempty"`
+  SignalInput []byte `thrift:"signalInput,60" db:"signalInput" json:"signalInput,omitempty"`
+  // unused fields # 61 to 69
+  RequestsPerSecond *int32 `thrift:"requestsPerSecond,70" db:"requestsPerSecond" json:"requestsPerSecond,omitempty"`
+  // unused fields # 71 to 79
+  Identity *string `thrift:"identity,80" db:"identity" json:"identity,omitempty"`
 }
 
-func NewListOpenWorkflowExecutionsRequest() *ListOpenWorkflowExecutionsRequest {
-  return &ListOpenWorkflowExecutionsRequest{}
+func NewStartBatchOperationRequest() *StartBatchOperationRequest {
+  return &StartBatchOperationRequest{}
 }
 
-var ListOpenWorkflowExecutionsRequest_Domain_DEFAULT string
-func (p *ListOpenWorkflowExecutionsRequest) GetDomain() string {
+var StartBatchOperationRequest_Domain_DEFAULT string
+func (p *StartBatchOperationRequest) GetDomain() string {
   if !p.IsSetDomain() {
-    return ListOpenWorkflowExecutionsRequest_Domain_DEFAULT
+    return StartBatchOperationRequest_Domain_DEFAULT
   }
 return *p.Domain
 }
-var ListOpenWorkflowExecutionsRequest_MaximumPageSize_DEFAULT int32
-func (p *ListOpenWorkflowExecutionsRequest) GetMaximumPageSize() int32 {
-  if !p.IsSetMaximumPageSize() {
-    return ListOpenWorkflowExecutionsRequest_MaximumPageSize_DEFAULT
+var StartBatchOperationRequest_Query_DEFAULT string
+func (p *StartBatchOperationRequest) GetQuery() string {
+  if !p.IsSetQuery() {
+    return StartBatchOperationRequest_Query_DEFAULT
   }
-return *p.MaximumPageSize
+return *p.Query
 }
-var ListOpenWorkflowExecutionsRequest_NextPageToken_DEFAULT []byte
-
-func (p *ListOpenWorkflowExecutionsRequest) GetNextPageToken() []byte {
-  return p.NextPageToken
+var StartBatchOperationRequest_OperationType_DEFAULT BatchOperationType
+func (p *StartBatchOperationRequest) GetOperationType() BatchOperationType {
+  if !p.IsSetOperationType() {
+    return StartBatchOperationRequest_OperationType_DEFAULT
+  }
+return *p.OperationType
+}
+var StartBatchOperationRequest_Reason_DEFAULT string
+func (p *StartBatchOperationRequest) GetReason() string {
+  if !p.IsSetReason() {
+    return StartBatchOperationRequest_Reason_DEFAULT
+  }
+return *p.Reason
 }
-var ListOpenWorkflowExecutionsRequest_StartTimeFilter_DEFAULT *StartTimeFilter
-func (p *ListOpenWorkflowExecutionsRequest) GetStartTimeFilter() *StartTimeFilter {
-  if !p.IsSetStartTimeFilter() {
-    return ListOpenWorkflowExecutionsRequest_StartTimeFilter_DEFAULT
+var StartBatchOperationRequest_SignalName_DEFAULT string
+func (p *StartBatchOperationRequest) GetSignalName() string {
+  if !p.IsSetSignalName() {
+    return StartBatchOperationRequest_SignalName_DEFAULT
   }
-return p.StartTimeFilter
+return *p.SignalName
 }
-var ListOpenWorkflowExecutionsRequest_ExecutionFilter_DEFAULT *WorkflowExecutionFilter
-func (p *ListOpenWorkflowExecutionsRequest) GetExecutionFilter() *WorkflowExecutionFilter {
-  if !p.IsSetExecutionFilter() {
-    return ListOpenWorkflowExecutionsRequest_ExecutionFilter_DEFAULT
+var StartBatchOperationRequest_SignalInput_DEFAULT []byte
+
+func (p *StartBatchOperationRequest) GetSignalInput() []byte {
+  return p.SignalInput
+}
+var StartBatchOperationRequest_RequestsPerSecond_DEFAULT int32
+func (p *StartBatchOperationRequest) GetRequestsPerSecond() int32 {
+  if !p.IsSetRequestsPerSecond() {
+    return StartBatchOperationRequest_RequestsPerSecond_DEFAULT
   }
-return p.ExecutionFilter
+return *p.RequestsPerSecond
 }
-var ListOpenWorkflowExecutionsRequest_TypeFilter_DEFAULT *WorkflowTypeFilter
-func (p *ListOpenWorkflowExecutionsRequest) GetTypeFilter() *WorkflowTypeFilter {
-  if !p.IsSetTypeFilter() {
-    return ListOpenWorkflowExecutionsRequest_TypeFilter_DEFAULT
+var StartBatchOperationRequest_Identity_DEFAULT string
+func (p *StartBatchOperationRequest) GetIdentity() string {
+  if !p.IsSetIdentity() {
+    return StartBatchOperationRequest_Identity_DEFAULT
   }
-return p.TypeFilter
+return *p.Identity
 }
-func (p *ListOpenWorkflowExecutionsRequest) IsSetDomain() bool {
+func (p *StartBatchOperationRequest) IsSetDomain() bool {
   return p.Domain != nil
 }
 
-func (p *ListOpenWorkflowExecutionsRequest) IsSetMaximumPageSize() bool {
-  return p.MaximumPageSize != nil
+func (p *StartBatchOperationRequest) IsSetQuery() bool {
+  return p.Query != nil
 }
 
-func (p *ListOpenWorkflowExecutionsRequest) IsSetNextPageToken() bool {
-  return p.NextPageToken != nil
+func (p *StartBatchOperationRequest) IsSetOperationType() bool {
+  return p.OperationType != nil
 }
 
-func (p *ListOpenWorkflowExecutionsRequest) IsSetStartTimeFilter() bool {
-  return p.StartTimeFilter != nil
+func (p *StartBatchOperationRequest) IsSetReason() bool {
+  return p.Reason != nil
 }
 
-func (p *ListOpenWorkflowExecutionsRequest) IsSetExecutionFilter() bool {
-  return p.ExecutionFilter != nil
+func (p *StartBatchOperationRequest) IsSetSignalName() bool {
+  return p.SignalName != nil
 }
 
-func (p *ListOpenWorkflowExecutionsRequest) IsSetTypeFilter() bool {
-  return p.TypeFilter != nil
+func (p *StartBatchOperationRequest) IsSetSignalInput() bool {
+  return p.SignalInput != nil
 }
 
-func (p *ListOpenWorkflowExecutionsRequest) Read(iprot thrift.TProtocol) error {
+func (p *StartBatchOperationRequest) IsSetRequestsPerSecond() bool {
+  return p.RequestsPerSecond != nil
+}
+
+func (p *StartBatchOperationRequest) IsSetIdentity() bool {
+  return p.Identity != nil
+}
+
+func (p *StartBatchOperationRequest) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -20628,6 +27563,14 @@ func (p *ListOpenWorkflowExecutionsRequest) Read(iprot thrift.TProtocol) error {
       if err := p.ReadField60(iprot); err != nil {
         return err
       }
+    case 70:
+      if err := p.ReadField70(iprot); err != nil {
+        return err
+      }
+    case 80:
+      if err := p.ReadField80(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -20643,7 +27586,7 @@ func (p *ListOpenWorkflowExecutionsRequest) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *ListOpenWorkflowExecutionsRequest)  ReadField10(iprot thrift.TProtocol) error {
+func (p *StartBatchOperationRequest)  ReadField10(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 10: ", err)
 } else {
@@ -20652,50 +27595,72 @@ func (p *ListOpenWorkflowExecutionsRequest)  ReadField10(iprot thrift.TProtocol)
   return nil
 }
 
-func (p *ListOpenWorkflowExecutionsRequest)  ReadField20(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI32(); err != nil {
+func (p *StartBatchOperationRequest)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 20: ", err)
 } else {
-  p.MaximumPageSize = &v
+  p.Query = &v
 }
   return nil
 }
 
-func (p *ListOpenWorkflowExecutionsRequest)  ReadField30(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBinary(); err != nil {
+func (p *StartBatchOperationRequest)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
   return thrift.PrependError("error reading field 30: ", err)
 } else {
-  p.NextPageToken = v
+  temp := BatchOperationType(v)
+  p.OperationType = &temp
 }
   return nil
 }
 
-func (p *ListOpenWorkflowExecutionsRequest)  ReadField40(iprot thrift.TProtocol) error {
-  p.StartTimeFilter = &StartTimeFilter{}
-  if err := p.StartTimeFilter.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.StartTimeFilter), err)
-  }
+func (p *StartBatchOperationRequest)  ReadField40(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 40: ", err)
+} else {
+  p.Reason = &v
+}
   return nil
 }
 
-func (p *ListOpenWorkflowExecutionsRequest)  ReadField50(iprot thrift.TProtocol) error {
-  p.ExecutionFilter = &WorkflowExecutionFilter{}
-  if err := p.ExecutionFilter.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ExecutionFilter), err)
-  }
+func (p *StartBatchOperationRequest)  ReadField50(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 50: ", err)
+} else {
+  p.SignalName = &v
+}
   return nil
 }
 
-func (p *ListOpenWorkflowExecutionsRequest)  ReadField60(iprot thrift.TProtocol) error {
-  p.TypeFilter = &WorkflowTypeFilter{}
-  if err := p.TypeFilter.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TypeFilter), err)
-  }
+func (p *StartBatchOperationRequest)  ReadField60(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(); err != nil {
+  return thrift.PrependError("error reading field 60: ", err)
+} else {
+  p.SignalInput = v
+}
   return nil
 }
 
-func (p *ListOpenWorkflowExecutionsRequest) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("ListOpenWorkflowExecutionsRequest"); err != nil {
+func (p *StartBatchOperationRequest)  ReadField70(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 70: ", err)
+} else {
+  p.RequestsPerSecond = &v
+}
+  return nil
+}
+
+func (p *StartBatchOperationRequest)  ReadField80(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 80: ", err)
+} else {
+  p.Identity = &v
+}
+  return nil
+}
+
+func (p *StartBatchOperationRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("StartBatchOperationRequest"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
@@ -20704,6 +27669,8 @@ func (p *ListOpenWorkflowExecutionsRequest) Write(oprot thrift.TProtocol) error
     if err := p.writeField40(oprot); err != nil { return err }
     if err := p.writeField50(oprot); err != nil { return err }
     if err := p.writeField60(oprot); err != nil { return err }
+    if err := p.writeField70(oprot); err != nil { return err }
+    if err := p.writeField80(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -20712,7 +27679,7 @@ func (p *ListOpenWorkflowExecutionsRequest) Write(oprot thrift.TProtocol) error
   return nil
 }
 
-func (p *ListOpenWorkflowExecutionsRequest) writeField10(oprot thrift.TProtocol) (err error) {
+func (p *StartBatchOperationRequest) writeField10(oprot thrift.TProtocol) (err error) {
   if p.IsSetDomain() {
     if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
@@ -20724,109 +27691,227 @@ func (p *ListOpenWorkflowExecutionsRequest) writeField10(oprot thrift.TProtocol)
   return err
 }
 
-func (p *ListOpenWorkflowExecutionsRequest) writeField20(oprot thrift.TProtocol) (err error) {
-  if p.IsSetMaximumPageSize() {
-    if err := oprot.WriteFieldBegin("maximumPageSize", thrift.I32, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:maximumPageSize: ", p), err) }
-    if err := oprot.WriteI32(int32(*p.MaximumPageSize)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.maximumPageSize (20) field write error: ", p), err) }
+func (p *StartBatchOperationRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetQuery() {
+    if err := oprot.WriteFieldBegin("query", thrift.STRING, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:query: ", p), err) }
+    if err := oprot.WriteString(string(*p.Query)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.query (20) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:maximumPageSize: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:query: ", p), err) }
   }
   return err
 }
 
-func (p *ListOpenWorkflowExecutionsRequest) writeField30(oprot thrift.TProtocol) (err error) {
-  if p.IsSetNextPageToken() {
-    if err := oprot.WriteFieldBegin("nextPageToken", thrift.STRING, 30); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:nextPageToken: ", p), err) }
-    if err := oprot.WriteBinary(p.NextPageToken); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.nextPageToken (30) field write error: ", p), err) }
+func (p *StartBatchOperationRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetOperationType() {
+    if err := oprot.WriteFieldBegin("operationType", thrift.I32, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:operationType: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.OperationType)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.operationType (30) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:nextPageToken: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:operationType: ", p), err) }
   }
   return err
 }
 
-func (p *ListOpenWorkflowExecutionsRequest) writeField40(oprot thrift.TProtocol) (err error) {
-  if p.IsSetStartTimeFilter() {
-    if err := oprot.WriteFieldBegin("StartTimeFilter", thrift.STRUCT, 40); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:StartTimeFilter: ", p), err) }
-    if err := p.StartTimeFilter.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.StartTimeFilter), err)
-    }
+func (p *StartBatchOperationRequest) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetReason() {
+    if err := oprot.WriteFieldBegin("reason", thrift.STRING, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:reason: ", p), err) }
+    if err := oprot.WriteString(string(*p.Reason)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.reason (40) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:StartTimeFilter: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:reason: ", p), err) }
   }
   return err
 }
 
-func (p *ListOpenWorkflowExecutionsRequest) writeField50(oprot thrift.TProtocol) (err error) {
-  if p.IsSetExecutionFilter() {
-    if err := oprot.WriteFieldBegin("executionFilter", thrift.STRUCT, 50); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:executionFilter: ", p), err) }
-    if err := p.ExecutionFilter.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ExecutionFilter), err)
-    }
+func (p *StartBatchOperationRequest) writeField50(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSignalName() {
+    if err := oprot.WriteFieldBegin("signalName", thrift.STRING, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:signalName: ", p), err) }
+    if err := oprot.WriteString(string(*p.SignalName)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.signalName (50) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:executionFilter: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:signalName: ", p), err) }
   }
   return err
 }
 
-func (p *ListOpenWorkflowExecutionsRequest) writeField60(oprot thrift.TProtocol) (err error) {
-  if p.IsSetTypeFilter() {
-    if err := oprot.WriteFieldBegin("typeFilter", thrift.STRUCT, 60); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:typeFilter: ", p), err) }
-    if err := p.TypeFilter.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TypeFilter), err)
-    }
+func (p *StartBatchOperationRequest) writeField60(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSignalInput() {
+    if err := oprot.WriteFieldBegin("signalInput", thrift.STRING, 60); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:signalInput: ", p), err) }
+    if err := oprot.WriteBinary(p.SignalInput); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.signalInput (60) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:typeFilter: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:signalInput: ", p), err) }
   }
   return err
 }
 
-func (p *ListOpenWorkflowExecutionsRequest) String() string {
+func (p *StartBatchOperationRequest) writeField70(oprot thrift.TProtocol) (err error) {
+  if p.IsSetRequestsPerSecond() {
+    if err := oprot.WriteFieldBegin("requestsPerSecond", thrift.I32, 70); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 70:requestsPerSecond: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.RequestsPerSecond)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.requestsPerSecond (70) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 70:requestsPerSecond: ", p), err) }
+  }
+  return err
+}
+
+func (p *StartBatchOperationRequest) writeField80(oprot thrift.TProtocol) (err error) {
+  if p.IsSetIdentity() {
+    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 80); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 80:identity: ", p), err) }
+    if err := oprot.WriteString(string(*p.Identity)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.identity (80) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 80:identity: ", p), err) }
+  }
+  return err
+}
+
+func (p *StartBatchOperationRequest) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("ListOpenWorkflowExecutionsRequest(%+v)", *p)
+  return fmt.Sprintf("StartBatchOperationRequest(%+v)", *p)
 }
-
 // Attributes:
-//  - Executions
-//  - NextPageToken
-type ListOpenWorkflowExecutionsResponse struct {
+//  - BatchId
+type StartBatchOperationResponse struct {
   // unused fields # 1 to 9
-  Executions []*WorkflowExecutionInfo `thrift:"executions,10" db:"executions" json:"executions,omitempty"`
-  // unused fields # 11 to 19
-  NextPageToken []byte `thrift:"nextPageToken,20" db:"nextPageToken" json:"nextPageToken,omitempty"`
+  BatchId *string `thrift:"batchId,10" db:"batchId" json:"batchId,omitempty"`
 }
 
-func NewListOpenWorkflowExecutionsResponse() *ListOpenWorkflowExecutionsResponse {
-  return &ListOpenWorkflowExecutionsResponse{}
+func NewStartBatchOperationResponse() *StartBatchOperationResponse {
+  return &StartBatchOperationResponse{}
 }
 
-var ListOpenWorkflowExecutionsResponse_Executions_DEFAULT []*WorkflowExecutionInfo
+var StartBatchOperationResponse_BatchId_DEFAULT string
+func (p *StartBatchOperationResponse) GetBatchId() string {
+  if !p.IsSetBatchId() {
+    return StartBatchOperationResponse_BatchId_DEFAULT
+  }
+return *p.BatchId
+}
+func (p *StartBatchOperationResponse) IsSetBatchId() bool {
+  return p.BatchId != nil
+}
 
-func (p *ListOpenWorkflowExecutionsResponse) GetExecutions() []*WorkflowExecutionInfo {
-  return p.Executions
+func (p *StartBatchOperationResponse) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
 }
-var ListOpenWorkflowExecutionsResponse_NextPageToken_DEFAULT []byte
 
-func (p *ListOpenWorkflowExecutionsResponse) GetNextPageToken() []byte {
-  return p.NextPageToken
+func (p *StartBatchOperationResponse)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.BatchId = &v
 }
-func (p *ListOpenWorkflowExecutionsResponse) IsSetExecutions() bool {
-  return p.Executions != nil
+  return nil
+}
+
+func (p *StartBatchOperationResponse) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("StartBatchOperationResponse"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *StartBatchOperationResponse) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBatchId() {
+    if err := oprot.WriteFieldBegin("batchId", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:batchId: ", p), err) }
+    if err := oprot.WriteString(string(*p.BatchId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.batchId (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:batchId: ", p), err) }
+  }
+  return err
+}
+
+func (p *StartBatchOperationResponse) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("StartBatchOperationResponse(%+v)", *p)
+}
+// Attributes:
+//  - Domain
+//  - BatchId
+type DescribeBatchOperationRequest struct {
+  // unused fields # 1 to 9
+  Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
+  // unused fields # 11 to 19
+  BatchId *string `thrift:"batchId,20" db:"batchId" json:"batchId,omitempty"`
+}
+
+func NewDescribeBatchOperationRequest() *DescribeBatchOperationRequest {
+  return &DescribeBatchOperationRequest{}
+}
+
+var DescribeBatchOperationRequest_Domain_DEFAULT string
+func (p *DescribeBatchOperationRequest) GetDomain() string {
+  if !p.IsSetDomain() {
+    return DescribeBatchOperationRequest_Domain_DEFAULT
+  }
+return *p.Domain
+}
+var DescribeBatchOperationRequest_BatchId_DEFAULT string
+func (p *DescribeBatchOperationRequest) GetBatchId() string {
+  if !p.IsSetBatchId() {
+    return DescribeBatchOperationRequest_BatchId_DEFAULT
+  }
+return *p.BatchId
+}
+func (p *DescribeBatchOperationRequest) IsSetDomain() bool {
+  return p.Domain != nil
 }
 
-func (p *ListOpenWorkflowExecutionsResponse) IsSetNextPageToken() bool {
-  return p.NextPageToken != nil
+func (p *DescribeBatchOperationRequest) IsSetBatchId() bool {
+  return p.BatchId != nil
 }
 
-func (p *ListOpenWorkflowExecutionsResponse) Read(iprot thrift.TProtocol) error {
+func (p *DescribeBatchOperationRequest) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -20862,37 +27947,26 @@ func (p *ListOpenWorkflowExecutionsResponse) Read(iprot thrift.TProtocol) error
   return nil
 }
 
-func (p *ListOpenWorkflowExecutionsResponse)  ReadField10(iprot thrift.TProtocol) error {
-  _, size, err := iprot.ReadListBegin()
-  if err != nil {
-    return thrift.PrependError("error reading list begin: ", err)
-  }
-  tSlice := make([]*WorkflowExecutionInfo, 0, size)
-  p.Executions =  tSlice
-  for i := 0; i < size; i ++ {
-    _elem2 := &WorkflowExecutionInfo{}
-    if err := _elem2.Read(iprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem2), err)
-    }
-    p.Executions = append(p.Executions, _elem2)
-  }
-  if err := iprot.ReadListEnd(); err != nil {
-    return thrift.PrependError("error reading list end: ", err)
-  }
+func (p *DescribeBatchOperationRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.Domain = &v
+}
   return nil
 }
 
-func (p *ListOpenWorkflowExecutionsResponse)  ReadField20(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBinary(); err != nil {
+func (p *DescribeBatchOperationRequest)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 20: ", err)
 } else {
-  p.NextPageToken = v
+  p.BatchId = &v
 }
   return nil
 }
 
-func (p *ListOpenWorkflowExecutionsResponse) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("ListOpenWorkflowExecutionsResponse"); err != nil {
+func (p *DescribeBatchOperationRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("DescribeBatchOperationRequest"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
@@ -20905,151 +27979,199 @@ func (p *ListOpenWorkflowExecutionsResponse) Write(oprot thrift.TProtocol) error
   return nil
 }
 
-func (p *ListOpenWorkflowExecutionsResponse) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetExecutions() {
-    if err := oprot.WriteFieldBegin("executions", thrift.LIST, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:executions: ", p), err) }
-    if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Executions)); err != nil {
-      return thrift.PrependError("error writing list begin: ", err)
-    }
-    for _, v := range p.Executions {
-      if err := v.Write(oprot); err != nil {
-        return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
-      }
-    }
-    if err := oprot.WriteListEnd(); err != nil {
-      return thrift.PrependError("error writing list end: ", err)
-    }
+func (p *DescribeBatchOperationRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomain() {
+    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
+    if err := oprot.WriteString(string(*p.Domain)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domain (10) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:executions: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domain: ", p), err) }
   }
   return err
 }
 
-func (p *ListOpenWorkflowExecutionsResponse) writeField20(oprot thrift.TProtocol) (err error) {
-  if p.IsSetNextPageToken() {
-    if err := oprot.WriteFieldBegin("nextPageToken", thrift.STRING, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:nextPageToken: ", p), err) }
-    if err := oprot.WriteBinary(p.NextPageToken); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.nextPageToken (20) field write error: ", p), err) }
+func (p *DescribeBatchOperationRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBatchId() {
+    if err := oprot.WriteFieldBegin("batchId", thrift.STRING, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:batchId: ", p), err) }
+    if err := oprot.WriteString(string(*p.BatchId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.batchId (20) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:nextPageToken: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:batchId: ", p), err) }
   }
   return err
 }
 
-func (p *ListOpenWorkflowExecutionsResponse) String() string {
+func (p *DescribeBatchOperationRequest) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("ListOpenWorkflowExecutionsResponse(%+v)", *p)
+  return fmt.Sprintf("DescribeBatchOperationRequest(%+v)", *p)
 }
-
 // Attributes:
-//  - Domain
-//  - MaximumPageSize
-//  - NextPageToken
-//  - StartTimeFilter
-//  - ExecutionFilter
-//  - TypeFilter
-//  - StatusFilter
-type ListClosedWorkflowExecutionsRequest struct {
+//  - OperationType
+//  - Query
+//  - Reason
+//  - Identity
+//  - State
+//  - StartTime
+//  - CloseTime
+//  - SucceededCount
+//  - SkippedCount
+//  - FailedCount
+//  - CloseReason
+type DescribeBatchOperationResponse struct {
   // unused fields # 1 to 9
-  Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
+  OperationType *BatchOperationType `thrift:"operationType,10" db:"operationType" json:"operationType,omitempty"`
   // unused fields # 11 to 19
-  MaximumPageSize *int32 `thrift:"maximumPageSize,20" db:"maximumPageSize" json:"maximumPageSize,omitempty"`
+  Query *string `thrift:"query,20" db:"query" json:"query,omitempty"`
   // unused fields # 21 to 29
-  NextPageToken []byte `thrift:"nextPageToken,30" db:"nextPageToken" json:"nextPageToken,omitempty"`
+  Reason *string `thrift:"reason,30" db:"reason" json:"reason,omitempty"`
   // unused fields # 31 to 39
-  StartTimeFilter *StartTimeFilter `thrift:"StartTimeFilter,40" db:"StartTimeFilter" json:"StartTimeFilter,omitempty"`
+  Identity *string `thrift:"identity,40" db:"identity" json:"identity,omitempty"`
   // unused fields # 41 to 49
-  ExecutionFilter *WorkflowExecutionFilter `thrift:"executionFilter,50" db:"executionFilter" json:"executionFilter,omitempty"`
+  State *BatchOperationState `thrift:"state,50" db:"state" json:"state,omitempty"`
   // unused fields # 51 to 59
-  TypeFilter *WorkflowTypeFilter `thrift:"typeFilter,60" db:"typeFilter" json:"typeFilter,omitempty"`
+  StartTime *int64 `thrift:"startTime,60" db:"startTime" json:"startTime,omitempty"`
   // unused fields # 61 to 69
-  StatusFilter *WorkflowExecutionCloseStatus `thrift:"statusFilter,70" db:"statusFilter" json:"statusFilter,omitempty"`
+  CloseTime *int64 `thrift:"closeTime,70" db:"closeTime" json:"closeTime,omitempty"`
+  // unused fields # 71 to 79
+  SucceededCount *int64 `thrift:"succeededCount,80" db:"succeededCount" json:"succeededCount,omitempty"`
+  // unused fields # 81 to 89
+  SkippedCount *int64 `thrift:"skippedCount,90" db:"skippedCount" json:"skippedCount,omitempty"`
+  // unused fields # 91 to 99
+  FailedCount *int64 `thrift:"failedCount,100" db:"failedCount" json:"failedCount,omitempty"`
+  // unused fields # 101 to 109
+  CloseReason *string `thrift:"closeReason,110" db:"closeReason" json:"closeReason,omitempty"`
 }
 
-func NewListClosedWorkflowExecutionsRequest() *ListClosedWorkflowExecutionsRequest {
-  return &ListClosedWorkflowExecutionsRequest{}
+func NewDescribeBatchOperationResponse() *DescribeBatchOperationResponse {
+  return &DescribeBatchOperationResponse{}
 }
 
-var ListClosedWorkflowExecutionsRequest_Domain_DEFAULT string
-func (p *ListClosedWorkflowExecutionsRequest) GetDomain() string {
-  if !p.IsSetDomain() {
-    return ListClosedWorkflowExecutionsRequest_Domain_DEFAULT
+var DescribeBatchOperationResponse_OperationType_DEFAULT BatchOperationType
+func (p *DescribeBatchOperationResponse) GetOperationType() BatchOperationType {
+  if !p.IsSetOperationType() {
+    return DescribeBatchOperationResponse_OperationType_DEFAULT
   }
-return *p.Domain
+return *p.OperationType
 }
-var ListClosedWorkflowExecutionsRequest_MaximumPageSize_DEFAULT int32
-func (p *ListClosedWorkflowExecutionsRequest) GetMaximumPageSize() int32 {
-  if !p.IsSetMaximumPageSize() {
-    return ListClosedWorkflowExecutionsRequest_MaximumPageSize_DEFAULT
+var DescribeBatchOperationResponse_Query_DEFAULT string
+func (p *DescribeBatchOperationResponse) GetQuery() string {
+  if !p.IsSetQuery() {
+    return DescribeBatchOperationResponse_Query_DEFAULT
   }
-return *p.MaximumPageSize
+return *p.Query
 }
-var ListClosedWorkflowExecutionsRequest_NextPageToken_DEFAULT []byte
-
-func (p *ListClosedWorkflowExecutionsRequest) GetNextPageToken() []byte {
-  return p.NextPageToken
+var DescribeBatchOperationResponse_Reason_DEFAULT string
+func (p *DescribeBatchOperationResponse) GetReason() string {
+  if !p.IsSetReason() {
+    return DescribeBatchOperationResponse_Reason_DEFAULT
+  }
+return *p.Reason
 }
-var ListClosedWorkflowExecutionsRequest_StartTimeFilter_DEFAULT *StartTimeFilter
-func (p *ListClosedWorkflowExecutionsRequest) GetStartTimeFilter() *StartTimeFilter {
-  if !p.IsSetStartTimeFilter() {
-    return ListClosedWorkflowExecutionsRequest_StartTimeFilter_DEFAULT
+var DescribeBatchOperationResponse_Identity_DEFAULT string
+func (p *DescribeBatchOperationResponse) GetIdentity() string {
+  if !p.IsSetIdentity() {
+    return DescribeBatchOperationResponse_Identity_DEFAULT
   }
-return p.StartTimeFilter
+return *p.Identity
 }
-var ListClosedWorkflowExecutionsRequest_ExecutionFilter_DEFAULT *WorkflowExecutionFilter
-func (p *ListClosedWorkflowExecutionsRequest) GetExecutionFilter() *WorkflowExecutionFilter {
-  if !p.IsSetExecutionFilter() {
-    return ListClosedWorkflowExecutionsRequest_ExecutionFilter_DEFAULT
+var DescribeBatchOperationResponse_State_DEFAULT BatchOperationState
+func (p *DescribeBatchOperationResponse) GetState() BatchOperationState {
+  if !p.IsSetState() {
+    return DescribeBatchOperationResponse_State_DEFAULT
   }
-return p.ExecutionFilter
+return *p.State
 }
-var ListClosedWorkflowExecutionsRequest_TypeFilter_DEFAULT *WorkflowTypeFilter
-func (p *ListClosedWorkflowExecutionsRequest) GetTypeFilter() *WorkflowTypeFilter {
-  if !p.IsSetTypeFilter() {
-    return ListClosedWorkflowExecutionsRequest_TypeFilter_DEFAULT
+var DescribeBatchOperationResponse_StartTime_DEFAULT int64
+func (p *DescribeBatchOperationResponse) GetStartTime() int64 {
+  if !p.IsSetStartTime() {
+    return DescribeBatchOperationResponse_StartTime_DEFAULT
   }
-return p.TypeFilter
+return *p.StartTime
 }
-var ListClosedWorkflowExecutionsRequest_StatusFilter_DEFAULT WorkflowExecutionCloseStatus
-func (p *ListClosedWorkflowExecutionsRequest) GetStatusFilter() WorkflowExecutionCloseStatus {
-  if !p.IsSetStatusFilter() {
-    return ListClosedWorkflowExecutionsRequest_StatusFilter_DEFAULT
+var DescribeBatchOperationResponse_CloseTime_DEFAULT int64
+func (p *DescribeBatchOperationResponse) GetCloseTime() int64 {
+  if !p.IsSetCloseTime() {
+    return DescribeBatchOperationResponse_CloseTime_DEFAULT
   }
-return *p.StatusFilter
+return *p.CloseTime
 }
-func (p *ListClosedWorkflowExecutionsRequest) IsSetDomain() bool {
-  return p.Domain != nil
+var DescribeBatchOperationResponse_SucceededCount_DEFAULT int64
+func (p *DescribeBatchOperationResponse) GetSucceededCount() int64 {
+  if !p.IsSetSucceededCount() {
+    return DescribeBatchOperationResponse_SucceededCount_DEFAULT
+  }
+return *p.SucceededCount
+}
+var DescribeBatchOperationResponse_SkippedCount_DEFAULT int64
+func (p *DescribeBatchOperationResponse) GetSkippedCount() int64 {
+  if !p.IsSetSkippedCount() {
+    return DescribeBatchOperationResponse_SkippedCount_DEFAULT
+  }
+return *p.SkippedCount
+}
+var DescribeBatchOperationResponse_FailedCount_DEFAULT int64
+func (p *DescribeBatchOperationResponse) GetFailedCount() int64 {
+  if !p.IsSetFailedCount() {
+    return DescribeBatchOperationResponse_FailedCount_DEFAULT
+  }
+return *p.FailedCount
+}
+var DescribeBatchOperationResponse_CloseReason_DEFAULT string
+func (p *DescribeBatchOperationResponse) GetCloseReason() string {
+  if !p.IsSetCloseReason() {
+    return DescribeBatchOperationResponse_CloseReason_DEFAULT
+  }
+return *p.CloseReason
+}
+func (p *DescribeBatchOperationResponse) IsSetOperationType() bool {
+  return p.OperationType != nil
 }
 
-func (p *ListClosedWorkflowExecutionsRequest) IsSetMaximumPageSize() bool {
-  return p.MaximumPageSize != nil
+func (p *DescribeBatchOperationResponse) IsSetQuery() bool {
+  return p.Query != nil
 }
 
-func (p *ListClosedWorkflowExecutionsRequest) IsSetNextPageToken() bool {
-  return p.NextPageToken != nil
+func (p *DescribeBatchOperationResponse) IsSetReason() bool {
+  return p.Reason != nil
 }
 
-func (p *ListClosedWorkflowExecutionsRequest) IsSetStartTimeFilter() bool {
-  return p.StartTimeFilter != nil
+func (p *DescribeBatchOperationResponse) IsSetIdentity() bool {
+  return p.Identity != nil
 }
 
-func (p *ListClosedWorkflowExecutionsRequest) IsSetExecutionFilter() bool {
-  return p.ExecutionFilter != nil
+func (p *DescribeBatchOperationResponse) IsSetState() bool {
+  return p.State != nil
 }
 
-func (p *ListClosedWorkflowExecutionsRequest) IsSetTypeFilter() bool {
-  return p.TypeFilter != nil
+func (p *DescribeBatchOperationResponse) IsSetStartTime() bool {
+  return p.StartTime != nil
 }
 
-func (p *ListClosedWorkflowExecutionsRequest) IsSetStatusFilter() bool {
-  return p.StatusFilter != nil
+func (p *DescribeBatchOperationResponse) IsSetCloseTime() bool {
+  return p.CloseTime != nil
 }
 
-func (p *ListClosedWorkflowExecutionsRequest) Read(iprot thrift.TProtocol) error {
+func (p *DescribeBatchOperationResponse) IsSetSucceededCount() bool {
+  return p.SucceededCount != nil
+}
+
+func (p *DescribeBatchOperationResponse) IsSetSkippedCount() bool {
+  return p.SkippedCount != nil
+}
+
+func (p *DescribeBatchOperationResponse) IsSetFailedCount() bool {
+  return p.FailedCount != nil
+}
+
+func (p *DescribeBatchOperationResponse) IsSetCloseReason() bool {
+  return p.CloseReason != nil
+}
+
+func (p *DescribeBatchOperationResponse) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -21090,6 +28212,22 @@ func (p *ListClosedWorkflowExecutionsRequest) Read(iprot thrift.TProtocol) error
       if err := p.ReadField70(iprot); err != nil {
         return err
       }
+    case 80:
+      if err := p.ReadField80(iprot); err != nil {
+        return err
+      }
+    case 90:
+      if err := p.ReadField90(iprot); err != nil {
+        return err
+      }
+    case 100:
+      if err := p.ReadField100(iprot); err != nil {
+        return err
+      }
+    case 110:
+      if err := p.ReadField110(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -21105,69 +28243,109 @@ func (p *ListClosedWorkflowExecutionsRequest) Read(iprot thrift.TProtocol) error
   return nil
 }
 
-func (p *ListClosedWorkflowExecutionsRequest)  ReadField10(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
+func (p *DescribeBatchOperationResponse)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
   return thrift.PrependError("error reading field 10: ", err)
 } else {
-  p.Domain = &v
+  temp := BatchOperationType(v)
+  p.OperationType = &temp
 }
   return nil
 }
 
-func (p *ListClosedWorkflowExecutionsRequest)  ReadField20(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI32(); err != nil {
+func (p *DescribeBatchOperationResponse)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 20: ", err)
 } else {
-  p.MaximumPageSize = &v
+  p.Query = &v
 }
   return nil
 }
 
-func (p *ListClosedWorkflowExecutionsRequest)  ReadField30(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBinary(); err != nil {
+func (p *DescribeBatchOperationResponse)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 30: ", err)
 } else {
-  p.NextPageToken = v
+  p.Reason = &v
 }
   return nil
 }
 
-func (p *ListClosedWorkflowExecutionsRequest)  ReadField40(iprot thrift.TProtocol) error {
-  p.StartTimeFilter = &StartTimeFilter{}
-  if err := p.StartTimeFilter.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.StartTimeFilter), err)
-  }
+func (p *DescribeBatchOperationResponse)  ReadField40(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 40: ", err)
+} else {
+  p.Identity = &v
+}
   return nil
 }
 
-func (p *ListClosedWorkflowExecutionsRequest)  ReadField50(iprot thrift.TProtocol) error {
-  p.ExecutionFilter = &WorkflowExecutionFilter{}
-  if err := p.ExecutionFilter.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ExecutionFilter), err)
-  }
+func (p *DescribeBatchOperationResponse)  ReadField50(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 50: ", err)
+} else {
+  temp := BatchOperationState(v)
+  p.State = &temp
+}
   return nil
 }
 
-func (p *ListClosedWorkflowExecutionsRequest)  ReadField60(iprot thrift.TProtocol) error {
-  p.TypeFilter = &WorkflowTypeFilter{}
-  if err := p.TypeFilter.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TypeFilter), err)
-  }
+func (p *DescribeBatchOperationResponse)  ReadField60(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 60: ", err)
+} else {
+  p.StartTime = &v
+}
   return nil
 }
 
-func (p *ListClosedWorkflowExecutionsRequest)  ReadField70(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI32(); err != nil {
+func (p *DescribeBatchOperationResponse)  ReadField70(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
   return thrift.PrependError("error reading field 70: ", err)
 } else {
-  temp := WorkflowExecutionCloseStatus(v)
-  p.StatusFilter = &temp
+  p.CloseTime = &v
+}
+  return nil
+}
+
+func (p *DescribeBatchOperationResponse)  ReadField80(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 80: ", err)
+} else {
+  p.SucceededCount = &v
+}
+  return nil
+}
+
+func (p *DescribeBatchOperationResponse)  ReadField90(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 90: ", err)
+} else {
+  p.SkippedCount = &v
+}
+  return nil
+}
+
+func (p *DescribeBatchOperationResponse)  ReadField100(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 100: ", err)
+} else {
+  p.FailedCount = &v
+}
+  return nil
+}
+
+func (p *DescribeBatchOperationResponse)  ReadField110(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 110: ", err)
+} else {
+  p.CloseReason = &v
 }
   return nil
 }
 
-func (p *ListClosedWorkflowExecutionsRequest) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("ListClosedWorkflowExecutionsRequest"); err != nil {
+func (p *DescribeBatchOperationResponse) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("DescribeBatchOperationResponse"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
@@ -21177,6 +28355,10 @@ func (p *ListClosedWorkflowExecutionsRequest) Write(oprot thrift.TProtocol) erro
     if err := p.writeField50(oprot); err != nil { return err }
     if err := p.writeField60(oprot); err != nil { return err }
     if err := p.writeField70(oprot); err != nil { return err }
+    if err := p.writeField80(oprot); err != nil { return err }
+    if err := p.writeField90(oprot); err != nil { return err }
+    if err := p.writeField100(oprot); err != nil { return err }
+    if err := p.writeField110(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -21185,133 +28367,209 @@ func (p *ListClosedWorkflowExecutionsRequest) Write(oprot thrift.TProtocol) erro
   return nil
 }
 
-func (p *ListClosedWorkflowExecutionsRequest) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetDomain() {
-    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
-    if err := oprot.WriteString(string(*p.Domain)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.domain (10) field write error: ", p), err) }
+func (p *DescribeBatchOperationResponse) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetOperationType() {
+    if err := oprot.WriteFieldBegin("operationType", thrift.I32, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:operationType: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.OperationType)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.operationType (10) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domain: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:operationType: ", p), err) }
   }
   return err
 }
 
-func (p *ListClosedWorkflowExecutionsRequest) writeField20(oprot thrift.TProtocol) (err error) {
-  if p.IsSetMaximumPageSize() {
-    if err := oprot.WriteFieldBegin("maximumPageSize", thrift.I32, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:maximumPageSize: ", p), err) }
-    if err := oprot.WriteI32(int32(*p.MaximumPageSize)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.maximumPageSize (20) field write error: ", p), err) }
+func (p *DescribeBatchOperationResponse) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetQuery() {
+    if err := oprot.WriteFieldBegin("query", thrift.STRING, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:query: ", p), err) }
+    if err := oprot.WriteString(string(*p.Query)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.query (20) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:maximumPageSize: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:query: ", p), err) }
   }
   return err
 }
 
-func (p *ListClosedWorkflowExecutionsRequest) writeField30(oprot thrift.TProtocol) (err error) {
-  if p.IsSetNextPageToken() {
-    if err := oprot.WriteFieldBegin("nextPageToken", thrift.STRING, 30); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:nextPageToken: ", p), err) }
-    if err := oprot.WriteBinary(p.NextPageToken); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.nextPageToken (30) field write error: ", p), err) }
+func (p *DescribeBatchOperationResponse) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetReason() {
+    if err := oprot.WriteFieldBegin("reason", thrift.STRING, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:reason: ", p), err) }
+    if err := oprot.WriteString(string(*p.Reason)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.reason (30) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:nextPageToken: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:reason: ", p), err) }
   }
   return err
 }
 
-func (p *ListClosedWorkflowExecutionsRequest) writeField40(oprot thrift.TProtocol) (err error) {
-  if p.IsSetStartTimeFilter() {
-    if err := oprot.WriteFieldBegin("StartTimeFilter", thrift.STRUCT, 40); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:StartTimeFilter: ", p), err) }
-    if err := p.StartTimeFilter.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.StartTimeFilter), err)
-    }
+func (p *DescribeBatchOperationResponse) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetIdentity() {
+    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:identity: ", p), err) }
+    if err := oprot.WriteString(string(*p.Identity)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.identity (40) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:StartTimeFilter: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:identity: ", p), err) }
   }
   return err
 }
 
-func (p *ListClosedWorkflowExecutionsRequest) writeField50(oprot thrift.TProtocol) (err error) {
-  if p.IsSetExecutionFilter() {
-    if err := oprot.WriteFieldBegin("executionFilter", thrift.STRUCT, 50); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:executionFilter: ", p), err) }
-    if err := p.ExecutionFilter.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ExecutionFilter), err)
-    }
+func (p *DescribeBatchOperationResponse) writeField50(oprot thrift.TProtocol) (err error) {
+  if p.IsSetState() {
+    if err := oprot.WriteFieldBegin("state", thrift.I32, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:state: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.State)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.state (50) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:executionFilter: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:state: ", p), err) }
   }
   return err
 }
 
-func (p *ListClosedWorkflowExecutionsRequest) writeField60(oprot thrift.TProtocol) (err error) {
-  if p.IsSetTypeFilter() {
-    if err := oprot.WriteFieldBegin("typeFilter", thrift.STRUCT, 60); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:typeFilter: ", p), err) }
-    if err := p.TypeFilter.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TypeFilter), err)
-    }
+func (p *DescribeBatchOperationResponse) writeField60(oprot thrift.TProtocol) (err error) {
+  if p.IsSetStartTime() {
+    if err := oprot.WriteFieldBegin("startTime", thrift.I64, 60); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:startTime: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.StartTime)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.startTime (60) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:typeFilter: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:startTime: ", p), err) }
   }
   return err
 }
 
-func (p *ListClosedWorkflowExecutionsRequest) writeField70(oprot thrift.TProtocol) (err error) {
-  if p.IsSetStatusFilter() {
-    if err := oprot.WriteFieldBegin("statusFilter", thrift.I32, 70); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 70:statusFilter: ", p), err) }
-    if err := oprot.WriteI32(int32(*p.StatusFilter)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.statusFilter (70) field write error: ", p), err) }
+func (p *DescribeBatchOperationResponse) writeField70(oprot thrift.TProtocol) (err error) {
+  if p.IsSetCloseTime() {
+    if err := oprot.WriteFieldBegin("closeTime", thrift.I64, 70); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 70:closeTime: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.CloseTime)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.closeTime (70) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 70:statusFilter: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 70:closeTime: ", p), err) }
   }
   return err
 }
 
-func (p *ListClosedWorkflowExecutionsRequest) String() string {
+func (p *DescribeBatchOperationResponse) writeField80(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSucceededCount() {
+    if err := oprot.WriteFieldBegin("succeededCount", thrift.I64, 80); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 80:succeededCount: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.SucceededCount)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.succeededCount (80) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 80:succeededCount: ", p), err) }
+  }
+  return err
+}
+
+func (p *DescribeBatchOperationResponse) writeField90(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSkippedCount() {
+    if err := oprot.WriteFieldBegin("skippedCount", thrift.I64, 90); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 90:skippedCount: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.SkippedCount)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.skippedCount (90) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 90:skippedCount: ", p), err) }
+  }
+  return err
+}
+
+func (p *DescribeBatchOperationResponse) writeField100(oprot thrift.TProtocol) (err error) {
+  if p.IsSetFailedCount() {
+    if err := oprot.WriteFieldBegin("failedCount", thrift.I64, 100); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 100:failedCount: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.FailedCount)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.failedCount (100) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 100:failedCount: ", p), err) }
+  }
+  return err
+}
+
+func (p *DescribeBatchOperationResponse) writeField110(oprot thrift.TProtocol) (err error) {
+  if p.IsSetCloseReason() {
+    if err := oprot.WriteFieldBegin("closeReason", thrift.STRING, 110); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 110:closeReason: ", p), err) }
+    if err := oprot.WriteString(string(*p.CloseReason)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.closeReason (110) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 110:closeReason: ", p), err) }
+  }
+  return err
+}
+
+func (p *DescribeBatchOperationResponse) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("ListClosedWorkflowExecutionsRequest(%+v)", *p)
+  return fmt.Sprintf("DescribeBatchOperationResponse(%+v)", *p)
 }
-
 // Attributes:
-//  - Executions
-//  - NextPageToken
-type ListClosedWorkflowExecutionsResponse struct {
+//  - Domain
+//  - BatchId
+//  - Reason
+//  - Identity
+type StopBatchOperationRequest struct {
   // unused fields # 1 to 9
-  Executions []*WorkflowExecutionInfo `thrift:"executions,10" db:"executions" json:"executions,omitempty"`
+  Domain *string `thrift:"domain,10" db:"domain" json:"domain,omitempty"`
   // unused fields # 11 to 19
-  NextPageToken []byte `thrift:"nextPageToken,20" db:"nextPageToken" json:"nextPageToken,omitempty"`
+  BatchId *string `thrift:"batchId,20" db:"batchId" json:"batchId,omitempty"`
+  // unused fields # 21 to 29
+  Reason *string `thrift:"reason,30" db:"reason" json:"reason,omitempty"`
+  // unused fields # 31 to 39
+  Identity *string `thrift:"identity,40" db:"identity" json:"identity,omitempty"`
 }
 
-func NewListClosedWorkflowExecutionsResponse() *ListClosedWorkflowExecutionsResponse {
-  return &ListClosedWorkflowExecutionsResponse{}
+func NewStopBatchOperationRequest() *StopBatchOperationRequest {
+  return &StopBatchOperationRequest{}
 }
 
-var ListClosedWorkflowExecutionsResponse_Executions_DEFAULT []*WorkflowExecutionInfo
-
-func (p *ListClosedWorkflowExecutionsResponse) GetExecutions() []*WorkflowExecutionInfo {
-  return p.Executions
+var StopBatchOperationRequest_Domain_DEFAULT string
+func (p *StopBatchOperationRequest) GetDomain() string {
+  if !p.IsSetDomain() {
+    return StopBatchOperationRequest_Domain_DEFAULT
+  }
+return *p.Domain
+}
+var StopBatchOperationRequest_BatchId_DEFAULT string
+func (p *StopBatchOperationRequest) GetBatchId() string {
+  if !p.IsSetBatchId() {
+    return StopBatchOperationRequest_BatchId_DEFAULT
+  }
+return *p.BatchId
+}
+var StopBatchOperationRequest_Reason_DEFAULT string
+func (p *StopBatchOperationRequest) GetReason() string {
+  if !p.IsSetReason() {
+    return StopBatchOperationRequest_Reason_DEFAULT
+  }
+return *p.Reason
+}
+var StopBatchOperationRequest_Identity_DEFAULT string
+func (p *StopBatchOperationRequest) GetIdentity() string {
+  if !p.IsSetIdentity() {
+    return StopBatchOperationRequest_Identity_DEFAULT
+  }
+return *p.Identity
+}
+func (p *StopBatchOperationRequest) IsSetDomain() bool {
+  return p.Domain != nil
 }
-var ListClosedWorkflowExecutionsResponse_NextPageToken_DEFAULT []byte
 
-func (p *ListClosedWorkflowExecutionsResponse) GetNextPageToken() []byte {
-  return p.NextPageToken
+func (p *StopBatchOperationRequest) IsSetBatchId() bool {
+  return p.BatchId != nil
 }
-func (p *ListClosedWorkflowExecutionsResponse) IsSetExecutions() bool {
-  return p.Executions != nil
+
+func (p *StopBatchOperationRequest) IsSetReason() bool {
+  return p.Reason != nil
 }
 
-func (p *ListClosedWorkflowExecutionsResponse) IsSetNextPageToken() bool {
-  return p.NextPageToken != nil
+func (p *StopBatchOperationRequest) IsSetIdentity() bool {
+  return p.Identity != nil
 }
 
-func (p *ListClosedWorkflowExecutionsResponse) Read(iprot thrift.TProtocol) error {
+func (p *StopBatchOperationRequest) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -21332,6 +28590,14 @@ func (p *ListClosedWorkflowExecutionsResponse) Read(iprot thrift.TProtocol) erro
       if err := p.ReadField20(iprot); err != nil {
         return err
       }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -21347,41 +28613,50 @@ func (p *ListClosedWorkflowExecutionsResponse) Read(iprot thrift.TProtocol) erro
   return nil
 }
 
-func (p *ListClosedWorkflowExecutionsResponse)  ReadField10(iprot thrift.TProtocol) error {
-  _, size, err := iprot.ReadListBegin()
-  if err != nil {
-    return thrift.PrependError("error reading list begin: ", err)
-  }
-  tSlice := make([]*WorkflowExecutionInfo, 0, size)
-  p.Executions =  tSlice
-  for i := 0; i < size; i ++ {
-    _elem3 := &WorkflowExecutionInfo{}
-    if err := _elem3.Read(iprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem3), err)
-    }
-    p.Executions = append(p.Executions, _elem3)
-  }
-  if err := iprot.ReadListEnd(); err != nil {
-    return thrift.PrependError("error reading list end: ", err)
-  }
+func (p *StopBatchOperationRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.Domain = &v
+}
   return nil
 }
 
-func (p *ListClosedWorkflowExecutionsResponse)  ReadField20(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBinary(); err != nil {
+func (p *StopBatchOperationRequest)  ReadField20(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 20: ", err)
 } else {
-  p.NextPageToken = v
+  p.BatchId = &v
 }
   return nil
 }
 
-func (p *ListClosedWorkflowExecutionsResponse) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("ListClosedWorkflowExecutionsResponse"); err != nil {
+func (p *StopBatchOperationRequest)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
+} else {
+  p.Reason = &v
+}
+  return nil
+}
+
+func (p *StopBatchOperationRequest)  ReadField40(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 40: ", err)
+} else {
+  p.Identity = &v
+}
+  return nil
+}
+
+func (p *StopBatchOperationRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("StopBatchOperationRequest"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
     if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
+    if err := p.writeField40(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -21390,43 +28665,57 @@ func (p *ListClosedWorkflowExecutionsResponse) Write(oprot thrift.TProtocol) err
   return nil
 }
 
-func (p *ListClosedWorkflowExecutionsResponse) writeField10(oprot thrift.TProtocol) (err error) {
-  if p.IsSetExecutions() {
-    if err := oprot.WriteFieldBegin("executions", thrift.LIST, 10); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:executions: ", p), err) }
-    if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Executions)); err != nil {
-      return thrift.PrependError("error writing list begin: ", err)
-    }
-    for _, v := range p.Executions {
-      if err := v.Write(oprot); err != nil {
-        return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
-      }
-    }
-    if err := oprot.WriteListEnd(); err != nil {
-      return thrift.PrependError("error writing list end: ", err)
-    }
+func (p *StopBatchOperationRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomain() {
+    if err := oprot.WriteFieldBegin("domain", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domain: ", p), err) }
+    if err := oprot.WriteString(string(*p.Domain)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domain (10) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:executions: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domain: ", p), err) }
   }
   return err
 }
 
-func (p *ListClosedWorkflowExecutionsResponse) writeField20(oprot thrift.TProtocol) (err error) {
-  if p.IsSetNextPageToken() {
-    if err := oprot.WriteFieldBegin("nextPageToken", thrift.STRING, 20); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:nextPageToken: ", p), err) }
-    if err := oprot.WriteBinary(p.NextPageToken); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.nextPageToken (20) field write error: ", p), err) }
+func (p *StopBatchOperationRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBatchId() {
+    if err := oprot.WriteFieldBegin("batchId", thrift.STRING, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:batchId: ", p), err) }
+    if err := oprot.WriteString(string(*p.BatchId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.batchId (20) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:nextPageToken: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:batchId: ", p), err) }
   }
   return err
 }
 
-func (p *ListClosedWorkflowExecutionsResponse) String() string {
+func (p *StopBatchOperationRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetReason() {
+    if err := oprot.WriteFieldBegin("reason", thrift.STRING, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:reason: ", p), err) }
+    if err := oprot.WriteString(string(*p.Reason)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.reason (30) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:reason: ", p), err) }
+  }
+  return err
+}
+
+func (p *StopBatchOperationRequest) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetIdentity() {
+    if err := oprot.WriteFieldBegin("identity", thrift.STRING, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:identity: ", p), err) }
+    if err := oprot.WriteString(string(*p.Identity)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.identity (40) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:identity: ", p), err) }
+  }
+  return err
+}
+
+func (p *StopBatchOperationRequest) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("ListClosedWorkflowExecutionsResponse(%+v)", *p)
+  return fmt.Sprintf("StopBatchOperationRequest(%+v)", *p)
 }
-
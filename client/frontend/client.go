@@ -100,6 +100,13 @@ func (c *clientImpl) GetWorkflowExecutionHistory(
 	return c.client.GetWorkflowExecutionHistory(ctx, request)
 }
 
+func (c *clientImpl) GetWorkflowResult(
+	getRequest *workflow.GetWorkflowResultRequest) (*workflow.GetWorkflowResultResponse, error) {
+	ctx, cancel := c.createContext()
+	defer cancel()
+	return c.client.GetWorkflowResult(ctx, getRequest)
+}
+
 func (c *clientImpl) PollForActivityTask(pollRequest *workflow.PollForActivityTaskRequest) (*workflow.PollForActivityTaskResponse, error) {
 	ctx, cancel := c.createContext()
 	defer cancel()
@@ -118,7 +125,7 @@ func (c *clientImpl) RecordActivityTaskHeartbeat(heartbeatRequest *workflow.Reco
 	return c.client.RecordActivityTaskHeartbeat(ctx, heartbeatRequest)
 }
 
-func (c *clientImpl) RespondDecisionTaskCompleted(request *workflow.RespondDecisionTaskCompletedRequest) error {
+func (c *clientImpl) RespondDecisionTaskCompleted(request *workflow.RespondDecisionTaskCompletedRequest) (*workflow.RespondDecisionTaskCompletedResponse, error) {
 	ctx, cancel := c.createContext()
 	defer cancel()
 	return c.client.RespondDecisionTaskCompleted(ctx, request)
@@ -154,12 +161,38 @@ func (c *clientImpl) SignalWorkflowExecution(request *workflow.SignalWorkflowExe
 	return c.client.SignalWorkflowExecution(ctx, request)
 }
 
+func (c *clientImpl) SignalWithStartWorkflowExecution(
+	request *workflow.SignalWithStartWorkflowExecutionRequest) (*workflow.StartWorkflowExecutionResponse, error) {
+	ctx, cancel := c.createContext()
+	defer cancel()
+	return c.client.SignalWithStartWorkflowExecution(ctx, request)
+}
+
 func (c *clientImpl) TerminateWorkflowExecution(request *workflow.TerminateWorkflowExecutionRequest) error {
 	ctx, cancel := c.createContext()
 	defer cancel()
 	return c.client.TerminateWorkflowExecution(ctx, request)
 }
 
+func (c *clientImpl) ResetWorkflowExecution(
+	request *workflow.ResetWorkflowExecutionRequest) (*workflow.ResetWorkflowExecutionResponse, error) {
+	ctx, cancel := c.createContext()
+	defer cancel()
+	return c.client.ResetWorkflowExecution(ctx, request)
+}
+
+func (c *clientImpl) QueryWorkflow(queryRequest *workflow.QueryWorkflowRequest) (*workflow.QueryWorkflowResponse, error) {
+	ctx, cancel := c.createContext()
+	defer cancel()
+	return c.client.QueryWorkflow(ctx, queryRequest)
+}
+
+func (c *clientImpl) RespondQueryTaskCompleted(request *workflow.RespondQueryTaskCompletedRequest) error {
+	ctx, cancel := c.createContext()
+	defer cancel()
+	return c.client.RespondQueryTaskCompleted(ctx, request)
+}
+
 func (c *clientImpl) ListOpenWorkflowExecutions(
 	listRequest *workflow.ListOpenWorkflowExecutionsRequest) (*workflow.ListOpenWorkflowExecutionsResponse, error) {
 	ctx, cancel := c.createContext()
@@ -173,3 +206,66 @@ func (c *clientImpl) ListClosedWorkflowExecutions(
 	defer cancel()
 	return c.client.ListClosedWorkflowExecutions(ctx, listRequest)
 }
+
+func (c *clientImpl) ScanWorkflowExecutions(
+	listRequest *workflow.ScanWorkflowExecutionsRequest) (*workflow.ScanWorkflowExecutionsResponse, error) {
+	ctx, cancel := c.createContext()
+	defer cancel()
+	return c.client.ScanWorkflowExecutions(ctx, listRequest)
+}
+
+func (c *clientImpl) ListWorkflowExecutionsWithQuery(
+	listRequest *workflow.ListWorkflowExecutionsWithQueryRequest) (*workflow.ListWorkflowExecutionsWithQueryResponse,
+	error) {
+	ctx, cancel := c.createContext()
+	defer cancel()
+	return c.client.ListWorkflowExecutionsWithQuery(ctx, listRequest)
+}
+
+func (c *clientImpl) CountOpenWorkflowExecutions(
+	countRequest *workflow.CountOpenWorkflowExecutionsRequest) (*workflow.CountOpenWorkflowExecutionsResponse, error) {
+	ctx, cancel := c.createContext()
+	defer cancel()
+	return c.client.CountOpenWorkflowExecutions(ctx, countRequest)
+}
+
+func (c *clientImpl) CountClosedWorkflowExecutions(
+	countRequest *workflow.CountClosedWorkflowExecutionsRequest) (*workflow.CountClosedWorkflowExecutionsResponse, error) {
+	ctx, cancel := c.createContext()
+	defer cancel()
+	return c.client.CountClosedWorkflowExecutions(ctx, countRequest)
+}
+
+func (c *clientImpl) GetClusterInfo(
+	getRequest *workflow.GetClusterInfoRequest) (*workflow.GetClusterInfoResponse, error) {
+	ctx, cancel := c.createContext()
+	defer cancel()
+	return c.client.GetClusterInfo(ctx, getRequest)
+}
+
+func (c *clientImpl) GetDomainReplicationMessages(
+	getRequest *workflow.GetDomainReplicationMessagesRequest) (*workflow.GetDomainReplicationMessagesResponse, error) {
+	ctx, cancel := c.createContext()
+	defer cancel()
+	return c.client.GetDomainReplicationMessages(ctx, getRequest)
+}
+
+func (c *clientImpl) StartBatchOperation(
+	startRequest *workflow.StartBatchOperationRequest) (*workflow.StartBatchOperationResponse, error) {
+	ctx, cancel := c.createContext()
+	defer cancel()
+	return c.client.StartBatchOperation(ctx, startRequest)
+}
+
+func (c *clientImpl) DescribeBatchOperation(
+	describeRequest *workflow.DescribeBatchOperationRequest) (*workflow.DescribeBatchOperationResponse, error) {
+	ctx, cancel := c.createContext()
+	defer cancel()
+	return c.client.DescribeBatchOperation(ctx, describeRequest)
+}
+
+func (c *clientImpl) StopBatchOperation(stopRequest *workflow.StopBatchOperationRequest) error {
+	ctx, cancel := c.createContext()
+	defer cancel()
+	return c.client.StopBatchOperation(ctx, stopRequest)
+}
@@ -31,17 +31,31 @@ type Client interface {
 	UpdateDomain(updateRequest *shared.UpdateDomainRequest) (*shared.UpdateDomainResponse, error)
 	DeprecateDomain(deprecateRequest *shared.DeprecateDomainRequest) error
 	GetWorkflowExecutionHistory(getRequest *shared.GetWorkflowExecutionHistoryRequest) (*shared.GetWorkflowExecutionHistoryResponse, error)
+	GetWorkflowResult(getRequest *shared.GetWorkflowResultRequest) (*shared.GetWorkflowResultResponse, error)
 	PollForActivityTask(pollRequest *shared.PollForActivityTaskRequest) (*shared.PollForActivityTaskResponse, error)
 	PollForDecisionTask(pollRequest *shared.PollForDecisionTaskRequest) (*shared.PollForDecisionTaskResponse, error)
 	RecordActivityTaskHeartbeat(heartbeatRequest *shared.RecordActivityTaskHeartbeatRequest) (*shared.RecordActivityTaskHeartbeatResponse, error)
 	RespondActivityTaskCompleted(completeRequest *shared.RespondActivityTaskCompletedRequest) error
 	RespondActivityTaskFailed(failRequest *shared.RespondActivityTaskFailedRequest) error
 	RespondActivityTaskCanceled(cancelRequest *shared.RespondActivityTaskCanceledRequest) error
-	RespondDecisionTaskCompleted(completeRequest *shared.RespondDecisionTaskCompletedRequest) error
+	RespondDecisionTaskCompleted(completeRequest *shared.RespondDecisionTaskCompletedRequest) (*shared.RespondDecisionTaskCompletedResponse, error)
 	StartWorkflowExecution(startRequest *shared.StartWorkflowExecutionRequest) (*shared.StartWorkflowExecutionResponse, error)
 	RequestCancelWorkflowExecution(cancelRequest *shared.RequestCancelWorkflowExecutionRequest) error
 	SignalWorkflowExecution(request *shared.SignalWorkflowExecutionRequest) error
+	SignalWithStartWorkflowExecution(signalWithStartRequest *shared.SignalWithStartWorkflowExecutionRequest) (*shared.StartWorkflowExecutionResponse, error)
 	TerminateWorkflowExecution(terminateRequest *shared.TerminateWorkflowExecutionRequest) error
+	ResetWorkflowExecution(resetRequest *shared.ResetWorkflowExecutionRequest) (*shared.ResetWorkflowExecutionResponse, error)
+	QueryWorkflow(queryRequest *shared.QueryWorkflowRequest) (*shared.QueryWorkflowResponse, error)
+	RespondQueryTaskCompleted(completeRequest *shared.RespondQueryTaskCompletedRequest) error
 	ListOpenWorkflowExecutions(listRequest *shared.ListOpenWorkflowExecutionsRequest) (*shared.ListOpenWorkflowExecutionsResponse, error)
 	ListClosedWorkflowExecutions(listRequest *shared.ListClosedWorkflowExecutionsRequest) (*shared.ListClosedWorkflowExecutionsResponse, error)
+	ScanWorkflowExecutions(listRequest *shared.ScanWorkflowExecutionsRequest) (*shared.ScanWorkflowExecutionsResponse, error)
+	ListWorkflowExecutionsWithQuery(listRequest *shared.ListWorkflowExecutionsWithQueryRequest) (*shared.ListWorkflowExecutionsWithQueryResponse, error)
+	CountOpenWorkflowExecutions(countRequest *shared.CountOpenWorkflowExecutionsRequest) (*shared.CountOpenWorkflowExecutionsResponse, error)
+	CountClosedWorkflowExecutions(countRequest *shared.CountClosedWorkflowExecutionsRequest) (*shared.CountClosedWorkflowExecutionsResponse, error)
+	GetClusterInfo(getRequest *shared.GetClusterInfoRequest) (*shared.GetClusterInfoResponse, error)
+	GetDomainReplicationMessages(getRequest *shared.GetDomainReplicationMessagesRequest) (*shared.GetDomainReplicationMessagesResponse, error)
+	StartBatchOperation(startRequest *shared.StartBatchOperationRequest) (*shared.StartBatchOperationResponse, error)
+	DescribeBatchOperation(describeRequest *shared.DescribeBatchOperationRequest) (*shared.DescribeBatchOperationResponse, error)
+	StopBatchOperation(stopRequest *shared.StopBatchOperationRequest) error
 }
@@ -148,22 +148,28 @@ func (c *clientImpl) RecordActivityTaskStarted(context thrift.Context,
 }
 
 func (c *clientImpl) RespondDecisionTaskCompleted(context thrift.Context,
-	request *h.RespondDecisionTaskCompletedRequest) error {
+	request *h.RespondDecisionTaskCompletedRequest) (*workflow.RespondDecisionTaskCompletedResponse, error) {
 	taskToken, err := c.tokenSerializer.Deserialize(request.GetCompleteRequest().TaskToken)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	client, err := c.getHostForRequest(taskToken.WorkflowID)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	var response *workflow.RespondDecisionTaskCompletedResponse
 	op := func(context thrift.Context, client h.TChanHistoryService) error {
+		var err error
 		ctx, cancel := c.createContext(context)
 		defer cancel()
-		return client.RespondDecisionTaskCompleted(ctx, request)
+		response, err = client.RespondDecisionTaskCompleted(ctx, request)
+		return err
 	}
 	err = c.executeWithRedirect(context, client, op)
-	return err
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
 }
 
 func (c *clientImpl) RespondActivityTaskCompleted(context thrift.Context,
@@ -278,6 +284,27 @@ func (c *clientImpl) SignalWorkflowExecution(context thrift.Context,
 	return err
 }
 
+func (c *clientImpl) SignalWithStartWorkflowExecution(context thrift.Context,
+	request *h.SignalWithStartWorkflowExecutionRequest) (*workflow.StartWorkflowExecutionResponse, error) {
+	client, err := c.getHostForRequest(request.GetSignalWithStartRequest().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
+	var response *workflow.StartWorkflowExecutionResponse
+	op := func(context thrift.Context, client h.TChanHistoryService) error {
+		var err error
+		ctx, cancel := c.createContext(context)
+		defer cancel()
+		response, err = client.SignalWithStartWorkflowExecution(ctx, request)
+		return err
+	}
+	err = c.executeWithRedirect(context, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) TerminateWorkflowExecution(context thrift.Context,
 	request *h.TerminateWorkflowExecutionRequest) error {
 	client, err := c.getHostForRequest(request.GetTerminateRequest().GetWorkflowExecution().GetWorkflowId())
@@ -293,6 +320,27 @@ func (c *clientImpl) TerminateWorkflowExecution(context thrift.Context,
 	return err
 }
 
+func (c *clientImpl) ResetWorkflowExecution(context thrift.Context,
+	request *h.ResetWorkflowExecutionRequest) (*workflow.ResetWorkflowExecutionResponse, error) {
+	client, err := c.getHostForRequest(request.GetResetRequest().GetWorkflowExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
+	var response *workflow.ResetWorkflowExecutionResponse
+	op := func(context thrift.Context, client h.TChanHistoryService) error {
+		var err error
+		ctx, cancel := c.createContext(context)
+		defer cancel()
+		response, err = client.ResetWorkflowExecution(ctx, request)
+		return err
+	}
+	err = c.executeWithRedirect(context, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) ScheduleDecisionTask(context thrift.Context, request *h.ScheduleDecisionTaskRequest) error {
 	client, err := c.getHostForRequest(request.GetWorkflowExecution().GetWorkflowId())
 	if err != nil {
@@ -321,9 +369,111 @@ func (c *clientImpl) RecordChildExecutionCompleted(context thrift.Context, reque
 	return err
 }
 
+func (c *clientImpl) DescribeShard(context thrift.Context,
+	request *h.DescribeShardRequest) (*h.DescribeShardResponse, error) {
+	client, err := c.getHostForShard(int(request.GetShardId()))
+	if err != nil {
+		return nil, err
+	}
+	var response *h.DescribeShardResponse
+	op := func(context thrift.Context, client h.TChanHistoryService) error {
+		var err error
+		ctx, cancel := c.createContext(context)
+		defer cancel()
+		response, err = client.DescribeShard(ctx, request)
+		return err
+	}
+	err = c.executeWithRedirect(context, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) InvalidateMutableState(context thrift.Context, request *h.InvalidateMutableStateRequest) error {
+	var client h.TChanHistoryService
+	var err error
+	if request.IsSetExecution() {
+		client, err = c.getHostForRequest(request.GetExecution().GetWorkflowId())
+	} else {
+		client, err = c.getHostForShard(int(request.GetShardId()))
+	}
+	if err != nil {
+		return err
+	}
+	op := func(context thrift.Context, client h.TChanHistoryService) error {
+		ctx, cancel := c.createContext(context)
+		defer cancel()
+		return client.InvalidateMutableState(ctx, request)
+	}
+	err = c.executeWithRedirect(context, client, op)
+	return err
+}
+
+// SetTaskProcessingPaused is sent to the owner of the shard, or to every history host for a domain
+func (c *clientImpl) SetTaskProcessingPaused(context thrift.Context, request *h.SetTaskProcessingPausedRequest) error {
+	if request.IsSetShardId() {
+		client, err := c.getHostForShard(int(request.GetShardId()))
+		if err != nil {
+			return err
+		}
+		op := func(context thrift.Context, client h.TChanHistoryService) error {
+			ctx, cancel := c.createContext(context)
+			defer cancel()
+			return client.SetTaskProcessingPaused(ctx, request)
+		}
+		return c.executeWithRedirect(context, client, op)
+	}
+
+	// Every host owns at least one shard, so looking up all the shards finds all the hosts
+	hosts := make(map[string]struct{})
+	for shardID := 0; shardID < c.numberOfShards; shardID++ {
+		host, err := c.resolver.Lookup(string(shardID))
+		if err != nil {
+			return err
+		}
+		hosts[host.GetAddress()] = struct{}{}
+	}
+
+	for address := range hosts {
+		ctx, cancel := c.createContext(context)
+		err := c.getThriftClient(address).SetTaskProcessingPaused(ctx, request)
+		cancel()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *clientImpl) ListConcreteExecutions(context thrift.Context,
+	request *h.ListConcreteExecutionsRequest) (*h.ListConcreteExecutionsResponse, error) {
+	client, err := c.getHostForShard(int(request.GetShardId()))
+	if err != nil {
+		return nil, err
+	}
+	var response *h.ListConcreteExecutionsResponse
+	op := func(context thrift.Context, client h.TChanHistoryService) error {
+		var err error
+		ctx, cancel := c.createContext(context)
+		defer cancel()
+		response, err = client.ListConcreteExecutions(ctx, request)
+		return err
+	}
+	err = c.executeWithRedirect(context, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) getHostForRequest(workflowID string) (h.TChanHistoryService, error) {
 	key := common.WorkflowIDToHistoryShard(workflowID, c.numberOfShards)
-	host, err := c.resolver.Lookup(string(key))
+	return c.getHostForShard(key)
+}
+
+func (c *clientImpl) getHostForShard(shardID int) (h.TChanHistoryService, error) {
+	host, err := c.resolver.Lookup(string(shardID))
 	if err != nil {
 		return nil, err
 	}
@@ -339,6 +489,10 @@ func (c *clientImpl) createContext(parent thrift.Context) (thrift.Context, conte
 	}
 	builder := tchannel.NewContextBuilder(timeout)
 	builder.SetParentContext(parent)
+	// forward the priority hint of the caller so that the history hosts can throttle the request accordingly
+	if priority, ok := parent.Headers()[common.PriorityHeaderName]; ok {
+		builder.AddHeader(common.PriorityHeaderName, priority)
+	}
 	return builder.Build()
 }
 
@@ -103,18 +103,18 @@ func (c *metricClient) RecordActivityTaskStarted(context thrift.Context,
 }
 
 func (c *metricClient) RespondDecisionTaskCompleted(context thrift.Context,
-	request *h.RespondDecisionTaskCompletedRequest) error {
+	request *h.RespondDecisionTaskCompletedRequest) (*workflow.RespondDecisionTaskCompletedResponse, error) {
 	c.metricsClient.IncCounter(metrics.HistoryClientRespondDecisionTaskCompletedScope, metrics.CadenceRequests)
 
 	sw := c.metricsClient.StartTimer(metrics.HistoryClientRespondDecisionTaskCompletedScope, metrics.CadenceLatency)
-	err := c.client.RespondDecisionTaskCompleted(context, request)
+	resp, err := c.client.RespondDecisionTaskCompleted(context, request)
 	sw.Stop()
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.HistoryClientRespondDecisionTaskCompletedScope, metrics.CadenceFailures)
 	}
 
-	return err
+	return resp, err
 }
 
 func (c *metricClient) RespondActivityTaskCompleted(context thrift.Context,
@@ -207,6 +207,21 @@ func (c *metricClient) SignalWorkflowExecution(context thrift.Context,
 	return err
 }
 
+func (c *metricClient) SignalWithStartWorkflowExecution(context thrift.Context,
+	request *h.SignalWithStartWorkflowExecutionRequest) (*workflow.StartWorkflowExecutionResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientSignalWithStartWorkflowExecutionScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientSignalWithStartWorkflowExecutionScope, metrics.CadenceLatency)
+	resp, err := c.client.SignalWithStartWorkflowExecution(context, request)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientSignalWithStartWorkflowExecutionScope, metrics.CadenceFailures)
+	}
+
+	return resp, err
+}
+
 func (c *metricClient) TerminateWorkflowExecution(context thrift.Context,
 	request *h.TerminateWorkflowExecutionRequest) error {
 	c.metricsClient.IncCounter(metrics.HistoryClientTerminateWorkflowExecutionScope, metrics.CadenceRequests)
@@ -222,6 +237,21 @@ func (c *metricClient) TerminateWorkflowExecution(context thrift.Context,
 	return err
 }
 
+func (c *metricClient) ResetWorkflowExecution(context thrift.Context,
+	request *h.ResetWorkflowExecutionRequest) (*workflow.ResetWorkflowExecutionResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientResetWorkflowExecutionScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientResetWorkflowExecutionScope, metrics.CadenceLatency)
+	resp, err := c.client.ResetWorkflowExecution(context, request)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientResetWorkflowExecutionScope, metrics.CadenceFailures)
+	}
+
+	return resp, err
+}
+
 func (c *metricClient) ScheduleDecisionTask(context thrift.Context,
 	request *h.ScheduleDecisionTaskRequest) error {
 	c.metricsClient.IncCounter(metrics.HistoryClientScheduleDecisionTaskScope, metrics.CadenceRequests)
@@ -251,3 +281,63 @@ func (c *metricClient) RecordChildExecutionCompleted(context thrift.Context,
 
 	return err
 }
+
+func (c *metricClient) DescribeShard(context thrift.Context,
+	request *h.DescribeShardRequest) (*h.DescribeShardResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientDescribeShardScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientDescribeShardScope, metrics.CadenceLatency)
+	resp, err := c.client.DescribeShard(context, request)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientDescribeShardScope, metrics.CadenceFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) InvalidateMutableState(context thrift.Context,
+	request *h.InvalidateMutableStateRequest) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientInvalidateMutableStateScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientInvalidateMutableStateScope, metrics.CadenceLatency)
+	err := c.client.InvalidateMutableState(context, request)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientInvalidateMutableStateScope, metrics.CadenceFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) SetTaskProcessingPaused(context thrift.Context,
+	request *h.SetTaskProcessingPausedRequest) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientSetTaskProcessingPausedScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientSetTaskProcessingPausedScope, metrics.CadenceLatency)
+	err := c.client.SetTaskProcessingPaused(context, request)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientSetTaskProcessingPausedScope, metrics.CadenceFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) ListConcreteExecutions(context thrift.Context,
+	request *h.ListConcreteExecutionsRequest) (*h.ListConcreteExecutionsResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientListConcreteExecutionsScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientListConcreteExecutionsScope, metrics.CadenceLatency)
+	resp, err := c.client.ListConcreteExecutions(context, request)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientListConcreteExecutionsScope, metrics.CadenceFailures)
+	}
+
+	return resp, err
+}
@@ -99,3 +99,48 @@ func (c *metricClient) PollForDecisionTask(context thrift.Context,
 
 	return resp, err
 }
+
+func (c *metricClient) DrainTaskList(context thrift.Context,
+	drainRequest *m.DrainTaskListRequest) (*m.DrainTaskListResponse, error) {
+	c.metricsClient.IncCounter(metrics.MatchingClientDrainTaskListScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientDrainTaskListScope, metrics.CadenceLatency)
+	resp, err := c.client.DrainTaskList(context, drainRequest)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientDrainTaskListScope, metrics.CadenceFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) QueryWorkflow(context thrift.Context,
+	queryRequest *m.QueryWorkflowRequest) (*workflow.QueryWorkflowResponse, error) {
+	c.metricsClient.IncCounter(metrics.MatchingClientQueryWorkflowScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientQueryWorkflowScope, metrics.CadenceLatency)
+	resp, err := c.client.QueryWorkflow(context, queryRequest)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientQueryWorkflowScope, metrics.CadenceFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) RespondQueryTaskCompleted(context thrift.Context,
+	request *m.RespondQueryTaskCompletedRequest) error {
+	c.metricsClient.IncCounter(metrics.MatchingClientRespondQueryTaskCompletedScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientRespondQueryTaskCompletedScope, metrics.CadenceLatency)
+	err := c.client.RespondQueryTaskCompleted(context, request)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientRespondQueryTaskCompletedScope, metrics.CadenceFailures)
+	}
+
+	return err
+}
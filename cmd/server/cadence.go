@@ -21,7 +21,10 @@
 package main
 
 import (
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/bootstrap"
 	"github.com/uber/cadence/common/service/config"
+	"github.com/uber/cadence/tools/cassandra"
 	"github.com/urfave/cli"
 	"log"
 	"os"
@@ -31,6 +34,13 @@ import (
 // validServices is the list of all valid cadence services
 var validServices = []string{historyService, matchingService, frontendService}
 
+const (
+	// devDataSourceName is the in-memory SQLite database used by the development server
+	devDataSourceName = "file:cadence?mode=memory&cache=shared"
+	// devSchemaFile is the schema of the development server database, relative to the root directory
+	devSchemaFile = "schema/sqlite/cadence/schema.sql"
+)
+
 // main entry point for the cadence server
 func main() {
 	app := buildCLI()
@@ -45,21 +55,54 @@ func startHandler(c *cli.Context) {
 
 	log.Printf("Loading config; env=%v,zone=%v,configDir=%v\n", env, zone, configDir)
 
-	var cfg config.Config
-	config.Load(env, configDir, zone, &cfg)
+	cfg, err := bootstrap.LoadConfig(env, configDir, zone)
+	if err != nil {
+		log.Fatalf("failed to load config: %v", err)
+	}
+	if c.GlobalBool("dev") {
+		devStore := setupDevPersistence(cfg, getRootDir(c))
+		defer devStore.Close()
+	}
+
 	log.Printf("config=\n%v\n", cfg.String())
 
+	if !cfg.Persistence.IsSQL() {
+		verifyVisibility := !cfg.Persistence.IsElasticsearchVisibility()
+		if err := cassandra.VerifyCompatibleVersion(cfg.Cassandra, verifyVisibility); err != nil {
+			log.Fatalf("incompatible cassandra schema: %v", err)
+		}
+	}
+
 	for _, svc := range getServices(c) {
 		if _, ok := cfg.Services[svc]; !ok {
 			log.Fatalf("`%v` service missing config", svc)
 		}
-		server := newServer(svc, &cfg)
+		server := newServer(svc, cfg, func() (*config.Config, error) {
+			return bootstrap.LoadConfig(env, configDir, zone)
+		})
 		server.Start()
 	}
 
 	select {}
 }
 
+// setupDevPersistence switches the persistence to an in-memory SQLite database and creates its tables, so that all
+// services can run in a single process without any datastore.  The returned handle keeps the database alive.
+func setupDevPersistence(cfg *config.Config, rootDir string) persistence.Closeable {
+	cfg.Persistence = config.Persistence{
+		DataStore: config.DataStoreSQLite,
+		SQL: config.SQL{
+			DataSourceName: devDataSourceName,
+		},
+	}
+
+	devStore, err := persistence.LoadSQLSchema(config.DataStoreSQLite, devDataSourceName, path(rootDir, devSchemaFile))
+	if err != nil {
+		log.Fatalf("failed to create development database (is the server built with BUILD_TAGS=sqlite?): %v", err)
+	}
+	return devStore
+}
+
 func getEnvironment(c *cli.Context) string {
 	return strings.TrimSpace(c.GlobalString("env"))
 }
@@ -73,6 +116,9 @@ func getZone(c *cli.Context) string {
 func getServices(c *cli.Context) []string {
 
 	val := strings.TrimSpace(c.String("services"))
+	if len(val) == 0 {
+		return validServices
+	}
 	tokens := strings.Split(val, ",")
 
 	if len(tokens) == 0 {
@@ -149,6 +195,19 @@ func buildCLI() *cli.App {
 			Usage:  "availability zone",
 			EnvVar: config.EnvKeyAvailabilityZone,
 		},
+		cli.BoolFlag{
+			Name:  "dev",
+			Usage: "keep all data in an in-memory SQLite database instead of the configured datastore",
+		},
+	}
+
+	// `cadence --dev` starts all services for development
+	app.Action = func(c *cli.Context) {
+		if !c.GlobalBool("dev") {
+			cli.ShowAppHelp(c)
+			return
+		}
+		startHandler(c)
 	}
 
 	app.Commands = []cli.Command{
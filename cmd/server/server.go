@@ -22,7 +22,7 @@ package main
 
 import (
 	"github.com/uber/cadence/common"
-	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/bootstrap"
 	"github.com/uber/cadence/common/service/config"
 	"github.com/uber/cadence/service/frontend"
 	"github.com/uber/cadence/service/history"
@@ -33,10 +33,11 @@ import (
 
 type (
 	server struct {
-		name   string
-		cfg    *config.Config
-		doneC  chan struct{}
-		daemon common.Daemon
+		name       string
+		cfg        *config.Config
+		loadConfig func() (*config.Config, error)
+		doneC      chan struct{}
+		daemon     common.Daemon
 	}
 )
 
@@ -47,12 +48,14 @@ const (
 )
 
 // newServer returns a new instance of a daemon
-// that represents a cadence service
-func newServer(service string, cfg *config.Config) common.Daemon {
+// that represents a cadence service, loadConfig
+// reloads its config while it runs
+func newServer(service string, cfg *config.Config, loadConfig func() (*config.Config, error)) common.Daemon {
 	return &server{
-		cfg:   cfg,
-		name:  service,
-		doneC: make(chan struct{}),
+		cfg:        cfg,
+		name:       service,
+		loadConfig: loadConfig,
+		doneC:      make(chan struct{}),
 	}
 }
 
@@ -86,32 +89,21 @@ func (s *server) Stop() {
 // startService starts a service with the given name and config
 func (s *server) startService() common.Daemon {
 
-	var err error
-
-	params := service.BootstrapParams{}
-	params.Name = "cadence-" + s.name
-	params.Logger = s.cfg.Log.NewBarkLogger()
-	params.CassandraConfig = s.cfg.Cassandra
-
-	params.RingpopFactory, err = s.cfg.Ringpop.NewFactory()
+	params, err := bootstrap.NewParams(s.name, s.cfg)
 	if err != nil {
-		log.Fatalf("error creating ringpop factory: %v", err)
+		log.Fatalf("%v", err)
 	}
-
-	svcCfg := s.cfg.Services[s.name]
-
-	params.MetricScope = svcCfg.Metrics.NewScope()
-	params.TChannelFactory = svcCfg.TChannel.NewFactory()
+	params.ReloadConfig = bootstrap.NewConfigReloader(s.name, s.loadConfig)
 
 	var daemon common.Daemon
 
 	switch s.name {
 	case frontendService:
-		daemon = frontend.NewService(&params)
+		daemon = frontend.NewService(params)
 	case historyService:
-		daemon = history.NewService(&params)
+		daemon = history.NewService(params)
 	case matchingService:
-		daemon = matching.NewService(&params)
+		daemon = matching.NewService(params)
 	}
 
 	go execute(daemon, s.doneC)
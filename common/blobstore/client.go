@@ -0,0 +1,61 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package blobstore
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+type (
+	// Client stores blobs by key in an external blob store, e.g. to keep large workflow payloads out of the
+	// persistence datastore.  Keys are slash separated paths, so that the blobs of a workflow execution can be
+	// deleted together by their common prefix.
+	Client interface {
+		Upload(key string, blob []byte) error
+		// Download returns ErrBlobNotFound if no blob is stored with the key
+		Download(key string) ([]byte, error)
+		// Delete does not fail if no blob is stored with the key
+		Delete(key string) error
+		// DeleteAll deletes every blob whose key starts with the prefix followed by a slash
+		DeleteAll(prefix string) error
+	}
+)
+
+var (
+	// ErrBlobNotFound is returned when downloading a blob which does not exist
+	ErrBlobNotFound = errors.New("blob not found")
+)
+
+// validateKey rejects the keys which are not relative slash separated paths of non empty segments, they would
+// escape the directory of a filesystem store or collide with other keys
+func validateKey(key string) error {
+	if key == "" {
+		return errors.New("blob key is empty")
+	}
+	for _, segment := range strings.Split(key, "/") {
+		if segment == "" || segment == "." || segment == ".." || strings.ContainsRune(segment, '\\') {
+			return fmt.Errorf("invalid blob key %v", key)
+		}
+	}
+	return nil
+}
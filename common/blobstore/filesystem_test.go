@@ -0,0 +1,105 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package blobstore
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	filesystemSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+		dir    string
+		client Client
+	}
+)
+
+func TestFilesystemSuite(t *testing.T) {
+	s := new(filesystemSuite)
+	suite.Run(t, s)
+}
+
+func (s *filesystemSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	var err error
+	s.dir, err = ioutil.TempDir("", "blobstore")
+	s.Nil(err)
+	s.client, err = NewFilesystemClient(s.dir)
+	s.Nil(err)
+}
+
+func (s *filesystemSuite) TearDownTest() {
+	os.RemoveAll(s.dir)
+}
+
+func (s *filesystemSuite) TestUploadDownload() {
+	err := s.client.Upload("domain/run/blob", []byte("payload"))
+	s.Nil(err)
+
+	blob, err := s.client.Download("domain/run/blob")
+	s.Nil(err)
+	s.Equal([]byte("payload"), blob)
+
+	err = s.client.Upload("domain/run/blob", []byte("overwritten"))
+	s.Nil(err)
+	blob, err = s.client.Download("domain/run/blob")
+	s.Nil(err)
+	s.Equal([]byte("overwritten"), blob)
+}
+
+func (s *filesystemSuite) TestDownloadNotFound() {
+	_, err := s.client.Download("domain/run/missing")
+	s.Equal(ErrBlobNotFound, err)
+}
+
+func (s *filesystemSuite) TestDelete() {
+	s.Nil(s.client.Upload("domain/run1/blob1", []byte("1")))
+	s.Nil(s.client.Upload("domain/run1/blob2", []byte("2")))
+	s.Nil(s.client.Upload("domain/run2/blob1", []byte("3")))
+
+	s.Nil(s.client.Delete("domain/run1/blob1"))
+	s.Nil(s.client.Delete("domain/run1/blob1"))
+	_, err := s.client.Download("domain/run1/blob1")
+	s.Equal(ErrBlobNotFound, err)
+
+	s.Nil(s.client.DeleteAll("domain/run1"))
+	_, err = s.client.Download("domain/run1/blob2")
+	s.Equal(ErrBlobNotFound, err)
+
+	blob, err := s.client.Download("domain/run2/blob1")
+	s.Nil(err)
+	s.Equal([]byte("3"), blob)
+}
+
+func (s *filesystemSuite) TestInvalidKey() {
+	for _, key := range []string{"", "/abs", "a//b", "../escape", "a/./b", "a\\b"} {
+		s.NotNil(s.client.Upload(key, []byte("payload")), key)
+	}
+}
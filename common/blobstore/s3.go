@@ -0,0 +1,144 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package blobstore
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+type (
+	s3Client struct {
+		client *s3.S3
+		bucket string
+		prefix string
+	}
+)
+
+// maxS3DeleteObjects is the maximum number of keys of a single DeleteObjects request
+const maxS3DeleteObjects = 1000
+
+var _ Client = (*s3Client)(nil)
+
+// NewS3Client returns a client storing the blobs in an S3 bucket, under the prefix followed by their key.  The
+// credentials are taken from the environment, as described by the AWS SDK.  The endpoint is only set for S3
+// compatible stores, otherwise it is derived from the region.
+func NewS3Client(bucket string, region string, endpoint string, prefix string) (Client, error) {
+	cfg := aws.NewConfig().WithRegion(region)
+	if endpoint != "" {
+		cfg = cfg.WithEndpoint(endpoint).WithS3ForcePathStyle(true)
+	}
+	sess, err := session.NewSession(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	return &s3Client{
+		client: s3.New(sess),
+		bucket: bucket,
+		prefix: prefix,
+	}, nil
+}
+
+func (c *s3Client) Upload(key string, blob []byte) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+	_, err := c.client.PutObject(&s3.PutObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(c.prefix + key),
+		Body:   bytes.NewReader(blob),
+	})
+	return err
+}
+
+func (c *s3Client) Download(key string) ([]byte, error) {
+	if err := validateKey(key); err != nil {
+		return nil, err
+	}
+	output, err := c.client.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(c.prefix + key),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchKey {
+			return nil, ErrBlobNotFound
+		}
+		return nil, err
+	}
+	defer output.Body.Close()
+	return ioutil.ReadAll(output.Body)
+}
+
+func (c *s3Client) Delete(key string) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+	_, err := c.client.DeleteObject(&s3.DeleteObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(c.prefix + key),
+	})
+	return err
+}
+
+func (c *s3Client) DeleteAll(prefix string) error {
+	if err := validateKey(prefix); err != nil {
+		return err
+	}
+
+	var objects []*s3.ObjectIdentifier
+	err := c.client.ListObjectsV2Pages(&s3.ListObjectsV2Input{
+		Bucket: aws.String(c.bucket),
+		Prefix: aws.String(c.prefix + prefix + "/"),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			objects = append(objects, &s3.ObjectIdentifier{Key: object.Key})
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	for len(objects) > 0 {
+		n := len(objects)
+		if n > maxS3DeleteObjects {
+			n = maxS3DeleteObjects
+		}
+		_, err := c.client.DeleteObjects(&s3.DeleteObjectsInput{
+			Bucket: aws.String(c.bucket),
+			Delete: &s3.Delete{Objects: objects[:n], Quiet: aws.Bool(true)},
+		})
+		if err != nil {
+			return err
+		}
+		objects = objects[n:]
+	}
+	return nil
+}
@@ -46,6 +46,10 @@ type Cache interface {
 
 	// Size returns the number of entries currently stored in the Cache
 	Size() int
+
+	// Trim evicts the least recently used elements which are not pinned until
+	// the cache holds at most size elements, returning the number evicted
+	Trim(size int) int
 }
 
 // Options control the behavior of the cache
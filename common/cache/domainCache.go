@@ -22,9 +22,11 @@ package cache
 
 import (
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 
 	"github.com/uber-common/bark"
@@ -35,6 +37,11 @@ const (
 	domainCacheMaxSize         = 16 * 1024
 	domainCacheTTL             = time.Hour
 	domainEntryRefreshInterval = int64(10 * time.Second)
+	// domainEntryWatchedRefreshInterval is the expiry of the entries while the domain changes are watched, which keep
+	// the entries up to date
+	domainEntryWatchedRefreshInterval = int64(5 * time.Minute)
+	domainChangesRefreshInterval      = 10 * time.Second
+	domainChangesPageSize             = 100
 )
 
 type (
@@ -42,43 +49,175 @@ type (
 	// This cache is mainly used by frontend for resolving domain names to domain uuids which are used throughout the
 	// system.  Each domain entry is kept in the cache for one hour but also has an expiry of 10 seconds.  This results
 	// in updating the domain entry every 10 seconds but in the case of a cassandra failure we can still keep on serving
-	// requests using the stale entry from cache upto an hour
+	// requests using the stale entry from cache upto an hour.  Once started, the cache also watches the domain changes
+	// and applies them to the cached entries, so a domain update takes effect within 10 seconds while the entries are
+	// only read again from the metadata store every 5 minutes.
 	DomainCache interface {
+		common.Daemon
 		GetDomain(name string) (*persistence.DomainInfo, *persistence.DomainConfig, error)
 		GetDomainByID(id string) (*persistence.DomainInfo, *persistence.DomainConfig, error)
 	}
 
 	domainCache struct {
-		cacheByName Cache
-		cacheByID   Cache
-		metadataMgr persistence.MetadataManager
-		timeSource  common.TimeSource
-		logger      bark.Logger
+		cacheByName   Cache
+		cacheByID     Cache
+		metadataMgr   persistence.MetadataManager
+		timeSource    common.TimeSource
+		logger        bark.Logger
+		metricsClient metrics.Client
+		status        int32
+		shutdownCh    chan struct{}
+		shutdownWG    sync.WaitGroup
+
+		// the notification version of the last domain change read, only used by the refresh loop
+		lastNotificationVersion int64
+		// caughtUp is set once the cache reads the domain changes from the current notification version on
+		caughtUp int32
 	}
 
 	domainCacheEntry struct {
 		info   *persistence.DomainInfo
 		config *persistence.DomainConfig
 		expiry int64
+		// notificationVersion is the notification version of the last change of the domain applied to the entry
+		notificationVersion int64
+		// refreshTime is when the entry was last read from the metadata store or updated by a domain change
+		refreshTime int64
 		sync.RWMutex
 	}
 )
 
 // NewDomainCache creates a new instance of cache for holding onto domain information to reduce the load on persistence
-func NewDomainCache(metadataMgr persistence.MetadataManager, logger bark.Logger) DomainCache {
+func NewDomainCache(metadataMgr persistence.MetadataManager, logger bark.Logger,
+	metricsClient metrics.Client) DomainCache {
 	opts := &Options{}
 	opts.InitialCapacity = domainCacheInitialSize
 	opts.TTL = domainCacheTTL
 
 	return &domainCache{
-		cacheByName: New(domainCacheMaxSize, opts),
-		cacheByID:   New(domainCacheMaxSize, opts),
-		metadataMgr: metadataMgr,
-		timeSource:  common.NewRealTimeSource(),
-		logger:      logger,
+		cacheByName:   New(domainCacheMaxSize, opts),
+		cacheByID:     New(domainCacheMaxSize, opts),
+		metadataMgr:   metadataMgr,
+		timeSource:    common.NewRealTimeSource(),
+		logger:        logger,
+		metricsClient: metricsClient,
+		shutdownCh:    make(chan struct{}),
+	}
+}
+
+// Start starts watching the domain changes
+func (c *domainCache) Start() {
+	if !atomic.CompareAndSwapInt32(&c.status, 0, 1) {
+		return
+	}
+
+	c.shutdownWG.Add(1)
+	go c.refreshLoop()
+}
+
+// Stop stops watching the domain changes, the entries are then refreshed lazily again
+func (c *domainCache) Stop() {
+	if !atomic.CompareAndSwapInt32(&c.status, 1, 2) {
+		return
+	}
+
+	close(c.shutdownCh)
+	c.shutdownWG.Wait()
+}
+
+func (c *domainCache) refreshLoop() {
+	defer c.shutdownWG.Done()
+
+	c.refreshDomainChangesAndLog()
+
+	ticker := time.NewTicker(domainChangesRefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.shutdownCh:
+			return
+		case <-ticker.C:
+			c.refreshDomainChangesAndLog()
+		}
+	}
+}
+
+func (c *domainCache) refreshDomainChangesAndLog() {
+	if err := c.refreshDomainChanges(); err != nil {
+		c.metricsClient.IncCounter(metrics.DomainCacheScope, metrics.DomainCacheRefreshFailures)
+		c.logger.Warnf("Failed to read domain changes: %v", err)
+	}
+}
+
+// refreshDomainChanges reads the domain changes made since the last one read and applies them to the cached entries.
+// The changes made before the cache started watching are skipped, the entries read since then are up to date.
+func (c *domainCache) refreshDomainChanges() error {
+	if atomic.LoadInt32(&c.caughtUp) == 0 {
+		response, err := c.metadataMgr.GetMetadata()
+		if err != nil {
+			return err
+		}
+		c.lastNotificationVersion = response.NotificationVersion
+		atomic.StoreInt32(&c.caughtUp, 1)
+	}
+
+	for {
+		response, err := c.metadataMgr.GetDomainChanges(&persistence.GetDomainChangesRequest{
+			LastNotificationVersion: c.lastNotificationVersion,
+			PageSize:                domainChangesPageSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, change := range response.Changes {
+			c.applyDomainChange(change)
+			c.lastNotificationVersion = change.NotificationVersion
+		}
+
+		if len(response.Changes) < domainChangesPageSize {
+			return nil
+		}
+	}
+}
+
+// applyDomainChange updates the cached entries of the changed domain, if any, unless they were read after the change
+func (c *domainCache) applyDomainChange(change *persistence.DomainChange) {
+	now := c.timeSource.Now().UnixNano()
+	expiry := now + c.entryRefreshInterval()
+	for _, item := range []struct {
+		key   string
+		cache Cache
+	}{
+		{change.Info.Name, c.cacheByName},
+		{change.Info.ID, c.cacheByID},
+	} {
+		entry, ok := item.cache.Get(item.key).(*domainCacheEntry)
+		if !ok {
+			continue
+		}
+
+		entry.Lock()
+		if change.NotificationVersion > entry.notificationVersion {
+			entry.info = change.Info
+			entry.config = change.Config
+			entry.notificationVersion = change.NotificationVersion
+			entry.expiry = expiry
+			entry.refreshTime = now
+		}
+		entry.Unlock()
 	}
 }
 
+// entryRefreshInterval returns the expiry of the entries read from the metadata store, which is longer once every
+// later domain change is applied to the entries
+func (c *domainCache) entryRefreshInterval() int64 {
+	if atomic.LoadInt32(&c.status) == 1 && atomic.LoadInt32(&c.caughtUp) == 1 {
+		return domainEntryWatchedRefreshInterval
+	}
+	return domainEntryRefreshInterval
+}
+
 func newDomainCacheEntry() *domainCacheEntry {
 	return &domainCacheEntry{}
 }
@@ -102,12 +241,14 @@ func (c *domainCache) getDomain(key, id, name string, cache Cache) (*persistence
 	refreshCache := false
 	var info *persistence.DomainInfo
 	var config *persistence.DomainConfig
+	var refreshTime int64
 	entry, cacheHit := cache.Get(key).(*domainCacheEntry)
 	if cacheHit {
 		// Found the information in the cache, lets check if it needs to be refreshed before returning back
 		entry.RLock()
 		info = entry.info
 		config = entry.config
+		refreshTime = entry.refreshTime
 
 		if entry.expiry == 0 || now >= entry.expiry {
 			refreshCache = true
@@ -117,6 +258,7 @@ func (c *domainCache) getDomain(key, id, name string, cache Cache) (*persistence
 
 	// Found a cache entry and no need to refresh.  Return immediately
 	if cacheHit && !refreshCache {
+		c.updateEntryAge(now, refreshTime)
 		return info, config, nil
 	}
 
@@ -139,7 +281,9 @@ func (c *domainCache) getDomain(key, id, name string, cache Cache) (*persistence
 
 		// Failed to get domain.  Return stale entry if we have one, otherwise just return error
 		if err != nil {
+			c.metricsClient.IncCounter(metrics.DomainCacheScope, metrics.DomainCacheRefreshFailures)
 			if entry.expiry > 0 {
+				c.updateEntryAge(now, entry.refreshTime)
 				return entry.info, entry.config, nil
 			}
 
@@ -148,8 +292,16 @@ func (c *domainCache) getDomain(key, id, name string, cache Cache) (*persistence
 
 		entry.info = response.Info
 		entry.config = response.Config
-		entry.expiry = now + domainEntryRefreshInterval
+		entry.notificationVersion = response.NotificationVersion
+		entry.expiry = now + c.entryRefreshInterval()
+		entry.refreshTime = now
 	}
 
 	return entry.info, entry.config, nil
 }
+
+// updateEntryAge reports the time since the entry served was last refreshed
+func (c *domainCache) updateEntryAge(now int64, refreshTime int64) {
+	c.metricsClient.UpdateGauge(metrics.DomainCacheScope, metrics.DomainCacheEntryAgeGauge,
+		float64(time.Duration(now-refreshTime)/time.Millisecond))
+}
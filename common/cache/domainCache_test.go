@@ -0,0 +1,221 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cache
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-common/bark"
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// recordingMetricsClient records the counters and the last value of the gauges
+	recordingMetricsClient struct {
+		metrics.Client
+		counters map[int]int
+		gauges   map[int]float64
+	}
+
+	fakeTimeSource struct {
+		now time.Time
+	}
+)
+
+func (c *recordingMetricsClient) IncCounter(scope int, counter int) {
+	c.counters[counter]++
+}
+
+func (c *recordingMetricsClient) UpdateGauge(scope int, gauge int, value float64) {
+	c.gauges[gauge] = value
+}
+
+func (ts *fakeTimeSource) Now() time.Time {
+	return ts.now
+}
+
+func newTestDomainCache(metadataMgr *mocks.MetadataManager) *domainCache {
+	return NewDomainCache(metadataMgr, bark.NewLoggerFromLogrus(log.New()),
+		metrics.NewClient(tally.NoopScope, metrics.Frontend)).(*domainCache)
+}
+
+func TestDomainChangesPropagation(t *testing.T) {
+	metadataMgr := &mocks.MetadataManager{}
+	c := newTestDomainCache(metadataMgr)
+
+	info := &persistence.DomainInfo{ID: "domain-id", Name: "domain"}
+	metadataMgr.On("GetDomain", &persistence.GetDomainRequest{Name: "domain"}).Return(&persistence.GetDomainResponse{
+		Info:   info,
+		Config: &persistence.DomainConfig{Retention: 1},
+	}, nil).Once()
+
+	// The changes made before the cache started watching are skipped
+	metadataMgr.On("GetMetadata").Return(&persistence.GetMetadataResponse{NotificationVersion: 1}, nil).Once()
+	metadataMgr.On("GetDomainChanges", &persistence.GetDomainChangesRequest{
+		LastNotificationVersion: 1,
+		PageSize:                domainChangesPageSize,
+	}).Return(&persistence.GetDomainChangesResponse{}, nil).Once()
+	assert.NoError(t, c.refreshDomainChanges())
+
+	_, config, err := c.GetDomain("domain")
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), config.Retention)
+	assert.False(t, config.ArchivalEnabled)
+
+	// A later change is applied to the entries by name and by ID without reading the domain again
+	metadataMgr.On("GetDomainChanges", &persistence.GetDomainChangesRequest{
+		LastNotificationVersion: 1,
+		PageSize:                domainChangesPageSize,
+	}).Return(&persistence.GetDomainChangesResponse{Changes: []*persistence.DomainChange{
+		{NotificationVersion: 2, ChangeType: persistence.DomainChangeTypeUpdated, Info: info,
+			Config: &persistence.DomainConfig{Retention: 1, ArchivalEnabled: true}},
+	}}, nil).Once()
+	assert.NoError(t, c.refreshDomainChanges())
+	assert.Equal(t, int64(2), c.lastNotificationVersion)
+
+	_, config, err = c.GetDomain("domain")
+	assert.NoError(t, err)
+	assert.True(t, config.ArchivalEnabled)
+
+	// The entry by ID is read after the next change, which is only applied to the entry by name
+	byIDConfig := &persistence.DomainConfig{Retention: 3, ArchivalEnabled: true}
+	metadataMgr.On("GetDomain", &persistence.GetDomainRequest{ID: "domain-id"}).Return(
+		&persistence.GetDomainResponse{
+			Info:                info,
+			Config:              byIDConfig,
+			NotificationVersion: 3,
+		}, nil).Once()
+	_, config, err = c.GetDomainByID("domain-id")
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), config.Retention)
+
+	metadataMgr.On("GetDomainChanges", &persistence.GetDomainChangesRequest{
+		LastNotificationVersion: 2,
+		PageSize:                domainChangesPageSize,
+	}).Return(&persistence.GetDomainChangesResponse{Changes: []*persistence.DomainChange{
+		{NotificationVersion: 3, ChangeType: persistence.DomainChangeTypeUpdated, Info: info,
+			Config: &persistence.DomainConfig{Retention: 3, ArchivalEnabled: true}},
+	}}, nil).Once()
+	assert.NoError(t, c.refreshDomainChanges())
+
+	_, config, err = c.GetDomain("domain")
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), config.Retention)
+	_, config, err = c.GetDomainByID("domain-id")
+	assert.NoError(t, err)
+	assert.True(t, config == byIDConfig)
+
+	// Both entries get the changes made after they were read
+	metadataMgr.On("GetDomainChanges", &persistence.GetDomainChangesRequest{
+		LastNotificationVersion: 3,
+		PageSize:                domainChangesPageSize,
+	}).Return(&persistence.GetDomainChangesResponse{Changes: []*persistence.DomainChange{
+		{NotificationVersion: 4, ChangeType: persistence.DomainChangeTypeUpdated, Info: info,
+			Config: &persistence.DomainConfig{Retention: 3}},
+	}}, nil).Once()
+	assert.NoError(t, c.refreshDomainChanges())
+
+	_, config, err = c.GetDomain("domain")
+	assert.NoError(t, err)
+	assert.False(t, config.ArchivalEnabled)
+	_, config, err = c.GetDomainByID("domain-id")
+	assert.NoError(t, err)
+	assert.False(t, config.ArchivalEnabled)
+
+	metadataMgr.AssertExpectations(t)
+}
+
+func TestDomainChangesPaging(t *testing.T) {
+	metadataMgr := &mocks.MetadataManager{}
+	c := newTestDomainCache(metadataMgr)
+
+	page := make([]*persistence.DomainChange, domainChangesPageSize)
+	for i := range page {
+		page[i] = &persistence.DomainChange{
+			NotificationVersion: int64(i + 1),
+			Info:                &persistence.DomainInfo{ID: "domain-id", Name: "domain"},
+			Config:              &persistence.DomainConfig{},
+		}
+	}
+	metadataMgr.On("GetMetadata").Return(&persistence.GetMetadataResponse{}, nil).Once()
+	metadataMgr.On("GetDomainChanges", &persistence.GetDomainChangesRequest{
+		LastNotificationVersion: 0,
+		PageSize:                domainChangesPageSize,
+	}).Return(&persistence.GetDomainChangesResponse{Changes: page}, nil).Once()
+	metadataMgr.On("GetDomainChanges", &persistence.GetDomainChangesRequest{
+		LastNotificationVersion: domainChangesPageSize,
+		PageSize:                domainChangesPageSize,
+	}).Return(&persistence.GetDomainChangesResponse{}, nil).Once()
+
+	assert.Equal(t, domainEntryRefreshInterval, c.entryRefreshInterval())
+	c.status = 1
+	assert.Equal(t, domainEntryRefreshInterval, c.entryRefreshInterval())
+	assert.NoError(t, c.refreshDomainChanges())
+	assert.Equal(t, int64(domainChangesPageSize), c.lastNotificationVersion)
+	assert.Equal(t, domainEntryWatchedRefreshInterval, c.entryRefreshInterval())
+
+	metadataMgr.AssertExpectations(t)
+}
+
+func TestDomainCacheMetrics(t *testing.T) {
+	metadataMgr := &mocks.MetadataManager{}
+	c := newTestDomainCache(metadataMgr)
+	metricsClient := &recordingMetricsClient{counters: make(map[int]int), gauges: make(map[int]float64)}
+	c.metricsClient = metricsClient
+	timeSource := &fakeTimeSource{now: time.Now()}
+	c.timeSource = timeSource
+
+	metadataMgr.On("GetDomain", &persistence.GetDomainRequest{Name: "domain"}).Return(&persistence.GetDomainResponse{
+		Info:   &persistence.DomainInfo{ID: "domain-id", Name: "domain"},
+		Config: &persistence.DomainConfig{Retention: 1},
+	}, nil).Once()
+	_, _, err := c.GetDomain("domain")
+	assert.NoError(t, err)
+
+	timeSource.now = timeSource.now.Add(3 * time.Second)
+	_, _, err = c.GetDomain("domain")
+	assert.NoError(t, err)
+	assert.Equal(t, float64(3000), metricsClient.gauges[metrics.DomainCacheEntryAgeGauge])
+
+	// the stale entry is served when it cannot be refreshed
+	metadataMgr.On("GetDomain", &persistence.GetDomainRequest{Name: "domain"}).Return(nil,
+		errors.New("metadata store unavailable")).Once()
+	timeSource.now = timeSource.now.Add(time.Minute)
+	_, config, err := c.GetDomain("domain")
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), config.Retention)
+	assert.Equal(t, 1, metricsClient.counters[metrics.DomainCacheRefreshFailures])
+	assert.Equal(t, float64(63000), metricsClient.gauges[metrics.DomainCacheEntryAgeGauge])
+
+	metadataMgr.On("GetMetadata").Return(nil, errors.New("metadata store unavailable")).Once()
+	c.refreshDomainChangesAndLog()
+	assert.Equal(t, 2, metricsClient.counters[metrics.DomainCacheRefreshFailures])
+
+	metadataMgr.AssertExpectations(t)
+}
@@ -85,10 +85,7 @@ func (c *lru) Get(key string) interface{} {
 
 	cacheEntry := elt.Value.(*cacheEntry)
 
-	if c.pin {
-		cacheEntry.refCount++
-	}
-
+	// Expiry has to be checked before the entry is pinned, otherwise pinned caches never expire anything
 	if cacheEntry.refCount == 0 && !cacheEntry.expiration.IsZero() && time.Now().After(cacheEntry.expiration) {
 		// Entry has expired
 		if c.rmFunc != nil {
@@ -99,6 +96,10 @@ func (c *lru) Get(key string) interface{} {
 		return nil
 	}
 
+	if c.pin {
+		cacheEntry.refCount++
+	}
+
 	c.byAccess.MoveToFront(elt)
 	return cacheEntry.value
 }
@@ -160,6 +161,28 @@ func (c *lru) Size() int {
 	return len(c.byKey)
 }
 
+// Trim evicts the least recently used elements which are not pinned until the lru holds at most size elements
+func (c *lru) Trim(size int) int {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	evicted := 0
+	for elt := c.byAccess.Back(); elt != nil && len(c.byKey) > size; {
+		prev := elt.Prev()
+		entry := elt.Value.(*cacheEntry)
+		if entry.refCount == 0 {
+			c.byAccess.Remove(elt)
+			if c.rmFunc != nil {
+				go c.rmFunc(entry.value)
+			}
+			delete(c.byKey, entry.key)
+			evicted++
+		}
+		elt = prev
+	}
+	return evicted
+}
+
 // Put puts a new value associated with a given key, returning the existing value (if present)
 // allowUpdate flag is used to control overwrite behavior if the value exists
 func (c *lru) putInternal(key string, value interface{}, allowUpdate bool) (interface{}, error) {
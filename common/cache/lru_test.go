@@ -73,6 +73,50 @@ func TestLRUWithTTL(t *testing.T) {
 	assert.Equal(t, 0, cache.Size())
 }
 
+func TestLRUWithTTLPinned(t *testing.T) {
+	cache := New(5, &Options{
+		TTL: time.Millisecond * 100,
+		Pin: true,
+	})
+	_, err := cache.PutIfNotExist("A", "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, "foo", cache.Get("A"))
+	time.Sleep(time.Millisecond * 300)
+
+	// Entry is still pinned by two callers, so it must not expire
+	assert.Equal(t, "foo", cache.Get("A"))
+	cache.Release("A")
+	cache.Release("A")
+	cache.Release("A")
+
+	assert.Nil(t, cache.Get("A"))
+	assert.Equal(t, 0, cache.Size())
+}
+
+func TestTrim(t *testing.T) {
+	cache := New(10, &Options{Pin: true})
+	for _, key := range []string{"A", "B", "C", "D", "E"} {
+		_, err := cache.PutIfNotExist(key, key)
+		assert.NoError(t, err)
+		if key != "B" {
+			cache.Release(key)
+		}
+	}
+
+	// B is the least recently used after A but still pinned
+	assert.Equal(t, 2, cache.Trim(3))
+	assert.Equal(t, 3, cache.Size())
+	assert.Nil(t, cache.Get("A"))
+	assert.Nil(t, cache.Get("C"))
+	assert.Equal(t, "B", cache.Get("B"))
+	cache.Release("B")
+	cache.Release("B")
+
+	assert.Equal(t, 3, cache.Trim(0))
+	assert.Equal(t, 0, cache.Size())
+	assert.Equal(t, 0, cache.Trim(0))
+}
+
 func TestLRUCacheConcurrentAccess(t *testing.T) {
 	cache := NewLRU(5)
 	values := map[string]string{
@@ -27,6 +27,12 @@ const (
 	EmptyEventID int64 = -23
 )
 
+const (
+	// ExecutionUpdateSizeLimit is the largest size in bytes of the mutable state and history written by an update of
+	// an execution, larger updates are rejected.  It bounds the size of the payloads accepted by the server.
+	ExecutionUpdateSizeLimit = 4 * 1024 * 1024
+)
+
 const (
 	// FrontendServiceName is the name of the frontend service
 	FrontendServiceName = "cadence-frontend"
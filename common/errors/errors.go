@@ -0,0 +1,206 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package errors defines stable codes for the typed errors returned by the cadence services and the persistence
+// layer, and the helpers to classify them and translate them to the errors sent over thrift.
+package errors
+
+import (
+	"fmt"
+
+	h "github.com/uber/cadence/.gen/go/history"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+)
+
+// Code is the stable code of a type of error.  The values are part of the contract with the operators reading logs
+// and dashboards, so existing codes must never be renumbered.
+type Code int
+
+// Error codes
+const (
+	// CodeInternal is the code of the internal errors and of all the errors without a more specific code
+	CodeInternal Code = 0
+	// CodeBadRequest is the code of the errors caused by an invalid request
+	CodeBadRequest Code = 1
+	// CodeEntityNotExists is the code of the errors reporting a missing domain, workflow or task
+	CodeEntityNotExists Code = 2
+	// CodeWorkflowExecutionAlreadyStarted is the code of the errors reporting a running workflow with the same ID
+	CodeWorkflowExecutionAlreadyStarted Code = 3
+	// CodeDomainAlreadyExists is the code of the errors reporting a domain with the same name
+	CodeDomainAlreadyExists Code = 4
+	// CodeShardOwnershipLost is the code of the errors reporting a history shard owned by another host
+	CodeShardOwnershipLost Code = 5
+	// CodeServiceBusy is the code of the errors reporting an overloaded service or persistence
+	CodeServiceBusy Code = 6
+	// CodeDomainNotActive is the code of the errors reporting a request to a domain which is not active
+	CodeDomainNotActive Code = 7
+	// CodeLimitExceeded is the code of the errors reporting a request exceeding a configured limit
+	CodeLimitExceeded Code = 8
+	// CodeDataCorruption is the code of the errors reporting persisted data which cannot be decoded
+	CodeDataCorruption Code = 9
+	// CodeEventAlreadyStarted is the code of the errors reporting a task which was already started
+	CodeEventAlreadyStarted Code = 10
+	// CodeQueryFailed is the code of the errors reporting a query which the workflow worker failed to answer
+	CodeQueryFailed Code = 11
+)
+
+type (
+	// DomainNotActiveError is returned for a request to a domain which is not active on this cluster
+	DomainNotActiveError struct {
+		Message string
+	}
+
+	// LimitExceededError is returned for a request exceeding a configured limit
+	LimitExceededError struct {
+		Message string
+	}
+)
+
+var codeNames = map[Code]string{
+	CodeInternal:                        "internal",
+	CodeBadRequest:                      "bad-request",
+	CodeEntityNotExists:                 "entity-not-exists",
+	CodeWorkflowExecutionAlreadyStarted: "execution-already-started",
+	CodeDomainAlreadyExists:             "domain-already-exists",
+	CodeShardOwnershipLost:              "shard-ownership-lost",
+	CodeServiceBusy:                     "service-busy",
+	CodeDomainNotActive:                 "domain-not-active",
+	CodeLimitExceeded:                   "limit-exceeded",
+	CodeDataCorruption:                  "data-corruption",
+	CodeEventAlreadyStarted:             "event-already-started",
+	CodeQueryFailed:                     "query-failed",
+}
+
+func (e *DomainNotActiveError) Error() string {
+	return e.Message
+}
+
+func (e *LimitExceededError) Error() string {
+	return e.Message
+}
+
+// String returns the name of the code
+func (c Code) String() string {
+	if name, ok := codeNames[c]; ok {
+		return name
+	}
+	return fmt.Sprintf("unknown(%d)", int(c))
+}
+
+// CodeOf returns the code of the error, CodeInternal for the errors without a more specific code
+func CodeOf(err error) Code {
+	switch err.(type) {
+	case *workflow.BadRequestError:
+		return CodeBadRequest
+	case *workflow.EntityNotExistsError:
+		return CodeEntityNotExists
+	case *workflow.WorkflowExecutionAlreadyStartedError:
+		return CodeWorkflowExecutionAlreadyStarted
+	case *workflow.DomainAlreadyExistsError:
+		return CodeDomainAlreadyExists
+	case *h.ShardOwnershipLostError, *persistence.ShardOwnershipLostError:
+		return CodeShardOwnershipLost
+	case *workflow.ServiceBusyError, *persistence.UnavailableError:
+		return CodeServiceBusy
+	case *DomainNotActiveError:
+		return CodeDomainNotActive
+	case *LimitExceededError:
+		return CodeLimitExceeded
+	case *persistence.CorruptionError:
+		return CodeDataCorruption
+	case *h.EventAlreadyStartedError:
+		return CodeEventAlreadyStarted
+	case *workflow.QueryFailedError:
+		return CodeQueryFailed
+	}
+	return CodeInternal
+}
+
+// New creates the thrift error of the code with the message
+func New(code Code, message string) error {
+	switch code {
+	case CodeBadRequest, CodeDomainNotActive:
+		return &workflow.BadRequestError{Message: message}
+	case CodeEntityNotExists:
+		return &workflow.EntityNotExistsError{Message: message}
+	case CodeWorkflowExecutionAlreadyStarted:
+		return &workflow.WorkflowExecutionAlreadyStartedError{Message: &message}
+	case CodeDomainAlreadyExists:
+		return &workflow.DomainAlreadyExistsError{Message: message}
+	case CodeShardOwnershipLost:
+		return &h.ShardOwnershipLostError{Message: &message}
+	case CodeServiceBusy, CodeLimitExceeded:
+		return &workflow.ServiceBusyError{Message: message}
+	case CodeEventAlreadyStarted:
+		return &h.EventAlreadyStartedError{Message: message}
+	case CodeQueryFailed:
+		return &workflow.QueryFailedError{Message: message}
+	}
+	return &workflow.InternalServiceError{Message: message}
+}
+
+// ToThrift translates the error to one of the shared thrift errors sent back to the callers of the frontend and
+// matching services.  The shared thrift errors are returned as is, the other errors are replaced by the shared thrift
+// error of their code.  The errors internal to the history service are reported as internal errors.
+func ToThrift(err error) error {
+	switch err.(type) {
+	case *workflow.BadRequestError, *workflow.EntityNotExistsError, *workflow.WorkflowExecutionAlreadyStartedError,
+		*workflow.DomainAlreadyExistsError, *workflow.ServiceBusyError, *workflow.InternalServiceError,
+		*workflow.QueryFailedError:
+		return err
+	}
+
+	switch code := CodeOf(err); code {
+	case CodeShardOwnershipLost, CodeEventAlreadyStarted:
+		return &workflow.InternalServiceError{Message: err.Error()}
+	default:
+		return New(code, err.Error())
+	}
+}
+
+// IsRetryable returns true if the request failed with the error may succeed when it is sent again
+func IsRetryable(err error) bool {
+	switch CodeOf(err) {
+	case CodeInternal, CodeShardOwnershipLost, CodeServiceBusy, CodeLimitExceeded:
+		return true
+	}
+	return false
+}
+
+// MetricCounter returns the common metric counting the errors of the code
+func MetricCounter(code Code) int {
+	switch code {
+	case CodeBadRequest, CodeDomainNotActive:
+		return metrics.CadenceErrBadRequestCounter
+	case CodeEntityNotExists:
+		return metrics.CadenceErrEntityNotExistsCounter
+	case CodeWorkflowExecutionAlreadyStarted:
+		return metrics.CadenceErrExecutionAlreadyStartedCounter
+	case CodeDomainAlreadyExists:
+		return metrics.CadenceErrDomainAlreadyExistsCounter
+	case CodeServiceBusy, CodeLimitExceeded:
+		return metrics.CadenceErrServiceBusyCounter
+	case CodeQueryFailed:
+		return metrics.CadenceErrQueryFailedCounter
+	}
+	return metrics.CadenceFailures
+}
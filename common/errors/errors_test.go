@@ -0,0 +1,96 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package errors
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	h "github.com/uber/cadence/.gen/go/history"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+)
+
+func TestCodeOf(t *testing.T) {
+	tests := []struct {
+		err  error
+		code Code
+	}{
+		{&workflow.BadRequestError{}, CodeBadRequest},
+		{&workflow.EntityNotExistsError{}, CodeEntityNotExists},
+		{&workflow.WorkflowExecutionAlreadyStartedError{}, CodeWorkflowExecutionAlreadyStarted},
+		{&workflow.DomainAlreadyExistsError{}, CodeDomainAlreadyExists},
+		{&h.ShardOwnershipLostError{}, CodeShardOwnershipLost},
+		{&persistence.ShardOwnershipLostError{}, CodeShardOwnershipLost},
+		{&workflow.ServiceBusyError{}, CodeServiceBusy},
+		{&persistence.UnavailableError{}, CodeServiceBusy},
+		{&DomainNotActiveError{}, CodeDomainNotActive},
+		{&LimitExceededError{}, CodeLimitExceeded},
+		{&persistence.CorruptionError{}, CodeDataCorruption},
+		{&h.EventAlreadyStartedError{}, CodeEventAlreadyStarted},
+		{&workflow.QueryFailedError{}, CodeQueryFailed},
+		{&workflow.InternalServiceError{}, CodeInternal},
+		{errors.New("unknown"), CodeInternal},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.code, CodeOf(test.err), "%T", test.err)
+	}
+}
+
+func TestToThrift(t *testing.T) {
+	badRequest := &workflow.BadRequestError{Message: "bad"}
+	assert.Equal(t, badRequest, ToThrift(badRequest))
+	queryFailed := &workflow.QueryFailedError{Message: "panic"}
+	assert.Equal(t, queryFailed, ToThrift(queryFailed))
+
+	assert.Equal(t, &workflow.BadRequestError{Message: "passive"}, ToThrift(&DomainNotActiveError{Message: "passive"}))
+	assert.Equal(t, &workflow.ServiceBusyError{Message: "limit"}, ToThrift(&LimitExceededError{Message: "limit"}))
+	assert.Equal(t, &workflow.ServiceBusyError{Message: "down"}, ToThrift(&persistence.UnavailableError{Msg: "down"}))
+	assert.Equal(t, &workflow.InternalServiceError{Message: "unknown"}, ToThrift(errors.New("unknown")))
+
+	message := "moved"
+	assert.Equal(t, &workflow.InternalServiceError{Message: "moved"},
+		ToThrift(&h.ShardOwnershipLostError{Message: &message}))
+}
+
+func TestIsRetryable(t *testing.T) {
+	assert.True(t, IsRetryable(errors.New("unknown")))
+	assert.True(t, IsRetryable(&workflow.ServiceBusyError{}))
+	assert.True(t, IsRetryable(&LimitExceededError{}))
+	assert.True(t, IsRetryable(&persistence.ShardOwnershipLostError{}))
+	assert.False(t, IsRetryable(&workflow.BadRequestError{}))
+	assert.False(t, IsRetryable(&workflow.EntityNotExistsError{}))
+	assert.False(t, IsRetryable(&DomainNotActiveError{}))
+	assert.False(t, IsRetryable(&persistence.CorruptionError{}))
+	assert.False(t, IsRetryable(&workflow.QueryFailedError{}))
+}
+
+func TestMetricCounter(t *testing.T) {
+	assert.Equal(t, metrics.CadenceErrBadRequestCounter, MetricCounter(CodeBadRequest))
+	assert.Equal(t, metrics.CadenceErrServiceBusyCounter, MetricCounter(CodeLimitExceeded))
+	assert.Equal(t, metrics.CadenceFailures, MetricCounter(CodeDataCorruption))
+	assert.Equal(t, metrics.CadenceErrQueryFailedCounter, MetricCounter(CodeQueryFailed))
+	assert.Equal(t, "domain-not-active", CodeDomainNotActive.String())
+	assert.Equal(t, "unknown(42)", Code(42).String())
+}
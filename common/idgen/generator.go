@@ -0,0 +1,90 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package idgen
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"sync/atomic"
+	"time"
+
+	"github.com/pborman/uuid"
+)
+
+type (
+	// Generator generates the unique identifiers used for workflow run IDs and request IDs.  Every identifier is
+	// formatted as a UUID, so it can be stored in the uuid columns of the executions table.
+	Generator interface {
+		NewID() string
+	}
+
+	randomGenerator struct{}
+
+	timeOrderedGenerator struct {
+		now func() time.Time
+	}
+
+	sequentialGenerator struct {
+		sequence uint64
+	}
+)
+
+// NewRandomGenerator returns a generator of random (version 4) UUIDs
+func NewRandomGenerator() Generator {
+	return &randomGenerator{}
+}
+
+// NewTimeOrderedGenerator returns a generator of UUIDs whose leading 48 bits are the millisecond creation time, so
+// identifiers sort by the time they were generated.  Rows keyed by these identifiers are appended next to each other
+// in ordered stores instead of being scattered across the whole key space.
+func NewTimeOrderedGenerator() Generator {
+	return &timeOrderedGenerator{now: time.Now}
+}
+
+// NewSequentialGenerator returns a generator of deterministic UUIDs which encode an increasing counter starting at 1.
+// It is meant for tests.
+func NewSequentialGenerator() Generator {
+	return &sequentialGenerator{}
+}
+
+func (g *randomGenerator) NewID() string {
+	return uuid.New()
+}
+
+func (g *timeOrderedGenerator) NewID() string {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id[6:]); err != nil {
+		return uuid.New()
+	}
+
+	ms := uint64(g.now().UnixNano() / int64(time.Millisecond))
+	binary.BigEndian.PutUint16(id[0:2], uint16(ms>>32))
+	binary.BigEndian.PutUint32(id[2:6], uint32(ms))
+	id[6] = (id[6] & 0x0f) | 0x70 // version 7
+	id[8] = (id[8] & 0x3f) | 0x80 // RFC 4122 variant
+	return uuid.UUID(id).String()
+}
+
+func (g *sequentialGenerator) NewID() string {
+	id := make([]byte, 16)
+	binary.BigEndian.PutUint64(id[8:], atomic.AddUint64(&g.sequence, 1))
+	return uuid.UUID(id).String()
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package idgen
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	generatorSuite struct {
+		*require.Assertions // override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test, not merely log an error
+		suite.Suite
+	}
+)
+
+func TestGeneratorSuite(t *testing.T) {
+	suite.Run(t, new(generatorSuite))
+}
+
+func (s *generatorSuite) SetupTest() {
+	s.Assertions = require.New(s.T()) // Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+}
+
+func (s *generatorSuite) TestRandomGenerator() {
+	g := NewRandomGenerator()
+	id := g.NewID()
+	s.NotNil(uuid.Parse(id))
+	s.NotEqual(id, g.NewID())
+}
+
+func (s *generatorSuite) TestTimeOrderedGenerator() {
+	now := time.Now()
+	g := &timeOrderedGenerator{now: func() time.Time { return now }}
+
+	id1 := g.NewID()
+	parsed := uuid.Parse(id1)
+	s.NotNil(parsed)
+	version, ok := parsed.Version()
+	s.True(ok)
+	s.Equal(uuid.Version(7), version)
+	s.Equal(uuid.RFC4122, parsed.Variant())
+	s.NotEqual(id1, g.NewID())
+
+	now = now.Add(time.Millisecond)
+	id2 := g.NewID()
+	s.True(id1 < id2)
+
+	// the first 48 bits are the creation time in milliseconds
+	ms := now.UnixNano() / int64(time.Millisecond)
+	s.Equal(fmt.Sprintf("%012x", ms), strings.Replace(id2[:13], "-", "", 1))
+}
+
+func (s *generatorSuite) TestSequentialGenerator() {
+	g := NewSequentialGenerator()
+	s.Equal("00000000-0000-0000-0000-000000000001", g.NewID())
+	s.Equal("00000000-0000-0000-0000-000000000002", g.NewID())
+	s.Equal("00000000-0000-0000-0000-000000000001", NewSequentialGenerator().NewID())
+}
@@ -0,0 +1,201 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package locks
+
+import (
+	"runtime"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber-common/bark"
+
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/metrics"
+)
+
+const (
+	// maxStackDumpSize caps the size of the goroutine dump logged for a lock held past the threshold
+	maxStackDumpSize = 1024 * 1024
+)
+
+type (
+	// Monitor tracks how long monitored locks are held.  Every hold is recorded as a timer, the longest hold of each
+	// scope is reported as a gauge on every check interval, and a lock held longer than the threshold is logged along
+	// with the stacks of all goroutines, so the holder of a stuck lock can be identified.
+	Monitor struct {
+		threshold     time.Duration
+		logger        bark.Logger
+		metricsClient metrics.Client
+		started       int32
+		shutdownCh    chan struct{}
+		shutdownWG    sync.WaitGroup
+
+		sync.Mutex
+		held    map[*holder]struct{}
+		maxHold map[int]time.Duration
+	}
+
+	// holder is the monitoring state embedded in every monitored lock
+	holder struct {
+		monitor    *Monitor
+		scope      int
+		name       string
+		acquiredAt int64
+		reported   int32
+	}
+)
+
+// NewMonitor creates a lock monitor which reports locks held longer than threshold
+func NewMonitor(threshold time.Duration, logger bark.Logger, metricsClient metrics.Client) *Monitor {
+	return &Monitor{
+		threshold:     threshold,
+		logger:        logger,
+		metricsClient: metricsClient,
+		shutdownCh:    make(chan struct{}),
+		held:          make(map[*holder]struct{}),
+		maxHold:       make(map[int]time.Duration),
+	}
+}
+
+// Start starts the background check of held locks
+func (m *Monitor) Start() {
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return
+	}
+
+	m.shutdownWG.Add(1)
+	go m.checkLoop()
+}
+
+// Stop stops the background check of held locks
+func (m *Monitor) Stop() {
+	if !atomic.CompareAndSwapInt32(&m.started, 1, 2) {
+		return
+	}
+
+	close(m.shutdownCh)
+	m.shutdownWG.Wait()
+}
+
+func (m *Monitor) checkLoop() {
+	defer m.shutdownWG.Done()
+
+	ticker := time.NewTicker(m.threshold)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.shutdownCh:
+			return
+		case <-ticker.C:
+			m.check(time.Now())
+		}
+	}
+}
+
+// check reports the longest hold of every scope since the last check and logs every lock currently held past the
+// threshold which has not been reported yet
+func (m *Monitor) check(now time.Time) {
+	var stuck []*holder
+
+	m.Lock()
+	for h := range m.held {
+		d := now.Sub(time.Unix(0, atomic.LoadInt64(&h.acquiredAt)))
+		if d > m.maxHold[h.scope] {
+			m.maxHold[h.scope] = d
+		}
+		if d > m.threshold && atomic.CompareAndSwapInt32(&h.reported, 0, 1) {
+			stuck = append(stuck, h)
+		}
+	}
+	maxHold := m.maxHold
+	m.maxHold = make(map[int]time.Duration)
+	m.Unlock()
+
+	for scope, d := range maxHold {
+		m.metricsClient.UpdateGauge(scope, metrics.LockMaxHoldGauge, float64(d/time.Millisecond))
+	}
+
+	if len(stuck) == 0 {
+		return
+	}
+
+	buf := make([]byte, maxStackDumpSize)
+	buf = buf[:runtime.Stack(buf, true)]
+	for _, h := range stuck {
+		m.metricsClient.IncCounter(h.scope, metrics.LockHoldThresholdExceededCounter)
+		m.logger.WithFields(bark.Fields{
+			logging.TagLockName:      h.name,
+			logging.TagLockHeldSince: time.Unix(0, atomic.LoadInt64(&h.acquiredAt)),
+		}).Warnf("Lock held longer than %v, goroutine dump:\n%s", m.threshold, buf)
+	}
+}
+
+func (m *Monitor) acquired(h *holder) {
+	atomic.StoreInt64(&h.acquiredAt, time.Now().UnixNano())
+	atomic.StoreInt32(&h.reported, 0)
+
+	m.Lock()
+	m.held[h] = struct{}{}
+	m.Unlock()
+}
+
+func (m *Monitor) released(h *holder) {
+	d := time.Since(time.Unix(0, atomic.LoadInt64(&h.acquiredAt)))
+
+	m.Lock()
+	delete(m.held, h)
+	if d > m.maxHold[h.scope] {
+		m.maxHold[h.scope] = d
+	}
+	m.Unlock()
+
+	m.metricsClient.RecordTimer(h.scope, metrics.LockHeldLatency, d)
+	if d > m.threshold && atomic.LoadInt32(&h.reported) == 0 {
+		// Release happens on the goroutine which held the lock, so its own stack identifies the holder
+		m.metricsClient.IncCounter(h.scope, metrics.LockHoldThresholdExceededCounter)
+		m.logger.WithFields(bark.Fields{
+			logging.TagLockName:    h.name,
+			logging.TagLockHeldFor: d,
+		}).Warnf("Lock held longer than %v, holder stack:\n%s", m.threshold, debug.Stack())
+	}
+}
+
+// SetMonitor attaches a monitor to the lock.  A nil monitor leaves the lock unmonitored.  It must be called before the
+// lock is first used.
+func (h *holder) SetMonitor(monitor *Monitor, scope int, name string) {
+	h.monitor = monitor
+	h.scope = scope
+	h.name = name
+}
+
+func (h *holder) acquired() {
+	if h.monitor != nil {
+		h.monitor.acquired(h)
+	}
+}
+
+func (h *holder) released() {
+	if h.monitor != nil {
+		h.monitor.released(h)
+	}
+}
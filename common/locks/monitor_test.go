@@ -0,0 +1,118 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package locks
+
+import (
+	"testing"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/common/metrics"
+)
+
+type (
+	monitorSuite struct {
+		*require.Assertions // override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test, not merely log an error
+		suite.Suite
+		monitor *Monitor
+	}
+)
+
+func TestMonitorSuite(t *testing.T) {
+	suite.Run(t, new(monitorSuite))
+}
+
+func (s *monitorSuite) SetupTest() {
+	s.Assertions = require.New(s.T()) // Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+	s.monitor = NewMonitor(10*time.Millisecond, bark.NewLoggerFromLogrus(log.New()),
+		metrics.NewClient(tally.NoopScope, metrics.History))
+}
+
+func (s *monitorSuite) TestUnmonitoredMutex() {
+	var m Mutex
+	m.Lock()
+	m.Unlock()
+
+	var rw RWMutex
+	rw.Lock()
+	rw.Unlock()
+	rw.RLock()
+	rw.RUnlock()
+}
+
+func (s *monitorSuite) TestHoldBelowThreshold() {
+	var m Mutex
+	m.SetMonitor(s.monitor, metrics.HistoryExecutionLockScope, "test-lock")
+
+	m.Lock()
+	s.Equal(1, len(s.monitor.held))
+	m.Unlock()
+	s.Equal(0, len(s.monitor.held))
+	s.True(s.monitor.maxHold[metrics.HistoryExecutionLockScope] < s.monitor.threshold)
+}
+
+func (s *monitorSuite) TestHoldAboveThresholdOnRelease() {
+	var m RWMutex
+	m.SetMonitor(s.monitor, metrics.HistoryShardLockScope, "test-lock")
+
+	m.Lock()
+	time.Sleep(20 * time.Millisecond)
+	m.Unlock()
+	s.Equal(0, len(s.monitor.held))
+	s.True(s.monitor.maxHold[metrics.HistoryShardLockScope] >= 20*time.Millisecond)
+
+	// the longest hold is reported once per check
+	s.monitor.check(time.Now())
+	s.Equal(0, len(s.monitor.maxHold))
+}
+
+func (s *monitorSuite) TestStuckLockReportedOnce() {
+	var m Mutex
+	m.SetMonitor(s.monitor, metrics.HistoryExecutionLockScope, "test-lock")
+
+	m.Lock()
+	s.monitor.check(time.Now())
+	s.Equal(int32(0), m.reported)
+
+	now := time.Now().Add(20 * time.Millisecond)
+	s.monitor.check(now)
+	s.Equal(int32(1), m.reported)
+	s.monitor.check(now)
+	s.Equal(int32(1), m.reported)
+	m.Unlock()
+
+	// a new hold of the same lock is reported again
+	m.Lock()
+	s.Equal(int32(0), m.reported)
+	m.Unlock()
+}
+
+func (s *monitorSuite) TestStartStop() {
+	s.monitor.Start()
+	s.monitor.Start()
+	s.monitor.Stop()
+	s.monitor.Stop()
+}
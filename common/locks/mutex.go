@@ -0,0 +1,65 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package locks
+
+import (
+	"sync"
+)
+
+type (
+	// Mutex is a sync.Mutex whose hold durations are reported to a Monitor.  The zero value is an unlocked,
+	// unmonitored mutex, so it can replace an embedded sync.Mutex as is.
+	Mutex struct {
+		sync.Mutex
+		holder
+	}
+
+	// RWMutex is a sync.RWMutex whose exclusive hold durations are reported to a Monitor.  Read locks are not
+	// monitored.  The zero value is an unlocked, unmonitored mutex.
+	RWMutex struct {
+		sync.RWMutex
+		holder
+	}
+)
+
+// Lock locks m
+func (m *Mutex) Lock() {
+	m.Mutex.Lock()
+	m.acquired()
+}
+
+// Unlock unlocks m
+func (m *Mutex) Unlock() {
+	m.released()
+	m.Mutex.Unlock()
+}
+
+// Lock locks m for writing
+func (m *RWMutex) Lock() {
+	m.RWMutex.Lock()
+	m.acquired()
+}
+
+// Unlock unlocks m for writing
+func (m *RWMutex) Unlock() {
+	m.released()
+	m.RWMutex.Unlock()
+}
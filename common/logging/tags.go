@@ -41,15 +41,32 @@ const (
 	TagDecisionType         = "decision-type"
 	TagDecisionFailCause    = "decision-fail-cause"
 
+	// access log tags
+	TagAPIName        = "api-name"
+	TagDomainName     = "domain-name"
+	TagLatency        = "latency-ms"
+	TagRequestSize    = "request-size"
+	TagResponseSize   = "response-size"
+	TagStatus         = "status"
+	TagCallerName     = "caller-name"
+	TagCallerIdentity = "caller-identity"
+
+	// lock monitor tags
+	TagLockName      = "lock-name"
+	TagLockHeldSince = "lock-held-since"
+	TagLockHeldFor   = "lock-held-for"
+
 	// workflow logging tag values
 	// TagWorkflowComponent Values
-	TagValueHistoryBuilderComponent = "history-builder"
-	TagValueHistoryEngineComponent  = "history-engine"
-	TagValueHistoryCacheComponent   = "history-cache"
-	TagValueTransferQueueComponent  = "transfer-queue-processor"
-	TagValueTimerQueueComponent     = "timer-queue-processor"
-	TagValueShardController         = "shard-controller"
-	TagValueMatchingEngineComponent = "matching-engine"
+	TagValueHistoryBuilderComponent    = "history-builder"
+	TagValueHistoryEngineComponent     = "history-engine"
+	TagValueHistoryCacheComponent      = "history-cache"
+	TagValueTransferQueueComponent     = "transfer-queue-processor"
+	TagValueTimerQueueComponent        = "timer-queue-processor"
+	TagValueShardController            = "shard-controller"
+	TagValueMatchingEngineComponent    = "matching-engine"
+	TagValueFrontendAccessLogComponent = "frontend-access-log"
+	TagValueLockMonitorComponent       = "lock-monitor"
 
 	// TagHistoryBuilderAction values
 	TagValueActionWorkflowStarted                 = "add-workflowexecution-started-event"
@@ -91,9 +108,12 @@ const (
 	TagValueStoreOperationGetWorkflowExecution    = "get-wf-execution"
 	TagValueStoreOperationUpdateWorkflowExecution = "update-wf-execution"
 	TagValueStoreOperationDeleteWorkflowExecution = "delete-wf-execution"
+	TagValueStoreOperationDeleteCurrentExecution  = "delete-current-execution"
+	TagValueStoreOperationUpdateCurrentExecution  = "update-current-execution"
 	TagValueStoreOperationUpdateShard             = "update-shard"
 	TagValueStoreOperationCreateTask              = "create-task"
 	TagValueStoreOperationUpdateTaskList          = "update-task-list"
+	TagValueStoreOperationGetTaskListBacklog      = "get-task-list-backlog"
 	TagValueStoreOperationStopTaskList            = "stop-task-list"
 
 	// task list tags
@@ -62,14 +62,20 @@ const (
 
 // Common tags for all services
 const (
-	HostnameTagName  = "hostname"
-	OperationTagName = "operation"
-	ShardTagName     = "shard"
+	HostnameTagName       = "hostname"
+	OperationTagName      = "operation"
+	ShardTagName          = "shard"
+	TaskListTagName       = "tasklist"
+	WorkflowIDHashTagName = "workflow_id_hash"
+	DomainTagName         = "domain"
+	WorkflowTypeTagName   = "workflow_type"
 )
 
 // This package should hold all the metrics and tags for cadence
 const (
 	UnknownDirectoryTagValue = "Unknown"
+	// OtherWorkflowTypeTagValue is the workflow type tag of the workflow types of a domain beyond the cardinality cap
+	OtherWorkflowTypeTagValue = "other"
 )
 
 // Common service base metrics
@@ -84,11 +90,22 @@ const (
 	MemoryStackGauge     = "memory.stack"
 	NumGCCounter         = "memory.num-gc"
 	GcPauseMsTimer       = "memory.gc-pause-ms"
+
+	InboundConnectionsGauge       = "inbound-connections"
+	ConnectionsRejectedCounter    = "connections-rejected"
+	ConnectionReadTimeoutCounter  = "connection-read-timeouts"
+	ConnectionWriteTimeoutCounter = "connection-write-timeouts"
+	ConnectionSlowWriteCounter    = "connection-slow-writes"
 )
 
 // ServiceMetrics are types for common service base metrics
 var ServiceMetrics = map[MetricName]MetricType{
-	RestartCount: Counter,
+	RestartCount:                  Counter,
+	InboundConnectionsGauge:       Gauge,
+	ConnectionsRejectedCounter:    Counter,
+	ConnectionReadTimeoutCounter:  Counter,
+	ConnectionWriteTimeoutCounter: Counter,
+	ConnectionSlowWriteCounter:    Counter,
 }
 
 // GoRuntimeMetrics represent the runtime stats from go runtime
@@ -114,6 +131,8 @@ const (
 	PersistenceGetShardScope
 	// PersistenceUpdateShardScope tracks UpdateShard calls made by service to persistence layer
 	PersistenceUpdateShardScope
+	// PersistenceGetServerTimeScope tracks GetServerTime calls made by service to persistence layer
+	PersistenceGetServerTimeScope
 	// PersistenceCreateWorkflowExecutionScope tracks CreateWorkflowExecution calls made by service to persistence layer
 	PersistenceCreateWorkflowExecutionScope
 	// PersistenceGetWorkflowExecutionScope tracks GetWorkflowExecution calls made by service to persistence layer
@@ -124,30 +143,60 @@ const (
 	PersistenceDeleteWorkflowExecutionScope
 	// PersistenceGetCurrentExecutionScope tracks GetCurrentExecution calls made by service to persistence layer
 	PersistenceGetCurrentExecutionScope
+	// PersistenceListConcreteExecutionsScope tracks ListConcreteExecutions calls made by service to persistence layer
+	PersistenceListConcreteExecutionsScope
+	// PersistenceListCurrentExecutionsScope tracks ListCurrentExecutions calls made by service to persistence layer
+	PersistenceListCurrentExecutionsScope
+	// PersistenceDeleteCurrentWorkflowExecutionScope tracks DeleteCurrentWorkflowExecution calls made by service to persistence layer
+	PersistenceDeleteCurrentWorkflowExecutionScope
+	// PersistenceCreateCurrentWorkflowExecutionScope tracks CreateCurrentWorkflowExecution calls made by service to persistence layer
+	PersistenceCreateCurrentWorkflowExecutionScope
+	// PersistenceUpdateCurrentWorkflowExecutionScope tracks UpdateCurrentWorkflowExecution calls made by service to persistence layer
+	PersistenceUpdateCurrentWorkflowExecutionScope
 	// PersistenceGetTransferTasksScope tracks GetTransferTasks calls made by service to persistence layer
 	PersistenceGetTransferTasksScope
 	// PersistenceCompleteTransferTaskScope tracks CompleteTransferTasks calls made by service to persistence layer
 	PersistenceCompleteTransferTaskScope
+	// PersistenceRangeCompleteTransferTaskScope tracks RangeCompleteTransferTask calls made by service to persistence layer
+	PersistenceRangeCompleteTransferTaskScope
 	// PersistenceGetTimerIndexTasksScope tracks GetTimerIndexTasks calls made by service to persistence layer
 	PersistenceGetTimerIndexTasksScope
 	// PersistenceCompleteTimerTaskScope tracks CompleteTimerTasks calls made by service to persistence layer
 	PersistenceCompleteTimerTaskScope
+	// PersistenceRangeCompleteTimerTaskScope tracks RangeCompleteTimerTask calls made by service to persistence layer
+	PersistenceRangeCompleteTimerTaskScope
 	// PersistenceCreateTaskScope tracks CreateTask calls made by service to persistence layer
 	PersistenceCreateTaskScope
 	// PersistenceGetTasksScope tracks GetTasks calls made by service to persistence layer
 	PersistenceGetTasksScope
 	// PersistenceCompleteTaskScope tracks CompleteTask calls made by service to persistence layer
 	PersistenceCompleteTaskScope
+	// PersistenceCompleteTasksLessThanScope tracks CompleteTasksLessThan calls made by service to persistence layer
+	PersistenceCompleteTasksLessThanScope
 	// PersistenceLeaseTaskListScope tracks LeaseTaskList calls made by service to persistence layer
 	PersistenceLeaseTaskListScope
 	// PersistenceUpdateTaskListScope tracks PersistenceUpdateTaskListScope calls made by service to persistence layer
 	PersistenceUpdateTaskListScope
+	// PersistenceGetTaskListBacklogScope tracks GetTaskListBacklog calls made by service to persistence layer
+	PersistenceGetTaskListBacklogScope
 	// PersistenceAppendHistoryEventsScope tracks AppendHistoryEvents calls made by service to persistence layer
 	PersistenceAppendHistoryEventsScope
+	// PersistenceAppendHistoryEventsBatchScope tracks AppendHistoryEventsBatch calls made by service to persistence layer
+	PersistenceAppendHistoryEventsBatchScope
 	// PersistenceGetWorkflowExecutionHistoryScope tracks GetWorkflowExecutionHistory calls made by service to persistence layer
 	PersistenceGetWorkflowExecutionHistoryScope
 	// PersistenceDeleteWorkflowExecutionHistoryScope tracks DeleteWorkflowExecutionHistory calls made by service to persistence layer
 	PersistenceDeleteWorkflowExecutionHistoryScope
+	// PersistenceAppendHistoryNodesScope tracks AppendHistoryNodes calls made by service to persistence layer
+	PersistenceAppendHistoryNodesScope
+	// PersistenceReadHistoryBranchScope tracks ReadHistoryBranch calls made by service to persistence layer
+	PersistenceReadHistoryBranchScope
+	// PersistenceForkHistoryBranchScope tracks ForkHistoryBranch calls made by service to persistence layer
+	PersistenceForkHistoryBranchScope
+	// PersistenceDeleteHistoryBranchScope tracks DeleteHistoryBranch calls made by service to persistence layer
+	PersistenceDeleteHistoryBranchScope
+	// PersistenceGetHistoryTreeScope tracks GetHistoryTree calls made by service to persistence layer
+	PersistenceGetHistoryTreeScope
 	// PersistenceCreateDomainScope tracks CreateDomain calls made by service to persistence layer
 	PersistenceCreateDomainScope
 	// PersistenceGetDomainScope tracks GetDomain calls made by service to persistence layer
@@ -158,6 +207,18 @@ const (
 	PersistenceDeleteDomainScope
 	// PersistenceDeleteDomainByNameScope tracks DeleteDomainByName calls made by service to persistence layer
 	PersistenceDeleteDomainByNameScope
+	// PersistenceGetDomainChangesScope tracks GetDomainChanges calls made by service to persistence layer
+	PersistenceGetDomainChangesScope
+	// PersistenceGetMetadataScope tracks GetMetadata calls made by service to persistence layer
+	PersistenceGetMetadataScope
+	// PersistenceCreateBatchOperationScope tracks CreateBatchOperation calls made by service to persistence layer
+	PersistenceCreateBatchOperationScope
+	// PersistenceUpdateBatchOperationScope tracks UpdateBatchOperation calls made by service to persistence layer
+	PersistenceUpdateBatchOperationScope
+	// PersistenceStopBatchOperationScope tracks StopBatchOperation calls made by service to persistence layer
+	PersistenceStopBatchOperationScope
+	// PersistenceGetBatchOperationScope tracks GetBatchOperation calls made by service to persistence layer
+	PersistenceGetBatchOperationScope
 	// HistoryClientStartWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientStartWorkflowExecutionScope
 	// HistoryClientRecordActivityTaskHeartbeatScope tracks RPC calls to history service
@@ -180,12 +241,24 @@ const (
 	HistoryClientRequestCancelWorkflowExecutionScope
 	// HistoryClientSignalWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientSignalWorkflowExecutionScope
+	// HistoryClientSignalWithStartWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientSignalWithStartWorkflowExecutionScope
 	// HistoryClientTerminateWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientTerminateWorkflowExecutionScope
+	// HistoryClientResetWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientResetWorkflowExecutionScope
 	// HistoryClientScheduleDecisionTaskScope tracks RPC calls to history service
 	HistoryClientScheduleDecisionTaskScope
 	// HistoryClientRecordChildExecutionCompletedScope tracks RPC calls to history service
 	HistoryClientRecordChildExecutionCompletedScope
+	// HistoryClientDescribeShardScope tracks RPC calls to history service
+	HistoryClientDescribeShardScope
+	// HistoryClientInvalidateMutableStateScope tracks RPC calls to history service
+	HistoryClientInvalidateMutableStateScope
+	// HistoryClientSetTaskProcessingPausedScope tracks RPC calls to history service
+	HistoryClientSetTaskProcessingPausedScope
+	// HistoryClientListConcreteExecutionsScope tracks RPC calls to history service
+	HistoryClientListConcreteExecutionsScope
 	// MatchingClientPollForDecisionTaskScope tracks RPC calls to matching service
 	MatchingClientPollForDecisionTaskScope
 	// MatchingClientPollForActivityTaskScope tracks RPC calls to matching service
@@ -194,6 +267,14 @@ const (
 	MatchingClientAddActivityTaskScope
 	// MatchingClientAddDecisionTaskScope tracks RPC calls to matching service
 	MatchingClientAddDecisionTaskScope
+	// MatchingClientDrainTaskListScope tracks RPC calls to matching service
+	MatchingClientDrainTaskListScope
+	// MatchingClientQueryWorkflowScope tracks RPC calls to matching service
+	MatchingClientQueryWorkflowScope
+	// MatchingClientRespondQueryTaskCompletedScope tracks RPC calls to matching service
+	MatchingClientRespondQueryTaskCompletedScope
+	// DomainCacheScope is the scope used by the cache of the domains
+	DomainCacheScope
 
 	NumCommonScopes
 )
@@ -220,14 +301,30 @@ const (
 	FrontendGetWorkflowExecutionHistoryScope
 	// FrontendSignalWorkflowExecutionScope is the metric scope for frontend.SignalWorkflowExecution
 	FrontendSignalWorkflowExecutionScope
+	// FrontendSignalWithStartWorkflowExecutionScope is the metric scope for frontend.SignalWithStartWorkflowExecution
+	FrontendSignalWithStartWorkflowExecutionScope
 	// FrontendTerminateWorkflowExecutionScope is the metric scope for frontend.TerminateWorkflowExecution
 	FrontendTerminateWorkflowExecutionScope
+	// FrontendResetWorkflowExecutionScope is the metric scope for frontend.ResetWorkflowExecution
+	FrontendResetWorkflowExecutionScope
 	// FrontendRequestCancelWorkflowExecutionScope is the metric scope for frontend.RequestCancelWorkflowExecution
 	FrontendRequestCancelWorkflowExecutionScope
+	// FrontendQueryWorkflowScope is the metric scope for frontend.QueryWorkflow
+	FrontendQueryWorkflowScope
+	// FrontendRespondQueryTaskCompletedScope is the metric scope for frontend.RespondQueryTaskCompleted
+	FrontendRespondQueryTaskCompletedScope
 	// FrontendListOpenWorkflowExecutionsScope is the metric scope for frontend.ListOpenWorkflowExecutions
 	FrontendListOpenWorkflowExecutionsScope
 	// FrontendListClosedWorkflowExecutionsScope is the metric scope for frontend.ListClosedWorkflowExecutions
 	FrontendListClosedWorkflowExecutionsScope
+	// FrontendScanWorkflowExecutionsScope is the metric scope for frontend.ScanWorkflowExecutions
+	FrontendScanWorkflowExecutionsScope
+	// FrontendCountOpenWorkflowExecutionsScope is the metric scope for frontend.CountOpenWorkflowExecutions
+	FrontendCountOpenWorkflowExecutionsScope
+	// FrontendCountClosedWorkflowExecutionsScope is the metric scope for frontend.CountClosedWorkflowExecutions
+	FrontendCountClosedWorkflowExecutionsScope
+	// FrontendListWorkflowExecutionsWithQueryScope is the metric scope for frontend.ListWorkflowExecutionsWithQuery
+	FrontendListWorkflowExecutionsWithQueryScope
 	// FrontendRegisterDomainScope is the metric scope for frontend.RegisterDomain
 	FrontendRegisterDomainScope
 	// FrontendDescribeDomainScope is the metric scope for frontend.DescribeDomain
@@ -236,6 +333,22 @@ const (
 	FrontendUpdateDomainScope
 	// FrontendDeprecateDomainScope is the metric scope for frontend.DeprecateDomain
 	FrontendDeprecateDomainScope
+	// FrontendGetDomainReplicationMessagesScope is the metric scope for frontend.GetDomainReplicationMessages
+	FrontendGetDomainReplicationMessagesScope
+	// FrontendGetWorkflowResultScope is the metric scope for frontend.GetWorkflowResult
+	FrontendGetWorkflowResultScope
+	// FrontendGetClusterInfoScope is the metric scope for frontend.GetClusterInfo
+	FrontendGetClusterInfoScope
+	// FrontendStartBatchOperationScope is the metric scope for frontend.StartBatchOperation
+	FrontendStartBatchOperationScope
+	// FrontendDescribeBatchOperationScope is the metric scope for frontend.DescribeBatchOperation
+	FrontendDescribeBatchOperationScope
+	// FrontendStopBatchOperationScope is the metric scope for frontend.StopBatchOperation
+	FrontendStopBatchOperationScope
+	// FrontendRateLimitCoordinatorScope is the metric scope for the coordinator of the global rate limit
+	FrontendRateLimitCoordinatorScope
+	// FrontendBatcherScope is the metric scope for the batch operations run by the frontend
+	FrontendBatcherScope
 
 	NumFrontendScopes
 )
@@ -262,14 +375,44 @@ const (
 	HistoryRecordActivityTaskStartedScope
 	// HistorySignalWorkflowExecutionScope tracks SignalWorkflowExecution API calls received by service
 	HistorySignalWorkflowExecutionScope
+	// HistorySignalWithStartWorkflowExecutionScope tracks SignalWithStartWorkflowExecution API calls received by service
+	HistorySignalWithStartWorkflowExecutionScope
 	// HistoryTerminateWorkflowExecutionScope tracks TerminateWorkflowExecution API calls received by service
 	HistoryTerminateWorkflowExecutionScope
+	// HistoryResetWorkflowExecutionScope tracks ResetWorkflowExecution API calls received by service
+	HistoryResetWorkflowExecutionScope
 	// HistoryScheduleDecisionTaskScope tracks ScheduleDecisionTask API calls received by service
 	HistoryScheduleDecisionTaskScope
 	// HistoryRecordChildExecutionCompletedScope tracks CompleteChildExecution API calls received by service
 	HistoryRecordChildExecutionCompletedScope
 	// HistoryRequestCancelWorkflowExecutionScope tracks RequestCancelWorkflowExecution API calls received by service
 	HistoryRequestCancelWorkflowExecutionScope
+	// HistoryDescribeShardScope tracks DescribeShard API calls received by service
+	HistoryDescribeShardScope
+	// HistoryInvalidateMutableStateScope tracks InvalidateMutableState API calls received by service
+	HistoryInvalidateMutableStateScope
+	// HistorySetTaskProcessingPausedScope tracks SetTaskProcessingPaused API calls received by service
+	HistorySetTaskProcessingPausedScope
+	// HistoryListConcreteExecutionsScope tracks ListConcreteExecutions API calls received by service
+	HistoryListConcreteExecutionsScope
+	// HistoryShardControllerScope is the scope used by all metric emitted by the shard controller
+	HistoryShardControllerScope
+	// HistoryShardLockScope is the scope used by the lock monitor for shard locks
+	HistoryShardLockScope
+	// HistoryExecutionLockScope is the scope used by the lock monitor for workflow execution locks
+	HistoryExecutionLockScope
+	// HistoryTimeSkewMonitorScope is the scope used by the monitor of the skew of the host clock
+	HistoryTimeSkewMonitorScope
+	// HistoryExecutionScannerScope is the scope used by the scanner of the corrupted workflow executions
+	HistoryExecutionScannerScope
+	// HistoryAppenderScope is the scope used by the grouping of the history appends of a shard
+	HistoryAppenderScope
+	// HistoryExecutionUpdateScope is the scope used by the statistics of the updates of workflow executions
+	HistoryExecutionUpdateScope
+	// HistoryWorkflowTypeScope is the scope used by the metrics of the workflows tagged by domain and workflow type
+	HistoryWorkflowTypeScope
+	// HistoryLoadShedderScope is the scope used by the shedding of the load of the host under memory pressure
+	HistoryLoadShedderScope
 	// TransferQueueProcessorScope is the scope used by all metric emitted by transfer queue processor
 	TransferQueueProcessorScope
 	// TransferTaskActivityScope is the scope used for activity task processing by transfer queue processor
@@ -282,6 +425,8 @@ const (
 	TransferTaskCancelExecutionScope
 	// TransferTaskStartChildExecutionScope is the scope used for start child execution task processing by transfer queue processor
 	TransferTaskStartChildExecutionScope
+	// TransferTaskCompletionCallbackScope is the scope used for completion callback task processing by transfer queue processor
+	TransferTaskCompletionCallbackScope
 	// TimerQueueProcessorScope is the scope used by all metric emitted by timer queue processor
 	TimerQueueProcessorScope
 
@@ -298,6 +443,14 @@ const (
 	MatchingAddActivityTaskScope
 	// MatchingAddDecisionTaskScope tracks AddDecisionTask API calls received by service
 	MatchingAddDecisionTaskScope
+	// MatchingDrainTaskListScope tracks DrainTaskList API calls received by service
+	MatchingDrainTaskListScope
+	// MatchingQueryWorkflowScope tracks QueryWorkflow API calls received by service
+	MatchingQueryWorkflowScope
+	// MatchingRespondQueryTaskCompletedScope tracks RespondQueryTaskCompleted API calls received by service
+	MatchingRespondQueryTaskCompletedScope
+	// MatchingTaskListMgrScope is the metrics scope for matching.TaskListManager component
+	MatchingTaskListMgrScope
 
 	NumMatchingScopes
 )
@@ -309,99 +462,167 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceCreateShardScope:                    {operation: "CreateShard"},
 		PersistenceGetShardScope:                       {operation: "GetShard"},
 		PersistenceUpdateShardScope:                    {operation: "UpdateShard"},
+		PersistenceGetServerTimeScope:                  {operation: "GetServerTime"},
 		PersistenceCreateWorkflowExecutionScope:        {operation: "CreateWorkflowExecution"},
 		PersistenceGetWorkflowExecutionScope:           {operation: "GetWorkflowExecution"},
 		PersistenceUpdateWorkflowExecutionScope:        {operation: "UpdateWorkflowExecution"},
 		PersistenceDeleteWorkflowExecutionScope:        {operation: "DeleteWorkflowExecution"},
 		PersistenceGetCurrentExecutionScope:            {operation: "GetCurrentExecution"},
+		PersistenceListConcreteExecutionsScope:         {operation: "ListConcreteExecutions"},
+		PersistenceListCurrentExecutionsScope:          {operation: "ListCurrentExecutions"},
+		PersistenceDeleteCurrentWorkflowExecutionScope: {operation: "DeleteCurrentWorkflowExecution"},
+		PersistenceCreateCurrentWorkflowExecutionScope: {operation: "CreateCurrentWorkflowExecution"},
+		PersistenceUpdateCurrentWorkflowExecutionScope: {operation: "UpdateCurrentWorkflowExecution"},
 		PersistenceGetTransferTasksScope:               {operation: "GetTransferTasks"},
 		PersistenceCompleteTransferTaskScope:           {operation: "CompleteTransferTask"},
+		PersistenceRangeCompleteTransferTaskScope:      {operation: "RangeCompleteTransferTask"},
 		PersistenceGetTimerIndexTasksScope:             {operation: "GetTimerIndexTasks"},
 		PersistenceCompleteTimerTaskScope:              {operation: "CompleteTimerTask"},
+		PersistenceRangeCompleteTimerTaskScope:         {operation: "RangeCompleteTimerTask"},
 		PersistenceCreateTaskScope:                     {operation: "CreateTask"},
 		PersistenceGetTasksScope:                       {operation: "GetTasks"},
 		PersistenceCompleteTaskScope:                   {operation: "CompleteTask"},
+		PersistenceCompleteTasksLessThanScope:          {operation: "CompleteTasksLessThan"},
 		PersistenceLeaseTaskListScope:                  {operation: "LeaseTaskList"},
 		PersistenceUpdateTaskListScope:                 {operation: "UpdateTaskList"},
+		PersistenceGetTaskListBacklogScope:             {operation: "GetTaskListBacklog"},
 		PersistenceAppendHistoryEventsScope:            {operation: "AppendHistoryEvents"},
+		PersistenceAppendHistoryEventsBatchScope:       {operation: "AppendHistoryEventsBatch"},
 		PersistenceGetWorkflowExecutionHistoryScope:    {operation: "GetWorkflowExecutionHistory"},
 		PersistenceDeleteWorkflowExecutionHistoryScope: {operation: "DeleteWorkflowExecutionHistory"},
+		PersistenceAppendHistoryNodesScope:             {operation: "AppendHistoryNodes"},
+		PersistenceReadHistoryBranchScope:              {operation: "ReadHistoryBranch"},
+		PersistenceForkHistoryBranchScope:              {operation: "ForkHistoryBranch"},
+		PersistenceDeleteHistoryBranchScope:            {operation: "DeleteHistoryBranch"},
+		PersistenceGetHistoryTreeScope:                 {operation: "GetHistoryTree"},
 		PersistenceCreateDomainScope:                   {operation: "CreateDomain"},
 		PersistenceGetDomainScope:                      {operation: "GetDomain"},
 		PersistenceUpdateDomainScope:                   {operation: "UpdateDomain"},
 		PersistenceDeleteDomainScope:                   {operation: "DeleteDomain"},
 		PersistenceDeleteDomainByNameScope:             {operation: "DeleteDomainByName"},
+		PersistenceGetDomainChangesScope:               {operation: "GetDomainChanges"},
+		PersistenceGetMetadataScope:                    {operation: "GetMetadata"},
+		PersistenceCreateBatchOperationScope:           {operation: "CreateBatchOperation"},
+		PersistenceUpdateBatchOperationScope:           {operation: "UpdateBatchOperation"},
+		PersistenceStopBatchOperationScope:             {operation: "StopBatchOperation"},
+		PersistenceGetBatchOperationScope:              {operation: "GetBatchOperation"},
 
-		HistoryClientStartWorkflowExecutionScope:          {operation: "HistoryClientStartWorkflowExecution"},
-		HistoryClientRecordActivityTaskHeartbeatScope:     {operation: "HistoryClientRecordActivityTaskHeartbeat"},
-		HistoryClientRespondDecisionTaskCompletedScope:    {operation: "HistoryClientRespondDecisionTaskCompleted"},
-		HistoryClientRespondActivityTaskCompletedScope:    {operation: "HistoryClientRespondActivityTaskCompleted"},
-		HistoryClientRespondActivityTaskFailedScope:       {operation: "HistoryClientRespondActivityTaskFailed"},
-		HistoryClientRespondActivityTaskCanceledScope:     {operation: "HistoryClientRespondActivityTaskCanceled"},
-		HistoryClientGetWorkflowExecutionNextEventIDScope: {operation: "HistoryClientGetWorkflowExecutionNextEventId"},
-		HistoryClientRecordDecisionTaskStartedScope:       {operation: "HistoryClientRecordDecisionTaskStarted"},
-		HistoryClientRecordActivityTaskStartedScope:       {operation: "HistoryClientRecordActivityTaskStarted"},
-		HistoryClientRequestCancelWorkflowExecutionScope:  {operation: "HistoryClientRequestCancelWorkflowExecution"},
-		HistoryClientSignalWorkflowExecutionScope:         {operation: "HistoryClientSignalWorkflowExecution"},
-		HistoryClientTerminateWorkflowExecutionScope:      {operation: "HistoryClientTerminateWorkflowExecution"},
-		HistoryClientScheduleDecisionTaskScope:            {operation: "HistoryClientScheduleDecisionTask"},
-		HistoryClientRecordChildExecutionCompletedScope:   {operation: "HistoryClientRecordChildExecutionCompleted"},
-		MatchingClientPollForDecisionTaskScope:            {operation: "MatchingClientPollForDecisionTask"},
-		MatchingClientPollForActivityTaskScope:            {operation: "MatchingClientPollForActivityTask"},
-		MatchingClientAddActivityTaskScope:                {operation: "MatchingClientAddActivityTask"},
-		MatchingClientAddDecisionTaskScope:                {operation: "MatchingClientAddDecisionTask"},
+		HistoryClientStartWorkflowExecutionScope:           {operation: "HistoryClientStartWorkflowExecution"},
+		HistoryClientRecordActivityTaskHeartbeatScope:      {operation: "HistoryClientRecordActivityTaskHeartbeat"},
+		HistoryClientRespondDecisionTaskCompletedScope:     {operation: "HistoryClientRespondDecisionTaskCompleted"},
+		HistoryClientRespondActivityTaskCompletedScope:     {operation: "HistoryClientRespondActivityTaskCompleted"},
+		HistoryClientRespondActivityTaskFailedScope:        {operation: "HistoryClientRespondActivityTaskFailed"},
+		HistoryClientRespondActivityTaskCanceledScope:      {operation: "HistoryClientRespondActivityTaskCanceled"},
+		HistoryClientGetWorkflowExecutionNextEventIDScope:  {operation: "HistoryClientGetWorkflowExecutionNextEventId"},
+		HistoryClientRecordDecisionTaskStartedScope:        {operation: "HistoryClientRecordDecisionTaskStarted"},
+		HistoryClientRecordActivityTaskStartedScope:        {operation: "HistoryClientRecordActivityTaskStarted"},
+		HistoryClientRequestCancelWorkflowExecutionScope:   {operation: "HistoryClientRequestCancelWorkflowExecution"},
+		HistoryClientSignalWorkflowExecutionScope:          {operation: "HistoryClientSignalWorkflowExecution"},
+		HistoryClientSignalWithStartWorkflowExecutionScope: {operation: "HistoryClientSignalWithStartWorkflowExecution"},
+		HistoryClientTerminateWorkflowExecutionScope:       {operation: "HistoryClientTerminateWorkflowExecution"},
+		HistoryClientResetWorkflowExecutionScope:           {operation: "HistoryClientResetWorkflowExecution"},
+		HistoryClientScheduleDecisionTaskScope:             {operation: "HistoryClientScheduleDecisionTask"},
+		HistoryClientRecordChildExecutionCompletedScope:    {operation: "HistoryClientRecordChildExecutionCompleted"},
+		HistoryClientDescribeShardScope:                    {operation: "HistoryClientDescribeShard"},
+		HistoryClientInvalidateMutableStateScope:           {operation: "HistoryClientInvalidateMutableState"},
+		HistoryClientSetTaskProcessingPausedScope:          {operation: "HistoryClientSetTaskProcessingPaused"},
+		HistoryClientListConcreteExecutionsScope:           {operation: "HistoryClientListConcreteExecutions"},
+		MatchingClientPollForDecisionTaskScope:             {operation: "MatchingClientPollForDecisionTask"},
+		MatchingClientPollForActivityTaskScope:             {operation: "MatchingClientPollForActivityTask"},
+		MatchingClientAddActivityTaskScope:                 {operation: "MatchingClientAddActivityTask"},
+		MatchingClientAddDecisionTaskScope:                 {operation: "MatchingClientAddDecisionTask"},
+		MatchingClientDrainTaskListScope:                   {operation: "MatchingClientDrainTaskList"},
+		MatchingClientQueryWorkflowScope:                   {operation: "MatchingClientQueryWorkflow"},
+		MatchingClientRespondQueryTaskCompletedScope:       {operation: "MatchingClientRespondQueryTaskCompleted"},
+		DomainCacheScope: {operation: "DomainCache"},
 	},
 	// Frontend Scope Names
 	Frontend: {
-		FrontendStartWorkflowExecutionScope:         {operation: "StartWorkflowExecution"},
-		FrontendPollForDecisionTaskScope:            {operation: "PollForDecisionTask"},
-		FrontendPollForActivityTaskScope:            {operation: "PollForActivityTask"},
-		FrontendRecordActivityTaskHeartbeatScope:    {operation: "RecordActivityTaskHeartbeat"},
-		FrontendRespondDecisionTaskCompletedScope:   {operation: "RespondDecisionTaskCompleted"},
-		FrontendRespondActivityTaskCompletedScope:   {operation: "RespondActivityTaskCompleted"},
-		FrontendRespondActivityTaskFailedScope:      {operation: "RespondActivityTaskFailed"},
-		FrontendRespondActivityTaskCanceledScope:    {operation: "RespondActivityTaskCanceled"},
-		FrontendGetWorkflowExecutionHistoryScope:    {operation: "GetWorkflowExecutionHistory"},
-		FrontendSignalWorkflowExecutionScope:        {operation: "SignalWorkflowExecution"},
-		FrontendTerminateWorkflowExecutionScope:     {operation: "TerminateWorkflowExecution"},
-		FrontendRequestCancelWorkflowExecutionScope: {operation: "RequestCancelWorkflowExecution"},
-		FrontendListOpenWorkflowExecutionsScope:     {operation: "ListOpenWorkflowExecutions"},
-		FrontendListClosedWorkflowExecutionsScope:   {operation: "ListClosedWorkflowExecutions"},
-		FrontendRegisterDomainScope:                 {operation: "RegisterDomain"},
-		FrontendDescribeDomainScope:                 {operation: "DescribeDomain"},
-		FrontendUpdateDomainScope:                   {operation: "UpdateDomain"},
-		FrontendDeprecateDomainScope:                {operation: "DeprecateDomain"},
+		FrontendStartWorkflowExecutionScope:           {operation: "StartWorkflowExecution"},
+		FrontendPollForDecisionTaskScope:              {operation: "PollForDecisionTask"},
+		FrontendPollForActivityTaskScope:              {operation: "PollForActivityTask"},
+		FrontendRecordActivityTaskHeartbeatScope:      {operation: "RecordActivityTaskHeartbeat"},
+		FrontendRespondDecisionTaskCompletedScope:     {operation: "RespondDecisionTaskCompleted"},
+		FrontendRespondActivityTaskCompletedScope:     {operation: "RespondActivityTaskCompleted"},
+		FrontendRespondActivityTaskFailedScope:        {operation: "RespondActivityTaskFailed"},
+		FrontendRespondActivityTaskCanceledScope:      {operation: "RespondActivityTaskCanceled"},
+		FrontendGetWorkflowExecutionHistoryScope:      {operation: "GetWorkflowExecutionHistory"},
+		FrontendSignalWorkflowExecutionScope:          {operation: "SignalWorkflowExecution"},
+		FrontendSignalWithStartWorkflowExecutionScope: {operation: "SignalWithStartWorkflowExecution"},
+		FrontendTerminateWorkflowExecutionScope:       {operation: "TerminateWorkflowExecution"},
+		FrontendResetWorkflowExecutionScope:           {operation: "ResetWorkflowExecution"},
+		FrontendRequestCancelWorkflowExecutionScope:   {operation: "RequestCancelWorkflowExecution"},
+		FrontendQueryWorkflowScope:                    {operation: "QueryWorkflow"},
+		FrontendRespondQueryTaskCompletedScope:        {operation: "RespondQueryTaskCompleted"},
+		FrontendListOpenWorkflowExecutionsScope:       {operation: "ListOpenWorkflowExecutions"},
+		FrontendListClosedWorkflowExecutionsScope:     {operation: "ListClosedWorkflowExecutions"},
+		FrontendScanWorkflowExecutionsScope:           {operation: "ScanWorkflowExecutions"},
+		FrontendCountOpenWorkflowExecutionsScope:      {operation: "CountOpenWorkflowExecutions"},
+		FrontendCountClosedWorkflowExecutionsScope:    {operation: "CountClosedWorkflowExecutions"},
+		FrontendListWorkflowExecutionsWithQueryScope:  {operation: "ListWorkflowExecutionsWithQuery"},
+		FrontendRegisterDomainScope:                   {operation: "RegisterDomain"},
+		FrontendDescribeDomainScope:                   {operation: "DescribeDomain"},
+		FrontendUpdateDomainScope:                     {operation: "UpdateDomain"},
+		FrontendDeprecateDomainScope:                  {operation: "DeprecateDomain"},
+		FrontendGetDomainReplicationMessagesScope:     {operation: "GetDomainReplicationMessages"},
+		FrontendGetWorkflowResultScope:                {operation: "GetWorkflowResult"},
+		FrontendGetClusterInfoScope:                   {operation: "GetClusterInfo"},
+		FrontendStartBatchOperationScope:              {operation: "StartBatchOperation"},
+		FrontendDescribeBatchOperationScope:           {operation: "DescribeBatchOperation"},
+		FrontendStopBatchOperationScope:               {operation: "StopBatchOperation"},
+		FrontendRateLimitCoordinatorScope:             {operation: "RateLimitCoordinator"},
+		FrontendBatcherScope:                          {operation: "Batcher"},
 	},
 	// History Scope Names
 	History: {
-		HistoryStartWorkflowExecutionScope:          {operation: "StartWorkflowExecution"},
-		HistoryRecordActivityTaskHeartbeatScope:     {operation: "RecordActivityTaskHeartbeat"},
-		HistoryRespondDecisionTaskCompletedScope:    {operation: "RespondDecisionTaskCompleted"},
-		HistoryRespondActivityTaskCompletedScope:    {operation: "RespondActivityTaskCompleted"},
-		HistoryRespondActivityTaskFailedScope:       {operation: "RespondActivityTaskFailed"},
-		HistoryRespondActivityTaskCanceledScope:     {operation: "RespondActivityTaskCanceled"},
-		HistoryGetWorkflowExecutionNextEventIDScope: {operation: "GetWorkflowExecutionNextEventIDScope"},
-		HistoryRecordDecisionTaskStartedScope:       {operation: "RecordDecisionTaskStarted"},
-		HistoryRecordActivityTaskStartedScope:       {operation: "RecordActivityTaskStarted"},
-		HistorySignalWorkflowExecutionScope:         {operation: "SignalWorkflowExecution"},
-		HistoryTerminateWorkflowExecutionScope:      {operation: "TerminateWorkflowExecution"},
-		HistoryScheduleDecisionTaskScope:            {operation: "ScheduleDecisionTask"},
-		HistoryRecordChildExecutionCompletedScope:   {operation: "RecordChildExecutionCompleted"},
-		HistoryRequestCancelWorkflowExecutionScope:  {operation: "RequestCancelWorkflowExecution"},
-		TransferQueueProcessorScope:                 {operation: "TransferQueueProcessor"},
-		TransferTaskActivityScope:                   {operation: "TransferTaskActivity"},
-		TransferTaskDecisionScope:                   {operation: "TransferTaskDecision"},
-		TransferTaskDeleteExecutionScope:            {operation: "TransferTaskDeleteExecution"},
-		TransferTaskCancelExecutionScope:            {operation: "TransferTaskCancelExecution"},
-		TransferTaskStartChildExecutionScope:        {operation: "TransferTaskStartChildExecution"},
-		TimerQueueProcessorScope:                    {operation: "TimerQueueProcessor"},
+		HistoryStartWorkflowExecutionScope:           {operation: "StartWorkflowExecution"},
+		HistoryRecordActivityTaskHeartbeatScope:      {operation: "RecordActivityTaskHeartbeat"},
+		HistoryRespondDecisionTaskCompletedScope:     {operation: "RespondDecisionTaskCompleted"},
+		HistoryRespondActivityTaskCompletedScope:     {operation: "RespondActivityTaskCompleted"},
+		HistoryRespondActivityTaskFailedScope:        {operation: "RespondActivityTaskFailed"},
+		HistoryRespondActivityTaskCanceledScope:      {operation: "RespondActivityTaskCanceled"},
+		HistoryGetWorkflowExecutionNextEventIDScope:  {operation: "GetWorkflowExecutionNextEventIDScope"},
+		HistoryRecordDecisionTaskStartedScope:        {operation: "RecordDecisionTaskStarted"},
+		HistoryRecordActivityTaskStartedScope:        {operation: "RecordActivityTaskStarted"},
+		HistorySignalWorkflowExecutionScope:          {operation: "SignalWorkflowExecution"},
+		HistorySignalWithStartWorkflowExecutionScope: {operation: "SignalWithStartWorkflowExecution"},
+		HistoryTerminateWorkflowExecutionScope:       {operation: "TerminateWorkflowExecution"},
+		HistoryResetWorkflowExecutionScope:           {operation: "ResetWorkflowExecution"},
+		HistoryScheduleDecisionTaskScope:             {operation: "ScheduleDecisionTask"},
+		HistoryRecordChildExecutionCompletedScope:    {operation: "RecordChildExecutionCompleted"},
+		HistoryRequestCancelWorkflowExecutionScope:   {operation: "RequestCancelWorkflowExecution"},
+		HistoryDescribeShardScope:                    {operation: "DescribeShard"},
+		HistoryInvalidateMutableStateScope:           {operation: "InvalidateMutableState"},
+		HistorySetTaskProcessingPausedScope:          {operation: "SetTaskProcessingPaused"},
+		HistoryListConcreteExecutionsScope:           {operation: "ListConcreteExecutions"},
+		HistoryShardControllerScope:                  {operation: "ShardController"},
+		HistoryShardLockScope:                        {operation: "ShardLock"},
+		HistoryExecutionLockScope:                    {operation: "ExecutionLock"},
+		HistoryTimeSkewMonitorScope:                  {operation: "TimeSkewMonitor"},
+		HistoryExecutionScannerScope:                 {operation: "ExecutionScanner"},
+		HistoryAppenderScope:                         {operation: "HistoryAppender"},
+		HistoryExecutionUpdateScope:                  {operation: "ExecutionUpdate"},
+		HistoryWorkflowTypeScope:                     {operation: "WorkflowType"},
+		HistoryLoadShedderScope:                      {operation: "LoadShedder"},
+		TransferQueueProcessorScope:                  {operation: "TransferQueueProcessor"},
+		TransferTaskActivityScope:                    {operation: "TransferTaskActivity"},
+		TransferTaskDecisionScope:                    {operation: "TransferTaskDecision"},
+		TransferTaskDeleteExecutionScope:             {operation: "TransferTaskDeleteExecution"},
+		TransferTaskCancelExecutionScope:             {operation: "TransferTaskCancelExecution"},
+		TransferTaskStartChildExecutionScope:         {operation: "TransferTaskStartChildExecution"},
+		TransferTaskCompletionCallbackScope:          {operation: "TransferTaskCompletionCallback"},
+		TimerQueueProcessorScope:                     {operation: "TimerQueueProcessor"},
 	},
 	// Matching Scope Names
 	Matching: {
-		MatchingPollForDecisionTaskScope: {operation: "PollForDecisionTask"},
-		MatchingPollForActivityTaskScope: {operation: "PollForActivityTask"},
-		MatchingAddActivityTaskScope:     {operation: "AddActivityTask"},
-		MatchingAddDecisionTaskScope:     {operation: "AddDecisionTask"},
+		MatchingPollForDecisionTaskScope:       {operation: "PollForDecisionTask"},
+		MatchingPollForActivityTaskScope:       {operation: "PollForActivityTask"},
+		MatchingAddActivityTaskScope:           {operation: "AddActivityTask"},
+		MatchingAddDecisionTaskScope:           {operation: "AddDecisionTask"},
+		MatchingDrainTaskListScope:             {operation: "DrainTaskList"},
+		MatchingQueryWorkflowScope:             {operation: "QueryWorkflow"},
+		MatchingRespondQueryTaskCompletedScope: {operation: "RespondQueryTaskCompleted"},
+		MatchingTaskListMgrScope:               {operation: "TaskListMgr"},
 	},
 }
 
@@ -414,6 +635,9 @@ const (
 	CadenceErrEntityNotExistsCounter
 	CadenceErrExecutionAlreadyStartedCounter
 	CadenceErrDomainAlreadyExistsCounter
+	CadenceErrServiceBusyCounter
+	CadenceErrUnauthorizedCounter
+	CadenceErrQueryFailedCounter
 	PersistenceRequests
 	PersistenceFailures
 	PersistenceLatency
@@ -421,10 +645,33 @@ const (
 	PersistenceErrShardOwnershipLostCounter
 	PersistenceErrConditionFailedCounter
 	PersistenceErrTimeoutCounter
+	PersistenceErrUnavailableCounter
+	PersistenceErrBusyCounter
+	PersistenceErrCorruptCounter
+	PersistenceShadowWriteFailures
+	PersistenceShadowReadDivergences
+	PersistenceTombstoneWarningCounter
+	LockHeldLatency
+	LockMaxHoldGauge
+	LockHoldThresholdExceededCounter
+	DomainCacheEntryAgeGauge
+	DomainCacheRefreshFailures
 
 	NumCommonMetrics
 )
 
+// Frontend Metrics enum
+const (
+	WorkflowNearInfiniteTimeoutGauge = iota + NumCommonMetrics
+	RateLimitHostShareGauge
+	RateLimitCoordinationFallbackCounter
+	DomainRateLimitThrottledCounter
+	HostRateLimitThrottledCounter
+	BatchOperationSucceededCounter
+	BatchOperationSkippedCounter
+	BatchOperationFailedCounter
+)
+
 // History Metrics enum
 const (
 	TaskRequests = iota + NumCommonMetrics
@@ -449,6 +696,59 @@ const (
 	ConcurrencyUpdateFailureCounter
 	CadenceErrEventAlreadyStartedCounter
 	CadenceErrShardOwnershipLostCounter
+	NewTimerNotifyCounter
+	NewTimerNotifySkippedCounter
+	HeartbeatFlushSkippedCounter
+	ShardTransferAckLevelGauge
+	ShardTransferMaxReadLevelGauge
+	ShardMaxTaskIDGauge
+	ShardTimerAckLevelLagGauge
+	PausedShardsGauge
+	PausedDomainsGauge
+	TimeSkewGauge
+	TimeSkewThresholdExceededCounter
+	CompletionCallbackAttemptFailedCounter
+	CompletionCallbackDeadLetteredCounter
+	HistoryArchivedCounter
+	HistoryArchivalFailedCounter
+	ExecutionScannerScannedCounter
+	ExecutionScannerMissingHistoryCounter
+	ExecutionScannerOrphanedCurrentCounter
+	ExecutionScannerZombieActivityCounter
+	ExecutionScannerQuarantinedCounter
+	ExecutionScannerDeletedCounter
+	ExecutionScannerRepairFailedCounter
+	HistoryAppendBatchSizeGauge
+	HistoryAppendBatchFailedCounter
+	ExecutionUpdateMutableStateSizeCounter
+	ExecutionUpdateHistorySizeCounter
+	ExecutionUpdateTransferTasksCounter
+	ExecutionUpdateTimerTasksCounter
+	ExecutionUpdateSizeLimitExceededCounter
+	HistoryCacheStaleHitCounter
+	HotWorkflowSignalCounter
+	HotWorkflowHeartbeatCounter
+	HotWorkflowSignalThrottledCounter
+	TimeoutCappedCounter
+	WorkflowStartedCounter
+	WorkflowClosedCounter
+	WorkflowEndToEndLatency
+	LoadSheddingGauge
+	LoadSheddingEvictedCounter
+	LoadSheddingRejectedCounter
+	TransferTaskUnknownTypeCounter
+)
+
+// Matching Metrics enum
+const (
+	DrainTaskListCounter = iota + NumCommonMetrics
+	DrainedTasksCounter
+	PrefetchBufferTasksGauge
+	PrefetchBufferBytesGauge
+	GetTasksBatchSizeGauge
+	TaskListBacklogGauge
+	TaskListPollersGauge
+	PollThrottleCounter
 )
 
 // MetricDefs record the metrics for all services
@@ -461,6 +761,9 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		CadenceErrEntityNotExistsCounter:         {metricName: "cadence.errors.entity-not-exists", metricType: Counter},
 		CadenceErrExecutionAlreadyStartedCounter: {metricName: "cadence.errors.execution-already-started", metricType: Counter},
 		CadenceErrDomainAlreadyExistsCounter:     {metricName: "cadence.errors.domain-already-exists", metricType: Counter},
+		CadenceErrServiceBusyCounter:             {metricName: "cadence.errors.service-busy", metricType: Counter},
+		CadenceErrUnauthorizedCounter:            {metricName: "cadence.errors.unauthorized", metricType: Counter},
+		CadenceErrQueryFailedCounter:             {metricName: "cadence.errors.query-failed", metricType: Counter},
 		PersistenceRequests:                      {metricName: "persistence.requests", metricType: Counter},
 		PersistenceFailures:                      {metricName: "persistence.errors", metricType: Counter},
 		PersistenceLatency:                       {metricName: "persistence.latency", metricType: Timer},
@@ -468,8 +771,28 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		PersistenceErrShardOwnershipLostCounter:  {metricName: "persistence.errors.shard-ownership-lost", metricType: Counter},
 		PersistenceErrConditionFailedCounter:     {metricName: "persistence.errors.condition-failed", metricType: Counter},
 		PersistenceErrTimeoutCounter:             {metricName: "persistence.errors.timeout", metricType: Counter},
+		PersistenceErrUnavailableCounter:         {metricName: "persistence.errors.unavailable", metricType: Counter},
+		PersistenceErrBusyCounter:                {metricName: "persistence.errors.throttled", metricType: Counter},
+		PersistenceErrCorruptCounter:             {metricName: "persistence.errors.corrupt", metricType: Counter},
+		PersistenceShadowWriteFailures:           {metricName: "persistence.shadow.write-errors", metricType: Counter},
+		PersistenceShadowReadDivergences:         {metricName: "persistence.shadow.read-divergences", metricType: Counter},
+		PersistenceTombstoneWarningCounter:       {metricName: "persistence.tombstone-warnings", metricType: Counter},
+		LockHeldLatency:                          {metricName: "lock.held-latency", metricType: Timer},
+		LockMaxHoldGauge:                         {metricName: "lock.max-hold-ms", metricType: Gauge},
+		LockHoldThresholdExceededCounter:         {metricName: "lock.hold-threshold-exceeded", metricType: Counter},
+		DomainCacheEntryAgeGauge:                 {metricName: "domain-cache.entry-age-ms", metricType: Gauge},
+		DomainCacheRefreshFailures:               {metricName: "domain-cache.refresh-errors", metricType: Counter},
+	},
+	Frontend: {
+		WorkflowNearInfiniteTimeoutGauge:     {metricName: "workflow-near-infinite-timeout", metricType: Gauge},
+		RateLimitHostShareGauge:              {metricName: "rate-limit.host-share", metricType: Gauge},
+		RateLimitCoordinationFallbackCounter: {metricName: "rate-limit.coordination-fallback", metricType: Counter},
+		DomainRateLimitThrottledCounter:      {metricName: "rate-limit.domain-throttled", metricType: Counter},
+		HostRateLimitThrottledCounter:        {metricName: "rate-limit.host-throttled", metricType: Counter},
+		BatchOperationSucceededCounter:       {metricName: "batch-operation.succeeded", metricType: Counter},
+		BatchOperationSkippedCounter:         {metricName: "batch-operation.skipped", metricType: Counter},
+		BatchOperationFailedCounter:          {metricName: "batch-operation.failed", metricType: Counter},
 	},
-	Frontend: {},
 	History: {
 		TaskRequests:                              {metricName: "task.requests", metricType: Counter},
 		TaskFailures:                              {metricName: "task.errors", metricType: Counter},
@@ -493,8 +816,58 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ConcurrencyUpdateFailureCounter:           {metricName: "concurrency-update-failure", metricType: Counter},
 		CadenceErrShardOwnershipLostCounter:       {metricName: "cadence.errors.shard-ownership-lost", metricType: Counter},
 		CadenceErrEventAlreadyStartedCounter:      {metricName: "cadence.errors.event-already-started", metricType: Counter},
+		NewTimerNotifyCounter:                     {metricName: "new-timer-notifications", metricType: Counter},
+		NewTimerNotifySkippedCounter:              {metricName: "new-timer-notifications-skipped", metricType: Counter},
+		HeartbeatFlushSkippedCounter:              {metricName: "heartbeat-flush-skipped", metricType: Counter},
+		ShardTransferAckLevelGauge:                {metricName: "shard.transfer-ack-level", metricType: Gauge},
+		ShardTransferMaxReadLevelGauge:            {metricName: "shard.transfer-max-read-level", metricType: Gauge},
+		ShardMaxTaskIDGauge:                       {metricName: "shard.max-task-id", metricType: Gauge},
+		ShardTimerAckLevelLagGauge:                {metricName: "shard.timer-ack-level-lag", metricType: Gauge},
+		PausedShardsGauge:                         {metricName: "task-processing.paused-shards", metricType: Gauge},
+		PausedDomainsGauge:                        {metricName: "task-processing.paused-domains", metricType: Gauge},
+		TimeSkewGauge:                             {metricName: "time-skew-ms", metricType: Gauge},
+		TimeSkewThresholdExceededCounter:          {metricName: "time-skew-threshold-exceeded", metricType: Counter},
+		CompletionCallbackAttemptFailedCounter:    {metricName: "completion-callback.attempt-failed", metricType: Counter},
+		CompletionCallbackDeadLetteredCounter:     {metricName: "completion-callback.dead-lettered", metricType: Counter},
+		HistoryArchivedCounter:                    {metricName: "history-archived", metricType: Counter},
+		HistoryArchivalFailedCounter:              {metricName: "history-archival-failed", metricType: Counter},
+		ExecutionScannerScannedCounter:            {metricName: "execution-scanner.scanned", metricType: Counter},
+		ExecutionScannerMissingHistoryCounter:     {metricName: "execution-scanner.missing-history", metricType: Counter},
+		ExecutionScannerOrphanedCurrentCounter:    {metricName: "execution-scanner.orphaned-current-execution", metricType: Counter},
+		ExecutionScannerZombieActivityCounter:     {metricName: "execution-scanner.zombie-activity", metricType: Counter},
+		ExecutionScannerQuarantinedCounter:        {metricName: "execution-scanner.quarantined", metricType: Counter},
+		ExecutionScannerDeletedCounter:            {metricName: "execution-scanner.deleted", metricType: Counter},
+		ExecutionScannerRepairFailedCounter:       {metricName: "execution-scanner.repair-failed", metricType: Counter},
+		HistoryAppendBatchSizeGauge:               {metricName: "history-append.batch-size", metricType: Gauge},
+		HistoryAppendBatchFailedCounter:           {metricName: "history-append.batch-failed", metricType: Counter},
+		ExecutionUpdateMutableStateSizeCounter:    {metricName: "execution-update.mutable-state-bytes", metricType: Counter},
+		ExecutionUpdateHistorySizeCounter:         {metricName: "execution-update.history-bytes", metricType: Counter},
+		ExecutionUpdateTransferTasksCounter:       {metricName: "execution-update.transfer-tasks", metricType: Counter},
+		ExecutionUpdateTimerTasksCounter:          {metricName: "execution-update.timer-tasks", metricType: Counter},
+		ExecutionUpdateSizeLimitExceededCounter:   {metricName: "execution-update.size-limit-exceeded", metricType: Counter},
+		HistoryCacheStaleHitCounter:               {metricName: "history-cache.stale-hits", metricType: Counter},
+		HotWorkflowSignalCounter:                  {metricName: "hot-workflow.signals", metricType: Counter},
+		HotWorkflowHeartbeatCounter:               {metricName: "hot-workflow.heartbeats", metricType: Counter},
+		HotWorkflowSignalThrottledCounter:         {metricName: "hot-workflow.signals-throttled", metricType: Counter},
+		TimeoutCappedCounter:                      {metricName: "timeout-capped", metricType: Counter},
+		WorkflowStartedCounter:                    {metricName: "workflow.started", metricType: Counter},
+		WorkflowClosedCounter:                     {metricName: "workflow.closed", metricType: Counter},
+		WorkflowEndToEndLatency:                   {metricName: "workflow.end-to-end-latency", metricType: Timer},
+		LoadSheddingGauge:                         {metricName: "load-shedding.active", metricType: Gauge},
+		LoadSheddingEvictedCounter:                {metricName: "load-shedding.cache-evictions", metricType: Counter},
+		LoadSheddingRejectedCounter:               {metricName: "load-shedding.rejected-starts", metricType: Counter},
+		TransferTaskUnknownTypeCounter:            {metricName: "transfer-task.unknown-type", metricType: Counter},
+	},
+	Matching: {
+		DrainTaskListCounter:     {metricName: "drain-task-list", metricType: Counter},
+		DrainedTasksCounter:      {metricName: "drained-tasks", metricType: Counter},
+		PrefetchBufferTasksGauge: {metricName: "prefetch-buffer-tasks", metricType: Gauge},
+		PrefetchBufferBytesGauge: {metricName: "prefetch-buffer-bytes", metricType: Gauge},
+		GetTasksBatchSizeGauge:   {metricName: "get-tasks-batch-size", metricType: Gauge},
+		TaskListBacklogGauge:     {metricName: "task-list-backlog", metricType: Gauge},
+		TaskListPollersGauge:     {metricName: "task-list-pollers", metricType: Gauge},
+		PollThrottleCounter:      {metricName: "poll-throttle", metricType: Counter},
 	},
-	Matching: {},
 }
 
 // ErrorClass is an enum to help with classifying SLA vs. non-SLA errors (SLA = "service level agreement")
@@ -122,6 +122,29 @@ func (_m *HistoryClient) RecordDecisionTaskStarted(ctx thrift.Context, addReques
 	return r0, r1
 }
 
+// ResetWorkflowExecution provides a mock function with given fields: ctx, resetRequest
+func (_m *HistoryClient) ResetWorkflowExecution(ctx thrift.Context, resetRequest *history.ResetWorkflowExecutionRequest) (*shared.ResetWorkflowExecutionResponse, error) {
+	ret := _m.Called(ctx, resetRequest)
+
+	var r0 *shared.ResetWorkflowExecutionResponse
+	if rf, ok := ret.Get(0).(func(thrift.Context, *history.ResetWorkflowExecutionRequest) *shared.ResetWorkflowExecutionResponse); ok {
+		r0 = rf(ctx, resetRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.ResetWorkflowExecutionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(thrift.Context, *history.ResetWorkflowExecutionRequest) error); ok {
+		r1 = rf(ctx, resetRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RespondActivityTaskCanceled provides a mock function with given fields: ctx, canceledRequest
 func (_m *HistoryClient) RespondActivityTaskCanceled(ctx thrift.Context, canceledRequest *history.RespondActivityTaskCanceledRequest) error {
 	ret := _m.Called(ctx, canceledRequest)
@@ -165,17 +188,49 @@ func (_m *HistoryClient) RespondActivityTaskFailed(ctx thrift.Context, failReque
 }
 
 // RespondDecisionTaskCompleted provides a mock function with given fields: ctx, completeRequest
-func (_m *HistoryClient) RespondDecisionTaskCompleted(ctx thrift.Context, completeRequest *history.RespondDecisionTaskCompletedRequest) error {
+func (_m *HistoryClient) RespondDecisionTaskCompleted(ctx thrift.Context, completeRequest *history.RespondDecisionTaskCompletedRequest) (*shared.RespondDecisionTaskCompletedResponse, error) {
 	ret := _m.Called(ctx, completeRequest)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(thrift.Context, *history.RespondDecisionTaskCompletedRequest) error); ok {
+	var r0 *shared.RespondDecisionTaskCompletedResponse
+	if rf, ok := ret.Get(0).(func(thrift.Context, *history.RespondDecisionTaskCompletedRequest) *shared.RespondDecisionTaskCompletedResponse); ok {
 		r0 = rf(ctx, completeRequest)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.RespondDecisionTaskCompletedResponse)
+		}
 	}
 
-	return r0
+	var r1 error
+	if rf, ok := ret.Get(1).(func(thrift.Context, *history.RespondDecisionTaskCompletedRequest) error); ok {
+		r1 = rf(ctx, completeRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SignalWithStartWorkflowExecution provides a mock function with given fields: ctx, signalWithStartRequest
+func (_m *HistoryClient) SignalWithStartWorkflowExecution(ctx thrift.Context, signalWithStartRequest *history.SignalWithStartWorkflowExecutionRequest) (*shared.StartWorkflowExecutionResponse, error) {
+	ret := _m.Called(ctx, signalWithStartRequest)
+
+	var r0 *shared.StartWorkflowExecutionResponse
+	if rf, ok := ret.Get(0).(func(thrift.Context, *history.SignalWithStartWorkflowExecutionRequest) *shared.StartWorkflowExecutionResponse); ok {
+		r0 = rf(ctx, signalWithStartRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.StartWorkflowExecutionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(thrift.Context, *history.SignalWithStartWorkflowExecutionRequest) error); ok {
+		r1 = rf(ctx, signalWithStartRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // SignalWorkflowExecution provides a mock function with given fields: ctx, signalRequest
@@ -270,3 +325,77 @@ func (_m *HistoryClient) RecordChildExecutionCompleted(ctx thrift.Context, reque
 
 	return r0
 }
+
+// DescribeShard provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) DescribeShard(ctx thrift.Context, request *history.DescribeShardRequest) (*history.DescribeShardResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *history.DescribeShardResponse
+	if rf, ok := ret.Get(0).(func(thrift.Context, *history.DescribeShardRequest) *history.DescribeShardResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*history.DescribeShardResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(thrift.Context, *history.DescribeShardRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InvalidateMutableState provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) InvalidateMutableState(ctx thrift.Context, request *history.InvalidateMutableStateRequest) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(thrift.Context, *history.InvalidateMutableStateRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetTaskProcessingPaused provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) SetTaskProcessingPaused(ctx thrift.Context, request *history.SetTaskProcessingPausedRequest) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(thrift.Context, *history.SetTaskProcessingPausedRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListConcreteExecutions provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) ListConcreteExecutions(ctx thrift.Context, request *history.ListConcreteExecutionsRequest) (*history.ListConcreteExecutionsResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *history.ListConcreteExecutionsResponse
+	if rf, ok := ret.Get(0).(func(thrift.Context, *history.ListConcreteExecutionsRequest) *history.ListConcreteExecutionsResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*history.ListConcreteExecutionsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(thrift.Context, *history.ListConcreteExecutionsRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
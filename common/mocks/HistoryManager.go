@@ -47,6 +47,20 @@ func (_m *HistoryManager) AppendHistoryEvents(request *persistence.AppendHistory
 	return r0
 }
 
+// AppendHistoryEventsBatch provides a mock function with given fields: request
+func (_m *HistoryManager) AppendHistoryEventsBatch(request *persistence.AppendHistoryEventsBatchRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*persistence.AppendHistoryEventsBatchRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteWorkflowExecutionHistory provides a mock function with given fields: request
 func (_m *HistoryManager) DeleteWorkflowExecutionHistory(request *persistence.DeleteWorkflowExecutionHistoryRequest) error {
 	ret := _m.Called(request)
@@ -85,4 +99,101 @@ func (_m *HistoryManager) GetWorkflowExecutionHistory(
 	return r0, r1
 }
 
+// AppendHistoryNodes provides a mock function with given fields: request
+func (_m *HistoryManager) AppendHistoryNodes(request *persistence.AppendHistoryNodesRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*persistence.AppendHistoryNodesRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReadHistoryBranch provides a mock function with given fields: request
+func (_m *HistoryManager) ReadHistoryBranch(request *persistence.ReadHistoryBranchRequest) (*persistence.ReadHistoryBranchResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *persistence.ReadHistoryBranchResponse
+	if rf, ok := ret.Get(0).(func(*persistence.ReadHistoryBranchRequest) *persistence.ReadHistoryBranchResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.ReadHistoryBranchResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*persistence.ReadHistoryBranchRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ForkHistoryBranch provides a mock function with given fields: request
+func (_m *HistoryManager) ForkHistoryBranch(request *persistence.ForkHistoryBranchRequest) (*persistence.ForkHistoryBranchResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *persistence.ForkHistoryBranchResponse
+	if rf, ok := ret.Get(0).(func(*persistence.ForkHistoryBranchRequest) *persistence.ForkHistoryBranchResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.ForkHistoryBranchResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*persistence.ForkHistoryBranchRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteHistoryBranch provides a mock function with given fields: request
+func (_m *HistoryManager) DeleteHistoryBranch(request *persistence.DeleteHistoryBranchRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*persistence.DeleteHistoryBranchRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetHistoryTree provides a mock function with given fields: request
+func (_m *HistoryManager) GetHistoryTree(request *persistence.GetHistoryTreeRequest) (*persistence.GetHistoryTreeResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *persistence.GetHistoryTreeResponse
+	if rf, ok := ret.Get(0).(func(*persistence.GetHistoryTreeRequest) *persistence.GetHistoryTreeResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.GetHistoryTreeResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*persistence.GetHistoryTreeRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 var _ persistence.HistoryManager = (*HistoryManager)(nil)
@@ -59,6 +59,30 @@ func (_m *MatchingClient) AddDecisionTask(ctx thrift.Context, addRequest *matchi
 	return r0
 }
 
+// DrainTaskList provides a mock function with given fields: ctx, drainRequest
+func (_m *MatchingClient) DrainTaskList(ctx thrift.Context,
+	drainRequest *matching.DrainTaskListRequest) (*matching.DrainTaskListResponse, error) {
+	ret := _m.Called(ctx, drainRequest)
+
+	var r0 *matching.DrainTaskListResponse
+	if rf, ok := ret.Get(0).(func(thrift.Context, *matching.DrainTaskListRequest) *matching.DrainTaskListResponse); ok {
+		r0 = rf(ctx, drainRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*matching.DrainTaskListResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(thrift.Context, *matching.DrainTaskListRequest) error); ok {
+		r1 = rf(ctx, drainRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PollForActivityTask provides a mock function with given fields: ctx, pollRequest
 func (_m *MatchingClient) PollForActivityTask(ctx thrift.Context,
 	pollRequest *matching.PollForActivityTaskRequest) (*shared.PollForActivityTaskResponse, error) {
@@ -107,3 +131,42 @@ func (_m *MatchingClient) PollForDecisionTask(ctx thrift.Context,
 
 	return r0, r1
 }
+
+// QueryWorkflow provides a mock function with given fields: ctx, queryRequest
+func (_m *MatchingClient) QueryWorkflow(ctx thrift.Context,
+	queryRequest *matching.QueryWorkflowRequest) (*shared.QueryWorkflowResponse, error) {
+	ret := _m.Called(ctx, queryRequest)
+
+	var r0 *shared.QueryWorkflowResponse
+	if rf, ok := ret.Get(0).(func(thrift.Context, *matching.QueryWorkflowRequest) *shared.QueryWorkflowResponse); ok {
+		r0 = rf(ctx, queryRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.QueryWorkflowResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(thrift.Context, *matching.QueryWorkflowRequest) error); ok {
+		r1 = rf(ctx, queryRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RespondQueryTaskCompleted provides a mock function with given fields: ctx, request
+func (_m *MatchingClient) RespondQueryTaskCompleted(ctx thrift.Context,
+	request *matching.RespondQueryTaskCompletedRequest) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(thrift.Context, *matching.RespondQueryTaskCompletedRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -33,6 +33,20 @@ func (_m *MetadataManager) Close() {
 	_m.Called()
 }
 
+// CreateBatchOperation provides a mock function with given fields: request
+func (_m *MetadataManager) CreateBatchOperation(request *persistence.CreateBatchOperationRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*persistence.CreateBatchOperationRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreateDomain provides a mock function with given fields: request
 func (_m *MetadataManager) CreateDomain(request *persistence.CreateDomainRequest) (*persistence.CreateDomainResponse, error) {
 	ret := _m.Called(request)
@@ -84,6 +98,29 @@ func (_m *MetadataManager) DeleteDomainByName(request *persistence.DeleteDomainB
 	return r0
 }
 
+// GetBatchOperation provides a mock function with given fields: request
+func (_m *MetadataManager) GetBatchOperation(request *persistence.GetBatchOperationRequest) (*persistence.GetBatchOperationResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *persistence.GetBatchOperationResponse
+	if rf, ok := ret.Get(0).(func(*persistence.GetBatchOperationRequest) *persistence.GetBatchOperationResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.GetBatchOperationResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*persistence.GetBatchOperationRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetDomain provides a mock function with given fields: request
 func (_m *MetadataManager) GetDomain(request *persistence.GetDomainRequest) (*persistence.GetDomainResponse, error) {
 	ret := _m.Called(request)
@@ -107,6 +144,80 @@ func (_m *MetadataManager) GetDomain(request *persistence.GetDomainRequest) (*pe
 	return r0, r1
 }
 
+// GetDomainChanges provides a mock function with given fields: request
+func (_m *MetadataManager) GetDomainChanges(request *persistence.GetDomainChangesRequest) (*persistence.GetDomainChangesResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *persistence.GetDomainChangesResponse
+	if rf, ok := ret.Get(0).(func(*persistence.GetDomainChangesRequest) *persistence.GetDomainChangesResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.GetDomainChangesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*persistence.GetDomainChangesRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMetadata provides a mock function with given fields:
+func (_m *MetadataManager) GetMetadata() (*persistence.GetMetadataResponse, error) {
+	ret := _m.Called()
+
+	var r0 *persistence.GetMetadataResponse
+	if rf, ok := ret.Get(0).(func() *persistence.GetMetadataResponse); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.GetMetadataResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StopBatchOperation provides a mock function with given fields: request
+func (_m *MetadataManager) StopBatchOperation(request *persistence.StopBatchOperationRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*persistence.StopBatchOperationRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateBatchOperation provides a mock function with given fields: request
+func (_m *MetadataManager) UpdateBatchOperation(request *persistence.UpdateBatchOperationRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*persistence.UpdateBatchOperationRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateDomain provides a mock function with given fields: request
 func (_m *MetadataManager) UpdateDomain(request *persistence.UpdateDomainRequest) error {
 	ret := _m.Called(request)
@@ -70,6 +70,29 @@ func (_m *ShardManager) GetShard(request *persistence.GetShardRequest) (*persist
 	return r0, r1
 }
 
+// GetServerTime provides a mock function with given fields:
+func (_m *ShardManager) GetServerTime() (*persistence.GetServerTimeResponse, error) {
+	ret := _m.Called()
+
+	var r0 *persistence.GetServerTimeResponse
+	if rf, ok := ret.Get(0).(func() *persistence.GetServerTimeResponse); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.GetServerTimeResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateShard provides a mock function with given fields: request
 func (_m *ShardManager) UpdateShard(request *persistence.UpdateShardRequest) error {
 	ret := _m.Called(request)
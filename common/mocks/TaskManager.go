@@ -79,6 +79,29 @@ func (_m *TaskManager) UpdateTaskList(request *persistence.UpdateTaskListRequest
 	return r0, r1
 }
 
+// GetTaskListBacklog provides a mock function with given fields: request
+func (_m *TaskManager) GetTaskListBacklog(request *persistence.GetTaskListBacklogRequest) (*persistence.GetTaskListBacklogResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *persistence.GetTaskListBacklogResponse
+	if rf, ok := ret.Get(0).(func(*persistence.GetTaskListBacklogRequest) *persistence.GetTaskListBacklogResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.GetTaskListBacklogResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*persistence.GetTaskListBacklogRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CompleteTask provides a mock function with given fields: request
 func (_m *TaskManager) CompleteTask(request *persistence.CompleteTaskRequest) error {
 	ret := _m.Called(request)
@@ -93,6 +116,20 @@ func (_m *TaskManager) CompleteTask(request *persistence.CompleteTaskRequest) er
 	return r0
 }
 
+// CompleteTasksLessThan provides a mock function with given fields: request
+func (_m *TaskManager) CompleteTasksLessThan(request *persistence.CompleteTasksLessThanRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*persistence.CompleteTasksLessThanRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreateTasks provides a mock function with given fields: request
 func (_m *TaskManager) CreateTasks(request *persistence.CreateTasksRequest) (*persistence.CreateTasksResponse, error) {
 	ret := _m.Called(request)
@@ -33,6 +33,20 @@ func (_m *VisibilityManager) Close() {
 	_m.Called()
 }
 
+// DeleteWorkflowExecution provides a mock function with given fields: request
+func (_m *VisibilityManager) DeleteWorkflowExecution(request *persistence.DeleteWorkflowExecutionVisibilityRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*persistence.DeleteWorkflowExecutionVisibilityRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetClosedWorkflowExecution provides a mock function with given fields: request
 func (_m *VisibilityManager) GetClosedWorkflowExecution(request *persistence.GetClosedWorkflowExecutionRequest) (*persistence.GetClosedWorkflowExecutionResponse, error) {
 	ret := _m.Called(request)
@@ -79,6 +93,98 @@ func (_m *VisibilityManager) ListClosedWorkflowExecutions(request *persistence.L
 	return r0, r1
 }
 
+// ScanWorkflowExecutions provides a mock function with given fields: request
+func (_m *VisibilityManager) ScanWorkflowExecutions(request *persistence.ScanWorkflowExecutionsRequest) (*persistence.ListWorkflowExecutionsResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *persistence.ListWorkflowExecutionsResponse
+	if rf, ok := ret.Get(0).(func(*persistence.ScanWorkflowExecutionsRequest) *persistence.ListWorkflowExecutionsResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.ListWorkflowExecutionsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*persistence.ScanWorkflowExecutionsRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListWorkflowExecutionsWithQuery provides a mock function with given fields: request
+func (_m *VisibilityManager) ListWorkflowExecutionsWithQuery(request *persistence.ListWorkflowExecutionsWithQueryRequest) (*persistence.ListWorkflowExecutionsResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *persistence.ListWorkflowExecutionsResponse
+	if rf, ok := ret.Get(0).(func(*persistence.ListWorkflowExecutionsWithQueryRequest) *persistence.ListWorkflowExecutionsResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.ListWorkflowExecutionsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*persistence.ListWorkflowExecutionsWithQueryRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountOpenWorkflowExecutions provides a mock function with given fields: request
+func (_m *VisibilityManager) CountOpenWorkflowExecutions(request *persistence.CountWorkflowExecutionsRequest) (*persistence.CountWorkflowExecutionsResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *persistence.CountWorkflowExecutionsResponse
+	if rf, ok := ret.Get(0).(func(*persistence.CountWorkflowExecutionsRequest) *persistence.CountWorkflowExecutionsResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.CountWorkflowExecutionsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*persistence.CountWorkflowExecutionsRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountClosedWorkflowExecutions provides a mock function with given fields: request
+func (_m *VisibilityManager) CountClosedWorkflowExecutions(request *persistence.CountWorkflowExecutionsRequest) (*persistence.CountWorkflowExecutionsResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *persistence.CountWorkflowExecutionsResponse
+	if rf, ok := ret.Get(0).(func(*persistence.CountWorkflowExecutionsRequest) *persistence.CountWorkflowExecutionsResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.CountWorkflowExecutionsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*persistence.CountWorkflowExecutionsRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListClosedWorkflowExecutionsByStatus provides a mock function with given fields: request
 func (_m *VisibilityManager) ListClosedWorkflowExecutionsByStatus(request *persistence.ListClosedWorkflowExecutionsByStatusRequest) (*persistence.ListWorkflowExecutionsResponse, error) {
 	ret := _m.Called(request)
@@ -0,0 +1,124 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gocql/gocql"
+	"github.com/uber-common/bark"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/metrics"
+)
+
+type (
+	// CassandraConsistency is the consistency of the requests of the cassandra persistence managers.  Consistency
+	// applies to reads and writes, SerialConsistency to the conditional updates.
+	CassandraConsistency struct {
+		Consistency       gocql.Consistency
+		SerialConsistency gocql.SerialConsistency
+	}
+)
+
+var (
+	// DefaultCassandraConsistency is the consistency used unless it is configured, it keeps every request within the
+	// local data center
+	DefaultCassandraConsistency = CassandraConsistency{
+		Consistency:       gocql.LocalQuorum,
+		SerialConsistency: gocql.LocalSerial,
+	}
+
+	cassandraConsistencies = []gocql.Consistency{
+		gocql.Any, gocql.One, gocql.Two, gocql.Three, gocql.Quorum, gocql.All, gocql.LocalQuorum, gocql.EachQuorum,
+		gocql.LocalOne,
+	}
+	cassandraSerialConsistencies = []gocql.SerialConsistency{gocql.Serial, gocql.LocalSerial}
+)
+
+// ParseCassandraConsistency returns the consistency with the given names, e.g. EACH_QUORUM and SERIAL.  Empty names
+// keep the default consistency.
+func ParseCassandraConsistency(consistency string, serialConsistency string) (CassandraConsistency, error) {
+	result := DefaultCassandraConsistency
+	if consistency != "" {
+		found := false
+		for _, c := range cassandraConsistencies {
+			if strings.EqualFold(c.String(), consistency) {
+				result.Consistency = c
+				found = true
+				break
+			}
+		}
+		if !found {
+			return result, fmt.Errorf("unknown cassandra consistency %v", consistency)
+		}
+	}
+	if serialConsistency != "" {
+		found := false
+		for _, c := range cassandraSerialConsistencies {
+			if strings.EqualFold(c.String(), serialConsistency) {
+				result.SerialConsistency = c
+				found = true
+				break
+			}
+		}
+		if !found {
+			return result, fmt.Errorf("unknown cassandra serial consistency %v", serialConsistency)
+		}
+	}
+	return result, nil
+}
+
+// newCassandraCluster returns the config of the cluster of the persistence managers
+func newCassandraCluster(hosts string, dc string, keyspace string,
+	consistency CassandraConsistency) *gocql.ClusterConfig {
+	cluster := common.NewCassandraCluster(hosts, dc)
+	cluster.Keyspace = keyspace
+	cluster.ProtoVersion = cassandraProtoVersion
+	cluster.Consistency = consistency.Consistency
+	cluster.SerialConsistency = consistency.SerialConsistency
+	cluster.Timeout = defaultSessionTimeout
+	return cluster
+}
+
+// reportTombstoneWarnings counts the warnings sent by Cassandra along with the page read by the iterator about the
+// tombstones the read had to scan, which tell the deletes of the partition read are piling up faster than they are
+// compacted
+func reportTombstoneWarnings(iter *gocql.Iter, metricsClient metrics.Client, scope int, logger bark.Logger) {
+	warnings := tombstoneWarnings(iter.Warnings())
+	if len(warnings) == 0 {
+		return
+	}
+	metricsClient.AddCounter(scope, metrics.PersistenceTombstoneWarningCounter, int64(len(warnings)))
+	logger.Warnf("Cassandra read scanned too many tombstones: %v", strings.Join(warnings, "; "))
+}
+
+// tombstoneWarnings returns the warnings about scanned tombstones among the warnings of a Cassandra response
+func tombstoneWarnings(warnings []string) []string {
+	var result []string
+	for _, warning := range warnings {
+		if strings.Contains(strings.ToLower(warning), "tombstone") {
+			result = append(result, warning)
+		}
+	}
+	return result
+}
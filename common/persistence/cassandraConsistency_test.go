@@ -0,0 +1,82 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	cassandraConsistencySuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+	}
+)
+
+func TestCassandraConsistencySuite(t *testing.T) {
+	s := new(cassandraConsistencySuite)
+	suite.Run(t, s)
+}
+
+func (s *cassandraConsistencySuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *cassandraConsistencySuite) TestDefault() {
+	consistency, err := ParseCassandraConsistency("", "")
+	s.Nil(err)
+	s.Equal(DefaultCassandraConsistency, consistency)
+}
+
+func (s *cassandraConsistencySuite) TestParse() {
+	consistency, err := ParseCassandraConsistency("each_quorum", "Serial")
+	s.Nil(err)
+	s.Equal(gocql.EachQuorum, consistency.Consistency)
+	s.Equal(gocql.Serial, consistency.SerialConsistency)
+
+	consistency, err = ParseCassandraConsistency("One", "")
+	s.Nil(err)
+	s.Equal(gocql.One, consistency.Consistency)
+	s.Equal(gocql.LocalSerial, consistency.SerialConsistency)
+}
+
+func (s *cassandraConsistencySuite) TestUnknown() {
+	_, err := ParseCassandraConsistency("most", "")
+	s.NotNil(err)
+
+	_, err = ParseCassandraConsistency("", "QUORUM")
+	s.NotNil(err)
+}
+
+func (s *cassandraConsistencySuite) TestTombstoneWarnings() {
+	s.Empty(tombstoneWarnings(nil))
+	s.Equal([]string{"Read 10 live rows and 5000 tombstone cells for query SELECT timer FROM executions"},
+		tombstoneWarnings([]string{
+			"Aggregation query used without partition key",
+			"Read 10 live rows and 5000 tombstone cells for query SELECT timer FROM executions",
+		}))
+}
@@ -27,7 +27,7 @@ import (
 	"github.com/uber-common/bark"
 
 	workflow "github.com/uber/cadence/.gen/go/shared"
-	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/metrics"
 )
 
 const (
@@ -50,31 +50,54 @@ const (
 		`WHERE domain_id = ? ` +
 		`AND workflow_id = ? ` +
 		`AND run_id = ? `
+
+	templateInsertHistoryNode = `INSERT INTO history_node (` +
+		`tree_id, branch_id, node_id, txn_id, data, data_encoding, data_version) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?)`
+
+	templateReadHistoryNodes = `SELECT node_id, txn_id, data, data_encoding, data_version FROM history_node ` +
+		`WHERE tree_id = ? ` +
+		`AND branch_id = ? ` +
+		`AND node_id >= ? ` +
+		`AND node_id < ? ` +
+		`LIMIT ?`
+
+	templateDeleteHistoryNodes = `DELETE FROM history_node ` +
+		`WHERE tree_id = ? ` +
+		`AND branch_id = ? ` +
+		`AND node_id >= ?`
+
+	templateInsertHistoryBranch = `INSERT INTO history_tree (tree_id, branch_id, ancestors) VALUES (?, ?, ?)`
+
+	templateGetHistoryBranches = `SELECT branch_id, ancestors FROM history_tree ` +
+		`WHERE tree_id = ?`
+
+	templateDeleteHistoryBranch = `DELETE FROM history_tree ` +
+		`WHERE tree_id = ? ` +
+		`AND branch_id = ?`
 )
 
 type (
 	cassandraHistoryPersistence struct {
-		session *gocql.Session
-		logger  bark.Logger
+		session       *gocql.Session
+		metricsClient metrics.Client
+		logger        bark.Logger
 	}
 )
 
+var _ historyBranchStore = (*cassandraHistoryPersistence)(nil)
+
 // NewCassandraHistoryPersistence is used to create an instance of HistoryManager implementation
-func NewCassandraHistoryPersistence(hosts string, dc string, keyspace string, logger bark.Logger) (HistoryManager,
-	error) {
-	cluster := common.NewCassandraCluster(hosts, dc)
-	cluster.Keyspace = keyspace
-	cluster.ProtoVersion = cassandraProtoVersion
-	cluster.Consistency = gocql.LocalQuorum
-	cluster.SerialConsistency = gocql.LocalSerial
-	cluster.Timeout = defaultSessionTimeout
+func NewCassandraHistoryPersistence(hosts string, dc string, keyspace string, consistency CassandraConsistency,
+	metricsClient metrics.Client, logger bark.Logger) (HistoryManager, error) {
+	cluster := newCassandraCluster(hosts, dc, keyspace, consistency)
 
 	session, err := cluster.CreateSession()
 	if err != nil {
 		return nil, err
 	}
 
-	return &cassandraHistoryPersistence{session: session, logger: logger}, nil
+	return &cassandraHistoryPersistence{session: session, metricsClient: metricsClient, logger: logger}, nil
 }
 
 // Close gracefully releases the resources held by this object
@@ -85,57 +108,90 @@ func (h *cassandraHistoryPersistence) Close() {
 }
 
 func (h *cassandraHistoryPersistence) AppendHistoryEvents(request *AppendHistoryEventsRequest) error {
-	var query *gocql.Query
-	if request.Overwrite {
-		query = h.session.Query(templateOverwriteHistoryEvents,
-			request.RangeID,
-			request.TransactionID,
-			request.Events.Data,
-			request.Events.EncodingType,
-			request.Events.Version,
-			request.DomainID,
-			request.Execution.GetWorkflowId(),
-			request.Execution.GetRunId(),
-			request.FirstEventID,
-			request.RangeID,
-			request.TransactionID)
-	} else {
-		query = h.session.Query(templateAppendHistoryEvents,
-			request.DomainID,
-			request.Execution.GetWorkflowId(),
-			request.Execution.GetRunId(),
-			request.FirstEventID,
-			request.RangeID,
-			request.TransactionID,
-			request.Events.Data,
-			request.Events.EncodingType,
-			request.Events.Version)
+	template, args := appendHistoryEventsStatement(request)
+	previous := make(map[string]interface{})
+	applied, err := h.session.Query(template, args...).MapScanCAS(previous)
+	return convertAppendHistoryEventsResult("AppendHistoryEvents", applied, err)
+}
+
+func (h *cassandraHistoryPersistence) AppendHistoryEventsBatch(request *AppendHistoryEventsBatchRequest) error {
+	// Conditional batches cannot span partitions, so the events of every execution are written by a batch of its
+	// own and the batches are executed concurrently
+	var batches []*gocql.Batch
+	var batchRequests [][]int
+	executionBatches := make(map[string]int)
+	for i, r := range request.Requests {
+		key := fmt.Sprintf("%v/%v/%v", r.DomainID, r.Execution.GetWorkflowId(), r.Execution.GetRunId())
+		index, ok := executionBatches[key]
+		if !ok {
+			index = len(batches)
+			executionBatches[key] = index
+			batches = append(batches, h.session.NewBatch(gocql.LoggedBatch))
+			batchRequests = append(batchRequests, nil)
+		}
+		template, args := appendHistoryEventsStatement(r)
+		batches[index].Query(template, args...)
+		batchRequests[index] = append(batchRequests[index], i)
 	}
 
-	previous := make(map[string]interface{})
-	applied, err := query.MapScanCAS(previous)
-	if err != nil {
-		if _, ok := err.(*gocql.RequestErrWriteTimeout); ok {
-			// Write may have succeeded, but we don't know
-			// return this info to the caller so they have the option of trying to find out by executing a read
-			return &TimeoutError{Msg: fmt.Sprintf("AppendHistoryEvents timed out. Error: %v", err)}
+	executeBatch := func(batch *gocql.Batch) error {
+		previous := make(map[string]interface{})
+		applied, _, err := h.session.MapExecuteBatchCAS(batch, previous)
+		return convertAppendHistoryEventsResult("AppendHistoryEventsBatch", applied, err)
+	}
+	if len(batches) == 1 {
+		return executeBatch(batches[0])
+	}
+
+	type batchResult struct {
+		index int
+		err   error
+	}
+	results := make(chan batchResult, len(batches))
+	for index, batch := range batches {
+		go func(index int, batch *gocql.Batch) {
+			results <- batchResult{index: index, err: executeBatch(batch)}
+		}(index, batch)
+	}
+
+	var firstErr error
+	failed := 0
+	errs := make([]error, len(request.Requests))
+	for range batches {
+		result := <-results
+		if result.err == nil {
+			continue
 		}
-		return &workflow.InternalServiceError{
-			Message: fmt.Sprintf("AppendHistoryEvents operation failed. Error: %v", err),
+		failed++
+		if firstErr == nil {
+			firstErr = result.err
+		}
+		for _, i := range batchRequests[result.index] {
+			errs[i] = result.err
 		}
 	}
 
-	if !applied {
-		return &ConditionFailedError{
-			Msg: "Failed to append history events.",
+	switch failed {
+	case 0:
+		return nil
+	case len(batches):
+		return firstErr
+	default:
+		return &PartialAppendError{
+			Msg: fmt.Sprintf("AppendHistoryEventsBatch appended the events of %v of %v executions. Error: %v",
+				len(batches)-failed, len(batches), firstErr),
+			Errors: errs,
 		}
 	}
-
-	return nil
 }
 
 func (h *cassandraHistoryPersistence) GetWorkflowExecutionHistory(request *GetWorkflowExecutionHistoryRequest) (
 	*GetWorkflowExecutionHistoryResponse, error) {
+	pageState, err := deserializePageToken(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
 	execution := request.Execution
 	query := h.session.Query(templateGetWorkflowExecutionHistory,
 		request.DomainID,
@@ -143,7 +199,7 @@ func (h *cassandraHistoryPersistence) GetWorkflowExecutionHistory(request *GetWo
 		execution.GetRunId(),
 		request.NextEventID)
 
-	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
+	iter := query.PageSize(getPageSize(request.PageSize)).PageState(pageState).Iter()
 	if iter == nil {
 		return nil, &workflow.InternalServiceError{
 			Message: "GetWorkflowExecutionHistory operation failed.  Not able to create query iterator.",
@@ -160,13 +216,10 @@ func (h *cassandraHistoryPersistence) GetWorkflowExecutionHistory(request *GetWo
 		history = SerializedHistoryEventBatch{}
 	}
 
-	nextPageToken := iter.PageState()
-	response.NextPageToken = make([]byte, len(nextPageToken))
-	copy(response.NextPageToken, nextPageToken)
+	reportTombstoneWarnings(iter, h.metricsClient, metrics.PersistenceGetWorkflowExecutionHistoryScope, h.logger)
+	response.NextPageToken = serializePageToken(iter.PageState())
 	if err := iter.Close(); err != nil {
-		return nil, &workflow.InternalServiceError{
-			Message: fmt.Sprintf("GetWorkflowExecutionHistory operation failed. Error: %v", err),
-		}
+		return nil, convertCommonErrors("GetWorkflowExecutionHistory", err)
 	}
 
 	if !found {
@@ -189,8 +242,169 @@ func (h *cassandraHistoryPersistence) DeleteWorkflowExecutionHistory(
 
 	err := query.Exec()
 	if err != nil {
-		return &workflow.InternalServiceError{
-			Message: fmt.Sprintf("DeleteWorkflowExecutionHistory operation failed. Error: %v", err),
+		return convertCommonErrors("DeleteWorkflowExecutionHistory", err)
+	}
+
+	return nil
+}
+
+func (h *cassandraHistoryPersistence) AppendHistoryNodes(request *AppendHistoryNodesRequest) error {
+	return appendHistoryNodes(h, request)
+}
+
+func (h *cassandraHistoryPersistence) ReadHistoryBranch(request *ReadHistoryBranchRequest) (
+	*ReadHistoryBranchResponse, error) {
+	return readHistoryBranch(h, request)
+}
+
+func (h *cassandraHistoryPersistence) ForkHistoryBranch(request *ForkHistoryBranchRequest) (
+	*ForkHistoryBranchResponse, error) {
+	return forkHistoryBranch(h, request)
+}
+
+func (h *cassandraHistoryPersistence) DeleteHistoryBranch(request *DeleteHistoryBranchRequest) error {
+	return deleteHistoryBranch(h, request)
+}
+
+func (h *cassandraHistoryPersistence) GetHistoryTree(request *GetHistoryTreeRequest) (*GetHistoryTreeResponse,
+	error) {
+	return getHistoryTree(h, request)
+}
+
+func (h *cassandraHistoryPersistence) insertHistoryNode(branch *HistoryBranch, nodeID int64, txnID int64,
+	events *SerializedHistoryEventBatch) error {
+	return h.session.Query(templateInsertHistoryNode,
+		branch.TreeID,
+		branch.BranchID,
+		nodeID,
+		txnID,
+		events.Data,
+		events.EncodingType,
+		events.Version).Exec()
+}
+
+func (h *cassandraHistoryPersistence) readHistoryNodes(treeID string, branchID string, minNodeID int64,
+	maxNodeID int64, limit int) ([]*historyNode, error) {
+	iter := h.session.Query(templateReadHistoryNodes,
+		treeID,
+		branchID,
+		minNodeID,
+		maxNodeID,
+		limit).Iter()
+
+	var nodes []*historyNode
+	node := &historyNode{}
+	for iter.Scan(&node.nodeID, &node.txnID, &node.events.Data, &node.events.EncodingType, &node.events.Version) {
+		nodes = append(nodes, node)
+		node = &historyNode{}
+	}
+	reportTombstoneWarnings(iter, h.metricsClient, metrics.PersistenceReadHistoryBranchScope, h.logger)
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return nodes, nil
+}
+
+func (h *cassandraHistoryPersistence) deleteHistoryNodes(treeID string, branchID string, minNodeID int64) error {
+	return h.session.Query(templateDeleteHistoryNodes,
+		treeID,
+		branchID,
+		minNodeID).Exec()
+}
+
+func (h *cassandraHistoryPersistence) insertHistoryBranch(branch *HistoryBranch) error {
+	ancestors := make([]map[string]interface{}, 0, len(branch.Ancestors))
+	for _, r := range branch.Ancestors {
+		ancestors = append(ancestors, map[string]interface{}{
+			"branch_id":     r.BranchID,
+			"begin_node_id": r.BeginNodeID,
+			"end_node_id":   r.EndNodeID,
+		})
+	}
+
+	return h.session.Query(templateInsertHistoryBranch,
+		branch.TreeID,
+		branch.BranchID,
+		ancestors).Exec()
+}
+
+func (h *cassandraHistoryPersistence) getHistoryBranches(treeID string) ([]*HistoryBranch, error) {
+	iter := h.session.Query(templateGetHistoryBranches, treeID).Iter()
+
+	var branches []*HistoryBranch
+	var branchID string
+	var ancestors []map[string]interface{}
+	for iter.Scan(&branchID, &ancestors) {
+		branch := &HistoryBranch{TreeID: treeID, BranchID: branchID}
+		for _, r := range ancestors {
+			branch.Ancestors = append(branch.Ancestors, &HistoryBranchRange{
+				BranchID:    r["branch_id"].(gocql.UUID).String(),
+				BeginNodeID: r["begin_node_id"].(int64),
+				EndNodeID:   r["end_node_id"].(int64),
+			})
+		}
+		branches = append(branches, branch)
+		ancestors = nil
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return branches, nil
+}
+
+func (h *cassandraHistoryPersistence) deleteHistoryBranch(treeID string, branchID string) error {
+	return h.session.Query(templateDeleteHistoryBranch,
+		treeID,
+		branchID).Exec()
+}
+
+func (h *cassandraHistoryPersistence) convertHistoryBranchError(operation string, err error) error {
+	return convertCommonErrors(operation, err)
+}
+
+func appendHistoryEventsStatement(request *AppendHistoryEventsRequest) (string, []interface{}) {
+	if request.Overwrite {
+		return templateOverwriteHistoryEvents, []interface{}{
+			request.RangeID,
+			request.TransactionID,
+			request.Events.Data,
+			request.Events.EncodingType,
+			request.Events.Version,
+			request.DomainID,
+			request.Execution.GetWorkflowId(),
+			request.Execution.GetRunId(),
+			request.FirstEventID,
+			request.RangeID,
+			request.TransactionID,
+		}
+	}
+
+	return templateAppendHistoryEvents, []interface{}{
+		request.DomainID,
+		request.Execution.GetWorkflowId(),
+		request.Execution.GetRunId(),
+		request.FirstEventID,
+		request.RangeID,
+		request.TransactionID,
+		request.Events.Data,
+		request.Events.EncodingType,
+		request.Events.Version,
+	}
+}
+
+func convertAppendHistoryEventsResult(operation string, applied bool, err error) error {
+	if err != nil {
+		if _, ok := err.(*gocql.RequestErrWriteTimeout); ok {
+			// Write may have succeeded, but we don't know
+			// return this info to the caller so they have the option of trying to find out by executing a read
+			return &TimeoutError{Msg: fmt.Sprintf("%v timed out. Error: %v", operation, err)}
+		}
+		return convertCommonErrors(operation, err)
+	}
+
+	if !applied {
+		return &ConditionFailedError{
+			Msg: "Failed to append history events.",
 		}
 	}
 
@@ -92,6 +92,82 @@ func (s *historyPersistenceSuite) TestAppendHistoryEvents() {
 	s.Nil(err3)
 }
 
+func (s *historyPersistenceSuite) TestAppendHistoryEventsBatch() {
+	domainID := "6b3a8b1e-2e1f-4c36-9e0a-0c3f6f4b8a52"
+	execution1 := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("append-history-events-batch-test"),
+		RunId:      common.StringPtr("0b5c6a1e-63c2-4f8d-8a57-4c0f8a9f2c11"),
+	}
+	execution2 := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("append-history-events-batch-test"),
+		RunId:      common.StringPtr("9d2f3c6a-7b1e-4e52-a0f4-2f6c3b8d1e07"),
+	}
+
+	newRequest := func(execution gen.WorkflowExecution, firstEventID, txID int64, data string,
+		overwrite bool) *AppendHistoryEventsRequest {
+		return &AppendHistoryEventsRequest{
+			DomainID:      domainID,
+			Execution:     execution,
+			FirstEventID:  firstEventID,
+			RangeID:       1,
+			TransactionID: txID,
+			Events: &SerializedHistoryEventBatch{Version: 1, EncodingType: common.EncodingTypeJSON,
+				Data: []byte(data)},
+			Overwrite: overwrite,
+		}
+	}
+
+	err0 := s.HistoryMgr.AppendHistoryEventsBatch(&AppendHistoryEventsBatchRequest{
+		Requests: []*AppendHistoryEventsRequest{
+			newRequest(execution1, 1, 1, "event1;event2", false),
+			newRequest(execution1, 3, 1, "event3;", false),
+			newRequest(execution2, 1, 1, "event1;", false),
+		},
+	})
+	s.Nil(err0)
+
+	history1, _, err1 := s.GetWorkflowExecutionHistory(domainID, execution1, 4, 10, nil)
+	s.Nil(err1)
+	s.Equal(2, len(history1))
+	s.Equal([]byte("event3;"), history1[1].Data)
+
+	history2, _, err2 := s.GetWorkflowExecutionHistory(domainID, execution2, 2, 10, nil)
+	s.Nil(err2)
+	s.Equal(1, len(history2))
+
+	err3 := s.HistoryMgr.AppendHistoryEventsBatch(&AppendHistoryEventsBatchRequest{
+		Requests: []*AppendHistoryEventsRequest{newRequest(execution1, 3, 1, "event3new;", false)},
+	})
+	s.IsType(&ConditionFailedError{}, err3)
+
+	err4 := s.HistoryMgr.AppendHistoryEventsBatch(&AppendHistoryEventsBatchRequest{
+		Requests: []*AppendHistoryEventsRequest{newRequest(execution1, 3, 2, "event3new;", true)},
+	})
+	s.Nil(err4)
+	// the batches of the other executions are appended when those of an execution fail
+	err5 := s.HistoryMgr.AppendHistoryEventsBatch(&AppendHistoryEventsBatchRequest{
+		Requests: []*AppendHistoryEventsRequest{
+			newRequest(execution1, 4, 3, "event4;", false),
+			newRequest(execution2, 1, 2, "event1new;", false),
+			newRequest(execution2, 2, 2, "event2;", false),
+		},
+	})
+	s.IsType(&PartialAppendError{}, err5)
+	partial := err5.(*PartialAppendError)
+	s.Equal(3, len(partial.Errors))
+	s.Nil(partial.Errors[0])
+	s.IsType(&ConditionFailedError{}, partial.Errors[1])
+	s.IsType(&ConditionFailedError{}, partial.Errors[2])
+
+	history1, _, err1 = s.GetWorkflowExecutionHistory(domainID, execution1, 5, 10, nil)
+	s.Nil(err1)
+	s.Equal(3, len(history1))
+	history2, _, err2 = s.GetWorkflowExecutionHistory(domainID, execution2, 3, 10, nil)
+	s.Nil(err2)
+	s.Equal(1, len(history2))
+	s.Equal([]byte("event1;"), history2[0].Data)
+}
+
 func (s *historyPersistenceSuite) TestGetHistoryEvents() {
 	domainID := "0fdc53ef-b890-4870-a944-b9b028ac9742"
 	workflowExecution := gen.WorkflowExecution{
@@ -188,6 +264,92 @@ func (s *historyPersistenceSuite) TestAppendAndGet() {
 	}
 }
 
+func (s *historyPersistenceSuite) TestHistoryBranches() {
+	treeID := "8e9c4dc3-4b4a-4c5b-9d22-2c1a5d3e7f10"
+	rootToken, err0 := NewHistoryBranchToken(treeID)
+	s.Nil(err0)
+
+	batch := func(data string) *SerializedHistoryEventBatch {
+		return NewSerializedHistoryEventBatch([]byte(data), common.EncodingTypeJSON, 1)
+	}
+	s.Nil(s.AppendHistoryNodes(rootToken, true, 1, 1, batch("event1;event2")))
+	s.Nil(s.AppendHistoryNodes(rootToken, false, 3, 2, batch("event3;event4")))
+	s.Nil(s.AppendHistoryNodes(rootToken, false, 5, 3, batch("event5")))
+
+	forkResponse, err1 := s.HistoryMgr.ForkHistoryBranch(&ForkHistoryBranchRequest{
+		ForkBranchToken: rootToken,
+		ForkNodeID:      5,
+	})
+	s.Nil(err1)
+	forkToken := forkResponse.NewBranchToken
+	s.NotNil(s.AppendHistoryNodes(forkToken, false, 3, 4, batch("event3fork")))
+	s.Nil(s.AppendHistoryNodes(forkToken, false, 5, 4, batch("event5fork")))
+	s.Nil(s.AppendHistoryNodes(forkToken, false, 5, 5, batch("event5forkretry")))
+	s.Nil(s.AppendHistoryNodes(forkToken, false, 6, 6, batch("event6fork")))
+
+	s.Equal([]string{"event1;event2", "event3;event4", "event5"}, s.ReadHistoryBranch(rootToken, 1, 100, 100))
+	s.Equal([]string{"event1;event2", "event3;event4", "event5forkretry", "event6fork"},
+		s.ReadHistoryBranch(forkToken, 1, 100, 2))
+	s.Equal([]string{"event3;event4", "event5forkretry"}, s.ReadHistoryBranch(forkToken, 3, 6, 1))
+
+	tree, err2 := s.HistoryMgr.GetHistoryTree(&GetHistoryTreeRequest{TreeID: treeID})
+	s.Nil(err2)
+	s.Equal(2, len(tree.Branches))
+
+	s.Nil(s.HistoryMgr.DeleteHistoryBranch(&DeleteHistoryBranchRequest{BranchToken: rootToken}))
+	s.Equal([]string{"event1;event2", "event3;event4", "event5forkretry", "event6fork"},
+		s.ReadHistoryBranch(forkToken, 1, 100, 100))
+	// the nodes of the deleted branch are kept as long as the fork shares them
+	s.Equal([]string{"event1;event2", "event3;event4"}, s.ReadHistoryBranch(rootToken, 1, 100, 100))
+
+	s.Nil(s.HistoryMgr.DeleteHistoryBranch(&DeleteHistoryBranchRequest{BranchToken: forkToken}))
+	tree, err3 := s.HistoryMgr.GetHistoryTree(&GetHistoryTreeRequest{TreeID: treeID})
+	s.Nil(err3)
+	s.Equal(0, len(tree.Branches))
+	_, err4 := s.HistoryMgr.ReadHistoryBranch(&ReadHistoryBranchRequest{
+		BranchToken: forkToken,
+		MinEventID:  1,
+		MaxEventID:  100,
+	})
+	s.IsType(&gen.EntityNotExistsError{}, err4)
+}
+
+func (s *historyPersistenceSuite) AppendHistoryNodes(branchToken []byte, isNewBranch bool, nodeID int64,
+	txnID int64, events *SerializedHistoryEventBatch) error {
+	return s.HistoryMgr.AppendHistoryNodes(&AppendHistoryNodesRequest{
+		BranchToken:   branchToken,
+		IsNewBranch:   isNewBranch,
+		NodeID:        nodeID,
+		TransactionID: txnID,
+		Events:        events,
+	})
+}
+
+// ReadHistoryBranch reads all the pages of the branch and returns the data of the batches
+func (s *historyPersistenceSuite) ReadHistoryBranch(branchToken []byte, minEventID int64, maxEventID int64,
+	pageSize int) []string {
+	var data []string
+	var token []byte
+	for {
+		response, err := s.HistoryMgr.ReadHistoryBranch(&ReadHistoryBranchRequest{
+			BranchToken:   branchToken,
+			MinEventID:    minEventID,
+			MaxEventID:    maxEventID,
+			PageSize:      pageSize,
+			NextPageToken: token,
+		})
+		s.Nil(err)
+		s.True(len(response.Events) <= pageSize)
+		for _, events := range response.Events {
+			data = append(data, string(events.Data))
+		}
+		if len(response.NextPageToken) == 0 {
+			return data
+		}
+		token = response.NextPageToken
+	}
+}
+
 func (s *historyPersistenceSuite) AppendHistoryEvents(domainID string, workflowExecution gen.WorkflowExecution,
 	firstEventID, rangeID, txID int64, eventsBatch *SerializedHistoryEventBatch, overwrite bool) error {
 
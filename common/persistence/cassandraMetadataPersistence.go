@@ -22,6 +22,7 @@ package persistence
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/gocql/gocql"
 	"github.com/pborman/uuid"
@@ -42,35 +43,52 @@ const (
 
 	templateDomainConfigType = `{` +
 		`retention: ?, ` +
-		`emit_metric: ?` +
+		`emit_metric: ?, ` +
+		`archival_enabled: ?, ` +
+		`default_task_list: ?, ` +
+		`task_list_overrides: ?, ` +
+		`termination_protected: ?` +
 		`}`
 
 	templateCreateDomainQuery = `INSERT INTO domains (` +
-		`id, domain, config) ` +
-		`VALUES(?, ` + templateDomainType + `, ` + templateDomainConfigType + `)`
+		`id, domain, config, failover_version, notification_version) ` +
+		`VALUES(?, ` + templateDomainType + `, ` + templateDomainConfigType + `, ?, 0)`
 
 	templateCreateDomainByNameQuery = `INSERT INTO domains_by_name (` +
-		`name, domain, config) ` +
-		`VALUES(?, ` + templateDomainType + `, ` + templateDomainConfigType + `) IF NOT EXISTS`
+		`name, domain, config, failover_version, notification_version) ` +
+		`VALUES(?, ` + templateDomainType + `, ` + templateDomainConfigType + `, ?, 0) IF NOT EXISTS`
 
 	templateGetDomainQuery = `SELECT domain.id, domain.name, domain.status, domain.description, domain.owner_email, ` +
-		`config.retention, config.emit_metric ` +
+		`config.retention, config.emit_metric, config.archival_enabled, config.default_task_list, ` +
+		`config.task_list_overrides, config.termination_protected, failover_version, notification_version ` +
 		`FROM domains ` +
 		`WHERE id = ?`
 
 	templateGetDomainByNameQuery = `SELECT domain.id, domain.name, domain.status, domain.description, ` +
-		`domain.owner_email, config.retention, config.emit_metric ` +
+		`domain.owner_email, config.retention, config.emit_metric, config.archival_enabled, ` +
+		`config.default_task_list, config.task_list_overrides, config.termination_protected, failover_version, ` +
+		`notification_version ` +
 		`FROM domains_by_name ` +
 		`WHERE name = ?`
 
 	templateUpdateDomainQuery = `UPDATE domains ` +
 		`SET domain = ` + templateDomainType + `, ` +
-		`config = ` + templateDomainConfigType + ` ` +
+		`config = ` + templateDomainConfigType + `, ` +
+		`failover_version = ? ` +
 		`WHERE id = ?`
 
 	templateUpdateDomainByNameQuery = `UPDATE domains_by_name ` +
 		`SET domain = ` + templateDomainType + `, ` +
-		`config = ` + templateDomainConfigType + ` ` +
+		`config = ` + templateDomainConfigType + `, ` +
+		`failover_version = ? ` +
+		`WHERE name = ?`
+
+	templateUpdateDomainNotificationVersionQuery = `UPDATE domains ` +
+		`SET notification_version = ? ` +
+		`WHERE id = ?`
+
+	templateUpdateDomainByNameNotificationVersionQuery = `UPDATE domains_by_name ` +
+		`SET notification_version = ? ` +
 		`WHERE name = ?`
 
 	templateDeleteDomainQuery = `DELETE FROM domains ` +
@@ -78,6 +96,51 @@ const (
 
 	templateDeleteDomainByNameQuery = `DELETE FROM domains_by_name ` +
 		`WHERE name = ?`
+
+	templateGetLastDomainChangeVersionQuery = `SELECT notification_version ` +
+		`FROM domain_changes ` +
+		`WHERE bucket = ? ` +
+		`ORDER BY notification_version DESC ` +
+		`LIMIT 1`
+
+	templateCreateDomainChangeQuery = `INSERT INTO domain_changes (` +
+		`bucket, notification_version, change_type, domain, config, failover_version) ` +
+		`VALUES(?, ?, ?, ` + templateDomainType + `, ` + templateDomainConfigType + `, ?) IF NOT EXISTS`
+
+	templateGetDomainChangesQuery = `SELECT notification_version, change_type, domain.id, domain.name, ` +
+		`domain.status, domain.description, domain.owner_email, config.retention, config.emit_metric, config.archival_enabled, ` +
+		`config.default_task_list, config.task_list_overrides, config.termination_protected, failover_version ` +
+		`FROM domain_changes ` +
+		`WHERE bucket = ? ` +
+		`AND notification_version > ? ` +
+		`LIMIT ?`
+
+	templateBatchOperationColumns = `id, domain_id, domain_name, operation_type, query, signal_name, signal_input, ` +
+		`reason, identity, rps, state, start_time, close_time, update_time, succeeded, skipped, failed, ` +
+		`close_reason, stop_reason`
+
+	templateCreateBatchOperationQuery = `INSERT INTO batch_operations (` + templateBatchOperationColumns + `) ` +
+		`VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	templateUpdateBatchOperationQuery = `UPDATE batch_operations ` +
+		`SET state = ?, close_time = ?, update_time = ?, succeeded = ?, skipped = ?, failed = ?, ` +
+		`close_reason = ? ` +
+		`WHERE id = ?`
+
+	templateStopBatchOperationQuery = `UPDATE batch_operations ` +
+		`SET stop_reason = ? ` +
+		`WHERE id = ?`
+
+	templateGetBatchOperationQuery = `SELECT ` + templateBatchOperationColumns + ` ` +
+		`FROM batch_operations ` +
+		`WHERE id = ?`
+)
+
+const (
+	// all domain changes live in a single partition so they can be read back in notification version order
+	domainChangesBucket = 0
+	// number of times a domain change is retried when another change takes the same notification version
+	domainChangeMaxAttempts = 5
 )
 
 type (
@@ -88,14 +151,9 @@ type (
 )
 
 // NewCassandraMetadataPersistence is used to create an instance of HistoryManager implementation
-func NewCassandraMetadataPersistence(hosts string, dc string, keyspace string, logger bark.Logger) (MetadataManager,
-	error) {
-	cluster := common.NewCassandraCluster(hosts, dc)
-	cluster.Keyspace = keyspace
-	cluster.ProtoVersion = cassandraProtoVersion
-	cluster.Consistency = gocql.LocalQuorum
-	cluster.SerialConsistency = gocql.LocalSerial
-	cluster.Timeout = defaultSessionTimeout
+func NewCassandraMetadataPersistence(hosts string, dc string, keyspace string, consistency CassandraConsistency,
+	logger bark.Logger) (MetadataManager, error) {
+	cluster := newCassandraCluster(hosts, dc, keyspace, consistency)
 
 	session, err := cluster.CreateSession()
 	if err != nil {
@@ -126,7 +184,12 @@ func (m *cassandraMetadataPersistence) CreateDomain(request *CreateDomainRequest
 		request.Description,
 		request.OwnerEmail,
 		request.Retention,
-		request.EmitMetric).Exec(); err != nil {
+		request.EmitMetric,
+		request.ArchivalEnabled,
+		request.DefaultTaskList,
+		request.TaskListOverrides,
+		request.TerminationProtected,
+		request.FailoverVersion).Exec(); err != nil {
 		return nil, &workflow.InternalServiceError{
 			Message: fmt.Sprintf("CreateDomain operation failed. Inserting into domains table. Error: %v", err),
 		}
@@ -140,7 +203,12 @@ func (m *cassandraMetadataPersistence) CreateDomain(request *CreateDomainRequest
 		request.Description,
 		request.OwnerEmail,
 		request.Retention,
-		request.EmitMetric)
+		request.EmitMetric,
+		request.ArchivalEnabled,
+		request.DefaultTaskList,
+		request.TaskListOverrides,
+		request.TerminationProtected,
+		request.FailoverVersion)
 
 	previous := make(map[string]interface{})
 	applied, err := query.MapScanCAS(previous)
@@ -168,14 +236,32 @@ func (m *cassandraMetadataPersistence) CreateDomain(request *CreateDomainRequest
 		}
 	}
 
+	info := &DomainInfo{
+		ID:          domainUUID,
+		Name:        request.Name,
+		Status:      request.Status,
+		Description: request.Description,
+		OwnerEmail:  request.OwnerEmail,
+	}
+	config := &DomainConfig{
+		Retention:            request.Retention,
+		EmitMetric:           request.EmitMetric,
+		ArchivalEnabled:      request.ArchivalEnabled,
+		DefaultTaskList:      request.DefaultTaskList,
+		TaskListOverrides:    request.TaskListOverrides,
+		TerminationProtected: request.TerminationProtected,
+	}
+	if err := m.recordDomainChange(DomainChangeTypeRegistered, info, config, request.FailoverVersion); err != nil {
+		return nil, err
+	}
+
 	return &CreateDomainResponse{ID: domainUUID}, nil
 }
 
 func (m *cassandraMetadataPersistence) GetDomain(request *GetDomainRequest) (*GetDomainResponse, error) {
 	var query *gocql.Query
 	var err error
-	info := &DomainInfo{}
-	config := &DomainConfig{}
+	response := &GetDomainResponse{Info: &DomainInfo{}, Config: &DomainConfig{}}
 	if len(request.ID) > 0 {
 		if len(request.Name) > 0 {
 			return nil, &workflow.BadRequestError{
@@ -186,24 +272,36 @@ func (m *cassandraMetadataPersistence) GetDomain(request *GetDomainRequest) (*Ge
 		query = m.session.Query(templateGetDomainQuery,
 			request.ID)
 		err = query.Scan(
-			&info.ID,
-			&info.Name,
-			&info.Status,
-			&info.Description,
-			&info.OwnerEmail,
-			&config.Retention,
-			&config.EmitMetric)
+			&response.Info.ID,
+			&response.Info.Name,
+			&response.Info.Status,
+			&response.Info.Description,
+			&response.Info.OwnerEmail,
+			&response.Config.Retention,
+			&response.Config.EmitMetric,
+			&response.Config.ArchivalEnabled,
+			&response.Config.DefaultTaskList,
+			&response.Config.TaskListOverrides,
+			&response.Config.TerminationProtected,
+			&response.FailoverVersion,
+			&response.NotificationVersion)
 	} else if len(request.Name) > 0 {
 		query = m.session.Query(templateGetDomainByNameQuery,
 			request.Name)
 		err = query.Scan(
-			&info.ID,
-			&info.Name,
-			&info.Status,
-			&info.Description,
-			&info.OwnerEmail,
-			&config.Retention,
-			&config.EmitMetric)
+			&response.Info.ID,
+			&response.Info.Name,
+			&response.Info.Status,
+			&response.Info.Description,
+			&response.Info.OwnerEmail,
+			&response.Config.Retention,
+			&response.Config.EmitMetric,
+			&response.Config.ArchivalEnabled,
+			&response.Config.DefaultTaskList,
+			&response.Config.TaskListOverrides,
+			&response.Config.TerminationProtected,
+			&response.FailoverVersion,
+			&response.NotificationVersion)
 	} else {
 		return nil, &workflow.BadRequestError{
 			Message: "GetDomain operation failed.  Both ID and Name are empty.",
@@ -224,15 +322,10 @@ func (m *cassandraMetadataPersistence) GetDomain(request *GetDomainRequest) (*Ge
 			}
 		}
 
-		return nil, &workflow.InternalServiceError{
-			Message: fmt.Sprintf("GetDomain operation failed. Error %v", err),
-		}
+		return nil, convertCommonErrors("GetDomain", err)
 	}
 
-	return &GetDomainResponse{
-		Info:   info,
-		Config: config,
-	}, nil
+	return response, nil
 }
 
 func (m *cassandraMetadataPersistence) UpdateDomain(request *UpdateDomainRequest) error {
@@ -246,6 +339,11 @@ func (m *cassandraMetadataPersistence) UpdateDomain(request *UpdateDomainRequest
 		request.Info.OwnerEmail,
 		request.Config.Retention,
 		request.Config.EmitMetric,
+		request.Config.ArchivalEnabled,
+		request.Config.DefaultTaskList,
+		request.Config.TaskListOverrides,
+		request.Config.TerminationProtected,
+		request.FailoverVersion,
 		request.Info.ID)
 
 	batch.Query(templateUpdateDomainByNameQuery,
@@ -256,15 +354,23 @@ func (m *cassandraMetadataPersistence) UpdateDomain(request *UpdateDomainRequest
 		request.Info.OwnerEmail,
 		request.Config.Retention,
 		request.Config.EmitMetric,
+		request.Config.ArchivalEnabled,
+		request.Config.DefaultTaskList,
+		request.Config.TaskListOverrides,
+		request.Config.TerminationProtected,
+		request.FailoverVersion,
 		request.Info.Name)
 
 	if err := m.session.ExecuteBatch(batch); err != nil {
-		return &workflow.InternalServiceError{
-			Message: fmt.Sprintf("UpdateDomain operation failed. Error %v", err),
-		}
+		return convertCommonErrors("UpdateDomain", err)
 	}
 
-	return nil
+	changeType := DomainChangeTypeUpdated
+	if request.Info.Status == DomainStatusDeprecated {
+		changeType = DomainChangeTypeDeprecated
+	}
+
+	return m.recordDomainChange(changeType, request.Info, request.Config, request.FailoverVersion)
 }
 
 func (m *cassandraMetadataPersistence) DeleteDomain(request *DeleteDomainRequest) error {
@@ -272,9 +378,7 @@ func (m *cassandraMetadataPersistence) DeleteDomain(request *DeleteDomainRequest
 		request.ID)
 
 	if err := query.Exec(); err != nil {
-		return &workflow.InternalServiceError{
-			Message: fmt.Sprintf("DeleteDomain operation failed. Error %v", err),
-		}
+		return convertCommonErrors("DeleteDomain", err)
 	}
 
 	return nil
@@ -285,8 +389,243 @@ func (m *cassandraMetadataPersistence) DeleteDomainByName(request *DeleteDomainB
 		request.Name)
 
 	if err := query.Exec(); err != nil {
+		return convertCommonErrors("DeleteDomainByName", err)
+	}
+
+	return nil
+}
+
+func (m *cassandraMetadataPersistence) GetDomainChanges(request *GetDomainChangesRequest) (*GetDomainChangesResponse,
+	error) {
+	query := m.session.Query(templateGetDomainChangesQuery,
+		domainChangesBucket,
+		request.LastNotificationVersion,
+		getPageSize(request.PageSize))
+
+	iter := query.Iter()
+	if iter == nil {
+		return nil, &workflow.InternalServiceError{
+			Message: "GetDomainChanges operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	response := &GetDomainChangesResponse{}
+	change := &DomainChange{Info: &DomainInfo{}, Config: &DomainConfig{}}
+	for iter.Scan(
+		&change.NotificationVersion,
+		&change.ChangeType,
+		&change.Info.ID,
+		&change.Info.Name,
+		&change.Info.Status,
+		&change.Info.Description,
+		&change.Info.OwnerEmail,
+		&change.Config.Retention,
+		&change.Config.EmitMetric,
+		&change.Config.ArchivalEnabled,
+		&change.Config.DefaultTaskList,
+		&change.Config.TaskListOverrides,
+		&change.Config.TerminationProtected,
+		&change.FailoverVersion) {
+		response.Changes = append(response.Changes, change)
+		change = &DomainChange{Info: &DomainInfo{}, Config: &DomainConfig{}}
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, convertCommonErrors("GetDomainChanges", err)
+	}
+
+	return response, nil
+}
+
+func (m *cassandraMetadataPersistence) GetMetadata() (*GetMetadataResponse, error) {
+	lastVersion, err := m.getLastDomainChangeVersion()
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetMetadataResponse{NotificationVersion: lastVersion}, nil
+}
+
+func (m *cassandraMetadataPersistence) CreateBatchOperation(request *CreateBatchOperationRequest) error {
+	info := request.Info
+	query := m.session.Query(templateCreateBatchOperationQuery,
+		info.ID,
+		info.DomainID,
+		info.DomainName,
+		info.OperationType,
+		info.Query,
+		info.SignalName,
+		info.SignalInput,
+		info.Reason,
+		info.Identity,
+		info.RPS,
+		info.State,
+		cqlTimestampOrNull(info.StartTime),
+		cqlTimestampOrNull(info.CloseTime),
+		cqlTimestampOrNull(info.UpdateTime),
+		info.Succeeded,
+		info.Skipped,
+		info.Failed,
+		info.CloseReason,
+		info.StopReason)
+
+	if err := query.Exec(); err != nil {
+		return convertCommonErrors("CreateBatchOperation", err)
+	}
+
+	return nil
+}
+
+func (m *cassandraMetadataPersistence) UpdateBatchOperation(request *UpdateBatchOperationRequest) error {
+	info := request.Info
+	query := m.session.Query(templateUpdateBatchOperationQuery,
+		info.State,
+		cqlTimestampOrNull(info.CloseTime),
+		cqlTimestampOrNull(info.UpdateTime),
+		info.Succeeded,
+		info.Skipped,
+		info.Failed,
+		info.CloseReason,
+		info.ID)
+
+	if err := query.Exec(); err != nil {
+		return convertCommonErrors("UpdateBatchOperation", err)
+	}
+
+	return nil
+}
+
+func (m *cassandraMetadataPersistence) StopBatchOperation(request *StopBatchOperationRequest) error {
+	query := m.session.Query(templateStopBatchOperationQuery,
+		request.Reason,
+		request.ID)
+
+	if err := query.Exec(); err != nil {
+		return convertCommonErrors("StopBatchOperation", err)
+	}
+
+	return nil
+}
+
+func (m *cassandraMetadataPersistence) GetBatchOperation(request *GetBatchOperationRequest) (*GetBatchOperationResponse,
+	error) {
+	info := &BatchOperationInfo{}
+	err := m.session.Query(templateGetBatchOperationQuery,
+		request.ID).Scan(
+		&info.ID,
+		&info.DomainID,
+		&info.DomainName,
+		&info.OperationType,
+		&info.Query,
+		&info.SignalName,
+		&info.SignalInput,
+		&info.Reason,
+		&info.Identity,
+		&info.RPS,
+		&info.State,
+		&info.StartTime,
+		&info.CloseTime,
+		&info.UpdateTime,
+		&info.Succeeded,
+		&info.Skipped,
+		&info.Failed,
+		&info.CloseReason,
+		&info.StopReason)
+	if err != nil {
+		if err == gocql.ErrNotFound {
+			return nil, &workflow.EntityNotExistsError{
+				Message: fmt.Sprintf("Batch operation %v does not exist.", request.ID),
+			}
+		}
+
+		return nil, convertCommonErrors("GetBatchOperation", err)
+	}
+
+	return &GetBatchOperationResponse{Info: info}, nil
+}
+
+// cqlTimestampOrNull returns the timestamp of a time, or null for the zero time which is read back as the zero time
+func cqlTimestampOrNull(t time.Time) interface{} {
+	if t.IsZero() {
+		return nil
+	}
+	return common.UnixNanoToCQLTimestamp(t.UnixNano())
+}
+
+// getLastDomainChangeVersion returns the notification version of the last domain change, zero if there is none
+func (m *cassandraMetadataPersistence) getLastDomainChangeVersion() (int64, error) {
+	var lastVersion int64
+	err := m.session.Query(templateGetLastDomainChangeVersionQuery,
+		domainChangesBucket).Scan(&lastVersion)
+	if err != nil && err != gocql.ErrNotFound {
+		return 0, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("Failed to read last domain change version. Error: %v", err),
+		}
+	}
+
+	return lastVersion, nil
+}
+
+// recordDomainChange appends a change to the domain_changes table using the next notification version, then stores
+// the version on the domain.  The version is claimed with a conditional insert, so concurrent changes retry with a
+// newer version.  Like CreateDomain this is not atomic with the write to the domains tables; if it fails the caller
+// gets an error but the domain write stays.
+func (m *cassandraMetadataPersistence) recordDomainChange(changeType int, info *DomainInfo,
+	config *DomainConfig, failoverVersion int64) error {
+	for attempt := 0; attempt < domainChangeMaxAttempts; attempt++ {
+		lastVersion, err := m.getLastDomainChangeVersion()
+		if err != nil {
+			return err
+		}
+
+		query := m.session.Query(templateCreateDomainChangeQuery,
+			domainChangesBucket,
+			lastVersion+1,
+			changeType,
+			info.ID,
+			info.Name,
+			info.Status,
+			info.Description,
+			info.OwnerEmail,
+			config.Retention,
+			config.EmitMetric,
+			config.ArchivalEnabled,
+			config.DefaultTaskList,
+			config.TaskListOverrides,
+			config.TerminationProtected,
+			failoverVersion)
+
+		previous := make(map[string]interface{})
+		applied, err := query.MapScanCAS(previous)
+		if err != nil {
+			return &workflow.InternalServiceError{
+				Message: fmt.Sprintf("Failed to record domain change. Error: %v", err),
+			}
+		}
+
+		if applied {
+			return m.updateDomainNotificationVersion(info, lastVersion+1)
+		}
+	}
+
+	return &workflow.InternalServiceError{
+		Message: fmt.Sprintf("Failed to record domain change for domain %v after %v attempts.", info.Name,
+			domainChangeMaxAttempts),
+	}
+}
+
+func (m *cassandraMetadataPersistence) updateDomainNotificationVersion(info *DomainInfo, version int64) error {
+	batch := m.session.NewBatch(gocql.LoggedBatch)
+	batch.Query(templateUpdateDomainNotificationVersionQuery,
+		version,
+		info.ID)
+	batch.Query(templateUpdateDomainByNameNotificationVersionQuery,
+		version,
+		info.Name)
+
+	if err := m.session.ExecuteBatch(batch); err != nil {
 		return &workflow.InternalServiceError{
-			Message: fmt.Sprintf("DeleteDomainByName operation failed. Error %v", err),
+			Message: fmt.Sprintf("Failed to update domain notification version. Error: %v", err),
 		}
 	}
 
@@ -23,8 +23,10 @@ package persistence
 import (
 	"os"
 	"testing"
+	"time"
 
 	log "github.com/Sirupsen/logrus"
+	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
@@ -194,6 +196,9 @@ func (m *metadataPersistenceSuite) TestUpdateDomain() {
 	updatedOwner := "owner-updated"
 	updatedRetention := int32(20)
 	updatedEmitMetric := false
+	updatedDefaultTaskList := "default-task-list-updated"
+	updatedTaskListOverrides := map[string]string{"workflow-type": "task-list-updated"}
+	updatedTerminationProtected := true
 
 	err3 := m.UpdateDomain(
 		&DomainInfo{
@@ -204,8 +209,11 @@ func (m *metadataPersistenceSuite) TestUpdateDomain() {
 			OwnerEmail:  updatedOwner,
 		},
 		&DomainConfig{
-			Retention:  updatedRetention,
-			EmitMetric: updatedEmitMetric,
+			Retention:            updatedRetention,
+			EmitMetric:           updatedEmitMetric,
+			DefaultTaskList:      updatedDefaultTaskList,
+			TaskListOverrides:    updatedTaskListOverrides,
+			TerminationProtected: updatedTerminationProtected,
 		})
 
 	m.Nil(err3)
@@ -220,6 +228,9 @@ func (m *metadataPersistenceSuite) TestUpdateDomain() {
 	m.Equal(updatedOwner, resp4.Info.OwnerEmail)
 	m.Equal(updatedRetention, resp4.Config.Retention)
 	m.Equal(updatedEmitMetric, resp4.Config.EmitMetric)
+	m.Equal(updatedDefaultTaskList, resp4.Config.DefaultTaskList)
+	m.Equal(updatedTaskListOverrides, resp4.Config.TaskListOverrides)
+	m.Equal(updatedTerminationProtected, resp4.Config.TerminationProtected)
 
 	resp5, err5 := m.GetDomain("", name)
 	m.Nil(err5)
@@ -282,14 +293,169 @@ func (m *metadataPersistenceSuite) TestDeleteDomain() {
 	m.Nil(resp7)
 }
 
+func (m *metadataPersistenceSuite) TestGetDomainChanges() {
+	lastVersion := m.getLastDomainChangeVersion()
+
+	name := "domain-changes-test-name"
+	info := &DomainInfo{
+		Name:        name,
+		Status:      DomainStatusRegistered,
+		Description: "domain-changes-test-description",
+		OwnerEmail:  "domain-changes-test-owner",
+	}
+	config := &DomainConfig{
+		Retention:  10,
+		EmitMetric: true,
+	}
+
+	resp1, err1 := m.CreateDomain(info, config)
+	m.Nil(err1)
+	info.ID = resp1.ID
+
+	info.Description = "description-updated"
+	err2 := m.UpdateDomain(info, config)
+	m.Nil(err2)
+
+	info.Status = DomainStatusDeprecated
+	err3 := m.UpdateDomain(info, config)
+	m.Nil(err3)
+
+	resp4, err4 := m.MetadataManager.GetDomainChanges(&GetDomainChangesRequest{
+		LastNotificationVersion: lastVersion,
+		PageSize:                10,
+	})
+	m.Nil(err4)
+	m.Equal(3, len(resp4.Changes))
+	for i, changeType := range []int{DomainChangeTypeRegistered, DomainChangeTypeUpdated,
+		DomainChangeTypeDeprecated} {
+		change := resp4.Changes[i]
+		m.Equal(lastVersion+int64(i)+1, change.NotificationVersion)
+		m.Equal(changeType, change.ChangeType)
+		m.Equal(resp1.ID, change.Info.ID)
+		m.Equal(name, change.Info.Name)
+		m.Equal(config.Retention, change.Config.Retention)
+	}
+	m.Equal("domain-changes-test-description", resp4.Changes[0].Info.Description)
+	m.Equal("description-updated", resp4.Changes[1].Info.Description)
+	m.Equal(DomainStatusDeprecated, resp4.Changes[2].Info.Status)
+
+	resp5, err5 := m.MetadataManager.GetDomainChanges(&GetDomainChangesRequest{
+		LastNotificationVersion: lastVersion + 1,
+		PageSize:                1,
+	})
+	m.Nil(err5)
+	m.Equal(1, len(resp5.Changes))
+	m.Equal(DomainChangeTypeUpdated, resp5.Changes[0].ChangeType)
+}
+
+func (m *metadataPersistenceSuite) TestDomainVersions() {
+	name := "domain-versions-test-name"
+	resp1, err1 := m.MetadataManager.CreateDomain(&CreateDomainRequest{
+		Name:            name,
+		Status:          DomainStatusRegistered,
+		OwnerEmail:      "domain-versions-test-owner",
+		Retention:       10,
+		FailoverVersion: 5,
+	})
+	m.Nil(err1)
+
+	metadata1, err := m.MetadataManager.GetMetadata()
+	m.Nil(err)
+	m.Equal(m.getLastDomainChangeVersion(), metadata1.NotificationVersion)
+
+	resp2, err2 := m.GetDomain(resp1.ID, "")
+	m.Nil(err2)
+	m.Equal(int64(5), resp2.FailoverVersion)
+	m.Equal(metadata1.NotificationVersion, resp2.NotificationVersion)
+
+	err3 := m.MetadataManager.UpdateDomain(&UpdateDomainRequest{
+		Info:            resp2.Info,
+		Config:          resp2.Config,
+		FailoverVersion: 6,
+	})
+	m.Nil(err3)
+
+	metadata2, err := m.MetadataManager.GetMetadata()
+	m.Nil(err)
+	m.Equal(metadata1.NotificationVersion+1, metadata2.NotificationVersion)
+
+	resp4, err4 := m.GetDomain("", name)
+	m.Nil(err4)
+	m.Equal(int64(6), resp4.FailoverVersion)
+	m.Equal(metadata2.NotificationVersion, resp4.NotificationVersion)
+
+	resp5, err5 := m.MetadataManager.GetDomainChanges(&GetDomainChangesRequest{
+		LastNotificationVersion: metadata1.NotificationVersion - 1,
+		PageSize:                10,
+	})
+	m.Nil(err5)
+	m.Equal(2, len(resp5.Changes))
+	m.Equal(int64(5), resp5.Changes[0].FailoverVersion)
+	m.Equal(int64(6), resp5.Changes[1].FailoverVersion)
+}
+
+func (m *metadataPersistenceSuite) TestBatchOperation() {
+	startTime := time.Unix(0, 0).Add(time.Hour)
+	info := &BatchOperationInfo{
+		ID:            uuid.New(),
+		DomainID:      uuid.New(),
+		DomainName:    "batch-operation-test-domain",
+		OperationType: int(gen.BatchOperationType_SIGNAL),
+		Query:         "WorkflowType = 'batch-operation-test-type'",
+		SignalName:    "batch-operation-test-signal",
+		SignalInput:   []byte("input"),
+		Identity:      "batch-operation-test-identity",
+		RPS:           50,
+		State:         int(gen.BatchOperationState_RUNNING),
+		StartTime:     startTime,
+		UpdateTime:    startTime,
+	}
+	m.Nil(m.MetadataManager.CreateBatchOperation(&CreateBatchOperationRequest{Info: info}))
+
+	resp1, err1 := m.MetadataManager.GetBatchOperation(&GetBatchOperationRequest{ID: info.ID})
+	m.Nil(err1)
+	m.Equal(info.DomainName, resp1.Info.DomainName)
+	m.Equal(info.SignalInput, resp1.Info.SignalInput)
+	m.Equal(info.RPS, resp1.Info.RPS)
+	m.True(startTime.Equal(resp1.Info.StartTime))
+	m.True(resp1.Info.CloseTime.IsZero())
+
+	m.Nil(m.MetadataManager.StopBatchOperation(&StopBatchOperationRequest{ID: info.ID, Reason: "Stopped."}))
+
+	// Recording the progress of the batch keeps the stop reason set through another host
+	info.State = int(gen.BatchOperationState_STOPPED)
+	info.Succeeded = 3
+	info.Skipped = 2
+	info.Failed = 1
+	info.UpdateTime = startTime.Add(time.Minute)
+	info.CloseTime = info.UpdateTime
+	info.CloseReason = "Stopped."
+	m.Nil(m.MetadataManager.UpdateBatchOperation(&UpdateBatchOperationRequest{Info: info}))
+
+	resp2, err2 := m.MetadataManager.GetBatchOperation(&GetBatchOperationRequest{ID: info.ID})
+	m.Nil(err2)
+	m.Equal(int(gen.BatchOperationState_STOPPED), resp2.Info.State)
+	m.Equal(int64(3), resp2.Info.Succeeded)
+	m.Equal(int64(2), resp2.Info.Skipped)
+	m.Equal(int64(1), resp2.Info.Failed)
+	m.Equal("Stopped.", resp2.Info.StopReason)
+	m.True(info.CloseTime.Equal(resp2.Info.CloseTime))
+
+	_, err3 := m.MetadataManager.GetBatchOperation(&GetBatchOperationRequest{ID: uuid.New()})
+	m.IsType(&gen.EntityNotExistsError{}, err3)
+}
+
 func (m *metadataPersistenceSuite) CreateDomain(info *DomainInfo, config *DomainConfig) (*CreateDomainResponse, error) {
 	return m.MetadataManager.CreateDomain(&CreateDomainRequest{
-		Name:        info.Name,
-		Status:      info.Status,
-		Description: info.Description,
-		OwnerEmail:  info.OwnerEmail,
-		Retention:   config.Retention,
-		EmitMetric:  config.EmitMetric,
+		Name:                 info.Name,
+		Status:               info.Status,
+		Description:          info.Description,
+		OwnerEmail:           info.OwnerEmail,
+		Retention:            config.Retention,
+		EmitMetric:           config.EmitMetric,
+		DefaultTaskList:      config.DefaultTaskList,
+		TaskListOverrides:    config.TaskListOverrides,
+		TerminationProtected: config.TerminationProtected,
 	})
 }
 
@@ -313,3 +479,17 @@ func (m *metadataPersistenceSuite) DeleteDomain(id, name string) error {
 	}
 	return m.MetadataManager.DeleteDomainByName(&DeleteDomainByNameRequest{Name: name})
 }
+
+func (m *metadataPersistenceSuite) getLastDomainChangeVersion() int64 {
+	var lastVersion int64
+	for {
+		resp, err := m.MetadataManager.GetDomainChanges(&GetDomainChangesRequest{
+			LastNotificationVersion: lastVersion,
+		})
+		m.Nil(err)
+		if len(resp.Changes) == 0 {
+			return lastVersion
+		}
+		lastVersion = resp.Changes[len(resp.Changes)-1].NotificationVersion
+	}
+}
@@ -31,6 +31,7 @@ import (
 
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/metrics"
 )
 
 const (
@@ -109,7 +110,13 @@ const (
 		`decision_schedule_id: ?, ` +
 		`decision_started_id: ?, ` +
 		`decision_request_id: ?, ` +
-		`decision_timeout: ?` +
+		`decision_timeout: ?, ` +
+		`completion_callback_url: ?, ` +
+		`cancel_requested: ?, ` +
+		`cancel_request_id: ?, ` +
+		`first_execution_run_id: ?, ` +
+		`trace_id: ?, ` +
+		`search_attributes: ?` +
 		`}`
 
 	templateTransferTaskType = `{` +
@@ -122,7 +129,8 @@ const (
 		`target_run_id: ?, ` +
 		`task_list: ?, ` +
 		`type: ?, ` +
-		`schedule_id: ?` +
+		`schedule_id: ?, ` +
+		`callback_url: ?` +
 		`}`
 
 	templateTimerTaskType = `{` +
@@ -136,43 +144,12 @@ const (
 		`event_id: ?` +
 		`}`
 
-	templateActivityInfoType = `{` +
-		`schedule_id: ?, ` +
-		`scheduled_event: ?, ` +
-		`started_id: ?, ` +
-		`started_event: ?, ` +
-		`activity_id: ?, ` +
-		`request_id: ?, ` +
-		`details: ?, ` +
-		`schedule_to_start_timeout: ?, ` +
-		`schedule_to_close_timeout: ?, ` +
-		`start_to_close_timeout: ?, ` +
-		`heart_beat_timeout: ?, ` +
-		`cancel_requested: ?, ` +
-		`cancel_request_id: ?, ` +
-		`last_hb_updated_time: ?` +
-		`}`
-
-	templateTimerInfoType = `{` +
-		`timer_id: ?, ` +
-		`started_id: ?, ` +
-		`expiry_time: ?, ` +
-		`task_id: ?` +
-		`}`
-
-	templateChildExecutionInfoType = `{` +
-		`initiated_id: ?, ` +
-		`initiated_event: ?, ` +
-		`started_id: ?, ` +
-		`started_event: ?, ` +
-		`create_request_id: ?` +
-		`}`
-
 	templateTaskListType = `{` +
 		`domain_id: ?, ` +
 		`name: ?, ` +
 		`type: ?, ` +
-		`ack_level: ? ` +
+		`ack_level: ?, ` +
+		`pollers: ? ` +
 		`}`
 
 	templateTaskType = `{` +
@@ -196,6 +173,8 @@ const (
 		`and visibility_ts = ? ` +
 		`and task_id = ?`
 
+	templateGetServerTimeQuery = `SELECT toUnixTimestamp(now()) FROM system.local`
+
 	templateUpdateShardQuery = `UPDATE executions ` +
 		`SET shard = ` + templateShardType + `, range_id = ? ` +
 		`WHERE shard_id = ? ` +
@@ -217,6 +196,9 @@ const (
 		`and visibility_ts = ? ` +
 		`and task_id = ? `
 
+	templateUpdateCurrentWorkflowExecutionOfRunQuery = templateUpdateCurrentWorkflowExecutionQuery +
+		`IF current_run_id = ?`
+
 	templateCreateWorkflowExecutionQuery = `INSERT INTO executions (` +
 		`shard_id, type, domain_id, workflow_id, run_id, visibility_ts, task_id, current_run_id, execution) ` +
 		`VALUES(?, ?, ?, ?, ?, ?, ?, ?, {run_id: ?, create_request_id: ?}) IF NOT EXISTS`
@@ -238,7 +220,18 @@ const (
 		`WHERE shard_id = ? ` +
 		`IF range_id = ?`
 
-	templateGetWorkflowExecutionQuery = `SELECT execution, activity_map, timer_map, child_executions_map ` +
+	templateGetWorkflowExecutionQuery = `SELECT execution, activity_map, timer_map, child_executions_map, activity_blob_map, timer_blob_map, ` +
+		`child_executions_blob_map, signal_requested, signal_requested_times ` +
+		`FROM executions ` +
+		`WHERE shard_id = ? ` +
+		`and type = ? ` +
+		`and domain_id = ? ` +
+		`and workflow_id = ? ` +
+		`and run_id = ? ` +
+		`and visibility_ts = ? ` +
+		`and task_id = ?`
+
+	templateGetWorkflowExecutionInfoQuery = `SELECT execution ` +
 		`FROM executions ` +
 		`WHERE shard_id = ? ` +
 		`and type = ? ` +
@@ -258,6 +251,16 @@ const (
 		`and visibility_ts = ? ` +
 		`and task_id = ?`
 
+	templateListWorkflowExecutionQuery = `SELECT run_id, execution ` +
+		`FROM executions ` +
+		`WHERE shard_id = ? ` +
+		`and type = ?`
+
+	templateListCurrentExecutionQuery = `SELECT domain_id, workflow_id, run_id, current_run_id ` +
+		`FROM executions ` +
+		`WHERE shard_id = ? ` +
+		`and type = ?`
+
 	templateUpdateWorkflowExecutionQuery = `UPDATE executions ` +
 		`SET execution = ` + templateWorkflowExecutionType + `, next_event_id = ? ` +
 		`WHERE shard_id = ? ` +
@@ -270,7 +273,7 @@ const (
 		`IF next_event_id = ? and range_id = ?`
 
 	templateUpdateActivityInfoQuery = `UPDATE executions ` +
-		`SET activity_map[ ? ] =` + templateActivityInfoType + ` ` +
+		`SET activity_blob_map[ ? ] = ? ` +
 		`WHERE shard_id = ? ` +
 		`and type = ? ` +
 		`and domain_id = ? ` +
@@ -281,7 +284,7 @@ const (
 		`IF next_event_id = ? and range_id = ?`
 
 	templateUpdateTimerInfoQuery = `UPDATE executions ` +
-		`SET timer_map[ ? ] =` + templateTimerInfoType + ` ` +
+		`SET timer_blob_map[ ? ] = ? ` +
 		`WHERE shard_id = ? ` +
 		`and type = ? ` +
 		`and domain_id = ? ` +
@@ -291,8 +294,28 @@ const (
 		`and task_id = ? ` +
 		`IF next_event_id = ? and range_id = ?`
 
+	templateCreateActivityInfoQuery = `UPDATE executions ` +
+		`SET activity_blob_map[ ? ] = ? ` +
+		`WHERE shard_id = ? ` +
+		`and type = ? ` +
+		`and domain_id = ? ` +
+		`and workflow_id = ? ` +
+		`and run_id = ? ` +
+		`and visibility_ts = ? ` +
+		`and task_id = ? `
+
+	templateCreateTimerInfoQuery = `UPDATE executions ` +
+		`SET timer_blob_map[ ? ] = ? ` +
+		`WHERE shard_id = ? ` +
+		`and type = ? ` +
+		`and domain_id = ? ` +
+		`and workflow_id = ? ` +
+		`and run_id = ? ` +
+		`and visibility_ts = ? ` +
+		`and task_id = ? `
+
 	templateUpdateChildExecutionInfoQuery = `UPDATE executions ` +
-		`SET child_executions_map[ ? ] =` + templateChildExecutionInfoType + ` ` +
+		`SET child_executions_blob_map[ ? ] = ? ` +
 		`WHERE shard_id = ? ` +
 		`and type = ? ` +
 		`and domain_id = ? ` +
@@ -302,7 +325,29 @@ const (
 		`and task_id = ? ` +
 		`IF next_event_id = ? and range_id = ?`
 
-	templateDeleteActivityInfoQuery = `DELETE activity_map[ ? ] ` +
+	templateUpdateSignalRequestedQuery = `UPDATE executions ` +
+		`SET signal_requested_times = signal_requested_times + ? ` +
+		`WHERE shard_id = ? ` +
+		`and type = ? ` +
+		`and domain_id = ? ` +
+		`and workflow_id = ? ` +
+		`and run_id = ? ` +
+		`and visibility_ts = ? ` +
+		`and task_id = ? ` +
+		`IF next_event_id = ? and range_id = ?`
+
+	templateDeleteSignalRequestedQuery = `UPDATE executions ` +
+		`SET signal_requested = signal_requested - ?, signal_requested_times = signal_requested_times - ? ` +
+		`WHERE shard_id = ? ` +
+		`and type = ? ` +
+		`and domain_id = ? ` +
+		`and workflow_id = ? ` +
+		`and run_id = ? ` +
+		`and visibility_ts = ? ` +
+		`and task_id = ? ` +
+		`IF next_event_id = ? and range_id = ?`
+
+	templateDeleteActivityInfoQuery = `DELETE activity_map[ ? ], activity_blob_map[ ? ] ` +
 		`FROM executions ` +
 		`WHERE shard_id = ? ` +
 		`and type = ? ` +
@@ -313,7 +358,7 @@ const (
 		`and task_id = ? ` +
 		`IF next_event_id = ? and range_id = ?`
 
-	templateDeleteTimerInfoQuery = `DELETE timer_map[ ? ] ` +
+	templateDeleteTimerInfoQuery = `DELETE timer_map[ ? ], timer_blob_map[ ? ] ` +
 		`FROM executions ` +
 		`WHERE shard_id = ? ` +
 		`and type = ? ` +
@@ -324,7 +369,7 @@ const (
 		`and task_id = ? ` +
 		`IF next_event_id = ? and range_id = ?`
 
-	templateDeleteChildExecutionInfoQuery = `DELETE child_executions_map[ ? ] ` +
+	templateDeleteChildExecutionInfoQuery = `DELETE child_executions_map[ ? ], child_executions_blob_map[ ? ] ` +
 		`FROM executions ` +
 		`WHERE shard_id = ? ` +
 		`and type = ? ` +
@@ -353,6 +398,9 @@ const (
 		`and visibility_ts = ? ` +
 		`and task_id = ? `
 
+	templateDeleteCurrentWorkflowExecutionQuery = templateDeleteWorkflowExecutionQuery +
+		`IF current_run_id = ?`
+
 	templateGetTransferTasksQuery = `SELECT transfer ` +
 		`FROM executions ` +
 		`WHERE shard_id = ? ` +
@@ -362,7 +410,7 @@ const (
 		`and run_id = ? ` +
 		`and visibility_ts = ? ` +
 		`and task_id > ? ` +
-		`and task_id <= ? LIMIT ?`
+		`and task_id <= ?`
 
 	templateCompleteTransferTaskQuery = `DELETE FROM executions ` +
 		`WHERE shard_id = ? ` +
@@ -373,6 +421,16 @@ const (
 		`and visibility_ts = ? ` +
 		`and task_id = ?`
 
+	templateRangeCompleteTransferTaskQuery = `DELETE FROM executions ` +
+		`WHERE shard_id = ? ` +
+		`and type = ? ` +
+		`and domain_id = ? ` +
+		`and workflow_id = ? ` +
+		`and run_id = ? ` +
+		`and visibility_ts = ? ` +
+		`and task_id > ? ` +
+		`and task_id <= ?`
+
 	templateGetTimerTasksQuery = `SELECT timer ` +
 		`FROM executions ` +
 		`WHERE shard_id = ? ` +
@@ -381,7 +439,7 @@ const (
 		`and workflow_id = ?` +
 		`and run_id = ?` +
 		`and visibility_ts >= ? ` +
-		`and visibility_ts < ? LIMIT ?`
+		`and visibility_ts < ?`
 
 	templateCompleteTimerTaskQuery = `DELETE FROM executions ` +
 		`WHERE shard_id = ? ` +
@@ -392,6 +450,15 @@ const (
 		`and visibility_ts = ? ` +
 		`and task_id = ?`
 
+	templateRangeCompleteTimerTaskQuery = `DELETE FROM executions ` +
+		`WHERE shard_id = ? ` +
+		`and type = ? ` +
+		`and domain_id = ? ` +
+		`and workflow_id = ? ` +
+		`and run_id = ? ` +
+		`and visibility_ts >= ? ` +
+		`and visibility_ts < ?`
+
 	templateCreateTaskQuery = `INSERT INTO tasks (` +
 		`domain_id, task_list_name, task_list_type, type, task_id, task) ` +
 		`VALUES(?, ?, ?, ?, ?, ` + templateTaskType + `)`
@@ -409,6 +476,14 @@ const (
 		`and task_id > ? ` +
 		`and task_id <= ? LIMIT ?`
 
+	templateGetTaskListBacklogQuery = `SELECT COUNT(*) ` +
+		`FROM tasks ` +
+		`WHERE domain_id = ? ` +
+		`and task_list_name = ? ` +
+		`and task_list_type = ? ` +
+		`and type = ? ` +
+		`and task_id > ?`
+
 	templateCompleteTaskQuery = `DELETE FROM tasks ` +
 		`WHERE domain_id = ? ` +
 		`and task_list_name = ? ` +
@@ -416,6 +491,13 @@ const (
 		`and type = ? ` +
 		`and task_id = ?`
 
+	templateCompleteTasksLessThanQuery = `DELETE FROM tasks ` +
+		`WHERE domain_id = ? ` +
+		`and task_list_name = ? ` +
+		`and task_list_type = ? ` +
+		`and type = ? ` +
+		`and task_id < ?`
+
 	templateGetTaskList = `SELECT ` +
 		`range_id, ` +
 		`task_list ` +
@@ -461,21 +543,18 @@ var (
 
 type (
 	cassandraPersistence struct {
-		session      *gocql.Session
-		lowConslevel gocql.Consistency
-		shardID      int
-		logger       bark.Logger
+		session       *gocql.Session
+		lowConslevel  gocql.Consistency
+		shardID       int
+		metricsClient metrics.Client
+		logger        bark.Logger
 	}
 )
 
 // NewCassandraShardPersistence is used to create an instance of ShardManager implementation
-func NewCassandraShardPersistence(hosts string, dc string, keyspace string, logger bark.Logger) (ShardManager, error) {
-	cluster := common.NewCassandraCluster(hosts, dc)
-	cluster.Keyspace = keyspace
-	cluster.ProtoVersion = cassandraProtoVersion
-	cluster.Consistency = gocql.LocalQuorum
-	cluster.SerialConsistency = gocql.LocalSerial
-	cluster.Timeout = defaultSessionTimeout
+func NewCassandraShardPersistence(hosts string, dc string, keyspace string, consistency CassandraConsistency,
+	logger bark.Logger) (ShardManager, error) {
+	cluster := newCassandraCluster(hosts, dc, keyspace, consistency)
 
 	session, err := cluster.CreateSession()
 	if err != nil {
@@ -486,36 +565,31 @@ func NewCassandraShardPersistence(hosts string, dc string, keyspace string, logg
 }
 
 // NewCassandraWorkflowExecutionPersistence is used to create an instance of workflowExecutionManager implementation
-func NewCassandraWorkflowExecutionPersistence(hosts string, dc string, keyspace string, shardID int, logger bark.Logger) (ExecutionManager, error) {
-	cluster := common.NewCassandraCluster(hosts, dc)
-	cluster.Keyspace = keyspace
-	cluster.ProtoVersion = cassandraProtoVersion
-	cluster.Consistency = gocql.LocalQuorum
-	cluster.SerialConsistency = gocql.LocalSerial
-	cluster.Timeout = defaultSessionTimeout
+func NewCassandraWorkflowExecutionPersistence(hosts string, dc string, keyspace string,
+	consistency CassandraConsistency, shardID int, metricsClient metrics.Client, logger bark.Logger) (ExecutionManager,
+	error) {
+	cluster := newCassandraCluster(hosts, dc, keyspace, consistency)
 
 	session, err := cluster.CreateSession()
 	if err != nil {
 		return nil, err
 	}
 
-	return &cassandraPersistence{shardID: shardID, session: session, lowConslevel: gocql.One, logger: logger}, nil
+	return &cassandraPersistence{shardID: shardID, session: session, lowConslevel: gocql.One,
+		metricsClient: metricsClient, logger: logger}, nil
 }
 
 // NewCassandraTaskPersistence is used to create an instance of TaskManager implementation
-func NewCassandraTaskPersistence(hosts string, dc string, keyspace string, logger bark.Logger) (TaskManager, error) {
-	cluster := common.NewCassandraCluster(hosts, dc)
-	cluster.Keyspace = keyspace
-	cluster.ProtoVersion = cassandraProtoVersion
-	cluster.Consistency = gocql.LocalQuorum
-	cluster.SerialConsistency = gocql.LocalSerial
-	cluster.Timeout = defaultSessionTimeout
+func NewCassandraTaskPersistence(hosts string, dc string, keyspace string, consistency CassandraConsistency,
+	metricsClient metrics.Client, logger bark.Logger) (TaskManager, error) {
+	cluster := newCassandraCluster(hosts, dc, keyspace, consistency)
 
 	session, err := cluster.CreateSession()
 	if err != nil {
 		return nil, err
 	}
-	return &cassandraPersistence{shardID: -1, session: session, lowConslevel: gocql.One, logger: logger}, nil
+	return &cassandraPersistence{shardID: -1, session: session, lowConslevel: gocql.One,
+		metricsClient: metricsClient, logger: logger}, nil
 }
 
 // Close releases the underlying resources held by this object
@@ -548,9 +622,7 @@ func (d *cassandraPersistence) CreateShard(request *CreateShardRequest) error {
 	previous := make(map[string]interface{})
 	applied, err := query.MapScanCAS(previous)
 	if err != nil {
-		return &workflow.InternalServiceError{
-			Message: fmt.Sprintf("CreateShard operation failed. Error : %v", err),
-		}
+		return convertCommonErrors("CreateShard", err)
 	}
 
 	if !applied {
@@ -583,9 +655,7 @@ func (d *cassandraPersistence) GetShard(request *GetShardRequest) (*GetShardResp
 			}
 		}
 
-		return nil, &workflow.InternalServiceError{
-			Message: fmt.Sprintf("GetShard operation failed. Error: %v", err),
-		}
+		return nil, convertCommonErrors("GetShard", err)
 	}
 
 	info := createShardInfo(result["shard"].(map[string]interface{}))
@@ -593,6 +663,16 @@ func (d *cassandraPersistence) GetShard(request *GetShardRequest) (*GetShardResp
 	return &GetShardResponse{ShardInfo: info}, nil
 }
 
+func (d *cassandraPersistence) GetServerTime() (*GetServerTimeResponse, error) {
+	var millis int64
+	query := d.session.Query(templateGetServerTimeQuery).Consistency(d.lowConslevel)
+	if err := query.Scan(&millis); err != nil {
+		return nil, convertCommonErrors("GetServerTime", err)
+	}
+
+	return &GetServerTimeResponse{Time: time.Unix(0, millis*int64(time.Millisecond))}, nil
+}
+
 func (d *cassandraPersistence) UpdateShard(request *UpdateShardRequest) error {
 	cqlNowTimestamp := common.UnixNanoToCQLTimestamp(time.Now().UnixNano())
 	shardInfo := request.ShardInfo
@@ -618,9 +698,7 @@ func (d *cassandraPersistence) UpdateShard(request *UpdateShardRequest) error {
 	previous := make(map[string]interface{})
 	applied, err := query.MapScanCAS(previous)
 	if err != nil {
-		return &workflow.InternalServiceError{
-			Message: fmt.Sprintf("UpdateShard operation failed. Error: %v", err),
-		}
+		return convertCommonErrors("UpdateShard", err)
 	}
 
 	if !applied {
@@ -645,7 +723,9 @@ func (d *cassandraPersistence) CreateWorkflowExecution(request *CreateWorkflowEx
 	cqlNowTimestamp := common.UnixNanoToCQLTimestamp(time.Now().UnixNano())
 	batch := d.session.NewBatch(gocql.LoggedBatch)
 
-	d.CreateWorkflowExecutionWithinBatch(request, batch, cqlNowTimestamp)
+	if err := d.CreateWorkflowExecutionWithinBatch(request, batch, cqlNowTimestamp); err != nil {
+		return nil, err
+	}
 
 	d.createTransferTasks(batch, request.TransferTasks, request.DomainID, request.Execution.GetWorkflowId(),
 		request.Execution.GetRunId(), cqlNowTimestamp)
@@ -666,9 +746,7 @@ func (d *cassandraPersistence) CreateWorkflowExecution(request *CreateWorkflowEx
 			// return this info to the caller so they have the option of trying to find out by executing a read
 			return nil, &TimeoutError{Msg: fmt.Sprintf("CreateWorkflowExecution timed out. Error: %v", err)}
 		}
-		return nil, &workflow.InternalServiceError{
-			Message: fmt.Sprintf("CreateWorkflowExecution operation failed. Error: %v", err),
-		}
+		return nil, convertCommonErrors("CreateWorkflowExecution", err)
 	}
 
 	if !applied {
@@ -707,8 +785,11 @@ func (d *cassandraPersistence) CreateWorkflowExecution(request *CreateWorkflowEx
 }
 
 func (d *cassandraPersistence) CreateWorkflowExecutionWithinBatch(request *CreateWorkflowExecutionRequest,
-	batch *gocql.Batch, cqlNowTimestamp int64) {
-	if request.ContinueAsNew {
+	batch *gocql.Batch, cqlNowTimestamp int64) error {
+	switch {
+	case request.CurrentExecutionRecorded:
+		// The current execution already points at the run
+	case request.ContinueAsNew:
 		batch.Query(templateUpdateCurrentWorkflowExecutionQuery,
 			request.Execution.GetRunId(),
 			request.Execution.GetRunId(),
@@ -720,7 +801,7 @@ func (d *cassandraPersistence) CreateWorkflowExecutionWithinBatch(request *Creat
 			permanentRunID,
 			defaultVisibilityTimestamp,
 			rowTypeExecutionTaskID)
-	} else {
+	default:
 		batch.Query(templateCreateWorkflowExecutionQuery,
 			d.shardID,
 			rowTypeExecution,
@@ -774,15 +855,61 @@ func (d *cassandraPersistence) CreateWorkflowExecutionWithinBatch(request *Creat
 		request.DecisionStartedID,
 		"", // Decision Start Request ID
 		request.DecisionStartToCloseTimeout,
+		request.CompletionCallbackURL,
+		false, // Cancel Requested
+		"",    // Cancel Request ID
+		request.FirstExecutionRunID,
+		request.TraceID,
+		request.SearchAttributes,
 		request.NextEventID,
 		defaultVisibilityTimestamp,
 		rowTypeExecutionTaskID)
+
+	for _, a := range request.ActivityInfos {
+		blob, err := serializeActivityInfo(a)
+		if err != nil {
+			return newMutableStateBlobError("activity info", err)
+		}
+		batch.Query(templateCreateActivityInfoQuery,
+			a.ScheduleID,
+			blob,
+			d.shardID,
+			rowTypeExecution,
+			request.DomainID,
+			request.Execution.GetWorkflowId(),
+			request.Execution.GetRunId(),
+			defaultVisibilityTimestamp,
+			rowTypeExecutionTaskID)
+	}
+
+	for _, t := range request.TimerInfos {
+		blob, err := serializeTimerInfo(t)
+		if err != nil {
+			return newMutableStateBlobError("timer info", err)
+		}
+		batch.Query(templateCreateTimerInfoQuery,
+			t.TimerID,
+			blob,
+			d.shardID,
+			rowTypeExecution,
+			request.DomainID,
+			request.Execution.GetWorkflowId(),
+			request.Execution.GetRunId(),
+			defaultVisibilityTimestamp,
+			rowTypeExecutionTaskID)
+	}
+
+	return nil
 }
 
 func (d *cassandraPersistence) GetWorkflowExecution(request *GetWorkflowExecutionRequest) (
 	*GetWorkflowExecutionResponse, error) {
 	execution := request.Execution
-	query := d.session.Query(templateGetWorkflowExecutionQuery,
+	template := templateGetWorkflowExecutionQuery
+	if request.Projection == MutableStateProjectionExecutionInfo {
+		template = templateGetWorkflowExecutionInfoQuery
+	}
+	query := d.session.Query(template,
 		d.shardID,
 		rowTypeExecution,
 		request.DomainID,
@@ -800,14 +927,15 @@ func (d *cassandraPersistence) GetWorkflowExecution(request *GetWorkflowExecutio
 			}
 		}
 
-		return nil, &workflow.InternalServiceError{
-			Message: fmt.Sprintf("GetWorkflowExecution operation failed. Error: %v", err),
-		}
+		return nil, convertCommonErrors("GetWorkflowExecution", err)
 	}
 
 	state := &WorkflowMutableState{}
 	info := createWorkflowExecutionInfo(result["execution"].(map[string]interface{}))
 	state.ExecutionInfo = info
+	if request.Projection == MutableStateProjectionExecutionInfo {
+		return &GetWorkflowExecutionResponse{State: state}, nil
+	}
 
 	activityInfos := make(map[int64]*ActivityInfo)
 	aMap := result["activity_map"].(map[int64]map[string]interface{})
@@ -815,6 +943,13 @@ func (d *cassandraPersistence) GetWorkflowExecution(request *GetWorkflowExecutio
 		info := createActivityInfo(value)
 		activityInfos[key] = info
 	}
+	for key, value := range result["activity_blob_map"].(map[int64][]byte) {
+		info, err := deserializeActivityInfo(value)
+		if err != nil {
+			return nil, newMutableStateBlobError("activity info", err)
+		}
+		activityInfos[key] = info
+	}
 	state.ActivitInfos = activityInfos
 
 	timerInfos := make(map[string]*TimerInfo)
@@ -823,6 +958,13 @@ func (d *cassandraPersistence) GetWorkflowExecution(request *GetWorkflowExecutio
 		info := createTimerInfo(value)
 		timerInfos[key] = info
 	}
+	for key, value := range result["timer_blob_map"].(map[string][]byte) {
+		info, err := deserializeTimerInfo(value)
+		if err != nil {
+			return nil, newMutableStateBlobError("timer info", err)
+		}
+		timerInfos[key] = info
+	}
 	state.TimerInfos = timerInfos
 
 	childExecutionInfos := make(map[int64]*ChildExecutionInfo)
@@ -831,12 +973,30 @@ func (d *cassandraPersistence) GetWorkflowExecution(request *GetWorkflowExecutio
 		info := createChildExecutionInfo(value)
 		childExecutionInfos[key] = info
 	}
+	for key, value := range result["child_executions_blob_map"].(map[int64][]byte) {
+		info, err := deserializeChildExecutionInfo(value)
+		if err != nil {
+			return nil, newMutableStateBlobError("child execution info", err)
+		}
+		childExecutionInfos[key] = info
+	}
 	state.ChildExecutionInfos = childExecutionInfos
 
+	// The IDs recorded by older servers are in the set, without the time they were recorded
+	signalRequestedIDs := make(map[string]time.Time)
+	for _, requestID := range result["signal_requested"].([]string) {
+		signalRequestedIDs[requestID] = time.Time{}
+	}
+	for requestID, recorded := range result["signal_requested_times"].(map[string]time.Time) {
+		signalRequestedIDs[requestID] = recorded
+	}
+	state.SignalRequestedIDs = signalRequestedIDs
+
 	return &GetWorkflowExecutionResponse{State: state}, nil
 }
 
-func (d *cassandraPersistence) UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) error {
+func (d *cassandraPersistence) UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) (
+	*UpdateWorkflowExecutionResponse, error) {
 	executionInfo := request.ExecutionInfo
 	cqlNowTimestamp := common.UnixNanoToCQLTimestamp(time.Now().UnixNano())
 
@@ -865,6 +1025,12 @@ func (d *cassandraPersistence) UpdateWorkflowExecution(request *UpdateWorkflowEx
 		executionInfo.DecisionStartedID,
 		executionInfo.DecisionRequestID,
 		executionInfo.DecisionTimeout,
+		executionInfo.CompletionCallbackURL,
+		executionInfo.CancelRequested,
+		executionInfo.CancelRequestID,
+		executionInfo.FirstExecutionRunID,
+		executionInfo.TraceID,
+		executionInfo.SearchAttributes,
 		executionInfo.NextEventID,
 		d.shardID,
 		rowTypeExecution,
@@ -882,20 +1048,65 @@ func (d *cassandraPersistence) UpdateWorkflowExecution(request *UpdateWorkflowEx
 	d.createTimerTasks(batch, request.TimerTasks, request.DeleteTimerTask, request.ExecutionInfo.DomainID,
 		executionInfo.WorkflowID, executionInfo.RunID, cqlNowTimestamp)
 
-	d.updateActivityInfos(batch, request.UpsertActivityInfos, request.DeleteActivityInfo, executionInfo.DomainID,
-		executionInfo.WorkflowID, executionInfo.RunID, request.Condition, request.RangeID)
+	if err := d.updateActivityInfos(batch, request.UpsertActivityInfos, request.DeleteActivityInfo,
+		executionInfo.DomainID, executionInfo.WorkflowID, executionInfo.RunID, request.Condition,
+		request.RangeID); err != nil {
+		return nil, err
+	}
 
-	d.updateTimerInfos(batch, request.UpserTimerInfos, request.DeleteTimerInfos, executionInfo.DomainID,
-		executionInfo.WorkflowID, executionInfo.RunID, request.Condition, request.RangeID)
+	if err := d.updateTimerInfos(batch, request.UpserTimerInfos, request.DeleteTimerInfos, executionInfo.DomainID,
+		executionInfo.WorkflowID, executionInfo.RunID, request.Condition, request.RangeID); err != nil {
+		return nil, err
+	}
+
+	if err := d.updateChildExecutionInfos(batch, request.UpsertChildExecutionInfos,
+		request.DeleteChildExecutionInfo, executionInfo.DomainID, executionInfo.WorkflowID, executionInfo.RunID,
+		request.Condition, request.RangeID); err != nil {
+		return nil, err
+	}
+
+	if len(request.UpsertSignalRequestedIDs) > 0 {
+		signalRequestedTimes := make(map[string]int64, len(request.UpsertSignalRequestedIDs))
+		for _, requestID := range request.UpsertSignalRequestedIDs {
+			signalRequestedTimes[requestID] = cqlNowTimestamp
+		}
+		batch.Query(templateUpdateSignalRequestedQuery,
+			signalRequestedTimes,
+			d.shardID,
+			rowTypeExecution,
+			executionInfo.DomainID,
+			executionInfo.WorkflowID,
+			executionInfo.RunID,
+			defaultVisibilityTimestamp,
+			rowTypeExecutionTaskID,
+			request.Condition,
+			request.RangeID)
+	}
 
-	d.updateChildExecutionInfos(batch, request.UpsertChildExecutionInfos, request.DeleteChildExecutionInfo,
-		executionInfo.DomainID, executionInfo.WorkflowID, executionInfo.RunID, request.Condition, request.RangeID)
+	if len(request.DeleteSignalRequestedIDs) > 0 {
+		batch.Query(templateDeleteSignalRequestedQuery,
+			request.DeleteSignalRequestedIDs,
+			request.DeleteSignalRequestedIDs,
+			d.shardID,
+			rowTypeExecution,
+			executionInfo.DomainID,
+			executionInfo.WorkflowID,
+			executionInfo.RunID,
+			defaultVisibilityTimestamp,
+			rowTypeExecutionTaskID,
+			request.Condition,
+			request.RangeID)
+	}
 
 	if request.ContinueAsNew != nil {
 		startReq := request.ContinueAsNew
-		d.CreateWorkflowExecutionWithinBatch(startReq, batch, cqlNowTimestamp)
+		if err := d.CreateWorkflowExecutionWithinBatch(startReq, batch, cqlNowTimestamp); err != nil {
+			return nil, err
+		}
 		d.createTransferTasks(batch, startReq.TransferTasks, startReq.DomainID, startReq.Execution.GetWorkflowId(),
 			startReq.Execution.GetRunId(), cqlNowTimestamp)
+		d.createTimerTasks(batch, startReq.TimerTasks, nil, startReq.DomainID, startReq.Execution.GetWorkflowId(),
+			startReq.Execution.GetRunId(), cqlNowTimestamp)
 	} else if request.CloseExecution {
 		// Delete WorkflowExecution row representing current execution
 		batch.Query(templateDeleteWorkflowExecutionQuery,
@@ -914,17 +1125,15 @@ func (d *cassandraPersistence) UpdateWorkflowExecution(request *UpdateWorkflowEx
 		if isTimeoutError(err) {
 			// Write may have succeeded, but we don't know
 			// return this info to the caller so they have the option of trying to find out by executing a read
-			return &TimeoutError{Msg: fmt.Sprintf("UpdateWorkflowExecution timed out. Error: %v", err)}
-		}
-		return &workflow.InternalServiceError{
-			Message: fmt.Sprintf("UpdateWorkflowExecution operation failed. Error: %v", err),
+			return nil, &TimeoutError{Msg: fmt.Sprintf("UpdateWorkflowExecution timed out. Error: %v", err)}
 		}
+		return nil, convertCommonErrors("UpdateWorkflowExecution", err)
 	}
 
 	if !applied {
 		if rangeID, ok := previous["range_id"].(int64); ok && rangeID != request.RangeID {
 			// UpdateWorkflowExecution failed because rangeID was modified
-			return &ShardOwnershipLostError{
+			return nil, &ShardOwnershipLostError{
 				ShardID: d.shardID,
 				Msg: fmt.Sprintf("Failed to update workflow execution.  Request RangeID: %v, Actual RangeID: %v",
 					request.RangeID, rangeID),
@@ -933,7 +1142,7 @@ func (d *cassandraPersistence) UpdateWorkflowExecution(request *UpdateWorkflowEx
 
 		if nextEventID, ok := previous["next_event_id"].(int64); ok && nextEventID != request.Condition {
 			// CreateWorkflowExecution failed because next event ID is unexpected
-			return &ConditionFailedError{
+			return nil, &ConditionFailedError{
 				Msg: fmt.Sprintf("Failed to update workflow execution.  Request Condition: %v, Actual Value: %v",
 					request.Condition, nextEventID),
 			}
@@ -944,13 +1153,15 @@ func (d *cassandraPersistence) UpdateWorkflowExecution(request *UpdateWorkflowEx
 			columns = append(columns, fmt.Sprintf("%s=%v", k, v))
 		}
 
-		return &ConditionFailedError{
+		return nil, &ConditionFailedError{
 			Msg: fmt.Sprintf("Failed to update workflow execution.  RangeID: %v, Condition: %v, columns: (%v)",
 				request.RangeID, request.Condition, strings.Join(columns, ",")),
 		}
 	}
 
-	return nil
+	return &UpdateWorkflowExecutionResponse{
+		MutableStateUpdateSessionStats: NewMutableStateUpdateSessionStats(request),
+	}, nil
 }
 
 func (d *cassandraPersistence) DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error {
@@ -967,9 +1178,7 @@ func (d *cassandraPersistence) DeleteWorkflowExecution(request *DeleteWorkflowEx
 
 	err := query.Exec()
 	if err != nil {
-		return &workflow.InternalServiceError{
-			Message: fmt.Sprintf("DeleteWorkflowExecution operation failed. Error: %v", err),
-		}
+		return convertCommonErrors("DeleteWorkflowExecution", err)
 	}
 
 	return nil
@@ -995,15 +1204,239 @@ func (d *cassandraPersistence) GetCurrentExecution(request *GetCurrentExecutionR
 			}
 		}
 
+		return nil, convertCommonErrors("GetCurrentExecution", err)
+	}
+
+	return &GetCurrentExecutionResponse{RunID: currentRunID}, nil
+}
+
+func (d *cassandraPersistence) ListConcreteExecutions(request *ListConcreteExecutionsRequest) (
+	*ListConcreteExecutionsResponse, error) {
+	pageState, err := deserializePageToken(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	query := d.session.Query(templateListWorkflowExecutionQuery,
+		d.shardID,
+		rowTypeExecution)
+
+	iter := query.PageSize(getPageSize(request.PageSize)).PageState(pageState).Iter()
+	if iter == nil {
 		return nil, &workflow.InternalServiceError{
-			Message: fmt.Sprintf("GetCurrentExecution operation failed. Error: %v", err),
+			Message: "ListConcreteExecutions operation failed.  Not able to create query iterator.",
 		}
 	}
 
-	return &GetCurrentExecutionResponse{RunID: currentRunID}, nil
+	response := &ListConcreteExecutionsResponse{}
+	result := make(map[string]interface{})
+	for iter.MapScan(result) {
+		// The rows of the current executions have the permanent run ID and are skipped
+		if result["run_id"].(gocql.UUID).String() != permanentRunID {
+			info := createWorkflowExecutionInfo(result["execution"].(map[string]interface{}))
+			response.ExecutionInfos = append(response.ExecutionInfos, info)
+		}
+		result = make(map[string]interface{})
+	}
+
+	response.NextPageToken = serializePageToken(iter.PageState())
+	if err := iter.Close(); err != nil {
+		return nil, convertCommonErrors("ListConcreteExecutions", err)
+	}
+
+	return response, nil
+}
+
+func (d *cassandraPersistence) ListCurrentExecutions(request *ListCurrentExecutionsRequest) (
+	*ListCurrentExecutionsResponse, error) {
+	pageState, err := deserializePageToken(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	query := d.session.Query(templateListCurrentExecutionQuery,
+		d.shardID,
+		rowTypeExecution)
+
+	iter := query.PageSize(getPageSize(request.PageSize)).PageState(pageState).Iter()
+	if iter == nil {
+		return nil, &workflow.InternalServiceError{
+			Message: "ListCurrentExecutions operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	response := &ListCurrentExecutionsResponse{}
+	result := make(map[string]interface{})
+	for iter.MapScan(result) {
+		// Only the rows of the current executions have the permanent run ID
+		if result["run_id"].(gocql.UUID).String() == permanentRunID {
+			response.Executions = append(response.Executions, &CurrentExecution{
+				DomainID:   result["domain_id"].(gocql.UUID).String(),
+				WorkflowID: result["workflow_id"].(string),
+				RunID:      result["current_run_id"].(gocql.UUID).String(),
+			})
+		}
+		result = make(map[string]interface{})
+	}
+
+	response.NextPageToken = serializePageToken(iter.PageState())
+	if err := iter.Close(); err != nil {
+		return nil, convertCommonErrors("ListCurrentExecutions", err)
+	}
+
+	return response, nil
+}
+
+func (d *cassandraPersistence) DeleteCurrentWorkflowExecution(request *DeleteCurrentWorkflowExecutionRequest) error {
+	query := d.session.Query(templateDeleteCurrentWorkflowExecutionQuery,
+		d.shardID,
+		rowTypeExecution,
+		request.DomainID,
+		request.WorkflowID,
+		permanentRunID,
+		defaultVisibilityTimestamp,
+		rowTypeExecutionTaskID,
+		request.RunID)
+
+	// The current execution is left alone if it points at another run
+	previous := make(map[string]interface{})
+	if _, err := query.MapScanCAS(previous); err != nil {
+		return convertCommonErrors("DeleteCurrentWorkflowExecution", err)
+	}
+
+	return nil
+}
+
+func (d *cassandraPersistence) CreateCurrentWorkflowExecution(request *CreateCurrentWorkflowExecutionRequest) error {
+	batch := d.session.NewBatch(gocql.LoggedBatch)
+	batch.Query(templateCreateWorkflowExecutionQuery,
+		d.shardID,
+		rowTypeExecution,
+		request.DomainID,
+		request.WorkflowID,
+		permanentRunID,
+		defaultVisibilityTimestamp,
+		rowTypeExecutionTaskID,
+		request.RunID,
+		request.RunID,
+		request.RequestID)
+	batch.Query(templateUpdateLeaseQuery,
+		request.RangeID,
+		d.shardID,
+		request.RangeID,
+	)
+
+	previous := make(map[string]interface{})
+	applied, iter, err := d.session.MapExecuteBatchCAS(batch, previous)
+	if err != nil {
+		return convertCommonErrors("CreateCurrentWorkflowExecution", err)
+	}
+	defer iter.Close()
+
+	if !applied {
+		return d.currentWorkflowConditionFailed("create", iter, previous, request.WorkflowID, request.RangeID,
+			func(current map[string]interface{}) error {
+				execution, _ := current["execution"].(map[string]interface{})
+				msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v, rangeID: %v",
+					request.WorkflowID, execution["run_id"], request.RangeID)
+				return &workflow.WorkflowExecutionAlreadyStartedError{
+					Message:        common.StringPtr(msg),
+					StartRequestId: common.StringPtr(fmt.Sprintf("%v", execution["create_request_id"])),
+					RunId:          common.StringPtr(fmt.Sprintf("%v", execution["run_id"])),
+				}
+			})
+	}
+
+	return nil
+}
+
+func (d *cassandraPersistence) UpdateCurrentWorkflowExecution(request *UpdateCurrentWorkflowExecutionRequest) error {
+	batch := d.session.NewBatch(gocql.LoggedBatch)
+	batch.Query(templateUpdateCurrentWorkflowExecutionOfRunQuery,
+		request.RunID,
+		request.RunID,
+		request.RequestID,
+		d.shardID,
+		rowTypeExecution,
+		request.DomainID,
+		request.WorkflowID,
+		permanentRunID,
+		defaultVisibilityTimestamp,
+		rowTypeExecutionTaskID,
+		request.PreviousRunID)
+	batch.Query(templateUpdateLeaseQuery,
+		request.RangeID,
+		d.shardID,
+		request.RangeID,
+	)
+
+	previous := make(map[string]interface{})
+	applied, iter, err := d.session.MapExecuteBatchCAS(batch, previous)
+	if err != nil {
+		return convertCommonErrors("UpdateCurrentWorkflowExecution", err)
+	}
+	defer iter.Close()
+
+	if !applied {
+		return d.currentWorkflowConditionFailed("update", iter, previous, request.WorkflowID, request.RangeID,
+			func(current map[string]interface{}) error {
+				currentRunID := ""
+				if runID, ok := current["current_run_id"].(gocql.UUID); ok && runID != (gocql.UUID{}) {
+					currentRunID = runID.String()
+				}
+				return &CurrentWorkflowConditionFailedError{
+					Msg: fmt.Sprintf("Current execution does not point at the previous run. "+
+						"WorkflowId: %v, PreviousRunId: %v, CurrentRunId: %v",
+						request.WorkflowID, request.PreviousRunID, currentRunID),
+					RunID: currentRunID,
+				}
+			})
+	}
+
+	return nil
+}
+
+// currentWorkflowConditionFailed tells why a batch conditioned on the current execution of a workflow and on the
+// range ID of the shard was not applied.  The batch returns a row for each condition which failed: the error of the
+// current execution row is built by currentErr from its columns.
+func (d *cassandraPersistence) currentWorkflowConditionFailed(operation string, iter *gocql.Iter,
+	previous map[string]interface{}, workflowID string, rangeID int64,
+	currentErr func(current map[string]interface{}) error) error {
+	var columns []string
+	for {
+		if runID, ok := previous["run_id"].(gocql.UUID); ok && runID.String() == permanentRunID {
+			return currentErr(previous)
+		}
+
+		if actualRangeID, ok := previous["range_id"].(int64); ok && actualRangeID != rangeID {
+			return &ShardOwnershipLostError{
+				ShardID: d.shardID,
+				Msg: fmt.Sprintf("Failed to %v current workflow execution.  Request RangeID: %v, Actual RangeID: %v",
+					operation, rangeID, actualRangeID),
+			}
+		}
+
+		for k, v := range previous {
+			columns = append(columns, fmt.Sprintf("%s=%v", k, v))
+		}
+
+		previous = make(map[string]interface{})
+		if !iter.MapScan(previous) {
+			break
+		}
+	}
+
+	return &ConditionFailedError{
+		Msg: fmt.Sprintf("Failed to %v current workflow execution.  WorkflowId: %v, Request RangeID: %v, columns: (%v)",
+			operation, workflowID, rangeID, strings.Join(columns, ",")),
+	}
 }
 
 func (d *cassandraPersistence) GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error) {
+	pageState, err := deserializePageToken(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
 
 	// Reading transfer tasks need to be quorum level consistent, otherwise we could loose task
 	query := d.session.Query(templateGetTransferTasksQuery,
@@ -1014,10 +1447,9 @@ func (d *cassandraPersistence) GetTransferTasks(request *GetTransferTasksRequest
 		rowTypeTransferRunID,
 		defaultVisibilityTimestamp,
 		request.ReadLevel,
-		request.MaxReadLevel,
-		request.BatchSize)
+		request.MaxReadLevel)
 
-	iter := query.Iter()
+	iter := query.PageSize(getPageSize(request.BatchSize)).PageState(pageState).Iter()
 	if iter == nil {
 		return nil, &workflow.InternalServiceError{
 			Message: "GetTransferTasks operation failed.  Not able to create query iterator.",
@@ -1034,10 +1466,10 @@ func (d *cassandraPersistence) GetTransferTasks(request *GetTransferTasksRequest
 		response.Tasks = append(response.Tasks, t)
 	}
 
+	response.NextPageToken = serializePageToken(iter.PageState())
+	reportTombstoneWarnings(iter, d.metricsClient, metrics.PersistenceGetTransferTasksScope, d.logger)
 	if err := iter.Close(); err != nil {
-		return nil, &workflow.InternalServiceError{
-			Message: fmt.Sprintf("GetTransferTasks operation failed. Error: %v", err),
-		}
+		return nil, convertCommonErrors("GetTransferTasks", err)
 	}
 
 	return response, nil
@@ -1055,9 +1487,26 @@ func (d *cassandraPersistence) CompleteTransferTask(request *CompleteTransferTas
 
 	err := query.Exec()
 	if err != nil {
-		return &workflow.InternalServiceError{
-			Message: fmt.Sprintf("CompleteTransferTask operation failed. Error: %v", err),
-		}
+		return convertCommonErrors("CompleteTransferTask", err)
+	}
+
+	return nil
+}
+
+func (d *cassandraPersistence) RangeCompleteTransferTask(request *RangeCompleteTransferTaskRequest) error {
+	query := d.session.Query(templateRangeCompleteTransferTaskQuery,
+		d.shardID,
+		rowTypeTransferTask,
+		rowTypeTransferDomainID,
+		rowTypeTransferWorkflowID,
+		rowTypeTransferRunID,
+		defaultVisibilityTimestamp,
+		request.ExclusiveBeginTaskID,
+		request.InclusiveEndTaskID)
+
+	err := query.Exec()
+	if err != nil {
+		return convertCommonErrors("RangeCompleteTransferTask", err)
 	}
 
 	return nil
@@ -1076,9 +1525,27 @@ func (d *cassandraPersistence) CompleteTimerTask(request *CompleteTimerTaskReque
 
 	err := query.Exec()
 	if err != nil {
-		return &workflow.InternalServiceError{
-			Message: fmt.Sprintf("CompleteTimerTask operation failed. Error: %v", err),
-		}
+		return convertCommonErrors("CompleteTimerTask", err)
+	}
+
+	return nil
+}
+
+func (d *cassandraPersistence) RangeCompleteTimerTask(request *RangeCompleteTimerTaskRequest) error {
+	start := common.UnixNanoToCQLTimestamp(request.InclusiveBeginTimestamp.UnixNano())
+	end := common.UnixNanoToCQLTimestamp(request.ExclusiveEndTimestamp.UnixNano())
+	query := d.session.Query(templateRangeCompleteTimerTaskQuery,
+		d.shardID,
+		rowTypeTimerTask,
+		rowTypeTimerDomainID,
+		rowTypeTimerWorkflowID,
+		rowTypeTimerRunID,
+		start,
+		end)
+
+	err := query.Exec()
+	if err != nil {
+		return convertCommonErrors("RangeCompleteTimerTask", err)
 	}
 
 	return nil
@@ -1099,6 +1566,7 @@ func (d *cassandraPersistence) LeaseTaskList(request *LeaseTaskListRequest) (*Le
 		taskListTaskID,
 	)
 	var rangeID, ackLevel int64
+	var pollers map[string]time.Time
 	var tlDB map[string]interface{}
 	err := query.Scan(&rangeID, &tlDB)
 	if err != nil {
@@ -1113,7 +1581,8 @@ func (d *cassandraPersistence) LeaseTaskList(request *LeaseTaskListRequest) (*Le
 				request.DomainID,
 				request.TaskList,
 				request.TaskType,
-				0)
+				0,
+				nil)
 		} else {
 			return nil, &workflow.InternalServiceError{
 				Message: fmt.Sprintf("LeaseTaskList operation failed. TaskList: %v, TaskType: %v, Error : %v",
@@ -1122,12 +1591,14 @@ func (d *cassandraPersistence) LeaseTaskList(request *LeaseTaskListRequest) (*Le
 		}
 	} else {
 		ackLevel = tlDB["ack_level"].(int64)
+		pollers, _ = tlDB["pollers"].(map[string]time.Time) // not set by older servers
 		query = d.session.Query(templateUpdateTaskListQuery,
 			rangeID+1,
 			request.DomainID,
 			&request.TaskList,
 			request.TaskType,
 			ackLevel,
+			pollers,
 			request.DomainID,
 			&request.TaskList,
 			request.TaskType,
@@ -1139,9 +1610,7 @@ func (d *cassandraPersistence) LeaseTaskList(request *LeaseTaskListRequest) (*Le
 	previous := make(map[string]interface{})
 	applied, err := query.MapScanCAS(previous)
 	if err != nil {
-		return nil, &workflow.InternalServiceError{
-			Message: fmt.Sprintf("LeaseTaskList operation failed. Error : %v", err),
-		}
+		return nil, convertCommonErrors("LeaseTaskList", err)
 	}
 	if !applied {
 		previousRangeID := previous["range_id"]
@@ -1149,7 +1618,14 @@ func (d *cassandraPersistence) LeaseTaskList(request *LeaseTaskListRequest) (*Le
 			Msg: fmt.Sprintf("LeaseTaskList failed to apply. db rangeID %v", previousRangeID),
 		}
 	}
-	tli := &TaskListInfo{Name: request.TaskList, TaskType: request.TaskType, RangeID: rangeID + 1, AckLevel: ackLevel}
+	tli := &TaskListInfo{
+		DomainID: request.DomainID,
+		Name:     request.TaskList,
+		TaskType: request.TaskType,
+		RangeID:  rangeID + 1,
+		AckLevel: ackLevel,
+		Pollers:  pollers,
+	}
 	return &LeaseTaskListResponse{TaskListInfo: tli}, nil
 }
 
@@ -1163,6 +1639,7 @@ func (d *cassandraPersistence) UpdateTaskList(request *UpdateTaskListRequest) (*
 		&tli.Name,
 		tli.TaskType,
 		tli.AckLevel,
+		tli.Pollers,
 		tli.DomainID,
 		&tli.Name,
 		tli.TaskType,
@@ -1174,9 +1651,7 @@ func (d *cassandraPersistence) UpdateTaskList(request *UpdateTaskListRequest) (*
 	previous := make(map[string]interface{})
 	applied, err := query.MapScanCAS(previous)
 	if err != nil {
-		return nil, &workflow.InternalServiceError{
-			Message: fmt.Sprintf("UpdateTaskList operation failed. Error: %v", err),
-		}
+		return nil, convertCommonErrors("UpdateTaskList", err)
 	}
 
 	if !applied {
@@ -1194,6 +1669,24 @@ func (d *cassandraPersistence) UpdateTaskList(request *UpdateTaskListRequest) (*
 	return &UpdateTaskListResponse{}, nil
 }
 
+// From TaskManager interface
+func (d *cassandraPersistence) GetTaskListBacklog(request *GetTaskListBacklogRequest) (*GetTaskListBacklogResponse,
+	error) {
+	query := d.session.Query(templateGetTaskListBacklogQuery,
+		request.DomainID,
+		request.TaskList,
+		request.TaskType,
+		rowTypeTask,
+		request.AckLevel)
+
+	var count int64
+	if err := query.Scan(&count); err != nil {
+		return nil, convertCommonErrors("GetTaskListBacklog", err)
+	}
+
+	return &GetTaskListBacklogResponse{ApproximateCount: count}, nil
+}
+
 // From TaskManager interface
 func (d *cassandraPersistence) CreateTasks(request *CreateTasksRequest) (*CreateTasksResponse, error) {
 	batch := d.session.NewBatch(gocql.LoggedBatch)
@@ -1240,9 +1733,7 @@ func (d *cassandraPersistence) CreateTasks(request *CreateTasksRequest) (*Create
 	previous := make(map[string]interface{})
 	applied, _, err := d.session.MapExecuteBatchCAS(batch, previous)
 	if err != nil {
-		return nil, &workflow.InternalServiceError{
-			Message: fmt.Sprintf("CreateTask operation failed. Error : %v", err),
-		}
+		return nil, convertCommonErrors("CreateTask", err)
 	}
 	if !applied {
 		rangeID := previous["range_id"]
@@ -1261,6 +1752,8 @@ func (d *cassandraPersistence) GetTasks(request *GetTasksRequest) (*GetTasksResp
 		return &GetTasksResponse{}, nil
 	}
 
+	batchSize := getPageSize(request.BatchSize)
+
 	// Reading tasklist tasks need to be quorum level consistent, otherwise we could loose task
 	query := d.session.Query(templateGetTasksQuery,
 		request.DomainID,
@@ -1269,7 +1762,7 @@ func (d *cassandraPersistence) GetTasks(request *GetTasksRequest) (*GetTasksResp
 		rowTypeTask,
 		request.ReadLevel,
 		request.MaxReadLevel,
-		request.BatchSize)
+		batchSize)
 
 	iter := query.Iter()
 	if iter == nil {
@@ -1289,16 +1782,15 @@ PopulateTasks:
 		t := createTaskInfo(task["task"].(map[string]interface{}))
 		t.TaskID = taskID.(int64)
 		response.Tasks = append(response.Tasks, t)
-		if len(response.Tasks) == request.BatchSize {
+		if len(response.Tasks) == batchSize {
 			break PopulateTasks
 		}
 		task = make(map[string]interface{}) // Reinitialize map as initialized fails on unmarshalling
 	}
 
+	reportTombstoneWarnings(iter, d.metricsClient, metrics.PersistenceGetTasksScope, d.logger)
 	if err := iter.Close(); err != nil {
-		return nil, &workflow.InternalServiceError{
-			Message: fmt.Sprintf("GetTasks operation failed. Error: %v", err),
-		}
+		return nil, convertCommonErrors("GetTasks", err)
 	}
 
 	return response, nil
@@ -1316,9 +1808,24 @@ func (d *cassandraPersistence) CompleteTask(request *CompleteTaskRequest) error
 
 	err := query.Exec()
 	if err != nil {
-		return &workflow.InternalServiceError{
-			Message: fmt.Sprintf("CompleteTask operation failed. Error: %v", err),
-		}
+		return convertCommonErrors("CompleteTask", err)
+	}
+
+	return nil
+}
+
+// From TaskManager interface
+func (d *cassandraPersistence) CompleteTasksLessThan(request *CompleteTasksLessThanRequest) error {
+	query := d.session.Query(templateCompleteTasksLessThanQuery,
+		request.DomainID,
+		request.TaskListName,
+		request.TaskType,
+		rowTypeTask,
+		request.TaskID)
+
+	err := query.Exec()
+	if err != nil {
+		return convertCommonErrors("CompleteTasksLessThan", err)
 	}
 
 	return nil
@@ -1326,6 +1833,11 @@ func (d *cassandraPersistence) CompleteTask(request *CompleteTaskRequest) error
 
 func (d *cassandraPersistence) GetTimerIndexTasks(request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse,
 	error) {
+	pageState, err := deserializePageToken(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
 	// Reading timer tasks need to be quorum level consistent, otherwise we could loose task
 	minTimestamp := common.UnixNanoToCQLTimestamp(request.MinTimestamp.UnixNano())
 	maxTimestamp := common.UnixNanoToCQLTimestamp(request.MaxTimestamp.UnixNano())
@@ -1336,10 +1848,9 @@ func (d *cassandraPersistence) GetTimerIndexTasks(request *GetTimerIndexTasksReq
 		rowTypeTimerWorkflowID,
 		rowTypeTimerRunID,
 		minTimestamp,
-		maxTimestamp,
-		request.BatchSize)
+		maxTimestamp)
 
-	iter := query.Iter()
+	iter := query.PageSize(getPageSize(request.BatchSize)).PageState(pageState).Iter()
 	if iter == nil {
 		return nil, &workflow.InternalServiceError{
 			Message: "GetTimerTasks operation failed.  Not able to create query iterator.",
@@ -1356,10 +1867,10 @@ func (d *cassandraPersistence) GetTimerIndexTasks(request *GetTimerIndexTasksReq
 		response.Timers = append(response.Timers, t)
 	}
 
+	response.NextPageToken = serializePageToken(iter.PageState())
+	reportTombstoneWarnings(iter, d.metricsClient, metrics.PersistenceGetTimerIndexTasksScope, d.logger)
 	if err := iter.Close(); err != nil {
-		return nil, &workflow.InternalServiceError{
-			Message: fmt.Sprintf("GetTimerTasks operation failed. Error: %v", err),
-		}
+		return nil, convertCommonErrors("GetTimerTasks", err)
 	}
 
 	return response, nil
@@ -1371,6 +1882,7 @@ func (d *cassandraPersistence) createTransferTasks(batch *gocql.Batch, transferT
 	for _, task := range transferTasks {
 		var taskList string
 		var scheduleID int64
+		var callbackURL string
 		targetWorkflowID := transferTaskTransferTargetWorkflowID
 		targetRunID := transferTaskTypeTransferTargetRunID
 
@@ -1395,6 +1907,9 @@ func (d *cassandraPersistence) createTransferTasks(batch *gocql.Batch, transferT
 			targetDomainID = task.(*StartChildExecutionTask).TargetDomainID
 			targetWorkflowID = task.(*StartChildExecutionTask).TargetWorkflowID
 			scheduleID = task.(*StartChildExecutionTask).InitiatedID
+
+		case TransferTaskTypeCompletionCallback:
+			callbackURL = task.(*CompletionCallbackTask).CallbackURL
 		}
 
 		batch.Query(templateCreateTransferTaskQuery,
@@ -1413,6 +1928,7 @@ func (d *cassandraPersistence) createTransferTasks(batch *gocql.Batch, transferT
 			taskList,
 			task.GetType(),
 			scheduleID,
+			callbackURL,
 			defaultVisibilityTimestamp,
 			task.GetTaskID())
 	}
@@ -1436,6 +1952,9 @@ func (d *cassandraPersistence) createTimerTasks(batch *gocql.Batch, timerTasks [
 
 		case TaskTypeUserTimer:
 			eventID = task.(*UserTimerTask).EventID
+
+		case TaskTypeCancelTimeout:
+			eventID = task.(*CancelTimeoutTask).EventID
 		}
 
 		ts := common.UnixNanoToCQLTimestamp(GetVisibilityTSFrom(task).UnixNano())
@@ -1472,25 +1991,16 @@ func (d *cassandraPersistence) createTimerTasks(batch *gocql.Batch, timerTasks [
 }
 
 func (d *cassandraPersistence) updateActivityInfos(batch *gocql.Batch, activityInfos []*ActivityInfo, deleteInfo *int64,
-	domainID, workflowID, runID string, condition int64, rangeID int64) {
+	domainID, workflowID, runID string, condition int64, rangeID int64) error {
 
 	for _, a := range activityInfos {
+		blob, err := serializeActivityInfo(a)
+		if err != nil {
+			return newMutableStateBlobError("activity info", err)
+		}
 		batch.Query(templateUpdateActivityInfoQuery,
 			a.ScheduleID,
-			a.ScheduleID,
-			a.ScheduledEvent,
-			a.StartedID,
-			a.StartedEvent,
-			a.ActivityID,
-			a.RequestID,
-			a.Details,
-			a.ScheduleToStartTimeout,
-			a.ScheduleToCloseTimeout,
-			a.StartToCloseTimeout,
-			a.HeartbeatTimeout,
-			a.CancelRequested,
-			a.CancelRequestID,
-			a.LastHeartBeatUpdatedTime,
+			blob,
 			d.shardID,
 			rowTypeExecution,
 			domainID,
@@ -1504,6 +2014,7 @@ func (d *cassandraPersistence) updateActivityInfos(batch *gocql.Batch, activityI
 
 	if deleteInfo != nil {
 		batch.Query(templateDeleteActivityInfoQuery,
+			*deleteInfo,
 			*deleteInfo,
 			d.shardID,
 			rowTypeExecution,
@@ -1515,18 +2026,21 @@ func (d *cassandraPersistence) updateActivityInfos(batch *gocql.Batch, activityI
 			condition,
 			rangeID)
 	}
+
+	return nil
 }
 
 func (d *cassandraPersistence) updateTimerInfos(batch *gocql.Batch, timerInfos []*TimerInfo, deleteInfos []string,
-	domainID, workflowID, runID string, condition int64, rangeID int64) {
+	domainID, workflowID, runID string, condition int64, rangeID int64) error {
 
 	for _, a := range timerInfos {
+		blob, err := serializeTimerInfo(a)
+		if err != nil {
+			return newMutableStateBlobError("timer info", err)
+		}
 		batch.Query(templateUpdateTimerInfoQuery,
 			a.TimerID,
-			a.TimerID,
-			a.StartedID,
-			a.ExpiryTime,
-			a.TaskID,
+			blob,
 			d.shardID,
 			rowTypeExecution,
 			domainID,
@@ -1540,6 +2054,7 @@ func (d *cassandraPersistence) updateTimerInfos(batch *gocql.Batch, timerInfos [
 
 	for _, t := range deleteInfos {
 		batch.Query(templateDeleteTimerInfoQuery,
+			t,
 			t,
 			d.shardID,
 			rowTypeExecution,
@@ -1551,19 +2066,21 @@ func (d *cassandraPersistence) updateTimerInfos(batch *gocql.Batch, timerInfos [
 			condition,
 			rangeID)
 	}
+
+	return nil
 }
 
 func (d *cassandraPersistence) updateChildExecutionInfos(batch *gocql.Batch, childExecutionInfos []*ChildExecutionInfo,
-	deleteInfo *int64, domainID, workflowID, runID string, condition int64, rangeID int64) {
+	deleteInfo *int64, domainID, workflowID, runID string, condition int64, rangeID int64) error {
 
 	for _, c := range childExecutionInfos {
+		blob, err := serializeChildExecutionInfo(c)
+		if err != nil {
+			return newMutableStateBlobError("child execution info", err)
+		}
 		batch.Query(templateUpdateChildExecutionInfoQuery,
 			c.InitiatedID,
-			c.InitiatedID,
-			c.InitiatedEvent,
-			c.StartedID,
-			c.StartedEvent,
-			c.CreateRequestID,
+			blob,
 			d.shardID,
 			rowTypeExecution,
 			domainID,
@@ -1578,6 +2095,7 @@ func (d *cassandraPersistence) updateChildExecutionInfos(batch *gocql.Batch, chi
 	// deleteInfo is the initiatedID for ChildInfo being deleted
 	if deleteInfo != nil {
 		batch.Query(templateDeleteChildExecutionInfoQuery,
+			*deleteInfo,
 			*deleteInfo,
 			d.shardID,
 			rowTypeExecution,
@@ -1589,6 +2107,8 @@ func (d *cassandraPersistence) updateChildExecutionInfos(batch *gocql.Batch, chi
 			condition,
 			rangeID)
 	}
+
+	return nil
 }
 
 func createShardInfo(result map[string]interface{}) *ShardInfo {
@@ -1665,6 +2185,21 @@ func createWorkflowExecutionInfo(result map[string]interface{}) *WorkflowExecuti
 			info.DecisionRequestID = v.(string)
 		case "decision_timeout":
 			info.DecisionTimeout = int32(v.(int))
+		case "completion_callback_url":
+			info.CompletionCallbackURL = v.(string)
+		case "cancel_requested":
+			info.CancelRequested = v.(bool)
+		case "cancel_request_id":
+			info.CancelRequestID = v.(string)
+		case "first_execution_run_id":
+			// not set for the executions created before the chains of executions were recorded
+			if runID := v.(gocql.UUID); runID != (gocql.UUID{}) {
+				info.FirstExecutionRunID = runID.String()
+			}
+		case "trace_id":
+			info.TraceID = v.(string)
+		case "search_attributes":
+			info.SearchAttributes = v.(map[string][]byte)
 		}
 	}
 
@@ -1695,6 +2230,8 @@ func createTransferTaskInfo(result map[string]interface{}) *TransferTaskInfo {
 			info.TaskType = v.(int)
 		case "schedule_id":
 			info.ScheduleID = v.(int64)
+		case "callback_url":
+			info.CallbackURL = v.(string)
 		}
 	}
 
@@ -1831,6 +2368,42 @@ func isTimeoutError(err error) bool {
 	return ok
 }
 
+// convertCommonErrors converts an error returned by gocql for the given operation into the persistence error
+// describing the failure, so callers and metrics can tell an overloaded or unreachable cluster from a bad request.
+func convertCommonErrors(operation string, err error) error {
+	message := fmt.Sprintf("%v operation failed. Error: %v", operation, err)
+	switch {
+	case isThrottlingError(err):
+		return &workflow.ServiceBusyError{Message: message}
+	case isUnavailableError(err):
+		return &UnavailableError{Msg: message}
+	case isCorruptionError(err):
+		return &CorruptionError{Msg: message}
+	}
+	return &workflow.InternalServiceError{Message: message}
+}
+
+func isThrottlingError(err error) bool {
+	if reqErr, ok := err.(gocql.RequestError); ok {
+		// gocql does not export the code of the overloaded error
+		return reqErr.Code() == 0x1001
+	}
+	return false
+}
+
+func isUnavailableError(err error) bool {
+	if err == gocql.ErrUnavailable || err == gocql.ErrNoConnections {
+		return true
+	}
+	_, ok := err.(*gocql.RequestErrUnavailable)
+	return ok
+}
+
+func isCorruptionError(err error) bool {
+	_, ok := err.(gocql.UnmarshalError)
+	return ok
+}
+
 // GetVisibilityTSFrom - helper method to get visibility timestamp
 func GetVisibilityTSFrom(task Task) time.Time {
 	switch task.GetType() {
@@ -1842,6 +2415,15 @@ func GetVisibilityTSFrom(task Task) time.Time {
 
 	case TaskTypeUserTimer:
 		return task.(*UserTimerTask).VisibilityTimestamp
+
+	case TaskTypeCancelTimeout:
+		return task.(*CancelTimeoutTask).VisibilityTimestamp
+
+	case TaskTypeDeleteExecution:
+		return task.(*DeleteExecutionTimerTask).VisibilityTimestamp
+
+	case TaskTypeDeleteHistory:
+		return task.(*DeleteHistoryTimerTask).VisibilityTimestamp
 	}
 	return time.Time{}
 }
@@ -1857,5 +2439,14 @@ func SetVisibilityTSFrom(task Task, t time.Time) {
 
 	case TaskTypeUserTimer:
 		task.(*UserTimerTask).VisibilityTimestamp = t
+
+	case TaskTypeCancelTimeout:
+		task.(*CancelTimeoutTask).VisibilityTimestamp = t
+
+	case TaskTypeDeleteExecution:
+		task.(*DeleteExecutionTimerTask).VisibilityTimestamp = t
+
+	case TaskTypeDeleteHistory:
+		task.(*DeleteHistoryTimerTask).VisibilityTimestamp = t
 	}
 }
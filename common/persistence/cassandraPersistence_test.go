@@ -21,6 +21,8 @@
 package persistence
 
 import (
+	"fmt"
+	"math"
 	"os"
 	"testing"
 	"time"
@@ -148,6 +150,67 @@ func (s *cassandraPersistenceSuite) TestGetWorkflow() {
 	log.Infof("Workflow execution last updated: %v", info.LastUpdatedTimestamp)
 }
 
+func (s *cassandraPersistenceSuite) TestGetWorkflowExecutionInfoProjection() {
+	domainID := "4a4fb3c6-6bfd-4a6c-b8e4-2f5c3b6a4a11"
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("get-workflow-projection-test"),
+		RunId:      common.StringPtr("3e0b9b47-8d62-4f56-93a1-0d7cc0e2f0a5"),
+	}
+	task0, err0 := s.CreateWorkflowExecution(domainID, workflowExecution, "queue1", "wType", 13, nil, 3, 0, 2, nil)
+	s.Nil(err0, "No error expected.")
+	s.NotEmpty(task0, "Expected non empty task identifier.")
+
+	response, err1 := s.WorkflowMgr.GetWorkflowExecution(&GetWorkflowExecutionRequest{
+		DomainID:   domainID,
+		Execution:  workflowExecution,
+		Projection: MutableStateProjectionExecutionInfo,
+	})
+	s.Nil(err1, "No error expected.")
+	info := response.State.ExecutionInfo
+	s.NotNil(info, "Valid Workflow info expected.")
+	s.Equal("get-workflow-projection-test", info.WorkflowID)
+	s.Equal(int64(3), info.NextEventID)
+	s.Nil(response.State.ActivitInfos)
+	s.Nil(response.State.TimerInfos)
+	s.Nil(response.State.ChildExecutionInfos)
+}
+
+func (s *cassandraPersistenceSuite) TestListConcreteExecutions() {
+	domainID := "8c3d0f3e-8a9b-4f69-9a62-2f0d0c1de6b2"
+	runIDs := []string{"1b9f3c57-3f2c-4d8e-9ad0-4c7d0e5b1f01", "1b9f3c57-3f2c-4d8e-9ad0-4c7d0e5b1f02"}
+	for i, runID := range runIDs {
+		workflowExecution := gen.WorkflowExecution{
+			WorkflowId: common.StringPtr(fmt.Sprintf("list-concrete-executions-test-%v", i)),
+			RunId:      common.StringPtr(runID),
+		}
+		_, err := s.CreateWorkflowExecution(domainID, workflowExecution, "queue1", "wType", 13, nil, 3, 0, 2, nil)
+		s.Nil(err, "No error expected.")
+	}
+
+	found := make(map[string]bool)
+	var token []byte
+	for {
+		response, err := s.WorkflowMgr.ListConcreteExecutions(&ListConcreteExecutionsRequest{
+			PageSize:      2,
+			NextPageToken: token,
+		})
+		s.Nil(err, "No error expected.")
+		for _, info := range response.ExecutionInfos {
+			s.NotEqual(permanentRunID, info.RunID)
+			if info.DomainID == domainID {
+				s.Equal("wType", info.WorkflowTypeName)
+				s.Equal(int64(3), info.NextEventID)
+				found[info.RunID] = true
+			}
+		}
+		if len(response.NextPageToken) == 0 {
+			break
+		}
+		token = response.NextPageToken
+	}
+	s.Equal(map[string]bool{runIDs[0]: true, runIDs[1]: true}, found)
+}
+
 func (s *cassandraPersistenceSuite) TestUpdateWorkflow() {
 	domainID := "b0a8571c-0257-40ea-afcd-3a14eae181c0"
 	workflowExecution := gen.WorkflowExecution{
@@ -347,6 +410,102 @@ func (s *cassandraPersistenceSuite) TestGetCurrentWorkflow() {
 	s.Equal(workflowExecution2.GetRunId(), runID1)
 }
 
+func (s *cassandraPersistenceSuite) TestCurrentWorkflowTerminateThenStart() {
+	domainID := "b0a8571c-0257-40ea-afcd-3a14eae181c0"
+	workflowID := "current-workflow-terminate-then-start-test"
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr(workflowID),
+		RunId:      common.StringPtr("1f3bbed8-5b1f-4ab2-9a0b-2d1d1b5c6e01"),
+	}
+	runID1 := "1f3bbed8-5b1f-4ab2-9a0b-2d1d1b5c6e02"
+	runID2 := "1f3bbed8-5b1f-4ab2-9a0b-2d1d1b5c6e03"
+	task0, err0 := s.CreateWorkflowExecution(domainID, workflowExecution, "queue1", "wType", 13, nil, 3, 0, 2, nil)
+	s.Nil(err0, "No error expected.")
+	s.NotEmpty(task0, "Expected non empty task identifier.")
+
+	// A start racing with the terminate sees the run still open
+	err1 := s.CreateCurrentWorkflow(domainID, workflowID, runID1)
+	s.NotNil(err1)
+	s.IsType(&gen.WorkflowExecutionAlreadyStartedError{}, err1)
+	s.Equal(workflowExecution.GetRunId(), err1.(*gen.WorkflowExecutionAlreadyStartedError).GetRunId())
+
+	info0, err2 := s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.Nil(err2)
+	updatedInfo := copyWorkflowExecutionInfo(info0.ExecutionInfo)
+	updatedInfo.NextEventID = int64(6)
+	err3 := s.UpdateWorkflowExecutionAndDelete(updatedInfo, int64(3))
+	s.Nil(err3, "No error expected.")
+
+	// The terminated run can no longer be followed by another run
+	err4 := s.UpdateCurrentWorkflow(domainID, workflowID, runID2, workflowExecution.GetRunId())
+	s.NotNil(err4)
+	s.IsType(&CurrentWorkflowConditionFailedError{}, err4)
+	s.Equal("", err4.(*CurrentWorkflowConditionFailedError).RunID)
+
+	err5 := s.CreateCurrentWorkflow(domainID, workflowID, runID1)
+	s.Nil(err5, "No error expected.")
+
+	// Only one of the starts racing after the terminate wins
+	err6 := s.CreateCurrentWorkflow(domainID, workflowID, runID2)
+	s.NotNil(err6)
+	s.IsType(&gen.WorkflowExecutionAlreadyStartedError{}, err6)
+	s.Equal(runID1, err6.(*gen.WorkflowExecutionAlreadyStartedError).GetRunId())
+
+	currentRunID, err7 := s.GetCurrentWorkflow(domainID, workflowID)
+	s.Nil(err7, "No error expected.")
+	s.Equal(runID1, currentRunID)
+}
+
+func (s *cassandraPersistenceSuite) TestCurrentWorkflowResetRace() {
+	domainID := "d6f3a0a5-6a0b-4bf8-8d5e-6a52c6e64d7b"
+	workflowID := "current-workflow-reset-race-test"
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr(workflowID),
+		RunId:      common.StringPtr("7c0f4f7e-33c4-4c32-8b8e-5a0e0c2bd101"),
+	}
+	runID1 := "7c0f4f7e-33c4-4c32-8b8e-5a0e0c2bd102"
+	runID2 := "7c0f4f7e-33c4-4c32-8b8e-5a0e0c2bd103"
+	task0, err0 := s.CreateWorkflowExecution(domainID, workflowExecution, "queue1", "wType", 13, nil, 3, 0, 2, nil)
+	s.Nil(err0, "No error expected.")
+	s.NotEmpty(task0, "Expected non empty task identifier.")
+
+	err1 := s.UpdateCurrentWorkflow(domainID, workflowID, runID1, workflowExecution.GetRunId())
+	s.Nil(err1, "No error expected.")
+
+	// The second reset of the same run loses the race
+	err2 := s.UpdateCurrentWorkflow(domainID, workflowID, runID2, workflowExecution.GetRunId())
+	s.NotNil(err2)
+	s.IsType(&CurrentWorkflowConditionFailedError{}, err2)
+	s.Equal(runID1, err2.(*CurrentWorkflowConditionFailedError).RunID)
+
+	// Closing the reset run leaves the current execution of the new run alone
+	err3 := s.WorkflowMgr.DeleteCurrentWorkflowExecution(&DeleteCurrentWorkflowExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: workflowID,
+		RunID:      workflowExecution.GetRunId(),
+	})
+	s.Nil(err3, "No error expected.")
+
+	currentRunID, err4 := s.GetCurrentWorkflow(domainID, workflowID)
+	s.Nil(err4, "No error expected.")
+	s.Equal(runID1, currentRunID)
+
+	err5 := s.WorkflowMgr.UpdateCurrentWorkflowExecution(&UpdateCurrentWorkflowExecutionRequest{
+		RangeID:       s.ShardContext.GetRangeID() - 1,
+		DomainID:      domainID,
+		WorkflowID:    workflowID,
+		RunID:         runID2,
+		RequestID:     uuid.New(),
+		PreviousRunID: runID1,
+	})
+	s.NotNil(err5)
+	s.IsType(&ShardOwnershipLostError{}, err5)
+
+	currentRunID, err6 := s.GetCurrentWorkflow(domainID, workflowID)
+	s.Nil(err6, "No error expected.")
+	s.Equal(runID1, currentRunID)
+}
+
 func (s *cassandraPersistenceSuite) TestTransferTasks() {
 	domainID := "1eda632b-dde5-4cb2-94fd-5a6f04e6dfcd"
 	workflowExecution := gen.WorkflowExecution{
@@ -514,6 +673,52 @@ func (s *cassandraPersistenceSuite) TestCancelTransferTaskTasks() {
 	s.Nil(err)
 }
 
+func (s *cassandraPersistenceSuite) TestCompletionCallbackTask() {
+	domainID := "5d9e8e6c-3f4b-4a55-9d0b-7c0b52ec1e2a"
+	workflowExecution := gen.WorkflowExecution{WorkflowId: common.StringPtr("completion-callback-task-test"),
+		RunId: common.StringPtr("a3a5c1a8-4f0e-4b6e-8f61-1f7d0b3b4c9e")}
+
+	task0, err := s.CreateWorkflowExecution(domainID, workflowExecution, "queue1", "wType", 13, nil, 3, 0, 2, nil)
+	s.Nil(err, "No error expected.")
+	s.NotEmpty(task0, "Expected non empty task identifier.")
+
+	taskD, err := s.GetTransferTasks(1)
+	s.Equal(1, len(taskD), "Expected 1 decision task.")
+	err = s.CompleteTransferTask(taskD[0].TaskID)
+	s.Nil(err)
+
+	state0, err := s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.Nil(err, "No error expected.")
+	s.Equal("", state0.ExecutionInfo.CompletionCallbackURL)
+
+	callbackURL := "http://localhost:8080/workflows/completed"
+	updatedInfo := copyWorkflowExecutionInfo(state0.ExecutionInfo)
+	updatedInfo.CompletionCallbackURL = callbackURL
+	transferTasks := []Task{&CompletionCallbackTask{
+		TaskID:      s.GetNextSequenceNumber(),
+		CallbackURL: callbackURL,
+	}}
+	err = s.UpdateWorkflowExecutionWithTransferTasks(updatedInfo, int64(3), transferTasks, nil)
+	s.Nil(err, "No error expected.")
+
+	state1, err := s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.Nil(err, "No error expected.")
+	s.Equal(callbackURL, state1.ExecutionInfo.CompletionCallbackURL)
+
+	tasks, err := s.GetTransferTasks(1)
+	s.Nil(err, "No error expected.")
+	s.Equal(1, len(tasks), "Expected 1 completion callback task.")
+	task := tasks[0]
+	s.Equal(TransferTaskTypeCompletionCallback, task.TaskType)
+	s.Equal(domainID, task.DomainID)
+	s.Equal(workflowExecution.GetWorkflowId(), task.WorkflowID)
+	s.Equal(workflowExecution.GetRunId(), task.RunID)
+	s.Equal(callbackURL, task.CallbackURL)
+
+	err = s.CompleteTransferTask(task.TaskID)
+	s.Nil(err)
+}
+
 func (s *cassandraPersistenceSuite) TestCreateTask() {
 	domainID := "11adbd1b-f164-4ea7-b2f3-2e857a5048f1"
 	workflowExecution := gen.WorkflowExecution{WorkflowId: common.StringPtr("create-task-test"),
@@ -597,6 +802,43 @@ func (s *cassandraPersistenceSuite) TestCompleteDecisionTask() {
 	}
 }
 
+func (s *cassandraPersistenceSuite) TestCompleteTasksLessThan() {
+	domainID := "52c5ea35-4f3b-4c2e-9bc4-e4e31a8a0c2b"
+	workflowExecution := gen.WorkflowExecution{WorkflowId: common.StringPtr("complete-tasks-less-than-test"),
+		RunId: common.StringPtr("9d7b08f5-02a4-4a02-a7e8-6c05e9a7a0e1")}
+	taskList := "6c05e9a7a0e1"
+	taskIDs, err0 := s.CreateActivityTasks(domainID, workflowExecution, map[int64]string{
+		10: taskList,
+		20: taskList,
+		30: taskList,
+	})
+	s.Nil(err0, "No error expected.")
+	s.Equal(3, len(taskIDs))
+
+	tasksResponse, err1 := s.GetTasks(domainID, taskList, TaskListTypeActivity, 10)
+	s.Nil(err1, "No error expected.")
+	s.Equal(3, len(tasksResponse.Tasks))
+	lastTaskID := tasksResponse.Tasks[2].TaskID
+
+	err2 := s.TaskMgr.CompleteTasksLessThan(&CompleteTasksLessThanRequest{
+		DomainID:     domainID,
+		TaskListName: taskList,
+		TaskType:     TaskListTypeActivity,
+		TaskID:       lastTaskID,
+	})
+	s.Nil(err2, "No error expected.")
+
+	tasksResponse, err1 = s.GetTasks(domainID, taskList, TaskListTypeActivity, 10)
+	s.Nil(err1, "No error expected.")
+	s.Equal(1, len(tasksResponse.Tasks))
+	s.Equal(lastTaskID, tasksResponse.Tasks[0].TaskID)
+
+	// The task list row itself is kept
+	_, err3 := s.TaskMgr.LeaseTaskList(&LeaseTaskListRequest{DomainID: domainID, TaskList: taskList,
+		TaskType: TaskListTypeActivity})
+	s.Nil(err3, "No error expected.")
+}
+
 func (s *cassandraPersistenceSuite) TestLeaseTaskList() {
 	domainID := "00136543-72ad-4615-b7e9-44bca9775b45"
 	taskList := "aaaaaaa"
@@ -621,6 +863,70 @@ func (s *cassandraPersistenceSuite) TestLeaseTaskList() {
 	s.EqualValues(0, tli.AckLevel)
 }
 
+func (s *cassandraPersistenceSuite) TestTaskListPollersAndBacklog() {
+	domainID := "6a3a1fd1-61b5-4c5a-a4bf-2ab4b1a9c8c0"
+	workflowExecution := gen.WorkflowExecution{WorkflowId: common.StringPtr("task-list-backlog-test"),
+		RunId: common.StringPtr("0f7e3a5c-2c4e-4a26-9a64-5fb2d1e9d0a1")}
+	taskList := "5fb2d1e9d0a1"
+	taskIDs, err := s.CreateActivityTasks(domainID, workflowExecution, map[int64]string{
+		10: taskList,
+		20: taskList,
+		30: taskList,
+	})
+	s.NoError(err)
+
+	response, err := s.TaskMgr.LeaseTaskList(&LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: TaskListTypeActivity,
+	})
+	s.NoError(err)
+	tli := response.TaskListInfo
+	s.Empty(tli.Pollers)
+
+	backlog, err := s.TaskMgr.GetTaskListBacklog(&GetTaskListBacklogRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: TaskListTypeActivity,
+		AckLevel: tli.AckLevel,
+	})
+	s.NoError(err)
+	s.EqualValues(3, backlog.ApproximateCount)
+
+	// The tasks up to the ack level are not counted
+	minTaskID := taskIDs[0]
+	for _, taskID := range taskIDs {
+		if taskID < minTaskID {
+			minTaskID = taskID
+		}
+	}
+	lastAccessTime := time.Now().Truncate(time.Millisecond)
+	tli.AckLevel = minTaskID
+	tli.Pollers = map[string]time.Time{"worker-1": lastAccessTime}
+	_, err = s.TaskMgr.UpdateTaskList(&UpdateTaskListRequest{TaskListInfo: tli})
+	s.NoError(err)
+
+	backlog, err = s.TaskMgr.GetTaskListBacklog(&GetTaskListBacklogRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: TaskListTypeActivity,
+		AckLevel: tli.AckLevel,
+	})
+	s.NoError(err)
+	s.EqualValues(2, backlog.ApproximateCount)
+
+	// The pollers are kept when the task list is leased again
+	response, err = s.TaskMgr.LeaseTaskList(&LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: TaskListTypeActivity,
+	})
+	s.NoError(err)
+	s.Equal(minTaskID, response.TaskListInfo.AckLevel)
+	s.Equal(1, len(response.TaskListInfo.Pollers))
+	s.True(lastAccessTime.Equal(response.TaskListInfo.Pollers["worker-1"]))
+}
+
 func (s *cassandraPersistenceSuite) TestTimerTasks() {
 	domainID := "8bfb47be-5b57-4d66-9109-5fb35e20b1d7"
 	workflowExecution := gen.WorkflowExecution{
@@ -657,6 +963,173 @@ func (s *cassandraPersistenceSuite) TestTimerTasks() {
 	s.Empty(timerTasks2, "expected empty task list.")
 }
 
+func (s *cassandraPersistenceSuite) TestRangeCompleteTransferTasks() {
+	domainID := "8b4b5ee4-6a79-4e6b-9e46-3d1dfe0ab5a1"
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("range-complete-transfer-tasks-test"),
+		RunId:      common.StringPtr("4a2bbd6b-a3c2-4ea1-8b3f-2c4a9a1de1c0"),
+	}
+
+	task0, err0 := s.CreateWorkflowExecution(domainID, workflowExecution, "queue1", "wType", 13, nil, 3, 0, 2, nil)
+	s.Nil(err0, "No error expected.")
+	s.NotEmpty(task0, "Expected non empty task identifier.")
+
+	state0, err1 := s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.Nil(err1, "No error expected.")
+	updatedInfo := copyWorkflowExecutionInfo(state0.ExecutionInfo)
+	updatedInfo.NextEventID = int64(6)
+	updatedInfo.LastProcessedEvent = int64(2)
+	err2 := s.UpdateWorkflowExecution(updatedInfo, nil, []int64{int64(4), int64(5)}, int64(3), nil, nil, nil, nil, nil,
+		nil)
+	s.Nil(err2, "No error expected.")
+
+	tasks, err3 := s.GetTransferTasks(10)
+	s.Nil(err3, "No error expected.")
+	s.Equal(3, len(tasks), "Expected 1 decision task and 2 activity tasks.")
+
+	err4 := s.RangeCompleteTransferTask(tasks[0].TaskID, tasks[1].TaskID)
+	s.Nil(err4, "No error expected.")
+
+	response, err5 := s.WorkflowMgr.GetTransferTasks(&GetTransferTasksRequest{
+		ReadLevel:    tasks[0].TaskID - 1,
+		MaxReadLevel: tasks[2].TaskID,
+		BatchSize:    10,
+	})
+	s.Nil(err5, "No error expected.")
+	s.Equal(2, len(response.Tasks), "Expected only the task at the beginning of the range to remain.")
+	s.Equal(tasks[0].TaskID, response.Tasks[0].TaskID)
+	s.Equal(tasks[2].TaskID, response.Tasks[1].TaskID)
+
+	err6 := s.RangeCompleteTransferTask(tasks[0].TaskID-1, tasks[2].TaskID)
+	s.Nil(err6, "No error expected.")
+}
+
+func (s *cassandraPersistenceSuite) TestRangeCompleteTimerTasks() {
+	domainID := "0c6b8e1f-8f3a-4b58-a8c2-2fcbb3d0c0a4"
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("range-complete-timer-tasks-test"),
+		RunId:      common.StringPtr("f1cd0e2a-5c21-4f76-9a6e-6b2a9b8e8a7d"),
+	}
+
+	task0, err0 := s.CreateWorkflowExecution(domainID, workflowExecution, "taskList", "wType", 13, nil, 3, 0, 2, nil)
+	s.Nil(err0, "No error expected.")
+	s.NotEmpty(task0, "Expected non empty task identifier.")
+
+	state0, err1 := s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.Nil(err1, "No error expected.")
+	updatedInfo := copyWorkflowExecutionInfo(state0.ExecutionInfo)
+	updatedInfo.NextEventID = int64(5)
+	updatedInfo.LastProcessedEvent = int64(2)
+	now := time.Now()
+	tasks := []Task{
+		&UserTimerTask{VisibilityTimestamp: now.Add(time.Minute), TaskID: 1, EventID: 1},
+		&UserTimerTask{VisibilityTimestamp: now.Add(2 * time.Minute), TaskID: 2, EventID: 2},
+		&UserTimerTask{VisibilityTimestamp: now.Add(3 * time.Minute), TaskID: 3, EventID: 3},
+	}
+	err2 := s.UpdateWorkflowExecution(updatedInfo, nil, nil, int64(3), tasks, nil, nil, nil, nil, nil)
+	s.Nil(err2, "No error expected.")
+
+	timerTasks, err3 := s.GetTimerIndexTasks()
+	s.Nil(err3, "No error expected.")
+	s.Equal(3, len(timerTasks))
+
+	err4 := s.RangeCompleteTimerTask(time.Time{}, timerTasks[2].VisibilityTimestamp)
+	s.Nil(err4, "No error expected.")
+
+	timerTasks2, err5 := s.GetTimerIndexTasks()
+	s.Nil(err5, "No error expected.")
+	s.Equal(1, len(timerTasks2), "Expected only the task at the end of the range to remain.")
+	s.Equal(timerTasks[2].TaskID, timerTasks2[0].TaskID)
+
+	err6 := s.RangeCompleteTimerTask(time.Time{}, now.Add(time.Hour))
+	s.Nil(err6, "No error expected.")
+}
+
+func (s *cassandraPersistenceSuite) TestTransferTasksPagination() {
+	domainID := "5b0b5e70-1a36-4b5b-9c0e-7f0e5cf9f3a2"
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("transfer-tasks-pagination-test"),
+		RunId:      common.StringPtr("c7f1a0b2-6e0d-4c5e-8f0b-3a9d2e1c4b5f"),
+	}
+
+	task0, err0 := s.CreateWorkflowExecution(domainID, workflowExecution, "queue1", "wType", 13, nil, 3, 0, 2, nil)
+	s.Nil(err0, "No error expected.")
+	s.NotEmpty(task0, "Expected non empty task identifier.")
+
+	state0, err1 := s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.Nil(err1, "No error expected.")
+	updatedInfo := copyWorkflowExecutionInfo(state0.ExecutionInfo)
+	updatedInfo.NextEventID = int64(6)
+	updatedInfo.LastProcessedEvent = int64(2)
+	err2 := s.UpdateWorkflowExecution(updatedInfo, nil, []int64{int64(4), int64(5)}, int64(3), nil, nil, nil, nil, nil,
+		nil)
+	s.Nil(err2, "No error expected.")
+
+	request := &GetTransferTasksRequest{
+		ReadLevel:    s.GetReadLevel(),
+		MaxReadLevel: int64(math.MaxInt64),
+		BatchSize:    2,
+	}
+	response, err3 := s.WorkflowMgr.GetTransferTasks(request)
+	s.Nil(err3, "No error expected.")
+	s.Equal(2, len(response.Tasks))
+	s.NotEmpty(response.NextPageToken, "Expected a token for the last task.")
+
+	request.NextPageToken = response.NextPageToken
+	response2, err4 := s.WorkflowMgr.GetTransferTasks(request)
+	s.Nil(err4, "No error expected.")
+	s.Equal(1, len(response2.Tasks))
+	s.True(response2.Tasks[0].TaskID > response.Tasks[1].TaskID)
+
+	err5 := s.RangeCompleteTransferTask(request.ReadLevel, response2.Tasks[0].TaskID)
+	s.Nil(err5, "No error expected.")
+}
+
+func (s *cassandraPersistenceSuite) TestTimerTasksPagination() {
+	domainID := "9e2d4c1b-3f6a-4d7e-8b0c-1a5f3e7d9c2b"
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("timer-tasks-pagination-test"),
+		RunId:      common.StringPtr("2d8e6f4a-0b1c-4e3d-9a5f-7c6b8d0e2f1a"),
+	}
+
+	task0, err0 := s.CreateWorkflowExecution(domainID, workflowExecution, "taskList", "wType", 13, nil, 3, 0, 2, nil)
+	s.Nil(err0, "No error expected.")
+	s.NotEmpty(task0, "Expected non empty task identifier.")
+
+	state0, err1 := s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.Nil(err1, "No error expected.")
+	updatedInfo := copyWorkflowExecutionInfo(state0.ExecutionInfo)
+	updatedInfo.NextEventID = int64(5)
+	updatedInfo.LastProcessedEvent = int64(2)
+	now := time.Now()
+	tasks := []Task{
+		&UserTimerTask{VisibilityTimestamp: now.Add(time.Minute), TaskID: 1, EventID: 1},
+		&UserTimerTask{VisibilityTimestamp: now.Add(time.Minute), TaskID: 2, EventID: 2},
+		&UserTimerTask{VisibilityTimestamp: now.Add(2 * time.Minute), TaskID: 3, EventID: 3},
+	}
+	err2 := s.UpdateWorkflowExecution(updatedInfo, nil, nil, int64(3), tasks, nil, nil, nil, nil, nil)
+	s.Nil(err2, "No error expected.")
+
+	request := &GetTimerIndexTasksRequest{
+		MinTimestamp: time.Time{},
+		MaxTimestamp: time.Unix(0, math.MaxInt64),
+		BatchSize:    2,
+	}
+	response, err3 := s.WorkflowMgr.GetTimerIndexTasks(request)
+	s.Nil(err3, "No error expected.")
+	s.Equal(2, len(response.Timers))
+	s.NotEmpty(response.NextPageToken, "Expected a token for the last timer.")
+
+	request.NextPageToken = response.NextPageToken
+	response2, err4 := s.WorkflowMgr.GetTimerIndexTasks(request)
+	s.Nil(err4, "No error expected.")
+	s.Equal(1, len(response2.Timers))
+	s.True(response2.Timers[0].VisibilityTimestamp.After(response.Timers[1].VisibilityTimestamp))
+
+	err5 := s.RangeCompleteTimerTask(time.Time{}, now.Add(time.Hour))
+	s.Nil(err5, "No error expected.")
+}
+
 func (s *cassandraPersistenceSuite) TestWorkflowMutableState_Activities() {
 	domainID := "7fcf0aa9-e121-4292-bdad-0a75181b4aa3"
 	workflowExecution := gen.WorkflowExecution{
@@ -824,6 +1297,60 @@ func (s *cassandraPersistenceSuite) TestWorkflowMutableState_ChildExecutions() {
 	s.Equal(0, len(state.ChildExecutionInfos))
 }
 
+func (s *cassandraPersistenceSuite) TestWorkflowMutableState_SignalRequested() {
+	domainID := "3f7d8a1e-5b0c-4e59-9d2a-7c6b1e8f4a20"
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("test-workflow-mutable-signal-requested-test"),
+		RunId:      common.StringPtr("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"),
+	}
+
+	task0, err0 := s.CreateWorkflowExecution(domainID, workflowExecution, "taskList", "wType", 13, nil, 3, 0, 2, nil)
+	s.Nil(err0, "No error expected.")
+	s.NotEmpty(task0, "Expected non empty task identifier.")
+
+	state0, err1 := s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.Nil(err1, "No error expected.")
+	info0 := state0.ExecutionInfo
+	s.NotNil(info0, "Valid Workflow info expected.")
+	s.Equal(0, len(state0.SignalRequestedIDs))
+	s.False(info0.CancelRequested)
+	s.Equal("", info0.CancelRequestID)
+
+	updatedInfo := copyWorkflowExecutionInfo(info0)
+	updatedInfo.NextEventID = int64(5)
+	updatedInfo.LastProcessedEvent = int64(2)
+	updatedInfo.CancelRequested = true
+	updatedInfo.CancelRequestID = "cancel-request-id"
+	err2 := s.UpsertSignalRequestedState(updatedInfo, int64(3), []string{"signal-request-id-1"})
+	s.Nil(err2, "No error expected.")
+
+	updatedInfo.NextEventID = int64(6)
+	err2 = s.UpsertSignalRequestedState(updatedInfo, int64(5), []string{"signal-request-id-2"})
+	s.Nil(err2, "No error expected.")
+
+	state, err1 := s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.Nil(err1, "No error expected.")
+	s.NotNil(state, "expected valid state.")
+	s.Equal(2, len(state.SignalRequestedIDs))
+	for _, requestID := range []string{"signal-request-id-1", "signal-request-id-2"} {
+		recorded, ok := state.SignalRequestedIDs[requestID]
+		s.True(ok)
+		s.False(recorded.IsZero())
+	}
+	s.True(state.ExecutionInfo.CancelRequested)
+	s.Equal("cancel-request-id", state.ExecutionInfo.CancelRequestID)
+
+	updatedInfo.NextEventID = int64(7)
+	err2 = s.DeleteSignalRequestedState(updatedInfo, int64(6), []string{"signal-request-id-1"})
+	s.Nil(err2, "No error expected.")
+
+	state, err1 = s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.Nil(err1, "No error expected.")
+	s.Equal(1, len(state.SignalRequestedIDs))
+	_, ok := state.SignalRequestedIDs["signal-request-id-2"]
+	s.True(ok)
+}
+
 func (s *cassandraPersistenceSuite) TestWorkflowMutableStateInfo() {
 	domainID := "9ed8818b-3090-4160-9f21-c6b70e64d2dd"
 	workflowExecution := gen.WorkflowExecution{
@@ -900,28 +1427,82 @@ func (s *cassandraPersistenceSuite) TestContinueAsNew() {
 	s.Equal(newWorkflowExecution.GetRunId(), newRunID)
 }
 
+func (s *cassandraPersistenceSuite) TestCreateWorkflowExecutionWithMutableState() {
+	domainID := "3b2f5b0c-6fb0-4b39-a4c4-3f0c8e4d5a21"
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("create-workflow-with-mutable-state-test"),
+		RunId:      common.StringPtr("8f0a9e9e-7c56-4bde-bd6e-2a3b0a1b6c3d"),
+	}
+
+	currentTime := time.Now().UTC()
+	timerID := "id_1"
+	_, err0 := s.WorkflowMgr.CreateWorkflowExecution(&CreateWorkflowExecutionRequest{
+		RequestID:                   uuid.New(),
+		DomainID:                    domainID,
+		Execution:                   workflowExecution,
+		TaskList:                    "taskList",
+		WorkflowTypeName:            "wType",
+		DecisionTimeoutValue:        13,
+		NextEventID:                 8,
+		LastProcessedEvent:          4,
+		RangeID:                     s.ShardContext.GetRangeID(),
+		DecisionScheduleID:          7,
+		DecisionStartedID:           common.EmptyEventID,
+		DecisionStartToCloseTimeout: 1,
+		ActivityInfos: []*ActivityInfo{
+			{
+				ScheduleID:             5,
+				ScheduledEvent:         []byte("scheduled_event_5"),
+				StartedID:              common.EmptyEventID,
+				ScheduleToCloseTimeout: 1,
+				ScheduleToStartTimeout: 2,
+				StartToCloseTimeout:    3,
+				HeartbeatTimeout:       4,
+				CancelRequestID:        common.EmptyEventID,
+			}},
+		TimerInfos: []*TimerInfo{{TimerID: timerID, ExpiryTime: currentTime, TaskID: 2, StartedID: 6}},
+	})
+	s.Nil(err0, "No error expected.")
+
+	state, err1 := s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.Nil(err1, "No error expected.")
+	s.Equal(int64(8), state.ExecutionInfo.NextEventID)
+	s.Equal(1, len(state.ActivitInfos))
+	ai, ok := state.ActivitInfos[5]
+	s.True(ok)
+	s.Equal([]byte("scheduled_event_5"), ai.ScheduledEvent)
+	s.Equal(common.EmptyEventID, ai.StartedID)
+	s.Equal(int32(2), ai.ScheduleToStartTimeout)
+	s.Equal(1, len(state.TimerInfos))
+	s.Equal(currentTime.Unix(), state.TimerInfos[timerID].ExpiryTime.Unix())
+	s.Equal(int64(6), state.TimerInfos[timerID].StartedID)
+}
+
 func copyWorkflowExecutionInfo(sourceInfo *WorkflowExecutionInfo) *WorkflowExecutionInfo {
 	return &WorkflowExecutionInfo{
-		DomainID:             sourceInfo.DomainID,
-		WorkflowID:           sourceInfo.WorkflowID,
-		RunID:                sourceInfo.RunID,
-		ParentDomainID:       sourceInfo.ParentDomainID,
-		ParentWorkflowID:     sourceInfo.ParentWorkflowID,
-		ParentRunID:          sourceInfo.ParentRunID,
-		InitiatedID:          sourceInfo.InitiatedID,
-		CompletionEvent:      sourceInfo.CompletionEvent,
-		TaskList:             sourceInfo.TaskList,
-		WorkflowTypeName:     sourceInfo.WorkflowTypeName,
-		DecisionTimeoutValue: sourceInfo.DecisionTimeoutValue,
-		ExecutionContext:     sourceInfo.ExecutionContext,
-		State:                sourceInfo.State,
-		NextEventID:          sourceInfo.NextEventID,
-		LastProcessedEvent:   sourceInfo.LastProcessedEvent,
-		LastUpdatedTimestamp: sourceInfo.LastUpdatedTimestamp,
-		CreateRequestID:      sourceInfo.CreateRequestID,
-		DecisionScheduleID:   sourceInfo.DecisionScheduleID,
-		DecisionStartedID:    sourceInfo.DecisionStartedID,
-		DecisionRequestID:    sourceInfo.DecisionRequestID,
-		DecisionTimeout:      sourceInfo.DecisionTimeout,
+		DomainID:              sourceInfo.DomainID,
+		WorkflowID:            sourceInfo.WorkflowID,
+		RunID:                 sourceInfo.RunID,
+		ParentDomainID:        sourceInfo.ParentDomainID,
+		ParentWorkflowID:      sourceInfo.ParentWorkflowID,
+		ParentRunID:           sourceInfo.ParentRunID,
+		InitiatedID:           sourceInfo.InitiatedID,
+		CompletionEvent:       sourceInfo.CompletionEvent,
+		TaskList:              sourceInfo.TaskList,
+		WorkflowTypeName:      sourceInfo.WorkflowTypeName,
+		DecisionTimeoutValue:  sourceInfo.DecisionTimeoutValue,
+		ExecutionContext:      sourceInfo.ExecutionContext,
+		State:                 sourceInfo.State,
+		NextEventID:           sourceInfo.NextEventID,
+		LastProcessedEvent:    sourceInfo.LastProcessedEvent,
+		LastUpdatedTimestamp:  sourceInfo.LastUpdatedTimestamp,
+		CreateRequestID:       sourceInfo.CreateRequestID,
+		DecisionScheduleID:    sourceInfo.DecisionScheduleID,
+		DecisionStartedID:     sourceInfo.DecisionStartedID,
+		DecisionRequestID:     sourceInfo.DecisionRequestID,
+		DecisionTimeout:       sourceInfo.DecisionTimeout,
+		CompletionCallbackURL: sourceInfo.CompletionCallbackURL,
+		CancelRequested:       sourceInfo.CancelRequested,
+		CancelRequestID:       sourceInfo.CancelRequestID,
 	}
 }
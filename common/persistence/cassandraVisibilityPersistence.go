@@ -37,6 +37,12 @@ const (
 	defaultCloseTTLSeconds = 86400
 )
 
+// Phases of ScanWorkflowExecutions, recorded as the first byte of the page token
+const (
+	scanPhaseOpen byte = iota
+	scanPhaseClosed
+)
+
 const (
 	templateCreateWorkflowExecutionStarted = `INSERT INTO open_executions (` +
 		`domain_id, domain_partition, workflow_id, run_id, start_time, workflow_type_name) ` +
@@ -106,6 +112,35 @@ const (
 		`AND start_time <= ? ` +
 		`AND status = ? `
 
+	templateScanOpenWorkflowExecutions = `SELECT workflow_id, run_id, start_time, workflow_type_name ` +
+		`FROM open_executions ` +
+		`WHERE domain_id = ? ` +
+		`AND domain_partition = ? `
+
+	templateScanClosedWorkflowExecutions = `SELECT workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length ` +
+		`FROM closed_executions ` +
+		`WHERE domain_id = ? ` +
+		`AND domain_partition = ? `
+
+	templateCountOpenWorkflowExecutions = `SELECT COUNT(*) ` +
+		`FROM open_executions ` +
+		`WHERE domain_id = ? ` +
+		`AND domain_partition = ? ` +
+		`AND start_time >= ? ` +
+		`AND start_time <= ? `
+
+	templateCountClosedWorkflowExecutions = `SELECT COUNT(*) ` +
+		`FROM closed_executions ` +
+		`WHERE domain_id = ? ` +
+		`AND domain_partition = ? ` +
+		`AND start_time >= ? ` +
+		`AND start_time <= ? `
+
+	// The filters of the count queries, at most one of them is appended
+	templateCountByTypeFilter   = `AND workflow_type_name = ? `
+	templateCountByIDFilter     = `AND workflow_id = ? `
+	templateCountByStatusFilter = `AND status = ? `
+
 	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length ` +
 		`FROM closed_executions ` +
 		`WHERE domain_id = ? ` +
@@ -123,14 +158,9 @@ type (
 )
 
 // NewCassandraVisibilityPersistence is used to create an instance of VisibilityManager implementation
-func NewCassandraVisibilityPersistence(
-	hosts string, dc string, keyspace string, logger bark.Logger) (VisibilityManager, error) {
-	cluster := common.NewCassandraCluster(hosts, dc)
-	cluster.Keyspace = keyspace
-	cluster.ProtoVersion = cassandraProtoVersion
-	cluster.Consistency = gocql.LocalQuorum
-	cluster.SerialConsistency = gocql.LocalSerial
-	cluster.Timeout = defaultSessionTimeout
+func NewCassandraVisibilityPersistence(hosts string, dc string, keyspace string, consistency CassandraConsistency,
+	logger bark.Logger) (VisibilityManager, error) {
+	cluster := newCassandraCluster(hosts, dc, keyspace, consistency)
 
 	session, err := cluster.CreateSession()
 	if err != nil {
@@ -160,9 +190,7 @@ func (v *cassandraVisibilityPersistence) RecordWorkflowExecutionStarted(
 	query = query.WithTimestamp(common.UnixNanoToCQLTimestamp(request.StartTimestamp))
 	err := query.Exec()
 	if err != nil {
-		return &workflow.InternalServiceError{
-			Message: fmt.Sprintf("RecordWorkflowExecutionStarted operation failed. Error: %v", err),
-		}
+		return convertCommonErrors("RecordWorkflowExecutionStarted", err)
 	}
 
 	return nil
@@ -204,23 +232,24 @@ func (v *cassandraVisibilityPersistence) RecordWorkflowExecutionClosed(
 	batch = batch.WithTimestamp(common.UnixNanoToCQLTimestamp(request.CloseTimestamp))
 	err := v.session.ExecuteBatch(batch)
 	if err != nil {
-		return &workflow.InternalServiceError{
-			Message: fmt.Sprintf("RecordWorkflowExecutionClosed operation failed. Error: %v", err),
-		}
+		return convertCommonErrors("RecordWorkflowExecutionClosed", err)
 	}
 	return nil
 }
 
 func (v *cassandraVisibilityPersistence) ListOpenWorkflowExecutions(
 	request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	pageState, err := deserializePageToken(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
 	query := v.session.Query(templateGetOpenWorkflowExecutions,
 		request.DomainUUID,
 		domainPartition,
 		common.UnixNanoToCQLTimestamp(request.EarliestStartTime),
 		common.UnixNanoToCQLTimestamp(request.LatestStartTime)).Consistency(v.lowConslevel)
-	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
+	iter := query.PageSize(getPageSize(request.PageSize)).PageState(pageState).Iter()
 	if iter == nil {
-		// TODO: should return a bad request error if the token is invalid
 		return nil, &workflow.InternalServiceError{
 			Message: "ListOpenWorkflowExecutions operation failed.  Not able to create query iterator.",
 		}
@@ -234,13 +263,9 @@ func (v *cassandraVisibilityPersistence) ListOpenWorkflowExecutions(
 		wfexecution, has = readOpenWorkflowExecutionRecord(iter)
 	}
 
-	nextPageToken := iter.PageState()
-	response.NextPageToken = make([]byte, len(nextPageToken))
-	copy(response.NextPageToken, nextPageToken)
+	response.NextPageToken = serializePageToken(iter.PageState())
 	if err := iter.Close(); err != nil {
-		return nil, &workflow.InternalServiceError{
-			Message: fmt.Sprintf("ListOpenWorkflowExecutions operation failed. Error: %v", err),
-		}
+		return nil, convertCommonErrors("ListOpenWorkflowExecutions", err)
 	}
 
 	return response, nil
@@ -248,14 +273,17 @@ func (v *cassandraVisibilityPersistence) ListOpenWorkflowExecutions(
 
 func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutions(
 	request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	pageState, err := deserializePageToken(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
 	query := v.session.Query(templateGetClosedWorkflowExecutions,
 		request.DomainUUID,
 		domainPartition,
 		common.UnixNanoToCQLTimestamp(request.EarliestStartTime),
 		common.UnixNanoToCQLTimestamp(request.LatestStartTime)).Consistency(v.lowConslevel)
-	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
+	iter := query.PageSize(getPageSize(request.PageSize)).PageState(pageState).Iter()
 	if iter == nil {
-		// TODO: should return a bad request error if the token is invalid
 		return nil, &workflow.InternalServiceError{
 			Message: "ListOpenWorkflowExecutions operation failed.  Not able to create query iterator.",
 		}
@@ -269,13 +297,9 @@ func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutions(
 		wfexecution, has = readClosedWorkflowExecutionRecord(iter)
 	}
 
-	nextPageToken := iter.PageState()
-	response.NextPageToken = make([]byte, len(nextPageToken))
-	copy(response.NextPageToken, nextPageToken)
+	response.NextPageToken = serializePageToken(iter.PageState())
 	if err := iter.Close(); err != nil {
-		return nil, &workflow.InternalServiceError{
-			Message: fmt.Sprintf("ListOpenWorkflowExecutions operation failed. Error: %v", err),
-		}
+		return nil, convertCommonErrors("ListOpenWorkflowExecutions", err)
 	}
 
 	return response, nil
@@ -283,15 +307,18 @@ func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutions(
 
 func (v *cassandraVisibilityPersistence) ListOpenWorkflowExecutionsByType(
 	request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+	pageState, err := deserializePageToken(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
 	query := v.session.Query(templateGetOpenWorkflowExecutionsByType,
 		request.DomainUUID,
 		domainPartition,
 		common.UnixNanoToCQLTimestamp(request.EarliestStartTime),
 		common.UnixNanoToCQLTimestamp(request.LatestStartTime),
 		request.WorkflowTypeName).Consistency(v.lowConslevel)
-	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
+	iter := query.PageSize(getPageSize(request.PageSize)).PageState(pageState).Iter()
 	if iter == nil {
-		// TODO: should return a bad request error if the token is invalid
 		return nil, &workflow.InternalServiceError{
 			Message: "ListOpenWorkflowExecutionsByType operation failed.  Not able to create query iterator.",
 		}
@@ -305,13 +332,9 @@ func (v *cassandraVisibilityPersistence) ListOpenWorkflowExecutionsByType(
 		wfexecution, has = readOpenWorkflowExecutionRecord(iter)
 	}
 
-	nextPageToken := iter.PageState()
-	response.NextPageToken = make([]byte, len(nextPageToken))
-	copy(response.NextPageToken, nextPageToken)
+	response.NextPageToken = serializePageToken(iter.PageState())
 	if err := iter.Close(); err != nil {
-		return nil, &workflow.InternalServiceError{
-			Message: fmt.Sprintf("ListOpenWorkflowExecutionsByType operation failed. Error: %v", err),
-		}
+		return nil, convertCommonErrors("ListOpenWorkflowExecutionsByType", err)
 	}
 
 	return response, nil
@@ -319,15 +342,18 @@ func (v *cassandraVisibilityPersistence) ListOpenWorkflowExecutionsByType(
 
 func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutionsByType(
 	request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+	pageState, err := deserializePageToken(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
 	query := v.session.Query(templateGetClosedWorkflowExecutionsByType,
 		request.DomainUUID,
 		domainPartition,
 		common.UnixNanoToCQLTimestamp(request.EarliestStartTime),
 		common.UnixNanoToCQLTimestamp(request.LatestStartTime),
 		request.WorkflowTypeName).Consistency(v.lowConslevel)
-	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
+	iter := query.PageSize(getPageSize(request.PageSize)).PageState(pageState).Iter()
 	if iter == nil {
-		// TODO: should return a bad request error if the token is invalid
 		return nil, &workflow.InternalServiceError{
 			Message: "ListClosedWorkflowExecutionsByType operation failed.  Not able to create query iterator.",
 		}
@@ -341,13 +367,9 @@ func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutionsByType(
 		wfexecution, has = readClosedWorkflowExecutionRecord(iter)
 	}
 
-	nextPageToken := iter.PageState()
-	response.NextPageToken = make([]byte, len(nextPageToken))
-	copy(response.NextPageToken, nextPageToken)
+	response.NextPageToken = serializePageToken(iter.PageState())
 	if err := iter.Close(); err != nil {
-		return nil, &workflow.InternalServiceError{
-			Message: fmt.Sprintf("ListClosedWorkflowExecutionsByType operation failed. Error: %v", err),
-		}
+		return nil, convertCommonErrors("ListClosedWorkflowExecutionsByType", err)
 	}
 
 	return response, nil
@@ -355,15 +377,18 @@ func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutionsByType(
 
 func (v *cassandraVisibilityPersistence) ListOpenWorkflowExecutionsByWorkflowID(
 	request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+	pageState, err := deserializePageToken(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
 	query := v.session.Query(templateGetOpenWorkflowExecutionsByID,
 		request.DomainUUID,
 		domainPartition,
 		common.UnixNanoToCQLTimestamp(request.EarliestStartTime),
 		common.UnixNanoToCQLTimestamp(request.LatestStartTime),
 		request.WorkflowID).Consistency(v.lowConslevel)
-	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
+	iter := query.PageSize(getPageSize(request.PageSize)).PageState(pageState).Iter()
 	if iter == nil {
-		// TODO: should return a bad request error if the token is invalid
 		return nil, &workflow.InternalServiceError{
 			Message: "ListOpenWorkflowExecutionsByWorkflowID operation failed.  Not able to create query iterator.",
 		}
@@ -377,13 +402,9 @@ func (v *cassandraVisibilityPersistence) ListOpenWorkflowExecutionsByWorkflowID(
 		wfexecution, has = readOpenWorkflowExecutionRecord(iter)
 	}
 
-	nextPageToken := iter.PageState()
-	response.NextPageToken = make([]byte, len(nextPageToken))
-	copy(response.NextPageToken, nextPageToken)
+	response.NextPageToken = serializePageToken(iter.PageState())
 	if err := iter.Close(); err != nil {
-		return nil, &workflow.InternalServiceError{
-			Message: fmt.Sprintf("ListOpenWorkflowExecutionsByWorkflowID operation failed. Error: %v", err),
-		}
+		return nil, convertCommonErrors("ListOpenWorkflowExecutionsByWorkflowID", err)
 	}
 
 	return response, nil
@@ -391,15 +412,18 @@ func (v *cassandraVisibilityPersistence) ListOpenWorkflowExecutionsByWorkflowID(
 
 func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutionsByWorkflowID(
 	request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+	pageState, err := deserializePageToken(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
 	query := v.session.Query(templateGetClosedWorkflowExecutionsByID,
 		request.DomainUUID,
 		domainPartition,
 		common.UnixNanoToCQLTimestamp(request.EarliestStartTime),
 		common.UnixNanoToCQLTimestamp(request.LatestStartTime),
 		request.WorkflowID).Consistency(v.lowConslevel)
-	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
+	iter := query.PageSize(getPageSize(request.PageSize)).PageState(pageState).Iter()
 	if iter == nil {
-		// TODO: should return a bad request error if the token is invalid
 		return nil, &workflow.InternalServiceError{
 			Message: "ListClosedWorkflowExecutionsByWorkflowID operation failed.  Not able to create query iterator.",
 		}
@@ -413,13 +437,9 @@ func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutionsByWorkflowI
 		wfexecution, has = readClosedWorkflowExecutionRecord(iter)
 	}
 
-	nextPageToken := iter.PageState()
-	response.NextPageToken = make([]byte, len(nextPageToken))
-	copy(response.NextPageToken, nextPageToken)
+	response.NextPageToken = serializePageToken(iter.PageState())
 	if err := iter.Close(); err != nil {
-		return nil, &workflow.InternalServiceError{
-			Message: fmt.Sprintf("ListClosedWorkflowExecutionsByWorkflowID operation failed. Error: %v", err),
-		}
+		return nil, convertCommonErrors("ListClosedWorkflowExecutionsByWorkflowID", err)
 	}
 
 	return response, nil
@@ -427,15 +447,18 @@ func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutionsByWorkflowI
 
 func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutionsByStatus(
 	request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error) {
+	pageState, err := deserializePageToken(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
 	query := v.session.Query(templateGetClosedWorkflowExecutionsByStatus,
 		request.DomainUUID,
 		domainPartition,
 		common.UnixNanoToCQLTimestamp(request.EarliestStartTime),
 		common.UnixNanoToCQLTimestamp(request.LatestStartTime),
 		request.Status).Consistency(v.lowConslevel)
-	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
+	iter := query.PageSize(getPageSize(request.PageSize)).PageState(pageState).Iter()
 	if iter == nil {
-		// TODO: should return a bad request error if the token is invalid
 		return nil, &workflow.InternalServiceError{
 			Message: "ListClosedWorkflowExecutionsByStatus operation failed.  Not able to create query iterator.",
 		}
@@ -449,18 +472,123 @@ func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutionsByStatus(
 		wfexecution, has = readClosedWorkflowExecutionRecord(iter)
 	}
 
-	nextPageToken := iter.PageState()
-	response.NextPageToken = make([]byte, len(nextPageToken))
-	copy(response.NextPageToken, nextPageToken)
+	response.NextPageToken = serializePageToken(iter.PageState())
 	if err := iter.Close(); err != nil {
+		return nil, convertCommonErrors("ListClosedWorkflowExecutionsByStatus", err)
+	}
+
+	return response, nil
+}
+
+func (v *cassandraVisibilityPersistence) ScanWorkflowExecutions(
+	request *ScanWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	tokenData, err := deserializePageToken(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	phase := scanPhaseOpen
+	var pageState []byte
+	if len(tokenData) > 0 {
+		phase = tokenData[0]
+		pageState = tokenData[1:]
+	}
+
+	template := templateScanOpenWorkflowExecutions
+	readRecord := readOpenWorkflowExecutionRecord
+	switch phase {
+	case scanPhaseOpen:
+	case scanPhaseClosed:
+		template = templateScanClosedWorkflowExecutions
+		readRecord = readClosedWorkflowExecutionRecord
+	default:
+		return nil, ErrInvalidPageToken
+	}
+
+	query := v.session.Query(template,
+		request.DomainUUID,
+		domainPartition).Consistency(v.lowConslevel)
+	iter := query.PageSize(getPageSize(request.PageSize)).PageState(pageState).Iter()
+	if iter == nil {
 		return nil, &workflow.InternalServiceError{
-			Message: fmt.Sprintf("ListClosedWorkflowExecutionsByStatus operation failed. Error: %v", err),
+			Message: "ScanWorkflowExecutions operation failed.  Not able to create query iterator.",
 		}
 	}
 
+	response := &ListWorkflowExecutionsResponse{}
+	response.Executions = make([]*workflow.WorkflowExecutionInfo, 0)
+	wfexecution, has := readRecord(iter)
+	for has {
+		response.Executions = append(response.Executions, wfexecution)
+		wfexecution, has = readRecord(iter)
+	}
+
+	nextPageState := iter.PageState()
+	if err := iter.Close(); err != nil {
+		return nil, convertCommonErrors("ScanWorkflowExecutions", err)
+	}
+
+	if len(nextPageState) > 0 {
+		response.NextPageToken = serializePageToken(append([]byte{phase}, nextPageState...))
+	} else if phase == scanPhaseOpen {
+		// Open executions are exhausted, continue with closed executions
+		response.NextPageToken = serializePageToken([]byte{scanPhaseClosed})
+	} else {
+		response.NextPageToken = serializePageToken(nil)
+	}
+
 	return response, nil
 }
 
+// ListWorkflowExecutionsWithQuery is not supported by the Cassandra visibility store
+func (v *cassandraVisibilityPersistence) ListWorkflowExecutionsWithQuery(
+	request *ListWorkflowExecutionsWithQueryRequest) (*ListWorkflowExecutionsResponse, error) {
+	return nil, &workflow.BadRequestError{
+		Message: "ListWorkflowExecutionsWithQuery is only supported by the Elasticsearch visibility store.",
+	}
+}
+
+func (v *cassandraVisibilityPersistence) CountOpenWorkflowExecutions(
+	request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
+	return v.countWorkflowExecutions("CountOpenWorkflowExecutions", templateCountOpenWorkflowExecutions, false,
+		request)
+}
+
+func (v *cassandraVisibilityPersistence) CountClosedWorkflowExecutions(
+	request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
+	return v.countWorkflowExecutions("CountClosedWorkflowExecutions", templateCountClosedWorkflowExecutions, true,
+		request)
+}
+
+func (v *cassandraVisibilityPersistence) countWorkflowExecutions(operation, template string, closed bool,
+	request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
+	args := []interface{}{
+		request.DomainUUID,
+		domainPartition,
+		common.UnixNanoToCQLTimestamp(request.EarliestStartTime),
+		common.UnixNanoToCQLTimestamp(request.LatestStartTime),
+	}
+	switch {
+	case request.WorkflowTypeName != "":
+		template += templateCountByTypeFilter
+		args = append(args, request.WorkflowTypeName)
+	case request.WorkflowID != "":
+		template += templateCountByIDFilter
+		args = append(args, request.WorkflowID)
+	case request.Status != nil && closed:
+		template += templateCountByStatusFilter
+		args = append(args, *request.Status)
+	}
+
+	var count int64
+	query := v.session.Query(template, args...).Consistency(v.lowConslevel)
+	if err := query.Scan(&count); err != nil {
+		return nil, convertCommonErrors(operation, err)
+	}
+
+	return &CountWorkflowExecutionsResponse{Count: count}, nil
+}
+
 func (v *cassandraVisibilityPersistence) GetClosedWorkflowExecution(
 	request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error) {
 	execution := request.Execution
@@ -486,9 +614,7 @@ func (v *cassandraVisibilityPersistence) GetClosedWorkflowExecution(
 	}
 
 	if err := iter.Close(); err != nil {
-		return nil, &workflow.InternalServiceError{
-			Message: fmt.Sprintf("GetClosedWorkflowExecution operation failed. Error: %v", err),
-		}
+		return nil, convertCommonErrors("GetClosedWorkflowExecution", err)
 	}
 
 	return &GetClosedWorkflowExecutionResponse{
@@ -496,6 +622,11 @@ func (v *cassandraVisibilityPersistence) GetClosedWorkflowExecution(
 	}, nil
 }
 
+// DeleteWorkflowExecution is a no-op, closed records already expire after the retention period of their domain
+func (v *cassandraVisibilityPersistence) DeleteWorkflowExecution(request *DeleteWorkflowExecutionVisibilityRequest) error {
+	return nil
+}
+
 func readOpenWorkflowExecutionRecord(iter *gocql.Iter) (*workflow.WorkflowExecutionInfo, bool) {
 	var workflowID string
 	var runID gocql.UUID
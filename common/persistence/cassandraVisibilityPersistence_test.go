@@ -405,6 +405,73 @@ func (s *visibilityPersistenceSuite) TestFilteringByCloseStatus() {
 	s.Equal(workflowExecution2.GetWorkflowId(), resp.Executions[0].Execution.GetWorkflowId())
 }
 
+func (s *visibilityPersistenceSuite) TestCountWorkflowExecutions() {
+	testDomainUUID := uuid.New()
+	startTime := time.Now().UnixNano()
+
+	// Create 2 executions, one of them is closed
+	workflowExecution1 := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("visibility-count-test1"),
+		RunId:      common.StringPtr("a3dbc7bf-deb1-4946-b57c-cf0615ea553f"),
+	}
+	err0 := s.VisibilityMgr.RecordWorkflowExecutionStarted(&RecordWorkflowExecutionStartedRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        workflowExecution1,
+		WorkflowTypeName: "visibility-workflow",
+		StartTimestamp:   startTime,
+	})
+	s.Nil(err0)
+
+	workflowExecution2 := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("visibility-count-test2"),
+		RunId:      common.StringPtr("0d00698f-08e1-4d36-a3e2-3bf109f5d2d6"),
+	}
+	err1 := s.VisibilityMgr.RecordWorkflowExecutionStarted(&RecordWorkflowExecutionStartedRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        workflowExecution2,
+		WorkflowTypeName: "visibility-workflow",
+		StartTimestamp:   startTime,
+	})
+	s.Nil(err1)
+
+	err2 := s.VisibilityMgr.RecordWorkflowExecutionClosed(&RecordWorkflowExecutionClosedRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        workflowExecution2,
+		WorkflowTypeName: "visibility-workflow",
+		StartTimestamp:   startTime,
+		CloseTimestamp:   time.Now().UnixNano(),
+		Status:           gen.WorkflowExecutionCloseStatus_FAILED,
+	})
+	s.Nil(err2)
+
+	resp, err3 := s.VisibilityMgr.CountOpenWorkflowExecutions(&CountWorkflowExecutionsRequest{
+		DomainUUID:        testDomainUUID,
+		EarliestStartTime: startTime,
+		LatestStartTime:   startTime,
+		WorkflowTypeName:  "visibility-workflow",
+	})
+	s.Nil(err3)
+	s.Equal(int64(1), resp.Count)
+
+	resp, err4 := s.VisibilityMgr.CountClosedWorkflowExecutions(&CountWorkflowExecutionsRequest{
+		DomainUUID:        testDomainUUID,
+		EarliestStartTime: startTime,
+		LatestStartTime:   startTime,
+		Status:            gen.WorkflowExecutionCloseStatusPtr(gen.WorkflowExecutionCloseStatus_COMPLETED),
+	})
+	s.Nil(err4)
+	s.Equal(int64(0), resp.Count)
+
+	resp, err5 := s.VisibilityMgr.CountClosedWorkflowExecutions(&CountWorkflowExecutionsRequest{
+		DomainUUID:        testDomainUUID,
+		EarliestStartTime: startTime,
+		LatestStartTime:   startTime,
+		WorkflowID:        workflowExecution2.GetWorkflowId(),
+	})
+	s.Nil(err5)
+	s.Equal(int64(1), resp.Count)
+}
+
 func (s *visibilityPersistenceSuite) TestGetClosedExecution() {
 	testDomainUUID := uuid.New()
 
@@ -446,3 +513,62 @@ func (s *visibilityPersistenceSuite) TestGetClosedExecution() {
 	s.Equal(workflowExecution.GetWorkflowId(), resp.Execution.GetExecution().GetWorkflowId())
 	s.Equal(int64(3), resp.Execution.GetHistoryLength())
 }
+
+func (s *visibilityPersistenceSuite) TestScanWorkflowExecutions() {
+	testDomainUUID := uuid.New()
+
+	// Create 3 executions, close one of them
+	startTime := time.Now().Add(time.Second * -5).UnixNano()
+	executions := []gen.WorkflowExecution{}
+	for _, workflowID := range []string{"visibility-scan-test1", "visibility-scan-test2", "visibility-scan-test3"} {
+		workflowExecution := gen.WorkflowExecution{
+			WorkflowId: common.StringPtr(workflowID),
+			RunId:      common.StringPtr(uuid.New()),
+		}
+		err := s.VisibilityMgr.RecordWorkflowExecutionStarted(&RecordWorkflowExecutionStartedRequest{
+			DomainUUID:       testDomainUUID,
+			Execution:        workflowExecution,
+			WorkflowTypeName: "visibility-workflow",
+			StartTimestamp:   startTime,
+		})
+		s.Nil(err)
+		executions = append(executions, workflowExecution)
+	}
+
+	err := s.VisibilityMgr.RecordWorkflowExecutionClosed(&RecordWorkflowExecutionClosedRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        executions[0],
+		WorkflowTypeName: "visibility-workflow",
+		StartTimestamp:   startTime,
+		CloseTimestamp:   time.Now().UnixNano(),
+	})
+	s.Nil(err)
+
+	// Scan one execution at a time until the token is exhausted
+	scanned := make(map[string]bool)
+	var token []byte
+	for pages := 0; pages < 10; pages++ {
+		resp, err := s.VisibilityMgr.ScanWorkflowExecutions(&ScanWorkflowExecutionsRequest{
+			DomainUUID:    testDomainUUID,
+			PageSize:      1,
+			NextPageToken: token,
+		})
+		s.Nil(err)
+		for _, e := range resp.Executions {
+			scanned[e.Execution.GetWorkflowId()] = true
+		}
+		token = resp.NextPageToken
+		if len(token) == 0 {
+			break
+		}
+	}
+	s.Empty(token)
+	s.Equal(3, len(scanned))
+
+	_, err = s.VisibilityMgr.ScanWorkflowExecutions(&ScanWorkflowExecutionsRequest{
+		DomainUUID:    testDomainUUID,
+		PageSize:      1,
+		NextPageToken: []byte("invalid"),
+	})
+	s.IsType(&gen.BadRequestError{}, err)
+}
@@ -35,6 +35,13 @@ const (
 	DomainStatusDeleted
 )
 
+// Domain change types
+const (
+	DomainChangeTypeRegistered = iota
+	DomainChangeTypeUpdated
+	DomainChangeTypeDeprecated
+)
+
 // Workflow execution states
 const (
 	WorkflowStateCreated = iota
@@ -66,6 +73,7 @@ const (
 	TransferTaskTypeDeleteExecution
 	TransferTaskTypeCancelExecution
 	TransferTaskTypeStartChildExecution
+	TransferTaskTypeCompletionCallback
 )
 
 // Types of timers
@@ -73,6 +81,17 @@ const (
 	TaskTypeDecisionTimeout = iota
 	TaskTypeActivityTimeout
 	TaskTypeUserTimer
+	TaskTypeCancelTimeout
+	TaskTypeDeleteExecution
+	TaskTypeDeleteHistory
+)
+
+// Mutable state projections, the parts of the mutable state read by GetWorkflowExecution
+const (
+	// MutableStateProjectionAll reads the execution info and all the maps of the mutable state
+	MutableStateProjectionAll = iota
+	// MutableStateProjectionExecutionInfo reads the execution info only, the maps of the mutable state are left nil
+	MutableStateProjectionExecutionInfo
 )
 
 type (
@@ -81,6 +100,13 @@ type (
 		Msg string
 	}
 
+	// CurrentWorkflowConditionFailedError is returned when the current execution of a workflow does not point at the
+	// expected run.  RunID is the run the current execution points at, empty if there is none.
+	CurrentWorkflowConditionFailedError struct {
+		Msg   string
+		RunID string
+	}
+
 	// ShardAlreadyExistError is returned when conditionally creating a shard fails
 	ShardAlreadyExistError struct {
 		Msg string
@@ -92,11 +118,29 @@ type (
 		Msg     string
 	}
 
+	// PartialAppendError is returned by AppendHistoryEventsBatch when the event batches of some of the executions
+	// were appended and those of the others were not.  Errors holds the error of each request of the batch, by index,
+	// nil for the requests appended.
+	PartialAppendError struct {
+		Msg    string
+		Errors []error
+	}
+
 	// TimeoutError is returned when a write operation fails due to a timeout
 	TimeoutError struct {
 		Msg string
 	}
 
+	// UnavailableError is returned when an operation fails because the store could not be reached
+	UnavailableError struct {
+		Msg string
+	}
+
+	// CorruptionError is returned when data read from the store cannot be decoded
+	CorruptionError struct {
+		Msg string
+	}
+
 	// ShardInfo describes a shard
 	ShardInfo struct {
 		ShardID          int
@@ -110,29 +154,43 @@ type (
 
 	// WorkflowExecutionInfo describes a workflow execution
 	WorkflowExecutionInfo struct {
-		DomainID             string
-		WorkflowID           string
-		RunID                string
-		ParentDomainID       string
-		ParentWorkflowID     string
-		ParentRunID          string
-		InitiatedID          int64
-		CompletionEvent      []byte
-		TaskList             string
-		WorkflowTypeName     string
-		DecisionTimeoutValue int32
-		ExecutionContext     []byte
-		State                int
-		CloseStatus          int
-		NextEventID          int64
-		LastProcessedEvent   int64
-		StartTimestamp       time.Time
-		LastUpdatedTimestamp time.Time
-		CreateRequestID      string
-		DecisionScheduleID   int64
-		DecisionStartedID    int64
-		DecisionRequestID    string
-		DecisionTimeout      int32
+		DomainID              string
+		WorkflowID            string
+		RunID                 string
+		ParentDomainID        string
+		ParentWorkflowID      string
+		ParentRunID           string
+		InitiatedID           int64
+		CompletionEvent       []byte
+		TaskList              string
+		WorkflowTypeName      string
+		DecisionTimeoutValue  int32
+		ExecutionContext      []byte
+		State                 int
+		CloseStatus           int
+		NextEventID           int64
+		LastProcessedEvent    int64
+		StartTimestamp        time.Time
+		LastUpdatedTimestamp  time.Time
+		CreateRequestID       string
+		DecisionScheduleID    int64
+		DecisionStartedID     int64
+		DecisionRequestID     string
+		DecisionTimeout       int32
+		CompletionCallbackURL string
+		// CancelRequested is set once a cancellation of the execution is requested, CancelRequestID is the ID of the
+		// request, which makes retries of the request idempotent
+		CancelRequested bool
+		CancelRequestID string
+		// FirstExecutionRunID is the run ID of the first execution of the chain of executions the execution belongs
+		// to, which is inherited through continue-as-new.  It is the run ID of the execution if it starts the chain.
+		FirstExecutionRunID string
+		// TraceID identifies the chain of executions the execution belongs to for tracing systems, it is chosen when
+		// the chain starts and is inherited through continue-as-new
+		TraceID string
+		// SearchAttributes are the JSON encoded values of the search attributes the execution is indexed by in
+		// visibility, they are set when the execution starts and are inherited through continue-as-new
+		SearchAttributes map[string][]byte
 	}
 
 	// TransferTaskInfo describes a transfer task
@@ -147,6 +205,7 @@ type (
 		TaskList         string
 		TaskType         int
 		ScheduleID       int64
+		CallbackURL      string
 	}
 
 	// TimerTaskInfo describes a timer task.
@@ -168,6 +227,8 @@ type (
 		TaskType int
 		RangeID  int64
 		AckLevel int64
+		// Pollers maps the identities of the workers which polled the task list to the time of their last poll
+		Pollers map[string]time.Time
 	}
 
 	// TaskInfo describes either activity or decision task
@@ -215,6 +276,28 @@ type (
 		EventID             int64
 	}
 
+	// CancelTimeoutTask identifies a timer task which terminates an execution that has not closed within the
+	// grace period requested along with its cancellation.
+	CancelTimeoutTask struct {
+		VisibilityTimestamp time.Time
+		TaskID              int64
+		EventID             int64
+	}
+
+	// DeleteExecutionTimerTask identifies a timer task which deletes a closed execution once the close cleanup
+	// delay has passed.
+	DeleteExecutionTimerTask struct {
+		VisibilityTimestamp time.Time
+		TaskID              int64
+	}
+
+	// DeleteHistoryTimerTask identifies a timer task which deletes the history and visibility records of a closed
+	// execution once the retention period of its domain has passed.
+	DeleteHistoryTimerTask struct {
+		VisibilityTimestamp time.Time
+		TaskID              int64
+	}
+
 	// CancelExecutionTask identifies a transfer task for cancel of execution
 	CancelExecutionTask struct {
 		TaskID           int64
@@ -232,6 +315,12 @@ type (
 		InitiatedID      int64
 	}
 
+	// CompletionCallbackTask identifies a transfer task which notifies the completion callback of a closed execution
+	CompletionCallbackTask struct {
+		TaskID      int64
+		CallbackURL string
+	}
+
 	// ActivityTimeoutTask identifies a timeout task.
 	ActivityTimeoutTask struct {
 		VisibilityTimestamp time.Time
@@ -252,7 +341,11 @@ type (
 		ActivitInfos        map[int64]*ActivityInfo
 		TimerInfos          map[string]*TimerInfo
 		ChildExecutionInfos map[int64]*ChildExecutionInfo
-		ExecutionInfo       *WorkflowExecutionInfo
+		// SignalRequestedIDs are the IDs of the signal requests applied to the execution, which make retries of the
+		// requests idempotent, with the time they were recorded.  The time is zero for the IDs recorded before it was
+		// persisted.
+		SignalRequestedIDs map[string]time.Time
+		ExecutionInfo      *WorkflowExecutionInfo
 	}
 
 	// ActivityInfo details.
@@ -305,6 +398,12 @@ type (
 		ShardInfo *ShardInfo
 	}
 
+	// GetServerTimeResponse is the response to GetServerTime
+	GetServerTimeResponse struct {
+		// Time is the current time of the clock of the datastore
+		Time time.Time
+	}
+
 	// UpdateShardRequest  is used to update shard information
 	UpdateShardRequest struct {
 		ShardInfo       *ShardInfo
@@ -332,6 +431,18 @@ type (
 		DecisionStartedID           int64
 		DecisionStartToCloseTimeout int32
 		ContinueAsNew               bool
+		CompletionCallbackURL       string
+		FirstExecutionRunID         string
+		TraceID                     string
+		SearchAttributes            map[string][]byte
+		// ActivityInfos and TimerInfos seed the mutable state of an execution which does not start from
+		// scratch, like the run created by a reset
+		ActivityInfos []*ActivityInfo
+		TimerInfos    []*TimerInfo
+		// CurrentExecutionRecorded is set when the caller already pointed the current execution of the workflow at
+		// the run with CreateCurrentWorkflowExecution or UpdateCurrentWorkflowExecution, the run is then written
+		// without touching the current execution
+		CurrentExecutionRecorded bool
 	}
 
 	// CreateWorkflowExecutionResponse is the response to CreateWorkflowExecutionRequest
@@ -341,8 +452,9 @@ type (
 
 	// GetWorkflowExecutionRequest is used to retrieve the info of a workflow execution
 	GetWorkflowExecutionRequest struct {
-		DomainID  string
-		Execution workflow.WorkflowExecution
+		DomainID   string
+		Execution  workflow.WorkflowExecution
+		Projection int
 	}
 
 	// GetWorkflowExecutionResponse is the response to GetworkflowExecutionRequest
@@ -361,6 +473,41 @@ type (
 		RunID string
 	}
 
+	// ListConcreteExecutionsRequest is used to list the workflow executions of a shard
+	ListConcreteExecutionsRequest struct {
+		PageSize int
+		// Token to continue reading the next page of executions.  Pass in empty slice for first page
+		NextPageToken []byte
+	}
+
+	// ListConcreteExecutionsResponse is the response to ListConcreteExecutionsRequest
+	ListConcreteExecutionsResponse struct {
+		ExecutionInfos []*WorkflowExecutionInfo
+		// Token to read the next page if there are more executions beyond the page size
+		NextPageToken []byte
+	}
+
+	// ListCurrentExecutionsRequest is used to list the current executions of the workflows of a shard
+	ListCurrentExecutionsRequest struct {
+		PageSize int
+		// Token to continue reading the next page of current executions.  Pass in empty slice for first page
+		NextPageToken []byte
+	}
+
+	// CurrentExecution is the run of a workflow pointed at as its current execution
+	CurrentExecution struct {
+		DomainID   string
+		WorkflowID string
+		RunID      string
+	}
+
+	// ListCurrentExecutionsResponse is the response to ListCurrentExecutionsRequest
+	ListCurrentExecutionsResponse struct {
+		Executions []*CurrentExecution
+		// Token to read the next page if there are more current executions beyond the page size
+		NextPageToken []byte
+	}
+
 	// UpdateWorkflowExecutionRequest is used to update a workflow execution
 	UpdateWorkflowExecutionRequest struct {
 		ExecutionInfo   *WorkflowExecutionInfo
@@ -379,6 +526,34 @@ type (
 		DeleteTimerInfos          []string
 		UpsertChildExecutionInfos []*ChildExecutionInfo
 		DeleteChildExecutionInfo  *int64
+		UpsertSignalRequestedIDs  []string
+		DeleteSignalRequestedIDs  []string
+	}
+
+	// UpdateWorkflowExecutionResponse is the response to UpdateWorkflowExecutionRequest
+	UpdateWorkflowExecutionResponse struct {
+		MutableStateUpdateSessionStats *MutableStateUpdateSessionStats
+	}
+
+	// MutableStateUpdateSessionStats is the statistics of the mutable state written by an update.  The size of an
+	// entry is the size of its variable length fields, which dominate the size of the stored entry.
+	MutableStateUpdateSessionStats struct {
+		// MutableStateSize is the total size of the entries written
+		MutableStateSize  int
+		ExecutionInfoSize int
+		ActivityInfoSize  int
+		TimerInfoSize     int
+		ChildInfoSize     int
+
+		ActivityInfoCount       int
+		TimerInfoCount          int
+		ChildInfoCount          int
+		DeleteActivityInfoCount int
+		DeleteTimerInfoCount    int
+		DeleteChildInfoCount    int
+
+		TransferTasksCount int
+		TimerTasksCount    int
 	}
 
 	// DeleteWorkflowExecutionRequest is used to delete a workflow execution
@@ -386,16 +561,49 @@ type (
 		ExecutionInfo *WorkflowExecutionInfo
 	}
 
+	// DeleteCurrentWorkflowExecutionRequest is used to delete the current execution of a workflow, as long as it
+	// still points at the given run
+	DeleteCurrentWorkflowExecutionRequest struct {
+		DomainID   string
+		WorkflowID string
+		RunID      string
+	}
+
+	// CreateCurrentWorkflowExecutionRequest is used to point the current execution of a workflow at a run, as long as
+	// the workflow has no current execution
+	CreateCurrentWorkflowExecutionRequest struct {
+		RangeID    int64
+		DomainID   string
+		WorkflowID string
+		RunID      string
+		RequestID  string
+	}
+
+	// UpdateCurrentWorkflowExecutionRequest is used to point the current execution of a workflow at another run, as
+	// long as it still points at the previous run
+	UpdateCurrentWorkflowExecutionRequest struct {
+		RangeID       int64
+		DomainID      string
+		WorkflowID    string
+		RunID         string
+		RequestID     string
+		PreviousRunID string
+	}
+
 	// GetTransferTasksRequest is used to read tasks from the transfer task queue
 	GetTransferTasksRequest struct {
 		ReadLevel    int64
 		MaxReadLevel int64
 		BatchSize    int
+		// Token to continue reading the next page of tasks.  Pass in empty slice for first page
+		NextPageToken []byte
 	}
 
 	// GetTransferTasksResponse is the response to GetTransferTasksRequest
 	GetTransferTasksResponse struct {
 		Tasks []*TransferTaskInfo
+		// Token to read the next page if there are more tasks up to MaxReadLevel beyond the batch size
+		NextPageToken []byte
 	}
 
 	// CompleteTransferTaskRequest is used to complete a task in the transfer task queue
@@ -409,6 +617,18 @@ type (
 		TaskID              int64
 	}
 
+	// RangeCompleteTransferTaskRequest is used to complete the tasks of the transfer task queue between two ack levels
+	RangeCompleteTransferTaskRequest struct {
+		ExclusiveBeginTaskID int64
+		InclusiveEndTaskID   int64
+	}
+
+	// RangeCompleteTimerTaskRequest is used to complete the tasks of the timer task queue between two ack levels
+	RangeCompleteTimerTaskRequest struct {
+		InclusiveBeginTimestamp time.Time
+		ExclusiveEndTimestamp   time.Time
+	}
+
 	// LeaseTaskListRequest is used to request lease of a task list
 	LeaseTaskListRequest struct {
 		DomainID string
@@ -430,6 +650,21 @@ type (
 	UpdateTaskListResponse struct {
 	}
 
+	// GetTaskListBacklogRequest is used to count the tasks of a task list above its ack level
+	GetTaskListBacklogRequest struct {
+		DomainID string
+		TaskList string
+		TaskType int
+		AckLevel int64
+	}
+
+	// GetTaskListBacklogResponse is the response to GetTaskListBacklog
+	GetTaskListBacklogResponse struct {
+		// ApproximateCount is the number of tasks above the ack level at the time of the call, tasks created or
+		// completed concurrently may or may not be counted
+		ApproximateCount int64
+	}
+
 	// CreateTasksRequest is used to create a new task for a workflow exectution
 	CreateTasksRequest struct {
 		DomainID     string
@@ -472,17 +707,29 @@ type (
 		TaskID   int64
 	}
 
+	// CompleteTasksLessThanRequest is used to complete all tasks of a task list below a task ID
+	CompleteTasksLessThanRequest struct {
+		DomainID     string
+		TaskListName string
+		TaskType     int
+		TaskID       int64 // exclusive
+	}
+
 	// GetTimerIndexTasksRequest is the request for GetTimerIndexTasks
 	// TODO: replace this with an iterator that can configure min and max index.
 	GetTimerIndexTasksRequest struct {
 		MinTimestamp time.Time
 		MaxTimestamp time.Time
 		BatchSize    int
+		// Token to continue reading the next page of timers.  Pass in empty slice for first page
+		NextPageToken []byte
 	}
 
 	// GetTimerIndexTasksResponse is the response for GetTimerIndexTasks
 	GetTimerIndexTasksResponse struct {
 		Timers []*TimerTaskInfo
+		// Token to read the next page if there are more timers before MaxTimestamp beyond the batch size
+		NextPageToken []byte
 	}
 
 	// SerializedHistoryEventBatch represents a serialized batch of history events
@@ -509,6 +756,12 @@ type (
 		Overwrite     bool
 	}
 
+	// AppendHistoryEventsBatchRequest is used to append event batches of one or more workflow executions in a single
+	// request
+	AppendHistoryEventsBatchRequest struct {
+		Requests []*AppendHistoryEventsRequest
+	}
+
 	// GetWorkflowExecutionHistoryRequest is used to retrieve history of a workflow execution
 	GetWorkflowExecutionHistoryRequest struct {
 		DomainID  string
@@ -536,6 +789,84 @@ type (
 		Execution workflow.WorkflowExecution
 	}
 
+	// HistoryBranch identifies a branch of a history tree.  A branch shares the nodes of its ancestors up to the node
+	// it was forked at, and only stores the nodes appended after the fork, so forking a history does not copy it.  It
+	// is passed around as an opaque branch token, see SerializeHistoryBranch.
+	HistoryBranch struct {
+		TreeID   string
+		BranchID string
+		// Ancestors are the ranges of nodes shared with the ancestors of the branch, ordered by node ID
+		Ancestors []*HistoryBranchRange
+	}
+
+	// HistoryBranchRange is the range of nodes [BeginNodeID, EndNodeID) a branch shares with one of its ancestors
+	HistoryBranchRange struct {
+		BranchID    string
+		BeginNodeID int64
+		EndNodeID   int64
+	}
+
+	// AppendHistoryNodesRequest is used to append a batch of events to a branch of a history tree
+	AppendHistoryNodesRequest struct {
+		BranchToken []byte
+		// IsNewBranch must be set on the first append to a branch created by NewHistoryBranchToken
+		IsNewBranch bool
+		// NodeID is the ID of the first event of the batch
+		NodeID int64
+		// TransactionID orders the writes of the same node, the batch written by the latest transaction is read
+		TransactionID int64
+		Events        *SerializedHistoryEventBatch
+	}
+
+	// ReadHistoryBranchRequest is used to read the events of a branch of a history tree, including the events shared
+	// with its ancestors
+	ReadHistoryBranchRequest struct {
+		BranchToken []byte
+		// MinEventID is the ID of the first node read, inclusive
+		MinEventID int64
+		// MaxEventID is the ID of the last node read, exclusive
+		MaxEventID int64
+		// Maximum number of batches of events per page
+		PageSize int
+		// Token to continue reading next page of batches.  Pass in empty slice for first page
+		NextPageToken []byte
+	}
+
+	// ReadHistoryBranchResponse is the response to ReadHistoryBranchRequest
+	ReadHistoryBranchResponse struct {
+		Events []SerializedHistoryEventBatch
+		// Token to read next page if there are more events beyond page size
+		NextPageToken []byte
+	}
+
+	// ForkHistoryBranchRequest is used to create a branch sharing the first nodes of an existing branch
+	ForkHistoryBranchRequest struct {
+		ForkBranchToken []byte
+		// ForkNodeID is the ID of the first node of the new branch, the nodes before it are shared with the forked
+		// branch.  It must be the ID of the first event of a batch.
+		ForkNodeID int64
+	}
+
+	// ForkHistoryBranchResponse is the response to ForkHistoryBranchRequest
+	ForkHistoryBranchResponse struct {
+		NewBranchToken []byte
+	}
+
+	// DeleteHistoryBranchRequest is used to delete a branch of a history tree
+	DeleteHistoryBranchRequest struct {
+		BranchToken []byte
+	}
+
+	// GetHistoryTreeRequest is used to list the branches of a history tree
+	GetHistoryTreeRequest struct {
+		TreeID string
+	}
+
+	// GetHistoryTreeResponse is the response to GetHistoryTreeRequest
+	GetHistoryTreeResponse struct {
+		Branches []*HistoryBranch
+	}
+
 	// DomainInfo describes the domain entity
 	DomainInfo struct {
 		ID          string
@@ -549,16 +880,29 @@ type (
 	DomainConfig struct {
 		Retention  int32
 		EmitMetric bool
+		// ArchivalEnabled is set to archive the history of closed executions before it is deleted
+		ArchivalEnabled bool
+		// DefaultTaskList is the task list of the workflows started without one
+		DefaultTaskList string
+		// TaskListOverrides is the task list of the workflows started without one, by workflow type name
+		TaskListOverrides map[string]string
+		// TerminationProtected requires an admin override to terminate the workflows of the domain
+		TerminationProtected bool
 	}
 
 	// CreateDomainRequest is used to create the domain
 	CreateDomainRequest struct {
-		Name        string
-		Status      int
-		Description string
-		OwnerEmail  string
-		Retention   int32
-		EmitMetric  bool
+		Name                 string
+		Status               int
+		Description          string
+		OwnerEmail           string
+		Retention            int32
+		EmitMetric           bool
+		ArchivalEnabled      bool
+		DefaultTaskList      string
+		TaskListOverrides    map[string]string
+		TerminationProtected bool
+		FailoverVersion      int64
 	}
 
 	// CreateDomainResponse is the response for CreateDomain
@@ -572,16 +916,20 @@ type (
 		Name string
 	}
 
-	// GetDomainResponse is the response for GetDomain
+	// GetDomainResponse is the response for GetDomain.  FailoverVersion is incremented by every failover of the
+	// domain, NotificationVersion is the notification version of the last change of the domain.
 	GetDomainResponse struct {
-		Info   *DomainInfo
-		Config *DomainConfig
+		Info                *DomainInfo
+		Config              *DomainConfig
+		FailoverVersion     int64
+		NotificationVersion int64
 	}
 
 	// UpdateDomainRequest is used to update domain
 	UpdateDomainRequest struct {
-		Info   *DomainInfo
-		Config *DomainConfig
+		Info            *DomainInfo
+		Config          *DomainConfig
+		FailoverVersion int64
 	}
 
 	// DeleteDomainRequest is used to delete domain entry from domains table
@@ -594,6 +942,86 @@ type (
 		Name string
 	}
 
+	// DomainChange is a registration, update or deprecation of a domain.  Every change is assigned the next
+	// notification version, so changes are ordered by notification version.
+	DomainChange struct {
+		NotificationVersion int64
+		ChangeType          int
+		Info                *DomainInfo
+		Config              *DomainConfig
+		FailoverVersion     int64
+	}
+
+	// GetDomainChangesRequest is used to read the domain changes made after a notification version
+	GetDomainChangesRequest struct {
+		LastNotificationVersion int64
+		PageSize                int
+	}
+
+	// GetDomainChangesResponse is the response to GetDomainChanges
+	GetDomainChangesResponse struct {
+		Changes []*DomainChange
+	}
+
+	// GetMetadataResponse is the response to GetMetadata
+	GetMetadataResponse struct {
+		// NotificationVersion is the notification version of the last domain change
+		NotificationVersion int64
+	}
+
+	// BatchOperationInfo is the state and the progress of a batch operation started by a frontend host
+	BatchOperationInfo struct {
+		ID            string
+		DomainID      string
+		DomainName    string
+		OperationType int // enum BatchOperationType in IDL
+		Query         string
+		SignalName    string
+		SignalInput   []byte
+		Reason        string
+		Identity      string
+		RPS           int
+		State         int // enum BatchOperationState in IDL
+		StartTime     time.Time
+		CloseTime     time.Time
+		// UpdateTime is when the host running the batch last recorded its progress
+		UpdateTime time.Time
+		Succeeded  int64
+		Skipped    int64
+		Failed     int64
+		// CloseReason tells why the batch was stopped or failed
+		CloseReason string
+		// StopReason is set once the batch is requested to stop, by any of the frontend hosts
+		StopReason string
+	}
+
+	// CreateBatchOperationRequest is used to record a new batch operation
+	CreateBatchOperationRequest struct {
+		Info *BatchOperationInfo
+	}
+
+	// UpdateBatchOperationRequest is used to record the state and the progress of a batch operation, it leaves the
+	// stop reason of the batch as is
+	UpdateBatchOperationRequest struct {
+		Info *BatchOperationInfo
+	}
+
+	// StopBatchOperationRequest is used to request a batch operation to stop
+	StopBatchOperationRequest struct {
+		ID     string
+		Reason string
+	}
+
+	// GetBatchOperationRequest is used to read a batch operation
+	GetBatchOperationRequest struct {
+		ID string
+	}
+
+	// GetBatchOperationResponse is the response to GetBatchOperation
+	GetBatchOperationResponse struct {
+		Info *BatchOperationInfo
+	}
+
 	// Closeable is an interface for any entity that supports a close operation to release resources
 	Closeable interface {
 		Close()
@@ -605,6 +1033,8 @@ type (
 		CreateShard(request *CreateShardRequest) error
 		GetShard(request *GetShardRequest) (*GetShardResponse, error)
 		UpdateShard(request *UpdateShardRequest) error
+		// GetServerTime returns the current time of the datastore, to detect the skew of the clock of the host
+		GetServerTime() (*GetServerTimeResponse, error)
 	}
 
 	// ExecutionManager is used to manage workflow executions
@@ -612,15 +1042,34 @@ type (
 		Closeable
 		CreateWorkflowExecution(request *CreateWorkflowExecutionRequest) (*CreateWorkflowExecutionResponse, error)
 		GetWorkflowExecution(request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error)
-		UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) error
+		UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error)
 		DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error
 		GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error)
+		// ListConcreteExecutions returns a page of the workflow executions of the shard, open or closed.  A page may
+		// hold fewer executions than the page size even when more executions follow.
+		ListConcreteExecutions(request *ListConcreteExecutionsRequest) (*ListConcreteExecutionsResponse, error)
+		// ListCurrentExecutions returns a page of the current executions of the workflows of the shard.  A page may
+		// hold fewer current executions than the page size even when more follow.
+		ListCurrentExecutions(request *ListCurrentExecutionsRequest) (*ListCurrentExecutionsResponse, error)
+		// DeleteCurrentWorkflowExecution deletes the current execution of a workflow, unless it points at another run
+		// than the given one.  It does not delete the run itself.
+		DeleteCurrentWorkflowExecution(request *DeleteCurrentWorkflowExecutionRequest) error
+		// CreateCurrentWorkflowExecution points the current execution of a workflow at a run.  It fails with
+		// WorkflowExecutionAlreadyStartedError if the workflow already has a current execution, i.e. its last run is
+		// still open.
+		CreateCurrentWorkflowExecution(request *CreateCurrentWorkflowExecutionRequest) error
+		// UpdateCurrentWorkflowExecution points the current execution of a workflow at another run.  It fails with
+		// CurrentWorkflowConditionFailedError unless the current execution points at the previous run, which is the
+		// case only while the previous run is open.  It does not create or update the runs themselves.
+		UpdateCurrentWorkflowExecution(request *UpdateCurrentWorkflowExecutionRequest) error
 		GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error)
 		CompleteTransferTask(request *CompleteTransferTaskRequest) error
+		RangeCompleteTransferTask(request *RangeCompleteTransferTaskRequest) error
 
 		// Timer related methods.
 		GetTimerIndexTasks(request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse, error)
 		CompleteTimerTask(request *CompleteTimerTaskRequest) error
+		RangeCompleteTimerTask(request *RangeCompleteTimerTaskRequest) error
 	}
 
 	// ExecutionManagerFactory creates an instance of ExecutionManager for a given shard
@@ -628,24 +1077,60 @@ type (
 		CreateExecutionManager(shardID int) (ExecutionManager, error)
 	}
 
+	// Factory creates the persistence managers of a datastore.  The managers are not wrapped into the metrics or
+	// retryable clients, which are added by the services using them.
+	Factory interface {
+		ExecutionManagerFactory
+		CreateShardManager() (ShardManager, error)
+		CreateMetadataManager() (MetadataManager, error)
+		CreateVisibilityManager() (VisibilityManager, error)
+		CreateHistoryManager() (HistoryManager, error)
+		CreateTaskManager() (TaskManager, error)
+	}
+
 	// TaskManager is used to manage tasks
 	TaskManager interface {
 		Closeable
 		LeaseTaskList(request *LeaseTaskListRequest) (*LeaseTaskListResponse, error)
 		UpdateTaskList(request *UpdateTaskListRequest) (*UpdateTaskListResponse, error)
+		// GetTaskListBacklog returns the approximate number of tasks of a task list which are not dispatched yet
+		GetTaskListBacklog(request *GetTaskListBacklogRequest) (*GetTaskListBacklogResponse, error)
 		CreateTasks(request *CreateTasksRequest) (*CreateTasksResponse, error)
 		GetTasks(request *GetTasksRequest) (*GetTasksResponse, error)
 		CompleteTask(request *CompleteTaskRequest) error
+		CompleteTasksLessThan(request *CompleteTasksLessThanRequest) error
 	}
 
 	// HistoryManager is used to manage Workflow Execution HistoryEventBatch
 	HistoryManager interface {
 		Closeable
 		AppendHistoryEvents(request *AppendHistoryEventsRequest) error
+		// AppendHistoryEventsBatch appends all the event batches of the request.  The SQL stores append them in one
+		// transaction.  Cassandra appends the batches of every execution in a conditional batch of its own, as they
+		// cannot span partitions, and executes them concurrently, so it takes a single round trip only when all the
+		// batches are of the same execution.  If the batches of some executions fail to be appended while the others
+		// are, PartialAppendError tells which ones failed.  The batches appended are not visible until the mutable
+		// state of their execution is updated, and are overwritten when the update is retried.
+		AppendHistoryEventsBatch(request *AppendHistoryEventsBatchRequest) error
 		// GetWorkflowExecutionHistory retrieves the paginated list of history events for given execution
 		GetWorkflowExecutionHistory(request *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryResponse,
 			error)
 		DeleteWorkflowExecutionHistory(request *DeleteWorkflowExecutionHistoryRequest) error
+
+		// The history branch APIs store a history as a tree of branches, so that a history can be forked at any batch
+		// without copying the events before the fork
+
+		// AppendHistoryNodes appends a batch of events to a branch
+		AppendHistoryNodes(request *AppendHistoryNodesRequest) error
+		// ReadHistoryBranch retrieves the paginated list of batches of events of a branch, including those shared
+		// with its ancestors
+		ReadHistoryBranch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchResponse, error)
+		// ForkHistoryBranch creates a branch sharing the nodes of a branch before the fork node
+		ForkHistoryBranch(request *ForkHistoryBranchRequest) (*ForkHistoryBranchResponse, error)
+		// DeleteHistoryBranch deletes a branch and the nodes no other branch of the tree shares
+		DeleteHistoryBranch(request *DeleteHistoryBranchRequest) error
+		// GetHistoryTree lists the branches of a tree
+		GetHistoryTree(request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error)
 	}
 
 	// MetadataManager is used to manage metadata CRUD for various entities
@@ -656,6 +1141,19 @@ type (
 		UpdateDomain(request *UpdateDomainRequest) error
 		DeleteDomain(request *DeleteDomainRequest) error
 		DeleteDomainByName(request *DeleteDomainByNameRequest) error
+		GetDomainChanges(request *GetDomainChangesRequest) (*GetDomainChangesResponse, error)
+		GetMetadata() (*GetMetadataResponse, error)
+
+		// The batch operations are recorded with the domains, so that any frontend host can describe or stop the
+		// batches started by the others
+
+		CreateBatchOperation(request *CreateBatchOperationRequest) error
+		UpdateBatchOperation(request *UpdateBatchOperationRequest) error
+		// StopBatchOperation records the request to stop a batch operation, the host running the batch stops it
+		// once it reads the request
+		StopBatchOperation(request *StopBatchOperationRequest) error
+		// GetBatchOperation returns EntityNotExistsError if the batch operation is not recorded
+		GetBatchOperation(request *GetBatchOperationRequest) (*GetBatchOperationResponse, error)
 	}
 )
 
@@ -663,6 +1161,10 @@ func (e *ConditionFailedError) Error() string {
 	return e.Msg
 }
 
+func (e *CurrentWorkflowConditionFailedError) Error() string {
+	return e.Msg
+}
+
 func (e *ShardAlreadyExistError) Error() string {
 	return e.Msg
 }
@@ -671,10 +1173,33 @@ func (e *ShardOwnershipLostError) Error() string {
 	return e.Msg
 }
 
+func (e *PartialAppendError) Error() string {
+	return e.Msg
+}
+
 func (e *TimeoutError) Error() string {
 	return e.Msg
 }
 
+func (e *UnavailableError) Error() string {
+	return e.Msg
+}
+
+func (e *CorruptionError) Error() string {
+	return e.Msg
+}
+
+// IsTransientError checks if the error returned by a persistence manager is worth retrying. Errors reporting
+// the outcome of a conditional update, like ConditionFailedError or ShardOwnershipLostError, are never transient
+func IsTransientError(err error) bool {
+	switch err.(type) {
+	case *workflow.InternalServiceError, *workflow.ServiceBusyError, *UnavailableError:
+		return true
+	}
+
+	return false
+}
+
 // GetType returns the type of the activity task
 func (a *ActivityTask) GetType() int {
 	return TransferTaskTypeActivityTask
@@ -795,6 +1320,81 @@ func (u *UserTimerTask) SetVisibilityTimestamp(t time.Time) {
 	u.VisibilityTimestamp = t
 }
 
+// GetType returns the type of the cancel timeout task
+func (c *CancelTimeoutTask) GetType() int {
+	return TaskTypeCancelTimeout
+}
+
+// GetTaskID returns the sequence ID of the cancel timeout task.
+func (c *CancelTimeoutTask) GetTaskID() int64 {
+	return c.TaskID
+}
+
+// SetTaskID sets the sequence ID of the cancel timeout task.
+func (c *CancelTimeoutTask) SetTaskID(id int64) {
+	c.TaskID = id
+}
+
+// GetVisibilityTimestamp gets the visibility time stamp
+func (c *CancelTimeoutTask) GetVisibilityTimestamp() time.Time {
+	return c.VisibilityTimestamp
+}
+
+// SetVisibilityTimestamp gets the visibility time stamp
+func (c *CancelTimeoutTask) SetVisibilityTimestamp(t time.Time) {
+	c.VisibilityTimestamp = t
+}
+
+// GetType returns the type of the delete execution timer task
+func (d *DeleteExecutionTimerTask) GetType() int {
+	return TaskTypeDeleteExecution
+}
+
+// GetTaskID returns the sequence ID of the delete execution timer task.
+func (d *DeleteExecutionTimerTask) GetTaskID() int64 {
+	return d.TaskID
+}
+
+// SetTaskID sets the sequence ID of the delete execution timer task.
+func (d *DeleteExecutionTimerTask) SetTaskID(id int64) {
+	d.TaskID = id
+}
+
+// GetVisibilityTimestamp gets the visibility time stamp
+func (d *DeleteExecutionTimerTask) GetVisibilityTimestamp() time.Time {
+	return d.VisibilityTimestamp
+}
+
+// SetVisibilityTimestamp gets the visibility time stamp
+func (d *DeleteExecutionTimerTask) SetVisibilityTimestamp(t time.Time) {
+	d.VisibilityTimestamp = t
+}
+
+// GetType returns the type of the delete history timer task
+func (d *DeleteHistoryTimerTask) GetType() int {
+	return TaskTypeDeleteHistory
+}
+
+// GetTaskID returns the sequence ID of the delete history timer task.
+func (d *DeleteHistoryTimerTask) GetTaskID() int64 {
+	return d.TaskID
+}
+
+// SetTaskID sets the sequence ID of the delete history timer task.
+func (d *DeleteHistoryTimerTask) SetTaskID(id int64) {
+	d.TaskID = id
+}
+
+// GetVisibilityTimestamp gets the visibility time stamp
+func (d *DeleteHistoryTimerTask) GetVisibilityTimestamp() time.Time {
+	return d.VisibilityTimestamp
+}
+
+// SetVisibilityTimestamp gets the visibility time stamp
+func (d *DeleteHistoryTimerTask) SetVisibilityTimestamp(t time.Time) {
+	d.VisibilityTimestamp = t
+}
+
 // GetType returns the type of the cancel transfer task
 func (u *CancelExecutionTask) GetType() int {
 	return TransferTaskTypeCancelExecution
@@ -825,6 +1425,21 @@ func (u *StartChildExecutionTask) SetTaskID(id int64) {
 	u.TaskID = id
 }
 
+// GetType returns the type of the completion callback task
+func (c *CompletionCallbackTask) GetType() int {
+	return TransferTaskTypeCompletionCallback
+}
+
+// GetTaskID returns the sequence ID of the completion callback task
+func (c *CompletionCallbackTask) GetTaskID() int64 {
+	return c.TaskID
+}
+
+// SetTaskID sets the sequence ID of the completion callback task
+func (c *CompletionCallbackTask) SetTaskID(id int64) {
+	c.TaskID = id
+}
+
 // NewHistoryEventBatch returns a new instance of HistoryEventBatch
 func NewHistoryEventBatch(version int, events []*workflow.HistoryEvent) *HistoryEventBatch {
 	return &HistoryEventBatch{
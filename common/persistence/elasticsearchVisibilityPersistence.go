@@ -0,0 +1,592 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/uber-common/bark"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/visibilityquery"
+)
+
+// Notes on the Elasticsearch visibility store:
+// * Every run is a document of the index keyed by its run ID, see schema/elasticsearch/visibility/index.json.
+//   Closing a run replaces its document, while the open document is only created if the run has no document yet,
+//   so a start record delivered late never reopens a closed run.
+// * Executions are listed newest first, sorted by start time and then by run ID.  The page token holds the sort
+//   values of the last execution returned, which are passed as search_after to read the next page.
+// * The queries of ListWorkflowExecutionsWithQuery are translated into the query DSL, their fields are the fields of
+//   the documents.
+// * The FirstRunID of a document is the run ID of the first run of the chain of continued as new runs it belongs to,
+//   querying it lists the runs of a chain.  So does querying the TraceID of the chain, which tracing systems know.
+// * The search attributes of a run are indexed under the Attr object of its document, with their JSON encoded values.
+//   The index maps the default keys, the keys added to the config of the cluster are mapped dynamically unless they
+//   are added to the mapping of the index before the first run using them is indexed.
+// * Documents become searchable after the refresh interval of the index.
+// * Closed executions are not expired by the store, the history service deletes them once the retention period of
+//   their domain has passed.
+
+const (
+	elasticsearchRequestTimeout = 10 * time.Second
+)
+
+var (
+	elasticsearchRangeOperators = map[visibilityquery.Operator]string{
+		visibilityquery.OperatorLess:           "lt",
+		visibilityquery.OperatorLessOrEqual:    "lte",
+		visibilityquery.OperatorGreater:        "gt",
+		visibilityquery.OperatorGreaterOrEqual: "gte",
+	}
+)
+
+type (
+	elasticsearchVisibilityPersistence struct {
+		indexURL string
+		client   *http.Client
+		logger   bark.Logger
+	}
+
+	// elasticsearchVisibilityRecord is the document indexed for every run, the close fields are only set once the
+	// run is closed
+	elasticsearchVisibilityRecord struct {
+		DomainID      string
+		WorkflowID    string
+		RunID         string
+		WorkflowType  string
+		StartTime     int64
+		CloseTime     *int64 `json:",omitempty"`
+		CloseStatus   *int32 `json:",omitempty"`
+		HistoryLength *int64 `json:",omitempty"`
+		// FirstRunID is the run ID of the first execution of the chain of the run, it is not set in the documents
+		// indexed before the chains were recorded
+		FirstRunID string `json:",omitempty"`
+		// TraceID is the trace ID of the chain of the run, it is not set in the documents indexed before the trace
+		// IDs were recorded
+		TraceID string `json:",omitempty"`
+		// Attr holds the search attributes of the run
+		Attr map[string]json.RawMessage `json:",omitempty"`
+	}
+
+	elasticsearchGetResponse struct {
+		Found  bool                          `json:"found"`
+		Source elasticsearchVisibilityRecord `json:"_source"`
+	}
+
+	elasticsearchSearchResponse struct {
+		Hits struct {
+			Hits []struct {
+				Source elasticsearchVisibilityRecord `json:"_source"`
+			} `json:"hits"`
+		} `json:"hits"`
+	}
+
+	elasticsearchCountResponse struct {
+		Count int64 `json:"count"`
+	}
+
+	// elasticsearchQuery is a JSON object of the Elasticsearch query DSL
+	elasticsearchQuery map[string]interface{}
+)
+
+// NewElasticsearchVisibilityPersistence is used to create an instance of VisibilityManager implementation which
+// indexes the executions into the given index of the Elasticsearch cluster at baseURL
+func NewElasticsearchVisibilityPersistence(baseURL, index string, logger bark.Logger) (VisibilityManager, error) {
+	if baseURL == "" || index == "" {
+		return nil, fmt.Errorf("elasticsearch url and index must be set")
+	}
+	if _, err := url.Parse(baseURL); err != nil {
+		return nil, fmt.Errorf("invalid elasticsearch url %q: %v", baseURL, err)
+	}
+
+	return &elasticsearchVisibilityPersistence{
+		indexURL: strings.TrimSuffix(baseURL, "/") + "/" + url.PathEscape(index),
+		client:   &http.Client{Timeout: elasticsearchRequestTimeout},
+		logger:   logger,
+	}, nil
+}
+
+// Close releases the resources held by this object
+func (v *elasticsearchVisibilityPersistence) Close() {
+}
+
+func (v *elasticsearchVisibilityPersistence) RecordWorkflowExecutionStarted(
+	request *RecordWorkflowExecutionStartedRequest) error {
+	record := &elasticsearchVisibilityRecord{
+		DomainID:     request.DomainUUID,
+		WorkflowID:   request.Execution.GetWorkflowId(),
+		RunID:        request.Execution.GetRunId(),
+		WorkflowType: request.WorkflowTypeName,
+		StartTime:    request.StartTimestamp,
+		FirstRunID:   request.FirstRunID,
+		TraceID:      request.TraceID,
+		Attr:         searchAttributesDocument(request.SearchAttributes),
+	}
+
+	// A conflict means the run already has a document, possibly the closed one
+	return v.send("RecordWorkflowExecutionStarted", http.MethodPut,
+		v.documentPath(record.RunID)+"?op_type=create", record, nil, http.StatusConflict)
+}
+
+func (v *elasticsearchVisibilityPersistence) RecordWorkflowExecutionClosed(
+	request *RecordWorkflowExecutionClosedRequest) error {
+	record := &elasticsearchVisibilityRecord{
+		DomainID:      request.DomainUUID,
+		WorkflowID:    request.Execution.GetWorkflowId(),
+		RunID:         request.Execution.GetRunId(),
+		WorkflowType:  request.WorkflowTypeName,
+		StartTime:     request.StartTimestamp,
+		CloseTime:     common.Int64Ptr(request.CloseTimestamp),
+		CloseStatus:   common.Int32Ptr(int32(request.Status)),
+		HistoryLength: common.Int64Ptr(request.HistoryLength),
+		FirstRunID:    request.FirstRunID,
+		TraceID:       request.TraceID,
+		Attr:          searchAttributesDocument(request.SearchAttributes),
+	}
+
+	return v.send("RecordWorkflowExecutionClosed", http.MethodPut, v.documentPath(record.RunID), record, nil)
+}
+
+// searchAttributesDocument returns the search attributes indexed in the document of a run, their values are validated
+// JSON when the run starts
+func searchAttributesDocument(searchAttributes map[string][]byte) map[string]json.RawMessage {
+	if len(searchAttributes) == 0 {
+		return nil
+	}
+	attr := make(map[string]json.RawMessage, len(searchAttributes))
+	for key, value := range searchAttributes {
+		attr[key] = json.RawMessage(value)
+	}
+	return attr
+}
+
+func (v *elasticsearchVisibilityPersistence) ListOpenWorkflowExecutions(
+	request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions("ListOpenWorkflowExecutions", false, request)
+}
+
+func (v *elasticsearchVisibilityPersistence) ListClosedWorkflowExecutions(
+	request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions("ListClosedWorkflowExecutions", true, request)
+}
+
+func (v *elasticsearchVisibilityPersistence) ListOpenWorkflowExecutionsByType(
+	request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions("ListOpenWorkflowExecutionsByType", false, &request.ListWorkflowExecutionsRequest,
+		elasticsearchTerm("WorkflowType", request.WorkflowTypeName))
+}
+
+func (v *elasticsearchVisibilityPersistence) ListClosedWorkflowExecutionsByType(
+	request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions("ListClosedWorkflowExecutionsByType", true, &request.ListWorkflowExecutionsRequest,
+		elasticsearchTerm("WorkflowType", request.WorkflowTypeName))
+}
+
+func (v *elasticsearchVisibilityPersistence) ListOpenWorkflowExecutionsByWorkflowID(
+	request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions("ListOpenWorkflowExecutionsByWorkflowID", false,
+		&request.ListWorkflowExecutionsRequest, elasticsearchTerm("WorkflowID", request.WorkflowID))
+}
+
+func (v *elasticsearchVisibilityPersistence) ListClosedWorkflowExecutionsByWorkflowID(
+	request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions("ListClosedWorkflowExecutionsByWorkflowID", true,
+		&request.ListWorkflowExecutionsRequest, elasticsearchTerm("WorkflowID", request.WorkflowID))
+}
+
+func (v *elasticsearchVisibilityPersistence) ListClosedWorkflowExecutionsByStatus(
+	request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions("ListClosedWorkflowExecutionsByStatus", true,
+		&request.ListWorkflowExecutionsRequest, elasticsearchTerm("CloseStatus", int32(request.Status)))
+}
+
+func (v *elasticsearchVisibilityPersistence) ScanWorkflowExecutions(
+	request *ScanWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	tokenData, err := deserializePageToken(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	phase := scanPhaseOpen
+	var pageState []byte
+	if len(tokenData) > 0 {
+		phase = tokenData[0]
+		pageState = tokenData[1:]
+	}
+
+	closed := false
+	switch phase {
+	case scanPhaseOpen:
+	case scanPhaseClosed:
+		closed = true
+	default:
+		return nil, ErrInvalidPageToken
+	}
+
+	executions, nextPageState, err := v.searchWorkflowExecutions("ScanWorkflowExecutions",
+		elasticsearchExecutionsQuery(closed, request.DomainUUID, math.MinInt64, math.MaxInt64), pageState,
+		request.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListWorkflowExecutionsResponse{Executions: executions}
+	if len(nextPageState) > 0 {
+		response.NextPageToken = serializePageToken(append([]byte{phase}, nextPageState...))
+	} else if phase == scanPhaseOpen {
+		// Open executions are exhausted, continue with closed executions
+		response.NextPageToken = serializePageToken([]byte{scanPhaseClosed})
+	} else {
+		response.NextPageToken = serializePageToken(nil)
+	}
+
+	return response, nil
+}
+
+func (v *elasticsearchVisibilityPersistence) ListWorkflowExecutionsWithQuery(
+	request *ListWorkflowExecutionsWithQueryRequest) (*ListWorkflowExecutionsResponse, error) {
+	expr, err := visibilityquery.Parse(request.Query)
+	if err != nil {
+		return nil, &workflow.BadRequestError{Message: fmt.Sprintf("Invalid query: %v", err)}
+	}
+
+	pageState, err := deserializePageToken(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := []elasticsearchQuery{elasticsearchTerm("DomainID", request.DomainUUID)}
+	if expr != nil {
+		filters = append(filters, elasticsearchQueryOf(expr))
+	}
+	executions, nextPageState, err := v.searchWorkflowExecutions("ListWorkflowExecutionsWithQuery",
+		elasticsearchQuery{"bool": elasticsearchQuery{"filter": filters}}, pageState, request.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListWorkflowExecutionsResponse{
+		Executions:    executions,
+		NextPageToken: serializePageToken(nextPageState),
+	}, nil
+}
+
+func (v *elasticsearchVisibilityPersistence) CountOpenWorkflowExecutions(
+	request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
+	return v.countWorkflowExecutions("CountOpenWorkflowExecutions", false, request)
+}
+
+func (v *elasticsearchVisibilityPersistence) CountClosedWorkflowExecutions(
+	request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
+	return v.countWorkflowExecutions("CountClosedWorkflowExecutions", true, request)
+}
+
+func (v *elasticsearchVisibilityPersistence) GetClosedWorkflowExecution(
+	request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error) {
+	execution := request.Execution
+	var response elasticsearchGetResponse
+	if err := v.send("GetClosedWorkflowExecution", http.MethodGet, v.documentPath(execution.GetRunId()), nil,
+		&response, http.StatusNotFound); err != nil {
+		return nil, err
+	}
+
+	record := &response.Source
+	if !response.Found || record.CloseTime == nil || record.DomainID != request.DomainUUID ||
+		record.WorkflowID != execution.GetWorkflowId() {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Workflow execution not found.  WorkflowId: %v, RunId: %v",
+				execution.GetWorkflowId(), execution.GetRunId()),
+		}
+	}
+
+	return &GetClosedWorkflowExecutionResponse{
+		Execution: record.toWorkflowExecutionInfo(),
+	}, nil
+}
+
+func (v *elasticsearchVisibilityPersistence) DeleteWorkflowExecution(
+	request *DeleteWorkflowExecutionVisibilityRequest) error {
+	return v.send("DeleteWorkflowExecution", http.MethodDelete, v.documentPath(request.Execution.GetRunId()),
+		nil, nil, http.StatusNotFound)
+}
+
+func (v *elasticsearchVisibilityPersistence) listWorkflowExecutions(operation string, closed bool,
+	request *ListWorkflowExecutionsRequest, filters ...elasticsearchQuery) (*ListWorkflowExecutionsResponse, error) {
+	pageState, err := deserializePageToken(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	executions, nextPageState, err := v.searchWorkflowExecutions(operation,
+		elasticsearchExecutionsQuery(closed, request.DomainUUID, request.EarliestStartTime, request.LatestStartTime,
+			filters...), pageState, request.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListWorkflowExecutionsResponse{
+		Executions:    executions,
+		NextPageToken: serializePageToken(nextPageState),
+	}, nil
+}
+
+// searchWorkflowExecutions reads one page of the executions matching the query and returns them with the page state
+// of the next page, which is empty on the last page
+func (v *elasticsearchVisibilityPersistence) searchWorkflowExecutions(operation string, query elasticsearchQuery,
+	pageState []byte, pageSize int) ([]*workflow.WorkflowExecutionInfo, []byte, error) {
+	pageSize = getPageSize(pageSize)
+	search := elasticsearchQuery{
+		"query": query,
+		"sort":  []elasticsearchQuery{{"StartTime": "desc"}, {"RunID": "asc"}},
+		"size":  pageSize + 1, // one extra execution tells if there is a next page
+	}
+	if len(pageState) > 0 {
+		if len(pageState) < 8 {
+			return nil, nil, ErrInvalidPageToken
+		}
+		search["search_after"] = []interface{}{int64(binary.BigEndian.Uint64(pageState)), string(pageState[8:])}
+	}
+
+	var response elasticsearchSearchResponse
+	if err := v.send(operation, http.MethodPost, "/_search", search, &response); err != nil {
+		return nil, nil, err
+	}
+
+	hits := response.Hits.Hits
+	var nextPageState []byte
+	if len(hits) > pageSize {
+		hits = hits[:pageSize]
+		last := hits[len(hits)-1].Source
+		nextPageState = make([]byte, 8)
+		binary.BigEndian.PutUint64(nextPageState, uint64(last.StartTime))
+		nextPageState = append(nextPageState, last.RunID...)
+	}
+
+	executions := make([]*workflow.WorkflowExecutionInfo, 0, len(hits))
+	for _, hit := range hits {
+		executions = append(executions, hit.Source.toWorkflowExecutionInfo())
+	}
+	return executions, nextPageState, nil
+}
+
+func (v *elasticsearchVisibilityPersistence) countWorkflowExecutions(operation string, closed bool,
+	request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
+	var filters []elasticsearchQuery
+	switch {
+	case request.WorkflowTypeName != "":
+		filters = append(filters, elasticsearchTerm("WorkflowType", request.WorkflowTypeName))
+	case request.WorkflowID != "":
+		filters = append(filters, elasticsearchTerm("WorkflowID", request.WorkflowID))
+	case request.Status != nil && closed:
+		filters = append(filters, elasticsearchTerm("CloseStatus", int32(*request.Status)))
+	}
+
+	count := elasticsearchQuery{
+		"query": elasticsearchExecutionsQuery(closed, request.DomainUUID, request.EarliestStartTime,
+			request.LatestStartTime, filters...),
+	}
+
+	var response elasticsearchCountResponse
+	if err := v.send(operation, http.MethodPost, "/_count", count, &response); err != nil {
+		return nil, err
+	}
+
+	return &CountWorkflowExecutionsResponse{Count: response.Count}, nil
+}
+
+func (v *elasticsearchVisibilityPersistence) documentPath(runID string) string {
+	return "/_doc/" + url.PathEscape(runID)
+}
+
+// send sends the request with the JSON encoding of the body, if any, and decodes the response into the result, if
+// any.  Responses with a status code other than 2xx and the accepted ones are converted into errors, the body of the
+// accepted ones is decoded as well, e.g. the document which was not found.
+func (v *elasticsearchVisibilityPersistence) send(operation, method, path string, body, result interface{},
+	accepted ...int) error {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return &workflow.InternalServiceError{
+				Message: fmt.Sprintf("%v operation failed. Error: %v", operation, err),
+			}
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequest(method, v.indexURL+path, reader)
+	if err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("%v operation failed. Error: %v", operation, err),
+		}
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return &UnavailableError{Msg: fmt.Sprintf("%v operation failed. Error: %v", operation, err)}
+	}
+	defer resp.Body.Close()
+
+	payload, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return &UnavailableError{Msg: fmt.Sprintf("%v operation failed. Error: %v", operation, err)}
+	}
+
+	if (resp.StatusCode < 200 || resp.StatusCode >= 300) && !isAcceptedStatus(resp.StatusCode, accepted) {
+		return convertElasticsearchError(operation, resp.StatusCode, payload)
+	}
+
+	if result != nil {
+		if err := json.Unmarshal(payload, result); err != nil {
+			return &workflow.InternalServiceError{
+				Message: fmt.Sprintf("%v operation failed. Failed to decode response. Error: %v", operation, err),
+			}
+		}
+	}
+	return nil
+}
+
+func isAcceptedStatus(status int, accepted []int) bool {
+	for _, s := range accepted {
+		if status == s {
+			return true
+		}
+	}
+	return false
+}
+
+func convertElasticsearchError(operation string, status int, payload []byte) error {
+	message := fmt.Sprintf("%v operation failed. Status: %v, Error: %s", operation, status, payload)
+	switch status {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return &UnavailableError{Msg: message}
+	}
+	return &workflow.InternalServiceError{Message: message}
+}
+
+// elasticsearchExecutionsQuery returns the query of the open or closed executions of the domain started within
+// [earliestStartTime, latestStartTime] which match all the filters
+func elasticsearchExecutionsQuery(closed bool, domainID string, earliestStartTime, latestStartTime int64,
+	filters ...elasticsearchQuery) elasticsearchQuery {
+	filters = append(filters,
+		elasticsearchTerm("DomainID", domainID),
+		elasticsearchQuery{"range": elasticsearchQuery{
+			"StartTime": elasticsearchQuery{"gte": earliestStartTime, "lte": latestStartTime},
+		}})
+
+	closeTimeExists := elasticsearchQuery{"exists": elasticsearchQuery{"field": "CloseTime"}}
+	boolQuery := elasticsearchQuery{}
+	if closed {
+		filters = append(filters, closeTimeExists)
+	} else {
+		boolQuery["must_not"] = []elasticsearchQuery{closeTimeExists}
+	}
+	boolQuery["filter"] = filters
+
+	return elasticsearchQuery{"bool": boolQuery}
+}
+
+// elasticsearchQueryOf translates a parsed visibility query into the Elasticsearch query DSL, the query fields are
+// the fields of the indexed documents
+func elasticsearchQueryOf(expr visibilityquery.Expr) elasticsearchQuery {
+	switch e := expr.(type) {
+	case *visibilityquery.And:
+		return elasticsearchQuery{"bool": elasticsearchQuery{"filter": elasticsearchQueriesOf(e.Exprs)}}
+	case *visibilityquery.Or:
+		return elasticsearchQuery{"bool": elasticsearchQuery{
+			"should":               elasticsearchQueriesOf(e.Exprs),
+			"minimum_should_match": 1,
+		}}
+	case *visibilityquery.Not:
+		return elasticsearchQuery{"bool": elasticsearchQuery{
+			"must_not": []elasticsearchQuery{elasticsearchQueryOf(e.Expr)},
+		}}
+	case *visibilityquery.Comparison:
+		switch e.Operator {
+		case visibilityquery.OperatorEqual:
+			return elasticsearchTerm(e.Field, e.Value)
+		case visibilityquery.OperatorNotEqual:
+			return elasticsearchQuery{"bool": elasticsearchQuery{
+				"must_not": []elasticsearchQuery{elasticsearchTerm(e.Field, e.Value)},
+			}}
+		}
+		return elasticsearchQuery{"range": elasticsearchQuery{
+			e.Field: elasticsearchQuery{elasticsearchRangeOperators[e.Operator]: e.Value},
+		}}
+	}
+	panic(fmt.Sprintf("unknown visibility query expression %T", expr))
+}
+
+func elasticsearchQueriesOf(exprs []visibilityquery.Expr) []elasticsearchQuery {
+	queries := make([]elasticsearchQuery, 0, len(exprs))
+	for _, expr := range exprs {
+		queries = append(queries, elasticsearchQueryOf(expr))
+	}
+	return queries
+}
+
+func elasticsearchTerm(field string, value interface{}) elasticsearchQuery {
+	return elasticsearchQuery{"term": elasticsearchQuery{field: value}}
+}
+
+func (r *elasticsearchVisibilityRecord) toWorkflowExecutionInfo() *workflow.WorkflowExecutionInfo {
+	execution := workflow.NewWorkflowExecution()
+	execution.WorkflowId = common.StringPtr(r.WorkflowID)
+	execution.RunId = common.StringPtr(r.RunID)
+
+	wfType := workflow.NewWorkflowType()
+	wfType.Name = common.StringPtr(r.WorkflowType)
+
+	record := workflow.NewWorkflowExecutionInfo()
+	record.Execution = execution
+	record.StartTime = common.Int64Ptr(r.StartTime)
+	record.Type = wfType
+	if r.FirstRunID != "" {
+		record.FirstExecutionRunId = common.StringPtr(r.FirstRunID)
+	}
+	if r.TraceID != "" {
+		record.TraceId = common.StringPtr(r.TraceID)
+	}
+	if r.CloseTime != nil {
+		record.CloseTime = common.Int64Ptr(*r.CloseTime)
+		if r.CloseStatus != nil {
+			record.CloseStatus = workflow.WorkflowExecutionCloseStatusPtr(
+				workflow.WorkflowExecutionCloseStatus(*r.CloseStatus))
+		}
+		if r.HistoryLength != nil {
+			record.HistoryLength = common.Int64Ptr(*r.HistoryLength)
+		}
+	}
+	return record
+}
@@ -0,0 +1,260 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+type (
+	elasticsearchVisibilitySuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+
+		server   *httptest.Server
+		visMgr   VisibilityManager
+		requests []*elasticsearchTestRequest
+		status   int
+		response string
+	}
+
+	elasticsearchTestRequest struct {
+		method string
+		uri    string
+		body   map[string]interface{}
+	}
+)
+
+func TestElasticsearchVisibilitySuite(t *testing.T) {
+	s := new(elasticsearchVisibilitySuite)
+	suite.Run(t, s)
+}
+
+func (s *elasticsearchVisibilitySuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.requests = nil
+	s.status = http.StatusOK
+	s.response = "{}"
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request := &elasticsearchTestRequest{method: r.Method, uri: r.URL.RequestURI()}
+		payload, _ := ioutil.ReadAll(r.Body)
+		if len(payload) > 0 {
+			json.Unmarshal(payload, &request.body)
+		}
+		s.requests = append(s.requests, request)
+		w.WriteHeader(s.status)
+		w.Write([]byte(s.response))
+	}))
+
+	var err error
+	s.visMgr, err = NewElasticsearchVisibilityPersistence(s.server.URL, "visibility",
+		bark.NewLoggerFromLogrus(log.New()))
+	s.NoError(err)
+}
+
+func (s *elasticsearchVisibilitySuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *elasticsearchVisibilitySuite) TestRecordWorkflowExecutionStarted() {
+	// The run was already closed
+	s.status = http.StatusConflict
+	err := s.visMgr.RecordWorkflowExecutionStarted(&RecordWorkflowExecutionStartedRequest{
+		DomainUUID:       "domain",
+		Execution:        workflow.WorkflowExecution{WorkflowId: common.StringPtr("wid"), RunId: common.StringPtr("rid")},
+		WorkflowTypeName: "type",
+		StartTimestamp:   10,
+		FirstRunID:       "first-rid",
+		TraceID:          "trace-id",
+		SearchAttributes: map[string][]byte{"CustomKeywordField": []byte(`"keyword"`), "CustomIntField": []byte(`12`)},
+	})
+	s.NoError(err)
+
+	s.Len(s.requests, 1)
+	s.Equal(http.MethodPut, s.requests[0].method)
+	s.Equal("/visibility/_doc/rid?op_type=create", s.requests[0].uri)
+	s.Equal("wid", s.requests[0].body["WorkflowID"])
+	s.Equal("first-rid", s.requests[0].body["FirstRunID"])
+	s.Equal("trace-id", s.requests[0].body["TraceID"])
+	s.Equal(map[string]interface{}{"CustomKeywordField": "keyword", "CustomIntField": float64(12)},
+		s.requests[0].body["Attr"])
+	s.NotContains(s.requests[0].body, "CloseTime")
+}
+
+func (s *elasticsearchVisibilitySuite) TestRecordWorkflowExecutionClosedUnavailable() {
+	s.status = http.StatusServiceUnavailable
+	err := s.visMgr.RecordWorkflowExecutionClosed(&RecordWorkflowExecutionClosedRequest{
+		DomainUUID: "domain",
+		Execution:  workflow.WorkflowExecution{WorkflowId: common.StringPtr("wid"), RunId: common.StringPtr("rid")},
+		Status:     workflow.WorkflowExecutionCloseStatus_FAILED,
+	})
+	s.IsType(&UnavailableError{}, err)
+
+	s.Len(s.requests, 1)
+	s.Equal("/visibility/_doc/rid", s.requests[0].uri)
+	s.Equal(float64(workflow.WorkflowExecutionCloseStatus_FAILED), s.requests[0].body["CloseStatus"])
+}
+
+func (s *elasticsearchVisibilitySuite) TestListOpenWorkflowExecutionsByTypePaging() {
+	s.response = `{"hits": {"hits": [
+		{"_source": {"DomainID": "domain", "WorkflowID": "wid1", "RunID": "rid1", "WorkflowType": "type", "StartTime": 30}},
+		{"_source": {"DomainID": "domain", "WorkflowID": "wid2", "RunID": "rid2", "WorkflowType": "type", "StartTime": 20}}
+	]}}`
+	request := &ListWorkflowExecutionsByTypeRequest{
+		ListWorkflowExecutionsRequest: ListWorkflowExecutionsRequest{
+			DomainUUID:        "domain",
+			EarliestStartTime: 0,
+			LatestStartTime:   100,
+			PageSize:          1,
+		},
+		WorkflowTypeName: "type",
+	}
+	response, err := s.visMgr.ListOpenWorkflowExecutionsByType(request)
+	s.NoError(err)
+	s.Len(response.Executions, 1)
+	s.Equal("rid1", response.Executions[0].Execution.GetRunId())
+	s.Nil(response.Executions[0].CloseTime)
+	s.NotEmpty(response.NextPageToken)
+
+	search := s.requests[0].body
+	s.Equal("/visibility/_search", s.requests[0].uri)
+	s.Equal(float64(2), search["size"])
+	s.NotContains(search, "search_after")
+	boolQuery := search["query"].(map[string]interface{})["bool"].(map[string]interface{})
+	s.Contains(boolQuery, "must_not")
+	s.Len(boolQuery["filter"], 3)
+
+	request.NextPageToken = response.NextPageToken
+	_, err = s.visMgr.ListOpenWorkflowExecutionsByType(request)
+	s.NoError(err)
+	s.Equal([]interface{}{float64(30), "rid1"}, s.requests[1].body["search_after"])
+}
+
+func (s *elasticsearchVisibilitySuite) TestListWorkflowExecutionsWithQuery() {
+	s.response = `{"hits": {"hits": [
+		{"_source": {"DomainID": "domain", "WorkflowID": "wid1", "RunID": "rid1", "WorkflowType": "type", "StartTime": 30,
+			"CloseTime": 40, "CloseStatus": 1, "HistoryLength": 5}}
+	]}}`
+	response, err := s.visMgr.ListWorkflowExecutionsWithQuery(&ListWorkflowExecutionsWithQueryRequest{
+		DomainUUID: "domain",
+		Query:      "WorkflowType = 'type' AND (CloseStatus = 'FAILED' OR NOT HistoryLength > 10)",
+		PageSize:   10,
+	})
+	s.NoError(err)
+	s.Len(response.Executions, 1)
+	s.Equal(int64(40), response.Executions[0].GetCloseTime())
+	s.Empty(response.NextPageToken)
+
+	s.Len(s.requests, 1)
+	s.Equal("/visibility/_search", s.requests[0].uri)
+	query, err := json.Marshal(s.requests[0].body["query"])
+	s.NoError(err)
+	s.JSONEq(`{"bool": {"filter": [
+		{"term": {"DomainID": "domain"}},
+		{"bool": {"filter": [
+			{"term": {"WorkflowType": "type"}},
+			{"bool": {"minimum_should_match": 1, "should": [
+				{"term": {"CloseStatus": 1}},
+				{"bool": {"must_not": [{"range": {"HistoryLength": {"gt": 10}}}]}}
+			]}}
+		]}}
+	]}}`, string(query))
+}
+
+func (s *elasticsearchVisibilitySuite) TestListWorkflowExecutionsWithInvalidQuery() {
+	_, err := s.visMgr.ListWorkflowExecutionsWithQuery(&ListWorkflowExecutionsWithQueryRequest{
+		DomainUUID: "domain",
+		Query:      "WorkflowType > 'type'",
+	})
+	s.IsType(&workflow.BadRequestError{}, err)
+	s.Empty(s.requests)
+}
+
+func (s *elasticsearchVisibilitySuite) TestCountClosedWorkflowExecutionsByStatus() {
+	s.response = `{"count": 42}`
+	response, err := s.visMgr.CountClosedWorkflowExecutions(&CountWorkflowExecutionsRequest{
+		DomainUUID:        "domain",
+		EarliestStartTime: 0,
+		LatestStartTime:   100,
+		Status:            workflow.WorkflowExecutionCloseStatusPtr(workflow.WorkflowExecutionCloseStatus_FAILED),
+	})
+	s.NoError(err)
+	s.Equal(int64(42), response.Count)
+
+	s.Len(s.requests, 1)
+	s.Equal(http.MethodPost, s.requests[0].method)
+	s.Equal("/visibility/_count", s.requests[0].uri)
+	s.NotContains(s.requests[0].body, "size")
+	boolQuery := s.requests[0].body["query"].(map[string]interface{})["bool"].(map[string]interface{})
+	s.NotContains(boolQuery, "must_not")
+	// status, domain, start time and close time
+	s.Len(boolQuery["filter"], 4)
+}
+
+func (s *elasticsearchVisibilitySuite) TestGetClosedWorkflowExecution() {
+	execution := workflow.WorkflowExecution{WorkflowId: common.StringPtr("wid"), RunId: common.StringPtr("rid")}
+	s.status = http.StatusNotFound
+	s.response = `{"found": false}`
+	_, err := s.visMgr.GetClosedWorkflowExecution(&GetClosedWorkflowExecutionRequest{
+		DomainUUID: "domain",
+		Execution:  execution,
+	})
+	s.IsType(&workflow.EntityNotExistsError{}, err)
+
+	s.status = http.StatusOK
+	s.response = `{"found": true, "_source": {"DomainID": "domain", "WorkflowID": "wid", "RunID": "rid",
+		"WorkflowType": "type", "StartTime": 10, "CloseTime": 20, "CloseStatus": 1, "HistoryLength": 5,
+		"FirstRunID": "first-rid", "TraceID": "trace-id"}}`
+	response, err := s.visMgr.GetClosedWorkflowExecution(&GetClosedWorkflowExecutionRequest{
+		DomainUUID: "domain",
+		Execution:  execution,
+	})
+	s.NoError(err)
+	s.Equal(int64(20), response.Execution.GetCloseTime())
+	s.Equal(workflow.WorkflowExecutionCloseStatus(1), response.Execution.GetCloseStatus())
+	s.Equal(int64(5), response.Execution.GetHistoryLength())
+	s.Equal("first-rid", response.Execution.GetFirstExecutionRunId())
+	s.Equal("trace-id", response.Execution.GetTraceId())
+}
+
+func (s *elasticsearchVisibilitySuite) TestDeleteWorkflowExecutionNotFound() {
+	s.status = http.StatusNotFound
+	err := s.visMgr.DeleteWorkflowExecution(&DeleteWorkflowExecutionVisibilityRequest{
+		DomainUUID: "domain",
+		Execution:  workflow.WorkflowExecution{WorkflowId: common.StringPtr("wid"), RunId: common.StringPtr("rid")},
+	})
+	s.NoError(err)
+	s.Equal(http.MethodDelete, s.requests[0].method)
+}
@@ -0,0 +1,158 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/blobstore"
+)
+
+type (
+	// HistoryArchive keeps the histories of closed workflow executions in a blob store, so that they can still be
+	// read once they are deleted from the datastore at the end of the retention period of their domain.  The
+	// archived histories are encoded with the payload codec, if any.
+	HistoryArchive struct {
+		client blobstore.Client
+		codec  PayloadCodec
+	}
+
+	archivedHistory struct {
+		Batches []SerializedHistoryEventBatch `json:"batches"`
+	}
+)
+
+// historyArchivePageSize is the number of batches of events read at once from the datastore to archive a history
+const historyArchivePageSize = 100
+
+// NewHistoryArchive creates a HistoryArchive keeping the histories in the blob store, the codec is optional
+func NewHistoryArchive(client blobstore.Client, codec PayloadCodec) *HistoryArchive {
+	return &HistoryArchive{
+		client: client,
+		codec:  codec,
+	}
+}
+
+// ArchiveWorkflowExecutionHistory uploads the whole history of the closed execution, read from the history manager,
+// to the archive.  A history which is already gone from the datastore is assumed to be archived already.
+func (a *HistoryArchive) ArchiveWorkflowExecutionHistory(historyMgr HistoryManager, domainID string,
+	execution workflow.WorkflowExecution) error {
+	archived := &archivedHistory{}
+	token := []byte{}
+	for {
+		response, err := historyMgr.GetWorkflowExecutionHistory(&GetWorkflowExecutionHistoryRequest{
+			DomainID:      domainID,
+			Execution:     execution,
+			NextEventID:   math.MaxInt64,
+			PageSize:      historyArchivePageSize,
+			NextPageToken: token,
+		})
+		if err != nil {
+			if _, ok := err.(*workflow.EntityNotExistsError); ok && len(token) == 0 {
+				return nil
+			}
+			return err
+		}
+
+		archived.Batches = append(archived.Batches, response.Events...)
+		if len(response.NextPageToken) == 0 {
+			break
+		}
+		token = response.NextPageToken
+	}
+
+	data, err := json.Marshal(archived)
+	if err != nil {
+		return &workflow.InternalServiceError{Message: "Failed to serialize archived history. Error: " + err.Error()}
+	}
+	encoded, err := encodeWithCodec(a.codec, data)
+	if err != nil {
+		return err
+	}
+	if err := a.client.Upload(historyArchiveKey(domainID, execution.GetRunId()), encoded); err != nil {
+		return &workflow.InternalServiceError{Message: "Failed to upload archived history. Error: " + err.Error()}
+	}
+	return nil
+}
+
+// GetWorkflowExecutionHistory reads a page of the archived history of an execution, it returns EntityNotExistsError
+// if the history is not archived.  The NextEventID of the request is ignored, archived histories are complete.
+func (a *HistoryArchive) GetWorkflowExecutionHistory(request *GetWorkflowExecutionHistoryRequest) (
+	*GetWorkflowExecutionHistoryResponse, error) {
+	// The page state is the index of the first batch of the next page
+	pageState, err := deserializePageToken(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+	first := 0
+	if len(pageState) > 0 {
+		if len(pageState) != 8 {
+			return nil, ErrInvalidPageToken
+		}
+		first = int(binary.BigEndian.Uint64(pageState))
+	}
+
+	execution := request.Execution
+	blob, err := a.client.Download(historyArchiveKey(request.DomainID, execution.GetRunId()))
+	if err != nil {
+		if err == blobstore.ErrBlobNotFound {
+			return nil, &workflow.EntityNotExistsError{
+				Message: fmt.Sprintf("Archived workflow execution history not found.  WorkflowId: %v, RunId: %v",
+					execution.GetWorkflowId(), execution.GetRunId()),
+			}
+		}
+		return nil, &workflow.InternalServiceError{Message: "Failed to download archived history. Error: " +
+			err.Error()}
+	}
+	data, err := decodeWithCodec(a.codec, blob)
+	if err != nil {
+		return nil, err
+	}
+	archived := &archivedHistory{}
+	if err := json.Unmarshal(data, archived); err != nil {
+		return nil, &CorruptionError{Msg: "Failed to deserialize archived history. Error: " + err.Error()}
+	}
+
+	if first > len(archived.Batches) {
+		return nil, ErrInvalidPageToken
+	}
+	last := first + getPageSize(request.PageSize)
+	response := &GetWorkflowExecutionHistoryResponse{NextPageToken: []byte{}}
+	if last < len(archived.Batches) {
+		pageState = make([]byte, 8)
+		binary.BigEndian.PutUint64(pageState, uint64(last))
+		response.NextPageToken = serializePageToken(pageState)
+	} else {
+		last = len(archived.Batches)
+	}
+	response.Events = archived.Batches[first:last]
+	return response, nil
+}
+
+// historyArchiveKey returns the key of the archived history of a run, which never collides with the keys of the
+// offloaded payloads of the run, so that a blob store can be shared by both
+func historyArchiveKey(domainID string, runID string) string {
+	return fmt.Sprintf("archive/%v/%v/history", domainID, runID)
+}
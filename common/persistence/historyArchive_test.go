@@ -0,0 +1,136 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore"
+)
+
+type (
+	historyArchiveSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+		dir     string
+		archive *HistoryArchive
+	}
+
+	// pagedHistoryManager returns the pages of a single history, the other methods are not implemented
+	pagedHistoryManager struct {
+		HistoryManager
+		pages [][]SerializedHistoryEventBatch
+	}
+)
+
+func TestHistoryArchiveSuite(t *testing.T) {
+	s := new(historyArchiveSuite)
+	suite.Run(t, s)
+}
+
+func (s *historyArchiveSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	var err error
+	s.dir, err = ioutil.TempDir("", "historyArchive")
+	s.Nil(err)
+	client, err := blobstore.NewFilesystemClient(s.dir)
+	s.Nil(err)
+	codec, err := NewPayloadCodec(PayloadCodecAESGCM, map[string]string{"key": "000102030405060708090a0b0c0d0e0f"})
+	s.Nil(err)
+	s.archive = NewHistoryArchive(client, codec)
+}
+
+func (s *historyArchiveSuite) TearDownTest() {
+	os.RemoveAll(s.dir)
+}
+
+func (s *historyArchiveSuite) TestArchiveAndRead() {
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("archive-workflow"),
+		RunId:      common.StringPtr("0d00698f-08e1-4d36-a3e2-3bf109f5d2d6"),
+	}
+	historyMgr := &pagedHistoryManager{pages: [][]SerializedHistoryEventBatch{
+		{{Data: []byte("batch1")}, {Data: []byte("batch2")}},
+		{{Data: []byte("batch3")}},
+	}}
+	s.Nil(s.archive.ArchiveWorkflowExecutionHistory(historyMgr, "domain", execution))
+
+	var batches []SerializedHistoryEventBatch
+	token := []byte{}
+	for {
+		response, err := s.archive.GetWorkflowExecutionHistory(&GetWorkflowExecutionHistoryRequest{
+			DomainID:      "domain",
+			Execution:     execution,
+			PageSize:      2,
+			NextPageToken: token,
+		})
+		s.Nil(err)
+		batches = append(batches, response.Events...)
+		if len(response.NextPageToken) == 0 {
+			break
+		}
+		token = response.NextPageToken
+	}
+	s.Equal([]SerializedHistoryEventBatch{
+		{Data: []byte("batch1")}, {Data: []byte("batch2")}, {Data: []byte("batch3")},
+	}, batches)
+}
+
+func (s *historyArchiveSuite) TestNotArchived() {
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("archive-workflow"),
+		RunId:      common.StringPtr("1e5d2c5a-3c61-4ae3-8d42-5b2c1a0e4a57"),
+	}
+	s.Nil(s.archive.ArchiveWorkflowExecutionHistory(&pagedHistoryManager{}, "domain", execution))
+
+	_, err := s.archive.GetWorkflowExecutionHistory(&GetWorkflowExecutionHistoryRequest{
+		DomainID:  "domain",
+		Execution: execution,
+	})
+	s.IsType(&workflow.EntityNotExistsError{}, err)
+}
+
+func (m *pagedHistoryManager) GetWorkflowExecutionHistory(request *GetWorkflowExecutionHistoryRequest) (
+	*GetWorkflowExecutionHistoryResponse, error) {
+	if len(m.pages) == 0 {
+		return nil, &workflow.EntityNotExistsError{Message: "Workflow execution history not found."}
+	}
+
+	page := 0
+	if len(request.NextPageToken) > 0 {
+		page = int(request.NextPageToken[0])
+	}
+	response := &GetWorkflowExecutionHistoryResponse{Events: m.pages[page], NextPageToken: []byte{}}
+	if page+1 < len(m.pages) {
+		response.NextPageToken = []byte{byte(page + 1)}
+	}
+	return response, nil
+}
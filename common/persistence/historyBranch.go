@@ -0,0 +1,304 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/pborman/uuid"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+type (
+	// historyNode is a batch of events of a branch, keyed by the ID of its first event
+	historyNode struct {
+		nodeID int64
+		txnID  int64
+		events SerializedHistoryEventBatch
+	}
+
+	// historyBranchStore is implemented by the history stores.  It provides the storage of the nodes and the
+	// branches of history trees, on top of which the history branch APIs are implemented by the functions below.
+	historyBranchStore interface {
+		insertHistoryNode(branch *HistoryBranch, nodeID int64, txnID int64, events *SerializedHistoryEventBatch) error
+		// readHistoryNodes returns at most limit nodes of the branch with an ID in [minNodeID, maxNodeID), ordered
+		// by node ID and by descending transaction ID
+		readHistoryNodes(treeID string, branchID string, minNodeID int64, maxNodeID int64, limit int) (
+			[]*historyNode, error)
+		// deleteHistoryNodes deletes the nodes of the branch with an ID greater than or equal to minNodeID
+		deleteHistoryNodes(treeID string, branchID string, minNodeID int64) error
+		insertHistoryBranch(branch *HistoryBranch) error
+		getHistoryBranches(treeID string) ([]*HistoryBranch, error)
+		deleteHistoryBranch(treeID string, branchID string) error
+		// convertHistoryBranchError converts an error returned by the methods above into a persistence error
+		convertHistoryBranchError(operation string, err error) error
+	}
+)
+
+var (
+	errInvalidBranchToken = &workflow.BadRequestError{Message: "Invalid history branch token."}
+)
+
+// NewHistoryBranchToken returns the token of a new branch of a history tree, without any ancestor.  The branch is
+// created by the first AppendHistoryNodes with IsNewBranch set.
+func NewHistoryBranchToken(treeID string) ([]byte, error) {
+	return SerializeHistoryBranch(&HistoryBranch{
+		TreeID:   treeID,
+		BranchID: uuid.New(),
+	})
+}
+
+// SerializeHistoryBranch returns the branch token of a branch
+func SerializeHistoryBranch(branch *HistoryBranch) ([]byte, error) {
+	return json.Marshal(branch)
+}
+
+// DeserializeHistoryBranch returns the branch of a branch token
+func DeserializeHistoryBranch(token []byte) (*HistoryBranch, error) {
+	branch := &HistoryBranch{}
+	if err := json.Unmarshal(token, branch); err != nil || branch.TreeID == "" || branch.BranchID == "" {
+		return nil, errInvalidBranchToken
+	}
+	return branch, nil
+}
+
+// historyBranchRanges returns the ranges of nodes of the branch: the ranges shared with its ancestors followed by the
+// range of the nodes of the branch itself, which starts where the last ancestor range ends
+func historyBranchRanges(branch *HistoryBranch) []*HistoryBranchRange {
+	ranges := make([]*HistoryBranchRange, 0, len(branch.Ancestors)+1)
+	ranges = append(ranges, branch.Ancestors...)
+	return append(ranges, &HistoryBranchRange{
+		BranchID:    branch.BranchID,
+		BeginNodeID: historyBranchBeginNodeID(branch),
+		EndNodeID:   math.MaxInt64,
+	})
+}
+
+// historyBranchBeginNodeID returns the ID of the first node stored by the branch itself
+func historyBranchBeginNodeID(branch *HistoryBranch) int64 {
+	if len(branch.Ancestors) == 0 {
+		return common.FirstEventID
+	}
+	return branch.Ancestors[len(branch.Ancestors)-1].EndNodeID
+}
+
+func appendHistoryNodes(store historyBranchStore, request *AppendHistoryNodesRequest) error {
+	branch, err := DeserializeHistoryBranch(request.BranchToken)
+	if err != nil {
+		return err
+	}
+
+	if begin := historyBranchBeginNodeID(branch); request.NodeID < begin {
+		return &workflow.BadRequestError{
+			Message: fmt.Sprintf("Cannot append node %v to a history branch forked at node %v.", request.NodeID, begin),
+		}
+	}
+
+	if request.IsNewBranch {
+		if err := store.insertHistoryBranch(branch); err != nil {
+			return store.convertHistoryBranchError("AppendHistoryNodes", err)
+		}
+	}
+
+	if err := store.insertHistoryNode(branch, request.NodeID, request.TransactionID, request.Events); err != nil {
+		return store.convertHistoryBranchError("AppendHistoryNodes", err)
+	}
+	return nil
+}
+
+func readHistoryBranch(store historyBranchStore, request *ReadHistoryBranchRequest) (*ReadHistoryBranchResponse,
+	error) {
+	branch, err := DeserializeHistoryBranch(request.BranchToken)
+	if err != nil {
+		return nil, err
+	}
+
+	pageState, err := deserializePageToken(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// The page state is the ID of the first node of the next page
+	minNodeID := request.MinEventID
+	if len(pageState) > 0 {
+		if len(pageState) != 8 {
+			return nil, ErrInvalidPageToken
+		}
+		minNodeID = int64(binary.BigEndian.Uint64(pageState))
+	}
+
+	pageSize := getPageSize(request.PageSize)
+	response := &ReadHistoryBranchResponse{NextPageToken: []byte{}}
+	lastNodeID := minNodeID - 1
+	for _, r := range historyBranchRanges(branch) {
+		begin := maxInt64(minNodeID, r.BeginNodeID)
+		end := minInt64(request.MaxEventID, r.EndNodeID)
+		if begin >= end {
+			continue
+		}
+
+		limit := pageSize - len(response.Events)
+		nodes, err := store.readHistoryNodes(branch.TreeID, r.BranchID, begin, end, limit)
+		if err != nil {
+			return nil, store.convertHistoryBranchError("ReadHistoryBranch", err)
+		}
+
+		for _, node := range nodes {
+			if node.nodeID == lastNodeID {
+				// superseded by the batch of a later transaction, which is read first
+				continue
+			}
+			lastNodeID = node.nodeID
+			response.Events = append(response.Events, node.events)
+		}
+
+		if len(nodes) == limit {
+			// the remaining batches of the last node read, if any, are superseded
+			pageState = make([]byte, 8)
+			binary.BigEndian.PutUint64(pageState, uint64(nodes[len(nodes)-1].nodeID+1))
+			response.NextPageToken = serializePageToken(pageState)
+			break
+		}
+	}
+
+	if len(response.Events) == 0 && len(request.NextPageToken) == 0 {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("History branch not found.  TreeId: %v, BranchId: %v", branch.TreeID,
+				branch.BranchID),
+		}
+	}
+
+	return response, nil
+}
+
+func forkHistoryBranch(store historyBranchStore, request *ForkHistoryBranchRequest) (*ForkHistoryBranchResponse,
+	error) {
+	forkBranch, err := DeserializeHistoryBranch(request.ForkBranchToken)
+	if err != nil {
+		return nil, err
+	}
+
+	forkNodeID := request.ForkNodeID
+	if forkNodeID <= common.FirstEventID {
+		return nil, &workflow.BadRequestError{Message: fmt.Sprintf("Invalid fork node ID %v.", forkNodeID)}
+	}
+
+	// The new branch shares the nodes before the fork node, whether they belong to the forked branch or to one of its
+	// ancestors
+	newBranch := &HistoryBranch{
+		TreeID:   forkBranch.TreeID,
+		BranchID: uuid.New(),
+	}
+	for _, r := range historyBranchRanges(forkBranch) {
+		if r.BeginNodeID >= forkNodeID {
+			break
+		}
+		newBranch.Ancestors = append(newBranch.Ancestors, &HistoryBranchRange{
+			BranchID:    r.BranchID,
+			BeginNodeID: r.BeginNodeID,
+			EndNodeID:   minInt64(r.EndNodeID, forkNodeID),
+		})
+	}
+
+	if err := store.insertHistoryBranch(newBranch); err != nil {
+		return nil, store.convertHistoryBranchError("ForkHistoryBranch", err)
+	}
+
+	token, err := SerializeHistoryBranch(newBranch)
+	if err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ForkHistoryBranch operation failed. Error: %v", err),
+		}
+	}
+	return &ForkHistoryBranchResponse{NewBranchToken: token}, nil
+}
+
+func deleteHistoryBranch(store historyBranchStore, request *DeleteHistoryBranchRequest) error {
+	branch, err := DeserializeHistoryBranch(request.BranchToken)
+	if err != nil {
+		return err
+	}
+
+	branches, err := store.getHistoryBranches(branch.TreeID)
+	if err != nil {
+		return store.convertHistoryBranchError("DeleteHistoryBranch", err)
+	}
+
+	// The nodes of every branch still in use are the nodes of the remaining branches, and the nodes of their
+	// ancestors up to the end of the ranges they share
+	remaining := make(map[string]bool)
+	usedEndNodeIDs := make(map[string]int64)
+	for _, b := range branches {
+		if b.BranchID == branch.BranchID {
+			continue
+		}
+		remaining[b.BranchID] = true
+		for _, r := range b.Ancestors {
+			usedEndNodeIDs[r.BranchID] = maxInt64(usedEndNodeIDs[r.BranchID], r.EndNodeID)
+		}
+	}
+
+	if err := store.deleteHistoryBranch(branch.TreeID, branch.BranchID); err != nil {
+		return store.convertHistoryBranchError("DeleteHistoryBranch", err)
+	}
+
+	// The unused nodes of the branch and of its ancestors are deleted, from the branch up to the nearest remaining
+	// ancestor, which keeps its nodes and whose ancestors are still used by it
+	ranges := historyBranchRanges(branch)
+	for i := len(ranges) - 1; i >= 0; i-- {
+		branchID := ranges[i].BranchID
+		if remaining[branchID] {
+			break
+		}
+		if err := store.deleteHistoryNodes(branch.TreeID, branchID, usedEndNodeIDs[branchID]); err != nil {
+			return store.convertHistoryBranchError("DeleteHistoryBranch", err)
+		}
+	}
+
+	return nil
+}
+
+func getHistoryTree(store historyBranchStore, request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error) {
+	branches, err := store.getHistoryBranches(request.TreeID)
+	if err != nil {
+		return nil, store.convertHistoryBranchError("GetHistoryTree", err)
+	}
+	return &GetHistoryTreeResponse{Branches: branches}, nil
+}
+
+func minInt64(a int64, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt64(a int64, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
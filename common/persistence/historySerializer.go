@@ -21,10 +21,14 @@
 package persistence
 
 import (
+	"bytes"
+	"compress/gzip"
 	"encoding/json"
 	"fmt"
+	"github.com/golang/snappy"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"io/ioutil"
 	"sync/atomic"
 )
 
@@ -71,7 +75,14 @@ type (
 		supportedVersion int
 	}
 
-	jsonHistorySerializer struct{}
+	// HistoryCompression is the codec used to compress serialized history
+	HistoryCompression string
+
+	jsonHistorySerializer struct {
+		compression HistoryCompression
+		// threshold is the size of a serialized batch from which it is compressed
+		threshold int
+	}
 
 	serializerFactoryImpl struct {
 		jsonSerializer HistorySerializer
@@ -83,6 +94,24 @@ const (
 	DefaultEncodingType = common.EncodingTypeJSON
 )
 
+// History compression codecs
+const (
+	HistoryCompressionNone   HistoryCompression = ""
+	HistoryCompressionGzip   HistoryCompression = "gzip"
+	HistoryCompressionSnappy HistoryCompression = "snappy"
+)
+
+// Compressed history starts with a header made of historyCompressionMagic and the codec.  Uncompressed history is a
+// JSON array, which never starts with historyCompressionMagic, so history written before compression was enabled
+// still deserializes.
+const (
+	historyCompressionMagic byte = 0xc7
+	historyCodecGzip        byte = 1
+	historyCodecSnappy      byte = 2
+
+	historyCompressionHeaderSize = 2
+)
+
 var defaultHistoryVersion = int32(1)
 var maxSupportedHistoryVersion = int32(1)
 
@@ -91,6 +120,18 @@ func NewJSONHistorySerializer() HistorySerializer {
 	return &jsonHistorySerializer{}
 }
 
+// NewCompressedJSONHistorySerializer returns a JSON HistorySerializer which compresses every batch serialized into
+// threshold bytes or more
+func NewCompressedJSONHistorySerializer(compression HistoryCompression, threshold int) (HistorySerializer, error) {
+	switch compression {
+	case HistoryCompressionNone, HistoryCompressionGzip, HistoryCompressionSnappy:
+	default:
+		return nil, fmt.Errorf("unknown history compression %v", compression)
+	}
+
+	return &jsonHistorySerializer{compression: compression, threshold: threshold}, nil
+}
+
 func (j *jsonHistorySerializer) Serialize(batch *HistoryEventBatch) (*SerializedHistoryEventBatch, error) {
 
 	if batch.Version > GetMaxSupportedHistoryVersion() {
@@ -102,6 +143,13 @@ func (j *jsonHistorySerializer) Serialize(batch *HistoryEventBatch) (*Serialized
 	if err != nil {
 		return nil, &HistorySerializationError{msg: err.Error()}
 	}
+
+	if j.compression != HistoryCompressionNone && len(data) >= j.threshold {
+		data, err = compressHistory(j.compression, data)
+		if err != nil {
+			return nil, &HistorySerializationError{msg: err.Error()}
+		}
+	}
 	return NewSerializedHistoryEventBatch(data, common.EncodingTypeJSON, batch.Version), nil
 }
 
@@ -112,14 +160,62 @@ func (j *jsonHistorySerializer) Deserialize(batch *SerializedHistoryEventBatch)
 		return nil, &HistoryDeserializationError{msg: err.Error()}
 	}
 
+	data, err := decompressHistory(batch.Data)
+	if err != nil {
+		return nil, &HistoryDeserializationError{msg: err.Error()}
+	}
+
 	var events []*workflow.HistoryEvent
-	err := json.Unmarshal(batch.Data, &events)
+	err = json.Unmarshal(data, &events)
 	if err != nil {
 		return nil, &HistoryDeserializationError{msg: err.Error()}
 	}
 	return &HistoryEventBatch{Version: batch.Version, Events: events}, nil
 }
 
+func compressHistory(compression HistoryCompression, data []byte) ([]byte, error) {
+	switch compression {
+	case HistoryCompressionGzip:
+		var b bytes.Buffer
+		b.Write([]byte{historyCompressionMagic, historyCodecGzip})
+		w := gzip.NewWriter(&b)
+		if _, err := w.Write(data); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+		return b.Bytes(), nil
+	case HistoryCompressionSnappy:
+		header := []byte{historyCompressionMagic, historyCodecSnappy}
+		return append(header, snappy.Encode(nil, data)...), nil
+	default:
+		return nil, fmt.Errorf("unknown history compression %v", compression)
+	}
+}
+
+// decompressHistory returns the data of uncompressed history unchanged
+func decompressHistory(data []byte) ([]byte, error) {
+	if len(data) < historyCompressionHeaderSize || data[0] != historyCompressionMagic {
+		return data, nil
+	}
+
+	compressed := data[historyCompressionHeaderSize:]
+	switch data[1] {
+	case historyCodecGzip:
+		r, err := gzip.NewReader(bytes.NewReader(compressed))
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		return ioutil.ReadAll(r)
+	case historyCodecSnappy:
+		return snappy.Decode(nil, compressed)
+	default:
+		return nil, fmt.Errorf("unknown history compression codec %v", data[1])
+	}
+}
+
 // NewHistorySerializerFactory creates and returns an instance
 // of HistorySerializerFactory
 func NewHistorySerializerFactory() HistorySerializerFactory {
@@ -128,6 +224,22 @@ func NewHistorySerializerFactory() HistorySerializerFactory {
 	}
 }
 
+// NewCompressedHistorySerializerFactory creates and returns an instance
+// of HistorySerializerFactory whose serializers compress every batch
+// serialized into threshold bytes or more.  Serializers of every
+// factory deserialize both compressed and uncompressed history.
+func NewCompressedHistorySerializerFactory(compression HistoryCompression, threshold int) (HistorySerializerFactory,
+	error) {
+	jsonSerializer, err := NewCompressedJSONHistorySerializer(compression, threshold)
+	if err != nil {
+		return nil, err
+	}
+
+	return &serializerFactoryImpl{
+		jsonSerializer: jsonSerializer,
+	}, nil
+}
+
 // Get returns the serializer corresponding to the given encoding type
 func (f *serializerFactoryImpl) Get(encodingType common.EncodingType) (HistorySerializer, error) {
 	switch encodingType {
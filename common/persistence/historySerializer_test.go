@@ -27,6 +27,7 @@ import (
 	"github.com/uber-common/bark"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -134,3 +135,58 @@ func (s *historySerializerSuite) TestSerializerFactory() {
 	succ := common.AwaitWaitGroup(&doneWG, 10*time.Second)
 	s.True(succ, "test timed out")
 }
+
+func (s *historySerializerSuite) TestCompression() {
+	event1 := &workflow.HistoryEvent{
+		EventId:   common.Int64Ptr(999),
+		Timestamp: common.Int64Ptr(time.Now().UnixNano()),
+		EventType: common.EventTypePtr(workflow.EventType_ActivityTaskCompleted),
+		ActivityTaskCompletedEventAttributes: &workflow.ActivityTaskCompletedEventAttributes{
+			Result_:          []byte(strings.Repeat("result-1-event-1", 100)),
+			ScheduledEventId: common.Int64Ptr(4),
+			StartedEventId:   common.Int64Ptr(5),
+			Identity:         common.StringPtr("event-1"),
+		},
+	}
+	eventBatch := NewHistoryEventBatch(1, []*workflow.HistoryEvent{event1})
+
+	uncompressed, err := NewJSONHistorySerializer().Serialize(eventBatch)
+	s.Nil(err)
+
+	_, err = NewCompressedHistorySerializerFactory("lz4", 0)
+	s.NotNil(err)
+
+	for _, compression := range []HistoryCompression{HistoryCompressionGzip, HistoryCompressionSnappy} {
+		factory, err := NewCompressedHistorySerializerFactory(compression, 0)
+		s.Nil(err)
+		serializer, err := factory.Get(common.EncodingTypeJSON)
+		s.Nil(err)
+
+		sh, err := serializer.Serialize(eventBatch)
+		s.Nil(err)
+		s.Equal(common.EncodingTypeJSON, sh.EncodingType)
+		s.Equal(historyCompressionMagic, sh.Data[0])
+		s.True(len(sh.Data) < len(uncompressed.Data))
+
+		// compressed history deserializes whether or not the reader compresses
+		for _, reader := range []HistorySerializer{serializer, NewJSONHistorySerializer()} {
+			dh, err := reader.Deserialize(sh)
+			s.Nil(err)
+			s.Equal(1, len(dh.Events))
+			s.Equal(event1.GetActivityTaskCompletedEventAttributes().GetResult_(),
+				dh.Events[0].GetActivityTaskCompletedEventAttributes().GetResult_())
+		}
+
+		// history written before compression was enabled still deserializes
+		dh, err := serializer.Deserialize(uncompressed)
+		s.Nil(err)
+		s.Equal(event1.GetEventId(), dh.Events[0].GetEventId())
+	}
+
+	// batches smaller than the threshold are not compressed
+	serializer, err := NewCompressedJSONHistorySerializer(HistoryCompressionGzip, len(uncompressed.Data)+1)
+	s.Nil(err)
+	sh, err := serializer.Serialize(eventBatch)
+	s.Nil(err)
+	s.Equal(uncompressed.Data, sh.Data)
+}
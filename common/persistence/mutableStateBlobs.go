@@ -0,0 +1,127 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/persistenceblobs"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+// The entries of the activity, timer and child execution maps of the mutable state are stored as thrift serialized
+// blobs, see persistenceblobs.thrift
+
+func serializeActivityInfo(info *ActivityInfo) ([]byte, error) {
+	return common.TSerialize(&persistenceblobs.ActivityInfo{
+		ScheduleId:                    common.Int64Ptr(info.ScheduleID),
+		ScheduledEvent:                info.ScheduledEvent,
+		StartedId:                     common.Int64Ptr(info.StartedID),
+		StartedEvent:                  info.StartedEvent,
+		ActivityId:                    common.StringPtr(info.ActivityID),
+		RequestId:                     common.StringPtr(info.RequestID),
+		Details:                       info.Details,
+		ScheduleToStartTimeoutSeconds: common.Int32Ptr(info.ScheduleToStartTimeout),
+		ScheduleToCloseTimeoutSeconds: common.Int32Ptr(info.ScheduleToCloseTimeout),
+		StartToCloseTimeoutSeconds:    common.Int32Ptr(info.StartToCloseTimeout),
+		HeartbeatTimeoutSeconds:       common.Int32Ptr(info.HeartbeatTimeout),
+		CancelRequested:               common.BoolPtr(info.CancelRequested),
+		CancelRequestId:               common.Int64Ptr(info.CancelRequestID),
+		LastHeartbeatUpdatedTimeNanos: common.Int64Ptr(info.LastHeartBeatUpdatedTime.UnixNano()),
+	})
+}
+
+func deserializeActivityInfo(data []byte) (*ActivityInfo, error) {
+	blob := &persistenceblobs.ActivityInfo{}
+	if err := common.TDeserialize(blob, data); err != nil {
+		return nil, err
+	}
+	return &ActivityInfo{
+		ScheduleID:               blob.GetScheduleId(),
+		ScheduledEvent:           blob.GetScheduledEvent(),
+		StartedID:                blob.GetStartedId(),
+		StartedEvent:             blob.GetStartedEvent(),
+		ActivityID:               blob.GetActivityId(),
+		RequestID:                blob.GetRequestId(),
+		Details:                  blob.GetDetails(),
+		ScheduleToStartTimeout:   blob.GetScheduleToStartTimeoutSeconds(),
+		ScheduleToCloseTimeout:   blob.GetScheduleToCloseTimeoutSeconds(),
+		StartToCloseTimeout:      blob.GetStartToCloseTimeoutSeconds(),
+		HeartbeatTimeout:         blob.GetHeartbeatTimeoutSeconds(),
+		CancelRequested:          blob.GetCancelRequested(),
+		CancelRequestID:          blob.GetCancelRequestId(),
+		LastHeartBeatUpdatedTime: time.Unix(0, blob.GetLastHeartbeatUpdatedTimeNanos()),
+	}, nil
+}
+
+func serializeTimerInfo(info *TimerInfo) ([]byte, error) {
+	return common.TSerialize(&persistenceblobs.TimerInfo{
+		TimerId:         common.StringPtr(info.TimerID),
+		StartedId:       common.Int64Ptr(info.StartedID),
+		ExpiryTimeNanos: common.Int64Ptr(info.ExpiryTime.UnixNano()),
+		TaskId:          common.Int64Ptr(info.TaskID),
+	})
+}
+
+func deserializeTimerInfo(data []byte) (*TimerInfo, error) {
+	blob := &persistenceblobs.TimerInfo{}
+	if err := common.TDeserialize(blob, data); err != nil {
+		return nil, err
+	}
+	return &TimerInfo{
+		TimerID:    blob.GetTimerId(),
+		StartedID:  blob.GetStartedId(),
+		ExpiryTime: time.Unix(0, blob.GetExpiryTimeNanos()),
+		TaskID:     blob.GetTaskId(),
+	}, nil
+}
+
+func serializeChildExecutionInfo(info *ChildExecutionInfo) ([]byte, error) {
+	return common.TSerialize(&persistenceblobs.ChildExecutionInfo{
+		InitiatedId:     common.Int64Ptr(info.InitiatedID),
+		InitiatedEvent:  info.InitiatedEvent,
+		StartedId:       common.Int64Ptr(info.StartedID),
+		StartedEvent:    info.StartedEvent,
+		CreateRequestId: common.StringPtr(info.CreateRequestID),
+	})
+}
+
+func deserializeChildExecutionInfo(data []byte) (*ChildExecutionInfo, error) {
+	blob := &persistenceblobs.ChildExecutionInfo{}
+	if err := common.TDeserialize(blob, data); err != nil {
+		return nil, err
+	}
+	return &ChildExecutionInfo{
+		InitiatedID:     blob.GetInitiatedId(),
+		InitiatedEvent:  blob.GetInitiatedEvent(),
+		StartedID:       blob.GetStartedId(),
+		StartedEvent:    blob.GetStartedEvent(),
+		CreateRequestID: blob.GetCreateRequestId(),
+	}, nil
+}
+
+func newMutableStateBlobError(entry string, err error) error {
+	return &workflow.InternalServiceError{
+		Message: fmt.Sprintf("Failed to encode %v of mutable state. Error: %v", entry, err),
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common"
+)
+
+type (
+	mutableStateBlobsSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+	}
+)
+
+func TestMutableStateBlobsSuite(t *testing.T) {
+	s := new(mutableStateBlobsSuite)
+	suite.Run(t, s)
+}
+
+func (s *mutableStateBlobsSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *mutableStateBlobsSuite) TestActivityInfo() {
+	info := &ActivityInfo{
+		ScheduleID:               5,
+		ScheduledEvent:           []byte("scheduled event"),
+		StartedID:                6,
+		StartedEvent:             []byte("started event"),
+		ActivityID:               "activity",
+		RequestID:                "request",
+		Details:                  []byte("details"),
+		ScheduleToStartTimeout:   1,
+		ScheduleToCloseTimeout:   2,
+		StartToCloseTimeout:      3,
+		HeartbeatTimeout:         4,
+		CancelRequested:          true,
+		CancelRequestID:          7,
+		LastHeartBeatUpdatedTime: time.Unix(0, 1234567890123),
+	}
+
+	blob, err := serializeActivityInfo(info)
+	s.Nil(err)
+	decoded, err := deserializeActivityInfo(blob)
+	s.Nil(err)
+	s.True(info.LastHeartBeatUpdatedTime.Equal(decoded.LastHeartBeatUpdatedTime))
+	decoded.LastHeartBeatUpdatedTime = info.LastHeartBeatUpdatedTime
+	s.Equal(info, decoded)
+}
+
+func (s *mutableStateBlobsSuite) TestTimerInfo() {
+	info := &TimerInfo{
+		TimerID:    "timer",
+		StartedID:  5,
+		ExpiryTime: time.Unix(0, 1234567890123),
+		TaskID:     6,
+	}
+
+	blob, err := serializeTimerInfo(info)
+	s.Nil(err)
+	decoded, err := deserializeTimerInfo(blob)
+	s.Nil(err)
+	s.True(info.ExpiryTime.Equal(decoded.ExpiryTime))
+	decoded.ExpiryTime = info.ExpiryTime
+	s.Equal(info, decoded)
+}
+
+func (s *mutableStateBlobsSuite) TestChildExecutionInfo() {
+	info := &ChildExecutionInfo{
+		InitiatedID:     5,
+		InitiatedEvent:  []byte("initiated event"),
+		StartedID:       common.EmptyEventID,
+		CreateRequestID: "request",
+	}
+
+	blob, err := serializeChildExecutionInfo(info)
+	s.Nil(err)
+	decoded, err := deserializeChildExecutionInfo(blob)
+	s.Nil(err)
+	s.Equal(info, decoded)
+}
+
+func (s *mutableStateBlobsSuite) TestCorruptBlob() {
+	_, err := deserializeTimerInfo([]byte{0xff, 0x01})
+	s.NotNil(err)
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+// NewMutableStateUpdateSessionStats returns the statistics of the mutable state written by an update
+func NewMutableStateUpdateSessionStats(request *UpdateWorkflowExecutionRequest) *MutableStateUpdateSessionStats {
+	stats := &MutableStateUpdateSessionStats{
+		ExecutionInfoSize:  executionInfoSize(request.ExecutionInfo),
+		ActivityInfoCount:  len(request.UpsertActivityInfos),
+		TimerInfoCount:     len(request.UpserTimerInfos),
+		ChildInfoCount:     len(request.UpsertChildExecutionInfos),
+		TransferTasksCount: len(request.TransferTasks),
+		TimerTasksCount:    len(request.TimerTasks),
+	}
+
+	for _, ai := range request.UpsertActivityInfos {
+		stats.ActivityInfoSize += len(ai.ScheduledEvent) + len(ai.StartedEvent) + len(ai.ActivityID) +
+			len(ai.RequestID) + len(ai.Details)
+	}
+	for _, ti := range request.UpserTimerInfos {
+		stats.TimerInfoSize += len(ti.TimerID)
+	}
+	for _, ci := range request.UpsertChildExecutionInfos {
+		stats.ChildInfoSize += len(ci.InitiatedEvent) + len(ci.StartedEvent) + len(ci.CreateRequestID)
+	}
+
+	if request.DeleteActivityInfo != nil {
+		stats.DeleteActivityInfoCount = 1
+	}
+	stats.DeleteTimerInfoCount = len(request.DeleteTimerInfos)
+	if request.DeleteChildExecutionInfo != nil {
+		stats.DeleteChildInfoCount = 1
+	}
+
+	stats.MutableStateSize = stats.ExecutionInfoSize + stats.ActivityInfoSize + stats.TimerInfoSize +
+		stats.ChildInfoSize
+	return stats
+}
+
+func executionInfoSize(info *WorkflowExecutionInfo) int {
+	if info == nil {
+		return 0
+	}
+	size := len(info.DomainID) + len(info.WorkflowID) + len(info.RunID) + len(info.ParentDomainID) +
+		len(info.ParentWorkflowID) + len(info.ParentRunID) + len(info.CompletionEvent) + len(info.TaskList) +
+		len(info.WorkflowTypeName) + len(info.ExecutionContext) + len(info.CreateRequestID) +
+		len(info.DecisionRequestID) + len(info.CompletionCallbackURL) + len(info.FirstExecutionRunID) +
+		len(info.TraceID)
+	for key, value := range info.SearchAttributes {
+		size += len(key) + len(value)
+	}
+	return size
+}
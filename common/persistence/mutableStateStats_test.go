@@ -0,0 +1,89 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common"
+)
+
+type (
+	mutableStateStatsSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+	}
+)
+
+func TestMutableStateStatsSuite(t *testing.T) {
+	s := new(mutableStateStatsSuite)
+	suite.Run(t, s)
+}
+
+func (s *mutableStateStatsSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *mutableStateStatsSuite) TestUpdateSessionStats() {
+	stats := NewMutableStateUpdateSessionStats(&UpdateWorkflowExecutionRequest{
+		ExecutionInfo: &WorkflowExecutionInfo{
+			DomainID:         "domain",
+			WorkflowID:       "workflow",
+			RunID:            "run",
+			ExecutionContext: []byte("context"),
+		},
+		TransferTasks: []Task{&DecisionTask{}, &ActivityTask{}},
+		TimerTasks:    []Task{&UserTimerTask{}},
+		UpsertActivityInfos: []*ActivityInfo{{
+			ScheduledEvent: []byte("scheduled"),
+			ActivityID:     "activity",
+			Details:        []byte("details"),
+		}},
+		DeleteActivityInfo: common.Int64Ptr(5),
+		UpserTimerInfos:    []*TimerInfo{{TimerID: "timer1"}, {TimerID: "timer2"}},
+		DeleteTimerInfos:   []string{"timer3"},
+		UpsertChildExecutionInfos: []*ChildExecutionInfo{{
+			InitiatedEvent:  []byte("initiated"),
+			CreateRequestID: "request",
+		}},
+	})
+
+	s.Equal(len("domain")+len("workflow")+len("run")+len("context"), stats.ExecutionInfoSize)
+	s.Equal(len("scheduled")+len("activity")+len("details"), stats.ActivityInfoSize)
+	s.Equal(len("timer1")+len("timer2"), stats.TimerInfoSize)
+	s.Equal(len("initiated")+len("request"), stats.ChildInfoSize)
+	s.Equal(stats.ExecutionInfoSize+stats.ActivityInfoSize+stats.TimerInfoSize+stats.ChildInfoSize,
+		stats.MutableStateSize)
+
+	s.Equal(1, stats.ActivityInfoCount)
+	s.Equal(2, stats.TimerInfoCount)
+	s.Equal(1, stats.ChildInfoCount)
+	s.Equal(1, stats.DeleteActivityInfoCount)
+	s.Equal(1, stats.DeleteTimerInfoCount)
+	s.Equal(0, stats.DeleteChildInfoCount)
+	s.Equal(2, stats.TransferTasksCount)
+	s.Equal(1, stats.TimerTasksCount)
+}
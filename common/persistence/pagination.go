@@ -0,0 +1,93 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"encoding/binary"
+	"hash/crc32"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+)
+
+const (
+	// DefaultPageSize is the number of records returned by a list/scan API when the caller does not set a page size
+	DefaultPageSize = 100
+	// MaxPageSize is the server enforced upper bound on the number of records returned by a single list/scan API
+	MaxPageSize = 1000
+
+	pageTokenVersion    = byte(1)
+	pageTokenHeaderSize = 5 // version byte followed by the crc32 checksum of the page state
+)
+
+var (
+	// ErrInvalidPageToken is returned when a next page token is malformed or was corrupted.  The checksum only detects
+	// accidental corruption, it does not authenticate the token and offers no protection against a forged one.
+	ErrInvalidPageToken = &workflow.BadRequestError{Message: "Invalid next page token."}
+)
+
+// getPageSize applies the default and maximum page size to the size requested by the caller
+func getPageSize(requested int) int {
+	if requested <= 0 {
+		return DefaultPageSize
+	}
+	if requested > MaxPageSize {
+		return MaxPageSize
+	}
+	return requested
+}
+
+// serializePageToken wraps the page state returned by the store into an opaque token which carries a checksum to
+// detect corruption, the token is not signed.
+// An empty page state means there are no more pages and results in an empty token.
+func serializePageToken(pageState []byte) []byte {
+	if len(pageState) == 0 {
+		return []byte{}
+	}
+
+	token := make([]byte, pageTokenHeaderSize+len(pageState))
+	token[0] = pageTokenVersion
+	binary.BigEndian.PutUint32(token[1:pageTokenHeaderSize], crc32.ChecksumIEEE(pageState))
+	copy(token[pageTokenHeaderSize:], pageState)
+	return token
+}
+
+// deserializePageToken validates a token created by serializePageToken and returns the page state it wraps.
+// The tokens returned before page tokens were versioned are the raw page state of the store, those which do not start
+// with the version byte are returned as is so that the pages listed across an upgrade can still be read.  They are
+// accepted until the next release, which only returns versioned tokens.
+func deserializePageToken(token []byte) ([]byte, error) {
+	if len(token) == 0 {
+		return nil, nil
+	}
+
+	if token[0] != pageTokenVersion {
+		return token, nil
+	}
+	if len(token) <= pageTokenHeaderSize {
+		return nil, ErrInvalidPageToken
+	}
+
+	pageState := token[pageTokenHeaderSize:]
+	if binary.BigEndian.Uint32(token[1:pageTokenHeaderSize]) != crc32.ChecksumIEEE(pageState) {
+		return nil, ErrInvalidPageToken
+	}
+	return pageState, nil
+}
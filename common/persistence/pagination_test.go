@@ -0,0 +1,105 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	paginationSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+	}
+)
+
+func TestPaginationSuite(t *testing.T) {
+	s := new(paginationSuite)
+	suite.Run(t, s)
+}
+
+func (s *paginationSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *paginationSuite) TestGetPageSize() {
+	s.Equal(DefaultPageSize, getPageSize(0))
+	s.Equal(DefaultPageSize, getPageSize(-1))
+	s.Equal(10, getPageSize(10))
+	s.Equal(MaxPageSize, getPageSize(MaxPageSize))
+	s.Equal(MaxPageSize, getPageSize(MaxPageSize+1))
+}
+
+func (s *paginationSuite) TestPageTokenRoundTrip() {
+	pageState := []byte("cassandra-page-state")
+	token := serializePageToken(pageState)
+	s.NotEqual(pageState, token)
+
+	decoded, err := deserializePageToken(token)
+	s.NoError(err)
+	s.Equal(pageState, decoded)
+}
+
+func (s *paginationSuite) TestPageTokenEmpty() {
+	s.Empty(serializePageToken(nil))
+	s.Empty(serializePageToken([]byte{}))
+
+	decoded, err := deserializePageToken(nil)
+	s.NoError(err)
+	s.Nil(decoded)
+
+	decoded, err = deserializePageToken([]byte{})
+	s.NoError(err)
+	s.Nil(decoded)
+}
+
+func (s *paginationSuite) TestPageTokenInvalid() {
+	token := serializePageToken([]byte("cassandra-page-state"))
+
+	corrupted := make([]byte, len(token))
+	copy(corrupted, token)
+	corrupted[len(corrupted)-1]++
+	_, err := deserializePageToken(corrupted)
+	s.Equal(ErrInvalidPageToken, err)
+
+	_, err = deserializePageToken(token[:pageTokenHeaderSize])
+	s.Equal(ErrInvalidPageToken, err)
+
+	_, err = deserializePageToken([]byte{pageTokenVersion})
+	s.Equal(ErrInvalidPageToken, err)
+}
+
+func (s *paginationSuite) TestPageTokenLegacy() {
+	// the tokens returned before page tokens were versioned are the raw page state of the store
+	decoded, err := deserializePageToken([]byte("cassandra-page-state"))
+	s.NoError(err)
+	s.Equal([]byte("cassandra-page-state"), decoded)
+
+	sqlPageState := []byte{0, 0, 0, 0, 0, 0, 0, 42}
+	decoded, err = deserializePageToken(sqlPageState)
+	s.NoError(err)
+	s.Equal(sqlPageState, decoded)
+}
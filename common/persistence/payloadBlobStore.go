@@ -0,0 +1,94 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/uber/cadence/common/blobstore"
+)
+
+type (
+	// PayloadBlobStore offloads the workflow payloads larger than a threshold to a blob store.  Only a reference to
+	// the blob is kept in the persistence datastore in place of the payload.
+	PayloadBlobStore struct {
+		client    blobstore.Client
+		threshold int
+	}
+)
+
+// Payloads kept in the blob store are replaced by payloadBlobMagic followed by the key of the blob.  Neither encoded
+// nor unencoded payloads start with payloadBlobMagic.
+const payloadBlobMagic byte = 0xc9
+
+var (
+	errPayloadBlobStoreMissing = errors.New("payload is kept in a blob store but no blob store is configured")
+)
+
+// NewPayloadBlobStore creates a PayloadBlobStore offloading the payloads larger than threshold bytes to the client
+func NewPayloadBlobStore(client blobstore.Client, threshold int) *PayloadBlobStore {
+	return &PayloadBlobStore{
+		client:    client,
+		threshold: threshold,
+	}
+}
+
+// offloadPayload uploads the payload of the workflow execution to the blob store if it is larger than the
+// threshold, and returns the reference to keep in its place.  Smaller payloads are returned unchanged.  The key of
+// the blob is derived from the content of the payload, so retried writes upload the same blob.
+func offloadPayload(blobs *PayloadBlobStore, domainID string, runID string, data []byte) ([]byte, error) {
+	if blobs == nil || len(data) <= blobs.threshold {
+		return data, nil
+	}
+
+	sum := sha256.Sum256(data)
+	key := fmt.Sprintf("%v/%v", payloadBlobPrefix(domainID, runID), hex.EncodeToString(sum[:]))
+	if err := blobs.client.Upload(key, data); err != nil {
+		return nil, err
+	}
+	return append([]byte{payloadBlobMagic}, key...), nil
+}
+
+// resolvePayload downloads the payload referenced by data from the blob store, other payloads are returned unchanged
+func resolvePayload(blobs *PayloadBlobStore, data []byte) ([]byte, error) {
+	if len(data) == 0 || data[0] != payloadBlobMagic {
+		return data, nil
+	}
+	if blobs == nil {
+		return nil, errPayloadBlobStoreMissing
+	}
+	return blobs.client.Download(string(data[1:]))
+}
+
+// deleteExecutionPayloads deletes the payloads of the workflow execution kept in the blob store
+func deleteExecutionPayloads(blobs *PayloadBlobStore, domainID string, runID string) error {
+	if blobs == nil {
+		return nil
+	}
+	return blobs.client.DeleteAll(payloadBlobPrefix(domainID, runID))
+}
+
+func payloadBlobPrefix(domainID string, runID string) string {
+	return fmt.Sprintf("%v/%v", domainID, runID)
+}
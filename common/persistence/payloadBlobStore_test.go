@@ -0,0 +1,130 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore"
+)
+
+type (
+	payloadBlobStoreSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+		dir   string
+		blobs *PayloadBlobStore
+	}
+)
+
+func TestPayloadBlobStoreSuite(t *testing.T) {
+	s := new(payloadBlobStoreSuite)
+	suite.Run(t, s)
+}
+
+func (s *payloadBlobStoreSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	var err error
+	s.dir, err = ioutil.TempDir("", "payloadBlobStore")
+	s.Nil(err)
+	client, err := blobstore.NewFilesystemClient(s.dir)
+	s.Nil(err)
+	s.blobs = NewPayloadBlobStore(client, 16)
+}
+
+func (s *payloadBlobStoreSuite) TearDownTest() {
+	os.RemoveAll(s.dir)
+}
+
+func (s *payloadBlobStoreSuite) TestSmallPayload() {
+	payload := []byte(`{"eventId":1}`)
+	offloaded, err := offloadPayload(s.blobs, "domain", "run", payload)
+	s.Nil(err)
+	s.Equal(payload, offloaded)
+
+	resolved, err := resolvePayload(s.blobs, offloaded)
+	s.Nil(err)
+	s.Equal(payload, resolved)
+}
+
+func (s *payloadBlobStoreSuite) TestLargePayload() {
+	payload := []byte(`[{"eventId":1,"input":"large activity input"}]`)
+	offloaded, err := offloadPayload(s.blobs, "domain", "run", payload)
+	s.Nil(err)
+	s.Equal(payloadBlobMagic, offloaded[0])
+	s.NotContains(string(offloaded), "eventId")
+
+	resolved, err := resolvePayload(s.blobs, offloaded)
+	s.Nil(err)
+	s.Equal(payload, resolved)
+
+	_, err = resolvePayload(nil, offloaded)
+	s.Equal(errPayloadBlobStoreMissing, err)
+
+	s.Nil(deleteExecutionPayloads(s.blobs, "domain", "run"))
+	_, err = resolvePayload(s.blobs, offloaded)
+	s.Equal(blobstore.ErrBlobNotFound, err)
+}
+
+func (s *payloadBlobStoreSuite) TestEncodeAndOffload() {
+	codec, err := NewPayloadCodec(PayloadCodecAESGCM, map[string]string{"key": "000102030405060708090a0b0c0d0e0f"})
+	s.Nil(err)
+
+	payload := []byte(`[{"eventId":1,"input":"large activity input"}]`)
+	offloaded, err := encodeAndOffload(codec, s.blobs, "domain", "run", payload)
+	s.Nil(err)
+	s.Equal(payloadBlobMagic, offloaded[0])
+
+	blob, err := resolvePayload(s.blobs, offloaded)
+	s.Nil(err)
+	s.Equal(payloadCodecMagic, blob[0])
+
+	decoded, err := resolveAndDecode(codec, s.blobs, offloaded)
+	s.Nil(err)
+	s.Equal(payload, decoded)
+
+	s.Nil(deleteExecutionPayloads(s.blobs, "domain", "run"))
+	_, err = resolveAndDecode(codec, s.blobs, offloaded)
+	s.IsType(&CorruptionError{}, err)
+}
+
+func (s *payloadBlobStoreSuite) TestHistoryPayloadClient() {
+	history := &historyPayloadClient{blobs: s.blobs}
+	request := &AppendHistoryEventsRequest{
+		DomainID:  "domain",
+		Execution: workflow.WorkflowExecution{RunId: common.StringPtr("run")},
+		Events:    &SerializedHistoryEventBatch{Data: []byte(`[{"eventId":1,"input":"large activity input"}]`)},
+	}
+	encoded, err := history.encodeAppendRequest(request)
+	s.Nil(err)
+	s.Equal(payloadBlobMagic, encoded.Events.Data[0])
+	s.Equal(byte('['), request.Events.Data[0])
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+)
+
+type (
+	// PayloadCodec encodes the workflow payloads written to persistence, e.g. to encrypt them at rest with keys of
+	// an external key management service.  Decode must accept everything returned by Encode, including data
+	// encoded with keys which have been rotated since.
+	PayloadCodec interface {
+		Encode(data []byte) ([]byte, error)
+		Decode(data []byte) ([]byte, error)
+	}
+
+	// PayloadCodecFactory creates a PayloadCodec from the options of its configuration
+	PayloadCodecFactory func(options map[string]string) (PayloadCodec, error)
+
+	aesGCMPayloadCodec struct {
+		aead cipher.AEAD
+	}
+)
+
+// PayloadCodecAESGCM is the name of the built-in codec which encrypts payloads with AES-GCM.  Its key option is the
+// hex encoded 16, 24 or 32 byte key.
+const PayloadCodecAESGCM = "aes-gcm"
+
+// Encoded payloads start with payloadCodecMagic.  Payloads written before a codec was configured are JSON or
+// compressed history, neither of which starts with payloadCodecMagic, so they are still read back unchanged.
+const payloadCodecMagic byte = 0xc8
+
+var (
+	payloadCodecLock      sync.RWMutex
+	payloadCodecFactories = map[string]PayloadCodecFactory{
+		PayloadCodecAESGCM: newAESGCMPayloadCodec,
+	}
+
+	errPayloadCodecMissing = errors.New("payload is encoded but no payload codec is configured")
+)
+
+// RegisterPayloadCodec makes a payload codec available to the configuration under the given name.  Operators plug
+// in their own key management by registering a codec from an init function of their server binary.
+func RegisterPayloadCodec(name string, factory PayloadCodecFactory) {
+	payloadCodecLock.Lock()
+	defer payloadCodecLock.Unlock()
+	payloadCodecFactories[name] = factory
+}
+
+// NewPayloadCodec creates the registered payload codec with the given name
+func NewPayloadCodec(name string, options map[string]string) (PayloadCodec, error) {
+	payloadCodecLock.RLock()
+	factory, ok := payloadCodecFactories[name]
+	payloadCodecLock.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown payload codec %v", name)
+	}
+	return factory(options)
+}
+
+// encodePayload encodes the payload with the codec, empty payloads are left as they are, as are all payloads if
+// there is no codec
+func encodePayload(codec PayloadCodec, data []byte) ([]byte, error) {
+	if len(data) == 0 || codec == nil {
+		return data, nil
+	}
+
+	encoded, err := codec.Encode(data)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{payloadCodecMagic}, encoded...), nil
+}
+
+// decodePayload returns payloads which were written without a codec unchanged
+func decodePayload(codec PayloadCodec, data []byte) ([]byte, error) {
+	if len(data) == 0 || data[0] != payloadCodecMagic {
+		return data, nil
+	}
+	if codec == nil {
+		return nil, errPayloadCodecMissing
+	}
+	return codec.Decode(data[1:])
+}
+
+func newAESGCMPayloadCodec(options map[string]string) (PayloadCodec, error) {
+	key, err := hex.DecodeString(options["key"])
+	if err != nil {
+		return nil, fmt.Errorf("invalid %v payload codec key: %v", PayloadCodecAESGCM, err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %v payload codec key: %v", PayloadCodecAESGCM, err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &aesGCMPayloadCodec{aead: aead}, nil
+}
+
+func (c *aesGCMPayloadCodec) Encode(data []byte) ([]byte, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return c.aead.Seal(nonce, nonce, data, nil), nil
+}
+
+func (c *aesGCMPayloadCodec) Decode(data []byte) ([]byte, error) {
+	nonceSize := c.aead.NonceSize()
+	if len(data) < nonceSize {
+		return nil, errors.New("encrypted payload is too short")
+	}
+	return c.aead.Open(nil, data[:nonceSize], data[nonceSize:], nil)
+}
@@ -0,0 +1,441 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/blobstore"
+)
+
+type (
+	workflowExecutionPayloadClient struct {
+		codec       PayloadCodec
+		blobs       *PayloadBlobStore
+		persistence ExecutionManager
+	}
+
+	historyPayloadClient struct {
+		codec       PayloadCodec
+		blobs       *PayloadBlobStore
+		persistence HistoryManager
+	}
+)
+
+var _ ExecutionManager = (*workflowExecutionPayloadClient)(nil)
+var _ HistoryManager = (*historyPayloadClient)(nil)
+
+// NewWorkflowExecutionPayloadClient creates a client to manage executions which encodes the events and execution
+// context kept in mutable state with the codec, and offloads the large ones to the blob store.  Either of the codec
+// and the blob store may be nil.  The requests of the caller are not modified.
+func NewWorkflowExecutionPayloadClient(persistence ExecutionManager, codec PayloadCodec,
+	blobs *PayloadBlobStore) ExecutionManager {
+	return &workflowExecutionPayloadClient{
+		codec:       codec,
+		blobs:       blobs,
+		persistence: persistence,
+	}
+}
+
+// NewHistoryPayloadClient creates a HistoryManager client which encodes the serialized history with the codec, and
+// offloads the large batches of events to the blob store.  Either of the codec and the blob store may be nil.
+func NewHistoryPayloadClient(persistence HistoryManager, codec PayloadCodec, blobs *PayloadBlobStore) HistoryManager {
+	return &historyPayloadClient{
+		codec:       codec,
+		blobs:       blobs,
+		persistence: persistence,
+	}
+}
+
+func (p *workflowExecutionPayloadClient) CreateWorkflowExecution(request *CreateWorkflowExecutionRequest) (
+	*CreateWorkflowExecutionResponse, error) {
+	encoded, err := p.encodeCreateRequest(request)
+	if err != nil {
+		return nil, err
+	}
+	return p.persistence.CreateWorkflowExecution(encoded)
+}
+
+func (p *workflowExecutionPayloadClient) GetWorkflowExecution(request *GetWorkflowExecutionRequest) (
+	*GetWorkflowExecutionResponse, error) {
+	response, err := p.persistence.GetWorkflowExecution(request)
+	if err != nil {
+		return nil, err
+	}
+
+	state := response.State
+	if state.ExecutionInfo != nil {
+		if err := p.decodeExecutionInfo(state.ExecutionInfo); err != nil {
+			return nil, err
+		}
+	}
+	for _, ai := range state.ActivitInfos {
+		if ai.ScheduledEvent, err = p.decode(ai.ScheduledEvent); err != nil {
+			return nil, err
+		}
+		if ai.StartedEvent, err = p.decode(ai.StartedEvent); err != nil {
+			return nil, err
+		}
+		if ai.Details, err = p.decode(ai.Details); err != nil {
+			return nil, err
+		}
+	}
+	for _, ci := range state.ChildExecutionInfos {
+		if ci.InitiatedEvent, err = p.decode(ci.InitiatedEvent); err != nil {
+			return nil, err
+		}
+		if ci.StartedEvent, err = p.decode(ci.StartedEvent); err != nil {
+			return nil, err
+		}
+	}
+
+	return response, nil
+}
+
+func (p *workflowExecutionPayloadClient) UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) (
+	*UpdateWorkflowExecutionResponse, error) {
+	encoded := *request
+	var err error
+
+	var domainID, runID string
+	if request.ExecutionInfo != nil {
+		domainID, runID = request.ExecutionInfo.DomainID, request.ExecutionInfo.RunID
+		if encoded.ExecutionInfo, err = p.encodeExecutionInfo(request.ExecutionInfo); err != nil {
+			return nil, err
+		}
+	}
+	if request.ContinueAsNew != nil {
+		if encoded.ContinueAsNew, err = p.encodeCreateRequest(request.ContinueAsNew); err != nil {
+			return nil, err
+		}
+	}
+
+	if encoded.UpsertActivityInfos, err = p.encodeActivityInfos(domainID, runID,
+		request.UpsertActivityInfos); err != nil {
+		return nil, err
+	}
+
+	encoded.UpsertChildExecutionInfos = make([]*ChildExecutionInfo, len(request.UpsertChildExecutionInfos))
+	for i, ci := range request.UpsertChildExecutionInfos {
+		copied := *ci
+		if copied.InitiatedEvent, err = p.encode(domainID, runID, ci.InitiatedEvent); err != nil {
+			return nil, err
+		}
+		if copied.StartedEvent, err = p.encode(domainID, runID, ci.StartedEvent); err != nil {
+			return nil, err
+		}
+		encoded.UpsertChildExecutionInfos[i] = &copied
+	}
+
+	return p.persistence.UpdateWorkflowExecution(&encoded)
+}
+
+func (p *workflowExecutionPayloadClient) DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error {
+	return p.persistence.DeleteWorkflowExecution(request)
+}
+
+func (p *workflowExecutionPayloadClient) GetCurrentExecution(request *GetCurrentExecutionRequest) (
+	*GetCurrentExecutionResponse, error) {
+	return p.persistence.GetCurrentExecution(request)
+}
+
+func (p *workflowExecutionPayloadClient) ListConcreteExecutions(request *ListConcreteExecutionsRequest) (
+	*ListConcreteExecutionsResponse, error) {
+	response, err := p.persistence.ListConcreteExecutions(request)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, info := range response.ExecutionInfos {
+		if err := p.decodeExecutionInfo(info); err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
+
+func (p *workflowExecutionPayloadClient) ListCurrentExecutions(request *ListCurrentExecutionsRequest) (
+	*ListCurrentExecutionsResponse, error) {
+	return p.persistence.ListCurrentExecutions(request)
+}
+
+func (p *workflowExecutionPayloadClient) DeleteCurrentWorkflowExecution(
+	request *DeleteCurrentWorkflowExecutionRequest) error {
+	return p.persistence.DeleteCurrentWorkflowExecution(request)
+}
+
+func (p *workflowExecutionPayloadClient) CreateCurrentWorkflowExecution(
+	request *CreateCurrentWorkflowExecutionRequest) error {
+	return p.persistence.CreateCurrentWorkflowExecution(request)
+}
+
+func (p *workflowExecutionPayloadClient) UpdateCurrentWorkflowExecution(
+	request *UpdateCurrentWorkflowExecutionRequest) error {
+	return p.persistence.UpdateCurrentWorkflowExecution(request)
+}
+
+func (p *workflowExecutionPayloadClient) GetTransferTasks(request *GetTransferTasksRequest) (
+	*GetTransferTasksResponse, error) {
+	return p.persistence.GetTransferTasks(request)
+}
+
+func (p *workflowExecutionPayloadClient) CompleteTransferTask(request *CompleteTransferTaskRequest) error {
+	return p.persistence.CompleteTransferTask(request)
+}
+
+func (p *workflowExecutionPayloadClient) RangeCompleteTransferTask(request *RangeCompleteTransferTaskRequest) error {
+	return p.persistence.RangeCompleteTransferTask(request)
+}
+
+func (p *workflowExecutionPayloadClient) GetTimerIndexTasks(request *GetTimerIndexTasksRequest) (
+	*GetTimerIndexTasksResponse, error) {
+	return p.persistence.GetTimerIndexTasks(request)
+}
+
+func (p *workflowExecutionPayloadClient) CompleteTimerTask(request *CompleteTimerTaskRequest) error {
+	return p.persistence.CompleteTimerTask(request)
+}
+
+func (p *workflowExecutionPayloadClient) RangeCompleteTimerTask(request *RangeCompleteTimerTaskRequest) error {
+	return p.persistence.RangeCompleteTimerTask(request)
+}
+
+func (p *workflowExecutionPayloadClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *workflowExecutionPayloadClient) encodeCreateRequest(request *CreateWorkflowExecutionRequest) (
+	*CreateWorkflowExecutionRequest, error) {
+	encoded := *request
+	var err error
+	if encoded.ExecutionContext, err = p.encode(request.DomainID, request.Execution.GetRunId(),
+		request.ExecutionContext); err != nil {
+		return nil, err
+	}
+	if encoded.ActivityInfos, err = p.encodeActivityInfos(request.DomainID, request.Execution.GetRunId(),
+		request.ActivityInfos); err != nil {
+		return nil, err
+	}
+	return &encoded, nil
+}
+
+func (p *workflowExecutionPayloadClient) encodeActivityInfos(domainID, runID string, infos []*ActivityInfo) (
+	[]*ActivityInfo, error) {
+	encoded := make([]*ActivityInfo, len(infos))
+	var err error
+	for i, ai := range infos {
+		copied := *ai
+		if copied.ScheduledEvent, err = p.encode(domainID, runID, ai.ScheduledEvent); err != nil {
+			return nil, err
+		}
+		if copied.StartedEvent, err = p.encode(domainID, runID, ai.StartedEvent); err != nil {
+			return nil, err
+		}
+		if copied.Details, err = p.encode(domainID, runID, ai.Details); err != nil {
+			return nil, err
+		}
+		encoded[i] = &copied
+	}
+	return encoded, nil
+}
+
+func (p *workflowExecutionPayloadClient) encodeExecutionInfo(info *WorkflowExecutionInfo) (*WorkflowExecutionInfo,
+	error) {
+	encoded := *info
+	var err error
+	if encoded.CompletionEvent, err = p.encode(info.DomainID, info.RunID, info.CompletionEvent); err != nil {
+		return nil, err
+	}
+	if encoded.ExecutionContext, err = p.encode(info.DomainID, info.RunID, info.ExecutionContext); err != nil {
+		return nil, err
+	}
+	return &encoded, nil
+}
+
+func (p *workflowExecutionPayloadClient) decodeExecutionInfo(info *WorkflowExecutionInfo) error {
+	var err error
+	if info.CompletionEvent, err = p.decode(info.CompletionEvent); err != nil {
+		return err
+	}
+	info.ExecutionContext, err = p.decode(info.ExecutionContext)
+	return err
+}
+
+func (p *workflowExecutionPayloadClient) encode(domainID string, runID string, data []byte) ([]byte, error) {
+	return encodeAndOffload(p.codec, p.blobs, domainID, runID, data)
+}
+
+func (p *workflowExecutionPayloadClient) decode(data []byte) ([]byte, error) {
+	return resolveAndDecode(p.codec, p.blobs, data)
+}
+
+func (p *historyPayloadClient) AppendHistoryEvents(request *AppendHistoryEventsRequest) error {
+	encoded, err := p.encodeAppendRequest(request)
+	if err != nil {
+		return err
+	}
+	return p.persistence.AppendHistoryEvents(encoded)
+}
+
+func (p *historyPayloadClient) AppendHistoryEventsBatch(request *AppendHistoryEventsBatchRequest) error {
+	encoded := &AppendHistoryEventsBatchRequest{Requests: make([]*AppendHistoryEventsRequest, len(request.Requests))}
+	for i, r := range request.Requests {
+		var err error
+		if encoded.Requests[i], err = p.encodeAppendRequest(r); err != nil {
+			return err
+		}
+	}
+	return p.persistence.AppendHistoryEventsBatch(encoded)
+}
+
+func (p *historyPayloadClient) GetWorkflowExecutionHistory(request *GetWorkflowExecutionHistoryRequest) (
+	*GetWorkflowExecutionHistoryResponse, error) {
+	response, err := p.persistence.GetWorkflowExecutionHistory(request)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range response.Events {
+		if response.Events[i].Data, err = resolveAndDecode(p.codec, p.blobs, response.Events[i].Data); err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
+
+func (p *historyPayloadClient) DeleteWorkflowExecutionHistory(request *DeleteWorkflowExecutionHistoryRequest) error {
+	if err := p.persistence.DeleteWorkflowExecutionHistory(request); err != nil {
+		return err
+	}
+
+	// The history is deleted once the retention period of the closed execution is over, together with the payloads
+	// of both its history and its mutable state
+	if err := deleteExecutionPayloads(p.blobs, request.DomainID, request.Execution.GetRunId()); err != nil {
+		return &workflow.InternalServiceError{Message: "Failed to delete offloaded payloads. Error: " + err.Error()}
+	}
+	return nil
+}
+
+func (p *historyPayloadClient) AppendHistoryNodes(request *AppendHistoryNodesRequest) error {
+	encoded := *request
+	if request.Events != nil {
+		// The nodes of a history tree are shared by its branches and not deleted with an execution, so they are
+		// not offloaded to the blob store
+		events := *request.Events
+		data, err := encodeWithCodec(p.codec, events.Data)
+		if err != nil {
+			return err
+		}
+		events.Data = data
+		encoded.Events = &events
+	}
+	return p.persistence.AppendHistoryNodes(&encoded)
+}
+
+func (p *historyPayloadClient) ReadHistoryBranch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchResponse,
+	error) {
+	response, err := p.persistence.ReadHistoryBranch(request)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range response.Events {
+		if response.Events[i].Data, err = resolveAndDecode(p.codec, p.blobs, response.Events[i].Data); err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
+
+func (p *historyPayloadClient) ForkHistoryBranch(request *ForkHistoryBranchRequest) (*ForkHistoryBranchResponse,
+	error) {
+	return p.persistence.ForkHistoryBranch(request)
+}
+
+func (p *historyPayloadClient) DeleteHistoryBranch(request *DeleteHistoryBranchRequest) error {
+	return p.persistence.DeleteHistoryBranch(request)
+}
+
+func (p *historyPayloadClient) GetHistoryTree(request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error) {
+	return p.persistence.GetHistoryTree(request)
+}
+
+func (p *historyPayloadClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *historyPayloadClient) encodeAppendRequest(request *AppendHistoryEventsRequest) (*AppendHistoryEventsRequest,
+	error) {
+	encoded := *request
+	if request.Events != nil {
+		events := *request.Events
+		data, err := encodeAndOffload(p.codec, p.blobs, request.DomainID, request.Execution.GetRunId(), events.Data)
+		if err != nil {
+			return nil, err
+		}
+		events.Data = data
+		encoded.Events = &events
+	}
+	return &encoded, nil
+}
+
+// encodeAndOffload encodes the payload of the workflow execution, then offloads it to the blob store if it is large.
+// The blob store keeps the encoded payload, so it is no less protected than the datastore.
+func encodeAndOffload(codec PayloadCodec, blobs *PayloadBlobStore, domainID string, runID string, data []byte) (
+	[]byte, error) {
+	encoded, err := encodeWithCodec(codec, data)
+	if err != nil {
+		return nil, err
+	}
+	offloaded, err := offloadPayload(blobs, domainID, runID, encoded)
+	if err != nil {
+		return nil, &workflow.InternalServiceError{Message: "Failed to offload payload. Error: " + err.Error()}
+	}
+	return offloaded, nil
+}
+
+// resolveAndDecode downloads the payload from the blob store if it was offloaded, then decodes it
+func resolveAndDecode(codec PayloadCodec, blobs *PayloadBlobStore, data []byte) ([]byte, error) {
+	resolved, err := resolvePayload(blobs, data)
+	if err != nil {
+		if err == blobstore.ErrBlobNotFound || err == errPayloadBlobStoreMissing {
+			return nil, &CorruptionError{Msg: "Failed to resolve offloaded payload. Error: " + err.Error()}
+		}
+		return nil, &workflow.InternalServiceError{Message: "Failed to download offloaded payload. Error: " +
+			err.Error()}
+	}
+	return decodeWithCodec(codec, resolved)
+}
+
+func encodeWithCodec(codec PayloadCodec, data []byte) ([]byte, error) {
+	encoded, err := encodePayload(codec, data)
+	if err != nil {
+		return nil, &workflow.InternalServiceError{Message: "Failed to encode payload. Error: " + err.Error()}
+	}
+	return encoded, nil
+}
+
+func decodeWithCodec(codec PayloadCodec, data []byte) ([]byte, error) {
+	decoded, err := decodePayload(codec, data)
+	if err != nil {
+		return nil, &CorruptionError{Msg: "Failed to decode payload. Error: " + err.Error()}
+	}
+	return decoded, nil
+}
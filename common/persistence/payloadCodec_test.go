@@ -0,0 +1,94 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	payloadCodecSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+	}
+)
+
+func TestPayloadCodecSuite(t *testing.T) {
+	s := new(payloadCodecSuite)
+	suite.Run(t, s)
+}
+
+func (s *payloadCodecSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *payloadCodecSuite) TestAESGCMRoundTrip() {
+	codec, err := NewPayloadCodec(PayloadCodecAESGCM, map[string]string{"key": "000102030405060708090a0b0c0d0e0f"})
+	s.Nil(err)
+
+	payload := []byte(`[{"eventId":1}]`)
+	encoded, err := encodePayload(codec, payload)
+	s.Nil(err)
+	s.Equal(payloadCodecMagic, encoded[0])
+	s.NotContains(string(encoded), "eventId")
+
+	decoded, err := decodePayload(codec, encoded)
+	s.Nil(err)
+	s.Equal(payload, decoded)
+}
+
+func (s *payloadCodecSuite) TestInvalidKey() {
+	_, err := NewPayloadCodec(PayloadCodecAESGCM, map[string]string{"key": "0001"})
+	s.NotNil(err)
+
+	_, err = NewPayloadCodec("unknown", nil)
+	s.NotNil(err)
+}
+
+func (s *payloadCodecSuite) TestUnencodedPayload() {
+	codec, err := NewPayloadCodec(PayloadCodecAESGCM, map[string]string{"key": "000102030405060708090a0b0c0d0e0f"})
+	s.Nil(err)
+
+	payload := []byte(`{"eventId":1}`)
+	decoded, err := decodePayload(codec, payload)
+	s.Nil(err)
+	s.Equal(payload, decoded)
+
+	encoded, err := encodePayload(codec, nil)
+	s.Nil(err)
+	s.Nil(encoded)
+}
+
+func (s *payloadCodecSuite) TestMissingCodec() {
+	codec, err := NewPayloadCodec(PayloadCodecAESGCM, map[string]string{"key": "000102030405060708090a0b0c0d0e0f"})
+	s.Nil(err)
+
+	encoded, err := encodePayload(codec, []byte("payload"))
+	s.Nil(err)
+
+	_, err = decodePayload(nil, encoded)
+	s.Equal(errPayloadCodecMissing, err)
+}
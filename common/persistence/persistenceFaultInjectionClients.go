@@ -0,0 +1,409 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"sync"
+	"time"
+)
+
+type (
+	// Fault is a failure injected into the requests of a persistence operation by the fault injection clients
+	Fault struct {
+		// Err is returned to the caller, the request fails without reaching the persistence when Persist is not set
+		Err error
+		// Latency delays the request before it reaches the persistence
+		Latency time.Duration
+		// Persist forwards the request to the persistence before Err is returned, like a write which timed out after
+		// it succeeded
+		Persist bool
+		// Count is the number of requests the fault is injected into, zero means every request
+		Count int
+	}
+
+	// FaultInjector holds the faults injected into the requests of the persistence operations, keyed by the name of
+	// the operation such as "UpdateWorkflowExecution".  The faults of an operation are injected in the order they
+	// were added, so tests can exercise failure paths deterministically.
+	FaultInjector struct {
+		sync.Mutex
+		faults   map[string][]*Fault
+		injected map[string]int
+	}
+
+	workflowExecutionFaultInjectionClient struct {
+		persistence ExecutionManager
+		injector    *FaultInjector
+	}
+
+	historyFaultInjectionClient struct {
+		persistence HistoryManager
+		injector    *FaultInjector
+	}
+)
+
+var _ ExecutionManager = (*workflowExecutionFaultInjectionClient)(nil)
+var _ HistoryManager = (*historyFaultInjectionClient)(nil)
+
+// NewFaultInjector creates a FaultInjector without any fault
+func NewFaultInjector() *FaultInjector {
+	return &FaultInjector{
+		faults:   make(map[string][]*Fault),
+		injected: make(map[string]int),
+	}
+}
+
+// Inject adds a fault to the requests of an operation, after the faults already added to the operation
+func (f *FaultInjector) Inject(operation string, fault Fault) {
+	f.Lock()
+	defer f.Unlock()
+	f.faults[operation] = append(f.faults[operation], &fault)
+}
+
+// Clear removes the faults of every operation
+func (f *FaultInjector) Clear() {
+	f.Lock()
+	defer f.Unlock()
+	f.faults = make(map[string][]*Fault)
+}
+
+// Injected returns the number of requests of an operation a fault was injected into
+func (f *FaultInjector) Injected(operation string) int {
+	f.Lock()
+	defer f.Unlock()
+	return f.injected[operation]
+}
+
+// nextFault returns the fault to inject into a request of the operation, nil if there is none
+func (f *FaultInjector) nextFault(operation string) *Fault {
+	f.Lock()
+	defer f.Unlock()
+
+	faults := f.faults[operation]
+	if len(faults) == 0 {
+		return nil
+	}
+
+	fault := faults[0]
+	if fault.Count > 0 {
+		fault.Count--
+		if fault.Count == 0 {
+			f.faults[operation] = faults[1:]
+		}
+	}
+	f.injected[operation]++
+	return fault
+}
+
+// apply runs the request of the operation with the next fault of the operation injected into it
+func (f *FaultInjector) apply(operation string, op func() error) error {
+	fault := f.nextFault(operation)
+	if fault == nil {
+		return op()
+	}
+
+	if fault.Latency > 0 {
+		time.Sleep(fault.Latency)
+	}
+	if fault.Err == nil {
+		return op()
+	}
+	if fault.Persist {
+		op()
+	}
+	return fault.Err
+}
+
+// NewWorkflowExecutionPersistenceFaultInjectionClient creates a client to manage executions which injects the faults
+// of the injector into the requests
+func NewWorkflowExecutionPersistenceFaultInjectionClient(persistence ExecutionManager,
+	injector *FaultInjector) ExecutionManager {
+	return &workflowExecutionFaultInjectionClient{
+		persistence: persistence,
+		injector:    injector,
+	}
+}
+
+// NewHistoryPersistenceFaultInjectionClient creates a HistoryManager client to manage workflow execution history
+// which injects the faults of the injector into the requests
+func NewHistoryPersistenceFaultInjectionClient(persistence HistoryManager, injector *FaultInjector) HistoryManager {
+	return &historyFaultInjectionClient{
+		persistence: persistence,
+		injector:    injector,
+	}
+}
+
+func (p *workflowExecutionFaultInjectionClient) CreateWorkflowExecution(
+	request *CreateWorkflowExecutionRequest) (*CreateWorkflowExecutionResponse, error) {
+	var response *CreateWorkflowExecutionResponse
+	err := p.injector.apply("CreateWorkflowExecution", func() error {
+		var err error
+		response, err = p.persistence.CreateWorkflowExecution(request)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (p *workflowExecutionFaultInjectionClient) GetWorkflowExecution(
+	request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error) {
+	var response *GetWorkflowExecutionResponse
+	err := p.injector.apply("GetWorkflowExecution", func() error {
+		var err error
+		response, err = p.persistence.GetWorkflowExecution(request)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (p *workflowExecutionFaultInjectionClient) UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) (
+	*UpdateWorkflowExecutionResponse, error) {
+	var response *UpdateWorkflowExecutionResponse
+	err := p.injector.apply("UpdateWorkflowExecution", func() error {
+		var err error
+		response, err = p.persistence.UpdateWorkflowExecution(request)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (p *workflowExecutionFaultInjectionClient) DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error {
+	return p.injector.apply("DeleteWorkflowExecution", func() error {
+		return p.persistence.DeleteWorkflowExecution(request)
+	})
+}
+
+func (p *workflowExecutionFaultInjectionClient) GetCurrentExecution(
+	request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error) {
+	var response *GetCurrentExecutionResponse
+	err := p.injector.apply("GetCurrentExecution", func() error {
+		var err error
+		response, err = p.persistence.GetCurrentExecution(request)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (p *workflowExecutionFaultInjectionClient) ListConcreteExecutions(
+	request *ListConcreteExecutionsRequest) (*ListConcreteExecutionsResponse, error) {
+	var response *ListConcreteExecutionsResponse
+	err := p.injector.apply("ListConcreteExecutions", func() error {
+		var err error
+		response, err = p.persistence.ListConcreteExecutions(request)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (p *workflowExecutionFaultInjectionClient) ListCurrentExecutions(
+	request *ListCurrentExecutionsRequest) (*ListCurrentExecutionsResponse, error) {
+	var response *ListCurrentExecutionsResponse
+	err := p.injector.apply("ListCurrentExecutions", func() error {
+		var err error
+		response, err = p.persistence.ListCurrentExecutions(request)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (p *workflowExecutionFaultInjectionClient) DeleteCurrentWorkflowExecution(
+	request *DeleteCurrentWorkflowExecutionRequest) error {
+	return p.injector.apply("DeleteCurrentWorkflowExecution", func() error {
+		return p.persistence.DeleteCurrentWorkflowExecution(request)
+	})
+}
+
+func (p *workflowExecutionFaultInjectionClient) CreateCurrentWorkflowExecution(
+	request *CreateCurrentWorkflowExecutionRequest) error {
+	return p.injector.apply("CreateCurrentWorkflowExecution", func() error {
+		return p.persistence.CreateCurrentWorkflowExecution(request)
+	})
+}
+
+func (p *workflowExecutionFaultInjectionClient) UpdateCurrentWorkflowExecution(
+	request *UpdateCurrentWorkflowExecutionRequest) error {
+	return p.injector.apply("UpdateCurrentWorkflowExecution", func() error {
+		return p.persistence.UpdateCurrentWorkflowExecution(request)
+	})
+}
+
+func (p *workflowExecutionFaultInjectionClient) GetTransferTasks(
+	request *GetTransferTasksRequest) (*GetTransferTasksResponse, error) {
+	var response *GetTransferTasksResponse
+	err := p.injector.apply("GetTransferTasks", func() error {
+		var err error
+		response, err = p.persistence.GetTransferTasks(request)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (p *workflowExecutionFaultInjectionClient) CompleteTransferTask(request *CompleteTransferTaskRequest) error {
+	return p.injector.apply("CompleteTransferTask", func() error {
+		return p.persistence.CompleteTransferTask(request)
+	})
+}
+
+func (p *workflowExecutionFaultInjectionClient) RangeCompleteTransferTask(
+	request *RangeCompleteTransferTaskRequest) error {
+	return p.injector.apply("RangeCompleteTransferTask", func() error {
+		return p.persistence.RangeCompleteTransferTask(request)
+	})
+}
+
+func (p *workflowExecutionFaultInjectionClient) GetTimerIndexTasks(
+	request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse, error) {
+	var response *GetTimerIndexTasksResponse
+	err := p.injector.apply("GetTimerIndexTasks", func() error {
+		var err error
+		response, err = p.persistence.GetTimerIndexTasks(request)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (p *workflowExecutionFaultInjectionClient) CompleteTimerTask(request *CompleteTimerTaskRequest) error {
+	return p.injector.apply("CompleteTimerTask", func() error {
+		return p.persistence.CompleteTimerTask(request)
+	})
+}
+
+func (p *workflowExecutionFaultInjectionClient) RangeCompleteTimerTask(request *RangeCompleteTimerTaskRequest) error {
+	return p.injector.apply("RangeCompleteTimerTask", func() error {
+		return p.persistence.RangeCompleteTimerTask(request)
+	})
+}
+
+func (p *workflowExecutionFaultInjectionClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *historyFaultInjectionClient) AppendHistoryEvents(request *AppendHistoryEventsRequest) error {
+	return p.injector.apply("AppendHistoryEvents", func() error {
+		return p.persistence.AppendHistoryEvents(request)
+	})
+}
+
+func (p *historyFaultInjectionClient) AppendHistoryEventsBatch(request *AppendHistoryEventsBatchRequest) error {
+	return p.injector.apply("AppendHistoryEventsBatch", func() error {
+		return p.persistence.AppendHistoryEventsBatch(request)
+	})
+}
+
+func (p *historyFaultInjectionClient) GetWorkflowExecutionHistory(
+	request *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryResponse, error) {
+	var response *GetWorkflowExecutionHistoryResponse
+	err := p.injector.apply("GetWorkflowExecutionHistory", func() error {
+		var err error
+		response, err = p.persistence.GetWorkflowExecutionHistory(request)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (p *historyFaultInjectionClient) DeleteWorkflowExecutionHistory(
+	request *DeleteWorkflowExecutionHistoryRequest) error {
+	return p.injector.apply("DeleteWorkflowExecutionHistory", func() error {
+		return p.persistence.DeleteWorkflowExecutionHistory(request)
+	})
+}
+
+func (p *historyFaultInjectionClient) AppendHistoryNodes(request *AppendHistoryNodesRequest) error {
+	return p.injector.apply("AppendHistoryNodes", func() error {
+		return p.persistence.AppendHistoryNodes(request)
+	})
+}
+
+func (p *historyFaultInjectionClient) ReadHistoryBranch(
+	request *ReadHistoryBranchRequest) (*ReadHistoryBranchResponse, error) {
+	var response *ReadHistoryBranchResponse
+	err := p.injector.apply("ReadHistoryBranch", func() error {
+		var err error
+		response, err = p.persistence.ReadHistoryBranch(request)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (p *historyFaultInjectionClient) ForkHistoryBranch(
+	request *ForkHistoryBranchRequest) (*ForkHistoryBranchResponse, error) {
+	var response *ForkHistoryBranchResponse
+	err := p.injector.apply("ForkHistoryBranch", func() error {
+		var err error
+		response, err = p.persistence.ForkHistoryBranch(request)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (p *historyFaultInjectionClient) DeleteHistoryBranch(request *DeleteHistoryBranchRequest) error {
+	return p.injector.apply("DeleteHistoryBranch", func() error {
+		return p.persistence.DeleteHistoryBranch(request)
+	})
+}
+
+func (p *historyFaultInjectionClient) GetHistoryTree(request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error) {
+	var response *GetHistoryTreeResponse
+	err := p.injector.apply("GetHistoryTree", func() error {
+		var err error
+		response, err = p.persistence.GetHistoryTree(request)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (p *historyFaultInjectionClient) Close() {
+	p.persistence.Close()
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	faultInjectionClientSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+	}
+
+	// countingHistoryManager counts the requests which reached the persistence
+	countingHistoryManager struct {
+		HistoryManager
+		calls int
+	}
+)
+
+func TestFaultInjectionClientSuite(t *testing.T) {
+	s := new(faultInjectionClientSuite)
+	suite.Run(t, s)
+}
+
+func (s *faultInjectionClientSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (m *countingHistoryManager) AppendHistoryEvents(request *AppendHistoryEventsRequest) error {
+	m.calls++
+	return nil
+}
+
+func (s *faultInjectionClientSuite) TestFaultsInjectedInOrder() {
+	mgr := &countingExecutionManager{}
+	injector := NewFaultInjector()
+	client := NewWorkflowExecutionPersistenceFaultInjectionClient(mgr, injector)
+
+	errFirst := errors.New("first fault")
+	errSecond := errors.New("second fault")
+	injector.Inject("UpdateWorkflowExecution", Fault{Err: errFirst, Count: 2})
+	injector.Inject("UpdateWorkflowExecution", Fault{Err: errSecond, Count: 1})
+
+	for _, expected := range []error{errFirst, errFirst, errSecond} {
+		response, err := client.UpdateWorkflowExecution(&UpdateWorkflowExecutionRequest{})
+		s.Equal(expected, err)
+		s.Nil(response)
+	}
+	s.Equal(0, mgr.calls)
+
+	response, err := client.UpdateWorkflowExecution(&UpdateWorkflowExecutionRequest{})
+	s.Nil(err)
+	s.NotNil(response)
+	s.Equal(1, mgr.calls)
+	s.Equal(3, injector.Injected("UpdateWorkflowExecution"))
+
+	// the faults of an operation are not injected into the other operations
+	_, err = client.GetWorkflowExecution(&GetWorkflowExecutionRequest{})
+	s.Nil(err)
+	s.Equal(0, injector.Injected("GetWorkflowExecution"))
+}
+
+func (s *faultInjectionClientSuite) TestPersistedWriteFails() {
+	mgr := &countingExecutionManager{}
+	injector := NewFaultInjector()
+	client := NewWorkflowExecutionPersistenceFaultInjectionClient(mgr, injector)
+
+	timeout := &TimeoutError{Msg: "UpdateWorkflowExecution timed out."}
+	injector.Inject("UpdateWorkflowExecution", Fault{Err: timeout, Persist: true, Count: 1})
+
+	_, err := client.UpdateWorkflowExecution(&UpdateWorkflowExecutionRequest{})
+	s.Equal(timeout, err)
+	s.Equal(1, mgr.calls)
+}
+
+func (s *faultInjectionClientSuite) TestPermanentFaultCleared() {
+	mgr := &countingHistoryManager{}
+	injector := NewFaultInjector()
+	client := NewHistoryPersistenceFaultInjectionClient(mgr, injector)
+
+	errFault := errors.New("history store unavailable")
+	injector.Inject("AppendHistoryEvents", Fault{Err: errFault, Latency: time.Millisecond})
+
+	for i := 0; i < 3; i++ {
+		s.Equal(errFault, client.AppendHistoryEvents(&AppendHistoryEventsRequest{}))
+	}
+	s.Equal(0, mgr.calls)
+
+	injector.Clear()
+	s.Nil(client.AppendHistoryEvents(&AppendHistoryEventsRequest{}))
+	s.Equal(1, mgr.calls)
+	s.Equal(3, injector.Injected("AppendHistoryEvents"))
+}
+
+func (s *faultInjectionClientSuite) TestLatencyInjected() {
+	mgr := &countingHistoryManager{}
+	injector := NewFaultInjector()
+	client := NewHistoryPersistenceFaultInjectionClient(mgr, injector)
+
+	injector.Inject("AppendHistoryEvents", Fault{Latency: 20 * time.Millisecond, Count: 1})
+
+	start := time.Now()
+	s.Nil(client.AppendHistoryEvents(&AppendHistoryEventsRequest{}))
+	s.True(time.Since(start) >= 20*time.Millisecond)
+	s.Equal(1, mgr.calls)
+}
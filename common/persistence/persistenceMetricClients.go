@@ -109,7 +109,7 @@ func (p *shardPersistenceClient) CreateShard(request *CreateShardRequest) error
 		if _, ok := err.(*ShardAlreadyExistError); ok {
 			p.metricClient.IncCounter(metrics.PersistenceCreateShardScope, metrics.PersistenceErrShardExistsCounter)
 		} else {
-			p.metricClient.IncCounter(metrics.PersistenceCreateShardScope, metrics.PersistenceFailures)
+			updateFailureMetric(p.metricClient, metrics.PersistenceCreateShardScope, err)
 		}
 	}
 
@@ -129,7 +129,7 @@ func (p *shardPersistenceClient) GetShard(
 		case *workflow.EntityNotExistsError:
 			p.metricClient.IncCounter(metrics.PersistenceGetShardScope, metrics.CadenceErrEntityNotExistsCounter)
 		default:
-			p.metricClient.IncCounter(metrics.PersistenceGetShardScope, metrics.PersistenceFailures)
+			updateFailureMetric(p.metricClient, metrics.PersistenceGetShardScope, err)
 		}
 	}
 
@@ -147,13 +147,27 @@ func (p *shardPersistenceClient) UpdateShard(request *UpdateShardRequest) error
 		if _, ok := err.(*ShardOwnershipLostError); ok {
 			p.metricClient.IncCounter(metrics.PersistenceUpdateShardScope, metrics.PersistenceErrShardOwnershipLostCounter)
 		} else {
-			p.metricClient.IncCounter(metrics.PersistenceUpdateShardScope, metrics.PersistenceFailures)
+			updateFailureMetric(p.metricClient, metrics.PersistenceUpdateShardScope, err)
 		}
 	}
 
 	return err
 }
 
+func (p *shardPersistenceClient) GetServerTime() (*GetServerTimeResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceGetServerTimeScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceGetServerTimeScope, metrics.PersistenceLatency)
+	response, err := p.persistence.GetServerTime()
+	sw.Stop()
+
+	if err != nil {
+		updateFailureMetric(p.metricClient, metrics.PersistenceGetServerTimeScope, err)
+	}
+
+	return response, err
+}
+
 func (p *shardPersistenceClient) Close() {
 	p.persistence.Close()
 }
@@ -186,18 +200,19 @@ func (p *workflowExecutionPersistenceClient) GetWorkflowExecution(request *GetWo
 	return response, err
 }
 
-func (p *workflowExecutionPersistenceClient) UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) error {
+func (p *workflowExecutionPersistenceClient) UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) (
+	*UpdateWorkflowExecutionResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceUpdateWorkflowExecutionScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceUpdateWorkflowExecutionScope, metrics.PersistenceLatency)
-	err := p.persistence.UpdateWorkflowExecution(request)
+	response, err := p.persistence.UpdateWorkflowExecution(request)
 	sw.Stop()
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceUpdateWorkflowExecutionScope, err)
 	}
 
-	return err
+	return response, err
 }
 
 func (p *workflowExecutionPersistenceClient) DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error {
@@ -228,6 +243,76 @@ func (p *workflowExecutionPersistenceClient) GetCurrentExecution(request *GetCur
 	return response, err
 }
 
+func (p *workflowExecutionPersistenceClient) ListConcreteExecutions(request *ListConcreteExecutionsRequest) (*ListConcreteExecutionsResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceListConcreteExecutionsScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceListConcreteExecutionsScope, metrics.PersistenceLatency)
+	response, err := p.persistence.ListConcreteExecutions(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceListConcreteExecutionsScope, err)
+	}
+
+	return response, err
+}
+
+func (p *workflowExecutionPersistenceClient) ListCurrentExecutions(request *ListCurrentExecutionsRequest) (*ListCurrentExecutionsResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceListCurrentExecutionsScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceListCurrentExecutionsScope, metrics.PersistenceLatency)
+	response, err := p.persistence.ListCurrentExecutions(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceListCurrentExecutionsScope, err)
+	}
+
+	return response, err
+}
+
+func (p *workflowExecutionPersistenceClient) DeleteCurrentWorkflowExecution(request *DeleteCurrentWorkflowExecutionRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceDeleteCurrentWorkflowExecutionScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteCurrentWorkflowExecutionScope, metrics.PersistenceLatency)
+	err := p.persistence.DeleteCurrentWorkflowExecution(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceDeleteCurrentWorkflowExecutionScope, err)
+	}
+
+	return err
+}
+
+func (p *workflowExecutionPersistenceClient) CreateCurrentWorkflowExecution(request *CreateCurrentWorkflowExecutionRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceCreateCurrentWorkflowExecutionScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceCreateCurrentWorkflowExecutionScope, metrics.PersistenceLatency)
+	err := p.persistence.CreateCurrentWorkflowExecution(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceCreateCurrentWorkflowExecutionScope, err)
+	}
+
+	return err
+}
+
+func (p *workflowExecutionPersistenceClient) UpdateCurrentWorkflowExecution(request *UpdateCurrentWorkflowExecutionRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceUpdateCurrentWorkflowExecutionScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceUpdateCurrentWorkflowExecutionScope, metrics.PersistenceLatency)
+	err := p.persistence.UpdateCurrentWorkflowExecution(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceUpdateCurrentWorkflowExecutionScope, err)
+	}
+
+	return err
+}
+
 func (p *workflowExecutionPersistenceClient) GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetTransferTasksScope, metrics.PersistenceRequests)
 
@@ -256,6 +341,20 @@ func (p *workflowExecutionPersistenceClient) CompleteTransferTask(request *Compl
 	return err
 }
 
+func (p *workflowExecutionPersistenceClient) RangeCompleteTransferTask(request *RangeCompleteTransferTaskRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceRangeCompleteTransferTaskScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceRangeCompleteTransferTaskScope, metrics.PersistenceLatency)
+	err := p.persistence.RangeCompleteTransferTask(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceRangeCompleteTransferTaskScope, err)
+	}
+
+	return err
+}
+
 func (p *workflowExecutionPersistenceClient) GetTimerIndexTasks(request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetTimerIndexTasksScope, metrics.PersistenceRequests)
 
@@ -284,19 +383,30 @@ func (p *workflowExecutionPersistenceClient) CompleteTimerTask(request *Complete
 	return err
 }
 
+func (p *workflowExecutionPersistenceClient) RangeCompleteTimerTask(request *RangeCompleteTimerTaskRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceRangeCompleteTimerTaskScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceRangeCompleteTimerTaskScope, metrics.PersistenceLatency)
+	err := p.persistence.RangeCompleteTimerTask(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceRangeCompleteTimerTaskScope, err)
+	}
+
+	return err
+}
+
 func (p *workflowExecutionPersistenceClient) updateErrorMetric(scope int, err error) {
 	switch err.(type) {
 	case *workflow.WorkflowExecutionAlreadyStartedError:
 		p.metricClient.IncCounter(scope, metrics.CadenceErrExecutionAlreadyStartedCounter)
 	case *ShardOwnershipLostError:
 		p.metricClient.IncCounter(scope, metrics.PersistenceErrShardOwnershipLostCounter)
-	case *ConditionFailedError:
+	case *ConditionFailedError, *CurrentWorkflowConditionFailedError:
 		p.metricClient.IncCounter(scope, metrics.PersistenceErrConditionFailedCounter)
-	case *TimeoutError:
-		p.metricClient.IncCounter(scope, metrics.PersistenceErrTimeoutCounter)
-		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
 	default:
-		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
+		updateFailureMetric(p.metricClient, scope, err)
 	}
 }
 
@@ -346,6 +456,20 @@ func (p *taskPersistenceClient) CompleteTask(request *CompleteTaskRequest) error
 	return err
 }
 
+func (p *taskPersistenceClient) CompleteTasksLessThan(request *CompleteTasksLessThanRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceCompleteTasksLessThanScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceCompleteTasksLessThanScope, metrics.PersistenceLatency)
+	err := p.persistence.CompleteTasksLessThan(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceCompleteTasksLessThanScope, err)
+	}
+
+	return err
+}
+
 func (p *taskPersistenceClient) LeaseTaskList(request *LeaseTaskListRequest) (*LeaseTaskListResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceLeaseTaskListScope, metrics.PersistenceRequests)
 
@@ -374,15 +498,27 @@ func (p *taskPersistenceClient) UpdateTaskList(request *UpdateTaskListRequest) (
 	return response, err
 }
 
+func (p *taskPersistenceClient) GetTaskListBacklog(request *GetTaskListBacklogRequest) (*GetTaskListBacklogResponse,
+	error) {
+	p.metricClient.IncCounter(metrics.PersistenceGetTaskListBacklogScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceGetTaskListBacklogScope, metrics.PersistenceLatency)
+	response, err := p.persistence.GetTaskListBacklog(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceGetTaskListBacklogScope, err)
+	}
+
+	return response, err
+}
+
 func (p *taskPersistenceClient) updateErrorMetric(scope int, err error) {
 	switch err.(type) {
 	case *ConditionFailedError:
 		p.metricClient.IncCounter(scope, metrics.PersistenceErrConditionFailedCounter)
-	case *TimeoutError:
-		p.metricClient.IncCounter(scope, metrics.PersistenceErrTimeoutCounter)
-		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
 	default:
-		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
+		updateFailureMetric(p.metricClient, scope, err)
 	}
 }
 
@@ -404,6 +540,20 @@ func (p *historyPersistenceClient) AppendHistoryEvents(request *AppendHistoryEve
 	return err
 }
 
+func (p *historyPersistenceClient) AppendHistoryEventsBatch(request *AppendHistoryEventsBatchRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceAppendHistoryEventsBatchScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceAppendHistoryEventsBatchScope, metrics.PersistenceLatency)
+	err := p.persistence.AppendHistoryEventsBatch(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceAppendHistoryEventsBatchScope, err)
+	}
+
+	return err
+}
+
 func (p *historyPersistenceClient) GetWorkflowExecutionHistory(
 	request *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetWorkflowExecutionHistoryScope, metrics.PersistenceRequests)
@@ -434,17 +584,84 @@ func (p *historyPersistenceClient) DeleteWorkflowExecutionHistory(
 	return err
 }
 
+func (p *historyPersistenceClient) AppendHistoryNodes(request *AppendHistoryNodesRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceAppendHistoryNodesScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceAppendHistoryNodesScope, metrics.PersistenceLatency)
+	err := p.persistence.AppendHistoryNodes(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceAppendHistoryNodesScope, err)
+	}
+
+	return err
+}
+
+func (p *historyPersistenceClient) ReadHistoryBranch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceReadHistoryBranchScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceReadHistoryBranchScope, metrics.PersistenceLatency)
+	response, err := p.persistence.ReadHistoryBranch(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceReadHistoryBranchScope, err)
+	}
+
+	return response, err
+}
+
+func (p *historyPersistenceClient) ForkHistoryBranch(request *ForkHistoryBranchRequest) (*ForkHistoryBranchResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceForkHistoryBranchScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceForkHistoryBranchScope, metrics.PersistenceLatency)
+	response, err := p.persistence.ForkHistoryBranch(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceForkHistoryBranchScope, err)
+	}
+
+	return response, err
+}
+
+func (p *historyPersistenceClient) DeleteHistoryBranch(request *DeleteHistoryBranchRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceDeleteHistoryBranchScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteHistoryBranchScope, metrics.PersistenceLatency)
+	err := p.persistence.DeleteHistoryBranch(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceDeleteHistoryBranchScope, err)
+	}
+
+	return err
+}
+
+func (p *historyPersistenceClient) GetHistoryTree(request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceGetHistoryTreeScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceGetHistoryTreeScope, metrics.PersistenceLatency)
+	response, err := p.persistence.GetHistoryTree(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceGetHistoryTreeScope, err)
+	}
+
+	return response, err
+}
+
 func (p *historyPersistenceClient) updateErrorMetric(scope int, err error) {
 	switch err.(type) {
 	case *workflow.EntityNotExistsError:
 		p.metricClient.IncCounter(scope, metrics.CadenceErrEntityNotExistsCounter)
 	case *ConditionFailedError:
 		p.metricClient.IncCounter(scope, metrics.PersistenceErrConditionFailedCounter)
-	case *TimeoutError:
-		p.metricClient.IncCounter(scope, metrics.PersistenceErrTimeoutCounter)
-		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
 	default:
-		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
+		updateFailureMetric(p.metricClient, scope, err)
 	}
 }
 
@@ -522,6 +739,92 @@ func (p *metadataPersistenceClient) DeleteDomainByName(request *DeleteDomainByNa
 	return err
 }
 
+func (p *metadataPersistenceClient) GetDomainChanges(request *GetDomainChangesRequest) (*GetDomainChangesResponse,
+	error) {
+	p.metricClient.IncCounter(metrics.PersistenceGetDomainChangesScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceGetDomainChangesScope, metrics.PersistenceLatency)
+	response, err := p.persistence.GetDomainChanges(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceGetDomainChangesScope, err)
+	}
+
+	return response, err
+}
+
+func (p *metadataPersistenceClient) GetMetadata() (*GetMetadataResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceGetMetadataScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceGetMetadataScope, metrics.PersistenceLatency)
+	response, err := p.persistence.GetMetadata()
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceGetMetadataScope, err)
+	}
+
+	return response, err
+}
+
+func (p *metadataPersistenceClient) CreateBatchOperation(request *CreateBatchOperationRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceCreateBatchOperationScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceCreateBatchOperationScope, metrics.PersistenceLatency)
+	err := p.persistence.CreateBatchOperation(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceCreateBatchOperationScope, err)
+	}
+
+	return err
+}
+
+func (p *metadataPersistenceClient) UpdateBatchOperation(request *UpdateBatchOperationRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceUpdateBatchOperationScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceUpdateBatchOperationScope, metrics.PersistenceLatency)
+	err := p.persistence.UpdateBatchOperation(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceUpdateBatchOperationScope, err)
+	}
+
+	return err
+}
+
+func (p *metadataPersistenceClient) StopBatchOperation(request *StopBatchOperationRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceStopBatchOperationScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceStopBatchOperationScope, metrics.PersistenceLatency)
+	err := p.persistence.StopBatchOperation(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceStopBatchOperationScope, err)
+	}
+
+	return err
+}
+
+func (p *metadataPersistenceClient) GetBatchOperation(request *GetBatchOperationRequest) (*GetBatchOperationResponse,
+	error) {
+	p.metricClient.IncCounter(metrics.PersistenceGetBatchOperationScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceGetBatchOperationScope, metrics.PersistenceLatency)
+	response, err := p.persistence.GetBatchOperation(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceGetBatchOperationScope, err)
+	}
+
+	return response, err
+}
+
 func (p *metadataPersistenceClient) Close() {
 	p.persistence.Close()
 }
@@ -534,7 +837,26 @@ func (p *metadataPersistenceClient) updateErrorMetric(scope int, err error) {
 		p.metricClient.IncCounter(scope, metrics.CadenceErrEntityNotExistsCounter)
 	case *workflow.BadRequestError:
 		p.metricClient.IncCounter(scope, metrics.CadenceErrBadRequestCounter)
+	case *ConditionFailedError:
+		p.metricClient.IncCounter(scope, metrics.PersistenceErrConditionFailedCounter)
 	default:
-		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
+		updateFailureMetric(p.metricClient, scope, err)
 	}
 }
+
+// updateFailureMetric records a failure of the persistence store itself.  Besides the PersistenceFailures counter
+// a typed counter is emitted for failures caused by an overloaded, unreachable or corrupt store, so these can be
+// told apart from errors in the persistence layer.
+func updateFailureMetric(metricClient metrics.Client, scope int, err error) {
+	switch err.(type) {
+	case *TimeoutError:
+		metricClient.IncCounter(scope, metrics.PersistenceErrTimeoutCounter)
+	case *UnavailableError:
+		metricClient.IncCounter(scope, metrics.PersistenceErrUnavailableCounter)
+	case *workflow.ServiceBusyError:
+		metricClient.IncCounter(scope, metrics.PersistenceErrBusyCounter)
+	case *CorruptionError:
+		metricClient.IncCounter(scope, metrics.PersistenceErrCorruptCounter)
+	}
+	metricClient.IncCounter(scope, metrics.PersistenceFailures)
+}
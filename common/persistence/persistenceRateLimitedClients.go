@@ -0,0 +1,187 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+type (
+	workflowExecutionRateLimitedClient struct {
+		persistence ExecutionManager
+		readBucket  common.TokenBucket
+		writeBucket common.TokenBucket
+	}
+)
+
+// ErrPersistenceLimitExceeded is the error returned when the rate of persistence requests exceeds the limit
+var ErrPersistenceLimitExceeded = &workflow.ServiceBusyError{Message: "Persistence Max QPS Reached."}
+
+var _ ExecutionManager = (*workflowExecutionRateLimitedClient)(nil)
+
+// NewWorkflowExecutionPersistenceRateLimitedClient creates a client to manage executions which fails the requests
+// above the rate limits with ErrPersistenceLimitExceeded, reads and writes are limited separately and a nil bucket
+// means unlimited
+func NewWorkflowExecutionPersistenceRateLimitedClient(persistence ExecutionManager, readBucket,
+	writeBucket common.TokenBucket) ExecutionManager {
+	return &workflowExecutionRateLimitedClient{
+		persistence: persistence,
+		readBucket:  readBucket,
+		writeBucket: writeBucket,
+	}
+}
+
+func (p *workflowExecutionRateLimitedClient) CreateWorkflowExecution(
+	request *CreateWorkflowExecutionRequest) (*CreateWorkflowExecutionResponse, error) {
+	if !allowRequest(p.writeBucket) {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	return p.persistence.CreateWorkflowExecution(request)
+}
+
+func (p *workflowExecutionRateLimitedClient) GetWorkflowExecution(
+	request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error) {
+	if !allowRequest(p.readBucket) {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	return p.persistence.GetWorkflowExecution(request)
+}
+
+func (p *workflowExecutionRateLimitedClient) UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) (
+	*UpdateWorkflowExecutionResponse, error) {
+	if !allowRequest(p.writeBucket) {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	return p.persistence.UpdateWorkflowExecution(request)
+}
+
+func (p *workflowExecutionRateLimitedClient) DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error {
+	if !allowRequest(p.writeBucket) {
+		return ErrPersistenceLimitExceeded
+	}
+	return p.persistence.DeleteWorkflowExecution(request)
+}
+
+func (p *workflowExecutionRateLimitedClient) GetCurrentExecution(
+	request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error) {
+	if !allowRequest(p.readBucket) {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	return p.persistence.GetCurrentExecution(request)
+}
+
+func (p *workflowExecutionRateLimitedClient) ListConcreteExecutions(
+	request *ListConcreteExecutionsRequest) (*ListConcreteExecutionsResponse, error) {
+	if !allowRequest(p.readBucket) {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	return p.persistence.ListConcreteExecutions(request)
+}
+
+func (p *workflowExecutionRateLimitedClient) ListCurrentExecutions(
+	request *ListCurrentExecutionsRequest) (*ListCurrentExecutionsResponse, error) {
+	if !allowRequest(p.readBucket) {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	return p.persistence.ListCurrentExecutions(request)
+}
+
+func (p *workflowExecutionRateLimitedClient) DeleteCurrentWorkflowExecution(
+	request *DeleteCurrentWorkflowExecutionRequest) error {
+	if !allowRequest(p.writeBucket) {
+		return ErrPersistenceLimitExceeded
+	}
+	return p.persistence.DeleteCurrentWorkflowExecution(request)
+}
+
+func (p *workflowExecutionRateLimitedClient) CreateCurrentWorkflowExecution(
+	request *CreateCurrentWorkflowExecutionRequest) error {
+	if !allowRequest(p.writeBucket) {
+		return ErrPersistenceLimitExceeded
+	}
+	return p.persistence.CreateCurrentWorkflowExecution(request)
+}
+
+func (p *workflowExecutionRateLimitedClient) UpdateCurrentWorkflowExecution(
+	request *UpdateCurrentWorkflowExecutionRequest) error {
+	if !allowRequest(p.writeBucket) {
+		return ErrPersistenceLimitExceeded
+	}
+	return p.persistence.UpdateCurrentWorkflowExecution(request)
+}
+
+func (p *workflowExecutionRateLimitedClient) GetTransferTasks(
+	request *GetTransferTasksRequest) (*GetTransferTasksResponse, error) {
+	if !allowRequest(p.readBucket) {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	return p.persistence.GetTransferTasks(request)
+}
+
+func (p *workflowExecutionRateLimitedClient) CompleteTransferTask(request *CompleteTransferTaskRequest) error {
+	if !allowRequest(p.writeBucket) {
+		return ErrPersistenceLimitExceeded
+	}
+	return p.persistence.CompleteTransferTask(request)
+}
+
+func (p *workflowExecutionRateLimitedClient) RangeCompleteTransferTask(
+	request *RangeCompleteTransferTaskRequest) error {
+	if !allowRequest(p.writeBucket) {
+		return ErrPersistenceLimitExceeded
+	}
+	return p.persistence.RangeCompleteTransferTask(request)
+}
+
+func (p *workflowExecutionRateLimitedClient) GetTimerIndexTasks(
+	request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse, error) {
+	if !allowRequest(p.readBucket) {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	return p.persistence.GetTimerIndexTasks(request)
+}
+
+func (p *workflowExecutionRateLimitedClient) CompleteTimerTask(request *CompleteTimerTaskRequest) error {
+	if !allowRequest(p.writeBucket) {
+		return ErrPersistenceLimitExceeded
+	}
+	return p.persistence.CompleteTimerTask(request)
+}
+
+func (p *workflowExecutionRateLimitedClient) RangeCompleteTimerTask(request *RangeCompleteTimerTaskRequest) error {
+	if !allowRequest(p.writeBucket) {
+		return ErrPersistenceLimitExceeded
+	}
+	return p.persistence.RangeCompleteTimerTask(request)
+}
+
+func (p *workflowExecutionRateLimitedClient) Close() {
+	p.persistence.Close()
+}
+
+func allowRequest(bucket common.TokenBucket) bool {
+	if bucket == nil {
+		return true
+	}
+	ok, _ := bucket.TryConsume(1)
+	return ok
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	rateLimitedClientSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+	}
+
+	// countingExecutionManager counts the requests which reached the persistence
+	countingExecutionManager struct {
+		ExecutionManager
+		calls int
+	}
+
+	// fixedTokenBucket holds a fixed number of tokens which are never refilled
+	fixedTokenBucket struct {
+		tokens int
+	}
+)
+
+func TestRateLimitedClientSuite(t *testing.T) {
+	s := new(rateLimitedClientSuite)
+	suite.Run(t, s)
+}
+
+func (s *rateLimitedClientSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (m *countingExecutionManager) GetWorkflowExecution(
+	request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error) {
+	m.calls++
+	return &GetWorkflowExecutionResponse{}, nil
+}
+
+func (m *countingExecutionManager) UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) (
+	*UpdateWorkflowExecutionResponse, error) {
+	m.calls++
+	return &UpdateWorkflowExecutionResponse{}, nil
+}
+
+func (b *fixedTokenBucket) TryConsume(count int) (bool, time.Duration) {
+	if b.tokens < count {
+		return false, time.Second
+	}
+	b.tokens -= count
+	return true, 0
+}
+
+func (b *fixedTokenBucket) Consume(count int, timeout time.Duration) bool {
+	ok, _ := b.TryConsume(count)
+	return ok
+}
+
+func (s *rateLimitedClientSuite) TestReadsAndWritesLimitedSeparately() {
+	mgr := &countingExecutionManager{}
+	client := NewWorkflowExecutionPersistenceRateLimitedClient(mgr, &fixedTokenBucket{tokens: 1},
+		&fixedTokenBucket{tokens: 2})
+
+	_, err := client.GetWorkflowExecution(&GetWorkflowExecutionRequest{})
+	s.Nil(err)
+	_, err = client.GetWorkflowExecution(&GetWorkflowExecutionRequest{})
+	s.Equal(ErrPersistenceLimitExceeded, err)
+
+	_, err = client.UpdateWorkflowExecution(&UpdateWorkflowExecutionRequest{})
+	s.Nil(err)
+	_, err = client.UpdateWorkflowExecution(&UpdateWorkflowExecutionRequest{})
+	s.Nil(err)
+	_, err = client.UpdateWorkflowExecution(&UpdateWorkflowExecutionRequest{})
+	s.Equal(ErrPersistenceLimitExceeded, err)
+	s.Equal(3, mgr.calls)
+	s.True(IsTransientError(ErrPersistenceLimitExceeded))
+}
+
+func (s *rateLimitedClientSuite) TestNilBucketUnlimited() {
+	mgr := &countingExecutionManager{}
+	client := NewWorkflowExecutionPersistenceRateLimitedClient(mgr, nil, nil)
+
+	for i := 0; i < 10; i++ {
+		_, err := client.GetWorkflowExecution(&GetWorkflowExecutionRequest{})
+		s.Nil(err)
+		_, err = client.UpdateWorkflowExecution(&UpdateWorkflowExecutionRequest{})
+		s.Nil(err)
+	}
+	s.Equal(20, mgr.calls)
+}
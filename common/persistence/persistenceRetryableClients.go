@@ -0,0 +1,613 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"github.com/uber/cadence/common/backoff"
+)
+
+type (
+	shardRetryableClient struct {
+		persistence ShardManager
+		policy      backoff.RetryPolicy
+		isRetryable backoff.IsRetryable
+	}
+
+	workflowExecutionRetryableClient struct {
+		persistence ExecutionManager
+		policy      backoff.RetryPolicy
+		isRetryable backoff.IsRetryable
+	}
+
+	taskRetryableClient struct {
+		persistence TaskManager
+		policy      backoff.RetryPolicy
+		isRetryable backoff.IsRetryable
+	}
+
+	historyRetryableClient struct {
+		persistence HistoryManager
+		policy      backoff.RetryPolicy
+		isRetryable backoff.IsRetryable
+	}
+
+	metadataRetryableClient struct {
+		persistence MetadataManager
+		policy      backoff.RetryPolicy
+		isRetryable backoff.IsRetryable
+	}
+)
+
+var _ ShardManager = (*shardRetryableClient)(nil)
+var _ ExecutionManager = (*workflowExecutionRetryableClient)(nil)
+var _ TaskManager = (*taskRetryableClient)(nil)
+var _ HistoryManager = (*historyRetryableClient)(nil)
+var _ MetadataManager = (*metadataRetryableClient)(nil)
+
+// NewShardPersistenceRetryableClient creates a client to manage shards which retries the operations failed with an error
+// classified as retryable by isRetryable, backing off between attempts according to the policy
+func NewShardPersistenceRetryableClient(persistence ShardManager, policy backoff.RetryPolicy,
+	isRetryable backoff.IsRetryable) ShardManager {
+	return &shardRetryableClient{
+		persistence: persistence,
+		policy:      policy,
+		isRetryable: isRetryable,
+	}
+}
+
+// NewWorkflowExecutionPersistenceRetryableClient creates a client to manage executions which retries the operations failed with an error
+// classified as retryable by isRetryable, backing off between attempts according to the policy
+func NewWorkflowExecutionPersistenceRetryableClient(persistence ExecutionManager, policy backoff.RetryPolicy,
+	isRetryable backoff.IsRetryable) ExecutionManager {
+	return &workflowExecutionRetryableClient{
+		persistence: persistence,
+		policy:      policy,
+		isRetryable: isRetryable,
+	}
+}
+
+// NewTaskPersistenceRetryableClient creates a client to manage tasks which retries the operations failed with an error
+// classified as retryable by isRetryable, backing off between attempts according to the policy
+func NewTaskPersistenceRetryableClient(persistence TaskManager, policy backoff.RetryPolicy,
+	isRetryable backoff.IsRetryable) TaskManager {
+	return &taskRetryableClient{
+		persistence: persistence,
+		policy:      policy,
+		isRetryable: isRetryable,
+	}
+}
+
+// NewHistoryPersistenceRetryableClient creates a HistoryManager client to manage workflow execution history which retries the operations failed with an error
+// classified as retryable by isRetryable, backing off between attempts according to the policy
+func NewHistoryPersistenceRetryableClient(persistence HistoryManager, policy backoff.RetryPolicy,
+	isRetryable backoff.IsRetryable) HistoryManager {
+	return &historyRetryableClient{
+		persistence: persistence,
+		policy:      policy,
+		isRetryable: isRetryable,
+	}
+}
+
+// NewMetadataPersistenceRetryableClient creates a MetadataManager client to manage domains which retries the operations failed with an error
+// classified as retryable by isRetryable, backing off between attempts according to the policy
+func NewMetadataPersistenceRetryableClient(persistence MetadataManager, policy backoff.RetryPolicy,
+	isRetryable backoff.IsRetryable) MetadataManager {
+	return &metadataRetryableClient{
+		persistence: persistence,
+		policy:      policy,
+		isRetryable: isRetryable,
+	}
+}
+
+func (p *shardRetryableClient) CreateShard(request *CreateShardRequest) error {
+	op := func() error {
+		return p.persistence.CreateShard(request)
+	}
+
+	return backoff.Retry(op, p.policy, p.isRetryable)
+}
+
+func (p *shardRetryableClient) GetShard(request *GetShardRequest) (*GetShardResponse, error) {
+	var response *GetShardResponse
+	op := func() error {
+		var err error
+		response, err = p.persistence.GetShard(request)
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, p.isRetryable)
+	return response, err
+}
+
+func (p *shardRetryableClient) UpdateShard(request *UpdateShardRequest) error {
+	op := func() error {
+		return p.persistence.UpdateShard(request)
+	}
+
+	return backoff.Retry(op, p.policy, p.isRetryable)
+}
+
+func (p *shardRetryableClient) GetServerTime() (*GetServerTimeResponse, error) {
+	var response *GetServerTimeResponse
+	op := func() error {
+		var err error
+		response, err = p.persistence.GetServerTime()
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, p.isRetryable)
+	return response, err
+}
+
+func (p *shardRetryableClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *workflowExecutionRetryableClient) CreateWorkflowExecution(request *CreateWorkflowExecutionRequest) (*CreateWorkflowExecutionResponse, error) {
+	var response *CreateWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		response, err = p.persistence.CreateWorkflowExecution(request)
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, p.isRetryable)
+	return response, err
+}
+
+func (p *workflowExecutionRetryableClient) GetWorkflowExecution(request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error) {
+	var response *GetWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		response, err = p.persistence.GetWorkflowExecution(request)
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, p.isRetryable)
+	return response, err
+}
+
+func (p *workflowExecutionRetryableClient) UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) (
+	*UpdateWorkflowExecutionResponse, error) {
+	var response *UpdateWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		response, err = p.persistence.UpdateWorkflowExecution(request)
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, p.isRetryable)
+	return response, err
+}
+
+func (p *workflowExecutionRetryableClient) DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error {
+	op := func() error {
+		return p.persistence.DeleteWorkflowExecution(request)
+	}
+
+	return backoff.Retry(op, p.policy, p.isRetryable)
+}
+
+func (p *workflowExecutionRetryableClient) GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error) {
+	var response *GetCurrentExecutionResponse
+	op := func() error {
+		var err error
+		response, err = p.persistence.GetCurrentExecution(request)
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, p.isRetryable)
+	return response, err
+}
+
+func (p *workflowExecutionRetryableClient) ListConcreteExecutions(request *ListConcreteExecutionsRequest) (*ListConcreteExecutionsResponse, error) {
+	var response *ListConcreteExecutionsResponse
+	op := func() error {
+		var err error
+		response, err = p.persistence.ListConcreteExecutions(request)
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, p.isRetryable)
+	return response, err
+}
+
+func (p *workflowExecutionRetryableClient) ListCurrentExecutions(request *ListCurrentExecutionsRequest) (*ListCurrentExecutionsResponse, error) {
+	var response *ListCurrentExecutionsResponse
+	op := func() error {
+		var err error
+		response, err = p.persistence.ListCurrentExecutions(request)
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, p.isRetryable)
+	return response, err
+}
+
+func (p *workflowExecutionRetryableClient) DeleteCurrentWorkflowExecution(request *DeleteCurrentWorkflowExecutionRequest) error {
+	op := func() error {
+		return p.persistence.DeleteCurrentWorkflowExecution(request)
+	}
+
+	return backoff.Retry(op, p.policy, p.isRetryable)
+}
+
+func (p *workflowExecutionRetryableClient) CreateCurrentWorkflowExecution(request *CreateCurrentWorkflowExecutionRequest) error {
+	op := func() error {
+		return p.persistence.CreateCurrentWorkflowExecution(request)
+	}
+
+	return backoff.Retry(op, p.policy, p.isRetryable)
+}
+
+func (p *workflowExecutionRetryableClient) UpdateCurrentWorkflowExecution(request *UpdateCurrentWorkflowExecutionRequest) error {
+	op := func() error {
+		return p.persistence.UpdateCurrentWorkflowExecution(request)
+	}
+
+	return backoff.Retry(op, p.policy, p.isRetryable)
+}
+
+func (p *workflowExecutionRetryableClient) GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error) {
+	var response *GetTransferTasksResponse
+	op := func() error {
+		var err error
+		response, err = p.persistence.GetTransferTasks(request)
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, p.isRetryable)
+	return response, err
+}
+
+func (p *workflowExecutionRetryableClient) CompleteTransferTask(request *CompleteTransferTaskRequest) error {
+	op := func() error {
+		return p.persistence.CompleteTransferTask(request)
+	}
+
+	return backoff.Retry(op, p.policy, p.isRetryable)
+}
+
+func (p *workflowExecutionRetryableClient) RangeCompleteTransferTask(request *RangeCompleteTransferTaskRequest) error {
+	op := func() error {
+		return p.persistence.RangeCompleteTransferTask(request)
+	}
+
+	return backoff.Retry(op, p.policy, p.isRetryable)
+}
+
+func (p *workflowExecutionRetryableClient) GetTimerIndexTasks(request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse, error) {
+	var response *GetTimerIndexTasksResponse
+	op := func() error {
+		var err error
+		response, err = p.persistence.GetTimerIndexTasks(request)
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, p.isRetryable)
+	return response, err
+}
+
+func (p *workflowExecutionRetryableClient) CompleteTimerTask(request *CompleteTimerTaskRequest) error {
+	op := func() error {
+		return p.persistence.CompleteTimerTask(request)
+	}
+
+	return backoff.Retry(op, p.policy, p.isRetryable)
+}
+
+func (p *workflowExecutionRetryableClient) RangeCompleteTimerTask(request *RangeCompleteTimerTaskRequest) error {
+	op := func() error {
+		return p.persistence.RangeCompleteTimerTask(request)
+	}
+
+	return backoff.Retry(op, p.policy, p.isRetryable)
+}
+
+func (p *workflowExecutionRetryableClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *taskRetryableClient) LeaseTaskList(request *LeaseTaskListRequest) (*LeaseTaskListResponse, error) {
+	var response *LeaseTaskListResponse
+	op := func() error {
+		var err error
+		response, err = p.persistence.LeaseTaskList(request)
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, p.isRetryable)
+	return response, err
+}
+
+func (p *taskRetryableClient) UpdateTaskList(request *UpdateTaskListRequest) (*UpdateTaskListResponse, error) {
+	var response *UpdateTaskListResponse
+	op := func() error {
+		var err error
+		response, err = p.persistence.UpdateTaskList(request)
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, p.isRetryable)
+	return response, err
+}
+
+func (p *taskRetryableClient) GetTaskListBacklog(request *GetTaskListBacklogRequest) (*GetTaskListBacklogResponse,
+	error) {
+	var response *GetTaskListBacklogResponse
+	op := func() error {
+		var err error
+		response, err = p.persistence.GetTaskListBacklog(request)
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, p.isRetryable)
+	return response, err
+}
+
+func (p *taskRetryableClient) CreateTasks(request *CreateTasksRequest) (*CreateTasksResponse, error) {
+	var response *CreateTasksResponse
+	op := func() error {
+		var err error
+		response, err = p.persistence.CreateTasks(request)
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, p.isRetryable)
+	return response, err
+}
+
+func (p *taskRetryableClient) GetTasks(request *GetTasksRequest) (*GetTasksResponse, error) {
+	var response *GetTasksResponse
+	op := func() error {
+		var err error
+		response, err = p.persistence.GetTasks(request)
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, p.isRetryable)
+	return response, err
+}
+
+func (p *taskRetryableClient) CompleteTask(request *CompleteTaskRequest) error {
+	op := func() error {
+		return p.persistence.CompleteTask(request)
+	}
+
+	return backoff.Retry(op, p.policy, p.isRetryable)
+}
+
+func (p *taskRetryableClient) CompleteTasksLessThan(request *CompleteTasksLessThanRequest) error {
+	op := func() error {
+		return p.persistence.CompleteTasksLessThan(request)
+	}
+
+	return backoff.Retry(op, p.policy, p.isRetryable)
+}
+
+func (p *taskRetryableClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *historyRetryableClient) AppendHistoryEvents(request *AppendHistoryEventsRequest) error {
+	op := func() error {
+		return p.persistence.AppendHistoryEvents(request)
+	}
+
+	return backoff.Retry(op, p.policy, p.isRetryable)
+}
+
+func (p *historyRetryableClient) AppendHistoryEventsBatch(request *AppendHistoryEventsBatchRequest) error {
+	op := func() error {
+		return p.persistence.AppendHistoryEventsBatch(request)
+	}
+
+	return backoff.Retry(op, p.policy, p.isRetryable)
+}
+
+func (p *historyRetryableClient) GetWorkflowExecutionHistory(request *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryResponse, error) {
+	var response *GetWorkflowExecutionHistoryResponse
+	op := func() error {
+		var err error
+		response, err = p.persistence.GetWorkflowExecutionHistory(request)
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, p.isRetryable)
+	return response, err
+}
+
+func (p *historyRetryableClient) DeleteWorkflowExecutionHistory(request *DeleteWorkflowExecutionHistoryRequest) error {
+	op := func() error {
+		return p.persistence.DeleteWorkflowExecutionHistory(request)
+	}
+
+	return backoff.Retry(op, p.policy, p.isRetryable)
+}
+
+func (p *historyRetryableClient) AppendHistoryNodes(request *AppendHistoryNodesRequest) error {
+	op := func() error {
+		return p.persistence.AppendHistoryNodes(request)
+	}
+
+	return backoff.Retry(op, p.policy, p.isRetryable)
+}
+
+func (p *historyRetryableClient) ReadHistoryBranch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchResponse, error) {
+	var response *ReadHistoryBranchResponse
+	op := func() error {
+		var err error
+		response, err = p.persistence.ReadHistoryBranch(request)
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, p.isRetryable)
+	return response, err
+}
+
+func (p *historyRetryableClient) ForkHistoryBranch(request *ForkHistoryBranchRequest) (*ForkHistoryBranchResponse, error) {
+	var response *ForkHistoryBranchResponse
+	op := func() error {
+		var err error
+		response, err = p.persistence.ForkHistoryBranch(request)
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, p.isRetryable)
+	return response, err
+}
+
+func (p *historyRetryableClient) DeleteHistoryBranch(request *DeleteHistoryBranchRequest) error {
+	op := func() error {
+		return p.persistence.DeleteHistoryBranch(request)
+	}
+
+	return backoff.Retry(op, p.policy, p.isRetryable)
+}
+
+func (p *historyRetryableClient) GetHistoryTree(request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error) {
+	var response *GetHistoryTreeResponse
+	op := func() error {
+		var err error
+		response, err = p.persistence.GetHistoryTree(request)
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, p.isRetryable)
+	return response, err
+}
+
+func (p *historyRetryableClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *metadataRetryableClient) CreateDomain(request *CreateDomainRequest) (*CreateDomainResponse, error) {
+	var response *CreateDomainResponse
+	op := func() error {
+		var err error
+		response, err = p.persistence.CreateDomain(request)
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, p.isRetryable)
+	return response, err
+}
+
+func (p *metadataRetryableClient) GetDomain(request *GetDomainRequest) (*GetDomainResponse, error) {
+	var response *GetDomainResponse
+	op := func() error {
+		var err error
+		response, err = p.persistence.GetDomain(request)
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, p.isRetryable)
+	return response, err
+}
+
+func (p *metadataRetryableClient) UpdateDomain(request *UpdateDomainRequest) error {
+	op := func() error {
+		return p.persistence.UpdateDomain(request)
+	}
+
+	return backoff.Retry(op, p.policy, p.isRetryable)
+}
+
+func (p *metadataRetryableClient) DeleteDomain(request *DeleteDomainRequest) error {
+	op := func() error {
+		return p.persistence.DeleteDomain(request)
+	}
+
+	return backoff.Retry(op, p.policy, p.isRetryable)
+}
+
+func (p *metadataRetryableClient) DeleteDomainByName(request *DeleteDomainByNameRequest) error {
+	op := func() error {
+		return p.persistence.DeleteDomainByName(request)
+	}
+
+	return backoff.Retry(op, p.policy, p.isRetryable)
+}
+
+func (p *metadataRetryableClient) GetDomainChanges(request *GetDomainChangesRequest) (*GetDomainChangesResponse, error) {
+	var response *GetDomainChangesResponse
+	op := func() error {
+		var err error
+		response, err = p.persistence.GetDomainChanges(request)
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, p.isRetryable)
+	return response, err
+}
+
+func (p *metadataRetryableClient) GetMetadata() (*GetMetadataResponse, error) {
+	var response *GetMetadataResponse
+	op := func() error {
+		var err error
+		response, err = p.persistence.GetMetadata()
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, p.isRetryable)
+	return response, err
+}
+
+func (p *metadataRetryableClient) CreateBatchOperation(request *CreateBatchOperationRequest) error {
+	op := func() error {
+		return p.persistence.CreateBatchOperation(request)
+	}
+
+	return backoff.Retry(op, p.policy, p.isRetryable)
+}
+
+func (p *metadataRetryableClient) UpdateBatchOperation(request *UpdateBatchOperationRequest) error {
+	op := func() error {
+		return p.persistence.UpdateBatchOperation(request)
+	}
+
+	return backoff.Retry(op, p.policy, p.isRetryable)
+}
+
+func (p *metadataRetryableClient) StopBatchOperation(request *StopBatchOperationRequest) error {
+	op := func() error {
+		return p.persistence.StopBatchOperation(request)
+	}
+
+	return backoff.Retry(op, p.policy, p.isRetryable)
+}
+
+func (p *metadataRetryableClient) GetBatchOperation(request *GetBatchOperationRequest) (*GetBatchOperationResponse,
+	error) {
+	var response *GetBatchOperationResponse
+	op := func() error {
+		var err error
+		response, err = p.persistence.GetBatchOperation(request)
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, p.isRetryable)
+	return response, err
+}
+
+func (p *metadataRetryableClient) Close() {
+	p.persistence.Close()
+}
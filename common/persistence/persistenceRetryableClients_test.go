@@ -0,0 +1,105 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/backoff"
+)
+
+type (
+	retryableClientSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+		policy backoff.RetryPolicy
+	}
+
+	// failingShardManager fails GetShard with the queued errors before succeeding
+	failingShardManager struct {
+		ShardManager
+		errors []error
+		calls  int
+	}
+)
+
+func TestRetryableClientSuite(t *testing.T) {
+	s := new(retryableClientSuite)
+	suite.Run(t, s)
+}
+
+func (s *retryableClientSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	policy := backoff.NewExponentialRetryPolicy(time.Millisecond)
+	policy.SetMaximumAttempts(3)
+	s.policy = policy
+}
+
+func (m *failingShardManager) GetShard(request *GetShardRequest) (*GetShardResponse, error) {
+	m.calls++
+	if m.calls <= len(m.errors) {
+		return nil, m.errors[m.calls-1]
+	}
+	return &GetShardResponse{ShardInfo: &ShardInfo{ShardID: request.ShardID}}, nil
+}
+
+func (s *retryableClientSuite) TestRetryTransientError() {
+	mgr := &failingShardManager{errors: []error{
+		&workflow.InternalServiceError{Message: "timeout"},
+		&UnavailableError{Msg: "no hosts"},
+	}}
+	client := NewShardPersistenceRetryableClient(mgr, s.policy, IsTransientError)
+
+	response, err := client.GetShard(&GetShardRequest{ShardID: 5})
+	s.Nil(err)
+	s.Equal(5, response.ShardInfo.ShardID)
+	s.Equal(3, mgr.calls)
+}
+
+func (s *retryableClientSuite) TestNoRetryNonTransientError() {
+	for _, e := range []error{
+		&ConditionFailedError{Msg: "condition failed"},
+		&ShardOwnershipLostError{ShardID: 5, Msg: "ownership lost"},
+	} {
+		mgr := &failingShardManager{errors: []error{e}}
+		client := NewShardPersistenceRetryableClient(mgr, s.policy, IsTransientError)
+
+		_, err := client.GetShard(&GetShardRequest{ShardID: 5})
+		s.Equal(e, err)
+		s.Equal(1, mgr.calls)
+	}
+}
+
+func (s *retryableClientSuite) TestRetryAttemptsExhausted() {
+	busy := &workflow.ServiceBusyError{Message: "busy"}
+	mgr := &failingShardManager{errors: []error{busy, busy, busy, busy, busy}}
+	client := NewShardPersistenceRetryableClient(mgr, s.policy, IsTransientError)
+
+	_, err := client.GetShard(&GetShardRequest{ShardID: 5})
+	s.Equal(busy, err)
+	s.Equal(4, mgr.calls)
+}
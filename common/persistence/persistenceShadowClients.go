@@ -0,0 +1,217 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"reflect"
+
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/common/metrics"
+)
+
+type (
+	// workflowExecutionShadowClient writes the executions to a primary and a secondary datastore, and compares the
+	// executions read from both.  The primary is the source of truth: the requests succeed or fail with it, the
+	// failures of the secondary and the divergences of its reads are only logged and counted.
+	workflowExecutionShadowClient struct {
+		primary      ExecutionManager
+		secondary    ExecutionManager
+		metricClient metrics.Client
+		logger       bark.Logger
+	}
+)
+
+var _ ExecutionManager = (*workflowExecutionShadowClient)(nil)
+
+// NewWorkflowExecutionPersistenceShadowClient creates a client to manage executions which mirrors the writes to the
+// secondary persistence once they succeeded on the primary one, and which reads the executions from both to report
+// their divergences.  The paged reads are only served by the primary, as page tokens are specific to a datastore.
+func NewWorkflowExecutionPersistenceShadowClient(primary ExecutionManager, secondary ExecutionManager,
+	metricClient metrics.Client, logger bark.Logger) ExecutionManager {
+	return &workflowExecutionShadowClient{
+		primary:      primary,
+		secondary:    secondary,
+		metricClient: metricClient,
+		logger:       logger,
+	}
+}
+
+func (p *workflowExecutionShadowClient) CreateWorkflowExecution(request *CreateWorkflowExecutionRequest) (
+	*CreateWorkflowExecutionResponse, error) {
+	response, err := p.primary.CreateWorkflowExecution(request)
+	if err == nil {
+		_, shadowErr := p.secondary.CreateWorkflowExecution(request)
+		p.checkShadowWrite(metrics.PersistenceCreateWorkflowExecutionScope, shadowErr)
+	}
+	return response, err
+}
+
+func (p *workflowExecutionShadowClient) GetWorkflowExecution(request *GetWorkflowExecutionRequest) (
+	*GetWorkflowExecutionResponse, error) {
+	response, err := p.primary.GetWorkflowExecution(request)
+	shadowResponse, shadowErr := p.secondary.GetWorkflowExecution(request)
+	p.checkShadowRead(metrics.PersistenceGetWorkflowExecutionScope, response, err, shadowResponse, shadowErr)
+	return response, err
+}
+
+func (p *workflowExecutionShadowClient) UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) (
+	*UpdateWorkflowExecutionResponse, error) {
+	response, err := p.primary.UpdateWorkflowExecution(request)
+	if err == nil {
+		_, shadowErr := p.secondary.UpdateWorkflowExecution(request)
+		p.checkShadowWrite(metrics.PersistenceUpdateWorkflowExecutionScope, shadowErr)
+	}
+	return response, err
+}
+
+func (p *workflowExecutionShadowClient) DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error {
+	err := p.primary.DeleteWorkflowExecution(request)
+	if err == nil {
+		p.checkShadowWrite(metrics.PersistenceDeleteWorkflowExecutionScope,
+			p.secondary.DeleteWorkflowExecution(request))
+	}
+	return err
+}
+
+func (p *workflowExecutionShadowClient) GetCurrentExecution(request *GetCurrentExecutionRequest) (
+	*GetCurrentExecutionResponse, error) {
+	response, err := p.primary.GetCurrentExecution(request)
+	shadowResponse, shadowErr := p.secondary.GetCurrentExecution(request)
+	p.checkShadowRead(metrics.PersistenceGetCurrentExecutionScope, response, err, shadowResponse, shadowErr)
+	return response, err
+}
+
+func (p *workflowExecutionShadowClient) ListConcreteExecutions(request *ListConcreteExecutionsRequest) (
+	*ListConcreteExecutionsResponse, error) {
+	return p.primary.ListConcreteExecutions(request)
+}
+
+func (p *workflowExecutionShadowClient) ListCurrentExecutions(request *ListCurrentExecutionsRequest) (
+	*ListCurrentExecutionsResponse, error) {
+	return p.primary.ListCurrentExecutions(request)
+}
+
+func (p *workflowExecutionShadowClient) DeleteCurrentWorkflowExecution(
+	request *DeleteCurrentWorkflowExecutionRequest) error {
+	err := p.primary.DeleteCurrentWorkflowExecution(request)
+	if err == nil {
+		p.checkShadowWrite(metrics.PersistenceDeleteCurrentWorkflowExecutionScope,
+			p.secondary.DeleteCurrentWorkflowExecution(request))
+	}
+	return err
+}
+
+func (p *workflowExecutionShadowClient) CreateCurrentWorkflowExecution(
+	request *CreateCurrentWorkflowExecutionRequest) error {
+	err := p.primary.CreateCurrentWorkflowExecution(request)
+	if err == nil {
+		p.checkShadowWrite(metrics.PersistenceCreateCurrentWorkflowExecutionScope,
+			p.secondary.CreateCurrentWorkflowExecution(request))
+	}
+	return err
+}
+
+func (p *workflowExecutionShadowClient) UpdateCurrentWorkflowExecution(
+	request *UpdateCurrentWorkflowExecutionRequest) error {
+	err := p.primary.UpdateCurrentWorkflowExecution(request)
+	if err == nil {
+		p.checkShadowWrite(metrics.PersistenceUpdateCurrentWorkflowExecutionScope,
+			p.secondary.UpdateCurrentWorkflowExecution(request))
+	}
+	return err
+}
+
+func (p *workflowExecutionShadowClient) GetTransferTasks(request *GetTransferTasksRequest) (
+	*GetTransferTasksResponse, error) {
+	return p.primary.GetTransferTasks(request)
+}
+
+func (p *workflowExecutionShadowClient) CompleteTransferTask(request *CompleteTransferTaskRequest) error {
+	err := p.primary.CompleteTransferTask(request)
+	if err == nil {
+		p.checkShadowWrite(metrics.PersistenceCompleteTransferTaskScope, p.secondary.CompleteTransferTask(request))
+	}
+	return err
+}
+
+func (p *workflowExecutionShadowClient) RangeCompleteTransferTask(request *RangeCompleteTransferTaskRequest) error {
+	err := p.primary.RangeCompleteTransferTask(request)
+	if err == nil {
+		p.checkShadowWrite(metrics.PersistenceRangeCompleteTransferTaskScope,
+			p.secondary.RangeCompleteTransferTask(request))
+	}
+	return err
+}
+
+func (p *workflowExecutionShadowClient) GetTimerIndexTasks(request *GetTimerIndexTasksRequest) (
+	*GetTimerIndexTasksResponse, error) {
+	return p.primary.GetTimerIndexTasks(request)
+}
+
+func (p *workflowExecutionShadowClient) CompleteTimerTask(request *CompleteTimerTaskRequest) error {
+	err := p.primary.CompleteTimerTask(request)
+	if err == nil {
+		p.checkShadowWrite(metrics.PersistenceCompleteTimerTaskScope, p.secondary.CompleteTimerTask(request))
+	}
+	return err
+}
+
+func (p *workflowExecutionShadowClient) RangeCompleteTimerTask(request *RangeCompleteTimerTaskRequest) error {
+	err := p.primary.RangeCompleteTimerTask(request)
+	if err == nil {
+		p.checkShadowWrite(metrics.PersistenceRangeCompleteTimerTaskScope,
+			p.secondary.RangeCompleteTimerTask(request))
+	}
+	return err
+}
+
+func (p *workflowExecutionShadowClient) Close() {
+	p.primary.Close()
+	p.secondary.Close()
+}
+
+func (p *workflowExecutionShadowClient) checkShadowWrite(scope int, err error) {
+	if err == nil {
+		return
+	}
+	p.metricClient.IncCounter(scope, metrics.PersistenceShadowWriteFailures)
+	p.logger.WithField("Scope", scope).Warnf("Write to the secondary persistence failed: %v", err)
+}
+
+// checkShadowRead reports the read from the secondary persistence if it does not return the same response or the
+// same kind of error as the read from the primary
+func (p *workflowExecutionShadowClient) checkShadowRead(scope int, response interface{}, err error,
+	shadowResponse interface{}, shadowErr error) {
+	if err != nil || shadowErr != nil {
+		if reflect.TypeOf(err) == reflect.TypeOf(shadowErr) {
+			return
+		}
+		p.metricClient.IncCounter(scope, metrics.PersistenceShadowReadDivergences)
+		p.logger.WithField("Scope", scope).Warnf("Read from the secondary persistence diverged.  Error: %v, "+
+			"Secondary error: %v", err, shadowErr)
+		return
+	}
+	if !reflect.DeepEqual(response, shadowResponse) {
+		p.metricClient.IncCounter(scope, metrics.PersistenceShadowReadDivergences)
+		p.logger.WithField("Scope", scope).Warnf("Read from the secondary persistence diverged.  Response: %+v, "+
+			"Secondary response: %+v", response, shadowResponse)
+	}
+}
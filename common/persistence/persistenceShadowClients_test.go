@@ -0,0 +1,150 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"errors"
+	"testing"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/metrics"
+)
+
+type (
+	shadowClientSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+		primary       *fakeShadowedExecutionManager
+		secondary     *fakeShadowedExecutionManager
+		metricsClient *countingMetricsClient
+		client        ExecutionManager
+	}
+
+	// fakeShadowedExecutionManager returns the configured results, and counts the creations
+	fakeShadowedExecutionManager struct {
+		ExecutionManager
+		creates     int
+		createErr   error
+		getResponse *GetWorkflowExecutionResponse
+		getErr      error
+	}
+
+	// countingMetricsClient counts the increments of the counters
+	countingMetricsClient struct {
+		metrics.Client
+		counters map[int]int
+	}
+)
+
+func TestShadowClientSuite(t *testing.T) {
+	s := new(shadowClientSuite)
+	suite.Run(t, s)
+}
+
+func (s *shadowClientSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.primary = &fakeShadowedExecutionManager{}
+	s.secondary = &fakeShadowedExecutionManager{}
+	s.metricsClient = &countingMetricsClient{counters: make(map[int]int)}
+	s.client = NewWorkflowExecutionPersistenceShadowClient(s.primary, s.secondary, s.metricsClient,
+		bark.NewLoggerFromLogrus(log.New()))
+}
+
+func (m *fakeShadowedExecutionManager) CreateWorkflowExecution(request *CreateWorkflowExecutionRequest) (
+	*CreateWorkflowExecutionResponse, error) {
+	m.creates++
+	if m.createErr != nil {
+		return nil, m.createErr
+	}
+	return &CreateWorkflowExecutionResponse{}, nil
+}
+
+func (m *fakeShadowedExecutionManager) GetWorkflowExecution(request *GetWorkflowExecutionRequest) (
+	*GetWorkflowExecutionResponse, error) {
+	return m.getResponse, m.getErr
+}
+
+func (c *countingMetricsClient) IncCounter(scope int, counter int) {
+	c.counters[counter]++
+}
+
+func (s *shadowClientSuite) TestWritesMirrored() {
+	_, err := s.client.CreateWorkflowExecution(&CreateWorkflowExecutionRequest{})
+	s.Nil(err)
+	s.Equal(1, s.primary.creates)
+	s.Equal(1, s.secondary.creates)
+	s.Equal(0, s.metricsClient.counters[metrics.PersistenceShadowWriteFailures])
+
+	// the failures of the secondary are only counted
+	s.secondary.createErr = errors.New("secondary failure")
+	_, err = s.client.CreateWorkflowExecution(&CreateWorkflowExecutionRequest{})
+	s.Nil(err)
+	s.Equal(2, s.secondary.creates)
+	s.Equal(1, s.metricsClient.counters[metrics.PersistenceShadowWriteFailures])
+
+	// the writes which failed on the primary are not mirrored
+	s.primary.createErr = &ConditionFailedError{Msg: "primary failure"}
+	_, err = s.client.CreateWorkflowExecution(&CreateWorkflowExecutionRequest{})
+	s.Equal(s.primary.createErr, err)
+	s.Equal(2, s.secondary.creates)
+	s.Equal(1, s.metricsClient.counters[metrics.PersistenceShadowWriteFailures])
+}
+
+func (s *shadowClientSuite) TestReadsCompared() {
+	newResponse := func(nextEventID int64) *GetWorkflowExecutionResponse {
+		return &GetWorkflowExecutionResponse{State: &WorkflowMutableState{
+			ExecutionInfo: &WorkflowExecutionInfo{WorkflowID: "wid", RunID: "rid", NextEventID: nextEventID},
+		}}
+	}
+	s.primary.getResponse = newResponse(5)
+	s.secondary.getResponse = newResponse(5)
+	response, err := s.client.GetWorkflowExecution(&GetWorkflowExecutionRequest{})
+	s.Nil(err)
+	s.Equal(s.primary.getResponse, response)
+	s.Equal(0, s.metricsClient.counters[metrics.PersistenceShadowReadDivergences])
+
+	s.secondary.getResponse = newResponse(4)
+	response, err = s.client.GetWorkflowExecution(&GetWorkflowExecutionRequest{})
+	s.Nil(err)
+	s.Equal(s.primary.getResponse, response)
+	s.Equal(1, s.metricsClient.counters[metrics.PersistenceShadowReadDivergences])
+
+	// both datastores not finding the execution is not a divergence, only one of them is
+	s.primary.getResponse = nil
+	s.primary.getErr = &workflow.EntityNotExistsError{Message: "primary"}
+	s.secondary.getResponse = nil
+	s.secondary.getErr = &workflow.EntityNotExistsError{Message: "secondary"}
+	_, err = s.client.GetWorkflowExecution(&GetWorkflowExecutionRequest{})
+	s.Equal(s.primary.getErr, err)
+	s.Equal(1, s.metricsClient.counters[metrics.PersistenceShadowReadDivergences])
+
+	s.secondary.getResponse = newResponse(5)
+	s.secondary.getErr = nil
+	_, err = s.client.GetWorkflowExecution(&GetWorkflowExecutionRequest{})
+	s.Equal(s.primary.getErr, err)
+	s.Equal(2, s.metricsClient.counters[metrics.PersistenceShadowReadDivergences])
+}
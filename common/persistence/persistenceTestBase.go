@@ -23,6 +23,7 @@ package persistence
 import (
 	"math"
 	"math/rand"
+	"path/filepath"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -36,6 +37,7 @@ import (
 	"github.com/uber-go/tally"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/locks"
 	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/metrics"
 )
@@ -46,6 +48,13 @@ const (
 	testSchemaDir            = "../.."
 )
 
+// testSQLSchemaFiles are the schemas of the SQL drivers, relative to the schema directory of the test options
+var testSQLSchemaFiles = map[string]string{
+	MySQLDriverName:    "schema/mysql/cadence/schema.sql",
+	PostgresDriverName: "schema/postgres/cadence/schema.sql",
+	SQLiteDriverName:   "schema/sqlite/cadence/schema.sql",
+}
+
 type (
 	// TestBaseOptions options to configure workflow test base.
 	TestBaseOptions struct {
@@ -54,6 +63,10 @@ type (
 		Datacenter   string
 		DropKeySpace bool
 		SchemaDir    string
+		// SQLDriverName sets up the stores on the SQL database of SQLDataSourceName instead of cassandra.  The
+		// database must be empty, the schema of the driver is loaded from SchemaDir.
+		SQLDriverName     string
+		SQLDataSourceName string
 	}
 
 	// TestBase wraps the base setup needed to create workflows over engine layer.
@@ -67,7 +80,10 @@ type (
 		VisibilityMgr       VisibilityManager
 		ShardInfo           *ShardInfo
 		ShardContext        *TestShardContext
-		readLevel           int64
+		// StoreOptions are the options of SetupWorkflowStore, which uses a local cassandra if they are not set
+		StoreOptions *TestBaseOptions
+		readLevel    int64
+		sqlSchema    Closeable
 		CassandraTestCluster
 	}
 
@@ -167,7 +183,8 @@ func (s *TestShardContext) CreateWorkflowExecution(request *CreateWorkflowExecut
 }
 
 // UpdateWorkflowExecution test implementation
-func (s *TestShardContext) UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) error {
+func (s *TestShardContext) UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) (
+	*UpdateWorkflowExecutionResponse, error) {
 	// assign IDs for the timer tasks. They need to be assigned under shard lock.
 	// TODO: This needs to be moved out of persistence.
 	for _, task := range request.TimerTasks {
@@ -187,6 +204,11 @@ func (s *TestShardContext) AppendHistoryEvents(request *AppendHistoryEventsReque
 	return s.historyMgr.AppendHistoryEvents(request)
 }
 
+// AppendHistoryEventsBatch test implementation
+func (s *TestShardContext) AppendHistoryEventsBatch(requests []*AppendHistoryEventsRequest) error {
+	return s.historyMgr.AppendHistoryEventsBatch(&AppendHistoryEventsBatchRequest{Requests: requests})
+}
+
 // GetLogger test implementation
 func (s *TestShardContext) GetLogger() bark.Logger {
 	return s.logger
@@ -203,11 +225,46 @@ func (s *TestShardContext) Reset() {
 	atomic.StoreInt64(&s.shardInfo.TransferAckLevel, 0)
 }
 
+// GetLockMonitor test implementation
+func (s *TestShardContext) GetLockMonitor() *locks.Monitor {
+	return nil
+}
+
+// GetHistorySerializer test implementation
+func (s *TestShardContext) GetHistorySerializer() HistorySerializer {
+	return NewJSONHistorySerializer()
+}
+
+// GetShardID test implementation
+func (s *TestShardContext) GetShardID() int {
+	return s.shardInfo.ShardID
+}
+
 // GetRangeID test implementation
 func (s *TestShardContext) GetRangeID() int64 {
 	return atomic.LoadInt64(&s.shardInfo.RangeID)
 }
 
+// GetRangeRemainingTaskIDs test implementation
+func (s *TestShardContext) GetRangeRemainingTaskIDs() int64 {
+	return math.MaxInt64 - atomic.LoadInt64(&s.transferSequenceNumber)
+}
+
+// GetTimeSinceAcquired test implementation
+func (s *TestShardContext) GetTimeSinceAcquired() time.Duration {
+	return 0
+}
+
+// GetTimeSource test implementation
+func (s *TestShardContext) GetTimeSource() common.TimeSource {
+	return common.NewRealTimeSource()
+}
+
+// ExecuteWithRangeID test implementation
+func (s *TestShardContext) ExecuteWithRangeID(operation func(rangeID int64) error) error {
+	return operation(s.GetRangeID())
+}
+
 func newTestExecutionMgrFactory(options TestBaseOptions, cassandra CassandraTestCluster,
 	logger bark.Logger) ExecutionManagerFactory {
 	return &testExecutionMgrFactory{
@@ -218,19 +275,47 @@ func newTestExecutionMgrFactory(options TestBaseOptions, cassandra CassandraTest
 }
 
 func (f *testExecutionMgrFactory) CreateExecutionManager(shardID int) (ExecutionManager, error) {
+	if f.options.SQLDriverName != "" {
+		return NewSQLWorkflowExecutionPersistence(f.options.SQLDriverName, f.options.SQLDataSourceName, 0, shardID,
+			f.logger)
+	}
 	return NewCassandraWorkflowExecutionPersistence(f.options.ClusterHost, f.options.Datacenter, f.cassandra.keyspace,
-		shardID, f.logger)
+		DefaultCassandraConsistency, shardID, metrics.NewClient(tally.NoopScope, metrics.History), f.logger)
 }
 
 // SetupWorkflowStoreWithOptions to setup workflow test base
 func (s *TestBase) SetupWorkflowStoreWithOptions(options TestBaseOptions) {
 	log := bark.NewLoggerFromLogrus(log.New())
+	shardID := 0
+	if options.SQLDriverName != "" {
+		s.setupSQLStores(options, shardID, log)
+	} else {
+		s.setupCassandraStores(options, shardID, log)
+	}
+
+	// Create a shard for test
+	s.readLevel = 0
+	s.ShardInfo = &ShardInfo{
+		ShardID:          shardID,
+		RangeID:          0,
+		TransferAckLevel: 0,
+	}
+	s.ShardContext = newTestShardContext(s.ShardInfo, 0, s.HistoryMgr, s.WorkflowMgr, log)
+	err1 := s.ShardMgr.CreateShard(&CreateShardRequest{
+		ShardInfo: s.ShardInfo,
+	})
+	if err1 != nil {
+		log.Fatal(err1)
+	}
+}
+
+func (s *TestBase) setupCassandraStores(options TestBaseOptions, shardID int, log bark.Logger) {
 	// Setup Workflow keyspace and deploy schema for tests
 	s.CassandraTestCluster.setupTestCluster(options.KeySpace, options.DropKeySpace, options.SchemaDir)
-	shardID := 0
 	var err error
 	s.ShardMgr, err = NewCassandraShardPersistence(options.ClusterHost, options.Datacenter,
-		s.CassandraTestCluster.keyspace, log)
+		s.CassandraTestCluster.keyspace,
+		DefaultCassandraConsistency, log)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -241,41 +326,67 @@ func (s *TestBase) SetupWorkflowStoreWithOptions(options TestBaseOptions) {
 		log.Fatal(err)
 	}
 	s.TaskMgr, err = NewCassandraTaskPersistence(options.ClusterHost, options.Datacenter, s.CassandraTestCluster.keyspace,
-		log)
+		DefaultCassandraConsistency, metrics.NewClient(tally.NoopScope, metrics.Matching), log)
 	if err != nil {
 		log.Fatal(err)
 	}
 
 	s.HistoryMgr, err = NewCassandraHistoryPersistence(options.ClusterHost, options.Datacenter,
-		s.CassandraTestCluster.keyspace, log)
+		s.CassandraTestCluster.keyspace,
+		DefaultCassandraConsistency, metrics.NewClient(tally.NoopScope, metrics.History), log)
 	if err != nil {
 		log.Fatal(err)
 	}
 
 	s.MetadataManager, err = NewCassandraMetadataPersistence(options.ClusterHost, options.Datacenter,
-		s.CassandraTestCluster.keyspace, log)
+		s.CassandraTestCluster.keyspace,
+		DefaultCassandraConsistency, log)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	s.VisibilityMgr, err = NewCassandraVisibilityPersistence(options.ClusterHost, options.Datacenter, s.CassandraTestCluster.keyspace, log)
+	s.VisibilityMgr, err = NewCassandraVisibilityPersistence(options.ClusterHost, options.Datacenter, s.CassandraTestCluster.keyspace,
+		DefaultCassandraConsistency, log)
 	if err != nil {
 		log.Fatal(err)
 	}
+}
 
-	// Create a shard for test
-	s.readLevel = 0
-	s.ShardInfo = &ShardInfo{
-		ShardID:          shardID,
-		RangeID:          0,
-		TransferAckLevel: 0,
+func (s *TestBase) setupSQLStores(options TestBaseOptions, shardID int, log bark.Logger) {
+	driverName := options.SQLDriverName
+	dataSourceName := options.SQLDataSourceName
+	var err error
+	s.sqlSchema, err = LoadSQLSchema(driverName, dataSourceName,
+		filepath.Join(options.SchemaDir, testSQLSchemaFiles[driverName]))
+	if err != nil {
+		log.Fatal(err)
 	}
-	s.ShardContext = newTestShardContext(s.ShardInfo, 0, s.HistoryMgr, s.WorkflowMgr, log)
-	err1 := s.ShardMgr.CreateShard(&CreateShardRequest{
-		ShardInfo: s.ShardInfo,
-	})
-	if err1 != nil {
-		log.Fatal(err1)
+
+	s.ShardMgr, err = NewSQLShardPersistence(driverName, dataSourceName, 0, log)
+	if err != nil {
+		log.Fatal(err)
+	}
+	s.ExecutionMgrFactory = newTestExecutionMgrFactory(options, s.CassandraTestCluster, log)
+	// Create an ExecutionManager for the shard for use in unit tests
+	s.WorkflowMgr, err = s.ExecutionMgrFactory.CreateExecutionManager(shardID)
+	if err != nil {
+		log.Fatal(err)
+	}
+	s.TaskMgr, err = NewSQLTaskPersistence(driverName, dataSourceName, 0, log)
+	if err != nil {
+		log.Fatal(err)
+	}
+	s.HistoryMgr, err = NewSQLHistoryPersistence(driverName, dataSourceName, 0, log)
+	if err != nil {
+		log.Fatal(err)
+	}
+	s.MetadataManager, err = NewSQLMetadataPersistence(driverName, dataSourceName, 0, log)
+	if err != nil {
+		log.Fatal(err)
+	}
+	s.VisibilityMgr, err = NewSQLVisibilityPersistence(driverName, dataSourceName, 0, log)
+	if err != nil {
+		log.Fatal(err)
 	}
 }
 
@@ -465,6 +576,29 @@ func (s *TestBase) GetCurrentWorkflow(domainID, workflowID string) (string, erro
 	return response.RunID, nil
 }
 
+// CreateCurrentWorkflow is a utility method to point the current execution of a workflow at a run
+func (s *TestBase) CreateCurrentWorkflow(domainID, workflowID, runID string) error {
+	return s.WorkflowMgr.CreateCurrentWorkflowExecution(&CreateCurrentWorkflowExecutionRequest{
+		RangeID:    s.ShardContext.GetRangeID(),
+		DomainID:   domainID,
+		WorkflowID: workflowID,
+		RunID:      runID,
+		RequestID:  uuid.New(),
+	})
+}
+
+// UpdateCurrentWorkflow is a utility method to point the current execution of a workflow at another run
+func (s *TestBase) UpdateCurrentWorkflow(domainID, workflowID, runID, previousRunID string) error {
+	return s.WorkflowMgr.UpdateCurrentWorkflowExecution(&UpdateCurrentWorkflowExecutionRequest{
+		RangeID:       s.ShardContext.GetRangeID(),
+		DomainID:      domainID,
+		WorkflowID:    workflowID,
+		RunID:         runID,
+		RequestID:     uuid.New(),
+		PreviousRunID: previousRunID,
+	})
+}
+
 // ContinueAsNewExecution is a utility method to create workflow executions
 func (s *TestBase) ContinueAsNewExecution(updatedInfo *WorkflowExecutionInfo, condition int64,
 	newExecution workflow.WorkflowExecution, nextEventID, decisionScheduleID int64) error {
@@ -475,7 +609,7 @@ func (s *TestBase) ContinueAsNewExecution(updatedInfo *WorkflowExecutionInfo, co
 		ScheduleID: int64(decisionScheduleID),
 	}
 
-	return s.WorkflowMgr.UpdateWorkflowExecution(&UpdateWorkflowExecutionRequest{
+	_, err := s.WorkflowMgr.UpdateWorkflowExecution(&UpdateWorkflowExecutionRequest{
 		ExecutionInfo:       updatedInfo,
 		TransferTasks:       []Task{newdecisionTask},
 		TimerTasks:          nil,
@@ -505,6 +639,7 @@ func (s *TestBase) ContinueAsNewExecution(updatedInfo *WorkflowExecutionInfo, co
 			ContinueAsNew:               true,
 		},
 	})
+	return err
 }
 
 // UpdateWorkflowExecution is a utility method to update workflow execution
@@ -521,7 +656,7 @@ func (s *TestBase) UpdateWorkflowExecution(updatedInfo *WorkflowExecutionInfo, d
 func (s *TestBase) UpdateWorkflowExecutionAndDelete(updatedInfo *WorkflowExecutionInfo, condition int64) error {
 	transferTasks := []Task{}
 	transferTasks = append(transferTasks, &DeleteExecutionTask{TaskID: s.GetNextSequenceNumber()})
-	return s.WorkflowMgr.UpdateWorkflowExecution(&UpdateWorkflowExecutionRequest{
+	_, err := s.WorkflowMgr.UpdateWorkflowExecution(&UpdateWorkflowExecutionRequest{
 		ExecutionInfo:       updatedInfo,
 		TransferTasks:       transferTasks,
 		TimerTasks:          nil,
@@ -534,6 +669,7 @@ func (s *TestBase) UpdateWorkflowExecutionAndDelete(updatedInfo *WorkflowExecuti
 		DeleteTimerInfos:    nil,
 		CloseExecution:      true,
 	})
+	return err
 }
 
 // UpsertChildExecutionsState is a utility method to update mutable state of workflow execution
@@ -552,6 +688,30 @@ func (s *TestBase) DeleteChildExecutionsState(updatedInfo *WorkflowExecutionInfo
 		nil, nil, nil, &deleteChildInfo)
 }
 
+// UpsertSignalRequestedState is a utility method to add the IDs of signal requests to mutable state
+func (s *TestBase) UpsertSignalRequestedState(updatedInfo *WorkflowExecutionInfo, condition int64,
+	upsertSignalRequestedIDs []string) error {
+	_, err := s.WorkflowMgr.UpdateWorkflowExecution(&UpdateWorkflowExecutionRequest{
+		ExecutionInfo:            updatedInfo,
+		Condition:                condition,
+		RangeID:                  s.ShardContext.GetRangeID(),
+		UpsertSignalRequestedIDs: upsertSignalRequestedIDs,
+	})
+	return err
+}
+
+// DeleteSignalRequestedState is a utility method to delete the IDs of signal requests from mutable state
+func (s *TestBase) DeleteSignalRequestedState(updatedInfo *WorkflowExecutionInfo, condition int64,
+	deleteSignalRequestedIDs []string) error {
+	_, err := s.WorkflowMgr.UpdateWorkflowExecution(&UpdateWorkflowExecutionRequest{
+		ExecutionInfo:            updatedInfo,
+		Condition:                condition,
+		RangeID:                  s.ShardContext.GetRangeID(),
+		DeleteSignalRequestedIDs: deleteSignalRequestedIDs,
+	})
+	return err
+}
+
 // UpdateWorkflowExecutionWithRangeID is a utility method to update workflow execution
 func (s *TestBase) UpdateWorkflowExecutionWithRangeID(updatedInfo *WorkflowExecutionInfo, decisionScheduleIDs []int64,
 	activityScheduleIDs []int64, rangeID, condition int64, timerTasks []Task, deleteTimerTask Task,
@@ -574,7 +734,7 @@ func (s *TestBase) UpdateWorkflowExecutionWithRangeID(updatedInfo *WorkflowExecu
 			ScheduleID: int64(activityScheduleID)})
 	}
 
-	return s.WorkflowMgr.UpdateWorkflowExecution(&UpdateWorkflowExecutionRequest{
+	_, err := s.WorkflowMgr.UpdateWorkflowExecution(&UpdateWorkflowExecutionRequest{
 		ExecutionInfo:             updatedInfo,
 		TransferTasks:             transferTasks,
 		TimerTasks:                timerTasks,
@@ -588,18 +748,20 @@ func (s *TestBase) UpdateWorkflowExecutionWithRangeID(updatedInfo *WorkflowExecu
 		UpsertChildExecutionInfos: upsertChildInfos,
 		DeleteChildExecutionInfo:  deleteChildInfo,
 	})
+	return err
 }
 
 // UpdateWorkflowExecutionWithTransferTasks is a utility method to update workflow execution
 func (s *TestBase) UpdateWorkflowExecutionWithTransferTasks(
 	updatedInfo *WorkflowExecutionInfo, condition int64, transferTasks []Task, upsertActivityInfo []*ActivityInfo) error {
-	return s.WorkflowMgr.UpdateWorkflowExecution(&UpdateWorkflowExecutionRequest{
+	_, err := s.WorkflowMgr.UpdateWorkflowExecution(&UpdateWorkflowExecutionRequest{
 		ExecutionInfo:       updatedInfo,
 		TransferTasks:       transferTasks,
 		Condition:           condition,
 		UpsertActivityInfos: upsertActivityInfo,
 		RangeID:             s.ShardContext.GetRangeID(),
 	})
+	return err
 }
 
 // DeleteWorkflowExecution is a utility method to delete a workflow execution
@@ -636,6 +798,14 @@ func (s *TestBase) CompleteTransferTask(taskID int64) error {
 	})
 }
 
+// RangeCompleteTransferTask is a utility method to complete a range of transfer tasks
+func (s *TestBase) RangeCompleteTransferTask(exclusiveBeginTaskID int64, inclusiveEndTaskID int64) error {
+	return s.WorkflowMgr.RangeCompleteTransferTask(&RangeCompleteTransferTaskRequest{
+		ExclusiveBeginTaskID: exclusiveBeginTaskID,
+		InclusiveEndTaskID:   inclusiveEndTaskID,
+	})
+}
+
 // GetTimerIndexTasks is a utility method to get tasks from transfer task queue
 func (s *TestBase) GetTimerIndexTasks() ([]*TimerTaskInfo, error) {
 	response, err := s.WorkflowMgr.GetTimerIndexTasks(&GetTimerIndexTasksRequest{
@@ -658,6 +828,14 @@ func (s *TestBase) CompleteTimerTask(ts time.Time, taskID int64) error {
 	})
 }
 
+// RangeCompleteTimerTask is a utility method to complete a range of timer tasks
+func (s *TestBase) RangeCompleteTimerTask(inclusiveBeginTimestamp time.Time, exclusiveEndTimestamp time.Time) error {
+	return s.WorkflowMgr.RangeCompleteTimerTask(&RangeCompleteTimerTaskRequest{
+		InclusiveBeginTimestamp: inclusiveBeginTimestamp,
+		ExclusiveEndTimestamp:   exclusiveEndTimestamp,
+	})
+}
+
 // CreateDecisionTask is a utility method to create a task
 func (s *TestBase) CreateDecisionTask(domainID string, workflowExecution workflow.WorkflowExecution, taskList string,
 	decisionScheduleID int64) (int64, error) {
@@ -819,6 +997,10 @@ func (s *TestBase) ClearTransferQueue() {
 
 // SetupWorkflowStore to setup workflow test base
 func (s *TestBase) SetupWorkflowStore() {
+	if s.StoreOptions != nil {
+		s.SetupWorkflowStoreWithOptions(*s.StoreOptions)
+		return
+	}
 	s.SetupWorkflowStoreWithOptions(TestBaseOptions{
 		SchemaDir:    testSchemaDir,
 		ClusterHost:  testWorkflowClusterHosts,
@@ -828,6 +1010,10 @@ func (s *TestBase) SetupWorkflowStore() {
 
 // TearDownWorkflowStore to cleanup
 func (s *TestBase) TearDownWorkflowStore() {
+	if s.sqlSchema != nil {
+		s.sqlSchema.Close()
+		return
+	}
 	s.CassandraTestCluster.tearDownTestCluster()
 }
 
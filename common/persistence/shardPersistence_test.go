@@ -95,6 +95,17 @@ func (s *shardPersistenceSuite) TestGetShard() {
 	log.Infof("GetShard failed with error: %v", err2)
 }
 
+func (s *shardPersistenceSuite) TestGetServerTime() {
+	before := time.Now()
+	response, err := s.ShardMgr.GetServerTime()
+	after := time.Now()
+	s.Nil(err)
+	s.NotNil(response)
+	// the test datastore runs on the same host, so the server time only differs by its millisecond precision
+	s.False(response.Time.Before(before.Add(-time.Second)))
+	s.False(response.Time.After(after.Add(time.Second)))
+}
+
 func (s *shardPersistenceSuite) TestUpdateShard() {
 	shardID := 30
 	owner := "test_update_shard"
@@ -0,0 +1,341 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"sync"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+)
+
+const (
+	// MySQLDriverName is the database/sql driver used by the MySQL persistence.  The driver is only linked into
+	// binaries built with the mysql build tag.
+	MySQLDriverName = "mysql"
+	// PostgresDriverName is the database/sql driver used by the PostgreSQL persistence.  The driver is only linked
+	// into binaries built with the postgres build tag.
+	PostgresDriverName = "postgres"
+	// SQLiteDriverName is the database/sql driver used by the SQLite persistence.  The driver is only linked into
+	// binaries built with the sqlite build tag.
+	SQLiteDriverName = "sqlite3"
+
+	sqlInsertPrefix      = "INSERT INTO "
+	sqlOnConflictSuffix  = " ON CONFLICT DO NOTHING"
+	sqlForShareSuffix    = " FOR SHARE"
+	sqlForUpdateSuffix   = " FOR UPDATE"
+	mysqlInsertIgnore    = "INSERT IGNORE INTO "
+	mysqlLockInShareMode = " LOCK IN SHARE MODE"
+	sqliteInsertIgnore   = "INSERT OR IGNORE INTO "
+
+	mysqlServerTimeQuery    = "SELECT CAST(UNIX_TIMESTAMP(NOW(3)) * 1000 AS SIGNED)"
+	postgresServerTimeQuery = "SELECT CAST(EXTRACT(EPOCH FROM CLOCK_TIMESTAMP()) * 1000 AS BIGINT)"
+	sqliteServerTimeQuery   = "SELECT CAST((JULIANDAY('now') - 2440587.5) * 86400000 AS INTEGER)"
+)
+
+type (
+	// sqlDialect rewrites the queries of the SQL persistence, which are written with ? placeholders,
+	// ON CONFLICT DO NOTHING for inserts of rows which may already exist and FOR SHARE for read locks, into the
+	// syntax understood by a database.  It also provides the queries which have no common syntax.
+	sqlDialect interface {
+		bind(query string) string
+		// serverTimeQuery returns a query selecting the current time of the database in milliseconds since epoch
+		serverTimeQuery() string
+	}
+
+	mysqlDialect struct{}
+
+	postgresDialect struct{}
+
+	// sqliteDialect drops row locks.  SQLite databases are opened with a single connection (see newSQLiteDB), so
+	// transactions never run concurrently.
+	sqliteDialect struct{}
+
+	// sqlDB is a database handle which rewrites every query into the dialect of its driver
+	sqlDB struct {
+		*sql.DB
+		dialect sqlDialect
+		// release replaces closing the database when the handle is shared
+		release func()
+	}
+
+	// sqlSchemaHandle keeps the database of a loaded schema open
+	sqlSchemaHandle struct {
+		db *sqlDB
+	}
+
+	// sharedSQLDB is a database opened once per data source and shared by every persistence manager of the process
+	sharedSQLDB struct {
+		db   *sql.DB
+		refs int
+	}
+
+	// sqlTx is a transaction which rewrites every query into the dialect of its driver
+	sqlTx struct {
+		*sql.Tx
+		dialect sqlDialect
+	}
+)
+
+var sqlDialects = map[string]sqlDialect{
+	MySQLDriverName:    &mysqlDialect{},
+	PostgresDriverName: &postgresDialect{},
+	SQLiteDriverName:   &sqliteDialect{},
+}
+
+var (
+	sqliteDBsLock sync.Mutex
+	sqliteDBs     = make(map[string]*sharedSQLDB)
+)
+
+func (d *mysqlDialect) bind(query string) string {
+	if strings.HasSuffix(query, sqlOnConflictSuffix) {
+		query = mysqlInsertIgnore + strings.TrimPrefix(strings.TrimSuffix(query, sqlOnConflictSuffix), sqlInsertPrefix)
+	}
+	if strings.HasSuffix(query, sqlForShareSuffix) {
+		query = strings.TrimSuffix(query, sqlForShareSuffix) + mysqlLockInShareMode
+	}
+	return query
+}
+
+func (d *mysqlDialect) serverTimeQuery() string {
+	return mysqlServerTimeQuery
+}
+
+func (d *postgresDialect) bind(query string) string {
+	var b bytes.Buffer
+	n := 0
+	for _, c := range query {
+		if c == '?' {
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
+
+func (d *postgresDialect) serverTimeQuery() string {
+	return postgresServerTimeQuery
+}
+
+func (d *sqliteDialect) bind(query string) string {
+	if strings.HasSuffix(query, sqlOnConflictSuffix) {
+		query = sqliteInsertIgnore + strings.TrimPrefix(strings.TrimSuffix(query, sqlOnConflictSuffix), sqlInsertPrefix)
+	}
+	query = strings.TrimSuffix(query, sqlForShareSuffix)
+	return strings.TrimSuffix(query, sqlForUpdateSuffix)
+}
+
+func (d *sqliteDialect) serverTimeQuery() string {
+	return sqliteServerTimeQuery
+}
+
+func newSQLDB(driverName, dataSourceName string, maxConns int) (*sqlDB, error) {
+	dialect, ok := sqlDialects[driverName]
+	if !ok {
+		return nil, fmt.Errorf("unsupported SQL driver: %v", driverName)
+	}
+
+	if driverName == SQLiteDriverName {
+		return newSQLiteDB(dataSourceName)
+	}
+
+	db, err := sql.Open(driverName, dataSourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	if maxConns > 0 {
+		db.SetMaxOpenConns(maxConns)
+		db.SetMaxIdleConns(maxConns)
+	}
+
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &sqlDB{DB: db, dialect: dialect}, nil
+}
+
+// newSQLiteDB returns a handle on the SQLite database of the data source, which is shared with every other handle on
+// the same data source.  The database has a single connection: SQLite locks the whole database on writes, so
+// concurrent transactions would fail instead of waiting for each other, and an in-memory database only lives as long
+// as one of its connections.  The database is closed when its last handle is closed.
+func newSQLiteDB(dataSourceName string) (*sqlDB, error) {
+	sqliteDBsLock.Lock()
+	defer sqliteDBsLock.Unlock()
+
+	shared, ok := sqliteDBs[dataSourceName]
+	if !ok {
+		db, err := sql.Open(SQLiteDriverName, dataSourceName)
+		if err != nil {
+			return nil, err
+		}
+
+		db.SetMaxOpenConns(1)
+		db.SetMaxIdleConns(1)
+		if err := db.Ping(); err != nil {
+			db.Close()
+			return nil, err
+		}
+
+		shared = &sharedSQLDB{db: db}
+		sqliteDBs[dataSourceName] = shared
+	}
+
+	shared.refs++
+	return &sqlDB{
+		DB:      shared.db,
+		dialect: sqlDialects[SQLiteDriverName],
+		release: func() {
+			sqliteDBsLock.Lock()
+			defer sqliteDBsLock.Unlock()
+
+			shared.refs--
+			if shared.refs == 0 {
+				shared.db.Close()
+				delete(sqliteDBs, dataSourceName)
+			}
+		},
+	}, nil
+}
+
+// Close closes the database, or releases it if the database is shared
+func (db *sqlDB) Close() error {
+	if db.release != nil {
+		db.release()
+		return nil
+	}
+	return db.DB.Close()
+}
+
+func (db *sqlDB) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return db.DB.Exec(db.dialect.bind(query), args...)
+}
+
+func (db *sqlDB) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return db.DB.Query(db.dialect.bind(query), args...)
+}
+
+func (db *sqlDB) QueryRow(query string, args ...interface{}) *sql.Row {
+	return db.DB.QueryRow(db.dialect.bind(query), args...)
+}
+
+func (db *sqlDB) Begin() (*sqlTx, error) {
+	tx, err := db.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	return &sqlTx{Tx: tx, dialect: db.dialect}, nil
+}
+
+func (tx *sqlTx) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return tx.Tx.Exec(tx.dialect.bind(query), args...)
+}
+
+func (tx *sqlTx) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return tx.Tx.Query(tx.dialect.bind(query), args...)
+}
+
+func (tx *sqlTx) QueryRow(query string, args ...interface{}) *sql.Row {
+	return tx.Tx.QueryRow(tx.dialect.bind(query), args...)
+}
+
+// convertSQLError converts an error returned by database/sql for the given operation into the persistence error
+// describing the failure.  Connection failures are reported as UnavailableError, anything else as
+// InternalServiceError.
+func convertSQLError(operation string, err error) error {
+	message := fmt.Sprintf("%v operation failed. Error: %v", operation, err)
+	if err == driver.ErrBadConn || err == sql.ErrConnDone {
+		return &UnavailableError{Msg: message}
+	}
+	return &workflow.InternalServiceError{Message: message}
+}
+
+// sqlTxExecute runs fn within a transaction which is committed only if fn succeeds.  Errors other than the
+// persistence and service errors returned by fn are converted into InternalServiceError for the operation.
+func sqlTxExecute(db *sqlDB, operation string, fn func(tx *sqlTx) error) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("%v operation failed. Failed to start transaction. Error: %v", operation, err),
+		}
+	}
+
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		switch err.(type) {
+		case *ConditionFailedError, *CurrentWorkflowConditionFailedError, *ShardOwnershipLostError, *UnavailableError,
+			*workflow.WorkflowExecutionAlreadyStartedError, *workflow.EntityNotExistsError,
+			*workflow.DomainAlreadyExistsError, *workflow.BadRequestError:
+			return err
+		}
+		return convertSQLError(operation, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("%v operation failed. Failed to commit transaction. Error: %v", operation, err),
+		}
+	}
+
+	return nil
+}
+
+// LoadSQLSchema runs the statements of a schema file, separated by semicolons, against the database of the data
+// source.  It is meant for databases created on startup, like the in-memory SQLite database of a development
+// server: the returned handle keeps a shared database open until it is closed.
+func LoadSQLSchema(driverName, dataSourceName, schemaFile string) (Closeable, error) {
+	content, err := ioutil.ReadFile(schemaFile)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := newSQLDB(driverName, dataSourceName, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, stmt := range strings.Split(string(content), ";") {
+		if len(strings.TrimSpace(stmt)) == 0 {
+			continue
+		}
+		if _, err := db.DB.Exec(stmt); err != nil {
+			db.Close()
+			return nil, fmt.Errorf("failed to load schema %v. Error: %v", schemaFile, err)
+		}
+	}
+
+	return &sqlSchemaHandle{db: db}, nil
+}
+
+func (h *sqlSchemaHandle) Close() {
+	h.db.Close()
+}
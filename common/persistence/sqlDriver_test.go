@@ -0,0 +1,74 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	sqlDriverSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+	}
+)
+
+func TestSQLDriverSuite(t *testing.T) {
+	s := new(sqlDriverSuite)
+	suite.Run(t, s)
+}
+
+func (s *sqlDriverSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *sqlDriverSuite) TestMySQLBind() {
+	dialect := sqlDialects[MySQLDriverName]
+	s.Equal("INSERT IGNORE INTO task_lists (domain_id, name, task_type, range_id, ack_level) VALUES (?, ?, ?, ?, ?)",
+		dialect.bind(sqlCreateTaskListQuery))
+	s.Equal("SELECT range_id FROM shards WHERE shard_id = ? LOCK IN SHARE MODE", dialect.bind(sqlReadLockShardQuery))
+	s.Equal(sqlLockShardQuery, dialect.bind(sqlLockShardQuery))
+}
+
+func (s *sqlDriverSuite) TestPostgresBind() {
+	dialect := sqlDialects[PostgresDriverName]
+	s.Equal("INSERT INTO task_lists (domain_id, name, task_type, range_id, ack_level) VALUES ($1, $2, $3, $4, $5) "+
+		"ON CONFLICT DO NOTHING", dialect.bind(sqlCreateTaskListQuery))
+	s.Equal("SELECT range_id FROM shards WHERE shard_id = $1 FOR SHARE", dialect.bind(sqlReadLockShardQuery))
+}
+
+func (s *sqlDriverSuite) TestSQLiteBind() {
+	dialect := sqlDialects[SQLiteDriverName]
+	s.Equal("INSERT OR IGNORE INTO task_lists (domain_id, name, task_type, range_id, ack_level) VALUES (?, ?, ?, ?, ?)",
+		dialect.bind(sqlCreateTaskListQuery))
+	s.Equal("SELECT range_id FROM shards WHERE shard_id = ?", dialect.bind(sqlReadLockShardQuery))
+	s.Equal("SELECT range_id FROM shards WHERE shard_id = ?", dialect.bind(sqlLockShardQuery))
+}
+
+func (s *sqlDriverSuite) TestUnsupportedDriver() {
+	_, err := newSQLDB("oracle", "", 0)
+	s.Error(err)
+}
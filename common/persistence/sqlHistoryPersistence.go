@@ -0,0 +1,329 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"database/sql"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+
+	"github.com/uber-common/bark"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+const (
+	sqlAppendHistoryEventsQuery = `INSERT INTO events (` +
+		`domain_id, workflow_id, run_id, first_event_id, range_id, tx_id, data, data_encoding, data_version) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?) ON CONFLICT DO NOTHING`
+
+	sqlOverwriteHistoryEventsQuery = `UPDATE events ` +
+		`SET range_id = ?, tx_id = ?, data = ?, data_encoding = ?, data_version = ? ` +
+		`WHERE domain_id = ? AND workflow_id = ? AND run_id = ? AND first_event_id = ? ` +
+		`AND range_id <= ? AND tx_id < ?`
+
+	sqlGetWorkflowExecutionHistoryQuery = `SELECT first_event_id, data, data_encoding, data_version FROM events ` +
+		`WHERE domain_id = ? AND workflow_id = ? AND run_id = ? AND first_event_id > ? AND first_event_id < ? ` +
+		`ORDER BY first_event_id LIMIT ?`
+
+	sqlDeleteWorkflowExecutionHistoryQuery = `DELETE FROM events ` +
+		`WHERE domain_id = ? AND workflow_id = ? AND run_id = ?`
+
+	sqlInsertHistoryNodeQuery = `INSERT INTO history_node (` +
+		`tree_id, branch_id, node_id, txn_id, data, data_encoding, data_version) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?) ON CONFLICT DO NOTHING`
+
+	sqlReadHistoryNodesQuery = `SELECT node_id, txn_id, data, data_encoding, data_version FROM history_node ` +
+		`WHERE tree_id = ? AND branch_id = ? AND node_id >= ? AND node_id < ? ` +
+		`ORDER BY node_id, txn_id DESC LIMIT ?`
+
+	sqlDeleteHistoryNodesQuery = `DELETE FROM history_node ` +
+		`WHERE tree_id = ? AND branch_id = ? AND node_id >= ?`
+
+	sqlInsertHistoryBranchQuery = `INSERT INTO history_tree (tree_id, branch_id, ancestors) VALUES (?, ?, ?)`
+
+	sqlGetHistoryBranchesQuery = `SELECT branch_id, ancestors FROM history_tree WHERE tree_id = ?`
+
+	sqlDeleteHistoryBranchQuery = `DELETE FROM history_tree WHERE tree_id = ? AND branch_id = ?`
+)
+
+type (
+	sqlHistoryPersistence struct {
+		db     *sqlDB
+		logger bark.Logger
+	}
+)
+
+var _ historyBranchStore = (*sqlHistoryPersistence)(nil)
+
+// NewSQLHistoryPersistence is used to create an instance of HistoryManager implementation
+func NewSQLHistoryPersistence(driverName, dataSourceName string, maxConns int, logger bark.Logger) (HistoryManager,
+	error) {
+	db, err := newSQLDB(driverName, dataSourceName, maxConns)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sqlHistoryPersistence{db: db, logger: logger}, nil
+}
+
+// Close gracefully releases the resources held by this object
+func (h *sqlHistoryPersistence) Close() {
+	if h.db != nil {
+		h.db.Close()
+	}
+}
+
+func (h *sqlHistoryPersistence) AppendHistoryEvents(request *AppendHistoryEventsRequest) error {
+	err := appendHistoryEvents(h.db, request)
+	if err != nil {
+		if _, ok := err.(*ConditionFailedError); ok {
+			return err
+		}
+		return convertSQLError("AppendHistoryEvents", err)
+	}
+	return nil
+}
+
+func (h *sqlHistoryPersistence) AppendHistoryEventsBatch(request *AppendHistoryEventsBatchRequest) error {
+	return sqlTxExecute(h.db, "AppendHistoryEventsBatch", func(tx *sqlTx) error {
+		for _, r := range request.Requests {
+			if err := appendHistoryEvents(tx, r); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (h *sqlHistoryPersistence) GetWorkflowExecutionHistory(request *GetWorkflowExecutionHistoryRequest) (
+	*GetWorkflowExecutionHistoryResponse, error) {
+	pageState, err := deserializePageToken(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// The page state is the first event ID of the last batch returned
+	lastFirstEventID := common.FirstEventID - 1
+	if len(pageState) > 0 {
+		if len(pageState) != 8 {
+			return nil, ErrInvalidPageToken
+		}
+		lastFirstEventID = int64(binary.BigEndian.Uint64(pageState))
+	}
+
+	execution := request.Execution
+	pageSize := getPageSize(request.PageSize)
+	args := []interface{}{
+		request.DomainID,
+		execution.GetWorkflowId(),
+		execution.GetRunId(),
+		lastFirstEventID,
+		request.NextEventID,
+		pageSize + 1, // one extra batch tells if there is a next page
+	}
+
+	response := &GetWorkflowExecutionHistoryResponse{NextPageToken: []byte{}}
+	found := false
+	if err := sqlQueryEach(h.db, sqlGetWorkflowExecutionHistoryQuery, args, func(row sqlScanner) error {
+		var firstEventID int64
+		var history SerializedHistoryEventBatch
+		if err := row.Scan(&firstEventID, &history.Data, &history.EncodingType, &history.Version); err != nil {
+			return err
+		}
+
+		found = true
+		if len(response.Events) == pageSize {
+			pageState = make([]byte, 8)
+			binary.BigEndian.PutUint64(pageState, uint64(lastFirstEventID))
+			response.NextPageToken = serializePageToken(pageState)
+			return nil
+		}
+
+		lastFirstEventID = firstEventID
+		response.Events = append(response.Events, history)
+		return nil
+	}); err != nil {
+		return nil, convertSQLError("GetWorkflowExecutionHistory", err)
+	}
+
+	if !found {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Workflow execution history not found.  WorkflowId: %v, RunId: %v",
+				execution.GetWorkflowId(), execution.GetRunId()),
+		}
+	}
+
+	return response, nil
+}
+
+func (h *sqlHistoryPersistence) DeleteWorkflowExecutionHistory(
+	request *DeleteWorkflowExecutionHistoryRequest) error {
+	execution := request.Execution
+	if _, err := h.db.Exec(sqlDeleteWorkflowExecutionHistoryQuery,
+		request.DomainID,
+		execution.GetWorkflowId(),
+		execution.GetRunId()); err != nil {
+		return convertSQLError("DeleteWorkflowExecutionHistory", err)
+	}
+
+	return nil
+}
+
+func (h *sqlHistoryPersistence) AppendHistoryNodes(request *AppendHistoryNodesRequest) error {
+	return appendHistoryNodes(h, request)
+}
+
+func (h *sqlHistoryPersistence) ReadHistoryBranch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchResponse,
+	error) {
+	return readHistoryBranch(h, request)
+}
+
+func (h *sqlHistoryPersistence) ForkHistoryBranch(request *ForkHistoryBranchRequest) (*ForkHistoryBranchResponse,
+	error) {
+	return forkHistoryBranch(h, request)
+}
+
+func (h *sqlHistoryPersistence) DeleteHistoryBranch(request *DeleteHistoryBranchRequest) error {
+	return deleteHistoryBranch(h, request)
+}
+
+func (h *sqlHistoryPersistence) GetHistoryTree(request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error) {
+	return getHistoryTree(h, request)
+}
+
+func (h *sqlHistoryPersistence) insertHistoryNode(branch *HistoryBranch, nodeID int64, txnID int64,
+	events *SerializedHistoryEventBatch) error {
+	_, err := h.db.Exec(sqlInsertHistoryNodeQuery,
+		branch.TreeID,
+		branch.BranchID,
+		nodeID,
+		txnID,
+		events.Data,
+		events.EncodingType,
+		events.Version)
+	return err
+}
+
+func (h *sqlHistoryPersistence) readHistoryNodes(treeID string, branchID string, minNodeID int64, maxNodeID int64,
+	limit int) ([]*historyNode, error) {
+	var nodes []*historyNode
+	args := []interface{}{treeID, branchID, minNodeID, maxNodeID, limit}
+	err := sqlQueryEach(h.db, sqlReadHistoryNodesQuery, args, func(row sqlScanner) error {
+		node := &historyNode{}
+		if err := row.Scan(&node.nodeID, &node.txnID, &node.events.Data, &node.events.EncodingType,
+			&node.events.Version); err != nil {
+			return err
+		}
+		nodes = append(nodes, node)
+		return nil
+	})
+	return nodes, err
+}
+
+func (h *sqlHistoryPersistence) deleteHistoryNodes(treeID string, branchID string, minNodeID int64) error {
+	_, err := h.db.Exec(sqlDeleteHistoryNodesQuery, treeID, branchID, minNodeID)
+	return err
+}
+
+func (h *sqlHistoryPersistence) insertHistoryBranch(branch *HistoryBranch) error {
+	// The ancestors are only read along with the branch, so they are stored as a JSON blob
+	ancestors, err := json.Marshal(branch.Ancestors)
+	if err != nil {
+		return err
+	}
+
+	_, err = h.db.Exec(sqlInsertHistoryBranchQuery, branch.TreeID, branch.BranchID, ancestors)
+	return err
+}
+
+func (h *sqlHistoryPersistence) getHistoryBranches(treeID string) ([]*HistoryBranch, error) {
+	var branches []*HistoryBranch
+	err := sqlQueryEach(h.db, sqlGetHistoryBranchesQuery, []interface{}{treeID}, func(row sqlScanner) error {
+		branch := &HistoryBranch{TreeID: treeID}
+		var ancestors []byte
+		if err := row.Scan(&branch.BranchID, &ancestors); err != nil {
+			return err
+		}
+		if err := json.Unmarshal(ancestors, &branch.Ancestors); err != nil {
+			return err
+		}
+		branches = append(branches, branch)
+		return nil
+	})
+	return branches, err
+}
+
+func (h *sqlHistoryPersistence) deleteHistoryBranch(treeID string, branchID string) error {
+	_, err := h.db.Exec(sqlDeleteHistoryBranchQuery, treeID, branchID)
+	return err
+}
+
+func (h *sqlHistoryPersistence) convertHistoryBranchError(operation string, err error) error {
+	return convertSQLError(operation, err)
+}
+
+// appendHistoryEvents returns ConditionFailedError if the events could not be appended, any other error is returned
+// as reported by the driver
+func appendHistoryEvents(db sqlExecer, request *AppendHistoryEventsRequest) error {
+	var result sql.Result
+	var err error
+	if request.Overwrite {
+		result, err = db.Exec(sqlOverwriteHistoryEventsQuery,
+			request.RangeID,
+			request.TransactionID,
+			request.Events.Data,
+			request.Events.EncodingType,
+			request.Events.Version,
+			request.DomainID,
+			request.Execution.GetWorkflowId(),
+			request.Execution.GetRunId(),
+			request.FirstEventID,
+			request.RangeID,
+			request.TransactionID)
+	} else {
+		result, err = db.Exec(sqlAppendHistoryEventsQuery,
+			request.DomainID,
+			request.Execution.GetWorkflowId(),
+			request.Execution.GetRunId(),
+			request.FirstEventID,
+			request.RangeID,
+			request.TransactionID,
+			request.Events.Data,
+			request.Events.EncodingType,
+			request.Events.Version)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	// The overwrite always changes tx_id, so a matched row is never reported as unaffected
+	if rows, err := result.RowsAffected(); err != nil || rows == 0 {
+		return &ConditionFailedError{
+			Msg: "Failed to append history events.",
+		}
+	}
+
+	return nil
+}
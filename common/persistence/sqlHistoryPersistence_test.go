@@ -0,0 +1,158 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// +build sqlite
+
+package persistence
+
+import (
+	"os"
+	"testing"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+type (
+	sqlHistoryPersistenceSuite struct {
+		suite.Suite
+		TestBase
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+	}
+)
+
+func TestSQLHistoryPersistenceSuite(t *testing.T) {
+	s := new(sqlHistoryPersistenceSuite)
+	s.StoreOptions = sqliteTestBaseOptions()
+	suite.Run(t, s)
+}
+
+func (s *sqlHistoryPersistenceSuite) SetupSuite() {
+	if testing.Verbose() {
+		log.SetOutput(os.Stdout)
+	}
+
+	s.SetupWorkflowStore()
+}
+
+func (s *sqlHistoryPersistenceSuite) TearDownSuite() {
+	s.TearDownWorkflowStore()
+}
+
+func (s *sqlHistoryPersistenceSuite) SetupTest() {
+	// Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+	s.Assertions = require.New(s.T())
+}
+
+func (s *sqlHistoryPersistenceSuite) TestOverwriteHistoryEvents() {
+	domainID := uuid.New()
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("overwrite-history-events"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+
+	s.NoError(s.appendHistoryEvents(domainID, workflowExecution, 2, 1, "event1", false))
+	s.IsType(&ConditionFailedError{}, s.appendHistoryEvents(domainID, workflowExecution, 2, 2, "event2", false))
+
+	// Only a later transaction of the same or a newer range overwrites the batch
+	s.IsType(&ConditionFailedError{}, s.appendHistoryEvents(domainID, workflowExecution, 2, 1, "event2", true))
+	s.IsType(&ConditionFailedError{}, s.appendHistoryEvents(domainID, workflowExecution, 1, 5, "event2", true))
+	s.NoError(s.appendHistoryEvents(domainID, workflowExecution, 2, 2, "event2", true))
+	s.NoError(s.appendHistoryEvents(domainID, workflowExecution, 3, 3, "event3", true))
+
+	response, err := s.HistoryMgr.GetWorkflowExecutionHistory(&GetWorkflowExecutionHistoryRequest{
+		DomainID:    domainID,
+		Execution:   workflowExecution,
+		NextEventID: 10,
+		PageSize:    10,
+	})
+	s.NoError(err)
+	s.Equal(1, len(response.Events))
+	s.Equal("event3", string(response.Events[0].Data))
+	s.Empty(response.NextPageToken)
+}
+
+func (s *sqlHistoryPersistenceSuite) TestAppendHistoryEventsBatchRollback() {
+	domainID := uuid.New()
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("append-history-events-batch"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	s.NoError(s.appendHistoryEvents(domainID, workflowExecution, 1, 1, "event1", false))
+
+	// The batch is applied in a single transaction, the conflict of its second request discards the first one
+	err := s.HistoryMgr.AppendHistoryEventsBatch(&AppendHistoryEventsBatchRequest{
+		Requests: []*AppendHistoryEventsRequest{
+			s.newAppendRequest(domainID, workflowExecution, 5, 1, 2, "event5", false),
+			s.newAppendRequest(domainID, workflowExecution, 1, 1, 2, "event1", false),
+		},
+	})
+	s.IsType(&ConditionFailedError{}, err)
+
+	response, err := s.HistoryMgr.GetWorkflowExecutionHistory(&GetWorkflowExecutionHistoryRequest{
+		DomainID:    domainID,
+		Execution:   workflowExecution,
+		NextEventID: 10,
+		PageSize:    10,
+	})
+	s.NoError(err)
+	s.Equal(1, len(response.Events))
+	s.Equal("event1", string(response.Events[0].Data))
+}
+
+func (s *sqlHistoryPersistenceSuite) TestGetHistoryInvalidPageToken() {
+	_, err := s.HistoryMgr.GetWorkflowExecutionHistory(&GetWorkflowExecutionHistoryRequest{
+		DomainID: uuid.New(),
+		Execution: gen.WorkflowExecution{
+			WorkflowId: common.StringPtr("invalid-page-token"),
+			RunId:      common.StringPtr(uuid.New()),
+		},
+		NextEventID:   10,
+		PageSize:      10,
+		NextPageToken: serializePageToken([]byte{1, 2, 3}),
+	})
+	s.Equal(ErrInvalidPageToken, err)
+}
+
+func (s *sqlHistoryPersistenceSuite) appendHistoryEvents(domainID string, workflowExecution gen.WorkflowExecution,
+	rangeID, txID int64, data string, overwrite bool) error {
+	return s.HistoryMgr.AppendHistoryEvents(s.newAppendRequest(domainID, workflowExecution, 1, rangeID, txID, data,
+		overwrite))
+}
+
+func (s *sqlHistoryPersistenceSuite) newAppendRequest(domainID string, workflowExecution gen.WorkflowExecution,
+	firstEventID, rangeID, txID int64, data string, overwrite bool) *AppendHistoryEventsRequest {
+	return &AppendHistoryEventsRequest{
+		DomainID:      domainID,
+		Execution:     workflowExecution,
+		FirstEventID:  firstEventID,
+		RangeID:       rangeID,
+		TransactionID: txID,
+		Events:        NewSerializedHistoryEventBatch([]byte(data), common.EncodingTypeJSON, 1),
+		Overwrite:     overwrite,
+	}
+}
@@ -0,0 +1,487 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pborman/uuid"
+	"github.com/uber-common/bark"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+)
+
+const (
+	sqlDomainColumns = `id, name, status, description, owner_email, retention, emit_metric, ` +
+		`archival_enabled, default_task_list, task_list_overrides, termination_protected, failover_version, ` +
+		`notification_version`
+
+	sqlCreateDomainQuery = `INSERT INTO domains (` + sqlDomainColumns + `) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, 0) ON CONFLICT DO NOTHING`
+
+	sqlGetDomainQuery = `SELECT ` + sqlDomainColumns + ` FROM domains WHERE id = ?`
+
+	sqlGetDomainByNameQuery = `SELECT ` + sqlDomainColumns + ` FROM domains WHERE name = ?`
+
+	sqlGetDomainIDByNameQuery = `SELECT id FROM domains WHERE name = ?`
+
+	sqlUpdateDomainQuery = `UPDATE domains ` +
+		`SET name = ?, status = ?, description = ?, owner_email = ?, retention = ?, emit_metric = ?, ` +
+		`archival_enabled = ?, default_task_list = ?, task_list_overrides = ?, termination_protected = ?, ` +
+		`failover_version = ? ` +
+		`WHERE id = ?`
+
+	sqlUpdateDomainNotificationVersionQuery = `UPDATE domains SET notification_version = ? WHERE id = ?`
+
+	sqlDeleteDomainQuery = `DELETE FROM domains WHERE id = ?`
+
+	sqlDeleteDomainByNameQuery = `DELETE FROM domains WHERE name = ?`
+
+	sqlLockDomainMetadataQuery = `SELECT notification_version FROM domain_metadata WHERE id = 0 FOR UPDATE`
+
+	sqlGetDomainMetadataQuery = `SELECT notification_version FROM domain_metadata WHERE id = 0`
+
+	sqlUpdateDomainMetadataQuery = `UPDATE domain_metadata SET notification_version = ? WHERE id = 0`
+
+	sqlDomainChangeColumns = `notification_version, change_type, domain_id, name, status, description, owner_email, ` +
+		`retention, emit_metric, archival_enabled, default_task_list, task_list_overrides, termination_protected, ` +
+		`failover_version`
+
+	sqlCreateDomainChangeQuery = `INSERT INTO domain_changes (` + sqlDomainChangeColumns + `) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	sqlGetDomainChangesQuery = `SELECT ` + sqlDomainChangeColumns + ` FROM domain_changes ` +
+		`WHERE notification_version > ? ` +
+		`ORDER BY notification_version ` +
+		`LIMIT ?`
+
+	sqlBatchOperationColumns = `id, domain_id, domain_name, operation_type, query, signal_name, signal_input, ` +
+		`reason, identity, rps, state, start_time, close_time, update_time, succeeded, skipped, failed, ` +
+		`close_reason, stop_reason`
+
+	sqlCreateBatchOperationQuery = `INSERT INTO batch_operations (` + sqlBatchOperationColumns + `) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	sqlUpdateBatchOperationQuery = `UPDATE batch_operations ` +
+		`SET state = ?, close_time = ?, update_time = ?, succeeded = ?, skipped = ?, failed = ?, ` +
+		`close_reason = ? ` +
+		`WHERE id = ?`
+
+	sqlStopBatchOperationQuery = `UPDATE batch_operations SET stop_reason = ? WHERE id = ?`
+
+	sqlGetBatchOperationQuery = `SELECT ` + sqlBatchOperationColumns + ` FROM batch_operations WHERE id = ?`
+)
+
+type (
+	sqlMetadataPersistence struct {
+		db     *sqlDB
+		logger bark.Logger
+	}
+
+	// taskListOverridesColumn stores the task list overrides of a domain as a JSON blob
+	taskListOverridesColumn map[string]string
+)
+
+// NewSQLMetadataPersistence is used to create an instance of MetadataManager implementation
+func NewSQLMetadataPersistence(driverName, dataSourceName string, maxConns int, logger bark.Logger) (MetadataManager,
+	error) {
+	db, err := newSQLDB(driverName, dataSourceName, maxConns)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sqlMetadataPersistence{db: db, logger: logger}, nil
+}
+
+// Close releases the resources held by this object
+func (m *sqlMetadataPersistence) Close() {
+	if m.db != nil {
+		m.db.Close()
+	}
+}
+
+// Unlike cassandra, domains are kept in a single table with a unique index on the name, so creating a domain is a
+// single conditional insert which never leaves an orphaned record behind.  The domain change is recorded in the same
+// transaction, and its notification version stored on the domain.
+func (m *sqlMetadataPersistence) CreateDomain(request *CreateDomainRequest) (*CreateDomainResponse, error) {
+	domainUUID := uuid.New()
+	info := &DomainInfo{
+		ID:          domainUUID,
+		Name:        request.Name,
+		Status:      request.Status,
+		Description: request.Description,
+		OwnerEmail:  request.OwnerEmail,
+	}
+	config := &DomainConfig{
+		Retention:            request.Retention,
+		EmitMetric:           request.EmitMetric,
+		ArchivalEnabled:      request.ArchivalEnabled,
+		DefaultTaskList:      request.DefaultTaskList,
+		TaskListOverrides:    request.TaskListOverrides,
+		TerminationProtected: request.TerminationProtected,
+	}
+
+	err := sqlTxExecute(m.db, "CreateDomain", func(tx *sqlTx) error {
+		result, err := tx.Exec(sqlCreateDomainQuery,
+			domainUUID,
+			request.Name,
+			request.Status,
+			request.Description,
+			request.OwnerEmail,
+			request.Retention,
+			request.EmitMetric,
+			request.ArchivalEnabled,
+			request.DefaultTaskList,
+			taskListOverridesColumn(request.TaskListOverrides),
+			request.TerminationProtected,
+			request.FailoverVersion)
+		if err != nil {
+			return fmt.Errorf("Inserting into domains table. Error: %v", err)
+		}
+
+		if rows, err := result.RowsAffected(); err != nil || rows == 0 {
+			var existingID string
+			if err := tx.QueryRow(sqlGetDomainIDByNameQuery, request.Name).Scan(&existingID); err == nil {
+				return &workflow.DomainAlreadyExistsError{
+					Message: fmt.Sprintf("Domain already exists.  DomainId: %v", existingID),
+				}
+			}
+
+			return &workflow.DomainAlreadyExistsError{
+				Message: fmt.Sprintf("CreateDomain operation failed because of conditional failure."),
+			}
+		}
+
+		return m.recordDomainChange(tx, DomainChangeTypeRegistered, info, config, request.FailoverVersion)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreateDomainResponse{ID: domainUUID}, nil
+}
+
+func (m *sqlMetadataPersistence) GetDomain(request *GetDomainRequest) (*GetDomainResponse, error) {
+	var row *sql.Row
+	if len(request.ID) > 0 {
+		if len(request.Name) > 0 {
+			return nil, &workflow.BadRequestError{
+				Message: "GetDomain operation failed.  Both ID and Name specified in request.",
+			}
+		}
+
+		row = m.db.QueryRow(sqlGetDomainQuery, request.ID)
+	} else if len(request.Name) > 0 {
+		row = m.db.QueryRow(sqlGetDomainByNameQuery, request.Name)
+	} else {
+		return nil, &workflow.BadRequestError{
+			Message: "GetDomain operation failed.  Both ID and Name are empty.",
+		}
+	}
+
+	response := &GetDomainResponse{Info: &DomainInfo{}, Config: &DomainConfig{}}
+	if err := row.Scan(
+		&response.Info.ID,
+		&response.Info.Name,
+		&response.Info.Status,
+		&response.Info.Description,
+		&response.Info.OwnerEmail,
+		&response.Config.Retention,
+		&response.Config.EmitMetric,
+		&response.Config.ArchivalEnabled,
+		&response.Config.DefaultTaskList,
+		(*taskListOverridesColumn)(&response.Config.TaskListOverrides),
+		&response.Config.TerminationProtected,
+		&response.FailoverVersion,
+		&response.NotificationVersion); err != nil {
+		if err == sql.ErrNoRows {
+			var d string
+			if len(request.ID) > 0 {
+				d = request.ID
+			} else {
+				d = request.Name
+			}
+
+			return nil, &workflow.EntityNotExistsError{
+				Message: fmt.Sprintf("Domain %s does not exist.", d),
+			}
+		}
+
+		return nil, convertSQLError("GetDomain", err)
+	}
+
+	return response, nil
+}
+
+func (m *sqlMetadataPersistence) UpdateDomain(request *UpdateDomainRequest) error {
+	changeType := DomainChangeTypeUpdated
+	if request.Info.Status == DomainStatusDeprecated {
+		changeType = DomainChangeTypeDeprecated
+	}
+
+	return sqlTxExecute(m.db, "UpdateDomain", func(tx *sqlTx) error {
+		if _, err := tx.Exec(sqlUpdateDomainQuery,
+			request.Info.Name,
+			request.Info.Status,
+			request.Info.Description,
+			request.Info.OwnerEmail,
+			request.Config.Retention,
+			request.Config.EmitMetric,
+			request.Config.ArchivalEnabled,
+			request.Config.DefaultTaskList,
+			taskListOverridesColumn(request.Config.TaskListOverrides),
+			request.Config.TerminationProtected,
+			request.FailoverVersion,
+			request.Info.ID); err != nil {
+			return err
+		}
+
+		return m.recordDomainChange(tx, changeType, request.Info, request.Config, request.FailoverVersion)
+	})
+}
+
+func (m *sqlMetadataPersistence) DeleteDomain(request *DeleteDomainRequest) error {
+	if _, err := m.db.Exec(sqlDeleteDomainQuery, request.ID); err != nil {
+		return convertSQLError("DeleteDomain", err)
+	}
+
+	return nil
+}
+
+func (m *sqlMetadataPersistence) DeleteDomainByName(request *DeleteDomainByNameRequest) error {
+	if _, err := m.db.Exec(sqlDeleteDomainByNameQuery, request.Name); err != nil {
+		return convertSQLError("DeleteDomainByName", err)
+	}
+
+	return nil
+}
+
+func (m *sqlMetadataPersistence) GetDomainChanges(request *GetDomainChangesRequest) (*GetDomainChangesResponse,
+	error) {
+	rows, err := m.db.Query(sqlGetDomainChangesQuery, request.LastNotificationVersion,
+		getPageSize(request.PageSize))
+	if err != nil {
+		return nil, convertSQLError("GetDomainChanges", err)
+	}
+	defer rows.Close()
+
+	response := &GetDomainChangesResponse{}
+	for rows.Next() {
+		change := &DomainChange{Info: &DomainInfo{}, Config: &DomainConfig{}}
+		if err := rows.Scan(
+			&change.NotificationVersion,
+			&change.ChangeType,
+			&change.Info.ID,
+			&change.Info.Name,
+			&change.Info.Status,
+			&change.Info.Description,
+			&change.Info.OwnerEmail,
+			&change.Config.Retention,
+			&change.Config.EmitMetric,
+			&change.Config.ArchivalEnabled,
+			&change.Config.DefaultTaskList,
+			(*taskListOverridesColumn)(&change.Config.TaskListOverrides),
+			&change.Config.TerminationProtected,
+			&change.FailoverVersion); err != nil {
+			return nil, convertSQLError("GetDomainChanges", err)
+		}
+		response.Changes = append(response.Changes, change)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, convertSQLError("GetDomainChanges", err)
+	}
+
+	return response, nil
+}
+
+func (m *sqlMetadataPersistence) GetMetadata() (*GetMetadataResponse, error) {
+	response := &GetMetadataResponse{}
+	if err := m.db.QueryRow(sqlGetDomainMetadataQuery).Scan(&response.NotificationVersion); err != nil {
+		return nil, convertSQLError("GetMetadata", err)
+	}
+
+	return response, nil
+}
+
+func (m *sqlMetadataPersistence) CreateBatchOperation(request *CreateBatchOperationRequest) error {
+	info := request.Info
+	if _, err := m.db.Exec(sqlCreateBatchOperationQuery,
+		info.ID,
+		info.DomainID,
+		info.DomainName,
+		info.OperationType,
+		info.Query,
+		info.SignalName,
+		info.SignalInput,
+		info.Reason,
+		info.Identity,
+		info.RPS,
+		info.State,
+		timeToSQL(info.StartTime),
+		timeToSQL(info.CloseTime),
+		timeToSQL(info.UpdateTime),
+		info.Succeeded,
+		info.Skipped,
+		info.Failed,
+		info.CloseReason,
+		info.StopReason); err != nil {
+		return convertSQLError("CreateBatchOperation", err)
+	}
+
+	return nil
+}
+
+func (m *sqlMetadataPersistence) UpdateBatchOperation(request *UpdateBatchOperationRequest) error {
+	info := request.Info
+	if _, err := m.db.Exec(sqlUpdateBatchOperationQuery,
+		info.State,
+		timeToSQL(info.CloseTime),
+		timeToSQL(info.UpdateTime),
+		info.Succeeded,
+		info.Skipped,
+		info.Failed,
+		info.CloseReason,
+		info.ID); err != nil {
+		return convertSQLError("UpdateBatchOperation", err)
+	}
+
+	return nil
+}
+
+func (m *sqlMetadataPersistence) StopBatchOperation(request *StopBatchOperationRequest) error {
+	if _, err := m.db.Exec(sqlStopBatchOperationQuery, request.Reason, request.ID); err != nil {
+		return convertSQLError("StopBatchOperation", err)
+	}
+
+	return nil
+}
+
+func (m *sqlMetadataPersistence) GetBatchOperation(request *GetBatchOperationRequest) (*GetBatchOperationResponse,
+	error) {
+	info := &BatchOperationInfo{}
+	var startTime, closeTime, updateTime int64
+	if err := m.db.QueryRow(sqlGetBatchOperationQuery, request.ID).Scan(
+		&info.ID,
+		&info.DomainID,
+		&info.DomainName,
+		&info.OperationType,
+		&info.Query,
+		&info.SignalName,
+		&info.SignalInput,
+		&info.Reason,
+		&info.Identity,
+		&info.RPS,
+		&info.State,
+		&startTime,
+		&closeTime,
+		&updateTime,
+		&info.Succeeded,
+		&info.Skipped,
+		&info.Failed,
+		&info.CloseReason,
+		&info.StopReason); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &workflow.EntityNotExistsError{
+				Message: fmt.Sprintf("Batch operation %v does not exist.", request.ID),
+			}
+		}
+
+		return nil, convertSQLError("GetBatchOperation", err)
+	}
+
+	info.StartTime = timeFromSQL(startTime)
+	info.CloseTime = timeFromSQL(closeTime)
+	info.UpdateTime = timeFromSQL(updateTime)
+	return &GetBatchOperationResponse{Info: info}, nil
+}
+
+// recordDomainChange bumps the notification version held in domain_metadata, records the change under the new
+// version and stores the version on the domain.  The metadata row is locked for the rest of the transaction, which
+// serializes all domain changes.
+func (m *sqlMetadataPersistence) recordDomainChange(tx *sqlTx, changeType int, info *DomainInfo,
+	config *DomainConfig, failoverVersion int64) error {
+	var lastVersion int64
+	if err := tx.QueryRow(sqlLockDomainMetadataQuery).Scan(&lastVersion); err != nil {
+		return fmt.Errorf("Failed to lock domain metadata. Error: %v", err)
+	}
+
+	version := lastVersion + 1
+	if _, err := tx.Exec(sqlUpdateDomainMetadataQuery, version); err != nil {
+		return fmt.Errorf("Failed to update domain metadata. Error: %v", err)
+	}
+
+	if _, err := tx.Exec(sqlCreateDomainChangeQuery,
+		version,
+		changeType,
+		info.ID,
+		info.Name,
+		info.Status,
+		info.Description,
+		info.OwnerEmail,
+		config.Retention,
+		config.EmitMetric,
+		config.ArchivalEnabled,
+		config.DefaultTaskList,
+		taskListOverridesColumn(config.TaskListOverrides),
+		config.TerminationProtected,
+		failoverVersion); err != nil {
+		return fmt.Errorf("Failed to record domain change. Error: %v", err)
+	}
+
+	if _, err := tx.Exec(sqlUpdateDomainNotificationVersionQuery, version, info.ID); err != nil {
+		return fmt.Errorf("Failed to update domain notification version. Error: %v", err)
+	}
+
+	return nil
+}
+
+// Value encodes the overrides as a JSON blob, NULL if there are none
+func (c taskListOverridesColumn) Value() (driver.Value, error) {
+	if len(c) == 0 {
+		return nil, nil
+	}
+	data, err := json.Marshal(map[string]string(c))
+	return data, err
+}
+
+// Scan decodes the overrides from a JSON blob
+func (c *taskListOverridesColumn) Scan(src interface{}) error {
+	*c = nil
+	var data []byte
+	switch v := src.(type) {
+	case nil:
+		return nil
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("Invalid task list overrides of type %T", src)
+	}
+	if len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, c)
+}
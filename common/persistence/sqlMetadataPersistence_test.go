@@ -0,0 +1,148 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// +build sqlite
+
+package persistence
+
+import (
+	"os"
+	"testing"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	gen "github.com/uber/cadence/.gen/go/shared"
+)
+
+type (
+	sqlMetadataPersistenceSuite struct {
+		suite.Suite
+		TestBase
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+	}
+)
+
+func TestSQLMetadataPersistenceSuite(t *testing.T) {
+	s := new(sqlMetadataPersistenceSuite)
+	s.StoreOptions = sqliteTestBaseOptions()
+	suite.Run(t, s)
+}
+
+func (m *sqlMetadataPersistenceSuite) SetupSuite() {
+	if testing.Verbose() {
+		log.SetOutput(os.Stdout)
+	}
+
+	m.SetupWorkflowStore()
+}
+
+func (m *sqlMetadataPersistenceSuite) TearDownSuite() {
+	m.TearDownWorkflowStore()
+}
+
+func (m *sqlMetadataPersistenceSuite) SetupTest() {
+	// Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+	m.Assertions = require.New(m.T())
+}
+
+func (m *sqlMetadataPersistenceSuite) TestCreateDomainNameConflict() {
+	name := "sql-name-conflict-" + uuid.New()
+	response, err := m.MetadataManager.CreateDomain(&CreateDomainRequest{Name: name, Retention: 1})
+	m.NoError(err)
+
+	// The unique name index rejects the second domain without leaving a record of it
+	_, err = m.MetadataManager.CreateDomain(&CreateDomainRequest{Name: name, Retention: 2})
+	m.IsType(&gen.DomainAlreadyExistsError{}, err)
+	m.Contains(err.Error(), response.ID)
+
+	domain, err := m.MetadataManager.GetDomain(&GetDomainRequest{Name: name})
+	m.NoError(err)
+	m.Equal(response.ID, domain.Info.ID)
+	m.Equal(int32(1), domain.Config.Retention)
+}
+
+func (m *sqlMetadataPersistenceSuite) TestTaskListOverrides() {
+	name := "sql-task-list-overrides-" + uuid.New()
+	overrides := map[string]string{"wType": "tl1"}
+	response, err := m.MetadataManager.CreateDomain(&CreateDomainRequest{Name: name, TaskListOverrides: overrides})
+	m.NoError(err)
+
+	domain, err := m.MetadataManager.GetDomain(&GetDomainRequest{ID: response.ID})
+	m.NoError(err)
+	m.Equal(overrides, domain.Config.TaskListOverrides)
+
+	// No overrides are stored as NULL
+	domain.Config.TaskListOverrides = nil
+	m.NoError(m.MetadataManager.UpdateDomain(&UpdateDomainRequest{Info: domain.Info, Config: domain.Config}))
+	domain, err = m.MetadataManager.GetDomain(&GetDomainRequest{ID: response.ID})
+	m.NoError(err)
+	m.Nil(domain.Config.TaskListOverrides)
+}
+
+func (m *sqlMetadataPersistenceSuite) TestTaskListOverridesColumn() {
+	value, err := taskListOverridesColumn(nil).Value()
+	m.NoError(err)
+	m.Nil(value)
+
+	value, err = taskListOverridesColumn(map[string]string{"wType": "tl1"}).Value()
+	m.NoError(err)
+
+	var column taskListOverridesColumn
+	m.NoError(column.Scan(value))
+	m.Equal(taskListOverridesColumn{"wType": "tl1"}, column)
+	m.NoError(column.Scan(nil))
+	m.Nil(column)
+	m.Error(column.Scan(42))
+}
+
+func (m *sqlMetadataPersistenceSuite) TestDomainChangesFollowNotificationVersion() {
+	metadata, err := m.MetadataManager.GetMetadata()
+	m.NoError(err)
+
+	name := "sql-domain-changes-" + uuid.New()
+	response, err := m.MetadataManager.CreateDomain(&CreateDomainRequest{Name: name, Retention: 1})
+	m.NoError(err)
+	domain, err := m.MetadataManager.GetDomain(&GetDomainRequest{ID: response.ID})
+	m.NoError(err)
+	m.Equal(metadata.NotificationVersion+1, domain.NotificationVersion)
+
+	domain.Config.Retention = 2
+	m.NoError(m.MetadataManager.UpdateDomain(&UpdateDomainRequest{Info: domain.Info, Config: domain.Config}))
+
+	changes, err := m.MetadataManager.GetDomainChanges(&GetDomainChangesRequest{
+		LastNotificationVersion: metadata.NotificationVersion,
+		PageSize:                10,
+	})
+	m.NoError(err)
+	m.Equal(2, len(changes.Changes))
+	m.Equal(DomainChangeTypeRegistered, changes.Changes[0].ChangeType)
+	m.Equal(DomainChangeTypeUpdated, changes.Changes[1].ChangeType)
+	m.Equal(int32(2), changes.Changes[1].Config.Retention)
+	m.Equal(metadata.NotificationVersion+2, changes.Changes[1].NotificationVersion)
+
+	domain, err = m.MetadataManager.GetDomain(&GetDomainRequest{ID: response.ID})
+	m.NoError(err)
+	m.Equal(metadata.NotificationVersion+2, domain.NotificationVersion)
+}
@@ -0,0 +1,1677 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"database/sql"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/uber-common/bark"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+// Guidelines for the SQL implementation:
+// * Every conditional write of the cassandra implementation is a transaction here.  Writes to the executions of a
+//   shard read lock the shard row and compare its range_id, so UpdateShard (which write locks the row) cannot steal
+//   the shard while the write is in flight.
+// * MySQL reports matched rows which are left unchanged by an UPDATE as not affected, so conditions are checked by
+//   reading the locked row instead of relying on the number of affected rows.
+// * Times are stored as unix nanoseconds, zero represents an unset time.
+// * Queries are written with ? placeholders, ON CONFLICT DO NOTHING and FOR SHARE, and are rewritten into the
+//   dialect of the driver (see sqlDriver.go).  Rows of the mutable state maps are replaced by a delete followed by
+//   an insert, which behaves the same on every database.
+
+const (
+	sqlExecutionPredicate = `WHERE shard_id = ? AND domain_id = ? AND workflow_id = ? AND run_id = ?`
+
+	sqlCreateShardQuery = `INSERT INTO shards (` +
+		`shard_id, owner, range_id, stolen_since_renew, updated_at, transfer_ack_level, timer_ack_level) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?) ON CONFLICT DO NOTHING`
+
+	sqlGetShardQuery = `SELECT ` +
+		`shard_id, owner, range_id, stolen_since_renew, updated_at, transfer_ack_level, timer_ack_level ` +
+		`FROM shards WHERE shard_id = ?`
+
+	sqlLockShardQuery = `SELECT range_id FROM shards WHERE shard_id = ? FOR UPDATE`
+
+	sqlReadLockShardQuery = `SELECT range_id FROM shards WHERE shard_id = ? FOR SHARE`
+
+	sqlUpdateShardQuery = `UPDATE shards SET ` +
+		`owner = ?, range_id = ?, stolen_since_renew = ?, updated_at = ?, transfer_ack_level = ?, timer_ack_level = ? ` +
+		`WHERE shard_id = ?`
+
+	sqlCreateCurrentExecutionQuery = `INSERT INTO current_executions (` +
+		`shard_id, domain_id, workflow_id, run_id, create_request_id) ` +
+		`VALUES (?, ?, ?, ?, ?) ON CONFLICT DO NOTHING`
+
+	sqlUpdateCurrentExecutionQuery = `UPDATE current_executions SET run_id = ?, create_request_id = ? ` +
+		`WHERE shard_id = ? AND domain_id = ? AND workflow_id = ?`
+
+	sqlUpdateCurrentExecutionOfRunQuery = sqlUpdateCurrentExecutionQuery + ` AND run_id = ?`
+
+	sqlGetCurrentExecutionQuery = `SELECT run_id, create_request_id FROM current_executions ` +
+		`WHERE shard_id = ? AND domain_id = ? AND workflow_id = ?`
+
+	sqlReadLockCurrentExecutionQuery = sqlGetCurrentExecutionQuery + ` FOR SHARE`
+
+	sqlDeleteCurrentExecutionQuery = `DELETE FROM current_executions ` +
+		`WHERE shard_id = ? AND domain_id = ? AND workflow_id = ?`
+
+	sqlDeleteCurrentExecutionOfRunQuery = sqlDeleteCurrentExecutionQuery + ` AND run_id = ?`
+
+	// sqlListCurrentExecutionsQuery continues after the workflow key (domain_id, workflow_id) of the previous page
+	sqlListCurrentExecutionsQuery = `SELECT domain_id, workflow_id, run_id FROM current_executions ` +
+		`WHERE shard_id = ? AND (domain_id > ? OR (domain_id = ? AND workflow_id > ?)) ` +
+		`ORDER BY domain_id, workflow_id LIMIT ?`
+
+	sqlExecutionColumns = `domain_id, workflow_id, run_id, parent_domain_id, parent_workflow_id, parent_run_id, ` +
+		`initiated_id, completion_event, task_list, workflow_type_name, decision_task_timeout, execution_context, ` +
+		`state, close_status, next_event_id, last_processed_event, start_time, last_updated_time, create_request_id, ` +
+		`decision_schedule_id, decision_started_id, decision_request_id, decision_timeout, completion_callback_url, ` +
+		`cancel_requested, cancel_request_id, first_execution_run_id, trace_id, search_attributes`
+
+	sqlCreateExecutionQuery = `INSERT INTO executions (shard_id, ` + sqlExecutionColumns + `) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	sqlGetExecutionQuery = `SELECT ` + sqlExecutionColumns + ` FROM executions ` + sqlExecutionPredicate
+
+	// sqlListExecutionsQuery continues after the execution key (domain_id, workflow_id, run_id) of the previous page
+	sqlListExecutionsQuery = `SELECT ` + sqlExecutionColumns + ` FROM executions WHERE shard_id = ? ` +
+		`AND (domain_id > ? OR (domain_id = ? AND (workflow_id > ? OR (workflow_id = ? AND run_id > ?)))) ` +
+		`ORDER BY domain_id, workflow_id, run_id LIMIT ?`
+
+	sqlLockExecutionQuery = `SELECT next_event_id FROM executions ` + sqlExecutionPredicate + ` FOR UPDATE`
+
+	sqlUpdateExecutionQuery = `UPDATE executions SET ` +
+		`parent_domain_id = ?, parent_workflow_id = ?, parent_run_id = ?, initiated_id = ?, completion_event = ?, ` +
+		`task_list = ?, workflow_type_name = ?, decision_task_timeout = ?, execution_context = ?, state = ?, ` +
+		`close_status = ?, next_event_id = ?, last_processed_event = ?, start_time = ?, last_updated_time = ?, ` +
+		`create_request_id = ?, decision_schedule_id = ?, decision_started_id = ?, decision_request_id = ?, ` +
+		`decision_timeout = ?, completion_callback_url = ?, cancel_requested = ?, cancel_request_id = ?, ` +
+		`first_execution_run_id = ?, trace_id = ? ` + sqlExecutionPredicate
+
+	sqlDeleteExecutionQuery = `DELETE FROM executions ` + sqlExecutionPredicate
+
+	sqlActivityInfoColumns = `schedule_id, scheduled_event, started_id, started_event, activity_id, request_id, ` +
+		`details, schedule_to_start_timeout, schedule_to_close_timeout, start_to_close_timeout, heart_beat_timeout, ` +
+		`cancel_requested, cancel_request_id, last_hb_updated_time`
+
+	sqlCreateActivityInfoQuery = `INSERT INTO activity_info_maps (` +
+		`shard_id, domain_id, workflow_id, run_id, ` + sqlActivityInfoColumns + `) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	sqlGetActivityInfosQuery = `SELECT ` + sqlActivityInfoColumns + ` FROM activity_info_maps ` +
+		sqlExecutionPredicate
+
+	sqlDeleteActivityInfoQuery = `DELETE FROM activity_info_maps ` + sqlExecutionPredicate + ` AND schedule_id = ?`
+
+	sqlDeleteActivityInfosQuery = `DELETE FROM activity_info_maps ` + sqlExecutionPredicate
+
+	sqlTimerInfoColumns = `timer_id, started_id, expiry_time, task_id`
+
+	sqlCreateTimerInfoQuery = `INSERT INTO timer_info_maps (` +
+		`shard_id, domain_id, workflow_id, run_id, ` + sqlTimerInfoColumns + `) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+
+	sqlGetTimerInfosQuery = `SELECT ` + sqlTimerInfoColumns + ` FROM timer_info_maps ` + sqlExecutionPredicate
+
+	sqlDeleteTimerInfoQuery = `DELETE FROM timer_info_maps ` + sqlExecutionPredicate + ` AND timer_id = ?`
+
+	sqlDeleteTimerInfosQuery = `DELETE FROM timer_info_maps ` + sqlExecutionPredicate
+
+	sqlChildExecutionInfoColumns = `initiated_id, initiated_event, started_id, started_event, create_request_id`
+
+	sqlCreateChildExecutionInfoQuery = `INSERT INTO child_execution_info_maps (` +
+		`shard_id, domain_id, workflow_id, run_id, ` + sqlChildExecutionInfoColumns + `) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	sqlGetChildExecutionInfosQuery = `SELECT ` + sqlChildExecutionInfoColumns + ` FROM child_execution_info_maps ` +
+		sqlExecutionPredicate
+
+	sqlDeleteChildExecutionInfoQuery = `DELETE FROM child_execution_info_maps ` + sqlExecutionPredicate +
+		` AND initiated_id = ?`
+
+	sqlDeleteChildExecutionInfosQuery = `DELETE FROM child_execution_info_maps ` + sqlExecutionPredicate
+
+	sqlCreateSignalRequestedQuery = `INSERT INTO signals_requested_sets (` +
+		`shard_id, domain_id, workflow_id, run_id, signal_id, recorded_time) ` +
+		`VALUES (?, ?, ?, ?, ?, ?)`
+
+	sqlGetSignalsRequestedQuery = `SELECT signal_id, recorded_time FROM signals_requested_sets ` +
+		sqlExecutionPredicate
+
+	sqlDeleteSignalRequestedQuery = `DELETE FROM signals_requested_sets ` + sqlExecutionPredicate +
+		` AND signal_id = ?`
+
+	sqlDeleteSignalsRequestedQuery = `DELETE FROM signals_requested_sets ` + sqlExecutionPredicate
+
+	sqlCreateTransferTaskQuery = `INSERT INTO transfer_tasks (` +
+		`shard_id, task_id, domain_id, workflow_id, run_id, target_domain_id, target_workflow_id, target_run_id, ` +
+		`task_list, type, schedule_id, callback_url) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	sqlGetTransferTasksQuery = `SELECT ` +
+		`domain_id, workflow_id, run_id, task_id, target_domain_id, target_workflow_id, target_run_id, task_list, ` +
+		`type, schedule_id, callback_url ` +
+		`FROM transfer_tasks WHERE shard_id = ? AND task_id > ? AND task_id <= ? ORDER BY task_id LIMIT ?`
+
+	sqlCompleteTransferTaskQuery = `DELETE FROM transfer_tasks WHERE shard_id = ? AND task_id = ?`
+
+	sqlRangeCompleteTransferTaskQuery = `DELETE FROM transfer_tasks WHERE shard_id = ? AND task_id > ? AND task_id <= ?`
+
+	sqlCreateTimerTaskQuery = `INSERT INTO timer_tasks (` +
+		`shard_id, visibility_ts, task_id, domain_id, workflow_id, run_id, type, timeout_type, event_id) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	sqlGetTimerTasksQuery = `SELECT ` +
+		`domain_id, workflow_id, run_id, visibility_ts, task_id, type, timeout_type, event_id ` +
+		`FROM timer_tasks WHERE shard_id = ? ` +
+		`AND (visibility_ts > ? OR (visibility_ts = ? AND task_id > ?)) AND visibility_ts < ? ` +
+		`ORDER BY visibility_ts, task_id LIMIT ?`
+
+	sqlCompleteTimerTaskQuery = `DELETE FROM timer_tasks WHERE shard_id = ? AND visibility_ts = ? AND task_id = ?`
+
+	sqlRangeCompleteTimerTaskQuery = `DELETE FROM timer_tasks WHERE shard_id = ? AND visibility_ts >= ? AND visibility_ts < ?`
+
+	sqlTaskListPredicate = `WHERE domain_id = ? AND name = ? AND task_type = ?`
+
+	sqlCreateTaskListQuery = `INSERT INTO task_lists (domain_id, name, task_type, range_id, ack_level, pollers) ` +
+		`VALUES (?, ?, ?, ?, ?, ?) ON CONFLICT DO NOTHING`
+
+	sqlLockTaskListQuery = `SELECT range_id, ack_level, pollers FROM task_lists ` + sqlTaskListPredicate + ` FOR UPDATE`
+
+	sqlReadLockTaskListQuery = `SELECT range_id FROM task_lists ` + sqlTaskListPredicate + ` FOR SHARE`
+
+	sqlUpdateTaskListQuery = `UPDATE task_lists SET range_id = ?, ack_level = ?, pollers = ? ` + sqlTaskListPredicate
+
+	sqlCreateTaskQuery = `INSERT INTO tasks (` +
+		`domain_id, task_list_name, task_list_type, task_id, workflow_id, run_id, schedule_id, expiry_ts) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+
+	sqlGetTasksQuery = `SELECT task_id, workflow_id, run_id, schedule_id FROM tasks ` +
+		`WHERE domain_id = ? AND task_list_name = ? AND task_list_type = ? AND task_id > ? AND task_id <= ? ` +
+		`AND (expiry_ts = 0 OR expiry_ts > ?) ORDER BY task_id LIMIT ?`
+
+	sqlGetTaskListBacklogQuery = `SELECT COUNT(*) FROM tasks ` +
+		`WHERE domain_id = ? AND task_list_name = ? AND task_list_type = ? AND task_id > ? ` +
+		`AND (expiry_ts = 0 OR expiry_ts > ?)`
+
+	sqlCompleteTaskQuery = `DELETE FROM tasks ` +
+		`WHERE domain_id = ? AND task_list_name = ? AND task_list_type = ? AND task_id = ?`
+
+	sqlCompleteTasksLessThanQuery = `DELETE FROM tasks ` +
+		`WHERE domain_id = ? AND task_list_name = ? AND task_list_type = ? AND task_id < ?`
+)
+
+type (
+	sqlPersistence struct {
+		db      *sqlDB
+		shardID int
+		logger  bark.Logger
+	}
+
+	// sqlScanner is implemented by both sql.Row and sql.Rows
+	sqlScanner interface {
+		Scan(dest ...interface{}) error
+	}
+
+	// sqlQuerier is implemented by both sqlDB and sqlTx
+	sqlQuerier interface {
+		Query(query string, args ...interface{}) (*sql.Rows, error)
+	}
+
+	// sqlExecer is implemented by both sqlDB and sqlTx
+	sqlExecer interface {
+		Exec(query string, args ...interface{}) (sql.Result, error)
+	}
+)
+
+// NewSQLShardPersistence is used to create an instance of ShardManager implementation
+func NewSQLShardPersistence(driverName, dataSourceName string, maxConns int, logger bark.Logger) (ShardManager, error) {
+	db, err := newSQLDB(driverName, dataSourceName, maxConns)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sqlPersistence{db: db, shardID: -1, logger: logger}, nil
+}
+
+// NewSQLWorkflowExecutionPersistence is used to create an instance of workflowExecutionManager implementation
+func NewSQLWorkflowExecutionPersistence(driverName, dataSourceName string, maxConns int, shardID int,
+	logger bark.Logger) (ExecutionManager, error) {
+	db, err := newSQLDB(driverName, dataSourceName, maxConns)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sqlPersistence{db: db, shardID: shardID, logger: logger}, nil
+}
+
+// NewSQLTaskPersistence is used to create an instance of TaskManager implementation
+func NewSQLTaskPersistence(driverName, dataSourceName string, maxConns int, logger bark.Logger) (TaskManager, error) {
+	db, err := newSQLDB(driverName, dataSourceName, maxConns)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sqlPersistence{db: db, shardID: -1, logger: logger}, nil
+}
+
+// Close releases the underlying resources held by this object
+func (d *sqlPersistence) Close() {
+	if d.db != nil {
+		d.db.Close()
+	}
+}
+
+func (d *sqlPersistence) CreateShard(request *CreateShardRequest) error {
+	shardInfo := request.ShardInfo
+	result, err := d.db.Exec(sqlCreateShardQuery,
+		shardInfo.ShardID,
+		shardInfo.Owner,
+		shardInfo.RangeID,
+		shardInfo.StolenSinceRenew,
+		time.Now().UnixNano(),
+		shardInfo.TransferAckLevel,
+		timeToSQL(shardInfo.TimerAckLevel))
+	if err != nil {
+		return convertSQLError("CreateShard", err)
+	}
+
+	if rows, err := result.RowsAffected(); err != nil || rows == 0 {
+		return &ShardAlreadyExistError{
+			Msg: fmt.Sprintf("Shard already exists in shards table.  ShardId: %v", shardInfo.ShardID),
+		}
+	}
+
+	return nil
+}
+
+func (d *sqlPersistence) GetShard(request *GetShardRequest) (*GetShardResponse, error) {
+	shardID := request.ShardID
+	info := &ShardInfo{}
+	var updatedAt, timerAckLevel int64
+	if err := d.db.QueryRow(sqlGetShardQuery, shardID).Scan(
+		&info.ShardID,
+		&info.Owner,
+		&info.RangeID,
+		&info.StolenSinceRenew,
+		&updatedAt,
+		&info.TransferAckLevel,
+		&timerAckLevel); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &workflow.EntityNotExistsError{
+				Message: fmt.Sprintf("Shard not found.  ShardId: %v", shardID),
+			}
+		}
+
+		return nil, convertSQLError("GetShard", err)
+	}
+	info.UpdatedAt = timeFromSQL(updatedAt)
+	info.TimerAckLevel = timeFromSQL(timerAckLevel)
+
+	return &GetShardResponse{ShardInfo: info}, nil
+}
+
+func (d *sqlPersistence) GetServerTime() (*GetServerTimeResponse, error) {
+	var millis int64
+	if err := d.db.QueryRow(d.db.dialect.serverTimeQuery()).Scan(&millis); err != nil {
+		return nil, convertSQLError("GetServerTime", err)
+	}
+
+	return &GetServerTimeResponse{Time: time.Unix(0, millis*int64(time.Millisecond))}, nil
+}
+
+func (d *sqlPersistence) UpdateShard(request *UpdateShardRequest) error {
+	shardInfo := request.ShardInfo
+	return sqlTxExecute(d.db, "UpdateShard", func(tx *sqlTx) error {
+		var rangeID int64
+		if err := tx.QueryRow(sqlLockShardQuery, shardInfo.ShardID).Scan(&rangeID); err != nil {
+			return err
+		}
+
+		if rangeID != request.PreviousRangeID {
+			return &ShardOwnershipLostError{
+				ShardID: shardInfo.ShardID,
+				Msg: fmt.Sprintf("Failed to update shard.  previous_range_id: %v, range_id: %v",
+					request.PreviousRangeID, rangeID),
+			}
+		}
+
+		_, err := tx.Exec(sqlUpdateShardQuery,
+			shardInfo.Owner,
+			shardInfo.RangeID,
+			shardInfo.StolenSinceRenew,
+			time.Now().UnixNano(),
+			shardInfo.TransferAckLevel,
+			timeToSQL(shardInfo.TimerAckLevel),
+			shardInfo.ShardID)
+		return err
+	})
+}
+
+func (d *sqlPersistence) CreateWorkflowExecution(request *CreateWorkflowExecutionRequest) (
+	*CreateWorkflowExecutionResponse, error) {
+	transferTaskID := uuid.New()
+	nowTimestamp := time.Now().UnixNano()
+
+	err := sqlTxExecute(d.db, "CreateWorkflowExecution", func(tx *sqlTx) error {
+		if err := d.assertShardRangeID(tx, request.RangeID, "create workflow execution"); err != nil {
+			return err
+		}
+
+		if err := d.createWorkflowExecutionWithinTx(tx, request, nowTimestamp); err != nil {
+			return err
+		}
+
+		if err := d.createTransferTasks(tx, request.TransferTasks, request.DomainID,
+			request.Execution.GetWorkflowId(), request.Execution.GetRunId()); err != nil {
+			return err
+		}
+
+		return d.createTimerTasks(tx, request.TimerTasks, nil, request.DomainID, request.Execution.GetWorkflowId(),
+			request.Execution.GetRunId())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreateWorkflowExecutionResponse{TaskID: transferTaskID}, nil
+}
+
+func (d *sqlPersistence) createWorkflowExecutionWithinTx(tx *sqlTx, request *CreateWorkflowExecutionRequest,
+	nowTimestamp int64) error {
+	domainID := request.DomainID
+	workflowID := request.Execution.GetWorkflowId()
+	runID := request.Execution.GetRunId()
+
+	switch {
+	case request.CurrentExecutionRecorded:
+		// The current execution already points at the run
+	case request.ContinueAsNew:
+		if _, err := tx.Exec(sqlUpdateCurrentExecutionQuery,
+			runID,
+			request.RequestID,
+			d.shardID,
+			domainID,
+			workflowID); err != nil {
+			return err
+		}
+	default:
+		if err := d.createCurrentExecutionWithinTx(tx, domainID, workflowID, runID, request.RequestID,
+			request.RangeID); err != nil {
+			return err
+		}
+	}
+
+	parentDomainID := emptyDomainID
+	parentWorkflowID := ""
+	parentRunID := emptyRunID
+	initiatedID := emptyInitiatedID
+	if request.ParentExecution != nil {
+		parentDomainID = request.ParentDomainID
+		parentWorkflowID = request.ParentExecution.GetWorkflowId()
+		parentRunID = request.ParentExecution.GetRunId()
+		initiatedID = request.InitiatedID
+	}
+
+	// The search attributes are only set when the execution is created, so they are stored as a JSON blob which
+	// the updates of the execution leave unchanged
+	var searchAttributes []byte
+	if len(request.SearchAttributes) > 0 {
+		var err error
+		if searchAttributes, err = json.Marshal(request.SearchAttributes); err != nil {
+			return err
+		}
+	}
+
+	_, err := tx.Exec(sqlCreateExecutionQuery,
+		d.shardID,
+		domainID,
+		workflowID,
+		runID,
+		parentDomainID,
+		parentWorkflowID,
+		parentRunID,
+		initiatedID,
+		nil,
+		request.TaskList,
+		request.WorkflowTypeName,
+		request.DecisionTimeoutValue,
+		request.ExecutionContext,
+		WorkflowStateCreated,
+		WorkflowCloseStatusNone,
+		request.NextEventID,
+		request.LastProcessedEvent,
+		nowTimestamp,
+		nowTimestamp,
+		request.RequestID,
+		request.DecisionScheduleID,
+		request.DecisionStartedID,
+		"", // Decision Start Request ID
+		request.DecisionStartToCloseTimeout,
+		request.CompletionCallbackURL,
+		false, // Cancel Requested
+		"",    // Cancel Request ID
+		request.FirstExecutionRunID,
+		request.TraceID,
+		searchAttributes)
+	if err != nil {
+		return err
+	}
+
+	if err := d.updateActivityInfos(tx, request.ActivityInfos, nil, domainID, workflowID, runID); err != nil {
+		return err
+	}
+
+	return d.updateTimerInfos(tx, request.TimerInfos, nil, domainID, workflowID, runID)
+}
+
+// createCurrentExecutionWithinTx points the current execution of a workflow at a run, unless the workflow already
+// has a current execution
+func (d *sqlPersistence) createCurrentExecutionWithinTx(tx *sqlTx, domainID, workflowID, runID, requestID string,
+	rangeID int64) error {
+	result, err := tx.Exec(sqlCreateCurrentExecutionQuery,
+		d.shardID,
+		domainID,
+		workflowID,
+		runID,
+		requestID)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		// The current execution already exists
+		var currentRunID, createRequestID string
+		if err := tx.QueryRow(sqlReadLockCurrentExecutionQuery, d.shardID, domainID, workflowID).Scan(
+			&currentRunID, &createRequestID); err != nil {
+			return err
+		}
+
+		msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v, rangeID: %v",
+			workflowID, currentRunID, rangeID)
+		return &workflow.WorkflowExecutionAlreadyStartedError{
+			Message:        common.StringPtr(msg),
+			StartRequestId: common.StringPtr(createRequestID),
+			RunId:          common.StringPtr(currentRunID),
+		}
+	}
+
+	return nil
+}
+func (d *sqlPersistence) GetWorkflowExecution(request *GetWorkflowExecutionRequest) (
+	*GetWorkflowExecutionResponse, error) {
+	execution := request.Execution
+	key := []interface{}{d.shardID, request.DomainID, execution.GetWorkflowId(), execution.GetRunId()}
+	state := &WorkflowMutableState{}
+
+	// Read the execution and its mutable state maps from the same snapshot
+	err := sqlTxExecute(d.db, "GetWorkflowExecution", func(tx *sqlTx) error {
+		info, err := scanWorkflowExecutionInfo(tx.QueryRow(sqlGetExecutionQuery, key...))
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return &workflow.EntityNotExistsError{
+					Message: fmt.Sprintf("Workflow execution not found.  WorkflowId: %v, RunId: %v",
+						execution.GetWorkflowId(), execution.GetRunId()),
+				}
+			}
+			return err
+		}
+		state.ExecutionInfo = info
+		if request.Projection == MutableStateProjectionExecutionInfo {
+			return nil
+		}
+
+		state.ActivitInfos = make(map[int64]*ActivityInfo)
+		if err := sqlQueryEach(tx, sqlGetActivityInfosQuery, key, func(row sqlScanner) error {
+			info, err := scanActivityInfo(row)
+			if err == nil {
+				state.ActivitInfos[info.ScheduleID] = info
+			}
+			return err
+		}); err != nil {
+			return err
+		}
+
+		state.TimerInfos = make(map[string]*TimerInfo)
+		if err := sqlQueryEach(tx, sqlGetTimerInfosQuery, key, func(row sqlScanner) error {
+			info, err := scanTimerInfo(row)
+			if err == nil {
+				state.TimerInfos[info.TimerID] = info
+			}
+			return err
+		}); err != nil {
+			return err
+		}
+
+		state.ChildExecutionInfos = make(map[int64]*ChildExecutionInfo)
+		if err := sqlQueryEach(tx, sqlGetChildExecutionInfosQuery, key, func(row sqlScanner) error {
+			info, err := scanChildExecutionInfo(row)
+			if err == nil {
+				state.ChildExecutionInfos[info.InitiatedID] = info
+			}
+			return err
+		}); err != nil {
+			return err
+		}
+
+		state.SignalRequestedIDs = make(map[string]time.Time)
+		return sqlQueryEach(tx, sqlGetSignalsRequestedQuery, key, func(row sqlScanner) error {
+			var requestID string
+			var recordedTime int64
+			err := row.Scan(&requestID, &recordedTime)
+			if err == nil {
+				state.SignalRequestedIDs[requestID] = timeFromSQL(recordedTime)
+			}
+			return err
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetWorkflowExecutionResponse{State: state}, nil
+}
+
+func (d *sqlPersistence) UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) (
+	*UpdateWorkflowExecutionResponse, error) {
+	executionInfo := request.ExecutionInfo
+	nowTimestamp := time.Now().UnixNano()
+
+	if err := sqlTxExecute(d.db, "UpdateWorkflowExecution", func(tx *sqlTx) error {
+		if err := d.assertShardRangeID(tx, request.RangeID, "update workflow execution"); err != nil {
+			return err
+		}
+
+		var nextEventID int64
+		if err := tx.QueryRow(sqlLockExecutionQuery,
+			d.shardID,
+			executionInfo.DomainID,
+			executionInfo.WorkflowID,
+			executionInfo.RunID).Scan(&nextEventID); err != nil {
+			if err == sql.ErrNoRows {
+				return &ConditionFailedError{
+					Msg: fmt.Sprintf("Failed to update workflow execution.  WorkflowId: %v, RunId: %v not found",
+						executionInfo.WorkflowID, executionInfo.RunID),
+				}
+			}
+			return err
+		}
+
+		if nextEventID != request.Condition {
+			// UpdateWorkflowExecution failed because next event ID is unexpected
+			return &ConditionFailedError{
+				Msg: fmt.Sprintf("Failed to update workflow execution.  Request Condition: %v, Actual Value: %v",
+					request.Condition, nextEventID),
+			}
+		}
+
+		if _, err := tx.Exec(sqlUpdateExecutionQuery,
+			executionInfo.ParentDomainID,
+			executionInfo.ParentWorkflowID,
+			executionInfo.ParentRunID,
+			executionInfo.InitiatedID,
+			executionInfo.CompletionEvent,
+			executionInfo.TaskList,
+			executionInfo.WorkflowTypeName,
+			executionInfo.DecisionTimeoutValue,
+			executionInfo.ExecutionContext,
+			executionInfo.State,
+			executionInfo.CloseStatus,
+			executionInfo.NextEventID,
+			executionInfo.LastProcessedEvent,
+			timeToSQL(executionInfo.StartTimestamp),
+			nowTimestamp,
+			executionInfo.CreateRequestID,
+			executionInfo.DecisionScheduleID,
+			executionInfo.DecisionStartedID,
+			executionInfo.DecisionRequestID,
+			executionInfo.DecisionTimeout,
+			executionInfo.CompletionCallbackURL,
+			executionInfo.CancelRequested,
+			executionInfo.CancelRequestID,
+			executionInfo.FirstExecutionRunID,
+			executionInfo.TraceID,
+			d.shardID,
+			executionInfo.DomainID,
+			executionInfo.WorkflowID,
+			executionInfo.RunID); err != nil {
+			return err
+		}
+
+		if err := d.createTransferTasks(tx, request.TransferTasks, executionInfo.DomainID, executionInfo.WorkflowID,
+			executionInfo.RunID); err != nil {
+			return err
+		}
+
+		if err := d.createTimerTasks(tx, request.TimerTasks, request.DeleteTimerTask, executionInfo.DomainID,
+			executionInfo.WorkflowID, executionInfo.RunID); err != nil {
+			return err
+		}
+
+		if err := d.updateActivityInfos(tx, request.UpsertActivityInfos, request.DeleteActivityInfo,
+			executionInfo.DomainID, executionInfo.WorkflowID, executionInfo.RunID); err != nil {
+			return err
+		}
+
+		if err := d.updateTimerInfos(tx, request.UpserTimerInfos, request.DeleteTimerInfos, executionInfo.DomainID,
+			executionInfo.WorkflowID, executionInfo.RunID); err != nil {
+			return err
+		}
+
+		if err := d.updateChildExecutionInfos(tx, request.UpsertChildExecutionInfos,
+			request.DeleteChildExecutionInfo, executionInfo.DomainID, executionInfo.WorkflowID,
+			executionInfo.RunID); err != nil {
+			return err
+		}
+
+		for _, requestID := range request.UpsertSignalRequestedIDs {
+			if _, err := tx.Exec(sqlCreateSignalRequestedQuery, d.shardID, executionInfo.DomainID,
+				executionInfo.WorkflowID, executionInfo.RunID, requestID, nowTimestamp); err != nil {
+				return err
+			}
+		}
+		for _, requestID := range request.DeleteSignalRequestedIDs {
+			if _, err := tx.Exec(sqlDeleteSignalRequestedQuery, d.shardID, executionInfo.DomainID,
+				executionInfo.WorkflowID, executionInfo.RunID, requestID); err != nil {
+				return err
+			}
+		}
+
+		if request.ContinueAsNew != nil {
+			startReq := request.ContinueAsNew
+			if err := d.createWorkflowExecutionWithinTx(tx, startReq, nowTimestamp); err != nil {
+				return err
+			}
+			if err := d.createTransferTasks(tx, startReq.TransferTasks, startReq.DomainID,
+				startReq.Execution.GetWorkflowId(), startReq.Execution.GetRunId()); err != nil {
+				return err
+			}
+			return d.createTimerTasks(tx, startReq.TimerTasks, nil, startReq.DomainID,
+				startReq.Execution.GetWorkflowId(), startReq.Execution.GetRunId())
+		} else if request.CloseExecution {
+			// Delete row representing current execution
+			_, err := tx.Exec(sqlDeleteCurrentExecutionQuery,
+				d.shardID,
+				executionInfo.DomainID,
+				executionInfo.WorkflowID)
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &UpdateWorkflowExecutionResponse{
+		MutableStateUpdateSessionStats: NewMutableStateUpdateSessionStats(request),
+	}, nil
+}
+
+func (d *sqlPersistence) DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error {
+	info := request.ExecutionInfo
+	key := []interface{}{d.shardID, info.DomainID, info.WorkflowID, info.RunID}
+
+	return sqlTxExecute(d.db, "DeleteWorkflowExecution", func(tx *sqlTx) error {
+		for _, query := range []string{
+			sqlDeleteActivityInfosQuery,
+			sqlDeleteTimerInfosQuery,
+			sqlDeleteChildExecutionInfosQuery,
+			sqlDeleteSignalsRequestedQuery,
+			sqlDeleteExecutionQuery,
+		} {
+			if _, err := tx.Exec(query, key...); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (d *sqlPersistence) GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse,
+	error) {
+	var currentRunID, createRequestID string
+	if err := d.db.QueryRow(sqlGetCurrentExecutionQuery,
+		d.shardID,
+		request.DomainID,
+		request.WorkflowID).Scan(&currentRunID, &createRequestID); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &workflow.EntityNotExistsError{
+				Message: fmt.Sprintf("Workflow execution not found.  WorkflowId: %v",
+					request.WorkflowID),
+			}
+		}
+
+		return nil, convertSQLError("GetCurrentExecution", err)
+	}
+
+	return &GetCurrentExecutionResponse{RunID: currentRunID}, nil
+}
+
+func (d *sqlPersistence) ListConcreteExecutions(request *ListConcreteExecutionsRequest) (
+	*ListConcreteExecutionsResponse, error) {
+	pageState, err := deserializePageToken(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// The page state is the key of the last execution returned
+	var lastKey [3]string
+	if len(pageState) > 0 {
+		if err := json.Unmarshal(pageState, &lastKey); err != nil {
+			return nil, ErrInvalidPageToken
+		}
+	}
+
+	pageSize := getPageSize(request.PageSize)
+	// one extra execution tells if there is a next page
+	args := []interface{}{d.shardID, lastKey[0], lastKey[0], lastKey[1], lastKey[1], lastKey[2], pageSize + 1}
+
+	response := &ListConcreteExecutionsResponse{NextPageToken: []byte{}}
+	if err := sqlQueryEach(d.db, sqlListExecutionsQuery, args, func(row sqlScanner) error {
+		if len(response.ExecutionInfos) == pageSize {
+			last := response.ExecutionInfos[pageSize-1]
+			nextPageState, err := json.Marshal([3]string{last.DomainID, last.WorkflowID, last.RunID})
+			if err != nil {
+				return err
+			}
+			response.NextPageToken = serializePageToken(nextPageState)
+			return nil
+		}
+
+		info, err := scanWorkflowExecutionInfo(row)
+		if err != nil {
+			return err
+		}
+		response.ExecutionInfos = append(response.ExecutionInfos, info)
+		return nil
+	}); err != nil {
+		return nil, convertSQLError("ListConcreteExecutions", err)
+	}
+
+	return response, nil
+}
+
+func (d *sqlPersistence) ListCurrentExecutions(request *ListCurrentExecutionsRequest) (
+	*ListCurrentExecutionsResponse, error) {
+	pageState, err := deserializePageToken(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// The page state is the key of the last workflow returned
+	var lastKey [2]string
+	if len(pageState) > 0 {
+		if err := json.Unmarshal(pageState, &lastKey); err != nil {
+			return nil, ErrInvalidPageToken
+		}
+	}
+
+	pageSize := getPageSize(request.PageSize)
+	// one extra current execution tells if there is a next page
+	args := []interface{}{d.shardID, lastKey[0], lastKey[0], lastKey[1], pageSize + 1}
+
+	response := &ListCurrentExecutionsResponse{NextPageToken: []byte{}}
+	if err := sqlQueryEach(d.db, sqlListCurrentExecutionsQuery, args, func(row sqlScanner) error {
+		if len(response.Executions) == pageSize {
+			last := response.Executions[pageSize-1]
+			nextPageState, err := json.Marshal([2]string{last.DomainID, last.WorkflowID})
+			if err != nil {
+				return err
+			}
+			response.NextPageToken = serializePageToken(nextPageState)
+			return nil
+		}
+
+		execution := &CurrentExecution{}
+		if err := row.Scan(&execution.DomainID, &execution.WorkflowID, &execution.RunID); err != nil {
+			return err
+		}
+		response.Executions = append(response.Executions, execution)
+		return nil
+	}); err != nil {
+		return nil, convertSQLError("ListCurrentExecutions", err)
+	}
+
+	return response, nil
+}
+
+func (d *sqlPersistence) DeleteCurrentWorkflowExecution(request *DeleteCurrentWorkflowExecutionRequest) error {
+	// The current execution is left alone if it points at another run
+	if _, err := d.db.Exec(sqlDeleteCurrentExecutionOfRunQuery,
+		d.shardID,
+		request.DomainID,
+		request.WorkflowID,
+		request.RunID); err != nil {
+		return convertSQLError("DeleteCurrentWorkflowExecution", err)
+	}
+
+	return nil
+}
+
+func (d *sqlPersistence) CreateCurrentWorkflowExecution(request *CreateCurrentWorkflowExecutionRequest) error {
+	return sqlTxExecute(d.db, "CreateCurrentWorkflowExecution", func(tx *sqlTx) error {
+		if err := d.assertShardRangeID(tx, request.RangeID, "create current workflow execution"); err != nil {
+			return err
+		}
+
+		return d.createCurrentExecutionWithinTx(tx, request.DomainID, request.WorkflowID, request.RunID,
+			request.RequestID, request.RangeID)
+	})
+}
+
+func (d *sqlPersistence) UpdateCurrentWorkflowExecution(request *UpdateCurrentWorkflowExecutionRequest) error {
+	return sqlTxExecute(d.db, "UpdateCurrentWorkflowExecution", func(tx *sqlTx) error {
+		if err := d.assertShardRangeID(tx, request.RangeID, "update current workflow execution"); err != nil {
+			return err
+		}
+
+		result, err := tx.Exec(sqlUpdateCurrentExecutionOfRunQuery,
+			request.RunID,
+			request.RequestID,
+			d.shardID,
+			request.DomainID,
+			request.WorkflowID,
+			request.PreviousRunID)
+		if err != nil {
+			return err
+		}
+
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+
+		if rows == 0 {
+			// The current execution points at another run, or there is none
+			var currentRunID, createRequestID string
+			if err := tx.QueryRow(sqlReadLockCurrentExecutionQuery, d.shardID, request.DomainID,
+				request.WorkflowID).Scan(&currentRunID, &createRequestID); err != nil && err != sql.ErrNoRows {
+				return err
+			}
+
+			return &CurrentWorkflowConditionFailedError{
+				Msg: fmt.Sprintf("Current execution does not point at the previous run. "+
+					"WorkflowId: %v, PreviousRunId: %v, CurrentRunId: %v",
+					request.WorkflowID, request.PreviousRunID, currentRunID),
+				RunID: currentRunID,
+			}
+		}
+
+		return nil
+	})
+}
+
+func (d *sqlPersistence) GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error) {
+	pageState, err := deserializePageToken(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// The page state is the ID of the last task returned
+	readLevel := request.ReadLevel
+	if len(pageState) > 0 {
+		if len(pageState) != 8 {
+			return nil, ErrInvalidPageToken
+		}
+		readLevel = int64(binary.BigEndian.Uint64(pageState))
+	}
+
+	pageSize := getPageSize(request.BatchSize)
+	// one extra task tells if there is a next page
+	args := []interface{}{d.shardID, readLevel, request.MaxReadLevel, pageSize + 1}
+
+	response := &GetTransferTasksResponse{NextPageToken: []byte{}}
+	if err := sqlQueryEach(d.db, sqlGetTransferTasksQuery, args, func(row sqlScanner) error {
+		if len(response.Tasks) == pageSize {
+			pageState = make([]byte, 8)
+			binary.BigEndian.PutUint64(pageState, uint64(response.Tasks[pageSize-1].TaskID))
+			response.NextPageToken = serializePageToken(pageState)
+			return nil
+		}
+
+		t := &TransferTaskInfo{}
+		if err := row.Scan(
+			&t.DomainID,
+			&t.WorkflowID,
+			&t.RunID,
+			&t.TaskID,
+			&t.TargetDomainID,
+			&t.TargetWorkflowID,
+			&t.TargetRunID,
+			&t.TaskList,
+			&t.TaskType,
+			&t.ScheduleID,
+			&t.CallbackURL); err != nil {
+			return err
+		}
+		response.Tasks = append(response.Tasks, t)
+		return nil
+	}); err != nil {
+		return nil, convertSQLError("GetTransferTasks", err)
+	}
+
+	return response, nil
+}
+
+func (d *sqlPersistence) CompleteTransferTask(request *CompleteTransferTaskRequest) error {
+	if _, err := d.db.Exec(sqlCompleteTransferTaskQuery, d.shardID, request.TaskID); err != nil {
+		return convertSQLError("CompleteTransferTask", err)
+	}
+
+	return nil
+}
+
+func (d *sqlPersistence) RangeCompleteTransferTask(request *RangeCompleteTransferTaskRequest) error {
+	if _, err := d.db.Exec(sqlRangeCompleteTransferTaskQuery,
+		d.shardID,
+		request.ExclusiveBeginTaskID,
+		request.InclusiveEndTaskID); err != nil {
+		return convertSQLError("RangeCompleteTransferTask", err)
+	}
+
+	return nil
+}
+
+func (d *sqlPersistence) CompleteTimerTask(request *CompleteTimerTaskRequest) error {
+	if _, err := d.db.Exec(sqlCompleteTimerTaskQuery,
+		d.shardID,
+		timeToSQL(request.VisibilityTimestamp),
+		request.TaskID); err != nil {
+		return convertSQLError("CompleteTimerTask", err)
+	}
+
+	return nil
+}
+
+func (d *sqlPersistence) RangeCompleteTimerTask(request *RangeCompleteTimerTaskRequest) error {
+	if _, err := d.db.Exec(sqlRangeCompleteTimerTaskQuery,
+		d.shardID,
+		timeToSQL(request.InclusiveBeginTimestamp),
+		timeToSQL(request.ExclusiveEndTimestamp)); err != nil {
+		return convertSQLError("RangeCompleteTimerTask", err)
+	}
+
+	return nil
+}
+
+// From TaskManager interface
+func (d *sqlPersistence) LeaseTaskList(request *LeaseTaskListRequest) (*LeaseTaskListResponse, error) {
+	if len(request.TaskList) == 0 {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("LeaseTaskList requires non empty task list"),
+		}
+	}
+
+	var rangeID, ackLevel int64
+	var pollers []byte
+	err := sqlTxExecute(d.db, "LeaseTaskList", func(tx *sqlTx) error {
+		err := tx.QueryRow(sqlLockTaskListQuery,
+			request.DomainID,
+			request.TaskList,
+			request.TaskType).Scan(&rangeID, &ackLevel, &pollers)
+		if err == sql.ErrNoRows { // First time task list is used
+			result, err := tx.Exec(sqlCreateTaskListQuery,
+				request.DomainID,
+				request.TaskList,
+				request.TaskType,
+				initialRangeID,
+				0,
+				nil)
+			if err != nil {
+				return err
+			}
+
+			if rows, err := result.RowsAffected(); err != nil || rows == 0 {
+				return &ConditionFailedError{
+					Msg: fmt.Sprintf("LeaseTaskList failed to apply. TaskList: %v, TaskType: %v created concurrently",
+						request.TaskList, request.TaskType),
+				}
+			}
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(sqlUpdateTaskListQuery,
+			rangeID+1,
+			ackLevel,
+			pollers,
+			request.DomainID,
+			request.TaskList,
+			request.TaskType)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tli := &TaskListInfo{
+		DomainID: request.DomainID,
+		Name:     request.TaskList,
+		TaskType: request.TaskType,
+		RangeID:  rangeID + 1,
+		AckLevel: ackLevel,
+	}
+	// The pollers are only read along with the task list, so they are stored as a JSON blob
+	if len(pollers) > 0 {
+		if err := json.Unmarshal(pollers, &tli.Pollers); err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("LeaseTaskList operation failed. Invalid pollers: %v", err),
+			}
+		}
+	}
+	return &LeaseTaskListResponse{TaskListInfo: tli}, nil
+}
+
+// From TaskManager interface
+func (d *sqlPersistence) UpdateTaskList(request *UpdateTaskListRequest) (*UpdateTaskListResponse, error) {
+	tli := request.TaskListInfo
+
+	var pollers []byte
+	if len(tli.Pollers) > 0 {
+		var err error
+		if pollers, err = json.Marshal(tli.Pollers); err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("UpdateTaskList operation failed. Invalid pollers: %v", err),
+			}
+		}
+	}
+
+	err := sqlTxExecute(d.db, "UpdateTaskList", func(tx *sqlTx) error {
+		var rangeID, ackLevel int64
+		var previousPollers []byte
+		if err := tx.QueryRow(sqlLockTaskListQuery, tli.DomainID, tli.Name, tli.TaskType).Scan(
+			&rangeID, &ackLevel, &previousPollers); err != nil {
+			if err == sql.ErrNoRows {
+				rangeID = -1
+			} else {
+				return err
+			}
+		}
+
+		if rangeID != tli.RangeID {
+			return &ConditionFailedError{
+				Msg: fmt.Sprintf("Failed to update task list. name: %v, type: %v, rangeID: %v, db rangeID: %v",
+					tli.Name, tli.TaskType, tli.RangeID, rangeID),
+			}
+		}
+
+		_, err := tx.Exec(sqlUpdateTaskListQuery,
+			tli.RangeID,
+			tli.AckLevel,
+			pollers,
+			tli.DomainID,
+			tli.Name,
+			tli.TaskType)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &UpdateTaskListResponse{}, nil
+}
+
+// From TaskManager interface
+func (d *sqlPersistence) GetTaskListBacklog(request *GetTaskListBacklogRequest) (*GetTaskListBacklogResponse,
+	error) {
+	var count int64
+	if err := d.db.QueryRow(sqlGetTaskListBacklogQuery,
+		request.DomainID,
+		request.TaskList,
+		request.TaskType,
+		request.AckLevel,
+		time.Now().UnixNano()).Scan(&count); err != nil {
+		return nil, convertSQLError("GetTaskListBacklog", err)
+	}
+
+	return &GetTaskListBacklogResponse{ApproximateCount: count}, nil
+}
+
+// From TaskManager interface
+func (d *sqlPersistence) CreateTasks(request *CreateTasksRequest) (*CreateTasksResponse, error) {
+	domainID := request.DomainID
+	taskList := request.TaskList
+	taskListType := request.TaskListType
+	now := time.Now()
+
+	err := sqlTxExecute(d.db, "CreateTask", func(tx *sqlTx) error {
+		// Ensure that range_id didn't change while the tasks are written
+		var rangeID int64
+		if err := tx.QueryRow(sqlReadLockTaskListQuery, domainID, taskList, taskListType).Scan(
+			&rangeID); err != nil && err != sql.ErrNoRows {
+			return err
+		}
+
+		if rangeID != request.RangeID {
+			return &ConditionFailedError{
+				Msg: fmt.Sprintf("Failed to create task. TaskList: %v, taskListType: %v, rangeID: %v, db rangeID: %v",
+					taskList, taskListType, request.RangeID, rangeID),
+			}
+		}
+
+		for _, task := range request.Tasks {
+			var expiryTimestamp int64
+			if task.Data.ScheduleToStartTimeout != 0 {
+				timeout := time.Duration(task.Data.ScheduleToStartTimeout) * time.Second
+				expiryTimestamp = now.Add(timeout).UnixNano()
+			}
+
+			if _, err := tx.Exec(sqlCreateTaskQuery,
+				domainID,
+				taskList,
+				taskListType,
+				task.TaskID,
+				task.Execution.GetWorkflowId(),
+				task.Execution.GetRunId(),
+				task.Data.ScheduleID,
+				expiryTimestamp); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreateTasksResponse{}, nil
+}
+
+// From TaskManager interface
+func (d *sqlPersistence) GetTasks(request *GetTasksRequest) (*GetTasksResponse, error) {
+	if request.ReadLevel > request.MaxReadLevel {
+		return &GetTasksResponse{}, nil
+	}
+
+	response := &GetTasksResponse{}
+	args := []interface{}{
+		request.DomainID,
+		request.TaskList,
+		request.TaskType,
+		request.ReadLevel,
+		request.MaxReadLevel,
+		time.Now().UnixNano(),
+		getPageSize(request.BatchSize),
+	}
+	if err := sqlQueryEach(d.db, sqlGetTasksQuery, args, func(row sqlScanner) error {
+		t := &TaskInfo{DomainID: request.DomainID}
+		if err := row.Scan(&t.TaskID, &t.WorkflowID, &t.RunID, &t.ScheduleID); err != nil {
+			return err
+		}
+		response.Tasks = append(response.Tasks, t)
+		return nil
+	}); err != nil {
+		return nil, convertSQLError("GetTasks", err)
+	}
+
+	return response, nil
+}
+
+// From TaskManager interface
+func (d *sqlPersistence) CompleteTask(request *CompleteTaskRequest) error {
+	tli := request.TaskList
+	if _, err := d.db.Exec(sqlCompleteTaskQuery, tli.DomainID, tli.Name, tli.TaskType, request.TaskID); err != nil {
+		return convertSQLError("CompleteTask", err)
+	}
+
+	return nil
+}
+
+// From TaskManager interface
+func (d *sqlPersistence) CompleteTasksLessThan(request *CompleteTasksLessThanRequest) error {
+	if _, err := d.db.Exec(sqlCompleteTasksLessThanQuery, request.DomainID, request.TaskListName, request.TaskType,
+		request.TaskID); err != nil {
+		return convertSQLError("CompleteTasksLessThan", err)
+	}
+
+	return nil
+}
+
+func (d *sqlPersistence) GetTimerIndexTasks(request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse,
+	error) {
+	pageState, err := deserializePageToken(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// The page state is the visibility timestamp and ID of the last timer returned
+	lastTimestamp := timeToSQL(request.MinTimestamp)
+	lastTaskID := int64(math.MinInt64)
+	if len(pageState) > 0 {
+		if len(pageState) != 16 {
+			return nil, ErrInvalidPageToken
+		}
+		lastTimestamp = int64(binary.BigEndian.Uint64(pageState[:8]))
+		lastTaskID = int64(binary.BigEndian.Uint64(pageState[8:]))
+	}
+
+	pageSize := getPageSize(request.BatchSize)
+	args := []interface{}{
+		d.shardID,
+		lastTimestamp,
+		lastTimestamp,
+		lastTaskID,
+		timeToSQL(request.MaxTimestamp),
+		pageSize + 1, // one extra timer tells if there is a next page
+	}
+
+	response := &GetTimerIndexTasksResponse{NextPageToken: []byte{}}
+	if err := sqlQueryEach(d.db, sqlGetTimerTasksQuery, args, func(row sqlScanner) error {
+		if len(response.Timers) == pageSize {
+			last := response.Timers[pageSize-1]
+			pageState = make([]byte, 16)
+			binary.BigEndian.PutUint64(pageState[:8], uint64(timeToSQL(last.VisibilityTimestamp)))
+			binary.BigEndian.PutUint64(pageState[8:], uint64(last.TaskID))
+			response.NextPageToken = serializePageToken(pageState)
+			return nil
+		}
+
+		t := &TimerTaskInfo{}
+		var visibilityTimestamp int64
+		if err := row.Scan(
+			&t.DomainID,
+			&t.WorkflowID,
+			&t.RunID,
+			&visibilityTimestamp,
+			&t.TaskID,
+			&t.TaskType,
+			&t.TimeoutType,
+			&t.EventID); err != nil {
+			return err
+		}
+		t.VisibilityTimestamp = timeFromSQL(visibilityTimestamp)
+		response.Timers = append(response.Timers, t)
+		return nil
+	}); err != nil {
+		return nil, convertSQLError("GetTimerTasks", err)
+	}
+
+	return response, nil
+}
+
+// assertShardRangeID read locks the shard row for the rest of the transaction and fails it with
+// ShardOwnershipLostError if the shard has been acquired with another range since rangeID
+func (d *sqlPersistence) assertShardRangeID(tx *sqlTx, rangeID int64, operation string) error {
+	var currentRangeID int64
+	if err := tx.QueryRow(sqlReadLockShardQuery, d.shardID).Scan(&currentRangeID); err != nil {
+		return err
+	}
+
+	if currentRangeID != rangeID {
+		return &ShardOwnershipLostError{
+			ShardID: d.shardID,
+			Msg: fmt.Sprintf("Failed to %v.  Request RangeID: %v, Actual RangeID: %v",
+				operation, rangeID, currentRangeID),
+		}
+	}
+
+	return nil
+}
+
+func (d *sqlPersistence) createTransferTasks(tx *sqlTx, transferTasks []Task, domainID, workflowID,
+	runID string) error {
+	for _, task := range transferTasks {
+		var taskList string
+		var scheduleID int64
+		var callbackURL string
+		targetDomainID := domainID
+		targetWorkflowID := transferTaskTransferTargetWorkflowID
+		targetRunID := transferTaskTypeTransferTargetRunID
+
+		switch task.GetType() {
+		case TransferTaskTypeActivityTask:
+			targetDomainID = task.(*ActivityTask).DomainID
+			taskList = task.(*ActivityTask).TaskList
+			scheduleID = task.(*ActivityTask).ScheduleID
+
+		case TransferTaskTypeDecisionTask:
+			targetDomainID = task.(*DecisionTask).DomainID
+			taskList = task.(*DecisionTask).TaskList
+			scheduleID = task.(*DecisionTask).ScheduleID
+
+		case TransferTaskTypeCancelExecution:
+			targetDomainID = task.(*CancelExecutionTask).TargetDomainID
+			targetWorkflowID = task.(*CancelExecutionTask).TargetWorkflowID
+			targetRunID = task.(*CancelExecutionTask).TargetRunID
+			scheduleID = task.(*CancelExecutionTask).ScheduleID
+
+		case TransferTaskTypeStartChildExecution:
+			targetDomainID = task.(*StartChildExecutionTask).TargetDomainID
+			targetWorkflowID = task.(*StartChildExecutionTask).TargetWorkflowID
+			scheduleID = task.(*StartChildExecutionTask).InitiatedID
+
+		case TransferTaskTypeCompletionCallback:
+			callbackURL = task.(*CompletionCallbackTask).CallbackURL
+		}
+
+		if _, err := tx.Exec(sqlCreateTransferTaskQuery,
+			d.shardID,
+			task.GetTaskID(),
+			domainID,
+			workflowID,
+			runID,
+			targetDomainID,
+			targetWorkflowID,
+			targetRunID,
+			taskList,
+			task.GetType(),
+			scheduleID,
+			callbackURL); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *sqlPersistence) createTimerTasks(tx *sqlTx, timerTasks []Task, deleteTimerTask Task,
+	domainID, workflowID, runID string) error {
+	for _, task := range timerTasks {
+		var eventID int64
+
+		timeoutType := 0
+
+		switch task.GetType() {
+		case TaskTypeDecisionTimeout:
+			eventID = task.(*DecisionTimeoutTask).EventID
+
+		case TaskTypeActivityTimeout:
+			eventID = task.(*ActivityTimeoutTask).EventID
+			timeoutType = task.(*ActivityTimeoutTask).TimeoutType
+
+		case TaskTypeUserTimer:
+			eventID = task.(*UserTimerTask).EventID
+
+		case TaskTypeCancelTimeout:
+			eventID = task.(*CancelTimeoutTask).EventID
+		}
+
+		if _, err := tx.Exec(sqlCreateTimerTaskQuery,
+			d.shardID,
+			timeToSQL(GetVisibilityTSFrom(task)),
+			task.GetTaskID(),
+			domainID,
+			workflowID,
+			runID,
+			task.GetType(),
+			timeoutType,
+			eventID); err != nil {
+			return err
+		}
+	}
+
+	if deleteTimerTask != nil {
+		if _, err := tx.Exec(sqlCompleteTimerTaskQuery,
+			d.shardID,
+			timeToSQL(GetVisibilityTSFrom(deleteTimerTask)),
+			deleteTimerTask.GetTaskID()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *sqlPersistence) updateActivityInfos(tx *sqlTx, activityInfos []*ActivityInfo, deleteInfo *int64,
+	domainID, workflowID, runID string) error {
+	for _, a := range activityInfos {
+		if _, err := tx.Exec(sqlDeleteActivityInfoQuery, d.shardID, domainID, workflowID, runID,
+			a.ScheduleID); err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(sqlCreateActivityInfoQuery,
+			d.shardID,
+			domainID,
+			workflowID,
+			runID,
+			a.ScheduleID,
+			a.ScheduledEvent,
+			a.StartedID,
+			a.StartedEvent,
+			a.ActivityID,
+			a.RequestID,
+			a.Details,
+			a.ScheduleToStartTimeout,
+			a.ScheduleToCloseTimeout,
+			a.StartToCloseTimeout,
+			a.HeartbeatTimeout,
+			a.CancelRequested,
+			a.CancelRequestID,
+			timeToSQL(a.LastHeartBeatUpdatedTime)); err != nil {
+			return err
+		}
+	}
+
+	if deleteInfo != nil {
+		if _, err := tx.Exec(sqlDeleteActivityInfoQuery, d.shardID, domainID, workflowID, runID,
+			*deleteInfo); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *sqlPersistence) updateTimerInfos(tx *sqlTx, timerInfos []*TimerInfo, deleteInfos []string,
+	domainID, workflowID, runID string) error {
+	for _, a := range timerInfos {
+		if _, err := tx.Exec(sqlDeleteTimerInfoQuery, d.shardID, domainID, workflowID, runID,
+			a.TimerID); err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(sqlCreateTimerInfoQuery,
+			d.shardID,
+			domainID,
+			workflowID,
+			runID,
+			a.TimerID,
+			a.StartedID,
+			timeToSQL(a.ExpiryTime),
+			a.TaskID); err != nil {
+			return err
+		}
+	}
+
+	for _, t := range deleteInfos {
+		if _, err := tx.Exec(sqlDeleteTimerInfoQuery, d.shardID, domainID, workflowID, runID, t); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *sqlPersistence) updateChildExecutionInfos(tx *sqlTx, childExecutionInfos []*ChildExecutionInfo,
+	deleteInfo *int64, domainID, workflowID, runID string) error {
+	for _, c := range childExecutionInfos {
+		if _, err := tx.Exec(sqlDeleteChildExecutionInfoQuery, d.shardID, domainID, workflowID, runID,
+			c.InitiatedID); err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(sqlCreateChildExecutionInfoQuery,
+			d.shardID,
+			domainID,
+			workflowID,
+			runID,
+			c.InitiatedID,
+			c.InitiatedEvent,
+			c.StartedID,
+			c.StartedEvent,
+			c.CreateRequestID); err != nil {
+			return err
+		}
+	}
+
+	if deleteInfo != nil {
+		if _, err := tx.Exec(sqlDeleteChildExecutionInfoQuery, d.shardID, domainID, workflowID, runID,
+			*deleteInfo); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func scanWorkflowExecutionInfo(row sqlScanner) (*WorkflowExecutionInfo, error) {
+	info := &WorkflowExecutionInfo{}
+	var startTime, lastUpdatedTime int64
+	var searchAttributes []byte
+	if err := row.Scan(
+		&info.DomainID,
+		&info.WorkflowID,
+		&info.RunID,
+		&info.ParentDomainID,
+		&info.ParentWorkflowID,
+		&info.ParentRunID,
+		&info.InitiatedID,
+		&info.CompletionEvent,
+		&info.TaskList,
+		&info.WorkflowTypeName,
+		&info.DecisionTimeoutValue,
+		&info.ExecutionContext,
+		&info.State,
+		&info.CloseStatus,
+		&info.NextEventID,
+		&info.LastProcessedEvent,
+		&startTime,
+		&lastUpdatedTime,
+		&info.CreateRequestID,
+		&info.DecisionScheduleID,
+		&info.DecisionStartedID,
+		&info.DecisionRequestID,
+		&info.DecisionTimeout,
+		&info.CompletionCallbackURL,
+		&info.CancelRequested,
+		&info.CancelRequestID,
+		&info.FirstExecutionRunID,
+		&info.TraceID,
+		&searchAttributes); err != nil {
+		return nil, err
+	}
+	info.StartTimestamp = timeFromSQL(startTime)
+	info.LastUpdatedTimestamp = timeFromSQL(lastUpdatedTime)
+	if len(searchAttributes) > 0 {
+		if err := json.Unmarshal(searchAttributes, &info.SearchAttributes); err != nil {
+			return nil, err
+		}
+	}
+
+	return info, nil
+}
+
+func scanActivityInfo(row sqlScanner) (*ActivityInfo, error) {
+	info := &ActivityInfo{}
+	var lastHeartBeatUpdatedTime int64
+	if err := row.Scan(
+		&info.ScheduleID,
+		&info.ScheduledEvent,
+		&info.StartedID,
+		&info.StartedEvent,
+		&info.ActivityID,
+		&info.RequestID,
+		&info.Details,
+		&info.ScheduleToStartTimeout,
+		&info.ScheduleToCloseTimeout,
+		&info.StartToCloseTimeout,
+		&info.HeartbeatTimeout,
+		&info.CancelRequested,
+		&info.CancelRequestID,
+		&lastHeartBeatUpdatedTime); err != nil {
+		return nil, err
+	}
+	info.LastHeartBeatUpdatedTime = timeFromSQL(lastHeartBeatUpdatedTime)
+
+	return info, nil
+}
+
+func scanTimerInfo(row sqlScanner) (*TimerInfo, error) {
+	info := &TimerInfo{}
+	var expiryTime int64
+	if err := row.Scan(&info.TimerID, &info.StartedID, &expiryTime, &info.TaskID); err != nil {
+		return nil, err
+	}
+	info.ExpiryTime = timeFromSQL(expiryTime)
+
+	return info, nil
+}
+
+func scanChildExecutionInfo(row sqlScanner) (*ChildExecutionInfo, error) {
+	info := &ChildExecutionInfo{}
+	if err := row.Scan(
+		&info.InitiatedID,
+		&info.InitiatedEvent,
+		&info.StartedID,
+		&info.StartedEvent,
+		&info.CreateRequestID); err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+// sqlQueryEach runs the query and calls fn for every row returned
+func sqlQueryEach(q sqlQuerier, query string, args []interface{}, fn func(row sqlScanner) error) error {
+	rows, err := q.Query(query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		if err := fn(rows); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+func timeToSQL(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixNano()
+}
+
+func timeFromSQL(ts int64) time.Time {
+	if ts == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, ts)
+}
@@ -0,0 +1,211 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// +build sqlite
+
+package persistence
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	// registers the database/sql driver used by the SQLite persistence
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+type (
+	// sqlPersistenceSuite covers the behaviors of the SQL persistence which rely on its transactions rather than on
+	// the conditional writes of cassandra.  It runs on an in-memory SQLite database, the same queries are rewritten
+	// into the MySQL and PostgreSQL dialects.
+	sqlPersistenceSuite struct {
+		suite.Suite
+		TestBase
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+	}
+)
+
+func TestSQLPersistenceSuite(t *testing.T) {
+	s := new(sqlPersistenceSuite)
+	s.StoreOptions = sqliteTestBaseOptions()
+	suite.Run(t, s)
+}
+
+// sqliteTestBaseOptions returns the options of a test base on a new in-memory SQLite database
+func sqliteTestBaseOptions() *TestBaseOptions {
+	return &TestBaseOptions{
+		SchemaDir:         testSchemaDir,
+		SQLDriverName:     SQLiteDriverName,
+		SQLDataSourceName: fmt.Sprintf("file:%v?mode=memory&cache=shared", uuid.New()),
+	}
+}
+
+func (s *sqlPersistenceSuite) SetupSuite() {
+	if testing.Verbose() {
+		log.SetOutput(os.Stdout)
+	}
+
+	s.SetupWorkflowStore()
+}
+
+func (s *sqlPersistenceSuite) TearDownSuite() {
+	s.TearDownWorkflowStore()
+}
+
+func (s *sqlPersistenceSuite) SetupTest() {
+	// Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+	s.Assertions = require.New(s.T())
+}
+
+func (s *sqlPersistenceSuite) TestUpdateShardWithStaleRangeID() {
+	shardID := 10
+	s.NoError(s.CreateShard(shardID, "owner", 5))
+	s.IsType(&ShardAlreadyExistError{}, s.CreateShard(shardID, "other-owner", 5))
+
+	info, err := s.GetShard(shardID)
+	s.NoError(err)
+	s.True(info.TimerAckLevel.IsZero())
+
+	info.RangeID = 6
+	s.NoError(s.UpdateShard(info, 5))
+
+	// The shard was stolen by the update above
+	info.RangeID = 7
+	s.IsType(&ShardOwnershipLostError{}, s.UpdateShard(info, 5))
+
+	info, err = s.GetShard(shardID)
+	s.NoError(err)
+	s.Equal(int64(6), info.RangeID)
+	s.Equal("owner", info.Owner)
+}
+
+func (s *sqlPersistenceSuite) TestCreateWorkflowExecutionWithStaleRangeID() {
+	shardID := 11
+	s.NoError(s.CreateShard(shardID, "owner", 3))
+	executionMgr, err := s.ExecutionMgrFactory.CreateExecutionManager(shardID)
+	s.NoError(err)
+	defer executionMgr.Close()
+
+	domainID := uuid.New()
+	newRequest := func(rangeID int64) *CreateWorkflowExecutionRequest {
+		return &CreateWorkflowExecutionRequest{
+			RequestID: uuid.New(),
+			DomainID:  domainID,
+			Execution: gen.WorkflowExecution{
+				WorkflowId: common.StringPtr("stale-range-workflow"),
+				RunId:      common.StringPtr(uuid.New()),
+			},
+			TaskList:           "queue1",
+			WorkflowTypeName:   "wType",
+			NextEventID:        3,
+			LastProcessedEvent: 0,
+			RangeID:            rangeID,
+			DecisionScheduleID: 2,
+			DecisionStartedID:  common.EmptyEventID,
+		}
+	}
+
+	_, err = executionMgr.CreateWorkflowExecution(newRequest(2))
+	s.IsType(&ShardOwnershipLostError{}, err)
+
+	// Nothing is left behind by the rejected write
+	_, err = executionMgr.GetCurrentExecution(&GetCurrentExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: "stale-range-workflow",
+	})
+	s.IsType(&gen.EntityNotExistsError{}, err)
+
+	first := newRequest(3)
+	_, err = executionMgr.CreateWorkflowExecution(first)
+	s.NoError(err)
+
+	_, err = executionMgr.CreateWorkflowExecution(newRequest(3))
+	s.IsType(&gen.WorkflowExecutionAlreadyStartedError{}, err)
+	alreadyStarted := err.(*gen.WorkflowExecutionAlreadyStartedError)
+	s.Equal(first.RequestID, alreadyStarted.GetStartRequestId())
+	s.Equal(first.Execution.GetRunId(), alreadyStarted.GetRunId())
+}
+
+func (s *sqlPersistenceSuite) TestUpdateWorkflowExecutionCondition() {
+	domainID := uuid.New()
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("update-condition-workflow"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	_, err := s.CreateWorkflowExecution(domainID, workflowExecution, "queue1", "wType", 20, nil, 3, 0, 2, nil)
+	s.NoError(err)
+
+	state, err := s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.NoError(err)
+	info := state.ExecutionInfo
+
+	// The condition is checked on the locked row, a write leaving the next event ID unchanged still matches it
+	s.NoError(s.UpdateWorkflowExecutionWithTransferTasks(info, 3, nil, nil))
+	s.IsType(&ConditionFailedError{}, s.UpdateWorkflowExecutionWithTransferTasks(info, 2, nil, nil))
+
+	info.NextEventID = 5
+	s.NoError(s.UpdateWorkflowExecutionWithTransferTasks(info, 3, nil, nil))
+	s.IsType(&ConditionFailedError{}, s.UpdateWorkflowExecutionWithTransferTasks(info, 3, nil, nil))
+
+	state, err = s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.NoError(err)
+	s.Equal(int64(5), state.ExecutionInfo.NextEventID)
+}
+
+func (s *sqlPersistenceSuite) TestTaskListRangeID() {
+	domainID := uuid.New()
+	request := &LeaseTaskListRequest{DomainID: domainID, TaskList: "range-task-list", TaskType: TaskListTypeDecision}
+	response, err := s.TaskMgr.LeaseTaskList(request)
+	s.NoError(err)
+	s.Equal(int64(1), response.TaskListInfo.RangeID)
+
+	response, err = s.TaskMgr.LeaseTaskList(request)
+	s.NoError(err)
+	tli := response.TaskListInfo
+	s.Equal(int64(2), tli.RangeID)
+
+	// The pollers are stored as a blob along with the task list
+	pollTime := time.Unix(1500000000, 0)
+	tli.AckLevel = 10
+	tli.Pollers = map[string]time.Time{"poller1": pollTime}
+	_, err = s.TaskMgr.UpdateTaskList(&UpdateTaskListRequest{TaskListInfo: tli})
+	s.NoError(err)
+
+	stale := *tli
+	stale.RangeID = 1
+	_, err = s.TaskMgr.UpdateTaskList(&UpdateTaskListRequest{TaskListInfo: &stale})
+	s.IsType(&ConditionFailedError{}, err)
+
+	response, err = s.TaskMgr.LeaseTaskList(request)
+	s.NoError(err)
+	s.Equal(int64(3), response.TaskListInfo.RangeID)
+	s.Equal(int64(10), response.TaskListInfo.AckLevel)
+	s.True(pollTime.Equal(response.TaskListInfo.Pollers["poller1"]))
+}
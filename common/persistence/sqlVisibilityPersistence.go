@@ -0,0 +1,435 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/uber-common/bark"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+// Notes on the SQL visibility store:
+// * Executions are listed newest first, ordered by start time and then by run ID.  The page token holds the start
+//   time and run ID of the last execution returned, so pages stay stable while executions are recorded.
+// * Closed executions are not expired by the store, the history service deletes them once the retention period of
+//   their domain has passed.
+
+const (
+	sqlOpenExecutionColumns = `workflow_id, run_id, start_time, workflow_type_name`
+
+	sqlClosedExecutionColumns = `workflow_id, run_id, start_time, close_time, workflow_type_name, status, ` +
+		`history_length`
+
+	// sqlVisibilityPageClause restricts the start time to [earliest, latest] and continues after the last execution
+	// of the previous page
+	sqlVisibilityPageClause = `AND start_time >= ? AND (start_time < ? OR (start_time = ? AND run_id > ?)) ` +
+		`ORDER BY start_time DESC, run_id LIMIT ?`
+
+	sqlCreateWorkflowExecutionStartedQuery = `INSERT INTO open_executions (domain_id, ` + sqlOpenExecutionColumns +
+		`) VALUES (?, ?, ?, ?, ?) ON CONFLICT DO NOTHING`
+
+	sqlDeleteWorkflowExecutionStartedQuery = `DELETE FROM open_executions WHERE domain_id = ? AND run_id = ?`
+
+	sqlCreateWorkflowExecutionClosedQuery = `INSERT INTO closed_executions (domain_id, ` + sqlClosedExecutionColumns +
+		`) VALUES (?, ?, ?, ?, ?, ?, ?, ?) ON CONFLICT DO NOTHING`
+
+	sqlGetOpenWorkflowExecutionsQuery = `SELECT ` + sqlOpenExecutionColumns + ` FROM open_executions ` +
+		`WHERE domain_id = ? ` + sqlVisibilityPageClause
+
+	sqlGetClosedWorkflowExecutionsQuery = `SELECT ` + sqlClosedExecutionColumns + ` FROM closed_executions ` +
+		`WHERE domain_id = ? ` + sqlVisibilityPageClause
+
+	sqlGetOpenWorkflowExecutionsByTypeQuery = `SELECT ` + sqlOpenExecutionColumns + ` FROM open_executions ` +
+		`WHERE domain_id = ? AND workflow_type_name = ? ` + sqlVisibilityPageClause
+
+	sqlGetClosedWorkflowExecutionsByTypeQuery = `SELECT ` + sqlClosedExecutionColumns + ` FROM closed_executions ` +
+		`WHERE domain_id = ? AND workflow_type_name = ? ` + sqlVisibilityPageClause
+
+	sqlGetOpenWorkflowExecutionsByIDQuery = `SELECT ` + sqlOpenExecutionColumns + ` FROM open_executions ` +
+		`WHERE domain_id = ? AND workflow_id = ? ` + sqlVisibilityPageClause
+
+	sqlGetClosedWorkflowExecutionsByIDQuery = `SELECT ` + sqlClosedExecutionColumns + ` FROM closed_executions ` +
+		`WHERE domain_id = ? AND workflow_id = ? ` + sqlVisibilityPageClause
+
+	sqlGetClosedWorkflowExecutionsByStatusQuery = `SELECT ` + sqlClosedExecutionColumns + ` FROM closed_executions ` +
+		`WHERE domain_id = ? AND status = ? ` + sqlVisibilityPageClause
+
+	sqlCountOpenWorkflowExecutionsQuery = `SELECT COUNT(*) FROM open_executions ` +
+		`WHERE domain_id = ? AND start_time >= ? AND start_time <= ? `
+
+	sqlCountClosedWorkflowExecutionsQuery = `SELECT COUNT(*) FROM closed_executions ` +
+		`WHERE domain_id = ? AND start_time >= ? AND start_time <= ? `
+
+	// The filters of the count queries, at most one of them is appended
+	sqlCountByTypeFilter   = `AND workflow_type_name = ?`
+	sqlCountByIDFilter     = `AND workflow_id = ?`
+	sqlCountByStatusFilter = `AND status = ?`
+
+	sqlGetClosedWorkflowExecutionQuery = `SELECT ` + sqlClosedExecutionColumns + ` FROM closed_executions ` +
+		`WHERE domain_id = ? AND workflow_id = ? AND run_id = ?`
+
+	sqlDeleteWorkflowExecutionClosedQuery = `DELETE FROM closed_executions WHERE domain_id = ? AND run_id = ?`
+)
+
+type (
+	sqlVisibilityPersistence struct {
+		db     *sqlDB
+		logger bark.Logger
+	}
+)
+
+// NewSQLVisibilityPersistence is used to create an instance of VisibilityManager implementation
+func NewSQLVisibilityPersistence(driverName, dataSourceName string, maxConns int, logger bark.Logger) (
+	VisibilityManager, error) {
+	db, err := newSQLDB(driverName, dataSourceName, maxConns)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sqlVisibilityPersistence{db: db, logger: logger}, nil
+}
+
+// Close releases the resources held by this object
+func (v *sqlVisibilityPersistence) Close() {
+	if v.db != nil {
+		v.db.Close()
+	}
+}
+
+func (v *sqlVisibilityPersistence) RecordWorkflowExecutionStarted(
+	request *RecordWorkflowExecutionStartedRequest) error {
+	if _, err := v.db.Exec(sqlCreateWorkflowExecutionStartedQuery,
+		request.DomainUUID,
+		request.Execution.GetWorkflowId(),
+		request.Execution.GetRunId(),
+		request.StartTimestamp,
+		request.WorkflowTypeName); err != nil {
+		return convertSQLError("RecordWorkflowExecutionStarted", err)
+	}
+
+	return nil
+}
+
+func (v *sqlVisibilityPersistence) RecordWorkflowExecutionClosed(
+	request *RecordWorkflowExecutionClosedRequest) error {
+	return sqlTxExecute(v.db, "RecordWorkflowExecutionClosed", func(tx *sqlTx) error {
+		// First, remove execution from the open table
+		if _, err := tx.Exec(sqlDeleteWorkflowExecutionStartedQuery,
+			request.DomainUUID,
+			request.Execution.GetRunId()); err != nil {
+			return err
+		}
+
+		// Next, add a row in the closed table
+		_, err := tx.Exec(sqlCreateWorkflowExecutionClosedQuery,
+			request.DomainUUID,
+			request.Execution.GetWorkflowId(),
+			request.Execution.GetRunId(),
+			request.StartTimestamp,
+			request.CloseTimestamp,
+			request.WorkflowTypeName,
+			request.Status,
+			request.HistoryLength)
+		return err
+	})
+}
+
+func (v *sqlVisibilityPersistence) ListOpenWorkflowExecutions(
+	request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions("ListOpenWorkflowExecutions", sqlGetOpenWorkflowExecutionsQuery, false,
+		request, request.DomainUUID)
+}
+
+func (v *sqlVisibilityPersistence) ListClosedWorkflowExecutions(
+	request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions("ListClosedWorkflowExecutions", sqlGetClosedWorkflowExecutionsQuery, true,
+		request, request.DomainUUID)
+}
+
+func (v *sqlVisibilityPersistence) ListOpenWorkflowExecutionsByType(
+	request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions("ListOpenWorkflowExecutionsByType", sqlGetOpenWorkflowExecutionsByTypeQuery,
+		false, &request.ListWorkflowExecutionsRequest, request.DomainUUID, request.WorkflowTypeName)
+}
+
+func (v *sqlVisibilityPersistence) ListClosedWorkflowExecutionsByType(
+	request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions("ListClosedWorkflowExecutionsByType", sqlGetClosedWorkflowExecutionsByTypeQuery,
+		true, &request.ListWorkflowExecutionsRequest, request.DomainUUID, request.WorkflowTypeName)
+}
+
+func (v *sqlVisibilityPersistence) ListOpenWorkflowExecutionsByWorkflowID(
+	request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions("ListOpenWorkflowExecutionsByWorkflowID", sqlGetOpenWorkflowExecutionsByIDQuery,
+		false, &request.ListWorkflowExecutionsRequest, request.DomainUUID, request.WorkflowID)
+}
+
+func (v *sqlVisibilityPersistence) ListClosedWorkflowExecutionsByWorkflowID(
+	request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions("ListClosedWorkflowExecutionsByWorkflowID",
+		sqlGetClosedWorkflowExecutionsByIDQuery, true, &request.ListWorkflowExecutionsRequest, request.DomainUUID,
+		request.WorkflowID)
+}
+
+func (v *sqlVisibilityPersistence) ListClosedWorkflowExecutionsByStatus(
+	request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions("ListClosedWorkflowExecutionsByStatus",
+		sqlGetClosedWorkflowExecutionsByStatusQuery, true, &request.ListWorkflowExecutionsRequest, request.DomainUUID,
+		request.Status)
+}
+
+func (v *sqlVisibilityPersistence) ScanWorkflowExecutions(
+	request *ScanWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	tokenData, err := deserializePageToken(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	phase := scanPhaseOpen
+	var pageState []byte
+	if len(tokenData) > 0 {
+		phase = tokenData[0]
+		pageState = tokenData[1:]
+	}
+
+	query := sqlGetOpenWorkflowExecutionsQuery
+	closed := false
+	switch phase {
+	case scanPhaseOpen:
+	case scanPhaseClosed:
+		query = sqlGetClosedWorkflowExecutionsQuery
+		closed = true
+	default:
+		return nil, ErrInvalidPageToken
+	}
+
+	executions, nextPageState, err := v.readWorkflowExecutions(query, closed, math.MinInt64, math.MaxInt64,
+		pageState, request.PageSize, request.DomainUUID)
+	if err != nil {
+		if err == ErrInvalidPageToken {
+			return nil, err
+		}
+		return nil, convertSQLError("ScanWorkflowExecutions", err)
+	}
+
+	response := &ListWorkflowExecutionsResponse{Executions: executions}
+	if len(nextPageState) > 0 {
+		response.NextPageToken = serializePageToken(append([]byte{phase}, nextPageState...))
+	} else if phase == scanPhaseOpen {
+		// Open executions are exhausted, continue with closed executions
+		response.NextPageToken = serializePageToken([]byte{scanPhaseClosed})
+	} else {
+		response.NextPageToken = serializePageToken(nil)
+	}
+
+	return response, nil
+}
+
+// ListWorkflowExecutionsWithQuery is not supported by the SQL visibility store
+func (v *sqlVisibilityPersistence) ListWorkflowExecutionsWithQuery(
+	request *ListWorkflowExecutionsWithQueryRequest) (*ListWorkflowExecutionsResponse, error) {
+	return nil, &workflow.BadRequestError{
+		Message: "ListWorkflowExecutionsWithQuery is only supported by the Elasticsearch visibility store.",
+	}
+}
+
+func (v *sqlVisibilityPersistence) CountOpenWorkflowExecutions(
+	request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
+	return v.countWorkflowExecutions("CountOpenWorkflowExecutions", sqlCountOpenWorkflowExecutionsQuery, false,
+		request)
+}
+
+func (v *sqlVisibilityPersistence) CountClosedWorkflowExecutions(
+	request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
+	return v.countWorkflowExecutions("CountClosedWorkflowExecutions", sqlCountClosedWorkflowExecutionsQuery, true,
+		request)
+}
+
+func (v *sqlVisibilityPersistence) GetClosedWorkflowExecution(
+	request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error) {
+	execution := request.Execution
+	var record *workflow.WorkflowExecutionInfo
+	args := []interface{}{request.DomainUUID, execution.GetWorkflowId(), execution.GetRunId()}
+	if err := sqlQueryEach(v.db, sqlGetClosedWorkflowExecutionQuery, args, func(row sqlScanner) error {
+		var err error
+		record, err = readSQLWorkflowExecutionRecord(row, true)
+		return err
+	}); err != nil {
+		return nil, convertSQLError("GetClosedWorkflowExecution", err)
+	}
+
+	if record == nil {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Workflow execution not found.  WorkflowId: %v, RunId: %v",
+				execution.GetWorkflowId(), execution.GetRunId()),
+		}
+	}
+
+	return &GetClosedWorkflowExecutionResponse{
+		Execution: record,
+	}, nil
+}
+
+func (v *sqlVisibilityPersistence) DeleteWorkflowExecution(request *DeleteWorkflowExecutionVisibilityRequest) error {
+	if _, err := v.db.Exec(sqlDeleteWorkflowExecutionClosedQuery,
+		request.DomainUUID,
+		request.Execution.GetRunId()); err != nil {
+		return convertSQLError("DeleteWorkflowExecution", err)
+	}
+
+	return nil
+}
+
+func (v *sqlVisibilityPersistence) listWorkflowExecutions(operation, query string, closed bool,
+	request *ListWorkflowExecutionsRequest, filters ...interface{}) (*ListWorkflowExecutionsResponse, error) {
+	pageState, err := deserializePageToken(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	executions, nextPageState, err := v.readWorkflowExecutions(query, closed, request.EarliestStartTime,
+		request.LatestStartTime, pageState, request.PageSize, filters...)
+	if err != nil {
+		if err == ErrInvalidPageToken {
+			return nil, err
+		}
+		return nil, convertSQLError(operation, err)
+	}
+
+	return &ListWorkflowExecutionsResponse{
+		Executions:    executions,
+		NextPageToken: serializePageToken(nextPageState),
+	}, nil
+}
+
+func (v *sqlVisibilityPersistence) countWorkflowExecutions(operation, query string, closed bool,
+	request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
+	args := []interface{}{request.DomainUUID, request.EarliestStartTime, request.LatestStartTime}
+	switch {
+	case request.WorkflowTypeName != "":
+		query += sqlCountByTypeFilter
+		args = append(args, request.WorkflowTypeName)
+	case request.WorkflowID != "":
+		query += sqlCountByIDFilter
+		args = append(args, request.WorkflowID)
+	case request.Status != nil && closed:
+		query += sqlCountByStatusFilter
+		args = append(args, *request.Status)
+	}
+
+	var count int64
+	if err := sqlQueryEach(v.db, query, args, func(row sqlScanner) error {
+		return row.Scan(&count)
+	}); err != nil {
+		return nil, convertSQLError(operation, err)
+	}
+
+	return &CountWorkflowExecutionsResponse{Count: count}, nil
+}
+
+// readWorkflowExecutions reads one page of executions started within [earliestStartTime, latestStartTime] and
+// returns them with the page state of the next page, which is empty on the last page
+func (v *sqlVisibilityPersistence) readWorkflowExecutions(query string, closed bool, earliestStartTime,
+	latestStartTime int64, pageState []byte, pageSize int, filters ...interface{}) (
+	[]*workflow.WorkflowExecutionInfo, []byte, error) {
+	// The first page continues after a fictitious execution started right after the latest start time
+	lastStartTime := latestStartTime
+	if lastStartTime < math.MaxInt64 {
+		lastStartTime++
+	}
+	lastRunID := ""
+	if len(pageState) > 0 {
+		if len(pageState) < 8 {
+			return nil, nil, ErrInvalidPageToken
+		}
+		lastStartTime = int64(binary.BigEndian.Uint64(pageState))
+		lastRunID = string(pageState[8:])
+	}
+
+	pageSize = getPageSize(pageSize)
+	args := append(filters, earliestStartTime, lastStartTime, lastStartTime, lastRunID,
+		pageSize+1) // one extra execution tells if there is a next page
+
+	executions := make([]*workflow.WorkflowExecutionInfo, 0)
+	var nextPageState []byte
+	if err := sqlQueryEach(v.db, query, args, func(row sqlScanner) error {
+		if len(executions) == pageSize {
+			last := executions[len(executions)-1]
+			nextPageState = make([]byte, 8)
+			binary.BigEndian.PutUint64(nextPageState, uint64(last.GetStartTime()))
+			nextPageState = append(nextPageState, last.Execution.GetRunId()...)
+			return nil
+		}
+
+		record, err := readSQLWorkflowExecutionRecord(row, closed)
+		if err != nil {
+			return err
+		}
+		executions = append(executions, record)
+		return nil
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	return executions, nextPageState, nil
+}
+
+func readSQLWorkflowExecutionRecord(row sqlScanner, closed bool) (*workflow.WorkflowExecutionInfo, error) {
+	var workflowID string
+	var runID string
+	var typeName string
+	var startTime int64
+	var closeTime int64
+	var status workflow.WorkflowExecutionCloseStatus
+	var historyLength int64
+	var err error
+	if closed {
+		err = row.Scan(&workflowID, &runID, &startTime, &closeTime, &typeName, &status, &historyLength)
+	} else {
+		err = row.Scan(&workflowID, &runID, &startTime, &typeName)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	execution := workflow.NewWorkflowExecution()
+	execution.WorkflowId = common.StringPtr(workflowID)
+	execution.RunId = common.StringPtr(runID)
+
+	wfType := workflow.NewWorkflowType()
+	wfType.Name = common.StringPtr(typeName)
+
+	record := workflow.NewWorkflowExecutionInfo()
+	record.Execution = execution
+	record.StartTime = common.Int64Ptr(startTime)
+	record.Type = wfType
+	if closed {
+		record.CloseTime = common.Int64Ptr(closeTime)
+		record.CloseStatus = workflow.WorkflowExecutionCloseStatusPtr(status)
+		record.HistoryLength = common.Int64Ptr(historyLength)
+	}
+	return record, nil
+}
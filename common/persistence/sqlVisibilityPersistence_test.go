@@ -0,0 +1,151 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// +build sqlite
+
+package persistence
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+type (
+	sqlVisibilityPersistenceSuite struct {
+		suite.Suite
+		TestBase
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+	}
+)
+
+func TestSQLVisibilityPersistenceSuite(t *testing.T) {
+	s := new(sqlVisibilityPersistenceSuite)
+	s.StoreOptions = sqliteTestBaseOptions()
+	suite.Run(t, s)
+}
+
+func (s *sqlVisibilityPersistenceSuite) SetupSuite() {
+	if testing.Verbose() {
+		log.SetOutput(os.Stdout)
+	}
+
+	s.SetupWorkflowStore()
+}
+
+func (s *sqlVisibilityPersistenceSuite) TearDownSuite() {
+	s.TearDownWorkflowStore()
+}
+
+func (s *sqlVisibilityPersistenceSuite) SetupTest() {
+	// Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+	s.Assertions = require.New(s.T())
+}
+
+func (s *sqlVisibilityPersistenceSuite) TestRecordClosedRetry() {
+	domainID := uuid.New()
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("sql-record-closed-retry"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	startTime := time.Now().UnixNano()
+	started := &RecordWorkflowExecutionStartedRequest{
+		DomainUUID:       domainID,
+		Execution:        workflowExecution,
+		WorkflowTypeName: "visibility-workflow",
+		StartTimestamp:   startTime,
+	}
+	s.NoError(s.VisibilityMgr.RecordWorkflowExecutionStarted(started))
+	s.NoError(s.VisibilityMgr.RecordWorkflowExecutionStarted(started))
+
+	closed := &RecordWorkflowExecutionClosedRequest{
+		DomainUUID:       domainID,
+		Execution:        workflowExecution,
+		WorkflowTypeName: "visibility-workflow",
+		StartTimestamp:   startTime,
+		CloseTimestamp:   startTime + int64(time.Second),
+		Status:           gen.WorkflowExecutionCloseStatus_COMPLETED,
+		HistoryLength:    5,
+	}
+	s.NoError(s.VisibilityMgr.RecordWorkflowExecutionClosed(closed))
+	s.NoError(s.VisibilityMgr.RecordWorkflowExecutionClosed(closed))
+
+	listRequest := &ListWorkflowExecutionsRequest{
+		DomainUUID:        domainID,
+		EarliestStartTime: startTime,
+		LatestStartTime:   startTime,
+		PageSize:          10,
+	}
+	openExecutions, err := s.VisibilityMgr.ListOpenWorkflowExecutions(listRequest)
+	s.NoError(err)
+	s.Equal(0, len(openExecutions.Executions))
+
+	closedExecutions, err := s.VisibilityMgr.ListClosedWorkflowExecutions(listRequest)
+	s.NoError(err)
+	s.Equal(1, len(closedExecutions.Executions))
+	s.Equal(int64(5), closedExecutions.Executions[0].GetHistoryLength())
+}
+
+func (s *sqlVisibilityPersistenceSuite) TestPaginationWithSameStartTime() {
+	domainID := uuid.New()
+	startTime := time.Now().UnixNano()
+	for i := 0; i < 5; i++ {
+		s.NoError(s.VisibilityMgr.RecordWorkflowExecutionStarted(&RecordWorkflowExecutionStartedRequest{
+			DomainUUID: domainID,
+			Execution: gen.WorkflowExecution{
+				WorkflowId: common.StringPtr("sql-same-start-time"),
+				RunId:      common.StringPtr(uuid.New()),
+			},
+			WorkflowTypeName: "visibility-workflow",
+			StartTimestamp:   startTime,
+		}))
+	}
+
+	// The run ID orders the executions started at the same time, so no execution is skipped or repeated
+	runIDs := make(map[string]bool)
+	var token []byte
+	for page := 0; page < 3; page++ {
+		response, err := s.VisibilityMgr.ListOpenWorkflowExecutions(&ListWorkflowExecutionsRequest{
+			DomainUUID:        domainID,
+			EarliestStartTime: startTime,
+			LatestStartTime:   startTime,
+			PageSize:          2,
+			NextPageToken:     token,
+		})
+		s.NoError(err)
+		for _, execution := range response.Executions {
+			s.False(runIDs[execution.Execution.GetRunId()])
+			runIDs[execution.Execution.GetRunId()] = true
+		}
+		token = response.NextPageToken
+	}
+	s.Equal(5, len(runIDs))
+	s.Empty(token)
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// +build sqlite
+
+package persistence
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// The persistence suites are run again on an in-memory SQLite database, which holds the SQL persistence to the
+// behaviors of the cassandra persistence.  Each suite gets a database of its own.
+
+func TestSQLiteExecutionPersistenceSuite(t *testing.T) {
+	s := new(cassandraPersistenceSuite)
+	s.StoreOptions = sqliteTestBaseOptions()
+	suite.Run(t, s)
+}
+
+func TestSQLiteShardPersistenceSuite(t *testing.T) {
+	s := new(shardPersistenceSuite)
+	s.StoreOptions = sqliteTestBaseOptions()
+	suite.Run(t, s)
+}
+
+func TestSQLiteHistoryPersistenceSuite(t *testing.T) {
+	s := new(historyPersistenceSuite)
+	s.StoreOptions = sqliteTestBaseOptions()
+	suite.Run(t, s)
+}
+
+func TestSQLiteMetadataPersistenceSuite(t *testing.T) {
+	s := new(metadataPersistenceSuite)
+	s.StoreOptions = sqliteTestBaseOptions()
+	suite.Run(t, s)
+}
+
+func TestSQLiteVisibilityPersistenceSuite(t *testing.T) {
+	s := new(visibilityPersistenceSuite)
+	s.StoreOptions = sqliteTestBaseOptions()
+	suite.Run(t, s)
+}
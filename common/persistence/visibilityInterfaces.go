@@ -30,12 +30,18 @@ import s "github.com/uber/cadence/.gen/go/shared"
 type (
 
 	// RecordWorkflowExecutionStartedRequest is used to add a record of a newly
-	// started execution
+	// started execution.  FirstRunID is the run ID of the first execution of its
+	// chain of continued as new executions, TraceID the trace ID of the chain and
+	// SearchAttributes the JSON encoded values of the search attributes of the
+	// execution, they are only indexed by the Elasticsearch store.
 	RecordWorkflowExecutionStartedRequest struct {
 		DomainUUID       string
 		Execution        s.WorkflowExecution
 		WorkflowTypeName string
 		StartTimestamp   int64
+		FirstRunID       string
+		TraceID          string
+		SearchAttributes map[string][]byte
 	}
 
 	// RecordWorkflowExecutionClosedRequest is used to add a record of a newly
@@ -49,6 +55,9 @@ type (
 		Status           s.WorkflowExecutionCloseStatus
 		HistoryLength    int64
 		RetentionSeconds int64
+		FirstRunID       string
+		TraceID          string
+		SearchAttributes map[string][]byte
 	}
 
 	// ListWorkflowExecutionsRequest is used to list executions in a domain
@@ -92,6 +101,46 @@ type (
 		Status s.WorkflowExecutionCloseStatus
 	}
 
+	// ScanWorkflowExecutionsRequest is used to scan all executions in a domain, open executions first.  Results are
+	// neither filtered nor sorted by time.
+	ScanWorkflowExecutionsRequest struct {
+		DomainUUID string
+		// Maximum number of workflow executions per page
+		PageSize int
+		// Token to continue reading next page of workflow executions.
+		// Pass in empty slice for first page.
+		NextPageToken []byte
+	}
+
+	// ListWorkflowExecutionsWithQueryRequest is used to list the open and closed executions in a domain which match
+	// a query, see the visibilityquery package for its syntax.  An empty query matches every execution.
+	ListWorkflowExecutionsWithQueryRequest struct {
+		DomainUUID string
+		Query      string
+		// Maximum number of workflow executions per page
+		PageSize int
+		// Token to continue reading next page of workflow executions.
+		// Pass in empty slice for first page.
+		NextPageToken []byte
+	}
+
+	// CountWorkflowExecutionsRequest is used to count the executions in a domain which started within
+	// [EarliestStartTime, LatestStartTime].  At most one of WorkflowTypeName, WorkflowID and Status is set, Status
+	// only applies to closed executions.
+	CountWorkflowExecutionsRequest struct {
+		DomainUUID        string
+		EarliestStartTime int64
+		LatestStartTime   int64
+		WorkflowTypeName  string
+		WorkflowID        string
+		Status            *s.WorkflowExecutionCloseStatus
+	}
+
+	// CountWorkflowExecutionsResponse is the response to CountWorkflowExecutionsRequest
+	CountWorkflowExecutionsResponse struct {
+		Count int64
+	}
+
 	// GetClosedWorkflowExecutionRequest is used retrieve the record for a specific execution
 	GetClosedWorkflowExecutionRequest struct {
 		DomainUUID string
@@ -103,6 +152,12 @@ type (
 		Execution *s.WorkflowExecutionInfo
 	}
 
+	// DeleteWorkflowExecutionVisibilityRequest is used to delete the record of a closed execution
+	DeleteWorkflowExecutionVisibilityRequest struct {
+		DomainUUID string
+		Execution  s.WorkflowExecution
+	}
+
 	// VisibilityManager is used to manage the visibility store
 	VisibilityManager interface {
 		Closeable
@@ -115,6 +170,11 @@ type (
 		ListOpenWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error)
 		ListClosedWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error)
 		ListClosedWorkflowExecutionsByStatus(request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error)
+		ScanWorkflowExecutions(request *ScanWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error)
+		ListWorkflowExecutionsWithQuery(request *ListWorkflowExecutionsWithQueryRequest) (*ListWorkflowExecutionsResponse, error)
+		CountOpenWorkflowExecutions(request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error)
+		CountClosedWorkflowExecutions(request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error)
 		GetClosedWorkflowExecution(request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error)
+		DeleteWorkflowExecution(request *DeleteWorkflowExecutionVisibilityRequest) error
 	}
 )
@@ -0,0 +1,118 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package common
+
+import (
+	"strings"
+
+	"github.com/uber/tchannel-go/thrift"
+)
+
+type (
+	// Priority is the hint sent by a caller with its request to tell how urgently it has to be served
+	Priority int
+
+	// PriorityTokenBucket limits the rate of the requests of all priorities, the background and batch requests being
+	// further limited to a fraction of the rate so that they leave room for the interactive ones
+	PriorityTokenBucket struct {
+		buckets [numPriorities]TokenBucket
+	}
+)
+
+const (
+	// PriorityInteractive is the priority of the requests a user or a worker is waiting on, it is the default
+	PriorityInteractive Priority = iota
+	// PriorityBackground is the priority of the requests of background processes like archival re-drives
+	PriorityBackground
+	// PriorityBatch is the priority of the requests of batch jobs like scans, which yield to all other requests
+	PriorityBatch
+
+	numPriorities = 3
+)
+
+const (
+	// PriorityHeaderName is the name of the tchannel header carrying the priority of a request
+	PriorityHeaderName = "cadence-priority"
+
+	// backgroundRatePercent and batchRatePercent are the shares of the rate of requests that the background and
+	// batch requests may use
+	backgroundRatePercent = 50
+	batchRatePercent      = 20
+)
+
+var priorityNames = [numPriorities]string{"interactive", "background", "batch"}
+
+// ParsePriority returns the priority of the given name, the interactive priority if the name is unknown
+func ParsePriority(name string) Priority {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for priority, priorityName := range priorityNames {
+		if name == priorityName {
+			return Priority(priority)
+		}
+	}
+	return PriorityInteractive
+}
+
+// GetPriority returns the priority sent in the headers of the call, the interactive priority if there is none
+func GetPriority(ctx thrift.Context) Priority {
+	if ctx == nil {
+		return PriorityInteractive
+	}
+	return ParsePriority(ctx.Headers()[PriorityHeaderName])
+}
+
+// String returns the name of the priority sent in the priority header
+func (p Priority) String() string {
+	if p < 0 || p >= numPriorities {
+		return priorityNames[PriorityInteractive]
+	}
+	return priorityNames[p]
+}
+
+// NewPriorityTokenBucket returns the token bucket allowing rps requests per second
+func NewPriorityTokenBucket(rps int, timeSource TimeSource) *PriorityTokenBucket {
+	return &PriorityTokenBucket{
+		buckets: [numPriorities]TokenBucket{
+			PriorityInteractive: NewTokenBucket(rps, timeSource),
+			PriorityBackground:  NewTokenBucket(sharedRPS(rps, backgroundRatePercent), timeSource),
+			PriorityBatch:       NewTokenBucket(sharedRPS(rps, batchRatePercent), timeSource),
+		},
+	}
+}
+
+// TryConsume takes a token for a request of the given priority, it returns false if the request must be rejected
+func (b *PriorityTokenBucket) TryConsume(priority Priority) bool {
+	if priority > PriorityInteractive && priority < numPriorities {
+		if ok, _ := b.buckets[priority].TryConsume(1); !ok {
+			return false
+		}
+	}
+	ok, _ := b.buckets[PriorityInteractive].TryConsume(1)
+	return ok
+}
+
+func sharedRPS(rps int, percent int) int {
+	shared := rps * percent / 100
+	if shared < 1 {
+		return 1
+	}
+	return shared
+}
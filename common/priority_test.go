@@ -0,0 +1,60 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePriority(t *testing.T) {
+	require.Equal(t, PriorityInteractive, ParsePriority(""))
+	require.Equal(t, PriorityInteractive, ParsePriority("urgent"))
+	require.Equal(t, PriorityBackground, ParsePriority("background"))
+	require.Equal(t, PriorityBatch, ParsePriority(" Batch "))
+	require.Equal(t, "batch", PriorityBatch.String())
+	require.Equal(t, "interactive", Priority(7).String())
+}
+
+func TestPriorityTokenBucket(t *testing.T) {
+	ts := &mockTimeSource{currTime: time.Now()}
+	tb := NewPriorityTokenBucket(100, ts)
+
+	// every 100ms the bucket allows 10 requests, of which 5 background and 2 batch ones
+	allowed := func(priority Priority, count int) int {
+		n := 0
+		for i := 0; i < count; i++ {
+			if tb.TryConsume(priority) {
+				n++
+			}
+		}
+		return n
+	}
+	require.Equal(t, 2, allowed(PriorityBatch, 3))
+	require.Equal(t, 5, allowed(PriorityBackground, 6))
+	require.Equal(t, 3, allowed(PriorityInteractive, 4))
+
+	ts.advance(101 * time.Millisecond)
+	require.Equal(t, 10, allowed(PriorityInteractive, 11))
+	require.Equal(t, 0, allowed(PriorityBatch, 1))
+}
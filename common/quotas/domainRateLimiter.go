@@ -0,0 +1,99 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quotas
+
+import (
+	"sync"
+
+	"github.com/uber/cadence/common"
+)
+
+type (
+	// DomainRateLimiter limits the rate of the requests of each domain with a token bucket of its own.  The limits
+	// can be changed while requests are served, which resets the buckets of all domains.
+	DomainRateLimiter struct {
+		timeSource common.TimeSource
+
+		sync.RWMutex
+		defaultRPS int
+		domainRPS  map[string]int
+		buckets    map[string]common.TokenBucket
+	}
+)
+
+// NewDomainRateLimiter returns a limiter which allows no more than defaultRPS requests per second to each domain,
+// except for the domains given their own limit in domainRPS.  A limit of zero lets all the requests of the domain
+// pass.
+func NewDomainRateLimiter(defaultRPS int, domainRPS map[string]int, timeSource common.TimeSource) *DomainRateLimiter {
+	l := &DomainRateLimiter{timeSource: timeSource}
+	l.SetRPS(defaultRPS, domainRPS)
+	return l
+}
+
+// SetRPS replaces the limits of all domains
+func (l *DomainRateLimiter) SetRPS(defaultRPS int, domainRPS map[string]int) {
+	limits := make(map[string]int, len(domainRPS))
+	for domain, rps := range domainRPS {
+		limits[domain] = rps
+	}
+
+	l.Lock()
+	defer l.Unlock()
+	l.defaultRPS = defaultRPS
+	l.domainRPS = limits
+	l.buckets = make(map[string]common.TokenBucket)
+}
+
+// Allow takes a token from the bucket of the domain, it returns false if the request must be rejected
+func (l *DomainRateLimiter) Allow(domain string) bool {
+	l.RLock()
+	bucket, ok := l.buckets[domain]
+	l.RUnlock()
+
+	if !ok {
+		bucket = l.getOrCreateBucket(domain)
+	}
+	if bucket == nil {
+		return true
+	}
+	ok, _ = bucket.TryConsume(1)
+	return ok
+}
+
+// getOrCreateBucket returns the bucket of the domain, nil if the requests of the domain are not limited
+func (l *DomainRateLimiter) getOrCreateBucket(domain string) common.TokenBucket {
+	l.Lock()
+	defer l.Unlock()
+	if bucket, ok := l.buckets[domain]; ok {
+		return bucket
+	}
+
+	rps, ok := l.domainRPS[domain]
+	if !ok {
+		rps = l.defaultRPS
+	}
+	var bucket common.TokenBucket
+	if rps > 0 {
+		bucket = common.NewTokenBucket(rps, l.timeSource)
+	}
+	l.buckets[domain] = bucket
+	return bucket
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quotas
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type mockTimeSource struct {
+	currTime time.Time
+}
+
+func (ts *mockTimeSource) Now() time.Time {
+	return ts.currTime
+}
+
+func TestDomainRateLimiter(t *testing.T) {
+	ts := &mockTimeSource{currTime: time.Now()}
+	limiter := NewDomainRateLimiter(10, map[string]int{"busy-domain": 20, "unlimited-domain": 0}, ts)
+
+	// every 100ms the buckets allow a tenth of the rps of the domain
+	allowed := func(domain string, count int) int {
+		n := 0
+		for i := 0; i < count; i++ {
+			if limiter.Allow(domain) {
+				n++
+			}
+		}
+		return n
+	}
+	require.Equal(t, 1, allowed("domain", 5))
+	require.Equal(t, 1, allowed("other-domain", 5))
+	require.Equal(t, 2, allowed("busy-domain", 5))
+	require.Equal(t, 5, allowed("unlimited-domain", 5))
+
+	limiter.SetRPS(0, map[string]int{"domain": 30})
+	require.Equal(t, 3, allowed("domain", 5))
+	require.Equal(t, 5, allowed("other-domain", 5))
+}
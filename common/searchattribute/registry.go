@@ -0,0 +1,149 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package searchattribute
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ValueType is the type of value a search attribute key holds
+type ValueType int
+
+// Types of values supported for search attributes
+const (
+	ValueTypeKeyword ValueType = iota
+	ValueTypeInt
+	ValueTypeDouble
+	ValueTypeBool
+	ValueTypeDatetime
+	ValueTypeText
+)
+
+// Keys of the search attributes available without registration
+const (
+	CustomKeywordField  = "CustomKeywordField"
+	CustomIntField      = "CustomIntField"
+	CustomDoubleField   = "CustomDoubleField"
+	CustomBoolField     = "CustomBoolField"
+	CustomDatetimeField = "CustomDatetimeField"
+	CustomStringField   = "CustomStringField"
+)
+
+var (
+	// DefaultKeys are the search attribute keys every registry starts with
+	DefaultKeys = map[string]ValueType{
+		CustomKeywordField:  ValueTypeKeyword,
+		CustomIntField:      ValueTypeInt,
+		CustomDoubleField:   ValueTypeDouble,
+		CustomBoolField:     ValueTypeBool,
+		CustomDatetimeField: ValueTypeDatetime,
+		CustomStringField:   ValueTypeText,
+	}
+
+	valueTypeNames = map[ValueType]string{
+		ValueTypeKeyword:  "Keyword",
+		ValueTypeInt:      "Int",
+		ValueTypeDouble:   "Double",
+		ValueTypeBool:     "Bool",
+		ValueTypeDatetime: "Datetime",
+		ValueTypeText:     "Text",
+	}
+)
+
+type (
+	// Registry holds the search attribute keys which are allowed on workflow executions along with their value types.
+	// The keys are registered in the config shared by the frontend and history hosts, so that every host accepts the
+	// same keys.
+	Registry interface {
+		// GetValueType returns the value type of a registered key
+		GetValueType(key string) (ValueType, bool)
+		// GetKeys returns a copy of all registered keys
+		GetKeys() map[string]ValueType
+	}
+
+	registryImpl struct {
+		keys map[string]ValueType
+	}
+)
+
+// NewRegistry creates a registry holding the given keys
+func NewRegistry(keys map[string]ValueType) Registry {
+	r := &registryImpl{keys: make(map[string]ValueType, len(keys))}
+	for key, valueType := range keys {
+		r.keys[key] = valueType
+	}
+	return r
+}
+
+// NewRegistryFromConfig creates a registry holding the default keys and the configured ones, which map a key to the
+// name of the type of its values, e.g. Keyword or Datetime.  A configured key cannot change the type of a default key.
+func NewRegistryFromConfig(configured map[string]string) (Registry, error) {
+	keys := make(map[string]ValueType, len(DefaultKeys)+len(configured))
+	for key, valueType := range DefaultKeys {
+		keys[key] = valueType
+	}
+	for key, name := range configured {
+		if len(key) == 0 {
+			return nil, fmt.Errorf("search attribute key is empty")
+		}
+		valueType, err := ParseValueType(name)
+		if err != nil {
+			return nil, fmt.Errorf("search attribute key %v: %v", key, err)
+		}
+		if existing, ok := keys[key]; ok && existing != valueType {
+			return nil, fmt.Errorf("search attribute key %v is already registered with value type %v", key, existing)
+		}
+		keys[key] = valueType
+	}
+	return &registryImpl{keys: keys}, nil
+}
+
+// ParseValueType returns the value type with the given name, names are case insensitive
+func ParseValueType(name string) (ValueType, error) {
+	for valueType, valueTypeName := range valueTypeNames {
+		if strings.EqualFold(name, valueTypeName) {
+			return valueType, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown search attribute value type %q", name)
+}
+
+func (r *registryImpl) GetValueType(key string) (ValueType, bool) {
+	valueType, ok := r.keys[key]
+	return valueType, ok
+}
+
+func (r *registryImpl) GetKeys() map[string]ValueType {
+	keys := make(map[string]ValueType, len(r.keys))
+	for key, valueType := range r.keys {
+		keys[key] = valueType
+	}
+	return keys
+}
+
+// String returns the name of the value type
+func (t ValueType) String() string {
+	if name, ok := valueTypeNames[t]; ok {
+		return name
+	}
+	return fmt.Sprintf("ValueType(%d)", int(t))
+}
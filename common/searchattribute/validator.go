@@ -0,0 +1,117 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package searchattribute
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+)
+
+const (
+	// DefaultMaxKeys is the maximum number of search attributes on a single workflow execution
+	DefaultMaxKeys = 100
+	// DefaultMaxValueSize is the maximum size in bytes of a single search attribute value
+	DefaultMaxValueSize = 2 * 1024
+	// DefaultMaxTotalSize is the maximum size in bytes of all search attributes of a workflow execution
+	DefaultMaxTotalSize = 40 * 1024
+)
+
+type (
+	// Validator checks search attributes against the registry and the size limits
+	Validator struct {
+		registry     Registry
+		maxKeys      int
+		maxValueSize int
+		maxTotalSize int
+	}
+)
+
+// NewValidator creates a validator for the keys of the given registry using the default limits
+func NewValidator(registry Registry) *Validator {
+	return &Validator{
+		registry:     registry,
+		maxKeys:      DefaultMaxKeys,
+		maxValueSize: DefaultMaxValueSize,
+		maxTotalSize: DefaultMaxTotalSize,
+	}
+}
+
+// Validate returns a BadRequestError if the search attributes use an unregistered key, a value which does not match
+// the registered value type or exceed the size limits.  Values are JSON encoded.
+func (v *Validator) Validate(attributes *workflow.SearchAttributes) error {
+	if attributes == nil {
+		return nil
+	}
+
+	fields := attributes.GetIndexedFields()
+	if len(fields) > v.maxKeys {
+		return badRequestf("Number of search attributes %v exceeds limit %v.", len(fields), v.maxKeys)
+	}
+
+	totalSize := 0
+	for key, value := range fields {
+		valueType, ok := v.registry.GetValueType(key)
+		if !ok {
+			return badRequestf("Search attribute %v is not registered.", key)
+		}
+		if len(value) > v.maxValueSize {
+			return badRequestf("Size of search attribute %v exceeds limit %v.", key, v.maxValueSize)
+		}
+		if err := validateValue(valueType, value); err != nil {
+			return badRequestf("Search attribute %v is not a valid %v value: %v", key, valueType, err)
+		}
+		totalSize += len(key) + len(value)
+	}
+
+	if totalSize > v.maxTotalSize {
+		return badRequestf("Total size of search attributes %v exceeds limit %v.", totalSize, v.maxTotalSize)
+	}
+	return nil
+}
+
+func validateValue(valueType ValueType, value []byte) error {
+	switch valueType {
+	case ValueTypeKeyword, ValueTypeText:
+		var s string
+		return json.Unmarshal(value, &s)
+	case ValueTypeInt:
+		var i int64
+		return json.Unmarshal(value, &i)
+	case ValueTypeDouble:
+		var f float64
+		return json.Unmarshal(value, &f)
+	case ValueTypeBool:
+		var b bool
+		return json.Unmarshal(value, &b)
+	case ValueTypeDatetime:
+		var t time.Time
+		return json.Unmarshal(value, &t)
+	default:
+		return fmt.Errorf("unknown value type %v", valueType)
+	}
+}
+
+func badRequestf(format string, args ...interface{}) error {
+	return &workflow.BadRequestError{Message: fmt.Sprintf(format, args...)}
+}
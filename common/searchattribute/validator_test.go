@@ -0,0 +1,122 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package searchattribute
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+)
+
+type (
+	validatorSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+		registry  Registry
+		validator *Validator
+	}
+)
+
+func TestValidatorSuite(t *testing.T) {
+	suite.Run(t, new(validatorSuite))
+}
+
+func (s *validatorSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.registry = NewRegistry(DefaultKeys)
+	s.validator = NewValidator(s.registry)
+}
+
+func (s *validatorSuite) TestValidate_Success() {
+	s.NoError(s.validator.Validate(nil))
+	s.NoError(s.validator.Validate(&workflow.SearchAttributes{IndexedFields: map[string][]byte{
+		CustomKeywordField:  []byte(`"keyword"`),
+		CustomIntField:      []byte(`123`),
+		CustomDoubleField:   []byte(`1.5`),
+		CustomBoolField:     []byte(`true`),
+		CustomDatetimeField: []byte(`"2017-08-01T10:00:00Z"`),
+		CustomStringField:   []byte(`"some text"`),
+	}}))
+}
+
+func (s *validatorSuite) TestValidate_UnregisteredKey() {
+	err := s.validator.Validate(&workflow.SearchAttributes{IndexedFields: map[string][]byte{
+		"UnknownField": []byte(`"value"`),
+	}})
+	s.IsType(&workflow.BadRequestError{}, err)
+
+	registry, err := NewRegistryFromConfig(map[string]string{"UnknownField": "Keyword"})
+	s.NoError(err)
+	s.NoError(NewValidator(registry).Validate(&workflow.SearchAttributes{IndexedFields: map[string][]byte{
+		"UnknownField": []byte(`"value"`),
+	}}))
+}
+
+func (s *validatorSuite) TestValidate_InvalidValue() {
+	for key, value := range map[string]string{
+		CustomKeywordField:  `123`,
+		CustomIntField:      `1.5`,
+		CustomDoubleField:   `"1.5"`,
+		CustomBoolField:     `1`,
+		CustomDatetimeField: `"yesterday"`,
+		CustomStringField:   `not json`,
+	} {
+		err := s.validator.Validate(&workflow.SearchAttributes{IndexedFields: map[string][]byte{key: []byte(value)}})
+		s.IsType(&workflow.BadRequestError{}, err, key)
+	}
+}
+
+func (s *validatorSuite) TestValidate_SizeLimits() {
+	large := []byte(`"` + strings.Repeat("a", DefaultMaxValueSize) + `"`)
+	err := s.validator.Validate(&workflow.SearchAttributes{IndexedFields: map[string][]byte{CustomKeywordField: large}})
+	s.IsType(&workflow.BadRequestError{}, err)
+
+	keys := make(map[string]ValueType)
+	fields := make(map[string][]byte)
+	for i := 0; i <= DefaultMaxKeys; i++ {
+		key := CustomKeywordField + strings.Repeat("x", i)
+		keys[key] = ValueTypeKeyword
+		fields[key] = []byte(`"value"`)
+	}
+	err = NewValidator(NewRegistry(keys)).Validate(&workflow.SearchAttributes{IndexedFields: fields})
+	s.IsType(&workflow.BadRequestError{}, err)
+}
+
+func (s *validatorSuite) TestRegistry_FromConfig() {
+	_, err := NewRegistryFromConfig(map[string]string{"": "Keyword"})
+	s.Error(err)
+	_, err = NewRegistryFromConfig(map[string]string{"NewField": "Uuid"})
+	s.Error(err)
+	_, err = NewRegistryFromConfig(map[string]string{CustomIntField: "Keyword"})
+	s.Error(err)
+
+	registry, err := NewRegistryFromConfig(map[string]string{CustomIntField: "Int", "NewField": "double"})
+	s.NoError(err)
+	valueType, ok := registry.GetValueType("NewField")
+	s.True(ok)
+	s.Equal(ValueTypeDouble, valueType)
+	s.Equal(len(DefaultKeys)+1, len(registry.GetKeys()))
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package bootstrap builds the dependencies of the cadence services from their config.  The services are started
+// with the BootstrapParams returned by NewParams, in which an embedder may replace some of the dependencies, e.g.
+// the metrics client or the persistence factory, before starting them.
+package bootstrap
+
+import (
+	"fmt"
+
+	"github.com/uber-common/bark"
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/searchattribute"
+	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/config"
+)
+
+// LoadConfig loads the config of the environment and of the availability zone from the config directory
+func LoadConfig(env string, configDir string, zone string) (*config.Config, error) {
+	cfg := &config.Config{}
+	if err := config.Load(env, configDir, zone, cfg); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// NewLogger returns the logger of the services
+func NewLogger(cfg *config.Config) bark.Logger {
+	return cfg.Log.NewBarkLogger()
+}
+
+// NewMetricsScope returns the scope the metrics of the service are reported to
+func NewMetricsScope(svcCfg *config.Service) tally.Scope {
+	return svcCfg.Metrics.NewScope()
+}
+
+// NewMetricsClient returns the client emitting the metrics of the service, e.g. cadence-history, to the scope
+func NewMetricsClient(scope tally.Scope, serviceName string, logger bark.Logger) metrics.Client {
+	return service.NewMetricsClient(scope, serviceName, logger)
+}
+
+// NewRingpopFactory returns the factory of the ring the hosts of the services join
+func NewRingpopFactory(cfg *config.Config) (service.RingpopFactory, error) {
+	factory, err := cfg.Ringpop.NewFactory()
+	if err != nil {
+		return nil, err
+	}
+	return factory, nil
+}
+
+// NewTChannelFactory returns the factory of the channel the service listens on, encrypted by the TLS configuration of
+// the service if any, else by the one of the cluster
+func NewTChannelFactory(cfg *config.Config, svcCfg *config.Service, scope tally.Scope) (service.TChannelFactory,
+	error) {
+	tlsCfg := &cfg.TLS
+	if svcCfg.TLS != nil {
+		tlsCfg = svcCfg.TLS
+	}
+	factory, err := svcCfg.TChannel.NewFactory(scope, tlsCfg)
+	if err != nil {
+		return nil, err
+	}
+	return factory, nil
+}
+
+// NewMembershipFactory returns the factory of the membership monitor of the hosts of the services
+func NewMembershipFactory() service.MembershipFactory {
+	return service.NewRingpopMembershipFactory()
+}
+
+// NewClientFactoryProvider returns the provider of the clients of the services
+func NewClientFactoryProvider() service.ClientFactoryProvider {
+	return service.NewTChannelClientFactoryProvider()
+}
+
+// NewParams returns the BootstrapParams of the service with the given name, e.g. history, built from the config
+func NewParams(serviceName string, cfg *config.Config) (*service.BootstrapParams, error) {
+	svcCfg, ok := cfg.Services[serviceName]
+	if !ok {
+		return nil, fmt.Errorf("`%v` service missing config", serviceName)
+	}
+	if err := validateConfig(cfg, &svcCfg); err != nil {
+		return nil, err
+	}
+
+	ringpopFactory, err := NewRingpopFactory(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ringpop factory: %v", err)
+	}
+
+	params := &service.BootstrapParams{}
+	params.Name = "cadence-" + serviceName
+	params.Logger = NewLogger(cfg)
+	params.CassandraConfig = cfg.Cassandra
+	params.PersistenceConfig = cfg.Persistence
+	params.RingpopFactory = ringpopFactory
+	params.MetricScope = NewMetricsScope(&svcCfg)
+	params.TChannelFactory, err = NewTChannelFactory(cfg, &svcCfg, params.MetricScope)
+	if err != nil {
+		return nil, fmt.Errorf("error creating tchannel factory: %v", err)
+	}
+	params.MetricsClient = NewMetricsClient(params.MetricScope, params.Name, params.Logger)
+	params.MembershipFactory = NewMembershipFactory()
+	params.ClientFactoryProvider = NewClientFactoryProvider()
+	params.PersistenceFactory = NewPersistenceFactory(cfg.Cassandra, cfg.Persistence, params.MetricsClient,
+		params.Logger)
+	params.ShadowPersistenceFactory = NewShadowPersistenceFactory(cfg.Cassandra, cfg.Persistence, params.MetricsClient,
+		params.Logger)
+
+	params.ServiceConfig = svcCfg
+	params.SearchAttributes = cfg.SearchAttributes
+	return params, nil
+}
+
+// NewConfigReloader returns the function reloading the config of the service with the given name with load, e.g.
+// from the config files the services were started with, which fails if the reloaded config is invalid
+func NewConfigReloader(serviceName string, load func() (*config.Config, error)) func() (*config.Config,
+	*config.Service, error) {
+	return func() (*config.Config, *config.Service, error) {
+		cfg, err := load()
+		if err != nil {
+			return nil, nil, err
+		}
+		svcCfg, ok := cfg.Services[serviceName]
+		if !ok {
+			return nil, nil, fmt.Errorf("`%v` service missing config", serviceName)
+		}
+		if err := validateConfig(cfg, &svcCfg); err != nil {
+			return nil, nil, err
+		}
+		return cfg, &svcCfg, nil
+	}
+}
+
+// validateConfig validates the config items which are not validated when the config is loaded
+func validateConfig(cfg *config.Config, svcCfg *config.Service) error {
+	switch cfg.Persistence.DataStore {
+	case "", config.DataStoreCassandra, config.DataStoreMySQL, config.DataStorePostgres, config.DataStoreSQLite:
+	default:
+		return fmt.Errorf("unsupported persistence data store: %v", cfg.Persistence.DataStore)
+	}
+	switch cfg.Persistence.Shadow.DataStore {
+	case "", config.DataStoreCassandra, config.DataStoreMySQL, config.DataStorePostgres, config.DataStoreSQLite:
+	default:
+		return fmt.Errorf("unsupported shadow persistence data store: %v", cfg.Persistence.Shadow.DataStore)
+	}
+
+	switch svcCfg.ExecutionScanner.Action {
+	case "", config.ExecutionScannerActionReport, config.ExecutionScannerActionQuarantine,
+		config.ExecutionScannerActionDelete:
+	default:
+		return fmt.Errorf("unsupported execution scanner action: %v", svcCfg.ExecutionScanner.Action)
+	}
+	if svcCfg.ExecutionScanner.Action == config.ExecutionScannerActionQuarantine &&
+		svcCfg.ExecutionScanner.QuarantineFile == "" {
+		return fmt.Errorf("execution scanner quarantine file missing")
+	}
+
+	if _, err := searchattribute.NewRegistryFromConfig(cfg.SearchAttributes); err != nil {
+		return err
+	}
+	return nil
+}
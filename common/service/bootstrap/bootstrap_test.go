@@ -0,0 +1,157 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bootstrap
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/service/config"
+)
+
+type bootstrapSuite struct {
+	suite.Suite
+	// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+	// not merely log an error
+	*require.Assertions
+	cfg *config.Config
+}
+
+func TestBootstrapSuite(t *testing.T) {
+	suite.Run(t, new(bootstrapSuite))
+}
+
+func (s *bootstrapSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.cfg = &config.Config{
+		Ringpop: config.Ringpop{
+			Name:           "cadence",
+			BootstrapMode:  config.BootstrapModeHosts,
+			BootstrapHosts: []string{"127.0.0.1:7933"},
+		},
+		Cassandra: config.Cassandra{
+			Hosts:              "127.0.0.1",
+			Keyspace:           "cadence",
+			VisibilityKeyspace: "cadence_visibility",
+			NumHistoryShards:   4,
+		},
+		Services: map[string]config.Service{
+			"history": {},
+		},
+	}
+}
+
+func (s *bootstrapSuite) TestNewParams() {
+	params, err := NewParams("history", s.cfg)
+	s.NoError(err)
+	s.Equal("cadence-history", params.Name)
+	s.Equal(4, params.CassandraConfig.NumHistoryShards)
+	s.NotNil(params.Logger)
+	s.NotNil(params.MetricScope)
+	s.NotNil(params.MetricsClient)
+	s.NotNil(params.RingpopFactory)
+	s.NotNil(params.TChannelFactory)
+	s.NotNil(params.MembershipFactory)
+	s.NotNil(params.ClientFactoryProvider)
+	s.NotNil(params.PersistenceFactory)
+	s.Nil(params.ShadowPersistenceFactory)
+	s.Equal(s.cfg.Services["history"], params.ServiceConfig)
+
+	s.cfg.Persistence.Shadow.DataStore = config.DataStoreMySQL
+	params, err = NewParams("history", s.cfg)
+	s.NoError(err)
+	s.NotNil(params.ShadowPersistenceFactory)
+}
+
+func (s *bootstrapSuite) TestNewParamsInvalidConfig() {
+	_, err := NewParams("matching", s.cfg)
+	s.Error(err)
+
+	s.cfg.Persistence.DataStore = "oracle"
+	_, err = NewParams("history", s.cfg)
+	s.Error(err)
+
+	s.cfg.Persistence.DataStore = ""
+	s.cfg.Services["history"] = config.Service{
+		ExecutionScanner: config.ExecutionScanner{Action: config.ExecutionScannerActionQuarantine},
+	}
+	_, err = NewParams("history", s.cfg)
+	s.Error(err)
+
+	s.cfg.Services["history"] = config.Service{}
+	s.cfg.TLS = config.TLS{Enabled: true}
+	_, err = NewParams("history", s.cfg)
+	s.Error(err)
+
+	s.cfg.Services["history"] = config.Service{TLS: &config.TLS{CertFile: "cert.pem"}}
+	_, err = NewParams("history", s.cfg)
+	s.NoError(err)
+
+	s.cfg.TLS = config.TLS{}
+	s.cfg.Services["history"] = config.Service{}
+	s.cfg.SearchAttributes = map[string]string{"CustomerID": "Uuid"}
+	_, err = NewParams("history", s.cfg)
+	s.Error(err)
+
+	s.cfg.SearchAttributes = map[string]string{"CustomerID": "keyword"}
+	params, err := NewParams("history", s.cfg)
+	s.NoError(err)
+	s.Equal(s.cfg.SearchAttributes, params.SearchAttributes)
+
+	s.cfg.Ringpop.Name = ""
+	_, err = NewParams("history", s.cfg)
+	s.Error(err)
+}
+
+func (s *bootstrapSuite) TestConfigReloader() {
+	s.cfg.Services["history"] = config.Service{AccessLog: config.AccessLog{Enabled: true}}
+	reload := NewConfigReloader("history", func() (*config.Config, error) {
+		return s.cfg, nil
+	})
+	cfg, svcCfg, err := reload()
+	s.NoError(err)
+	s.Equal(s.cfg, cfg)
+	s.True(svcCfg.AccessLog.Enabled)
+
+	s.cfg.SearchAttributes = map[string]string{"CustomerID": "Uuid"}
+	_, _, err = reload()
+	s.Error(err)
+
+	_, _, err = NewConfigReloader("matching", func() (*config.Config, error) {
+		return s.cfg, nil
+	})()
+	s.Error(err)
+
+	_, _, err = NewConfigReloader("history", func() (*config.Config, error) {
+		return nil, errors.New("unreadable config")
+	})()
+	s.Error(err)
+}
+
+func (s *bootstrapSuite) TestPersistenceFactoryInvalidConsistency() {
+	s.cfg.Cassandra.Consistency = "MOST"
+	factory := NewPersistenceFactory(s.cfg.Cassandra, s.cfg.Persistence, nil, NewLogger(s.cfg))
+	_, err := factory.CreateTaskManager()
+	s.Error(err)
+}
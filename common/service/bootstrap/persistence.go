@@ -0,0 +1,163 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bootstrap
+
+import (
+	"github.com/uber-common/bark"
+
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/config"
+)
+
+// persistenceFactory is the persistence.Factory creating the managers of the datastore configured by the persistence
+// config, either Cassandra or a SQL database, with the visibility records optionally kept in Elasticsearch
+type persistenceFactory struct {
+	cassandraConfig   config.Cassandra
+	persistenceConfig config.Persistence
+	metricsClient     metrics.Client
+	logger            bark.Logger
+}
+
+// NewPersistenceFactory returns the factory of the managers of the datastore of the persistence config.  The
+// Cassandra config is only used if the datastore is Cassandra.  The metrics client counts the warnings of Cassandra
+// about the tombstones scanned by the reads of the tasks and the histories.
+func NewPersistenceFactory(cassandraConfig config.Cassandra, persistenceConfig config.Persistence,
+	metricsClient metrics.Client, logger bark.Logger) persistence.Factory {
+	return &persistenceFactory{
+		cassandraConfig:   cassandraConfig,
+		persistenceConfig: persistenceConfig,
+		metricsClient:     metricsClient,
+		logger:            logger,
+	}
+}
+
+// NewShadowPersistenceFactory returns the factory of the managers of the secondary datastore of the workflow
+// executions, nil if the persistence config has no secondary datastore
+func NewShadowPersistenceFactory(cassandraConfig config.Cassandra, persistenceConfig config.Persistence,
+	metricsClient metrics.Client, logger bark.Logger) persistence.Factory {
+	shadow := persistenceConfig.Shadow
+	if shadow.DataStore == "" {
+		return nil
+	}
+	return NewPersistenceFactory(cassandraConfig, config.Persistence{DataStore: shadow.DataStore, SQL: shadow.SQL},
+		metricsClient, logger)
+}
+
+// CreateShardManager implements persistence.Factory
+func (f *persistenceFactory) CreateShardManager() (persistence.ShardManager, error) {
+	if f.persistenceConfig.IsSQL() {
+		sqlConfig := &f.persistenceConfig.SQL
+		return persistence.NewSQLShardPersistence(f.persistenceConfig.DataStore, sqlConfig.DataSourceName,
+			sqlConfig.MaxConns, f.logger)
+	}
+
+	consistency, err := f.cassandraConfig.NewConsistency()
+	if err != nil {
+		return nil, err
+	}
+	return persistence.NewCassandraShardPersistence(f.cassandraConfig.Hosts, f.cassandraConfig.Datacenter,
+		f.cassandraConfig.Keyspace, consistency, f.logger)
+}
+
+// CreateExecutionManager implements persistence.Factory
+func (f *persistenceFactory) CreateExecutionManager(shardID int) (persistence.ExecutionManager, error) {
+	if f.persistenceConfig.IsSQL() {
+		sqlConfig := &f.persistenceConfig.SQL
+		return persistence.NewSQLWorkflowExecutionPersistence(f.persistenceConfig.DataStore,
+			sqlConfig.DataSourceName, sqlConfig.MaxConns, shardID, f.logger)
+	}
+
+	consistency, err := f.cassandraConfig.NewConsistency()
+	if err != nil {
+		return nil, err
+	}
+	return persistence.NewCassandraWorkflowExecutionPersistence(f.cassandraConfig.Hosts,
+		f.cassandraConfig.Datacenter, f.cassandraConfig.Keyspace, consistency, shardID, f.metricsClient, f.logger)
+}
+
+// CreateMetadataManager implements persistence.Factory
+func (f *persistenceFactory) CreateMetadataManager() (persistence.MetadataManager, error) {
+	if f.persistenceConfig.IsSQL() {
+		sqlConfig := &f.persistenceConfig.SQL
+		return persistence.NewSQLMetadataPersistence(f.persistenceConfig.DataStore, sqlConfig.DataSourceName,
+			sqlConfig.MaxConns, f.logger)
+	}
+
+	consistency, err := f.cassandraConfig.NewConsistency()
+	if err != nil {
+		return nil, err
+	}
+	return persistence.NewCassandraMetadataPersistence(f.cassandraConfig.Hosts, f.cassandraConfig.Datacenter,
+		f.cassandraConfig.Keyspace, consistency, f.logger)
+}
+
+// CreateVisibilityManager implements persistence.Factory
+func (f *persistenceFactory) CreateVisibilityManager() (persistence.VisibilityManager, error) {
+	if f.persistenceConfig.IsElasticsearchVisibility() {
+		esConfig := &f.persistenceConfig.Elasticsearch
+		return persistence.NewElasticsearchVisibilityPersistence(esConfig.URL, esConfig.Index, f.logger)
+	}
+	if f.persistenceConfig.IsSQL() {
+		sqlConfig := &f.persistenceConfig.SQL
+		return persistence.NewSQLVisibilityPersistence(f.persistenceConfig.DataStore, sqlConfig.DataSourceName,
+			sqlConfig.MaxConns, f.logger)
+	}
+
+	consistency, err := f.cassandraConfig.NewConsistency()
+	if err != nil {
+		return nil, err
+	}
+	return persistence.NewCassandraVisibilityPersistence(f.cassandraConfig.Hosts, f.cassandraConfig.Datacenter,
+		f.cassandraConfig.VisibilityKeyspace, consistency, f.logger)
+}
+
+// CreateHistoryManager implements persistence.Factory
+func (f *persistenceFactory) CreateHistoryManager() (persistence.HistoryManager, error) {
+	if f.persistenceConfig.IsSQL() {
+		sqlConfig := &f.persistenceConfig.SQL
+		return persistence.NewSQLHistoryPersistence(f.persistenceConfig.DataStore, sqlConfig.DataSourceName,
+			sqlConfig.MaxConns, f.logger)
+	}
+
+	consistency, err := f.cassandraConfig.NewConsistency()
+	if err != nil {
+		return nil, err
+	}
+	return persistence.NewCassandraHistoryPersistence(f.cassandraConfig.Hosts, f.cassandraConfig.Datacenter,
+		f.cassandraConfig.Keyspace, consistency, f.metricsClient, f.logger)
+}
+
+// CreateTaskManager implements persistence.Factory
+func (f *persistenceFactory) CreateTaskManager() (persistence.TaskManager, error) {
+	if f.persistenceConfig.IsSQL() {
+		sqlConfig := &f.persistenceConfig.SQL
+		return persistence.NewSQLTaskPersistence(f.persistenceConfig.DataStore, sqlConfig.DataSourceName,
+			sqlConfig.MaxConns, f.logger)
+	}
+
+	consistency, err := f.cassandraConfig.NewConsistency()
+	if err != nil {
+		return nil, err
+	}
+	return persistence.NewCassandraTaskPersistence(f.cassandraConfig.Hosts, f.cassandraConfig.Datacenter,
+		f.cassandraConfig.Keyspace, consistency, f.metricsClient, f.logger)
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"fmt"
+
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	// BlobStoreFilesystem keeps the blobs in files of a directory
+	BlobStoreFilesystem = "filesystem"
+	// BlobStoreS3 keeps the blobs in an S3 bucket
+	BlobStoreS3 = "s3"
+
+	defaultBlobStoreThreshold = 256 * 1024
+)
+
+// NewClient builds the client of the blob store described by the config, it returns nil if no blob store is
+// configured
+func (c *BlobStore) NewClient() (blobstore.Client, error) {
+	switch c.Type {
+	case "":
+		return nil, nil
+	case BlobStoreFilesystem:
+		if c.Filesystem.Directory == "" {
+			return nil, fmt.Errorf("no directory configured for the %v blob store", c.Type)
+		}
+		return blobstore.NewFilesystemClient(c.Filesystem.Directory)
+	case BlobStoreS3:
+		return blobstore.NewS3Client(c.S3.Bucket, c.S3.Region, c.S3.Endpoint, c.S3.Prefix)
+	default:
+		return nil, fmt.Errorf("unknown blob store type %v", c.Type)
+	}
+}
+
+// NewPayloadBlobStore builds the blob store described by the config, it returns nil if no blob store is configured
+func (c *BlobStore) NewPayloadBlobStore() (*persistence.PayloadBlobStore, error) {
+	client, err := c.NewClient()
+	if client == nil || err != nil {
+		return nil, err
+	}
+
+	threshold := c.Threshold
+	if threshold <= 0 {
+		threshold = defaultBlobStoreThreshold
+	}
+	return persistence.NewPayloadBlobStore(client, threshold), nil
+}
+
+// NewHistoryArchive builds the archive of the histories described by the config, it returns nil if archival is not
+// configured.  The codec of the workflow payloads, if any, is applied to the archived histories.
+func (c *Archival) NewHistoryArchive(codec persistence.PayloadCodec) (*persistence.HistoryArchive, error) {
+	client, err := c.BlobStore.NewClient()
+	if client == nil || err != nil {
+		return nil, err
+	}
+	return persistence.NewHistoryArchive(client, codec), nil
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/common/metrics"
+)
+
+type (
+	// limitedListener enforces the connection limits on the connections it accepts
+	limitedListener struct {
+		net.Listener
+		limits *TChannelConnections
+		scope  tally.Scope
+		// slots holds a token per open connection when the number of connections is limited
+		slots       chan struct{}
+		connections int64
+	}
+
+	// limitedConn is a connection accepted by a limitedListener
+	limitedConn struct {
+		net.Conn
+		listener  *limitedListener
+		closeOnce sync.Once
+	}
+)
+
+func newLimitedListener(listener net.Listener, limits *TChannelConnections, scope tally.Scope) *limitedListener {
+	l := &limitedListener{
+		Listener: listener,
+		limits:   limits,
+		scope:    scope,
+	}
+	if limits.MaxConnections > 0 {
+		l.slots = make(chan struct{}, limits.MaxConnections)
+	}
+	return l
+}
+
+// Accept waits for the next connection within the limit, the connections beyond it are closed
+func (l *limitedListener) Accept() (net.Conn, error) {
+	for {
+		conn, err := l.Listener.Accept()
+		if err != nil {
+			return nil, err
+		}
+
+		if l.slots != nil {
+			select {
+			case l.slots <- struct{}{}:
+			default:
+				l.scope.Counter(metrics.ConnectionsRejectedCounter).Inc(1)
+				conn.Close()
+				continue
+			}
+		}
+
+		l.scope.Gauge(metrics.InboundConnectionsGauge).Update(float64(atomic.AddInt64(&l.connections, 1)))
+		return &limitedConn{Conn: conn, listener: l}, nil
+	}
+}
+
+func (l *limitedListener) release() {
+	if l.slots != nil {
+		<-l.slots
+	}
+	l.scope.Gauge(metrics.InboundConnectionsGauge).Update(float64(atomic.AddInt64(&l.connections, -1)))
+}
+
+// Read fails once the client has sent nothing for the read timeout
+func (c *limitedConn) Read(b []byte) (int, error) {
+	limits := c.listener.limits
+	if limits.ReadTimeout > 0 {
+		if err := c.Conn.SetReadDeadline(time.Now().Add(limits.ReadTimeout)); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := c.Conn.Read(b)
+	if isTimeout(err) {
+		c.listener.scope.Counter(metrics.ConnectionReadTimeoutCounter).Inc(1)
+	}
+	return n, err
+}
+
+// Write fails once the client has not consumed the data for the write timeout, the writes slower than the threshold
+// are reported
+func (c *limitedConn) Write(b []byte) (int, error) {
+	limits := c.listener.limits
+	start := time.Now()
+	if limits.WriteTimeout > 0 {
+		if err := c.Conn.SetWriteDeadline(start.Add(limits.WriteTimeout)); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := c.Conn.Write(b)
+	if isTimeout(err) {
+		c.listener.scope.Counter(metrics.ConnectionWriteTimeoutCounter).Inc(1)
+	} else if limits.SlowWriteThreshold > 0 && time.Since(start) > limits.SlowWriteThreshold {
+		c.listener.scope.Counter(metrics.ConnectionSlowWriteCounter).Inc(1)
+	}
+	return n, err
+}
+
+// Close closes the connection and frees its slot
+func (c *limitedConn) Close() error {
+	err := c.Conn.Close()
+	c.closeOnce.Do(c.listener.release)
+	return err
+}
+
+func isTimeout(err error) bool {
+	netErr, ok := err.(net.Error)
+	return ok && netErr.Timeout()
+}
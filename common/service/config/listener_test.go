@@ -0,0 +1,129 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/common/metrics"
+)
+
+type (
+	listenerSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+		scope    tally.TestScope
+		listener *limitedListener
+	}
+)
+
+func TestListenerSuite(t *testing.T) {
+	s := new(listenerSuite)
+	suite.Run(t, s)
+}
+
+func (s *listenerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.scope = tally.NewTestScope("", nil)
+}
+
+func (s *listenerSuite) TearDownTest() {
+	if s.listener != nil {
+		s.listener.Close()
+	}
+}
+
+func (s *listenerSuite) listen(limits *TChannelConnections) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	s.Nil(err)
+	s.listener = newLimitedListener(listener, limits, s.scope)
+}
+
+func (s *listenerSuite) counter(name string) int64 {
+	counter, ok := s.scope.Snapshot().Counters()[name+"+"]
+	if !ok {
+		return 0
+	}
+	return counter.Value()
+}
+
+func (s *listenerSuite) TestMaxConnections() {
+	s.listen(&TChannelConnections{MaxConnections: 1})
+	accepted := make(chan net.Conn, 2)
+	go func() {
+		for {
+			conn, err := s.listener.Accept()
+			if err != nil {
+				return
+			}
+			accepted <- conn
+		}
+	}()
+
+	first, err := net.Dial("tcp", s.listener.Addr().String())
+	s.Nil(err)
+	defer first.Close()
+	conn := <-accepted
+
+	// the second connection is closed by the listener while the first one is open
+	second, err := net.Dial("tcp", s.listener.Addr().String())
+	s.Nil(err)
+	second.SetReadDeadline(time.Now().Add(5 * time.Second))
+	_, err = second.Read(make([]byte, 1))
+	s.NotNil(err)
+	second.Close()
+	s.Equal(int64(1), s.counter(metrics.ConnectionsRejectedCounter))
+
+	// closing the first connection frees its slot
+	s.Nil(conn.Close())
+	third, err := net.Dial("tcp", s.listener.Addr().String())
+	s.Nil(err)
+	defer third.Close()
+	select {
+	case conn = <-accepted:
+		conn.Close()
+	case <-time.After(5 * time.Second):
+		s.Fail("connection not accepted")
+	}
+}
+
+func (s *listenerSuite) TestReadTimeout() {
+	s.listen(&TChannelConnections{ReadTimeout: 50 * time.Millisecond})
+	client, err := net.Dial("tcp", s.listener.Addr().String())
+	s.Nil(err)
+	defer client.Close()
+
+	conn, err := s.listener.Accept()
+	s.Nil(err)
+	defer conn.Close()
+
+	_, err = conn.Read(make([]byte, 1))
+	s.True(isTimeout(err))
+	s.Equal(int64(1), s.counter(metrics.ConnectionReadTimeoutCounter))
+}
@@ -21,29 +21,45 @@
 package config
 
 import (
+	"crypto/tls"
 	"fmt"
+	"github.com/uber-go/tally"
 	"github.com/uber/tchannel-go"
 	"github.com/uber/tchannel-go/thrift"
+	"golang.org/x/net/context"
 	"log"
 	"net"
 	"strings"
+	"time"
 )
 
 // TChannelFactory is an implementation of
 // service.TChannelFactory interface
 type TChannelFactory struct {
 	config *TChannel
+	scope  tally.Scope
+	// serverTLS and clientTLS encrypt the connections accepted and dialed by the channel, nil if TLS is disabled
+	serverTLS *tls.Config
+	clientTLS *tls.Config
 }
 
 // NewFactory builds a new tchannelFactory
-// conforming to the underlying configuration
-func (cfg *TChannel) NewFactory() *TChannelFactory {
-	return newTChannelFactory(cfg)
+// conforming to the underlying configuration,
+// the connection metrics are reported to scope,
+// the connections are encrypted according to tlsCfg
+func (cfg *TChannel) NewFactory(scope tally.Scope, tlsCfg *TLS) (*TChannelFactory, error) {
+	return newTChannelFactory(cfg, scope, tlsCfg)
 }
 
-func newTChannelFactory(cfg *TChannel) *TChannelFactory {
-	factory := &TChannelFactory{config: cfg}
-	return factory
+func newTChannelFactory(cfg *TChannel, scope tally.Scope, tlsCfg *TLS) (*TChannelFactory, error) {
+	factory := &TChannelFactory{config: cfg, scope: scope}
+	if tlsCfg != nil && tlsCfg.Enabled {
+		var err error
+		if factory.serverTLS, factory.clientTLS, err = tlsCfg.newTLSConfigs(); err != nil {
+			return nil, err
+		}
+	}
+	return factory, nil
 }
 
 // CreateChannel implements the TChannelFactory interface
@@ -59,6 +75,10 @@ func (factory *TChannelFactory) CreateChannel(sName string,
 		opts.Logger = tchannel.NewLevelLogger(tchannel.SimpleLogger, level)
 	}
 
+	if factory.clientTLS != nil {
+		opts.Dialer = factory.dialTLS
+	}
+
 	ch, err := tchannel.NewChannel(sName, opts)
 	if err != nil {
 		log.Fatalf("tchannel.NewChannel() failed, err=%v", err)
@@ -76,10 +96,46 @@ func (factory *TChannelFactory) CreateChannel(sName string,
 
 func (factory *TChannelFactory) listenAndServe(ch *tchannel.Channel) {
 	ip := factory.getListenIP()
-	err := ch.ListenAndServe(fmt.Sprintf("%v:%v", ip, factory.config.Port))
+	listener, err := net.Listen("tcp", fmt.Sprintf("%v:%v", ip, factory.config.Port))
 	if err != nil {
-		log.Fatalf("tchannel.ListenAndServer failed, err=%v", err)
+		log.Fatalf("net.Listen failed, err=%v", err)
+	}
+
+	limits := &factory.config.Connections
+	if limits.MaxConnections > 0 || limits.ReadTimeout > 0 || limits.WriteTimeout > 0 ||
+		limits.SlowWriteThreshold > 0 {
+		listener = newLimitedListener(listener, limits, factory.scope)
+	}
+
+	if factory.serverTLS != nil {
+		listener = tls.NewListener(listener, factory.serverTLS)
+	}
+
+	err = ch.Serve(listener)
+	if err != nil {
+		log.Fatalf("tchannel.Serve failed, err=%v", err)
+	}
+}
+
+// dialTLS dials the connections of the channel to the other hosts, and completes their TLS handshake before the
+// deadline of ctx
+func (factory *TChannelFactory) dialTLS(ctx context.Context, network, hostPort string) (net.Conn, error) {
+	dialer := &net.Dialer{}
+	conn, err := dialer.DialContext(ctx, network, hostPort)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConn := tls.Client(conn, factory.clientTLS)
+	if deadline, ok := ctx.Deadline(); ok {
+		tlsConn.SetDeadline(deadline)
+	}
+	if err := tlsConn.Handshake(); err != nil {
+		conn.Close()
+		return nil, err
 	}
+	tlsConn.SetDeadline(time.Time{})
+	return tlsConn, nil
 }
 
 func (factory *TChannelFactory) getListenIP() net.IP {
@@ -23,6 +23,7 @@ package config
 import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
 	"github.com/uber/tchannel-go"
 	"testing"
 )
@@ -61,7 +62,8 @@ func (s *TChannelSuite) TestFactory() {
 		LogLevel:        "info",
 		BindOnLocalHost: true,
 	}
-	f := cfg.NewFactory()
+	f, err := cfg.NewFactory(tally.NoopScope, nil)
+	s.NoError(err)
 	s.NotNil(f)
 	ch, _ := f.CreateChannel("test", nil)
 	s.NotNil(ch)
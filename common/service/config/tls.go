@@ -0,0 +1,119 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+)
+
+var errNoPeerCertificate = errors.New("no certificate presented by the peer")
+
+// validate returns an error if the TLS configuration is enabled but incomplete
+func (cfg *TLS) validate() error {
+	if !cfg.Enabled {
+		return nil
+	}
+	if cfg.CertFile == "" || cfg.KeyFile == "" {
+		return fmt.Errorf("TLS certificate or key file missing")
+	}
+	if cfg.RequireClientCert && cfg.CAFile == "" {
+		return fmt.Errorf("TLS CA file missing to verify the client certificates")
+	}
+	return nil
+}
+
+// newTLSConfigs returns the TLS configurations of the connections accepted and dialed by a channel
+func (cfg *TLS) newTLSConfigs() (server *tls.Config, client *tls.Config, err error) {
+	if err := cfg.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error loading the TLS certificate: %v", err)
+	}
+
+	var roots *x509.CertPool
+	if cfg.CAFile != "" {
+		pem, err := ioutil.ReadFile(cfg.CAFile)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error reading the TLS CA file: %v", err)
+		}
+		roots = x509.NewCertPool()
+		if !roots.AppendCertsFromPEM(pem) {
+			return nil, nil, fmt.Errorf("no certificate found in the TLS CA file %v", cfg.CAFile)
+		}
+	}
+
+	server = &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if cfg.RequireClientCert {
+		server.ClientAuth = tls.RequireAndVerifyClientCert
+		server.ClientCAs = roots
+	}
+
+	client = &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      roots,
+		ServerName:   cfg.ServerName,
+		MinVersion:   tls.VersionTLS12,
+	}
+	if cfg.ServerName == "" {
+		// The hosts are dialed by IP, which their certificates do not list
+		client.InsecureSkipVerify = true
+		client.VerifyPeerCertificate = newChainVerifier(roots)
+	}
+	return server, client, nil
+}
+
+// newChainVerifier returns a function verifying the certificate chain presented by a peer with roots, regardless of
+// the name of the peer
+func newChainVerifier(roots *x509.CertPool) func([][]byte, [][]*x509.Certificate) error {
+	return func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		if len(rawCerts) == 0 {
+			return errNoPeerCertificate
+		}
+		certs := make([]*x509.Certificate, len(rawCerts))
+		for i, raw := range rawCerts {
+			cert, err := x509.ParseCertificate(raw)
+			if err != nil {
+				return err
+			}
+			certs[i] = cert
+		}
+
+		opts := x509.VerifyOptions{
+			Roots:         roots,
+			Intermediates: x509.NewCertPool(),
+		}
+		for _, cert := range certs[1:] {
+			opts.Intermediates.AddCert(cert)
+		}
+		_, err := certs[0].Verify(opts)
+		return err
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type TLSSuite struct {
+	*require.Assertions
+	suite.Suite
+	dir string
+}
+
+func TestTLSSuite(t *testing.T) {
+	suite.Run(t, new(TLSSuite))
+}
+
+func (s *TLSSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	dir, err := ioutil.TempDir("", "cadence-tls-test")
+	s.NoError(err)
+	s.dir = dir
+}
+
+func (s *TLSSuite) TearDownTest() {
+	os.RemoveAll(s.dir)
+}
+
+func (s *TLSSuite) TestValidate() {
+	s.NoError((&TLS{}).validate())
+	s.Error((&TLS{Enabled: true}).validate())
+	s.NoError((&TLS{Enabled: true, CertFile: "cert.pem", KeyFile: "key.pem"}).validate())
+	s.Error((&TLS{Enabled: true, CertFile: "cert.pem", KeyFile: "key.pem", RequireClientCert: true}).validate())
+}
+
+func (s *TLSSuite) TestMutualAuthentication() {
+	cfg := s.newTLS("ca", "host")
+	server, _, err := cfg.newTLSConfigs()
+	s.NoError(err)
+	_, client, err := cfg.newTLSConfigs()
+	s.NoError(err)
+	s.NoError(s.handshake(server, client))
+}
+
+func (s *TLSSuite) TestUntrustedClient() {
+	server, _, err := s.newTLS("ca", "host").newTLSConfigs()
+	s.NoError(err)
+	_, client, err := s.newTLS("other-ca", "other-host").newTLSConfigs()
+	s.NoError(err)
+	s.Error(s.handshake(server, client))
+}
+
+func (s *TLSSuite) TestServerName() {
+	cfg := s.newTLS("ca", "host")
+	server, _, err := cfg.newTLSConfigs()
+	s.NoError(err)
+
+	cfg.ServerName = "host"
+	_, client, err := cfg.newTLSConfigs()
+	s.NoError(err)
+	s.NoError(s.handshake(server, client))
+
+	cfg.ServerName = "other-host"
+	_, client, err = cfg.newTLSConfigs()
+	s.NoError(err)
+	s.Error(s.handshake(server, client))
+}
+
+// handshake returns the error of the TLS handshake of a client with a server, nil if both succeeded
+func (s *TLSSuite) handshake(server, client *tls.Config) error {
+	serverConn, clientConn := net.Pipe()
+	defer serverConn.Close()
+	defer clientConn.Close()
+
+	serverErr := make(chan error, 1)
+	go func() {
+		conn := tls.Server(serverConn, server)
+		err := conn.Handshake()
+		if err != nil {
+			// Unblocks the client waiting for the server
+			serverConn.Close()
+		}
+		serverErr <- err
+	}()
+
+	conn := tls.Client(clientConn, client)
+	err := conn.Handshake()
+	if err != nil {
+		clientConn.Close()
+	}
+	if serverErr := <-serverErr; serverErr != nil {
+		return serverErr
+	}
+	return err
+}
+
+// newTLS writes a certificate for host issued by a new authority, and returns the TLS configuration using them
+func (s *TLSSuite) newTLS(caName, host string) *TLS {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.NoError(err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: caName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	s.NoError(err)
+	caCert, err := x509.ParseCertificate(caDER)
+	s.NoError(err)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.NoError(err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: host},
+		DNSNames:     []string{host},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, caCert, &key.PublicKey, caKey)
+	s.NoError(err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	s.NoError(err)
+
+	cfg := &TLS{
+		Enabled:           true,
+		CertFile:          filepath.Join(s.dir, host+"-cert.pem"),
+		KeyFile:           filepath.Join(s.dir, host+"-key.pem"),
+		CAFile:            filepath.Join(s.dir, caName+".pem"),
+		RequireClientCert: true,
+	}
+	s.writePEM(cfg.CAFile, "CERTIFICATE", caDER)
+	s.writePEM(cfg.CertFile, "CERTIFICATE", der)
+	s.writePEM(cfg.KeyFile, "EC PRIVATE KEY", keyDER)
+	return cfg
+}
+
+func (s *TLSSuite) writePEM(path, blockType string, der []byte) {
+	s.NoError(ioutil.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0600))
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service/config"
 
 	log "github.com/Sirupsen/logrus"
@@ -44,14 +45,31 @@ var cadenceServices = []string{common.FrontendServiceName, common.HistoryService
 
 type (
 	// BootstrapParams holds the set of parameters
-	// needed to bootstrap a service
+	// needed to bootstrap a service, see the bootstrap package for their default implementations
 	BootstrapParams struct {
-		Name            string
-		Logger          bark.Logger
-		MetricScope     tally.Scope
-		RingpopFactory  RingpopFactory
-		TChannelFactory TChannelFactory
-		CassandraConfig config.Cassandra
+		Name              string
+		Logger            bark.Logger
+		MetricScope       tally.Scope
+		RingpopFactory    RingpopFactory
+		TChannelFactory   TChannelFactory
+		CassandraConfig   config.Cassandra
+		PersistenceConfig config.Persistence
+		ServiceConfig     config.Service
+		SearchAttributes  map[string]string
+
+		// MetricsClient is optional, it defaults to a client emitting the metrics of the service to MetricScope
+		MetricsClient metrics.Client
+		// MembershipFactory and ClientFactoryProvider are optional, they default to the ringpop membership and to
+		// the tchannel clients
+		MembershipFactory     MembershipFactory
+		ClientFactoryProvider ClientFactoryProvider
+		// ReloadConfig is optional, it reloads the config of the service while it runs.  The settings which can change
+		// while the service serves requests are only reloaded if it is set.
+		ReloadConfig func() (*config.Config, *config.Service, error)
+		// PersistenceFactory creates the persistence managers of the services, ShadowPersistenceFactory creates the
+		// managers of the secondary datastore of the workflow executions, if any
+		PersistenceFactory       persistence.Factory
+		ShadowPersistenceFactory persistence.Factory
 	}
 
 	// TChannelFactory creates a TChannel and Thrift server
@@ -67,6 +85,26 @@ type (
 		CreateRingpop(ch *tchannel.Channel) (*ringpop.Ringpop, error)
 	}
 
+	// MembershipFactory creates the membership monitor of the hosts of the cadence services
+	MembershipFactory interface {
+		// CreateMembershipMonitor returns the monitor of the given services on top of the ring the host joined,
+		// the monitor is started by the service
+		CreateMembershipMonitor(services []string, rp *ringpop.Ringpop, logger bark.Logger) (membership.Monitor,
+			error)
+	}
+
+	// ClientFactoryProvider provides the factory of the clients of the cadence services
+	ClientFactoryProvider interface {
+		// CreateClientFactory returns the factory of the clients sending requests through the channel to the hosts
+		// of the monitor
+		CreateClientFactory(ch *tchannel.Channel, monitor membership.Monitor, metricsClient metrics.Client,
+			numberOfHistoryShards int) (client.Factory, error)
+	}
+
+	ringpopMembershipFactory struct{}
+
+	tchannelClientFactoryProvider struct{}
+
 	// Service contains the objects specific to this service
 	serviceImpl struct {
 		sName                  string
@@ -77,8 +115,10 @@ type (
 		ch                     *tchannel.Channel
 		rp                     *ringpop.Ringpop
 		rpFactory              RingpopFactory
+		membershipFactory      MembershipFactory
 		membershipMonitor      membership.Monitor
 		tchannelFactory        TChannelFactory
+		clientFactoryProvider  ClientFactoryProvider
 		clientFactory          client.Factory
 		numberOfHistoryShards  int
 		logger                 bark.Logger
@@ -96,11 +136,22 @@ func New(params *BootstrapParams) Service {
 		logger:                params.Logger.WithField("Service", params.Name),
 		tchannelFactory:       params.TChannelFactory,
 		rpFactory:             params.RingpopFactory,
+		membershipFactory:     params.MembershipFactory,
+		clientFactoryProvider: params.ClientFactoryProvider,
 		metricsScope:          params.MetricScope,
+		metricsClient:         params.MetricsClient,
 		numberOfHistoryShards: params.CassandraConfig.NumHistoryShards,
 	}
 	sVice.runtimeMetricsReporter = metrics.NewRuntimeMetricsReporter(params.MetricScope, time.Minute, sVice.logger)
-	sVice.metricsClient = metrics.NewClient(params.MetricScope, getMetricsServiceIdx(params.Name, params.Logger))
+	if sVice.metricsClient == nil {
+		sVice.metricsClient = NewMetricsClient(params.MetricScope, params.Name, params.Logger)
+	}
+	if sVice.membershipFactory == nil {
+		sVice.membershipFactory = NewRingpopMembershipFactory()
+	}
+	if sVice.clientFactoryProvider == nil {
+		sVice.clientFactoryProvider = NewTChannelClientFactoryProvider()
+	}
 
 	// Get the host name and set it on the service.  This is used for emitting metric with a tag for hostname
 	if hostName, e := os.Hostname(); e != nil {
@@ -151,7 +202,10 @@ func (h *serviceImpl) Start(thriftServices []thrift.TChanServer) {
 		h.logger.WithFields(bark.Fields{logging.TagErr: err}).Fatal("Ringpop setting role label failed")
 	}
 
-	h.membershipMonitor = membership.NewRingpopMonitor(cadenceServices, h.rp, h.logger)
+	h.membershipMonitor, err = h.membershipFactory.CreateMembershipMonitor(cadenceServices, h.rp, h.logger)
+	if err != nil {
+		h.logger.WithFields(bark.Fields{logging.TagErr: err}).Fatal("creating membership monitor failed")
+	}
 	err = h.membershipMonitor.Start()
 	if err != nil {
 		h.logger.WithFields(bark.Fields{logging.TagErr: err}).Fatal("starting membership monitor failed")
@@ -163,8 +217,11 @@ func (h *serviceImpl) Start(thriftServices []thrift.TChanServer) {
 	}
 	h.hostInfo = hostInfo
 
-	h.clientFactory = client.NewTChannelClientFactory(h.ch, h.membershipMonitor, h.metricsClient,
+	h.clientFactory, err = h.clientFactoryProvider.CreateClientFactory(h.ch, h.membershipMonitor, h.metricsClient,
 		h.numberOfHistoryShards)
+	if err != nil {
+		h.logger.WithFields(bark.Fields{logging.TagErr: err}).Fatal("creating client factory failed")
+	}
 
 	// The service is now started up
 	h.logger.Info("service started")
@@ -211,6 +268,33 @@ func (h *serviceImpl) GetHostInfo() *membership.HostInfo {
 	return h.hostInfo
 }
 
+// NewMetricsClient returns the client emitting the metrics of the service with the given name to the scope
+func NewMetricsClient(scope tally.Scope, serviceName string, logger bark.Logger) metrics.Client {
+	return metrics.NewClient(scope, getMetricsServiceIdx(serviceName, logger))
+}
+
+// NewRingpopMembershipFactory returns the MembershipFactory of the ringpop membership monitors
+func NewRingpopMembershipFactory() MembershipFactory {
+	return ringpopMembershipFactory{}
+}
+
+// CreateMembershipMonitor implements MembershipFactory
+func (ringpopMembershipFactory) CreateMembershipMonitor(services []string, rp *ringpop.Ringpop,
+	logger bark.Logger) (membership.Monitor, error) {
+	return membership.NewRingpopMonitor(services, rp, logger), nil
+}
+
+// NewTChannelClientFactoryProvider returns the ClientFactoryProvider of the tchannel clients
+func NewTChannelClientFactoryProvider() ClientFactoryProvider {
+	return tchannelClientFactoryProvider{}
+}
+
+// CreateClientFactory implements ClientFactoryProvider
+func (tchannelClientFactoryProvider) CreateClientFactory(ch *tchannel.Channel, monitor membership.Monitor,
+	metricsClient metrics.Client, numberOfHistoryShards int) (client.Factory, error) {
+	return client.NewTChannelClientFactory(ch, monitor, metricsClient, numberOfHistoryShards), nil
+}
+
 func getMetricsServiceIdx(serviceName string, logger bark.Logger) metrics.ServiceIdx {
 	switch serviceName {
 	case common.FrontendServiceName:
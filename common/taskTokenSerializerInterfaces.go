@@ -25,6 +25,8 @@ type (
 	TaskTokenSerializer interface {
 		Serialize(token *TaskToken) ([]byte, error)
 		Deserialize(data []byte) (*TaskToken, error)
+		SerializeQueryTaskToken(token *QueryTaskToken) ([]byte, error)
+		DeserializeQueryTaskToken(data []byte) (*QueryTaskToken, error)
 	}
 
 	// TaskToken identifies a task
@@ -34,4 +36,11 @@ type (
 		RunID      string `json:"runId"`
 		ScheduleID int64  `json:"scheduleId"`
 	}
+
+	// QueryTaskToken identifies a query task
+	QueryTaskToken struct {
+		DomainID string `json:"domainId"`
+		TaskList string `json:"taskList"`
+		TaskID   string `json:"taskId"`
+	}
 )
@@ -0,0 +1,401 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilityquery
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+)
+
+// Operator is the operator of a comparison
+type Operator string
+
+// Comparison operators
+const (
+	OperatorEqual          Operator = "="
+	OperatorNotEqual       Operator = "!="
+	OperatorLess           Operator = "<"
+	OperatorLessOrEqual    Operator = "<="
+	OperatorGreater        Operator = ">"
+	OperatorGreaterOrEqual Operator = ">="
+)
+
+// Fields of the executions which can be queried
+const (
+	FieldWorkflowID    = "WorkflowID"
+	FieldRunID         = "RunID"
+	FieldWorkflowType  = "WorkflowType"
+	FieldStartTime     = "StartTime"
+	FieldCloseTime     = "CloseTime"
+	FieldCloseStatus   = "CloseStatus"
+	FieldHistoryLength = "HistoryLength"
+	FieldFirstRunID    = "FirstRunID"
+	FieldTraceID       = "TraceID"
+)
+
+type (
+	// Expr is a node of a parsed query, one of And, Or, Not and Comparison
+	Expr interface {
+		isExpr()
+	}
+
+	// And matches the executions matched by all of its expressions
+	And struct {
+		Exprs []Expr
+	}
+
+	// Or matches the executions matched by any of its expressions
+	Or struct {
+		Exprs []Expr
+	}
+
+	// Not matches the executions not matched by its expression
+	Not struct {
+		Expr Expr
+	}
+
+	// Comparison compares a field of the executions with a value.  The value is a string for the workflow ID, run ID
+	// and workflow type, and an int64 for the other fields: times are in nanoseconds since the epoch and close
+	// statuses are the values of workflow.WorkflowExecutionCloseStatus.
+	Comparison struct {
+		Field    string
+		Operator Operator
+		Value    interface{}
+	}
+
+	fieldType int
+
+	tokenKind int
+
+	token struct {
+		kind  tokenKind
+		text  string
+		value string
+		pos   int
+	}
+
+	parser struct {
+		tokens []token
+		next   int
+	}
+)
+
+const (
+	fieldTypeString fieldType = iota
+	fieldTypeInt
+	fieldTypeTime
+	fieldTypeCloseStatus
+)
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenString
+	tokenNumber
+	tokenOperator
+	tokenLeftParen
+	tokenRightParen
+)
+
+var fieldTypes = map[string]fieldType{
+	FieldWorkflowID:    fieldTypeString,
+	FieldRunID:         fieldTypeString,
+	FieldWorkflowType:  fieldTypeString,
+	FieldStartTime:     fieldTypeTime,
+	FieldCloseTime:     fieldTypeTime,
+	FieldCloseStatus:   fieldTypeCloseStatus,
+	FieldHistoryLength: fieldTypeInt,
+	FieldFirstRunID:    fieldTypeString,
+	FieldTraceID:       fieldTypeString,
+}
+
+func (*And) isExpr()        {}
+func (*Or) isExpr()         {}
+func (*Not) isExpr()        {}
+func (*Comparison) isExpr() {}
+
+// Parse parses a query such as "WorkflowType = 'X' AND CloseTime > '2018-01-01T00:00:00Z'".  Comparisons of a field
+// with a value are combined with AND, OR, NOT and parentheses, keywords are case insensitive.  Strings are quoted with
+// single or double quotes, a quote is escaped by doubling it.  Times are either RFC3339 strings or nanoseconds since
+// the epoch, and close statuses are either names, e.g. 'FAILED', or numbers.  An empty query matches every execution
+// and is parsed into a nil expression.
+func Parse(query string) (Expr, error) {
+	tokens, err := tokenize(query)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	if p.peek().kind == tokenEOF {
+		return nil, nil
+	}
+
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokenEOF {
+		return nil, fmt.Errorf("unexpected %q at position %v", t.text, t.pos)
+	}
+	return expr, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.next]
+}
+
+func (p *parser) pop() token {
+	t := p.tokens[p.next]
+	if t.kind != tokenEOF {
+		p.next++
+	}
+	return t
+}
+
+// popKeyword consumes the next token if it is the keyword
+func (p *parser) popKeyword(keyword string) bool {
+	if t := p.peek(); t.kind == tokenIdent && strings.EqualFold(t.text, keyword) {
+		p.next++
+		return true
+	}
+	return false
+}
+
+func (p *parser) parseOr() (Expr, error) {
+	expr, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	exprs := []Expr{expr}
+	for p.popKeyword("OR") {
+		if expr, err = p.parseAnd(); err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, expr)
+	}
+	if len(exprs) == 1 {
+		return exprs[0], nil
+	}
+	return &Or{Exprs: exprs}, nil
+}
+
+func (p *parser) parseAnd() (Expr, error) {
+	expr, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+
+	exprs := []Expr{expr}
+	for p.popKeyword("AND") {
+		if expr, err = p.parseNot(); err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, expr)
+	}
+	if len(exprs) == 1 {
+		return exprs[0], nil
+	}
+	return &And{Exprs: exprs}, nil
+}
+
+func (p *parser) parseNot() (Expr, error) {
+	if p.popKeyword("NOT") {
+		expr, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &Not{Expr: expr}, nil
+	}
+
+	if p.peek().kind == tokenLeftParen {
+		p.pop()
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if t := p.pop(); t.kind != tokenRightParen {
+			return nil, fmt.Errorf("expected ')' at position %v", t.pos)
+		}
+		return expr, nil
+	}
+
+	return p.parseComparison()
+}
+
+func (p *parser) parseComparison() (Expr, error) {
+	field := p.pop()
+	if field.kind != tokenIdent {
+		return nil, fmt.Errorf("expected a field name at position %v", field.pos)
+	}
+	fieldType, ok := fieldTypes[field.text]
+	if !ok {
+		return nil, fmt.Errorf("unknown field %q at position %v", field.text, field.pos)
+	}
+
+	op := p.pop()
+	if op.kind != tokenOperator {
+		return nil, fmt.Errorf("expected a comparison operator at position %v", op.pos)
+	}
+	operator := Operator(op.text)
+	if operator == "<>" {
+		operator = OperatorNotEqual
+	}
+
+	value := p.pop()
+	if value.kind != tokenString && value.kind != tokenNumber {
+		return nil, fmt.Errorf("expected a value at position %v", value.pos)
+	}
+
+	comparison := &Comparison{Field: field.text, Operator: operator}
+	var err error
+	switch fieldType {
+	case fieldTypeString:
+		if value.kind != tokenString {
+			return nil, fmt.Errorf("%v must be compared with a string at position %v", field.text, value.pos)
+		}
+		comparison.Value = value.value
+	case fieldTypeInt:
+		comparison.Value, err = parseInt(value)
+	case fieldTypeTime:
+		comparison.Value, err = parseTime(value)
+	case fieldTypeCloseStatus:
+		comparison.Value, err = parseCloseStatus(value)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if (fieldType == fieldTypeString || fieldType == fieldTypeCloseStatus) &&
+		operator != OperatorEqual && operator != OperatorNotEqual {
+		return nil, fmt.Errorf("%v only supports = and != at position %v", field.text, op.pos)
+	}
+	return comparison, nil
+}
+
+func parseInt(value token) (int64, error) {
+	if value.kind != tokenNumber {
+		return 0, fmt.Errorf("expected a number at position %v", value.pos)
+	}
+	n, err := strconv.ParseInt(value.value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid number %v at position %v", value.text, value.pos)
+	}
+	return n, nil
+}
+
+func parseTime(value token) (int64, error) {
+	if value.kind == tokenNumber {
+		return parseInt(value)
+	}
+	t, err := time.Parse(time.RFC3339Nano, value.value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %v at position %v, expected RFC3339", value.text, value.pos)
+	}
+	return t.UnixNano(), nil
+}
+
+func parseCloseStatus(value token) (int64, error) {
+	if value.kind == tokenNumber {
+		return parseInt(value)
+	}
+	status, err := workflow.WorkflowExecutionCloseStatusFromString(strings.ToUpper(value.value))
+	if err != nil {
+		return 0, fmt.Errorf("invalid close status %v at position %v", value.text, value.pos)
+	}
+	return int64(status), nil
+}
+
+// tokenize splits the query into tokens, the last one being tokenEOF
+func tokenize(query string) ([]token, error) {
+	var tokens []token
+	runes := []rune(query)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		start := i
+		switch {
+		case unicode.IsSpace(r):
+			i++
+			continue
+		case r == '(':
+			i++
+			tokens = append(tokens, token{kind: tokenLeftParen, text: "(", pos: start})
+		case r == ')':
+			i++
+			tokens = append(tokens, token{kind: tokenRightParen, text: ")", pos: start})
+		case r == '\'' || r == '"':
+			var value []rune
+			closed := false
+			for i++; i < len(runes); i++ {
+				if runes[i] == r {
+					if i+1 < len(runes) && runes[i+1] == r {
+						// a doubled quote is an escaped quote
+						value = append(value, r)
+						i++
+						continue
+					}
+					closed = true
+					i++
+					break
+				}
+				value = append(value, runes[i])
+			}
+			if !closed {
+				return nil, fmt.Errorf("unterminated string at position %v", start)
+			}
+			tokens = append(tokens, token{kind: tokenString, text: string(runes[start:i]), value: string(value),
+				pos: start})
+		case r == '-' || unicode.IsDigit(r):
+			for i++; i < len(runes) && unicode.IsDigit(runes[i]); i++ {
+			}
+			text := string(runes[start:i])
+			tokens = append(tokens, token{kind: tokenNumber, text: text, value: text, pos: start})
+		case r == '_' || unicode.IsLetter(r):
+			for i++; i < len(runes) && isIdentRune(runes[i]); i++ {
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: string(runes[start:i]), pos: start})
+		case strings.ContainsRune("=!<>", r):
+			i++
+			if i < len(runes) && (runes[i] == '=' || (r == '<' && runes[i] == '>')) {
+				i++
+			}
+			text := string(runes[start:i])
+			switch text {
+			case "=", "!=", "<>", "<", "<=", ">", ">=":
+			default:
+				return nil, fmt.Errorf("invalid operator %q at position %v", text, start)
+			}
+			tokens = append(tokens, token{kind: tokenOperator, text: text, pos: start})
+		default:
+			return nil, fmt.Errorf("unexpected %q at position %v", r, start)
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, text: "end of query", pos: len(runes)}), nil
+}
+
+func isIdentRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
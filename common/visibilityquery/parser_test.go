@@ -0,0 +1,116 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilityquery
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+)
+
+type (
+	parserSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+	}
+)
+
+func TestParserSuite(t *testing.T) {
+	s := new(parserSuite)
+	suite.Run(t, s)
+}
+
+func (s *parserSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *parserSuite) TestEmptyQuery() {
+	expr, err := Parse("  ")
+	s.NoError(err)
+	s.Nil(expr)
+}
+
+func (s *parserSuite) TestComparison() {
+	expr, err := Parse(`WorkflowType = 'it''s'`)
+	s.NoError(err)
+	s.Equal(&Comparison{Field: FieldWorkflowType, Operator: OperatorEqual, Value: "it's"}, expr)
+
+	expr, err = Parse(`HistoryLength<>10`)
+	s.NoError(err)
+	s.Equal(&Comparison{Field: FieldHistoryLength, Operator: OperatorNotEqual, Value: int64(10)}, expr)
+
+	expr, err = Parse(`FirstRunID = "rid"`)
+	s.NoError(err)
+	s.Equal(&Comparison{Field: FieldFirstRunID, Operator: OperatorEqual, Value: "rid"}, expr)
+
+	expr, err = Parse(`TraceID = "trace"`)
+	s.NoError(err)
+	s.Equal(&Comparison{Field: FieldTraceID, Operator: OperatorEqual, Value: "trace"}, expr)
+
+	expr, err = Parse(`CloseStatus = 'failed'`)
+	s.NoError(err)
+	s.Equal(int64(workflow.WorkflowExecutionCloseStatus_FAILED), expr.(*Comparison).Value)
+
+	expr, err = Parse(`CloseTime >= "2018-01-01T00:00:00Z"`)
+	s.NoError(err)
+	s.Equal(time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC).UnixNano(), expr.(*Comparison).Value)
+	s.Equal(OperatorGreaterOrEqual, expr.(*Comparison).Operator)
+}
+
+func (s *parserSuite) TestPrecedence() {
+	expr, err := Parse(`WorkflowID = 'a' OR NOT WorkflowID = 'b' and (StartTime < 10 OR StartTime > 20)`)
+	s.NoError(err)
+	s.Equal(&Or{Exprs: []Expr{
+		&Comparison{Field: FieldWorkflowID, Operator: OperatorEqual, Value: "a"},
+		&And{Exprs: []Expr{
+			&Not{Expr: &Comparison{Field: FieldWorkflowID, Operator: OperatorEqual, Value: "b"}},
+			&Or{Exprs: []Expr{
+				&Comparison{Field: FieldStartTime, Operator: OperatorLess, Value: int64(10)},
+				&Comparison{Field: FieldStartTime, Operator: OperatorGreater, Value: int64(20)},
+			}},
+		}},
+	}}, expr)
+}
+
+func (s *parserSuite) TestInvalidQueries() {
+	for _, query := range []string{
+		`Unknown = 'a'`,
+		`WorkflowID = 10`,
+		`WorkflowID > 'a'`,
+		`HistoryLength = 'a'`,
+		`CloseTime > 'yesterday'`,
+		`CloseStatus = 'UNKNOWN'`,
+		`WorkflowID == 'a'`,
+		`WorkflowID = 'a`,
+		`(WorkflowID = 'a'`,
+		`WorkflowID = 'a' AND`,
+		`WorkflowID = 'a' WorkflowID = 'b'`,
+	} {
+		_, err := Parse(query)
+		s.Error(err, query)
+	}
+}
@@ -21,12 +21,14 @@
 # THE SOFTWARE.
 
 setup_schema() {
-    SCHEMA_FILE=$CADENCE_HOME/schema/cadence/schema.cql
+    SCHEMA_DIR=$CADENCE_HOME/schema/cadence/versioned
     $CADENCE_HOME/cadence-cassandra-tool --ep $CASSANDRA_SEEDS create -k $KEYSPACE --rf $RF
-    $CADENCE_HOME/cadence-cassandra-tool --ep $CASSANDRA_SEEDS -k $KEYSPACE setup-schema -d -f $SCHEMA_FILE
-    VISIBILITY_SCHEMA_FILE=$CADENCE_HOME/schema/visibility/schema.cql
+    $CADENCE_HOME/cadence-cassandra-tool --ep $CASSANDRA_SEEDS -k $KEYSPACE setup-schema -v 0.0
+    $CADENCE_HOME/cadence-cassandra-tool --ep $CASSANDRA_SEEDS -k $KEYSPACE update-schema -d $SCHEMA_DIR
+    VISIBILITY_SCHEMA_DIR=$CADENCE_HOME/schema/visibility/versioned
     $CADENCE_HOME/cadence-cassandra-tool --ep $CASSANDRA_SEEDS create -k $VISIBILITY_KEYSPACE --rf $RF
-    $CADENCE_HOME/cadence-cassandra-tool --ep $CASSANDRA_SEEDS -k $VISIBILITY_KEYSPACE setup-schema -d -f $VISIBILITY_SCHEMA_FILE
+    $CADENCE_HOME/cadence-cassandra-tool --ep $CASSANDRA_SEEDS -k $VISIBILITY_KEYSPACE setup-schema -v 0.0
+    $CADENCE_HOME/cadence-cassandra-tool --ep $CASSANDRA_SEEDS -k $VISIBILITY_KEYSPACE update-schema -d $VISIBILITY_SCHEMA_DIR
 }
 
 wait_for_cassandra() {
@@ -64,6 +66,10 @@ init_env() {
         export CASSANDRA_CONSISTENCY="One"
     fi
 
+    if [ -z "$CASSANDRA_SERIAL_CONSISTENCY" ]; then
+        export CASSANDRA_SERIAL_CONSISTENCY="Local_Serial"
+    fi
+
     if [ -z "$RINGPOP_SEEDS" ]; then
         export RINGPOP_SEEDS=$HOST_IP:7933
     fi
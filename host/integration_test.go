@@ -528,12 +528,13 @@ retry:
 		context, decisions := p.decisionHandler(response.GetWorkflowExecution(), response.GetWorkflowType(),
 			response.GetPreviousStartedEventId(), response.GetStartedEventId(), response.GetHistory())
 
-		return p.engine.RespondDecisionTaskCompleted(&workflow.RespondDecisionTaskCompletedRequest{
+		_, err := p.engine.RespondDecisionTaskCompleted(&workflow.RespondDecisionTaskCompletedRequest{
 			TaskToken:        response.GetTaskToken(),
 			Identity:         common.StringPtr(p.identity),
 			ExecutionContext: context,
 			Decisions:        decisions,
 		})
+		return err
 	}
 
 	return matching.ErrNoTasks
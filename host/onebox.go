@@ -181,7 +181,7 @@ func (c *cadenceImpl) startHistory(logger bark.Logger, shardMgr persistence.Shar
 		var thriftServices []thrift.TChanServer
 		var handler *history.Handler
 		handler, thriftServices = history.NewHandler(service, shardMgr, metadataMgr, visibilityMgr, historyMgr, executionMgrFactory,
-			c.numberOfHistoryShards)
+			c.numberOfHistoryShards, nil)
 		handler.Start(thriftServices)
 		c.historyHandlers = append(c.historyHandlers, handler)
 	}
@@ -55,7 +55,8 @@ service WorkflowService {
     )
 
   /**
-  * UpdateDomain is used to update the information and configuration for a registered domain.
+  * UpdateDomain is used to update the information and configuration for a registered domain.  The response describes
+  * the changes, which are only validated and described, without being applied, if validateOnly is set.
   **/
   shared.UpdateDomainResponse UpdateDomain(1: shared.UpdateDomainRequest updateRequest)
       throws (
@@ -80,7 +81,8 @@ service WorkflowService {
   * StartWorkflowExecution starts a new long running workflow instance.  It will create the instance with
   * 'WorkflowExecutionStarted' event in history and also schedule the first DecisionTask for the worker to make the
   * first decision for this instance.  It will return 'WorkflowExecutionAlreadyStartedError', if an instance already
-  * exists with same workflowId.
+  * exists with same workflowId.  When requestEagerExecution is set the first DecisionTask is started right away for
+  * the caller and returned in the response, instead of being dispatched to the pollers of the task list.
   **/
   shared.StartWorkflowExecutionResponse StartWorkflowExecution(1: shared.StartWorkflowExecutionRequest startRequest)
     throws (
@@ -120,7 +122,7 @@ service WorkflowService {
   * event in the history for that session.  Use the 'taskToken' provided as response of PollForDecisionTask API call
   * for completing the DecisionTask.
   **/
-  void RespondDecisionTaskCompleted(1: shared.RespondDecisionTaskCompletedRequest completeRequest)
+  shared.RespondDecisionTaskCompletedResponse RespondDecisionTaskCompleted(1: shared.RespondDecisionTaskCompletedRequest completeRequest)
     throws (
       1: shared.BadRequestError badRequestError,
       2: shared.InternalServiceError internalServiceError,
@@ -214,6 +216,8 @@ service WorkflowService {
   /**
   * SignalWorkflowExecution is used to send a signal event to running workflow execution.  This results in
   * WorkflowExecutionSignaled event recorded in the history and a decision task being created for the execution.
+  * Signals are recorded in the order they are accepted, so the eventId of WorkflowExecutionSignaled events is
+  * strictly increasing in delivery order.
   **/
   void SignalWorkflowExecution(1: shared.SignalWorkflowExecutionRequest signalRequest)
     throws (
@@ -222,6 +226,19 @@ service WorkflowService {
       3: shared.EntityNotExistsError entityNotExistError,
     )
 
+  /**
+  * SignalWithStartWorkflowExecution is used to ensure sending a signal to a workflow.  If the workflow is running, this
+  * results in WorkflowExecutionSignaled event recorded in the history and a decision task being created for the
+  * execution.  If the workflow is not running or not found, this results in WorkflowExecutionStarted and
+  * WorkflowExecutionSignaled events recorded in the history of a new run, and a decision task being created for it.
+  **/
+  shared.StartWorkflowExecutionResponse SignalWithStartWorkflowExecution(1: shared.SignalWithStartWorkflowExecutionRequest signalWithStartRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.WorkflowExecutionAlreadyStartedError sessionAlreadyExistError,
+    )
+
   /**
   * TerminateWorkflowExecution terminates an existing workflow execution by recording WorkflowExecutionTerminated event
   * in the history and immediately terminating the execution instance.
@@ -233,6 +250,44 @@ service WorkflowService {
       3: shared.EntityNotExistsError entityNotExistError,
     )
 
+  /**
+  * ResetWorkflowExecution resets a workflow execution to the end of a decision task of one of its runs.  A new run is
+  * created with the history of the run up to the started event of the decision task, which is then recorded as failed
+  * and followed by a new decision task.  The signals received by the run after that point are recorded again in the new
+  * run.  The run is terminated if it is still running.
+  **/
+  shared.ResetWorkflowExecutionResponse ResetWorkflowExecution(1: shared.ResetWorkflowExecutionRequest resetRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+    )
+
+  /**
+  * QueryWorkflow returns the result of a query of a workflow execution.  The query is dispatched to a worker polling
+  * the decision task list of the execution, which replays its history and answers with 'RespondQueryTaskCompleted'.
+  * It fails with 'QueryFailedError' if the worker fails to answer the query.
+  **/
+  shared.QueryWorkflowResponse QueryWorkflow(1: shared.QueryWorkflowRequest queryRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.QueryFailedError queryFailedError,
+    )
+
+  /**
+  * RespondQueryTaskCompleted is called by application worker to complete a query task handed as a result of
+  * 'PollForDecisionTask' API call, a decision task which carries a query.  It delivers the result of the query, or
+  * the reason it failed, to the caller of 'QueryWorkflow'.
+  **/
+  void RespondQueryTaskCompleted(1: shared.RespondQueryTaskCompletedRequest completeRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+    )
+
   /**
   * ListOpenWorkflowExecutions is a visibility API to list the open executions in a specific domain.
   **/
@@ -252,4 +307,115 @@ service WorkflowService {
       2: shared.InternalServiceError internalServiceError,
       3: shared.EntityNotExistsError entityNotExistError,
     )
+
+  /**
+  * ScanWorkflowExecutions is a visibility API to scan all the executions in a specific domain, open executions
+  * first.  Unlike the list APIs the results are not filtered or sorted by time, which makes it cheaper for bulk
+  * exports and batch jobs that sweep the whole domain.
+  **/
+  shared.ScanWorkflowExecutionsResponse ScanWorkflowExecutions(1: shared.ScanWorkflowExecutionsRequest listRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+    )
+  /**
+  * GetDomainReplicationMessages returns the changes made to domains (registration, update and deprecation) after the
+  * given notification version, in the order they were made.  External systems can poll it with the last notification
+  * version they have seen to keep a copy of the domains in sync.
+  **/
+  shared.GetDomainReplicationMessagesResponse GetDomainReplicationMessages(1: shared.GetDomainReplicationMessagesRequest getRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+    )
+
+  /**
+  * GetClusterInfo returns the capabilities of the cluster, so that clients can adapt their behavior to the features
+  * supported by the server instead of comparing server versions.
+  **/
+  shared.GetClusterInfoResponse GetClusterInfo(1: shared.GetClusterInfoRequest getRequest)
+    throws (
+      1: shared.InternalServiceError internalServiceError,
+    )
+
+  /**
+  * GetWorkflowResult waits until the run of a workflow execution closes and returns its close event, which holds the
+  * result or the failure of the run.  The call returns without a close event and with a continuation token before the
+  * deadline of the request expires, the token is passed with the next call to continue waiting.
+  **/
+  shared.GetWorkflowResultResponse GetWorkflowResult(1: shared.GetWorkflowResultRequest getRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+    )
+
+  /**
+  * CountOpenWorkflowExecutions is a visibility API to count the open executions in a specific domain which match the
+  * filters of the request, without listing them.
+  **/
+  shared.CountOpenWorkflowExecutionsResponse CountOpenWorkflowExecutions(1: shared.CountOpenWorkflowExecutionsRequest listRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+    )
+
+  /**
+  * CountClosedWorkflowExecutions is a visibility API to count the closed executions in a specific domain which match
+  * the filters of the request, without listing them.
+  **/
+  shared.CountClosedWorkflowExecutionsResponse CountClosedWorkflowExecutions(1: shared.CountClosedWorkflowExecutionsRequest listRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+    )
+
+  /**
+  * ListWorkflowExecutionsWithQuery is a visibility API to list the open and closed executions in a specific domain
+  * which match a SQL-like query, e.g. "WorkflowType = 'X' AND CloseTime > '2018-01-01T00:00:00Z'".  It is only
+  * supported by the Elasticsearch visibility store.
+  **/
+  shared.ListWorkflowExecutionsWithQueryResponse ListWorkflowExecutionsWithQuery(1: shared.ListWorkflowExecutionsWithQueryRequest listRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+    )
+
+  /**
+  * StartBatchOperation starts signaling, canceling or terminating all the open executions of a domain which match a
+  * visibility query, at a limited rate.  It returns the ID of the batch to follow its progress with
+  * DescribeBatchOperation.  It is only supported by the Elasticsearch visibility store.
+  **/
+  shared.StartBatchOperationResponse StartBatchOperation(1: shared.StartBatchOperationRequest startRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+    )
+
+  /**
+  * DescribeBatchOperation returns the state and the progress of a batch operation.
+  * The progress of a batch running on another frontend host is the progress that host last recorded.
+  **/
+  shared.DescribeBatchOperationResponse DescribeBatchOperation(1: shared.DescribeBatchOperationRequest describeRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+    )
+
+  /**
+  * StopBatchOperation stops a running batch operation, the executions already processed are left as they are.
+  **/
+  void StopBatchOperation(1: shared.StopBatchOperationRequest stopRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+    )
 }
\ No newline at end of file
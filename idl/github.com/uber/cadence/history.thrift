@@ -51,6 +51,9 @@ struct GetWorkflowExecutionNextEventIDRequest {
 struct GetWorkflowExecutionNextEventIDResponse {
   10: optional i64 (js.type = "Long") eventId
   20: optional string runId
+  30: optional shared.TaskList tasklist
+  40: optional shared.WorkflowType workflowType
+  50: optional i64 (js.type = "Long") previousStartedEventId
 }
 
 struct RespondDecisionTaskCompletedRequest {
@@ -112,11 +115,21 @@ struct SignalWorkflowExecutionRequest {
   20: optional shared.SignalWorkflowExecutionRequest signalRequest
 }
 
+struct SignalWithStartWorkflowExecutionRequest {
+  10: optional string domainUUID
+  20: optional shared.SignalWithStartWorkflowExecutionRequest signalWithStartRequest
+}
+
 struct TerminateWorkflowExecutionRequest {
   10: optional string domainUUID
   20: optional shared.TerminateWorkflowExecutionRequest terminateRequest
 }
 
+struct ResetWorkflowExecutionRequest {
+  10: optional string domainUUID
+  20: optional shared.ResetWorkflowExecutionRequest resetRequest
+}
+
 struct RequestCancelWorkflowExecutionRequest {
   10: optional string domainUUID
   20: optional shared.RequestCancelWorkflowExecutionRequest cancelRequest
@@ -148,6 +161,56 @@ struct RecordChildExecutionCompletedRequest {
 * HistoryService provides API to start a new long running workflow instance, as well as query and update the history
 * of workflow instances already created.
 **/
+struct DescribeShardRequest {
+  10: optional i32 shardId
+}
+
+struct DescribeShardResponse {
+  10: optional i32 shardId
+  20: optional string owner
+  30: optional i64 rangeId
+  40: optional i64 transferAckLevel
+  50: optional i64 transferMaxReadLevel
+  60: optional i64 maxTaskId
+  70: optional i64 timerAckLevel
+}
+
+struct InvalidateMutableStateRequest {
+  10: optional i32 shardId
+  20: optional string domainUUID
+  30: optional shared.WorkflowExecution execution
+}
+
+struct SetTaskProcessingPausedRequest {
+  10: optional i32 shardId
+  20: optional string domainUUID
+  30: optional bool transferQueue
+  40: optional bool timerQueue
+  50: optional bool paused
+}
+
+struct ListConcreteExecutionsRequest {
+  10: optional i32 shardId
+  20: optional i32 pageSize
+  30: optional binary nextPageToken
+}
+
+struct ConcreteExecution {
+  10: optional string domainUUID
+  20: optional shared.WorkflowExecution execution
+  30: optional string workflowTypeName
+  40: optional i32 state
+  50: optional i32 closeStatus
+  60: optional i64 nextEventId
+  70: optional i64 startTimestamp
+  80: optional i64 lastUpdatedTimestamp
+}
+
+struct ListConcreteExecutionsResponse {
+  10: optional list<ConcreteExecution> executions
+  20: optional binary nextPageToken
+}
+
 service HistoryService {
   /**
   * StartWorkflowExecution starts a new long running workflow instance.  It will create the instance with
@@ -211,7 +274,7 @@ service HistoryService {
   * event in the history for that session.  Use the 'taskToken' provided as response of PollForDecisionTask API call
   * for completing the DecisionTask.
   **/
-  void RespondDecisionTaskCompleted(1: RespondDecisionTaskCompletedRequest completeRequest)
+  shared.RespondDecisionTaskCompletedResponse RespondDecisionTaskCompleted(1: RespondDecisionTaskCompletedRequest completeRequest)
     throws (
       1: shared.BadRequestError badRequestError,
       2: shared.InternalServiceError internalServiceError,
@@ -282,6 +345,8 @@ service HistoryService {
   /**
   * SignalWorkflowExecution is used to send a signal event to running workflow execution.  This results in
   * WorkflowExecutionSignaled event recorded in the history and a decision task being created for the execution.
+  * Signals are recorded in the order they are accepted, so the eventId of WorkflowExecutionSignaled events is
+  * strictly increasing in delivery order.
   **/
   void SignalWorkflowExecution(1: SignalWorkflowExecutionRequest signalRequest)
     throws (
@@ -291,6 +356,20 @@ service HistoryService {
       4: ShardOwnershipLostError shardOwnershipLostError,
     )
 
+  /**
+  * SignalWithStartWorkflowExecution is used to ensure sending a signal to a workflow.  If the workflow is running, this
+  * results in WorkflowExecutionSignaled event recorded in the history and a decision task being created for the
+  * execution.  If the workflow is not running or not found, this results in WorkflowExecutionStarted and
+  * WorkflowExecutionSignaled events recorded in the history of a new run, and a decision task being created for it.
+  **/
+  shared.StartWorkflowExecutionResponse SignalWithStartWorkflowExecution(1: SignalWithStartWorkflowExecutionRequest signalWithStartRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.WorkflowExecutionAlreadyStartedError sessionAlreadyExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+    )
+
   /**
   * TerminateWorkflowExecution terminates an existing workflow execution by recording WorkflowExecutionTerminated event
   * in the history and immediately terminating the execution instance.
@@ -303,6 +382,20 @@ service HistoryService {
       4: ShardOwnershipLostError shardOwnershipLostError,
     )
 
+  /**
+  * ResetWorkflowExecution resets a workflow execution to the end of a decision task of one of its runs.  A new run is
+  * created with the history of the run up to the started event of the decision task, which is then recorded as failed
+  * and followed by a new decision task.  The signals received by the run after that point are recorded again in the new
+  * run.  The run is terminated if it is still running.
+  **/
+  shared.ResetWorkflowExecutionResponse ResetWorkflowExecution(1: ResetWorkflowExecutionRequest resetRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+    )
+
   /**
   * RequestCancelWorkflowExecution is called by application worker when it wants to request cancellation of a workflow instance.
   * It will result in a new 'WorkflowExecutionCancelRequested' event being written to the workflow history and a new DecisionTask
@@ -342,4 +435,53 @@ service HistoryService {
       3: shared.EntityNotExistsError entityNotExistError,
       4: ShardOwnershipLostError shardOwnershipLostError,
     )
+
+  /**
+  * DescribeShard returns the task ID watermarks and ack levels of a shard owned by this host.  It is used by
+  * consumers of the transfer and timer queues to verify that no task ID range has been skipped.
+  **/
+  DescribeShardResponse DescribeShard(1: DescribeShardRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+    )
+
+  /**
+  * InvalidateMutableState drops the cached mutable state of a workflow execution owned by this host, or unloads a
+  * whole shard when no execution is given, so that the state is reloaded from persistence on next use.
+  **/
+  void InvalidateMutableState(1: InvalidateMutableStateRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+    )
+
+  /**
+  * SetTaskProcessingPaused pauses or resumes the processing of the transfer and/or timer tasks of a shard, or of a
+  * domain on every shard owned by this host.  Paused tasks stay in the queues until processing is resumed.
+  **/
+  void SetTaskProcessingPaused(1: SetTaskProcessingPausedRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+    )
+
+  /**
+  * ListConcreteExecutions returns a page of the workflow executions stored by a shard owned by this host, including
+  * closed executions which have not been deleted yet.  It is used by scanners which check the integrity of the
+  * executions of a shard.
+  **/
+  ListConcreteExecutionsResponse ListConcreteExecutions(1: ListConcreteExecutionsRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+    )
 }
@@ -33,6 +33,7 @@ struct PollForDecisionTaskResponse {
   30: optional shared.WorkflowType workflowType
   40: optional i64 (js.type = "Long") previousStartedEventId
   50: optional i64 (js.type = "Long") startedEventId
+  60: optional shared.WorkflowQuery query
 }
 
 struct PollForActivityTaskRequest {
@@ -56,6 +57,34 @@ struct AddActivityTaskRequest {
   60: optional i32 scheduleToStartTimeoutSeconds
 }
 
+struct DrainTaskListRequest {
+  10: optional string domainUUID
+  20: optional shared.TaskList taskList
+  30: optional shared.TaskListType taskListType
+  // must be set to the name of the task list unless dryRun is set
+  40: optional string confirmation
+  50: optional bool dryRun
+}
+
+struct DrainTaskListResponse {
+  10: optional i64 (js.type = "Long") ackLevel
+  20: optional i64 (js.type = "Long") maxReadLevel
+  30: optional i64 (js.type = "Long") drainedTasks
+}
+
+struct QueryWorkflowRequest {
+  10: optional string domainUUID
+  20: optional shared.TaskList taskList
+  30: optional shared.QueryWorkflowRequest queryRequest
+}
+
+struct RespondQueryTaskCompletedRequest {
+  10: optional string domainUUID
+  20: optional shared.TaskList taskList
+  30: optional string taskID
+  40: optional shared.RespondQueryTaskCompletedRequest completedRequest
+}
+
 /**
 * MatchingService API is exposed to provide support for polling from long running applications.
 * Such applications are expected to have a worker which regularly polls for DecisionTask and ActivityTask.  For each
@@ -105,4 +134,38 @@ service MatchingService {
       2: shared.InternalServiceError internalServiceError,
       3: shared.ServiceBusyError serviceBusyError,
     )
-}
\ No newline at end of file
+
+  /**
+  * DrainTaskList is an admin operation which discards the backlog of a task list, e.g. after its domain is
+  * abandoned.  Tasks are completed in ranges up to the task list read level at the time of the call.  The request
+  * has to carry the task list name as confirmation, unless it is a dry run which only reports the backlog.
+  **/
+  DrainTaskListResponse DrainTaskList(1: DrainTaskListRequest drainRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+    )
+
+  /**
+  * QueryWorkflow is called by frontend to dispatch a query to a worker polling the decision task list of the
+  * workflow execution.  It blocks until the worker answers the query with RespondQueryTaskCompleted.
+  **/
+  shared.QueryWorkflowResponse QueryWorkflow(1: QueryWorkflowRequest queryRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.QueryFailedError queryFailedError,
+    )
+
+  /**
+  * RespondQueryTaskCompleted is called by frontend to deliver the answer of a worker to the pending QueryWorkflow
+  * call of the query task.
+  **/
+  void RespondQueryTaskCompleted(1: RespondQueryTaskCompletedRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+    )
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+
+namespace java com.uber.cadence.persistenceblobs
+
+// The structs below are not part of any API, they are the serialized entries of the maps of the mutable state of
+// workflow executions.  Times are in nanoseconds since the epoch.
+
+struct ActivityInfo {
+  10: optional i64 scheduleId
+  20: optional binary scheduledEvent
+  30: optional i64 startedId
+  40: optional binary startedEvent
+  50: optional string activityId
+  60: optional string requestId
+  70: optional binary details
+  80: optional i32 scheduleToStartTimeoutSeconds
+  90: optional i32 scheduleToCloseTimeoutSeconds
+  100: optional i32 startToCloseTimeoutSeconds
+  110: optional i32 heartbeatTimeoutSeconds
+  120: optional bool cancelRequested
+  130: optional i64 cancelRequestId
+  140: optional i64 lastHeartbeatUpdatedTimeNanos
+}
+
+struct TimerInfo {
+  10: optional string timerId
+  20: optional i64 startedId
+  30: optional i64 expiryTimeNanos
+  40: optional i64 taskId
+}
+
+struct ChildExecutionInfo {
+  10: optional i64 initiatedId
+  20: optional binary initiatedEvent
+  30: optional i64 startedId
+  40: optional binary startedEvent
+  50: optional string createRequestId
+}
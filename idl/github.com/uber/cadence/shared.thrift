@@ -46,6 +46,10 @@ exception ServiceBusyError {
   1: required string message
 }
 
+exception QueryFailedError {
+  1: required string message
+}
+
 enum DomainStatus {
   REGISTERED,
   DEPRECATED,
@@ -126,6 +130,7 @@ enum DecisionTaskFailedCause {
   BAD_CANCEL_WORKFLOW_EXECUTION_ATTRIBUTES,
   BAD_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_ATTRIBUTES,
   BAD_CONTINUE_AS_NEW_ATTRIBUTES,
+  RESET_WORKFLOW,
 }
 
 enum CancelExternalWorkflowExecutionFailedCause {
@@ -151,6 +156,41 @@ enum ChildPolicy {
   ABANDON,
 }
 
+enum DomainChangeType {
+  REGISTERED,
+  UPDATED,
+  DEPRECATED,
+}
+
+enum TaskListType {
+  /*
+   * Decision type of tasklist
+   */
+  Decision,
+  /*
+   * Activity type of tasklist
+   */
+  Activity,
+}
+
+enum QueryTaskCompletedType {
+  COMPLETED,
+  FAILED,
+}
+
+enum BatchOperationType {
+  SIGNAL,
+  CANCEL,
+  TERMINATE,
+}
+
+enum BatchOperationState {
+  RUNNING,
+  COMPLETED,
+  FAILED,
+  STOPPED,
+}
+
 struct WorkflowType {
   10: optional string name
 }
@@ -175,6 +215,8 @@ struct WorkflowExecutionInfo {
   40: optional i64 (js.type = "Long") closeTime
   50: optional WorkflowExecutionCloseStatus closeStatus
   60: optional i64 (js.type = "Long") historyLength
+  70: optional string firstExecutionRunId
+  80: optional string traceId
 }
 
 struct ScheduleActivityTaskDecisionAttributes {
@@ -269,6 +311,8 @@ struct WorkflowExecutionStartedEventAttributes {
   40: optional i32 executionStartToCloseTimeoutSeconds
   50: optional i32 taskStartToCloseTimeoutSeconds
   60: optional string identity
+  70: optional string firstExecutionRunId
+  80: optional string traceId
 }
 
 struct WorkflowExecutionCompletedEventAttributes {
@@ -610,6 +654,10 @@ struct DomainInfo {
 struct DomainConfiguration {
   10: optional i32 workflowExecutionRetentionPeriodInDays
   20: optional bool emitMetric
+  30: optional bool archivalEnabled
+  40: optional string defaultTaskList
+  50: optional map<string,string> taskListOverrides
+  60: optional bool terminationProtected
 }
 
 struct UpdateDomainInfo {
@@ -623,6 +671,10 @@ struct RegisterDomainRequest {
   30: optional string ownerEmail
   40: optional i32 workflowExecutionRetentionPeriodInDays
   50: optional bool emitMetric
+  60: optional bool archivalEnabled
+  70: optional string defaultTaskList
+  80: optional map<string,string> taskListOverrides
+  90: optional bool terminationProtected
 }
 
 struct DescribeDomainRequest {
@@ -638,17 +690,41 @@ struct UpdateDomainRequest {
  10: optional string name
  20: optional UpdateDomainInfo updatedInfo
  30: optional DomainConfiguration configuration
+ 40: optional bool validateOnly
 }
 
 struct UpdateDomainResponse {
   10: optional DomainInfo domainInfo
   20: optional DomainConfiguration configuration
+  30: optional list<string> changes
 }
 
 struct DeprecateDomainRequest {
  10: optional string name
 }
 
+struct DomainChangeEvent {
+  10: optional i64 (js.type = "Long") notificationVersion
+  20: optional DomainChangeType changeType
+  30: optional string domainId
+  40: optional DomainInfo domainInfo
+  50: optional DomainConfiguration configuration
+}
+
+struct GetDomainReplicationMessagesRequest {
+  10: optional i64 (js.type = "Long") lastNotificationVersion
+  20: optional i32 maximumPageSize
+}
+
+struct GetDomainReplicationMessagesResponse {
+  10: optional list<DomainChangeEvent> events
+  20: optional i64 (js.type = "Long") lastNotificationVersion
+}
+
+struct SearchAttributes {
+  10: optional map<string,binary> indexedFields
+}
+
 struct StartWorkflowExecutionRequest {
   10: optional string domain
   20: optional string workflowId
@@ -659,16 +735,26 @@ struct StartWorkflowExecutionRequest {
   70: optional i32 taskStartToCloseTimeoutSeconds
   80: optional string identity
   90: optional string requestId
+  100: optional SearchAttributes searchAttributes
+  110: optional string completionCallbackUrl
+  120: optional bool requestEagerExecution
+  130: optional string traceId
 }
 
-struct StartWorkflowExecutionResponse {
-  10: optional string runId
+struct TaskListMetadata {
+  10: optional double maxTasksPerSecond
 }
 
 struct PollForDecisionTaskRequest {
   10: optional string domain
   20: optional TaskList taskList
   30: optional string identity
+  40: optional TaskListMetadata taskListMetadata
+}
+
+struct WorkflowQuery {
+  10: optional string queryType
+  20: optional binary queryArgs
 }
 
 struct PollForDecisionTaskResponse {
@@ -679,6 +765,12 @@ struct PollForDecisionTaskResponse {
   50: optional i64 (js.type = "Long") startedEventId
   60: optional History history
   70: optional binary nextPageToken
+  80: optional WorkflowQuery query
+}
+
+struct StartWorkflowExecutionResponse {
+  10: optional string runId
+  20: optional PollForDecisionTaskResponse decisionTask
 }
 
 struct RespondDecisionTaskCompletedRequest {
@@ -686,12 +778,14 @@ struct RespondDecisionTaskCompletedRequest {
   20: optional list<Decision> decisions
   30: optional binary executionContext
   40: optional string identity
+  50: optional TaskList eagerActivityTaskList
 }
 
 struct PollForActivityTaskRequest {
   10: optional string domain
   20: optional TaskList taskList
   30: optional string identity
+  40: optional TaskListMetadata taskListMetadata
 }
 
 struct PollForActivityTaskResponse {
@@ -708,6 +802,10 @@ struct PollForActivityTaskResponse {
   110: optional i32 heartbeatTimeoutSeconds
 }
 
+struct RespondDecisionTaskCompletedResponse {
+  10: optional PollForActivityTaskResponse activityTask
+}
+
 struct RecordActivityTaskHeartbeatRequest {
   10: optional binary taskToken
   20: optional binary details
@@ -741,6 +839,8 @@ struct RequestCancelWorkflowExecutionRequest {
   10: optional string domain
   20: optional WorkflowExecution workflowExecution
   30: optional string identity
+  40: optional i32 terminateAfterSeconds
+  50: optional string requestId
 }
 
 struct GetWorkflowExecutionHistoryRequest {
@@ -755,12 +855,40 @@ struct GetWorkflowExecutionHistoryResponse {
   20: optional binary nextPageToken
 }
 
+struct GetWorkflowResultRequest {
+  10: optional string domain
+  20: optional WorkflowExecution execution
+  30: optional binary nextPageToken
+}
+
+struct GetWorkflowResultResponse {
+  10: optional HistoryEvent closeEvent
+  20: optional binary nextPageToken
+}
+
 struct SignalWorkflowExecutionRequest {
   10: optional string domain
   20: optional WorkflowExecution workflowExecution
   30: optional string signalName
   40: optional binary input
   50: optional string identity
+  60: optional string requestId
+}
+
+struct SignalWithStartWorkflowExecutionRequest {
+  10: optional string domain
+  20: optional string workflowId
+  30: optional WorkflowType workflowType
+  40: optional TaskList taskList
+  50: optional binary input
+  60: optional i32 executionStartToCloseTimeoutSeconds
+  70: optional i32 taskStartToCloseTimeoutSeconds
+  80: optional string identity
+  90: optional string requestId
+  100: optional SearchAttributes searchAttributes
+  110: optional string completionCallbackUrl
+  120: optional string signalName
+  130: optional binary signalInput
 }
 
 struct TerminateWorkflowExecutionRequest {
@@ -769,6 +897,37 @@ struct TerminateWorkflowExecutionRequest {
   30: optional string reason
   40: optional binary details
   50: optional string identity
+  60: optional bool adminOverride
+}
+
+struct ResetWorkflowExecutionRequest {
+  10: optional string domain
+  20: optional WorkflowExecution workflowExecution
+  30: optional string reason
+  40: optional i64 (js.type = "Long") decisionFinishEventId
+  50: optional string requestId
+  60: optional string identity
+}
+
+struct ResetWorkflowExecutionResponse {
+  10: optional string runId
+}
+
+struct QueryWorkflowRequest {
+  10: optional string domain
+  20: optional WorkflowExecution execution
+  30: optional WorkflowQuery query
+}
+
+struct QueryWorkflowResponse {
+  10: optional binary queryResult
+}
+
+struct RespondQueryTaskCompletedRequest {
+  10: optional binary taskToken
+  20: optional QueryTaskCompletedType completedType
+  30: optional binary queryResult
+  40: optional string errorMessage
 }
 
 struct ListOpenWorkflowExecutionsRequest {
@@ -799,3 +958,104 @@ struct ListClosedWorkflowExecutionsResponse {
   10: optional list<WorkflowExecutionInfo> executions
   20: optional binary nextPageToken
 }
+
+struct ScanWorkflowExecutionsRequest {
+  10: optional string domain
+  20: optional i32 maximumPageSize
+  30: optional binary nextPageToken
+}
+
+struct ScanWorkflowExecutionsResponse {
+  10: optional list<WorkflowExecutionInfo> executions
+  20: optional binary nextPageToken
+}
+
+struct CountOpenWorkflowExecutionsRequest {
+  10: optional string domain
+  20: optional StartTimeFilter StartTimeFilter
+  30: optional WorkflowExecutionFilter executionFilter
+  40: optional WorkflowTypeFilter typeFilter
+}
+
+struct CountOpenWorkflowExecutionsResponse {
+  10: optional i64 count
+}
+
+struct CountClosedWorkflowExecutionsRequest {
+  10: optional string domain
+  20: optional StartTimeFilter StartTimeFilter
+  30: optional WorkflowExecutionFilter executionFilter
+  40: optional WorkflowTypeFilter typeFilter
+  50: optional WorkflowExecutionCloseStatus statusFilter
+}
+
+struct CountClosedWorkflowExecutionsResponse {
+  10: optional i64 count
+}
+
+struct ClusterCapabilities {
+  10: optional bool supportsStickyQuery
+  20: optional bool supportsSignalWithStart
+  30: optional bool supportsRawHistory
+  40: optional i64 (js.type = "Long") maxBlobSizeBytes
+}
+
+struct GetClusterInfoRequest {
+}
+
+struct GetClusterInfoResponse {
+  10: optional ClusterCapabilities capabilities
+}
+
+struct ListWorkflowExecutionsWithQueryRequest {
+  10: optional string domain
+  20: optional i32 maximumPageSize
+  30: optional binary nextPageToken
+  40: optional string query
+}
+
+struct ListWorkflowExecutionsWithQueryResponse {
+  10: optional list<WorkflowExecutionInfo> executions
+  20: optional binary nextPageToken
+}
+
+struct StartBatchOperationRequest {
+  10: optional string domain
+  20: optional string query
+  30: optional BatchOperationType operationType
+  40: optional string reason
+  50: optional string signalName
+  60: optional binary signalInput
+  70: optional i32 requestsPerSecond
+  80: optional string identity
+}
+
+struct StartBatchOperationResponse {
+  10: optional string batchId
+}
+
+struct DescribeBatchOperationRequest {
+  10: optional string domain
+  20: optional string batchId
+}
+
+struct DescribeBatchOperationResponse {
+  10: optional BatchOperationType operationType
+  20: optional string query
+  30: optional string reason
+  40: optional string identity
+  50: optional BatchOperationState state
+  60: optional i64 (js.type = "Long") startTime
+  70: optional i64 (js.type = "Long") closeTime
+  80: optional i64 (js.type = "Long") succeededCount
+  90: optional i64 (js.type = "Long") skippedCount
+  100: optional i64 (js.type = "Long") failedCount
+  110: optional string closeReason
+}
+
+struct StopBatchOperationRequest {
+  10: optional string domain
+  20: optional string batchId
+  30: optional string reason
+  40: optional string identity
+}
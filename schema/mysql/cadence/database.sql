@@ -0,0 +1 @@
+CREATE DATABASE IF NOT EXISTS cadence CHARACTER SET utf8mb4;
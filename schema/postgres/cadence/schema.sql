@@ -0,0 +1,306 @@
+-- Times are stored as unix nanoseconds, zero represents an unset time.
+-- Conditional writes are implemented within transactions which lock the shard (or task list) row and compare range_id.
+-- Ids are kept in VARCHAR columns, CHAR columns would return values shorter than the column padded with spaces.
+
+CREATE TABLE shards (
+  shard_id            INT NOT NULL,
+  owner               VARCHAR(255) NOT NULL, -- Host identifier processing the shard
+  -- Range identifier used for generating ack ids for tasks within shard.
+  -- Also used for optimistic concurrency and all writes to a shard are conditional on this value.
+  range_id            BIGINT NOT NULL,
+  -- This field keeps track of number of times owner for a shard changes before updating range_id or ack_levels
+  stolen_since_renew  INT NOT NULL,
+  updated_at          BIGINT NOT NULL,
+  transfer_ack_level  BIGINT NOT NULL,
+  timer_ack_level     BIGINT NOT NULL,
+  PRIMARY KEY (shard_id)
+);
+
+--- Workflow execution and mutable state ---
+CREATE TABLE executions (
+  shard_id               INT NOT NULL,
+  domain_id              VARCHAR(36) NOT NULL,
+  workflow_id            VARCHAR(255) NOT NULL,
+  run_id                 VARCHAR(36) NOT NULL,
+  parent_domain_id       VARCHAR(36) NOT NULL,  -- Domain ID of parent workflow which started the workflow execution
+  parent_workflow_id     VARCHAR(255) NOT NULL, -- ID of parent workflow which started the workflow execution
+  parent_run_id          VARCHAR(36) NOT NULL,  -- RunID of parent workflow which started the workflow execution
+  initiated_id           BIGINT NOT NULL,       -- Initiated event ID of parent workflow which started this execution
+  completion_event       BYTEA,                 -- Completion event used to communicate result to parent workflow execution
+  task_list              VARCHAR(255) NOT NULL,
+  workflow_type_name     VARCHAR(255) NOT NULL,
+  decision_task_timeout  INT NOT NULL,
+  execution_context      BYTEA,
+  state                  INT NOT NULL, -- enum WorkflowState {Created, Running, Completed}
+  close_status           INT NOT NULL, -- enum WorkflowCloseStatus {None, Completed, Failed, Canceled, Terminated, ContinuedAsNew, TimedOut}
+  next_event_id          BIGINT NOT NULL,
+  last_processed_event   BIGINT NOT NULL,
+  start_time             BIGINT NOT NULL,
+  last_updated_time      BIGINT NOT NULL,
+  create_request_id      VARCHAR(36) NOT NULL,
+  decision_schedule_id   BIGINT NOT NULL,
+  decision_started_id    BIGINT NOT NULL,
+  decision_request_id    VARCHAR(255) NOT NULL, -- Identifier used by matching engine for retrying history service calls for recording task is started
+  decision_timeout       INT NOT NULL,
+  completion_callback_url VARCHAR(2048) NOT NULL, -- URL notified once the workflow closes
+  cancel_requested       BOOLEAN NOT NULL,
+  cancel_request_id      VARCHAR(255) NOT NULL, -- Identifier of the cancel request, to dedupe its retries
+  first_execution_run_id VARCHAR(36) NOT NULL,  -- RunID of the first execution of the chain of continued as new executions
+  trace_id               VARCHAR(255) NOT NULL, -- Trace ID of the chain of continued as new executions
+  search_attributes      BYTEA,                 -- JSON map of the search attributes indexed in visibility
+  PRIMARY KEY (shard_id, domain_id, workflow_id, run_id)
+);
+
+-- Run ID of the current (open) execution for every workflow ID
+CREATE TABLE current_executions (
+  shard_id           INT NOT NULL,
+  domain_id          VARCHAR(36) NOT NULL,
+  workflow_id        VARCHAR(255) NOT NULL,
+  run_id             VARCHAR(36) NOT NULL,
+  create_request_id  VARCHAR(36) NOT NULL,
+  PRIMARY KEY (shard_id, domain_id, workflow_id)
+);
+
+CREATE TABLE activity_info_maps (
+  shard_id                   INT NOT NULL,
+  domain_id                  VARCHAR(36) NOT NULL,
+  workflow_id                VARCHAR(255) NOT NULL,
+  run_id                     VARCHAR(36) NOT NULL,
+  schedule_id                BIGINT NOT NULL,
+  scheduled_event            BYTEA,
+  started_id                 BIGINT NOT NULL,
+  started_event              BYTEA,
+  activity_id                VARCHAR(255) NOT NULL,
+  request_id                 VARCHAR(255) NOT NULL,
+  details                    BYTEA,
+  schedule_to_start_timeout  INT NOT NULL,
+  schedule_to_close_timeout  INT NOT NULL,
+  start_to_close_timeout     INT NOT NULL,
+  heart_beat_timeout         INT NOT NULL,
+  cancel_requested           BOOLEAN NOT NULL,
+  cancel_request_id          BIGINT NOT NULL,
+  last_hb_updated_time       BIGINT NOT NULL,
+  PRIMARY KEY (shard_id, domain_id, workflow_id, run_id, schedule_id)
+);
+
+CREATE TABLE timer_info_maps (
+  shard_id     INT NOT NULL,
+  domain_id    VARCHAR(36) NOT NULL,
+  workflow_id  VARCHAR(255) NOT NULL,
+  run_id       VARCHAR(36) NOT NULL,
+  timer_id     VARCHAR(255) NOT NULL,
+  started_id   BIGINT NOT NULL,
+  expiry_time  BIGINT NOT NULL,
+  task_id      BIGINT NOT NULL,
+  PRIMARY KEY (shard_id, domain_id, workflow_id, run_id, timer_id)
+);
+
+CREATE TABLE child_execution_info_maps (
+  shard_id           INT NOT NULL,
+  domain_id          VARCHAR(36) NOT NULL,
+  workflow_id        VARCHAR(255) NOT NULL,
+  run_id             VARCHAR(36) NOT NULL,
+  initiated_id       BIGINT NOT NULL,
+  initiated_event    BYTEA,
+  started_id         BIGINT NOT NULL,
+  started_event      BYTEA,
+  create_request_id  VARCHAR(36) NOT NULL,
+  PRIMARY KEY (shard_id, domain_id, workflow_id, run_id, initiated_id)
+);
+
+-- Identifiers of the signal requests applied to every execution, to dedupe their retries
+CREATE TABLE signals_requested_sets (
+  shard_id     INT NOT NULL,
+  domain_id    VARCHAR(36) NOT NULL,
+  workflow_id  VARCHAR(255) NOT NULL,
+  run_id       VARCHAR(36) NOT NULL,
+  signal_id    VARCHAR(255) NOT NULL,
+  recorded_time BIGINT NOT NULL, -- time the signal request was recorded, to expire its dedup
+  PRIMARY KEY (shard_id, domain_id, workflow_id, run_id, signal_id)
+);
+
+CREATE TABLE transfer_tasks (
+  shard_id            INT NOT NULL,
+  task_id             BIGINT NOT NULL,
+  domain_id           VARCHAR(36) NOT NULL,  -- The domain ID that this transfer task belongs to
+  workflow_id         VARCHAR(255) NOT NULL, -- The workflow ID that this transfer task belongs to
+  run_id              VARCHAR(36) NOT NULL,  -- The run ID that this transfer task belongs to
+  target_domain_id    VARCHAR(36) NOT NULL,  -- The external domain ID that this transfer task is doing work for.
+  target_workflow_id  VARCHAR(255) NOT NULL, -- The external workflow ID that this transfer task is doing work for.
+  target_run_id       VARCHAR(36) NOT NULL,  -- The external run ID that this transfer task is doing work for.
+  task_list           VARCHAR(255) NOT NULL,
+  type                INT NOT NULL, -- enum TaskType {ActivityTask, DecisionTask, DeleteExecution, CancelExecution, StartChildExecution, CompletionCallback}
+  schedule_id         BIGINT NOT NULL,
+  callback_url        VARCHAR(2048) NOT NULL, -- The URL notified by a CompletionCallback task
+  PRIMARY KEY (shard_id, task_id)
+);
+
+CREATE TABLE timer_tasks (
+  shard_id       INT NOT NULL,
+  visibility_ts  BIGINT NOT NULL,
+  task_id        BIGINT NOT NULL,
+  domain_id      VARCHAR(36) NOT NULL,
+  workflow_id    VARCHAR(255) NOT NULL,
+  run_id         VARCHAR(36) NOT NULL,
+  type           INT NOT NULL, -- enum TaskType {DecisionTimeout, ActivityTimeout, UserTimer, CancelTimeout}
+  timeout_type   INT NOT NULL, -- enum TimeoutType in IDL {START_TO_CLOSE, SCHEDULE_TO_START, SCHEDULE_TO_CLOSE, HEARTBEAT}
+  event_id       BIGINT NOT NULL, -- Corresponds to event ID in history that is responsible for this timer.
+  PRIMARY KEY (shard_id, visibility_ts, task_id)
+);
+
+-- Workflow execution history, one row per append transaction
+CREATE TABLE events (
+  domain_id       VARCHAR(36) NOT NULL,
+  workflow_id     VARCHAR(255) NOT NULL,
+  run_id          VARCHAR(36) NOT NULL,
+  first_event_id  BIGINT NOT NULL, -- We insert a new row for each transaction, keyed by the first event ID in the batch
+  range_id        BIGINT NOT NULL, -- Range ID of the shard which appended the batch
+  tx_id           BIGINT NOT NULL, -- Transaction ID of the shard which appended the batch
+  data            BYTEA,           -- Batch of workflow execution history events as a blob
+  data_encoding   VARCHAR(16) NOT NULL, -- Protocol used for history serialization
+  data_version    INT NOT NULL,    -- History blob version
+  PRIMARY KEY (domain_id, workflow_id, run_id, first_event_id)
+);
+
+-- Batches of events of the branches of history trees.  A branch only stores the batches appended after it was
+-- forked, the batches before are read from its ancestors.
+CREATE TABLE history_node (
+  tree_id         VARCHAR(36) NOT NULL,
+  branch_id       VARCHAR(36) NOT NULL,
+  node_id         BIGINT NOT NULL, -- Event ID of the first event in the batch
+  txn_id          BIGINT NOT NULL, -- The batch of the latest transaction supersedes the earlier ones of the node
+  data            BYTEA,           -- Batch of workflow execution history events as a blob
+  data_encoding   VARCHAR(16) NOT NULL, -- Protocol used for history serialization
+  data_version    INT NOT NULL,    -- History blob version
+  PRIMARY KEY (tree_id, branch_id, node_id, txn_id)
+);
+
+-- Branches of history trees
+CREATE TABLE history_tree (
+  tree_id         VARCHAR(36) NOT NULL,
+  branch_id       VARCHAR(36) NOT NULL,
+  ancestors       BYTEA NOT NULL, -- JSON list of the ranges of nodes shared with the ancestors of the branch
+  PRIMARY KEY (tree_id, branch_id)
+);
+
+--- Task lists ---
+CREATE TABLE task_lists (
+  domain_id  VARCHAR(36) NOT NULL,
+  name       VARCHAR(255) NOT NULL,
+  task_type  INT NOT NULL, -- enum TaskListType {ActivityTask, DecisionTask}
+  range_id   BIGINT NOT NULL,
+  ack_level  BIGINT NOT NULL,
+  pollers    BYTEA, -- JSON map of the identities of the workers which polled the task list to their last poll time
+  PRIMARY KEY (domain_id, name, task_type)
+);
+
+CREATE TABLE tasks (
+  domain_id       VARCHAR(36) NOT NULL,
+  task_list_name  VARCHAR(255) NOT NULL,
+  task_list_type  INT NOT NULL, -- enum TaskListType {ActivityTask, DecisionTask}
+  task_id         BIGINT NOT NULL,
+  workflow_id     VARCHAR(255) NOT NULL,
+  run_id          VARCHAR(36) NOT NULL,
+  schedule_id     BIGINT NOT NULL,
+  expiry_ts       BIGINT NOT NULL, -- Tasks past their schedule to start timeout are not returned, zero means no expiry
+  PRIMARY KEY (domain_id, task_list_name, task_list_type, task_id)
+);
+
+--- Domains ---
+CREATE TABLE domains (
+  id           VARCHAR(36) NOT NULL,
+  name         VARCHAR(255) NOT NULL,
+  status       INT NOT NULL, -- enum DomainStatus {Registered, Deprecated, Deleted}
+  description  TEXT,
+  owner_email  VARCHAR(255) NOT NULL,
+  retention    INT NOT NULL,
+  emit_metric  BOOLEAN NOT NULL,
+  archival_enabled BOOLEAN NOT NULL,
+  default_task_list VARCHAR(255),
+  task_list_overrides BYTEA, -- JSON encoded task list by workflow type name
+  termination_protected BOOLEAN NOT NULL, -- terminating the workflows of the domain requires an admin override
+  failover_version BIGINT NOT NULL, -- incremented by every failover of the domain
+  notification_version BIGINT NOT NULL, -- notification version of the last change of the domain
+  PRIMARY KEY (id),
+  UNIQUE (name)
+);
+
+--- Domain changes ---
+-- Holds the notification version of the last domain change, the row is locked by every domain write.
+CREATE TABLE domain_metadata (
+  id                   INT NOT NULL,
+  notification_version BIGINT NOT NULL,
+  PRIMARY KEY (id)
+);
+
+INSERT INTO domain_metadata (id, notification_version) VALUES (0, 0);
+
+CREATE TABLE domain_changes (
+  notification_version BIGINT NOT NULL,
+  change_type          INT NOT NULL, -- enum DomainChangeType {Registered, Updated, Deprecated}
+  domain_id            VARCHAR(36) NOT NULL,
+  name                 VARCHAR(255) NOT NULL,
+  status               INT NOT NULL,
+  description          TEXT,
+  owner_email          VARCHAR(255) NOT NULL,
+  retention            INT NOT NULL,
+  emit_metric          BOOLEAN NOT NULL,
+  archival_enabled     BOOLEAN NOT NULL,
+  default_task_list    VARCHAR(255),
+  task_list_overrides  BYTEA,
+  termination_protected BOOLEAN NOT NULL,
+  failover_version     BIGINT NOT NULL,
+  PRIMARY KEY (notification_version)
+);
+
+--- Batch operations ---
+-- The state and the progress of the batch operations started by the frontend hosts.
+CREATE TABLE batch_operations (
+  id              VARCHAR(36) NOT NULL,
+  domain_id       VARCHAR(36) NOT NULL,
+  domain_name     VARCHAR(255) NOT NULL,
+  operation_type  INT NOT NULL, -- enum BatchOperationType {Signal, Cancel, Terminate}
+  query           TEXT NOT NULL,
+  signal_name     VARCHAR(255),
+  signal_input    BYTEA,
+  reason          TEXT,
+  identity        VARCHAR(255),
+  rps             INT NOT NULL,
+  state           INT NOT NULL, -- enum BatchOperationState {Running, Completed, Failed, Stopped}
+  start_time      BIGINT NOT NULL,
+  close_time      BIGINT NOT NULL,
+  update_time     BIGINT NOT NULL, -- when the host running the batch last recorded its progress
+  succeeded       BIGINT NOT NULL,
+  skipped         BIGINT NOT NULL,
+  failed          BIGINT NOT NULL,
+  close_reason    TEXT,
+  stop_reason     TEXT, -- set by the host the batch is requested to stop through
+  PRIMARY KEY (id)
+);
+
+--- Visibility ---
+-- Open and closed executions are listed by start time, newest first.
+CREATE TABLE open_executions (
+  domain_id          VARCHAR(36) NOT NULL,
+  workflow_id        VARCHAR(255) NOT NULL,
+  run_id             VARCHAR(36) NOT NULL,
+  start_time         BIGINT NOT NULL,
+  workflow_type_name VARCHAR(255) NOT NULL,
+  PRIMARY KEY (domain_id, run_id)
+);
+
+CREATE INDEX open_executions_by_start_time ON open_executions (domain_id, start_time, run_id);
+
+CREATE TABLE closed_executions (
+  domain_id          VARCHAR(36) NOT NULL,
+  workflow_id        VARCHAR(255) NOT NULL,
+  run_id             VARCHAR(36) NOT NULL,
+  start_time         BIGINT NOT NULL,
+  close_time         BIGINT NOT NULL,
+  workflow_type_name VARCHAR(255) NOT NULL,
+  status             INT NOT NULL, -- enum WorkflowExecutionCloseStatus {Completed, Failed, Canceled, Terminated, ContinuedAsNew, TimedOut}
+  history_length     BIGINT NOT NULL,
+  PRIMARY KEY (domain_id, run_id)
+);
+
+CREATE INDEX closed_executions_by_start_time ON closed_executions (domain_id, start_time, run_id);
@@ -0,0 +1,502 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/uber-common/bark"
+	h "github.com/uber/cadence/.gen/go/history"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/tchannel-go/thrift"
+)
+
+const (
+	// batchDefaultRPS is the rate of the requests of the batches started without one
+	batchDefaultRPS = 50
+	// batchMaxRPS caps the rate of the requests of a batch
+	batchMaxRPS = 1000
+	// batchMaxRunning is the number of batches a host runs at most at the same time
+	batchMaxRunning = 10
+	// batchPageSize is the number of executions a batch reads from visibility at a time
+	batchPageSize = 1000
+	// batchRequestTimeout is the timeout of a request sent by a batch to the history service
+	batchRequestTimeout = 10 * time.Second
+	// batchCheckpointInterval is how often a running batch records its progress and reads whether it was requested
+	// to stop through another host
+	batchCheckpointInterval = 10 * time.Second
+	// batchOrphanTimeout is how long a running batch may go without recording its progress before it is described as
+	// failed, as the host running it is gone
+	batchOrphanTimeout = time.Minute
+)
+
+var (
+	errBatchIDNotSet         = &gen.BadRequestError{Message: "BatchId is not set on request."}
+	errBatchNotFound         = &gen.EntityNotExistsError{Message: "Batch operation not found."}
+	errTooManyBatches        = &gen.ServiceBusyError{Message: "Too many batch operations are running on this host."}
+	errBatchQueryUnsupported = &gen.BadRequestError{
+		Message: "Batch operations require the Elasticsearch visibility store to query the executions.",
+	}
+)
+
+type (
+	// batcher runs the batch operations started on the host.  A batch pages through the executions of its domain
+	// which match its visibility query and signals, cancels or terminates the open ones.  Its requests are sent at the
+	// rate of the batch and with the batch priority, so that they yield to the other requests of the history hosts.
+	// The state and the progress of a batch are recorded with the domains, so that any frontend host can describe
+	// the batch or request it to stop.  A batch is not resumed by another host when the host running it is gone, it
+	// is described as failed once its progress is not recorded for batchOrphanTimeout.
+	batcher struct {
+		sync.Mutex
+		metadataMgr   persistence.MetadataManager
+		visibilityMgr persistence.VisibilityManager
+		history       history.Client
+		timeSource    common.TimeSource
+		logger        bark.Logger
+		metricsClient metrics.Client
+
+		// batches are the batches running on the host
+		batches    map[string]*batchOperation
+		shutdownCh chan struct{}
+		shutdownWG sync.WaitGroup
+	}
+
+	// batchOperation is a batch running on the host, its info is guarded by the lock of the batcher
+	batchOperation struct {
+		info           *persistence.BatchOperationInfo
+		request        *gen.StartBatchOperationRequest
+		limiter        common.TokenBucket
+		stopCh         chan struct{}
+		lastCheckpoint time.Time
+	}
+)
+
+func newBatcher(metadataMgr persistence.MetadataManager, visibilityMgr persistence.VisibilityManager,
+	historyClient history.Client, timeSource common.TimeSource, logger bark.Logger,
+	metricsClient metrics.Client) *batcher {
+	return &batcher{
+		metadataMgr:   metadataMgr,
+		visibilityMgr: visibilityMgr,
+		history:       historyClient,
+		timeSource:    timeSource,
+		logger:        logger,
+		metricsClient: metricsClient,
+		batches:       make(map[string]*batchOperation),
+		shutdownCh:    make(chan struct{}),
+	}
+}
+
+// stop stops the running batches and waits for them to return
+func (b *batcher) stop() {
+	close(b.shutdownCh)
+	b.shutdownWG.Wait()
+}
+
+// start starts a batch on the executions of the domain and returns its ID.  The first page of executions is read
+// before the batch is started, so that a query the visibility store rejects fails the call.
+func (b *batcher) start(domainID string, request *gen.StartBatchOperationRequest, rps int) (string, error) {
+	page, err := b.visibilityMgr.ListWorkflowExecutionsWithQuery(&persistence.ListWorkflowExecutionsWithQueryRequest{
+		DomainUUID: domainID,
+		Query:      request.GetQuery(),
+		PageSize:   batchPageSize,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	now := b.timeSource.Now()
+	batch := &batchOperation{
+		info: &persistence.BatchOperationInfo{
+			ID:            uuid.New(),
+			DomainID:      domainID,
+			DomainName:    request.GetDomain(),
+			OperationType: int(request.GetOperationType()),
+			Query:         request.GetQuery(),
+			SignalName:    request.GetSignalName(),
+			SignalInput:   request.SignalInput,
+			Reason:        request.GetReason(),
+			Identity:      request.GetIdentity(),
+			RPS:           rps,
+			State:         int(gen.BatchOperationState_RUNNING),
+			StartTime:     now,
+			UpdateTime:    now,
+		},
+		request:        request,
+		limiter:        common.NewTokenBucket(rps, b.timeSource),
+		stopCh:         make(chan struct{}),
+		lastCheckpoint: now,
+	}
+
+	// The batch takes its slot before it is recorded, so that concurrent starts do not exceed the limit
+	b.Lock()
+	if len(b.batches) >= batchMaxRunning {
+		b.Unlock()
+		return "", errTooManyBatches
+	}
+	b.batches[batch.info.ID] = batch
+	b.Unlock()
+
+	if err := b.metadataMgr.CreateBatchOperation(&persistence.CreateBatchOperationRequest{
+		Info: copyBatchOperationInfo(batch.info),
+	}); err != nil {
+		b.Lock()
+		delete(b.batches, batch.info.ID)
+		b.Unlock()
+		return "", err
+	}
+	b.logger.Infof("Started batch operation %v: %v of the executions of domain %v matching %q at %v requests per "+
+		"second", batch.info.ID, request.GetOperationType(), domainID, request.GetQuery(), rps)
+
+	b.shutdownWG.Add(1)
+	go b.run(batch, page)
+	return batch.info.ID, nil
+}
+
+// describe returns the state and the progress of a batch of the domain.  The progress of a batch running on another
+// host is the progress it last recorded.
+func (b *batcher) describe(domainID, batchID string) (*gen.DescribeBatchOperationResponse, error) {
+	info, err := b.getBatchOperation(batchID)
+	if err != nil {
+		return nil, err
+	}
+	if info.DomainID != domainID {
+		return nil, errBatchNotFound
+	}
+
+	state := gen.BatchOperationState(info.State)
+	closeTime := info.CloseTime
+	closeReason := info.CloseReason
+	if state == gen.BatchOperationState_RUNNING && b.timeSource.Now().Sub(info.UpdateTime) > batchOrphanTimeout {
+		state = gen.BatchOperationState_FAILED
+		closeTime = info.UpdateTime
+		closeReason = "The frontend host running the batch operation is gone."
+	}
+
+	response := &gen.DescribeBatchOperationResponse{
+		OperationType:  gen.BatchOperationTypePtr(gen.BatchOperationType(info.OperationType)),
+		Query:          common.StringPtr(info.Query),
+		Reason:         common.StringPtr(info.Reason),
+		Identity:       common.StringPtr(info.Identity),
+		State:          gen.BatchOperationStatePtr(state),
+		StartTime:      common.Int64Ptr(info.StartTime.UnixNano()),
+		SucceededCount: common.Int64Ptr(info.Succeeded),
+		SkippedCount:   common.Int64Ptr(info.Skipped),
+		FailedCount:    common.Int64Ptr(info.Failed),
+	}
+	if state != gen.BatchOperationState_RUNNING {
+		response.CloseTime = common.Int64Ptr(closeTime.UnixNano())
+		response.CloseReason = common.StringPtr(closeReason)
+	}
+	return response, nil
+}
+
+// stopBatch stops a running batch of the domain.  A batch running on another host stops once that host reads the
+// request, stopping a closed batch does nothing.
+func (b *batcher) stopBatch(domainID, batchID, reason string) error {
+	info, err := b.getBatchOperation(batchID)
+	if err != nil {
+		return err
+	}
+	if info.DomainID != domainID {
+		return errBatchNotFound
+	}
+	if info.State != int(gen.BatchOperationState_RUNNING) || info.StopReason != "" {
+		return nil
+	}
+
+	stopReason := "Stopped."
+	if reason != "" {
+		stopReason = "Stopped: " + reason
+	}
+	if err := b.metadataMgr.StopBatchOperation(&persistence.StopBatchOperationRequest{
+		ID:     batchID,
+		Reason: stopReason,
+	}); err != nil {
+		return err
+	}
+
+	b.Lock()
+	batch, ok := b.batches[batchID]
+	b.Unlock()
+	if ok {
+		b.requestStop(batch, stopReason)
+	}
+	return nil
+}
+
+// getBatchOperation returns the info of a batch, the info of a batch running on the host is read from memory as it
+// is ahead of the recorded one
+func (b *batcher) getBatchOperation(batchID string) (*persistence.BatchOperationInfo, error) {
+	b.Lock()
+	batch, ok := b.batches[batchID]
+	if ok {
+		info := copyBatchOperationInfo(batch.info)
+		b.Unlock()
+		return info, nil
+	}
+	b.Unlock()
+
+	response, err := b.metadataMgr.GetBatchOperation(&persistence.GetBatchOperationRequest{ID: batchID})
+	if err != nil {
+		if _, ok := err.(*gen.EntityNotExistsError); ok {
+			return nil, errBatchNotFound
+		}
+		return nil, err
+	}
+	return response.Info, nil
+}
+
+// requestStop makes the batch stop before its next request, unless it was already requested to stop
+func (b *batcher) requestStop(batch *batchOperation, reason string) {
+	b.Lock()
+	defer b.Unlock()
+	if batch.info.StopReason == "" {
+		batch.info.StopReason = reason
+		close(batch.stopCh)
+	}
+}
+
+func (b *batcher) run(batch *batchOperation, page *persistence.ListWorkflowExecutionsResponse) {
+	defer b.shutdownWG.Done()
+
+	for {
+		for _, info := range page.Executions {
+			if !b.waitForToken(batch) {
+				return
+			}
+			skipped, err := b.apply(batch, info)
+			b.record(batch, info, skipped, err)
+			b.checkpoint(batch)
+		}
+		if len(page.NextPageToken) == 0 {
+			b.close(batch, gen.BatchOperationState_COMPLETED, "")
+			return
+		}
+
+		var err error
+		page, err = b.visibilityMgr.ListWorkflowExecutionsWithQuery(&persistence.ListWorkflowExecutionsWithQueryRequest{
+			DomainUUID:    batch.info.DomainID,
+			Query:         batch.request.GetQuery(),
+			PageSize:      batchPageSize,
+			NextPageToken: page.NextPageToken,
+		})
+		if err != nil {
+			b.close(batch, gen.BatchOperationState_FAILED, fmt.Sprintf("Failed to list the executions: %v", err))
+			return
+		}
+	}
+}
+
+// waitForToken waits until the batch may send its next request.  It closes the batch and returns false if the batch
+// is stopped or the host shuts down first.
+func (b *batcher) waitForToken(batch *batchOperation) bool {
+	for {
+		select {
+		case <-batch.stopCh:
+			b.Lock()
+			reason := batch.info.StopReason
+			b.Unlock()
+			b.close(batch, gen.BatchOperationState_STOPPED, reason)
+			return false
+		case <-b.shutdownCh:
+			b.close(batch, gen.BatchOperationState_STOPPED, "Stopped: the frontend host shut down.")
+			return false
+		default:
+		}
+
+		ok, wait := batch.limiter.TryConsume(1)
+		if ok {
+			return true
+		}
+		if wait <= 0 {
+			wait = time.Millisecond
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-batch.stopCh:
+		case <-b.shutdownCh:
+		case <-timer.C:
+		}
+		timer.Stop()
+	}
+}
+
+// checkpoint records the progress of the batch once per checkpoint interval, and stops the batch if it was requested
+// to stop through another host.  A failed checkpoint is retried at the next interval.
+func (b *batcher) checkpoint(batch *batchOperation) {
+	now := b.timeSource.Now()
+	if now.Sub(batch.lastCheckpoint) < batchCheckpointInterval {
+		return
+	}
+	batch.lastCheckpoint = now
+
+	b.Lock()
+	batch.info.UpdateTime = now
+	info := copyBatchOperationInfo(batch.info)
+	b.Unlock()
+
+	if err := b.metadataMgr.UpdateBatchOperation(&persistence.UpdateBatchOperationRequest{Info: info}); err != nil {
+		b.logger.Warnf("Unable to record the progress of batch operation %v: %v", info.ID, err)
+		return
+	}
+	response, err := b.metadataMgr.GetBatchOperation(&persistence.GetBatchOperationRequest{ID: info.ID})
+	if err != nil {
+		b.logger.Warnf("Unable to read whether batch operation %v was requested to stop: %v", info.ID, err)
+		return
+	}
+	if response.Info.StopReason != "" {
+		b.requestStop(batch, response.Info.StopReason)
+	}
+}
+
+// apply signals, cancels or terminates an execution matched by the batch.  It returns whether the execution was
+// skipped as it is already closed.
+func (b *batcher) apply(batch *batchOperation, info *gen.WorkflowExecutionInfo) (bool, error) {
+	if info.IsSetCloseStatus() {
+		return true, nil
+	}
+
+	ctx, cancel := thrift.NewContext(batchRequestTimeout)
+	defer cancel()
+	ctx = thrift.WithHeaders(ctx, map[string]string{common.PriorityHeaderName: common.PriorityBatch.String()})
+
+	request := batch.request
+	execution := &gen.WorkflowExecution{
+		WorkflowId: info.GetExecution().WorkflowId,
+		RunId:      info.GetExecution().RunId,
+	}
+	var err error
+	switch request.GetOperationType() {
+	case gen.BatchOperationType_SIGNAL:
+		err = b.history.SignalWorkflowExecution(ctx, &h.SignalWorkflowExecutionRequest{
+			DomainUUID: common.StringPtr(batch.info.DomainID),
+			SignalRequest: &gen.SignalWorkflowExecutionRequest{
+				Domain:            request.Domain,
+				WorkflowExecution: execution,
+				SignalName:        request.SignalName,
+				Input:             request.SignalInput,
+				Identity:          request.Identity,
+			},
+		})
+	case gen.BatchOperationType_CANCEL:
+		err = b.history.RequestCancelWorkflowExecution(ctx, &h.RequestCancelWorkflowExecutionRequest{
+			DomainUUID: common.StringPtr(batch.info.DomainID),
+			CancelRequest: &gen.RequestCancelWorkflowExecutionRequest{
+				Domain:            request.Domain,
+				WorkflowExecution: execution,
+				Identity:          request.Identity,
+			},
+		})
+	case gen.BatchOperationType_TERMINATE:
+		err = b.history.TerminateWorkflowExecution(ctx, &h.TerminateWorkflowExecutionRequest{
+			DomainUUID: common.StringPtr(batch.info.DomainID),
+			TerminateRequest: &gen.TerminateWorkflowExecutionRequest{
+				Domain:            request.Domain,
+				WorkflowExecution: execution,
+				Reason:            request.Reason,
+				Identity:          request.Identity,
+			},
+		})
+	}
+	if _, ok := err.(*gen.EntityNotExistsError); ok {
+		// The execution closed since it was listed
+		return true, nil
+	}
+	return false, err
+}
+
+func (b *batcher) record(batch *batchOperation, info *gen.WorkflowExecutionInfo, skipped bool, err error) {
+	b.Lock()
+	switch {
+	case err != nil:
+		batch.info.Failed++
+	case skipped:
+		batch.info.Skipped++
+	default:
+		batch.info.Succeeded++
+	}
+	b.Unlock()
+
+	switch {
+	case err != nil:
+		b.metricsClient.IncCounter(metrics.FrontendBatcherScope, metrics.BatchOperationFailedCounter)
+		b.logger.Warnf("Batch operation %v failed on execution %v, run %v: %v", batch.info.ID,
+			info.GetExecution().GetWorkflowId(), info.GetExecution().GetRunId(), err)
+	case skipped:
+		b.metricsClient.IncCounter(metrics.FrontendBatcherScope, metrics.BatchOperationSkippedCounter)
+	default:
+		b.metricsClient.IncCounter(metrics.FrontendBatcherScope, metrics.BatchOperationSucceededCounter)
+	}
+}
+
+// close records the final state and progress of the batch and frees its slot on the host
+func (b *batcher) close(batch *batchOperation, state gen.BatchOperationState, reason string) {
+	now := b.timeSource.Now()
+	b.Lock()
+	batch.info.State = int(state)
+	batch.info.CloseTime = now
+	batch.info.UpdateTime = now
+	batch.info.CloseReason = reason
+	info := copyBatchOperationInfo(batch.info)
+	b.Unlock()
+
+	if err := b.metadataMgr.UpdateBatchOperation(&persistence.UpdateBatchOperationRequest{Info: info}); err != nil {
+		b.logger.Errorf("Unable to record the close of batch operation %v as %v: %v", info.ID, state, err)
+	}
+	b.Lock()
+	delete(b.batches, info.ID)
+	b.Unlock()
+
+	message := fmt.Sprintf("Batch operation %v closed as %v: %v succeeded, %v skipped, %v failed.", info.ID, state,
+		info.Succeeded, info.Skipped, info.Failed)
+	if reason != "" {
+		message += " " + reason
+	}
+	b.logger.Info(message)
+}
+
+// copyBatchOperationInfo returns a copy of the info of a batch, so that it can be used without the lock of the batcher
+func copyBatchOperationInfo(info *persistence.BatchOperationInfo) *persistence.BatchOperationInfo {
+	copied := *info
+	return &copied
+}
+
+// getBatchRPS returns the rate of the requests of a batch, the default rate if it is not set and at most the maximum
+// rate
+func getBatchRPS(requested int32) (int, error) {
+	if requested < 0 {
+		return 0, &gen.BadRequestError{Message: fmt.Sprintf("Invalid RequestsPerSecond %v.", requested)}
+	}
+	if requested == 0 {
+		return batchDefaultRPS, nil
+	}
+	if requested > batchMaxRPS {
+		return batchMaxRPS, nil
+	}
+	return int(requested), nil
+}
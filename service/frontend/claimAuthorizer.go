@@ -0,0 +1,198 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/tchannel-go/thrift"
+)
+
+type (
+	// Permission is the access to a domain granted to a caller by its claims
+	Permission string
+
+	// Claims are the permissions granted to a caller by the issuer of its token
+	Claims struct {
+		// Subject is the caller the claims were issued to
+		Subject string `json:"sub"`
+		// Admin allows every request, including the ones managing the domains
+		Admin bool `json:"admin,omitempty"`
+		// Domains are the permissions of the caller keyed by domain name
+		Domains map[string]Permission `json:"domains,omitempty"`
+		// ExpiresAt is the unix time in seconds after which the claims are rejected, zero never expires
+		ExpiresAt int64 `json:"exp,omitempty"`
+	}
+
+	// ClaimAuthorizer is an example Authorizer enforcing per-domain access control.  The caller sends its claims in
+	// the AuthorizationHeaderName header as a token signed with a key shared with the issuer, see SignClaims.  It is
+	// installed with RegisterAuthorizer.
+	ClaimAuthorizer struct {
+		key        []byte
+		timeSource common.TimeSource
+	}
+)
+
+const (
+	// PermissionRead allows the APIs which only read the workflows of a domain
+	PermissionRead Permission = "read"
+	// PermissionWrite allows all the APIs of a domain except the ones managing the domain itself
+	PermissionWrite Permission = "write"
+
+	// AuthorizationHeaderName is the name of the tchannel header carrying the claims token of the caller
+	AuthorizationHeaderName = "cadence-authorization"
+)
+
+var (
+	// adminAPIs are the APIs which are only allowed to the admin callers
+	adminAPIs = map[string]bool{
+		"RegisterDomain":               true,
+		"UpdateDomain":                 true,
+		"DeprecateDomain":              true,
+		"GetDomainReplicationMessages": true,
+	}
+
+	// readAPIs are the APIs which are allowed to the callers with the read permission on the domain
+	readAPIs = map[string]bool{
+		"DescribeBatchOperation":          true,
+		"DescribeDomain":                  true,
+		"GetWorkflowExecutionHistory":     true,
+		"GetWorkflowResult":               true,
+		"ListOpenWorkflowExecutions":      true,
+		"ListClosedWorkflowExecutions":    true,
+		"ListWorkflowExecutionsWithQuery": true,
+		"ScanWorkflowExecutions":          true,
+		"CountOpenWorkflowExecutions":     true,
+		"CountClosedWorkflowExecutions":   true,
+		"QueryWorkflow":                   true,
+	}
+
+	errClaimsNotSet     = &gen.BadRequestError{Message: "Authorization token is not set on request."}
+	errClaimsInvalid    = &gen.BadRequestError{Message: "Authorization token is invalid."}
+	errClaimsExpired    = &gen.BadRequestError{Message: "Authorization token has expired."}
+	claimsTokenEncoding = base64.RawURLEncoding
+)
+
+// NewClaimAuthorizer returns an authorizer trusting the claims signed with the given key
+func NewClaimAuthorizer(key []byte, timeSource common.TimeSource) *ClaimAuthorizer {
+	return &ClaimAuthorizer{
+		key:        key,
+		timeSource: timeSource,
+	}
+}
+
+// SignClaims returns the token carrying the given claims, signed with the given key
+func SignClaims(claims *Claims, key []byte) (string, error) {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	encoded := claimsTokenEncoding.EncodeToString(payload)
+	return encoded + "." + claimsTokenEncoding.EncodeToString(signClaims(encoded, key)), nil
+}
+
+// Authorize allows the request if the claims of the caller grant it the API on the domain of the request.  The APIs
+// identifying their domain through a task token are allowed to any caller with valid claims, as the task token was
+// handed out to a poller of the domain.
+func (a *ClaimAuthorizer) Authorize(ctx thrift.Context, request *Request) error {
+	if ctx == nil {
+		return errClaimsNotSet
+	}
+	claims, err := a.parseClaims(ctx.Headers()[AuthorizationHeaderName])
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case claims.Admin:
+		return nil
+	case adminAPIs[request.API]:
+		// denied below
+	case request.Domain == "":
+		return nil
+	default:
+		switch claims.Domains[request.Domain] {
+		case PermissionWrite:
+			return nil
+		case PermissionRead:
+			if readAPIs[request.API] {
+				return nil
+			}
+		}
+	}
+	return &gen.BadRequestError{Message: fmt.Sprintf("%v is not authorized to call %v on domain %v.",
+		claims.Subject, request.API, request.Domain)}
+}
+
+// AuthorizeAdminOverride allows the request to override a protection of its domain if the caller is an admin
+func (a *ClaimAuthorizer) AuthorizeAdminOverride(ctx thrift.Context, request *Request) error {
+	if ctx == nil {
+		return errClaimsNotSet
+	}
+	claims, err := a.parseClaims(ctx.Headers()[AuthorizationHeaderName])
+	if err != nil {
+		return err
+	}
+	if !claims.Admin {
+		return &gen.BadRequestError{Message: fmt.Sprintf("%v is not authorized to override the protections of domain %v.",
+			claims.Subject, request.Domain)}
+	}
+	return nil
+}
+
+func (a *ClaimAuthorizer) parseClaims(token string) (*Claims, error) {
+	if token == "" {
+		return nil, errClaimsNotSet
+	}
+	parts := strings.Split(token, ".")
+	if len(parts) != 2 {
+		return nil, errClaimsInvalid
+	}
+	signature, err := claimsTokenEncoding.DecodeString(parts[1])
+	if err != nil || !hmac.Equal(signature, signClaims(parts[0], a.key)) {
+		return nil, errClaimsInvalid
+	}
+	payload, err := claimsTokenEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, errClaimsInvalid
+	}
+	claims := &Claims{}
+	if err := json.Unmarshal(payload, claims); err != nil {
+		return nil, errClaimsInvalid
+	}
+	if claims.ExpiresAt != 0 && a.timeSource.Now().Unix() > claims.ExpiresAt {
+		return nil, errClaimsExpired
+	}
+	return claims, nil
+}
+
+func signClaims(encodedClaims string, key []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(encodedClaims))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,154 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/common/searchattribute"
+	"github.com/uber/cadence/common/service/config"
+)
+
+type (
+	// configWatcher periodically reloads the config of the frontend host and applies the settings of the handler
+	// which can change while it serves requests.  The setters of the handler are only called for the settings which
+	// changed since they were last applied.  A config which fails to load or is invalid is logged and the settings in
+	// force are kept.
+	configWatcher struct {
+		handler  *WorkflowHandler
+		load     func() (*config.Config, *config.Service, error)
+		interval time.Duration
+		logger   bark.Logger
+
+		applied    runtimeSettings
+		shutdownCh chan struct{}
+		shutdownWG sync.WaitGroup
+	}
+
+	// runtimeSettings are the settings of the handler applied by the config watcher
+	runtimeSettings struct {
+		accessLog        config.AccessLog
+		hostRPS          int
+		globalRPS        int
+		domainRPS        int
+		domains          map[string]int
+		payloadLimits    config.PayloadLimits
+		searchAttributes map[string]string
+	}
+)
+
+func newConfigWatcher(handler *WorkflowHandler, load func() (*config.Config, *config.Service, error),
+	interval time.Duration, applied runtimeSettings, logger bark.Logger) *configWatcher {
+	return &configWatcher{
+		handler:    handler,
+		load:       load,
+		interval:   interval,
+		logger:     logger,
+		applied:    applied,
+		shutdownCh: make(chan struct{}),
+	}
+}
+
+// newRuntimeSettings returns the settings of the handler read from the config of the frontend host
+func newRuntimeSettings(cfg *config.Config, svcCfg *config.Service) runtimeSettings {
+	return runtimeSettings{
+		accessLog:        svcCfg.AccessLog,
+		hostRPS:          svcCfg.RateLimit.RPS,
+		globalRPS:        svcCfg.RateLimit.GlobalRPS,
+		domainRPS:        svcCfg.RateLimit.DomainRPS,
+		domains:          svcCfg.RateLimit.Domains,
+		payloadLimits:    svcCfg.PayloadLimits,
+		searchAttributes: cfg.SearchAttributes,
+	}
+}
+
+func (w *configWatcher) start() {
+	w.shutdownWG.Add(1)
+	go w.reloadPump()
+}
+
+func (w *configWatcher) stop() {
+	close(w.shutdownCh)
+	w.shutdownWG.Wait()
+}
+
+func (w *configWatcher) reloadPump() {
+	defer w.shutdownWG.Done()
+
+	reloadTicker := time.NewTicker(w.interval)
+	defer reloadTicker.Stop()
+
+	for {
+		select {
+		case <-w.shutdownCh:
+			return
+		case <-reloadTicker.C:
+			w.reload()
+		}
+	}
+}
+
+// reload loads the config and applies the settings which changed
+func (w *configWatcher) reload() {
+	cfg, svcCfg, err := w.load()
+	if err != nil {
+		w.logger.Warnf("Unable to reload the config, the settings in force are kept: %v", err)
+		return
+	}
+	settings := newRuntimeSettings(cfg, svcCfg)
+
+	var searchAttributes searchattribute.Registry
+	if !reflect.DeepEqual(settings.searchAttributes, w.applied.searchAttributes) {
+		if searchAttributes, err = searchattribute.NewRegistryFromConfig(settings.searchAttributes); err != nil {
+			w.logger.Warnf("Invalid search attributes, the settings in force are kept: %v", err)
+			return
+		}
+	}
+
+	if settings.accessLog != w.applied.accessLog {
+		w.handler.SetAccessLogEnabled(settings.accessLog.Enabled)
+		w.logger.Infof("Access log enabled: %v", settings.accessLog.Enabled)
+	}
+	if settings.hostRPS != w.applied.hostRPS {
+		w.handler.SetRateLimit(settings.hostRPS)
+		w.logger.Infof("Host rate limit: %v rps", settings.hostRPS)
+	}
+	if settings.globalRPS != w.applied.globalRPS {
+		w.handler.SetGlobalRateLimit(settings.globalRPS)
+		w.logger.Infof("Global rate limit: %v rps", settings.globalRPS)
+	}
+	if settings.domainRPS != w.applied.domainRPS || !reflect.DeepEqual(settings.domains, w.applied.domains) {
+		w.handler.SetDomainRateLimit(settings.domainRPS, settings.domains)
+		w.logger.Infof("Domain rate limit: %v rps, overrides: %v", settings.domainRPS, settings.domains)
+	}
+	if settings.payloadLimits != w.applied.payloadLimits {
+		w.handler.SetPayloadLimits(settings.payloadLimits)
+		w.logger.Infof("Payload limits: %+v", settings.payloadLimits)
+	}
+	if searchAttributes != nil {
+		w.handler.SetSearchAttributes(searchAttributes)
+		w.logger.Infof("Search attributes: %v", settings.searchAttributes)
+	}
+	w.applied = settings
+}
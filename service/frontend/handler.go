@@ -22,9 +22,16 @@ package frontend
 
 import (
 	"encoding/json"
+	"fmt"
 	"log"
+	"math"
+	"net/url"
 	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
 
+	athrift "github.com/apache/thrift/lib/go/thrift"
 	"github.com/pborman/uuid"
 	"github.com/uber/cadence/.gen/go/cadence"
 	h "github.com/uber/cadence/.gen/go/history"
@@ -34,12 +41,15 @@ import (
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/errors"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/searchattribute"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/config"
 
 	"github.com/uber-common/bark"
-	"github.com/uber-go/tally"
 	"github.com/uber/tchannel-go/thrift"
 )
 
@@ -57,20 +67,60 @@ type (
 		tokenSerializer    common.TaskTokenSerializer
 		hSerializerFactory persistence.HistorySerializerFactory
 		metricsClient      metrics.Client
+		accessLog          *accessLog
+		rateLimiter        *rateLimiter
+		domainRateLimiter  *quotas.DomainRateLimiter
+		hostRPS            int
+		globalRPS          int
+		domainRPS          int
+		domainRPSOverrides map[string]int
+		rateCoordinator    *rateLimitCoordinator
+		rateLimitStarted   bool
+		rateLimitLock      sync.Mutex
+		searchAttributes   *searchattribute.Validator
+		payloadLimits      config.PayloadLimits
+		settingsLock       sync.RWMutex
+		esVisibility       bool
+		maxExecTimeout     time.Duration
+		maxTaskTimeout     time.Duration
+		historyArchive     *persistence.HistoryArchive
+		identityRequired   bool
+		batcher            *batcher
 		startWG            sync.WaitGroup
 		service.Service
 	}
 
 	getHistoryContinuationToken struct {
-		runID            string
-		nextEventID      int64
-		persistenceToken []byte
+		RunID            string `json:"runId"`
+		NextEventID      int64  `json:"nextEventId"`
+		PersistenceToken []byte `json:"persistenceToken"`
+		Archived         bool   `json:"archived,omitempty"`
+	}
+
+	getWorkflowResultContinuationToken struct {
+		RunID string `json:"runId"`
 	}
 )
 
 const (
 	defaultVisibilityMaxPageSize = 1000
 	defaultHistoryMaxPageSize    = 1000
+	defaultDomainChangesPageSize = 100
+
+	// getWorkflowResultMaxWait is the longest a GetWorkflowResult call waits for the run to close before it returns
+	// a continuation token
+	getWorkflowResultMaxWait = time.Minute
+	// getWorkflowResultPollInterval is the interval at which GetWorkflowResult checks whether the run has closed
+	getWorkflowResultPollInterval = time.Second
+	// getWorkflowResultDeadlineBuffer is the time left to return the continuation token before the caller's deadline
+	getWorkflowResultDeadlineBuffer = time.Second
+
+	// nearInfiniteWorkflowTimeout is the execution timeout above which a started workflow is reported as never
+	// expected to time out
+	nearInfiniteWorkflowTimeout = 10 * 365 * 24 * time.Hour
+
+	// maxTraceIDLength is the length in bytes of the longest trace ID of a started workflow, as persisted
+	maxTraceIDLength = 255
 )
 
 var (
@@ -82,6 +132,11 @@ var (
 	errRunIDNotSet          = &gen.BadRequestError{Message: "RunId is not set on request."}
 	errInvalidRunID         = &gen.BadRequestError{Message: "Invalid RunId."}
 	errInvalidNextPageToken = &gen.BadRequestError{Message: "Invalid NextPageToken."}
+	errInvalidTaskToken     = &gen.BadRequestError{Message: "Invalid TaskToken."}
+	errInvalidPageSize      = &gen.BadRequestError{Message: "Invalid MaximumPageSize."}
+
+	errInvalidTerminateAfterSeconds = &gen.BadRequestError{Message: "A valid TerminateAfterSeconds is not set on request."}
+	errArchivalNotConfigured        = &gen.BadRequestError{Message: "History archival is not configured for the cluster."}
 )
 
 // NewWorkflowHandler creates a thrift handler for the cadence service
@@ -95,11 +150,125 @@ func NewWorkflowHandler(
 		visibitiltyMgr:     visibilityMgr,
 		tokenSerializer:    common.NewJSONTaskTokenSerializer(),
 		hSerializerFactory: persistence.NewHistorySerializerFactory(),
-		domainCache:        cache.NewDomainCache(metadataMgr, sVice.GetLogger()),
-	}
+		domainCache:        cache.NewDomainCache(metadataMgr, sVice.GetLogger(), sVice.GetMetricsClient()),
+		searchAttributes:   searchattribute.NewValidator(searchattribute.NewRegistry(searchattribute.DefaultKeys)),
+		accessLog:          newAccessLog(sVice.GetLogger()),
+		rateLimiter:        &rateLimiter{},
+		domainRateLimiter:  quotas.NewDomainRateLimiter(0, nil, common.NewRealTimeSource()),
+	}
+	chain := buildMiddlewareChain(handler.accessLog, handler.rateLimiter, handler.domainRateLimiter,
+		sVice.GetMetricsClient(), handler.dispatchRequest)
 	// prevent us from trying to serve requests before handler's Start() is complete
 	handler.startWG.Add(1)
-	return handler, []thrift.TChanServer{cadence.NewTChanWorkflowServiceServer(handler)}
+	return handler, []thrift.TChanServer{cadence.NewTChanWorkflowServiceServer(newMiddlewareHandler(handler, chain))}
+}
+
+// SetAccessLogEnabled turns the structured per-request access log on or off, it can be called while the handler is
+// serving requests
+func (wh *WorkflowHandler) SetAccessLogEnabled(enabled bool) {
+	wh.accessLog.setEnabled(enabled)
+}
+
+// SetRateLimit limits the rate of requests served by the frontend host, zero removes the limit.  The limit also caps
+// the share of the host of the global rate limit, and can be changed while the handler is serving requests.
+func (wh *WorkflowHandler) SetRateLimit(rps int) {
+	wh.rateLimitLock.Lock()
+	defer wh.rateLimitLock.Unlock()
+	wh.hostRPS = rps
+	if wh.rateCoordinator != nil {
+		wh.rateCoordinator.setHostRPS(rps)
+		return
+	}
+	wh.rateLimiter.setRPS(rps)
+}
+
+// SetDomainRateLimit limits the rate of requests of each domain served by all the frontend hosts of the cluster to
+// defaultRPS, except for the domains given their own limit in domainRPS.  Each host enforces its share of the limits
+// once the handler is started.  Zero removes the limit of a domain.  The limits can be changed while the handler is
+// serving requests.
+func (wh *WorkflowHandler) SetDomainRateLimit(defaultRPS int, domainRPS map[string]int) {
+	wh.rateLimitLock.Lock()
+	defer wh.rateLimitLock.Unlock()
+	wh.domainRPS = defaultRPS
+	wh.domainRPSOverrides = domainRPS
+	if wh.rateCoordinator != nil {
+		wh.rateCoordinator.setDomainRPS(defaultRPS, domainRPS)
+		return
+	}
+	wh.domainRateLimiter.SetRPS(defaultRPS, domainRPS)
+	if wh.rateLimitStarted {
+		wh.startRateLimitCoordinatorLocked()
+	}
+}
+
+// SetGlobalRateLimit limits the rate of requests served by all the frontend hosts of the cluster, each host enforcing
+// its share of the limit once the handler is started.  Zero keeps the limit of the host.  The limit can be changed
+// while the handler is serving requests.
+func (wh *WorkflowHandler) SetGlobalRateLimit(globalRPS int) {
+	wh.rateLimitLock.Lock()
+	defer wh.rateLimitLock.Unlock()
+	wh.globalRPS = globalRPS
+	if wh.rateCoordinator != nil {
+		wh.rateCoordinator.setGlobalRPS(globalRPS)
+		return
+	}
+	if wh.rateLimitStarted {
+		wh.startRateLimitCoordinatorLocked()
+	}
+}
+
+// SetMaxWorkflowTimeouts limits the execution and decision task timeouts of the started workflows, zero removes
+// the limit
+func (wh *WorkflowHandler) SetMaxWorkflowTimeouts(executionTimeout, taskTimeout time.Duration) {
+	wh.maxExecTimeout = executionTimeout
+	wh.maxTaskTimeout = taskTimeout
+}
+
+// SetHistoryArchive sets the archive the histories of the domains with archival enabled are read from once they are
+// deleted, nil disables archival
+func (wh *WorkflowHandler) SetHistoryArchive(archive *persistence.HistoryArchive) {
+	wh.historyArchive = archive
+}
+
+// SetIdentityRequired rejects the mutating requests without an identity, by default the address of the caller is
+// recorded in their history events instead
+func (wh *WorkflowHandler) SetIdentityRequired(required bool) {
+	wh.identityRequired = required
+}
+
+// SetPayloadLimits limits the size of the payloads and IDs of the requests, zero removes a limit.  The limits can be
+// changed while the handler is serving requests.
+func (wh *WorkflowHandler) SetPayloadLimits(limits config.PayloadLimits) {
+	wh.settingsLock.Lock()
+	defer wh.settingsLock.Unlock()
+	wh.payloadLimits = limits
+}
+
+// SetSearchAttributes sets the registry of the search attribute keys the started workflows can be indexed by, the
+// default keys are accepted until it is called.  The registry can be changed while the handler is serving requests.
+func (wh *WorkflowHandler) SetSearchAttributes(registry searchattribute.Registry) {
+	validator := searchattribute.NewValidator(registry)
+	wh.settingsLock.Lock()
+	defer wh.settingsLock.Unlock()
+	wh.searchAttributes = validator
+}
+
+func (wh *WorkflowHandler) getPayloadLimits() config.PayloadLimits {
+	wh.settingsLock.RLock()
+	defer wh.settingsLock.RUnlock()
+	return wh.payloadLimits
+}
+
+func (wh *WorkflowHandler) getSearchAttributes() *searchattribute.Validator {
+	wh.settingsLock.RLock()
+	defer wh.settingsLock.RUnlock()
+	return wh.searchAttributes
+}
+
+// SetElasticsearchVisibility tells whether the visibility records are indexed into Elasticsearch, the batch operations
+// are rejected unless they are
+func (wh *WorkflowHandler) SetElasticsearchVisibility(enabled bool) {
+	wh.esVisibility = enabled
 }
 
 // Start starts the handler
@@ -115,12 +284,47 @@ func (wh *WorkflowHandler) Start(thriftService []thrift.TChanServer) error {
 		return err
 	}
 	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.batcher = newBatcher(wh.metadataMgr, wh.visibitiltyMgr, wh.history, common.NewRealTimeSource(),
+		wh.Service.GetLogger(), wh.metricsClient)
+	wh.domainCache.Start()
+	wh.startRateLimitCoordinator()
 	wh.startWG.Done()
 	return nil
 }
 
+func (wh *WorkflowHandler) startRateLimitCoordinator() {
+	wh.rateLimitLock.Lock()
+	defer wh.rateLimitLock.Unlock()
+	wh.rateLimitStarted = true
+	wh.startRateLimitCoordinatorLocked()
+}
+
+// startRateLimitCoordinatorLocked starts the coordinator of the rate limits once the handler is started, if there is a
+// global or a domain rate limit to share between the hosts.  The caller must hold the rate limit lock.
+func (wh *WorkflowHandler) startRateLimitCoordinatorLocked() {
+	if wh.globalRPS <= 0 && wh.domainRPS <= 0 && len(wh.domainRPSOverrides) == 0 {
+		return
+	}
+	resolver, err := wh.Service.GetMembershipMonitor().GetResolver(common.FrontendServiceName)
+	if err != nil {
+		wh.Service.GetLogger().Warnf("Unable to resolve the frontend membership, the global and domain rate limits "+
+			"are enforced in full by the host: %v", err)
+		return
+	}
+	wh.rateCoordinator = newRateLimitCoordinator(wh.rateLimiter, wh.domainRateLimiter, resolver, wh.globalRPS,
+		wh.hostRPS, wh.domainRPS, wh.domainRPSOverrides, wh.Service.GetLogger(), wh.metricsClient)
+	wh.rateCoordinator.start()
+}
+
 // Stop stops the handler
 func (wh *WorkflowHandler) Stop() {
+	if wh.rateCoordinator != nil {
+		wh.rateCoordinator.stop()
+	}
+	if wh.batcher != nil {
+		wh.batcher.stop()
+	}
+	wh.domainCache.Stop()
 	wh.metadataMgr.Close()
 	wh.visibitiltyMgr.Close()
 	wh.historyMgr.Close()
@@ -140,20 +344,22 @@ func (wh *WorkflowHandler) IsHealthy(ctx thrift.Context) (bool, error) {
 func (wh *WorkflowHandler) RegisterDomain(ctx thrift.Context, registerRequest *gen.RegisterDomainRequest) error {
 
 	scope := metrics.FrontendRegisterDomainScope
-	sw := wh.startRequestProfile(scope)
-	defer sw.Stop()
 
-	if !registerRequest.IsSetName() || registerRequest.GetName() == "" {
-		return wh.error(errDomainNotSet, scope)
+	if registerRequest.GetArchivalEnabled() && wh.historyArchive == nil {
+		return wh.error(errArchivalNotConfigured, scope)
 	}
 
 	response, err := wh.metadataMgr.CreateDomain(&persistence.CreateDomainRequest{
-		Name:        registerRequest.GetName(),
-		Status:      persistence.DomainStatusRegistered,
-		OwnerEmail:  registerRequest.GetOwnerEmail(),
-		Description: registerRequest.GetDescription(),
-		Retention:   registerRequest.GetWorkflowExecutionRetentionPeriodInDays(),
-		EmitMetric:  registerRequest.GetEmitMetric(),
+		Name:                 registerRequest.GetName(),
+		Status:               persistence.DomainStatusRegistered,
+		OwnerEmail:           registerRequest.GetOwnerEmail(),
+		Description:          registerRequest.GetDescription(),
+		Retention:            registerRequest.GetWorkflowExecutionRetentionPeriodInDays(),
+		EmitMetric:           registerRequest.GetEmitMetric(),
+		ArchivalEnabled:      registerRequest.GetArchivalEnabled(),
+		DefaultTaskList:      registerRequest.GetDefaultTaskList(),
+		TaskListOverrides:    registerRequest.GetTaskListOverrides(),
+		TerminationProtected: registerRequest.GetTerminationProtected(),
 	})
 
 	if err != nil {
@@ -170,12 +376,6 @@ func (wh *WorkflowHandler) DescribeDomain(ctx thrift.Context,
 	describeRequest *gen.DescribeDomainRequest) (*gen.DescribeDomainResponse, error) {
 
 	scope := metrics.FrontendDescribeDomainScope
-	sw := wh.startRequestProfile(scope)
-	defer sw.Stop()
-
-	if !describeRequest.IsSetName() {
-		return nil, wh.error(errDomainNotSet, scope)
-	}
 
 	resp, err := wh.metadataMgr.GetDomain(&persistence.GetDomainRequest{
 		Name: describeRequest.GetName(),
@@ -191,17 +391,12 @@ func (wh *WorkflowHandler) DescribeDomain(ctx thrift.Context,
 	return response, nil
 }
 
-// UpdateDomain is used to update the information and configuration for a registered domain.
+// UpdateDomain is used to update the information and configuration for a registered domain.  The changes are
+// described by the response, with ValidateOnly set they are validated and described without being applied.
 func (wh *WorkflowHandler) UpdateDomain(ctx thrift.Context,
 	updateRequest *gen.UpdateDomainRequest) (*gen.UpdateDomainResponse, error) {
 
 	scope := metrics.FrontendUpdateDomainScope
-	sw := wh.startRequestProfile(scope)
-	defer sw.Stop()
-
-	if !updateRequest.IsSetName() {
-		return nil, wh.error(errDomainNotSet, scope)
-	}
 
 	domainName := updateRequest.GetName()
 
@@ -215,6 +410,7 @@ func (wh *WorkflowHandler) UpdateDomain(ctx thrift.Context,
 
 	info := getResponse.Info
 	config := getResponse.Config
+	oldInfo, oldConfig := *info, *config
 
 	if updateRequest.IsSetUpdatedInfo() {
 		updatedInfo := updateRequest.GetUpdatedInfo()
@@ -232,35 +428,134 @@ func (wh *WorkflowHandler) UpdateDomain(ctx thrift.Context,
 			config.EmitMetric = updatedConfig.GetEmitMetric()
 		}
 		if updatedConfig.IsSetWorkflowExecutionRetentionPeriodInDays() {
-			config.Retention = updatedConfig.GetWorkflowExecutionRetentionPeriodInDays()
+			retention := updatedConfig.GetWorkflowExecutionRetentionPeriodInDays()
+			if retention < 0 {
+				return nil, wh.error(&gen.BadRequestError{Message: fmt.Sprintf(
+					"Invalid WorkflowExecutionRetentionPeriodInDays %v.", retention)}, scope)
+			}
+			config.Retention = retention
 		}
+		if updatedConfig.IsSetArchivalEnabled() {
+			if updatedConfig.GetArchivalEnabled() && wh.historyArchive == nil {
+				return nil, wh.error(errArchivalNotConfigured, scope)
+			}
+			config.ArchivalEnabled = updatedConfig.GetArchivalEnabled()
+		}
+		if updatedConfig.IsSetDefaultTaskList() {
+			config.DefaultTaskList = updatedConfig.GetDefaultTaskList()
+		}
+		if updatedConfig.IsSetTaskListOverrides() {
+			config.TaskListOverrides = updatedConfig.GetTaskListOverrides()
+		}
+		if updatedConfig.IsSetTerminationProtected() {
+			config.TerminationProtected = updatedConfig.GetTerminationProtected()
+		}
+	}
+
+	response := gen.NewUpdateDomainResponse()
+	response.DomainInfo, response.Configuration = createDomainResponse(info, config)
+	response.Changes = describeDomainUpdate(&oldInfo, &oldConfig, info, config)
+	if updateRequest.GetValidateOnly() {
+		return response, nil
 	}
 
 	err := wh.metadataMgr.UpdateDomain(&persistence.UpdateDomainRequest{
-		Info:   info,
-		Config: config,
+		Info:            info,
+		Config:          config,
+		FailoverVersion: getResponse.FailoverVersion,
 	})
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
-
-	response := gen.NewUpdateDomainResponse()
-	response.DomainInfo, response.Configuration = createDomainResponse(info, config)
 	return response, nil
 }
 
+// describeDomainUpdate returns the descriptions of the changes made to a domain by an update, including their impact
+// on the workflow executions of the domain
+func describeDomainUpdate(oldInfo *persistence.DomainInfo, oldConfig *persistence.DomainConfig,
+	info *persistence.DomainInfo, config *persistence.DomainConfig) []string {
+	changes := []string{}
+	if info.Description != oldInfo.Description {
+		changes = append(changes, fmt.Sprintf("description: %q -> %q", oldInfo.Description, info.Description))
+	}
+	if info.OwnerEmail != oldInfo.OwnerEmail {
+		changes = append(changes, fmt.Sprintf("ownerEmail: %q -> %q", oldInfo.OwnerEmail, info.OwnerEmail))
+	}
+	if config.EmitMetric != oldConfig.EmitMetric {
+		changes = append(changes, fmt.Sprintf("emitMetric: %v -> %v", oldConfig.EmitMetric, config.EmitMetric))
+	}
+	if config.Retention != oldConfig.Retention {
+		changes = append(changes, fmt.Sprintf("workflowExecutionRetentionPeriodInDays: %v -> %v", oldConfig.Retention,
+			config.Retention))
+		// The retention of an execution is fixed when it closes
+		changes = append(changes, fmt.Sprintf(
+			"executions closing from now on are kept %v days, executions already closed keep their retention",
+			config.Retention))
+		if config.Retention > 0 && (oldConfig.Retention == 0 || config.Retention < oldConfig.Retention) {
+			changes = append(changes, fmt.Sprintf(
+				"workflows with an execution timeout over %v days can no longer be started", config.Retention))
+		}
+	}
+	if config.ArchivalEnabled != oldConfig.ArchivalEnabled {
+		changes = append(changes, fmt.Sprintf("archivalEnabled: %v -> %v", oldConfig.ArchivalEnabled,
+			config.ArchivalEnabled))
+		// The history of an execution is archived when it is deleted
+		if config.ArchivalEnabled {
+			changes = append(changes, "histories deleted from now on are archived, including those of executions "+
+				"already closed")
+		} else {
+			changes = append(changes, "histories deleted from now on are no longer archived, archived histories are "+
+				"kept")
+		}
+	}
+	defaultTaskListChanged := config.DefaultTaskList != oldConfig.DefaultTaskList
+	if defaultTaskListChanged {
+		changes = append(changes, fmt.Sprintf("defaultTaskList: %q -> %q", oldConfig.DefaultTaskList,
+			config.DefaultTaskList))
+	}
+	taskListOverridesChanged := !taskListOverridesEqual(config.TaskListOverrides, oldConfig.TaskListOverrides)
+	if taskListOverridesChanged {
+		changes = append(changes, fmt.Sprintf("taskListOverrides: %v -> %v", oldConfig.TaskListOverrides,
+			config.TaskListOverrides))
+	}
+	if defaultTaskListChanged || taskListOverridesChanged {
+		// The task list of an execution is fixed when it starts
+		changes = append(changes, "workflows started from now on without a task list use the new task lists, "+
+			"running executions keep theirs")
+	}
+	if config.TerminationProtected != oldConfig.TerminationProtected {
+		changes = append(changes, fmt.Sprintf("terminationProtected: %v -> %v", oldConfig.TerminationProtected,
+			config.TerminationProtected))
+		// The protection is checked when an execution is terminated
+		if config.TerminationProtected {
+			changes = append(changes, "executions can no longer be terminated without an admin override, "+
+				"including those already running")
+		} else {
+			changes = append(changes, "executions can be terminated again without an admin override")
+		}
+	}
+	return changes
+}
+
+// taskListOverridesEqual returns whether two task list overrides map the same workflow types, nil being empty
+func taskListOverridesEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for workflowType, taskList := range a {
+		if other, ok := b[workflowType]; !ok || other != taskList {
+			return false
+		}
+	}
+	return true
+}
+
 // DeprecateDomain us used to update status of a registered domain to DEPRECATED.  Once the domain is deprecated
 // it cannot be used to start new workflow executions.  Existing workflow executions will continue to run on
 // deprecated domains.
 func (wh *WorkflowHandler) DeprecateDomain(ctx thrift.Context, deprecateRequest *gen.DeprecateDomainRequest) error {
 
 	scope := metrics.FrontendDeprecateDomainScope
-	sw := wh.startRequestProfile(scope)
-	defer sw.Stop()
-
-	if !deprecateRequest.IsSetName() {
-		return wh.error(errDomainNotSet, scope)
-	}
 
 	domainName := deprecateRequest.GetName()
 
@@ -277,8 +572,9 @@ func (wh *WorkflowHandler) DeprecateDomain(ctx thrift.Context, deprecateRequest
 	config := getResponse.Config
 
 	err := wh.metadataMgr.UpdateDomain(&persistence.UpdateDomainRequest{
-		Info:   info,
-		Config: config,
+		Info:            info,
+		Config:          config,
+		FailoverVersion: getResponse.FailoverVersion,
 	})
 	if err != nil {
 		return wh.error(errDomainNotSet, scope)
@@ -286,19 +582,57 @@ func (wh *WorkflowHandler) DeprecateDomain(ctx thrift.Context, deprecateRequest
 	return nil
 }
 
+// GetClusterInfo - returns the capabilities of the cluster, which clients use to enable the features it supports
+func (wh *WorkflowHandler) GetClusterInfo(ctx thrift.Context,
+	getRequest *gen.GetClusterInfoRequest) (*gen.GetClusterInfoResponse, error) {
+	resp := gen.NewGetClusterInfoResponse()
+	resp.Capabilities = getClusterCapabilities()
+	return resp, nil
+}
+
+// GetDomainReplicationMessages - returns the domain changes made after the given notification version, so external
+// systems can follow domain registrations, updates and deprecations.
+func (wh *WorkflowHandler) GetDomainReplicationMessages(ctx thrift.Context,
+	getRequest *gen.GetDomainReplicationMessagesRequest) (*gen.GetDomainReplicationMessagesResponse, error) {
+
+	scope := metrics.FrontendGetDomainReplicationMessagesScope
+
+	if !getRequest.IsSetMaximumPageSize() || getRequest.GetMaximumPageSize() == 0 {
+		getRequest.MaximumPageSize = common.Int32Ptr(defaultDomainChangesPageSize)
+	}
+
+	lastVersion := getRequest.GetLastNotificationVersion()
+	persistenceResp, err := wh.metadataMgr.GetDomainChanges(&persistence.GetDomainChangesRequest{
+		LastNotificationVersion: lastVersion,
+		PageSize:                int(getRequest.GetMaximumPageSize()),
+	})
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	resp := gen.NewGetDomainReplicationMessagesResponse()
+	resp.Events = []*gen.DomainChangeEvent{}
+	for _, change := range persistenceResp.Changes {
+		event := gen.NewDomainChangeEvent()
+		event.NotificationVersion = common.Int64Ptr(change.NotificationVersion)
+		event.ChangeType = getDomainChangeType(change)
+		event.DomainId = common.StringPtr(change.Info.ID)
+		event.DomainInfo, event.Configuration = createDomainResponse(change.Info, change.Config)
+		resp.Events = append(resp.Events, event)
+		lastVersion = change.NotificationVersion
+	}
+	resp.LastNotificationVersion = common.Int64Ptr(lastVersion)
+	return resp, nil
+}
+
 // PollForActivityTask - Poll for an activity task.
 func (wh *WorkflowHandler) PollForActivityTask(
 	ctx thrift.Context,
 	pollRequest *gen.PollForActivityTaskRequest) (*gen.PollForActivityTaskResponse, error) {
 
 	scope := metrics.FrontendPollForActivityTaskScope
-	sw := wh.startRequestProfile(scope)
-	defer sw.Stop()
 
 	wh.Service.GetLogger().Debug("Received PollForActivityTask")
-	if !pollRequest.IsSetDomain() {
-		return nil, wh.error(errDomainNotSet, scope)
-	}
 
 	if !pollRequest.IsSetTaskList() ||
 		!pollRequest.GetTaskList().IsSetName() || pollRequest.GetTaskList().GetName() == "" {
@@ -311,6 +645,10 @@ func (wh *WorkflowHandler) PollForActivityTask(
 		return nil, wh.error(err, scope)
 	}
 
+	if pollRequest.Identity, err = wh.resolveIdentity(ctx, pollRequest.Identity); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
 	resp, err := wh.matching.PollForActivityTask(ctx, &m.PollForActivityTaskRequest{
 		DomainUUID:  common.StringPtr(info.ID),
 		PollRequest: pollRequest,
@@ -329,13 +667,8 @@ func (wh *WorkflowHandler) PollForDecisionTask(
 	pollRequest *gen.PollForDecisionTaskRequest) (*gen.PollForDecisionTaskResponse, error) {
 
 	scope := metrics.FrontendPollForDecisionTaskScope
-	sw := wh.startRequestProfile(scope)
-	defer sw.Stop()
 
 	wh.Service.GetLogger().Debug("Received PollForDecisionTask")
-	if !pollRequest.IsSetDomain() {
-		return nil, wh.error(errDomainNotSet, scope)
-	}
 
 	if !pollRequest.IsSetTaskList() ||
 		!pollRequest.GetTaskList().IsSetName() || pollRequest.GetTaskList().GetName() == "" {
@@ -351,6 +684,10 @@ func (wh *WorkflowHandler) PollForDecisionTask(
 	wh.Service.GetLogger().Infof("Poll for decision domain name: %v", domainName)
 	wh.Service.GetLogger().Infof("Poll for decision request domainID: %v", info.ID)
 
+	if pollRequest.Identity, err = wh.resolveIdentity(ctx, pollRequest.Identity); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
 	matchingResp, err := wh.matching.PollForDecisionTask(ctx, &m.PollForDecisionTaskRequest{
 		DomainUUID:  common.StringPtr(info.ID),
 		PollRequest: pollRequest,
@@ -367,13 +704,15 @@ func (wh *WorkflowHandler) PollForDecisionTask(
 	if matchingResp.IsSetWorkflowExecution() {
 		// Non-empty response. Get the history
 		history, persistenceToken, err = wh.getHistory(
-			info.ID, *matchingResp.GetWorkflowExecution(), matchingResp.GetStartedEventId()+1, defaultHistoryMaxPageSize, nil)
+			info.ID, *matchingResp.GetWorkflowExecution(), matchingResp.GetStartedEventId()+1, defaultHistoryMaxPageSize, nil,
+			false)
 		if err != nil {
 			return nil, wh.error(err, scope)
 		}
 
 		continuation, err =
-			getSerializedGetHistoryToken(persistenceToken, matchingResp.GetWorkflowExecution().GetRunId(), history, matchingResp.GetStartedEventId()+1)
+			getSerializedGetHistoryToken(persistenceToken, matchingResp.GetWorkflowExecution().GetRunId(), history, matchingResp.GetStartedEventId()+1,
+				false)
 		if err != nil {
 			return nil, wh.error(err, scope)
 		}
@@ -388,8 +727,6 @@ func (wh *WorkflowHandler) RecordActivityTaskHeartbeat(
 	heartbeatRequest *gen.RecordActivityTaskHeartbeatRequest) (*gen.RecordActivityTaskHeartbeatResponse, error) {
 
 	scope := metrics.FrontendRecordActivityTaskHeartbeatScope
-	sw := wh.startRequestProfile(scope)
-	defer sw.Stop()
 
 	wh.Service.GetLogger().Debug("Received RecordActivityTaskHeartbeat")
 	if !heartbeatRequest.IsSetTaskToken() {
@@ -403,6 +740,15 @@ func (wh *WorkflowHandler) RecordActivityTaskHeartbeat(
 		return nil, wh.error(errDomainNotSet, scope)
 	}
 
+	if err := validatePayloadSize("Details", heartbeatRequest.GetDetails(),
+		wh.getPayloadLimits().MaxHeartbeatDetailsSize); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if heartbeatRequest.Identity, err = wh.resolveIdentity(ctx, heartbeatRequest.Identity); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
 	resp, err := wh.history.RecordActivityTaskHeartbeat(ctx, &h.RecordActivityTaskHeartbeatRequest{
 		DomainUUID:       common.StringPtr(taskToken.DomainID),
 		HeartbeatRequest: heartbeatRequest,
@@ -419,8 +765,6 @@ func (wh *WorkflowHandler) RespondActivityTaskCompleted(
 	completeRequest *gen.RespondActivityTaskCompletedRequest) error {
 
 	scope := metrics.FrontendRespondActivityTaskCompletedScope
-	sw := wh.startRequestProfile(scope)
-	defer sw.Stop()
 
 	if !completeRequest.IsSetTaskToken() {
 		return wh.error(errTaskTokenNotSet, scope)
@@ -433,6 +777,10 @@ func (wh *WorkflowHandler) RespondActivityTaskCompleted(
 		return wh.error(errDomainNotSet, scope)
 	}
 
+	if completeRequest.Identity, err = wh.resolveIdentity(ctx, completeRequest.Identity); err != nil {
+		return wh.error(err, scope)
+	}
+
 	err = wh.history.RespondActivityTaskCompleted(ctx, &h.RespondActivityTaskCompletedRequest{
 		DomainUUID:      common.StringPtr(taskToken.DomainID),
 		CompleteRequest: completeRequest,
@@ -451,8 +799,6 @@ func (wh *WorkflowHandler) RespondActivityTaskFailed(
 	failedRequest *gen.RespondActivityTaskFailedRequest) error {
 
 	scope := metrics.FrontendRespondActivityTaskFailedScope
-	sw := wh.startRequestProfile(scope)
-	defer sw.Stop()
 
 	if !failedRequest.IsSetTaskToken() {
 		return wh.error(errTaskTokenNotSet, scope)
@@ -465,6 +811,10 @@ func (wh *WorkflowHandler) RespondActivityTaskFailed(
 		return wh.error(errDomainNotSet, scope)
 	}
 
+	if failedRequest.Identity, err = wh.resolveIdentity(ctx, failedRequest.Identity); err != nil {
+		return wh.error(err, scope)
+	}
+
 	err = wh.history.RespondActivityTaskFailed(ctx, &h.RespondActivityTaskFailedRequest{
 		DomainUUID:    common.StringPtr(taskToken.DomainID),
 		FailedRequest: failedRequest,
@@ -484,8 +834,6 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceled(
 	cancelRequest *gen.RespondActivityTaskCanceledRequest) error {
 
 	scope := metrics.FrontendRespondActivityTaskCanceledScope
-	sw := wh.startRequestProfile(scope)
-	defer sw.Stop()
 
 	if !cancelRequest.IsSetTaskToken() {
 		return wh.error(errTaskTokenNotSet, scope)
@@ -498,6 +846,10 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceled(
 		return wh.error(errDomainNotSet, scope)
 	}
 
+	if cancelRequest.Identity, err = wh.resolveIdentity(ctx, cancelRequest.Identity); err != nil {
+		return wh.error(err, scope)
+	}
+
 	err = wh.history.RespondActivityTaskCanceled(ctx, &h.RespondActivityTaskCanceledRequest{
 		DomainUUID:    common.StringPtr(taskToken.DomainID),
 		CancelRequest: cancelRequest,
@@ -514,30 +866,66 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceled(
 // RespondDecisionTaskCompleted - response to a decision task
 func (wh *WorkflowHandler) RespondDecisionTaskCompleted(
 	ctx thrift.Context,
-	completeRequest *gen.RespondDecisionTaskCompletedRequest) error {
+	completeRequest *gen.RespondDecisionTaskCompletedRequest) (*gen.RespondDecisionTaskCompletedResponse, error) {
 
 	scope := metrics.FrontendRespondDecisionTaskCompletedScope
-	sw := wh.startRequestProfile(scope)
-	defer sw.Stop()
 
 	if !completeRequest.IsSetTaskToken() {
-		return wh.error(errTaskTokenNotSet, scope)
+		return nil, wh.error(errTaskTokenNotSet, scope)
 	}
 	taskToken, err := wh.tokenSerializer.Deserialize(completeRequest.GetTaskToken())
 	if err != nil {
-		return wh.error(err, scope)
+		return nil, wh.error(err, scope)
 	}
 	if taskToken.DomainID == "" {
-		return wh.error(errDomainNotSet, scope)
+		return nil, wh.error(errDomainNotSet, scope)
+	}
+
+	if completeRequest.Identity, err = wh.resolveIdentity(ctx, completeRequest.Identity); err != nil {
+		return nil, wh.error(err, scope)
 	}
 
-	err = wh.history.RespondDecisionTaskCompleted(ctx, &h.RespondDecisionTaskCompletedRequest{
+	resp, err := wh.history.RespondDecisionTaskCompleted(ctx, &h.RespondDecisionTaskCompletedRequest{
 		DomainUUID:      common.StringPtr(taskToken.DomainID),
 		CompleteRequest: completeRequest,
 	})
 	if err != nil {
 		logger := wh.getLoggerForTask(completeRequest.GetTaskToken())
 		logger.Errorf("RespondDecisionTaskCompleted. Error: %v", err)
+		return nil, wh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// RespondQueryTaskCompleted - response to a query task
+func (wh *WorkflowHandler) RespondQueryTaskCompleted(
+	ctx thrift.Context,
+	completeRequest *gen.RespondQueryTaskCompletedRequest) error {
+
+	scope := metrics.FrontendRespondQueryTaskCompletedScope
+
+	if !completeRequest.IsSetTaskToken() {
+		return wh.error(errTaskTokenNotSet, scope)
+	}
+	queryTaskToken, err := wh.tokenSerializer.DeserializeQueryTaskToken(completeRequest.GetTaskToken())
+	if err != nil {
+		return wh.error(err, scope)
+	}
+	if queryTaskToken.DomainID == "" || queryTaskToken.TaskList == "" || queryTaskToken.TaskID == "" {
+		return wh.error(errInvalidTaskToken, scope)
+	}
+
+	if !completeRequest.IsSetCompletedType() {
+		return wh.error(&gen.BadRequestError{Message: "CompletedType is not set on request."}, scope)
+	}
+
+	err = wh.matching.RespondQueryTaskCompleted(ctx, &m.RespondQueryTaskCompletedRequest{
+		DomainUUID:       common.StringPtr(queryTaskToken.DomainID),
+		TaskList:         &gen.TaskList{Name: common.StringPtr(queryTaskToken.TaskList)},
+		TaskID:           common.StringPtr(queryTaskToken.TaskID),
+		CompletedRequest: completeRequest,
+	})
+	if err != nil {
 		return wh.error(err, scope)
 	}
 	return nil
@@ -549,60 +937,200 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 	startRequest *gen.StartWorkflowExecutionRequest) (*gen.StartWorkflowExecutionResponse, error) {
 
 	scope := metrics.FrontendStartWorkflowExecutionScope
-	sw := wh.startRequestProfile(scope)
-	defer sw.Stop()
 
 	wh.Service.GetLogger().Debugf("Received StartWorkflowExecution. WorkflowID: %v", startRequest.GetWorkflowId())
 
-	if !startRequest.IsSetDomain() {
-		return nil, wh.error(errDomainNotSet, scope)
+	info, err := wh.validateStartRequest(startRequest)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	wh.Service.GetLogger().Infof("Start workflow execution request domain: %v, domainID: %v",
+		startRequest.GetDomain(), info.ID)
+
+	if startRequest.Identity, err = wh.resolveIdentity(ctx, startRequest.Identity); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	resp, err := wh.history.StartWorkflowExecution(ctx, &h.StartWorkflowExecutionRequest{
+		DomainUUID:   common.StringPtr(info.ID),
+		StartRequest: startRequest,
+	})
+	if err != nil {
+		wh.Service.GetLogger().Errorf("StartWorkflowExecution failed. WorkflowID: %v. Error: %v",
+			startRequest.GetWorkflowId(), err)
+		return nil, wh.error(err, scope)
+	}
+
+	executionTimeout := time.Duration(startRequest.GetExecutionStartToCloseTimeoutSeconds()) * time.Second
+	if executionTimeout >= nearInfiniteWorkflowTimeout {
+		wh.metricsClient.UpdateGauge(scope, metrics.WorkflowNearInfiniteTimeoutGauge, executionTimeout.Seconds())
 	}
+	return resp, nil
+}
 
+// validateStartRequest validates a request to start a workflow, and returns the domain of the workflow
+func (wh *WorkflowHandler) validateStartRequest(startRequest *gen.StartWorkflowExecutionRequest) (
+	*persistence.DomainInfo, error) {
 	if !startRequest.IsSetWorkflowId() || startRequest.GetWorkflowId() == "" {
-		return nil, wh.error(&gen.BadRequestError{Message: "WorkflowId is not set on request."}, scope)
+		return nil, &gen.BadRequestError{Message: "WorkflowId is not set on request."}
 	}
 
 	if !startRequest.IsSetWorkflowType() ||
 		!startRequest.GetWorkflowType().IsSetName() || startRequest.GetWorkflowType().GetName() == "" {
-		return nil, wh.error(&gen.BadRequestError{Message: "WorkflowType is not set on request."}, scope)
+		return nil, &gen.BadRequestError{Message: "WorkflowType is not set on request."}
 	}
 
-	if !startRequest.IsSetTaskList() ||
-		!startRequest.GetTaskList().IsSetName() || startRequest.GetTaskList().GetName() == "" {
-		return nil, wh.error(errTaskListNotSet, scope)
+	if err := wh.validateStartPayloads(startRequest); err != nil {
+		return nil, err
 	}
 
 	if !startRequest.IsSetExecutionStartToCloseTimeoutSeconds() ||
 		startRequest.GetExecutionStartToCloseTimeoutSeconds() <= 0 {
-		return nil, wh.error(&gen.BadRequestError{
-			Message: "A valid ExecutionStartToCloseTimeoutSeconds is not set on request."}, scope)
+		return nil, &gen.BadRequestError{Message: "A valid ExecutionStartToCloseTimeoutSeconds is not set on request."}
 	}
 
 	if !startRequest.IsSetTaskStartToCloseTimeoutSeconds() ||
-		startRequest.GetExecutionStartToCloseTimeoutSeconds() <= 0 {
-		return nil, wh.error(&gen.BadRequestError{
-			Message: "A valid TaskStartToCloseTimeoutSeconds is not set on request."}, scope)
+		startRequest.GetTaskStartToCloseTimeoutSeconds() <= 0 {
+		return nil, &gen.BadRequestError{Message: "A valid TaskStartToCloseTimeoutSeconds is not set on request."}
 	}
 
-	domainName := startRequest.GetDomain()
-	wh.Service.GetLogger().Infof("Start workflow execution request domain: %v", domainName)
-	info, _, err := wh.domainCache.GetDomain(domainName)
-	if err != nil {
-		return nil, wh.error(err, scope)
+	if err := wh.getSearchAttributes().Validate(startRequest.GetSearchAttributes()); err != nil {
+		return nil, err
 	}
 
-	wh.Service.GetLogger().Infof("Start workflow execution request domainID: %v", info.ID)
+	if err := validateCompletionCallbackURL(startRequest.GetCompletionCallbackUrl()); err != nil {
+		return nil, err
+	}
 
-	resp, err := wh.history.StartWorkflowExecution(ctx, &h.StartWorkflowExecutionRequest{
-		DomainUUID:   common.StringPtr(info.ID),
-		StartRequest: startRequest,
-	})
+	info, config, err := wh.domainCache.GetDomain(startRequest.GetDomain())
 	if err != nil {
-		wh.Service.GetLogger().Errorf("StartWorkflowExecution failed. WorkflowID: %v. Error: %v",
-			startRequest.GetWorkflowId(), err)
-		return nil, wh.error(err, scope)
+		return nil, err
 	}
-	return resp, nil
+
+	if !startRequest.IsSetTaskList() ||
+		!startRequest.GetTaskList().IsSetName() || startRequest.GetTaskList().GetName() == "" {
+		taskList := resolveTaskList(config, startRequest.GetWorkflowType().GetName())
+		if taskList == "" {
+			return nil, errTaskListNotSet
+		}
+		startRequest.TaskList = &gen.TaskList{Name: common.StringPtr(taskList)}
+	}
+	if err := validateID("TaskList", startRequest.GetTaskList().GetName(),
+		wh.getPayloadLimits().MaxIDLength); err != nil {
+		return nil, err
+	}
+
+	if err := wh.validateStartTimeouts(startRequest, config); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// validateStartPayloads rejects the IDs and input of a workflow to start which exceed the payload limits
+func (wh *WorkflowHandler) validateStartPayloads(startRequest *gen.StartWorkflowExecutionRequest) error {
+	limits := wh.getPayloadLimits()
+	if err := validateID("WorkflowId", startRequest.GetWorkflowId(), limits.MaxIDLength); err != nil {
+		return err
+	}
+	if err := validateID("WorkflowType", startRequest.GetWorkflowType().GetName(), limits.MaxIDLength); err != nil {
+		return err
+	}
+	if err := validateID("RequestId", startRequest.GetRequestId(), limits.MaxIDLength); err != nil {
+		return err
+	}
+	// The trace ID is also bounded by maxTraceIDLength by the validation middleware
+	if err := validateID("TraceId", startRequest.GetTraceId(), limits.MaxIDLength); err != nil {
+		return err
+	}
+	return validatePayloadSize("Input", startRequest.GetInput(), limits.MaxWorkflowInputSize)
+}
+
+// validateID rejects an ID longer than limit bytes, a zero limit disabling the check, or which is not printable
+// UTF-8, as IDs end up in logs, metrics tags and visibility records
+func validateID(name, id string, limit int) error {
+	if limit > 0 && len(id) > limit {
+		return &gen.BadRequestError{Message: fmt.Sprintf("%v length %v exceeds the limit of %v.", name, len(id),
+			limit)}
+	}
+	if !utf8.ValidString(id) {
+		return &gen.BadRequestError{Message: fmt.Sprintf("%v is not valid UTF-8.", name)}
+	}
+	for _, c := range id {
+		if unicode.IsControl(c) {
+			return &gen.BadRequestError{Message: fmt.Sprintf("%v contains control characters.", name)}
+		}
+	}
+	return nil
+}
+
+// validatePayloadSize rejects a payload larger than limit bytes, a zero limit disabling the check
+func validatePayloadSize(name string, payload []byte, limit int) error {
+	if limit > 0 && len(payload) > limit {
+		return &gen.BadRequestError{Message: fmt.Sprintf("%v size %v exceeds the limit of %v bytes.", name,
+			len(payload), limit)}
+	}
+	return nil
+}
+
+// checkTerminationProtection rejects the termination of a workflow of a termination protected domain, unless the
+// caller overrides the protection.  The override is rejected by the auth middleware unless the authorizer grants it.
+func checkTerminationProtection(domainName string, config *persistence.DomainConfig, adminOverride bool) error {
+	if config.TerminationProtected && !adminOverride {
+		return &gen.BadRequestError{Message: fmt.Sprintf(
+			"Workflows of domain %v are protected from termination, an admin override is required.", domainName)}
+	}
+	return nil
+}
+
+// resolveTaskList returns the task list of a workflow started without one: the override of its type configured on
+// its domain, else the default task list of the domain, or "" if the domain has neither
+func resolveTaskList(config *persistence.DomainConfig, workflowType string) string {
+	if taskList := config.TaskListOverrides[workflowType]; taskList != "" {
+		return taskList
+	}
+	return config.DefaultTaskList
+}
+
+// validateStartTimeouts rejects the timeouts of a workflow to start which are longer than the configured maximums
+// or than the retention period of its domain
+func (wh *WorkflowHandler) validateStartTimeouts(startRequest *gen.StartWorkflowExecutionRequest,
+	config *persistence.DomainConfig) error {
+	executionTimeout := time.Duration(startRequest.GetExecutionStartToCloseTimeoutSeconds()) * time.Second
+	if wh.maxExecTimeout > 0 && executionTimeout > wh.maxExecTimeout {
+		return &gen.BadRequestError{Message: fmt.Sprintf(
+			"ExecutionStartToCloseTimeoutSeconds %v exceeds the maximum of %v seconds.",
+			startRequest.GetExecutionStartToCloseTimeoutSeconds(), int64(wh.maxExecTimeout.Seconds()))}
+	}
+
+	taskTimeout := time.Duration(startRequest.GetTaskStartToCloseTimeoutSeconds()) * time.Second
+	if wh.maxTaskTimeout > 0 && taskTimeout > wh.maxTaskTimeout {
+		return &gen.BadRequestError{Message: fmt.Sprintf(
+			"TaskStartToCloseTimeoutSeconds %v exceeds the maximum of %v seconds.",
+			startRequest.GetTaskStartToCloseTimeoutSeconds(), int64(wh.maxTaskTimeout.Seconds()))}
+	}
+
+	retention := time.Duration(config.Retention) * 24 * time.Hour
+	if retention > 0 && executionTimeout > retention {
+		return &gen.BadRequestError{Message: fmt.Sprintf(
+			"ExecutionStartToCloseTimeoutSeconds %v exceeds the retention period of the domain of %v days.",
+			startRequest.GetExecutionStartToCloseTimeoutSeconds(), config.Retention)}
+	}
+	return nil
+}
+
+// validateCompletionCallbackURL rejects the completion callback of a workflow to start which is not an absolute
+// http or https URL
+func validateCompletionCallbackURL(callbackURL string) error {
+	if callbackURL == "" {
+		return nil
+	}
+	u, err := url.Parse(callbackURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return &gen.BadRequestError{Message: fmt.Sprintf(
+			"CompletionCallbackUrl %v is not a valid http or https URL.", callbackURL)}
+	}
+	return nil
 }
 
 // GetWorkflowExecutionHistory - retrieves the hisotry of workflow execution
@@ -611,12 +1139,6 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 	getRequest *gen.GetWorkflowExecutionHistoryRequest) (*gen.GetWorkflowExecutionHistoryResponse, error) {
 
 	scope := metrics.FrontendGetWorkflowExecutionHistoryScope
-	sw := wh.startRequestProfile(scope)
-	defer sw.Stop()
-
-	if !getRequest.IsSetDomain() {
-		return nil, wh.error(errDomainNotSet, scope)
-	}
 
 	if !getRequest.IsSetExecution() {
 		return nil, wh.error(errExecutionNotSet, scope)
@@ -630,8 +1152,9 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 		return nil, wh.error(errInvalidRunID, scope)
 	}
 
-	if !getRequest.IsSetMaximumPageSize() || getRequest.GetMaximumPageSize() == 0 {
-		getRequest.MaximumPageSize = common.Int32Ptr(defaultHistoryMaxPageSize)
+	pageSize, err := getHistoryPageSize(getRequest.GetMaximumPageSize())
+	if err != nil {
+		return nil, wh.error(err, scope)
 	}
 
 	domainName := getRequest.GetDomain()
@@ -643,7 +1166,7 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 	token := &getHistoryContinuationToken{}
 	if getRequest.IsSetNextPageToken() {
 		token, err = deserializeGetHistoryToken(getRequest.GetNextPageToken())
-		if err != nil {
+		if err != nil || !token.matches(getRequest.GetExecution()) {
 			return nil, wh.error(errInvalidNextPageToken, scope)
 		}
 	} else {
@@ -652,8 +1175,8 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 			Execution:  getRequest.GetExecution(),
 		})
 		if err == nil {
-			token.nextEventID = response.GetEventId()
-			token.runID = response.GetRunId()
+			token.NextEventID = response.GetEventId()
+			token.RunID = response.GetRunId()
 		} else {
 			if _, ok := err.(*gen.EntityNotExistsError); !ok || !getRequest.GetExecution().IsSetRunId() {
 				return nil, wh.error(err, scope)
@@ -664,46 +1187,117 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 				DomainUUID: info.ID,
 				Execution:  *getRequest.GetExecution(),
 			})
-			if err != nil {
-				return nil, wh.error(err, scope)
+			if err == nil {
+				token.NextEventID = visibilityResp.Execution.GetHistoryLength()
+				token.RunID = visibilityResp.Execution.GetExecution().GetRunId()
+			} else {
+				// Once the retention period is over the history may only be left in the archive, which holds
+				// complete histories
+				if _, ok := err.(*gen.EntityNotExistsError); !ok || wh.historyArchive == nil {
+					return nil, wh.error(err, scope)
+				}
+				token.NextEventID = math.MaxInt64
+				token.RunID = getRequest.GetExecution().GetRunId()
+				token.Archived = true
 			}
-			token.nextEventID = visibilityResp.Execution.GetHistoryLength()
-			token.runID = visibilityResp.Execution.GetExecution().GetRunId()
 		}
 	}
 
 	we := gen.WorkflowExecution{
 		WorkflowId: getRequest.GetExecution().WorkflowId,
-		RunId:      common.StringPtr(token.runID),
+		RunId:      common.StringPtr(token.RunID),
 	}
 	history, persistenceToken, err :=
-		wh.getHistory(info.ID, we, token.nextEventID, getRequest.GetMaximumPageSize(), getRequest.GetNextPageToken())
+		wh.getHistory(info.ID, we, token.NextEventID, pageSize, token.PersistenceToken,
+			token.Archived)
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
 
-	nextToken, err := getSerializedGetHistoryToken(persistenceToken, token.runID, history, token.nextEventID)
+	nextToken, err := getSerializedGetHistoryToken(persistenceToken, token.RunID, history, token.NextEventID,
+		token.Archived)
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
 
-	return createGetWorkflowExecutionHistoryResponse(history, token.nextEventID, nextToken), nil
+	return createGetWorkflowExecutionHistoryResponse(history, token.NextEventID, nextToken), nil
 }
 
-// SignalWorkflowExecution is used to send a signal event to running workflow execution.  This results in
-// WorkflowExecutionSignaled event recorded in the history and a decision task being created for the execution.
-func (wh *WorkflowHandler) SignalWorkflowExecution(ctx thrift.Context,
-	signalRequest *gen.SignalWorkflowExecutionRequest) error {
+// GetWorkflowResult - waits until the run of a workflow execution closes and returns its close event.  If the run
+// is still open when the call has to return, the response only carries a continuation token to wait with again.
+func (wh *WorkflowHandler) GetWorkflowResult(ctx thrift.Context,
+	getRequest *gen.GetWorkflowResultRequest) (*gen.GetWorkflowResultResponse, error) {
 
-	scope := metrics.FrontendSignalWorkflowExecutionScope
-	sw := wh.startRequestProfile(scope)
-	defer sw.Stop()
+	scope := metrics.FrontendGetWorkflowResultScope
 
-	if !signalRequest.IsSetDomain() {
-		return wh.error(errDomainNotSet, scope)
+	if !getRequest.IsSetExecution() {
+		return nil, wh.error(errExecutionNotSet, scope)
 	}
 
-	if !signalRequest.IsSetWorkflowExecution() {
+	if !getRequest.GetExecution().IsSetWorkflowId() {
+		return nil, wh.error(errWorkflowIDNotSet, scope)
+	}
+
+	if getRequest.GetExecution().IsSetRunId() && uuid.Parse(getRequest.GetExecution().GetRunId()) == nil {
+		return nil, wh.error(errInvalidRunID, scope)
+	}
+
+	info, _, err := wh.domainCache.GetDomain(getRequest.GetDomain())
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	execution := gen.WorkflowExecution{
+		WorkflowId: getRequest.GetExecution().WorkflowId,
+		RunId:      getRequest.GetExecution().RunId,
+	}
+	if getRequest.IsSetNextPageToken() {
+		var token getWorkflowResultContinuationToken
+		if err := json.Unmarshal(getRequest.GetNextPageToken(), &token); err != nil || uuid.Parse(token.RunID) == nil {
+			return nil, wh.error(errInvalidNextPageToken, scope)
+		}
+		execution.RunId = common.StringPtr(token.RunID)
+	}
+
+	deadline := time.Now().Add(getWorkflowResultMaxWait)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Add(-getWorkflowResultDeadlineBuffer).Before(deadline) {
+		deadline = ctxDeadline.Add(-getWorkflowResultDeadlineBuffer)
+	}
+
+	for {
+		closeEvent, runID, err := wh.getCloseEvent(ctx, info.ID, execution)
+		if err != nil {
+			return nil, wh.error(err, scope)
+		}
+		if closeEvent != nil {
+			return &gen.GetWorkflowResultResponse{CloseEvent: closeEvent}, nil
+		}
+		execution.RunId = common.StringPtr(runID)
+
+		if time.Now().Add(getWorkflowResultPollInterval).After(deadline) {
+			token, err := json.Marshal(&getWorkflowResultContinuationToken{RunID: runID})
+			if err != nil {
+				return nil, wh.error(err, scope)
+			}
+			return &gen.GetWorkflowResultResponse{NextPageToken: token}, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, wh.error(ctx.Err(), scope)
+		case <-time.After(getWorkflowResultPollInterval):
+		}
+	}
+}
+
+// SignalWorkflowExecution is used to send a signal event to running workflow execution.  This results in
+// WorkflowExecutionSignaled event recorded in the history and a decision task being created for the execution.
+func (wh *WorkflowHandler) SignalWorkflowExecution(ctx thrift.Context,
+	signalRequest *gen.SignalWorkflowExecutionRequest) error {
+
+	scope := metrics.FrontendSignalWorkflowExecutionScope
+
+	if !signalRequest.IsSetWorkflowExecution() {
 		return wh.error(errExecutionNotSet, scope)
 	}
 
@@ -720,12 +1314,21 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(ctx thrift.Context,
 		return wh.error(&gen.BadRequestError{Message: "SignalName is not set on request."}, scope)
 	}
 
+	if err := wh.validateSignalPayloads(signalRequest.GetSignalName(), signalRequest.GetRequestId(),
+		signalRequest.GetInput()); err != nil {
+		return wh.error(err, scope)
+	}
+
 	domainName := signalRequest.GetDomain()
 	info, _, err := wh.domainCache.GetDomain(domainName)
 	if err != nil {
 		return wh.error(err, scope)
 	}
 
+	if signalRequest.Identity, err = wh.resolveIdentity(ctx, signalRequest.Identity); err != nil {
+		return wh.error(err, scope)
+	}
+
 	err = wh.history.SignalWorkflowExecution(ctx, &h.SignalWorkflowExecutionRequest{
 		DomainUUID:    common.StringPtr(info.ID),
 		SignalRequest: signalRequest,
@@ -737,18 +1340,81 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(ctx thrift.Context,
 	return nil
 }
 
+// validateSignalPayloads rejects the name, request ID and input of a signal which exceed the payload limits
+func (wh *WorkflowHandler) validateSignalPayloads(signalName, requestID string, input []byte) error {
+	limits := wh.getPayloadLimits()
+	if err := validateID("SignalName", signalName, limits.MaxIDLength); err != nil {
+		return err
+	}
+	if err := validateID("RequestId", requestID, limits.MaxIDLength); err != nil {
+		return err
+	}
+	return validatePayloadSize("Input", input, limits.MaxSignalInputSize)
+}
+
+// SignalWithStartWorkflowExecution is used to ensure sending a signal to a workflow.  If the workflow is running, this
+// results in WorkflowExecutionSignaled event recorded in the history and a decision task being created for the
+// execution.  If the workflow is not running or not found, this results in WorkflowExecutionStarted and
+// WorkflowExecutionSignaled events recorded in the history of a new run, and a decision task being created for it.
+func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx thrift.Context,
+	signalWithStartRequest *gen.SignalWithStartWorkflowExecutionRequest) (*gen.StartWorkflowExecutionResponse, error) {
+
+	scope := metrics.FrontendSignalWithStartWorkflowExecutionScope
+
+	wh.Service.GetLogger().Debugf("Received SignalWithStartWorkflowExecution. WorkflowID: %v",
+		signalWithStartRequest.GetWorkflowId())
+
+	if !signalWithStartRequest.IsSetSignalName() || signalWithStartRequest.GetSignalName() == "" {
+		return nil, wh.error(&gen.BadRequestError{Message: "SignalName is not set on request."}, scope)
+	}
+
+	if err := wh.validateSignalPayloads(signalWithStartRequest.GetSignalName(), "",
+		signalWithStartRequest.GetSignalInput()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	// The workflow started if it is not running is validated as by StartWorkflowExecution
+	startRequest := &gen.StartWorkflowExecutionRequest{
+		Domain:                              signalWithStartRequest.Domain,
+		WorkflowId:                          signalWithStartRequest.WorkflowId,
+		WorkflowType:                        signalWithStartRequest.WorkflowType,
+		TaskList:                            signalWithStartRequest.TaskList,
+		Input:                               signalWithStartRequest.Input,
+		RequestId:                           signalWithStartRequest.RequestId,
+		ExecutionStartToCloseTimeoutSeconds: signalWithStartRequest.ExecutionStartToCloseTimeoutSeconds,
+		TaskStartToCloseTimeoutSeconds:      signalWithStartRequest.TaskStartToCloseTimeoutSeconds,
+		SearchAttributes:                    signalWithStartRequest.SearchAttributes,
+		CompletionCallbackUrl:               signalWithStartRequest.CompletionCallbackUrl,
+	}
+	info, err := wh.validateStartRequest(startRequest)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+	signalWithStartRequest.TaskList = startRequest.TaskList
+
+	if signalWithStartRequest.Identity, err = wh.resolveIdentity(ctx, signalWithStartRequest.Identity); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	resp, err := wh.history.SignalWithStartWorkflowExecution(ctx, &h.SignalWithStartWorkflowExecutionRequest{
+		DomainUUID:             common.StringPtr(info.ID),
+		SignalWithStartRequest: signalWithStartRequest,
+	})
+	if err != nil {
+		wh.Service.GetLogger().Errorf("SignalWithStartWorkflowExecution failed. WorkflowID: %v. Error: %v",
+			signalWithStartRequest.GetWorkflowId(), err)
+		return nil, wh.error(err, scope)
+	}
+
+	return resp, nil
+}
+
 // TerminateWorkflowExecution terminates an existing workflow execution by recording WorkflowExecutionTerminated event
 // in the history and immediately terminating the execution instance.
 func (wh *WorkflowHandler) TerminateWorkflowExecution(ctx thrift.Context,
 	terminateRequest *gen.TerminateWorkflowExecutionRequest) error {
 
 	scope := metrics.FrontendTerminateWorkflowExecutionScope
-	sw := wh.startRequestProfile(scope)
-	defer sw.Stop()
-
-	if !terminateRequest.IsSetDomain() {
-		return wh.error(errDomainNotSet, scope)
-	}
 
 	if !terminateRequest.IsSetWorkflowExecution() {
 		return wh.error(errExecutionNotSet, scope)
@@ -764,11 +1430,19 @@ func (wh *WorkflowHandler) TerminateWorkflowExecution(ctx thrift.Context,
 	}
 
 	domainName := terminateRequest.GetDomain()
-	info, _, err := wh.domainCache.GetDomain(domainName)
+	info, config, err := wh.domainCache.GetDomain(domainName)
 	if err != nil {
 		return wh.error(err, scope)
 	}
 
+	if err := checkTerminationProtection(domainName, config, terminateRequest.GetAdminOverride()); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if terminateRequest.Identity, err = wh.resolveIdentity(ctx, terminateRequest.Identity); err != nil {
+		return wh.error(err, scope)
+	}
+
 	err = wh.history.TerminateWorkflowExecution(ctx, &h.TerminateWorkflowExecutionRequest{
 		DomainUUID:       common.StringPtr(info.ID),
 		TerminateRequest: terminateRequest,
@@ -780,18 +1454,117 @@ func (wh *WorkflowHandler) TerminateWorkflowExecution(ctx thrift.Context,
 	return nil
 }
 
+// ResetWorkflowExecution resets a workflow execution to the end of a decision task of one of its runs, by starting a
+// new run with the history of the run up to that point and terminating the run if it is still running.
+func (wh *WorkflowHandler) ResetWorkflowExecution(ctx thrift.Context,
+	resetRequest *gen.ResetWorkflowExecutionRequest) (*gen.ResetWorkflowExecutionResponse, error) {
+
+	scope := metrics.FrontendResetWorkflowExecutionScope
+
+	if !resetRequest.IsSetWorkflowExecution() {
+		return nil, wh.error(errExecutionNotSet, scope)
+	}
+
+	if !resetRequest.GetWorkflowExecution().IsSetWorkflowId() {
+		return nil, wh.error(errWorkflowIDNotSet, scope)
+	}
+
+	if resetRequest.GetWorkflowExecution().IsSetRunId() &&
+		uuid.Parse(resetRequest.GetWorkflowExecution().GetRunId()) == nil {
+		return nil, wh.error(errInvalidRunID, scope)
+	}
+
+	if resetRequest.GetDecisionFinishEventId() <= common.FirstEventID {
+		return nil, wh.error(&gen.BadRequestError{
+			Message: "A valid DecisionFinishEventId is not set on request."}, scope)
+	}
+
+	if !resetRequest.IsSetRequestId() {
+		resetRequest.RequestId = common.StringPtr(uuid.New())
+	}
+
+	domainName := resetRequest.GetDomain()
+	info, _, err := wh.domainCache.GetDomain(domainName)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if resetRequest.Identity, err = wh.resolveIdentity(ctx, resetRequest.Identity); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	resp, err := wh.history.ResetWorkflowExecution(ctx, &h.ResetWorkflowExecutionRequest{
+		DomainUUID:   common.StringPtr(info.ID),
+		ResetRequest: resetRequest,
+	})
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	return resp, nil
+}
+
+// QueryWorkflow returns the result of a query of a workflow execution.  The query is answered by a worker polling the
+// decision task list of the execution, which replays the history of the execution to do so.
+func (wh *WorkflowHandler) QueryWorkflow(ctx thrift.Context,
+	queryRequest *gen.QueryWorkflowRequest) (*gen.QueryWorkflowResponse, error) {
+
+	scope := metrics.FrontendQueryWorkflowScope
+
+	if !queryRequest.IsSetExecution() {
+		return nil, wh.error(errExecutionNotSet, scope)
+	}
+
+	if !queryRequest.GetExecution().IsSetWorkflowId() {
+		return nil, wh.error(errWorkflowIDNotSet, scope)
+	}
+
+	if queryRequest.GetExecution().IsSetRunId() && uuid.Parse(queryRequest.GetExecution().GetRunId()) == nil {
+		return nil, wh.error(errInvalidRunID, scope)
+	}
+
+	if !queryRequest.IsSetQuery() || queryRequest.GetQuery().GetQueryType() == "" {
+		return nil, wh.error(&gen.BadRequestError{Message: "QueryType is not set on request."}, scope)
+	}
+
+	domainName := queryRequest.GetDomain()
+	info, _, err := wh.domainCache.GetDomain(domainName)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	// The history service resolves the run to query and the decision task list its workers poll
+	response, err := wh.history.GetWorkflowExecutionNextEventID(ctx, &h.GetWorkflowExecutionNextEventIDRequest{
+		DomainUUID: common.StringPtr(info.ID),
+		Execution:  queryRequest.GetExecution(),
+	})
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	matchingRequest := *queryRequest
+	matchingRequest.Execution = &gen.WorkflowExecution{
+		WorkflowId: queryRequest.GetExecution().WorkflowId,
+		RunId:      response.RunId,
+	}
+	resp, err := wh.matching.QueryWorkflow(ctx, &m.QueryWorkflowRequest{
+		DomainUUID:   common.StringPtr(info.ID),
+		TaskList:     response.GetTasklist(),
+		QueryRequest: &matchingRequest,
+	})
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	return resp, nil
+}
+
 // RequestCancelWorkflowExecution - requests to cancel a workflow execution
 func (wh *WorkflowHandler) RequestCancelWorkflowExecution(
 	ctx thrift.Context,
 	cancelRequest *gen.RequestCancelWorkflowExecutionRequest) error {
 
 	scope := metrics.FrontendRequestCancelWorkflowExecutionScope
-	sw := wh.startRequestProfile(scope)
-	defer sw.Stop()
-
-	if !cancelRequest.IsSetDomain() {
-		return wh.error(errDomainNotSet, scope)
-	}
 
 	if !cancelRequest.IsSetWorkflowExecution() {
 		return wh.error(errExecutionNotSet, scope)
@@ -809,12 +1582,20 @@ func (wh *WorkflowHandler) RequestCancelWorkflowExecution(
 		return wh.error(errInvalidRunID, scope)
 	}
 
+	if cancelRequest.IsSetTerminateAfterSeconds() && cancelRequest.GetTerminateAfterSeconds() <= 0 {
+		return wh.error(errInvalidTerminateAfterSeconds, scope)
+	}
+
 	domainName := cancelRequest.GetDomain()
 	info, _, err := wh.domainCache.GetDomain(domainName)
 	if err != nil {
 		return wh.error(err, scope)
 	}
 
+	if cancelRequest.Identity, err = wh.resolveIdentity(ctx, cancelRequest.Identity); err != nil {
+		return wh.error(err, scope)
+	}
+
 	err = wh.history.RequestCancelWorkflowExecution(ctx, &h.RequestCancelWorkflowExecutionRequest{
 		DomainUUID:    common.StringPtr(info.ID),
 		CancelRequest: cancelRequest,
@@ -831,12 +1612,6 @@ func (wh *WorkflowHandler) ListOpenWorkflowExecutions(ctx thrift.Context,
 	listRequest *gen.ListOpenWorkflowExecutionsRequest) (*gen.ListOpenWorkflowExecutionsResponse, error) {
 
 	scope := metrics.FrontendListOpenWorkflowExecutionsScope
-	sw := wh.startRequestProfile(scope)
-	defer sw.Stop()
-
-	if !listRequest.IsSetDomain() {
-		return nil, wh.error(errDomainNotSet, scope)
-	}
 
 	if !listRequest.IsSetStartTimeFilter() {
 		return nil, wh.error(&gen.BadRequestError{Message: "StartTimeFilter is required"}, scope)
@@ -904,12 +1679,6 @@ func (wh *WorkflowHandler) ListClosedWorkflowExecutions(ctx thrift.Context,
 	listRequest *gen.ListClosedWorkflowExecutionsRequest) (*gen.ListClosedWorkflowExecutionsResponse, error) {
 
 	scope := metrics.FrontendListClosedWorkflowExecutionsScope
-	sw := wh.startRequestProfile(scope)
-	defer sw.Stop()
-
-	if !listRequest.IsSetDomain() {
-		return nil, wh.error(errDomainNotSet, scope)
-	}
 
 	if !listRequest.IsSetStartTimeFilter() {
 		return nil, wh.error(&gen.BadRequestError{Message: "StartTimeFilter is required"}, scope)
@@ -988,21 +1757,308 @@ func (wh *WorkflowHandler) ListClosedWorkflowExecutions(ctx thrift.Context,
 	return resp, nil
 }
 
+// ScanWorkflowExecutions - scans all workflow executions of a domain for bulk exports
+func (wh *WorkflowHandler) ScanWorkflowExecutions(ctx thrift.Context,
+	listRequest *gen.ScanWorkflowExecutionsRequest) (*gen.ScanWorkflowExecutionsResponse, error) {
+
+	scope := metrics.FrontendScanWorkflowExecutionsScope
+
+	if !listRequest.IsSetMaximumPageSize() || listRequest.GetMaximumPageSize() == 0 {
+		listRequest.MaximumPageSize = common.Int32Ptr(defaultVisibilityMaxPageSize)
+	}
+
+	domainName := listRequest.GetDomain()
+	domainInfo, _, err := wh.domainCache.GetDomain(domainName)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	persistenceResp, err := wh.visibitiltyMgr.ScanWorkflowExecutions(&persistence.ScanWorkflowExecutionsRequest{
+		DomainUUID:    domainInfo.ID,
+		PageSize:      int(listRequest.GetMaximumPageSize()),
+		NextPageToken: listRequest.GetNextPageToken(),
+	})
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	resp := gen.NewScanWorkflowExecutionsResponse()
+	resp.Executions = persistenceResp.Executions
+	resp.NextPageToken = persistenceResp.NextPageToken
+	return resp, nil
+}
+
+// ListWorkflowExecutionsWithQuery - retrieves info for the open and closed workflow executions in a domain which match
+// a query
+func (wh *WorkflowHandler) ListWorkflowExecutionsWithQuery(ctx thrift.Context,
+	listRequest *gen.ListWorkflowExecutionsWithQueryRequest) (*gen.ListWorkflowExecutionsWithQueryResponse, error) {
+
+	scope := metrics.FrontendListWorkflowExecutionsWithQueryScope
+
+	if !listRequest.IsSetMaximumPageSize() || listRequest.GetMaximumPageSize() == 0 {
+		listRequest.MaximumPageSize = common.Int32Ptr(defaultVisibilityMaxPageSize)
+	}
+
+	domainName := listRequest.GetDomain()
+	domainInfo, _, err := wh.domainCache.GetDomain(domainName)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	persistenceResp, err := wh.visibitiltyMgr.ListWorkflowExecutionsWithQuery(
+		&persistence.ListWorkflowExecutionsWithQueryRequest{
+			DomainUUID:    domainInfo.ID,
+			Query:         listRequest.GetQuery(),
+			PageSize:      int(listRequest.GetMaximumPageSize()),
+			NextPageToken: listRequest.GetNextPageToken(),
+		})
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	resp := gen.NewListWorkflowExecutionsWithQueryResponse()
+	resp.Executions = persistenceResp.Executions
+	resp.NextPageToken = persistenceResp.NextPageToken
+	return resp, nil
+}
+
+// StartBatchOperation starts signaling, canceling or terminating all the open executions of a domain which match a
+// visibility query, at a limited rate.  It requires the Elasticsearch visibility store, which is the only one able to
+// query the executions.
+func (wh *WorkflowHandler) StartBatchOperation(ctx thrift.Context,
+	startRequest *gen.StartBatchOperationRequest) (*gen.StartBatchOperationResponse, error) {
+
+	scope := metrics.FrontendStartBatchOperationScope
+
+	if !wh.esVisibility {
+		return nil, wh.error(errBatchQueryUnsupported, scope)
+	}
+
+	if startRequest.GetQuery() == "" {
+		return nil, wh.error(&gen.BadRequestError{Message: "Query is not set on request."}, scope)
+	}
+
+	if !startRequest.IsSetOperationType() {
+		return nil, wh.error(&gen.BadRequestError{Message: "OperationType is not set on request."}, scope)
+	}
+
+	if startRequest.GetOperationType() == gen.BatchOperationType_SIGNAL {
+		if startRequest.GetSignalName() == "" {
+			return nil, wh.error(&gen.BadRequestError{Message: "SignalName is not set on request."}, scope)
+		}
+		if err := wh.validateSignalPayloads(startRequest.GetSignalName(), "",
+			startRequest.GetSignalInput()); err != nil {
+			return nil, wh.error(err, scope)
+		}
+	}
+
+	rps, err := getBatchRPS(startRequest.GetRequestsPerSecond())
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	domainName := startRequest.GetDomain()
+	info, config, err := wh.domainCache.GetDomain(domainName)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	// A batch cannot override the termination protection of a domain
+	if startRequest.GetOperationType() == gen.BatchOperationType_TERMINATE {
+		if err := checkTerminationProtection(domainName, config, false); err != nil {
+			return nil, wh.error(err, scope)
+		}
+	}
+
+	if startRequest.Identity, err = wh.resolveIdentity(ctx, startRequest.Identity); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	batchID, err := wh.batcher.start(info.ID, startRequest, rps)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	return &gen.StartBatchOperationResponse{BatchId: common.StringPtr(batchID)}, nil
+}
+
+// DescribeBatchOperation returns the state and the progress of a batch operation started on any frontend host
+func (wh *WorkflowHandler) DescribeBatchOperation(ctx thrift.Context,
+	describeRequest *gen.DescribeBatchOperationRequest) (*gen.DescribeBatchOperationResponse, error) {
+
+	scope := metrics.FrontendDescribeBatchOperationScope
+
+	if describeRequest.GetBatchId() == "" {
+		return nil, wh.error(errBatchIDNotSet, scope)
+	}
+
+	info, _, err := wh.domainCache.GetDomain(describeRequest.GetDomain())
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	response, err := wh.batcher.describe(info.ID, describeRequest.GetBatchId())
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+	return response, nil
+}
+
+// StopBatchOperation stops a running batch operation started on any frontend host
+func (wh *WorkflowHandler) StopBatchOperation(ctx thrift.Context, stopRequest *gen.StopBatchOperationRequest) error {
+
+	scope := metrics.FrontendStopBatchOperationScope
+
+	if stopRequest.GetBatchId() == "" {
+		return wh.error(errBatchIDNotSet, scope)
+	}
+
+	info, _, err := wh.domainCache.GetDomain(stopRequest.GetDomain())
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	if err := wh.batcher.stopBatch(info.ID, stopRequest.GetBatchId(), stopRequest.GetReason()); err != nil {
+		return wh.error(err, scope)
+	}
+	return nil
+}
+
+// CountOpenWorkflowExecutions - counts the open workflow executions in a domain
+func (wh *WorkflowHandler) CountOpenWorkflowExecutions(ctx thrift.Context,
+	countRequest *gen.CountOpenWorkflowExecutionsRequest) (*gen.CountOpenWorkflowExecutionsResponse, error) {
+
+	scope := metrics.FrontendCountOpenWorkflowExecutionsScope
+
+	if err := validateStartTimeFilter(countRequest.GetStartTimeFilter()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if countRequest.IsSetExecutionFilter() && countRequest.IsSetTypeFilter() {
+		return nil, wh.error(&gen.BadRequestError{
+			Message: "Only one of ExecutionFilter or TypeFilter is allowed"}, scope)
+	}
+
+	domainInfo, _, err := wh.domainCache.GetDomain(countRequest.GetDomain())
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	persistenceReq := &persistence.CountWorkflowExecutionsRequest{
+		DomainUUID:        domainInfo.ID,
+		EarliestStartTime: countRequest.GetStartTimeFilter().GetEarliestTime(),
+		LatestStartTime:   countRequest.GetStartTimeFilter().GetLatestTime(),
+	}
+	if countRequest.IsSetExecutionFilter() {
+		persistenceReq.WorkflowID = countRequest.ExecutionFilter.GetWorkflowId()
+	}
+	if countRequest.IsSetTypeFilter() {
+		persistenceReq.WorkflowTypeName = countRequest.TypeFilter.GetName()
+	}
+
+	persistenceResp, err := wh.visibitiltyMgr.CountOpenWorkflowExecutions(persistenceReq)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	resp := gen.NewCountOpenWorkflowExecutionsResponse()
+	resp.Count = common.Int64Ptr(persistenceResp.Count)
+	return resp, nil
+}
+
+// CountClosedWorkflowExecutions - counts the closed workflow executions in a domain
+func (wh *WorkflowHandler) CountClosedWorkflowExecutions(ctx thrift.Context,
+	countRequest *gen.CountClosedWorkflowExecutionsRequest) (*gen.CountClosedWorkflowExecutionsResponse, error) {
+
+	scope := metrics.FrontendCountClosedWorkflowExecutionsScope
+
+	if err := validateStartTimeFilter(countRequest.GetStartTimeFilter()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	filterCount := 0
+	if countRequest.IsSetExecutionFilter() {
+		filterCount++
+	}
+	if countRequest.IsSetTypeFilter() {
+		filterCount++
+	}
+	if countRequest.IsSetStatusFilter() {
+		filterCount++
+	}
+
+	if filterCount > 1 {
+		return nil, wh.error(&gen.BadRequestError{
+			Message: "Only one of ExecutionFilter, TypeFilter or StatusFilter is allowed"}, scope)
+	}
+
+	domainInfo, _, err := wh.domainCache.GetDomain(countRequest.GetDomain())
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	persistenceReq := &persistence.CountWorkflowExecutionsRequest{
+		DomainUUID:        domainInfo.ID,
+		EarliestStartTime: countRequest.GetStartTimeFilter().GetEarliestTime(),
+		LatestStartTime:   countRequest.GetStartTimeFilter().GetLatestTime(),
+		Status:            countRequest.StatusFilter,
+	}
+	if countRequest.IsSetExecutionFilter() {
+		persistenceReq.WorkflowID = countRequest.ExecutionFilter.GetWorkflowId()
+	}
+	if countRequest.IsSetTypeFilter() {
+		persistenceReq.WorkflowTypeName = countRequest.TypeFilter.GetName()
+	}
+
+	persistenceResp, err := wh.visibitiltyMgr.CountClosedWorkflowExecutions(persistenceReq)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	resp := gen.NewCountClosedWorkflowExecutionsResponse()
+	resp.Count = common.Int64Ptr(persistenceResp.Count)
+	return resp, nil
+}
+
+// validateStartTimeFilter checks that both bounds of the start time filter of a visibility request are set
+func validateStartTimeFilter(filter *gen.StartTimeFilter) error {
+	if filter == nil {
+		return &gen.BadRequestError{Message: "StartTimeFilter is required"}
+	}
+
+	if !filter.IsSetEarliestTime() {
+		return &gen.BadRequestError{Message: "EarliestTime in StartTimeFilter is required"}
+	}
+
+	if !filter.IsSetLatestTime() {
+		return &gen.BadRequestError{Message: "LatestTime in StartTimeFilter is required"}
+	}
+
+	return nil
+}
+
 func (wh *WorkflowHandler) getHistory(domainID string, execution gen.WorkflowExecution,
-	nextEventID int64, pageSize int32, nextPageToken []byte) (*gen.History, []byte, error) {
+	nextEventID int64, pageSize int32, nextPageToken []byte, archived bool) (*gen.History, []byte, error) {
 
 	if nextPageToken == nil {
 		nextPageToken = []byte{}
 	}
 	historyEvents := []*gen.HistoryEvent{}
 
-	response, err := wh.historyMgr.GetWorkflowExecutionHistory(&persistence.GetWorkflowExecutionHistoryRequest{
+	request := &persistence.GetWorkflowExecutionHistoryRequest{
 		DomainID:      domainID,
 		Execution:     execution,
 		NextEventID:   nextEventID,
 		PageSize:      int(pageSize),
 		NextPageToken: nextPageToken,
-	})
+	}
+	var response *persistence.GetWorkflowExecutionHistoryResponse
+	var err error
+	if archived {
+		response, err = wh.historyArchive.GetWorkflowExecutionHistory(request)
+	} else {
+		response, err = wh.historyMgr.GetWorkflowExecutionHistory(request)
+	}
 
 	if err != nil {
 		return nil, nil, err
@@ -1025,6 +2081,58 @@ func (wh *WorkflowHandler) getHistory(domainID string, execution gen.WorkflowExe
 	return executionHistory, nextPageToken, nil
 }
 
+// getCloseEvent returns the close event of the run of the execution, or nil if the run is still open.  The run ID is
+// resolved to the current run if the execution has none.
+func (wh *WorkflowHandler) getCloseEvent(ctx thrift.Context, domainID string,
+	execution gen.WorkflowExecution) (*gen.HistoryEvent, string, error) {
+
+	var nextEventID int64
+	response, err := wh.history.GetWorkflowExecutionNextEventID(ctx, &h.GetWorkflowExecutionNextEventIDRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  &execution,
+	})
+	if err == nil {
+		execution.RunId = common.StringPtr(response.GetRunId())
+		nextEventID = response.GetEventId()
+	} else if _, ok := err.(*gen.EntityNotExistsError); !ok || !execution.IsSetRunId() {
+		return nil, "", err
+	}
+
+	// The run has closed once it is recorded as closed by visibility
+	visibilityResp, err := wh.visibitiltyMgr.GetClosedWorkflowExecution(&persistence.GetClosedWorkflowExecutionRequest{
+		DomainUUID: domainID,
+		Execution:  execution,
+	})
+	if err != nil {
+		if _, ok := err.(*gen.EntityNotExistsError); ok && nextEventID != 0 {
+			return nil, execution.GetRunId(), nil
+		}
+		return nil, "", err
+	}
+	if nextEventID == 0 {
+		nextEventID = visibilityResp.Execution.GetHistoryLength() + 1
+	}
+
+	var closeEvent *gen.HistoryEvent
+	var token []byte
+	for {
+		history, nextToken, err := wh.getHistory(domainID, execution, nextEventID, defaultHistoryMaxPageSize, token,
+			false)
+		if err != nil {
+			return nil, "", err
+		}
+		if events := history.GetEvents(); len(events) > 0 {
+			closeEvent = events[len(events)-1]
+		}
+		if len(nextToken) == 0 {
+			break
+		}
+		token = nextToken
+	}
+
+	return closeEvent, execution.GetRunId(), nil
+}
+
 // sets the version and encoding types to defaults if they
 // are missing from persistence. This is purely for backwards
 // compatibility
@@ -1050,35 +2158,15 @@ func (wh *WorkflowHandler) getLoggerForTask(taskToken []byte) bark.Logger {
 	return logger
 }
 
-// startRequestProfile initiates recording of request metrics
-func (wh *WorkflowHandler) startRequestProfile(scope int) tally.Stopwatch {
+// dispatchRequest is the end of the middleware chain, it calls the API the request is for
+func (wh *WorkflowHandler) dispatchRequest(ctx thrift.Context, request *Request) (athrift.TStruct, error) {
 	wh.startWG.Wait()
-	sw := wh.metricsClient.StartTimer(scope, metrics.CadenceLatency)
-	wh.metricsClient.IncCounter(scope, metrics.CadenceRequests)
-	return sw
+	return request.dispatch(ctx)
 }
 
 func (wh *WorkflowHandler) error(err error, scope int) error {
-	switch err.(type) {
-	case *gen.InternalServiceError:
-		wh.metricsClient.IncCounter(scope, metrics.CadenceFailures)
-		return err
-	case *gen.BadRequestError:
-		wh.metricsClient.IncCounter(scope, metrics.CadenceErrBadRequestCounter)
-		return err
-	case *gen.EntityNotExistsError:
-		wh.metricsClient.IncCounter(scope, metrics.CadenceErrEntityNotExistsCounter)
-		return err
-	case *gen.WorkflowExecutionAlreadyStartedError:
-		wh.metricsClient.IncCounter(scope, metrics.CadenceErrExecutionAlreadyStartedCounter)
-		return err
-	case *gen.DomainAlreadyExistsError:
-		wh.metricsClient.IncCounter(scope, metrics.CadenceErrDomainAlreadyExistsCounter)
-		return err
-	default:
-		wh.metricsClient.IncCounter(scope, metrics.CadenceFailures)
-		return &gen.InternalServiceError{Message: err.Error()}
-	}
+	wh.metricsClient.IncCounter(scope, errors.MetricCounter(errors.CodeOf(err)))
+	return errors.ToThrift(err)
 }
 
 func getDomainStatus(info *persistence.DomainInfo) *gen.DomainStatus {
@@ -1094,6 +2182,29 @@ func getDomainStatus(info *persistence.DomainInfo) *gen.DomainStatus {
 	return nil
 }
 
+// getClusterCapabilities returns the features supported by this version of the server
+func getClusterCapabilities() *gen.ClusterCapabilities {
+	capabilities := gen.NewClusterCapabilities()
+	capabilities.SupportsStickyQuery = common.BoolPtr(false)
+	capabilities.SupportsSignalWithStart = common.BoolPtr(true)
+	capabilities.SupportsRawHistory = common.BoolPtr(false)
+	capabilities.MaxBlobSizeBytes = common.Int64Ptr(common.ExecutionUpdateSizeLimit)
+	return capabilities
+}
+
+func getDomainChangeType(change *persistence.DomainChange) *gen.DomainChangeType {
+	switch change.ChangeType {
+	case persistence.DomainChangeTypeRegistered:
+		return gen.DomainChangeTypePtr(gen.DomainChangeType_REGISTERED)
+	case persistence.DomainChangeTypeUpdated:
+		return gen.DomainChangeTypePtr(gen.DomainChangeType_UPDATED)
+	case persistence.DomainChangeTypeDeprecated:
+		return gen.DomainChangeTypePtr(gen.DomainChangeType_DEPRECATED)
+	}
+
+	return nil
+}
+
 func createDomainResponse(info *persistence.DomainInfo, config *persistence.DomainConfig) (*gen.DomainInfo,
 	*gen.DomainConfiguration) {
 
@@ -1106,6 +2217,10 @@ func createDomainResponse(info *persistence.DomainInfo, config *persistence.Doma
 	c := gen.NewDomainConfiguration()
 	c.EmitMetric = common.BoolPtr(config.EmitMetric)
 	c.WorkflowExecutionRetentionPeriodInDays = common.Int32Ptr(config.Retention)
+	c.ArchivalEnabled = common.BoolPtr(config.ArchivalEnabled)
+	c.DefaultTaskList = common.StringPtr(config.DefaultTaskList)
+	c.TaskListOverrides = config.TaskListOverrides
+	c.TerminationProtected = common.BoolPtr(config.TerminationProtected)
 
 	return i, c
 }
@@ -1119,6 +2234,7 @@ func createPollForDecisionTaskResponse(
 		resp.WorkflowType = matchingResponse.WorkflowType
 		resp.PreviousStartedEventId = matchingResponse.PreviousStartedEventId
 		resp.StartedEventId = matchingResponse.StartedEventId
+		resp.Query = matchingResponse.Query
 	}
 	resp.History = history
 	resp.NextPageToken = nextPageToken
@@ -1140,7 +2256,26 @@ func deserializeGetHistoryToken(data []byte) (*getHistoryContinuationToken, erro
 	return &token, err
 }
 
-func getSerializedGetHistoryToken(persistenceToken []byte, runID string, history *gen.History, nextEventID int64) ([]byte, error) {
+// matches tells whether the token continues the read of the history of the execution, a token of a run being
+// rejected for any other run as its persistence token is only meaningful to the run it was issued for
+func (t *getHistoryContinuationToken) matches(execution *gen.WorkflowExecution) bool {
+	return t.RunID != "" && (!execution.IsSetRunId() || execution.GetRunId() == t.RunID)
+}
+
+// getHistoryPageSize returns the number of history batches to read for a page, an unset page size reading the
+// default one and larger ones being capped to bound the memory a page takes in the frontend
+func getHistoryPageSize(requested int32) (int32, error) {
+	if requested < 0 {
+		return 0, errInvalidPageSize
+	}
+	if requested == 0 || requested > defaultHistoryMaxPageSize {
+		return defaultHistoryMaxPageSize, nil
+	}
+	return requested, nil
+}
+
+func getSerializedGetHistoryToken(persistenceToken []byte, runID string, history *gen.History, nextEventID int64,
+	archived bool) ([]byte, error) {
 	// create token if there are more events to read
 	if history == nil {
 		return nil, nil
@@ -1148,9 +2283,10 @@ func getSerializedGetHistoryToken(persistenceToken []byte, runID string, history
 	events := history.GetEvents()
 	if len(persistenceToken) > 0 && len(events) > 0 && events[len(events)-1].GetEventId() < nextEventID-1 {
 		token := &getHistoryContinuationToken{
-			runID:            runID,
-			nextEventID:      nextEventID,
-			persistenceToken: persistenceToken,
+			RunID:            runID,
+			NextEventID:      nextEventID,
+			PersistenceToken: persistenceToken,
+			Archived:         archived,
 		}
 		data, err := json.Marshal(token)
 
@@ -21,10 +21,31 @@
 package frontend
 
 import (
+	"bytes"
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/Sirupsen/logrus"
+	athrift "github.com/apache/thrift/lib/go/thrift"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+	"github.com/uber-go/tally"
+	h "github.com/uber/cadence/.gen/go/history"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/searchattribute"
+	"github.com/uber/cadence/common/service/config"
+	"github.com/uber/tchannel-go/thrift"
 )
 
 type HandlerTestSuite struct {
@@ -45,3 +66,883 @@ func (s *HandlerTestSuite) TestHealthEndpoint() {
 	assert.NoError(s.T(), err, "Health check shouldn't return error")
 	assert.True(s.T(), healthy, "Health check needs to work")
 }
+
+func (s *HandlerTestSuite) TestAccessLogToggle() {
+	accessLog := newAccessLog(bark.NewLoggerFromLogrus(logrus.New()))
+	assert.False(s.T(), accessLog.isEnabled(), "Access log must be disabled by default")
+	accessLog.setEnabled(true)
+	assert.True(s.T(), accessLog.isEnabled())
+	accessLog.setEnabled(false)
+	assert.False(s.T(), accessLog.isEnabled())
+}
+
+func (s *HandlerTestSuite) TestAccessLogMiddleware() {
+	buf := new(bytes.Buffer)
+	logger := logrus.New()
+	logger.Out = buf
+	logger.Formatter = &logrus.JSONFormatter{}
+	accessLog := newAccessLog(bark.NewLoggerFromLogrus(logger))
+	limiter := &rateLimiter{}
+	chain := s.newChainWithAccessLog(accessLog, limiter, quotas.NewDomainRateLimiter(0, nil, common.NewRealTimeSource()))
+
+	_, err := chain(nil, s.newRequest("test-domain", func() {}))
+	assert.NoError(s.T(), err)
+	assert.Empty(s.T(), buf.String(), "Access log must be disabled by default")
+
+	// The requests rejected by the other middlewares are logged as well
+	accessLog.setEnabled(true)
+	limiter.setRPS(1)
+	requests := 0
+	for ; requests < 20 && err == nil; requests++ {
+		_, err = chain(nil, s.newRequest("test-domain", func() {}))
+	}
+	assert.Equal(s.T(), errRateLimited, err)
+
+	entries := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Equal(s.T(), requests, len(entries))
+	assert.Contains(s.T(), entries[0], `"api-name":"DescribeDomain"`)
+	assert.Contains(s.T(), entries[0], `"domain-name":"test-domain"`)
+	assert.Contains(s.T(), entries[0], `"status":"ok"`)
+	assert.Contains(s.T(), entries[requests-1], `"status":"*shared.ServiceBusyError"`)
+}
+
+func (s *HandlerTestSuite) TestAccessLogThriftSize() {
+	var nilRequest *gen.DescribeDomainRequest
+	assert.Equal(s.T(), 0, thriftSize(nil))
+	assert.Equal(s.T(), 0, thriftSize(nilRequest))
+	assert.True(s.T(), thriftSize(&gen.DescribeDomainRequest{Name: common.StringPtr("test-domain")}) > 0)
+}
+
+func (s *HandlerTestSuite) TestMiddlewareChainOrder() {
+	var calls []string
+	RegisterMiddleware(func(next Handler) Handler {
+		return func(ctx thrift.Context, request *Request) (athrift.TStruct, error) {
+			calls = append(calls, "registered")
+			return next(ctx, request)
+		}
+	})
+	defer func() {
+		middlewareLock.Lock()
+		registeredMiddlewares = nil
+		middlewareLock.Unlock()
+	}()
+
+	chain := s.newChain(&rateLimiter{})
+	_, err := chain(nil, s.newRequest("test-domain", func() {
+		calls = append(calls, "dispatch")
+	}))
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), []string{"registered", "dispatch"}, calls)
+}
+
+func (s *HandlerTestSuite) TestMiddlewareValidation() {
+	dispatched := false
+	chain := s.newChain(&rateLimiter{})
+	_, err := chain(nil, s.newRequest("", func() {
+		dispatched = true
+	}))
+	assert.Equal(s.T(), errDomainNotSet, err)
+	assert.False(s.T(), dispatched, "Invalid request must not be dispatched")
+
+	request := s.newRequest("test-domain", func() {
+		dispatched = true
+	})
+	request.TraceID = strings.Repeat("t", maxTraceIDLength+1)
+	_, err = chain(nil, request)
+	assert.IsType(s.T(), &gen.BadRequestError{}, err)
+	assert.False(s.T(), dispatched, "Invalid request must not be dispatched")
+
+	request.TraceID = strings.Repeat("t", maxTraceIDLength)
+	_, err = chain(nil, request)
+	assert.NoError(s.T(), err)
+	assert.True(s.T(), dispatched)
+}
+
+func (s *HandlerTestSuite) TestMiddlewareRateLimit() {
+	limiter := &rateLimiter{}
+	limiter.setRPS(1)
+	chain := s.newChain(limiter)
+
+	var err error
+	for i := 0; i < 20 && err == nil; i++ {
+		_, err = chain(nil, s.newRequest("test-domain", func() {}))
+	}
+	assert.Equal(s.T(), errRateLimited, err)
+
+	limiter.setRPS(0)
+	_, err = chain(nil, s.newRequest("test-domain", func() {}))
+	assert.NoError(s.T(), err)
+}
+
+func (s *HandlerTestSuite) TestMiddlewareRateLimitPriority() {
+	limiter := &rateLimiter{}
+	limiter.setRPS(100)
+	chain := s.newChain(limiter)
+
+	ctx, cancel := thrift.NewContext(time.Second)
+	defer cancel()
+	batchCtx := thrift.WithHeaders(ctx, map[string]string{common.PriorityHeaderName: "batch"})
+
+	var err error
+	for i := 0; i < 20 && err == nil; i++ {
+		_, err = chain(batchCtx, s.newRequest("test-domain", func() {}))
+	}
+	assert.Equal(s.T(), errRateLimited, err)
+
+	// the batch requests only get a share of the limit, leaving room for the interactive ones
+	_, err = chain(ctx, s.newRequest("test-domain", func() {}))
+	assert.NoError(s.T(), err)
+}
+
+func (s *HandlerTestSuite) TestMiddlewareDomainRateLimit() {
+	domainLimiter := quotas.NewDomainRateLimiter(0, map[string]int{"busy-domain": 1}, common.NewRealTimeSource())
+	chain := s.newChainWithDomainLimit(&rateLimiter{}, domainLimiter)
+
+	var err error
+	for i := 0; i < 20 && err == nil; i++ {
+		_, err = chain(nil, s.newRequest("busy-domain", func() {}))
+	}
+	assert.Equal(s.T(), errDomainRateLimited, err)
+
+	// the other domains are not limited
+	_, err = chain(nil, s.newRequest("test-domain", func() {}))
+	assert.NoError(s.T(), err)
+}
+
+func (s *HandlerTestSuite) TestClaimAuthorizer() {
+	key := []byte("test-key")
+	now := time.Now()
+	authorizer := NewClaimAuthorizer(key, fixedTimeSource{now: now})
+	RegisterAuthorizer(authorizer)
+	defer RegisterAuthorizer(allowAllAuthorizer{})
+	chain := s.newChain(&rateLimiter{})
+
+	ctx, cancel := thrift.NewContext(time.Second)
+	defer cancel()
+	withClaims := func(claims *Claims) thrift.Context {
+		token, err := SignClaims(claims, key)
+		assert.NoError(s.T(), err)
+		return thrift.WithHeaders(ctx, map[string]string{AuthorizationHeaderName: token})
+	}
+
+	_, err := chain(ctx, s.newRequest("test-domain", func() {}))
+	assert.Equal(s.T(), errClaimsNotSet, err)
+
+	reader := withClaims(&Claims{Subject: "reader", Domains: map[string]Permission{"test-domain": PermissionRead}})
+	_, err = chain(reader, s.newRequest("test-domain", func() {}))
+	assert.NoError(s.T(), err)
+	_, err = chain(reader, s.newRequest("other-domain", func() {}))
+	assert.IsType(s.T(), &gen.BadRequestError{}, err)
+	assert.Error(s.T(), authorizer.Authorize(reader, &Request{API: "StartWorkflowExecution", Domain: "test-domain"}))
+	assert.NoError(s.T(), authorizer.Authorize(reader, &Request{API: "RespondDecisionTaskCompleted"}))
+
+	writer := withClaims(&Claims{Subject: "writer", Domains: map[string]Permission{"test-domain": PermissionWrite}})
+	assert.NoError(s.T(), authorizer.Authorize(writer, &Request{API: "StartWorkflowExecution", Domain: "test-domain"}))
+	assert.Error(s.T(), authorizer.Authorize(writer, &Request{API: "UpdateDomain", Domain: "test-domain"}))
+
+	terminate := &Request{API: "TerminateWorkflowExecution", Domain: "test-domain",
+		Request: &gen.TerminateWorkflowExecutionRequest{Domain: common.StringPtr("test-domain")}}
+	assert.NoError(s.T(), authorizer.Authorize(writer, terminate))
+	override := &Request{API: "TerminateWorkflowExecution", Domain: "test-domain",
+		Request: &gen.TerminateWorkflowExecutionRequest{Domain: common.StringPtr("test-domain"),
+			AdminOverride: common.BoolPtr(true)}}
+	assert.NoError(s.T(), authorizer.Authorize(writer, override))
+	assert.Error(s.T(), authorizer.AuthorizeAdminOverride(writer, override))
+	_, err = chain(writer, s.newOverrideRequest())
+	assert.IsType(s.T(), &gen.BadRequestError{}, err)
+
+	admin := withClaims(&Claims{Subject: "admin", Admin: true})
+	assert.NoError(s.T(), authorizer.Authorize(admin, &Request{API: "UpdateDomain", Domain: "test-domain"}))
+	assert.NoError(s.T(), authorizer.AuthorizeAdminOverride(admin, override))
+	_, err = chain(admin, s.newOverrideRequest())
+	assert.NoError(s.T(), err)
+
+	expired := withClaims(&Claims{Subject: "admin", Admin: true, ExpiresAt: now.Add(-time.Minute).Unix()})
+	assert.Equal(s.T(), errClaimsExpired, authorizer.Authorize(expired, &Request{API: "DescribeDomain"}))
+
+	token, err := SignClaims(&Claims{Subject: "admin", Admin: true}, []byte("other-key"))
+	assert.NoError(s.T(), err)
+	forged := thrift.WithHeaders(ctx, map[string]string{AuthorizationHeaderName: token})
+	assert.Equal(s.T(), errClaimsInvalid, authorizer.Authorize(forged, &Request{API: "DescribeDomain"}))
+}
+
+func (s *HandlerTestSuite) TestConfigWatcherAccessLog() {
+	handler := s.newConfigWatcherHandler()
+	svcCfg := &config.Service{}
+	var loadErr error
+	load := func() (*config.Config, *config.Service, error) {
+		return &config.Config{}, svcCfg, loadErr
+	}
+	watcher := newConfigWatcher(handler, load, time.Second, runtimeSettings{},
+		bark.NewLoggerFromLogrus(logrus.New()))
+
+	svcCfg.AccessLog.Enabled = true
+	watcher.reload()
+	assert.True(s.T(), handler.accessLog.isEnabled())
+
+	// the settings in force are kept when the config fails to load
+	svcCfg.AccessLog.Enabled = false
+	loadErr = errors.New("invalid config")
+	watcher.reload()
+	assert.True(s.T(), handler.accessLog.isEnabled())
+
+	loadErr = nil
+	watcher.reload()
+	assert.False(s.T(), handler.accessLog.isEnabled())
+}
+
+func (s *HandlerTestSuite) TestConfigWatcherSettings() {
+	handler := s.newConfigWatcherHandler()
+	cfg := &config.Config{}
+	svcCfg := &config.Service{}
+	load := func() (*config.Config, *config.Service, error) {
+		return cfg, svcCfg, nil
+	}
+	watcher := newConfigWatcher(handler, load, time.Second, runtimeSettings{},
+		bark.NewLoggerFromLogrus(logrus.New()))
+	// every 100ms the buckets allow a tenth of the limit of the domain
+	allowed := func(domain string) int {
+		n := 0
+		for i := 0; i < 10; i++ {
+			if handler.domainRateLimiter.Allow(domain) {
+				n++
+			}
+		}
+		return n
+	}
+	customerID := &gen.SearchAttributes{IndexedFields: map[string][]byte{"CustomerID": []byte(`"customer"`)}}
+	assert.Error(s.T(), handler.getSearchAttributes().Validate(customerID))
+
+	svcCfg.RateLimit = config.RateLimit{DomainRPS: 10, Domains: map[string]int{"busy-domain": 30}}
+	svcCfg.PayloadLimits = config.PayloadLimits{MaxIDLength: 100}
+	cfg.SearchAttributes = map[string]string{"CustomerID": "Keyword"}
+	watcher.reload()
+	assert.Equal(s.T(), 1, allowed("domain"))
+	assert.Equal(s.T(), 3, allowed("busy-domain"))
+	assert.Equal(s.T(), svcCfg.PayloadLimits, handler.getPayloadLimits())
+	assert.NoError(s.T(), handler.getSearchAttributes().Validate(customerID))
+
+	// none of the settings are applied when the search attributes are invalid
+	svcCfg.PayloadLimits = config.PayloadLimits{MaxIDLength: 200}
+	cfg.SearchAttributes = map[string]string{"CustomerID": "Uuid"}
+	watcher.reload()
+	assert.Equal(s.T(), 100, handler.getPayloadLimits().MaxIDLength)
+	assert.NoError(s.T(), handler.getSearchAttributes().Validate(customerID))
+
+	cfg.SearchAttributes = nil
+	watcher.reload()
+	assert.Equal(s.T(), 200, handler.getPayloadLimits().MaxIDLength)
+	assert.Error(s.T(), handler.getSearchAttributes().Validate(customerID))
+}
+
+func (s *HandlerTestSuite) TestConfigWatcherRateLimit() {
+	handler := s.newConfigWatcherHandler()
+	svcCfg := &config.Service{}
+	load := func() (*config.Config, *config.Service, error) {
+		return &config.Config{}, svcCfg, nil
+	}
+	watcher := newConfigWatcher(handler, load, time.Second, runtimeSettings{},
+		bark.NewLoggerFromLogrus(logrus.New()))
+
+	svcCfg.RateLimit = config.RateLimit{RPS: 40, GlobalRPS: 100}
+	watcher.reload()
+	assert.Equal(s.T(), 40, handler.rateLimiter.rps)
+	assert.Equal(s.T(), 40, handler.hostRPS)
+	assert.Equal(s.T(), 100, handler.globalRPS)
+
+	svcCfg.RateLimit = config.RateLimit{}
+	watcher.reload()
+	assert.Equal(s.T(), 0, handler.rateLimiter.rps)
+	assert.Equal(s.T(), 0, handler.globalRPS)
+}
+
+func (s *HandlerTestSuite) TestConfigWatcherStop() {
+	reloaded := make(chan struct{}, 1)
+	load := func() (*config.Config, *config.Service, error) {
+		select {
+		case reloaded <- struct{}{}:
+		default:
+		}
+		return &config.Config{}, &config.Service{}, nil
+	}
+	watcher := newConfigWatcher(s.newConfigWatcherHandler(), load, time.Millisecond, runtimeSettings{},
+		bark.NewLoggerFromLogrus(logrus.New()))
+	watcher.start()
+	select {
+	case <-reloaded:
+	case <-time.After(time.Second):
+		s.Fail("The config was not reloaded")
+	}
+	watcher.stop()
+}
+
+func (s *HandlerTestSuite) newConfigWatcherHandler() *WorkflowHandler {
+	return &WorkflowHandler{
+		accessLog:         newAccessLog(bark.NewLoggerFromLogrus(logrus.New())),
+		rateLimiter:       &rateLimiter{},
+		domainRateLimiter: quotas.NewDomainRateLimiter(0, nil, fixedTimeSource{now: time.Now()}),
+		searchAttributes:  searchattribute.NewValidator(searchattribute.NewRegistry(searchattribute.DefaultKeys)),
+	}
+}
+
+func (s *HandlerTestSuite) TestMiddlewareAdminOverride() {
+	chain := s.newChain(&rateLimiter{})
+	_, err := chain(nil, s.newOverrideRequest())
+	assert.Equal(s.T(), errAdminOverrideDenied, err)
+}
+
+func (s *HandlerTestSuite) TestRateLimitCoordinator() {
+	resolver := &mocks.ServiceResolver{}
+	limiter := &rateLimiter{}
+	metricsClient := metrics.NewClient(tally.NoopScope, metrics.Frontend)
+	domainLimiter := quotas.NewDomainRateLimiter(0, nil, common.NewRealTimeSource())
+	coordinator := newRateLimitCoordinator(limiter, domainLimiter, resolver, 100, 40, 0, nil,
+		bark.NewLoggerFromLogrus(logrus.New()), metricsClient)
+
+	resolver.On("MemberCount").Return(3).Once()
+	coordinator.update()
+	assert.Equal(s.T(), 34, limiter.rps)
+
+	resolver.On("MemberCount").Return(0).Once()
+	coordinator.update()
+	assert.Equal(s.T(), 40, limiter.rps)
+
+	resolver.On("MemberCount").Return(4).Once()
+	coordinator.update()
+	assert.Equal(s.T(), 25, limiter.rps)
+
+	// the limit of the host caps its share of the global limit
+	resolver.On("MemberCount").Return(2).Once()
+	coordinator.update()
+	assert.Equal(s.T(), 40, limiter.rps)
+
+	resolver.On("MemberCount").Return(2).Once()
+	coordinator.setHostRPS(0)
+	assert.Equal(s.T(), 50, limiter.rps)
+
+	resolver.On("MemberCount").Return(2).Once()
+	coordinator.setGlobalRPS(60)
+	assert.Equal(s.T(), 30, limiter.rps)
+	resolver.AssertExpectations(s.T())
+}
+
+func (s *HandlerTestSuite) TestRateLimitCoordinatorDomainShare() {
+	resolver := &mocks.ServiceResolver{}
+	limiter := &rateLimiter{}
+	metricsClient := metrics.NewClient(tally.NoopScope, metrics.Frontend)
+	domainLimiter := quotas.NewDomainRateLimiter(0, nil, fixedTimeSource{now: time.Now()})
+	coordinator := newRateLimitCoordinator(limiter, domainLimiter, resolver, 0, 40, 60,
+		map[string]int{"busy-domain": 90}, bark.NewLoggerFromLogrus(logrus.New()), metricsClient)
+
+	// every 100ms the buckets allow a tenth of the share of the domain
+	allowed := func(domain string) int {
+		n := 0
+		for i := 0; i < 10; i++ {
+			if domainLimiter.Allow(domain) {
+				n++
+			}
+		}
+		return n
+	}
+
+	resolver.On("MemberCount").Return(3).Once()
+	coordinator.update()
+	assert.Equal(s.T(), 40, limiter.rps)
+	assert.Equal(s.T(), 2, allowed("domain"))
+	assert.Equal(s.T(), 3, allowed("busy-domain"))
+
+	// the limits of the domains are enforced in full while the membership is unknown
+	resolver.On("MemberCount").Return(0).Once()
+	coordinator.update()
+	assert.Equal(s.T(), 6, allowed("domain"))
+	assert.Equal(s.T(), 9, allowed("busy-domain"))
+
+	resolver.On("MemberCount").Return(2).Once()
+	coordinator.setDomainRPS(0, map[string]int{"busy-domain": 40})
+	assert.Equal(s.T(), 10, allowed("domain"))
+	assert.Equal(s.T(), 2, allowed("busy-domain"))
+	resolver.AssertExpectations(s.T())
+}
+
+func (s *HandlerTestSuite) newChain(limiter *rateLimiter) Handler {
+	return s.newChainWithDomainLimit(limiter, quotas.NewDomainRateLimiter(0, nil, common.NewRealTimeSource()))
+}
+
+func (s *HandlerTestSuite) newChainWithDomainLimit(limiter *rateLimiter, domainLimiter *quotas.DomainRateLimiter) Handler {
+	return s.newChainWithAccessLog(newAccessLog(bark.NewLoggerFromLogrus(logrus.New())), limiter, domainLimiter)
+}
+
+func (s *HandlerTestSuite) newChainWithAccessLog(accessLog *accessLog, limiter *rateLimiter,
+	domainLimiter *quotas.DomainRateLimiter) Handler {
+	metricsClient := metrics.NewClient(tally.NoopScope, metrics.Frontend)
+	return buildMiddlewareChain(accessLog, limiter, domainLimiter, metricsClient, func(ctx thrift.Context,
+		request *Request) (athrift.TStruct, error) {
+		return request.dispatch(ctx)
+	})
+}
+
+func (s *HandlerTestSuite) newRequest(domain string, onDispatch func()) *Request {
+	request := &gen.DescribeDomainRequest{Name: common.StringPtr(domain)}
+	return &Request{
+		API:          "DescribeDomain",
+		Scope:        metrics.FrontendDescribeDomainScope,
+		Domain:       domain,
+		Request:      request,
+		domainScoped: true,
+		dispatch: func(ctx thrift.Context) (athrift.TStruct, error) {
+			onDispatch()
+			return &gen.DescribeDomainResponse{}, nil
+		},
+	}
+}
+
+func (s *HandlerTestSuite) newOverrideRequest() *Request {
+	request := &gen.TerminateWorkflowExecutionRequest{Domain: common.StringPtr("test-domain"),
+		AdminOverride: common.BoolPtr(true)}
+	return &Request{
+		API:          "TerminateWorkflowExecution",
+		Scope:        metrics.FrontendTerminateWorkflowExecutionScope,
+		Domain:       "test-domain",
+		Request:      request,
+		domainScoped: true,
+		dispatch: func(ctx thrift.Context) (athrift.TStruct, error) {
+			return nil, nil
+		},
+	}
+}
+
+func (s *HandlerTestSuite) TestValidateStartTimeouts() {
+	request := &gen.StartWorkflowExecutionRequest{
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(2 * 24 * 3600),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(60),
+	}
+	assert.NoError(s.T(), s.Handler.validateStartTimeouts(request, &persistence.DomainConfig{Retention: 2}))
+	assert.NoError(s.T(), s.Handler.validateStartTimeouts(request, &persistence.DomainConfig{Retention: 0}))
+
+	err := s.Handler.validateStartTimeouts(request, &persistence.DomainConfig{Retention: 1})
+	assert.IsType(s.T(), &gen.BadRequestError{}, err)
+
+	s.Handler.SetMaxWorkflowTimeouts(24*time.Hour, time.Minute)
+	err = s.Handler.validateStartTimeouts(request, &persistence.DomainConfig{Retention: 7})
+	assert.IsType(s.T(), &gen.BadRequestError{}, err)
+
+	request.ExecutionStartToCloseTimeoutSeconds = common.Int32Ptr(3600)
+	assert.NoError(s.T(), s.Handler.validateStartTimeouts(request, &persistence.DomainConfig{Retention: 7}))
+
+	request.TaskStartToCloseTimeoutSeconds = common.Int32Ptr(61)
+	err = s.Handler.validateStartTimeouts(request, &persistence.DomainConfig{Retention: 7})
+	assert.IsType(s.T(), &gen.BadRequestError{}, err)
+}
+
+func (s *HandlerTestSuite) TestValidateCompletionCallbackURL() {
+	assert.NoError(s.T(), validateCompletionCallbackURL(""))
+	assert.NoError(s.T(), validateCompletionCallbackURL("http://localhost:8080/completed"))
+	assert.NoError(s.T(), validateCompletionCallbackURL("https://example.com/hooks/cadence?token=abc"))
+
+	for _, callbackURL := range []string{"localhost:8080", "/completed", "ftp://example.com/completed", "http://"} {
+		assert.IsType(s.T(), &gen.BadRequestError{}, validateCompletionCallbackURL(callbackURL), callbackURL)
+	}
+}
+
+func (s *HandlerTestSuite) TestValidateID() {
+	assert.NoError(s.T(), validateID("WorkflowId", "", 0))
+	assert.NoError(s.T(), validateID("WorkflowId", "order-42/retry", 0))
+	assert.NoError(s.T(), validateID("WorkflowId", "commande-été", 16))
+
+	for _, id := range []string{"order-42-retry-3", "order\n42", "order\x0042", "order\xff42"} {
+		assert.IsType(s.T(), &gen.BadRequestError{}, validateID("WorkflowId", id, 10), id)
+	}
+	err := validateID("WorkflowId", "order-42-retry-3", 10)
+	assert.Equal(s.T(), "WorkflowId length 16 exceeds the limit of 10.", err.(*gen.BadRequestError).Message)
+}
+
+func (s *HandlerTestSuite) TestValidatePayloadSize() {
+	assert.NoError(s.T(), validatePayloadSize("Input", nil, 4))
+	assert.NoError(s.T(), validatePayloadSize("Input", make([]byte, 100), 0))
+	assert.NoError(s.T(), validatePayloadSize("Input", make([]byte, 4), 4))
+
+	err := validatePayloadSize("Input", make([]byte, 5), 4)
+	assert.IsType(s.T(), &gen.BadRequestError{}, err)
+	assert.Equal(s.T(), "Input size 5 exceeds the limit of 4 bytes.", err.(*gen.BadRequestError).Message)
+}
+
+func (s *HandlerTestSuite) TestGetHistoryPageSize() {
+	for requested, expected := range map[int32]int32{0: defaultHistoryMaxPageSize, 1: 1, 250: 250,
+		defaultHistoryMaxPageSize + 1: defaultHistoryMaxPageSize} {
+		pageSize, err := getHistoryPageSize(requested)
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), expected, pageSize, "%v", requested)
+	}
+
+	_, err := getHistoryPageSize(-1)
+	assert.Equal(s.T(), errInvalidPageSize, err)
+}
+
+func (s *HandlerTestSuite) TestGetHistoryTokenMatches() {
+	runID := "3b6d5ad5-4b8e-4bd3-9f1c-4d4a5e8a9b0c"
+	token := &getHistoryContinuationToken{RunID: runID, NextEventID: 42, PersistenceToken: []byte{1}}
+	assert.True(s.T(), token.matches(&gen.WorkflowExecution{WorkflowId: common.StringPtr("order-42")}))
+	assert.True(s.T(), token.matches(&gen.WorkflowExecution{WorkflowId: common.StringPtr("order-42"),
+		RunId: common.StringPtr(runID)}))
+	assert.False(s.T(), token.matches(&gen.WorkflowExecution{WorkflowId: common.StringPtr("order-42"),
+		RunId: common.StringPtr("c7f0a3a2-1e4b-4a7e-8d0b-2f6c1e9d7a11")}))
+	assert.False(s.T(), (&getHistoryContinuationToken{}).matches(&gen.WorkflowExecution{}))
+}
+
+func (s *HandlerTestSuite) TestDescribeDomainUpdate() {
+	info := &persistence.DomainInfo{Name: "domain", Description: "desc", OwnerEmail: "owner@example.com"}
+	config := &persistence.DomainConfig{Retention: 7, EmitMetric: true}
+	assert.Empty(s.T(), describeDomainUpdate(info, config, info, config))
+
+	updatedInfo := *info
+	updatedInfo.OwnerEmail = "team@example.com"
+	changes := describeDomainUpdate(info, config, &updatedInfo, config)
+	assert.Equal(s.T(), []string{`ownerEmail: "owner@example.com" -> "team@example.com"`}, changes)
+
+	updatedConfig := *config
+	updatedConfig.Retention = 3
+	changes = describeDomainUpdate(info, config, info, &updatedConfig)
+	assert.Len(s.T(), changes, 3)
+	assert.Equal(s.T(), "workflowExecutionRetentionPeriodInDays: 7 -> 3", changes[0])
+
+	updatedConfig.Retention = 10
+	changes = describeDomainUpdate(info, config, info, &updatedConfig)
+	assert.Len(s.T(), changes, 2)
+
+	updatedConfig = *config
+	updatedConfig.ArchivalEnabled = true
+	changes = describeDomainUpdate(info, config, info, &updatedConfig)
+	assert.Len(s.T(), changes, 2)
+	assert.Equal(s.T(), "archivalEnabled: false -> true", changes[0])
+
+	updatedConfig = *config
+	updatedConfig.DefaultTaskList = "tl"
+	updatedConfig.TaskListOverrides = map[string]string{"wt": "tl-wt"}
+	changes = describeDomainUpdate(info, config, info, &updatedConfig)
+	assert.Len(s.T(), changes, 3)
+	assert.Equal(s.T(), `defaultTaskList: "" -> "tl"`, changes[0])
+	assert.Equal(s.T(), "taskListOverrides: map[] -> map[wt:tl-wt]", changes[1])
+
+	config.TaskListOverrides = map[string]string{}
+	updatedConfig = *config
+	updatedConfig.TaskListOverrides = nil
+	assert.Empty(s.T(), describeDomainUpdate(info, config, info, &updatedConfig))
+
+	updatedConfig = *config
+	updatedConfig.TerminationProtected = true
+	changes = describeDomainUpdate(info, config, info, &updatedConfig)
+	assert.Len(s.T(), changes, 2)
+	assert.Equal(s.T(), "terminationProtected: false -> true", changes[0])
+}
+
+func (s *HandlerTestSuite) TestCheckTerminationProtection() {
+	config := &persistence.DomainConfig{}
+	assert.NoError(s.T(), checkTerminationProtection("domain", config, false))
+
+	config.TerminationProtected = true
+	assert.NoError(s.T(), checkTerminationProtection("domain", config, true))
+	err := checkTerminationProtection("domain", config, false)
+	assert.IsType(s.T(), &gen.BadRequestError{}, err)
+	assert.Equal(s.T(), "Workflows of domain domain are protected from termination, an admin override is required.",
+		err.(*gen.BadRequestError).Message)
+}
+
+func (s *HandlerTestSuite) TestResolveTaskList() {
+	config := &persistence.DomainConfig{}
+	assert.Equal(s.T(), "", resolveTaskList(config, "wt"))
+
+	config.DefaultTaskList = "tl"
+	assert.Equal(s.T(), "tl", resolveTaskList(config, "wt"))
+
+	config.TaskListOverrides = map[string]string{"wt": "tl-wt", "empty": ""}
+	assert.Equal(s.T(), "tl-wt", resolveTaskList(config, "wt"))
+	assert.Equal(s.T(), "tl", resolveTaskList(config, "other"))
+	assert.Equal(s.T(), "tl", resolveTaskList(config, "empty"))
+}
+
+func (s *HandlerTestSuite) TestGetClusterInfo() {
+	resp, err := s.Handler.GetClusterInfo(nil, gen.NewGetClusterInfoRequest())
+	assert.NoError(s.T(), err)
+	capabilities := resp.GetCapabilities()
+	assert.True(s.T(), capabilities.GetSupportsSignalWithStart())
+	assert.True(s.T(), capabilities.IsSetSupportsRawHistory(), "Unsupported features must be reported as well")
+	assert.False(s.T(), capabilities.GetSupportsRawHistory())
+	assert.Equal(s.T(), int64(common.ExecutionUpdateSizeLimit), capabilities.GetMaxBlobSizeBytes())
+}
+
+func (s *HandlerTestSuite) TestResolveIdentity() {
+	ctx, cancel := thrift.NewContext(time.Second)
+	defer cancel()
+
+	identity, err := s.Handler.resolveIdentity(ctx, common.StringPtr("worker-1"))
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "worker-1", *identity)
+
+	// outside of a tchannel call there is no peer address to fall back to
+	identity, err = s.Handler.resolveIdentity(ctx, nil)
+	assert.NoError(s.T(), err)
+	assert.Nil(s.T(), identity)
+
+	s.Handler.SetIdentityRequired(true)
+	_, err = s.Handler.resolveIdentity(ctx, common.StringPtr(""))
+	assert.Equal(s.T(), errIdentityNotSet, err)
+	identity, err = s.Handler.resolveIdentity(ctx, common.StringPtr("worker-1"))
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "worker-1", *identity)
+}
+
+func (s *HandlerTestSuite) TestBatcher() {
+	visibilityMgr := &mocks.VisibilityManager{}
+	historyClient := &mocks.HistoryClient{}
+	b := newBatcher(newBatchStore(), visibilityMgr, historyClient, common.NewRealTimeSource(),
+		bark.NewLoggerFromLogrus(logrus.New()), metrics.NewClient(tally.NoopScope, metrics.Frontend))
+	defer b.stop()
+
+	open := func(workflowID string) *gen.WorkflowExecutionInfo {
+		return &gen.WorkflowExecutionInfo{Execution: &gen.WorkflowExecution{
+			WorkflowId: common.StringPtr(workflowID), RunId: common.StringPtr(workflowID + "-run")}}
+	}
+	closed := open("closed")
+	closed.CloseStatus = gen.WorkflowExecutionCloseStatusPtr(gen.WorkflowExecutionCloseStatus_COMPLETED)
+	visibilityMgr.On("ListWorkflowExecutionsWithQuery", mock.MatchedBy(
+		func(request *persistence.ListWorkflowExecutionsWithQueryRequest) bool {
+			return len(request.NextPageToken) == 0
+		})).Return(&persistence.ListWorkflowExecutionsResponse{
+		Executions:    []*gen.WorkflowExecutionInfo{open("terminated"), closed, open("gone")},
+		NextPageToken: []byte{1},
+	}, nil)
+	visibilityMgr.On("ListWorkflowExecutionsWithQuery", mock.MatchedBy(
+		func(request *persistence.ListWorkflowExecutionsWithQueryRequest) bool {
+			return len(request.NextPageToken) > 0
+		})).Return(&persistence.ListWorkflowExecutionsResponse{
+		Executions: []*gen.WorkflowExecutionInfo{open("failing")},
+	}, nil)
+	terminated := func(workflowID string) interface{} {
+		return mock.MatchedBy(func(request *h.TerminateWorkflowExecutionRequest) bool {
+			return request.GetDomainUUID() == "domain-id" &&
+				request.GetTerminateRequest().GetWorkflowExecution().GetWorkflowId() == workflowID
+		})
+	}
+	historyClient.On("TerminateWorkflowExecution", mock.Anything, terminated("terminated")).Return(nil).Once()
+	historyClient.On("TerminateWorkflowExecution", mock.Anything, terminated("gone")).
+		Return(&gen.EntityNotExistsError{}).Once()
+	historyClient.On("TerminateWorkflowExecution", mock.Anything, terminated("failing")).
+		Return(&gen.InternalServiceError{}).Once()
+
+	batchID, err := b.start("domain-id", &gen.StartBatchOperationRequest{
+		Domain:        common.StringPtr("domain"),
+		Query:         common.StringPtr("WorkflowType = 'order'"),
+		OperationType: gen.BatchOperationTypePtr(gen.BatchOperationType_TERMINATE),
+		Reason:        common.StringPtr("cleanup"),
+	}, batchMaxRPS)
+	assert.NoError(s.T(), err)
+
+	response := s.waitForBatch(b, "domain-id", batchID)
+	assert.Equal(s.T(), gen.BatchOperationState_COMPLETED, response.GetState())
+	assert.Equal(s.T(), int64(1), response.GetSucceededCount())
+	assert.Equal(s.T(), int64(2), response.GetSkippedCount())
+	assert.Equal(s.T(), int64(1), response.GetFailedCount())
+	assert.Equal(s.T(), "cleanup", response.GetReason())
+	historyClient.AssertExpectations(s.T())
+
+	_, err = b.describe("other-domain-id", batchID)
+	assert.Equal(s.T(), errBatchNotFound, err)
+	assert.Equal(s.T(), errBatchNotFound, b.stopBatch("domain-id", "unknown", ""))
+}
+
+func (s *HandlerTestSuite) TestBatcherStop() {
+	visibilityMgr := &mocks.VisibilityManager{}
+	historyClient := &mocks.HistoryClient{}
+	b := newBatcher(newBatchStore(), visibilityMgr, historyClient, common.NewRealTimeSource(),
+		bark.NewLoggerFromLogrus(logrus.New()), metrics.NewClient(tally.NoopScope, metrics.Frontend))
+	defer b.stop()
+
+	executions := []*gen.WorkflowExecutionInfo{}
+	for i := 0; i < 100; i++ {
+		executions = append(executions, &gen.WorkflowExecutionInfo{Execution: &gen.WorkflowExecution{
+			WorkflowId: common.StringPtr("order"), RunId: common.StringPtr("run")}})
+	}
+	visibilityMgr.On("ListWorkflowExecutionsWithQuery", mock.Anything).Return(
+		&persistence.ListWorkflowExecutionsResponse{Executions: executions}, nil)
+	historyClient.On("SignalWorkflowExecution", mock.Anything, mock.Anything).Return(nil)
+
+	batchID, err := b.start("domain-id", &gen.StartBatchOperationRequest{
+		Query:         common.StringPtr("WorkflowType = 'order'"),
+		OperationType: gen.BatchOperationTypePtr(gen.BatchOperationType_SIGNAL),
+		SignalName:    common.StringPtr("cancel-order"),
+	}, 1)
+	assert.NoError(s.T(), err)
+	assert.NoError(s.T(), b.stopBatch("domain-id", batchID, "wrong query"))
+
+	response := s.waitForBatch(b, "domain-id", batchID)
+	assert.Equal(s.T(), gen.BatchOperationState_STOPPED, response.GetState())
+	assert.Equal(s.T(), "Stopped: wrong query", response.GetCloseReason())
+	assert.True(s.T(), response.GetSucceededCount() < 100)
+	assert.NoError(s.T(), b.stopBatch("domain-id", batchID, "again"))
+}
+
+func (s *HandlerTestSuite) TestBatcherAcrossHosts() {
+	store := newBatchStore()
+	visibilityMgr := &mocks.VisibilityManager{}
+	historyClient := &mocks.HistoryClient{}
+	timeSource := &shiftedTimeSource{}
+	running := newBatcher(store, visibilityMgr, historyClient, timeSource, bark.NewLoggerFromLogrus(logrus.New()),
+		metrics.NewClient(tally.NoopScope, metrics.Frontend))
+	defer running.stop()
+	other := newBatcher(store, visibilityMgr, historyClient, timeSource, bark.NewLoggerFromLogrus(logrus.New()),
+		metrics.NewClient(tally.NoopScope, metrics.Frontend))
+	defer other.stop()
+
+	executions := []*gen.WorkflowExecutionInfo{}
+	for i := 0; i < 100; i++ {
+		executions = append(executions, &gen.WorkflowExecutionInfo{Execution: &gen.WorkflowExecution{
+			WorkflowId: common.StringPtr("order"), RunId: common.StringPtr("run")}})
+	}
+	visibilityMgr.On("ListWorkflowExecutionsWithQuery", mock.Anything).Return(
+		&persistence.ListWorkflowExecutionsResponse{Executions: executions}, nil)
+	historyClient.On("SignalWorkflowExecution", mock.Anything, mock.Anything).Return(nil)
+
+	batchID, err := running.start("domain-id", &gen.StartBatchOperationRequest{
+		Domain:        common.StringPtr("domain"),
+		Query:         common.StringPtr("WorkflowType = 'order'"),
+		OperationType: gen.BatchOperationTypePtr(gen.BatchOperationType_SIGNAL),
+		SignalName:    common.StringPtr("cancel-order"),
+	}, 1)
+	assert.NoError(s.T(), err)
+
+	// The other host describes the batch from its record, and records the request to stop it
+	response, err := other.describe("domain-id", batchID)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), gen.BatchOperationState_RUNNING, response.GetState())
+	assert.NoError(s.T(), other.stopBatch("domain-id", batchID, "wrong query"))
+
+	// The host running the batch reads the request at its next checkpoint
+	timeSource.shift(batchCheckpointInterval)
+	response = s.waitForBatch(other, "domain-id", batchID)
+	assert.Equal(s.T(), gen.BatchOperationState_STOPPED, response.GetState())
+	assert.Equal(s.T(), "Stopped: wrong query", response.GetCloseReason())
+	assert.True(s.T(), response.GetSucceededCount() < 100)
+}
+
+func (s *HandlerTestSuite) TestBatcherOrphaned() {
+	store := newBatchStore()
+	timeSource := &shiftedTimeSource{}
+	b := newBatcher(store, &mocks.VisibilityManager{}, &mocks.HistoryClient{}, timeSource,
+		bark.NewLoggerFromLogrus(logrus.New()), metrics.NewClient(tally.NoopScope, metrics.Frontend))
+	defer b.stop()
+
+	updateTime := timeSource.Now()
+	store.CreateBatchOperation(&persistence.CreateBatchOperationRequest{Info: &persistence.BatchOperationInfo{
+		ID:         "batch-id",
+		DomainID:   "domain-id",
+		State:      int(gen.BatchOperationState_RUNNING),
+		StartTime:  updateTime,
+		UpdateTime: updateTime,
+	}})
+
+	response, err := b.describe("domain-id", "batch-id")
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), gen.BatchOperationState_RUNNING, response.GetState())
+
+	// The batch stopped recording its progress, the host running it is gone
+	timeSource.shift(batchOrphanTimeout + time.Second)
+	response, err = b.describe("domain-id", "batch-id")
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), gen.BatchOperationState_FAILED, response.GetState())
+	assert.Equal(s.T(), updateTime.UnixNano(), response.GetCloseTime())
+}
+
+func (s *HandlerTestSuite) waitForBatch(b *batcher, domainID, batchID string) *gen.DescribeBatchOperationResponse {
+	for i := 0; i < 100; i++ {
+		response, err := b.describe(domainID, batchID)
+		s.Require().NoError(err)
+		if response.GetState() != gen.BatchOperationState_RUNNING {
+			return response
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	s.FailNow("batch operation is still running")
+	return nil
+}
+
+func (s *HandlerTestSuite) TestGetBatchRPS() {
+	for requested, expected := range map[int32]int{0: batchDefaultRPS, 1: 1, 200: 200, batchMaxRPS + 1: batchMaxRPS} {
+		rps, err := getBatchRPS(requested)
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), expected, rps, "%v", requested)
+	}
+
+	_, err := getBatchRPS(-1)
+	assert.IsType(s.T(), &gen.BadRequestError{}, err)
+}
+
+// batchStore records the batch operations in memory
+type batchStore struct {
+	*mocks.MetadataManager
+	sync.Mutex
+	batches map[string]persistence.BatchOperationInfo
+}
+
+func newBatchStore() *batchStore {
+	return &batchStore{
+		MetadataManager: &mocks.MetadataManager{},
+		batches:         make(map[string]persistence.BatchOperationInfo),
+	}
+}
+
+func (s *batchStore) CreateBatchOperation(request *persistence.CreateBatchOperationRequest) error {
+	s.Lock()
+	defer s.Unlock()
+	s.batches[request.Info.ID] = *request.Info
+	return nil
+}
+
+func (s *batchStore) UpdateBatchOperation(request *persistence.UpdateBatchOperationRequest) error {
+	s.Lock()
+	defer s.Unlock()
+	info := *request.Info
+	info.StopReason = s.batches[info.ID].StopReason
+	s.batches[info.ID] = info
+	return nil
+}
+
+func (s *batchStore) StopBatchOperation(request *persistence.StopBatchOperationRequest) error {
+	s.Lock()
+	defer s.Unlock()
+	info := s.batches[request.ID]
+	info.StopReason = request.Reason
+	s.batches[request.ID] = info
+	return nil
+}
+
+func (s *batchStore) GetBatchOperation(request *persistence.GetBatchOperationRequest) (
+	*persistence.GetBatchOperationResponse, error) {
+	s.Lock()
+	defer s.Unlock()
+	info, ok := s.batches[request.ID]
+	if !ok {
+		return nil, &gen.EntityNotExistsError{}
+	}
+	return &persistence.GetBatchOperationResponse{Info: &info}, nil
+}
+
+// shiftedTimeSource returns the current time shifted by a duration which can be changed
+type shiftedTimeSource struct {
+	offset int64
+}
+
+func (ts *shiftedTimeSource) Now() time.Time {
+	return time.Now().Add(time.Duration(atomic.LoadInt64(&ts.offset)))
+}
+
+func (ts *shiftedTimeSource) shift(d time.Duration) {
+	atomic.AddInt64(&ts.offset, int64(d))
+}
+
+type fixedTimeSource struct {
+	now time.Time
+}
+
+func (ts fixedTimeSource) Now() time.Time {
+	return ts.now
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/tchannel-go"
+	"github.com/uber/tchannel-go/thrift"
+)
+
+var errIdentityNotSet = &gen.BadRequestError{Message: "Identity is not set on request."}
+
+// resolveIdentity returns the identity recorded in the history events of a mutating request: the identity provided
+// by the caller, or the address of the peer the request comes from when it is missing and not required
+func (wh *WorkflowHandler) resolveIdentity(ctx thrift.Context, identity *string) (*string, error) {
+	if identity != nil && *identity != "" {
+		return identity, nil
+	}
+	if wh.identityRequired {
+		return nil, errIdentityNotSet
+	}
+	if call := tchannel.CurrentCall(ctx); call != nil {
+		if hostPort := call.RemotePeer().HostPort; hostPort != "" {
+			return common.StringPtr(hostPort), nil
+		}
+	}
+	return identity, nil
+}
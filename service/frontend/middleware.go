@@ -0,0 +1,358 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	athrift "github.com/apache/thrift/lib/go/thrift"
+	"github.com/uber-common/bark"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/tchannel-go"
+	"github.com/uber/tchannel-go/thrift"
+)
+
+const accessLogStatusOK = "ok"
+
+type (
+	// Request is a call to the frontend API on its way through the middleware chain
+	Request struct {
+		// API is the name of the called API
+		API string
+		// Scope is the metric scope of the called API
+		Scope int
+		// Domain is the name of the domain targeted by the request, it is empty for the APIs which identify the
+		// domain through a task token
+		Domain string
+		// Identity is the identity of the caller sent with the request, if the API has one
+		Identity string
+		// TraceID is the trace ID of the workflow to start sent with the request, if the API has one
+		TraceID string
+		// Priority is the priority hint sent in the headers of the call
+		Priority common.Priority
+		// Request is the thrift request struct
+		Request athrift.TStruct
+
+		domainScoped bool
+		dispatch     func(ctx thrift.Context) (athrift.TStruct, error)
+	}
+
+	// Handler serves a request passed to it by the previous middleware of the chain
+	Handler func(ctx thrift.Context, request *Request) (athrift.TStruct, error)
+
+	// Middleware wraps the rest of the chain with a concern shared by all APIs.  It either passes the request on to
+	// next or rejects it by returning an error.
+	Middleware func(next Handler) Handler
+
+	// Authorizer decides whether the caller may make a request.  It returns an error to reject the request, a
+	// BadRequestError is sent back to the caller as is and any other error is replaced by errAuthorizationFailed.
+	Authorizer interface {
+		Authorize(ctx thrift.Context, request *Request) error
+	}
+
+	// AdminOverrideAuthorizer is implemented by the authorizers which may grant a caller the override of a protection
+	// reserved to the admins, such as the termination protection of a domain.  The requests asking for an override
+	// are rejected if the registered authorizer does not implement it.
+	AdminOverrideAuthorizer interface {
+		AuthorizeAdminOverride(ctx thrift.Context, request *Request) error
+	}
+
+	allowAllAuthorizer struct{}
+
+	// accessLog writes a structured entry for every request while it is enabled.  The entries carry the domain, API,
+	// latency, request and response sizes, status and caller identity, which are used for capacity analysis and
+	// abuse detection independent of metrics aggregation.
+	accessLog struct {
+		logger  bark.Logger
+		enabled int32
+	}
+
+	rateLimiter struct {
+		sync.RWMutex
+		rps    int
+		bucket *common.PriorityTokenBucket
+	}
+)
+
+var (
+	middlewareLock        sync.RWMutex
+	registeredMiddlewares []Middleware
+	registeredAuthorizer  Authorizer = allowAllAuthorizer{}
+
+	errRateLimited         = &gen.ServiceBusyError{Message: "Frontend request rate limit exceeded."}
+	errDomainRateLimited   = &gen.ServiceBusyError{Message: "Domain request rate limit exceeded."}
+	errAuthorizationFailed = &gen.BadRequestError{Message: "Request authorization failed."}
+	errAdminOverrideDenied = &gen.BadRequestError{Message: "Admin override is not allowed to the caller."}
+)
+
+// RegisterMiddleware adds a deployment specific middleware to the chain of the frontend handlers created afterwards.
+// Middlewares run after the built-in ones in the order they are registered, so they are called from an init
+// function of the server binary before the services are started.
+func RegisterMiddleware(middleware Middleware) {
+	middlewareLock.Lock()
+	defer middlewareLock.Unlock()
+	registeredMiddlewares = append(registeredMiddlewares, middleware)
+}
+
+// RegisterAuthorizer replaces the authorizer of the frontend handlers created afterwards, which allows every request
+// by default.  ClaimAuthorizer is an example of per-domain access control.
+func RegisterAuthorizer(authorizer Authorizer) {
+	middlewareLock.Lock()
+	defer middlewareLock.Unlock()
+	registeredAuthorizer = authorizer
+}
+
+// buildMiddlewareChain returns the handler which runs a request through the access log, host and domain rate
+// limiting, authorization, validation, metrics and the registered middlewares before dispatching it to the API
+func buildMiddlewareChain(accessLog *accessLog, limiter *rateLimiter, domainLimiter *quotas.DomainRateLimiter,
+	metricsClient metrics.Client, dispatch Handler) Handler {
+	middlewareLock.RLock()
+	defer middlewareLock.RUnlock()
+
+	chain := []Middleware{
+		newAccessLogMiddleware(accessLog),
+		newRateLimitMiddleware(limiter, metricsClient),
+		newDomainRateLimitMiddleware(domainLimiter, metricsClient),
+		newAuthMiddleware(registeredAuthorizer, metricsClient),
+		newValidationMiddleware(metricsClient),
+		newMetricsMiddleware(metricsClient),
+	}
+	chain = append(chain, registeredMiddlewares...)
+
+	handler := dispatch
+	for i := len(chain) - 1; i >= 0; i-- {
+		handler = chain[i](handler)
+	}
+	return func(ctx thrift.Context, request *Request) (athrift.TStruct, error) {
+		request.Priority = common.GetPriority(ctx)
+		return handler(ctx, request)
+	}
+}
+
+// newAccessLogMiddleware logs every request once it is served.  It runs first so that the requests rejected by the
+// other middlewares are logged too.
+func newAccessLogMiddleware(accessLog *accessLog) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx thrift.Context, request *Request) (athrift.TStruct, error) {
+			if !accessLog.isEnabled() {
+				return next(ctx, request)
+			}
+			startTime := time.Now()
+			response, err := next(ctx, request)
+			accessLog.log(ctx, request, startTime, response, err)
+			return response, err
+		}
+	}
+}
+
+func newRateLimitMiddleware(limiter *rateLimiter, metricsClient metrics.Client) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx thrift.Context, request *Request) (athrift.TStruct, error) {
+			if !limiter.allow(request.Priority) {
+				metricsClient.IncCounter(request.Scope, metrics.CadenceErrServiceBusyCounter)
+				metricsClient.IncCounter(request.Scope, metrics.HostRateLimitThrottledCounter)
+				return nil, errRateLimited
+			}
+			return next(ctx, request)
+		}
+	}
+}
+
+// newDomainRateLimitMiddleware limits the rate of the requests of each domain, the requests identifying their domain
+// through a task token are not limited
+func newDomainRateLimitMiddleware(limiter *quotas.DomainRateLimiter, metricsClient metrics.Client) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx thrift.Context, request *Request) (athrift.TStruct, error) {
+			if request.Domain != "" && !limiter.Allow(request.Domain) {
+				metricsClient.IncCounter(request.Scope, metrics.CadenceErrServiceBusyCounter)
+				metricsClient.IncCounter(request.Scope, metrics.DomainRateLimitThrottledCounter)
+				return nil, errDomainRateLimited
+			}
+			return next(ctx, request)
+		}
+	}
+}
+
+// newAuthMiddleware rejects the requests denied by the authorizer, as well as the requests asking for an admin
+// override which the authorizer does not explicitly grant
+func newAuthMiddleware(authorizer Authorizer, metricsClient metrics.Client) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx thrift.Context, request *Request) (athrift.TStruct, error) {
+			if err := authorize(ctx, authorizer, request); err != nil {
+				metricsClient.IncCounter(request.Scope, metrics.CadenceErrUnauthorizedCounter)
+				if _, ok := err.(*gen.BadRequestError); ok {
+					return nil, err
+				}
+				return nil, errAuthorizationFailed
+			}
+			return next(ctx, request)
+		}
+	}
+}
+
+func authorize(ctx thrift.Context, authorizer Authorizer, request *Request) error {
+	if err := authorizer.Authorize(ctx, request); err != nil {
+		return err
+	}
+	if !isAdminOverride(request) {
+		return nil
+	}
+	overrideAuthorizer, ok := authorizer.(AdminOverrideAuthorizer)
+	if !ok {
+		return errAdminOverrideDenied
+	}
+	return overrideAuthorizer.AuthorizeAdminOverride(ctx, request)
+}
+
+// isAdminOverride returns whether the request overrides a protection which only the admin callers may override
+func isAdminOverride(request *Request) bool {
+	terminateRequest, ok := request.Request.(*gen.TerminateWorkflowExecutionRequest)
+	return ok && terminateRequest.GetAdminOverride()
+}
+
+// newValidationMiddleware rejects requests failing the checks shared by all APIs, the checks specific to an API
+// are left to the API
+func newValidationMiddleware(metricsClient metrics.Client) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx thrift.Context, request *Request) (athrift.TStruct, error) {
+			if request.domainScoped && request.Domain == "" {
+				metricsClient.IncCounter(request.Scope, metrics.CadenceErrBadRequestCounter)
+				return nil, errDomainNotSet
+			}
+			if err := validateID("TraceId", request.TraceID, maxTraceIDLength); err != nil {
+				metricsClient.IncCounter(request.Scope, metrics.CadenceErrBadRequestCounter)
+				return nil, err
+			}
+			return next(ctx, request)
+		}
+	}
+}
+
+// newMetricsMiddleware records the request count and latency of every API, the failures are counted by the API
+// itself as it knows how to classify its errors
+func newMetricsMiddleware(metricsClient metrics.Client) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx thrift.Context, request *Request) (athrift.TStruct, error) {
+			metricsClient.IncCounter(request.Scope, metrics.CadenceRequests)
+			sw := metricsClient.StartTimer(request.Scope, metrics.CadenceLatency)
+			defer sw.Stop()
+			return next(ctx, request)
+		}
+	}
+}
+
+func (allowAllAuthorizer) Authorize(ctx thrift.Context, request *Request) error {
+	return nil
+}
+
+// setRPS limits the rate of requests served by the frontend host, zero removes the limit.  The tokens left in the
+// bucket are kept if the limit does not change.
+func (l *rateLimiter) setRPS(rps int) {
+	l.Lock()
+	defer l.Unlock()
+	if rps == l.rps {
+		return
+	}
+
+	var bucket *common.PriorityTokenBucket
+	if rps > 0 {
+		bucket = common.NewPriorityTokenBucket(rps, common.NewRealTimeSource())
+	}
+	l.rps = rps
+	l.bucket = bucket
+}
+
+// allow takes a token for a request of the given priority, the background and batch requests only get a share of
+// the limit
+func (l *rateLimiter) allow(priority common.Priority) bool {
+	l.RLock()
+	bucket := l.bucket
+	l.RUnlock()
+
+	if bucket == nil {
+		return true
+	}
+	return bucket.TryConsume(priority)
+}
+
+func newAccessLog(logger bark.Logger) *accessLog {
+	return &accessLog{
+		logger: logger.WithField(logging.TagWorkflowComponent, logging.TagValueFrontendAccessLogComponent),
+	}
+}
+
+// setEnabled turns the access log on or off, it can be toggled while the handler is serving requests
+func (l *accessLog) setEnabled(enabled bool) {
+	value := int32(0)
+	if enabled {
+		value = 1
+	}
+	atomic.StoreInt32(&l.enabled, value)
+}
+
+func (l *accessLog) isEnabled() bool {
+	return atomic.LoadInt32(&l.enabled) == 1
+}
+
+func (l *accessLog) log(ctx thrift.Context, request *Request, startTime time.Time, response athrift.TStruct,
+	err error) {
+	status := accessLogStatusOK
+	if err != nil {
+		status = fmt.Sprintf("%T", err)
+	}
+
+	caller := ""
+	if call := tchannel.CurrentCall(ctx); call != nil {
+		caller = call.CallerName()
+	}
+
+	l.logger.WithFields(bark.Fields{
+		logging.TagAPIName:        request.API,
+		logging.TagDomainName:     request.Domain,
+		logging.TagLatency:        time.Since(startTime).Nanoseconds() / int64(time.Millisecond),
+		logging.TagRequestSize:    thriftSize(request.Request),
+		logging.TagResponseSize:   thriftSize(response),
+		logging.TagStatus:         status,
+		logging.TagCallerName:     caller,
+		logging.TagCallerIdentity: request.Identity,
+	}).Info("Frontend request.")
+}
+
+// thriftSize returns the size of the serialized struct, or zero if it is nil or cannot be serialized
+func thriftSize(msg athrift.TStruct) int {
+	if msg == nil || reflect.ValueOf(msg).IsNil() {
+		return 0
+	}
+
+	data, err := common.TSerialize(msg)
+	if err != nil {
+		return 0
+	}
+	return len(data)
+}
@@ -0,0 +1,572 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	athrift "github.com/apache/thrift/lib/go/thrift"
+	"github.com/uber/cadence/.gen/go/cadence"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/tchannel-go/thrift"
+)
+
+type (
+	// middlewareHandler turns every call to the cadence frontend API into a Request and runs it through the
+	// middleware chain, which dispatches it to the wrapped handler once all middlewares have let it pass
+	middlewareHandler struct {
+		handler cadence.TChanWorkflowService
+		chain   Handler
+	}
+)
+
+var _ cadence.TChanWorkflowService = (*middlewareHandler)(nil)
+
+func newMiddlewareHandler(handler cadence.TChanWorkflowService, chain Handler) *middlewareHandler {
+	return &middlewareHandler{
+		handler: handler,
+		chain:   chain,
+	}
+}
+
+// CountClosedWorkflowExecutions runs WorkflowHandler.CountClosedWorkflowExecutions behind the middleware chain
+func (h *middlewareHandler) CountClosedWorkflowExecutions(ctx thrift.Context,
+	countRequest *gen.CountClosedWorkflowExecutionsRequest) (*gen.CountClosedWorkflowExecutionsResponse, error) {
+	resp, err := h.chain(ctx, &Request{
+		API:          "CountClosedWorkflowExecutions",
+		Scope:        metrics.FrontendCountClosedWorkflowExecutionsScope,
+		Domain:       countRequest.GetDomain(),
+		Request:      countRequest,
+		domainScoped: true,
+		dispatch: func(ctx thrift.Context) (athrift.TStruct, error) {
+			return h.handler.CountClosedWorkflowExecutions(ctx, countRequest)
+		},
+	})
+	response, _ := resp.(*gen.CountClosedWorkflowExecutionsResponse)
+	return response, err
+}
+
+// CountOpenWorkflowExecutions runs WorkflowHandler.CountOpenWorkflowExecutions behind the middleware chain
+func (h *middlewareHandler) CountOpenWorkflowExecutions(ctx thrift.Context,
+	countRequest *gen.CountOpenWorkflowExecutionsRequest) (*gen.CountOpenWorkflowExecutionsResponse, error) {
+	resp, err := h.chain(ctx, &Request{
+		API:          "CountOpenWorkflowExecutions",
+		Scope:        metrics.FrontendCountOpenWorkflowExecutionsScope,
+		Domain:       countRequest.GetDomain(),
+		Request:      countRequest,
+		domainScoped: true,
+		dispatch: func(ctx thrift.Context) (athrift.TStruct, error) {
+			return h.handler.CountOpenWorkflowExecutions(ctx, countRequest)
+		},
+	})
+	response, _ := resp.(*gen.CountOpenWorkflowExecutionsResponse)
+	return response, err
+}
+
+// DeprecateDomain runs WorkflowHandler.DeprecateDomain behind the middleware chain
+func (h *middlewareHandler) DeprecateDomain(ctx thrift.Context, deprecateRequest *gen.DeprecateDomainRequest) error {
+	_, err := h.chain(ctx, &Request{
+		API:          "DeprecateDomain",
+		Scope:        metrics.FrontendDeprecateDomainScope,
+		Domain:       deprecateRequest.GetName(),
+		Request:      deprecateRequest,
+		domainScoped: true,
+		dispatch: func(ctx thrift.Context) (athrift.TStruct, error) {
+			return nil, h.handler.DeprecateDomain(ctx, deprecateRequest)
+		},
+	})
+	return err
+}
+
+// DescribeBatchOperation runs WorkflowHandler.DescribeBatchOperation behind the middleware chain
+func (h *middlewareHandler) DescribeBatchOperation(ctx thrift.Context,
+	describeRequest *gen.DescribeBatchOperationRequest) (*gen.DescribeBatchOperationResponse, error) {
+	resp, err := h.chain(ctx, &Request{
+		API:          "DescribeBatchOperation",
+		Scope:        metrics.FrontendDescribeBatchOperationScope,
+		Domain:       describeRequest.GetDomain(),
+		Request:      describeRequest,
+		domainScoped: true,
+		dispatch: func(ctx thrift.Context) (athrift.TStruct, error) {
+			return h.handler.DescribeBatchOperation(ctx, describeRequest)
+		},
+	})
+	response, _ := resp.(*gen.DescribeBatchOperationResponse)
+	return response, err
+}
+
+// DescribeDomain runs WorkflowHandler.DescribeDomain behind the middleware chain
+func (h *middlewareHandler) DescribeDomain(ctx thrift.Context,
+	describeRequest *gen.DescribeDomainRequest) (*gen.DescribeDomainResponse, error) {
+	resp, err := h.chain(ctx, &Request{
+		API:          "DescribeDomain",
+		Scope:        metrics.FrontendDescribeDomainScope,
+		Domain:       describeRequest.GetName(),
+		Request:      describeRequest,
+		domainScoped: true,
+		dispatch: func(ctx thrift.Context) (athrift.TStruct, error) {
+			return h.handler.DescribeDomain(ctx, describeRequest)
+		},
+	})
+	response, _ := resp.(*gen.DescribeDomainResponse)
+	return response, err
+}
+
+// GetClusterInfo runs WorkflowHandler.GetClusterInfo behind the middleware chain
+func (h *middlewareHandler) GetClusterInfo(ctx thrift.Context,
+	getRequest *gen.GetClusterInfoRequest) (*gen.GetClusterInfoResponse, error) {
+	resp, err := h.chain(ctx, &Request{
+		API:     "GetClusterInfo",
+		Scope:   metrics.FrontendGetClusterInfoScope,
+		Request: getRequest,
+		dispatch: func(ctx thrift.Context) (athrift.TStruct, error) {
+			return h.handler.GetClusterInfo(ctx, getRequest)
+		},
+	})
+	response, _ := resp.(*gen.GetClusterInfoResponse)
+	return response, err
+}
+
+// GetDomainReplicationMessages runs WorkflowHandler.GetDomainReplicationMessages behind the middleware chain
+func (h *middlewareHandler) GetDomainReplicationMessages(ctx thrift.Context,
+	getRequest *gen.GetDomainReplicationMessagesRequest) (*gen.GetDomainReplicationMessagesResponse, error) {
+	resp, err := h.chain(ctx, &Request{
+		API:     "GetDomainReplicationMessages",
+		Scope:   metrics.FrontendGetDomainReplicationMessagesScope,
+		Request: getRequest,
+		dispatch: func(ctx thrift.Context) (athrift.TStruct, error) {
+			return h.handler.GetDomainReplicationMessages(ctx, getRequest)
+		},
+	})
+	response, _ := resp.(*gen.GetDomainReplicationMessagesResponse)
+	return response, err
+}
+
+// GetWorkflowExecutionHistory runs WorkflowHandler.GetWorkflowExecutionHistory behind the middleware chain
+func (h *middlewareHandler) GetWorkflowExecutionHistory(ctx thrift.Context,
+	getRequest *gen.GetWorkflowExecutionHistoryRequest) (*gen.GetWorkflowExecutionHistoryResponse, error) {
+	resp, err := h.chain(ctx, &Request{
+		API:          "GetWorkflowExecutionHistory",
+		Scope:        metrics.FrontendGetWorkflowExecutionHistoryScope,
+		Domain:       getRequest.GetDomain(),
+		Request:      getRequest,
+		domainScoped: true,
+		dispatch: func(ctx thrift.Context) (athrift.TStruct, error) {
+			return h.handler.GetWorkflowExecutionHistory(ctx, getRequest)
+		},
+	})
+	response, _ := resp.(*gen.GetWorkflowExecutionHistoryResponse)
+	return response, err
+}
+
+// GetWorkflowResult runs WorkflowHandler.GetWorkflowResult behind the middleware chain
+func (h *middlewareHandler) GetWorkflowResult(ctx thrift.Context,
+	getRequest *gen.GetWorkflowResultRequest) (*gen.GetWorkflowResultResponse, error) {
+	resp, err := h.chain(ctx, &Request{
+		API:          "GetWorkflowResult",
+		Scope:        metrics.FrontendGetWorkflowResultScope,
+		Domain:       getRequest.GetDomain(),
+		Request:      getRequest,
+		domainScoped: true,
+		dispatch: func(ctx thrift.Context) (athrift.TStruct, error) {
+			return h.handler.GetWorkflowResult(ctx, getRequest)
+		},
+	})
+	response, _ := resp.(*gen.GetWorkflowResultResponse)
+	return response, err
+}
+
+// ListClosedWorkflowExecutions runs WorkflowHandler.ListClosedWorkflowExecutions behind the middleware chain
+func (h *middlewareHandler) ListClosedWorkflowExecutions(ctx thrift.Context,
+	listRequest *gen.ListClosedWorkflowExecutionsRequest) (*gen.ListClosedWorkflowExecutionsResponse, error) {
+	resp, err := h.chain(ctx, &Request{
+		API:          "ListClosedWorkflowExecutions",
+		Scope:        metrics.FrontendListClosedWorkflowExecutionsScope,
+		Domain:       listRequest.GetDomain(),
+		Request:      listRequest,
+		domainScoped: true,
+		dispatch: func(ctx thrift.Context) (athrift.TStruct, error) {
+			return h.handler.ListClosedWorkflowExecutions(ctx, listRequest)
+		},
+	})
+	response, _ := resp.(*gen.ListClosedWorkflowExecutionsResponse)
+	return response, err
+}
+
+// ListOpenWorkflowExecutions runs WorkflowHandler.ListOpenWorkflowExecutions behind the middleware chain
+func (h *middlewareHandler) ListOpenWorkflowExecutions(ctx thrift.Context,
+	listRequest *gen.ListOpenWorkflowExecutionsRequest) (*gen.ListOpenWorkflowExecutionsResponse, error) {
+	resp, err := h.chain(ctx, &Request{
+		API:          "ListOpenWorkflowExecutions",
+		Scope:        metrics.FrontendListOpenWorkflowExecutionsScope,
+		Domain:       listRequest.GetDomain(),
+		Request:      listRequest,
+		domainScoped: true,
+		dispatch: func(ctx thrift.Context) (athrift.TStruct, error) {
+			return h.handler.ListOpenWorkflowExecutions(ctx, listRequest)
+		},
+	})
+	response, _ := resp.(*gen.ListOpenWorkflowExecutionsResponse)
+	return response, err
+}
+
+// ListWorkflowExecutionsWithQuery runs WorkflowHandler.ListWorkflowExecutionsWithQuery behind the middleware chain
+func (h *middlewareHandler) ListWorkflowExecutionsWithQuery(ctx thrift.Context,
+	listRequest *gen.ListWorkflowExecutionsWithQueryRequest) (*gen.ListWorkflowExecutionsWithQueryResponse, error) {
+	resp, err := h.chain(ctx, &Request{
+		API:          "ListWorkflowExecutionsWithQuery",
+		Scope:        metrics.FrontendListWorkflowExecutionsWithQueryScope,
+		Domain:       listRequest.GetDomain(),
+		Request:      listRequest,
+		domainScoped: true,
+		dispatch: func(ctx thrift.Context) (athrift.TStruct, error) {
+			return h.handler.ListWorkflowExecutionsWithQuery(ctx, listRequest)
+		},
+	})
+	response, _ := resp.(*gen.ListWorkflowExecutionsWithQueryResponse)
+	return response, err
+}
+
+// PollForActivityTask runs WorkflowHandler.PollForActivityTask behind the middleware chain
+func (h *middlewareHandler) PollForActivityTask(ctx thrift.Context,
+	pollRequest *gen.PollForActivityTaskRequest) (*gen.PollForActivityTaskResponse, error) {
+	resp, err := h.chain(ctx, &Request{
+		API:          "PollForActivityTask",
+		Scope:        metrics.FrontendPollForActivityTaskScope,
+		Domain:       pollRequest.GetDomain(),
+		Identity:     pollRequest.GetIdentity(),
+		Request:      pollRequest,
+		domainScoped: true,
+		dispatch: func(ctx thrift.Context) (athrift.TStruct, error) {
+			return h.handler.PollForActivityTask(ctx, pollRequest)
+		},
+	})
+	response, _ := resp.(*gen.PollForActivityTaskResponse)
+	return response, err
+}
+
+// PollForDecisionTask runs WorkflowHandler.PollForDecisionTask behind the middleware chain
+func (h *middlewareHandler) PollForDecisionTask(ctx thrift.Context,
+	pollRequest *gen.PollForDecisionTaskRequest) (*gen.PollForDecisionTaskResponse, error) {
+	resp, err := h.chain(ctx, &Request{
+		API:          "PollForDecisionTask",
+		Scope:        metrics.FrontendPollForDecisionTaskScope,
+		Domain:       pollRequest.GetDomain(),
+		Identity:     pollRequest.GetIdentity(),
+		Request:      pollRequest,
+		domainScoped: true,
+		dispatch: func(ctx thrift.Context) (athrift.TStruct, error) {
+			return h.handler.PollForDecisionTask(ctx, pollRequest)
+		},
+	})
+	response, _ := resp.(*gen.PollForDecisionTaskResponse)
+	return response, err
+}
+
+// RecordActivityTaskHeartbeat runs WorkflowHandler.RecordActivityTaskHeartbeat behind the middleware chain
+func (h *middlewareHandler) RecordActivityTaskHeartbeat(ctx thrift.Context,
+	heartbeatRequest *gen.RecordActivityTaskHeartbeatRequest) (*gen.RecordActivityTaskHeartbeatResponse, error) {
+	resp, err := h.chain(ctx, &Request{
+		API:      "RecordActivityTaskHeartbeat",
+		Scope:    metrics.FrontendRecordActivityTaskHeartbeatScope,
+		Identity: heartbeatRequest.GetIdentity(),
+		Request:  heartbeatRequest,
+		dispatch: func(ctx thrift.Context) (athrift.TStruct, error) {
+			return h.handler.RecordActivityTaskHeartbeat(ctx, heartbeatRequest)
+		},
+	})
+	response, _ := resp.(*gen.RecordActivityTaskHeartbeatResponse)
+	return response, err
+}
+
+// RegisterDomain runs WorkflowHandler.RegisterDomain behind the middleware chain
+func (h *middlewareHandler) RegisterDomain(ctx thrift.Context, registerRequest *gen.RegisterDomainRequest) error {
+	_, err := h.chain(ctx, &Request{
+		API:          "RegisterDomain",
+		Scope:        metrics.FrontendRegisterDomainScope,
+		Domain:       registerRequest.GetName(),
+		Request:      registerRequest,
+		domainScoped: true,
+		dispatch: func(ctx thrift.Context) (athrift.TStruct, error) {
+			return nil, h.handler.RegisterDomain(ctx, registerRequest)
+		},
+	})
+	return err
+}
+
+// QueryWorkflow runs WorkflowHandler.QueryWorkflow behind the middleware chain
+func (h *middlewareHandler) QueryWorkflow(ctx thrift.Context,
+	queryRequest *gen.QueryWorkflowRequest) (*gen.QueryWorkflowResponse, error) {
+	resp, err := h.chain(ctx, &Request{
+		API:          "QueryWorkflow",
+		Scope:        metrics.FrontendQueryWorkflowScope,
+		Domain:       queryRequest.GetDomain(),
+		Request:      queryRequest,
+		domainScoped: true,
+		dispatch: func(ctx thrift.Context) (athrift.TStruct, error) {
+			return h.handler.QueryWorkflow(ctx, queryRequest)
+		},
+	})
+	response, _ := resp.(*gen.QueryWorkflowResponse)
+	return response, err
+}
+
+// RequestCancelWorkflowExecution runs WorkflowHandler.RequestCancelWorkflowExecution behind the middleware chain
+func (h *middlewareHandler) RequestCancelWorkflowExecution(ctx thrift.Context, cancelRequest *gen.RequestCancelWorkflowExecutionRequest) error {
+	_, err := h.chain(ctx, &Request{
+		API:          "RequestCancelWorkflowExecution",
+		Scope:        metrics.FrontendRequestCancelWorkflowExecutionScope,
+		Domain:       cancelRequest.GetDomain(),
+		Identity:     cancelRequest.GetIdentity(),
+		Request:      cancelRequest,
+		domainScoped: true,
+		dispatch: func(ctx thrift.Context) (athrift.TStruct, error) {
+			return nil, h.handler.RequestCancelWorkflowExecution(ctx, cancelRequest)
+		},
+	})
+	return err
+}
+
+// ResetWorkflowExecution runs WorkflowHandler.ResetWorkflowExecution behind the middleware chain
+func (h *middlewareHandler) ResetWorkflowExecution(ctx thrift.Context,
+	resetRequest *gen.ResetWorkflowExecutionRequest) (*gen.ResetWorkflowExecutionResponse, error) {
+	resp, err := h.chain(ctx, &Request{
+		API:          "ResetWorkflowExecution",
+		Scope:        metrics.FrontendResetWorkflowExecutionScope,
+		Domain:       resetRequest.GetDomain(),
+		Identity:     resetRequest.GetIdentity(),
+		Request:      resetRequest,
+		domainScoped: true,
+		dispatch: func(ctx thrift.Context) (athrift.TStruct, error) {
+			return h.handler.ResetWorkflowExecution(ctx, resetRequest)
+		},
+	})
+	response, _ := resp.(*gen.ResetWorkflowExecutionResponse)
+	return response, err
+}
+
+// RespondActivityTaskCanceled runs WorkflowHandler.RespondActivityTaskCanceled behind the middleware chain
+func (h *middlewareHandler) RespondActivityTaskCanceled(ctx thrift.Context, canceledRequest *gen.RespondActivityTaskCanceledRequest) error {
+	_, err := h.chain(ctx, &Request{
+		API:      "RespondActivityTaskCanceled",
+		Scope:    metrics.FrontendRespondActivityTaskCanceledScope,
+		Identity: canceledRequest.GetIdentity(),
+		Request:  canceledRequest,
+		dispatch: func(ctx thrift.Context) (athrift.TStruct, error) {
+			return nil, h.handler.RespondActivityTaskCanceled(ctx, canceledRequest)
+		},
+	})
+	return err
+}
+
+// RespondActivityTaskCompleted runs WorkflowHandler.RespondActivityTaskCompleted behind the middleware chain
+func (h *middlewareHandler) RespondActivityTaskCompleted(ctx thrift.Context, completeRequest *gen.RespondActivityTaskCompletedRequest) error {
+	_, err := h.chain(ctx, &Request{
+		API:      "RespondActivityTaskCompleted",
+		Scope:    metrics.FrontendRespondActivityTaskCompletedScope,
+		Identity: completeRequest.GetIdentity(),
+		Request:  completeRequest,
+		dispatch: func(ctx thrift.Context) (athrift.TStruct, error) {
+			return nil, h.handler.RespondActivityTaskCompleted(ctx, completeRequest)
+		},
+	})
+	return err
+}
+
+// RespondActivityTaskFailed runs WorkflowHandler.RespondActivityTaskFailed behind the middleware chain
+func (h *middlewareHandler) RespondActivityTaskFailed(ctx thrift.Context, failRequest *gen.RespondActivityTaskFailedRequest) error {
+	_, err := h.chain(ctx, &Request{
+		API:      "RespondActivityTaskFailed",
+		Scope:    metrics.FrontendRespondActivityTaskFailedScope,
+		Identity: failRequest.GetIdentity(),
+		Request:  failRequest,
+		dispatch: func(ctx thrift.Context) (athrift.TStruct, error) {
+			return nil, h.handler.RespondActivityTaskFailed(ctx, failRequest)
+		},
+	})
+	return err
+}
+
+// RespondDecisionTaskCompleted runs WorkflowHandler.RespondDecisionTaskCompleted behind the middleware chain
+func (h *middlewareHandler) RespondDecisionTaskCompleted(ctx thrift.Context,
+	completeRequest *gen.RespondDecisionTaskCompletedRequest) (*gen.RespondDecisionTaskCompletedResponse, error) {
+	resp, err := h.chain(ctx, &Request{
+		API:      "RespondDecisionTaskCompleted",
+		Scope:    metrics.FrontendRespondDecisionTaskCompletedScope,
+		Identity: completeRequest.GetIdentity(),
+		Request:  completeRequest,
+		dispatch: func(ctx thrift.Context) (athrift.TStruct, error) {
+			return h.handler.RespondDecisionTaskCompleted(ctx, completeRequest)
+		},
+	})
+	response, _ := resp.(*gen.RespondDecisionTaskCompletedResponse)
+	return response, err
+}
+
+// RespondQueryTaskCompleted runs WorkflowHandler.RespondQueryTaskCompleted behind the middleware chain
+func (h *middlewareHandler) RespondQueryTaskCompleted(ctx thrift.Context, completeRequest *gen.RespondQueryTaskCompletedRequest) error {
+	_, err := h.chain(ctx, &Request{
+		API:     "RespondQueryTaskCompleted",
+		Scope:   metrics.FrontendRespondQueryTaskCompletedScope,
+		Request: completeRequest,
+		dispatch: func(ctx thrift.Context) (athrift.TStruct, error) {
+			return nil, h.handler.RespondQueryTaskCompleted(ctx, completeRequest)
+		},
+	})
+	return err
+}
+
+// ScanWorkflowExecutions runs WorkflowHandler.ScanWorkflowExecutions behind the middleware chain
+func (h *middlewareHandler) ScanWorkflowExecutions(ctx thrift.Context,
+	listRequest *gen.ScanWorkflowExecutionsRequest) (*gen.ScanWorkflowExecutionsResponse, error) {
+	resp, err := h.chain(ctx, &Request{
+		API:          "ScanWorkflowExecutions",
+		Scope:        metrics.FrontendScanWorkflowExecutionsScope,
+		Domain:       listRequest.GetDomain(),
+		Request:      listRequest,
+		domainScoped: true,
+		dispatch: func(ctx thrift.Context) (athrift.TStruct, error) {
+			return h.handler.ScanWorkflowExecutions(ctx, listRequest)
+		},
+	})
+	response, _ := resp.(*gen.ScanWorkflowExecutionsResponse)
+	return response, err
+}
+
+// SignalWithStartWorkflowExecution runs WorkflowHandler.SignalWithStartWorkflowExecution behind the middleware
+// chain
+func (h *middlewareHandler) SignalWithStartWorkflowExecution(ctx thrift.Context,
+	signalWithStartRequest *gen.SignalWithStartWorkflowExecutionRequest) (*gen.StartWorkflowExecutionResponse, error) {
+	resp, err := h.chain(ctx, &Request{
+		API:          "SignalWithStartWorkflowExecution",
+		Scope:        metrics.FrontendSignalWithStartWorkflowExecutionScope,
+		Domain:       signalWithStartRequest.GetDomain(),
+		Identity:     signalWithStartRequest.GetIdentity(),
+		Request:      signalWithStartRequest,
+		domainScoped: true,
+		dispatch: func(ctx thrift.Context) (athrift.TStruct, error) {
+			return h.handler.SignalWithStartWorkflowExecution(ctx, signalWithStartRequest)
+		},
+	})
+	response, _ := resp.(*gen.StartWorkflowExecutionResponse)
+	return response, err
+}
+
+// SignalWorkflowExecution runs WorkflowHandler.SignalWorkflowExecution behind the middleware chain
+func (h *middlewareHandler) SignalWorkflowExecution(ctx thrift.Context, signalRequest *gen.SignalWorkflowExecutionRequest) error {
+	_, err := h.chain(ctx, &Request{
+		API:          "SignalWorkflowExecution",
+		Scope:        metrics.FrontendSignalWorkflowExecutionScope,
+		Domain:       signalRequest.GetDomain(),
+		Identity:     signalRequest.GetIdentity(),
+		Request:      signalRequest,
+		domainScoped: true,
+		dispatch: func(ctx thrift.Context) (athrift.TStruct, error) {
+			return nil, h.handler.SignalWorkflowExecution(ctx, signalRequest)
+		},
+	})
+	return err
+}
+
+// StartBatchOperation runs WorkflowHandler.StartBatchOperation behind the middleware chain
+func (h *middlewareHandler) StartBatchOperation(ctx thrift.Context,
+	startRequest *gen.StartBatchOperationRequest) (*gen.StartBatchOperationResponse, error) {
+	resp, err := h.chain(ctx, &Request{
+		API:          "StartBatchOperation",
+		Scope:        metrics.FrontendStartBatchOperationScope,
+		Domain:       startRequest.GetDomain(),
+		Identity:     startRequest.GetIdentity(),
+		Request:      startRequest,
+		domainScoped: true,
+		dispatch: func(ctx thrift.Context) (athrift.TStruct, error) {
+			return h.handler.StartBatchOperation(ctx, startRequest)
+		},
+	})
+	response, _ := resp.(*gen.StartBatchOperationResponse)
+	return response, err
+}
+
+// StartWorkflowExecution runs WorkflowHandler.StartWorkflowExecution behind the middleware chain
+func (h *middlewareHandler) StartWorkflowExecution(ctx thrift.Context,
+	startRequest *gen.StartWorkflowExecutionRequest) (*gen.StartWorkflowExecutionResponse, error) {
+	resp, err := h.chain(ctx, &Request{
+		API:          "StartWorkflowExecution",
+		Scope:        metrics.FrontendStartWorkflowExecutionScope,
+		Domain:       startRequest.GetDomain(),
+		Identity:     startRequest.GetIdentity(),
+		TraceID:      startRequest.GetTraceId(),
+		Request:      startRequest,
+		domainScoped: true,
+		dispatch: func(ctx thrift.Context) (athrift.TStruct, error) {
+			return h.handler.StartWorkflowExecution(ctx, startRequest)
+		},
+	})
+	response, _ := resp.(*gen.StartWorkflowExecutionResponse)
+	return response, err
+}
+
+// StopBatchOperation runs WorkflowHandler.StopBatchOperation behind the middleware chain
+func (h *middlewareHandler) StopBatchOperation(ctx thrift.Context, stopRequest *gen.StopBatchOperationRequest) error {
+	_, err := h.chain(ctx, &Request{
+		API:          "StopBatchOperation",
+		Scope:        metrics.FrontendStopBatchOperationScope,
+		Domain:       stopRequest.GetDomain(),
+		Identity:     stopRequest.GetIdentity(),
+		Request:      stopRequest,
+		domainScoped: true,
+		dispatch: func(ctx thrift.Context) (athrift.TStruct, error) {
+			return nil, h.handler.StopBatchOperation(ctx, stopRequest)
+		},
+	})
+	return err
+}
+
+// TerminateWorkflowExecution runs WorkflowHandler.TerminateWorkflowExecution behind the middleware chain
+func (h *middlewareHandler) TerminateWorkflowExecution(ctx thrift.Context, terminateRequest *gen.TerminateWorkflowExecutionRequest) error {
+	_, err := h.chain(ctx, &Request{
+		API:          "TerminateWorkflowExecution",
+		Scope:        metrics.FrontendTerminateWorkflowExecutionScope,
+		Domain:       terminateRequest.GetDomain(),
+		Identity:     terminateRequest.GetIdentity(),
+		Request:      terminateRequest,
+		domainScoped: true,
+		dispatch: func(ctx thrift.Context) (athrift.TStruct, error) {
+			return nil, h.handler.TerminateWorkflowExecution(ctx, terminateRequest)
+		},
+	})
+	return err
+}
+
+// UpdateDomain runs WorkflowHandler.UpdateDomain behind the middleware chain
+func (h *middlewareHandler) UpdateDomain(ctx thrift.Context,
+	updateRequest *gen.UpdateDomainRequest) (*gen.UpdateDomainResponse, error) {
+	resp, err := h.chain(ctx, &Request{
+		API:          "UpdateDomain",
+		Scope:        metrics.FrontendUpdateDomainScope,
+		Domain:       updateRequest.GetName(),
+		Request:      updateRequest,
+		domainScoped: true,
+		dispatch: func(ctx thrift.Context) (athrift.TStruct, error) {
+			return h.handler.UpdateDomain(ctx, updateRequest)
+		},
+	})
+	response, _ := resp.(*gen.UpdateDomainResponse)
+	return response, err
+}
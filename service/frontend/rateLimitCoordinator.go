@@ -0,0 +1,207 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"sync"
+	"time"
+
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/quotas"
+)
+
+const (
+	rateLimitCoordinatorListenerName = "RateLimitCoordinator"
+	// rateLimitRefreshInterval is the interval at which the share of the host is recomputed, in case a membership
+	// change notification was dropped
+	rateLimitRefreshInterval = 10 * time.Second
+)
+
+// rateLimitCoordinator keeps the rate limit of the host at its share of the global rate limit of the cluster.  The
+// global limit is split evenly between the frontend hosts of the membership ring and each share is rounded up, so
+// the cluster serves at most one request per second per host above the global limit.  The rate limit of the host caps
+// its share, so that a host does not take more than it can serve when the cluster shrinks, and is enforced on its own
+// while the size of the ring is unknown.  The rate limits of the domains are split between the hosts the same way,
+// each host enforcing the whole limit of a domain while the size of the ring is unknown.
+type rateLimitCoordinator struct {
+	sync.Mutex
+	limiter       *rateLimiter
+	domainLimiter *quotas.DomainRateLimiter
+	resolver      membership.ServiceResolver
+	globalRPS     int
+	hostRPS       int
+	domainRPS     int
+	domains       map[string]int
+	logger        bark.Logger
+	metricsClient metrics.Client
+
+	// domainHosts is the number of hosts the rate limits of the domains were last split between, setting the limits
+	// of the domain limiter resets its buckets so they are only set again when it changes
+	domainHosts int
+
+	fallback           bool
+	membershipUpdateCh chan *membership.ChangedEvent
+	shutdownCh         chan struct{}
+	shutdownWG         sync.WaitGroup
+}
+
+func newRateLimitCoordinator(limiter *rateLimiter, domainLimiter *quotas.DomainRateLimiter,
+	resolver membership.ServiceResolver, globalRPS, hostRPS, domainRPS int, domains map[string]int,
+	logger bark.Logger, metricsClient metrics.Client) *rateLimitCoordinator {
+	return &rateLimitCoordinator{
+		limiter:            limiter,
+		domainLimiter:      domainLimiter,
+		resolver:           resolver,
+		globalRPS:          globalRPS,
+		hostRPS:            hostRPS,
+		domainRPS:          domainRPS,
+		domains:            domains,
+		logger:             logger,
+		metricsClient:      metricsClient,
+		domainHosts:        -1,
+		membershipUpdateCh: make(chan *membership.ChangedEvent, 10),
+		shutdownCh:         make(chan struct{}),
+	}
+}
+
+func (c *rateLimitCoordinator) start() {
+	if err := c.resolver.AddListener(rateLimitCoordinatorListenerName, c.membershipUpdateCh); err != nil {
+		c.logger.Warnf("Unable to listen to frontend membership changes, the rate limit share is only refreshed "+
+			"periodically: %v", err)
+	}
+	c.update()
+
+	c.shutdownWG.Add(1)
+	go c.updatePump()
+}
+
+func (c *rateLimitCoordinator) stop() {
+	if err := c.resolver.RemoveListener(rateLimitCoordinatorListenerName); err != nil {
+		c.logger.Warnf("Unable to stop listening to frontend membership changes: %v", err)
+	}
+	close(c.shutdownCh)
+	c.shutdownWG.Wait()
+}
+
+func (c *rateLimitCoordinator) updatePump() {
+	defer c.shutdownWG.Done()
+
+	refreshTicker := time.NewTicker(rateLimitRefreshInterval)
+	defer refreshTicker.Stop()
+
+	for {
+		select {
+		case <-c.shutdownCh:
+			return
+		case <-c.membershipUpdateCh:
+			c.update()
+		case <-refreshTicker.C:
+			c.update()
+		}
+	}
+}
+
+// setHostRPS changes the rate limit of the host and applies it right away
+func (c *rateLimitCoordinator) setHostRPS(rps int) {
+	c.Lock()
+	c.hostRPS = rps
+	c.Unlock()
+	c.update()
+}
+
+// setGlobalRPS changes the global rate limit of the cluster and applies the share of the host right away
+func (c *rateLimitCoordinator) setGlobalRPS(rps int) {
+	c.Lock()
+	c.globalRPS = rps
+	c.Unlock()
+	c.update()
+}
+
+// setDomainRPS changes the rate limits of the domains of the cluster and applies the share of the host right away
+func (c *rateLimitCoordinator) setDomainRPS(defaultRPS int, domainRPS map[string]int) {
+	c.Lock()
+	c.domainRPS = defaultRPS
+	c.domains = domainRPS
+	c.domainHosts = -1
+	c.Unlock()
+	c.update()
+}
+
+// update sets the rate limit of the host to its share of the global rate limit capped by the limit of the host, or
+// to the limit of the host if the number of frontend hosts is unknown, and the rate limits of the domains to their
+// share the same way
+func (c *rateLimitCoordinator) update() {
+	c.Lock()
+	defer c.Unlock()
+
+	rps := c.hostRPS
+	hosts := c.resolver.MemberCount()
+	if hosts > 0 {
+		if share := hostShare(c.globalRPS, hosts); c.globalRPS > 0 && (c.hostRPS <= 0 || share < c.hostRPS) {
+			rps = share
+		}
+		if c.fallback {
+			c.logger.Infof("Frontend membership is known again, enforcing the share of the global rate limit")
+		}
+		c.fallback = false
+	} else {
+		if !c.fallback {
+			c.logger.Warnf("Frontend membership is unknown, falling back to the rate limit of the host")
+		}
+		c.fallback = true
+		c.metricsClient.IncCounter(metrics.FrontendRateLimitCoordinatorScope,
+			metrics.RateLimitCoordinationFallbackCounter)
+	}
+
+	c.limiter.setRPS(rps)
+	c.metricsClient.UpdateGauge(metrics.FrontendRateLimitCoordinatorScope, metrics.RateLimitHostShareGauge,
+		float64(rps))
+	c.updateDomainRPS(hosts)
+}
+
+// updateDomainRPS sets the rate limits of the domains to their share for the given number of hosts, or to the limits
+// of the cluster if the number of hosts is unknown
+func (c *rateLimitCoordinator) updateDomainRPS(hosts int) {
+	if hosts < 0 {
+		hosts = 0
+	}
+	if hosts == c.domainHosts {
+		return
+	}
+	c.domainHosts = hosts
+
+	if hosts == 0 {
+		c.domainLimiter.SetRPS(c.domainRPS, c.domains)
+		return
+	}
+	shares := make(map[string]int, len(c.domains))
+	for domain, rps := range c.domains {
+		shares[domain] = hostShare(rps, hosts)
+	}
+	c.domainLimiter.SetRPS(hostShare(c.domainRPS, hosts), shares)
+}
+
+// hostShare returns the share of one of the hosts of the global rate limit, rounded up
+func hostShare(globalRPS, hosts int) int {
+	return (globalRPS + hosts - 1) / hosts
+}
@@ -23,6 +23,7 @@ package frontend
 import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/searchattribute"
 	"github.com/uber/cadence/common/service"
 )
 
@@ -45,48 +46,88 @@ func (s *Service) Start() {
 
 	var p = s.params
 	var log = p.Logger
+	var svcCfg = &p.ServiceConfig
 
 	log.Infof("%v starting", common.FrontendServiceName)
 
 	base := service.New(p)
 
-	metadata, err := persistence.NewCassandraMetadataPersistence(p.CassandraConfig.Hosts,
-		p.CassandraConfig.Datacenter,
-		p.CassandraConfig.Keyspace,
-		p.Logger)
+	payloadCodec, err := p.PersistenceConfig.PayloadCodec.NewCodec()
+	if err != nil {
+		log.Fatalf("failed to create payload codec: %v", err)
+	}
+	payloadBlobs, err := p.PersistenceConfig.BlobStore.NewPayloadBlobStore()
+	if err != nil {
+		log.Fatalf("failed to create payload blob store: %v", err)
+	}
+	historyArchive, err := p.PersistenceConfig.Archival.NewHistoryArchive(payloadCodec)
+	if err != nil {
+		log.Fatalf("failed to create history archive: %v", err)
+	}
 
+	metadata, err := p.PersistenceFactory.CreateMetadataManager()
 	if err != nil {
 		log.Fatalf("failed to create metadata manager: %v", err)
 	}
 	metadata = persistence.NewMetadataPersistenceClient(metadata, base.GetMetricsClient())
+	metadata = persistence.NewMetadataPersistenceRetryableClient(metadata, common.CreatePersistanceRetryPolicy(),
+		persistence.IsTransientError)
 
-	visibility, err := persistence.NewCassandraVisibilityPersistence(p.CassandraConfig.Hosts,
-		p.CassandraConfig.Datacenter,
-		p.CassandraConfig.VisibilityKeyspace,
-		p.Logger)
-
+	visibility, err := p.PersistenceFactory.CreateVisibilityManager()
 	if err != nil {
 		log.Fatalf("failed to create visiblity manager: %v", err)
 	}
 
-	history, err := persistence.NewCassandraHistoryPersistence(p.CassandraConfig.Hosts,
-		p.CassandraConfig.Datacenter,
-		p.CassandraConfig.Keyspace,
-		p.Logger)
-
+	history, err := p.PersistenceFactory.CreateHistoryManager()
 	if err != nil {
-		log.Fatalf("Creating Cassandra history manager persistence failed: %v", err)
+		log.Fatalf("Creating history manager persistence failed: %v", err)
 	}
 
 	history = persistence.NewHistoryPersistenceClient(history, base.GetMetricsClient())
+	if payloadCodec != nil || payloadBlobs != nil {
+		history = persistence.NewHistoryPayloadClient(history, payloadCodec, payloadBlobs)
+	}
+	history = persistence.NewHistoryPersistenceRetryableClient(history, common.CreatePersistanceRetryPolicy(),
+		persistence.IsTransientError)
 
 	handler, tchanServers := NewWorkflowHandler(base, metadata, history, visibility)
+	handler.SetAccessLogEnabled(svcCfg.AccessLog.Enabled)
+	handler.SetHistoryArchive(historyArchive)
+	handler.SetRateLimit(svcCfg.RateLimit.RPS)
+	handler.SetGlobalRateLimit(svcCfg.RateLimit.GlobalRPS)
+	handler.SetDomainRateLimit(svcCfg.RateLimit.DomainRPS, svcCfg.RateLimit.Domains)
+	handler.SetMaxWorkflowTimeouts(svcCfg.WorkflowTimeout.MaxExecutionTimeout, svcCfg.WorkflowTimeout.MaxTaskTimeout)
+	handler.SetIdentityRequired(svcCfg.Identity.Required)
+	handler.SetPayloadLimits(svcCfg.PayloadLimits)
+	handler.SetElasticsearchVisibility(p.PersistenceConfig.IsElasticsearchVisibility())
+	searchAttributes, err := searchattribute.NewRegistryFromConfig(p.SearchAttributes)
+	if err != nil {
+		log.Fatalf("invalid search attributes: %v", err)
+	}
+	handler.SetSearchAttributes(searchAttributes)
 	handler.Start(tchanServers)
 
+	var watcher *configWatcher
+	if p.ReloadConfig != nil && svcCfg.ConfigReload.Interval > 0 {
+		watcher = newConfigWatcher(handler, p.ReloadConfig, svcCfg.ConfigReload.Interval, runtimeSettings{
+			accessLog:        svcCfg.AccessLog,
+			hostRPS:          svcCfg.RateLimit.RPS,
+			globalRPS:        svcCfg.RateLimit.GlobalRPS,
+			domainRPS:        svcCfg.RateLimit.DomainRPS,
+			domains:          svcCfg.RateLimit.Domains,
+			payloadLimits:    svcCfg.PayloadLimits,
+			searchAttributes: p.SearchAttributes,
+		}, log)
+		watcher.start()
+	}
+
 	log.Infof("%v started", common.FrontendServiceName)
 
 	<-s.stopC
 
+	if watcher != nil {
+		watcher.stop()
+	}
 	base.Stop()
 }
 
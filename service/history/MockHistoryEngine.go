@@ -132,17 +132,26 @@ func (_m *MockHistoryEngine) RecordActivityTaskStarted(request *gohistory.Record
 }
 
 // RespondDecisionTaskCompleted is mock implementation for RespondDecisionTaskCompleted of HistoryEngine
-func (_m *MockHistoryEngine) RespondDecisionTaskCompleted(request *gohistory.RespondDecisionTaskCompletedRequest) error {
+func (_m *MockHistoryEngine) RespondDecisionTaskCompleted(request *gohistory.RespondDecisionTaskCompletedRequest) (*shared.RespondDecisionTaskCompletedResponse, error) {
 	ret := _m.Called(request)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*gohistory.RespondDecisionTaskCompletedRequest) error); ok {
+	var r0 *shared.RespondDecisionTaskCompletedResponse
+	if rf, ok := ret.Get(0).(func(*gohistory.RespondDecisionTaskCompletedRequest) *shared.RespondDecisionTaskCompletedResponse); ok {
 		r0 = rf(request)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.RespondDecisionTaskCompletedResponse)
+		}
 	}
 
-	return r0
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*gohistory.RespondDecisionTaskCompletedRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // RespondActivityTaskCompleted is mock implementation for RespondActivityTaskCompleted of HistoryEngine
@@ -238,6 +247,29 @@ func (_m *MockHistoryEngine) SignalWorkflowExecution(request *gohistory.SignalWo
 	return r0
 }
 
+// SignalWithStartWorkflowExecution is mock implementation for SignalWithStartWorkflowExecution of HistoryEngine
+func (_m *MockHistoryEngine) SignalWithStartWorkflowExecution(request *gohistory.SignalWithStartWorkflowExecutionRequest) (*shared.StartWorkflowExecutionResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *shared.StartWorkflowExecutionResponse
+	if rf, ok := ret.Get(0).(func(*gohistory.SignalWithStartWorkflowExecutionRequest) *shared.StartWorkflowExecutionResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.StartWorkflowExecutionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*gohistory.SignalWithStartWorkflowExecutionRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // TerminateWorkflowExecution is mock implementation for TerminateWorkflowExecution of HistoryEngine
 func (_m *MockHistoryEngine) TerminateWorkflowExecution(request *gohistory.TerminateWorkflowExecutionRequest) error {
 	ret := _m.Called(request)
@@ -252,6 +284,29 @@ func (_m *MockHistoryEngine) TerminateWorkflowExecution(request *gohistory.Termi
 	return r0
 }
 
+// ResetWorkflowExecution is mock implementation for ResetWorkflowExecution of HistoryEngine
+func (_m *MockHistoryEngine) ResetWorkflowExecution(request *gohistory.ResetWorkflowExecutionRequest) (*shared.ResetWorkflowExecutionResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *shared.ResetWorkflowExecutionResponse
+	if rf, ok := ret.Get(0).(func(*gohistory.ResetWorkflowExecutionRequest) *shared.ResetWorkflowExecutionResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.ResetWorkflowExecutionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*gohistory.ResetWorkflowExecutionRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ScheduleDecisionTask is mock implementation for ScheduleDecisionTask of HistoryEngine
 func (_m *MockHistoryEngine) ScheduleDecisionTask(request *gohistory.ScheduleDecisionTaskRequest) error {
 	ret := _m.Called(request)
@@ -280,4 +335,18 @@ func (_m *MockHistoryEngine) RecordChildExecutionCompleted(request *gohistory.Re
 	return r0
 }
 
+// InvalidateMutableState is mock implementation for InvalidateMutableState of HistoryEngine
+func (_m *MockHistoryEngine) InvalidateMutableState(request *gohistory.InvalidateMutableStateRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gohistory.InvalidateMutableStateRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 var _ Engine = (*MockHistoryEngine)(nil)
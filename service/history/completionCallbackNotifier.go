@@ -0,0 +1,196 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/uber-common/bark"
+
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service/config"
+)
+
+const (
+	// defaultCompletionCallbackTimeout is the timeout of a single callback request, unless configured
+	defaultCompletionCallbackTimeout = 10 * time.Second
+	// defaultCompletionCallbackMaxRetries is the number of retries of a failed callback, unless configured
+	defaultCompletionCallbackMaxRetries = 4
+
+	completionCallbackInitialInterval = time.Second
+	completionCallbackMaxInterval     = 30 * time.Second
+)
+
+type (
+	// completionCallbackNotifier POSTs a completionCallback to the completion callback URL registered by the start
+	// of a workflow, once the workflow has closed.  Failed requests are retried with an exponential backoff, and the
+	// callbacks which could still not be delivered are appended to the dead letter file, if any, so that they can be
+	// replayed by an operator.
+	completionCallbackNotifier struct {
+		client         *http.Client
+		retryPolicy    backoff.RetryPolicy
+		deadLetterFile string
+		deadLetterLock sync.Mutex
+		logger         bark.Logger
+		metricsClient  metrics.Client
+	}
+
+	// completionCallback is the body of the request sent to the completion callback URL of a closed workflow.  The
+	// receiver gets the result of the run with GetWorkflowResult or DescribeWorkflowExecution.
+	completionCallback struct {
+		DomainID   string `json:"domainId"`
+		WorkflowID string `json:"workflowId"`
+		RunID      string `json:"runId"`
+	}
+
+	// deadLetteredCompletionCallback is a line of the dead letter file
+	deadLetteredCompletionCallback struct {
+		URL      string              `json:"url"`
+		Callback *completionCallback `json:"callback"`
+		Error    string              `json:"error"`
+		Time     time.Time           `json:"time"`
+	}
+
+	// completionCallbackError is returned for a callback request answered with an error status
+	completionCallbackError struct {
+		statusCode int
+	}
+)
+
+func newCompletionCallbackNotifier(cfg config.CompletionCallback, logger bark.Logger,
+	metricsClient metrics.Client) *completionCallbackNotifier {
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultCompletionCallbackTimeout
+	}
+	maxRetries := cfg.MaxRetries
+	if maxRetries <= 0 {
+		maxRetries = defaultCompletionCallbackMaxRetries
+	}
+
+	retryPolicy := backoff.NewExponentialRetryPolicy(completionCallbackInitialInterval)
+	retryPolicy.SetMaximumInterval(completionCallbackMaxInterval)
+	retryPolicy.SetExpirationInterval(backoff.NoInterval)
+	retryPolicy.SetMaximumAttempts(maxRetries)
+
+	return &completionCallbackNotifier{
+		client:         &http.Client{Timeout: timeout},
+		retryPolicy:    retryPolicy,
+		deadLetterFile: cfg.DeadLetterFile,
+		logger:         logger,
+		metricsClient:  metricsClient,
+	}
+}
+
+// notify delivers the callback to the URL, and dead letters it once all the attempts have failed.  An error is only
+// returned when the callback could neither be delivered nor dead lettered.
+func (n *completionCallbackNotifier) notify(url string, callback *completionCallback) error {
+	body, err := json.Marshal(callback)
+	if err != nil {
+		return err
+	}
+
+	op := func() error {
+		err := n.post(url, body)
+		if err != nil {
+			n.metricsClient.IncCounter(metrics.TransferTaskCompletionCallbackScope,
+				metrics.CompletionCallbackAttemptFailedCounter)
+		}
+		return err
+	}
+	err = backoff.Retry(op, n.retryPolicy, isCompletionCallbackErrorRetryable)
+	if err == nil {
+		return nil
+	}
+
+	n.metricsClient.IncCounter(metrics.TransferTaskCompletionCallbackScope,
+		metrics.CompletionCallbackDeadLetteredCounter)
+	n.logger.WithFields(bark.Fields{
+		"URL":        url,
+		"DomainID":   callback.DomainID,
+		"WorkflowID": callback.WorkflowID,
+		"RunID":      callback.RunID,
+	}).Warnf("Failed to deliver completion callback: %v", err)
+	return n.deadLetter(url, callback, err)
+}
+
+func (n *completionCallbackNotifier) post(url string, body []byte) error {
+	response, err := n.client.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return &completionCallbackError{statusCode: response.StatusCode}
+	}
+	return nil
+}
+
+func (n *completionCallbackNotifier) deadLetter(url string, callback *completionCallback, cause error) error {
+	if n.deadLetterFile == "" {
+		return nil
+	}
+
+	line, err := json.Marshal(&deadLetteredCompletionCallback{
+		URL:      url,
+		Callback: callback,
+		Error:    cause.Error(),
+		Time:     time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	n.deadLetterLock.Lock()
+	defer n.deadLetterLock.Unlock()
+
+	f, err := os.OpenFile(n.deadLetterFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// isCompletionCallbackErrorRetryable retries the requests which failed to reach the receiver, timed out, were
+// throttled or failed on the side of the receiver
+func isCompletionCallbackErrorRetryable(err error) bool {
+	if e, ok := err.(*completionCallbackError); ok {
+		return e.statusCode >= 500 || e.statusCode == http.StatusRequestTimeout ||
+			e.statusCode == http.StatusTooManyRequests
+	}
+	return true
+}
+
+func (e *completionCallbackError) Error() string {
+	return fmt.Sprintf("completion callback failed with status %v", e.statusCode)
+}
@@ -0,0 +1,184 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service/config"
+)
+
+type (
+	completionCallbackNotifierSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+		deadLetterDir string
+		notifier      *completionCallbackNotifier
+		callback      *completionCallback
+	}
+)
+
+func TestCompletionCallbackNotifierSuite(t *testing.T) {
+	s := new(completionCallbackNotifierSuite)
+	suite.Run(t, s)
+}
+
+func (s *completionCallbackNotifierSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	var err error
+	s.deadLetterDir, err = ioutil.TempDir("", "completion-callback")
+	s.Nil(err)
+
+	s.notifier = newCompletionCallbackNotifier(config.CompletionCallback{
+		MaxRetries:     2,
+		DeadLetterFile: filepath.Join(s.deadLetterDir, "dlq.json"),
+	}, bark.NewLoggerFromLogrus(log.New()), metrics.NewClient(tally.NoopScope, metrics.History))
+	retryPolicy := backoff.NewExponentialRetryPolicy(time.Millisecond)
+	retryPolicy.SetMaximumAttempts(2)
+	s.notifier.retryPolicy = retryPolicy
+
+	s.callback = &completionCallback{
+		DomainID:   "3c8a5a9e-1b3f-4b7e-9f7e-0c52fe8b1e4d",
+		WorkflowID: "completion-callback-test",
+		RunID:      "b0a8b1c6-2d8e-4b1f-8d8f-3b1e4c2d6a7f",
+	}
+}
+
+func (s *completionCallbackNotifierSuite) TearDownTest() {
+	os.RemoveAll(s.deadLetterDir)
+}
+
+func (s *completionCallbackNotifierSuite) TestDefaults() {
+	notifier := newCompletionCallbackNotifier(config.CompletionCallback{}, bark.NewLoggerFromLogrus(log.New()),
+		metrics.NewClient(tally.NoopScope, metrics.History))
+	s.Equal(defaultCompletionCallbackTimeout, notifier.client.Timeout)
+	s.Equal("", notifier.deadLetterFile)
+}
+
+func (s *completionCallbackNotifierSuite) TestDelivered() {
+	var received completionCallback
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.Equal(http.MethodPost, r.Method)
+		s.Equal("application/json", r.Header.Get("Content-Type"))
+		s.Nil(json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	s.Nil(s.notifier.notify(server.URL, s.callback))
+	s.Equal(*s.callback, received)
+	s.noDeadLetters()
+}
+
+func (s *completionCallbackNotifierSuite) TestRetriedUntilDelivered() {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	s.Nil(s.notifier.notify(server.URL, s.callback))
+	s.Equal(int32(3), atomic.LoadInt32(&requests))
+	s.noDeadLetters()
+}
+
+func (s *completionCallbackNotifierSuite) TestDeadLetteredAfterRetries() {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	s.Nil(s.notifier.notify(server.URL, s.callback))
+	s.Equal(int32(3), atomic.LoadInt32(&requests))
+
+	deadLetters := s.deadLetters()
+	s.Equal(1, len(deadLetters))
+	s.Equal(server.URL, deadLetters[0].URL)
+	s.Equal(s.callback, deadLetters[0].Callback)
+	s.Contains(deadLetters[0].Error, "500")
+}
+
+func (s *completionCallbackNotifierSuite) TestClientErrorNotRetried() {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	s.Nil(s.notifier.notify(server.URL, s.callback))
+	s.Equal(int32(1), atomic.LoadInt32(&requests))
+	s.Equal(1, len(s.deadLetters()))
+}
+
+func (s *completionCallbackNotifierSuite) TestDeadLetterFailure() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	s.notifier.deadLetterFile = filepath.Join(s.deadLetterDir, "missing", "dlq.json")
+	s.NotNil(s.notifier.notify(server.URL, s.callback))
+}
+
+func (s *completionCallbackNotifierSuite) noDeadLetters() {
+	_, err := os.Stat(s.notifier.deadLetterFile)
+	s.True(os.IsNotExist(err))
+}
+
+func (s *completionCallbackNotifierSuite) deadLetters() []*deadLetteredCompletionCallback {
+	content, err := ioutil.ReadFile(s.notifier.deadLetterFile)
+	s.Nil(err)
+
+	var deadLetters []*deadLetteredCompletionCallback
+	for _, line := range strings.Split(strings.TrimSpace(string(content)), "\n") {
+		deadLetter := &deadLetteredCompletionCallback{}
+		s.Nil(json.Unmarshal([]byte(line), deadLetter))
+		deadLetters = append(deadLetters, deadLetter)
+	}
+	return deadLetters
+}
@@ -21,7 +21,10 @@
 package history
 
 import (
+	"strconv"
+
 	"github.com/uber-common/bark"
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service/config"
@@ -30,38 +33,72 @@ import (
 // executionMgrFactory is an implementation of
 // persistence.ExecutionManagerFactory interface
 type executionMgrFactory struct {
-	config        *config.Cassandra
-	logger        bark.Logger
-	metricsClient metrics.Client
+	stores            persistence.Factory
+	shadowStores      persistence.Factory
+	persistenceConfig *config.Persistence
+	payloadCodec      persistence.PayloadCodec
+	payloadBlobs      *persistence.PayloadBlobStore
+	logger            bark.Logger
+	metricsClient     metrics.Client
 }
 
-// NewExecutionManagerFactory builds and returns a factory object
-func NewExecutionManagerFactory(config *config.Cassandra,
-	logger bark.Logger, mClient metrics.Client) persistence.ExecutionManagerFactory {
+// NewExecutionManagerFactory builds and returns a factory object wrapping the execution managers of the stores into
+// the persistence clients of the history service.  The factory of the shadow stores, the payload codec and the blob
+// store are optional.
+func NewExecutionManagerFactory(stores persistence.Factory, shadowStores persistence.Factory,
+	persistenceConfig *config.Persistence, payloadCodec persistence.PayloadCodec,
+	payloadBlobs *persistence.PayloadBlobStore, logger bark.Logger,
+	mClient metrics.Client) persistence.ExecutionManagerFactory {
 
 	return &executionMgrFactory{
-		config:        config,
-		logger:        logger,
-		metricsClient: mClient,
+		stores:            stores,
+		shadowStores:      shadowStores,
+		persistenceConfig: persistenceConfig,
+		payloadCodec:      payloadCodec,
+		payloadBlobs:      payloadBlobs,
+		logger:            logger,
+		metricsClient:     mClient,
 	}
 }
 
 // CreateExecutionManager implements ExecutionManagerFactory interface
 func (factory *executionMgrFactory) CreateExecutionManager(shardID int) (persistence.ExecutionManager, error) {
 
-	mgr, err := persistence.NewCassandraWorkflowExecutionPersistence(
-		factory.config.Hosts,
-		factory.config.Datacenter,
-		factory.config.Keyspace,
-		shardID,
-		factory.logger)
-
+	mgr, err := factory.stores.CreateExecutionManager(shardID)
 	if err != nil {
 		return nil, err
 	}
 
 	tags := map[string]string{
-		metrics.ShardTagName: string(shardID),
+		metrics.ShardTagName: strconv.Itoa(shardID),
+	}
+	if factory.shadowStores != nil {
+		secondary, err := factory.shadowStores.CreateExecutionManager(shardID)
+		if err != nil {
+			mgr.Close()
+			return nil, err
+		}
+		mgr = persistence.NewWorkflowExecutionPersistenceShadowClient(mgr, secondary,
+			factory.metricsClient.Tagged(tags), factory.logger)
+	}
+
+	limit := factory.persistenceConfig.ShardRateLimit.ForShard(shardID)
+	if limit.ReadRPS > 0 || limit.WriteRPS > 0 {
+		mgr = persistence.NewWorkflowExecutionPersistenceRateLimitedClient(mgr, newTokenBucket(limit.ReadRPS),
+			newTokenBucket(limit.WriteRPS))
+	}
+
+	mgr = persistence.NewWorkflowExecutionPersistenceClient(mgr, factory.metricsClient.Tagged(tags))
+	if factory.payloadCodec != nil || factory.payloadBlobs != nil {
+		mgr = persistence.NewWorkflowExecutionPayloadClient(mgr, factory.payloadCodec, factory.payloadBlobs)
+	}
+	return mgr, nil
+}
+
+// newTokenBucket returns the token bucket allowing rps requests per second, nil if rps is not positive
+func newTokenBucket(rps int) common.TokenBucket {
+	if rps <= 0 {
+		return nil
 	}
-	return persistence.NewWorkflowExecutionPersistenceClient(mgr, factory.metricsClient.Tagged(tags)), nil
+	return common.NewTokenBucket(rps, common.NewRealTimeSource())
 }
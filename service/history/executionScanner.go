@@ -0,0 +1,425 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber-common/bark"
+
+	hist "github.com/uber/cadence/.gen/go/history"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/config"
+)
+
+const (
+	// defaultExecutionScannerPageSize is the number of executions read at once, unless configured
+	defaultExecutionScannerPageSize = 100
+
+	executionAnomalyMissingHistory  = "missing-history"
+	executionAnomalyOrphanedCurrent = "orphaned-current-execution"
+	executionAnomalyZombieActivity  = "zombie-activity"
+)
+
+type (
+	// executionScanner periodically walks the executions of the shards owned by the host, looking for corrupted
+	// executions: executions without history, current executions pointing at a run which does not exist, and
+	// activities whose scheduled event is beyond the end of the history.  Each anomaly is counted and logged, and,
+	// depending on the configured action, the corrupted data is appended to the quarantine file and deleted.
+	executionScanner struct {
+		controller     *shardController
+		interval       time.Duration
+		pageSize       int
+		action         string
+		quarantineFile string
+		logger         bark.Logger
+		metricsClient  metrics.Client
+		started        int32
+		shutdownCh     chan struct{}
+		shutdownWG     sync.WaitGroup
+	}
+
+	// executionAnomaly is a corrupted execution found by the scanner, and a line of the quarantine file
+	executionAnomaly struct {
+		Type       string                            `json:"type"`
+		ShardID    int                               `json:"shardId"`
+		DomainID   string                            `json:"domainId"`
+		WorkflowID string                            `json:"workflowId"`
+		RunID      string                            `json:"runId"`
+		Execution  *persistence.WorkflowMutableState `json:"execution,omitempty"`
+		Activity   *persistence.ActivityInfo         `json:"activity,omitempty"`
+		Time       time.Time                         `json:"time"`
+	}
+)
+
+func newExecutionScanner(cfg config.ExecutionScanner, controller *shardController, logger bark.Logger,
+	metricsClient metrics.Client) *executionScanner {
+	pageSize := cfg.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultExecutionScannerPageSize
+	}
+	action := cfg.Action
+	if action == "" {
+		action = config.ExecutionScannerActionReport
+	}
+	return &executionScanner{
+		controller:     controller,
+		interval:       cfg.Interval,
+		pageSize:       pageSize,
+		action:         action,
+		quarantineFile: cfg.QuarantineFile,
+		logger:         logger,
+		metricsClient:  metricsClient,
+		shutdownCh:     make(chan struct{}),
+	}
+}
+
+// Start starts the periodic scan of the shards
+func (s *executionScanner) Start() {
+	if !atomic.CompareAndSwapInt32(&s.started, 0, 1) {
+		return
+	}
+
+	s.shutdownWG.Add(1)
+	go s.scanLoop()
+}
+
+// Stop stops the periodic scan of the shards, interrupting the scan in progress
+func (s *executionScanner) Stop() {
+	if !atomic.CompareAndSwapInt32(&s.started, 1, 2) {
+		return
+	}
+
+	close(s.shutdownCh)
+	s.shutdownWG.Wait()
+}
+
+func (s *executionScanner) scanLoop() {
+	defer s.shutdownWG.Done()
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.shutdownCh:
+			return
+		case <-ticker.C:
+			s.scanShards()
+		}
+	}
+}
+
+// scanShards scans the shards owned by the host one after the other, so that a scan adds little load on the datastore
+func (s *executionScanner) scanShards() {
+	for _, shardID := range s.controller.getShardIDs() {
+		if s.isStopped() {
+			return
+		}
+
+		shard, err := s.controller.getShardContext(shardID)
+		if err != nil {
+			// the shard moved to another host since it was listed
+			continue
+		}
+		engine, err := s.controller.getEngineForShard(shardID)
+		if err != nil {
+			continue
+		}
+		if err := s.scanShard(shard, engine); err != nil {
+			s.logger.WithField(logging.TagHistoryShardID, shardID).Warnf("Failed to scan shard: %v", err)
+		}
+	}
+}
+
+// scanShard checks all the executions and current executions of a shard
+func (s *executionScanner) scanShard(shard ShardContext, engine Engine) error {
+	if err := s.scanConcreteExecutions(shard, engine); err != nil {
+		return err
+	}
+	return s.scanCurrentExecutions(shard)
+}
+
+func (s *executionScanner) scanConcreteExecutions(shard ShardContext, engine Engine) error {
+	request := &persistence.ListConcreteExecutionsRequest{PageSize: s.pageSize}
+	for {
+		response, err := shard.GetExecutionManager().ListConcreteExecutions(request)
+		if err != nil {
+			return err
+		}
+
+		for _, info := range response.ExecutionInfos {
+			if s.isStopped() {
+				return nil
+			}
+			s.metricsClient.IncCounter(metrics.HistoryExecutionScannerScope, metrics.ExecutionScannerScannedCounter)
+			if err := s.checkExecution(shard, engine, info); err != nil {
+				return err
+			}
+		}
+
+		if len(response.NextPageToken) == 0 {
+			return nil
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+}
+
+// checkExecution looks for the history of the execution and for its zombie activities.  The mutable state is read
+// again first, as the listed execution may have been deleted since.
+func (s *executionScanner) checkExecution(shard ShardContext, engine Engine,
+	info *persistence.WorkflowExecutionInfo) error {
+	executionManager := shard.GetExecutionManager()
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(info.WorkflowID),
+		RunId:      common.StringPtr(info.RunID),
+	}
+
+	response, err := executionManager.GetWorkflowExecution(&persistence.GetWorkflowExecutionRequest{
+		DomainID:  info.DomainID,
+		Execution: execution,
+	})
+	if err != nil {
+		if _, ok := err.(*workflow.EntityNotExistsError); ok {
+			return nil
+		}
+		return err
+	}
+	state := response.State
+	info = state.ExecutionInfo
+
+	if _, err := shard.GetHistoryManager().GetWorkflowExecutionHistory(&persistence.GetWorkflowExecutionHistoryRequest{
+		DomainID:    info.DomainID,
+		Execution:   execution,
+		NextEventID: info.NextEventID,
+		PageSize:    1,
+	}); err != nil {
+		if _, ok := err.(*workflow.EntityNotExistsError); !ok {
+			return err
+		}
+
+		s.handleAnomaly(&executionAnomaly{
+			Type:      executionAnomalyMissingHistory,
+			Execution: state,
+		}, shard, metrics.ExecutionScannerMissingHistoryCounter, func() error {
+			if err := executionManager.DeleteWorkflowExecution(&persistence.DeleteWorkflowExecutionRequest{
+				ExecutionInfo: info,
+			}); err != nil {
+				return err
+			}
+			if err := executionManager.DeleteCurrentWorkflowExecution(
+				&persistence.DeleteCurrentWorkflowExecutionRequest{
+					DomainID:   info.DomainID,
+					WorkflowID: info.WorkflowID,
+					RunID:      info.RunID,
+				}); err != nil {
+				return err
+			}
+			return s.invalidateMutableState(engine, info)
+		})
+		return nil
+	}
+
+	for _, ai := range state.ActivitInfos {
+		if ai.ScheduleID < info.NextEventID {
+			continue
+		}
+
+		scheduleID := ai.ScheduleID
+		s.handleAnomaly(&executionAnomaly{
+			Type:      executionAnomalyZombieActivity,
+			Execution: state,
+			Activity:  ai,
+		}, shard, metrics.ExecutionScannerZombieActivityCounter, func() error {
+			// the update fails if the execution has made progress since it was read
+			if _, err := shard.UpdateWorkflowExecution(&persistence.UpdateWorkflowExecutionRequest{
+				ExecutionInfo:      info,
+				Condition:          info.NextEventID,
+				DeleteActivityInfo: &scheduleID,
+			}); err != nil {
+				return err
+			}
+			return s.invalidateMutableState(engine, info)
+		})
+	}
+	return nil
+}
+
+func (s *executionScanner) scanCurrentExecutions(shard ShardContext) error {
+	request := &persistence.ListCurrentExecutionsRequest{PageSize: s.pageSize}
+	for {
+		response, err := shard.GetExecutionManager().ListCurrentExecutions(request)
+		if err != nil {
+			return err
+		}
+
+		for _, current := range response.Executions {
+			if s.isStopped() {
+				return nil
+			}
+			s.metricsClient.IncCounter(metrics.HistoryExecutionScannerScope, metrics.ExecutionScannerScannedCounter)
+			if err := s.checkCurrentExecution(shard, current); err != nil {
+				return err
+			}
+		}
+
+		if len(response.NextPageToken) == 0 {
+			return nil
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+}
+
+// checkCurrentExecution looks for the run the current execution points at.  A run which is not found is only an
+// anomaly if the current execution still points at it, as the run may have closed and been deleted since the current
+// execution was listed.
+func (s *executionScanner) checkCurrentExecution(shard ShardContext, current *persistence.CurrentExecution) error {
+	executionManager := shard.GetExecutionManager()
+	_, err := executionManager.GetWorkflowExecution(&persistence.GetWorkflowExecutionRequest{
+		DomainID: current.DomainID,
+		Execution: workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(current.WorkflowID),
+			RunId:      common.StringPtr(current.RunID),
+		},
+		Projection: persistence.MutableStateProjectionExecutionInfo,
+	})
+	if _, ok := err.(*workflow.EntityNotExistsError); !ok {
+		return err
+	}
+
+	response, err := executionManager.GetCurrentExecution(&persistence.GetCurrentExecutionRequest{
+		DomainID:   current.DomainID,
+		WorkflowID: current.WorkflowID,
+	})
+	if err != nil {
+		if _, ok := err.(*workflow.EntityNotExistsError); ok {
+			return nil
+		}
+		return err
+	}
+	if response.RunID != current.RunID {
+		return nil
+	}
+
+	s.handleAnomaly(&executionAnomaly{
+		Type:       executionAnomalyOrphanedCurrent,
+		DomainID:   current.DomainID,
+		WorkflowID: current.WorkflowID,
+		RunID:      current.RunID,
+	}, shard, metrics.ExecutionScannerOrphanedCurrentCounter, func() error {
+		return executionManager.DeleteCurrentWorkflowExecution(&persistence.DeleteCurrentWorkflowExecutionRequest{
+			DomainID:   current.DomainID,
+			WorkflowID: current.WorkflowID,
+			RunID:      current.RunID,
+		})
+	})
+	return nil
+}
+
+// handleAnomaly reports an anomaly, then quarantines or deletes the corrupted data with repair according to the
+// configured action.  A failed repair is reported and retried by the next scan.
+func (s *executionScanner) handleAnomaly(anomaly *executionAnomaly, shard ShardContext, counter int,
+	repair func() error) {
+	anomaly.ShardID = shard.GetShardID()
+	if anomaly.Execution != nil {
+		anomaly.DomainID = anomaly.Execution.ExecutionInfo.DomainID
+		anomaly.WorkflowID = anomaly.Execution.ExecutionInfo.WorkflowID
+		anomaly.RunID = anomaly.Execution.ExecutionInfo.RunID
+	}
+	anomaly.Time = time.Now()
+
+	s.metricsClient.IncCounter(metrics.HistoryExecutionScannerScope, counter)
+	logger := s.logger.WithFields(bark.Fields{
+		logging.TagHistoryShardID: anomaly.ShardID,
+		"Anomaly":                 anomaly.Type,
+		"DomainID":                anomaly.DomainID,
+		"WorkflowID":              anomaly.WorkflowID,
+		"RunID":                   anomaly.RunID,
+	})
+	logger.Warnf("Found corrupted workflow execution")
+
+	switch s.action {
+	case config.ExecutionScannerActionQuarantine:
+		if err := s.quarantine(anomaly); err != nil {
+			s.metricsClient.IncCounter(metrics.HistoryExecutionScannerScope, metrics.ExecutionScannerRepairFailedCounter)
+			logger.Warnf("Failed to quarantine corrupted workflow execution: %v", err)
+			return
+		}
+		if err := repair(); err != nil {
+			s.metricsClient.IncCounter(metrics.HistoryExecutionScannerScope, metrics.ExecutionScannerRepairFailedCounter)
+			logger.Warnf("Failed to delete quarantined workflow execution: %v", err)
+			return
+		}
+		s.metricsClient.IncCounter(metrics.HistoryExecutionScannerScope, metrics.ExecutionScannerQuarantinedCounter)
+	case config.ExecutionScannerActionDelete:
+		if err := repair(); err != nil {
+			s.metricsClient.IncCounter(metrics.HistoryExecutionScannerScope, metrics.ExecutionScannerRepairFailedCounter)
+			logger.Warnf("Failed to delete corrupted workflow execution: %v", err)
+			return
+		}
+		s.metricsClient.IncCounter(metrics.HistoryExecutionScannerScope, metrics.ExecutionScannerDeletedCounter)
+	}
+}
+
+func (s *executionScanner) quarantine(anomaly *executionAnomaly) error {
+	line, err := json.Marshal(anomaly)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(s.quarantineFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// invalidateMutableState drops the mutable state of a repaired execution from the cache of the shard, so that the
+// repair is not overwritten with the cached state
+func (s *executionScanner) invalidateMutableState(engine Engine, info *persistence.WorkflowExecutionInfo) error {
+	return engine.InvalidateMutableState(&hist.InvalidateMutableStateRequest{
+		DomainUUID: common.StringPtr(info.DomainID),
+		Execution: &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(info.WorkflowID),
+			RunId:      common.StringPtr(info.RunID),
+		},
+	})
+}
+
+func (s *executionScanner) isStopped() bool {
+	select {
+	case <-s.shutdownCh:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,290 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+	"github.com/uber-go/tally"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/config"
+)
+
+type (
+	executionScannerSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+		logger           bark.Logger
+		mockExecutionMgr *mocks.ExecutionManager
+		mockHistoryMgr   *mocks.HistoryManager
+		mockEngine       *MockHistoryEngine
+		shard            *shardContextImpl
+		quarantineDir    string
+		info             *persistence.WorkflowExecutionInfo
+	}
+)
+
+func TestExecutionScannerSuite(t *testing.T) {
+	s := new(executionScannerSuite)
+	suite.Run(t, s)
+}
+
+func (s *executionScannerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.logger = bark.NewLoggerFromLogrus(log.New())
+	s.mockExecutionMgr = &mocks.ExecutionManager{}
+	s.mockHistoryMgr = &mocks.HistoryManager{}
+	s.mockEngine = &MockHistoryEngine{}
+	s.shard = &shardContextImpl{
+		shardID:           1,
+		rangeID:           1,
+		shardInfo:         &persistence.ShardInfo{ShardID: 1, RangeID: 1},
+		executionManager:  s.mockExecutionMgr,
+		historyMgr:        s.mockHistoryMgr,
+		logger:            s.logger,
+		metricsClient:     metrics.NewClient(tally.NoopScope, metrics.History),
+		historySerializer: persistence.NewJSONHistorySerializer(),
+	}
+
+	var err error
+	s.quarantineDir, err = ioutil.TempDir("", "execution-scanner")
+	s.Nil(err)
+
+	s.info = &persistence.WorkflowExecutionInfo{
+		DomainID:    "6a6a0b3e-5b3e-4f0b-8f1c-4f6b9b2a3c1d",
+		WorkflowID:  "execution-scanner-test",
+		RunID:       "0d2b4f5e-7c1a-4e8b-9d3f-2a6c8e1b5f7d",
+		NextEventID: 10,
+	}
+}
+
+func (s *executionScannerSuite) TearDownTest() {
+	s.mockExecutionMgr.AssertExpectations(s.T())
+	s.mockHistoryMgr.AssertExpectations(s.T())
+	s.mockEngine.AssertExpectations(s.T())
+	os.RemoveAll(s.quarantineDir)
+}
+
+func (s *executionScannerSuite) TestDefaults() {
+	scanner := s.newScanner("")
+	s.Equal(defaultExecutionScannerPageSize, scanner.pageSize)
+	s.Equal(config.ExecutionScannerActionReport, scanner.action)
+}
+
+func (s *executionScannerSuite) TestHealthyExecution() {
+	s.expectConcreteExecutions(s.info)
+	s.expectMutableState(nil)
+	s.expectHistory(nil)
+	s.expectCurrentExecutions()
+
+	s.Nil(s.newScanner("").scanShard(s.shard, s.mockEngine))
+}
+
+func (s *executionScannerSuite) TestMissingHistoryReported() {
+	s.expectConcreteExecutions(s.info)
+	s.expectMutableState(nil)
+	s.expectHistory(&workflow.EntityNotExistsError{})
+	s.expectCurrentExecutions()
+
+	s.Nil(s.newScanner(config.ExecutionScannerActionReport).scanShard(s.shard, s.mockEngine))
+}
+
+func (s *executionScannerSuite) TestMissingHistoryDeleted() {
+	s.expectConcreteExecutions(s.info)
+	s.expectMutableState(nil)
+	s.expectHistory(&workflow.EntityNotExistsError{})
+	s.mockExecutionMgr.On("DeleteWorkflowExecution", &persistence.DeleteWorkflowExecutionRequest{
+		ExecutionInfo: s.info,
+	}).Return(nil).Once()
+	s.mockExecutionMgr.On("DeleteCurrentWorkflowExecution", &persistence.DeleteCurrentWorkflowExecutionRequest{
+		DomainID:   s.info.DomainID,
+		WorkflowID: s.info.WorkflowID,
+		RunID:      s.info.RunID,
+	}).Return(nil).Once()
+	s.mockEngine.On("InvalidateMutableState", mock.Anything).Return(nil).Once()
+	s.expectCurrentExecutions()
+
+	s.Nil(s.newScanner(config.ExecutionScannerActionDelete).scanShard(s.shard, s.mockEngine))
+}
+
+func (s *executionScannerSuite) TestExecutionDeletedSinceListed() {
+	s.expectConcreteExecutions(s.info)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(nil,
+		&workflow.EntityNotExistsError{}).Once()
+	s.expectCurrentExecutions()
+
+	s.Nil(s.newScanner(config.ExecutionScannerActionDelete).scanShard(s.shard, s.mockEngine))
+}
+
+func (s *executionScannerSuite) TestZombieActivityQuarantined() {
+	s.expectConcreteExecutions(s.info)
+	s.expectMutableState(map[int64]*persistence.ActivityInfo{
+		5:  {ScheduleID: 5, ActivityID: "healthy"},
+		12: {ScheduleID: 12, ActivityID: "zombie"},
+	})
+	s.expectHistory(nil)
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(
+		func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+			return request.DeleteActivityInfo != nil && *request.DeleteActivityInfo == 12 &&
+				request.Condition == s.info.NextEventID
+		})).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil).Once()
+	s.mockEngine.On("InvalidateMutableState", mock.Anything).Return(nil).Once()
+	s.expectCurrentExecutions()
+
+	s.Nil(s.newScanner(config.ExecutionScannerActionQuarantine).scanShard(s.shard, s.mockEngine))
+
+	anomalies := s.quarantined()
+	s.Equal(1, len(anomalies))
+	s.Equal(executionAnomalyZombieActivity, anomalies[0].Type)
+	s.Equal(1, anomalies[0].ShardID)
+	s.Equal(s.info.RunID, anomalies[0].RunID)
+	s.Equal("zombie", anomalies[0].Activity.ActivityID)
+}
+
+func (s *executionScannerSuite) TestQuarantineFailureKeepsExecution() {
+	s.expectConcreteExecutions(s.info)
+	s.expectMutableState(nil)
+	s.expectHistory(&workflow.EntityNotExistsError{})
+	s.expectCurrentExecutions()
+
+	scanner := s.newScanner(config.ExecutionScannerActionQuarantine)
+	scanner.quarantineFile = filepath.Join(s.quarantineDir, "missing", "quarantine.json")
+	s.Nil(scanner.scanShard(s.shard, s.mockEngine))
+}
+
+func (s *executionScannerSuite) TestOrphanedCurrentExecutionDeleted() {
+	s.expectConcreteExecutions()
+	current := &persistence.CurrentExecution{
+		DomainID:   s.info.DomainID,
+		WorkflowID: s.info.WorkflowID,
+		RunID:      s.info.RunID,
+	}
+	s.expectCurrentExecutions(current)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(nil,
+		&workflow.EntityNotExistsError{}).Once()
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(
+		&persistence.GetCurrentExecutionResponse{RunID: s.info.RunID}, nil).Once()
+	s.mockExecutionMgr.On("DeleteCurrentWorkflowExecution", &persistence.DeleteCurrentWorkflowExecutionRequest{
+		DomainID:   s.info.DomainID,
+		WorkflowID: s.info.WorkflowID,
+		RunID:      s.info.RunID,
+	}).Return(nil).Once()
+
+	s.Nil(s.newScanner(config.ExecutionScannerActionDelete).scanShard(s.shard, s.mockEngine))
+}
+
+func (s *executionScannerSuite) TestCurrentExecutionClosedSinceListed() {
+	s.expectConcreteExecutions()
+	s.expectCurrentExecutions(&persistence.CurrentExecution{
+		DomainID:   s.info.DomainID,
+		WorkflowID: s.info.WorkflowID,
+		RunID:      s.info.RunID,
+	})
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(nil,
+		&workflow.EntityNotExistsError{}).Once()
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(nil,
+		&workflow.EntityNotExistsError{}).Once()
+
+	s.Nil(s.newScanner(config.ExecutionScannerActionDelete).scanShard(s.shard, s.mockEngine))
+}
+
+func (s *executionScannerSuite) TestPaging() {
+	s.mockExecutionMgr.On("ListConcreteExecutions", &persistence.ListConcreteExecutionsRequest{PageSize: 1}).Return(
+		&persistence.ListConcreteExecutionsResponse{
+			ExecutionInfos: []*persistence.WorkflowExecutionInfo{s.info},
+			NextPageToken:  []byte("next"),
+		}, nil).Once()
+	s.mockExecutionMgr.On("ListConcreteExecutions", &persistence.ListConcreteExecutionsRequest{
+		PageSize:      1,
+		NextPageToken: []byte("next"),
+	}).Return(&persistence.ListConcreteExecutionsResponse{}, nil).Once()
+	s.expectMutableState(nil)
+	s.expectHistory(nil)
+	s.expectCurrentExecutions()
+
+	scanner := newExecutionScanner(config.ExecutionScanner{PageSize: 1}, nil, s.logger,
+		metrics.NewClient(tally.NoopScope, metrics.History))
+	s.Nil(scanner.scanShard(s.shard, s.mockEngine))
+}
+
+func (s *executionScannerSuite) newScanner(action string) *executionScanner {
+	return newExecutionScanner(config.ExecutionScanner{
+		Action:         action,
+		QuarantineFile: filepath.Join(s.quarantineDir, "quarantine.json"),
+	}, nil, s.logger, metrics.NewClient(tally.NoopScope, metrics.History))
+}
+
+func (s *executionScannerSuite) expectConcreteExecutions(infos ...*persistence.WorkflowExecutionInfo) {
+	s.mockExecutionMgr.On("ListConcreteExecutions", mock.Anything).Return(
+		&persistence.ListConcreteExecutionsResponse{ExecutionInfos: infos}, nil).Once()
+}
+
+func (s *executionScannerSuite) expectCurrentExecutions(executions ...*persistence.CurrentExecution) {
+	s.mockExecutionMgr.On("ListCurrentExecutions", mock.Anything).Return(
+		&persistence.ListCurrentExecutionsResponse{Executions: executions}, nil).Once()
+}
+
+func (s *executionScannerSuite) expectMutableState(activityInfos map[int64]*persistence.ActivityInfo) {
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{
+		State: &persistence.WorkflowMutableState{
+			ExecutionInfo: s.info,
+			ActivitInfos:  activityInfos,
+		},
+	}, nil).Once()
+}
+
+func (s *executionScannerSuite) expectHistory(err error) {
+	if err != nil {
+		s.mockHistoryMgr.On("GetWorkflowExecutionHistory", mock.Anything).Return(nil, err).Once()
+		return
+	}
+	s.mockHistoryMgr.On("GetWorkflowExecutionHistory", mock.Anything).Return(
+		&persistence.GetWorkflowExecutionHistoryResponse{}, nil).Once()
+}
+
+func (s *executionScannerSuite) quarantined() []*executionAnomaly {
+	content, err := ioutil.ReadFile(filepath.Join(s.quarantineDir, "quarantine.json"))
+	s.Nil(err)
+
+	var anomalies []*executionAnomaly
+	for _, line := range strings.Split(strings.TrimSpace(string(content)), "\n") {
+		anomaly := &executionAnomaly{}
+		s.Nil(json.Unmarshal([]byte(line), anomaly))
+		anomalies = append(anomalies, anomaly)
+	}
+	return anomalies
+}
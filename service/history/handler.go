@@ -24,16 +24,25 @@ import (
 	"fmt"
 	"log"
 	"sync"
+	"time"
 
+	"github.com/uber-common/bark"
 	hist "github.com/uber/cadence/.gen/go/history"
 	gen "github.com/uber/cadence/.gen/go/shared"
 	hc "github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/errors"
+	"github.com/uber/cadence/common/idgen"
+	"github.com/uber/cadence/common/locks"
+	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/searchattribute"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/config"
 	"github.com/uber/tchannel-go/thrift"
 )
 
@@ -52,6 +61,32 @@ type Handler struct {
 	tokenSerializer       common.TaskTokenSerializer
 	startWG               sync.WaitGroup
 	metricsClient         metrics.Client
+	lockHoldThreshold     time.Duration
+	lockMonitor           *locks.Monitor
+	idGenerator           idgen.Generator
+	hSerializerFactory    persistence.HistorySerializerFactory
+	historyCacheTTL       time.Duration
+	closeCleanupDelay     time.Duration
+	taskPauses            *taskProcessingPauses
+	maxTimeSkew           time.Duration
+	timeSkewCheckInterval time.Duration
+	timeSkewMonitor       *timeSkewMonitor
+	completionCallback    config.CompletionCallback
+	callbackNotifier      *completionCallbackNotifier
+	historyAppend         config.AsyncHistoryAppend
+	executionScannerCfg   config.ExecutionScanner
+	executionScanner      *executionScanner
+	historyArchive        *persistence.HistoryArchive
+	timeoutCaps           *timeoutCaps
+	hotWorkflows          *hotWorkflowDetector
+	signalDedupWindow     time.Duration
+	rateLimiter           *common.PriorityTokenBucket
+	workflowTypeCfg       config.WorkflowTypeMetrics
+	workflowTypeMetrics   *workflowTypeMetrics
+	loadSheddingCfg       config.LoadShedding
+	loadShedder           *loadShedder
+	searchAttributes      *searchattribute.Validator
+	domainCache           cache.DomainCache
 	service.Service
 }
 
@@ -61,22 +96,116 @@ var _ EngineFactory = (*Handler)(nil)
 var (
 	errDomainNotSet            = &gen.BadRequestError{Message: "Domain not set on request."}
 	errWorkflowExecutionNotSet = &gen.BadRequestError{Message: "WorkflowExecution not set on request."}
+	errInvalidShardID          = &gen.BadRequestError{Message: "Invalid ShardID."}
+	errTaskQueueNotSet         = &gen.BadRequestError{Message: "Neither transfer nor timer queue set on request."}
+	errShardOrDomainNotSet     = &gen.BadRequestError{Message: "Exactly one of ShardID and Domain must be set on request."}
+	errRateLimited             = &gen.ServiceBusyError{Message: "History request rate limit exceeded."}
 )
 
-// NewHandler creates a thrift handler for the history service
+// HandlerOptions are the settings of the history handler, read once when it starts.  The zero value of a setting keeps
+// its default.
+type HandlerOptions struct {
+	// LockHoldThreshold is the hold duration after which shard and workflow execution locks are reported as stuck,
+	// zero disables lock monitoring
+	LockHoldThreshold time.Duration
+	// IDGenerator generates the workflow run IDs and request IDs, they are random by default
+	IDGenerator idgen.Generator
+	// HistorySerializerFactory is the factory of the serializer of the history written by the shards, e.g. to
+	// compress history
+	HistorySerializerFactory persistence.HistorySerializerFactory
+	// HistoryCacheTTL is the age after which an entry of the mutable state cache of a shard expires once it is not
+	// in use
+	HistoryCacheTTL time.Duration
+	// CloseCleanupDelay is the time the mutable state of a closed execution is kept before it is deleted, so the
+	// execution can still be described and queried for a while.  Zero deletes it right away.
+	CloseCleanupDelay time.Duration
+	// TaskProcessingPause lists the shards and domains whose transfer and timer tasks are not processed until they
+	// are resumed by SetTaskProcessingPaused
+	TaskProcessingPause config.TaskProcessingPause
+	// TimeSkew is the check of the skew of the host clock against the datastore clock, at startup and periodically
+	TimeSkew config.TimeSkew
+	// CompletionCallback is the delivery of the completion callbacks of the closed workflows
+	CompletionCallback config.CompletionCallback
+	// AsyncHistoryAppend is the grouping of the history appends of the concurrent updates of a shard into batches
+	AsyncHistoryAppend config.AsyncHistoryAppend
+	// ExecutionScanner is the scan of the shards owned by the host for corrupted executions
+	ExecutionScanner config.ExecutionScanner
+	// HistoryArchive is the archive the histories of the domains with archival enabled are copied to before they are
+	// deleted, nil disables archival
+	HistoryArchive *persistence.HistoryArchive
+	// TimeoutCaps are the caps on the decision and activity timeouts of the workflows of each domain
+	TimeoutCaps config.TimeoutCaps
+	// HotWorkflow is the limits on the signals and the activity heartbeats received by a workflow, above which the
+	// workflow is reported as hot
+	HotWorkflow config.HotWorkflow
+	// SignalDedupWindow is the time the IDs of the signal requests applied to a workflow are kept to dedupe their
+	// retries.  Zero keeps them for the lifetime of the workflow.
+	SignalDedupWindow time.Duration
+	// RateLimit is the rate of the workflow requests and scans served by the host, the background and batch requests
+	// only getting a share of the limit.  Zero disables the limit.
+	RateLimit int
+	// WorkflowTypeMetrics is the emission of the workflow metrics tagged by domain and workflow type
+	WorkflowTypeMetrics config.WorkflowTypeMetrics
+	// LoadShedding is the heap size above which the host sheds load to avoid running out of memory
+	LoadShedding config.LoadShedding
+	// SearchAttributes is the registry of the search attribute keys the started workflows can be indexed by, only
+	// the default keys are accepted if it is nil
+	SearchAttributes searchattribute.Registry
+}
+
+// NewHandler creates a thrift handler for the history service, nil options keep the default of every setting
 func NewHandler(sVice service.Service, shardManager persistence.ShardManager, metadataMgr persistence.MetadataManager,
 	visibilityMgr persistence.VisibilityManager, historyMgr persistence.HistoryManager,
-	executionMgrFactory persistence.ExecutionManagerFactory, numberOfShards int) (*Handler, []thrift.TChanServer) {
+	executionMgrFactory persistence.ExecutionManagerFactory, numberOfShards int, opts *HandlerOptions) (*Handler,
+	[]thrift.TChanServer) {
+	if opts == nil {
+		opts = &HandlerOptions{}
+	}
 	handler := &Handler{
-		Service:             sVice,
-		shardManager:        shardManager,
-		metadataMgr:         metadataMgr,
-		historyMgr:          historyMgr,
-		visibilityMgr:       visibilityMgr,
-		executionMgrFactory: executionMgrFactory,
-		numberOfShards:      numberOfShards,
-		tokenSerializer:     common.NewJSONTaskTokenSerializer(),
+		Service:               sVice,
+		shardManager:          shardManager,
+		metadataMgr:           metadataMgr,
+		historyMgr:            historyMgr,
+		visibilityMgr:         visibilityMgr,
+		executionMgrFactory:   executionMgrFactory,
+		numberOfShards:        numberOfShards,
+		tokenSerializer:       common.NewJSONTaskTokenSerializer(),
+		idGenerator:           opts.IDGenerator,
+		hSerializerFactory:    opts.HistorySerializerFactory,
+		historyCacheTTL:       opts.HistoryCacheTTL,
+		lockHoldThreshold:     opts.LockHoldThreshold,
+		closeCleanupDelay:     opts.CloseCleanupDelay,
+		taskPauses:            newTaskProcessingPauses(),
+		maxTimeSkew:           opts.TimeSkew.MaxSkew,
+		timeSkewCheckInterval: opts.TimeSkew.CheckInterval,
+		completionCallback:    opts.CompletionCallback,
+		historyAppend:         opts.AsyncHistoryAppend,
+		executionScannerCfg:   opts.ExecutionScanner,
+		historyArchive:        opts.HistoryArchive,
+		timeoutCaps:           newTimeoutCaps(opts.TimeoutCaps),
+		hotWorkflows:          newHotWorkflowDetector(opts.HotWorkflow),
+		signalDedupWindow:     opts.SignalDedupWindow,
+		workflowTypeCfg:       opts.WorkflowTypeMetrics,
+		loadSheddingCfg:       opts.LoadShedding,
+	}
+	if handler.idGenerator == nil {
+		handler.idGenerator = idgen.NewRandomGenerator()
+	}
+	if handler.hSerializerFactory == nil {
+		handler.hSerializerFactory = persistence.NewHistorySerializerFactory()
+	}
+	if handler.historyCacheTTL <= 0 {
+		handler.historyCacheTTL = historyCacheTTL
 	}
+	if opts.RateLimit > 0 {
+		handler.rateLimiter = common.NewPriorityTokenBucket(opts.RateLimit, common.NewRealTimeSource())
+	}
+	searchAttributes := opts.SearchAttributes
+	if searchAttributes == nil {
+		searchAttributes = searchattribute.NewRegistry(searchattribute.DefaultKeys)
+	}
+	handler.searchAttributes = searchattribute.NewValidator(searchAttributes)
+	handler.pauseTaskProcessing(opts.TaskProcessingPause)
 	// prevent us from trying to serve requests before shard controller is started and ready
 	handler.startWG.Add(1)
 	return handler, []thrift.TChanServer{hist.NewTChanHistoryServiceServer(handler)}
@@ -102,9 +231,45 @@ func (h *Handler) Start(thriftService []thrift.TChanServer) error {
 		h.Service.GetLogger().Fatalf("Unable to get history service resolver.")
 	}
 	h.hServiceResolver = hServiceResolver
+	if h.lockHoldThreshold > 0 {
+		h.lockMonitor = locks.NewMonitor(h.lockHoldThreshold, h.GetLogger().WithFields(bark.Fields{
+			logging.TagWorkflowComponent: logging.TagValueLockMonitorComponent,
+		}), h.GetMetricsClient())
+		h.lockMonitor.Start()
+	}
+	if h.maxTimeSkew > 0 {
+		h.timeSkewMonitor = newTimeSkewMonitor(h.shardManager, h.maxTimeSkew, h.timeSkewCheckInterval,
+			h.GetLogger(), h.GetMetricsClient())
+		if err := h.timeSkewMonitor.check(); err != nil {
+			h.Service.GetLogger().Fatalf("Refusing to start history host: %v", err)
+		}
+		h.timeSkewMonitor.Start()
+	}
+	h.domainCache = cache.NewDomainCache(h.metadataMgr, h.GetLogger(), h.GetMetricsClient())
+	h.domainCache.Start()
+	h.callbackNotifier = newCompletionCallbackNotifier(h.completionCallback, h.GetLogger(), h.GetMetricsClient())
+	if h.workflowTypeCfg.Enabled {
+		h.workflowTypeMetrics = newWorkflowTypeMetrics(h.workflowTypeCfg, h.GetMetricsClient())
+	}
+	if h.loadSheddingCfg.MaxHeapBytes > 0 {
+		h.loadShedder = newLoadShedder(h.loadSheddingCfg, h.GetLogger(), h.GetMetricsClient())
+		h.loadShedder.Start()
+	}
 	h.controller = newShardController(h.numberOfShards, h.GetHostInfo(), hServiceResolver, h.shardManager, h.historyMgr,
 		h.executionMgrFactory, h, h.GetLogger(), h.GetMetricsClient())
+	h.controller.lockMonitor = h.lockMonitor
+	h.controller.taskPauses = h.taskPauses
+	h.controller.historyAppend = h.historyAppend
+	h.controller.historySerializer, err0 = h.hSerializerFactory.Get(persistence.DefaultEncodingType)
+	if err0 != nil {
+		h.Service.GetLogger().Fatalf("Unable to get history serializer: %v", err0)
+	}
 	h.controller.Start()
+	if h.executionScannerCfg.Interval > 0 {
+		h.executionScanner = newExecutionScanner(h.executionScannerCfg, h.controller, h.GetLogger(),
+			h.GetMetricsClient())
+		h.executionScanner.Start()
+	}
 	h.metricsClient = h.GetMetricsClient()
 	h.startWG.Done()
 	return nil
@@ -112,7 +277,20 @@ func (h *Handler) Start(thriftService []thrift.TChanServer) error {
 
 // Stop stops the handler
 func (h *Handler) Stop() {
+	if h.executionScanner != nil {
+		h.executionScanner.Stop()
+	}
 	h.controller.Stop()
+	if h.loadShedder != nil {
+		h.loadShedder.Stop()
+	}
+	h.domainCache.Stop()
+	if h.lockMonitor != nil {
+		h.lockMonitor.Stop()
+	}
+	if h.timeSkewMonitor != nil {
+		h.timeSkewMonitor.Stop()
+	}
 	h.shardManager.Close()
 	h.historyMgr.Close()
 	h.metadataMgr.Close()
@@ -120,9 +298,43 @@ func (h *Handler) Stop() {
 	h.Service.Stop()
 }
 
+// pauseTaskProcessing pauses the processing of the transfer and timer tasks of the configured shards and domains
+// until they are resumed by SetTaskProcessingPaused
+func (h *Handler) pauseTaskProcessing(pause config.TaskProcessingPause) {
+	for queue, paused := range map[taskQueueType]config.PausedTasks{
+		transferTaskQueue: pause.Transfer,
+		timerTaskQueue:    pause.Timer,
+	} {
+		for _, shardID := range paused.ShardIDs {
+			h.taskPauses.setShardPaused(queue, shardID, true)
+		}
+		for _, domainID := range paused.DomainIDs {
+			h.taskPauses.setDomainPaused(queue, domainID, true)
+		}
+	}
+}
+
 // CreateEngine is implementation for HistoryEngineFactory used for creating the engine instance for shard
 func (h *Handler) CreateEngine(context ShardContext) Engine {
-	return NewEngineWithShardContext(context, h.metadataMgr, h.visibilityMgr, h.matchingServiceClient, h.historyServiceClient)
+	return NewEngineWithShardContext(context, &engineOptions{
+		metadataMgr:         h.metadataMgr,
+		domainCache:         h.domainCache,
+		visibilityMgr:       h.visibilityMgr,
+		matching:            h.matchingServiceClient,
+		historyClient:       h.historyServiceClient,
+		idGenerator:         h.idGenerator,
+		historyCacheTTL:     h.historyCacheTTL,
+		closeCleanupDelay:   h.closeCleanupDelay,
+		taskPauses:          h.taskPauses,
+		callbackNotifier:    h.callbackNotifier,
+		historyArchive:      h.historyArchive,
+		timeoutCaps:         h.timeoutCaps,
+		hotWorkflows:        h.hotWorkflows,
+		signalDedupWindow:   h.signalDedupWindow,
+		workflowTypeMetrics: h.workflowTypeMetrics,
+		loadShedder:         h.loadShedder,
+		searchAttributes:    h.searchAttributes,
+	})
 }
 
 // IsHealthy - Health endpoint.
@@ -343,7 +555,7 @@ func (h *Handler) RespondActivityTaskCanceled(ctx thrift.Context,
 
 // RespondDecisionTaskCompleted - records completion of a decision task
 func (h *Handler) RespondDecisionTaskCompleted(ctx thrift.Context,
-	wrappedRequest *hist.RespondDecisionTaskCompletedRequest) error {
+	wrappedRequest *hist.RespondDecisionTaskCompletedRequest) (*gen.RespondDecisionTaskCompletedResponse, error) {
 	h.startWG.Wait()
 
 	h.metricsClient.IncCounter(metrics.HistoryRespondDecisionTaskCompletedScope, metrics.CadenceRequests)
@@ -351,7 +563,7 @@ func (h *Handler) RespondDecisionTaskCompleted(ctx thrift.Context,
 	defer sw.Stop()
 
 	if !wrappedRequest.IsSetDomainUUID() {
-		return errDomainNotSet
+		return nil, errDomainNotSet
 	}
 
 	completeRequest := wrappedRequest.GetCompleteRequest()
@@ -359,7 +571,7 @@ func (h *Handler) RespondDecisionTaskCompleted(ctx thrift.Context,
 	if err0 != nil {
 		err0 = &gen.BadRequestError{Message: fmt.Sprintf("Error deserializing task token. Error: %v", err0)}
 		h.updateErrorMetric(metrics.HistoryRespondDecisionTaskCompletedScope, err0)
-		return err0
+		return nil, err0
 	}
 
 	h.Service.GetLogger().Debugf("RespondDecisionTaskCompleted. DomainID: %v, WorkflowID: %v, RunID: %v, ScheduleID: %v",
@@ -371,16 +583,16 @@ func (h *Handler) RespondDecisionTaskCompleted(ctx thrift.Context,
 	engine, err1 := h.controller.GetEngine(token.WorkflowID)
 	if err1 != nil {
 		h.updateErrorMetric(metrics.HistoryRespondDecisionTaskCompletedScope, err1)
-		return err1
+		return nil, err1
 	}
 
-	err2 := engine.RespondDecisionTaskCompleted(wrappedRequest)
+	response, err2 := engine.RespondDecisionTaskCompleted(wrappedRequest)
 	if err2 != nil {
 		h.updateErrorMetric(metrics.HistoryRespondDecisionTaskCompletedScope, h.convertError(err2))
-		return h.convertError(err2)
+		return nil, h.convertError(err2)
 	}
 
-	return nil
+	return response, nil
 }
 
 // StartWorkflowExecution - creates a new workflow execution
@@ -392,6 +604,17 @@ func (h *Handler) StartWorkflowExecution(ctx thrift.Context,
 	sw := h.metricsClient.StartTimer(metrics.HistoryStartWorkflowExecutionScope, metrics.CadenceLatency)
 	defer sw.Stop()
 
+	if err := h.allowRequest(ctx, metrics.HistoryStartWorkflowExecutionScope); err != nil {
+		return nil, err
+	}
+
+	// The child workflows are still started, their parents are already running
+	if h.loadShedder.isShedding() && !wrappedRequest.IsSetParentExecutionInfo() {
+		h.metricsClient.IncCounter(metrics.HistoryLoadShedderScope, metrics.LoadSheddingRejectedCounter)
+		h.metricsClient.IncCounter(metrics.HistoryStartWorkflowExecutionScope, metrics.CadenceErrServiceBusyCounter)
+		return nil, errMemoryPressure
+	}
+
 	if !wrappedRequest.IsSetDomainUUID() {
 		return nil, errDomainNotSet
 	}
@@ -449,6 +672,10 @@ func (h *Handler) RequestCancelWorkflowExecution(ctx thrift.Context,
 	sw := h.metricsClient.StartTimer(metrics.HistoryRequestCancelWorkflowExecutionScope, metrics.CadenceLatency)
 	defer sw.Stop()
 
+	if err := h.allowRequest(ctx, metrics.HistoryRequestCancelWorkflowExecutionScope); err != nil {
+		return err
+	}
+
 	cancelRequest := request.GetCancelRequest()
 	h.Service.GetLogger().Debugf("RequestCancelWorkflowExecution. DomainID: %v/%v, WorkflowID: %v, RunID: %v.",
 		cancelRequest.GetDomain(),
@@ -481,6 +708,10 @@ func (h *Handler) SignalWorkflowExecution(ctx thrift.Context,
 	sw := h.metricsClient.StartTimer(metrics.HistorySignalWorkflowExecutionScope, metrics.CadenceLatency)
 	defer sw.Stop()
 
+	if err := h.allowRequest(ctx, metrics.HistorySignalWorkflowExecutionScope); err != nil {
+		return err
+	}
+
 	if !wrappedRequest.IsSetDomainUUID() {
 		return errDomainNotSet
 	}
@@ -502,6 +733,48 @@ func (h *Handler) SignalWorkflowExecution(ctx thrift.Context,
 	return nil
 }
 
+// SignalWithStartWorkflowExecution is used to ensure sending a signal to a workflow.  The signal is recorded in the
+// history of the running execution, or of a new run started if the workflow is not running.
+func (h *Handler) SignalWithStartWorkflowExecution(ctx thrift.Context,
+	wrappedRequest *hist.SignalWithStartWorkflowExecutionRequest) (*gen.StartWorkflowExecutionResponse, error) {
+	h.startWG.Wait()
+
+	h.metricsClient.IncCounter(metrics.HistorySignalWithStartWorkflowExecutionScope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(metrics.HistorySignalWithStartWorkflowExecutionScope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if err := h.allowRequest(ctx, metrics.HistorySignalWithStartWorkflowExecutionScope); err != nil {
+		return nil, err
+	}
+
+	// A signal with start has no parent and may start a workflow, it is rejected like a parentless start
+	if h.loadShedder.isShedding() {
+		h.metricsClient.IncCounter(metrics.HistoryLoadShedderScope, metrics.LoadSheddingRejectedCounter)
+		h.metricsClient.IncCounter(metrics.HistorySignalWithStartWorkflowExecutionScope,
+			metrics.CadenceErrServiceBusyCounter)
+		return nil, errMemoryPressure
+	}
+
+	if !wrappedRequest.IsSetDomainUUID() {
+		return nil, errDomainNotSet
+	}
+
+	signalWithStartRequest := wrappedRequest.GetSignalWithStartRequest()
+	engine, err1 := h.controller.GetEngine(signalWithStartRequest.GetWorkflowId())
+	if err1 != nil {
+		h.updateErrorMetric(metrics.HistorySignalWithStartWorkflowExecutionScope, err1)
+		return nil, err1
+	}
+
+	response, err2 := engine.SignalWithStartWorkflowExecution(wrappedRequest)
+	if err2 != nil {
+		h.updateErrorMetric(metrics.HistorySignalWithStartWorkflowExecutionScope, h.convertError(err2))
+		return nil, h.convertError(err2)
+	}
+
+	return response, nil
+}
+
 // TerminateWorkflowExecution terminates an existing workflow execution by recording WorkflowExecutionTerminated event
 // in the history and immediately terminating the execution instance.
 func (h *Handler) TerminateWorkflowExecution(ctx thrift.Context,
@@ -512,6 +785,10 @@ func (h *Handler) TerminateWorkflowExecution(ctx thrift.Context,
 	sw := h.metricsClient.StartTimer(metrics.HistoryTerminateWorkflowExecutionScope, metrics.CadenceLatency)
 	defer sw.Stop()
 
+	if err := h.allowRequest(ctx, metrics.HistoryTerminateWorkflowExecutionScope); err != nil {
+		return err
+	}
+
 	if !wrappedRequest.IsSetDomainUUID() {
 		return errDomainNotSet
 	}
@@ -533,6 +810,41 @@ func (h *Handler) TerminateWorkflowExecution(ctx thrift.Context,
 	return nil
 }
 
+// ResetWorkflowExecution resets a workflow execution to the end of a decision task of one of its runs.  A new run is
+// created with the history of the run up to that point and the run is terminated if it is still running.
+func (h *Handler) ResetWorkflowExecution(ctx thrift.Context,
+	wrappedRequest *hist.ResetWorkflowExecutionRequest) (*gen.ResetWorkflowExecutionResponse, error) {
+	h.startWG.Wait()
+
+	h.metricsClient.IncCounter(metrics.HistoryResetWorkflowExecutionScope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(metrics.HistoryResetWorkflowExecutionScope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if err := h.allowRequest(ctx, metrics.HistoryResetWorkflowExecutionScope); err != nil {
+		return nil, err
+	}
+
+	if !wrappedRequest.IsSetDomainUUID() {
+		return nil, errDomainNotSet
+	}
+
+	resetRequest := wrappedRequest.GetResetRequest()
+	workflowExecution := resetRequest.GetWorkflowExecution()
+	engine, err1 := h.controller.GetEngine(workflowExecution.GetWorkflowId())
+	if err1 != nil {
+		h.updateErrorMetric(metrics.HistoryResetWorkflowExecutionScope, err1)
+		return nil, err1
+	}
+
+	response, err2 := engine.ResetWorkflowExecution(wrappedRequest)
+	if err2 != nil {
+		h.updateErrorMetric(metrics.HistoryResetWorkflowExecutionScope, h.convertError(err2))
+		return nil, h.convertError(err2)
+	}
+
+	return response, nil
+}
+
 // ScheduleDecisionTask is used for creating a decision task for already started workflow execution.  This is mainly
 // used by transfer queue processor during the processing of StartChildWorkflowExecution task, where it first starts
 // child execution without creating the decision task and then calls this API after updating the mutable state of
@@ -601,9 +913,214 @@ func (h *Handler) RecordChildExecutionCompleted(ctx thrift.Context, request *his
 	return nil
 }
 
+// DescribeShard returns the task ID watermarks and ack levels of a shard loaded on this host, it never acquires the
+// shard.  It is used by consumers of the transfer and timer queues to verify that no task ID range has been skipped.
+func (h *Handler) DescribeShard(ctx thrift.Context,
+	request *hist.DescribeShardRequest) (*hist.DescribeShardResponse, error) {
+	h.startWG.Wait()
+
+	h.metricsClient.IncCounter(metrics.HistoryDescribeShardScope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(metrics.HistoryDescribeShardScope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	shardID := int(request.GetShardId())
+	if !request.IsSetShardId() || shardID < 0 || shardID >= h.numberOfShards {
+		h.updateErrorMetric(metrics.HistoryDescribeShardScope, errInvalidShardID)
+		return nil, errInvalidShardID
+	}
+
+	watermarks, err := h.controller.getShardWatermarks(shardID)
+	if err != nil {
+		h.updateErrorMetric(metrics.HistoryDescribeShardScope, h.convertError(err))
+		return nil, h.convertError(err)
+	}
+
+	return &hist.DescribeShardResponse{
+		ShardId:              common.Int32Ptr(int32(watermarks.shardID)),
+		Owner:                common.StringPtr(watermarks.owner),
+		RangeId:              common.Int64Ptr(watermarks.rangeID),
+		TransferAckLevel:     common.Int64Ptr(watermarks.transferAckLevel),
+		TransferMaxReadLevel: common.Int64Ptr(watermarks.transferMaxReadLevel),
+		MaxTaskId:            common.Int64Ptr(watermarks.maxTaskID),
+		TimerAckLevel:        common.Int64Ptr(watermarks.timerAckLevel.UnixNano()),
+	}, nil
+}
+
+// InvalidateMutableState drops the cached mutable state of a workflow execution owned by this host, or unloads a
+// whole shard when no execution is given, so that the state is reloaded from persistence on next use.
+func (h *Handler) InvalidateMutableState(ctx thrift.Context, request *hist.InvalidateMutableStateRequest) error {
+	h.startWG.Wait()
+
+	h.metricsClient.IncCounter(metrics.HistoryInvalidateMutableStateScope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(metrics.HistoryInvalidateMutableStateScope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if !request.IsSetExecution() {
+		shardID := int(request.GetShardId())
+		if !request.IsSetShardId() || shardID < 0 || shardID >= h.numberOfShards {
+			h.updateErrorMetric(metrics.HistoryInvalidateMutableStateScope, errInvalidShardID)
+			return errInvalidShardID
+		}
+
+		if err := h.controller.unloadShard(shardID); err != nil {
+			h.updateErrorMetric(metrics.HistoryInvalidateMutableStateScope, h.convertError(err))
+			return h.convertError(err)
+		}
+		return nil
+	}
+
+	if !request.IsSetDomainUUID() {
+		return errDomainNotSet
+	}
+
+	workflowExecution := request.GetExecution()
+	if workflowExecution.GetWorkflowId() == "" {
+		return errWorkflowExecutionNotSet
+	}
+
+	engine, err1 := h.controller.GetEngine(workflowExecution.GetWorkflowId())
+	if err1 != nil {
+		h.updateErrorMetric(metrics.HistoryInvalidateMutableStateScope, err1)
+		return err1
+	}
+
+	err2 := engine.InvalidateMutableState(request)
+	if err2 != nil {
+		h.updateErrorMetric(metrics.HistoryInvalidateMutableStateScope, h.convertError(err2))
+		return h.convertError(err2)
+	}
+
+	return nil
+}
+
+// SetTaskProcessingPaused pauses or resumes the processing of the transfer and/or timer tasks of a shard owned by this
+// host, or of a domain on all the shards owned by this host.  Pauses are not persisted: a shard pause is lost when the
+// shard moves to another host, and any pause is lost when the host restarts.  Pauses which must survive those are
+// configured with taskProcessingPause.
+func (h *Handler) SetTaskProcessingPaused(ctx thrift.Context, request *hist.SetTaskProcessingPausedRequest) error {
+	h.startWG.Wait()
+
+	h.metricsClient.IncCounter(metrics.HistorySetTaskProcessingPausedScope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(metrics.HistorySetTaskProcessingPausedScope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	var queues []taskQueueType
+	if request.GetTransferQueue() {
+		queues = append(queues, transferTaskQueue)
+	}
+	if request.GetTimerQueue() {
+		queues = append(queues, timerTaskQueue)
+	}
+	if len(queues) == 0 {
+		h.updateErrorMetric(metrics.HistorySetTaskProcessingPausedScope, errTaskQueueNotSet)
+		return errTaskQueueNotSet
+	}
+
+	if request.IsSetShardId() == (request.GetDomainUUID() != "") {
+		h.updateErrorMetric(metrics.HistorySetTaskProcessingPausedScope, errShardOrDomainNotSet)
+		return errShardOrDomainNotSet
+	}
+
+	paused := request.GetPaused()
+	if request.IsSetShardId() {
+		shardID := int(request.GetShardId())
+		if shardID < 0 || shardID >= h.numberOfShards {
+			h.updateErrorMetric(metrics.HistorySetTaskProcessingPausedScope, errInvalidShardID)
+			return errInvalidShardID
+		}
+
+		if err := h.controller.checkShardOwnership(shardID); err != nil {
+			h.updateErrorMetric(metrics.HistorySetTaskProcessingPausedScope, h.convertError(err))
+			return h.convertError(err)
+		}
+
+		for _, queue := range queues {
+			h.taskPauses.setShardPaused(queue, shardID, paused)
+		}
+		h.GetLogger().Infof("Task processing paused: %v, shard: %v, transfer: %v, timer: %v", paused, shardID,
+			request.GetTransferQueue(), request.GetTimerQueue())
+	} else {
+		for _, queue := range queues {
+			h.taskPauses.setDomainPaused(queue, request.GetDomainUUID(), paused)
+		}
+		h.GetLogger().Infof("Task processing paused: %v, domain: %v, transfer: %v, timer: %v", paused,
+			request.GetDomainUUID(), request.GetTransferQueue(), request.GetTimerQueue())
+	}
+
+	h.controller.emitTaskProcessingPauses()
+	return nil
+}
+
+// ListConcreteExecutions returns a page of the workflow executions stored by a shard owned by this host
+func (h *Handler) ListConcreteExecutions(ctx thrift.Context,
+	request *hist.ListConcreteExecutionsRequest) (*hist.ListConcreteExecutionsResponse, error) {
+	h.startWG.Wait()
+
+	h.metricsClient.IncCounter(metrics.HistoryListConcreteExecutionsScope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(metrics.HistoryListConcreteExecutionsScope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if err := h.allowRequest(ctx, metrics.HistoryListConcreteExecutionsScope); err != nil {
+		return nil, err
+	}
+
+	shardID := int(request.GetShardId())
+	if !request.IsSetShardId() || shardID < 0 || shardID >= h.numberOfShards {
+		h.updateErrorMetric(metrics.HistoryListConcreteExecutionsScope, errInvalidShardID)
+		return nil, errInvalidShardID
+	}
+
+	context, err := h.controller.getShardContext(shardID)
+	if err != nil {
+		h.updateErrorMetric(metrics.HistoryListConcreteExecutionsScope, h.convertError(err))
+		return nil, h.convertError(err)
+	}
+
+	response, err := context.GetExecutionManager().ListConcreteExecutions(&persistence.ListConcreteExecutionsRequest{
+		PageSize:      int(request.GetPageSize()),
+		NextPageToken: request.NextPageToken,
+	})
+	if err != nil {
+		h.updateErrorMetric(metrics.HistoryListConcreteExecutionsScope, h.convertError(err))
+		return nil, h.convertError(err)
+	}
+
+	executions := make([]*hist.ConcreteExecution, 0, len(response.ExecutionInfos))
+	for _, info := range response.ExecutionInfos {
+		executions = append(executions, &hist.ConcreteExecution{
+			DomainUUID: common.StringPtr(info.DomainID),
+			Execution: &gen.WorkflowExecution{
+				WorkflowId: common.StringPtr(info.WorkflowID),
+				RunId:      common.StringPtr(info.RunID),
+			},
+			WorkflowTypeName:     common.StringPtr(info.WorkflowTypeName),
+			State:                common.Int32Ptr(int32(info.State)),
+			CloseStatus:          common.Int32Ptr(int32(info.CloseStatus)),
+			NextEventId:          common.Int64Ptr(info.NextEventID),
+			StartTimestamp:       common.Int64Ptr(info.StartTimestamp.UnixNano()),
+			LastUpdatedTimestamp: common.Int64Ptr(info.LastUpdatedTimestamp.UnixNano()),
+		})
+	}
+
+	return &hist.ListConcreteExecutionsResponse{
+		Executions:    executions,
+		NextPageToken: response.NextPageToken,
+	}, nil
+}
+
+// allowRequest rejects the request if the rate limit of the host is exceeded for the priority sent by the caller
+func (h *Handler) allowRequest(ctx thrift.Context, scope int) error {
+	if h.rateLimiter == nil || h.rateLimiter.TryConsume(common.GetPriority(ctx)) {
+		return nil
+	}
+	h.metricsClient.IncCounter(scope, metrics.CadenceErrServiceBusyCounter)
+	return errRateLimited
+}
+
 // convertError is a helper method to convert ShardOwnershipLostError from persistence layer returned by various
 // HistoryEngine API calls to ShardOwnershipLost error return by HistoryService for client to be redirected to the
-// correct shard.
+// correct shard.  The persistence errors signalling an unavailable or corrupted store are translated to the shared
+// thrift errors of their code, the callers of the history service cannot decode them otherwise.
 func (h *Handler) convertError(err error) error {
 	switch err.(type) {
 	case *persistence.ShardOwnershipLostError:
@@ -613,23 +1130,21 @@ func (h *Handler) convertError(err error) error {
 			return createShardOwnershipLostError(h.GetHostInfo().GetAddress(), info.GetAddress())
 		}
 		return createShardOwnershipLostError(h.GetHostInfo().GetAddress(), "")
+	case *persistence.UnavailableError, *persistence.CorruptionError:
+		return errors.ToThrift(err)
 	}
 
 	return err
 }
 
 func (h *Handler) updateErrorMetric(scope int, err error) {
-	switch err.(type) {
-	case *hist.ShardOwnershipLostError:
+	switch code := errors.CodeOf(err); code {
+	case errors.CodeShardOwnershipLost:
 		h.metricsClient.IncCounter(scope, metrics.CadenceErrShardOwnershipLostCounter)
-	case *hist.EventAlreadyStartedError:
+	case errors.CodeEventAlreadyStarted:
 		h.metricsClient.IncCounter(scope, metrics.CadenceErrEventAlreadyStartedCounter)
-	case *gen.BadRequestError:
-		h.metricsClient.IncCounter(scope, metrics.CadenceErrBadRequestCounter)
-	case *gen.EntityNotExistsError:
-		h.metricsClient.IncCounter(scope, metrics.CadenceErrEntityNotExistsCounter)
 	default:
-		h.metricsClient.IncCounter(scope, metrics.CadenceFailures)
+		h.metricsClient.IncCounter(scope, errors.MetricCounter(code))
 	}
 }
 
@@ -0,0 +1,94 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/idgen"
+	"github.com/uber/cadence/common/searchattribute"
+	"github.com/uber/cadence/common/service/config"
+)
+
+type (
+	handlerSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+	}
+)
+
+func TestHandlerSuite(t *testing.T) {
+	s := new(handlerSuite)
+	suite.Run(t, s)
+}
+
+func (s *handlerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *handlerSuite) TestNewHandlerDefaults() {
+	handler, _ := NewHandler(nil, nil, nil, nil, nil, nil, 4, nil)
+	s.NotNil(handler.idGenerator)
+	s.NotNil(handler.hSerializerFactory)
+	s.Equal(historyCacheTTL, handler.historyCacheTTL)
+	s.Nil(handler.rateLimiter)
+	s.NoError(handler.searchAttributes.Validate(&workflow.SearchAttributes{IndexedFields: map[string][]byte{
+		searchattribute.CustomKeywordField: []byte(`"keyword"`),
+	}}))
+	s.False(handler.taskPauses.isShardPaused(transferTaskQueue, 1))
+}
+
+func (s *handlerSuite) TestNewHandlerOptions() {
+	registry, err := searchattribute.NewRegistryFromConfig(map[string]string{"CustomerID": "Keyword"})
+	s.NoError(err)
+	idGenerator := idgen.NewRandomGenerator()
+	handler, _ := NewHandler(nil, nil, nil, nil, nil, nil, 4, &HandlerOptions{
+		IDGenerator:     idGenerator,
+		HistoryCacheTTL: time.Minute,
+		TaskProcessingPause: config.TaskProcessingPause{
+			Transfer: config.PausedTasks{ShardIDs: []int{1}},
+			Timer:    config.PausedTasks{DomainIDs: []string{"domain"}},
+		},
+		TimeSkew:          config.TimeSkew{MaxSkew: time.Second, CheckInterval: time.Minute},
+		SignalDedupWindow: time.Hour,
+		RateLimit:         100,
+		SearchAttributes:  registry,
+	})
+	s.Equal(idGenerator, handler.idGenerator)
+	s.Equal(time.Minute, handler.historyCacheTTL)
+	s.True(handler.taskPauses.isShardPaused(transferTaskQueue, 1))
+	s.False(handler.taskPauses.isShardPaused(timerTaskQueue, 1))
+	s.True(handler.taskPauses.isTaskPaused(timerTaskQueue, 2, "domain"))
+	s.Equal(time.Second, handler.maxTimeSkew)
+	s.Equal(time.Minute, handler.timeSkewCheckInterval)
+	s.Equal(time.Hour, handler.signalDedupWindow)
+	s.NotNil(handler.rateLimiter)
+	s.NoError(handler.searchAttributes.Validate(&workflow.SearchAttributes{IndexedFields: map[string][]byte{
+		"CustomerID": []byte(`"customer"`),
+	}}))
+}
@@ -0,0 +1,189 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"sync"
+
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/config"
+)
+
+const (
+	// defaultHistoryAppendMaxBatchSize is the largest number of appends written at once, unless configured
+	defaultHistoryAppendMaxBatchSize = 100
+	// defaultHistoryAppendMaxPending is the number of appends which can wait to be written, unless configured
+	defaultHistoryAppendMaxPending = 1000
+)
+
+type (
+	// historyAppender groups the history appends of the concurrent updates of a shard, so that they are written by a
+	// few AppendHistoryEventsBatch instead of one AppendHistoryEvents each.  The appends which arrive while a batch is
+	// written are buffered and written by the next batch, so an append waits for at most one batch more than when it
+	// is written on its own.  Callers still wait for their append to be written, and they block once too many appends
+	// are waiting, which pushes back on the updates of the shard when the datastore cannot keep up.
+	historyAppender struct {
+		// writeBatch writes the event batches of a group of appends in one request
+		writeBatch    func(requests []*persistence.AppendHistoryEventsRequest) error
+		maxBatchSize  int
+		pendingSlots  chan struct{}
+		metricsClient metrics.Client
+
+		sync.Mutex
+		pending  []*historyAppend
+		flushing bool
+	}
+
+	// historyAppend is the append of the event batches of an update, waiting to be written
+	historyAppend struct {
+		requests []*persistence.AppendHistoryEventsRequest
+		doneCh   chan error
+	}
+)
+
+func newHistoryAppender(cfg config.AsyncHistoryAppend,
+	writeBatch func(requests []*persistence.AppendHistoryEventsRequest) error,
+	metricsClient metrics.Client) *historyAppender {
+	maxBatchSize := cfg.MaxBatchSize
+	if maxBatchSize <= 0 {
+		maxBatchSize = defaultHistoryAppendMaxBatchSize
+	}
+	maxPending := cfg.MaxPending
+	if maxPending <= 0 {
+		maxPending = defaultHistoryAppendMaxPending
+	}
+	return &historyAppender{
+		writeBatch:    writeBatch,
+		maxBatchSize:  maxBatchSize,
+		pendingSlots:  make(chan struct{}, maxPending),
+		metricsClient: metricsClient,
+	}
+}
+
+// append writes the event batches of an update along with the appends of the other updates of the shard, and returns
+// once they are written
+func (a *historyAppender) append(requests []*persistence.AppendHistoryEventsRequest) error {
+	a.pendingSlots <- struct{}{}
+	defer func() { <-a.pendingSlots }()
+
+	pending := &historyAppend{
+		requests: requests,
+		doneCh:   make(chan error, 1),
+	}
+
+	a.Lock()
+	a.pending = append(a.pending, pending)
+	if !a.flushing {
+		a.flushing = true
+		go a.flush()
+	}
+	a.Unlock()
+
+	return <-pending.doneCh
+}
+
+// flush writes the pending appends batch after batch, until none is left
+func (a *historyAppender) flush() {
+	for {
+		a.Lock()
+		if len(a.pending) == 0 {
+			a.flushing = false
+			a.Unlock()
+			return
+		}
+		batch := a.nextBatchLocked()
+		a.Unlock()
+
+		a.write(batch)
+	}
+}
+
+// nextBatchLocked takes the oldest pending appends, as many as fit in a batch.  An append with more event batches
+// than fit in a batch is written by a batch of its own.
+func (a *historyAppender) nextBatchLocked() []*historyAppend {
+	count := 0
+	size := 0
+	for _, pending := range a.pending {
+		if count > 0 && size+len(pending.requests) > a.maxBatchSize {
+			break
+		}
+		count++
+		size += len(pending.requests)
+	}
+
+	batch := a.pending[:count:count]
+	a.pending = a.pending[count:]
+	return batch
+}
+
+func (a *historyAppender) write(batch []*historyAppend) {
+	var requests []*persistence.AppendHistoryEventsRequest
+	for _, pending := range batch {
+		requests = append(requests, pending.requests...)
+	}
+	a.metricsClient.UpdateGauge(metrics.HistoryAppenderScope, metrics.HistoryAppendBatchSizeGauge,
+		float64(len(requests)))
+
+	err := a.writeBatch(requests)
+	if partial, ok := err.(*persistence.PartialAppendError); ok {
+		// Each append gets the error of its own event batches, the appends written are not written again
+		a.metricsClient.IncCounter(metrics.HistoryAppenderScope, metrics.HistoryAppendBatchFailedCounter)
+		offset := 0
+		for _, pending := range batch {
+			pending.doneCh <- firstAppendError(&persistence.PartialAppendError{
+				Msg:    partial.Msg,
+				Errors: partial.Errors[offset : offset+len(pending.requests)],
+			})
+			offset += len(pending.requests)
+		}
+		return
+	}
+	if err == nil || len(batch) == 1 {
+		for _, pending := range batch {
+			pending.doneCh <- err
+		}
+		return
+	}
+
+	// The failure of the batch may come from a single append, so each append is written again on its own to get its
+	// own result
+	a.metricsClient.IncCounter(metrics.HistoryAppenderScope, metrics.HistoryAppendBatchFailedCounter)
+	for _, pending := range batch {
+		pending.doneCh <- a.writeBatch(pending.requests)
+	}
+}
+
+// firstAppendError returns the error of the first event batch of a partial append which failed to be appended, nil if
+// they were all appended, and any other error as is.  The callers then see the same errors as if the event batches
+// of their update were appended on their own.
+func firstAppendError(err error) error {
+	partial, ok := err.(*persistence.PartialAppendError)
+	if !ok {
+		return err
+	}
+	for _, err := range partial.Errors {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,250 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/config"
+)
+
+type (
+	historyAppenderSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+
+		sync.Mutex
+		batchSizes []int
+		// writeStarted receives the size of every batch when it starts being written
+		writeStarted chan int
+		// releaseWrite holds the writes until closed
+		releaseWrite chan struct{}
+		failedEvent  int64
+		// partialFailure fails only the event batch with the failed event of a batch, the others being appended
+		partialFailure bool
+	}
+)
+
+func TestHistoryAppenderSuite(t *testing.T) {
+	s := new(historyAppenderSuite)
+	suite.Run(t, s)
+}
+
+func (s *historyAppenderSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.batchSizes = nil
+	s.writeStarted = make(chan int, 100)
+	s.releaseWrite = make(chan struct{})
+	s.failedEvent = 0
+	s.partialFailure = false
+}
+
+func (s *historyAppenderSuite) TestDefaults() {
+	appender := s.newAppender(config.AsyncHistoryAppend{Enabled: true})
+	s.Equal(defaultHistoryAppendMaxBatchSize, appender.maxBatchSize)
+	s.Equal(defaultHistoryAppendMaxPending, cap(appender.pendingSlots))
+}
+
+func (s *historyAppenderSuite) TestSingleAppend() {
+	close(s.releaseWrite)
+	appender := s.newAppender(config.AsyncHistoryAppend{Enabled: true})
+
+	s.Nil(appender.append(s.requests(1)))
+	s.Equal([]int{1}, s.writtenBatchSizes())
+}
+
+func (s *historyAppenderSuite) TestConcurrentAppendsGrouped() {
+	appender := s.newAppender(config.AsyncHistoryAppend{Enabled: true})
+
+	results := s.appendConcurrently(appender, 1, 1)
+	s.Equal(1, <-s.writeStarted)
+	results = append(results, s.appendConcurrently(appender, 3, 2)...)
+	s.waitPending(appender, 3)
+	close(s.releaseWrite)
+
+	for _, result := range results {
+		s.Nil(<-result)
+	}
+	s.Equal([]int{1, 3}, s.writtenBatchSizes())
+}
+
+func (s *historyAppenderSuite) TestMaxBatchSize() {
+	appender := s.newAppender(config.AsyncHistoryAppend{Enabled: true, MaxBatchSize: 2})
+
+	results := s.appendConcurrently(appender, 1, 1)
+	s.Equal(1, <-s.writeStarted)
+	results = append(results, s.appendConcurrently(appender, 3, 2)...)
+	s.waitPending(appender, 3)
+	close(s.releaseWrite)
+
+	for _, result := range results {
+		s.Nil(<-result)
+	}
+	s.Equal([]int{1, 2, 1}, s.writtenBatchSizes())
+}
+
+func (s *historyAppenderSuite) TestBatchFailureWrittenOneByOne() {
+	appender := s.newAppender(config.AsyncHistoryAppend{Enabled: true})
+	s.failedEvent = 3
+
+	results := s.appendConcurrently(appender, 1, 1)
+	s.Equal(1, <-s.writeStarted)
+	var grouped []chan error
+	for eventID := int64(2); eventID <= 4; eventID++ {
+		grouped = append(grouped, s.appendConcurrently(appender, 1, eventID)...)
+		s.waitPending(appender, int(eventID-1))
+	}
+	close(s.releaseWrite)
+
+	s.Nil(<-results[0])
+	s.Nil(<-grouped[0])
+	s.NotNil(<-grouped[1])
+	s.Nil(<-grouped[2])
+	s.Equal([]int{1, 3, 1, 1, 1}, s.writtenBatchSizes())
+}
+
+func (s *historyAppenderSuite) TestPartialBatchFailure() {
+	appender := s.newAppender(config.AsyncHistoryAppend{Enabled: true})
+	s.failedEvent = 3
+	s.partialFailure = true
+
+	results := s.appendConcurrently(appender, 1, 1)
+	s.Equal(1, <-s.writeStarted)
+	var grouped []chan error
+	for eventID := int64(2); eventID <= 4; eventID++ {
+		grouped = append(grouped, s.appendConcurrently(appender, 1, eventID)...)
+		s.waitPending(appender, int(eventID-1))
+	}
+	close(s.releaseWrite)
+
+	s.Nil(<-results[0])
+	s.Nil(<-grouped[0])
+	s.IsType(&persistence.ConditionFailedError{}, <-grouped[1])
+	s.Nil(<-grouped[2])
+	// the appends written by the failed batch are not written again
+	s.Equal([]int{1, 3}, s.writtenBatchSizes())
+}
+
+func (s *historyAppenderSuite) TestFirstAppendError() {
+	err := errors.New("append failed")
+	s.Nil(firstAppendError(nil))
+	s.Equal(err, firstAppendError(err))
+	s.Equal(err, firstAppendError(&persistence.PartialAppendError{Errors: []error{nil, err}}))
+	s.Nil(firstAppendError(&persistence.PartialAppendError{Errors: []error{nil, nil}}))
+}
+
+func (s *historyAppenderSuite) TestBackPressure() {
+	appender := s.newAppender(config.AsyncHistoryAppend{Enabled: true, MaxPending: 1})
+
+	first := s.appendConcurrently(appender, 1, 1)
+	s.Equal(1, <-s.writeStarted)
+	second := s.appendConcurrently(appender, 1, 2)
+
+	// the second append waits for the first one to be written before it is buffered
+	time.Sleep(50 * time.Millisecond)
+	appender.Lock()
+	s.Equal(0, len(appender.pending))
+	appender.Unlock()
+
+	close(s.releaseWrite)
+	s.Nil(<-first[0])
+	s.Nil(<-second[0])
+	s.Equal([]int{1, 1}, s.writtenBatchSizes())
+}
+
+func (s *historyAppenderSuite) newAppender(cfg config.AsyncHistoryAppend) *historyAppender {
+	return newHistoryAppender(cfg, s.writeBatch, metrics.NewClient(tally.NoopScope, metrics.History))
+}
+
+func (s *historyAppenderSuite) writeBatch(requests []*persistence.AppendHistoryEventsRequest) error {
+	s.Lock()
+	s.batchSizes = append(s.batchSizes, len(requests))
+	s.Unlock()
+
+	s.writeStarted <- len(requests)
+	<-s.releaseWrite
+
+	errs := make([]error, len(requests))
+	failed := false
+	for i, request := range requests {
+		if request.FirstEventID == s.failedEvent {
+			if !s.partialFailure || len(requests) == 1 {
+				return errors.New("append failed")
+			}
+			errs[i] = &persistence.ConditionFailedError{Msg: "append failed"}
+			failed = true
+		}
+	}
+	if failed {
+		return &persistence.PartialAppendError{Msg: "partial append", Errors: errs}
+	}
+	return nil
+}
+
+// appendConcurrently starts count appends of a single event batch each, the first one with the given event ID
+func (s *historyAppenderSuite) appendConcurrently(appender *historyAppender, count int,
+	firstEventID int64) []chan error {
+	var results []chan error
+	for i := 0; i < count; i++ {
+		result := make(chan error, 1)
+		results = append(results, result)
+		requests := s.requests(firstEventID + int64(i))
+		go func() {
+			result <- appender.append(requests)
+		}()
+	}
+	return results
+}
+
+func (s *historyAppenderSuite) requests(firstEventID int64) []*persistence.AppendHistoryEventsRequest {
+	return []*persistence.AppendHistoryEventsRequest{{FirstEventID: firstEventID}}
+}
+
+func (s *historyAppenderSuite) waitPending(appender *historyAppender, count int) {
+	for i := 0; i < 100; i++ {
+		appender.Lock()
+		pending := len(appender.pending)
+		appender.Unlock()
+		if pending == count {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	s.Fail("appends not pending")
+}
+
+func (s *historyAppenderSuite) writtenBatchSizes() []int {
+	s.Lock()
+	defer s.Unlock()
+
+	return s.batchSizes
+}
@@ -36,25 +36,24 @@ const (
 
 type (
 	historyBuilder struct {
-		serializer persistence.HistorySerializer
-		history    []*workflow.HistoryEvent
-		msBuilder  *mutableStateBuilder
-		logger     bark.Logger
+		history   []*workflow.HistoryEvent
+		msBuilder *mutableStateBuilder
+		logger    bark.Logger
 	}
 )
 
 func newHistoryBuilder(msBuilder *mutableStateBuilder, logger bark.Logger) *historyBuilder {
 	return &historyBuilder{
-		serializer: persistence.NewJSONHistorySerializer(),
-		history:    []*workflow.HistoryEvent{},
-		msBuilder:  msBuilder,
-		logger:     logger.WithField(logging.TagWorkflowComponent, logging.TagValueHistoryBuilderComponent),
+		history:   []*workflow.HistoryEvent{},
+		msBuilder: msBuilder,
+		logger:    logger.WithField(logging.TagWorkflowComponent, logging.TagValueHistoryBuilderComponent),
 	}
 }
 
-func (b *historyBuilder) Serialize() (*persistence.SerializedHistoryEventBatch, error) {
+func (b *historyBuilder) Serialize(serializer persistence.HistorySerializer) (*persistence.SerializedHistoryEventBatch,
+	error) {
 	eventBatch := persistence.NewHistoryEventBatch(persistence.GetDefaultHistoryVersion(), b.history)
-	history, err := b.serializer.Serialize(eventBatch)
+	history, err := serializer.Serialize(eventBatch)
 	if err != nil {
 		return nil, err
 	}
@@ -62,8 +61,8 @@ func (b *historyBuilder) Serialize() (*persistence.SerializedHistoryEventBatch,
 }
 
 func (b *historyBuilder) AddWorkflowExecutionStartedEvent(
-	request *workflow.StartWorkflowExecutionRequest) *workflow.HistoryEvent {
-	event := b.newWorkflowExecutionStartedEvent(request)
+	request *workflow.StartWorkflowExecutionRequest, firstExecutionRunID, traceID string) *workflow.HistoryEvent {
+	event := b.newWorkflowExecutionStartedEvent(request, firstExecutionRunID, traceID)
 
 	return b.addEventToHistory(event)
 }
@@ -381,7 +380,7 @@ func (b *historyBuilder) addEventToHistory(event *workflow.HistoryEvent) *workfl
 }
 
 func (b *historyBuilder) newWorkflowExecutionStartedEvent(
-	request *workflow.StartWorkflowExecutionRequest) *workflow.HistoryEvent {
+	request *workflow.StartWorkflowExecutionRequest, firstExecutionRunID, traceID string) *workflow.HistoryEvent {
 	historyEvent := b.msBuilder.createNewHistoryEvent(workflow.EventType_WorkflowExecutionStarted)
 	attributes := workflow.NewWorkflowExecutionStartedEventAttributes()
 	attributes.WorkflowType = request.GetWorkflowType()
@@ -390,6 +389,8 @@ func (b *historyBuilder) newWorkflowExecutionStartedEvent(
 	attributes.ExecutionStartToCloseTimeoutSeconds = common.Int32Ptr(request.GetExecutionStartToCloseTimeoutSeconds())
 	attributes.TaskStartToCloseTimeoutSeconds = common.Int32Ptr(request.GetTaskStartToCloseTimeoutSeconds())
 	attributes.Identity = common.StringPtr(request.GetIdentity())
+	attributes.FirstExecutionRunId = common.StringPtr(firstExecutionRunID)
+	attributes.TraceId = common.StringPtr(traceID)
 	historyEvent.WorkflowExecutionStartedEventAttributes = attributes
 
 	return historyEvent
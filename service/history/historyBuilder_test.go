@@ -302,6 +302,103 @@ func (s *historyBuilderSuite) TestHistoryBuilderDecisionStartedFailures() {
 	s.Equal(emptyEventID, s.getPreviousDecisionStartedEventID())
 }
 
+func (s *historyBuilderSuite) TestHistoryBuilderContinueAsNewChain() {
+	id := "continueasnew-historybuilder-test-workflow-id"
+	rid := "continueasnew-historybuilder-test-run-id"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(id),
+		RunId:      common.StringPtr(rid),
+	}
+
+	startedEvent := s.addWorkflowExecutionStartedEvent(we, "continueasnew-historybuilder-type",
+		"continueasnew-historybuilder-tasklist", nil, 70, 20, "continueasnew-historybuilder-worker")
+	s.NotNil(startedEvent)
+	s.Equal(rid, startedEvent.GetWorkflowExecutionStartedEventAttributes().GetFirstExecutionRunId())
+	s.Equal(rid, s.msBuilder.executionInfo.FirstExecutionRunID)
+	// Without a trace ID from the caller the chain is traced by its first run ID
+	s.Equal(rid, startedEvent.GetWorkflowExecutionStartedEventAttributes().GetTraceId())
+	s.Equal(rid, s.msBuilder.executionInfo.TraceID)
+
+	// Every execution of the chain records the first run ID
+	msBuilder := s.msBuilder
+	for _, newRunID := range []string{"continueasnew-historybuilder-test-run-id2",
+		"continueasnew-historybuilder-test-run-id3"} {
+		_, newStateBuilder, err := msBuilder.AddContinueAsNewEvent(common.EmptyEventID, s.domainID, newRunID,
+			uuid.New(), &workflow.ContinueAsNewWorkflowExecutionDecisionAttributes{
+				ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(70),
+			})
+		s.NoError(err)
+		s.Equal(rid, msBuilder.continueAsNew.FirstExecutionRunID)
+		s.Equal(newRunID, newStateBuilder.executionInfo.RunID)
+		s.Equal(rid, newStateBuilder.executionInfo.FirstExecutionRunID)
+
+		newStartedEvent := newStateBuilder.hBuilder.history[0]
+		s.Equal(workflow.EventType_WorkflowExecutionStarted, newStartedEvent.GetEventType())
+		s.Equal(rid, newStartedEvent.GetWorkflowExecutionStartedEventAttributes().GetFirstExecutionRunId())
+		msBuilder = newStateBuilder
+	}
+}
+
+func (s *historyBuilderSuite) TestHistoryBuilderContinueAsNewTraceID() {
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("traceid-historybuilder-test-workflow-id"),
+		RunId:      common.StringPtr("traceid-historybuilder-test-run-id"),
+	}
+	startedEvent := s.msBuilder.AddWorkflowExecutionStartedEvent(s.domainID, we,
+		&workflow.StartWorkflowExecutionRequest{
+			WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr("traceid-type")},
+			TaskList:                            &workflow.TaskList{Name: common.StringPtr("traceid-tasklist")},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(70),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(20),
+			TraceId:                             common.StringPtr("traceid-historybuilder-test-trace-id"),
+		})
+	s.NotNil(startedEvent)
+	s.Equal("traceid-historybuilder-test-trace-id",
+		startedEvent.GetWorkflowExecutionStartedEventAttributes().GetTraceId())
+
+	// The trace ID is inherited through continue-as-new
+	_, newStateBuilder, err := s.msBuilder.AddContinueAsNewEvent(common.EmptyEventID, s.domainID,
+		"traceid-historybuilder-test-run-id2", uuid.New(), &workflow.ContinueAsNewWorkflowExecutionDecisionAttributes{
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(70),
+		})
+	s.NoError(err)
+	s.Equal("traceid-historybuilder-test-trace-id", s.msBuilder.continueAsNew.TraceID)
+	s.Equal("traceid-historybuilder-test-trace-id", newStateBuilder.getTraceID())
+	s.Equal("traceid-historybuilder-test-trace-id",
+		newStateBuilder.hBuilder.history[0].GetWorkflowExecutionStartedEventAttributes().GetTraceId())
+
+	// The executions created before the trace IDs were recorded are traced by their first run ID
+	newStateBuilder.executionInfo.TraceID = ""
+	s.Equal("traceid-historybuilder-test-run-id", newStateBuilder.getTraceID())
+}
+
+func (s *historyBuilderSuite) TestHistoryBuilderContinueAsNewSearchAttributes() {
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("searchattr-historybuilder-test-workflow-id"),
+		RunId:      common.StringPtr("searchattr-historybuilder-test-run-id"),
+	}
+	searchAttributes := map[string][]byte{"CustomKeywordField": []byte(`"keyword"`)}
+	startedEvent := s.msBuilder.AddWorkflowExecutionStartedEvent(s.domainID, we,
+		&workflow.StartWorkflowExecutionRequest{
+			WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr("searchattr-type")},
+			TaskList:                            &workflow.TaskList{Name: common.StringPtr("searchattr-tasklist")},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(70),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(20),
+			SearchAttributes:                    &workflow.SearchAttributes{IndexedFields: searchAttributes},
+		})
+	s.NotNil(startedEvent)
+	s.Equal(searchAttributes, s.msBuilder.executionInfo.SearchAttributes)
+
+	// The search attributes are inherited through continue-as-new
+	_, newStateBuilder, err := s.msBuilder.AddContinueAsNewEvent(common.EmptyEventID, s.domainID,
+		"searchattr-historybuilder-test-run-id2", uuid.New(), &workflow.ContinueAsNewWorkflowExecutionDecisionAttributes{
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(70),
+		})
+	s.NoError(err)
+	s.Equal(searchAttributes, s.msBuilder.continueAsNew.SearchAttributes)
+	s.Equal(searchAttributes, newStateBuilder.executionInfo.SearchAttributes)
+}
+
 func (s *historyBuilderSuite) getNextEventID() int64 {
 	return s.msBuilder.executionInfo.NextEventID
 }
@@ -503,7 +600,7 @@ func (s *historyBuilderSuite) validateActivityTaskFailedEvent(event *workflow.Hi
 }
 
 func (s *historyBuilderSuite) printHistory() string {
-	history, err := s.builder.Serialize()
+	history, err := s.builder.Serialize(persistence.NewJSONHistorySerializer())
 	if err != nil {
 		s.logger.Errorf("Error serializing history: %v", err)
 		return ""
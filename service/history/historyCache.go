@@ -46,6 +46,7 @@ type (
 		cache.Cache
 		shard            ShardContext
 		executionManager persistence.ExecutionManager
+		loadShedder      *loadShedder
 		disabled         bool
 		logger           bark.Logger
 	}
@@ -58,9 +59,15 @@ var (
 )
 
 func newHistoryCache(maxSize int, shard ShardContext, logger bark.Logger) *historyCache {
+	return newHistoryCacheWithTTL(maxSize, historyCacheTTL, shard, logger)
+}
+
+// newHistoryCacheWithTTL creates a cache whose entries are reloaded from persistence once they are older than the TTL
+// and not in use
+func newHistoryCacheWithTTL(maxSize int, ttl time.Duration, shard ShardContext, logger bark.Logger) *historyCache {
 	opts := &cache.Options{}
 	opts.InitialCapacity = historyCacheInitialSize
-	opts.TTL = historyCacheTTL
+	opts.TTL = ttl
 	opts.Pin = true
 
 	return &historyCache{
@@ -100,6 +107,11 @@ func (c *historyCache) getOrCreateWorkflowExecution(domainID string,
 
 	key := execution.GetRunId()
 	context, cacheHit := c.Get(key).(*workflowExecutionContext)
+	if !cacheHit && c.loadShedder.isShedding() {
+		// The cache does not grow while the host sheds load, an execution which is not cached is only loaded
+		// once the pressure is gone so that its updates stay serialized by the lock of the cached context
+		return nil, nil, errMemoryPressure
+	}
 	if !cacheHit {
 		// Let's create the workflow execution context
 		context = newWorkflowExecutionContext(domainID, execution, c.shard, c.executionManager, c.logger)
@@ -131,7 +143,7 @@ func (c *historyCache) getCurrentExecutionWithRetry(
 		return err
 	}
 
-	err := backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
+	err := backoff.Retry(op, persistenceOperationRetryPolicy, persistence.IsTransientError)
 	if err != nil {
 		return nil, err
 	}
@@ -22,6 +22,7 @@ package history
 
 import (
 	"testing"
+	"time"
 
 	log "github.com/Sirupsen/logrus"
 	"github.com/pborman/uuid"
@@ -108,3 +109,26 @@ func (s *historyCacheSuite) TestHistoryCachePinning() {
 	s.False(context == newContext)
 	release()
 }
+
+func (s *historyCacheSuite) TestHistoryCacheTTL() {
+	domain := "test_domain"
+	s.cache = newHistoryCacheWithTTL(historyCacheMaxSize, 50*time.Millisecond, s.mockShard, s.logger)
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wf-cache-test"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+
+	context, release, err := s.cache.getOrCreateWorkflowExecution(domain, we)
+	s.Nil(err)
+	time.Sleep(100 * time.Millisecond)
+
+	// Context is still in use, so it must not expire
+	s.True(context == s.cache.Get(we.GetRunId()))
+	s.cache.Release(we.GetRunId())
+	release()
+
+	newContext, release2, err2 := s.cache.getOrCreateWorkflowExecution(domain, we)
+	s.Nil(err2)
+	s.False(context == newContext)
+	release2()
+}
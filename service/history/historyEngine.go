@@ -23,6 +23,7 @@ package history
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/pborman/uuid"
 	"github.com/uber-common/bark"
@@ -32,9 +33,11 @@ import (
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/idgen"
 	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/searchattribute"
 )
 
 const (
@@ -42,6 +45,9 @@ const (
 	activityCancelationMsgActivityIDUnknown  = "ACTIVITY_ID_UNKNOWN"
 	activityCancelationMsgActivityNotStarted = "ACTIVITY_ID_NOT_STARTED"
 	timerCancelationMsgTimerIDUnknown        = "TIMER_ID_UNKNOWN"
+	activityFailureReasonReset               = "resetWorkflow"
+	// resetHistoryPageSize is the number of history batches read per page by a reset of a workflow execution
+	resetHistoryPageSize = 100
 )
 
 type (
@@ -49,6 +55,7 @@ type (
 		shard              ShardContext
 		metadataMgr        persistence.MetadataManager
 		historyMgr         persistence.HistoryManager
+		visibilityMgr      persistence.VisibilityManager
 		executionManager   persistence.ExecutionManager
 		txProcessor        transferQueueProcessor
 		timerProcessor     timerQueueProcessor
@@ -59,13 +66,42 @@ type (
 		domainCache        cache.DomainCache
 		metricsClient      metrics.Client
 		logger             bark.Logger
-	}
-
-	// shardContextWrapper wraps ShardContext to notify transferQueueProcessor on new tasks.
-	// TODO: use to notify timerQueueProcessor as well.
+		searchAttributes   *searchattribute.Validator
+		idGenerator        idgen.Generator
+		taskPauses         *taskProcessingPauses
+		historyArchive     *persistence.HistoryArchive
+		timeoutCaps        *timeoutCaps
+		hotWorkflows       *hotWorkflowDetector
+		signalDedupWindow  time.Duration
+	}
+
+	// engineOptions are the dependencies and the settings of the history engine of a shard, shared by the engines
+	// of all the shards of the host
+	engineOptions struct {
+		metadataMgr         persistence.MetadataManager
+		domainCache         cache.DomainCache
+		visibilityMgr       persistence.VisibilityManager
+		matching            matching.Client
+		historyClient       hc.Client
+		idGenerator         idgen.Generator
+		historyCacheTTL     time.Duration
+		closeCleanupDelay   time.Duration
+		taskPauses          *taskProcessingPauses
+		callbackNotifier    *completionCallbackNotifier
+		historyArchive      *persistence.HistoryArchive
+		timeoutCaps         *timeoutCaps
+		hotWorkflows        *hotWorkflowDetector
+		signalDedupWindow   time.Duration
+		workflowTypeMetrics *workflowTypeMetrics
+		loadShedder         *loadShedder
+		searchAttributes    *searchattribute.Validator
+	}
+
+	// shardContextWrapper wraps ShardContext to notify transferQueueProcessor and timerQueueProcessor on new tasks.
 	shardContextWrapper struct {
 		ShardContext
-		txProcessor transferQueueProcessor
+		txProcessor    transferQueueProcessor
+		timerProcessor timerQueueProcessor
 	}
 )
 
@@ -78,36 +114,49 @@ var (
 	ErrConflict = errors.New("Conditional update failed")
 	// ErrMaxAttemptsExceeded is exported temporarily for integration test
 	ErrMaxAttemptsExceeded = errors.New("Maximum attempts exceeded to update history")
+	// errDuplicateRequest is returned by an update action for a request already applied to the execution, the
+	// execution is then left unchanged and the request succeeds
+	errDuplicateRequest = errors.New("Duplicate request, already applied")
 )
 
 // NewEngineWithShardContext creates an instance of history engine
-func NewEngineWithShardContext(shard ShardContext, metadataMgr persistence.MetadataManager,
-	visibilityMgr persistence.VisibilityManager, matching matching.Client, historyClient hc.Client) Engine {
+func NewEngineWithShardContext(shard ShardContext, opts *engineOptions) Engine {
 	shardWrapper := &shardContextWrapper{ShardContext: shard}
 	shard = shardWrapper
 	logger := shard.GetLogger()
 	executionManager := shard.GetExecutionManager()
 	historyManager := shard.GetHistoryManager()
-	historyCache := newHistoryCache(historyCacheMaxSize, shard, logger)
-	domainCache := cache.NewDomainCache(metadataMgr, logger)
-	txProcessor := newTransferQueueProcessor(shard, visibilityMgr, matching, historyClient, historyCache, domainCache)
+	historyCache := newHistoryCacheWithTTL(historyCacheMaxSize, opts.historyCacheTTL, shard, logger)
+	historyCache.loadShedder = opts.loadShedder
+	opts.loadShedder.addCache(historyCache)
+	txProcessor := newTransferQueueProcessor(shard, opts.visibilityMgr, opts.matching, opts.historyClient, historyCache,
+		opts.domainCache, opts.closeCleanupDelay, opts.taskPauses, opts.callbackNotifier, opts.workflowTypeMetrics)
 	historyEngImpl := &historyEngineImpl{
 		shard:              shard,
-		metadataMgr:        metadataMgr,
+		metadataMgr:        opts.metadataMgr,
 		historyMgr:         historyManager,
+		visibilityMgr:      opts.visibilityMgr,
 		executionManager:   executionManager,
 		txProcessor:        txProcessor,
 		tokenSerializer:    common.NewJSONTaskTokenSerializer(),
 		hSerializerFactory: persistence.NewHistorySerializerFactory(),
 		historyCache:       historyCache,
-		domainCache:        domainCache,
+		domainCache:        opts.domainCache,
 		logger: logger.WithFields(bark.Fields{
 			logging.TagWorkflowComponent: logging.TagValueHistoryEngineComponent,
 		}),
-		metricsClient: shard.GetMetricsClient(),
+		metricsClient:     shard.GetMetricsClient(),
+		searchAttributes:  opts.searchAttributes,
+		idGenerator:       opts.idGenerator,
+		taskPauses:        opts.taskPauses,
+		historyArchive:    opts.historyArchive,
+		timeoutCaps:       opts.timeoutCaps,
+		hotWorkflows:      opts.hotWorkflows,
+		signalDedupWindow: opts.signalDedupWindow,
 	}
 	historyEngImpl.timerProcessor = newTimerQueueProcessor(shard, historyEngImpl, executionManager, logger)
 	shardWrapper.txProcessor = txProcessor
+	shardWrapper.timerProcessor = historyEngImpl.timerProcessor
 	return historyEngImpl
 }
 
@@ -129,17 +178,34 @@ func (e *historyEngineImpl) Stop() {
 
 	e.txProcessor.Stop()
 	e.timerProcessor.Stop()
+	if e.historyCache != nil {
+		e.historyCache.loadShedder.removeCache(e.historyCache)
+	}
 }
 
 // StartWorkflowExecution starts a workflow execution
 func (e *historyEngineImpl) StartWorkflowExecution(startRequest *h.StartWorkflowExecutionRequest) (
 	*workflow.StartWorkflowExecutionResponse, error) {
-	domainID := startRequest.GetDomainUUID()
-	request := startRequest.GetStartRequest()
+	return e.startWorkflowExecution(startRequest.GetDomainUUID(), startRequest.GetStartRequest(),
+		startRequest.GetParentExecutionInfo(), nil)
+}
+
+// startWorkflowExecution starts a workflow execution, the signal request is recorded in the history of the new run
+// right after it started if it is not nil
+func (e *historyEngineImpl) startWorkflowExecution(domainID string, request *workflow.StartWorkflowExecutionRequest,
+	parentInfo *h.ParentExecutionInfo, signalRequest *workflow.SignalWorkflowExecutionRequest) (
+	*workflow.StartWorkflowExecutionResponse, error) {
+	if err := e.searchAttributes.Validate(request.GetSearchAttributes()); err != nil {
+		return nil, err
+	}
+
 	executionID := request.GetWorkflowId()
+	if e.timeoutCaps.capDecisionTimeout(domainID, request.TaskStartToCloseTimeoutSeconds) {
+		e.reportTimeoutCapped(metrics.HistoryStartWorkflowExecutionScope, domainID, executionID, "decision task")
+	}
 	// We generate a new workflow execution run_id on each StartWorkflowExecution call.  This generated run_id is
 	// returned back to the caller as the response to StartWorkflowExecution.
-	runID := uuid.New()
+	runID := e.idGenerator.NewID()
 	workflowExecution := workflow.WorkflowExecution{
 		WorkflowId: common.StringPtr(executionID),
 		RunId:      common.StringPtr(runID),
@@ -148,7 +214,6 @@ func (e *historyEngineImpl) StartWorkflowExecution(startRequest *h.StartWorkflow
 	var parentExecution *workflow.WorkflowExecution
 	initiatedID := emptyEventID
 	parentDomainID := ""
-	parentInfo := startRequest.GetParentExecutionInfo()
 	if parentInfo != nil {
 		parentDomainID = parentInfo.GetDomainUUID()
 		parentExecution = parentInfo.GetExecution()
@@ -162,8 +227,14 @@ func (e *historyEngineImpl) StartWorkflowExecution(startRequest *h.StartWorkflow
 	if startedEvent == nil {
 		return nil, &workflow.InternalServiceError{Message: "Failed to add workflow execution started event."}
 	}
+	if signalRequest != nil && msBuilder.AddWorkflowExecutionSignaled(signalRequest) == nil {
+		return nil, &workflow.InternalServiceError{Message: "Failed to add workflow execution signaled event."}
+	}
 
+	// The first decision is started for the caller when it is also the worker, it is never the case of a child
+	eager := parentInfo == nil && request.GetRequestEagerExecution()
 	var transferTasks []persistence.Task
+	var timerTasks []persistence.Task
 	decisionScheduleID := emptyEventID
 	decisionStartID := emptyEventID
 	decisionTimeout := int32(0)
@@ -174,16 +245,32 @@ func (e *historyEngineImpl) StartWorkflowExecution(startRequest *h.StartWorkflow
 			return nil, &workflow.InternalServiceError{Message: "Failed to add decision started event."}
 		}
 
-		transferTasks = []persistence.Task{&persistence.DecisionTask{
-			DomainID: domainID, TaskList: taskList, ScheduleID: di.ScheduleID,
-		}}
+		if eager {
+			// The decision is started for the caller instead of going through matching, with the ID of the start
+			// request so that a retried start returns it again
+			decisionStartedEvent := msBuilder.AddDecisionTaskStartedEvent(di.ScheduleID, request.GetRequestId(),
+				&workflow.PollForDecisionTaskRequest{
+					TaskList: request.TaskList,
+					Identity: request.Identity,
+				})
+			if decisionStartedEvent == nil {
+				return nil, &workflow.InternalServiceError{Message: "Failed to add decision started event."}
+			}
+			tBuilder := newTimerBuilder(e.logger, e.shard.GetTimeSource())
+			timerTasks = []persistence.Task{tBuilder.AddDecisionTimoutTask(di.ScheduleID, di.DecisionTimeout)}
+			decisionStartID = decisionStartedEvent.GetEventId()
+		} else {
+			transferTasks = []persistence.Task{&persistence.DecisionTask{
+				DomainID: domainID, TaskList: taskList, ScheduleID: di.ScheduleID,
+			}}
+			decisionStartID = di.StartedID
+		}
 		decisionScheduleID = di.ScheduleID
-		decisionStartID = di.StartedID
 		decisionTimeout = di.DecisionTimeout
 	}
 
 	// Serialize the history
-	serializedHistory, serializedError := msBuilder.hBuilder.Serialize()
+	serializedHistory, serializedError := msBuilder.hBuilder.Serialize(e.shard.GetHistorySerializer())
 	if serializedError != nil {
 		logging.LogHistorySerializationErrorEvent(e.logger, serializedError, fmt.Sprintf(
 			"HistoryEventBatch serialization error on start workflow.  WorkflowID: %v, RunID: %v", executionID, runID))
@@ -203,7 +290,7 @@ func (e *historyEngineImpl) StartWorkflowExecution(startRequest *h.StartWorkflow
 		return nil, err1
 	}
 
-	_, err := e.shard.CreateWorkflowExecution(&persistence.CreateWorkflowExecutionRequest{
+	err := e.createWorkflowExecution(&persistence.CreateWorkflowExecutionRequest{
 		RequestID:                   request.GetRequestId(),
 		DomainID:                    domainID,
 		Execution:                   workflowExecution,
@@ -217,10 +304,15 @@ func (e *historyEngineImpl) StartWorkflowExecution(startRequest *h.StartWorkflow
 		NextEventID:                 msBuilder.GetNextEventID(),
 		LastProcessedEvent:          emptyEventID,
 		TransferTasks:               transferTasks,
+		TimerTasks:                  timerTasks,
 		DecisionScheduleID:          decisionScheduleID,
 		DecisionStartedID:           decisionStartID,
 		DecisionStartToCloseTimeout: decisionTimeout,
 		ContinueAsNew:               false,
+		CompletionCallbackURL:       request.GetCompletionCallbackUrl(),
+		FirstExecutionRunID:         msBuilder.executionInfo.FirstExecutionRunID,
+		TraceID:                     msBuilder.executionInfo.TraceID,
+		SearchAttributes:            msBuilder.executionInfo.SearchAttributes,
 	})
 
 	if err != nil {
@@ -236,9 +328,21 @@ func (e *historyEngineImpl) StartWorkflowExecution(startRequest *h.StartWorkflow
 			})
 
 			if t.GetStartRequestId() == request.GetRequestId() {
-				return &workflow.StartWorkflowExecutionResponse{
+				response := &workflow.StartWorkflowExecutionResponse{
 					RunId: t.RunId,
-				}, nil
+				}
+				if eager {
+					startedExecution := workflow.WorkflowExecution{
+						WorkflowId: common.StringPtr(executionID),
+						RunId:      t.RunId,
+					}
+					decisionTask, err := e.getEagerDecisionTask(domainID, startedExecution, request.GetRequestId())
+					if err != nil {
+						return nil, err
+					}
+					response.DecisionTask = decisionTask
+				}
+				return response, nil
 			}
 		case *persistence.ShardOwnershipLostError:
 			// We created the history events but failed to create workflow execution, so cleanup the history which could cause
@@ -256,9 +360,130 @@ func (e *historyEngineImpl) StartWorkflowExecution(startRequest *h.StartWorkflow
 		return nil, err
 	}
 
-	return &workflow.StartWorkflowExecutionResponse{
+	response := &workflow.StartWorkflowExecutionResponse{
 		RunId: workflowExecution.RunId,
-	}, nil
+	}
+	if eager {
+		e.timerProcessor.NotifyNewTimer(timerTasks)
+		response.DecisionTask = e.createEagerDecisionTaskResponse(domainID, workflowExecution,
+			msBuilder.getWorkflowType(), msBuilder.hBuilder.history, decisionScheduleID, decisionStartID)
+	}
+	return response, nil
+}
+
+// getEagerDecisionTask returns again the first decision of a run started for the caller of a start request which is
+// retried, nil if the decision is no longer started for it.  A decision which timed out meanwhile is dispatched
+// through matching again by its timeout.
+func (e *historyEngineImpl) getEagerDecisionTask(domainID string, execution workflow.WorkflowExecution,
+	requestID string) (*workflow.PollForDecisionTaskResponse, error) {
+	context, release, err0 := e.historyCache.getOrCreateWorkflowExecution(domainID, execution)
+	if err0 != nil {
+		return nil, err0
+	}
+	defer release()
+
+	msBuilder, err1 := context.loadWorkflowExecution()
+	if err1 != nil {
+		return nil, err1
+	}
+	di, isRunning := msBuilder.GetPendingDecision(msBuilder.executionInfo.DecisionScheduleID)
+	if !msBuilder.isWorkflowExecutionRunning() || !isRunning || di.StartedID == emptyEventID ||
+		di.RequestID != requestID {
+		return nil, nil
+	}
+
+	events, err2 := e.getHistoryEvents(domainID, execution, di.StartedID+1)
+	if err2 != nil {
+		return nil, err2
+	}
+	// The history read ends with whole batches, which may go past the started event
+	for len(events) > 0 && events[len(events)-1].GetEventId() > di.StartedID {
+		events = events[:len(events)-1]
+	}
+	return e.createEagerDecisionTaskResponse(domainID, execution, msBuilder.getWorkflowType(), events,
+		di.ScheduleID, di.StartedID), nil
+}
+
+// createWorkflowExecution creates a run of a workflow which has no open run.  The current execution of the workflow
+// is pointed at the run before the run is written, so only one of the runs created concurrently is written, and it
+// is removed again if the run could not be written.
+func (e *historyEngineImpl) createWorkflowExecution(request *persistence.CreateWorkflowExecutionRequest) error {
+	workflowID := request.Execution.GetWorkflowId()
+	runID := request.Execution.GetRunId()
+	err := e.shard.ExecuteWithRangeID(func(rangeID int64) error {
+		return e.executionManager.CreateCurrentWorkflowExecution(&persistence.CreateCurrentWorkflowExecutionRequest{
+			RangeID:    rangeID,
+			DomainID:   request.DomainID,
+			WorkflowID: workflowID,
+			RunID:      runID,
+			RequestID:  request.RequestID,
+		})
+	})
+	if t, ok := err.(*workflow.WorkflowExecutionAlreadyStartedError); ok && t.GetRunId() == runID {
+		// An attempt which timed out already pointed the current execution at the run
+		err = nil
+	}
+	if err != nil {
+		return err
+	}
+
+	request.CurrentExecutionRecorded = true
+	if _, err := e.shard.CreateWorkflowExecution(request); err != nil {
+		if _, ok := err.(*persistence.TimeoutError); !ok {
+			e.deleteCurrentExecution(request.DomainID, workflowID, runID)
+		}
+		return err
+	}
+	return nil
+}
+
+// deleteCurrentExecution removes the current execution of a workflow pointing at a run which could not be written.
+// The execution scanner removes it if this fails.
+func (e *historyEngineImpl) deleteCurrentExecution(domainID, workflowID, runID string) {
+	if err := e.executionManager.DeleteCurrentWorkflowExecution(&persistence.DeleteCurrentWorkflowExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: workflowID,
+		RunID:      runID,
+	}); err != nil {
+		logging.LogPersistantStoreErrorEvent(e.logger, logging.TagValueStoreOperationDeleteCurrentExecution, err,
+			fmt.Sprintf("{WorkflowID: %v, RunID: %v}", workflowID, runID))
+	}
+}
+
+// updateCurrentExecution points the current execution of a workflow at another run, as long as it still points at
+// the previous run
+func (e *historyEngineImpl) updateCurrentExecution(domainID, workflowID, runID, previousRunID,
+	requestID string) error {
+	return e.shard.ExecuteWithRangeID(func(rangeID int64) error {
+		return e.executionManager.UpdateCurrentWorkflowExecution(&persistence.UpdateCurrentWorkflowExecutionRequest{
+			RangeID:       rangeID,
+			DomainID:      domainID,
+			WorkflowID:    workflowID,
+			RunID:         runID,
+			RequestID:     requestID,
+			PreviousRunID: previousRunID,
+		})
+	})
+}
+
+// createEagerDecisionTaskResponse builds the first decision task of a workflow started for the caller, with the
+// history of the run up to the started decision
+func (e *historyEngineImpl) createEagerDecisionTaskResponse(domainID string, execution workflow.WorkflowExecution,
+	workflowType *workflow.WorkflowType, events []*workflow.HistoryEvent,
+	scheduleID, startedID int64) *workflow.PollForDecisionTaskResponse {
+	response := workflow.NewPollForDecisionTaskResponse()
+	response.WorkflowExecution = &execution
+	token := &common.TaskToken{
+		DomainID:   domainID,
+		WorkflowID: execution.GetWorkflowId(),
+		RunID:      execution.GetRunId(),
+		ScheduleID: scheduleID,
+	}
+	response.TaskToken, _ = e.tokenSerializer.Serialize(token)
+	response.WorkflowType = workflowType
+	response.StartedEventId = common.Int64Ptr(startedID)
+	response.History = &workflow.History{Events: events}
+	return response
 }
 
 // GetWorkflowExecutionNextEventID retrieves the nextEventId of the workflow execution history
@@ -276,14 +501,19 @@ func (e *historyEngineImpl) GetWorkflowExecutionNextEventID(
 	}
 	defer release()
 
-	msBuilder, err1 := context.loadWorkflowExecution()
+	executionInfo, err1 := context.loadExecutionInfo()
 	if err1 != nil {
 		return nil, err1
 	}
 
 	result := h.NewGetWorkflowExecutionNextEventIDResponse()
-	result.EventId = common.Int64Ptr(msBuilder.GetNextEventID())
+	result.EventId = common.Int64Ptr(executionInfo.NextEventID)
 	result.RunId = context.workflowExecution.RunId
+	result.Tasklist = &workflow.TaskList{Name: common.StringPtr(executionInfo.TaskList)}
+	result.WorkflowType = &workflow.WorkflowType{Name: common.StringPtr(executionInfo.WorkflowTypeName)}
+	if executionInfo.LastProcessedEvent != emptyEventID {
+		result.PreviousStartedEventId = common.Int64Ptr(executionInfo.LastProcessedEvent)
+	}
 
 	return result, nil
 }
@@ -494,12 +724,13 @@ Update_History_Loop:
 }
 
 // RespondDecisionTaskCompleted completes a decision task
-func (e *historyEngineImpl) RespondDecisionTaskCompleted(req *h.RespondDecisionTaskCompletedRequest) error {
+func (e *historyEngineImpl) RespondDecisionTaskCompleted(
+	req *h.RespondDecisionTaskCompletedRequest) (*workflow.RespondDecisionTaskCompletedResponse, error) {
 	domainID := req.GetDomainUUID()
 	request := req.GetCompleteRequest()
 	token, err0 := e.tokenSerializer.Deserialize(request.GetTaskToken())
 	if err0 != nil {
-		return &workflow.BadRequestError{Message: "Error deserializing task token."}
+		return nil, &workflow.BadRequestError{Message: "Error deserializing task token."}
 	}
 
 	workflowExecution := workflow.WorkflowExecution{
@@ -509,7 +740,7 @@ func (e *historyEngineImpl) RespondDecisionTaskCompleted(req *h.RespondDecisionT
 
 	context, release, err0 := e.historyCache.getOrCreateWorkflowExecution(domainID, workflowExecution)
 	if err0 != nil {
-		return err0
+		return nil, err0
 	}
 	defer release()
 
@@ -517,7 +748,7 @@ Update_History_Loop:
 	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
 		msBuilder, err1 := context.loadWorkflowExecution()
 		if err1 != nil {
-			return err1
+			return nil, err1
 		}
 
 		scheduleID := token.ScheduleID
@@ -532,13 +763,13 @@ Update_History_Loop:
 
 		di, isRunning := msBuilder.GetPendingDecision(scheduleID)
 		if !msBuilder.isWorkflowExecutionRunning() || !isRunning || di.StartedID == emptyEventID {
-			return &workflow.EntityNotExistsError{Message: "Decision task not found."}
+			return nil, &workflow.EntityNotExistsError{Message: "Decision task not found."}
 		}
 
 		startedID := di.StartedID
 		completedEvent := msBuilder.AddDecisionTaskCompletedEvent(scheduleID, startedID, request)
 		if completedEvent == nil {
-			return &workflow.InternalServiceError{Message: "Unable to add DecisionTaskCompleted event to history."}
+			return nil, &workflow.InternalServiceError{Message: "Unable to add DecisionTaskCompleted event to history."}
 		}
 
 		failDecision := false
@@ -550,6 +781,8 @@ Update_History_Loop:
 		transferTasks := []persistence.Task{}
 		timerTasks := []persistence.Task{}
 		var continueAsNewBuilder *mutableStateBuilder
+		var eagerActivity *persistence.ActivityInfo
+		var eagerScheduledEvent, eagerStartedEvent *workflow.HistoryEvent
 	Process_Decision_Loop:
 		for _, d := range request.Decisions {
 			switch d.GetDecisionType() {
@@ -563,7 +796,7 @@ Update_History_Loop:
 					// TODO: Error handling for ActivitySchedule failed when domain lookup fails
 					info, _, err := e.domainCache.GetDomain(attributes.GetDomain())
 					if err != nil {
-						return &workflow.InternalServiceError{Message: "Unable to schedule activity across domain."}
+						return nil, &workflow.InternalServiceError{Message: "Unable to schedule activity across domain."}
 					}
 					targetDomainID = info.ID
 				}
@@ -573,13 +806,25 @@ Update_History_Loop:
 					failCause = workflow.DecisionTaskFailedCause_BAD_SCHEDULE_ACTIVITY_ATTRIBUTES
 					break Process_Decision_Loop
 				}
+				if e.timeoutCaps.capActivityTimeouts(domainID, attributes) {
+					e.reportTimeoutCapped(metrics.HistoryRespondDecisionTaskCompletedScope, domainID, token.WorkflowID,
+						"activity task")
+				}
 
 				scheduleEvent, ai := msBuilder.AddActivityTaskScheduledEvent(completedID, attributes)
-				transferTasks = append(transferTasks, &persistence.ActivityTask{
-					DomainID:   targetDomainID,
-					TaskList:   attributes.GetTaskList().GetName(),
-					ScheduleID: scheduleEvent.GetEventId(),
-				})
+				if eagerActivity == nil && targetDomainID == domainID && request.IsSetEagerActivityTaskList() &&
+					attributes.GetTaskList().GetName() == request.GetEagerActivityTaskList().GetName() {
+					// The activity is started right away for the worker completing the decision, so it does not
+					// need to go through matching.
+					eagerActivity = ai
+					eagerScheduledEvent = scheduleEvent
+				} else {
+					transferTasks = append(transferTasks, &persistence.ActivityTask{
+						DomainID:   targetDomainID,
+						TaskList:   attributes.GetTaskList().GetName(),
+						ScheduleID: scheduleEvent.GetEventId(),
+					})
+				}
 
 				// Create activity timeouts.
 				Schedule2StartTimeoutTask := context.tBuilder.AddScheduleToStartActivityTimeout(ai)
@@ -587,7 +832,7 @@ Update_History_Loop:
 
 				Schedule2CloseTimeoutTask, err := context.tBuilder.AddScheduleToCloseActivityTimeout(ai)
 				if err != nil {
-					return err
+					return nil, err
 				}
 				timerTasks = append(timerTasks, Schedule2CloseTimeoutTask)
 				defer e.timerProcessor.NotifyNewTimer(timerTasks)
@@ -742,7 +987,7 @@ Update_History_Loop:
 
 				foreignInfo, _, err := e.domainCache.GetDomain(attributes.GetDomain())
 				if err != nil {
-					return &workflow.InternalServiceError{
+					return nil, &workflow.InternalServiceError{
 						Message: fmt.Sprintf("Unable to schedule activity across domain: %v.",
 							attributes.GetDomain())}
 				}
@@ -750,7 +995,7 @@ Update_History_Loop:
 				wfCancelReqEvent := msBuilder.AddRequestCancelExternalWorkflowExecutionInitiatedEvent(
 					completedID, attributes)
 				if wfCancelReqEvent == nil {
-					return &workflow.InternalServiceError{Message: "Unable to add external cancel workflow request."}
+					return nil, &workflow.InternalServiceError{Message: "Unable to add external cancel workflow request."}
 				}
 
 				transferTasks = append(transferTasks, &persistence.CancelExecutionTask{
@@ -782,10 +1027,15 @@ Update_History_Loop:
 					failCause = workflow.DecisionTaskFailedCause_BAD_CONTINUE_AS_NEW_ATTRIBUTES
 					break Process_Decision_Loop
 				}
-				runID := uuid.New()
-				_, newStateBuilder, err := msBuilder.AddContinueAsNewEvent(completedID, domainID, runID, attributes)
+				if e.timeoutCaps.capDecisionTimeout(domainID, attributes.TaskStartToCloseTimeoutSeconds) {
+					e.reportTimeoutCapped(metrics.HistoryRespondDecisionTaskCompletedScope, domainID, token.WorkflowID,
+						"decision task")
+				}
+				runID := e.idGenerator.NewID()
+				_, newStateBuilder, err := msBuilder.AddContinueAsNewEvent(completedID, domainID, runID,
+					e.idGenerator.NewID(), attributes)
 				if err != nil {
-					return nil
+					return nil, nil
 				}
 				isComplete = true
 				continueAsNewBuilder = newStateBuilder
@@ -800,12 +1050,12 @@ Update_History_Loop:
 					// TODO: Error handling for DecisionType_StartChildWorkflowExecution failed when domain lookup fails
 					info, _, err := e.domainCache.GetDomain(attributes.GetDomain())
 					if err != nil {
-						return &workflow.InternalServiceError{Message: "Unable to schedule child execution across domain."}
+						return nil, &workflow.InternalServiceError{Message: "Unable to schedule child execution across domain."}
 					}
 					targetDomainID = info.ID
 				}
 
-				requestID := uuid.New()
+				requestID := e.idGenerator.NewID()
 				initiatedEvent, _ := msBuilder.AddStartChildWorkflowExecutionInitiatedEvent(completedID, requestID, attributes)
 				transferTasks = append(transferTasks, &persistence.StartChildExecutionTask{
 					TargetDomainID:   targetDomainID,
@@ -814,7 +1064,7 @@ Update_History_Loop:
 				})
 
 			default:
-				return &workflow.BadRequestError{Message: fmt.Sprintf("Unknown decision type: %v", d.GetDecisionType())}
+				return nil, &workflow.BadRequestError{Message: fmt.Sprintf("Unknown decision type: %v", d.GetDecisionType())}
 			}
 		}
 
@@ -824,11 +1074,48 @@ Update_History_Loop:
 			var err1 error
 			msBuilder, err1 = e.failDecision(context, scheduleID, startedID, failCause, request)
 			if err1 != nil {
-				return err1
+				return nil, err1
 			}
 			isComplete = false
 			hasUnhandledEvents = true
 			continueAsNewBuilder = nil
+			eagerActivity = nil
+		}
+
+		if eagerActivity != nil && isComplete {
+			// Nobody is going to run the activity of a closed workflow, leave it to matching like any other
+			transferTasks = append(transferTasks, &persistence.ActivityTask{
+				DomainID:   domainID,
+				TaskList:   eagerScheduledEvent.GetActivityTaskScheduledEventAttributes().GetTaskList().GetName(),
+				ScheduleID: eagerActivity.ScheduleID,
+			})
+			eagerActivity = nil
+		}
+
+		if eagerActivity != nil {
+			eagerStartedEvent = msBuilder.AddActivityTaskStartedEvent(eagerActivity, eagerActivity.ScheduleID,
+				e.idGenerator.NewID(), &workflow.PollForActivityTaskRequest{
+					TaskList: request.GetEagerActivityTaskList(),
+					Identity: request.Identity,
+				})
+			if eagerStartedEvent == nil {
+				return nil, &workflow.InternalServiceError{Message: "Unable to add ActivityTaskStarted event to history."}
+			}
+
+			start2CloseTimeoutTask, err := context.tBuilder.AddStartToCloseActivityTimeout(eagerActivity)
+			if err != nil {
+				return nil, err
+			}
+			timerTasks = append(timerTasks, start2CloseTimeoutTask)
+
+			start2HeartBeatTimeoutTask, err := context.tBuilder.AddHeartBeatActivityTimeout(eagerActivity)
+			if err != nil {
+				return nil, err
+			}
+			if start2HeartBeatTimeoutTask != nil {
+				timerTasks = append(timerTasks, start2HeartBeatTimeoutTask)
+			}
+			defer e.timerProcessor.NotifyNewTimer(timerTasks)
 		}
 
 		// Schedule another decision task if new events came in during this decision
@@ -849,7 +1136,7 @@ Update_History_Loop:
 		// Generate a transaction ID for appending events to history
 		transactionID, err3 := e.shard.GetNextTransferTaskID()
 		if err3 != nil {
-			return err3
+			return nil, err3
 		}
 
 		// We apply the update to execution using optimistic concurrency.  If it fails due to a conflict then reload
@@ -870,13 +1157,48 @@ Update_History_Loop:
 				continue Update_History_Loop
 			}
 
-			return updateErr
+			return nil, updateErr
 		}
 
-		return err
+		if err != nil || eagerActivity == nil {
+			return nil, err
+		}
+
+		return &workflow.RespondDecisionTaskCompletedResponse{
+			ActivityTask: e.createEagerActivityTaskResponse(domainID, workflowExecution, eagerScheduledEvent,
+				eagerStartedEvent),
+		}, nil
 	}
 
-	return ErrMaxAttemptsExceeded
+	return nil, ErrMaxAttemptsExceeded
+}
+
+// createEagerActivityTaskResponse builds the activity task handed back to the worker which completed the decision
+// that scheduled it, the same way matching does for a polled activity task.
+func (e *historyEngineImpl) createEagerActivityTaskResponse(domainID string, execution workflow.WorkflowExecution,
+	scheduledEvent, startedEvent *workflow.HistoryEvent) *workflow.PollForActivityTaskResponse {
+	attributes := scheduledEvent.GetActivityTaskScheduledEventAttributes()
+
+	response := workflow.NewPollForActivityTaskResponse()
+	response.ActivityId = attributes.ActivityId
+	response.ActivityType = attributes.GetActivityType()
+	response.Input = attributes.GetInput()
+	response.StartedEventId = common.Int64Ptr(startedEvent.GetEventId())
+	response.WorkflowExecution = &execution
+	response.ScheduledTimestamp = common.Int64Ptr(scheduledEvent.GetTimestamp())
+	response.ScheduleToCloseTimeoutSeconds = common.Int32Ptr(attributes.GetScheduleToCloseTimeoutSeconds())
+	response.StartedTimestamp = common.Int64Ptr(startedEvent.GetTimestamp())
+	response.StartToCloseTimeoutSeconds = common.Int32Ptr(attributes.GetStartToCloseTimeoutSeconds())
+	response.HeartbeatTimeoutSeconds = common.Int32Ptr(attributes.GetHeartbeatTimeoutSeconds())
+
+	token := &common.TaskToken{
+		DomainID:   domainID,
+		WorkflowID: execution.GetWorkflowId(),
+		RunID:      execution.GetRunId(),
+		ScheduleID: scheduledEvent.GetEventId(),
+	}
+	response.TaskToken, _ = e.tokenSerializer.Serialize(token)
+	return response
 }
 
 // RespondActivityTaskCompleted completes an activity task.
@@ -1147,6 +1469,8 @@ func (e *historyEngineImpl) RecordActivityTaskHeartbeat(
 		RunId:      common.StringPtr(token.RunID),
 	}
 
+	e.checkHotWorkflow(hotWorkflowHeartbeat, domainID, token.WorkflowID)
+
 	context, release, err0 := e.historyCache.getOrCreateWorkflowExecution(domainID, workflowExecution)
 	if err0 != nil {
 		return nil, err0
@@ -1185,6 +1509,14 @@ Update_History_Loop:
 		// Save progress and last HB reported time.
 		msBuilder.updateActivityProgress(ai, request)
 
+		now := time.Now()
+		if !context.isHeartbeatFlushDue(ai, now) {
+			// Progress is persisted along with the next update of the execution.
+			e.metricsClient.IncCounter(metrics.HistoryRecordActivityTaskHeartbeatScope,
+				metrics.HeartbeatFlushSkippedCounter)
+			return &workflow.RecordActivityTaskHeartbeatResponse{CancelRequested: common.BoolPtr(cancelRequested)}, nil
+		}
+
 		// Generate a transaction ID for appending events to history
 		transactionID, err2 := e.shard.GetNextTransferTaskID()
 		if err2 != nil {
@@ -1202,6 +1534,7 @@ Update_History_Loop:
 
 			return nil, err
 		}
+		context.heartbeatFlushed(scheduleID, now)
 		return &workflow.RecordActivityTaskHeartbeatResponse{CancelRequested: common.BoolPtr(cancelRequested)}, nil
 	}
 
@@ -1210,9 +1543,7 @@ Update_History_Loop:
 
 // RequestCancelWorkflowExecution
 // https://github.com/uber/cadence/issues/145
-// TODO: (1) Each external request can result in one cancel requested event. it would be nice
-//	 to have dedupe on the server side.
-//	(2) if there are multiple calls if one request goes through then can we respond to the other ones with
+// TODO: if there are multiple calls if one request goes through then can we respond to the other ones with
 //       cancellation in progress instead of success.
 func (e *historyEngineImpl) RequestCancelWorkflowExecution(
 	req *h.RequestCancelWorkflowExecutionRequest) error {
@@ -1224,17 +1555,38 @@ func (e *historyEngineImpl) RequestCancelWorkflowExecution(
 		RunId:      common.StringPtr(request.GetWorkflowExecution().GetRunId()),
 	}
 
-	return e.updateWorkflowExecution(domainID, workflowExecution, false, true,
-		func(msBuilder *mutableStateBuilder) error {
+	if request.GetTerminateAfterSeconds() > 0 {
+		if err := checkTerminationProtection(e.domainCache, domainID, false); err != nil {
+			return err
+		}
+	}
+
+	return e.updateWorkflowExecutionWithTimers(domainID, workflowExecution, false, true,
+		func(msBuilder *mutableStateBuilder, tBuilder *timerBuilder) ([]persistence.Task, error) {
 			if !msBuilder.isWorkflowExecutionRunning() {
-				return &workflow.EntityNotExistsError{Message: "Workflow execution already completed."}
+				return nil, &workflow.EntityNotExistsError{Message: "Workflow execution already completed."}
 			}
 
-			if msBuilder.AddWorkflowExecutionCancelRequestedEvent("", req) == nil {
-				return &workflow.InternalServiceError{Message: "Unable to cancel workflow execution."}
+			// A retry of the request which cancelled the execution is deduped by its request ID
+			requestID := request.GetRequestId()
+			if cancelRequested, cancelRequestID := msBuilder.isCancelRequested(); cancelRequested &&
+				requestID != "" && requestID == cancelRequestID {
+				return nil, errDuplicateRequest
 			}
 
-			return nil
+			cancelRequestedEvent := msBuilder.AddWorkflowExecutionCancelRequestedEvent("", req)
+			if cancelRequestedEvent == nil {
+				return nil, &workflow.InternalServiceError{Message: "Unable to cancel workflow execution."}
+			}
+
+			// Forcefully terminate the execution if it does not close within the requested grace period
+			var timerTasks []persistence.Task
+			if request.GetTerminateAfterSeconds() > 0 {
+				timerTasks = append(timerTasks, tBuilder.AddCancelTimeoutTask(cancelRequestedEvent.GetEventId(),
+					request.GetTerminateAfterSeconds()))
+			}
+
+			return timerTasks, nil
 		})
 }
 
@@ -1246,18 +1598,147 @@ func (e *historyEngineImpl) SignalWorkflowExecution(signalRequest *h.SignalWorkf
 		RunId:      common.StringPtr(request.GetWorkflowExecution().GetRunId()),
 	}
 
+	if e.checkHotWorkflow(hotWorkflowSignal, domainID, execution.GetWorkflowId()) {
+		return errHotWorkflowSignalThrottled
+	}
+
 	return e.updateWorkflowExecution(domainID, execution, false, true,
 		func(msBuilder *mutableStateBuilder) error {
-			if !msBuilder.isWorkflowExecutionRunning() {
-				return &workflow.EntityNotExistsError{Message: "Workflow execution already completed."}
-			}
+			return e.addSignal(msBuilder, request)
+		})
+}
+
+// SignalWithStartWorkflowExecution signals the current run of a workflow if it is running, otherwise it starts a new
+// run with the signal recorded in its history
+func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
+	signalWithStartRequest *h.SignalWithStartWorkflowExecutionRequest) (*workflow.StartWorkflowExecutionResponse, error) {
+	domainID := signalWithStartRequest.GetDomainUUID()
+	request := signalWithStartRequest.GetSignalWithStartRequest()
+	signalRequest := &workflow.SignalWorkflowExecutionRequest{
+		Domain:            request.Domain,
+		WorkflowExecution: &workflow.WorkflowExecution{WorkflowId: request.WorkflowId},
+		SignalName:        request.SignalName,
+		Input:             request.SignalInput,
+		Identity:          request.Identity,
+		RequestId:         request.RequestId,
+	}
+	startRequest := &workflow.StartWorkflowExecutionRequest{
+		Domain:                              request.Domain,
+		WorkflowId:                          request.WorkflowId,
+		WorkflowType:                        request.WorkflowType,
+		TaskList:                            request.TaskList,
+		Input:                               request.Input,
+		ExecutionStartToCloseTimeoutSeconds: request.ExecutionStartToCloseTimeoutSeconds,
+		TaskStartToCloseTimeoutSeconds:      request.TaskStartToCloseTimeoutSeconds,
+		Identity:                            request.Identity,
+		RequestId:                           request.RequestId,
+		SearchAttributes:                    request.SearchAttributes,
+		CompletionCallbackUrl:               request.CompletionCallbackUrl,
+	}
+
+	if e.checkHotWorkflow(hotWorkflowSignal, domainID, request.GetWorkflowId()) {
+		return nil, errHotWorkflowSignalThrottled
+	}
+
+	// The run is created along with the current execution record of the workflow, which fails if another run was
+	// started since the workflow was found not running.  That run is then signaled instead.
+	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
+		runID, err := e.signalCurrentExecution(domainID, signalRequest)
+		if err == nil {
+			return &workflow.StartWorkflowExecutionResponse{RunId: common.StringPtr(runID)}, nil
+		}
+		if _, ok := err.(*workflow.EntityNotExistsError); !ok {
+			return nil, err
+		}
+
+		response, err := e.startWorkflowExecution(domainID, startRequest, nil, signalRequest)
+		if _, ok := err.(*workflow.WorkflowExecutionAlreadyStartedError); ok {
+			continue
+		}
+		return response, err
+	}
+
+	return nil, ErrMaxAttemptsExceeded
+}
+
+// signalCurrentExecution records the signal in the history of the current run of the workflow, and returns the ID of
+// the run.  It returns an EntityNotExistsError if the workflow is not running.
+func (e *historyEngineImpl) signalCurrentExecution(domainID string,
+	request *workflow.SignalWorkflowExecutionRequest) (string, error) {
+	var runID string
+	err := e.updateWorkflowExecution(domainID, *request.WorkflowExecution, false, true,
+		func(msBuilder *mutableStateBuilder) error {
+			runID = msBuilder.executionInfo.RunID
 
-			if msBuilder.AddWorkflowExecutionSignaled(request) == nil {
-				return &workflow.InternalServiceError{Message: "Unable to signal workflow execution."}
+			// The signal of a retry of the request which started the run is already recorded in its history
+			requestID := request.GetRequestId()
+			if requestID != "" && msBuilder.isWorkflowExecutionRunning() &&
+				msBuilder.executionInfo.CreateRequestID == requestID {
+				return errDuplicateRequest
 			}
 
-			return nil
+			return e.addSignal(msBuilder, request)
 		})
+	return runID, err
+}
+
+// addSignal records a signal in the history of a running execution, a retry of a signal request already applied is
+// deduped by its request ID
+func (e *historyEngineImpl) addSignal(msBuilder *mutableStateBuilder,
+	request *workflow.SignalWorkflowExecutionRequest) error {
+	if !msBuilder.isWorkflowExecutionRunning() {
+		return &workflow.EntityNotExistsError{Message: "Workflow execution already completed."}
+	}
+
+	requestID := request.GetRequestId()
+	if requestID != "" && msBuilder.isSignalRequested(requestID) {
+		return errDuplicateRequest
+	}
+
+	// The IDs of the signal requests recorded before the dedup window are deleted along with the update, so the IDs
+	// kept by a long running workflow signaled continuously stay bounded
+	now := time.Now()
+	if e.signalDedupWindow > 0 {
+		msBuilder.deleteSignalRequestedBefore(now.Add(-e.signalDedupWindow))
+	}
+
+	if msBuilder.AddWorkflowExecutionSignaled(request) == nil {
+		return &workflow.InternalServiceError{Message: "Unable to signal workflow execution."}
+	}
+	if requestID != "" {
+		msBuilder.addSignalRequested(requestID, now)
+	}
+
+	return nil
+}
+
+// checkHotWorkflow reports the signals and the heartbeats received by a workflow above the limits of the host, it
+// returns true if the signal must be throttled
+func (e *historyEngineImpl) checkHotWorkflow(requestType hotWorkflowRequestType, domainID string,
+	workflowID string) bool {
+	hot, first := e.hotWorkflows.record(requestType, domainID, workflowID)
+	if !hot {
+		return false
+	}
+
+	workflowIDHash := hashWorkflowID(workflowID)
+	if first {
+		e.logger.Warnf("Hot workflow detected. DomainID: %v, WorkflowID hash: %v, Signal: %v", domainID,
+			workflowIDHash, requestType == hotWorkflowSignal)
+	}
+
+	metricsClient := e.metricsClient.Tagged(map[string]string{metrics.WorkflowIDHashTagName: workflowIDHash})
+	if requestType == hotWorkflowHeartbeat {
+		metricsClient.IncCounter(metrics.HistoryRecordActivityTaskHeartbeatScope, metrics.HotWorkflowHeartbeatCounter)
+		return false
+	}
+
+	metricsClient.IncCounter(metrics.HistorySignalWorkflowExecutionScope, metrics.HotWorkflowSignalCounter)
+	if !e.hotWorkflows.throttleSignals() {
+		return false
+	}
+	metricsClient.IncCounter(metrics.HistorySignalWorkflowExecutionScope, metrics.HotWorkflowSignalThrottledCounter)
+	return true
 }
 
 func (e *historyEngineImpl) TerminateWorkflowExecution(terminateRequest *h.TerminateWorkflowExecutionRequest) error {
@@ -1268,6 +1749,10 @@ func (e *historyEngineImpl) TerminateWorkflowExecution(terminateRequest *h.Termi
 		RunId:      common.StringPtr(request.GetWorkflowExecution().GetRunId()),
 	}
 
+	if err := checkTerminationProtection(e.domainCache, domainID, request.GetAdminOverride()); err != nil {
+		return err
+	}
+
 	return e.updateWorkflowExecution(domainID, execution, true, false,
 		func(msBuilder *mutableStateBuilder) error {
 			if !msBuilder.isWorkflowExecutionRunning() {
@@ -1282,6 +1767,408 @@ func (e *historyEngineImpl) TerminateWorkflowExecution(terminateRequest *h.Termi
 		})
 }
 
+// checkTerminationProtection rejects the termination of a workflow of a termination protected domain, unless the
+// caller overrides the protection.  It is enforced here as well as in the frontend because the history service also
+// terminates workflows on its own, when resetting them or when a cancellation grace period expires.
+func checkTerminationProtection(domainCache cache.DomainCache, domainID string, adminOverride bool) error {
+	info, config, err := domainCache.GetDomainByID(domainID)
+	if err != nil {
+		return err
+	}
+	if config.TerminationProtected && !adminOverride {
+		return &workflow.BadRequestError{Message: fmt.Sprintf(
+			"Workflows of domain %v are protected from termination, an admin override is required.", info.Name)}
+	}
+	return nil
+}
+
+// ResetWorkflowExecution resets a workflow execution to the end of a decision task of one of its runs, the base run.
+// A new run is created with the events of the base run up to the started event of the decision task, which is then
+// failed and followed by a new decision task.  The signals received by the base run after that point are recorded
+// again in the new run.  The base run is terminated if it is still running, and the new run then replaces it as the
+// current run like a continue as new.
+func (e *historyEngineImpl) ResetWorkflowExecution(resetRequest *h.ResetWorkflowExecutionRequest) (
+	*workflow.ResetWorkflowExecutionResponse, error) {
+	domainID := resetRequest.GetDomainUUID()
+	request := resetRequest.GetResetRequest()
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(request.GetWorkflowExecution().GetWorkflowId()),
+		RunId:      common.StringPtr(request.GetWorkflowExecution().GetRunId()),
+	}
+
+	context, release, err0 := e.historyCache.getOrCreateWorkflowExecution(domainID, execution)
+	if err0 != nil {
+		return nil, err0
+	}
+	defer release()
+
+Reset_Loop:
+	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
+		baseBuilder, err1 := context.loadWorkflowExecution()
+		if err1 != nil {
+			return nil, err1
+		}
+
+		events, err2 := e.getHistoryEvents(domainID, context.workflowExecution, baseBuilder.GetNextEventID())
+		if err2 != nil {
+			return nil, err2
+		}
+
+		resetRunID := e.idGenerator.NewID()
+		resetBuilder, createRequest, err3 := e.buildResetRun(baseBuilder, events, resetRunID, request)
+		if err3 != nil {
+			return nil, err3
+		}
+
+		if baseBuilder.isWorkflowExecutionRunning() {
+			if err := checkTerminationProtection(e.domainCache, domainID, false); err != nil {
+				return nil, err
+			}
+
+			// Terminate the base run and create the new run in the same update, like a continue as new
+			if baseBuilder.AddWorkflowExecutionTerminatedEvent(&workflow.TerminateWorkflowExecutionRequest{
+				Reason:   common.StringPtr(request.GetReason()),
+				Identity: common.StringPtr(request.GetIdentity()),
+			}) == nil {
+				context.clear()
+				return nil, &workflow.InternalServiceError{Message: "Unable to terminate workflow execution."}
+			}
+			createRequest.ContinueAsNew = true
+			createRequest.CurrentExecutionRecorded = true
+			baseBuilder.continueAsNew = createRequest
+
+			transactionID, err4 := e.shard.GetNextTransferTaskID()
+			if err4 != nil {
+				context.clear()
+				return nil, err4
+			}
+
+			// The current execution is moved to the new run first, a concurrent reset of the same run fails here
+			baseRunID := baseBuilder.executionInfo.RunID
+			if err := e.updateCurrentExecution(domainID, execution.GetWorkflowId(), resetRunID, baseRunID,
+				createRequest.RequestID); err != nil {
+				context.clear()
+				if _, ok := err.(*persistence.CurrentWorkflowConditionFailedError); ok {
+					// The base run closed since it was loaded
+					continue Reset_Loop
+				}
+				return nil, err
+			}
+
+			if err := context.continueAsNewWorkflowExecution(baseBuilder.executionInfo.ExecutionContext,
+				resetBuilder, []persistence.Task{&persistence.DeleteExecutionTask{}}, transactionID); err != nil {
+				if _, ok := err.(*persistence.TimeoutError); !ok {
+					// The base run is still open, the current execution is moved back to it
+					if err := e.updateCurrentExecution(domainID, execution.GetWorkflowId(), baseRunID, resetRunID,
+						baseBuilder.executionInfo.CreateRequestID); err != nil {
+						logging.LogPersistantStoreErrorEvent(e.logger,
+							logging.TagValueStoreOperationUpdateCurrentExecution, err,
+							fmt.Sprintf("{WorkflowID: %v, RunID: %v}", execution.GetWorkflowId(), baseRunID))
+					}
+				}
+				if err == ErrConflict {
+					continue Reset_Loop
+				}
+				return nil, err
+			}
+		} else {
+			runID, err := e.createResetRun(resetBuilder, createRequest)
+			if err != nil {
+				return nil, err
+			}
+			if runID != resetRunID {
+				// The reset was already applied by a previous attempt of the request
+				return &workflow.ResetWorkflowExecutionResponse{RunId: common.StringPtr(runID)}, nil
+			}
+		}
+
+		e.recordResetRunStarted(resetBuilder)
+		return &workflow.ResetWorkflowExecutionResponse{RunId: common.StringPtr(resetRunID)}, nil
+	}
+
+	return nil, ErrMaxAttemptsExceeded
+}
+
+// buildResetRun builds the mutable state of the run created by a reset of the base run, and the request which
+// creates it
+func (e *historyEngineImpl) buildResetRun(baseBuilder *mutableStateBuilder, events []*workflow.HistoryEvent,
+	resetRunID string, request *workflow.ResetWorkflowExecutionRequest) (*mutableStateBuilder,
+	*persistence.CreateWorkflowExecutionRequest, error) {
+	finishEventID := request.GetDecisionFinishEventId()
+	if finishEventID < firstEventID || finishEventID > int64(len(events)) ||
+		events[finishEventID-1].GetEventId() != finishEventID {
+		return nil, nil, &workflow.BadRequestError{
+			Message: fmt.Sprintf("DecisionFinishEventId %v is not an event of the workflow execution.", finishEventID),
+		}
+	}
+
+	var startedEventID int64
+	finishEvent := events[finishEventID-1]
+	switch finishEvent.GetEventType() {
+	case workflow.EventType_DecisionTaskCompleted:
+		startedEventID = finishEvent.GetDecisionTaskCompletedEventAttributes().GetStartedEventId()
+	case workflow.EventType_DecisionTaskFailed:
+		startedEventID = finishEvent.GetDecisionTaskFailedEventAttributes().GetStartedEventId()
+	case workflow.EventType_DecisionTaskTimedOut:
+		startedEventID = finishEvent.GetDecisionTaskTimedOutEventAttributes().GetStartedEventId()
+	default:
+		return nil, nil, &workflow.BadRequestError{
+			Message: fmt.Sprintf("DecisionFinishEventId %v is not a completed, failed or timed out decision task.",
+				finishEventID),
+		}
+	}
+	if startedEventID < firstEventID || startedEventID >= finishEventID {
+		return nil, nil, &workflow.BadRequestError{
+			Message: fmt.Sprintf("DecisionFinishEventId %v finishes a decision task which was not started.",
+				finishEventID),
+		}
+	}
+
+	// Replay the history of the base run up to the started event of the decision task, which is left pending
+	resetter := newWorkflowResetter(baseBuilder.executionInfo, resetRunID, request.GetRequestId(), e.logger)
+	if err := resetter.replay(events[:startedEventID]); err != nil {
+		return nil, nil, err
+	}
+	resetBuilder := resetter.msBuilder
+	domainID := resetBuilder.executionInfo.DomainID
+
+	di, ok := resetBuilder.GetPendingDecision(resetBuilder.executionInfo.DecisionScheduleID)
+	if !ok || di.StartedID != startedEventID {
+		return nil, nil, &workflow.InternalServiceError{Message: "Unable to replay the decision task of the reset."}
+	}
+	if resetBuilder.AddDecisionTaskFailedEvent(di.ScheduleID, di.StartedID,
+		workflow.DecisionTaskFailedCause_RESET_WORKFLOW, &workflow.RespondDecisionTaskCompletedRequest{
+			Identity: common.StringPtr(request.GetIdentity()),
+		}) == nil {
+		return nil, nil, &workflow.InternalServiceError{Message: "Unable to fail the decision task of the reset."}
+	}
+
+	// The workers of the activities started by the base run can no longer complete them in the new run
+	for _, ai := range resetter.activityInfos() {
+		if ai.StartedID != emptyEventID && resetBuilder.AddActivityTaskFailedEvent(ai.ScheduleID, ai.StartedID,
+			&workflow.RespondActivityTaskFailedRequest{
+				Reason:   common.StringPtr(activityFailureReasonReset),
+				Details:  []byte(request.GetReason()),
+				Identity: common.StringPtr(request.GetIdentity()),
+			}) == nil {
+			return nil, nil, &workflow.InternalServiceError{Message: "Unable to fail the activity task of the reset."}
+		}
+	}
+
+	for _, event := range events[startedEventID:] {
+		if event.GetEventType() != workflow.EventType_WorkflowExecutionSignaled {
+			continue
+		}
+		attributes := event.GetWorkflowExecutionSignaledEventAttributes()
+		if resetBuilder.AddWorkflowExecutionSignaled(&workflow.SignalWorkflowExecutionRequest{
+			SignalName: attributes.SignalName,
+			Input:      attributes.Input,
+			Identity:   attributes.Identity,
+		}) == nil {
+			return nil, nil, &workflow.InternalServiceError{Message: "Failed to add workflow execution signaled event."}
+		}
+	}
+
+	_, newDecision := resetBuilder.AddDecisionTaskScheduledEvent()
+	if newDecision == nil {
+		return nil, nil, &workflow.InternalServiceError{Message: "Failed to add decision scheduled event."}
+	}
+	transferTasks := []persistence.Task{&persistence.DecisionTask{
+		DomainID: domainID, TaskList: resetBuilder.executionInfo.TaskList, ScheduleID: newDecision.ScheduleID,
+	}}
+
+	// Dispatch again the activities which were scheduled but not started by the base run
+	var timerTasks []persistence.Task
+	tBuilder := newTimerBuilder(e.logger, e.shard.GetTimeSource())
+	for _, ai := range resetter.activityInfos() {
+		scheduledEvent, ok := resetBuilder.getHistoryEvent(ai.ScheduledEvent)
+		if !ok {
+			return nil, nil, &workflow.InternalServiceError{Message: "Unable to read activity scheduled event."}
+		}
+		attributes := scheduledEvent.GetActivityTaskScheduledEventAttributes()
+		targetDomainID := domainID
+		if attributes.IsSetDomain() {
+			info, _, err := e.domainCache.GetDomain(attributes.GetDomain())
+			if err != nil {
+				return nil, nil, &workflow.InternalServiceError{Message: "Unable to schedule activity across domain."}
+			}
+			targetDomainID = info.ID
+		}
+		transferTasks = append(transferTasks, &persistence.ActivityTask{
+			DomainID:   targetDomainID,
+			TaskList:   attributes.GetTaskList().GetName(),
+			ScheduleID: ai.ScheduleID,
+		})
+
+		timerTasks = append(timerTasks, tBuilder.AddScheduleToStartActivityTimeout(ai))
+		scheduleToCloseTask, err := tBuilder.AddScheduleToCloseActivityTimeout(ai)
+		if err != nil {
+			return nil, nil, err
+		}
+		timerTasks = append(timerTasks, scheduleToCloseTask)
+	}
+
+	timerInfos := resetter.timerInfos()
+	if len(timerInfos) > 0 {
+		if userTimerTask := tBuilder.AddUserTimer(timerInfos[0], resetBuilder); userTimerTask != nil {
+			timerTasks = append(timerTasks, userTimerTask)
+		}
+	}
+
+	info := resetBuilder.executionInfo
+	var parentExecution *workflow.WorkflowExecution
+	if resetBuilder.hasParentExecution() {
+		parentExecution = &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(info.ParentWorkflowID),
+			RunId:      common.StringPtr(info.ParentRunID),
+		}
+	}
+
+	return resetBuilder, &persistence.CreateWorkflowExecutionRequest{
+		RequestID: request.GetRequestId(),
+		DomainID:  domainID,
+		Execution: workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(info.WorkflowID),
+			RunId:      common.StringPtr(info.RunID),
+		},
+		ParentDomainID:              info.ParentDomainID,
+		ParentExecution:             parentExecution,
+		InitiatedID:                 info.InitiatedID,
+		TaskList:                    info.TaskList,
+		WorkflowTypeName:            info.WorkflowTypeName,
+		DecisionTimeoutValue:        info.DecisionTimeoutValue,
+		ExecutionContext:            info.ExecutionContext,
+		NextEventID:                 resetBuilder.GetNextEventID(),
+		LastProcessedEvent:          info.LastProcessedEvent,
+		TransferTasks:               transferTasks,
+		TimerTasks:                  timerTasks,
+		DecisionScheduleID:          newDecision.ScheduleID,
+		DecisionStartedID:           newDecision.StartedID,
+		DecisionStartToCloseTimeout: newDecision.DecisionTimeout,
+		CompletionCallbackURL:       info.CompletionCallbackURL,
+		FirstExecutionRunID:         info.FirstExecutionRunID,
+		TraceID:                     info.TraceID,
+		SearchAttributes:            info.SearchAttributes,
+		ActivityInfos:               resetter.activityInfos(),
+		TimerInfos:                  resetter.timerInfos(),
+	}, nil
+}
+
+// createResetRun creates the run of a reset of a closed run.  It returns the ID of the run created by the request,
+// which differs from the new run when a previous attempt of the request already created its run.
+func (e *historyEngineImpl) createResetRun(resetBuilder *mutableStateBuilder,
+	createRequest *persistence.CreateWorkflowExecutionRequest) (string, error) {
+	serializedHistory, err := resetBuilder.hBuilder.Serialize(e.shard.GetHistorySerializer())
+	if err != nil {
+		logging.LogHistorySerializationErrorEvent(e.logger, err, fmt.Sprintf(
+			"HistoryEventBatch serialization error on reset workflow.  WorkflowID: %v, RunID: %v",
+			createRequest.Execution.GetWorkflowId(), createRequest.Execution.GetRunId()))
+		return "", err
+	}
+
+	if err := e.shard.AppendHistoryEvents(&persistence.AppendHistoryEventsRequest{
+		DomainID:  createRequest.DomainID,
+		Execution: createRequest.Execution,
+		// It is ok to use 0 for TransactionID because RunID is unique so there are
+		// no potential duplicates to override.
+		TransactionID: 0,
+		FirstEventID:  firstEventID,
+		Events:        serializedHistory,
+	}); err != nil {
+		return "", err
+	}
+
+	if err := e.createWorkflowExecution(createRequest); err != nil {
+		switch t := err.(type) {
+		case *workflow.WorkflowExecutionAlreadyStartedError:
+			// The history of the new run is not visible beyond this call, it is always safe to clean it up
+			// TODO: Handle error on deletion of execution history
+			e.historyMgr.DeleteWorkflowExecutionHistory(&persistence.DeleteWorkflowExecutionHistoryRequest{
+				DomainID:  createRequest.DomainID,
+				Execution: createRequest.Execution,
+			})
+
+			if t.GetStartRequestId() == createRequest.RequestID {
+				return t.GetRunId(), nil
+			}
+		case *persistence.ShardOwnershipLostError:
+			// TODO: Handle error on deletion of execution history
+			e.historyMgr.DeleteWorkflowExecutionHistory(&persistence.DeleteWorkflowExecutionHistoryRequest{
+				DomainID:  createRequest.DomainID,
+				Execution: createRequest.Execution,
+			})
+		}
+
+		logging.LogPersistantStoreErrorEvent(e.logger, logging.TagValueStoreOperationCreateWorkflowExecution, err,
+			fmt.Sprintf("{WorkflowID: %v, RunID: %v}", createRequest.Execution.GetWorkflowId(),
+				createRequest.Execution.GetRunId()))
+		return "", err
+	}
+
+	return createRequest.Execution.GetRunId(), nil
+}
+
+// recordResetRunStarted records the run created by a reset as open.  Runs are otherwise recorded as open by the
+// transfer task of their first decision task, which the run of a reset does not have.
+func (e *historyEngineImpl) recordResetRunStarted(resetBuilder *mutableStateBuilder) {
+	info := resetBuilder.executionInfo
+	if err := e.visibilityMgr.RecordWorkflowExecutionStarted(&persistence.RecordWorkflowExecutionStartedRequest{
+		DomainUUID: info.DomainID,
+		Execution: workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(info.WorkflowID),
+			RunId:      common.StringPtr(info.RunID),
+		},
+		WorkflowTypeName: info.WorkflowTypeName,
+		StartTimestamp:   time.Now().UnixNano(),
+		FirstRunID:       resetBuilder.getFirstExecutionRunID(),
+		TraceID:          resetBuilder.getTraceID(),
+		SearchAttributes: info.SearchAttributes,
+	}); err != nil {
+		e.logger.WithFields(bark.Fields{
+			logging.TagWorkflowExecutionID: info.WorkflowID,
+			logging.TagWorkflowRunID:       info.RunID,
+		}).Warnf("Failed to record the run of a reset as open: %v", err)
+	}
+}
+
+// getHistoryEvents reads the events of the history of a run, up to the next event ID
+func (e *historyEngineImpl) getHistoryEvents(domainID string, execution workflow.WorkflowExecution,
+	nextEventID int64) ([]*workflow.HistoryEvent, error) {
+	var events []*workflow.HistoryEvent
+	var nextPageToken []byte
+	for {
+		response, err := e.historyMgr.GetWorkflowExecutionHistory(&persistence.GetWorkflowExecutionHistoryRequest{
+			DomainID:      domainID,
+			Execution:     execution,
+			NextEventID:   nextEventID,
+			PageSize:      resetHistoryPageSize,
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, batch := range response.Events {
+			setSerializedHistoryDefaults(&batch)
+			serializer, err := e.hSerializerFactory.Get(batch.EncodingType)
+			if err != nil {
+				return nil, err
+			}
+			history, err := serializer.Deserialize(&batch)
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, history.Events...)
+		}
+
+		if len(response.NextPageToken) == 0 ||
+			(len(events) > 0 && events[len(events)-1].GetEventId() >= nextEventID-1) {
+			return events, nil
+		}
+		nextPageToken = response.NextPageToken
+	}
+}
+
 // ScheduleDecisionTask schedules a decision if no outstanding decision found
 func (e *historyEngineImpl) ScheduleDecisionTask(scheduleRequest *h.ScheduleDecisionTaskRequest) error {
 	domainID := scheduleRequest.GetDomainUUID()
@@ -1345,10 +2232,41 @@ func (e *historyEngineImpl) RecordChildExecutionCompleted(completionRequest *h.R
 		})
 }
 
+// InvalidateMutableState drops the cached mutable state of the execution, so that it is reloaded from persistence by
+// the next request.  Activity heartbeat progress which has not been flushed yet is lost.
+func (e *historyEngineImpl) InvalidateMutableState(request *h.InvalidateMutableStateRequest) error {
+	domainID := request.GetDomainUUID()
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(request.GetExecution().GetWorkflowId()),
+		RunId:      common.StringPtr(request.GetExecution().GetRunId()),
+	}
+
+	context, release, err0 := e.historyCache.getOrCreateWorkflowExecution(domainID, execution)
+	if err0 != nil {
+		return err0
+	}
+	defer release()
+
+	context.clear()
+	return nil
+}
+
 func (e *historyEngineImpl) updateWorkflowExecution(domainID string, execution workflow.WorkflowExecution,
 	createDeletionTask, createDecisionTask bool,
 	action func(builder *mutableStateBuilder) error) error {
 
+	return e.updateWorkflowExecutionWithTimers(domainID, execution, createDeletionTask, createDecisionTask,
+		func(msBuilder *mutableStateBuilder, tBuilder *timerBuilder) ([]persistence.Task, error) {
+			return nil, action(msBuilder)
+		})
+}
+
+// updateWorkflowExecutionWithTimers is similar to updateWorkflowExecution, but also persists the timer tasks returned
+// by the action along with the update.
+func (e *historyEngineImpl) updateWorkflowExecutionWithTimers(domainID string, execution workflow.WorkflowExecution,
+	createDeletionTask, createDecisionTask bool,
+	action func(builder *mutableStateBuilder, tBuilder *timerBuilder) ([]persistence.Task, error)) error {
+
 	context, release, err0 := e.historyCache.getOrCreateWorkflowExecution(domainID, execution)
 	if err0 != nil {
 		return err0
@@ -1363,7 +2281,11 @@ Update_History_Loop:
 		}
 
 		var transferTasks []persistence.Task
-		if err := action(msBuilder); err != nil {
+		timerTasks, err := action(msBuilder, context.tBuilder)
+		if err != nil {
+			if err == errDuplicateRequest {
+				return nil
+			}
 			return err
 		}
 
@@ -1392,12 +2314,16 @@ Update_History_Loop:
 
 		// We apply the update to execution using optimistic concurrency.  If it fails due to a conflict then reload
 		// the history and try the operation again.
-		if err := context.updateWorkflowExecution(transferTasks, nil, transactionID); err != nil {
+		if err := context.updateWorkflowExecution(transferTasks, timerTasks, transactionID); err != nil {
 			if err == ErrConflict {
 				continue Update_History_Loop
 			}
 			return err
 		}
+
+		if len(timerTasks) > 0 {
+			e.timerProcessor.NotifyNewTimer(timerTasks)
+		}
 		return nil
 	}
 	return ErrMaxAttemptsExceeded
@@ -1445,14 +2371,19 @@ func (e *historyEngineImpl) failDecision(context *workflowExecutionContext, sche
 	return msBuilder, nil
 }
 
-func (s *shardContextWrapper) UpdateWorkflowExecution(request *persistence.UpdateWorkflowExecutionRequest) error {
-	err := s.ShardContext.UpdateWorkflowExecution(request)
+func (s *shardContextWrapper) UpdateWorkflowExecution(request *persistence.UpdateWorkflowExecutionRequest) (
+	*persistence.UpdateWorkflowExecutionResponse, error) {
+	response, err := s.ShardContext.UpdateWorkflowExecution(request)
 	if err == nil {
 		if len(request.TransferTasks) > 0 {
 			s.txProcessor.NotifyNewTask()
 		}
+		s.timerProcessor.NotifyNewTimer(request.TimerTasks)
+		if request.ContinueAsNew != nil {
+			s.timerProcessor.NotifyNewTimer(request.ContinueAsNew.TimerTasks)
+		}
 	}
-	return err
+	return response, err
 }
 
 func (s *shardContextWrapper) CreateWorkflowExecution(request *persistence.CreateWorkflowExecutionRequest) (
@@ -1462,10 +2393,18 @@ func (s *shardContextWrapper) CreateWorkflowExecution(request *persistence.Creat
 		if len(request.TransferTasks) > 0 {
 			s.txProcessor.NotifyNewTask()
 		}
+		s.timerProcessor.NotifyNewTimer(request.TimerTasks)
 	}
 	return resp, err
 }
 
+// reportTimeoutCapped counts and logs the timeouts of a workflow which were clamped to the caps of its domain
+func (e *historyEngineImpl) reportTimeoutCapped(scope int, domainID, workflowID, task string) {
+	e.metricsClient.IncCounter(scope, metrics.TimeoutCappedCounter)
+	e.logger.Warnf("Timeouts of %v capped to the limits of the domain.  DomainID: %v, WorkflowID: %v", task,
+		domainID, workflowID)
+}
+
 func validateActivityScheduleAttributes(attributes *workflow.ScheduleActivityTaskDecisionAttributes) error {
 	if attributes == nil {
 		return &workflow.BadRequestError{Message: "ScheduleActivityTaskDecisionAttributes is not set on decision."}
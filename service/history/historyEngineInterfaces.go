@@ -38,16 +38,19 @@ type (
 			request *h.GetWorkflowExecutionNextEventIDRequest) (*h.GetWorkflowExecutionNextEventIDResponse, error)
 		RecordDecisionTaskStarted(request *h.RecordDecisionTaskStartedRequest) (*h.RecordDecisionTaskStartedResponse, error)
 		RecordActivityTaskStarted(request *h.RecordActivityTaskStartedRequest) (*h.RecordActivityTaskStartedResponse, error)
-		RespondDecisionTaskCompleted(request *h.RespondDecisionTaskCompletedRequest) error
+		RespondDecisionTaskCompleted(request *h.RespondDecisionTaskCompletedRequest) (*workflow.RespondDecisionTaskCompletedResponse, error)
 		RespondActivityTaskCompleted(request *h.RespondActivityTaskCompletedRequest) error
 		RespondActivityTaskFailed(request *h.RespondActivityTaskFailedRequest) error
 		RespondActivityTaskCanceled(request *h.RespondActivityTaskCanceledRequest) error
 		RecordActivityTaskHeartbeat(request *h.RecordActivityTaskHeartbeatRequest) (*workflow.RecordActivityTaskHeartbeatResponse, error)
 		RequestCancelWorkflowExecution(request *h.RequestCancelWorkflowExecutionRequest) error
 		SignalWorkflowExecution(request *h.SignalWorkflowExecutionRequest) error
+		SignalWithStartWorkflowExecution(request *h.SignalWithStartWorkflowExecutionRequest) (*workflow.StartWorkflowExecutionResponse, error)
 		TerminateWorkflowExecution(request *h.TerminateWorkflowExecutionRequest) error
+		ResetWorkflowExecution(request *h.ResetWorkflowExecutionRequest) (*workflow.ResetWorkflowExecutionResponse, error)
 		ScheduleDecisionTask(request *h.ScheduleDecisionTaskRequest) error
 		RecordChildExecutionCompleted(request *h.RecordChildExecutionCompletedRequest) error
+		InvalidateMutableState(request *h.InvalidateMutableStateRequest) error
 	}
 
 	// EngineFactory is used to create an instance of sharded history engine
@@ -65,6 +68,11 @@ type (
 		NotifyNewTask()
 	}
 
+	// transferTaskHandler processes transfer tasks of a single type on behalf of transferQueueProcessor
+	transferTaskHandler interface {
+		process(task *persistence.TransferTaskInfo) error
+	}
+
 	timerQueueProcessor interface {
 		common.Daemon
 		NotifyNewTimer(timerTask []persistence.Task)
@@ -25,6 +25,7 @@ import (
 	"errors"
 	"os"
 	"testing"
+	"time"
 
 	log "github.com/Sirupsen/logrus"
 	"github.com/pborman/uuid"
@@ -38,6 +39,7 @@ import (
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/idgen"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
@@ -106,15 +108,18 @@ func (s *engineSuite) SetupTest() {
 		closeCh:                   s.shardClosedCh,
 		logger:                    s.logger,
 		metricsClient:             metrics.NewClient(tally.NoopScope, metrics.History),
+		historySerializer:         persistence.NewJSONHistorySerializer(),
+		timeSource:                common.NewRealTimeSource(),
 	}
 
 	historyCache := newHistoryCache(historyCacheMaxSize, mockShard, s.logger)
-	domainCache := cache.NewDomainCache(s.mockMetadataMgr, s.logger)
-	txProcessor := newTransferQueueProcessor(mockShard, s.mockVisibilityMgr, s.mockMatchingClient, s.mockHistoryClient, historyCache, domainCache)
+	domainCache := cache.NewDomainCache(s.mockMetadataMgr, s.logger, mockShard.GetMetricsClient())
+	txProcessor := newTransferQueueProcessor(mockShard, s.mockVisibilityMgr, s.mockMatchingClient, s.mockHistoryClient, historyCache, domainCache, 0, nil, nil, nil)
 	h := &historyEngineImpl{
 		shard:              mockShard,
 		executionManager:   s.mockExecutionMgr,
 		historyMgr:         s.mockHistoryMgr,
+		visibilityMgr:      s.mockVisibilityMgr,
 		txProcessor:        txProcessor,
 		historyCache:       historyCache,
 		domainCache:        domainCache,
@@ -122,6 +127,7 @@ func (s *engineSuite) SetupTest() {
 		metricsClient:      metrics.NewClient(tally.NoopScope, metrics.History),
 		tokenSerializer:    common.NewJSONTaskTokenSerializer(),
 		hSerializerFactory: persistence.NewHistorySerializerFactory(),
+		idGenerator:        idgen.NewSequentialGenerator(),
 	}
 	h.timerProcessor = newTimerQueueProcessor(mockShard, h, s.mockExecutionMgr, s.logger)
 	s.mockHistoryEngine = h
@@ -140,7 +146,7 @@ func (s *engineSuite) TestRespondDecisionTaskCompletedInvalidToken() {
 	invalidToken, _ := json.Marshal("bad token")
 	identity := "testIdentity"
 
-	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
+	_, err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
 		DomainUUID: common.StringPtr(domainID),
 		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
 			TaskToken:        invalidToken,
@@ -165,7 +171,7 @@ func (s *engineSuite) TestRespondDecisionTaskCompletedIfNoExecution() {
 
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(nil, &workflow.EntityNotExistsError{}).Once()
 
-	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
+	_, err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
 		DomainUUID: common.StringPtr(domainID),
 		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
 			TaskToken: taskToken,
@@ -187,7 +193,7 @@ func (s *engineSuite) TestRespondDecisionTaskCompletedIfGetExecutionFailed() {
 
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(nil, errors.New("FAILED")).Once()
 
-	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
+	_, err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
 		DomainUUID: common.StringPtr(domainID),
 		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
 			TaskToken: taskToken,
@@ -222,10 +228,10 @@ func (s *engineSuite) TestRespondDecisionTaskCompletedUpdateExecutionFailed() {
 
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
 	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(errors.New("FAILED")).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil, errors.New("FAILED")).Once()
 	s.mockShardManager.On("UpdateShard", mock.Anything).Return(nil).Once()
 
-	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
+	_, err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
 		DomainUUID: common.StringPtr(domainID),
 		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
 			TaskToken: taskToken,
@@ -261,7 +267,7 @@ func (s *engineSuite) TestRespondDecisionTaskCompletedIfTaskCompleted() {
 
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
 
-	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
+	_, err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
 		DomainUUID: common.StringPtr(domainID),
 		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
 			TaskToken: taskToken,
@@ -295,7 +301,7 @@ func (s *engineSuite) TestRespondDecisionTaskCompletedIfTaskNotStarted() {
 
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
 
-	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
+	_, err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
 		DomainUUID: common.StringPtr(domainID),
 		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
 			TaskToken: taskToken,
@@ -374,14 +380,14 @@ func (s *engineSuite) TestRespondDecisionTaskCompletedConflictOnUpdate() {
 
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
 	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil,
 		&persistence.ConditionFailedError{}).Once()
 
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse2, nil).Once()
 	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil).Once()
 
-	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
+	_, err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
 		DomainUUID: common.StringPtr(domainID),
 		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
 			TaskToken:        taskToken,
@@ -453,11 +459,11 @@ func (s *engineSuite) TestRespondDecisionTaskCompletedMaxAttemptsExceeded() {
 
 		s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
 		s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-		s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(
+		s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil,
 			&persistence.ConditionFailedError{}).Once()
 	}
 
-	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
+	_, err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
 		DomainUUID: common.StringPtr(domainID),
 		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
 			TaskToken:        taskToken,
@@ -528,9 +534,9 @@ func (s *engineSuite) TestRespondDecisionTaskCompletedCompleteWorkflowFailed() {
 	}
 
 	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil).Once()
 
-	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
+	_, err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
 		DomainUUID: common.StringPtr(domainID),
 		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
 			TaskToken:        taskToken,
@@ -608,9 +614,9 @@ func (s *engineSuite) TestRespondDecisionTaskCompletedFailWorkflowFailed() {
 	}
 
 	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil).Once()
 
-	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
+	_, err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
 		DomainUUID: common.StringPtr(domainID),
 		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
 			TaskToken:        taskToken,
@@ -674,9 +680,9 @@ func (s *engineSuite) TestRespondDecisionTaskCompletedBadDecisionAttributes() {
 	}
 
 	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil).Once()
 
-	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
+	_, err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
 		DomainUUID: common.StringPtr(domainID),
 		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
 			TaskToken:        taskToken,
@@ -729,9 +735,9 @@ func (s *engineSuite) TestRespondDecisionTaskCompletedSingleActivityScheduledDec
 
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
 	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil).Once()
 
-	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
+	_, err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
 		DomainUUID: common.StringPtr(domainID),
 		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
 			TaskToken:        taskToken,
@@ -760,6 +766,83 @@ func (s *engineSuite) TestRespondDecisionTaskCompletedSingleActivityScheduledDec
 	s.Equal(int32(5), activity1Attributes.GetHeartbeatTimeoutSeconds())
 }
 
+func (s *engineSuite) TestRespondDecisionTaskCompletedEagerActivity() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: "wId",
+		RunID:      "rId",
+		ScheduleID: 2,
+	})
+	identity := "testIdentity"
+	input := []byte("input")
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	scheduleEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, scheduleEvent.GetEventId(), tl, identity)
+
+	decisions := []*workflow.Decision{}
+	for _, activityID := range []string{"activity1", "activity2"} {
+		decisions = append(decisions, &workflow.Decision{
+			DecisionType: workflow.DecisionTypePtr(workflow.DecisionType_ScheduleActivityTask),
+			ScheduleActivityTaskDecisionAttributes: &workflow.ScheduleActivityTaskDecisionAttributes{
+				ActivityId:   common.StringPtr(activityID),
+				ActivityType: &workflow.ActivityType{Name: common.StringPtr("activity_type1")},
+				TaskList:     &workflow.TaskList{Name: &tl},
+				Input:        input,
+				ScheduleToCloseTimeoutSeconds: common.Int32Ptr(100),
+				ScheduleToStartTimeoutSeconds: common.Int32Ptr(10),
+				StartToCloseTimeoutSeconds:    common.Int32Ptr(50),
+				HeartbeatTimeoutSeconds:       common.Int32Ptr(5),
+			},
+		})
+	}
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil).Once()
+
+	response, err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
+			TaskToken:             taskToken,
+			Decisions:             decisions,
+			Identity:              &identity,
+			EagerActivityTaskList: &workflow.TaskList{Name: &tl},
+		},
+	})
+	s.Nil(err, s.printHistory(msBuilder))
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(int64(8), executionBuilder.executionInfo.NextEventID)
+
+	// Only the first activity is started for the worker, the second one goes through matching
+	activity1, ok := executionBuilder.GetActivityInfo(5)
+	s.True(ok)
+	s.Equal(int64(7), activity1.StartedID)
+	activity2, ok := executionBuilder.GetActivityInfo(6)
+	s.True(ok)
+	s.Equal(emptyEventID, activity2.StartedID)
+
+	task := response.GetActivityTask()
+	s.NotNil(task)
+	s.Equal("activity1", task.GetActivityId())
+	s.Equal(input, task.GetInput())
+	s.Equal(int64(7), task.GetStartedEventId())
+	s.Equal(int32(50), task.GetStartToCloseTimeoutSeconds())
+	token, err := common.NewJSONTaskTokenSerializer().Deserialize(task.GetTaskToken())
+	s.Nil(err)
+	s.Equal(domainID, token.DomainID)
+	s.Equal(int64(5), token.ScheduleID)
+}
+
 func (s *engineSuite) TestRespondDecisionTaskCompletedCompleteWorkflowSuccess() {
 	domainID := "domainId"
 	we := workflow.WorkflowExecution{
@@ -793,9 +876,9 @@ func (s *engineSuite) TestRespondDecisionTaskCompletedCompleteWorkflowSuccess()
 
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
 	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil).Once()
 
-	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
+	_, err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
 		DomainUUID: common.StringPtr(domainID),
 		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
 			TaskToken:        taskToken,
@@ -848,9 +931,9 @@ func (s *engineSuite) TestRespondDecisionTaskCompletedFailWorkflowSuccess() {
 
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
 	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil).Once()
 
-	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
+	_, err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
 		DomainUUID: common.StringPtr(domainID),
 		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
 			TaskToken:        taskToken,
@@ -962,7 +1045,7 @@ func (s *engineSuite) TestRespondActivityTaskCompletedUpdateExecutionFailed() {
 
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
 	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(errors.New("FAILED")).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil, errors.New("FAILED")).Once()
 	s.mockShardManager.On("UpdateShard", mock.Anything).Return(nil).Once()
 
 	err := s.mockHistoryEngine.RespondActivityTaskCompleted(&history.RespondActivityTaskCompletedRequest{
@@ -1110,11 +1193,11 @@ func (s *engineSuite) TestRespondActivityTaskCompletedConflictOnUpdate() {
 
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse1, nil).Once()
 	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.ConditionFailedError{}).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil, &persistence.ConditionFailedError{}).Once()
 
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse2, nil).Once()
 	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil).Once()
 
 	err := s.mockHistoryEngine.RespondActivityTaskCompleted(&history.RespondActivityTaskCompletedRequest{
 		DomainUUID: common.StringPtr(domainID),
@@ -1172,7 +1255,7 @@ func (s *engineSuite) TestRespondActivityTaskCompletedMaxAttemptsExceeded() {
 
 		s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
 		s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-		s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.ConditionFailedError{}).Once()
+		s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil, &persistence.ConditionFailedError{}).Once()
 	}
 
 	err := s.mockHistoryEngine.RespondActivityTaskCompleted(&history.RespondActivityTaskCompletedRequest{
@@ -1219,7 +1302,7 @@ func (s *engineSuite) TestRespondActivityTaskCompletedSuccess() {
 
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
 	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil).Once()
 
 	err := s.mockHistoryEngine.RespondActivityTaskCompleted(&history.RespondActivityTaskCompletedRequest{
 		DomainUUID: common.StringPtr(domainID),
@@ -1337,7 +1420,7 @@ func (s *engineSuite) TestRespondActivityTaskFailedUpdateExecutionFailed() {
 
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
 	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(errors.New("FAILED")).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil, errors.New("FAILED")).Once()
 	s.mockShardManager.On("UpdateShard", mock.Anything).Return(nil).Once()
 
 	err := s.mockHistoryEngine.RespondActivityTaskFailed(&history.RespondActivityTaskFailedRequest{
@@ -1490,11 +1573,11 @@ func (s *engineSuite) TestRespondActivityTaskFailedConflictOnUpdate() {
 
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse1, nil).Once()
 	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.ConditionFailedError{}).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil, &persistence.ConditionFailedError{}).Once()
 
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse2, nil).Once()
 	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil).Once()
 
 	err := s.mockHistoryEngine.RespondActivityTaskFailed(&history.RespondActivityTaskFailedRequest{
 		DomainUUID: common.StringPtr(domainID),
@@ -1552,7 +1635,7 @@ func (s *engineSuite) TestRespondActivityTaskFailedMaxAttemptsExceeded() {
 
 		s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
 		s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-		s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.ConditionFailedError{}).Once()
+		s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil, &persistence.ConditionFailedError{}).Once()
 	}
 
 	err := s.mockHistoryEngine.RespondActivityTaskFailed(&history.RespondActivityTaskFailedRequest{
@@ -1599,7 +1682,7 @@ func (s *engineSuite) TestRespondActivityTaskFailedSuccess() {
 
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
 	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil).Once()
 
 	err := s.mockHistoryEngine.RespondActivityTaskFailed(&history.RespondActivityTaskFailedRequest{
 		DomainUUID: common.StringPtr(domainID),
@@ -1655,7 +1738,7 @@ func (s *engineSuite) TestRecordActivityTaskHeartBeatSuccess_NoTimer() {
 	ms := createMutableState(msBuilder)
 	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil).Once()
 
 	detais := []byte("details")
 
@@ -1670,6 +1753,49 @@ func (s *engineSuite) TestRecordActivityTaskHeartBeatSuccess_NoTimer() {
 	s.Nil(err)
 }
 
+func (s *engineSuite) TestRecordActivityTaskHeartBeatFailed_SizeLimitExceeded() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.GetWorkflowId(),
+		RunID:      we.GetRunId(),
+		ScheduleID: 5,
+	})
+	identity := "testIdentity"
+	activityID := "activity1_id"
+	activityType := "activity_type1"
+	activityInput := []byte("input1")
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.GetEventId(),
+		decisionStartedEvent.GetEventId(), nil, identity)
+	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(), activityID,
+		activityType, tl, activityInput, 100, 10, 0)
+	addActivityTaskStartedEvent(msBuilder, activityScheduledEvent.GetEventId(), tl, identity)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+
+	// the update is rejected without being written
+	_, err := s.mockHistoryEngine.RecordActivityTaskHeartbeat(&history.RecordActivityTaskHeartbeatRequest{
+		DomainUUID: common.StringPtr(domainID),
+		HeartbeatRequest: &workflow.RecordActivityTaskHeartbeatRequest{
+			TaskToken: taskToken,
+			Identity:  &identity,
+			Details:   make([]byte, common.ExecutionUpdateSizeLimit+1),
+		},
+	})
+	s.IsType(&workflow.BadRequestError{}, err)
+}
+
 func (s *engineSuite) TestRecordActivityTaskHeartBeatSuccess_TimerRunning() {
 	domainID := "domainId"
 	we := workflow.WorkflowExecution{
@@ -1702,7 +1828,7 @@ func (s *engineSuite) TestRecordActivityTaskHeartBeatSuccess_TimerRunning() {
 
 	// HeartBeat timer running.
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil).Once()
 
 	detais := []byte("details")
 
@@ -1722,6 +1848,59 @@ func (s *engineSuite) TestRecordActivityTaskHeartBeatSuccess_TimerRunning() {
 	s.False(executionBuilder.HasPendingDecisionTask())
 }
 
+func (s *engineSuite) TestRecordActivityTaskHeartBeatSuccess_FlushInterval() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.GetWorkflowId(),
+		RunID:      we.GetRunId(),
+		ScheduleID: 5,
+	})
+	identity := "testIdentity"
+	activityID := "activity1_id"
+	activityType := "activity_type1"
+	activityInput := []byte("input1")
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.GetEventId(),
+		decisionStartedEvent.GetEventId(), nil, identity)
+	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(), activityID,
+		activityType, tl, activityInput, 100, 10, 10)
+	addActivityTaskStartedEvent(msBuilder, activityScheduledEvent.GetEventId(), tl, identity)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	// Only the first heartbeat is persisted, the second one is within the flush interval.
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil).Once()
+
+	for _, details := range []string{"details1", "details2"} {
+		_, err := s.mockHistoryEngine.RecordActivityTaskHeartbeat(&history.RecordActivityTaskHeartbeatRequest{
+			DomainUUID: common.StringPtr(domainID),
+			HeartbeatRequest: &workflow.RecordActivityTaskHeartbeatRequest{
+				TaskToken: taskToken,
+				Identity:  &identity,
+				Details:   []byte(details),
+			},
+		})
+		s.Nil(err)
+	}
+
+	executionBuilder := s.getBuilder(domainID, we)
+	ai, isRunning := executionBuilder.GetActivityInfo(activityScheduledEvent.GetEventId())
+	s.True(isRunning)
+	s.Equal([]byte("details2"), ai.Details)
+	s.Equal(1, len(executionBuilder.updateActivityInfos))
+}
+
 func (s *engineSuite) TestRespondActivityTaskCanceled_Scheduled() {
 	domainID := "domainId"
 	we := workflow.WorkflowExecution{
@@ -1798,7 +1977,7 @@ func (s *engineSuite) TestRespondActivityTaskCanceled_Started() {
 
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
 	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil).Once()
 
 	err := s.mockHistoryEngine.RespondActivityTaskCanceled(&history.RespondActivityTaskCanceledRequest{
 		DomainUUID: common.StringPtr(domainID),
@@ -1854,9 +2033,9 @@ func (s *engineSuite) TestRequestCancel_RespondDecisionTaskCompleted_NotSchedule
 
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
 	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil).Once()
 
-	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
+	_, err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
 		DomainUUID: common.StringPtr(domainID),
 		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
 			TaskToken:        taskToken,
@@ -1913,9 +2092,9 @@ func (s *engineSuite) TestRequestCancel_RespondDecisionTaskCompleted_Scheduled()
 
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
 	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil).Once()
 
-	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
+	_, err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
 		DomainUUID: common.StringPtr(domainID),
 		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
 			TaskToken:        taskToken,
@@ -1974,9 +2153,9 @@ func (s *engineSuite) TestRequestCancel_RespondDecisionTaskCompleted_NoHeartBeat
 
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
 	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil).Once()
 
-	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
+	_, err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
 		DomainUUID: common.StringPtr(domainID),
 		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
 			TaskToken:        taskToken,
@@ -1994,7 +2173,7 @@ func (s *engineSuite) TestRequestCancel_RespondDecisionTaskCompleted_NoHeartBeat
 	s.False(executionBuilder.HasPendingDecisionTask())
 
 	// Try recording activity heartbeat
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil).Once()
 
 	activityTaskToken, _ := json.Marshal(&common.TaskToken{
 		WorkflowID: "wId",
@@ -2016,7 +2195,7 @@ func (s *engineSuite) TestRequestCancel_RespondDecisionTaskCompleted_NoHeartBeat
 
 	// Try cancelling the request.
 	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil).Once()
 
 	err = s.mockHistoryEngine.RespondActivityTaskCanceled(&history.RespondActivityTaskCanceledRequest{
 		DomainUUID: common.StringPtr(domainID),
@@ -2076,9 +2255,9 @@ func (s *engineSuite) TestRequestCancel_RespondDecisionTaskCompleted_Success() {
 
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
 	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil).Once()
 
-	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
+	_, err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
 		DomainUUID: common.StringPtr(domainID),
 		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
 			TaskToken:        taskToken,
@@ -2096,7 +2275,7 @@ func (s *engineSuite) TestRequestCancel_RespondDecisionTaskCompleted_Success() {
 	s.False(executionBuilder.HasPendingDecisionTask())
 
 	// Try recording activity heartbeat
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil).Once()
 
 	activityTaskToken, _ := json.Marshal(&common.TaskToken{
 		WorkflowID: "wId",
@@ -2118,7 +2297,7 @@ func (s *engineSuite) TestRequestCancel_RespondDecisionTaskCompleted_Success() {
 
 	// Try cancelling the request.
 	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil).Once()
 
 	err = s.mockHistoryEngine.RespondActivityTaskCanceled(&history.RespondActivityTaskCanceledRequest{
 		DomainUUID: common.StringPtr(domainID),
@@ -2175,9 +2354,9 @@ func (s *engineSuite) TestUserTimer_RespondDecisionTaskCompleted() {
 
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
 	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil).Once()
 
-	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
+	_, err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
 		DomainUUID: common.StringPtr(domainID),
 		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
 			TaskToken:        taskToken,
@@ -2228,9 +2407,9 @@ func (s *engineSuite) TestCancelTimer_RespondDecisionTaskCompleted_NoStartTimer(
 
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
 	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil).Once()
 
-	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
+	_, err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
 		DomainUUID: common.StringPtr(domainID),
 		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
 			TaskToken:        taskToken,
@@ -2248,6 +2427,913 @@ func (s *engineSuite) TestCancelTimer_RespondDecisionTaskCompleted_NoStartTimer(
 	s.False(executionBuilder.HasPendingDecisionTask())
 }
 
+func (s *engineSuite) TestRequestCancelWorkflowExecution_TerminateAfterSeconds() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	var updateRequest *persistence.UpdateWorkflowExecutionRequest
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(s.newDomainResponse(false), nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil).Run(func(args mock.Arguments) {
+		updateRequest = args.Get(0).(*persistence.UpdateWorkflowExecutionRequest)
+	}).Once()
+
+	err := s.mockHistoryEngine.RequestCancelWorkflowExecution(&history.RequestCancelWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CancelRequest: &workflow.RequestCancelWorkflowExecutionRequest{
+			WorkflowExecution:     &we,
+			Identity:              common.StringPtr(identity),
+			TerminateAfterSeconds: common.Int32Ptr(10),
+		},
+	})
+	s.Nil(err)
+
+	s.NotNil(updateRequest)
+	s.Equal(1, len(updateRequest.TimerTasks))
+	cancelTimeoutTask, ok := updateRequest.TimerTasks[0].(*persistence.CancelTimeoutTask)
+	s.True(ok)
+	// Cancel requested event is recorded right after the started decision
+	s.Equal(int64(4), cancelTimeoutTask.EventID)
+}
+
+func (s *engineSuite) TestSignalWorkflowExecution_OrderPreserved() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	var appendRequests []*persistence.AppendHistoryEventsRequest
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		appendRequests = append(appendRequests, args.Get(0).(*persistence.AppendHistoryEventsRequest))
+	}).Times(3)
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil).Times(3)
+
+	signalNames := []string{"signal1", "signal2", "signal3"}
+	for _, signalName := range signalNames {
+		err := s.mockHistoryEngine.SignalWorkflowExecution(&history.SignalWorkflowExecutionRequest{
+			DomainUUID: common.StringPtr(domainID),
+			SignalRequest: &workflow.SignalWorkflowExecutionRequest{
+				WorkflowExecution: &we,
+				SignalName:        common.StringPtr(signalName),
+				Input:             []byte(signalName),
+				Identity:          common.StringPtr(identity),
+			},
+		})
+		s.Nil(err)
+	}
+
+	// Signals are recorded in the order they are received, each with a strictly increasing event ID
+	s.Equal(len(signalNames), len(appendRequests))
+	serializer := persistence.NewJSONHistorySerializer()
+	lastEventID := int64(0)
+	for i, request := range appendRequests {
+		batch, err := serializer.Deserialize(request.Events)
+		s.Nil(err)
+		s.Equal(1, len(batch.Events))
+		event := batch.Events[0]
+		s.Equal(workflow.EventType_WorkflowExecutionSignaled, event.GetEventType())
+		s.Equal(signalNames[i], event.GetWorkflowExecutionSignaledEventAttributes().GetSignalName())
+		s.Equal(request.FirstEventID, event.GetEventId())
+		s.True(event.GetEventId() > lastEventID)
+		lastEventID = event.GetEventId()
+	}
+
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(lastEventID+1, executionBuilder.executionInfo.NextEventID)
+}
+
+func (s *engineSuite) TestSignalWorkflowExecution_Deduped() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	var updateRequests []*persistence.UpdateWorkflowExecutionRequest
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Times(2)
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(
+		&persistence.UpdateWorkflowExecutionResponse{}, nil).Run(func(args mock.Arguments) {
+		updateRequests = append(updateRequests, args.Get(0).(*persistence.UpdateWorkflowExecutionRequest))
+	}).Times(2)
+
+	// The retry of the first request is not applied again
+	for _, requestID := range []string{"request1", "request1", "request2"} {
+		err := s.mockHistoryEngine.SignalWorkflowExecution(&history.SignalWorkflowExecutionRequest{
+			DomainUUID: common.StringPtr(domainID),
+			SignalRequest: &workflow.SignalWorkflowExecutionRequest{
+				WorkflowExecution: &we,
+				SignalName:        common.StringPtr("signal"),
+				Identity:          common.StringPtr(identity),
+				RequestId:         common.StringPtr(requestID),
+			},
+		})
+		s.Nil(err)
+	}
+
+	s.Equal(2, len(updateRequests))
+	s.Equal([]string{"request1"}, updateRequests[0].UpsertSignalRequestedIDs)
+	s.Equal([]string{"request2"}, updateRequests[1].UpsertSignalRequestedIDs)
+
+	executionBuilder := s.getBuilder(domainID, we)
+	s.True(executionBuilder.isSignalRequested("request1"))
+	s.True(executionBuilder.isSignalRequested("request2"))
+	s.Equal(int64(6), executionBuilder.executionInfo.NextEventID)
+}
+
+func (s *engineSuite) TestSignalWorkflowExecution_DedupExpired() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+	s.mockHistoryEngine.signalDedupWindow = time.Hour
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
+	msBuilder.addSignalRequested("expired", time.Now().Add(-2*time.Hour))
+	msBuilder.addSignalRequested("recent", time.Now().Add(-time.Minute))
+
+	ms := createMutableState(msBuilder)
+	ms.SignalRequestedIDs["legacy"] = time.Time{}
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	var updateRequest *persistence.UpdateWorkflowExecutionRequest
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(
+		&persistence.UpdateWorkflowExecutionResponse{}, nil).Run(func(args mock.Arguments) {
+		updateRequest = args.Get(0).(*persistence.UpdateWorkflowExecutionRequest)
+	}).Once()
+
+	// The IDs recorded before the window are deleted with the update of the next signal
+	err := s.mockHistoryEngine.SignalWorkflowExecution(&history.SignalWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		SignalRequest: &workflow.SignalWorkflowExecutionRequest{
+			WorkflowExecution: &we,
+			SignalName:        common.StringPtr("signal"),
+			Identity:          common.StringPtr(identity),
+			RequestId:         common.StringPtr("new"),
+		},
+	})
+	s.Nil(err)
+
+	s.NotNil(updateRequest)
+	s.Equal([]string{"new"}, updateRequest.UpsertSignalRequestedIDs)
+	s.ElementsMatch([]string{"expired", "legacy"}, updateRequest.DeleteSignalRequestedIDs)
+
+	executionBuilder := s.getBuilder(domainID, we)
+	s.True(executionBuilder.isSignalRequested("new"))
+	s.True(executionBuilder.isSignalRequested("recent"))
+	s.False(executionBuilder.isSignalRequested("expired"))
+	s.False(executionBuilder.isSignalRequested("legacy"))
+}
+
+func (s *engineSuite) TestSignalWithStartWorkflowExecution_Running() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	var appendRequest *persistence.AppendHistoryEventsRequest
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(
+		&persistence.GetCurrentExecutionResponse{RunID: we.GetRunId()}, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		appendRequest = args.Get(0).(*persistence.AppendHistoryEventsRequest)
+	}).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(
+		&persistence.UpdateWorkflowExecutionResponse{}, nil).Once()
+
+	resp, err := s.mockHistoryEngine.SignalWithStartWorkflowExecution(&history.SignalWithStartWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		SignalWithStartRequest: &workflow.SignalWithStartWorkflowExecutionRequest{
+			WorkflowId:   we.WorkflowId,
+			WorkflowType: &workflow.WorkflowType{Name: common.StringPtr("wType")},
+			TaskList:     &workflow.TaskList{Name: common.StringPtr(tl)},
+			Identity:     common.StringPtr(identity),
+			SignalName:   common.StringPtr("signal"),
+			SignalInput:  []byte("signal input"),
+			RequestId:    common.StringPtr("request1"),
+		},
+	})
+	s.Nil(err)
+	s.Equal(we.GetRunId(), resp.GetRunId())
+
+	// The running execution is signaled
+	s.NotNil(appendRequest)
+	batch, err := persistence.NewJSONHistorySerializer().Deserialize(appendRequest.Events)
+	s.Nil(err)
+	s.Equal(1, len(batch.Events))
+	s.Equal(workflow.EventType_WorkflowExecutionSignaled, batch.Events[0].GetEventType())
+	s.Equal("signal", batch.Events[0].GetWorkflowExecutionSignaledEventAttributes().GetSignalName())
+	s.True(s.getBuilder(domainID, we).isSignalRequested("request1"))
+}
+
+func (s *engineSuite) TestSignalWithStartWorkflowExecution_NotRunning() {
+	domainID := "domainId"
+	workflowID := "wId"
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	var appendRequest *persistence.AppendHistoryEventsRequest
+	var createRequest *persistence.CreateWorkflowExecutionRequest
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(nil,
+		&workflow.EntityNotExistsError{Message: "Workflow execution not found."}).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		appendRequest = args.Get(0).(*persistence.AppendHistoryEventsRequest)
+	}).Once()
+	s.mockExecutionMgr.On("CreateCurrentWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.Anything).Return(
+		&persistence.CreateWorkflowExecutionResponse{}, nil).Run(func(args mock.Arguments) {
+		createRequest = args.Get(0).(*persistence.CreateWorkflowExecutionRequest)
+	}).Once()
+
+	resp, err := s.mockHistoryEngine.SignalWithStartWorkflowExecution(&history.SignalWithStartWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		SignalWithStartRequest: &workflow.SignalWithStartWorkflowExecutionRequest{
+			WorkflowId:                          common.StringPtr(workflowID),
+			WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr("wType")},
+			TaskList:                            &workflow.TaskList{Name: common.StringPtr(tl)},
+			Input:                               []byte("input"),
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(200),
+			Identity:                            common.StringPtr(identity),
+			SignalName:                          common.StringPtr("signal"),
+			SignalInput:                         []byte("signal input"),
+			RequestId:                           common.StringPtr("request1"),
+		},
+	})
+	s.Nil(err)
+
+	// A new run is started with the signal recorded right after its started event
+	s.NotNil(createRequest)
+	s.Equal(createRequest.Execution.GetRunId(), resp.GetRunId())
+	s.Equal("request1", createRequest.RequestID)
+	s.Equal(1, len(createRequest.TransferTasks))
+	s.True(createRequest.CurrentExecutionRecorded)
+
+	s.NotNil(appendRequest)
+	batch, err := persistence.NewJSONHistorySerializer().Deserialize(appendRequest.Events)
+	s.Nil(err)
+	s.Equal(3, len(batch.Events))
+	s.Equal(workflow.EventType_WorkflowExecutionStarted, batch.Events[0].GetEventType())
+	s.Equal(workflow.EventType_WorkflowExecutionSignaled, batch.Events[1].GetEventType())
+	s.Equal([]byte("signal input"), batch.Events[1].GetWorkflowExecutionSignaledEventAttributes().GetInput())
+	s.Equal(workflow.EventType_DecisionTaskScheduled, batch.Events[2].GetEventType())
+}
+
+func (s *engineSuite) TestSignalWithStartWorkflowExecution_StartedConcurrently() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	// The workflow is not running when it is first signaled, and another run is started before this one is created
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(nil,
+		&workflow.EntityNotExistsError{Message: "Workflow execution not found."}).Once()
+	s.mockExecutionMgr.On("CreateCurrentWorkflowExecution", mock.Anything).Return(
+		&workflow.WorkflowExecutionAlreadyStartedError{
+			StartRequestId: common.StringPtr("otherRequest"),
+			RunId:          we.RunId,
+		}).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Times(2)
+	s.mockHistoryMgr.On("DeleteWorkflowExecutionHistory", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(
+		&persistence.GetCurrentExecutionResponse{RunID: we.GetRunId()}, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(
+		&persistence.UpdateWorkflowExecutionResponse{}, nil).Once()
+
+	resp, err := s.mockHistoryEngine.SignalWithStartWorkflowExecution(&history.SignalWithStartWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		SignalWithStartRequest: &workflow.SignalWithStartWorkflowExecutionRequest{
+			WorkflowId:                          we.WorkflowId,
+			WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr("wType")},
+			TaskList:                            &workflow.TaskList{Name: common.StringPtr(tl)},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(200),
+			Identity:                            common.StringPtr(identity),
+			SignalName:                          common.StringPtr("signal"),
+			RequestId:                           common.StringPtr("request1"),
+		},
+	})
+	s.Nil(err)
+	s.Equal(we.GetRunId(), resp.GetRunId())
+	s.True(s.getBuilder(domainID, we).isSignalRequested("request1"))
+}
+
+func (s *engineSuite) TestStartWorkflowExecution_EagerDecision() {
+	domainID := "domainId"
+	workflowID := "wId"
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	var createRequest *persistence.CreateWorkflowExecutionRequest
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("CreateCurrentWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.Anything).Return(
+		&persistence.CreateWorkflowExecutionResponse{}, nil).Run(func(args mock.Arguments) {
+		createRequest = args.Get(0).(*persistence.CreateWorkflowExecutionRequest)
+	}).Once()
+
+	startRequest := &workflow.StartWorkflowExecutionRequest{
+		WorkflowId:                          common.StringPtr(workflowID),
+		WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr("wType")},
+		TaskList:                            &workflow.TaskList{Name: common.StringPtr(tl)},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(200),
+		Identity:                            common.StringPtr(identity),
+		RequestId:                           common.StringPtr("request1"),
+		RequestEagerExecution:               common.BoolPtr(true),
+	}
+	resp, err := s.mockHistoryEngine.StartWorkflowExecution(&history.StartWorkflowExecutionRequest{
+		DomainUUID:   common.StringPtr(domainID),
+		StartRequest: startRequest,
+	})
+	s.Nil(err)
+
+	s.NotNil(createRequest)
+
+	// The chain started without a trace ID is traced by the run ID, the request of the caller is left unchanged
+	s.Nil(startRequest.TraceId)
+	s.Equal(resp.GetRunId(), createRequest.TraceID)
+
+	// The first decision is started for the caller instead of being dispatched through matching
+	s.Equal(0, len(createRequest.TransferTasks))
+	s.Equal(1, len(createRequest.TimerTasks))
+	s.Equal(int64(2), createRequest.DecisionScheduleID)
+	s.Equal(int64(3), createRequest.DecisionStartedID)
+
+	decisionTask := resp.GetDecisionTask()
+	s.NotNil(decisionTask)
+	s.Equal(resp.GetRunId(), decisionTask.GetWorkflowExecution().GetRunId())
+	s.Equal("wType", decisionTask.GetWorkflowType().GetName())
+	s.Equal(int64(3), decisionTask.GetStartedEventId())
+	s.Equal(3, len(decisionTask.GetHistory().GetEvents()))
+	s.Equal(workflow.EventType_DecisionTaskStarted, decisionTask.GetHistory().GetEvents()[2].GetEventType())
+	token, err := common.NewJSONTaskTokenSerializer().Deserialize(decisionTask.GetTaskToken())
+	s.Nil(err)
+	s.Equal(domainID, token.DomainID)
+	s.Equal(int64(2), token.ScheduleID)
+}
+
+func (s *engineSuite) TestStartWorkflowExecution_EagerDecisionDeduplicated() {
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+
+	// The start is retried while the decision started for the first attempt is still outstanding
+	resp, err := s.startEagerDeduplicated(we, "request1")
+	s.Nil(err)
+	s.Equal(we.GetRunId(), resp.GetRunId())
+
+	decisionTask := resp.GetDecisionTask()
+	s.NotNil(decisionTask)
+	s.Equal(we.GetRunId(), decisionTask.GetWorkflowExecution().GetRunId())
+	s.Equal("wType", decisionTask.GetWorkflowType().GetName())
+	s.Equal(int64(3), decisionTask.GetStartedEventId())
+	s.Equal(3, len(decisionTask.GetHistory().GetEvents()))
+	token, err := common.NewJSONTaskTokenSerializer().Deserialize(decisionTask.GetTaskToken())
+	s.Nil(err)
+	s.Equal(we.GetRunId(), token.RunID)
+	s.Equal(int64(2), token.ScheduleID)
+}
+
+func (s *engineSuite) TestStartWorkflowExecution_EagerDecisionRedispatched() {
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+
+	// The decision of the first attempt timed out and was started again by a poller through matching
+	resp, err := s.startEagerDeduplicated(we, "pollRequest")
+	s.Nil(err)
+	s.Equal(we.GetRunId(), resp.GetRunId())
+	s.Nil(resp.GetDecisionTask())
+}
+
+// startEagerDeduplicated retries an eager start of a run whose first decision is started with the request ID
+func (s *engineSuite) startEagerDeduplicated(we workflow.WorkflowExecution,
+	decisionRequestID string) (*workflow.StartWorkflowExecutionResponse, error) {
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEventWithRequestID(msBuilder, decisionScheduledEvent.GetEventId(), decisionRequestID, tl,
+		identity)
+	ms := createMutableState(msBuilder)
+	serializedHistory, _ := msBuilder.hBuilder.Serialize(persistence.NewJSONHistorySerializer())
+
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("CreateCurrentWorkflowExecution", mock.Anything).Return(
+		&workflow.WorkflowExecutionAlreadyStartedError{
+			StartRequestId: common.StringPtr("request1"),
+			RunId:          we.RunId,
+		}).Once()
+	s.mockHistoryMgr.On("DeleteWorkflowExecutionHistory", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(
+		&persistence.GetWorkflowExecutionResponse{State: ms}, nil).Once()
+	if decisionRequestID == "request1" {
+		s.mockHistoryMgr.On("GetWorkflowExecutionHistory", mock.Anything).Return(
+			&persistence.GetWorkflowExecutionHistoryResponse{
+				Events: []persistence.SerializedHistoryEventBatch{*serializedHistory},
+			}, nil).Once()
+	}
+
+	return s.mockHistoryEngine.StartWorkflowExecution(&history.StartWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr("domainId"),
+		StartRequest: &workflow.StartWorkflowExecutionRequest{
+			WorkflowId:                          we.WorkflowId,
+			WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr("wType")},
+			TaskList:                            &workflow.TaskList{Name: common.StringPtr(tl)},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(200),
+			Identity:                            common.StringPtr(identity),
+			RequestId:                           common.StringPtr("request1"),
+			RequestEagerExecution:               common.BoolPtr(true),
+		},
+	})
+}
+
+func (s *engineSuite) TestStartWorkflowExecution_CreateFailed() {
+	var currentRequest *persistence.CreateCurrentWorkflowExecutionRequest
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("CreateCurrentWorkflowExecution", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		currentRequest = args.Get(0).(*persistence.CreateCurrentWorkflowExecutionRequest)
+	}).Once()
+	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.Anything).Return(nil,
+		&persistence.ConditionFailedError{Msg: "condition failed"}).Once()
+	s.mockExecutionMgr.On("DeleteCurrentWorkflowExecution", mock.MatchedBy(
+		func(request *persistence.DeleteCurrentWorkflowExecutionRequest) bool {
+			return request.RunID == currentRequest.RunID
+		})).Return(nil).Once()
+
+	_, err := s.mockHistoryEngine.StartWorkflowExecution(&history.StartWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr("domainId"),
+		StartRequest: &workflow.StartWorkflowExecutionRequest{
+			WorkflowId:                          common.StringPtr("wId"),
+			WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr("wType")},
+			TaskList:                            &workflow.TaskList{Name: common.StringPtr("testTaskList")},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(200),
+			Identity:                            common.StringPtr("testIdentity"),
+			RequestId:                           common.StringPtr("request1"),
+		},
+	})
+	// The current execution no longer points at the run which could not be written
+	s.IsType(&persistence.ConditionFailedError{}, err)
+	s.Equal("wId", currentRequest.WorkflowID)
+}
+
+func (s *engineSuite) TestRequestCancelWorkflowExecution_Deduped() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	var updateRequest *persistence.UpdateWorkflowExecutionRequest
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(
+		&persistence.UpdateWorkflowExecutionResponse{}, nil).Run(func(args mock.Arguments) {
+		updateRequest = args.Get(0).(*persistence.UpdateWorkflowExecutionRequest)
+	}).Once()
+
+	// The retry of the request is not applied again
+	for i := 0; i < 2; i++ {
+		err := s.mockHistoryEngine.RequestCancelWorkflowExecution(&history.RequestCancelWorkflowExecutionRequest{
+			DomainUUID: common.StringPtr(domainID),
+			CancelRequest: &workflow.RequestCancelWorkflowExecutionRequest{
+				WorkflowExecution: &we,
+				Identity:          common.StringPtr(identity),
+				RequestId:         common.StringPtr("request1"),
+			},
+		})
+		s.Nil(err)
+	}
+
+	s.NotNil(updateRequest)
+	s.True(updateRequest.ExecutionInfo.CancelRequested)
+	s.Equal("request1", updateRequest.ExecutionInfo.CancelRequestID)
+
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(int64(5), executionBuilder.executionInfo.NextEventID)
+}
+
+func (s *engineSuite) TestResetWorkflowExecution_Running() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	msBuilder := s.buildResetBaseRun(we)
+	ms := createMutableState(msBuilder)
+	serializedHistory, _ := msBuilder.hBuilder.Serialize(persistence.NewJSONHistorySerializer())
+
+	var appendRequest *persistence.AppendHistoryEventsBatchRequest
+	var currentRequest *persistence.UpdateCurrentWorkflowExecutionRequest
+	var updateRequest *persistence.UpdateWorkflowExecutionRequest
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(s.newDomainResponse(false), nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(
+		&persistence.GetWorkflowExecutionResponse{State: ms}, nil).Once()
+	s.mockHistoryMgr.On("GetWorkflowExecutionHistory", mock.Anything).Return(
+		&persistence.GetWorkflowExecutionHistoryResponse{
+			Events: []persistence.SerializedHistoryEventBatch{*serializedHistory},
+		}, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEventsBatch", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		appendRequest = args.Get(0).(*persistence.AppendHistoryEventsBatchRequest)
+	}).Once()
+	s.mockExecutionMgr.On("UpdateCurrentWorkflowExecution", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		currentRequest = args.Get(0).(*persistence.UpdateCurrentWorkflowExecutionRequest)
+	}).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(
+		&persistence.UpdateWorkflowExecutionResponse{}, nil).Run(func(args mock.Arguments) {
+		updateRequest = args.Get(0).(*persistence.UpdateWorkflowExecutionRequest)
+	}).Once()
+	s.mockVisibilityMgr.On("RecordWorkflowExecutionStarted", mock.Anything).Return(nil).Once()
+
+	response, err := s.mockHistoryEngine.ResetWorkflowExecution(&history.ResetWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		ResetRequest: &workflow.ResetWorkflowExecutionRequest{
+			WorkflowExecution:     &we,
+			Reason:                common.StringPtr("reset reason"),
+			DecisionFinishEventId: common.Int64Ptr(11),
+			RequestId:             common.StringPtr("request1"),
+			Identity:              common.StringPtr("testIdentity"),
+		},
+	})
+	s.Nil(err)
+	s.NotEqual("rId", response.GetRunId())
+
+	s.Equal(persistence.WorkflowStateCompleted, updateRequest.ExecutionInfo.State)
+	s.Equal(persistence.WorkflowCloseStatusTerminated, updateRequest.ExecutionInfo.CloseStatus)
+	s.NotNil(updateRequest.ContinueAsNew)
+	s.Equal(response.GetRunId(), updateRequest.ContinueAsNew.Execution.GetRunId())
+	// The current execution is moved from the base run to the new run before the runs are written
+	s.Equal("rId", currentRequest.PreviousRunID)
+	s.Equal(response.GetRunId(), currentRequest.RunID)
+	s.True(updateRequest.ContinueAsNew.CurrentExecutionRecorded)
+	s.Equal(int64(15), updateRequest.ContinueAsNew.NextEventID)
+	s.Equal(int64(14), updateRequest.ContinueAsNew.DecisionScheduleID)
+	s.Equal(2, len(updateRequest.ContinueAsNew.ActivityInfos))
+	s.Equal(1, len(updateRequest.ContinueAsNew.TimerInfos))
+	// The started activity is failed, the scheduled one is dispatched again with its timeouts
+	s.Equal(2, len(updateRequest.ContinueAsNew.TransferTasks))
+	s.Equal(3, len(updateRequest.ContinueAsNew.TimerTasks))
+
+	s.Equal(2, len(appendRequest.Requests))
+	resetHistory, _ := persistence.NewJSONHistorySerializer().Deserialize(appendRequest.Requests[1].Events)
+	events := resetHistory.Events
+	s.Equal(14, len(events))
+	s.Equal(workflow.EventType_DecisionTaskStarted, events[9].GetEventType())
+	s.Equal(workflow.EventType_DecisionTaskFailed, events[10].GetEventType())
+	s.Equal(workflow.DecisionTaskFailedCause_RESET_WORKFLOW,
+		events[10].GetDecisionTaskFailedEventAttributes().GetCause())
+	s.Equal(workflow.EventType_ActivityTaskFailed, events[11].GetEventType())
+	s.Equal(int64(5), events[11].GetActivityTaskFailedEventAttributes().GetScheduledEventId())
+	s.Equal(workflow.EventType_WorkflowExecutionSignaled, events[12].GetEventType())
+	s.Equal(workflow.EventType_DecisionTaskScheduled, events[13].GetEventType())
+}
+
+func (s *engineSuite) TestResetWorkflowExecution_TerminationProtected() {
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	msBuilder := s.buildResetBaseRun(we)
+	ms := createMutableState(msBuilder)
+	serializedHistory, _ := msBuilder.hBuilder.Serialize(persistence.NewJSONHistorySerializer())
+
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(s.newDomainResponse(true), nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(
+		&persistence.GetWorkflowExecutionResponse{State: ms}, nil).Once()
+	s.mockHistoryMgr.On("GetWorkflowExecutionHistory", mock.Anything).Return(
+		&persistence.GetWorkflowExecutionHistoryResponse{
+			Events: []persistence.SerializedHistoryEventBatch{*serializedHistory},
+		}, nil).Once()
+
+	// The running base run cannot be terminated
+	_, err := s.mockHistoryEngine.ResetWorkflowExecution(&history.ResetWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr("domainId"),
+		ResetRequest: &workflow.ResetWorkflowExecutionRequest{
+			WorkflowExecution:     &we,
+			DecisionFinishEventId: common.Int64Ptr(11),
+			RequestId:             common.StringPtr("request1"),
+		},
+	})
+	s.IsType(&workflow.BadRequestError{}, err)
+}
+
+func (s *engineSuite) TestTerminateWorkflowExecution_TerminationProtected() {
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(s.newDomainResponse(true), nil).Once()
+
+	err := s.mockHistoryEngine.TerminateWorkflowExecution(&history.TerminateWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr("domainId"),
+		TerminateRequest: &workflow.TerminateWorkflowExecutionRequest{
+			WorkflowExecution: &we,
+		},
+	})
+	s.IsType(&workflow.BadRequestError{}, err)
+}
+
+func (s *engineSuite) newDomainResponse(terminationProtected bool) *persistence.GetDomainResponse {
+	return &persistence.GetDomainResponse{
+		Info:   &persistence.DomainInfo{ID: "domainId", Name: "domainName"},
+		Config: &persistence.DomainConfig{Retention: 1, TerminationProtected: terminationProtected},
+	}
+}
+
+func (s *engineSuite) TestResetWorkflowExecution_Closed() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	msBuilder := s.buildResetBaseRun(we)
+	di, _ := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, "testTaskList", "testIdentity")
+	completedEvent := addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, di.ScheduleID+1, nil, "testIdentity")
+	addCompleteWorkflowEvent(msBuilder, completedEvent.GetEventId(), nil)
+	ms := createMutableState(msBuilder)
+	serializedHistory, _ := msBuilder.hBuilder.Serialize(persistence.NewJSONHistorySerializer())
+
+	var createRequest *persistence.CreateWorkflowExecutionRequest
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(
+		&persistence.GetWorkflowExecutionResponse{State: ms}, nil).Once()
+	s.mockHistoryMgr.On("GetWorkflowExecutionHistory", mock.Anything).Return(
+		&persistence.GetWorkflowExecutionHistoryResponse{
+			Events: []persistence.SerializedHistoryEventBatch{*serializedHistory},
+		}, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("CreateCurrentWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.Anything).Return(
+		&persistence.CreateWorkflowExecutionResponse{}, nil).Run(func(args mock.Arguments) {
+		createRequest = args.Get(0).(*persistence.CreateWorkflowExecutionRequest)
+	}).Once()
+	s.mockVisibilityMgr.On("RecordWorkflowExecutionStarted", mock.Anything).Return(nil).Once()
+
+	response, err := s.mockHistoryEngine.ResetWorkflowExecution(&history.ResetWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		ResetRequest: &workflow.ResetWorkflowExecutionRequest{
+			WorkflowExecution:     &we,
+			Reason:                common.StringPtr("reset reason"),
+			DecisionFinishEventId: common.Int64Ptr(11),
+			RequestId:             common.StringPtr("request1"),
+			Identity:              common.StringPtr("testIdentity"),
+		},
+	})
+	s.Nil(err)
+	s.Equal(response.GetRunId(), createRequest.Execution.GetRunId())
+	s.False(createRequest.ContinueAsNew)
+	s.True(createRequest.CurrentExecutionRecorded)
+	s.Equal("request1", createRequest.RequestID)
+	s.Equal(int64(15), createRequest.NextEventID)
+	s.Equal(2, len(createRequest.ActivityInfos))
+	s.Equal(1, len(createRequest.TimerInfos))
+}
+
+func (s *engineSuite) TestResetWorkflowExecution_ResetConcurrently() {
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	runningBuilder := s.buildResetBaseRun(we)
+	runningHistory, _ := runningBuilder.hBuilder.Serialize(persistence.NewJSONHistorySerializer())
+	closedBuilder := s.buildResetBaseRun(we)
+	di, _ := addDecisionTaskScheduledEvent(closedBuilder)
+	addDecisionTaskStartedEvent(closedBuilder, di.ScheduleID, "testTaskList", "testIdentity")
+	completedEvent := addDecisionTaskCompletedEvent(closedBuilder, di.ScheduleID, di.ScheduleID+1, nil, "testIdentity")
+	addCompleteWorkflowEvent(closedBuilder, completedEvent.GetEventId(), nil)
+	closedHistory, _ := closedBuilder.hBuilder.Serialize(persistence.NewJSONHistorySerializer())
+
+	// Another reset moved the current execution away from the base run after it was loaded
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(s.newDomainResponse(false), nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(
+		&persistence.GetWorkflowExecutionResponse{State: createMutableState(runningBuilder)}, nil).Once()
+	s.mockHistoryMgr.On("GetWorkflowExecutionHistory", mock.Anything).Return(
+		&persistence.GetWorkflowExecutionHistoryResponse{
+			Events: []persistence.SerializedHistoryEventBatch{*runningHistory},
+		}, nil).Once()
+	s.mockExecutionMgr.On("UpdateCurrentWorkflowExecution", mock.Anything).Return(
+		&persistence.CurrentWorkflowConditionFailedError{Msg: "condition failed", RunID: "otherRunId"}).Once()
+
+	// The base run is loaded again, closed by the other reset which owns the current execution
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(
+		&persistence.GetWorkflowExecutionResponse{State: createMutableState(closedBuilder)}, nil).Once()
+	s.mockHistoryMgr.On("GetWorkflowExecutionHistory", mock.Anything).Return(
+		&persistence.GetWorkflowExecutionHistoryResponse{
+			Events: []persistence.SerializedHistoryEventBatch{*closedHistory},
+		}, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("CreateCurrentWorkflowExecution", mock.Anything).Return(
+		&workflow.WorkflowExecutionAlreadyStartedError{
+			StartRequestId: common.StringPtr("otherRequest"),
+			RunId:          common.StringPtr("otherRunId"),
+		}).Once()
+	s.mockHistoryMgr.On("DeleteWorkflowExecutionHistory", mock.Anything).Return(nil).Once()
+
+	_, err := s.mockHistoryEngine.ResetWorkflowExecution(&history.ResetWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr("domainId"),
+		ResetRequest: &workflow.ResetWorkflowExecutionRequest{
+			WorkflowExecution:     &we,
+			Reason:                common.StringPtr("reset reason"),
+			DecisionFinishEventId: common.Int64Ptr(11),
+			RequestId:             common.StringPtr("request1"),
+			Identity:              common.StringPtr("testIdentity"),
+		},
+	})
+	s.IsType(&workflow.WorkflowExecutionAlreadyStartedError{}, err)
+	s.Equal("otherRunId", err.(*workflow.WorkflowExecutionAlreadyStartedError).GetRunId())
+}
+
+func (s *engineSuite) TestResetWorkflowExecution_BadDecisionFinishEventID() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	msBuilder := s.buildResetBaseRun(we)
+	ms := createMutableState(msBuilder)
+	serializedHistory, _ := msBuilder.hBuilder.Serialize(persistence.NewJSONHistorySerializer())
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(
+		&persistence.GetWorkflowExecutionResponse{State: ms}, nil).Once()
+	s.mockHistoryMgr.On("GetWorkflowExecutionHistory", mock.Anything).Return(
+		&persistence.GetWorkflowExecutionHistoryResponse{
+			Events: []persistence.SerializedHistoryEventBatch{*serializedHistory},
+		}, nil).Times(3)
+
+	// The event is not a decision task finish event, or is beyond the history of the run
+	for _, eventID := range []int64{0, 5, 13} {
+		_, err := s.mockHistoryEngine.ResetWorkflowExecution(&history.ResetWorkflowExecutionRequest{
+			DomainUUID: common.StringPtr(domainID),
+			ResetRequest: &workflow.ResetWorkflowExecutionRequest{
+				WorkflowExecution:     &we,
+				DecisionFinishEventId: common.Int64Ptr(eventID),
+				RequestId:             common.StringPtr("request1"),
+			},
+		})
+		s.IsType(&workflow.BadRequestError{}, err)
+	}
+}
+
+// buildResetBaseRun builds a run which has a started activity, a scheduled activity and a timer pending, and a
+// signal received after the decision task which completes at event 11
+func (s *engineSuite) buildResetBaseRun(we workflow.WorkflowExecution) *mutableStateBuilder {
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	di, _ := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	completedEvent := addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, di.ScheduleID+1, nil, identity)
+	activity1, _ := addActivityTaskScheduledEvent(msBuilder, completedEvent.GetEventId(), "activity1", "activityType",
+		tl, []byte("input1"), 100, 10, 5)
+	addActivityTaskScheduledEvent(msBuilder, completedEvent.GetEventId(), "activity2", "activityType", tl,
+		[]byte("input2"), 100, 10, 5)
+	addTimerStartedEvent(msBuilder, completedEvent.GetEventId(), "timer1", 50)
+	addActivityTaskStartedEvent(msBuilder, activity1.GetEventId(), tl, identity)
+	di, _ = addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, di.ScheduleID+1, nil, identity)
+	msBuilder.AddWorkflowExecutionSignaled(&workflow.SignalWorkflowExecutionRequest{
+		SignalName: common.StringPtr("signal"),
+		Input:      []byte("signal input"),
+		Identity:   common.StringPtr(identity),
+	})
+	s.Equal(int64(13), msBuilder.GetNextEventID())
+
+	return msBuilder
+}
+
+func (s *engineSuite) TestHistoryCacheStaleHit() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+	scope := tally.NewTestScope("", nil)
+	s.mockHistoryEngine.shard.(*shardContextImpl).metricsClient = metrics.NewClient(scope, metrics.History)
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
+
+	// The second signal conflicts with an update made by another host since the mutable state was cached
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(
+		&persistence.GetWorkflowExecutionResponse{State: createMutableState(msBuilder)}, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Times(3)
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(
+		&persistence.UpdateWorkflowExecutionResponse{}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil,
+		&persistence.ConditionFailedError{}).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(
+		&persistence.GetWorkflowExecutionResponse{State: createMutableState(msBuilder)}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(
+		&persistence.UpdateWorkflowExecutionResponse{}, nil).Once()
+
+	for i := 0; i < 2; i++ {
+		err := s.mockHistoryEngine.SignalWorkflowExecution(&history.SignalWorkflowExecutionRequest{
+			DomainUUID: common.StringPtr(domainID),
+			SignalRequest: &workflow.SignalWorkflowExecutionRequest{
+				WorkflowExecution: &we,
+				SignalName:        common.StringPtr("signal"),
+				Identity:          common.StringPtr(identity),
+			},
+		})
+		s.Nil(err)
+	}
+
+	counter, ok := scope.Snapshot().Counters()["history-cache.stale-hits+operation=ExecutionUpdate"]
+	s.True(ok)
+	s.Equal(int64(1), counter.Value())
+}
+
 func (s *engineSuite) getBuilder(domainID string, we workflow.WorkflowExecution) *mutableStateBuilder {
 	context, release, err := s.mockHistoryEngine.historyCache.getOrCreateWorkflowExecution(domainID, we)
 	if err != nil {
@@ -2291,7 +3377,7 @@ func (s *engineSuite) getActivityStartedEvent(msBuilder *mutableStateBuilder,
 }
 
 func (s *engineSuite) printHistory(builder *mutableStateBuilder) string {
-	history, err := builder.hBuilder.Serialize()
+	history, err := builder.hBuilder.Serialize(persistence.NewJSONHistorySerializer())
 	if err != nil {
 		s.logger.Errorf("Error serializing history: %v", err)
 		return ""
@@ -2418,10 +3504,15 @@ func createMutableState(builder *mutableStateBuilder) *persistence.WorkflowMutab
 	for id, info := range builder.pendingTimerInfoIDs {
 		timerInfos[id] = copyTimerInfo(info)
 	}
+	signalRequestedIDs := make(map[string]time.Time)
+	for id, recorded := range builder.pendingSignalRequestedIDs {
+		signalRequestedIDs[id] = recorded
+	}
 	return &persistence.WorkflowMutableState{
-		ExecutionInfo: info,
-		ActivitInfos:  activityInfos,
-		TimerInfos:    timerInfos,
+		ExecutionInfo:      info,
+		ActivitInfos:       activityInfos,
+		TimerInfos:         timerInfos,
+		SignalRequestedIDs: signalRequestedIDs,
 	}
 }
 
@@ -2449,6 +3540,8 @@ func copyWorkflowExecutionInfo(sourceInfo *persistence.WorkflowExecutionInfo) *p
 		DecisionStartedID:    sourceInfo.DecisionStartedID,
 		DecisionRequestID:    sourceInfo.DecisionRequestID,
 		DecisionTimeout:      sourceInfo.DecisionTimeout,
+		CancelRequested:      sourceInfo.CancelRequested,
+		CancelRequestID:      sourceInfo.CancelRequestID,
 	}
 }
 
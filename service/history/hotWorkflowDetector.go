@@ -0,0 +1,112 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"hash/fnv"
+	"strconv"
+	"sync"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/service/config"
+)
+
+const (
+	hotWorkflowSignal hotWorkflowRequestType = iota
+	hotWorkflowHeartbeat
+)
+
+type (
+	hotWorkflowRequestType int
+
+	hotWorkflowKey struct {
+		domainID    string
+		workflowID  string
+		requestType hotWorkflowRequestType
+	}
+
+	// hotWorkflowDetector counts the signals and the activity heartbeats received by each workflow during the current
+	// second, to find the workflows receiving them at a pathological rate.  A nil hotWorkflowDetector detects nothing.
+	hotWorkflowDetector struct {
+		sync.Mutex
+		cfg        config.HotWorkflow
+		timeSource common.TimeSource
+		second     int64
+		counts     map[hotWorkflowKey]int
+	}
+)
+
+var errHotWorkflowSignalThrottled = &workflow.ServiceBusyError{
+	Message: "Too many signals sent to the workflow execution, retry later.",
+}
+
+func newHotWorkflowDetector(cfg config.HotWorkflow) *hotWorkflowDetector {
+	return &hotWorkflowDetector{
+		cfg:        cfg,
+		timeSource: common.NewRealTimeSource(),
+		counts:     make(map[hotWorkflowKey]int),
+	}
+}
+
+// record counts a request received by the workflow.  It returns whether the workflow received more requests of the
+// type than the limit during the current second, and whether this request is the first one above the limit.
+func (d *hotWorkflowDetector) record(requestType hotWorkflowRequestType, domainID string,
+	workflowID string) (hot bool, first bool) {
+	if d == nil {
+		return false, false
+	}
+
+	limit := d.cfg.MaxSignalsPerSecond
+	if requestType == hotWorkflowHeartbeat {
+		limit = d.cfg.MaxHeartbeatsPerSecond
+	}
+	if limit <= 0 {
+		return false, false
+	}
+
+	now := d.timeSource.Now().Unix()
+
+	d.Lock()
+	defer d.Unlock()
+
+	if now != d.second {
+		// the counts of the previous second are dropped, so only the workflows active this second are tracked
+		d.second = now
+		d.counts = make(map[hotWorkflowKey]int)
+	}
+
+	key := hotWorkflowKey{domainID: domainID, workflowID: workflowID, requestType: requestType}
+	d.counts[key]++
+	return d.counts[key] > limit, d.counts[key] == limit+1
+}
+
+// throttleSignals returns true if the signals above the limit are rejected
+func (d *hotWorkflowDetector) throttleSignals() bool {
+	return d != nil && d.cfg.ThrottleSignals
+}
+
+// hashWorkflowID returns the hash of a workflow ID reported in the metrics instead of the workflow ID itself
+func hashWorkflowID(workflowID string) string {
+	h := fnv.New32a()
+	h.Write([]byte(workflowID))
+	return strconv.FormatUint(uint64(h.Sum32()), 16)
+}
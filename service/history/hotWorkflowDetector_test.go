@@ -0,0 +1,113 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/service/config"
+)
+
+type (
+	hotWorkflowDetectorSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+		timeSource *mockTimeSource
+		detector   *hotWorkflowDetector
+	}
+)
+
+func TestHotWorkflowDetectorSuite(t *testing.T) {
+	s := new(hotWorkflowDetectorSuite)
+	suite.Run(t, s)
+}
+
+func (s *hotWorkflowDetectorSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.timeSource = &mockTimeSource{currTime: time.Unix(1000, 0)}
+	s.detector = newHotWorkflowDetector(config.HotWorkflow{
+		MaxSignalsPerSecond:    2,
+		MaxHeartbeatsPerSecond: 3,
+		ThrottleSignals:        true,
+	})
+	s.detector.timeSource = s.timeSource
+}
+
+func (s *hotWorkflowDetectorSuite) TestSignalsAboveLimit() {
+	for i := 0; i < 2; i++ {
+		hot, _ := s.detector.record(hotWorkflowSignal, "domain", "wid")
+		s.False(hot)
+	}
+
+	hot, first := s.detector.record(hotWorkflowSignal, "domain", "wid")
+	s.True(hot)
+	s.True(first)
+	hot, first = s.detector.record(hotWorkflowSignal, "domain", "wid")
+	s.True(hot)
+	s.False(first)
+
+	// the other workflows and the heartbeats are counted separately
+	hot, _ = s.detector.record(hotWorkflowSignal, "domain", "other-wid")
+	s.False(hot)
+	hot, _ = s.detector.record(hotWorkflowSignal, "other-domain", "wid")
+	s.False(hot)
+	hot, _ = s.detector.record(hotWorkflowHeartbeat, "domain", "wid")
+	s.False(hot)
+	s.True(s.detector.throttleSignals())
+}
+
+func (s *hotWorkflowDetectorSuite) TestCountsResetEverySecond() {
+	for i := 0; i < 3; i++ {
+		s.detector.record(hotWorkflowHeartbeat, "domain", "wid")
+	}
+	hot, _ := s.detector.record(hotWorkflowHeartbeat, "domain", "wid")
+	s.True(hot)
+
+	s.timeSource.currTime = s.timeSource.currTime.Add(time.Second)
+	hot, _ = s.detector.record(hotWorkflowHeartbeat, "domain", "wid")
+	s.False(hot)
+}
+
+func (s *hotWorkflowDetectorSuite) TestDisabled() {
+	var detector *hotWorkflowDetector
+	hot, _ := detector.record(hotWorkflowSignal, "domain", "wid")
+	s.False(hot)
+	s.False(detector.throttleSignals())
+
+	detector = newHotWorkflowDetector(config.HotWorkflow{MaxSignalsPerSecond: 1})
+	for i := 0; i < 10; i++ {
+		hot, _ = detector.record(hotWorkflowHeartbeat, "domain", "wid")
+		s.False(hot)
+	}
+	s.False(detector.throttleSignals())
+}
+
+func (s *hotWorkflowDetectorSuite) TestHashWorkflowID() {
+	s.Equal(hashWorkflowID("wid"), hashWorkflowID("wid"))
+	s.NotEqual(hashWorkflowID("wid"), hashWorkflowID("other-wid"))
+	s.NotContains(hashWorkflowID("wid"), "wid")
+}
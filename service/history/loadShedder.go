@@ -0,0 +1,187 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber-common/bark"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service/config"
+)
+
+const (
+	// defaultLoadSheddingCheckInterval is how often the heap size is checked, unless configured
+	defaultLoadSheddingCheckInterval = 10 * time.Second
+	// loadSheddingRecoveryRatio is the share of the heap threshold the heap has to fall back below for the host to
+	// stop shedding load, so that a heap hovering around the threshold does not flip the host in and out of it
+	loadSheddingRecoveryRatio = 0.9
+)
+
+var errMemoryPressure = &workflow.ServiceBusyError{Message: "History host is shedding load under memory pressure."}
+
+type (
+	// loadShedder sheds the load of the host while its heap is above the configured threshold, so that the host
+	// recovers instead of being killed out of memory and moving all its shards at once.  While shedding, the mutable
+	// state caches of the shards stop growing and each check evicts half of their entries not in use, and the
+	// requests starting new workflows are rejected.  A nil loadShedder never sheds load.
+	loadShedder struct {
+		sync.Mutex
+		maxHeapBytes  uint64
+		checkInterval time.Duration
+		readHeap      func() uint64
+		caches        map[*historyCache]struct{}
+		shedding      int32
+		logger        bark.Logger
+		metricsClient metrics.Client
+		started       int32
+		shutdownCh    chan struct{}
+		shutdownWG    sync.WaitGroup
+	}
+)
+
+func newLoadShedder(cfg config.LoadShedding, logger bark.Logger, metricsClient metrics.Client) *loadShedder {
+	checkInterval := cfg.CheckInterval
+	if checkInterval <= 0 {
+		checkInterval = defaultLoadSheddingCheckInterval
+	}
+	return &loadShedder{
+		maxHeapBytes:  cfg.MaxHeapBytes,
+		checkInterval: checkInterval,
+		readHeap:      readHeapAlloc,
+		caches:        make(map[*historyCache]struct{}),
+		logger:        logger,
+		metricsClient: metricsClient,
+		shutdownCh:    make(chan struct{}),
+	}
+}
+
+// Start starts the periodic check of the heap size
+func (s *loadShedder) Start() {
+	if !atomic.CompareAndSwapInt32(&s.started, 0, 1) {
+		return
+	}
+
+	s.shutdownWG.Add(1)
+	go s.checkLoop()
+}
+
+// Stop stops the periodic check of the heap size
+func (s *loadShedder) Stop() {
+	if !atomic.CompareAndSwapInt32(&s.started, 1, 2) {
+		return
+	}
+
+	close(s.shutdownCh)
+	s.shutdownWG.Wait()
+}
+
+// isShedding returns whether the heap of the host was above the threshold on the last check
+func (s *loadShedder) isShedding() bool {
+	return s != nil && atomic.LoadInt32(&s.shedding) == 1
+}
+
+// addCache registers the mutable state cache of a shard loaded by the host, to be shrunk while shedding load
+func (s *loadShedder) addCache(cache *historyCache) {
+	if s == nil {
+		return
+	}
+	s.Lock()
+	defer s.Unlock()
+	s.caches[cache] = struct{}{}
+}
+
+// removeCache unregisters the mutable state cache of a shard unloaded by the host
+func (s *loadShedder) removeCache(cache *historyCache) {
+	if s == nil {
+		return
+	}
+	s.Lock()
+	defer s.Unlock()
+	delete(s.caches, cache)
+}
+
+func (s *loadShedder) checkLoop() {
+	defer s.shutdownWG.Done()
+
+	ticker := time.NewTicker(s.checkInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.shutdownCh:
+			return
+		case <-ticker.C:
+			s.check()
+		}
+	}
+}
+
+// check starts or stops shedding load depending on the heap size, and shrinks the caches while shedding
+func (s *loadShedder) check() {
+	heapBytes := s.readHeap()
+	if heapBytes > s.maxHeapBytes {
+		if atomic.CompareAndSwapInt32(&s.shedding, 0, 1) {
+			s.logger.Warnf("Heap of %v bytes above the threshold of %v bytes, shedding load", heapBytes,
+				s.maxHeapBytes)
+		}
+	} else if float64(heapBytes) < loadSheddingRecoveryRatio*float64(s.maxHeapBytes) {
+		if atomic.CompareAndSwapInt32(&s.shedding, 1, 0) {
+			s.logger.Infof("Heap of %v bytes back below the threshold of %v bytes, no longer shedding load",
+				heapBytes, s.maxHeapBytes)
+		}
+	}
+
+	if !s.isShedding() {
+		s.metricsClient.UpdateGauge(metrics.HistoryLoadShedderScope, metrics.LoadSheddingGauge, 0)
+		return
+	}
+	s.metricsClient.UpdateGauge(metrics.HistoryLoadShedderScope, metrics.LoadSheddingGauge, 1)
+	s.metricsClient.AddCounter(metrics.HistoryLoadShedderScope, metrics.LoadSheddingEvictedCounter,
+		int64(s.shrinkCaches()))
+}
+
+// shrinkCaches evicts half of the entries of every cache, keeping the most recently used ones and the ones in use.
+// It returns the number of entries evicted.
+func (s *loadShedder) shrinkCaches() int {
+	s.Lock()
+	caches := make([]*historyCache, 0, len(s.caches))
+	for cache := range s.caches {
+		caches = append(caches, cache)
+	}
+	s.Unlock()
+
+	evicted := 0
+	for _, cache := range caches {
+		evicted += cache.Trim(cache.Size() / 2)
+	}
+	return evicted
+}
+
+func readHeapAlloc() uint64 {
+	var memStats runtime.MemStats
+	runtime.ReadMemStats(&memStats)
+	return memStats.HeapAlloc
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+	"github.com/uber-go/tally"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/config"
+)
+
+type (
+	loadShedderSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+		logger    bark.Logger
+		mockShard *shardContextImpl
+		heapBytes uint64
+		shedder   *loadShedder
+	}
+)
+
+func TestLoadShedderSuite(t *testing.T) {
+	s := new(loadShedderSuite)
+	suite.Run(t, s)
+}
+
+func (s *loadShedderSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.logger = bark.NewLoggerFromLogrus(log.New())
+	s.mockShard = &shardContextImpl{
+		shardInfo:        &persistence.ShardInfo{ShardID: 0, RangeID: 1},
+		executionManager: &mocks.ExecutionManager{},
+		shardManager:     &mocks.ShardManager{},
+		logger:           s.logger,
+		metricsClient:    metrics.NewClient(tally.NoopScope, metrics.History),
+	}
+	s.shedder = newLoadShedder(config.LoadShedding{MaxHeapBytes: 1000}, s.logger,
+		metrics.NewClient(tally.NoopScope, metrics.History))
+	s.shedder.readHeap = func() uint64 { return s.heapBytes }
+}
+
+func (s *loadShedderSuite) TestNil() {
+	var shedder *loadShedder
+	s.False(shedder.isShedding())
+	shedder.addCache(newHistoryCache(10, s.mockShard, s.logger))
+}
+
+func (s *loadShedderSuite) TestCheck() {
+	s.heapBytes = 1000
+	s.shedder.check()
+	s.False(s.shedder.isShedding())
+
+	s.heapBytes = 1001
+	s.shedder.check()
+	s.True(s.shedder.isShedding())
+
+	// The heap has to fall below 90% of the threshold to stop shedding load
+	s.heapBytes = 950
+	s.shedder.check()
+	s.True(s.shedder.isShedding())
+
+	s.heapBytes = 899
+	s.shedder.check()
+	s.False(s.shedder.isShedding())
+}
+
+func (s *loadShedderSuite) TestShrinkCaches() {
+	cache := newHistoryCache(100, s.mockShard, s.logger)
+	cache.loadShedder = s.shedder
+	s.shedder.addCache(cache)
+	for i := 0; i < 10; i++ {
+		_, release, err := cache.getOrCreateWorkflowExecution("domain", s.newExecution())
+		s.NoError(err)
+		release()
+	}
+	_, pinnedRelease, err := cache.getOrCreateWorkflowExecution("domain", s.newExecution())
+	s.NoError(err)
+	s.Equal(11, cache.Size())
+
+	s.heapBytes = 2000
+	s.shedder.check()
+	s.Equal(5, cache.Size())
+	s.shedder.check()
+	s.Equal(2, cache.Size())
+
+	// The cache does not grow while the host sheds load
+	_, _, err = cache.getOrCreateWorkflowExecution("domain", s.newExecution())
+	s.Equal(errMemoryPressure, err)
+	s.Equal(2, cache.Size())
+
+	// The entry in use is kept
+	s.shedder.check()
+	s.shedder.check()
+	s.Equal(1, cache.Size())
+	pinnedRelease()
+
+	s.heapBytes = 0
+	s.shedder.check()
+	_, release, err := cache.getOrCreateWorkflowExecution("domain", s.newExecution())
+	s.NoError(err)
+	release()
+	s.Equal(2, cache.Size())
+
+	s.shedder.removeCache(cache)
+	s.heapBytes = 2000
+	s.shedder.check()
+	s.Equal(2, cache.Size())
+}
+
+func (s *loadShedderSuite) newExecution() workflow.WorkflowExecution {
+	return workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wf-load-shedding-test"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+}
@@ -31,7 +31,6 @@ import (
 	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/persistence"
 
-	"github.com/pborman/uuid"
 	"github.com/uber-common/bark"
 )
 
@@ -54,6 +53,10 @@ type (
 		updateChildExecutionInfos    []*persistence.ChildExecutionInfo         // Modified ChildExecution Infos since last update
 		deleteChildExecutionInfo     *int64                                    // Deleted ChildExecution Info since last update
 
+		pendingSignalRequestedIDs map[string]time.Time // IDs of the signal requests applied to the execution.
+		updateSignalRequestedIDs  []string             // Added signal request IDs since last update.
+		deleteSignalRequestedIDs  []string             // Deleted signal request IDs since last update.
+
 		executionInfo   *persistence.WorkflowExecutionInfo // Workflow mutable state info.
 		continueAsNew   *persistence.CreateWorkflowExecutionRequest
 		hBuilder        *historyBuilder
@@ -69,6 +72,8 @@ type (
 		deleteTimerInfos          []string
 		updateChildExecutionInfos []*persistence.ChildExecutionInfo
 		deleteChildExecutionInfo  *int64
+		updateSignalRequestedIDs  []string
+		deleteSignalRequestedIDs  []string
 		continueAsNew             *persistence.CreateWorkflowExecutionRequest
 	}
 
@@ -91,6 +96,9 @@ func newMutableStateBuilder(logger bark.Logger) *mutableStateBuilder {
 		deleteTimerInfos:                []string{},
 		updateChildExecutionInfos:       []*persistence.ChildExecutionInfo{},
 		pendingChildExecutionInfoIDs:    make(map[int64]*persistence.ChildExecutionInfo),
+		pendingSignalRequestedIDs:       make(map[string]time.Time),
+		updateSignalRequestedIDs:        []string{},
+		deleteSignalRequestedIDs:        []string{},
 		eventSerializer:                 newJSONHistoryEventSerializer(),
 		logger:                          logger,
 	}
@@ -109,6 +117,9 @@ func (e *mutableStateBuilder) Load(state *persistence.WorkflowMutableState) {
 	e.pendingActivityInfoIDs = state.ActivitInfos
 	e.pendingTimerInfoIDs = state.TimerInfos
 	e.pendingChildExecutionInfoIDs = state.ChildExecutionInfos
+	if state.SignalRequestedIDs != nil {
+		e.pendingSignalRequestedIDs = state.SignalRequestedIDs
+	}
 	e.executionInfo = state.ExecutionInfo
 	for _, ai := range state.ActivitInfos {
 		e.pendingActivityInfoByActivityID[ai.ActivityID] = ai.ScheduleID
@@ -124,6 +135,8 @@ func (e *mutableStateBuilder) CloseUpdateSession() *mutableStateSessionUpdates {
 		deleteTimerInfos:          e.deleteTimerInfos,
 		updateChildExecutionInfos: e.updateChildExecutionInfos,
 		deleteChildExecutionInfo:  e.deleteChildExecutionInfo,
+		updateSignalRequestedIDs:  e.updateSignalRequestedIDs,
+		deleteSignalRequestedIDs:  e.deleteSignalRequestedIDs,
 		continueAsNew:             e.continueAsNew,
 	}
 
@@ -135,6 +148,8 @@ func (e *mutableStateBuilder) CloseUpdateSession() *mutableStateSessionUpdates {
 	e.deleteTimerInfos = []string{}
 	e.updateChildExecutionInfos = []*persistence.ChildExecutionInfo{}
 	e.deleteChildExecutionInfo = nil
+	e.updateSignalRequestedIDs = []string{}
+	e.deleteSignalRequestedIDs = []string{}
 	e.continueAsNew = nil
 
 	return updates
@@ -279,6 +294,12 @@ func (e *mutableStateBuilder) updateActivityProgress(ai *persistence.ActivityInf
 	request *workflow.RecordActivityTaskHeartbeatRequest) {
 	ai.Details = request.GetDetails()
 	ai.LastHeartBeatUpdatedTime = time.Now()
+	for _, pending := range e.updateActivityInfos {
+		if pending == ai {
+			// Activity is already part of the update session, heartbeat will be persisted along with it
+			return
+		}
+	}
 	e.updateActivityInfos = append(e.updateActivityInfos, ai)
 }
 
@@ -300,6 +321,15 @@ func (e *mutableStateBuilder) DeleteActivity(scheduleEventID int64) error {
 	}
 	delete(e.pendingActivityInfoByActivityID, a.ActivityID)
 
+	// Drop pending updates of the activity, like heartbeats which are not persisted yet
+	updateActivityInfos := e.updateActivityInfos[:0]
+	for _, pending := range e.updateActivityInfos {
+		if pending.ScheduleID != scheduleEventID {
+			updateActivityInfos = append(updateActivityInfos, pending)
+		}
+	}
+	e.updateActivityInfos = updateActivityInfos
+
 	e.deleteActivityInfo = common.Int64Ptr(scheduleEventID)
 	return nil
 }
@@ -385,7 +415,7 @@ func (e *mutableStateBuilder) getHistoryEvent(serializedEvent []byte) (*workflow
 	return event, true
 }
 
-func (e *mutableStateBuilder) AddWorkflowExecutionStartedEventForContinueAsNew(domainID string,
+func (e *mutableStateBuilder) AddWorkflowExecutionStartedEventForContinueAsNew(domainID, requestID string,
 	execution workflow.WorkflowExecution, previousExecutionState *mutableStateBuilder,
 	attributes *workflow.ContinueAsNewWorkflowExecutionDecisionAttributes) *workflow.HistoryEvent {
 	taskList := previousExecutionState.executionInfo.TaskList
@@ -407,23 +437,39 @@ func (e *mutableStateBuilder) AddWorkflowExecutionStartedEventForContinueAsNew(d
 		decisionTimeout = attributes.GetTaskStartToCloseTimeoutSeconds()
 	}
 
+	// The new run is indexed by the search attributes of the previous one
+	searchAttributes := &workflow.SearchAttributes{IndexedFields: previousExecutionState.executionInfo.SearchAttributes}
 	createRequest := &workflow.StartWorkflowExecutionRequest{
-		RequestId:                           common.StringPtr(uuid.New()),
+		RequestId:                           common.StringPtr(requestID),
 		Domain:                              common.StringPtr(previousExecutionState.executionInfo.DomainID),
 		WorkflowId:                          common.StringPtr(execution.GetWorkflowId()),
 		TaskList:                            tl,
 		WorkflowType:                        wType,
 		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(decisionTimeout),
 		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(attributes.GetExecutionStartToCloseTimeoutSeconds()),
-		Input:    attributes.GetInput(),
-		Identity: nil,
+		Input:                 attributes.GetInput(),
+		Identity:              nil,
+		CompletionCallbackUrl: common.StringPtr(previousExecutionState.executionInfo.CompletionCallbackURL),
+		SearchAttributes:      searchAttributes,
 	}
 
-	return e.AddWorkflowExecutionStartedEvent(domainID, execution, createRequest)
+	return e.addWorkflowExecutionStartedEvent(domainID, execution, createRequest,
+		previousExecutionState.getFirstExecutionRunID(), previousExecutionState.getTraceID())
 }
 
+// AddWorkflowExecutionStartedEvent starts a new chain of executions, the execution is the first of its chain.  The
+// trace ID of the chain is the one of the request, else the run ID of the execution.
 func (e *mutableStateBuilder) AddWorkflowExecutionStartedEvent(domainID string, execution workflow.WorkflowExecution,
 	request *workflow.StartWorkflowExecutionRequest) *workflow.HistoryEvent {
+	traceID := request.GetTraceId()
+	if traceID == "" {
+		traceID = execution.GetRunId()
+	}
+	return e.addWorkflowExecutionStartedEvent(domainID, execution, request, execution.GetRunId(), traceID)
+}
+
+func (e *mutableStateBuilder) addWorkflowExecutionStartedEvent(domainID string, execution workflow.WorkflowExecution,
+	request *workflow.StartWorkflowExecutionRequest, firstExecutionRunID, traceID string) *workflow.HistoryEvent {
 	eventID := e.GetNextEventID()
 	if eventID != firstEventID {
 		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionWorkflowStarted, eventID, "")
@@ -445,8 +491,32 @@ func (e *mutableStateBuilder) AddWorkflowExecutionStartedEvent(domainID string,
 	e.executionInfo.DecisionStartedID = emptyEventID
 	e.executionInfo.DecisionRequestID = emptyUUID
 	e.executionInfo.DecisionTimeout = 0
+	e.executionInfo.CompletionCallbackURL = request.GetCompletionCallbackUrl()
+	e.executionInfo.FirstExecutionRunID = firstExecutionRunID
+	e.executionInfo.TraceID = traceID
+	if request.IsSetSearchAttributes() {
+		e.executionInfo.SearchAttributes = request.SearchAttributes.GetIndexedFields()
+	}
 
-	return e.hBuilder.AddWorkflowExecutionStartedEvent(request)
+	return e.hBuilder.AddWorkflowExecutionStartedEvent(request, firstExecutionRunID, traceID)
+}
+
+// getFirstExecutionRunID returns the run ID of the first execution of the chain of the execution
+func (e *mutableStateBuilder) getFirstExecutionRunID() string {
+	if e.executionInfo.FirstExecutionRunID == "" {
+		// the execution was created before the chains of executions were recorded
+		return e.executionInfo.RunID
+	}
+	return e.executionInfo.FirstExecutionRunID
+}
+
+// getTraceID returns the trace ID of the chain of the execution
+func (e *mutableStateBuilder) getTraceID() string {
+	if e.executionInfo.TraceID == "" {
+		// the execution was created before the trace IDs were recorded, its chain is traced by its first run ID
+		return e.getFirstExecutionRunID()
+	}
+	return e.executionInfo.TraceID
 }
 
 func (e *mutableStateBuilder) AddDecisionTaskScheduledEvent() (*workflow.HistoryEvent, *decisionInfo) {
@@ -740,8 +810,15 @@ func (e *mutableStateBuilder) AddFailWorkflowEvent(decisionCompletedEventID int6
 	return event
 }
 
+// isCancelRequested returns whether a cancellation of the execution was requested, and the ID of the request
+func (e *mutableStateBuilder) isCancelRequested() (bool, string) {
+	return e.executionInfo.CancelRequested, e.executionInfo.CancelRequestID
+}
+
 func (e *mutableStateBuilder) AddWorkflowExecutionCancelRequestedEvent(cause string,
 	request *h.RequestCancelWorkflowExecutionRequest) *workflow.HistoryEvent {
+	e.executionInfo.CancelRequested = true
+	e.executionInfo.CancelRequestID = request.GetCancelRequest().GetRequestId()
 	return e.hBuilder.AddWorkflowExecutionCancelRequestedEvent(cause, request)
 }
 
@@ -882,7 +959,30 @@ func (e *mutableStateBuilder) AddWorkflowExecutionSignaled(
 	return e.hBuilder.AddWorkflowExecutionSignaledEvent(request)
 }
 
-func (e *mutableStateBuilder) AddContinueAsNewEvent(decisionCompletedEventID int64, domainID, newRunID string,
+// isSignalRequested returns whether the signal request with the ID was already applied to the execution
+func (e *mutableStateBuilder) isSignalRequested(requestID string) bool {
+	_, ok := e.pendingSignalRequestedIDs[requestID]
+	return ok
+}
+
+// addSignalRequested records the ID of a signal request applied to the execution at the given time
+func (e *mutableStateBuilder) addSignalRequested(requestID string, now time.Time) {
+	e.pendingSignalRequestedIDs[requestID] = now
+	e.updateSignalRequestedIDs = append(e.updateSignalRequestedIDs, requestID)
+}
+
+// deleteSignalRequestedBefore deletes the IDs of the signal requests recorded before the expiry time, their retries
+// are no longer deduped.  The IDs recorded before their time was persisted have a zero time and are deleted first.
+func (e *mutableStateBuilder) deleteSignalRequestedBefore(expiry time.Time) {
+	for requestID, recorded := range e.pendingSignalRequestedIDs {
+		if recorded.Before(expiry) {
+			delete(e.pendingSignalRequestedIDs, requestID)
+			e.deleteSignalRequestedIDs = append(e.deleteSignalRequestedIDs, requestID)
+		}
+	}
+}
+
+func (e *mutableStateBuilder) AddContinueAsNewEvent(decisionCompletedEventID int64, domainID, newRunID, requestID string,
 	attributes *workflow.ContinueAsNewWorkflowExecutionDecisionAttributes) (*workflow.HistoryEvent, *mutableStateBuilder,
 	error) {
 	if e.hasPendingTasks() || e.HasPendingDecisionTask() {
@@ -899,8 +999,8 @@ func (e *mutableStateBuilder) AddContinueAsNewEvent(decisionCompletedEventID int
 	}
 
 	newStateBuilder := newMutableStateBuilder(e.logger)
-	startedEvent := newStateBuilder.AddWorkflowExecutionStartedEventForContinueAsNew(domainID, newExecution, e,
-		attributes)
+	startedEvent := newStateBuilder.AddWorkflowExecutionStartedEventForContinueAsNew(domainID, requestID, newExecution,
+		e, attributes)
 	if startedEvent == nil {
 		return nil, nil, &workflow.InternalServiceError{Message: "Failed to add workflow execution started event."}
 	}
@@ -923,7 +1023,7 @@ func (e *mutableStateBuilder) AddContinueAsNewEvent(decisionCompletedEventID int
 	}
 
 	e.continueAsNew = &persistence.CreateWorkflowExecutionRequest{
-		RequestID:            uuid.New(),
+		RequestID:            requestID,
 		DomainID:             domainID,
 		Execution:            newExecution,
 		ParentDomainID:       parentDomainID,
@@ -942,6 +1042,10 @@ func (e *mutableStateBuilder) AddContinueAsNewEvent(decisionCompletedEventID int
 		DecisionStartedID:           di.StartedID,
 		DecisionStartToCloseTimeout: di.DecisionTimeout,
 		ContinueAsNew:               true,
+		CompletionCallbackURL:       e.executionInfo.CompletionCallbackURL,
+		FirstExecutionRunID:         newStateBuilder.executionInfo.FirstExecutionRunID,
+		TraceID:                     newStateBuilder.executionInfo.TraceID,
+		SearchAttributes:            newStateBuilder.executionInfo.SearchAttributes,
 	}
 
 	return e.hBuilder.AddContinuedAsNewEvent(decisionCompletedEventID, newRunID, attributes), newStateBuilder, nil
@@ -0,0 +1,110 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"sort"
+)
+
+type (
+	// queueAckTracker keeps track of the tasks read from a task queue of a shard until they are complete.  The read
+	// level is the last task read, and the ack level the last task before which every task read is complete, below
+	// which the tasks of the queue can be deleted and are not read again.  It is shared by the ack managers of the
+	// transfer and the timer queue processors, whose tasks are ordered by sequence ID, the transfer tasks having no
+	// visibility timestamp.  It is not safe for concurrent use, the ack managers lock around it.
+	queueAckTracker struct {
+		outstandingTasks map[SequenceID]bool
+		readLevel        SequenceID
+		ackLevel         SequenceID
+	}
+)
+
+func newQueueAckTracker(ackLevel SequenceID) *queueAckTracker {
+	return &queueAckTracker{
+		outstandingTasks: make(map[SequenceID]bool),
+		readLevel:        ackLevel,
+		ackLevel:         ackLevel,
+	}
+}
+
+func (q *queueAckTracker) getReadLevel() SequenceID {
+	return q.readLevel
+}
+
+func (q *queueAckTracker) getAckLevel() SequenceID {
+	return q.ackLevel
+}
+
+func (q *queueAckTracker) isOutstanding(taskID SequenceID) bool {
+	_, ok := q.outstandingTasks[taskID]
+	return ok
+}
+
+// addTask records a task read from the queue and moves the read level to it.  A task already outstanding, read again,
+// is left as is.
+func (q *queueAckTracker) addTask(taskID SequenceID) {
+	q.readLevel = taskID
+	if _, ok := q.outstandingTasks[taskID]; !ok {
+		q.outstandingTasks[taskID] = false
+	}
+}
+
+// completeTask marks an outstanding task as complete, a task which is not outstanding, either unknown or already
+// behind the ack level, is ignored
+func (q *queueAckTracker) completeTask(taskID SequenceID) {
+	if _, ok := q.outstandingTasks[taskID]; ok {
+		q.outstandingTasks[taskID] = true
+	}
+}
+
+// nextAckLevel returns the level the ack level can move to: the last of the outstanding tasks which are complete
+// along with every outstanding task before them, or the current ack level if the first one is not complete
+func (q *queueAckTracker) nextAckLevel() SequenceID {
+	taskIDs := make(timerTaskIDs, 0, len(q.outstandingTasks))
+	for taskID := range q.outstandingTasks {
+		taskIDs = append(taskIDs, taskID)
+	}
+	sort.Sort(taskIDs)
+
+	ackLevel := q.ackLevel
+	for i := range taskIDs {
+		if !q.outstandingTasks[taskIDs[i]] {
+			break
+		}
+		if compareTimerIDLess(&ackLevel, &taskIDs[i]) {
+			ackLevel = taskIDs[i]
+		}
+	}
+	return ackLevel
+}
+
+// moveAckLevel moves the ack level forward to the given level and forgets the outstanding tasks up to it
+func (q *queueAckTracker) moveAckLevel(ackLevel SequenceID) {
+	if !compareTimerIDLess(&q.ackLevel, &ackLevel) {
+		return
+	}
+	for taskID := range q.outstandingTasks {
+		if !compareTimerIDLess(&ackLevel, &taskID) {
+			delete(q.outstandingTasks, taskID)
+		}
+	}
+	q.ackLevel = ackLevel
+}
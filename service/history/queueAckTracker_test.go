@@ -0,0 +1,154 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	queueAckTrackerSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+	}
+)
+
+func TestQueueAckTrackerSuite(t *testing.T) {
+	s := new(queueAckTrackerSuite)
+	suite.Run(t, s)
+}
+
+func (s *queueAckTrackerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *queueAckTrackerSuite) TestNextAckLevel() {
+	testCases := []struct {
+		name      string
+		ackLevel  int64
+		read      []int64
+		completed []int64
+		expected  int64
+	}{
+		{name: "nothing read", ackLevel: 5, expected: 5},
+		{name: "nothing completed", ackLevel: 5, read: []int64{6, 7}, expected: 5},
+		{name: "in order completion", read: []int64{1, 2, 3}, completed: []int64{1, 2, 3}, expected: 3},
+		{name: "partial completion", read: []int64{1, 2, 3}, completed: []int64{1, 2}, expected: 2},
+		{name: "out of order completion", read: []int64{1, 2, 3}, completed: []int64{3, 2}, expected: 0},
+		{name: "out of order full completion", read: []int64{1, 2, 3}, completed: []int64{3, 1, 2}, expected: 3},
+		{name: "holes in task IDs", ackLevel: 1, read: []int64{4, 9, 12}, completed: []int64{9, 4}, expected: 9},
+		{name: "retried completion", read: []int64{1, 2}, completed: []int64{1, 1, 1}, expected: 1},
+		{name: "unknown task completed", read: []int64{1, 2}, completed: []int64{2, 7}, expected: 0},
+		{name: "task read again", read: []int64{1, 2, 2}, completed: []int64{1, 2}, expected: 2},
+	}
+
+	for _, tc := range testCases {
+		tracker := newQueueAckTracker(transferTaskSequenceID(tc.ackLevel))
+		for _, taskID := range tc.read {
+			tracker.addTask(transferTaskSequenceID(taskID))
+		}
+		for _, taskID := range tc.completed {
+			tracker.completeTask(transferTaskSequenceID(taskID))
+		}
+		s.Equal(transferTaskSequenceID(tc.expected), tracker.nextAckLevel(), tc.name)
+		s.Equal(transferTaskSequenceID(tc.ackLevel), tracker.getAckLevel(), tc.name)
+	}
+}
+
+func (s *queueAckTrackerSuite) TestReadLevel() {
+	tracker := newQueueAckTracker(transferTaskSequenceID(10))
+	s.Equal(transferTaskSequenceID(10), tracker.getReadLevel())
+
+	tracker.addTask(transferTaskSequenceID(11))
+	tracker.addTask(transferTaskSequenceID(15))
+	s.Equal(transferTaskSequenceID(15), tracker.getReadLevel())
+	s.True(tracker.isOutstanding(transferTaskSequenceID(11)))
+	s.True(tracker.isOutstanding(transferTaskSequenceID(15)))
+	s.False(tracker.isOutstanding(transferTaskSequenceID(12)))
+
+	// completing tasks moves the ack level, never the read level
+	tracker.completeTask(transferTaskSequenceID(11))
+	tracker.completeTask(transferTaskSequenceID(15))
+	tracker.moveAckLevel(tracker.nextAckLevel())
+	s.Equal(transferTaskSequenceID(15), tracker.getReadLevel())
+	s.Equal(transferTaskSequenceID(15), tracker.getAckLevel())
+}
+
+func (s *queueAckTrackerSuite) TestMoveAckLevel() {
+	tracker := newQueueAckTracker(transferTaskSequenceID(0))
+	for taskID := int64(1); taskID <= 4; taskID++ {
+		tracker.addTask(transferTaskSequenceID(taskID))
+	}
+	tracker.completeTask(transferTaskSequenceID(1))
+	tracker.completeTask(transferTaskSequenceID(2))
+	tracker.completeTask(transferTaskSequenceID(4))
+
+	// the ack level is only moved when told so, e.g. once the complete tasks are deleted from the queue
+	s.Equal(transferTaskSequenceID(2), tracker.nextAckLevel())
+	s.Equal(transferTaskSequenceID(0), tracker.getAckLevel())
+
+	tracker.moveAckLevel(transferTaskSequenceID(2))
+	s.Equal(transferTaskSequenceID(2), tracker.getAckLevel())
+	s.False(tracker.isOutstanding(transferTaskSequenceID(1)))
+	s.False(tracker.isOutstanding(transferTaskSequenceID(2)))
+	s.True(tracker.isOutstanding(transferTaskSequenceID(3)))
+
+	// the ack level never moves back, and a completion retried behind it is ignored
+	tracker.moveAckLevel(transferTaskSequenceID(1))
+	s.Equal(transferTaskSequenceID(2), tracker.getAckLevel())
+	tracker.completeTask(transferTaskSequenceID(2))
+	s.False(tracker.isOutstanding(transferTaskSequenceID(2)))
+	s.Equal(transferTaskSequenceID(2), tracker.nextAckLevel())
+
+	tracker.completeTask(transferTaskSequenceID(3))
+	tracker.moveAckLevel(tracker.nextAckLevel())
+	s.Equal(transferTaskSequenceID(4), tracker.getAckLevel())
+	s.False(tracker.isOutstanding(transferTaskSequenceID(4)))
+}
+
+func (s *queueAckTrackerSuite) TestTimerSequenceIDs() {
+	now := time.Now()
+	timer := func(offset time.Duration, taskID int64) SequenceID {
+		return SequenceID{VisibilityTimestamp: now.Add(offset), TaskID: taskID}
+	}
+
+	tracker := newQueueAckTracker(SequenceID{VisibilityTimestamp: now})
+	tracker.addTask(timer(time.Second, 7))
+	tracker.addTask(timer(time.Second, 9))
+	tracker.addTask(timer(2*time.Second, 3))
+
+	// timers are ordered by visibility timestamp, then by task ID
+	tracker.completeTask(timer(time.Second, 7))
+	tracker.completeTask(timer(2*time.Second, 3))
+	s.Equal(timer(time.Second, 7), tracker.nextAckLevel())
+
+	tracker.completeTask(timer(time.Second, 9))
+	tracker.moveAckLevel(tracker.nextAckLevel())
+	s.Equal(timer(2*time.Second, 3), tracker.getAckLevel())
+	s.Equal(timer(2*time.Second, 3), tracker.getReadLevel())
+	s.False(tracker.isOutstanding(timer(time.Second, 9)))
+}
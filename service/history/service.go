@@ -24,6 +24,7 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/searchattribute"
 	"github.com/uber/cadence/common/service"
 )
 
@@ -47,6 +48,7 @@ func (s *Service) Start() {
 
 	var p = s.params
 	var log = p.Logger
+	var svcCfg = &p.ServiceConfig
 
 	log.Infof("%v starting", common.HistoryServiceName)
 
@@ -54,11 +56,20 @@ func (s *Service) Start() {
 
 	s.metricsClient = base.GetMetricsClient()
 
-	shardMgr, err := persistence.NewCassandraShardPersistence(p.CassandraConfig.Hosts,
-		p.CassandraConfig.Datacenter,
-		p.CassandraConfig.Keyspace,
-		p.Logger)
+	payloadCodec, err := p.PersistenceConfig.PayloadCodec.NewCodec()
+	if err != nil {
+		log.Fatalf("failed to create payload codec: %v", err)
+	}
+	payloadBlobs, err := p.PersistenceConfig.BlobStore.NewPayloadBlobStore()
+	if err != nil {
+		log.Fatalf("failed to create payload blob store: %v", err)
+	}
+	historyArchive, err := p.PersistenceConfig.Archival.NewHistoryArchive(payloadCodec)
+	if err != nil {
+		log.Fatalf("failed to create history archive: %v", err)
+	}
 
+	shardMgr, err := p.PersistenceFactory.CreateShardManager()
 	if err != nil {
 		log.Fatalf("failed to create shard manager: %v", err)
 	}
@@ -81,36 +92,37 @@ func (s *Service) Start() {
 		}
 	}
 
-	metadata, err := persistence.NewCassandraMetadataPersistence(p.CassandraConfig.Hosts,
-		p.CassandraConfig.Datacenter,
-		p.CassandraConfig.Keyspace,
-		p.Logger)
-
+	metadata, err := p.PersistenceFactory.CreateMetadataManager()
 	if err != nil {
 		log.Fatalf("failed to create metadata manager: %v", err)
 	}
 	metadata = persistence.NewMetadataPersistenceClient(metadata, base.GetMetricsClient())
 
-	visibility, err := persistence.NewCassandraVisibilityPersistence(p.CassandraConfig.Hosts,
-		p.CassandraConfig.Datacenter,
-		p.CassandraConfig.VisibilityKeyspace,
-		p.Logger)
-
+	visibility, err := p.PersistenceFactory.CreateVisibilityManager()
 	if err != nil {
 		log.Fatalf("failed to create visiblity manager: %v", err)
 	}
 
-	history, err := persistence.NewCassandraHistoryPersistence(p.CassandraConfig.Hosts,
-		p.CassandraConfig.Datacenter,
-		p.CassandraConfig.Keyspace,
-		p.Logger)
-
+	history, err := p.PersistenceFactory.CreateHistoryManager()
 	if err != nil {
-		log.Fatalf("Creating Cassandra history manager persistence failed: %v", err)
+		log.Fatalf("Creating history manager persistence failed: %v", err)
 	}
 
 	history = persistence.NewHistoryPersistenceClient(history, base.GetMetricsClient())
-	execMgrFactory := NewExecutionManagerFactory(&p.CassandraConfig, p.Logger, base.GetMetricsClient())
+	if payloadCodec != nil || payloadBlobs != nil {
+		history = persistence.NewHistoryPayloadClient(history, payloadCodec, payloadBlobs)
+	}
+	execMgrFactory := NewExecutionManagerFactory(p.PersistenceFactory, p.ShadowPersistenceFactory,
+		&p.PersistenceConfig, payloadCodec, payloadBlobs, p.Logger, base.GetMetricsClient())
+
+	searchAttributes, err := searchattribute.NewRegistryFromConfig(p.SearchAttributes)
+	if err != nil {
+		log.Fatalf("invalid search attributes: %v", err)
+	}
+	hSerializerFactory, err := svcCfg.HistoryCompression.NewSerializerFactory()
+	if err != nil {
+		log.Fatalf("failed to create history serializer: %v", err)
+	}
 
 	handler, tchanServers := NewHandler(base,
 		shardMgr,
@@ -118,7 +130,27 @@ func (s *Service) Start() {
 		visibility,
 		history,
 		execMgrFactory,
-		p.CassandraConfig.NumHistoryShards)
+		p.CassandraConfig.NumHistoryShards,
+		&HandlerOptions{
+			LockHoldThreshold:        svcCfg.LockMonitor.HoldThreshold,
+			IDGenerator:              svcCfg.IDGenerator.NewGenerator(),
+			HistorySerializerFactory: hSerializerFactory,
+			HistoryCacheTTL:          svcCfg.HistoryCache.TTL,
+			CloseCleanupDelay:        svcCfg.CloseCleanup.Delay,
+			TaskProcessingPause:      svcCfg.TaskProcessingPause,
+			TimeSkew:                 svcCfg.TimeSkew,
+			CompletionCallback:       svcCfg.CompletionCallback,
+			AsyncHistoryAppend:       svcCfg.AsyncHistoryAppend,
+			ExecutionScanner:         svcCfg.ExecutionScanner,
+			HistoryArchive:           historyArchive,
+			TimeoutCaps:              svcCfg.TimeoutCaps,
+			HotWorkflow:              svcCfg.HotWorkflow,
+			SignalDedupWindow:        svcCfg.SignalDedup.Window,
+			RateLimit:                svcCfg.RateLimit.RPS,
+			WorkflowTypeMetrics:      svcCfg.WorkflowTypeMetrics,
+			LoadShedding:             svcCfg.LoadShedding,
+			SearchAttributes:         searchAttributes,
+		})
 
 	handler.Start(tchanServers)
 
@@ -22,14 +22,17 @@ package history
 
 import (
 	"fmt"
-	"sync"
+	"strconv"
 	"sync/atomic"
 	"time"
 
 	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/locks"
 	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/config"
 
 	"github.com/uber-common/bark"
 )
@@ -41,6 +44,7 @@ const (
 type (
 	// ShardContext represents a history engine shard
 	ShardContext interface {
+		GetShardID() int
 		GetExecutionManager() persistence.ExecutionManager
 		GetHistoryManager() persistence.HistoryManager
 		GetNextTransferTaskID() (int64, error)
@@ -50,27 +54,48 @@ type (
 		UpdateTransferAckLevel(ackLevel int64) error
 		CreateWorkflowExecution(request *persistence.CreateWorkflowExecutionRequest) (
 			*persistence.CreateWorkflowExecutionResponse, error)
-		UpdateWorkflowExecution(request *persistence.UpdateWorkflowExecutionRequest) error
+		UpdateWorkflowExecution(request *persistence.UpdateWorkflowExecutionRequest) (
+			*persistence.UpdateWorkflowExecutionResponse, error)
 		AppendHistoryEvents(request *persistence.AppendHistoryEventsRequest) error
+		AppendHistoryEventsBatch(requests []*persistence.AppendHistoryEventsRequest) error
 		GetLogger() bark.Logger
 		GetMetricsClient() metrics.Client
 		GetTimerAckLevel() time.Time
 		UpdateTimerAckLevel(ackLevel time.Time) error
+		GetRangeID() int64
+		// GetRangeRemainingTaskIDs returns the number of task IDs which can still be allocated before the range of
+		// the shard has to be renewed
+		GetRangeRemainingTaskIDs() int64
+		// GetTimeSinceAcquired returns the time elapsed since the shard was acquired by this host
+		GetTimeSinceAcquired() time.Duration
+		// GetTimeSource returns the source of the current time used for the timers of the shard
+		GetTimeSource() common.TimeSource
+		// ExecuteWithRangeID runs a persistence operation conditioned on the range ID of the shard, which it passes
+		// to the operation to stamp on its request
+		ExecuteWithRangeID(operation func(rangeID int64) error) error
+		GetLockMonitor() *locks.Monitor
+		GetHistorySerializer() persistence.HistorySerializer
 	}
 
 	shardContextImpl struct {
-		shardID          int
-		rangeID          int64
-		shardManager     persistence.ShardManager
-		historyMgr       persistence.HistoryManager
-		executionManager persistence.ExecutionManager
-		rangeSize        uint
-		closeCh          chan<- int
-		isClosed         bool
-		logger           bark.Logger
-		metricsClient    metrics.Client
-
-		sync.RWMutex
+		shardID           int
+		rangeID           int64
+		shardManager      persistence.ShardManager
+		historyMgr        persistence.HistoryManager
+		executionManager  persistence.ExecutionManager
+		rangeSize         uint
+		closeCh           chan<- int
+		isClosed          bool
+		logger            bark.Logger
+		metricsClient     metrics.Client
+		lockMonitor       *locks.Monitor
+		historySerializer persistence.HistorySerializer
+		timeSource        common.TimeSource
+		acquiredTime      time.Time
+		// historyAppender groups the history appends of the shard, nil when they are written one by one
+		historyAppender *historyAppender
+
+		locks.RWMutex
 		shardInfo                 *persistence.ShardInfo
 		transferSequenceNumber    int64
 		maxTransferSequenceNumber int64
@@ -160,49 +185,19 @@ func (s *shardContextImpl) CreateWorkflowExecution(request *persistence.CreateWo
 
 	s.allocateTimerIDsLocked(request.TimerTasks)
 
-Create_Loop:
-	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
-		currentRangeID := s.getRangeID()
-		request.RangeID = currentRangeID
-		response, err := s.executionManager.CreateWorkflowExecution(request)
-		if err != nil {
-			switch err.(type) {
-			case *persistence.ShardOwnershipLostError:
-				{
-					// RangeID might have been renewed by the same host while this update was in flight
-					// Retry the operation if we still have the shard ownership
-					if currentRangeID != s.getRangeID() {
-						continue Create_Loop
-					} else {
-						// Shard is stolen, trigger shutdown of history engine
-						s.closeShard()
-					}
-				}
-			case *shared.WorkflowExecutionAlreadyStartedError:
-			default:
-				{
-					// We have no idea if the write failed or will eventually make it to
-					// persistence. Increment RangeID to guarantee that subsequent reads
-					// will either see that write, or know for certain that it failed.
-					// This allows the callers to reliably check the outcome by performing
-					// a read.
-					err1 := s.renewRangeLocked(false)
-					if err1 != nil {
-						// At this point we have no choice but to unload the shard, so that it
-						// gets a new RangeID when it's reloaded.
-						s.closeShard()
-					}
-				}
-			}
-		}
-
-		return response, err
-	}
+	var response *persistence.CreateWorkflowExecutionResponse
+	err := s.executeWithRangeIDLocked(func(rangeID int64) error {
+		request.RangeID = rangeID
+		var err error
+		response, err = s.executionManager.CreateWorkflowExecution(request)
+		return err
+	})
 
-	return nil, ErrMaxAttemptsExceeded
+	return response, err
 }
 
-func (s *shardContextImpl) UpdateWorkflowExecution(request *persistence.UpdateWorkflowExecutionRequest) error {
+func (s *shardContextImpl) UpdateWorkflowExecution(request *persistence.UpdateWorkflowExecutionRequest) (
+	*persistence.UpdateWorkflowExecutionResponse, error) {
 	s.Lock()
 	defer s.Unlock()
 
@@ -213,7 +208,7 @@ func (s *shardContextImpl) UpdateWorkflowExecution(request *persistence.UpdateWo
 	for _, task := range request.TransferTasks {
 		id, err := s.getNextTransferTaskIDLocked()
 		if err != nil {
-			return err
+			return nil, err
 		}
 		s.logger.Debugf("Assigning transfer task ID: %v", id)
 		task.SetTaskID(id)
@@ -224,7 +219,7 @@ func (s *shardContextImpl) UpdateWorkflowExecution(request *persistence.UpdateWo
 		for _, task := range request.ContinueAsNew.TransferTasks {
 			id, err := s.getNextTransferTaskIDLocked()
 			if err != nil {
-				return err
+				return nil, err
 			}
 			s.logger.Debugf("Assigning transfer task ID: %v", id)
 			task.SetTaskID(id)
@@ -234,26 +229,50 @@ func (s *shardContextImpl) UpdateWorkflowExecution(request *persistence.UpdateWo
 	defer s.updateMaxReadLevelLocked(transferMaxReadLevel)
 
 	s.allocateTimerIDsLocked(request.TimerTasks)
+	if request.ContinueAsNew != nil {
+		s.allocateTimerIDsLocked(request.ContinueAsNew.TimerTasks)
+	}
+
+	var response *persistence.UpdateWorkflowExecutionResponse
+	err := s.executeWithRangeIDLocked(func(rangeID int64) error {
+		request.RangeID = rangeID
+		var err error
+		response, err = s.executionManager.UpdateWorkflowExecution(request)
+		return err
+	})
+
+	return response, err
+}
 
-Update_Loop:
+func (s *shardContextImpl) ExecuteWithRangeID(operation func(rangeID int64) error) error {
+	s.Lock()
+	defer s.Unlock()
+
+	return s.executeWithRangeIDLocked(operation)
+}
+
+// executeWithRangeIDLocked retries the operation while the range is renewed under it by this host.  Errors which do
+// not tell whether the write made it to persistence renew the range, those of a failed condition are returned as is.
+func (s *shardContextImpl) executeWithRangeIDLocked(operation func(rangeID int64) error) error {
+Execute_Loop:
 	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
-		currentRangeID := s.getRangeID()
-		request.RangeID = currentRangeID
-		err := s.executionManager.UpdateWorkflowExecution(request)
+		currentRangeID := s.GetRangeID()
+		err := operation(currentRangeID)
 		if err != nil {
 			switch err.(type) {
 			case *persistence.ShardOwnershipLostError:
 				{
-					// RangeID might have been renewed by the same host while this update was in flight
+					// RangeID might have been renewed by the same host while this operation was in flight
 					// Retry the operation if we still have the shard ownership
-					if currentRangeID != s.getRangeID() {
-						continue Update_Loop
+					if currentRangeID != s.GetRangeID() {
+						continue Execute_Loop
 					} else {
 						// Shard is stolen, trigger shutdown of history engine
 						s.closeShard()
 					}
 				}
-			case *persistence.ConditionFailedError:
+			case *persistence.ConditionFailedError, *persistence.CurrentWorkflowConditionFailedError,
+				*shared.WorkflowExecutionAlreadyStartedError:
 			default:
 				{
 					// We have no idea if the write failed or will eventually make it to
@@ -278,6 +297,20 @@ Update_Loop:
 }
 
 func (s *shardContextImpl) AppendHistoryEvents(request *persistence.AppendHistoryEventsRequest) error {
+	if s.historyAppender != nil {
+		return s.historyAppender.append([]*persistence.AppendHistoryEventsRequest{request})
+	}
+	return s.appendHistoryEvents(request)
+}
+
+func (s *shardContextImpl) AppendHistoryEventsBatch(requests []*persistence.AppendHistoryEventsRequest) error {
+	if s.historyAppender != nil {
+		return s.historyAppender.append(requests)
+	}
+	return firstAppendError(s.appendHistoryEventsBatch(requests))
+}
+
+func (s *shardContextImpl) appendHistoryEvents(request *persistence.AppendHistoryEventsRequest) error {
 	// No need to lock context here, as we can write concurrently to append history events
 	currentRangeID := atomic.LoadInt64(&s.rangeID)
 	request.RangeID = currentRangeID
@@ -293,18 +326,85 @@ func (s *shardContextImpl) AppendHistoryEvents(request *persistence.AppendHistor
 	return err0
 }
 
+func (s *shardContextImpl) appendHistoryEventsBatch(requests []*persistence.AppendHistoryEventsRequest) error {
+	if len(requests) == 1 {
+		return s.appendHistoryEvents(requests[0])
+	}
+
+	currentRangeID := atomic.LoadInt64(&s.rangeID)
+	for _, request := range requests {
+		request.RangeID = currentRangeID
+	}
+	err0 := s.historyMgr.AppendHistoryEventsBatch(&persistence.AppendHistoryEventsBatchRequest{Requests: requests})
+	switch err := err0.(type) {
+	case *persistence.ConditionFailedError:
+		// It is not known which of the batches failed to insert, so append them one by one to overwrite the
+		// tail where needed
+		for _, request := range requests {
+			if err1 := s.appendHistoryEvents(request); err1 != nil {
+				return err1
+			}
+		}
+		return nil
+	case *persistence.PartialAppendError:
+		// Only the batches of the executions which failed are appended again, one by one to overwrite the tail
+		// where needed, those of the other executions are appended
+		errs := make([]error, len(requests))
+		failed := false
+		for i, request := range requests {
+			if err.Errors[i] != nil {
+				errs[i] = s.appendHistoryEvents(request)
+				failed = failed || errs[i] != nil
+			}
+		}
+		if !failed {
+			return nil
+		}
+		return &persistence.PartialAppendError{Msg: err.Msg, Errors: errs}
+	}
+
+	return err0
+}
+
 func (s *shardContextImpl) GetLogger() bark.Logger {
 	return s.logger
 }
 
+func (s *shardContextImpl) GetLockMonitor() *locks.Monitor {
+	return s.lockMonitor
+}
+
 func (s *shardContextImpl) GetMetricsClient() metrics.Client {
 	return s.metricsClient
 }
 
-func (s *shardContextImpl) getRangeID() int64 {
+func (s *shardContextImpl) GetHistorySerializer() persistence.HistorySerializer {
+	return s.historySerializer
+}
+
+func (s *shardContextImpl) GetShardID() int {
+	return s.shardID
+}
+
+func (s *shardContextImpl) GetRangeID() int64 {
 	return s.shardInfo.RangeID
 }
 
+func (s *shardContextImpl) GetRangeRemainingTaskIDs() int64 {
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.maxTransferSequenceNumber - s.transferSequenceNumber
+}
+
+func (s *shardContextImpl) GetTimeSinceAcquired() time.Duration {
+	return time.Since(s.acquiredTime)
+}
+
+func (s *shardContextImpl) GetTimeSource() common.TimeSource {
+	return s.timeSource
+}
+
 func (s *shardContextImpl) closeShard() {
 	if s.isClosed {
 		return
@@ -427,7 +527,8 @@ func (s *shardContextImpl) allocateTimerIDsLocked(timerTasks []persistence.Task)
 // TODO: This method has too many parameters.  Clean it up.  Maybe create a struct to pass in as parameter.
 func acquireShard(shardID int, shardManager persistence.ShardManager, historyMgr persistence.HistoryManager,
 	executionMgr persistence.ExecutionManager, owner string, closeCh chan<- int, logger bark.Logger,
-	reporter metrics.Client) (ShardContext, error) {
+	reporter metrics.Client, lockMonitor *locks.Monitor, historySerializer persistence.HistorySerializer,
+	historyAppend config.AsyncHistoryAppend) (ShardContext, error) {
 	response, err0 := shardManager.GetShard(&persistence.GetShardRequest{ShardID: shardID})
 	if err0 != nil {
 		return nil, err0
@@ -437,26 +538,35 @@ func acquireShard(shardID int, shardManager persistence.ShardManager, historyMgr
 	updatedShardInfo := copyShardInfo(shardInfo)
 	updatedShardInfo.Owner = owner
 	context := &shardContextImpl{
-		shardID:          shardID,
-		shardManager:     shardManager,
-		historyMgr:       historyMgr,
-		executionManager: executionMgr,
-		shardInfo:        updatedShardInfo,
-		rangeSize:        defaultRangeSize,
-		closeCh:          closeCh,
+		shardID:           shardID,
+		shardManager:      shardManager,
+		historyMgr:        historyMgr,
+		executionManager:  executionMgr,
+		shardInfo:         updatedShardInfo,
+		rangeSize:         defaultRangeSize,
+		closeCh:           closeCh,
+		lockMonitor:       lockMonitor,
+		historySerializer: historySerializer,
+		timeSource:        common.NewRealTimeSource(),
 	}
+	context.SetMonitor(lockMonitor, metrics.HistoryShardLockScope, fmt.Sprintf("shard-%v", shardID))
 	context.logger = logger.WithFields(bark.Fields{
 		logging.TagHistoryShardID: shardID,
 	})
 	tags := map[string]string{
-		metrics.ShardTagName: string(shardID),
+		metrics.ShardTagName: strconv.Itoa(shardID),
 	}
 	context.metricsClient = reporter.Tagged(tags)
+	if historyAppend.Enabled {
+		context.historyAppender = newHistoryAppender(historyAppend, context.appendHistoryEventsBatch,
+			context.metricsClient)
+	}
 
 	err1 := context.renewRangeLocked(true)
 	if err1 != nil {
 		return nil, err1
 	}
+	context.acquiredTime = time.Now()
 
 	return context, nil
 }
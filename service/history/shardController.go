@@ -28,11 +28,14 @@ import (
 
 	"github.com/uber-common/bark"
 
+	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/locks"
 	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/config"
 )
 
 const (
@@ -40,6 +43,10 @@ const (
 	shardControllerMembershipUpdateListenerName = "ShardController"
 )
 
+// errShardNotLoaded is returned for a shard owned by this host which is not acquired yet, the shard management pump
+// acquires it shortly
+var errShardNotLoaded = &workflow.ServiceBusyError{Message: "Shard is not loaded on the history host yet."}
+
 type (
 	shardController struct {
 		numberOfShards      int
@@ -58,6 +65,10 @@ type (
 		shutdownCh          chan struct{}
 		logger              bark.Logger
 		metricsClient       metrics.Client
+		lockMonitor         *locks.Monitor
+		historySerializer   persistence.HistorySerializer
+		historyAppend       config.AsyncHistoryAppend
+		taskPauses          *taskProcessingPauses
 
 		sync.RWMutex
 		historyShards map[int]*historyShardsItem
@@ -65,19 +76,34 @@ type (
 	}
 
 	historyShardsItem struct {
-		shardID       int
-		shardMgr      persistence.ShardManager
-		historyMgr    persistence.HistoryManager
-		executionMgr  persistence.ExecutionManager
-		engineFactory EngineFactory
-		host          *membership.HostInfo
-		logger        bark.Logger
-		metricsClient metrics.Client
+		shardID           int
+		shardMgr          persistence.ShardManager
+		historyMgr        persistence.HistoryManager
+		executionMgr      persistence.ExecutionManager
+		engineFactory     EngineFactory
+		host              *membership.HostInfo
+		logger            bark.Logger
+		metricsClient     metrics.Client
+		lockMonitor       *locks.Monitor
+		historySerializer persistence.HistorySerializer
+		historyAppend     config.AsyncHistoryAppend
 
 		sync.RWMutex
 		engine  Engine
 		context ShardContext
 	}
+
+	// shardWatermarks is a snapshot of the task ID watermarks and ack levels of a shard.  Timer tasks draw their IDs
+	// from the same sequence as transfer tasks, so maxTaskID covers both queues.
+	shardWatermarks struct {
+		shardID              int
+		owner                string
+		rangeID              int64
+		transferAckLevel     int64
+		transferMaxReadLevel int64
+		maxTaskID            int64
+		timerAckLevel        time.Time
+	}
 )
 
 func newShardController(numberOfShards int, host *membership.HostInfo, resolver membership.ServiceResolver,
@@ -106,7 +132,9 @@ func newShardController(numberOfShards int, host *membership.HostInfo, resolver
 
 func newHistoryShardsItem(shardID int, shardMgr persistence.ShardManager, historyMgr persistence.HistoryManager,
 	executionMgrFactory persistence.ExecutionManagerFactory, factory EngineFactory, host *membership.HostInfo,
-	logger bark.Logger, reporter metrics.Client) (*historyShardsItem, error) {
+	logger bark.Logger, reporter metrics.Client, lockMonitor *locks.Monitor,
+	historySerializer persistence.HistorySerializer, historyAppend config.AsyncHistoryAppend) (*historyShardsItem,
+	error) {
 
 	executionMgr, err := executionMgrFactory.CreateExecutionManager(shardID)
 	if err != nil {
@@ -123,7 +151,10 @@ func newHistoryShardsItem(shardID int, shardMgr persistence.ShardManager, histor
 		logger: logger.WithFields(bark.Fields{
 			logging.TagHistoryShardID: shardID,
 		}),
-		metricsClient: reporter,
+		metricsClient:     reporter,
+		lockMonitor:       lockMonitor,
+		historySerializer: historySerializer,
+		historyAppend:     historyAppend,
 	}, nil
 }
 
@@ -211,7 +242,7 @@ func (c *shardController) getOrCreateHistoryShardItem(shardID int) (*historyShar
 
 	if info.Identity() == c.host.Identity() {
 		shardItem, err := newHistoryShardsItem(shardID, c.shardMgr, c.historyMgr, c.executionMgrFactory, c.engineFactory, c.host,
-			c.logger, c.metricsClient)
+			c.logger, c.metricsClient, c.lockMonitor, c.historySerializer, c.historyAppend)
 		if err != nil {
 			return nil, err
 		}
@@ -257,6 +288,8 @@ func (c *shardController) shardManagementPump() {
 			return
 		case <-acquireTicker.C:
 			c.acquireShards()
+			c.emitShardWatermarks()
+			c.emitTaskProcessingPauses()
 		case changedEvent := <-c.membershipUpdateCh:
 			logging.LogRingMembershipChangedEvent(c.logger, c.host.Identity(), len(changedEvent.HostsAdded),
 				len(changedEvent.HostsRemoved), len(changedEvent.HostsUpdated))
@@ -290,6 +323,132 @@ AcquireLoop:
 	}
 }
 
+// getShardIDs returns the IDs of the shards loaded on this host
+func (c *shardController) getShardIDs() []int {
+	c.RLock()
+	defer c.RUnlock()
+
+	shardIDs := make([]int, 0, len(c.historyShards))
+	for shardID := range c.historyShards {
+		shardIDs = append(shardIDs, shardID)
+	}
+	return shardIDs
+}
+
+// getShardWatermarks returns the task ID watermarks of a shard loaded on this host.  Reading them never acquires the
+// shard, the caller of a shard which is not loaded is redirected to its owner.
+func (c *shardController) getShardWatermarks(shardID int) (*shardWatermarks, error) {
+	c.RLock()
+	item, ok := c.historyShards[shardID]
+	c.RUnlock()
+	if ok {
+		if context := item.getContext(); context != nil {
+			return newShardWatermarks(shardID, c.host.Identity(), context), nil
+		}
+	}
+
+	if err := c.checkShardOwnership(shardID); err != nil {
+		return nil, err
+	}
+	return nil, errShardNotLoaded
+}
+
+// getShardContext returns the context of a shard owned by this host, acquiring the shard if it is not loaded yet
+func (c *shardController) getShardContext(shardID int) (ShardContext, error) {
+	item, err := c.getOrCreateHistoryShardItem(shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := item.getOrCreateEngine(c.shardClosedCh); err != nil {
+		return nil, err
+	}
+
+	context := item.getContext()
+	if context == nil {
+		return nil, createShardOwnershipLostError(c.host.Identity(), "")
+	}
+	return context, nil
+}
+
+// unloadShard stops the engine of a shard owned by this host, dropping all state cached by the shard.  The shard is
+// acquired again from persistence by the next request or by the shard management pump.
+func (c *shardController) unloadShard(shardID int) error {
+	if err := c.checkShardOwnership(shardID); err != nil {
+		return err
+	}
+
+	c.removeEngineForShard(shardID)
+	return nil
+}
+
+// checkShardOwnership returns ShardOwnershipLostError, which redirects the caller, if the shard is owned by another host
+func (c *shardController) checkShardOwnership(shardID int) error {
+	info, err := c.hServiceResolver.Lookup(string(shardID))
+	if err != nil {
+		return err
+	}
+
+	if info.Identity() != c.host.Identity() {
+		return createShardOwnershipLostError(c.host.Identity(), info.GetAddress())
+	}
+	return nil
+}
+
+// emitTaskProcessingPauses reports the number of shards and domains whose task processing is paused on this host.  It
+// is reported periodically so that a forgotten pause stays visible.
+func (c *shardController) emitTaskProcessingPauses() {
+	if c.taskPauses == nil {
+		return
+	}
+
+	shards, domains := c.taskPauses.counts()
+	c.metricsClient.UpdateGauge(metrics.HistoryShardControllerScope, metrics.PausedShardsGauge, float64(shards))
+	c.metricsClient.UpdateGauge(metrics.HistoryShardControllerScope, metrics.PausedDomainsGauge, float64(domains))
+}
+
+// emitShardWatermarks reports the task ID watermarks of all shards loaded on this host as gauges, through the metrics
+// client of each shard which tags them with the shard ID
+func (c *shardController) emitShardWatermarks() {
+	c.RLock()
+	items := make([]*historyShardsItem, 0, len(c.historyShards))
+	for _, item := range c.historyShards {
+		items = append(items, item)
+	}
+	c.RUnlock()
+
+	now := time.Now()
+	for _, item := range items {
+		context := item.getContext()
+		if context == nil {
+			continue
+		}
+
+		w := newShardWatermarks(item.shardID, c.host.Identity(), context)
+		metricsClient := context.GetMetricsClient()
+		metricsClient.UpdateGauge(metrics.HistoryShardControllerScope, metrics.ShardTransferAckLevelGauge,
+			float64(w.transferAckLevel))
+		metricsClient.UpdateGauge(metrics.HistoryShardControllerScope, metrics.ShardTransferMaxReadLevelGauge,
+			float64(w.transferMaxReadLevel))
+		metricsClient.UpdateGauge(metrics.HistoryShardControllerScope, metrics.ShardMaxTaskIDGauge,
+			float64(w.maxTaskID))
+		metricsClient.UpdateGauge(metrics.HistoryShardControllerScope, metrics.ShardTimerAckLevelLagGauge,
+			float64(now.Sub(w.timerAckLevel)/time.Millisecond))
+	}
+}
+
+func newShardWatermarks(shardID int, owner string, context ShardContext) *shardWatermarks {
+	return &shardWatermarks{
+		shardID:              shardID,
+		owner:                owner,
+		rangeID:              context.GetRangeID(),
+		transferAckLevel:     context.GetTransferAckLevel(),
+		transferMaxReadLevel: context.GetTransferMaxReadLevel(),
+		maxTaskID:            context.GetTransferSequenceNumber(),
+		timerAckLevel:        context.GetTimerAckLevel(),
+	}
+}
+
 func (i *historyShardsItem) getEngine() Engine {
 	i.RLock()
 	defer i.RUnlock()
@@ -297,6 +456,13 @@ func (i *historyShardsItem) getEngine() Engine {
 	return i.engine
 }
 
+func (i *historyShardsItem) getContext() ShardContext {
+	i.RLock()
+	defer i.RUnlock()
+
+	return i.context
+}
+
 func (i *historyShardsItem) getOrCreateEngine(shardClosedCh chan<- int) (Engine, error) {
 	i.RLock()
 	if i.engine != nil {
@@ -315,11 +481,12 @@ func (i *historyShardsItem) getOrCreateEngine(shardClosedCh chan<- int) (Engine,
 	logging.LogShardEngineCreatingEvent(i.logger, i.host.Identity(), i.shardID)
 
 	context, err := acquireShard(i.shardID, i.shardMgr, i.historyMgr, i.executionMgr, i.host.Identity(), shardClosedCh,
-		i.logger, i.metricsClient)
+		i.logger, i.metricsClient, i.lockMonitor, i.historySerializer, i.historyAppend)
 	if err != nil {
 		return nil, err
 	}
 
+	i.context = context
 	i.engine = i.engineFactory.CreateEngine(context)
 	i.engine.Start()
 
@@ -335,6 +502,7 @@ func (i *historyShardsItem) stopEngine() {
 		logging.LogShardEngineStoppingEvent(i.logger, i.host.Identity(), i.shardID)
 		i.engine.Stop()
 		i.engine = nil
+		i.context = nil
 		i.executionMgr.Close()
 		logging.LogShardEngineStoppedEvent(i.logger, i.host.Identity(), i.shardID)
 	}
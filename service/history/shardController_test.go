@@ -22,6 +22,7 @@ package history
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	log "github.com/Sirupsen/logrus"
@@ -35,10 +36,12 @@ import (
 	"time"
 
 	"github.com/uber-go/tally"
+	hist "github.com/uber/cadence/.gen/go/history"
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/metrics"
 	mmocks "github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/config"
 )
 
 type (
@@ -142,6 +145,103 @@ func (s *shardControllerSuite) TestAcquireShardLookupFailure() {
 	}
 }
 
+func (s *shardControllerSuite) TestGetShardWatermarks() {
+	numShards := 2
+	s.controller.numberOfShards = numShards
+	timerAckLevel := time.Now().Add(-time.Minute)
+
+	scope := tally.NewTestScope("", nil)
+	s.controller.metricsClient = metrics.NewClient(scope, metrics.History)
+
+	shardID := 0
+	mockExecutionMgr := &mmocks.ExecutionManager{}
+	s.mockExecutionMgrFactory.On("CreateExecutionManager", mock.Anything).Return(mockExecutionMgr, nil).Once()
+	mockEngine := &MockHistoryEngine{}
+	mockEngine.On("Start").Return().Once()
+	s.mockServiceResolver.On("Lookup", string(shardID)).Return(s.hostInfo, nil).Once()
+	s.mockEngineFactory.On("CreateEngine", mock.Anything).Return(mockEngine).Once()
+	s.mockShardManager.On("GetShard", &persistence.GetShardRequest{ShardID: shardID}).Return(
+		&persistence.GetShardResponse{
+			ShardInfo: &persistence.ShardInfo{
+				ShardID:          shardID,
+				Owner:            s.hostInfo.Identity(),
+				RangeID:          5,
+				TransferAckLevel: 100,
+				TimerAckLevel:    timerAckLevel,
+			},
+		}, nil).Once()
+	s.mockShardManager.On("UpdateShard", mock.Anything).Return(nil).Once()
+
+	// Reading the watermarks does not acquire the shard
+	s.mockServiceResolver.On("Lookup", string(shardID)).Return(s.hostInfo, nil).Once()
+	_, err := s.controller.getShardWatermarks(shardID)
+	s.Equal(errShardNotLoaded, err)
+	_, err = s.controller.getEngineForShard(shardID)
+	s.Nil(err)
+
+	watermarks, err := s.controller.getShardWatermarks(shardID)
+	s.Nil(err)
+	s.Equal(shardID, watermarks.shardID)
+	s.Equal(s.hostInfo.Identity(), watermarks.owner)
+	s.Equal(int64(6), watermarks.rangeID)
+	s.Equal(int64(100), watermarks.transferAckLevel)
+	s.Equal(int64(6<<defaultRangeSize)-1, watermarks.transferMaxReadLevel)
+	s.Equal(int64(6<<defaultRangeSize)-1, watermarks.maxTaskID)
+	s.Equal(timerAckLevel, watermarks.timerAckLevel)
+
+	// The gauges are tagged with the ID of the shard
+	s.controller.emitShardWatermarks()
+	found := false
+	for key, gauge := range scope.Snapshot().Gauges() {
+		if strings.HasPrefix(key, "shard.transfer-ack-level+") {
+			s.Equal("0", gauge.Tags()[metrics.ShardTagName])
+			s.Equal(float64(100), gauge.Value())
+			found = true
+		}
+	}
+	s.True(found)
+
+	otherShardID := 1
+	s.mockServiceResolver.On("Lookup", string(otherShardID)).Return(
+		membership.NewHostInfo("test-watermarks-other-host", nil), nil).Once()
+	_, err = s.controller.getShardWatermarks(otherShardID)
+	s.IsType(&hist.ShardOwnershipLostError{}, err)
+}
+
+func (s *shardControllerSuite) TestShardExecuteWithRangeID() {
+	shardID := 0
+	s.mockShardManager.On("GetShard", &persistence.GetShardRequest{ShardID: shardID}).Return(
+		&persistence.GetShardResponse{
+			ShardInfo: &persistence.ShardInfo{ShardID: shardID, RangeID: 5},
+		}, nil).Once()
+	s.mockShardManager.On("UpdateShard", mock.Anything).Return(nil).Twice()
+
+	context, err := acquireShard(shardID, s.mockShardManager, s.mockHistoryMgr, &mmocks.ExecutionManager{},
+		s.hostInfo.Identity(), nil, s.logger, s.metricsClient, nil, persistence.NewJSONHistorySerializer(),
+		config.AsyncHistoryAppend{})
+	s.Nil(err)
+	s.Equal(int64(6), context.GetRangeID())
+	s.Equal(int64(1<<defaultRangeSize), context.GetRangeRemainingTaskIDs())
+	s.True(context.GetTimeSinceAcquired() < time.Minute)
+
+	var rangeIDs []int64
+	operation := func(err error) func(rangeID int64) error {
+		return func(rangeID int64) error {
+			rangeIDs = append(rangeIDs, rangeID)
+			return err
+		}
+	}
+
+	s.Nil(context.ExecuteWithRangeID(operation(nil)))
+	// A failed condition is a known outcome, the range is kept
+	s.IsType(&persistence.ConditionFailedError{},
+		context.ExecuteWithRangeID(operation(&persistence.ConditionFailedError{})))
+	// The outcome of the write is unknown, the range is renewed so that reads see it or know it failed
+	s.NotNil(context.ExecuteWithRangeID(operation(errors.New("timeout"))))
+	s.Nil(context.ExecuteWithRangeID(operation(nil)))
+	s.Equal([]int64{6, 6, 6, 7}, rangeIDs)
+}
+
 func (s *shardControllerSuite) TestAcquireShardRenewSuccess() {
 	numShards := 2
 	s.controller.numberOfShards = numShards
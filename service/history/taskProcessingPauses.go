@@ -0,0 +1,125 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// taskProcessingPauseCheckInterval is how often the processors check whether the paused tasks can be resumed
+	taskProcessingPauseCheckInterval = 10 * time.Second
+)
+
+const (
+	transferTaskQueue taskQueueType = iota
+	timerTaskQueue
+	numTaskQueueTypes
+)
+
+type (
+	// taskQueueType identifies the transfer or the timer task queue of a shard
+	taskQueueType int
+
+	// taskProcessingPauses tracks the shards and the domains whose transfer or timer tasks must not be processed.  It
+	// is shared by all the shards owned by the host, a nil instance pauses nothing.  The tasks of a paused shard are
+	// not read, while the tasks of a paused domain are read and parked by the processors without being completed, so
+	// the ack level of the shard does not move past them until processing is resumed.
+	taskProcessingPauses struct {
+		sync.RWMutex
+		shards  [numTaskQueueTypes]map[int]struct{}
+		domains [numTaskQueueTypes]map[string]struct{}
+	}
+)
+
+func newTaskProcessingPauses() *taskProcessingPauses {
+	p := &taskProcessingPauses{}
+	for queue := taskQueueType(0); queue < numTaskQueueTypes; queue++ {
+		p.shards[queue] = make(map[int]struct{})
+		p.domains[queue] = make(map[string]struct{})
+	}
+	return p
+}
+
+func (p *taskProcessingPauses) setShardPaused(queue taskQueueType, shardID int, paused bool) {
+	p.Lock()
+	defer p.Unlock()
+	if paused {
+		p.shards[queue][shardID] = struct{}{}
+	} else {
+		delete(p.shards[queue], shardID)
+	}
+}
+
+func (p *taskProcessingPauses) setDomainPaused(queue taskQueueType, domainID string, paused bool) {
+	p.Lock()
+	defer p.Unlock()
+	if paused {
+		p.domains[queue][domainID] = struct{}{}
+	} else {
+		delete(p.domains[queue], domainID)
+	}
+}
+
+func (p *taskProcessingPauses) isShardPaused(queue taskQueueType, shardID int) bool {
+	if p == nil {
+		return false
+	}
+
+	p.RLock()
+	defer p.RUnlock()
+	_, ok := p.shards[queue][shardID]
+	return ok
+}
+
+// isTaskPaused returns true if the task of the domain must not be processed, either because its shard or its domain
+// is paused
+func (p *taskProcessingPauses) isTaskPaused(queue taskQueueType, shardID int, domainID string) bool {
+	if p == nil {
+		return false
+	}
+
+	p.RLock()
+	defer p.RUnlock()
+	if _, ok := p.shards[queue][shardID]; ok {
+		return true
+	}
+	_, ok := p.domains[queue][domainID]
+	return ok
+}
+
+// counts returns the number of paused shards and domains, those paused on both queues are counted once
+func (p *taskProcessingPauses) counts() (int, int) {
+	p.RLock()
+	defer p.RUnlock()
+	shards := make(map[int]struct{})
+	domains := make(map[string]struct{})
+	for queue := taskQueueType(0); queue < numTaskQueueTypes; queue++ {
+		for shardID := range p.shards[queue] {
+			shards[shardID] = struct{}{}
+		}
+		for domainID := range p.domains[queue] {
+			domains[domainID] = struct{}{}
+		}
+	}
+	return len(shards), len(domains)
+}
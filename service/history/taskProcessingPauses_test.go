@@ -0,0 +1,88 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	taskProcessingPausesSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+		pauses *taskProcessingPauses
+	}
+)
+
+func TestTaskProcessingPausesSuite(t *testing.T) {
+	s := new(taskProcessingPausesSuite)
+	suite.Run(t, s)
+}
+
+func (s *taskProcessingPausesSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.pauses = newTaskProcessingPauses()
+}
+
+func (s *taskProcessingPausesSuite) TestNilPausesNothing() {
+	var pauses *taskProcessingPauses
+	s.False(pauses.isShardPaused(transferTaskQueue, 1))
+	s.False(pauses.isTaskPaused(timerTaskQueue, 1, "domain"))
+}
+
+func (s *taskProcessingPausesSuite) TestShardPause() {
+	s.pauses.setShardPaused(transferTaskQueue, 1, true)
+	s.True(s.pauses.isShardPaused(transferTaskQueue, 1))
+	s.True(s.pauses.isTaskPaused(transferTaskQueue, 1, "domain"))
+	s.False(s.pauses.isShardPaused(timerTaskQueue, 1))
+	s.False(s.pauses.isShardPaused(transferTaskQueue, 2))
+
+	s.pauses.setShardPaused(transferTaskQueue, 1, false)
+	s.False(s.pauses.isShardPaused(transferTaskQueue, 1))
+}
+
+func (s *taskProcessingPausesSuite) TestDomainPause() {
+	s.pauses.setDomainPaused(timerTaskQueue, "domain", true)
+	s.True(s.pauses.isTaskPaused(timerTaskQueue, 1, "domain"))
+	s.True(s.pauses.isTaskPaused(timerTaskQueue, 2, "domain"))
+	s.False(s.pauses.isShardPaused(timerTaskQueue, 1))
+	s.False(s.pauses.isTaskPaused(timerTaskQueue, 1, "other-domain"))
+	s.False(s.pauses.isTaskPaused(transferTaskQueue, 1, "domain"))
+
+	s.pauses.setDomainPaused(timerTaskQueue, "domain", false)
+	s.False(s.pauses.isTaskPaused(timerTaskQueue, 1, "domain"))
+}
+
+func (s *taskProcessingPausesSuite) TestCounts() {
+	s.pauses.setShardPaused(transferTaskQueue, 1, true)
+	s.pauses.setShardPaused(timerTaskQueue, 1, true)
+	s.pauses.setShardPaused(timerTaskQueue, 2, true)
+	s.pauses.setDomainPaused(transferTaskQueue, "domain", true)
+
+	shards, domains := s.pauses.counts()
+	s.Equal(2, shards)
+	s.Equal(1, domains)
+}
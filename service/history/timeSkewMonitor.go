@@ -0,0 +1,137 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber-common/bark"
+
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	// defaultTimeSkewCheckInterval is how often the skew of the host clock is measured, unless configured
+	defaultTimeSkewCheckInterval = time.Minute
+)
+
+type (
+	// timeSkewMonitor measures the skew of the clock of the host against the clock of the datastore.  Timers are
+	// fired by comparing their deadline with the host clock, so a host whose clock drifts fires them early or late
+	// without any error.  The skew is reported as a gauge on every check interval and logged when it exceeds the
+	// threshold, and a host whose skew exceeds the threshold at startup refuses to start.
+	timeSkewMonitor struct {
+		shardManager  persistence.ShardManager
+		maxSkew       time.Duration
+		checkInterval time.Duration
+		logger        bark.Logger
+		metricsClient metrics.Client
+		started       int32
+		shutdownCh    chan struct{}
+		shutdownWG    sync.WaitGroup
+	}
+)
+
+func newTimeSkewMonitor(shardManager persistence.ShardManager, maxSkew time.Duration, checkInterval time.Duration,
+	logger bark.Logger, metricsClient metrics.Client) *timeSkewMonitor {
+	if checkInterval <= 0 {
+		checkInterval = defaultTimeSkewCheckInterval
+	}
+	return &timeSkewMonitor{
+		shardManager:  shardManager,
+		maxSkew:       maxSkew,
+		checkInterval: checkInterval,
+		logger:        logger,
+		metricsClient: metricsClient,
+		shutdownCh:    make(chan struct{}),
+	}
+}
+
+// Start starts the periodic check of the skew
+func (m *timeSkewMonitor) Start() {
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return
+	}
+
+	m.shutdownWG.Add(1)
+	go m.checkLoop()
+}
+
+// Stop stops the periodic check of the skew
+func (m *timeSkewMonitor) Stop() {
+	if !atomic.CompareAndSwapInt32(&m.started, 1, 2) {
+		return
+	}
+
+	close(m.shutdownCh)
+	m.shutdownWG.Wait()
+}
+
+func (m *timeSkewMonitor) checkLoop() {
+	defer m.shutdownWG.Done()
+
+	ticker := time.NewTicker(m.checkInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.shutdownCh:
+			return
+		case <-ticker.C:
+			if err := m.check(); err != nil {
+				m.logger.Warnf("%v", err)
+			}
+		}
+	}
+}
+
+// check measures and reports the skew, it returns an error if the skew cannot be measured or exceeds the threshold
+func (m *timeSkewMonitor) check() error {
+	skew, err := m.measureSkew()
+	if err != nil {
+		return fmt.Errorf("unable to measure time skew: %v", err)
+	}
+
+	m.metricsClient.UpdateGauge(metrics.HistoryTimeSkewMonitorScope, metrics.TimeSkewGauge,
+		float64(skew/time.Millisecond))
+	if skew > m.maxSkew || skew < -m.maxSkew {
+		m.metricsClient.IncCounter(metrics.HistoryTimeSkewMonitorScope, metrics.TimeSkewThresholdExceededCounter)
+		return fmt.Errorf("host clock is %v behind the datastore clock, more than the allowed skew of %v", skew,
+			m.maxSkew)
+	}
+	return nil
+}
+
+// measureSkew returns how far the host clock is behind the datastore clock, negative if it is ahead.  The datastore
+// time is compared with the host time halfway through the request, to cancel out the request latency.
+func (m *timeSkewMonitor) measureSkew() (time.Duration, error) {
+	before := time.Now()
+	response, err := m.shardManager.GetServerTime()
+	if err != nil {
+		return 0, err
+	}
+	after := time.Now()
+
+	return response.Time.Sub(before.Add(after.Sub(before) / 2)), nil
+}
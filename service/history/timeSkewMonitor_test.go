@@ -0,0 +1,97 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	timeSkewMonitorSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+		mockShardManager *mocks.ShardManager
+		monitor          *timeSkewMonitor
+	}
+)
+
+func TestTimeSkewMonitorSuite(t *testing.T) {
+	s := new(timeSkewMonitorSuite)
+	suite.Run(t, s)
+}
+
+func (s *timeSkewMonitorSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.mockShardManager = &mocks.ShardManager{}
+	s.monitor = newTimeSkewMonitor(s.mockShardManager, time.Minute, 0, bark.NewLoggerFromLogrus(log.New()),
+		metrics.NewClient(tally.NoopScope, metrics.History))
+}
+
+func (s *timeSkewMonitorSuite) TearDownTest() {
+	s.mockShardManager.AssertExpectations(s.T())
+}
+
+func (s *timeSkewMonitorSuite) TestDefaultCheckInterval() {
+	s.Equal(defaultTimeSkewCheckInterval, s.monitor.checkInterval)
+}
+
+func (s *timeSkewMonitorSuite) TestSkewWithinThreshold() {
+	s.mockShardManager.On("GetServerTime").Return(
+		&persistence.GetServerTimeResponse{Time: time.Now().Add(10 * time.Second)}, nil).Once()
+	s.Nil(s.monitor.check())
+}
+
+func (s *timeSkewMonitorSuite) TestHostBehind() {
+	s.mockShardManager.On("GetServerTime").Return(
+		&persistence.GetServerTimeResponse{Time: time.Now().Add(time.Hour)}, nil).Once()
+	skew, err := s.monitor.measureSkew()
+	s.Nil(err)
+	s.True(skew > 59*time.Minute)
+
+	s.mockShardManager.On("GetServerTime").Return(
+		&persistence.GetServerTimeResponse{Time: time.Now().Add(time.Hour)}, nil).Once()
+	s.NotNil(s.monitor.check())
+}
+
+func (s *timeSkewMonitorSuite) TestHostAhead() {
+	s.mockShardManager.On("GetServerTime").Return(
+		&persistence.GetServerTimeResponse{Time: time.Now().Add(-time.Hour)}, nil).Once()
+	s.NotNil(s.monitor.check())
+}
+
+func (s *timeSkewMonitorSuite) TestPersistenceError() {
+	s.mockShardManager.On("GetServerTime").Return(nil, errors.New("datastore down")).Once()
+	s.NotNil(s.monitor.check())
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"time"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/service/config"
+)
+
+type (
+	// timeoutCaps holds the caps on the decision and activity timeouts of the workflows of each domain.  A nil
+	// timeoutCaps caps nothing.
+	timeoutCaps struct {
+		defaults config.TaskTimeoutCaps
+		domains  map[string]config.TaskTimeoutCaps
+	}
+)
+
+func newTimeoutCaps(cfg config.TimeoutCaps) *timeoutCaps {
+	return &timeoutCaps{
+		defaults: cfg.Default,
+		domains:  cfg.Domains,
+	}
+}
+
+func (c *timeoutCaps) getCaps(domainID string) config.TaskTimeoutCaps {
+	if c == nil {
+		return config.TaskTimeoutCaps{}
+	}
+	if caps, ok := c.domains[domainID]; ok {
+		return caps
+	}
+	return c.defaults
+}
+
+// capDecisionTimeout clamps the decision task timeout of a workflow of the domain, it returns true if the timeout was
+// clamped
+func (c *timeoutCaps) capDecisionTimeout(domainID string, timeout *int32) bool {
+	return capTimeoutSeconds(timeout, c.getCaps(domainID).MaxDecisionTimeout)
+}
+
+// capActivityTimeouts clamps the timeouts of an activity scheduled by a workflow of the domain, it returns true if any
+// of them was clamped.  The schedule to start and start to close timeouts are clamped to the schedule to close cap,
+// as they never outlast the schedule to close timeout.
+func (c *timeoutCaps) capActivityTimeouts(domainID string,
+	attributes *workflow.ScheduleActivityTaskDecisionAttributes) bool {
+	caps := c.getCaps(domainID)
+	capped := false
+	for _, timeout := range []*int32{
+		attributes.ScheduleToCloseTimeoutSeconds,
+		attributes.ScheduleToStartTimeoutSeconds,
+		attributes.StartToCloseTimeoutSeconds,
+	} {
+		if capTimeoutSeconds(timeout, caps.MaxActivityScheduleToCloseTimeout) {
+			capped = true
+		}
+	}
+	if capTimeoutSeconds(attributes.HeartbeatTimeoutSeconds, caps.MaxActivityHeartbeatTimeout) {
+		capped = true
+	}
+	return capped
+}
+
+// capTimeoutSeconds clamps the timeout in seconds to the cap, a zero cap disables it.  It returns true if the timeout
+// was clamped.
+func capTimeoutSeconds(timeout *int32, limit time.Duration) bool {
+	if timeout == nil || limit <= 0 {
+		return false
+	}
+
+	max := int64(limit / time.Second)
+	if max < 1 {
+		max = 1
+	}
+	if int64(*timeout) <= max {
+		return false
+	}
+	*timeout = int32(max)
+	return true
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/service/config"
+)
+
+type (
+	timeoutCapsSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+		caps *timeoutCaps
+	}
+)
+
+func TestTimeoutCapsSuite(t *testing.T) {
+	s := new(timeoutCapsSuite)
+	suite.Run(t, s)
+}
+
+func (s *timeoutCapsSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.caps = newTimeoutCaps(config.TimeoutCaps{
+		Default: config.TaskTimeoutCaps{
+			MaxDecisionTimeout:                time.Minute,
+			MaxActivityScheduleToCloseTimeout: time.Hour,
+			MaxActivityHeartbeatTimeout:       10 * time.Minute,
+		},
+		Domains: map[string]config.TaskTimeoutCaps{
+			"uncapped": {},
+		},
+	})
+}
+
+func (s *timeoutCapsSuite) TestNilCapsNothing() {
+	var caps *timeoutCaps
+	timeout := common.Int32Ptr(1000000000)
+	s.False(caps.capDecisionTimeout("domain", timeout))
+	s.Equal(int32(1000000000), *timeout)
+}
+
+func (s *timeoutCapsSuite) TestCapDecisionTimeout() {
+	timeout := common.Int32Ptr(30)
+	s.False(s.caps.capDecisionTimeout("domain", timeout))
+	s.Equal(int32(30), *timeout)
+
+	timeout = common.Int32Ptr(3600)
+	s.True(s.caps.capDecisionTimeout("domain", timeout))
+	s.Equal(int32(60), *timeout)
+
+	timeout = common.Int32Ptr(3600)
+	s.False(s.caps.capDecisionTimeout("uncapped", timeout))
+	s.Equal(int32(3600), *timeout)
+
+	s.False(s.caps.capDecisionTimeout("domain", nil))
+}
+
+func (s *timeoutCapsSuite) TestCapActivityTimeouts() {
+	attributes := &workflow.ScheduleActivityTaskDecisionAttributes{
+		ScheduleToCloseTimeoutSeconds: common.Int32Ptr(10 * 365 * 24 * 3600),
+		ScheduleToStartTimeoutSeconds: common.Int32Ptr(60),
+		StartToCloseTimeoutSeconds:    common.Int32Ptr(2 * 3600),
+		HeartbeatTimeoutSeconds:       common.Int32Ptr(0),
+	}
+	s.True(s.caps.capActivityTimeouts("domain", attributes))
+	s.Equal(int32(3600), attributes.GetScheduleToCloseTimeoutSeconds())
+	s.Equal(int32(60), attributes.GetScheduleToStartTimeoutSeconds())
+	s.Equal(int32(3600), attributes.GetStartToCloseTimeoutSeconds())
+	s.Equal(int32(0), attributes.GetHeartbeatTimeoutSeconds())
+
+	// Capping is idempotent
+	s.False(s.caps.capActivityTimeouts("domain", attributes))
+
+	attributes.HeartbeatTimeoutSeconds = common.Int32Ptr(3600)
+	s.True(s.caps.capActivityTimeouts("domain", attributes))
+	s.Equal(int32(600), attributes.GetHeartbeatTimeoutSeconds())
+}
+
+func (s *timeoutCapsSuite) TestCapTimeoutSeconds() {
+	timeout := common.Int32Ptr(10)
+	s.True(capTimeoutSeconds(timeout, time.Millisecond))
+	s.Equal(int32(1), *timeout)
+
+	timeout = common.Int32Ptr(10)
+	s.False(capTimeoutSeconds(timeout, 1000*365*24*time.Hour))
+	s.Equal(int32(10), *timeout)
+}
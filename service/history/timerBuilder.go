@@ -173,6 +173,39 @@ func (tb *timerBuilder) AddActivityTimeoutTask(scheduleID int64,
 	return timeOutTask
 }
 
+// AddCancelTimeoutTask - Adds a task which terminates the execution if it has not closed within the grace period
+// requested along with its cancellation.
+func (tb *timerBuilder) AddCancelTimeoutTask(cancelRequestedEventID int64,
+	terminateAfterSeconds int32) *persistence.CancelTimeoutTask {
+	if terminateAfterSeconds <= 0 {
+		return nil
+	}
+
+	timeOutTask := tb.createCancelTimeoutTask(terminateAfterSeconds, cancelRequestedEventID)
+	tb.logger.Debugf("Adding Cancel Timeout: with timeout: %v sec, EventID: %v",
+		terminateAfterSeconds, timeOutTask.EventID)
+	return timeOutTask
+}
+
+// AddDeleteExecutionTask - Adds a task which deletes the closed execution once the close cleanup delay has passed.
+func (tb *timerBuilder) AddDeleteExecutionTask(delay time.Duration) *persistence.DeleteExecutionTimerTask {
+	timerTask := &persistence.DeleteExecutionTimerTask{
+		VisibilityTimestamp: tb.timeSource.Now().Add(delay),
+	}
+	tb.logger.Debugf("Adding Delete Execution Timer: with delay: %v", delay)
+	return timerTask
+}
+
+// AddDeleteHistoryTask - Adds a task which deletes the history of the closed execution once the retention period
+// of its domain has passed.
+func (tb *timerBuilder) AddDeleteHistoryTask(retention time.Duration) *persistence.DeleteHistoryTimerTask {
+	timerTask := &persistence.DeleteHistoryTimerTask{
+		VisibilityTimestamp: tb.timeSource.Now().Add(retention),
+	}
+	tb.logger.Debugf("Adding Delete History Timer: with retention: %v", retention)
+	return timerTask
+}
+
 // AddUserTimer - Adds an user timeout request.
 func (tb *timerBuilder) AddUserTimer(ti *persistence.TimerInfo, msBuilder *mutableStateBuilder) persistence.Task {
 	tb.logger.Debugf("Adding User Timeout for timer ID: %s", ti.TimerID)
@@ -236,6 +269,15 @@ func (tb *timerBuilder) createActivityTimeoutTask(fireTimeOut int32, timeoutType
 	}
 }
 
+// createCancelTimeoutTask - Creates a cancel timeout task.
+func (tb *timerBuilder) createCancelTimeoutTask(fireTimeOut int32, eventID int64) *persistence.CancelTimeoutTask {
+	expiryTime := tb.timeSource.Now().Add(time.Duration(fireTimeOut) * time.Second)
+	return &persistence.CancelTimeoutTask{
+		VisibilityTimestamp: expiryTime,
+		EventID:             eventID,
+	}
+}
+
 // createUserTimerTask - Creates a user timer task.
 func (tb *timerBuilder) createUserTimerTask(expiryTime time.Time, startedEventID int64) *persistence.UserTimerTask {
 	t := &persistence.UserTimerTask{
@@ -24,7 +24,6 @@ import (
 	"errors"
 	"fmt"
 	"math"
-	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -68,6 +67,13 @@ type (
 		lock             sync.Mutex // Used to synchronize pending timers.
 		ackMgr           *timerAckMgr
 		minPendingTimer  time.Time // Track the minimum timer ID in memory.
+		// sleepHorizon is the time (in 'UnixNano' units) at which the sleeping processor is already scheduled to
+		// read timers again, zero when the processor is either busy or waiting only for new timer notifications.
+		sleepHorizon int64
+		pauses       *taskProcessingPauses
+		shardID      int
+		parkedLock   sync.Mutex
+		parkedTasks  []*persistence.TimerTaskInfo // tasks of paused domains, not completed yet
 	}
 
 	timeGate struct {
@@ -79,13 +85,16 @@ type (
 
 	timerAckMgr struct {
 		sync.RWMutex
-		processor        *timerQueueProcessorImpl
-		shard            ShardContext
-		executionMgr     persistence.ExecutionManager
-		logger           bark.Logger
-		outstandingTasks map[SequenceID]bool
-		readLevel        SequenceID
-		ackLevel         time.Time
+		processor    *timerQueueProcessorImpl
+		shard        ShardContext
+		executionMgr persistence.ExecutionManager
+		logger       bark.Logger
+		tracker      *queueAckTracker
+		// scanLevel is the read level at which the ongoing paged scan of the timer queue started
+		scanLevel time.Time
+		// deletedLevel is the level below which the fired timers are deleted, it only moves once their range delete
+		// succeeds
+		deletedLevel time.Time
 	}
 )
 
@@ -142,6 +151,14 @@ func (t *timeGate) engaged() bool {
 	return t.tNext > t.tNow
 }
 
+// nextWakeUp returns the time (in 'UnixNano' units) at which the gate is going to fire, zero if it is not engaged
+func (t *timeGate) nextWakeUp() int64 {
+	if t.engaged() && t.tNext != t.tEnd {
+		return t.tNext
+	}
+	return 0
+}
+
 func (t *timeGate) setNext(next time.Time) {
 	t.tNext = next.UnixNano()
 }
@@ -166,7 +183,9 @@ func newTimerQueueProcessor(shard ShardContext, historyService *historyEngineImp
 		shutdownCh:       make(chan struct{}),
 		newTimerCh:       make(chan struct{}, 1),
 		logger:           l,
-		metricsClient:    historyService.metricsClient,
+		metricsClient:    shard.GetMetricsClient(),
+		pauses:           historyService.taskPauses,
+		shardID:          shard.GetShardID(),
 	}
 	tp.ackMgr = newTimerAckMgr(tp, shard, executionManager, l)
 	return tp
@@ -200,19 +219,36 @@ func (t *timerQueueProcessorImpl) Stop() {
 }
 
 // NotifyNewTimer - Notify the processor about the new timer arrival.
+// Only the earliest timer of the batch is considered.  The processor is not woken up if that timer fires after the
+// time it is already scheduled to read timers again, as the read will pick up the new timer anyway.  Notifications
+// arriving before the processor wakes up are coalesced into a single wake up for the earliest pending timer.
 func (t *timerQueueProcessorImpl) NotifyNewTimer(timerTasks []persistence.Task) {
+	if len(timerTasks) == 0 {
+		return
+	}
+
+	earliest := persistence.GetVisibilityTSFrom(timerTasks[0])
+	for _, task := range timerTasks[1:] {
+		if ts := persistence.GetVisibilityTSFrom(task); ts.Before(earliest) {
+			earliest = ts
+		}
+	}
+
+	if horizon := atomic.LoadInt64(&t.sleepHorizon); horizon != 0 && earliest.UnixNano() >= horizon {
+		t.metricsClient.IncCounter(metrics.TimerQueueProcessorScope, metrics.NewTimerNotifySkippedCounter)
+		return
+	}
+
 	updatedMinTimer := false
 	t.lock.Lock()
-	for _, task := range timerTasks {
-		ts := persistence.GetVisibilityTSFrom(task)
-		if t.minPendingTimer.IsZero() || ts.Before(t.minPendingTimer) {
-			t.minPendingTimer = ts
-			updatedMinTimer = true
-		}
+	if t.minPendingTimer.IsZero() || earliest.Before(t.minPendingTimer) {
+		t.minPendingTimer = earliest
+		updatedMinTimer = true
 	}
 	t.lock.Unlock()
 
 	if updatedMinTimer {
+		t.metricsClient.IncCounter(metrics.TimerQueueProcessorScope, metrics.NewTimerNotifyCounter)
 		select {
 		case t.newTimerCh <- struct{}{}:
 			// Notified about new timer.
@@ -266,6 +302,7 @@ func (t *timerQueueProcessorImpl) internalProcessor(tasksCh chan<- *persistence.
 
 		if nextKeyTask == nil || gate.engaged() {
 			gateC := gate.beforeSleep()
+			atomic.StoreInt64(&t.sleepHorizon, gate.nextWakeUp())
 
 			// Wait until one of four things occurs:
 			// 1. we get notified of a new message
@@ -288,7 +325,9 @@ func (t *timerQueueProcessorImpl) internalProcessor(tasksCh chan<- *persistence.
 
 			case <-updateAckChan:
 				t.ackMgr.updateAckLevel()
+				t.resumeParkedTasks(tasksCh)
 			}
+			atomic.StoreInt64(&t.sleepHorizon, 0)
 		}
 
 		if isWokeByNewTimer {
@@ -311,10 +350,18 @@ func (t *timerQueueProcessorImpl) internalProcessor(tasksCh chan<- *persistence.
 			}
 		}
 
+		if t.pauses.isShardPaused(timerTaskQueue, t.shardID) {
+			// Sleep until the pause is checked again
+			nextKeyTask = nil
+			gate.setNext(time.Now().Add(taskProcessingPauseCheckInterval))
+			continue
+		}
+
 		// Either we have new timer (or) we are gated on timer to query for it.
+		var pageToken []byte
 		for {
 			// Get next set of timer tasks.
-			timerTasks, lookAheadTask, err := t.getTasksAndNextKey()
+			timerTasks, lookAheadTask, nextPageToken, err := t.getTasksAndNextKey(pageToken)
 			if err != nil {
 				return err
 			}
@@ -324,11 +371,12 @@ func (t *timerQueueProcessorImpl) internalProcessor(tasksCh chan<- *persistence.
 				tasksCh <- task
 			}
 
-			if lookAheadTask != nil || len(timerTasks) < timerTaskBatchSize {
+			if lookAheadTask != nil || len(nextPageToken) == 0 {
 				// We have processed all the tasks.
 				nextKeyTask = lookAheadTask
 				break
 			}
+			pageToken = nextPageToken
 		}
 
 		if nextKeyTask != nil {
@@ -344,32 +392,35 @@ func (t *timerQueueProcessorImpl) isProcessNow(expiryTime time.Time) bool {
 	return !expiryTime.IsZero() && expiryTime.UnixNano() <= time.Now().UnixNano()
 }
 
-func (t *timerQueueProcessorImpl) getTasksAndNextKey() ([]*persistence.TimerTaskInfo, *persistence.TimerTaskInfo, error) {
-	tasks, lookAheadTask, err := t.ackMgr.readTimerTasks()
+func (t *timerQueueProcessorImpl) getTasksAndNextKey(pageToken []byte) ([]*persistence.TimerTaskInfo,
+	*persistence.TimerTaskInfo, []byte, error) {
+	tasks, lookAheadTask, nextPageToken, err := t.ackMgr.readTimerTasks(pageToken)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
-	return tasks, lookAheadTask, nil
+	return tasks, lookAheadTask, nextPageToken, nil
 }
 
 func (t *timerQueueProcessorImpl) getTimerTasks(
 	minTimestamp time.Time,
 	maxTimestamp time.Time,
-	batchSize int) ([]*persistence.TimerTaskInfo, error) {
+	batchSize int,
+	pageToken []byte) ([]*persistence.TimerTaskInfo, []byte, error) {
 	request := &persistence.GetTimerIndexTasksRequest{
-		MinTimestamp: minTimestamp,
-		MaxTimestamp: maxTimestamp,
-		BatchSize:    batchSize}
+		MinTimestamp:  minTimestamp,
+		MaxTimestamp:  maxTimestamp,
+		BatchSize:     batchSize,
+		NextPageToken: pageToken}
 
 	for attempt := 1; attempt <= getFailureRetryCount; attempt++ {
 		response, err := t.executionManager.GetTimerIndexTasks(request)
 		if err == nil {
-			return response.Timers, nil
+			return response.Timers, response.NextPageToken, nil
 		}
 		backoff := time.Duration(attempt * 100)
 		time.Sleep(backoff * time.Millisecond)
 	}
-	return nil, ErrMaxAttemptsExceeded
+	return nil, nil, ErrMaxAttemptsExceeded
 }
 
 func (t *timerQueueProcessorImpl) processTaskWorker(tasksCh <-chan *persistence.TimerTaskInfo, workerWG *sync.WaitGroup) {
@@ -381,6 +432,13 @@ func (t *timerQueueProcessorImpl) processTaskWorker(tasksCh <-chan *persistence.
 				return
 			}
 
+			if t.pauses.isTaskPaused(timerTaskQueue, t.shardID, task.DomainID) {
+				t.parkedLock.Lock()
+				t.parkedTasks = append(t.parkedTasks, task)
+				t.parkedLock.Unlock()
+				continue
+			}
+
 			var err error
 
 		UpdateFailureLoop:
@@ -403,6 +461,26 @@ func (t *timerQueueProcessorImpl) processTaskWorker(tasksCh <-chan *persistence.
 	}
 }
 
+// resumeParkedTasks dispatches again the parked tasks which are no longer paused
+func (t *timerQueueProcessorImpl) resumeParkedTasks(tasksCh chan<- *persistence.TimerTaskInfo) {
+	t.parkedLock.Lock()
+	var resumed []*persistence.TimerTaskInfo
+	parked := t.parkedTasks[:0]
+	for _, task := range t.parkedTasks {
+		if t.pauses.isTaskPaused(timerTaskQueue, t.shardID, task.DomainID) {
+			parked = append(parked, task)
+		} else {
+			resumed = append(resumed, task)
+		}
+	}
+	t.parkedTasks = parked
+	t.parkedLock.Unlock()
+
+	for _, task := range resumed {
+		tasksCh <- task
+	}
+}
+
 func (t *timerQueueProcessorImpl) processTimerTask(timerTask *persistence.TimerTaskInfo) error {
 	taskID := SequenceID{VisibilityTimestamp: timerTask.VisibilityTimestamp, TaskID: timerTask.TaskID}
 	t.logger.Debugf("Processing timer: (%s), for WorkflowID: %v, RunID: %v, Type: %v, TimeoutTupe: %v, EventID: %v",
@@ -429,6 +507,12 @@ func (t *timerQueueProcessorImpl) processTimerTask(timerTask *persistence.TimerT
 		err = t.processActivityTimeout(context, timerTask)
 	case persistence.TaskTypeDecisionTimeout:
 		err = t.processDecisionTimeout(context, timerTask)
+	case persistence.TaskTypeCancelTimeout:
+		err = t.processCancelTimeout(context, timerTask)
+	case persistence.TaskTypeDeleteExecution:
+		err = t.processDeleteExecution(context)
+	case persistence.TaskTypeDeleteHistory:
+		err = t.processDeleteHistory(context, timerTask)
 	}
 
 	if err != nil {
@@ -440,14 +524,9 @@ func (t *timerQueueProcessorImpl) processTimerTask(timerTask *persistence.TimerT
 	}
 
 	if err == nil {
-		// Tracking only successful ones.
+		// Tracking only successful ones.  The task is deleted along with the tasks around it once the ack level moves
+		// past it, deleting fired timers one row at a time leaves tombstones every read of the queue has to scan.
 		atomic.AddUint64(&t.timerFiredCount, 1)
-		err := t.executionManager.CompleteTimerTask(&persistence.CompleteTimerTaskRequest{
-			VisibilityTimestamp: timerTask.VisibilityTimestamp,
-			TaskID:              timerTask.TaskID})
-		if err != nil {
-			t.logger.Warnf("Processor unable to complete timer task '%v': %v", timerTask.TaskID, err)
-		}
 		return nil
 	}
 
@@ -681,6 +760,142 @@ Update_History_Loop:
 	return ErrMaxAttemptsExceeded
 }
 
+func (t *timerQueueProcessorImpl) processCancelTimeout(
+	context *workflowExecutionContext, task *persistence.TimerTaskInfo) error {
+Update_History_Loop:
+	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
+		msBuilder, err1 := context.loadWorkflowExecution()
+		if err1 != nil {
+			return err1
+		}
+
+		cancelRequestedEventID := task.EventID
+
+		// First check to see if cache needs to be refreshed as we could potentially have stale workflow execution in
+		// some extreme cassandra failure cases.
+		if cancelRequestedEventID >= msBuilder.GetNextEventID() {
+			t.metricsClient.IncCounter(metrics.TimerQueueProcessorScope, metrics.StaleMutableStateCounter)
+			// Reload workflow execution history
+			context.clear()
+			continue Update_History_Loop
+		}
+
+		if !msBuilder.isWorkflowExecutionRunning() {
+			// Workflow honored the cancellation within the grace period.
+			return nil
+		}
+
+		// The domain may have been protected from termination since the cancellation was requested
+		if err := checkTerminationProtection(t.historyService.domainCache, task.DomainID, false); err != nil {
+			if _, ok := err.(*workflow.BadRequestError); ok {
+				t.logger.WithFields(bark.Fields{
+					logging.TagWorkflowExecutionID: task.WorkflowID,
+					logging.TagWorkflowRunID:       task.RunID,
+				}).Warn("Workflow not terminated after the cancellation grace period, its domain is protected.")
+				return nil
+			}
+			return err
+		}
+
+		if msBuilder.AddWorkflowExecutionTerminatedEvent(&workflow.TerminateWorkflowExecutionRequest{
+			Reason:   common.StringPtr("Workflow did not close within the cancellation grace period."),
+			Identity: common.StringPtr("history-service"),
+		}) == nil {
+			return &workflow.InternalServiceError{Message: "Unable to terminate workflow execution."}
+		}
+
+		// Generate a transaction ID for appending events to history
+		transactionID, err2 := t.historyService.shard.GetNextTransferTaskID()
+		if err2 != nil {
+			return err2
+		}
+
+		// Terminated execution is deleted just like any other closed execution
+		transferTasks := []persistence.Task{&persistence.DeleteExecutionTask{}}
+		err := context.updateWorkflowExecution(transferTasks, nil, transactionID)
+		if err != nil {
+			if err == ErrConflict {
+				continue Update_History_Loop
+			}
+			if isShardOwnershiptLostError(err) {
+				// Shard is stolen.  Stop timer processing to reduce duplicates
+				t.Stop()
+			}
+		}
+		return err
+	}
+	return ErrMaxAttemptsExceeded
+}
+
+func (t *timerQueueProcessorImpl) processDeleteExecution(context *workflowExecutionContext) error {
+	if _, err := context.loadWorkflowExecution(); err != nil {
+		return err
+	}
+
+	// The close of the execution was already processed by the transfer queue, only the mutable state is left
+	return context.deleteWorkflowExecution()
+}
+
+func (t *timerQueueProcessorImpl) processDeleteHistory(context *workflowExecutionContext,
+	task *persistence.TimerTaskInfo) error {
+	// The mutable state is normally gone by now, unless it is kept for longer than the retention period
+	if _, err := context.loadWorkflowExecution(); err == nil {
+		if err := context.deleteWorkflowExecution(); err != nil {
+			return err
+		}
+	} else if _, ok := err.(*workflow.EntityNotExistsError); !ok {
+		return err
+	}
+
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(task.WorkflowID),
+		RunId:      common.StringPtr(task.RunID),
+	}
+	if err := t.archiveHistory(task.DomainID, execution); err != nil {
+		return err
+	}
+
+	err := t.historyService.historyMgr.DeleteWorkflowExecutionHistory(&persistence.DeleteWorkflowExecutionHistoryRequest{
+		DomainID:  task.DomainID,
+		Execution: execution,
+	})
+	if err != nil {
+		return err
+	}
+
+	return t.historyService.visibilityMgr.DeleteWorkflowExecution(&persistence.DeleteWorkflowExecutionVisibilityRequest{
+		DomainUUID: task.DomainID,
+		Execution:  execution,
+	})
+}
+
+// archiveHistory copies the history of the execution to the archive if its domain has archival enabled
+func (t *timerQueueProcessorImpl) archiveHistory(domainID string, execution workflow.WorkflowExecution) error {
+	archive := t.historyService.historyArchive
+	if archive == nil {
+		return nil
+	}
+
+	_, domainConfig, err := t.historyService.domainCache.GetDomainByID(domainID)
+	if err != nil {
+		if _, ok := err.(*workflow.EntityNotExistsError); ok {
+			// the domain got deleted, there is no one left to read the history
+			return nil
+		}
+		return err
+	}
+	if !domainConfig.ArchivalEnabled {
+		return nil
+	}
+
+	if err := archive.ArchiveWorkflowExecutionHistory(t.historyService.historyMgr, domainID, execution); err != nil {
+		t.metricsClient.IncCounter(metrics.TimerQueueProcessorScope, metrics.HistoryArchivalFailedCounter)
+		return err
+	}
+	t.metricsClient.IncCounter(metrics.TimerQueueProcessorScope, metrics.HistoryArchivedCounter)
+	return nil
+}
+
 func (t *timerQueueProcessorImpl) updateWorkflowExecution(context *workflowExecutionContext,
 	msBuilder *mutableStateBuilder, scheduleNewDecision bool, timerTasks []persistence.Task,
 	clearTimerTask persistence.Task) error {
@@ -719,6 +934,12 @@ func (t *timerQueueProcessorImpl) getTimerTaskType(taskType int) string {
 		return "ActivityTimeout"
 	case persistence.TaskTypeDecisionTimeout:
 		return "DecisionTimeout"
+	case persistence.TaskTypeCancelTimeout:
+		return "CancelTimeout"
+	case persistence.TaskTypeDeleteExecution:
+		return "DeleteExecution"
+	case persistence.TaskTypeDeleteHistory:
+		return "DeleteHistory"
 	}
 	return "UnKnown"
 }
@@ -744,24 +965,30 @@ func newTimerAckMgr(processor *timerQueueProcessorImpl, shard ShardContext, exec
 	logger bark.Logger) *timerAckMgr {
 	ackLevel := shard.GetTimerAckLevel()
 	return &timerAckMgr{
-		processor:        processor,
-		shard:            shard,
-		executionMgr:     executionMgr,
-		outstandingTasks: make(map[SequenceID]bool),
-		readLevel:        SequenceID{VisibilityTimestamp: ackLevel},
-		ackLevel:         ackLevel,
-		logger:           logger,
+		processor:    processor,
+		shard:        shard,
+		executionMgr: executionMgr,
+		tracker:      newQueueAckTracker(SequenceID{VisibilityTimestamp: ackLevel}),
+		logger:       logger,
+		deletedLevel: ackLevel,
 	}
 }
 
-func (t *timerAckMgr) readTimerTasks() ([]*persistence.TimerTaskInfo, *persistence.TimerTaskInfo, error) {
-	t.RLock()
-	rLevel := t.readLevel
-	t.RUnlock()
+// readTimerTasks reads a page of timers from the read level.  An empty page token starts a new scan of the queue and
+// the token returned continues it, so timers already outstanding do not starve the ones behind them.
+func (t *timerAckMgr) readTimerTasks(pageToken []byte) ([]*persistence.TimerTaskInfo, *persistence.TimerTaskInfo,
+	[]byte, error) {
+	t.Lock()
+	rLevel := t.tracker.getReadLevel()
+	if len(pageToken) == 0 {
+		t.scanLevel = rLevel.VisibilityTimestamp
+	}
+	scanLevel := t.scanLevel
+	t.Unlock()
 
-	tasks, err := t.processor.getTimerTasks(rLevel.VisibilityTimestamp, maxTimestamp, timerTaskBatchSize)
+	tasks, nextPageToken, err := t.processor.getTimerTasks(scanLevel, maxTimestamp, timerTaskBatchSize, pageToken)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	t.logger.Debugf("readTimerTasks: ReadLevel: (%s) count: %v", rLevel, len(tasks))
@@ -776,13 +1003,13 @@ func (t *timerAckMgr) readTimerTasks() ([]*persistence.TimerTaskInfo, *persisten
 	t.Lock()
 	for _, task := range tasks {
 		taskSeq := SequenceID{VisibilityTimestamp: task.VisibilityTimestamp, TaskID: task.TaskID}
-		if _, ok := t.outstandingTasks[taskSeq]; ok {
+		if t.tracker.isOutstanding(taskSeq) {
 			continue
 		}
-		if task.VisibilityTimestamp.Before(t.readLevel.VisibilityTimestamp) {
+		if readLevel := t.tracker.getReadLevel(); task.VisibilityTimestamp.Before(readLevel.VisibilityTimestamp) {
 			t.logger.Fatalf(
 				"Next timer task time stamp is less than current timer task read level. timer task: (%s), ReadLevel: (%s)",
-				taskSeq, t.readLevel)
+				taskSeq, readLevel)
 		}
 		if !t.processor.isProcessNow(task.VisibilityTimestamp) {
 			lookAheadTask = task
@@ -790,53 +1017,51 @@ func (t *timerAckMgr) readTimerTasks() ([]*persistence.TimerTaskInfo, *persisten
 		}
 
 		t.logger.Debugf("Moving timer read level: (%s)", taskSeq)
-		t.readLevel = taskSeq
-		t.outstandingTasks[t.readLevel] = false
+		t.tracker.addTask(taskSeq)
 		filteredTasks = append(filteredTasks, task)
 	}
 	t.Unlock()
 
-	return filteredTasks, lookAheadTask, nil
+	return filteredTasks, lookAheadTask, nextPageToken, nil
 }
 
 func (t *timerAckMgr) completeTimerTask(taskID SequenceID) {
 	t.Lock()
-	if _, ok := t.outstandingTasks[taskID]; ok {
-		t.outstandingTasks[taskID] = true
-	}
+	t.tracker.completeTask(taskID)
 	t.Unlock()
 }
 
 func (t *timerAckMgr) updateAckLevel() {
-	updatedAckLevel := t.ackLevel
 	t.Lock()
+	t.tracker.moveAckLevel(t.tracker.nextAckLevel())
+	updatedAckLevel := t.tracker.getAckLevel().VisibilityTimestamp
+	deletedLevel := t.deletedLevel
+	t.Unlock()
 
-	// Timer IDs can have holes in the middle. So we sort the map to get the order to
-	// check. TODO: we can maintain a sorted slice as well.
-	var taskIDs timerTaskIDs
-	for k := range t.outstandingTasks {
-		taskIDs = append(taskIDs, k)
-	}
-	sort.Sort(taskIDs)
-
-MoveAckLevelLoop:
-	for _, current := range taskIDs {
-		if acked, ok := t.outstandingTasks[current]; ok {
-			if acked {
-				t.ackLevel = current.VisibilityTimestamp
-				updatedAckLevel = current.VisibilityTimestamp
-				delete(t.outstandingTasks, current)
-			} else {
-				break MoveAckLevelLoop
-			}
+	// Fired timers are deleted with a single range delete as the ack level moves past them, a range tombstone below
+	// the ack level is skipped by the reads of the queue which start at the ack level.  A failed delete is retried
+	// from the same level by the next update, and the ack level persisted for the shard does not move past the
+	// deleted level, so the timers left behind are read again after a shard movement instead of leaking.
+	if updatedAckLevel.After(deletedLevel) {
+		err := t.executionMgr.RangeCompleteTimerTask(&persistence.RangeCompleteTimerTaskRequest{
+			InclusiveBeginTimestamp: deletedLevel,
+			ExclusiveEndTimestamp:   updatedAckLevel,
+		})
+		if err != nil {
+			t.logger.Warnf("Processor unable to complete timer tasks from '%v' before '%v': %v", deletedLevel,
+				updatedAckLevel, err)
+		} else {
+			deletedLevel = updatedAckLevel
+			t.Lock()
+			t.deletedLevel = deletedLevel
+			t.Unlock()
 		}
 	}
-	t.Unlock()
 
-	t.logger.Debugf("Updating timer ack level: %v", updatedAckLevel)
+	t.logger.Debugf("Updating timer ack level: %v", deletedLevel)
 
 	// Always update ackLevel to detect if the shared is stolen
-	if err := t.shard.UpdateTimerAckLevel(updatedAckLevel); err != nil {
+	if err := t.shard.UpdateTimerAckLevel(deletedLevel); err != nil {
 		t.logger.Errorf("Error updating timer ack level for shard: %v", err)
 	}
 }
@@ -28,6 +28,7 @@ import (
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/idgen"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
@@ -94,11 +95,13 @@ func (s *timerQueueProcessor2Suite) SetupTest() {
 		closeCh:                   s.shardClosedCh,
 		logger:                    s.logger,
 		metricsClient:             metrics.NewClient(tally.NoopScope, metrics.History),
+		historySerializer:         persistence.NewJSONHistorySerializer(),
+		timeSource:                common.NewRealTimeSource(),
 	}
 
 	historyCache := newHistoryCache(historyCacheMaxSize, s.mockShard, s.logger)
-	domainCache := cache.NewDomainCache(s.mockMetadataMgr, s.logger)
-	txProcessor := newTransferQueueProcessor(s.mockShard, s.mockVisibilityMgr, s.mockMatchingClient, &mocks.HistoryClient{}, historyCache, domainCache)
+	domainCache := cache.NewDomainCache(s.mockMetadataMgr, s.logger, s.mockShard.GetMetricsClient())
+	txProcessor := newTransferQueueProcessor(s.mockShard, s.mockVisibilityMgr, s.mockMatchingClient, &mocks.HistoryClient{}, historyCache, domainCache, 0, nil, nil, nil)
 	h := &historyEngineImpl{
 		shard:              s.mockShard,
 		historyMgr:         s.mockHistoryMgr,
@@ -108,6 +111,7 @@ func (s *timerQueueProcessor2Suite) SetupTest() {
 		logger:             s.logger,
 		tokenSerializer:    common.NewJSONTaskTokenSerializer(),
 		hSerializerFactory: persistence.NewHistorySerializerFactory(),
+		idGenerator:        idgen.NewSequentialGenerator(),
 	}
 	h.timerProcessor = newTimerQueueProcessor(s.mockShard, h, s.mockExecutionMgr, s.logger)
 	s.mockHistoryEngine = h
@@ -160,14 +164,12 @@ func (s *timerQueueProcessor2Suite) TestTimerUpdateTimesOut() {
 	s.mockExecutionMgr.On("GetTimerIndexTasks", mock.Anything).Return(
 		&persistence.GetTimerIndexTasksResponse{Timers: []*persistence.TimerTaskInfo{}}, nil)
 
-	s.mockExecutionMgr.On("CompleteTimerTask", mock.Anything).Return(nil).Once()
-
 	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(errors.New("FAILED")).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil, errors.New("FAILED")).Once()
 	s.mockShardManager.On("UpdateShard", mock.Anything).Return(nil)
 
 	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Run(func(arguments mock.Arguments) {
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil).Run(func(arguments mock.Arguments) {
 		// Done.
 		waitCh <- struct{}{}
 	}).Once()
@@ -184,3 +186,127 @@ func (s *timerQueueProcessor2Suite) TestTimerUpdateTimesOut() {
 	<-waitCh
 	processor.Stop()
 }
+
+func (s *timerQueueProcessor2Suite) TestTimerRetriedAfterReadFault() {
+	domainID := "5bb49df8-71bc-4c63-b57f-05f2a508e7b5"
+	we := workflow.WorkflowExecution{WorkflowId: common.StringPtr("timer-read-fault-test"),
+		RunId: common.StringPtr("0d00698f-08e1-4d36-a3e2-3bf109f5d2d6")}
+
+	taskList := "user-timer-read-fault"
+
+	builder := newMutableStateBuilder(s.logger)
+	builder.AddWorkflowExecutionStartedEvent(domainID, we, &workflow.StartWorkflowExecutionRequest{
+		WorkflowType:                   &workflow.WorkflowType{Name: common.StringPtr("wType")},
+		TaskList:                       common.TaskListPtr(workflow.TaskList{Name: common.StringPtr(taskList)}),
+		TaskStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+	})
+
+	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(builder)
+	addDecisionTaskStartedEvent(builder, decisionScheduledEvent.GetEventId(), taskList, uuid.New())
+
+	// The first read of the execution fails before reaching the persistence
+	injector := persistence.NewFaultInjector()
+	injector.Inject("GetWorkflowExecution", persistence.Fault{Err: errors.New("FAILED"), Count: 1})
+	s.mockShard.(*shardContextImpl).executionManager = persistence.NewWorkflowExecutionPersistenceFaultInjectionClient(
+		s.mockExecutionMgr, injector)
+
+	waitCh := make(chan struct{})
+
+	taskID := int64(100)
+	timerTask := &persistence.TimerTaskInfo{WorkflowID: "wid", RunID: "rid", TaskID: taskID,
+		TaskType: persistence.TaskTypeDecisionTimeout, TimeoutType: int(workflow.TimeoutType_START_TO_CLOSE),
+		VisibilityTimestamp: time.Now(),
+		EventID:             decisionScheduledEvent.GetEventId()}
+	timerIndexResponse := &persistence.GetTimerIndexTasksResponse{Timers: []*persistence.TimerTaskInfo{timerTask}}
+
+	s.mockExecutionMgr.On("GetTimerIndexTasks", mock.Anything).Return(timerIndexResponse, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(
+		&persistence.GetWorkflowExecutionResponse{State: createMutableState(builder)}, nil).Once()
+	s.mockExecutionMgr.On("GetTimerIndexTasks", mock.Anything).Return(
+		&persistence.GetTimerIndexTasksResponse{Timers: []*persistence.TimerTaskInfo{}}, nil)
+	s.mockShardManager.On("UpdateShard", mock.Anything).Return(nil)
+
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil).Run(func(arguments mock.Arguments) {
+		// Done.
+		waitCh <- struct{}{}
+	}).Once()
+
+	processor := newTimerQueueProcessor(s.mockShard, s.mockHistoryEngine, s.mockExecutionMgr, s.logger).(*timerQueueProcessorImpl)
+	processor.Start()
+
+	processor.NotifyNewTimer([]persistence.Task{&persistence.DecisionTimeoutTask{
+		VisibilityTimestamp: timerTask.VisibilityTimestamp,
+		EventID:             timerTask.EventID,
+	}})
+
+	<-waitCh
+	processor.Stop()
+	s.Equal(1, injector.Injected("GetWorkflowExecution"))
+}
+
+func (s *timerQueueProcessor2Suite) TestNotifyNewTimerCoalescing() {
+	processor := newTimerQueueProcessor(s.mockShard, s.mockHistoryEngine, s.mockExecutionMgr, s.logger).(*timerQueueProcessorImpl)
+	now := time.Now()
+	newTimer := func(ts time.Time) persistence.Task {
+		return &persistence.UserTimerTask{VisibilityTimestamp: ts}
+	}
+	hasNotification := func() bool {
+		select {
+		case <-processor.newTimerCh:
+			return true
+		default:
+			return false
+		}
+	}
+
+	// Processor is not sleeping on a known timer, notification goes through with the earliest timer of the batch.
+	processor.NotifyNewTimer([]persistence.Task{newTimer(now.Add(2 * time.Minute)), newTimer(now.Add(time.Minute))})
+	s.True(hasNotification())
+	s.Equal(now.Add(time.Minute).UnixNano(), processor.minPendingTimer.UnixNano())
+
+	// Later timers are coalesced into the pending notification.
+	processor.NotifyNewTimer([]persistence.Task{newTimer(now.Add(3 * time.Minute))})
+	s.False(hasNotification())
+	s.Equal(now.Add(time.Minute).UnixNano(), processor.minPendingTimer.UnixNano())
+
+	// Processor is scheduled to wake up before the new timer fires, no need to wake it up.
+	processor.minPendingTimer = time.Time{}
+	processor.sleepHorizon = now.Add(time.Minute).UnixNano()
+	processor.NotifyNewTimer([]persistence.Task{newTimer(now.Add(2 * time.Minute))})
+	s.False(hasNotification())
+	s.True(processor.minPendingTimer.IsZero())
+
+	// New timer fires before the processor is scheduled to wake up.
+	processor.NotifyNewTimer([]persistence.Task{newTimer(now.Add(30 * time.Second))})
+	s.True(hasNotification())
+	s.Equal(now.Add(30*time.Second).UnixNano(), processor.minPendingTimer.UnixNano())
+}
+
+func (s *timerQueueProcessor2Suite) TestTimerAckLevelKeptUntilRangeDeleted() {
+	ackMgr := newTimerAckMgr(nil, s.mockShard, s.mockExecutionMgr, s.logger)
+	deletedLevel := s.mockShard.GetTimerAckLevel()
+	taskID := SequenceID{VisibilityTimestamp: time.Now(), TaskID: 1}
+	ackMgr.tracker.addTask(taskID)
+	ackMgr.completeTimerTask(taskID)
+	s.mockShardManager.On("UpdateShard", mock.Anything).Return(nil)
+
+	// The fired timers are deleted again by the next update after a failed range delete
+	s.mockExecutionMgr.On("RangeCompleteTimerTask", &persistence.RangeCompleteTimerTaskRequest{
+		InclusiveBeginTimestamp: deletedLevel,
+		ExclusiveEndTimestamp:   taskID.VisibilityTimestamp,
+	}).Return(errors.New("FAILED")).Once()
+	ackMgr.updateAckLevel()
+	s.Equal(deletedLevel, s.mockShard.GetTimerAckLevel())
+
+	s.mockExecutionMgr.On("RangeCompleteTimerTask", &persistence.RangeCompleteTimerTaskRequest{
+		InclusiveBeginTimestamp: deletedLevel,
+		ExclusiveEndTimestamp:   taskID.VisibilityTimestamp,
+	}).Return(nil).Once()
+	ackMgr.updateAckLevel()
+	s.Equal(taskID.VisibilityTimestamp, s.mockShard.GetTimerAckLevel())
+
+	// Nothing is deleted while the ack level does not move
+	ackMgr.updateAckLevel()
+	s.Equal(taskID.VisibilityTimestamp, s.mockShard.GetTimerAckLevel())
+}
@@ -35,6 +35,7 @@ import (
 	"github.com/uber-common/bark"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/idgen"
 )
 
 type (
@@ -71,8 +72,8 @@ func (s *timerQueueProcessorSuite) SetupSuite() {
 	s.mockMetadataMgr = &mocks.MetadataManager{}
 	historyCache := newHistoryCache(historyCacheMaxSize, s.ShardContext, s.logger)
 	historyCache.disabled = true
-	domainCache := cache.NewDomainCache(s.mockMetadataMgr, s.logger)
-	txProcessor := newTransferQueueProcessor(s.ShardContext, s.mockVisibilityMgr, &mocks.MatchingClient{}, &mocks.HistoryClient{}, historyCache, domainCache)
+	domainCache := cache.NewDomainCache(s.mockMetadataMgr, s.logger, s.ShardContext.GetMetricsClient())
+	txProcessor := newTransferQueueProcessor(s.ShardContext, s.mockVisibilityMgr, &mocks.MatchingClient{}, &mocks.HistoryClient{}, historyCache, domainCache, 0, nil, nil, nil)
 	s.engineImpl = &historyEngineImpl{
 		shard:              s.ShardContext,
 		historyMgr:         s.HistoryMgr,
@@ -82,6 +83,7 @@ func (s *timerQueueProcessorSuite) SetupSuite() {
 		logger:             s.logger,
 		tokenSerializer:    common.NewJSONTaskTokenSerializer(),
 		hSerializerFactory: persistence.NewHistorySerializerFactory(),
+		idGenerator:        idgen.NewRandomGenerator(),
 	}
 }
 
@@ -797,7 +799,7 @@ func (s *timerQueueProcessorSuite) TestTimersOnClosedWorkflow() {
 }
 
 func (s *timerQueueProcessorSuite) printHistory(builder *mutableStateBuilder) string {
-	history, err := builder.hBuilder.Serialize()
+	history, err := builder.hBuilder.Serialize(persistence.NewJSONHistorySerializer())
 	if err != nil {
 		s.logger.Errorf("Error serializing history: %v", err)
 		return ""
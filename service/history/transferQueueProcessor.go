@@ -58,6 +58,7 @@ type (
 		historyClient     hc.Client
 		cache             *historyCache
 		domainCache       cache.DomainCache
+		registry          *transferTaskRegistry
 		rateLimiter       common.TokenBucket // Read rate limiter
 		appendCh          chan struct{}
 		isStarted         int32
@@ -66,12 +67,17 @@ type (
 		shutdownCh        chan struct{}
 		logger            bark.Logger
 		metricsClient     metrics.Client
+		closeCleanupDelay time.Duration
+		pauses            *taskProcessingPauses
+		callbackNotifier  *completionCallbackNotifier
+		typeMetrics       *workflowTypeMetrics
+		parkedLock        sync.Mutex
+		parkedTasks       []*persistence.TransferTaskInfo // tasks of paused domains, not completed yet
 	}
 
 	// ackManager is created by transferQueueProcessor to keep track of the transfer queue ackLevel for the shard.
-	// It keeps track of read level when dispatching transfer tasks to processor and maintains a map of outstanding tasks.
-	// Outstanding tasks map uses the task id sequencer as the key, which is used by updateAckLevel to move the ack level
-	// for the shard when all preceding tasks are acknowledged.
+	// Its tracker keeps track of read level when dispatching transfer tasks to processor and of the outstanding tasks,
+	// which is used by updateAckLevel to move the ack level for the shard when all preceding tasks are acknowledged.
 	ackManager struct {
 		processor     transferQueueProcessor
 		shard         ShardContext
@@ -80,15 +86,14 @@ type (
 		metricsClient metrics.Client
 
 		sync.RWMutex
-		outstandingTasks map[int64]bool
-		readLevel        int64
-		maxReadLevel     int64
-		ackLevel         int64
+		tracker *queueAckTracker
 	}
 )
 
 func newTransferQueueProcessor(shard ShardContext, visibilityMgr persistence.VisibilityManager, matching matching.Client,
-	historyClient hc.Client, cache *historyCache, domainCache cache.DomainCache) transferQueueProcessor {
+	historyClient hc.Client, cache *historyCache, domainCache cache.DomainCache,
+	closeCleanupDelay time.Duration, pauses *taskProcessingPauses,
+	callbackNotifier *completionCallbackNotifier, typeMetrics *workflowTypeMetrics) transferQueueProcessor {
 	executionManager := shard.GetExecutionManager()
 	logger := shard.GetLogger()
 	processor := &transferQueueProcessorImpl{
@@ -105,28 +110,54 @@ func newTransferQueueProcessor(shard ShardContext, visibilityMgr persistence.Vis
 		logger: logger.WithFields(bark.Fields{
 			logging.TagWorkflowComponent: logging.TagValueTransferQueueComponent,
 		}),
-		metricsClient: shard.GetMetricsClient(),
+		metricsClient:     shard.GetMetricsClient(),
+		closeCleanupDelay: closeCleanupDelay,
+		pauses:            pauses,
+		callbackNotifier:  callbackNotifier,
+		typeMetrics:       typeMetrics,
 	}
 	processor.ackMgr = newAckManager(processor, shard, executionManager, logger, shard.GetMetricsClient())
+	processor.registry = processor.newTransferTaskRegistry()
 
 	return processor
 }
 
+func (t *transferQueueProcessorImpl) newTransferTaskRegistry() *transferTaskRegistry {
+	registry := newTransferTaskRegistry(t.metricsClient)
+	registry.register(persistence.TransferTaskTypeActivityTask, metrics.TransferTaskActivityScope,
+		transferTaskHandlerFunc(t.processActivityTask))
+	registry.register(persistence.TransferTaskTypeDecisionTask, metrics.TransferTaskDecisionScope,
+		transferTaskHandlerFunc(t.processDecisionTask))
+	registry.register(persistence.TransferTaskTypeDeleteExecution, metrics.TransferTaskDeleteExecutionScope,
+		transferTaskHandlerFunc(t.processDeleteExecution))
+	registry.register(persistence.TransferTaskTypeCancelExecution, metrics.TransferTaskCancelExecutionScope,
+		transferTaskHandlerFunc(t.processCancelExecution))
+	registry.register(persistence.TransferTaskTypeStartChildExecution, metrics.TransferTaskStartChildExecutionScope,
+		transferTaskHandlerFunc(t.processStartChildExecution))
+	registry.register(persistence.TransferTaskTypeCompletionCallback, metrics.TransferTaskCompletionCallbackScope,
+		transferTaskHandlerFunc(t.processCompletionCallback))
+
+	return registry
+}
+
 func newAckManager(processor transferQueueProcessor, shard ShardContext, executionMgr persistence.ExecutionManager,
 	logger bark.Logger, metricsClient metrics.Client) *ackManager {
 	ackLevel := shard.GetTransferAckLevel()
 	return &ackManager{
-		processor:        processor,
-		shard:            shard,
-		executionMgr:     executionMgr,
-		outstandingTasks: make(map[int64]bool),
-		readLevel:        ackLevel,
-		ackLevel:         ackLevel,
-		logger:           logger,
-		metricsClient:    metricsClient,
+		processor:     processor,
+		shard:         shard,
+		executionMgr:  executionMgr,
+		tracker:       newQueueAckTracker(transferTaskSequenceID(ackLevel)),
+		logger:        logger,
+		metricsClient: metricsClient,
 	}
 }
 
+// transferTaskSequenceID returns the sequence ID of a transfer task, by which the tracker of the ack manager orders it
+func transferTaskSequenceID(taskID int64) SequenceID {
+	return SequenceID{TaskID: taskID}
+}
+
 func (t *transferQueueProcessorImpl) Start() {
 	if !atomic.CompareAndSwapInt32(&t.isStarted, 0, 1) {
 		return
@@ -186,6 +217,7 @@ processorPumpLoop:
 		case <-t.appendCh:
 			t.processTransferTasks(tasksCh)
 		case <-pollTimer.C:
+			t.resumeParkedTasks(tasksCh)
 			t.processTransferTasks(tasksCh)
 			pollTimer = time.NewTimer(transferProcessorMaxPollInterval)
 		case <-updateAckTimer.C:
@@ -206,12 +238,17 @@ processorPumpLoop:
 
 func (t *transferQueueProcessorImpl) processTransferTasks(tasksCh chan<- *persistence.TransferTaskInfo) {
 
+	if t.pauses.isShardPaused(transferTaskQueue, t.shard.GetShardID()) {
+		// The poll timer checks again later
+		return
+	}
+
 	if !t.rateLimiter.Consume(1, transferProcessorMaxPollInterval) {
 		t.NotifyNewTask() // re-enqueue the event
 		return
 	}
 
-	tasks, err := t.ackMgr.readTransferTasks()
+	tasks, moreTasks, err := t.ackMgr.readTransferTasks()
 
 	if err != nil {
 		t.logger.Warnf("Processor unable to retrieve transfer tasks: %v", err)
@@ -227,8 +264,7 @@ func (t *transferQueueProcessorImpl) processTransferTasks(tasksCh chan<- *persis
 		tasksCh <- tsk
 	}
 
-	if len(tasks) == transferTaskBatchSize {
-		// There might be more task
+	if moreTasks {
 		// We return now to yield, but enqueue an event to poll later
 		t.NotifyNewTask()
 	}
@@ -250,6 +286,13 @@ func (t *transferQueueProcessorImpl) taskWorker(tasksCh <-chan *persistence.Tran
 }
 
 func (t *transferQueueProcessorImpl) processTransferTask(task *persistence.TransferTaskInfo) {
+	if t.pauses.isTaskPaused(transferTaskQueue, t.shard.GetShardID(), task.DomainID) {
+		t.parkedLock.Lock()
+		t.parkedTasks = append(t.parkedTasks, task)
+		t.parkedLock.Unlock()
+		return
+	}
+
 	t.logger.Debugf("Processing transfer task: %v, type: %v", task.TaskID, task.TaskType)
 ProcessRetryLoop:
 	for retryCount := 1; retryCount <= 100; retryCount++ {
@@ -257,24 +300,12 @@ ProcessRetryLoop:
 		case <-t.shutdownCh:
 			return
 		default:
-			var err error
-			scope := metrics.TransferQueueProcessorScope
-			switch task.TaskType {
-			case persistence.TransferTaskTypeActivityTask:
-				scope = metrics.TransferTaskActivityScope
-				err = t.processActivityTask(task)
-			case persistence.TransferTaskTypeDecisionTask:
-				scope = metrics.TransferTaskDecisionScope
-				err = t.processDecisionTask(task)
-			case persistence.TransferTaskTypeDeleteExecution:
-				scope = metrics.TransferTaskDeleteExecutionScope
-				err = t.processDeleteExecution(task)
-			case persistence.TransferTaskTypeCancelExecution:
-				scope = metrics.TransferTaskCancelExecutionScope
-				err = t.processCancelExecution(task)
-			case persistence.TransferTaskTypeStartChildExecution:
-				scope = metrics.TransferTaskStartChildExecutionScope
-				err = t.processStartChildExecution(task)
+			scope, err := t.registry.process(task)
+			if err == errUnknownTransferTaskType {
+				// The task is left unacked, the ack level of the shard stays behind it until the shard is owned by a
+				// host which knows its type
+				t.logger.Errorf("No handler registered for transfer task: %v, type: %v", task.TaskID, task.TaskType)
+				return
 			}
 
 			if err != nil {
@@ -295,11 +326,27 @@ ProcessRetryLoop:
 		fmt.Sprintf("Retry count exceeded for transfer taskID: %v", task.TaskID), nil)
 }
 
-func (t *transferQueueProcessorImpl) processActivityTask(task *persistence.TransferTaskInfo) error {
-	t.metricsClient.IncCounter(metrics.TransferTaskActivityScope, metrics.TaskRequests)
-	sw := t.metricsClient.StartTimer(metrics.TransferTaskActivityScope, metrics.TaskLatency)
-	defer sw.Stop()
+// resumeParkedTasks dispatches again the parked tasks which are no longer paused
+func (t *transferQueueProcessorImpl) resumeParkedTasks(tasksCh chan<- *persistence.TransferTaskInfo) {
+	t.parkedLock.Lock()
+	var resumed []*persistence.TransferTaskInfo
+	parked := t.parkedTasks[:0]
+	for _, task := range t.parkedTasks {
+		if t.pauses.isTaskPaused(transferTaskQueue, t.shard.GetShardID(), task.DomainID) {
+			parked = append(parked, task)
+		} else {
+			resumed = append(resumed, task)
+		}
+	}
+	t.parkedTasks = parked
+	t.parkedLock.Unlock()
+
+	for _, task := range resumed {
+		tasksCh <- task
+	}
+}
 
+func (t *transferQueueProcessorImpl) processActivityTask(task *persistence.TransferTaskInfo) error {
 	var err error
 	domainID := task.DomainID
 	targetDomainID := task.TargetDomainID
@@ -349,10 +396,6 @@ func (t *transferQueueProcessorImpl) processActivityTask(task *persistence.Trans
 }
 
 func (t *transferQueueProcessorImpl) processDecisionTask(task *persistence.TransferTaskInfo) error {
-	t.metricsClient.IncCounter(metrics.TransferTaskDecisionScope, metrics.TaskRequests)
-	sw := t.metricsClient.StartTimer(metrics.TransferTaskDecisionScope, metrics.TaskLatency)
-	defer sw.Stop()
-
 	var err error
 	domainID := task.DomainID
 	execution := workflow.WorkflowExecution{
@@ -386,10 +429,6 @@ func (t *transferQueueProcessorImpl) processDecisionTask(task *persistence.Trans
 }
 
 func (t *transferQueueProcessorImpl) processDeleteExecution(task *persistence.TransferTaskInfo) error {
-	t.metricsClient.IncCounter(metrics.TransferTaskDeleteExecutionScope, metrics.TaskRequests)
-	sw := t.metricsClient.StartTimer(metrics.TransferTaskDeleteExecutionScope, metrics.TaskLatency)
-	defer sw.Stop()
-
 	var err error
 	domainID := task.DomainID
 	execution := workflow.WorkflowExecution{WorkflowId: common.StringPtr(task.WorkflowID),
@@ -463,10 +502,34 @@ func (t *transferQueueProcessorImpl) processDeleteExecution(task *persistence.Tr
 		Status:           getWorkflowExecutionCloseStatus(mb.executionInfo.CloseStatus),
 		HistoryLength:    mb.GetNextEventID(),
 		RetentionSeconds: retentionSeconds,
+		FirstRunID:       mb.getFirstExecutionRunID(),
+		TraceID:          mb.getTraceID(),
+		SearchAttributes: mb.executionInfo.SearchAttributes,
 	})
 	if err != nil {
 		return err
 	}
+	t.typeMetrics.recordClosed(t.domainName(task.DomainID), mb.executionInfo.WorkflowTypeName,
+		mb.executionInfo.LastUpdatedTimestamp.Sub(mb.executionInfo.StartTimestamp))
+
+	var timerTasks []persistence.Task
+	if retentionSeconds > 0 {
+		// Delete the history and the visibility record once the retention period has passed
+		timerTasks = append(timerTasks,
+			context.tBuilder.AddDeleteHistoryTask(time.Duration(retentionSeconds)*time.Second))
+	}
+	if t.closeCleanupDelay > 0 {
+		// Keep the mutable state around for a while so the closed execution can still be described and queried
+		timerTasks = append(timerTasks, context.tBuilder.AddDeleteExecutionTask(t.closeCleanupDelay))
+	}
+	if len(timerTasks) > 0 {
+		if err = context.updateClosedWorkflowExecution(timerTasks); err != nil {
+			return err
+		}
+	}
+	if t.closeCleanupDelay > 0 {
+		return nil
+	}
 
 	err = context.deleteWorkflowExecution()
 
@@ -474,10 +537,6 @@ func (t *transferQueueProcessorImpl) processDeleteExecution(task *persistence.Tr
 }
 
 func (t *transferQueueProcessorImpl) processCancelExecution(task *persistence.TransferTaskInfo) error {
-	t.metricsClient.IncCounter(metrics.TransferTaskCancelExecutionScope, metrics.TaskRequests)
-	sw := t.metricsClient.StartTimer(metrics.TransferTaskCancelExecutionScope, metrics.TaskLatency)
-	defer sw.Stop()
-
 	var err error
 	domainID := task.DomainID
 	targetDomainID := task.TargetDomainID
@@ -509,6 +568,8 @@ func (t *transferQueueProcessorImpl) processCancelExecution(task *persistence.Tr
 				RunId:      common.StringPtr(task.TargetRunID),
 			},
 			Identity: common.StringPtr("history-service"),
+			// The request ID identifies the cancel decision, so a retry of the task does not cancel the target again
+			RequestId: common.StringPtr(fmt.Sprintf("%v:%v", task.RunID, task.ScheduleID)),
 		},
 		ExternalInitiatedEventId: common.Int64Ptr(task.ScheduleID),
 		ExternalWorkflowExecution: &workflow.WorkflowExecution{
@@ -526,10 +587,6 @@ func (t *transferQueueProcessorImpl) processCancelExecution(task *persistence.Tr
 }
 
 func (t *transferQueueProcessorImpl) processStartChildExecution(task *persistence.TransferTaskInfo) error {
-	t.metricsClient.IncCounter(metrics.TransferTaskStartChildExecutionScope, metrics.TaskRequests)
-	sw := t.metricsClient.StartTimer(metrics.TransferTaskStartChildExecutionScope, metrics.TaskLatency)
-	defer sw.Stop()
-
 	var err error
 	domainID := task.DomainID
 	targetDomainID := task.TargetDomainID
@@ -630,6 +687,20 @@ func (t *transferQueueProcessorImpl) processStartChildExecution(task *persistenc
 	return err
 }
 
+func (t *transferQueueProcessorImpl) processCompletionCallback(task *persistence.TransferTaskInfo) error {
+	if t.callbackNotifier == nil {
+		t.logger.Warnf("Dropping completion callback of closed execution, no notifier configured.  WorkflowID: %v, "+
+			"RunID: %v", task.WorkflowID, task.RunID)
+		return nil
+	}
+
+	return t.callbackNotifier.notify(task.CallbackURL, &completionCallback{
+		DomainID:   task.DomainID,
+		WorkflowID: task.WorkflowID,
+		RunID:      task.RunID,
+	})
+}
+
 func (t *transferQueueProcessorImpl) recordWorkflowExecutionStarted(
 	execution workflow.WorkflowExecution, task *persistence.TransferTaskInfo) error {
 	context, release, err := t.cache.getOrCreateWorkflowExecution(task.DomainID, execution)
@@ -647,11 +718,30 @@ func (t *transferQueueProcessorImpl) recordWorkflowExecutionStarted(
 		Execution:        execution,
 		WorkflowTypeName: mb.executionInfo.WorkflowTypeName,
 		StartTimestamp:   mb.executionInfo.StartTimestamp.UnixNano(),
+		FirstRunID:       mb.getFirstExecutionRunID(),
+		TraceID:          mb.getTraceID(),
+		SearchAttributes: mb.executionInfo.SearchAttributes,
 	})
+	if err == nil {
+		t.typeMetrics.recordStarted(t.domainName(task.DomainID), mb.executionInfo.WorkflowTypeName)
+	}
 
 	return err
 }
 
+// domainName returns the name of the domain used to tag the workflow type metrics, the domain ID if the domain cannot
+// be found
+func (t *transferQueueProcessorImpl) domainName(domainID string) string {
+	if !t.typeMetrics.enabled() {
+		return domainID
+	}
+	info, _, err := t.domainCache.GetDomainByID(domainID)
+	if err != nil {
+		return domainID
+	}
+	return info.Name
+}
+
 func (t *transferQueueProcessorImpl) recordChildExecutionStarted(task *persistence.TransferTaskInfo,
 	context *workflowExecutionContext, initiatedAttributes *workflow.StartChildWorkflowExecutionInitiatedEventAttributes,
 	runID string) error {
@@ -749,71 +839,67 @@ Update_History_Loop:
 	return ErrMaxAttemptsExceeded
 }
 
-func (a *ackManager) readTransferTasks() ([]*persistence.TransferTaskInfo, error) {
+// readTransferTasks reads the next batch of tasks above the read level and moves the read level past them.  It also
+// returns whether more tasks are ready to be read.
+func (a *ackManager) readTransferTasks() ([]*persistence.TransferTaskInfo, bool, error) {
 	a.RLock()
-	rLevel := a.readLevel
+	rLevel := a.tracker.getReadLevel()
 	a.RUnlock()
 	response, err := a.executionMgr.GetTransferTasks(&persistence.GetTransferTasksRequest{
-		ReadLevel:    rLevel,
+		ReadLevel:    rLevel.TaskID,
 		MaxReadLevel: a.shard.GetTransferMaxReadLevel(),
 		BatchSize:    transferTaskBatchSize,
 	})
 
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
 	tasks := response.Tasks
 	if len(tasks) == 0 {
-		return tasks, nil
+		return tasks, false, nil
 	}
 
 	a.Lock()
 	for _, task := range tasks {
-		if a.readLevel >= task.TaskID {
+		if readLevel := a.tracker.getReadLevel(); readLevel.TaskID >= task.TaskID {
 			a.logger.Fatalf("Next task ID is less than current read level.  TaskID: %v, ReadLevel: %v", task.TaskID,
-				a.readLevel)
+				readLevel.TaskID)
 		}
 		a.logger.Debugf("Moving read level: %v", task.TaskID)
-		a.readLevel = task.TaskID
-		a.outstandingTasks[a.readLevel] = false
+		a.tracker.addTask(transferTaskSequenceID(task.TaskID))
 	}
 	a.Unlock()
 
-	return tasks, nil
+	return tasks, len(response.NextPageToken) != 0, nil
 }
 
 func (a *ackManager) completeTask(taskID int64) {
 	a.Lock()
-	if _, ok := a.outstandingTasks[taskID]; ok {
-		a.outstandingTasks[taskID] = true
-	}
+	a.tracker.completeTask(transferTaskSequenceID(taskID))
 	a.Unlock()
 }
 
 func (a *ackManager) updateAckLevel() {
 	a.metricsClient.IncCounter(metrics.TransferQueueProcessorScope, metrics.AckLevelUpdateCounter)
-	updatedAckLevel := a.ackLevel
 	a.Lock()
-MoveAckLevelLoop:
-	for current := a.ackLevel + 1; current <= a.readLevel; current++ {
-		if acked, ok := a.outstandingTasks[current]; ok {
-			if acked {
-				err := a.executionMgr.CompleteTransferTask(&persistence.CompleteTransferTaskRequest{TaskID: current})
-
-				if err != nil {
-					a.logger.Warnf("Processor unable to complete transfer task '%v': %v", current, err)
-					break MoveAckLevelLoop
-				}
-				a.logger.Debugf("Updating ack level: %v", current)
-				a.ackLevel = current
-				updatedAckLevel = current
-				delete(a.outstandingTasks, current)
-			} else {
-				break MoveAckLevelLoop
-			}
+	ackLevel := a.tracker.getAckLevel().TaskID
+	newAckLevel := a.tracker.nextAckLevel().TaskID
+
+	// All the tasks up to the new ack level are complete, they are deleted with a single call
+	if newAckLevel > ackLevel {
+		err := a.executionMgr.RangeCompleteTransferTask(&persistence.RangeCompleteTransferTaskRequest{
+			ExclusiveBeginTaskID: ackLevel,
+			InclusiveEndTaskID:   newAckLevel,
+		})
+		if err != nil {
+			a.logger.Warnf("Processor unable to complete transfer tasks up to '%v': %v", newAckLevel, err)
+		} else {
+			a.logger.Debugf("Updating ack level: %v", newAckLevel)
+			a.tracker.moveAckLevel(transferTaskSequenceID(newAckLevel))
 		}
 	}
+	updatedAckLevel := a.tracker.getAckLevel().TaskID
 	a.Unlock()
 
 	// Always update ackLevel to detect if the shared is stolen
@@ -70,8 +70,8 @@ func (s *transferQueueProcessorSuite) SetupSuite() {
 	s.mockVisibilityMgr = &mocks.VisibilityManager{}
 	s.mockMetadataMgr = &mocks.MetadataManager{}
 	historyCache := newHistoryCache(historyCacheMaxSize, s.ShardContext, s.logger)
-	domainCache := cache.NewDomainCache(s.mockMetadataMgr, s.logger)
-	s.processor = newTransferQueueProcessor(s.ShardContext, s.mockVisibilityMgr, s.mockMatching, s.mockHistoryClient, historyCache, domainCache).(*transferQueueProcessorImpl)
+	domainCache := cache.NewDomainCache(s.mockMetadataMgr, s.logger, s.ShardContext.GetMetricsClient())
+	s.processor = newTransferQueueProcessor(s.ShardContext, s.mockVisibilityMgr, s.mockMatching, s.mockHistoryClient, historyCache, domainCache, 0, nil, nil, nil).(*transferQueueProcessorImpl)
 }
 
 func (s *transferQueueProcessorSuite) TearDownSuite() {
@@ -0,0 +1,90 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+)
+
+// errUnknownTransferTaskType is returned for a transfer task of a type which has no registered handler
+var errUnknownTransferTaskType = errors.New("no handler registered for the transfer task type")
+
+type (
+	// transferTaskHandlerFunc adapts a plain function to the transferTaskHandler interface
+	transferTaskHandlerFunc func(task *persistence.TransferTaskInfo) error
+
+	// transferTaskRegistration binds a handler to the metrics scope used for its task type
+	transferTaskRegistration struct {
+		scope   int
+		handler transferTaskHandler
+	}
+
+	// transferTaskRegistry maps each transfer task type to the handler responsible for processing it.
+	// New task types are supported by registering a handler instead of extending the processor itself.
+	transferTaskRegistry struct {
+		handlers      map[int]transferTaskRegistration
+		metricsClient metrics.Client
+	}
+)
+
+func (f transferTaskHandlerFunc) process(task *persistence.TransferTaskInfo) error {
+	return f(task)
+}
+
+func newTransferTaskRegistry(metricsClient metrics.Client) *transferTaskRegistry {
+	return &transferTaskRegistry{
+		handlers:      make(map[int]transferTaskRegistration),
+		metricsClient: metricsClient,
+	}
+}
+
+// register adds the handler for the given task type.  Registering the same task type twice is a programming error.
+func (r *transferTaskRegistry) register(taskType int, scope int, handler transferTaskHandler) {
+	if _, ok := r.handlers[taskType]; ok {
+		panic(fmt.Sprintf("Transfer task handler already registered for task type: %v", taskType))
+	}
+	r.handlers[taskType] = transferTaskRegistration{scope: scope, handler: handler}
+}
+
+func (r *transferTaskRegistry) get(taskType int) (transferTaskRegistration, bool) {
+	registration, ok := r.handlers[taskType]
+	return registration, ok
+}
+
+// process runs the handler registered for the type of the task, and returns the metrics scope of the task type along
+// with the error of the handler.  A task of an unknown type is counted and fails with errUnknownTransferTaskType.
+func (r *transferTaskRegistry) process(task *persistence.TransferTaskInfo) (int, error) {
+	registration, ok := r.get(task.TaskType)
+	if !ok {
+		r.metricsClient.IncCounter(metrics.TransferQueueProcessorScope, metrics.TransferTaskUnknownTypeCounter)
+		return metrics.TransferQueueProcessorScope, errUnknownTransferTaskType
+	}
+
+	r.metricsClient.IncCounter(registration.scope, metrics.TaskRequests)
+	sw := r.metricsClient.StartTimer(registration.scope, metrics.TaskLatency)
+	defer sw.Stop()
+
+	return registration.scope, registration.handler.process(task)
+}
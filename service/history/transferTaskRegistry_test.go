@@ -0,0 +1,137 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+)
+
+func TestTransferTaskRegistryRegister(t *testing.T) {
+	noop := transferTaskHandlerFunc(func(task *persistence.TransferTaskInfo) error { return nil })
+	tests := []struct {
+		name      string
+		taskTypes []int
+		panics    bool
+	}{
+		{name: "single", taskTypes: []int{persistence.TransferTaskTypeActivityTask}},
+		{name: "distinct", taskTypes: []int{persistence.TransferTaskTypeActivityTask,
+			persistence.TransferTaskTypeDecisionTask}},
+		{name: "duplicate", taskTypes: []int{persistence.TransferTaskTypeActivityTask,
+			persistence.TransferTaskTypeActivityTask}, panics: true},
+	}
+
+	for _, test := range tests {
+		registry := newTransferTaskRegistry(metrics.NewClient(tally.NoopScope, metrics.History))
+		register := func() {
+			for _, taskType := range test.taskTypes {
+				registry.register(taskType, metrics.TransferTaskActivityScope, noop)
+			}
+		}
+		if test.panics {
+			require.Panics(t, register, test.name)
+			continue
+		}
+		require.NotPanics(t, register, test.name)
+		for _, taskType := range test.taskTypes {
+			registration, ok := registry.get(taskType)
+			require.True(t, ok, test.name)
+			require.Equal(t, metrics.TransferTaskActivityScope, registration.scope, test.name)
+		}
+	}
+}
+
+func TestTransferTaskRegistryProcess(t *testing.T) {
+	errHandler := errors.New("handler failed")
+	tests := []struct {
+		name          string
+		taskType      int
+		scope         int
+		err           error
+		processed     []int
+		requests      int64
+		unknownTypes  int64
+		requestsScope string
+	}{
+		{
+			name:          "activity",
+			taskType:      persistence.TransferTaskTypeActivityTask,
+			scope:         metrics.TransferTaskActivityScope,
+			processed:     []int{persistence.TransferTaskTypeActivityTask},
+			requests:      1,
+			requestsScope: "TransferTaskActivity",
+		},
+		{
+			name:          "decision failed",
+			taskType:      persistence.TransferTaskTypeDecisionTask,
+			scope:         metrics.TransferTaskDecisionScope,
+			err:           errHandler,
+			processed:     []int{persistence.TransferTaskTypeDecisionTask},
+			requests:      1,
+			requestsScope: "TransferTaskDecision",
+		},
+		{
+			name:         "unknown type",
+			taskType:     persistence.TransferTaskTypeCancelExecution,
+			scope:        metrics.TransferQueueProcessorScope,
+			err:          errUnknownTransferTaskType,
+			unknownTypes: 1,
+		},
+	}
+
+	for _, test := range tests {
+		scope := tally.NewTestScope("", nil)
+		registry := newTransferTaskRegistry(metrics.NewClient(scope, metrics.History))
+		var processed []int
+		registry.register(persistence.TransferTaskTypeActivityTask, metrics.TransferTaskActivityScope,
+			transferTaskHandlerFunc(func(task *persistence.TransferTaskInfo) error {
+				processed = append(processed, task.TaskType)
+				return nil
+			}))
+		registry.register(persistence.TransferTaskTypeDecisionTask, metrics.TransferTaskDecisionScope,
+			transferTaskHandlerFunc(func(task *persistence.TransferTaskInfo) error {
+				processed = append(processed, task.TaskType)
+				return errHandler
+			}))
+
+		taskScope, err := registry.process(&persistence.TransferTaskInfo{TaskID: 1, TaskType: test.taskType})
+		require.Equal(t, test.scope, taskScope, test.name)
+		require.Equal(t, test.err, err, test.name)
+		require.Equal(t, test.processed, processed, test.name)
+
+		counters := scope.Snapshot().Counters()
+		if test.requests > 0 {
+			require.Equal(t, test.requests,
+				counters["task.requests+operation="+test.requestsScope].Value(), test.name)
+		}
+		unknownTypes, ok := counters["transfer-task.unknown-type+operation=TransferQueueProcessor"]
+		require.Equal(t, test.unknownTypes > 0, ok, test.name)
+		if ok {
+			require.Equal(t, test.unknownTypes, unknownTypes.Value(), test.name)
+		}
+	}
+}
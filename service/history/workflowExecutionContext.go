@@ -22,14 +22,15 @@ package history
 
 import (
 	"fmt"
-	"sync"
 	"time"
 
 	"github.com/uber/cadence/.gen/go/history"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/locks"
 	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 
 	"github.com/uber-common/bark"
@@ -44,14 +45,30 @@ type (
 		executionManager  persistence.ExecutionManager
 		logger            bark.Logger
 
-		sync.Mutex
+		locks.Mutex
 		msBuilder       *mutableStateBuilder
 		tBuilder        *timerBuilder
 		updateCondition int64
 		deleteTimerTask persistence.Task
+		// msBuilderCached is true when the last load of the mutable state was served from the cache, rather than
+		// read from persistence
+		msBuilderCached bool
+		// newRunHistory is the history of the run started by continue as new, it is appended together with the
+		// events of the current run
+		newRunHistory *persistence.AppendHistoryEventsRequest
+		// heartbeatFlushTimes tracks when heartbeat progress of an activity was last persisted, keyed by schedule ID
+		heartbeatFlushTimes map[int64]time.Time
 	}
 )
 
+const (
+	// activityHeartbeatMaxFlushInterval is the longest time heartbeat progress is kept only in the cached mutable
+	// state before it is persisted
+	activityHeartbeatMaxFlushInterval = 10 * time.Second
+	// executionUpdateSizeWarnLimit is the size of an update above which a warning is logged
+	executionUpdateSizeWarnLimit = 1024 * 1024
+)
+
 var (
 	persistenceOperationRetryPolicy = common.CreatePersistanceRetryPolicy()
 )
@@ -64,21 +81,27 @@ func newWorkflowExecutionContext(domainID string, execution workflow.WorkflowExe
 	})
 	tBuilder := newTimerBuilder(lg, common.NewRealTimeSource())
 
-	return &workflowExecutionContext{
-		domainID:          domainID,
-		workflowExecution: execution,
-		shard:             shard,
-		executionManager:  executionManager,
-		tBuilder:          tBuilder,
-		logger:            lg,
+	context := &workflowExecutionContext{
+		domainID:            domainID,
+		workflowExecution:   execution,
+		shard:               shard,
+		executionManager:    executionManager,
+		tBuilder:            tBuilder,
+		logger:              lg,
+		heartbeatFlushTimes: make(map[int64]time.Time),
 	}
+	context.SetMonitor(shard.GetLockMonitor(), metrics.HistoryExecutionLockScope,
+		fmt.Sprintf("%v/%v", execution.GetWorkflowId(), execution.GetRunId()))
+	return context
 }
 
 func (c *workflowExecutionContext) loadWorkflowExecution() (*mutableStateBuilder, error) {
 	if c.msBuilder != nil {
+		c.msBuilderCached = true
 		return c.msBuilder, nil
 	}
 
+	c.msBuilderCached = false
 	response, err := c.getWorkflowExecutionWithRetry(&persistence.GetWorkflowExecutionRequest{
 		DomainID:  c.domainID,
 		Execution: c.workflowExecution,
@@ -100,6 +123,25 @@ func (c *workflowExecutionContext) loadWorkflowExecution() (*mutableStateBuilder
 	return msBuilder, nil
 }
 
+// loadExecutionInfo returns the execution info of the workflow.  When the mutable state is not loaded yet only the
+// execution info is read, and the mutable state is left unloaded.
+func (c *workflowExecutionContext) loadExecutionInfo() (*persistence.WorkflowExecutionInfo, error) {
+	if c.msBuilder != nil {
+		return c.msBuilder.executionInfo, nil
+	}
+
+	response, err := c.getWorkflowExecutionWithRetry(&persistence.GetWorkflowExecutionRequest{
+		DomainID:   c.domainID,
+		Execution:  c.workflowExecution,
+		Projection: persistence.MutableStateProjectionExecutionInfo,
+	})
+	if err != nil {
+		logging.LogPersistantStoreErrorEvent(c.logger, logging.TagValueStoreOperationGetWorkflowExecution, err, "")
+		return nil, err
+	}
+	return response.State.ExecutionInfo, nil
+}
+
 func (c *workflowExecutionContext) updateWorkflowExecutionWithContext(context []byte, transferTasks []persistence.Task,
 	timerTasks []persistence.Task, transactionID int64) error {
 	c.msBuilder.executionInfo.ExecutionContext = context
@@ -119,36 +161,29 @@ func (c *workflowExecutionContext) updateWorkflowExecution(transferTasks []persi
 	// Take a snapshot of all updates we have accumulated for this execution
 	updates := c.msBuilder.CloseUpdateSession()
 
+	var appendRequests []*persistence.AppendHistoryEventsRequest
+	if c.newRunHistory != nil {
+		appendRequests = append(appendRequests, c.newRunHistory)
+		c.newRunHistory = nil
+	}
+
 	builder := updates.newEventsBuilder
 	if builder.history != nil && len(builder.history) > 0 {
 		// Some operations only update the mutable state. For example RecordActivityTaskHeartbeat.
 		firstEvent := builder.history[0]
-		serializedHistory, err := builder.Serialize()
+		serializedHistory, err := builder.Serialize(c.shard.GetHistorySerializer())
 		if err != nil {
 			logging.LogHistorySerializationErrorEvent(c.logger, err, "Unable to serialize execution history for update.")
 			return err
 		}
 
-		if err0 := c.shard.AppendHistoryEvents(&persistence.AppendHistoryEventsRequest{
+		appendRequests = append(appendRequests, &persistence.AppendHistoryEventsRequest{
 			DomainID:      c.domainID,
 			Execution:     c.workflowExecution,
 			TransactionID: transactionID,
 			FirstEventID:  firstEvent.GetEventId(),
 			Events:        serializedHistory,
-		}); err0 != nil {
-			// Clear all cached state in case of error
-			c.clear()
-
-			switch err0.(type) {
-			case *persistence.ConditionFailedError:
-				return ErrConflict
-			}
-
-			logging.LogPersistantStoreErrorEvent(c.logger, logging.TagValueStoreOperationUpdateWorkflowExecution, err0,
-				fmt.Sprintf("{updateCondition: %v}", c.updateCondition))
-			return err0
-		}
-
+		})
 	}
 
 	continueAsNew := updates.continueAsNew
@@ -157,8 +192,16 @@ func (c *workflowExecutionContext) updateWorkflowExecution(transferTasks []persi
 		// Workflow execution completed as part of this transaction.
 		// Also transactionally delete workflow execution representing current run for the execution
 		deleteExecution = true
+
+		// Notify the completion callback of the workflow, unless it is succeeded by a new run which inherits it,
+		// like the run started by continue as new or by a reset
+		if c.msBuilder.executionInfo.CompletionCallbackURL != "" && continueAsNew == nil {
+			transferTasks = append(transferTasks, &persistence.CompletionCallbackTask{
+				CallbackURL: c.msBuilder.executionInfo.CompletionCallbackURL,
+			})
+		}
 	}
-	if err1 := c.updateWorkflowExecutionWithRetry(&persistence.UpdateWorkflowExecutionRequest{
+	request := &persistence.UpdateWorkflowExecutionRequest{
 		ExecutionInfo:             c.msBuilder.executionInfo,
 		TransferTasks:             transferTasks,
 		TimerTasks:                timerTasks,
@@ -170,21 +213,71 @@ func (c *workflowExecutionContext) updateWorkflowExecution(transferTasks []persi
 		DeleteTimerInfos:          updates.deleteTimerInfos,
 		UpsertChildExecutionInfos: updates.updateChildExecutionInfos,
 		DeleteChildExecutionInfo:  updates.deleteChildExecutionInfo,
+		UpsertSignalRequestedIDs:  updates.updateSignalRequestedIDs,
+		DeleteSignalRequestedIDs:  updates.deleteSignalRequestedIDs,
 		ContinueAsNew:             continueAsNew,
 		CloseExecution:            deleteExecution,
-	}); err1 != nil {
+	}
+
+	historySize := 0
+	for _, r := range appendRequests {
+		historySize += len(r.Events.Data)
+	}
+	// An update too large to be written is rejected before any of its history is appended
+	stats := persistence.NewMutableStateUpdateSessionStats(request)
+	if size := stats.MutableStateSize + historySize; size > common.ExecutionUpdateSizeLimit {
+		// Clear all cached state, the rejected changes are part of it
+		c.clear()
+		c.shard.GetMetricsClient().IncCounter(metrics.HistoryExecutionUpdateScope,
+			metrics.ExecutionUpdateSizeLimitExceededCounter)
+		c.logger.Errorf("Workflow execution update of %v bytes exceeds the size limit of %v bytes.  "+
+			"MutableStateSize: %v, HistorySize: %v", size, common.ExecutionUpdateSizeLimit, stats.MutableStateSize,
+			historySize)
+		return &workflow.BadRequestError{
+			Message: fmt.Sprintf("Workflow execution update of %v bytes exceeds the size limit of %v bytes.", size,
+				common.ExecutionUpdateSizeLimit),
+		}
+	}
+
+	if len(appendRequests) > 0 {
+		var err0 error
+		if len(appendRequests) == 1 {
+			err0 = c.shard.AppendHistoryEvents(appendRequests[0])
+		} else {
+			// Write the history of all the runs in a single request
+			err0 = c.shard.AppendHistoryEventsBatch(appendRequests)
+		}
+		if err0 != nil {
+			// Clear all cached state in case of error
+			c.clear()
+
+			switch err0.(type) {
+			case *persistence.ConditionFailedError:
+				return c.conflictError()
+			}
+
+			logging.LogPersistantStoreErrorEvent(c.logger, logging.TagValueStoreOperationUpdateWorkflowExecution, err0,
+				fmt.Sprintf("{updateCondition: %v}", c.updateCondition))
+			return err0
+		}
+
+	}
+
+	response, err1 := c.updateWorkflowExecutionWithRetry(request)
+	if err1 != nil {
 		// Clear all cached state in case of error
 		c.clear()
 
 		switch err1.(type) {
 		case *persistence.ConditionFailedError:
-			return ErrConflict
+			return c.conflictError()
 		}
 
 		logging.LogPersistantStoreErrorEvent(c.logger, logging.TagValueStoreOperationUpdateWorkflowExecution, err1,
 			fmt.Sprintf("{updateCondition: %v}", c.updateCondition))
 		return err1
 	}
+	c.emitUpdateStats(response.MutableStateUpdateSessionStats, historySize)
 
 	// Update went through so update the condition for new updates
 	c.updateCondition = c.msBuilder.GetNextEventID()
@@ -192,6 +285,26 @@ func (c *workflowExecutionContext) updateWorkflowExecution(transferTasks []persi
 	return nil
 }
 
+// updateClosedWorkflowExecution persists timer tasks of an execution which has already closed.  Unlike
+// updateWorkflowExecution it never deletes the current execution, which may belong to a newer run by now.
+func (c *workflowExecutionContext) updateClosedWorkflowExecution(timerTasks []persistence.Task) error {
+	if _, err := c.updateWorkflowExecutionWithRetry(&persistence.UpdateWorkflowExecutionRequest{
+		ExecutionInfo: c.msBuilder.executionInfo,
+		TimerTasks:    timerTasks,
+		Condition:     c.updateCondition,
+	}); err != nil {
+		// Clear all cached state in case of error
+		c.clear()
+
+		switch err.(type) {
+		case *persistence.ConditionFailedError:
+			return c.conflictError()
+		}
+		return err
+	}
+	return nil
+}
+
 func (c *workflowExecutionContext) continueAsNewWorkflowExecution(context []byte, newStateBuilder *mutableStateBuilder,
 	transferTasks []persistence.Task, transactionID int64) error {
 
@@ -203,7 +316,7 @@ func (c *workflowExecutionContext) continueAsNewWorkflowExecution(context []byte
 	firstEvent := newStateBuilder.hBuilder.history[0]
 
 	// Serialize the history
-	serializedHistory, serializedError := newStateBuilder.hBuilder.Serialize()
+	serializedHistory, serializedError := newStateBuilder.hBuilder.Serialize(c.shard.GetHistorySerializer())
 	if serializedError != nil {
 		logging.LogHistorySerializationErrorEvent(c.logger, serializedError, fmt.Sprintf(
 			"HistoryEventBatch serialization error on start workflow.  WorkflowID: %v, RunID: %v", newExecution.GetWorkflowId(),
@@ -211,7 +324,7 @@ func (c *workflowExecutionContext) continueAsNewWorkflowExecution(context []byte
 		return serializedError
 	}
 
-	err1 := c.shard.AppendHistoryEvents(&persistence.AppendHistoryEventsRequest{
+	c.newRunHistory = &persistence.AppendHistoryEventsRequest{
 		DomainID:  domainID,
 		Execution: newExecution,
 		// It is ok to use 0 for TransactionID because RunID is unique so there are
@@ -219,9 +332,6 @@ func (c *workflowExecutionContext) continueAsNewWorkflowExecution(context []byte
 		TransactionID: 0,
 		FirstEventID:  firstEvent.GetEventId(),
 		Events:        serializedHistory,
-	})
-	if err1 != nil {
-		return err1
 	}
 
 	err2 := c.updateWorkflowExecutionWithContext(context, transferTasks, nil, transactionID)
@@ -257,7 +367,7 @@ func (c *workflowExecutionContext) getWorkflowExecutionWithRetry(
 		return err
 	}
 
-	err := backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
+	err := backoff.Retry(op, persistenceOperationRetryPolicy, persistence.IsTransientError)
 	if err != nil {
 		return nil, err
 	}
@@ -266,12 +376,43 @@ func (c *workflowExecutionContext) getWorkflowExecutionWithRetry(
 }
 
 func (c *workflowExecutionContext) updateWorkflowExecutionWithRetry(
-	request *persistence.UpdateWorkflowExecutionRequest) error {
+	request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error) {
+	var response *persistence.UpdateWorkflowExecutionResponse
 	op := func() error {
-		return c.shard.UpdateWorkflowExecution(request)
+		var err error
+		response, err = c.shard.UpdateWorkflowExecution(request)
+		return err
+	}
+
+	err := backoff.Retry(op, persistenceOperationRetryPolicy, persistence.IsTransientError)
+	if err != nil {
+		return nil, err
 	}
 
-	return backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
+	return response, nil
+}
+
+// emitUpdateStats emits the statistics of an update, as reported by persistence, together with the size of the
+// history it appended
+func (c *workflowExecutionContext) emitUpdateStats(stats *persistence.MutableStateUpdateSessionStats,
+	historySize int) {
+	if stats == nil {
+		return
+	}
+
+	metricsClient := c.shard.GetMetricsClient()
+	scope := metrics.HistoryExecutionUpdateScope
+	metricsClient.AddCounter(scope, metrics.ExecutionUpdateMutableStateSizeCounter, int64(stats.MutableStateSize))
+	metricsClient.AddCounter(scope, metrics.ExecutionUpdateHistorySizeCounter, int64(historySize))
+	metricsClient.AddCounter(scope, metrics.ExecutionUpdateTransferTasksCounter, int64(stats.TransferTasksCount))
+	metricsClient.AddCounter(scope, metrics.ExecutionUpdateTimerTasksCounter, int64(stats.TimerTasksCount))
+
+	if size := stats.MutableStateSize + historySize; size > executionUpdateSizeWarnLimit {
+		c.logger.Warnf("Workflow execution update of %v bytes exceeds the warning size of %v bytes.  "+
+			"ExecutionInfoSize: %v, ActivityInfoSize: %v, TimerInfoSize: %v, ChildInfoSize: %v, HistorySize: %v",
+			size, executionUpdateSizeWarnLimit, stats.ExecutionInfoSize, stats.ActivityInfoSize, stats.TimerInfoSize,
+			stats.ChildInfoSize, historySize)
+	}
 }
 
 func (c *workflowExecutionContext) deleteWorkflowExecutionWithRetry(
@@ -280,14 +421,12 @@ func (c *workflowExecutionContext) deleteWorkflowExecutionWithRetry(
 		return c.executionManager.DeleteWorkflowExecution(request)
 	}
 
-	return backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
+	return backoff.Retry(op, persistenceOperationRetryPolicy, persistence.IsTransientError)
 }
 
 // Few problems with this approach.
 //   https://github.com/uber/cadence/issues/145
-//  (1) On the target workflow we can generate more than one cancel request if we end up retrying because of intermittent
-//	errors. We might want to have deduping logic internally to avoid that.
-//  (2) For single cancel transfer task we can generate more than one ExternalWorkflowExecutionCancelRequested event in the
+//  (1) For single cancel transfer task we can generate more than one ExternalWorkflowExecutionCancelRequested event in the
 //	history if we fail to delete transfer task and retry again. We need some logic to look back at the event
 //      state in mutable state when we are processing this transfer task.
 //      This means for one single ExternalWorkflowExecutionCancelInitiated we can see a
@@ -300,7 +439,7 @@ func (c *workflowExecutionContext) requestExternalCancelWorkflowExecutionWithRet
 		return historyClient.RequestCancelWorkflowExecution(nil, request)
 	}
 
-	err := backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
+	err := backoff.Retry(op, persistenceOperationRetryPolicy, persistence.IsTransientError)
 	if err == nil {
 		// We succeeded in request to cancel workflow.
 		if c.msBuilder.AddExternalWorkflowExecutionCancelRequested(
@@ -342,7 +481,42 @@ func (c *workflowExecutionContext) requestExternalCancelWorkflowExecutionWithRet
 	return err
 }
 
+// conflictError returns the error of an update which failed its condition.  The failure is a stale hit of the history
+// cache when the mutable state was served from the cache, as the execution was then updated by another host since
+// the mutable state was read.
+func (c *workflowExecutionContext) conflictError() error {
+	if c.msBuilderCached {
+		c.shard.GetMetricsClient().IncCounter(metrics.HistoryExecutionUpdateScope,
+			metrics.HistoryCacheStaleHitCounter)
+	}
+	return ErrConflict
+}
+
 func (c *workflowExecutionContext) clear() {
 	c.msBuilder = nil
 	c.tBuilder = newTimerBuilder(c.logger, common.NewRealTimeSource())
+	c.heartbeatFlushTimes = make(map[int64]time.Time)
+}
+
+// isHeartbeatFlushDue returns true if heartbeat progress of the activity needs to be persisted.  Otherwise heartbeats
+// only update the cached mutable state and are written along with the next update of the execution, so frequent
+// heartbeats do not rewrite the execution row every time.  Progress is persisted at least twice per heartbeat timeout
+// so a reload of the mutable state never results in a heartbeat timeout for an activity which kept heartbeating.
+func (c *workflowExecutionContext) isHeartbeatFlushDue(ai *persistence.ActivityInfo, now time.Time) bool {
+	lastFlush, ok := c.heartbeatFlushTimes[ai.ScheduleID]
+	if !ok {
+		return true
+	}
+
+	flushInterval := activityHeartbeatMaxFlushInterval
+	if ai.HeartbeatTimeout > 0 {
+		if timeoutInterval := time.Duration(ai.HeartbeatTimeout) * time.Second / 2; timeoutInterval < flushInterval {
+			flushInterval = timeoutInterval
+		}
+	}
+	return now.Sub(lastFlush) >= flushInterval
+}
+
+func (c *workflowExecutionContext) heartbeatFlushed(scheduleID int64, now time.Time) {
+	c.heartbeatFlushTimes[scheduleID] = now
 }
@@ -0,0 +1,259 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/uber-common/bark"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// workflowResetter rebuilds the mutable state of the run created by a reset from the history of the base run.
+	// The events of the base run up to the reset point are replayed into the mutable state of the new run, and
+	// become the first events of its history.
+	workflowResetter struct {
+		msBuilder       *mutableStateBuilder
+		pendingChildren map[int64]bool
+	}
+)
+
+func newWorkflowResetter(baseInfo *persistence.WorkflowExecutionInfo, runID, requestID string,
+	logger bark.Logger) *workflowResetter {
+	msBuilder := newMutableStateBuilder(logger)
+	info := msBuilder.executionInfo
+	info.DomainID = baseInfo.DomainID
+	info.WorkflowID = baseInfo.WorkflowID
+	info.RunID = runID
+	info.ParentDomainID = baseInfo.ParentDomainID
+	info.ParentWorkflowID = baseInfo.ParentWorkflowID
+	info.ParentRunID = baseInfo.ParentRunID
+	info.InitiatedID = baseInfo.InitiatedID
+	info.TaskList = baseInfo.TaskList
+	info.WorkflowTypeName = baseInfo.WorkflowTypeName
+	info.DecisionTimeoutValue = baseInfo.DecisionTimeoutValue
+	info.CreateRequestID = requestID
+	info.DecisionScheduleID = emptyEventID
+	info.DecisionStartedID = emptyEventID
+	info.DecisionRequestID = emptyUUID
+	info.CompletionCallbackURL = baseInfo.CompletionCallbackURL
+	info.FirstExecutionRunID = baseInfo.FirstExecutionRunID
+	if info.FirstExecutionRunID == "" {
+		// the base run was created before the chains of executions were recorded
+		info.FirstExecutionRunID = baseInfo.RunID
+	}
+	info.TraceID = baseInfo.TraceID
+	if info.TraceID == "" {
+		// the base run was created before the trace IDs were recorded
+		info.TraceID = info.FirstExecutionRunID
+	}
+	info.SearchAttributes = baseInfo.SearchAttributes
+
+	return &workflowResetter{
+		msBuilder:       msBuilder,
+		pendingChildren: make(map[int64]bool),
+	}
+}
+
+// replay applies the events to the mutable state of the new run and copies them to its history.  The events must
+// be the history of the base run from its first event.
+func (r *workflowResetter) replay(events []*workflow.HistoryEvent) error {
+	for _, event := range events {
+		if event.GetEventId() != r.msBuilder.GetNextEventID() {
+			return &workflow.InternalServiceError{
+				Message: fmt.Sprintf("Unexpected event ID %v in history of base run, expected %v.",
+					event.GetEventId(), r.msBuilder.GetNextEventID()),
+			}
+		}
+		if err := r.replayEvent(event); err != nil {
+			return err
+		}
+		r.msBuilder.hBuilder.history = append(r.msBuilder.hBuilder.history, event)
+		r.msBuilder.executionInfo.NextEventID++
+	}
+
+	if len(r.pendingChildren) > 0 {
+		return &workflow.BadRequestError{
+			Message: "Workflow execution cannot be reset to a point where it has pending child executions."}
+	}
+	return nil
+}
+
+func (r *workflowResetter) replayEvent(event *workflow.HistoryEvent) error {
+	msBuilder := r.msBuilder
+	eventID := event.GetEventId()
+	switch event.GetEventType() {
+	case workflow.EventType_DecisionTaskScheduled:
+		attributes := event.GetDecisionTaskScheduledEventAttributes()
+		msBuilder.UpdateDecision(&decisionInfo{
+			ScheduleID:      eventID,
+			StartedID:       emptyEventID,
+			RequestID:       emptyUUID,
+			DecisionTimeout: attributes.GetStartToCloseTimeoutSeconds(),
+		})
+
+	case workflow.EventType_DecisionTaskStarted:
+		msBuilder.executionInfo.DecisionStartedID = eventID
+		msBuilder.executionInfo.DecisionRequestID = event.GetDecisionTaskStartedEventAttributes().GetRequestId()
+		msBuilder.executionInfo.State = persistence.WorkflowStateRunning
+
+	case workflow.EventType_DecisionTaskCompleted:
+		attributes := event.GetDecisionTaskCompletedEventAttributes()
+		msBuilder.executionInfo.LastProcessedEvent = attributes.GetStartedEventId()
+		msBuilder.executionInfo.ExecutionContext = attributes.GetExecutionContext()
+		msBuilder.DeleteDecision()
+
+	case workflow.EventType_DecisionTaskFailed, workflow.EventType_DecisionTaskTimedOut:
+		msBuilder.DeleteDecision()
+
+	case workflow.EventType_ActivityTaskScheduled:
+		attributes := event.GetActivityTaskScheduledEventAttributes()
+		scheduledEvent, err := msBuilder.eventSerializer.Serialize(event)
+		if err != nil {
+			return err
+		}
+		// The timeouts are defaulted the same way as when the activity was scheduled
+		ai := &persistence.ActivityInfo{
+			ScheduleID:     eventID,
+			ScheduledEvent: scheduledEvent,
+			StartedID:      emptyEventID,
+			ActivityID:     attributes.GetActivityId(),
+			ScheduleToStartTimeout: defaultTimeoutSeconds(attributes.GetScheduleToStartTimeoutSeconds(),
+				DefaultScheduleToStartActivityTimeoutInSecs),
+			ScheduleToCloseTimeout: defaultTimeoutSeconds(attributes.GetScheduleToCloseTimeoutSeconds(),
+				DefaultScheduleToCloseActivityTimeoutInSecs),
+			StartToCloseTimeout: defaultTimeoutSeconds(attributes.GetStartToCloseTimeoutSeconds(),
+				DefaultStartToCloseActivityTimeoutInSecs),
+			HeartbeatTimeout: attributes.GetHeartbeatTimeoutSeconds(),
+			CancelRequestID:  emptyEventID,
+		}
+		msBuilder.pendingActivityInfoIDs[eventID] = ai
+		msBuilder.pendingActivityInfoByActivityID[ai.ActivityID] = eventID
+
+	case workflow.EventType_ActivityTaskStarted:
+		attributes := event.GetActivityTaskStartedEventAttributes()
+		ai, ok := msBuilder.GetActivityInfo(attributes.GetScheduledEventId())
+		if !ok {
+			return r.unknownEventError(event)
+		}
+		startedEvent, err := msBuilder.eventSerializer.Serialize(event)
+		if err != nil {
+			return err
+		}
+		ai.StartedID = eventID
+		ai.StartedEvent = startedEvent
+		ai.RequestID = attributes.GetRequestId()
+		ai.LastHeartBeatUpdatedTime = time.Unix(0, event.GetTimestamp())
+
+	case workflow.EventType_ActivityTaskCompleted:
+		return r.deleteActivity(event, event.GetActivityTaskCompletedEventAttributes().GetScheduledEventId())
+	case workflow.EventType_ActivityTaskFailed:
+		return r.deleteActivity(event, event.GetActivityTaskFailedEventAttributes().GetScheduledEventId())
+	case workflow.EventType_ActivityTaskTimedOut:
+		return r.deleteActivity(event, event.GetActivityTaskTimedOutEventAttributes().GetScheduledEventId())
+	case workflow.EventType_ActivityTaskCanceled:
+		return r.deleteActivity(event, event.GetActivityTaskCanceledEventAttributes().GetScheduledEventId())
+
+	case workflow.EventType_ActivityTaskCancelRequested:
+		attributes := event.GetActivityTaskCancelRequestedEventAttributes()
+		if ai, ok := msBuilder.GetActivityByActivityID(attributes.GetActivityId()); ok {
+			ai.CancelRequested = true
+			ai.CancelRequestID = eventID
+		}
+
+	case workflow.EventType_TimerStarted:
+		attributes := event.GetTimerStartedEventAttributes()
+		fireTimeout := time.Duration(attributes.GetStartToFireTimeoutSeconds()) * time.Second
+		msBuilder.pendingTimerInfoIDs[attributes.GetTimerId()] = &persistence.TimerInfo{
+			TimerID:    attributes.GetTimerId(),
+			ExpiryTime: time.Unix(0, event.GetTimestamp()).Add(fireTimeout),
+			StartedID:  eventID,
+			TaskID:     emptyTimerID,
+		}
+
+	case workflow.EventType_TimerFired:
+		delete(msBuilder.pendingTimerInfoIDs, event.GetTimerFiredEventAttributes().GetTimerId())
+	case workflow.EventType_TimerCanceled:
+		delete(msBuilder.pendingTimerInfoIDs, event.GetTimerCanceledEventAttributes().GetTimerId())
+
+	case workflow.EventType_StartChildWorkflowExecutionInitiated:
+		r.pendingChildren[eventID] = true
+	case workflow.EventType_StartChildWorkflowExecutionFailed:
+		delete(r.pendingChildren, event.GetStartChildWorkflowExecutionFailedEventAttributes().GetInitiatedEventId())
+	case workflow.EventType_ChildWorkflowExecutionCompleted:
+		delete(r.pendingChildren, event.GetChildWorkflowExecutionCompletedEventAttributes().GetInitiatedEventId())
+	case workflow.EventType_ChildWorkflowExecutionFailed:
+		delete(r.pendingChildren, event.GetChildWorkflowExecutionFailedEventAttributes().GetInitiatedEventId())
+	case workflow.EventType_ChildWorkflowExecutionCanceled:
+		delete(r.pendingChildren, event.GetChildWorkflowExecutionCanceledEventAttributes().GetInitiatedEventId())
+	case workflow.EventType_ChildWorkflowExecutionTimedOut:
+		delete(r.pendingChildren, event.GetChildWorkflowExecutionTimedOutEventAttributes().GetInitiatedEventId())
+	case workflow.EventType_ChildWorkflowExecutionTerminated:
+		delete(r.pendingChildren, event.GetChildWorkflowExecutionTerminatedEventAttributes().GetInitiatedEventId())
+	}
+
+	return nil
+}
+
+func (r *workflowResetter) deleteActivity(event *workflow.HistoryEvent, scheduleID int64) error {
+	ai, ok := r.msBuilder.GetActivityInfo(scheduleID)
+	if !ok {
+		return r.unknownEventError(event)
+	}
+	delete(r.msBuilder.pendingActivityInfoIDs, scheduleID)
+	delete(r.msBuilder.pendingActivityInfoByActivityID, ai.ActivityID)
+	return nil
+}
+
+func (r *workflowResetter) unknownEventError(event *workflow.HistoryEvent) error {
+	return &workflow.InternalServiceError{
+		Message: fmt.Sprintf("Event %v of type %v in history of base run refers to an unknown activity.",
+			event.GetEventId(), event.GetEventType()),
+	}
+}
+
+// activityInfos returns the activities pending in the new run
+func (r *workflowResetter) activityInfos() []*persistence.ActivityInfo {
+	var infos []*persistence.ActivityInfo
+	for _, ai := range r.msBuilder.pendingActivityInfoIDs {
+		infos = append(infos, ai)
+	}
+	return infos
+}
+
+// timerInfos returns the user timers pending in the new run
+func (r *workflowResetter) timerInfos() []*persistence.TimerInfo {
+	var infos []*persistence.TimerInfo
+	for _, ti := range r.msBuilder.pendingTimerInfoIDs {
+		infos = append(infos, ti)
+	}
+	return infos
+}
+
+func defaultTimeoutSeconds(timeout, defaultTimeout int32) int32 {
+	if timeout <= 0 {
+		return defaultTimeout
+	}
+	return timeout
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service/config"
+)
+
+const defaultMaxWorkflowTypesPerDomain = 100
+
+type (
+	// workflowTypeMetrics emits the start, close and latency metrics of the workflows tagged by domain and workflow
+	// type.  The number of workflow types tagged with their own name is capped per domain to bound the cardinality of
+	// the metrics, the types seen once the cap is reached sharing the "other" tag.  A nil workflowTypeMetrics emits
+	// nothing.
+	workflowTypeMetrics struct {
+		sync.RWMutex
+		metricsClient metrics.Client
+		domains       map[string]bool
+		maxTypes      int
+		clients       map[string]*domainTypeMetrics
+	}
+
+	// domainTypeMetrics are the metrics clients of the workflow types of a domain
+	domainTypeMetrics struct {
+		types map[string]metrics.Client
+		other metrics.Client
+	}
+)
+
+func newWorkflowTypeMetrics(cfg config.WorkflowTypeMetrics, metricsClient metrics.Client) *workflowTypeMetrics {
+	m := &workflowTypeMetrics{
+		metricsClient: metricsClient,
+		maxTypes:      cfg.MaxTypesPerDomain,
+		clients:       make(map[string]*domainTypeMetrics),
+	}
+	if m.maxTypes <= 0 {
+		m.maxTypes = defaultMaxWorkflowTypesPerDomain
+	}
+	if len(cfg.Domains) > 0 {
+		m.domains = make(map[string]bool, len(cfg.Domains))
+		for _, domain := range cfg.Domains {
+			m.domains[domain] = true
+		}
+	}
+	return m
+}
+
+func (m *workflowTypeMetrics) enabled() bool {
+	return m != nil
+}
+
+// recordStarted counts a workflow of the type started in the domain
+func (m *workflowTypeMetrics) recordStarted(domain string, workflowType string) {
+	if client := m.client(domain, workflowType); client != nil {
+		client.IncCounter(metrics.HistoryWorkflowTypeScope, metrics.WorkflowStartedCounter)
+	}
+}
+
+// recordClosed counts a workflow of the type closed in the domain and records the time it took from start to close
+func (m *workflowTypeMetrics) recordClosed(domain string, workflowType string, latency time.Duration) {
+	if client := m.client(domain, workflowType); client != nil {
+		client.IncCounter(metrics.HistoryWorkflowTypeScope, metrics.WorkflowClosedCounter)
+		client.RecordTimer(metrics.HistoryWorkflowTypeScope, metrics.WorkflowEndToEndLatency, latency)
+	}
+}
+
+// client returns the metrics client tagged with the domain and the workflow type, nil if the metrics of the domain
+// are not tagged
+func (m *workflowTypeMetrics) client(domain string, workflowType string) metrics.Client {
+	if m == nil || (m.domains != nil && !m.domains[domain]) {
+		return nil
+	}
+
+	m.RLock()
+	client := m.clients[domain].get(workflowType, m.maxTypes)
+	m.RUnlock()
+	if client != nil {
+		return client
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	types, ok := m.clients[domain]
+	if !ok {
+		types = &domainTypeMetrics{types: make(map[string]metrics.Client)}
+		m.clients[domain] = types
+	}
+	if client := types.get(workflowType, m.maxTypes); client != nil {
+		return client
+	}
+
+	if len(types.types) < m.maxTypes {
+		client = m.newClient(domain, workflowType)
+		types.types[workflowType] = client
+		return client
+	}
+	types.other = m.newClient(domain, metrics.OtherWorkflowTypeTagValue)
+	return types.other
+}
+
+func (m *workflowTypeMetrics) newClient(domain string, workflowTypeTag string) metrics.Client {
+	return m.metricsClient.Tagged(map[string]string{
+		metrics.DomainTagName:       domain,
+		metrics.WorkflowTypeTagName: workflowTypeTag,
+	})
+}
+
+// get returns the metrics client of the workflow type, the one of the other types if the cap is reached, nil if the
+// client remains to be created
+func (d *domainTypeMetrics) get(workflowType string, maxTypes int) metrics.Client {
+	if d == nil {
+		return nil
+	}
+	if client, ok := d.types[workflowType]; ok {
+		return client
+	}
+	if len(d.types) >= maxTypes {
+		return d.other
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service/config"
+)
+
+func TestWorkflowTypeMetricsCardinalityCap(t *testing.T) {
+	scope := tally.NewTestScope("", nil)
+	m := newWorkflowTypeMetrics(config.WorkflowTypeMetrics{
+		Enabled:           true,
+		Domains:           []string{"domain"},
+		MaxTypesPerDomain: 2,
+	}, metrics.NewClient(scope, metrics.History))
+
+	for _, workflowType := range []string{"type-a", "type-b", "type-a", "type-c", "type-d"} {
+		m.recordStarted("domain", workflowType)
+	}
+	m.recordClosed("domain", "type-c", time.Second)
+	m.recordStarted("untagged-domain", "type-a")
+
+	started := func(workflowType string) int64 {
+		counter, ok := scope.Snapshot().Counters()["workflow.started+domain=domain,operation=WorkflowType,workflow_type="+
+			workflowType]
+		if !ok {
+			return 0
+		}
+		return counter.Value()
+	}
+	require.Equal(t, int64(2), started("type-a"))
+	require.Equal(t, int64(1), started("type-b"))
+	require.Equal(t, int64(0), started("type-c"))
+	require.Equal(t, int64(2), started(metrics.OtherWorkflowTypeTagValue))
+
+	closed, ok := scope.Snapshot().Counters()["workflow.closed+domain=domain,operation=WorkflowType,workflow_type=other"]
+	require.True(t, ok)
+	require.Equal(t, int64(1), closed.Value())
+	for key := range scope.Snapshot().Counters() {
+		require.NotContains(t, key, "untagged-domain")
+	}
+}
+
+func TestWorkflowTypeMetricsDisabled(t *testing.T) {
+	var m *workflowTypeMetrics
+	require.False(t, m.enabled())
+	require.Nil(t, m.client("domain", "type-a"))
+	m.recordStarted("domain", "type-a")
+}
@@ -26,9 +26,11 @@ import (
 	"github.com/uber-go/tally"
 	m "github.com/uber/cadence/.gen/go/matching"
 	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/errors"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/config"
 	"github.com/uber/tchannel-go/thrift"
 )
 
@@ -39,7 +41,9 @@ type Handler struct {
 	taskPersistence persistence.TaskManager
 	engine          Engine
 	metricsClient   metrics.Client
-	startWG         sync.WaitGroup
+	// dispatchRateLimit is the limit of the rate of the tasks dispatched by each task list
+	dispatchRateLimit config.DispatchRateLimit
+	startWG           sync.WaitGroup
 	service.Service
 }
 
@@ -62,11 +66,17 @@ func (h *Handler) Start(thriftService []thrift.TChanServer) error {
 		return err
 	}
 	h.metricsClient = h.Service.GetMetricsClient()
-	h.engine = NewEngine(h.taskPersistence, history, h.Service.GetLogger())
+	h.engine = NewEngine(h.taskPersistence, history, h.Service.GetLogger(), h.metricsClient, h.dispatchRateLimit)
 	h.startWG.Done()
 	return nil
 }
 
+// SetDispatchRateLimit sets the limit of the rate of the tasks dispatched by each task list.  It must be called before
+// Start.
+func (h *Handler) SetDispatchRateLimit(dispatchRateLimit config.DispatchRateLimit) {
+	h.dispatchRateLimit = dispatchRateLimit
+}
+
 // Stop stops the handler
 func (h *Handler) Stop() {
 	h.engine.Stop()
@@ -132,30 +142,51 @@ func (h *Handler) PollForDecisionTask(ctx thrift.Context,
 	return response, h.handleErr(error, scope)
 }
 
+// DrainTaskList - discards the backlog of a task list.
+func (h *Handler) DrainTaskList(ctx thrift.Context,
+	drainRequest *m.DrainTaskListRequest) (*m.DrainTaskListResponse, error) {
+
+	scope := metrics.MatchingDrainTaskListScope
+	sw := h.startRequestProfile("DrainTaskList", scope)
+	defer sw.Stop()
+
+	response, err := h.engine.DrainTaskList(drainRequest)
+	if err != nil {
+		return nil, h.handleErr(err, scope)
+	}
+	if !drainRequest.GetDryRun() {
+		h.metricsClient.IncCounter(scope, metrics.DrainTaskListCounter)
+		h.metricsClient.AddCounter(scope, metrics.DrainedTasksCounter, response.GetDrainedTasks())
+	}
+	return response, nil
+}
+
+// QueryWorkflow - queries a workflow through a poller of its decision task list.
+func (h *Handler) QueryWorkflow(ctx thrift.Context,
+	queryRequest *m.QueryWorkflowRequest) (*gen.QueryWorkflowResponse, error) {
+
+	scope := metrics.MatchingQueryWorkflowScope
+	sw := h.startRequestProfile("QueryWorkflow", scope)
+	defer sw.Stop()
+
+	response, err := h.engine.QueryWorkflow(ctx, queryRequest)
+	return response, h.handleErr(err, scope)
+}
+
+// RespondQueryTaskCompleted - delivers the answer to a query task.
+func (h *Handler) RespondQueryTaskCompleted(ctx thrift.Context, request *m.RespondQueryTaskCompletedRequest) error {
+	scope := metrics.MatchingRespondQueryTaskCompletedScope
+	sw := h.startRequestProfile("RespondQueryTaskCompleted", scope)
+	defer sw.Stop()
+	return h.handleErr(h.engine.RespondQueryTaskCompleted(request), scope)
+}
+
 func (h *Handler) handleErr(err error, scope int) error {
 
 	if err == nil {
 		return nil
 	}
 
-	switch err.(type) {
-	case *gen.InternalServiceError:
-		h.metricsClient.IncCounter(scope, metrics.CadenceFailures)
-		return err
-	case *gen.BadRequestError:
-		h.metricsClient.IncCounter(scope, metrics.CadenceErrBadRequestCounter)
-		return err
-	case *gen.EntityNotExistsError:
-		h.metricsClient.IncCounter(scope, metrics.CadenceErrEntityNotExistsCounter)
-		return err
-	case *gen.WorkflowExecutionAlreadyStartedError:
-		h.metricsClient.IncCounter(scope, metrics.CadenceErrExecutionAlreadyStartedCounter)
-		return err
-	case *gen.DomainAlreadyExistsError:
-		h.metricsClient.IncCounter(scope, metrics.CadenceErrDomainAlreadyExistsCounter)
-		return err
-	default:
-		h.metricsClient.IncCounter(scope, metrics.CadenceFailures)
-		return &gen.InternalServiceError{Message: err.Error()}
-	}
+	h.metricsClient.IncCounter(scope, errors.MetricCounter(errors.CodeOf(err)))
+	return errors.ToThrift(err)
 }
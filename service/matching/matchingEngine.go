@@ -37,7 +37,9 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/config"
 	"github.com/uber/tchannel-go/thrift"
 )
 
@@ -49,10 +51,22 @@ type matchingEngineImpl struct {
 	historyService             history.Client
 	tokenSerializer            common.TaskTokenSerializer
 	rangeSize                  int64
+	prefetchBufferMaxBytes     int64
 	logger                     bark.Logger
+	metricsClient              metrics.Client
 	longPollExpirationInterval time.Duration
+	dispatchRateLimit          config.DispatchRateLimit
 	taskListsLock              sync.RWMutex                   // locks mutation of taskLists
 	taskLists                  map[taskListID]taskListManager // Convert to LRU cache
+	// Pending queries keyed by the query task ID, each waiting for the answer of a worker on its channel
+	queryMapLock   sync.Mutex
+	queryResultMap map[string]chan *workflow.RespondQueryTaskCompletedRequest
+}
+
+// queryTaskInfo is a query handed to a poller of the decision task list of the queried workflow
+type queryTaskInfo struct {
+	queryID      string
+	queryRequest *m.QueryWorkflowRequest
 }
 
 type taskListID struct {
@@ -96,17 +110,22 @@ func (t *taskListID) String() string {
 var _ Engine = (*matchingEngineImpl)(nil) // Asserts that interface is indeed implemented
 
 // NewEngine creates an instance of matching engine
-func NewEngine(taskManager persistence.TaskManager, historyService history.Client, logger bark.Logger) Engine {
+func NewEngine(taskManager persistence.TaskManager, historyService history.Client, logger bark.Logger,
+	metricsClient metrics.Client, dispatchRateLimit config.DispatchRateLimit) Engine {
 	return &matchingEngineImpl{
 		taskManager:                taskManager,
 		historyService:             historyService,
 		tokenSerializer:            common.NewJSONTaskTokenSerializer(),
 		taskLists:                  make(map[taskListID]taskListManager),
+		queryResultMap:             make(map[string]chan *workflow.RespondQueryTaskCompletedRequest),
 		rangeSize:                  defaultRangeSize,
+		prefetchBufferMaxBytes:     defaultPrefetchBufferMaxBytes,
 		longPollExpirationInterval: defaultLongPollExpirationInterval,
+		dispatchRateLimit:          dispatchRateLimit,
 		logger: logger.WithFields(bark.Fields{
 			logging.TagWorkflowComponent: logging.TagValueMatchingEngineComponent,
 		}),
+		metricsClient: metricsClient,
 	}
 }
 
@@ -234,7 +253,7 @@ pollLoop:
 		}
 
 		taskList := newTaskListID(domainID, taskListName, persistence.TaskListTypeDecision)
-		tCtx, err := e.getTask(ctx, taskList)
+		tCtx, err := e.getTask(ctx, taskList, request.GetIdentity(), request.GetTaskListMetadata())
 		if err != nil {
 			// TODO: Is empty poll the best reply for errPumpClosed?
 			if err == ErrNoTasks || err == errPumpClosed {
@@ -243,6 +262,24 @@ pollLoop:
 			return nil, err
 		}
 
+		if tCtx.queryTaskInfo != nil {
+			// Query tasks are not recorded in the history, the worker only needs the history to replay it
+			queryTask := tCtx.queryTaskInfo
+			nextEventResp, err := e.historyService.GetWorkflowExecutionNextEventID(ctx,
+				&h.GetWorkflowExecutionNextEventIDRequest{
+					DomainUUID: common.StringPtr(domainID),
+					Execution:  &tCtx.workflowExecution,
+				})
+			if err != nil {
+				e.deliverQueryResult(queryTask.queryID, &workflow.RespondQueryTaskCompletedRequest{
+					CompletedType: workflow.QueryTaskCompletedTypePtr(workflow.QueryTaskCompletedType_FAILED),
+					ErrorMessage:  common.StringPtr(err.Error()),
+				})
+				continue pollLoop
+			}
+			return e.createPollForDecisionTaskResponseForQuery(tCtx, taskListName, nextEventResp), nil
+		}
+
 		// Generate a unique requestId for this task which will be used for all retries
 		requestID := uuid.New()
 		resp, err := tCtx.RecordDecisionTaskStartedWithRetry(&h.RecordDecisionTaskStartedRequest{
@@ -285,7 +322,7 @@ pollLoop:
 		}
 
 		taskList := newTaskListID(domainID, taskListName, persistence.TaskListTypeActivity)
-		tCtx, err := e.getTask(ctx, taskList)
+		tCtx, err := e.getTask(ctx, taskList, request.GetIdentity(), request.GetTaskListMetadata())
 		if err != nil {
 			// TODO: Is empty poll the best reply for errPumpClosed?
 			if err == ErrNoTasks || err == errPumpClosed {
@@ -319,11 +356,120 @@ pollLoop:
 }
 
 // Loads a task from persistence and wraps it in a task context
-func (e *matchingEngineImpl) getTask(ctx thrift.Context, taskList *taskListID) (*taskContext, error) {
+// DrainTaskList discards the backlog of a task list. Unless it is a dry run the request has to be confirmed with
+// the name of the task list.
+func (e *matchingEngineImpl) DrainTaskList(request *m.DrainTaskListRequest) (*m.DrainTaskListResponse, error) {
+	domainID := request.GetDomainUUID()
+	taskListName := request.GetTaskList().GetName()
+	if domainID == "" {
+		return nil, &workflow.BadRequestError{Message: "DomainUUID is not set on request."}
+	}
+	if taskListName == "" {
+		return nil, &workflow.BadRequestError{Message: "TaskList is not set on request."}
+	}
+	if !request.IsSetTaskListType() {
+		return nil, &workflow.BadRequestError{Message: "TaskListType is not set on request."}
+	}
+	if !request.GetDryRun() && request.GetConfirmation() != taskListName {
+		return nil, &workflow.BadRequestError{Message: "Confirmation does not match the task list name."}
+	}
+
+	taskType := persistence.TaskListTypeDecision
+	if request.GetTaskListType() == workflow.TaskListType_Activity {
+		taskType = persistence.TaskListTypeActivity
+	}
+	taskList := newTaskListID(domainID, taskListName, taskType)
+	e.logger.Infof("Received DrainTaskList for %v, dryRun=%v", taskList, request.GetDryRun())
+	tlMgr, err := e.getTaskListManager(taskList)
+	if err != nil {
+		return nil, err
+	}
+	return tlMgr.DrainBacklog(request.GetDryRun())
+}
+
+// QueryWorkflow hands the query over to a poller of the decision task list of the workflow execution and waits
+// for the worker to answer it with RespondQueryTaskCompleted.
+func (e *matchingEngineImpl) QueryWorkflow(ctx thrift.Context, queryRequest *m.QueryWorkflowRequest) (
+	*workflow.QueryWorkflowResponse, error) {
+	domainID := queryRequest.GetDomainUUID()
+	taskListName := queryRequest.GetTaskList().GetName()
+	if domainID == "" {
+		return nil, &workflow.BadRequestError{Message: "DomainUUID is not set on request."}
+	}
+	if taskListName == "" {
+		return nil, &workflow.BadRequestError{Message: "TaskList is not set on request."}
+	}
+	if queryRequest.GetQueryRequest().GetExecution() == nil {
+		return nil, &workflow.BadRequestError{Message: "Execution is not set on request."}
+	}
+
+	taskList := newTaskListID(domainID, taskListName, persistence.TaskListTypeDecision)
+	tlMgr, err := e.getTaskListManager(taskList)
+	if err != nil {
+		return nil, err
+	}
+
+	queryTask := &queryTaskInfo{queryID: uuid.New(), queryRequest: queryRequest}
+	queryResultCh := make(chan *workflow.RespondQueryTaskCompletedRequest, 1)
+	e.queryMapLock.Lock()
+	e.queryResultMap[queryTask.queryID] = queryResultCh
+	e.queryMapLock.Unlock()
+	defer e.deleteQueryTask(queryTask.queryID)
+
+	if err := tlMgr.SyncMatchQueryTask(ctx, queryTask); err != nil {
+		return nil, err
+	}
+
+	select {
+	case result := <-queryResultCh:
+		if result.GetCompletedType() == workflow.QueryTaskCompletedType_FAILED {
+			return nil, &workflow.QueryFailedError{Message: result.GetErrorMessage()}
+		}
+		return &workflow.QueryWorkflowResponse{QueryResult: result.GetQueryResult()}, nil
+	case <-ctx.Done():
+		return nil, &workflow.QueryFailedError{Message: "timeout: query on the workflow worker timed out"}
+	}
+}
+
+// RespondQueryTaskCompleted delivers the answer of the worker to the pending QueryWorkflow call of the query task.
+func (e *matchingEngineImpl) RespondQueryTaskCompleted(request *m.RespondQueryTaskCompletedRequest) error {
+	if !e.deliverQueryResult(request.GetTaskID(), request.GetCompletedRequest()) {
+		return &workflow.EntityNotExistsError{Message: "query task not found, or already expired"}
+	}
+	return nil
+}
+
+// Returns false if nobody waits for the result of the query task anymore
+func (e *matchingEngineImpl) deliverQueryResult(queryID string, result *workflow.RespondQueryTaskCompletedRequest) bool {
+	e.queryMapLock.Lock()
+	queryResultCh, ok := e.queryResultMap[queryID]
+	e.queryMapLock.Unlock()
+	if !ok {
+		return false
+	}
+	select {
+	case queryResultCh <- result:
+	default: // the query was already answered
+	}
+	return true
+}
+
+func (e *matchingEngineImpl) deleteQueryTask(queryID string) {
+	e.queryMapLock.Lock()
+	defer e.queryMapLock.Unlock()
+	delete(e.queryResultMap, queryID)
+}
+
+func (e *matchingEngineImpl) getTask(ctx thrift.Context, taskList *taskListID, identity string,
+	taskListMetadata *workflow.TaskListMetadata) (*taskContext, error) {
 	tlMgr, err := e.getTaskListManager(taskList)
 	if err != nil {
 		return nil, err
 	}
+	tlMgr.RecordPoller(identity)
+	if taskListMetadata != nil && taskListMetadata.IsSetMaxTasksPerSecond() {
+		tlMgr.UpdateMaxDispatch(taskListMetadata.GetMaxTasksPerSecond())
+	}
 	return tlMgr.GetTaskContext(ctx)
 }
 
@@ -362,6 +508,30 @@ func (e *matchingEngineImpl) createPollForDecisionTaskResponse(context *taskCont
 	return response
 }
 
+// Populate the decision task response of a query task based on the next event ID of the workflow execution.
+func (e *matchingEngineImpl) createPollForDecisionTaskResponseForQuery(context *taskContext, taskListName string,
+	nextEventResponse *h.GetWorkflowExecutionNextEventIDResponse) *m.PollForDecisionTaskResponse {
+	queryTask := context.queryTaskInfo
+
+	response := m.NewPollForDecisionTaskResponse()
+	response.WorkflowExecution = workflowExecutionPtr(context.workflowExecution)
+	token := &common.QueryTaskToken{
+		DomainID: queryTask.queryRequest.GetDomainUUID(),
+		TaskList: taskListName,
+		TaskID:   queryTask.queryID,
+	}
+	response.TaskToken, _ = e.tokenSerializer.SerializeQueryTaskToken(token)
+	response.WorkflowType = nextEventResponse.GetWorkflowType()
+	if nextEventResponse.GetPreviousStartedEventId() != common.EmptyEventID {
+		response.PreviousStartedEventId = nextEventResponse.PreviousStartedEventId
+	}
+	// The worker replays all events of the history to answer the query
+	response.StartedEventId = common.Int64Ptr(nextEventResponse.GetEventId() - 1)
+	response.Query = queryTask.queryRequest.GetQueryRequest().GetQuery()
+
+	return response
+}
+
 // Populate the activity task response based on context and scheduled/started events.
 func (e *matchingEngineImpl) createPollForActivityTaskResponse(context *taskContext,
 	historyResponse *h.RecordActivityTaskStartedResponse) *workflow.PollForActivityTaskResponse {
@@ -34,5 +34,8 @@ type (
 		AddActivityTask(addRequest *m.AddActivityTaskRequest) error
 		PollForDecisionTask(ctx thrift.Context, request *m.PollForDecisionTaskRequest) (*m.PollForDecisionTaskResponse, error)
 		PollForActivityTask(ctx thrift.Context, request *m.PollForActivityTaskRequest) (*workflow.PollForActivityTaskResponse, error)
+		DrainTaskList(request *m.DrainTaskListRequest) (*m.DrainTaskListResponse, error)
+		QueryWorkflow(ctx thrift.Context, request *m.QueryWorkflowRequest) (*workflow.QueryWorkflowResponse, error)
+		RespondQueryTaskCompleted(request *m.RespondQueryTaskCompletedRequest) error
 	}
 )
@@ -35,11 +35,13 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-common/bark"
+	"github.com/uber-go/tally"
 
 	gohistory "github.com/uber/cadence/.gen/go/history"
 	"github.com/uber/cadence/.gen/go/matching"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/tchannel-go/thrift"
@@ -108,10 +110,13 @@ func (s *matchingEngineSuite) newMatchingEngine(rangeSize int64) *matchingEngine
 		taskManager:                s.taskManager,
 		historyService:             s.historyClient,
 		taskLists:                  make(map[taskListID]taskListManager),
+		queryResultMap:             make(map[string]chan *workflow.RespondQueryTaskCompletedRequest),
 		logger:                     s.logger,
 		tokenSerializer:            common.NewJSONTaskTokenSerializer(),
 		longPollExpirationInterval: 100 * time.Second, //time.Millisecond,
 		rangeSize:                  rangeSize,
+		prefetchBufferMaxBytes:     defaultPrefetchBufferMaxBytes,
+		metricsClient:              metrics.NewClient(tally.NoopScope, metrics.Matching),
 	}
 }
 
@@ -167,6 +172,120 @@ func (s *matchingEngineSuite) TestAckManager() {
 	s.EqualValues(t4, m.getReadLevel())
 }
 
+func (s *matchingEngineSuite) TestPrefetchBuffer() {
+	task := &persistence.TaskInfo{DomainID: "domain", WorkflowID: "workflow", RunID: "run"}
+	b := newPrefetchBuffer(10*taskSize(task), metrics.NewClient(tally.NoopScope, metrics.Matching))
+	room := int(b.maxBytes / taskInfoOverhead)
+	s.Equal(room, b.nextBatchSize())
+
+	// a batch limited by the room left in the buffer does not grow the batch size, full batches do
+	b.batchRead(room, room)
+	s.Equal(defaultGetTasksBatchSize, b.batchSize)
+	b.batchRead(defaultGetTasksBatchSize, defaultGetTasksBatchSize)
+	s.Equal(2*defaultGetTasksBatchSize, b.batchSize)
+
+	// empty batches shrink it down to the minimum
+	for i := 0; i < 10; i++ {
+		b.batchRead(b.batchSize, 0)
+	}
+	s.Equal(minGetTasksBatchSize, b.batchSize)
+
+	for i := 0; i < 10; i++ {
+		s.True(b.hasRoom())
+		b.add(task)
+	}
+	s.False(b.hasRoom())
+	s.Equal(1, b.nextBatchSize())
+
+	b.remove(task)
+	s.True(b.hasRoom())
+	select {
+	case <-b.roomCh:
+	default:
+		s.Fail("pump not woken up")
+	}
+}
+
+func (s *matchingEngineSuite) TestRateLimiter() {
+	ts := &testTimeSource{now: time.Now()}
+	r := newRateLimiter(10, 5, 0, ts)
+
+	// a single task is dispatched right away, the next one has to wait for the bucket to refill
+	delay, ok := r.reserve(0)
+	s.True(ok)
+	s.Equal(time.Duration(0), delay)
+	_, ok = r.reserve(0)
+	s.False(ok)
+	delay, ok = r.reserve(time.Second)
+	s.True(ok)
+	s.Equal(100*time.Millisecond, delay)
+
+	// the tokens accumulated while idle are capped by the burst
+	ts.advance(time.Second)
+	for i := 0; i < 5; i++ {
+		_, ok = r.reserve(0)
+		s.True(ok)
+	}
+	_, ok = r.reserve(0)
+	s.False(ok)
+
+	r.UpdateMaxDispatch(0)
+	for i := 0; i < 100; i++ {
+		_, ok = r.reserve(0)
+		s.True(ok)
+	}
+}
+
+func (s *matchingEngineSuite) TestRateLimiterWarmUp() {
+	ts := &testTimeSource{now: time.Now()}
+	r := newRateLimiter(10, 0, 10*time.Second, ts)
+	_, ok := r.reserve(0)
+	s.True(ok)
+
+	// a tenth of the rate after a tenth of the warm-up period
+	ts.advance(time.Second)
+	_, ok = r.reserve(0)
+	s.True(ok)
+	_, ok = r.reserve(0)
+	s.False(ok)
+
+	// the full rate once warmed up, with a default burst of one second worth of tasks
+	ts.advance(9 * time.Second)
+	for i := 0; i < 10; i++ {
+		_, ok = r.reserve(0)
+		s.True(ok)
+	}
+	_, ok = r.reserve(0)
+	s.False(ok)
+}
+
+func (s *matchingEngineSuite) TestPollUpdatesMaxDispatch() {
+	s.matchingEngine.longPollExpirationInterval = 10 * time.Millisecond
+	domainID := "domainId"
+	tl := "makeToast"
+	identity := "selfDrivingToaster"
+
+	taskList := workflow.NewTaskList()
+	taskList.Name = &tl
+	resp, err := s.matchingEngine.PollForActivityTask(s.callContext, &matching.PollForActivityTaskRequest{
+		DomainUUID: common.StringPtr(domainID),
+		PollRequest: &workflow.PollForActivityTaskRequest{
+			TaskList:         taskList,
+			Identity:         &identity,
+			TaskListMetadata: &workflow.TaskListMetadata{MaxTasksPerSecond: common.Float64Ptr(2.5)},
+		},
+	})
+	s.NoError(err)
+	s.Equal(emptyPollForActivityTaskResponse, resp)
+
+	tlMgr, err := s.matchingEngine.getTaskListManager(newTaskListID(domainID, tl, persistence.TaskListTypeActivity))
+	s.NoError(err)
+	r := tlMgr.(*taskListManagerImpl).rateLimiter
+	r.Lock()
+	defer r.Unlock()
+	s.Equal(2.5, r.maxDispatchPerSecond)
+}
+
 func (s *matchingEngineSuite) TestPollForActivityTasksEmptyResult() {
 	s.PollForTasksEmptyResultTest(persistence.TaskListTypeActivity)
 }
@@ -1085,6 +1204,226 @@ func (s *matchingEngineSuite) TestAddTaskAfterStartFailure() {
 	s.EqualValues(0, s.taskManager.getTaskCount(tlID))
 }
 
+func (s *matchingEngineSuite) TestDrainTaskList() {
+	runID := "run1"
+	workflowID := "workflow1"
+	workflowExecution := workflow.WorkflowExecution{RunId: &runID, WorkflowId: &workflowID}
+
+	domainID := "domainId"
+	tl := "makeToast"
+	tlID := &taskListID{domainID: domainID, taskListName: tl, taskType: persistence.TaskListTypeActivity}
+
+	taskList := workflow.NewTaskList()
+	taskList.Name = &tl
+
+	const taskCount = 250
+	for i := int64(0); i < taskCount; i++ {
+		scheduleID := i * 3
+		addRequest := matching.AddActivityTaskRequest{
+			SourceDomainUUID: common.StringPtr(domainID),
+			DomainUUID:       common.StringPtr(domainID),
+			Execution:        &workflowExecution,
+			ScheduleId:       &scheduleID,
+			TaskList:         taskList}
+
+		err := s.matchingEngine.AddActivityTask(&addRequest)
+		s.NoError(err)
+	}
+	s.EqualValues(taskCount, s.taskManager.getTaskCount(tlID))
+
+	drainRequest := &matching.DrainTaskListRequest{
+		DomainUUID:   common.StringPtr(domainID),
+		TaskList:     taskList,
+		TaskListType: workflow.TaskListTypePtr(workflow.TaskListType_Activity),
+		DryRun:       common.BoolPtr(true),
+	}
+	resp, err := s.matchingEngine.DrainTaskList(drainRequest)
+	s.NoError(err)
+	s.EqualValues(taskCount, resp.GetDrainedTasks())
+	s.EqualValues(taskCount, s.taskManager.getTaskCount(tlID))
+
+	drainRequest.DryRun = common.BoolPtr(false)
+	_, err = s.matchingEngine.DrainTaskList(drainRequest)
+	s.IsType(&workflow.BadRequestError{}, err)
+	s.EqualValues(taskCount, s.taskManager.getTaskCount(tlID))
+
+	drainRequest.Confirmation = common.StringPtr(tl)
+	resp, err = s.matchingEngine.DrainTaskList(drainRequest)
+	s.NoError(err)
+	s.EqualValues(taskCount, resp.GetDrainedTasks())
+	s.EqualValues(0, s.taskManager.getTaskCount(tlID))
+	s.EqualValues(resp.GetMaxReadLevel(), s.taskManager.getTaskListManager(tlID).ackLevel)
+
+	// The task list is reloaded on the next request and keeps serving new tasks
+	scheduleID := int64(taskCount * 3)
+	err = s.matchingEngine.AddActivityTask(&matching.AddActivityTaskRequest{
+		SourceDomainUUID: common.StringPtr(domainID),
+		DomainUUID:       common.StringPtr(domainID),
+		Execution:        &workflowExecution,
+		ScheduleId:       &scheduleID,
+		TaskList:         taskList})
+	s.NoError(err)
+	s.EqualValues(1, s.taskManager.getTaskCount(tlID))
+}
+
+func (s *matchingEngineSuite) TestTaskListPollersAndBacklog() {
+	runID := "run1"
+	workflowID := "workflow1"
+	workflowExecution := workflow.WorkflowExecution{RunId: &runID, WorkflowId: &workflowID}
+
+	domainID := "domainId"
+	tl := "makeToast"
+	tlID := &taskListID{domainID: domainID, taskListName: tl, taskType: persistence.TaskListTypeActivity}
+
+	taskList := workflow.NewTaskList()
+	taskList.Name = &tl
+
+	const taskCount = 5
+	for i := int64(0); i < taskCount; i++ {
+		scheduleID := i * 3
+		err := s.matchingEngine.AddActivityTask(&matching.AddActivityTaskRequest{
+			SourceDomainUUID: common.StringPtr(domainID),
+			DomainUUID:       common.StringPtr(domainID),
+			Execution:        &workflowExecution,
+			ScheduleId:       &scheduleID,
+			TaskList:         taskList})
+		s.NoError(err)
+	}
+
+	mgr, err := s.matchingEngine.getTaskListManager(tlID)
+	s.NoError(err)
+	tlMgr := mgr.(*taskListManagerImpl)
+
+	backlog, err := s.taskManager.GetTaskListBacklog(&persistence.GetTaskListBacklogRequest{
+		DomainID: domainID,
+		TaskList: tl,
+		TaskType: persistence.TaskListTypeActivity,
+		AckLevel: tlMgr.getAckLevel(),
+	})
+	s.NoError(err)
+	s.EqualValues(taskCount, backlog.ApproximateCount)
+	s.NoError(tlMgr.updateBacklogMetrics())
+
+	// The pollers are persisted along with the ack level, until they expire
+	tlMgr.RecordPoller("worker-1")
+	tlMgr.RecordPoller("")
+	tlMgr.Lock()
+	tlMgr.pollers["worker-2"] = time.Now().Add(-2 * pollerExpiration)
+	tlMgr.Unlock()
+	s.NoError(tlMgr.persistAckLevel())
+
+	pollers := s.taskManager.getTaskListManager(tlID).pollers
+	s.Equal(1, len(pollers))
+	s.Contains(pollers, "worker-1")
+	s.Equal(1, len(tlMgr.getPollers()))
+
+	// Another instance of the task list gets the pollers back when it leases the task list
+	engine := s.newMatchingEngine(defaultRangeSize)
+	mgr, err = engine.getTaskListManager(tlID)
+	s.NoError(err)
+	s.Equal(pollers, mgr.(*taskListManagerImpl).getPollers())
+	engine.Stop()
+}
+
+func (s *matchingEngineSuite) TestQueryWorkflow() {
+	runID := "run1"
+	workflowID := "workflow1"
+	workflowExecution := workflow.WorkflowExecution{RunId: &runID, WorkflowId: &workflowID}
+	workflowTypeName := "workflowType1"
+	workflowType := &workflow.WorkflowType{Name: &workflowTypeName}
+
+	domainID := "domainId"
+	tl := "makeToast"
+	taskList := workflow.NewTaskList()
+	taskList.Name = &tl
+	identity := "nobody"
+
+	s.historyClient.On("GetWorkflowExecutionNextEventID", mock.Anything,
+		mock.AnythingOfType("*history.GetWorkflowExecutionNextEventIDRequest")).Return(
+		&gohistory.GetWorkflowExecutionNextEventIDResponse{
+			EventId:                common.Int64Ptr(10),
+			RunId:                  &runID,
+			Tasklist:               taskList,
+			WorkflowType:           workflowType,
+			PreviousStartedEventId: common.Int64Ptr(7),
+		}, nil)
+
+	queryRequest := &matching.QueryWorkflowRequest{
+		DomainUUID: common.StringPtr(domainID),
+		TaskList:   taskList,
+		QueryRequest: &workflow.QueryWorkflowRequest{
+			Domain:    common.StringPtr("domain"),
+			Execution: &workflowExecution,
+			Query:     &workflow.WorkflowQuery{QueryType: common.StringPtr("state")},
+		},
+	}
+
+	// A worker polls the query task and answers it
+	answer := func(completedRequest *workflow.RespondQueryTaskCompletedRequest) {
+		result, err := s.matchingEngine.PollForDecisionTask(s.callContext, &matching.PollForDecisionTaskRequest{
+			DomainUUID: common.StringPtr(domainID),
+			PollRequest: &workflow.PollForDecisionTaskRequest{
+				TaskList: taskList,
+				Identity: &identity},
+		})
+		s.NoError(err)
+		s.Equal("state", result.GetQuery().GetQueryType())
+		s.Equal(workflowExecution, *result.WorkflowExecution)
+		s.Equal(workflowType, result.WorkflowType)
+		s.EqualValues(9, result.GetStartedEventId())
+		s.EqualValues(7, result.GetPreviousStartedEventId())
+
+		token, err := s.matchingEngine.tokenSerializer.DeserializeQueryTaskToken(result.TaskToken)
+		s.NoError(err)
+		s.Equal(domainID, token.DomainID)
+		s.Equal(tl, token.TaskList)
+
+		completedRequest.TaskToken = result.TaskToken
+		s.NoError(s.matchingEngine.RespondQueryTaskCompleted(&matching.RespondQueryTaskCompletedRequest{
+			DomainUUID:       common.StringPtr(domainID),
+			TaskList:         taskList,
+			TaskID:           common.StringPtr(token.TaskID),
+			CompletedRequest: completedRequest,
+		}))
+	}
+
+	go answer(&workflow.RespondQueryTaskCompletedRequest{
+		CompletedType: workflow.QueryTaskCompletedTypePtr(workflow.QueryTaskCompletedType_COMPLETED),
+		QueryResult:   []byte("running"),
+	})
+	ctx, cancel := thrift.NewContext(10 * time.Second)
+	resp, err := s.matchingEngine.QueryWorkflow(ctx, queryRequest)
+	cancel()
+	s.NoError(err)
+	s.Equal([]byte("running"), resp.QueryResult)
+
+	go answer(&workflow.RespondQueryTaskCompletedRequest{
+		CompletedType: workflow.QueryTaskCompletedTypePtr(workflow.QueryTaskCompletedType_FAILED),
+		ErrorMessage:  common.StringPtr("unknown query type"),
+	})
+	ctx, cancel = thrift.NewContext(10 * time.Second)
+	_, err = s.matchingEngine.QueryWorkflow(ctx, queryRequest)
+	cancel()
+	s.Equal(&workflow.QueryFailedError{Message: "unknown query type"}, err)
+
+	// Without a poller the query fails once the context expires, and nothing waits for an answer anymore
+	ctx, cancel = thrift.NewContext(100 * time.Millisecond)
+	_, err = s.matchingEngine.QueryWorkflow(ctx, queryRequest)
+	cancel()
+	s.IsType(&workflow.QueryFailedError{}, err)
+	s.Empty(s.matchingEngine.queryResultMap)
+
+	err = s.matchingEngine.RespondQueryTaskCompleted(&matching.RespondQueryTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		TaskList:   taskList,
+		TaskID:     common.StringPtr("unknown"),
+		CompletedRequest: &workflow.RespondQueryTaskCompletedRequest{
+			CompletedType: workflow.QueryTaskCompletedTypePtr(workflow.QueryTaskCompletedType_COMPLETED),
+		},
+	})
+	s.IsType(&workflow.EntityNotExistsError{}, err)
+}
+
 func newActivityTaskScheduledEvent(eventID int64, decisionTaskCompletedEventID int64,
 	scheduleAttributes *workflow.ScheduleActivityTaskDecisionAttributes) *workflow.HistoryEvent {
 	historyEvent := newHistoryEvent(eventID, workflow.EventType_ActivityTaskScheduled)
@@ -1156,6 +1495,7 @@ type testTaskListManager struct {
 	sync.Mutex
 	rangeID         int64
 	ackLevel        int64
+	pollers         map[string]time.Time
 	createTaskCount int
 	tasks           *treemap.Map
 }
@@ -1192,6 +1532,7 @@ func (m *testTaskManager) LeaseTaskList(request *persistence.LeaseTaskListReques
 			Name:     request.TaskList,
 			TaskType: request.TaskType,
 			RangeID:  tlm.rangeID,
+			Pollers:  tlm.pollers,
 		},
 	}, nil
 }
@@ -1211,9 +1552,26 @@ func (m *testTaskManager) UpdateTaskList(request *persistence.UpdateTaskListRequ
 		}
 	}
 	tlm.ackLevel = tli.AckLevel
+	tlm.pollers = tli.Pollers
 	return &persistence.UpdateTaskListResponse{}, nil
 }
 
+// GetTaskListBacklog provides a mock function with given fields: request
+func (m *testTaskManager) GetTaskListBacklog(
+	request *persistence.GetTaskListBacklogRequest) (*persistence.GetTaskListBacklogResponse, error) {
+	tlm := m.getTaskListManager(newTaskListID(request.DomainID, request.TaskList, request.TaskType))
+	tlm.Lock()
+	defer tlm.Unlock()
+
+	var count int64
+	for _, key := range tlm.tasks.Keys() {
+		if key.(int64) > request.AckLevel {
+			count++
+		}
+	}
+	return &persistence.GetTaskListBacklogResponse{ApproximateCount: count}, nil
+}
+
 // CompleteTask provides a mock function with given fields: request
 func (m *testTaskManager) CompleteTask(request *persistence.CompleteTaskRequest) error {
 	m.logger.Debugf("CompleteTask taskID=%v, ackLevel=%v", request.TaskID, request.TaskList.AckLevel)
@@ -1231,6 +1589,23 @@ func (m *testTaskManager) CompleteTask(request *persistence.CompleteTaskRequest)
 	return nil
 }
 
+// CompleteTasksLessThan provides a mock function with given fields: request
+func (m *testTaskManager) CompleteTasksLessThan(request *persistence.CompleteTasksLessThanRequest) error {
+	m.logger.Debugf("CompleteTasksLessThan taskID=%v", request.TaskID)
+
+	tlm := m.getTaskListManager(newTaskListID(request.DomainID, request.TaskListName, request.TaskType))
+
+	tlm.Lock()
+	defer tlm.Unlock()
+
+	for _, key := range tlm.tasks.Keys() {
+		if key.(int64) < request.TaskID {
+			tlm.tasks.Remove(key)
+		}
+	}
+	return nil
+}
+
 // CreateTask provides a mock function with given fields: request
 func (m *testTaskManager) CreateTasks(request *persistence.CreateTasksRequest) (*persistence.CreateTasksResponse, error) {
 	domainID := request.DomainID
@@ -1362,3 +1737,15 @@ func validateTimeRange(t time.Time, expectedDuration time.Duration) bool {
 	}
 	return true
 }
+
+type testTimeSource struct {
+	now time.Time
+}
+
+func (ts *testTimeSource) Now() time.Time {
+	return ts.now
+}
+
+func (ts *testTimeSource) advance(d time.Duration) {
+	ts.now = ts.now.Add(d)
+}
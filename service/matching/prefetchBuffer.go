@@ -0,0 +1,123 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"sync/atomic"
+
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	minGetTasksBatchSize     = 10
+	defaultGetTasksBatchSize = 100
+	maxGetTasksBatchSize     = 1000
+	// To perform one db operation if there are no pollers
+	taskBufferSize = maxGetTasksBatchSize - 1
+
+	defaultPrefetchBufferMaxBytes = 1024 * 1024
+	// taskInfoOverhead is the size of a buffered task besides its strings
+	taskInfoOverhead = 96
+)
+
+// prefetchBuffer accounts for the tasks read ahead by the pump of a task list and not yet dispatched to pollers.  It
+// adapts the size of the batches read by the pump: the size grows while every batch is full, which means the pollers
+// keep up with the backlog, and shrinks when the task list is idle.  The bytes of the buffered tasks are bounded, the
+// pump waits for the pollers once the bound is reached.
+type prefetchBuffer struct {
+	maxBytes      int64
+	metricsClient metrics.Client
+	tasks         int64 // number of buffered tasks, updated atomically
+	bytes         int64 // size of the buffered tasks, updated atomically
+	roomCh        chan struct{}
+	batchSize     int // only used by the pump
+}
+
+func newPrefetchBuffer(maxBytes int64, metricsClient metrics.Client) *prefetchBuffer {
+	return &prefetchBuffer{
+		maxBytes:      maxBytes,
+		metricsClient: metricsClient,
+		roomCh:        make(chan struct{}, 1),
+		batchSize:     defaultGetTasksBatchSize,
+	}
+}
+
+// nextBatchSize returns the number of tasks the pump reads next, no more than the buffer has room for
+func (b *prefetchBuffer) nextBatchSize() int {
+	size := b.batchSize
+	room := (b.maxBytes - atomic.LoadInt64(&b.bytes)) / taskInfoOverhead
+	if room < int64(size) {
+		size = int(room)
+	}
+	if size < 1 {
+		size = 1
+	}
+	return size
+}
+
+// batchRead adapts the batch size to the number of tasks returned by a read of requested tasks
+func (b *prefetchBuffer) batchRead(requested int, count int) {
+	switch {
+	case count == 0:
+		b.batchSize /= 2
+		if b.batchSize < minGetTasksBatchSize {
+			b.batchSize = minGetTasksBatchSize
+		}
+	case count == requested && requested == b.batchSize:
+		b.batchSize *= 2
+		if b.batchSize > maxGetTasksBatchSize {
+			b.batchSize = maxGetTasksBatchSize
+		}
+	}
+	b.metricsClient.UpdateGauge(metrics.MatchingTaskListMgrScope, metrics.GetTasksBatchSizeGauge,
+		float64(b.batchSize))
+}
+
+// hasRoom returns false while the buffered tasks use up the bytes of the buffer
+func (b *prefetchBuffer) hasRoom() bool {
+	return atomic.LoadInt64(&b.bytes) < b.maxBytes
+}
+
+// add accounts for a task before it is buffered
+func (b *prefetchBuffer) add(task *persistence.TaskInfo) {
+	b.update(1, taskSize(task))
+}
+
+// remove accounts for a task taken from the buffer, it wakes up the pump waiting for room
+func (b *prefetchBuffer) remove(task *persistence.TaskInfo) {
+	b.update(-1, -taskSize(task))
+	select {
+	case b.roomCh <- struct{}{}:
+	default:
+	}
+}
+
+func (b *prefetchBuffer) update(tasks int64, bytes int64) {
+	b.metricsClient.UpdateGauge(metrics.MatchingTaskListMgrScope, metrics.PrefetchBufferTasksGauge,
+		float64(atomic.AddInt64(&b.tasks, tasks)))
+	b.metricsClient.UpdateGauge(metrics.MatchingTaskListMgrScope, metrics.PrefetchBufferBytesGauge,
+		float64(atomic.AddInt64(&b.bytes, bytes)))
+}
+
+func taskSize(task *persistence.TaskInfo) int64 {
+	return int64(taskInfoOverhead + len(task.DomainID) + len(task.WorkflowID) + len(task.RunID))
+}
@@ -0,0 +1,154 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/tchannel-go/thrift"
+)
+
+const (
+	// warmUpMinRateRatio is the ratio of the dispatch rate of a task list right after it is loaded
+	warmUpMinRateRatio = 0.1
+)
+
+type (
+	// rateLimiter limits the rate at which the tasks of a task list are dispatched.  It is a token bucket whose rate
+	// ramps up during the warm-up period following its creation, and whose rate can be changed at any time without
+	// losing the tokens accumulated so far.
+	rateLimiter struct {
+		sync.Mutex
+		maxDispatchPerSecond float64 // zero means unlimited
+		burst                int     // zero means one second worth of tasks
+		warmUp               time.Duration
+		timeSource           common.TimeSource
+		startTime            time.Time
+		lastRefillTime       time.Time
+		tokens               float64 // negative when waiters reserved tokens ahead of time
+	}
+)
+
+func newRateLimiter(maxDispatchPerSecond float64, burst int, warmUp time.Duration,
+	timeSource common.TimeSource) *rateLimiter {
+	now := timeSource.Now()
+	return &rateLimiter{
+		maxDispatchPerSecond: maxDispatchPerSecond,
+		burst:                burst,
+		warmUp:               warmUp,
+		timeSource:           timeSource,
+		startTime:            now,
+		lastRefillTime:       now,
+		tokens:               1,
+	}
+}
+
+// UpdateMaxDispatch changes the dispatch rate, zero removes the limit
+func (r *rateLimiter) UpdateMaxDispatch(maxDispatchPerSecond float64) {
+	r.Lock()
+	defer r.Unlock()
+	if r.maxDispatchPerSecond == maxDispatchPerSecond {
+		return
+	}
+	r.refillLocked()
+	r.maxDispatchPerSecond = maxDispatchPerSecond
+	r.tokens = math.Min(r.tokens, r.capacityLocked())
+}
+
+// Wait blocks until a task can be dispatched, it returns false if the context is done or if the timeout expires first.
+// When the task cannot be dispatched before the timeout it still blocks until the timeout, like a poll which finds no
+// task, so that throttled pollers do not poll again right away.
+func (r *rateLimiter) Wait(ctx thrift.Context, timeout time.Duration) bool {
+	delay, ok := r.reserve(timeout)
+	if ok && delay <= 0 {
+		return true
+	}
+	if !ok {
+		delay = timeout
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return ok
+	case <-ctx.Done():
+		if ok {
+			r.cancelReservation()
+		}
+		return false
+	}
+}
+
+// reserve takes a token and returns how long to wait before it can be used, unless the wait is longer than timeout
+func (r *rateLimiter) reserve(timeout time.Duration) (time.Duration, bool) {
+	r.Lock()
+	defer r.Unlock()
+	if r.maxDispatchPerSecond <= 0 {
+		return 0, true
+	}
+	r.refillLocked()
+	if r.tokens >= 1 {
+		r.tokens--
+		return 0, true
+	}
+	delay := time.Duration((1 - r.tokens) / r.rateLocked() * float64(time.Second))
+	if delay > timeout {
+		return 0, false
+	}
+	r.tokens--
+	return delay, true
+}
+
+func (r *rateLimiter) cancelReservation() {
+	r.Lock()
+	defer r.Unlock()
+	r.tokens = math.Min(r.tokens+1, r.capacityLocked())
+}
+
+func (r *rateLimiter) refillLocked() {
+	now := r.timeSource.Now()
+	elapsed := now.Sub(r.lastRefillTime)
+	r.lastRefillTime = now
+	if r.maxDispatchPerSecond <= 0 || elapsed <= 0 {
+		return
+	}
+	r.tokens = math.Min(r.tokens+elapsed.Seconds()*r.rateLocked(), r.capacityLocked())
+}
+
+// rateLocked returns the current dispatch rate, which is lower than the max rate during the warm-up period
+func (r *rateLimiter) rateLocked() float64 {
+	if r.warmUp <= 0 {
+		return r.maxDispatchPerSecond
+	}
+	ratio := float64(r.lastRefillTime.Sub(r.startTime)) / float64(r.warmUp)
+	return r.maxDispatchPerSecond * math.Min(1, math.Max(warmUpMinRateRatio, ratio))
+}
+
+func (r *rateLimiter) capacityLocked() float64 {
+	if r.burst > 0 {
+		return float64(r.burst)
+	}
+	return math.Max(1, math.Ceil(r.maxDispatchPerSecond))
+}
@@ -45,16 +45,13 @@ func (s *Service) Start() {
 
 	var p = s.params
 	var log = p.Logger
+	var svcCfg = &p.ServiceConfig
 
 	log.Infof("%v starting", common.MatchingServiceName)
 
 	base := service.New(p)
 
-	taskPersistence, err := persistence.NewCassandraTaskPersistence(p.CassandraConfig.Hosts,
-		p.CassandraConfig.Datacenter,
-		p.CassandraConfig.Keyspace,
-		base.GetLogger())
-
+	taskPersistence, err := p.PersistenceFactory.CreateTaskManager()
 	if err != nil {
 		log.Fatalf("failed to create task persistence: %v", err)
 	}
@@ -62,6 +59,7 @@ func (s *Service) Start() {
 	taskPersistence = persistence.NewTaskPersistenceClient(taskPersistence, base.GetMetricsClient())
 
 	handler, tchanServers := NewHandler(taskPersistence, base)
+	handler.SetDispatchRateLimit(svcCfg.DispatchRateLimit)
 	handler.Start(tchanServers)
 
 	log.Infof("%v started", common.MatchingServiceName)
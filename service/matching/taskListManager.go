@@ -28,21 +28,23 @@ import (
 
 	"github.com/uber-common/bark"
 	h "github.com/uber/cadence/.gen/go/history"
+	m "github.com/uber/cadence/.gen/go/matching"
 	s "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/tchannel-go/thrift"
 	"golang.org/x/net/context"
 )
 
 const (
-	defaultRangeSize  = 100000
-	getTasksBatchSize = 100
-	// To perform one db operation if there are no pollers
-	taskBufferSize    = getTasksBatchSize - 1
-	updateAckInterval = 10 * time.Second
+	defaultRangeSize      = 100000
+	updateAckInterval     = 10 * time.Second
+	updateBacklogInterval = time.Minute
+	// pollerExpiration is how long a worker which stopped polling is still reported as a poller of the task list
+	pollerExpiration = 5 * time.Minute
 
 	done time.Duration = -1
 )
@@ -52,10 +54,17 @@ type taskListManager interface {
 	Stop()
 	AddTask(execution *s.WorkflowExecution, taskInfo *persistence.TaskInfo) error
 	GetTaskContext(ctx thrift.Context) (*taskContext, error)
+	RecordPoller(identity string)
+	UpdateMaxDispatch(maxDispatchPerSecond float64)
+	DrainBacklog(dryRun bool) (*m.DrainTaskListResponse, error)
+	SyncMatchQueryTask(ctx thrift.Context, queryTask *queryTaskInfo) error
 	String() string
 }
 
 func newTaskListManager(e *matchingEngineImpl, taskList *taskListID) taskListManager {
+	metricsClient := e.metricsClient.Tagged(map[string]string{
+		metrics.TaskListTagName: taskList.taskListName,
+	})
 	tlMgr := &taskListManagerImpl{
 		engine:     e,
 		taskBuffer: make(chan *persistence.TaskInfo, taskBufferSize),
@@ -66,8 +75,13 @@ func newTaskListManager(e *matchingEngineImpl, taskList *taskListID) taskListMan
 			logging.TagTaskListType: taskList.taskType,
 			logging.TagTaskListName: taskList.taskListName,
 		}),
+		metricsClient:  metricsClient,
 		taskAckManager: newAckManager(e.logger),
+		pollers:        make(map[string]time.Time),
 		syncMatch:      make(chan *getTaskResult),
+		prefetch:       newPrefetchBuffer(e.prefetchBufferMaxBytes, metricsClient),
+		rateLimiter: newRateLimiter(e.dispatchRateLimit.RPS, e.dispatchRateLimit.Burst, e.dispatchRateLimit.WarmUp,
+			common.NewRealTimeSource()),
 	}
 	tlMgr.taskWriter = newTaskWriter(tlMgr, tlMgr.shutdownCh)
 	return tlMgr
@@ -79,15 +93,19 @@ type taskContext struct {
 	info              *persistence.TaskInfo
 	syncResponseCh    chan<- *syncMatchResponse
 	workflowExecution s.WorkflowExecution
+	queryTaskInfo     *queryTaskInfo // set instead of info if the task is a query task
 }
 
 // Single task list in memory state
 type taskListManagerImpl struct {
-	taskListID *taskListID
-	logger     bark.Logger
-	engine     *matchingEngineImpl
-	taskWriter *taskWriter
-	taskBuffer chan *persistence.TaskInfo // tasks loaded from persistence
+	taskListID    *taskListID
+	logger        bark.Logger
+	metricsClient metrics.Client // tagged with the task list name
+	engine        *matchingEngineImpl
+	taskWriter    *taskWriter
+	taskBuffer    chan *persistence.TaskInfo // tasks loaded from persistence
+	prefetch      *prefetchBuffer            // accounts for the tasks in taskBuffer
+	rateLimiter   *rateLimiter               // limits the rate of the tasks dispatched to the pollers
 	// Sync channel used to perform sync matching.
 	// It must to be unbuffered. addTask publishes to it asynchronously and expects publish to succeed
 	// only if there is waiting poll that consumes from it.
@@ -101,13 +119,16 @@ type taskListManagerImpl struct {
 	rangeID                 int64      // Current range of the task list. Starts from 1.
 	taskSequenceNumber      int64      // Sequence number of the next task. Starts from 1.
 	nextRangeSequenceNumber int64      // Current range boundary
+	// Last poll time of the workers which polled the task list, persisted along with the ack level
+	pollers map[string]time.Time
 }
 
 // getTaskResult contains task info and optional channel to notify createTask caller
 // that task is successfully started and returned to a poller
 type getTaskResult struct {
-	task *persistence.TaskInfo
-	C    chan *syncMatchResponse
+	task      *persistence.TaskInfo
+	C         chan *syncMatchResponse
+	queryTask *queryTaskInfo
 }
 
 // syncMatchResponse result of sync match delivered to a createTask caller
@@ -161,6 +182,13 @@ func (c *taskListManagerImpl) GetTaskContext(ctx thrift.Context) (*taskContext,
 	if err != nil {
 		return nil, err
 	}
+	if result.queryTask != nil {
+		return &taskContext{
+			workflowExecution: *result.queryTask.queryRequest.GetQueryRequest().GetExecution(),
+			tlMgr:             c,
+			queryTaskInfo:     result.queryTask,
+		}, nil
+	}
 	task := result.task
 	workflowExecution := s.WorkflowExecution{
 		WorkflowId: common.StringPtr(task.WorkflowID),
@@ -175,6 +203,36 @@ func (c *taskListManagerImpl) GetTaskContext(ctx thrift.Context) (*taskContext,
 	return tCtx, nil
 }
 
+// RecordPoller records a poll of the task list by the worker with the given identity
+func (c *taskListManagerImpl) RecordPoller(identity string) {
+	if identity == "" {
+		return
+	}
+	c.Lock()
+	defer c.Unlock()
+	c.pollers[identity] = time.Now()
+}
+
+// getPollersLocked forgets the expired pollers and returns a copy of the others
+func (c *taskListManagerImpl) getPollersLocked() map[string]time.Time {
+	expiration := time.Now().Add(-pollerExpiration)
+	pollers := make(map[string]time.Time, len(c.pollers))
+	for identity, lastAccessTime := range c.pollers {
+		if lastAccessTime.Before(expiration) {
+			delete(c.pollers, identity)
+			continue
+		}
+		pollers[identity] = lastAccessTime
+	}
+	return pollers
+}
+
+func (c *taskListManagerImpl) getPollers() map[string]time.Time {
+	c.Lock()
+	defer c.Unlock()
+	return c.getPollersLocked()
+}
+
 func (c *taskListManagerImpl) getRangeID() int64 {
 	c.Lock()
 	defer c.Unlock()
@@ -198,6 +256,7 @@ func (c *taskListManagerImpl) persistAckLevel() error {
 			TaskType: c.taskListID.taskType,
 			AckLevel: c.taskAckManager.getAckLevel(),
 			RangeID:  c.rangeID,
+			Pollers:  c.getPollersLocked(),
 		},
 	}
 	c.Unlock()
@@ -205,6 +264,107 @@ func (c *taskListManagerImpl) persistAckLevel() error {
 	return err
 }
 
+// DrainBacklog discards the tasks between the ack level and the max read level at the time of the call. Tasks are
+// read in batches and every batch is completed with a single range delete, so an interrupted drain keeps its
+// progress. When dryRun is set the backlog is only counted. After a drain the task list is unloaded to drop the
+// tasks already buffered by the pump, tasks written after the drain started are picked up again on reload.
+func (c *taskListManagerImpl) DrainBacklog(dryRun bool) (*m.DrainTaskListResponse, error) {
+	ackLevel := c.getAckLevel()
+	maxReadLevel := c.taskWriter.GetMaxReadLevel()
+
+	var drained int64
+	readLevel := ackLevel
+	for readLevel < maxReadLevel {
+		response, err := c.executeWithRetry(func(rangeID int64) (interface{}, error) {
+			return c.engine.taskManager.GetTasks(&persistence.GetTasksRequest{
+				DomainID:     c.taskListID.domainID,
+				TaskList:     c.taskListID.taskListName,
+				TaskType:     c.taskListID.taskType,
+				BatchSize:    defaultGetTasksBatchSize,
+				RangeID:      rangeID,
+				ReadLevel:    readLevel,
+				MaxReadLevel: maxReadLevel,
+			})
+		})
+		if err != nil {
+			return nil, err
+		}
+		tasks := response.(*persistence.GetTasksResponse).Tasks
+		if len(tasks) == 0 {
+			break
+		}
+		readLevel = tasks[len(tasks)-1].TaskID
+		drained += int64(len(tasks))
+		if dryRun {
+			continue
+		}
+		if err := c.completeTasksLessThan(readLevel + 1); err != nil {
+			return nil, err
+		}
+	}
+
+	if !dryRun {
+		// Expired tasks are not returned by GetTasks, complete whatever is left of the range
+		if err := c.completeTasksLessThan(maxReadLevel + 1); err != nil {
+			return nil, err
+		}
+		_, err := c.executeWithRetry(func(rangeID int64) (interface{}, error) {
+			return c.engine.taskManager.UpdateTaskList(&persistence.UpdateTaskListRequest{
+				TaskListInfo: &persistence.TaskListInfo{
+					DomainID: c.taskListID.domainID,
+					Name:     c.taskListID.taskListName,
+					TaskType: c.taskListID.taskType,
+					AckLevel: maxReadLevel,
+					RangeID:  rangeID,
+					Pollers:  c.getPollers(),
+				},
+			})
+		})
+		if err != nil {
+			return nil, err
+		}
+		c.logger.Infof("Drained %v tasks up to taskID=%v from %v", drained, maxReadLevel, c.taskListID)
+		c.Stop()
+	}
+
+	return &m.DrainTaskListResponse{
+		AckLevel:     common.Int64Ptr(ackLevel),
+		MaxReadLevel: common.Int64Ptr(maxReadLevel),
+		DrainedTasks: common.Int64Ptr(drained),
+	}, nil
+}
+
+// updateBacklogMetrics reports the approximate number of tasks of the task list above the ack level, which are still
+// to be dispatched, along with the number of its pollers
+func (c *taskListManagerImpl) updateBacklogMetrics() error {
+	response, err := c.engine.taskManager.GetTaskListBacklog(&persistence.GetTaskListBacklogRequest{
+		DomainID: c.taskListID.domainID,
+		TaskList: c.taskListID.taskListName,
+		TaskType: c.taskListID.taskType,
+		AckLevel: c.getAckLevel(),
+	})
+	if err != nil {
+		return err
+	}
+	c.metricsClient.UpdateGauge(metrics.MatchingTaskListMgrScope, metrics.TaskListBacklogGauge,
+		float64(response.ApproximateCount))
+	c.metricsClient.UpdateGauge(metrics.MatchingTaskListMgrScope, metrics.TaskListPollersGauge,
+		float64(len(c.getPollers())))
+	return nil
+}
+
+func (c *taskListManagerImpl) completeTasksLessThan(taskID int64) error {
+	op := func() error {
+		return c.engine.taskManager.CompleteTasksLessThan(&persistence.CompleteTasksLessThanRequest{
+			DomainID:     c.taskListID.domainID,
+			TaskListName: c.taskListID.taskListName,
+			TaskType:     c.taskListID.taskType,
+			TaskID:       taskID,
+		})
+	}
+	return backoff.Retry(op, persistenceOperationRetryPolicy, persistence.IsTransientError)
+}
+
 // newTaskIDs taskID to use to persist the task
 func (c *taskListManagerImpl) newTaskIDs(count int) (taskID []int64, err error) {
 	c.Lock()
@@ -232,6 +392,11 @@ func (c *taskListManagerImpl) getAckLevel() (ackLevel int64) {
 	return c.taskAckManager.getAckLevel()
 }
 
+// UpdateMaxDispatch changes the rate of the tasks dispatched by the task list, as requested by a poller
+func (c *taskListManagerImpl) UpdateMaxDispatch(maxDispatchPerSecond float64) {
+	c.rateLimiter.UpdateMaxDispatch(maxDispatchPerSecond)
+}
+
 // completeTaskPoll should be called after task poll is done even if append has failed.
 // There is no correspondent initiateTaskPoll as append is initiated in getTasksPump
 func (c *taskListManagerImpl) completeTaskPoll(taskID int64) (ackLevel int64) {
@@ -243,6 +408,11 @@ func (c *taskListManagerImpl) completeTaskPoll(taskID int64) (ackLevel int64) {
 
 // Loads task from taskBuffer (which is populated from persistence) or from sync match to add task call
 func (c *taskListManagerImpl) getTask(ctx thrift.Context) (*getTaskResult, error) {
+	if !c.rateLimiter.Wait(ctx, c.engine.longPollExpirationInterval) {
+		c.metricsClient.IncCounter(metrics.MatchingTaskListMgrScope, metrics.PollThrottleCounter)
+		return nil, ErrNoTasks
+	}
+
 	timer := time.NewTimer(c.engine.longPollExpirationInterval)
 	defer timer.Stop()
 	select {
@@ -250,6 +420,7 @@ func (c *taskListManagerImpl) getTask(ctx thrift.Context) (*getTaskResult, error
 		if !ok { // Task list getTasks pump is shutdown
 			return nil, errPumpClosed
 		}
+		c.prefetch.remove(task)
 		return &getTaskResult{task: task}, nil
 	case resultFromSyncMatch := <-c.syncMatch:
 		return resultFromSyncMatch, nil
@@ -264,15 +435,15 @@ func (c *taskListManagerImpl) getTask(ctx thrift.Context) (*getTaskResult, error
 	}
 }
 
-// Returns a batch of tasks from persistence starting form current read level.
-func (c *taskListManagerImpl) getTaskBatch() ([]*persistence.TaskInfo, error) {
+// Returns a batch of at most batchSize tasks from persistence starting form current read level.
+func (c *taskListManagerImpl) getTaskBatch(batchSize int) ([]*persistence.TaskInfo, error) {
 	response, err := c.executeWithRetry(func(rangeID int64) (interface{}, error) {
 		c.Lock()
 		request := &persistence.GetTasksRequest{
 			DomainID:     c.taskListID.domainID,
 			TaskList:     c.taskListID.taskListName,
 			TaskType:     c.taskListID.taskType,
-			BatchSize:    getTasksBatchSize,
+			BatchSize:    batchSize,
 			RangeID:      rangeID,
 			ReadLevel:    c.taskAckManager.getReadLevel(),
 			MaxReadLevel: c.taskWriter.GetMaxReadLevel(),
@@ -307,7 +478,7 @@ func (c *taskListManagerImpl) updateRangeIfNeededLocked(e *matchingEngineImpl) e
 		})
 		return
 	}
-	err := backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
+	err := backoff.Retry(op, persistenceOperationRetryPolicy, persistence.IsTransientError)
 
 	if err != nil {
 		c.engine.unloadTaskList(c.taskListID)
@@ -317,6 +488,11 @@ func (c *taskListManagerImpl) updateRangeIfNeededLocked(e *matchingEngineImpl) e
 	tli := resp.TaskListInfo
 	c.rangeID = tli.RangeID // Starts from 1
 	c.taskAckManager.setAckLevel(tli.AckLevel)
+	for identity, lastAccessTime := range tli.Pollers {
+		if lastAccessTime.After(c.pollers[identity]) {
+			c.pollers[identity] = lastAccessTime
+		}
+	}
 	c.taskSequenceNumber = (tli.RangeID-1)*e.rangeSize + 1
 
 	c.nextRangeSequenceNumber = (tli.RangeID)*e.rangeSize + 1
@@ -362,10 +538,23 @@ func (c *taskListManagerImpl) trySyncMatch(task *persistence.TaskInfo) (*persist
 	}
 }
 
+// SyncMatchQueryTask hands the query task over to a poller waiting on getTask. Query tasks are never persisted,
+// so it blocks until a poller picks up the task or the context is done.
+func (c *taskListManagerImpl) SyncMatchQueryTask(ctx thrift.Context, queryTask *queryTaskInfo) error {
+	request := &getTaskResult{queryTask: queryTask}
+	select {
+	case c.syncMatch <- request:
+		return nil
+	case <-ctx.Done():
+		return &s.QueryFailedError{Message: "timeout: no workflow worker polling for given tasklist"}
+	}
+}
+
 func (c *taskListManagerImpl) getTasksPump() {
 	defer close(c.taskBuffer)
 
 	updateAckTimer := time.NewTimer(updateAckInterval)
+	updateBacklogTimer := time.NewTimer(updateBacklogInterval)
 
 getTasksPumpLoop:
 	for {
@@ -374,7 +563,8 @@ getTasksPumpLoop:
 			break getTasksPumpLoop
 		case <-c.notifyCh:
 			{
-				tasks, err := c.getTaskBatch()
+				batchSize := c.prefetch.nextBatchSize()
+				tasks, err := c.getTaskBatch(batchSize)
 				if err != nil {
 					logging.LogPersistantStoreErrorEvent(c.logger, logging.TagValueStoreOperationGetTasks, err,
 						fmt.Sprintf("{taskType: %v, taskList: %v}",
@@ -383,12 +573,21 @@ getTasksPumpLoop:
 					// TODO: Should we ever stop retrying on db errors?
 					continue getTasksPumpLoop
 				}
+				c.prefetch.batchRead(batchSize, len(tasks))
 				c.Lock()
 				for _, t := range tasks {
 					c.taskAckManager.addTask(t.TaskID)
 				}
 				c.Unlock()
 				for _, t := range tasks {
+					for !c.prefetch.hasRoom() {
+						select {
+						case <-c.prefetch.roomCh:
+						case <-c.shutdownCh:
+							break getTasksPumpLoop
+						}
+					}
+					c.prefetch.add(t)
 					select {
 					case c.taskBuffer <- t:
 					case <-c.shutdownCh:
@@ -415,10 +614,21 @@ getTasksPumpLoop:
 				c.signalNewTask() // periodically signal pump to check persistence for tasks
 				updateAckTimer = time.NewTimer(updateAckInterval)
 			}
+		case <-updateBacklogTimer.C:
+			{
+				if err := c.updateBacklogMetrics(); err != nil {
+					logging.LogPersistantStoreErrorEvent(c.logger, logging.TagValueStoreOperationGetTaskListBacklog,
+						err, fmt.Sprintf("{taskType: %v, taskList: %v}",
+							c.taskListID.taskType, c.taskListID.taskListName))
+					// keep going as the backlog is only reported
+				}
+				updateBacklogTimer = time.NewTimer(updateBacklogInterval)
+			}
 		}
 	}
 
 	updateAckTimer.Stop()
+	updateBacklogTimer.Stop()
 }
 
 // Retry operation on transient error and on rangeID change. On rangeID update by another process calls c.Stop().
@@ -449,7 +659,7 @@ func (c *taskListManagerImpl) executeWithRetry(
 			retryCount++
 			return true
 		}
-		return common.IsPersistenceTransientError(err)
+		return persistence.IsTransientError(err)
 	})
 
 	if _, ok := err.(*persistence.ConditionFailedError); ok {
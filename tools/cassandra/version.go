@@ -25,6 +25,16 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+
+	"github.com/uber/cadence/common/service/config"
+)
+
+const (
+	// ExpectedVersion is the version of the cadence keyspace schema (schema/cadence/versioned) required by the server
+	ExpectedVersion = "0.16"
+	// ExpectedVisibilityVersion is the version of the visibility keyspace schema (schema/visibility/versioned)
+	// required by the server
+	ExpectedVisibilityVersion = "0.1"
 )
 
 // represents names of the form vx.x where x.x is a (major, minor) version pair
@@ -94,3 +104,42 @@ func parseValidateVersion(ver string) (string, error) {
 	}
 	return ver, nil
 }
+
+// VerifyCompatibleVersion checks that the schema versions of the cadence and, if checkVisibility is true, the
+// visibility keyspaces are at least the ones required by the server, so that the server fails fast at startup
+// instead of failing requests against missing tables and columns
+func VerifyCompatibleVersion(cfg config.Cassandra, checkVisibility bool) error {
+	if err := verifyKeyspaceVersion(cfg.Hosts, cfg.Keyspace, ExpectedVersion); err != nil {
+		return err
+	}
+	if checkVisibility {
+		return verifyKeyspaceVersion(cfg.Hosts, cfg.VisibilityKeyspace, ExpectedVisibilityVersion)
+	}
+	return nil
+}
+
+func verifyKeyspaceVersion(hosts string, keyspace string, expectedVersion string) error {
+	client, err := newCQLClient(hosts, keyspace)
+	if err != nil {
+		return fmt.Errorf("error creating cql client for keyspace %v:%v", keyspace, err)
+	}
+	defer client.Close()
+
+	version, err := client.ReadSchemaVersion()
+	if err != nil {
+		return fmt.Errorf("error reading schema version of keyspace %v:%v", keyspace, err)
+	}
+	return checkCompatibleVersion(keyspace, version, expectedVersion)
+}
+
+// checkCompatibleVersion returns an error if the installed schema version of the keyspace is behind the expected one
+func checkCompatibleVersion(keyspace string, version string, expectedVersion string) error {
+	if _, _, err := parseVersion(version); err != nil {
+		return fmt.Errorf("invalid schema version %q of keyspace %v:%v", version, keyspace, err)
+	}
+	if cmpVersion(version, expectedVersion) < 0 {
+		return fmt.Errorf("schema version %v of keyspace %v is behind the required version %v, "+
+			"run cadence-cassandra-tool update-schema to upgrade it", version, keyspace, expectedVersion)
+	}
+	return nil
+}
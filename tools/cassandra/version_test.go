@@ -21,6 +21,8 @@
 package cassandra
 
 import (
+	"io/ioutil"
+
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"testing"
@@ -97,6 +99,34 @@ func (s *VersionTestSuite) TestParseValidateVersion() {
 	}
 }
 
+func (s *VersionTestSuite) TestCheckCompatibleVersion() {
+	s.Nil(checkCompatibleVersion("cadence", "0.2", "0.2"))
+	s.Nil(checkCompatibleVersion("cadence", "0.3", "0.2"))
+	s.Nil(checkCompatibleVersion("cadence", "1.0", "0.2"))
+	s.NotNil(checkCompatibleVersion("cadence", "0.1", "0.2"))
+	s.NotNil(checkCompatibleVersion("cadence", "0", "0.2"))
+	s.NotNil(checkCompatibleVersion("cadence", "0.2a", "0.1"))
+}
+
+func (s *VersionTestSuite) TestExpectedVersions() {
+	s.Equal(ExpectedVersion, s.latestVersion("../../schema/cadence/versioned"))
+	s.Equal(ExpectedVisibilityVersion, s.latestVersion("../../schema/visibility/versioned"))
+}
+
+func (s *VersionTestSuite) latestVersion(schemaDir string) string {
+	dirs, err := ioutil.ReadDir(schemaDir)
+	s.Nil(err)
+	latest := ""
+	for _, dir := range dirs {
+		ver, err := parseValidateVersion(dir.Name())
+		s.Nil(err)
+		if cmpVersion(ver, latest) > 0 {
+			latest = ver
+		}
+	}
+	return latest
+}
+
 func (s *VersionTestSuite) execParseValidateTest(input string, output string, isErr bool) {
 	ver, err := parseValidateVersion(input)
 	if isErr {